@@ -28,6 +28,9 @@ func SetDefaults_LandscaperConfiguration(obj *LandscaperConfiguration) {
 			UseInMemoryOverlay: false,
 		}
 	}
+	if obj.Registry.Git != nil && obj.Registry.Git.Interval == nil {
+		obj.Registry.Git.Interval = &metav1.Duration{Duration: 5 * time.Minute}
+	}
 
 	SetDefaults_CommonControllerConfig(&obj.Controllers.Installations.CommonControllerConfig)
 	SetDefaults_CommonControllerConfig(&obj.Controllers.Executions.CommonControllerConfig)
@@ -40,6 +43,9 @@ func SetDefaults_LandscaperConfiguration(obj *LandscaperConfiguration) {
 	if obj.DeployItemTimeouts.Pickup == nil {
 		obj.DeployItemTimeouts.Pickup = &v1alpha1.Duration{Duration: 5 * time.Minute}
 	}
+	if obj.DeployItemTimeouts.ProgressingDefault == nil {
+		obj.DeployItemTimeouts.ProgressingDefault = &v1alpha1.Duration{Duration: 10 * time.Minute}
+	}
 	if obj.DeployItemTimeouts.Abort == nil {
 		obj.DeployItemTimeouts.Abort = &v1alpha1.Duration{Duration: 5 * time.Minute}
 	}
@@ -56,6 +62,11 @@ func SetDefaults_LandscaperConfiguration(obj *LandscaperConfiguration) {
 	if obj.SignatureVerificationEnforcementPolicy == "" {
 		obj.SignatureVerificationEnforcementPolicy = DoNotEnforce
 	}
+	for i := range obj.SignatureVerificationRules {
+		if obj.SignatureVerificationRules[i].Mode == "" {
+			obj.SignatureVerificationRules[i].Mode = SignatureVerificationModeFail
+		}
+	}
 }
 
 // SetDefaults_CrdManagementConfiguration sets the defaults for the crd management configuration.
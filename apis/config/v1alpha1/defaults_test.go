@@ -30,6 +30,30 @@ var _ = Describe("Defaults", func() {
 		Expect(cfg.ForceUpdate).To(gstruct.PointTo(Equal(true)))
 	})
 
+	It("should default the mode of signature verification rules", func() {
+		cfg := &v1alpha1.LandscaperConfiguration{
+			SignatureVerificationRules: []v1alpha1.SignatureVerificationRule{
+				{RepositoryBaseURL: "example.com/a", SignatureName: "a"},
+				{RepositoryBaseURL: "example.com/b", SignatureName: "b", Mode: v1alpha1.SignatureVerificationModeWarn},
+			},
+		}
+		v1alpha1.SetDefaults_LandscaperConfiguration(cfg)
+		Expect(cfg.SignatureVerificationRules[0].Mode).To(Equal(v1alpha1.SignatureVerificationModeFail))
+		Expect(cfg.SignatureVerificationRules[1].Mode).To(Equal(v1alpha1.SignatureVerificationModeWarn))
+	})
+
+	It("should default the pull interval of the git registry", func() {
+		cfg := &v1alpha1.LandscaperConfiguration{}
+		v1alpha1.SetDefaults_LandscaperConfiguration(cfg)
+		Expect(cfg.Registry.Git).To(BeNil())
+
+		cfg.Registry.Git = &v1alpha1.GitRegistryConfiguration{}
+		v1alpha1.SetDefaults_LandscaperConfiguration(cfg)
+		Expect(cfg.Registry.Git.Interval).To(gstruct.PointTo(gstruct.MatchFields(gstruct.IgnoreExtras, gstruct.Fields{
+			"Duration": Equal(5 * time.Minute),
+		})))
+	})
+
 	Context("BlueprintStore", func() {
 
 		It("should default index method", func() {
@@ -46,6 +46,210 @@ type LandscaperConfiguration struct {
 	UseOCMLib bool `json:"useOCMLib,omitempty"`
 	// SignatureVerificationEnforcementPolicy defines how the landscaper handles signature verification.
 	SignatureVerificationEnforcementPolicy SignatureVerificationEnforcementPolicy `json:"signatureVerificationEnforcementPolicy,omitempty"`
+	// SignatureVerificationRules configure the signature verification of the component descriptors
+	// that are resolved from specific repositories.
+	// +optional
+	SignatureVerificationRules []SignatureVerificationRule `json:"signatureVerificationRules,omitempty"`
+	// FeatureGates enables optional features of the landscaper controllers.
+	// +optional
+	FeatureGates FeatureGates `json:"featureGates,omitempty"`
+	// ApprovalHooks configures external approval systems, e.g. change management systems,
+	// that have to approve phase transitions of root installations.
+	// +optional
+	ApprovalHooks []ApprovalHookConfiguration `json:"approvalHooks,omitempty"`
+	// WriteAudit configures an audit trail of all write operations of the landscaper controllers
+	// on landscaper resources.
+	// +optional
+	WriteAudit *WriteAuditConfiguration `json:"writeAudit,omitempty"`
+	// Clusters configures the clusters the landscaper controllers work with, if the cluster that contains
+	// the landscaper resources differs from the cluster in which the landscaper runs.
+	// +optional
+	Clusters *ClustersConfiguration `json:"clusters,omitempty"`
+	// SchemaStore configures the resolution and the caching of the json schemas that are referenced by blueprints.
+	// +optional
+	SchemaStore *SchemaStoreConfiguration `json:"schemaStore,omitempty"`
+	// ComponentOverwrites configures component overwrites that are applied to the component references of all installations.
+	// +optional
+	ComponentOverwrites *ComponentOverwritesConfiguration `json:"componentOverwrites,omitempty"`
+	// Encryption configures the envelope encryption of sensitive data at rest,
+	// e.g. the configuration of deploy items.
+	// +optional
+	Encryption *EncryptionConfiguration `json:"encryption,omitempty"`
+	// HTTPImports configures the imports of installations whose values are fetched from http(s) endpoints.
+	// +optional
+	HTTPImports *HTTPImportsConfiguration `json:"httpImports,omitempty"`
+	// RejectLocalKubeconfigCredentials rejects the kubeconfigs of targets that reference local files, like a tokenFile
+	// or client certificate files, or that use a credential plugin (exec), when the landscaper accesses target clusters,
+	// e.g. to write export sinks. Such kubeconfigs are accepted if not set.
+	// +optional
+	RejectLocalKubeconfigCredentials bool `json:"rejectLocalKubeconfigCredentials,omitempty"`
+}
+
+// HTTPImportsConfiguration configures the imports of installations whose values are fetched from http(s) endpoints.
+type HTTPImportsConfiguration struct {
+	// AllowedHosts is a list of hosts from which http imports are fetched.
+	// An entry of the form *.example.com allows all subdomains of example.com.
+	// If the list is empty, http imports are not resolved by the landscaper.
+	// +optional
+	AllowedHosts []string `json:"allowedHosts,omitempty"`
+}
+
+// EncryptionConfiguration configures the envelope encryption of sensitive data at rest.
+// Every value is encrypted with a new data key, which is in turn encrypted with a key encryption key.
+// Exactly one key provider has to be configured.
+type EncryptionConfiguration struct {
+	// Local encrypts the data keys with local AES keys.
+	// +optional
+	Local *LocalEncryptionConfiguration `json:"local,omitempty"`
+	// KMS encrypts the data keys with an external key management service.
+	// +optional
+	KMS *KMSEncryptionConfiguration `json:"kms,omitempty"`
+}
+
+// LocalEncryptionConfiguration configures local key encryption keys.
+type LocalEncryptionConfiguration struct {
+	// Keys are the key encryption keys. The first key is used to encrypt new data keys,
+	// all keys are used to decrypt existing data keys, so that keys can be rotated.
+	Keys []LocalEncryptionKey `json:"keys"`
+}
+
+// LocalEncryptionKey is an AES key encryption key.
+type LocalEncryptionKey struct {
+	// Name identifies the key in the encrypted data and must not be changed as long as data is encrypted with the key.
+	Name string `json:"name"`
+	// KeyFile is the path to a file that contains the base64 encoded key with a length of 16, 24 or 32 bytes.
+	KeyFile string `json:"keyFile"`
+}
+
+// KMSEncryptionConfiguration configures the encryption of the data keys with the transit secrets engine
+// of a HashiCorp Vault or a compatible key management service.
+type KMSEncryptionConfiguration struct {
+	// Address is the url of the key management service.
+	Address string `json:"address"`
+	// MountPath is the mount path of the transit secrets engine.
+	// Defaults to "transit".
+	// +optional
+	MountPath string `json:"mountPath,omitempty"`
+	// KeyName is the name of the transit key that encrypts the data keys.
+	KeyName string `json:"keyName"`
+	// TokenFile is the path to a file that contains the token to authenticate at the key management service.
+	// The file is read again for every request, so that the token can be renewed.
+	TokenFile string `json:"tokenFile"`
+	// CAFile is the path to a file that contains the ca certificates to verify the key management service.
+	// +optional
+	CAFile string `json:"caFile,omitempty"`
+}
+
+// ComponentOverwritesConfiguration configures the landscaper wide component overwrites.
+type ComponentOverwritesConfiguration struct {
+	// References is a list of ComponentVersionOverwrites resources whose overwrites are applied to the component
+	// references of all installations, e.g. to replace the repository contexts of public registries by mirrored registries.
+	// The overwrites of the context of an installation take precedence over these overwrites,
+	// and the overwrites of the referenced resources are applied in the given order.
+	// +optional
+	References []lsv1alpha1.ObjectReference `json:"references,omitempty"`
+}
+
+// SchemaStoreConfiguration configures the resolution and the caching of referenced json schemas.
+type SchemaStoreConfiguration struct {
+	// AllowedRemoteURLs is a list of url prefixes. Json schemas that are referenced by http(s) urls are only fetched
+	// by the landscaper if their url starts with one of the prefixes.
+	// If the list is empty, references to remote schemas are not resolved by the landscaper.
+	// +optional
+	AllowedRemoteURLs []string `json:"allowedRemoteURLs,omitempty"`
+	// RemoteCacheDuration is the duration for which fetched remote schemas are cached.
+	// Defaults to 1 hour.
+	// +optional
+	RemoteCacheDuration *metav1.Duration `json:"remoteCacheDuration,omitempty"`
+}
+
+// ClustersConfiguration configures the resource cluster and the host cluster of the landscaper controllers.
+type ClustersConfiguration struct {
+	// ResourceCluster is the cluster that contains the landscaper resources like installations,
+	// executions and deploy items.
+	// Defaults to the host cluster. The flag --landscaper-kubeconfig takes precedence over this setting.
+	// +optional
+	ResourceCluster *ClusterConfiguration `json:"resourceCluster,omitempty"`
+	// HostCluster is the cluster in which the landscaper and the deployers run.
+	// Defaults to the in-cluster configuration or the kubeconfig of the environment.
+	// +optional
+	HostCluster *ClusterConfiguration `json:"hostCluster,omitempty"`
+}
+
+// ClusterConfiguration describes how to access a cluster.
+type ClusterConfiguration struct {
+	// Kubeconfig is the path to a kubeconfig file of the cluster.
+	Kubeconfig string `json:"kubeconfig"`
+	// Context is the kubeconfig context that is used to access the cluster.
+	// Defaults to the current context of the kubeconfig.
+	// +optional
+	Context string `json:"context,omitempty"`
+}
+
+// WriteAuditConfiguration configures the audit trail of the write operations of the landscaper controllers.
+type WriteAuditConfiguration struct {
+	// Events enables the recording of every write operation as event of the written resource.
+	// +optional
+	Events bool `json:"events,omitempty"`
+	// History enables the recording of the write operations into a history configmap per written resource.
+	// +optional
+	History *WriteAuditHistoryConfiguration `json:"history,omitempty"`
+}
+
+// WriteAuditHistoryConfiguration configures the history configmaps of the write audit trail.
+type WriteAuditHistoryConfiguration struct {
+	// MaxEntries is the maximal number of write operations that are kept per resource.
+	// Defaults to 50.
+	// +optional
+	MaxEntries int `json:"maxEntries,omitempty"`
+}
+
+// ApprovalHookConfiguration configures an external approval system.
+type ApprovalHookConfiguration struct {
+	// Name is the unique name of the approval hook. It is used in the audit trail of the installations.
+	Name string `json:"name"`
+	// Phases are the installation phases that can only be entered after an approval.
+	// Supported phases are "Progressing" and "Deleting".
+	Phases []lsv1alpha1.InstallationPhase `json:"phases"`
+	// ContextSelector selects the installations by the labels of their context.
+	// All root installations are selected if not set.
+	// +optional
+	ContextSelector *metav1.LabelSelector `json:"contextSelector,omitempty"`
+	// Timeout is the time after which a pending approval is treated as rejected.
+	// Defaults to 24 hours.
+	// +optional
+	Timeout *lsv1alpha1.Duration `json:"timeout,omitempty"`
+	// Webhook configures the http endpoint of the external approval system.
+	Webhook ApprovalWebhookConfiguration `json:"webhook"`
+}
+
+// ApprovalWebhookConfiguration configures the http endpoint of an external approval system.
+type ApprovalWebhookConfiguration struct {
+	// URL is the url to which the approval requests are posted.
+	URL string `json:"url"`
+	// AuthSecretRef references the key of a secret that contains the value of the Authorization header.
+	// +optional
+	AuthSecretRef *lsv1alpha1.SecretReference `json:"authSecretRef,omitempty"`
+}
+
+// FeatureGates enables optional features of the landscaper controllers.
+type FeatureGates struct {
+	// ReconcileOnReferencedDataChange enables the automatic reconciliation of root installations
+	// whenever a Secret or ConfigMap changes from which they import data.
+	// Enabling the feature makes the landscaper watch the metadata of all Secrets and ConfigMaps.
+	// +optional
+	ReconcileOnReferencedDataChange bool `json:"reconcileOnReferencedDataChange,omitempty"`
+	// OwnerReferenceGarbageCollection makes the deploy items and exported data objects of an execution
+	// carry an owner reference to the installation of the execution. Executions and subinstallations that are
+	// deleted by the Kubernetes garbage collector process their deletion on their own, and installations
+	// with the delete-without-uninstall annotation leave the deletion of their subobjects to the garbage collector.
+	// +optional
+	OwnerReferenceGarbageCollection bool `json:"ownerReferenceGarbageCollection,omitempty"`
+	// DisablePartialRedeployment makes every job of an execution redeploy all its deploy items.
+	// By default, only the deploy items are redeployed whose template has changed, whose dependencies have been
+	// redeployed or whose last deployment has not succeeded.
+	// +optional
+	DisablePartialRedeployment bool `json:"disablePartialRedeployment,omitempty"`
 }
 
 // LsDeployments contains the names of the landscaper deployments.
@@ -84,6 +288,78 @@ type CommonControllerConfig struct {
 	CacheSyncTimeout *metav1.Duration `json:"cacheSyncTimeout"`
 }
 
+// TargetClientConfig describes the configuration of the clients a deployer uses to access target clusters.
+// It can be included in the specific deployer configurations.
+type TargetClientConfig struct {
+	// QPS is the maximum number of queries per second a deployer sends to a single target cluster.
+	// The defaults of the kubernetes client are used if not set.
+	// +optional
+	QPS int `json:"qps,omitempty"`
+
+	// Burst is the maximum burst of queries a deployer sends to a single target cluster.
+	// The defaults of the kubernetes client are used if not set.
+	// +optional
+	Burst int `json:"burst,omitempty"`
+
+	// ApplyBatchSize is the maximum number of manifests of a deploy item that are applied concurrently.
+	// The progress is written to the provider status of the deploy item after each batch.
+	// All manifests are applied concurrently if not set.
+	// +optional
+	ApplyBatchSize int `json:"applyBatchSize,omitempty"`
+
+	// CheckResourceQuotas enables a pre-flight check of the resource quotas in the target namespaces.
+	// Deploy items fail with the error code ERR_QUOTA_EXCEEDED if the quotas do not admit the pods
+	// of their manifests, instead of being applied.
+	// +optional
+	CheckResourceQuotas bool `json:"checkResourceQuotas,omitempty"`
+
+	// LandscapeInstanceID identifies the landscaper instance whose deploy items are processed by the deployer.
+	// If set, it is added as label to all resources that the deployer applies to target clusters.
+	// +optional
+	LandscapeInstanceID string `json:"landscapeInstanceId,omitempty"`
+
+	// Inventory enables a periodic inventory of the resources in the target clusters that are managed by the deployer.
+	// The inventory requires a LandscapeInstanceID, so that resources of other landscaper instances are not considered.
+	// +optional
+	Inventory *InventoryConfig `json:"inventory,omitempty"`
+
+	// RejectLocalCredentials rejects the kubeconfigs of targets that reference local files, like a tokenFile or
+	// client certificate files, or that use a credential plugin (exec), as they would give the target access to the
+	// files and executables of the deployer. Such kubeconfigs are accepted if not set.
+	// +optional
+	RejectLocalCredentials bool `json:"rejectLocalCredentials,omitempty"`
+}
+
+// InventoryConfig configures the periodic inventory of the resources that a deployer has applied to target clusters.
+// The inventory detects resources that carry the management labels of the landscaper instance,
+// but whose deploy item does not exist anymore, e.g. because the deployer crashed between applying a resource
+// and persisting it in the status of the deploy item.
+type InventoryConfig struct {
+	// Interval is the time between two inventory runs. Defaults to 1 hour.
+	// +optional
+	Interval *metav1.Duration `json:"interval,omitempty"`
+
+	// MinAge is the minimal age of a resource to be considered as orphaned.
+	// Younger resources might still be in the process of being deployed. Defaults to 1 hour.
+	// +optional
+	MinAge *metav1.Duration `json:"minAge,omitempty"`
+
+	// ResourceTypes are the types of the resources that are checked in the target clusters.
+	ResourceTypes []InventoryResourceType `json:"resourceTypes"`
+
+	// Cleanup enables the deletion of orphaned resources. Orphaned resources are only reported if not set.
+	// +optional
+	Cleanup bool `json:"cleanup,omitempty"`
+}
+
+// InventoryResourceType describes a type of resources that is checked by the inventory.
+type InventoryResourceType struct {
+	// APIVersion is the api version of the resources, e.g. apps/v1.
+	APIVersion string `json:"apiVersion"`
+	// Kind is the kind of the resources, e.g. Deployment.
+	Kind string `json:"kind"`
+}
+
 // Controllers contains all configuration for the specific controllers
 type Controllers struct {
 	// SyncPeriod determines the minimum frequency at which watched resources are
@@ -116,16 +392,53 @@ type Controllers struct {
 // InstallationsController contains the controller config that reconciles installations.
 type InstallationsController struct {
 	CommonControllerConfig
+
+	// MaxWorkersPerNamespace is the maximum number of concurrent Reconciles of installations of the same namespace.
+	// Further installations of the namespace are requeued until one of its Reconciles has finished,
+	// so that a namespace with many installations cannot occupy all workers.
+	// The number is not limited if not set.
+	// +optional
+	MaxWorkersPerNamespace int `json:"maxWorkersPerNamespace,omitempty"`
+
+	// StartupPriority configures that the installations with the label landscaper.gardener.cloud/startup-priority,
+	// or in namespaces with this label, are reconciled before all other installations after a restart of the controller.
+	// Installations are not prioritized if not set.
+	// +optional
+	StartupPriority *StartupPriorityConfiguration `json:"startupPriority,omitempty"`
+}
+
+// StartupPriorityConfiguration contains the configuration for the prioritized reconciliation of installations
+// after a restart of the controller.
+type StartupPriorityConfiguration struct {
+	// Timeout is the maximal duration after the start of the controller during which the reconciliation
+	// of installations without startup priority is postponed.
+	// Defaults to 10m.
+	// +optional
+	Timeout *metav1.Duration `json:"timeout,omitempty"`
 }
 
 // ExecutionsController contains the controller config that reconciles executions.
 type ExecutionsController struct {
 	CommonControllerConfig
+
+	// MaxWorkersPerNamespace is the maximum number of concurrent Reconciles of executions of the same namespace.
+	// Further executions of the namespace are requeued until one of its Reconciles has finished,
+	// so that a namespace with many executions cannot occupy all workers.
+	// The number is not limited if not set.
+	// +optional
+	MaxWorkersPerNamespace int `json:"maxWorkersPerNamespace,omitempty"`
 }
 
 // DeployItemsController contains the controller config that reconciles deploy items.
 type DeployItemsController struct {
 	CommonControllerConfig
+
+	// MaxWorkersPerNamespace is the maximum number of concurrent Reconciles of deploy items of the same namespace.
+	// Further deploy items of the namespace are requeued until one of its Reconciles has finished,
+	// so that a namespace with many deploy items cannot occupy all workers.
+	// The number is not limited if not set.
+	// +optional
+	MaxWorkersPerNamespace int `json:"maxWorkersPerNamespace,omitempty"`
 }
 
 // ContextsController contains all configuration for the context controller.
@@ -159,7 +472,13 @@ type DeployItemTimeouts struct {
 	// Defaults to five minutes if not specified.
 	// +optional
 	Pickup *lsv1alpha1.Duration `json:"pickup,omitempty"`
-	// Abort specifies how long the deployer may take to abort handling a deploy item after getting the abort annotation.
+	// ProgressingDefault is the progressing timeout that is used for deploy items which do not specify a timeout.
+	// Allowed values are 'none' (to disable progressing timeout detection) and anything that is understood by golang's time.ParseDuration method.
+	// Defaults to ten minutes if not specified.
+	// +optional
+	ProgressingDefault *lsv1alpha1.Duration `json:"progressingDefault,omitempty"`
+	// Abort specifies how long the deployer may take to finish a deploy item after its progressing timeout has been exceeded,
+	// before the landscaper will mark it as failed.
 	// Allowed values are 'none' (to disable abort timeout detection) and anything that is understood by golang's time.ParseDuration method.
 	// Defaults to five minutes if not specified.
 	// +optional
@@ -175,6 +494,29 @@ type RegistryConfiguration struct {
 	// OCI defines a oci registry to use for definitions
 	// +optional
 	OCI *OCIConfiguration `json:"oci,omitempty"`
+
+	// Git defines git repositories from which blueprints are read.
+	// +optional
+	Git *GitRegistryConfiguration `json:"git,omitempty"`
+
+	// Archives defines common transport format archives and component archives from which component versions are read.
+	// +optional
+	Archives *ArchiveRegistryConfiguration `json:"archives,omitempty"`
+
+	// ComponentVersionCache configures the cache of resolved component versions that is shared by all controllers.
+	// Component versions are not cached if not set.
+	// +optional
+	ComponentVersionCache *ComponentVersionCacheConfiguration `json:"componentVersionCache,omitempty"`
+}
+
+// ComponentVersionCacheConfiguration contains the configuration for the cache of resolved component versions.
+type ComponentVersionCacheConfiguration struct {
+	// Size is the maximal number of cached component versions.
+	Size int `json:"size"`
+	// TTL is the time after which a cached component version expires.
+	// Defaults to 10 minutes.
+	// +optional
+	TTL *metav1.Duration `json:"ttl,omitempty"`
 }
 
 // LocalRegistryConfiguration contains the configuration for a local registry
@@ -182,6 +524,100 @@ type LocalRegistryConfiguration struct {
 	// RootPath configures the root path of a local registry.
 	// This path is used to search for components locally.
 	RootPath string `json:"rootPath"`
+
+	// Watch enables the development mode of the local registry.
+	// Changes of the files below the root path invalidate the cached blueprints and component versions,
+	// so that they are read again on the next reconcile of an installation.
+	// +optional
+	Watch bool `json:"watch,omitempty"`
+}
+
+// ArchiveRegistryConfiguration contains the configuration of the archives from which component versions are read.
+// Archives are either common transport format archives or component archives. They allow to supply component
+// descriptors and blueprints in offline environments without running a registry.
+type ArchiveRegistryConfiguration struct {
+	// Archives are the archives that are searched for a component version, in the given order,
+	// before the repository of the component reference is accessed.
+	Archives []ArchiveConfiguration `json:"archives"`
+
+	// CacheDir is the directory to which archives of oci artifacts are downloaded.
+	// Defaults to a temporary directory.
+	// +optional
+	CacheDir string `json:"cacheDir,omitempty"`
+}
+
+// ArchiveConfiguration configures an archive of the archive registry.
+// Exactly one of path and ociArtifact has to be set.
+type ArchiveConfiguration struct {
+	// Name identifies the archive in logs and errors.
+	Name string `json:"name"`
+
+	// Path is the path to a mounted archive.
+	// The archive can be a directory, a tar or a tar.gz file.
+	// +optional
+	Path string `json:"path,omitempty"`
+
+	// OCIArtifact is the reference of an oci artifact with a single layer that contains the archive as tar or tar.gz file,
+	// e.g. "registry.example.com/archives/landscape:v1.0.0".
+	// The artifact is downloaded once at the start of the landscaper.
+	// +optional
+	OCIArtifact string `json:"ociArtifact,omitempty"`
+}
+
+// GitRegistryConfiguration contains the configuration of the git repositories from which blueprints are read.
+type GitRegistryConfiguration struct {
+	// Repositories are the git repositories that can be referenced by installations.
+	Repositories []GitRepositoryConfiguration `json:"repositories"`
+
+	// Interval is the interval in which the repositories are pulled.
+	// Defaults to 5 minutes.
+	// +optional
+	Interval *metav1.Duration `json:"interval,omitempty"`
+
+	// CacheDir is the directory in which the repositories are cloned.
+	// Defaults to a temporary directory.
+	// +optional
+	CacheDir string `json:"cacheDir,omitempty"`
+}
+
+// GitRepositoryConfiguration configures a git repository of the git registry.
+type GitRepositoryConfiguration struct {
+	// Name is the name by which installations reference the repository.
+	Name string `json:"name"`
+
+	// URL is the url of the repository, e.g. "https://github.com/gardener/landscaper.git"
+	// or "ssh://git@github.com/gardener/landscaper.git".
+	URL string `json:"url"`
+
+	// Ref is the branch, tag or commit that is used if an installation does not define a revision.
+	// Defaults to the default branch of the repository.
+	// +optional
+	Ref string `json:"ref,omitempty"`
+
+	// Auth configures the authentication against the repository.
+	// +optional
+	Auth *GitAuthConfiguration `json:"auth,omitempty"`
+}
+
+// GitAuthConfiguration configures the authentication against a git repository.
+// Either ssh or token authentication can be used.
+type GitAuthConfiguration struct {
+	// SSHPrivateKeyFile is the path to the private ssh key that is used for ssh urls.
+	// +optional
+	SSHPrivateKeyFile string `json:"sshPrivateKeyFile,omitempty"`
+
+	// SSHKnownHostsFile is the path to the known hosts file against which the host key of the git server is verified.
+	// +optional
+	SSHKnownHostsFile string `json:"sshKnownHostsFile,omitempty"`
+
+	// Username is the username that is used for token authentication.
+	// Defaults to "git".
+	// +optional
+	Username string `json:"username,omitempty"`
+
+	// TokenFile is the path to a file that contains the access token that is used for https urls.
+	// +optional
+	TokenFile string `json:"tokenFile,omitempty"`
 }
 
 // OCIConfiguration holds configuration for the oci registry
@@ -198,6 +634,16 @@ type OCIConfiguration struct {
 	AllowPlainHttp bool `json:"allowPlainHttp"`
 	// InsecureSkipVerify skips the certificate validation of the oci registry
 	InsecureSkipVerify bool `json:"insecureSkipVerify"`
+
+	// CredentialHelpers configures helpers that obtain short-lived credentials for oci registry hosts,
+	// e.g. by exchanging the workload identity of the cloud provider for a registry token.
+	// +optional
+	CredentialHelpers []OCICredentialHelper `json:"credentialHelpers,omitempty"`
+
+	// Registries configures the connections to oci registry hosts,
+	// e.g. a proxy or the certificate authorities of a private PKI.
+	// +optional
+	Registries []OCIRegistryConfiguration `json:"registries,omitempty"`
 }
 
 // OCICacheConfiguration contains the configuration for the oci cache
@@ -212,6 +658,59 @@ type OCICacheConfiguration struct {
 	Path string `json:"path"`
 }
 
+// OCICredentialHelperType describes how a credential helper obtains the credentials of a registry.
+type OCICredentialHelperType string
+
+const (
+	// GCPCredentialHelper exchanges the gcp workload identity for an access token of the
+	// google artifact or container registry.
+	GCPCredentialHelper OCICredentialHelperType = "gcp"
+	// AWSCredentialHelper exchanges the aws workload identity for an authorization token of the elastic container registry.
+	AWSCredentialHelper OCICredentialHelperType = "aws"
+	// AzureCredentialHelper exchanges the azure workload identity for a refresh token of the azure container registry.
+	AzureCredentialHelper OCICredentialHelperType = "azure"
+	// ExecCredentialHelper executes a binary that implements the docker credential helper protocol.
+	ExecCredentialHelper OCICredentialHelperType = "exec"
+)
+
+// OCICredentialHelper configures the credential helper that is used for a oci registry host.
+type OCICredentialHelper struct {
+	// Host is the registry host the credentials are obtained for, e.g. "europe-docker.pkg.dev".
+	Host string `json:"host"`
+	// Type is the type of the credential helper.
+	// Supported types are "gcp", "aws", "azure" and "exec".
+	Type OCICredentialHelperType `json:"type"`
+	// Command is the docker credential helper binary that is executed by a helper of type "exec",
+	// e.g. "docker-credential-ecr-login".
+	// +optional
+	Command string `json:"command,omitempty"`
+}
+
+// OCIRegistryConfiguration configures the connection to an oci registry host.
+type OCIRegistryConfiguration struct {
+	// Host is the registry host, optionally with a port, e.g. "registry.example.com:5000".
+	Host string `json:"host"`
+	// Proxy is the url of the http proxy that is used to connect to the registry, e.g. "http://proxy.example.com:3128".
+	// +optional
+	Proxy string `json:"proxy,omitempty"`
+	// CABundle references PEM encoded certificates of certificate authorities,
+	// which are trusted in addition to the system certificate authorities to verify the certificate of the registry.
+	// +optional
+	CABundle *OCICABundleReference `json:"caBundle,omitempty"`
+}
+
+// OCICABundleReference references a PEM encoded certificate bundle.
+// Exactly one of File and SecretRef has to be set.
+type OCICABundleReference struct {
+	// File is the path to a file that contains the certificate bundle.
+	// +optional
+	File string `json:"file,omitempty"`
+	// SecretRef references the key of a secret in the host cluster that contains the certificate bundle.
+	// The secret is read when the controller starts.
+	// +optional
+	SecretRef *lsv1alpha1.SecretReference `json:"secretRef,omitempty"`
+}
+
 // MetricsConfiguration allows to configure how metrics are exposed
 type MetricsConfiguration struct {
 	// Port specifies the port on which metrics are published
@@ -254,9 +753,28 @@ type BlueprintStore struct {
 	// Defaults to ComponentDescriptorIdentityMethod
 	// +optional
 	IndexMethod IndexMethod `json:"indexMethod"`
+	// BlobCache configures the cache of the blobs of blueprints that are referenced in component versions,
+	// so that blueprints are not downloaded again when they are removed from the blueprint store.
+	// +optional
+	BlobCache *BlueprintBlobCacheConfiguration `json:"blobCache,omitempty"`
 	GarbageCollectionConfiguration
 }
 
+// BlueprintBlobCacheConfiguration contains the configuration of the cache of the blueprint blobs.
+type BlueprintBlobCacheConfiguration struct {
+	// MemorySize is the maximal size of the blueprint blobs that are kept in memory, e.g. "100Mi".
+	// Least recently used blobs are evicted if the size is exceeded.
+	// If the value is 0, no blobs are kept in memory.
+	// Defaults to 64Mi.
+	// +optional
+	MemorySize string `json:"memorySize,omitempty"`
+	// DiskSize is the maximal size of the blueprint blobs that are written to disk when they are evicted from memory.
+	// The blobs are written to the directory "blueprint-blobs" of the path of the oci cache.
+	// If the value is 0 or no oci cache path is configured, evicted blobs are discarded.
+	// +optional
+	DiskSize string `json:"diskSize,omitempty"`
+}
+
 // GarbageCollectionConfiguration contains all options for the cache garbage collection.
 type GarbageCollectionConfiguration struct {
 	// Size is the size of the filesystem.
@@ -292,3 +810,27 @@ const (
 	// Disabled explcitly disables signature verification. Enabling the verification on installation level will not have an effect and the verification will still be disabled.
 	Disabled SignatureVerificationEnforcementPolicy = "Disabled"
 )
+
+// SignatureVerificationRule configures the signature verification of the component descriptors
+// that are resolved from a repository.
+type SignatureVerificationRule struct {
+	// RepositoryBaseURL selects the component descriptors of repository contexts of type "ociRegistry"
+	// whose base url starts with the given value.
+	RepositoryBaseURL string `json:"repositoryBaseUrl"`
+	// SignatureName is the name of the signature that is verified, if the installation does not define a signature name.
+	// The trusted public keys and certificates are read from the verification signatures of the installation context.
+	SignatureName string `json:"signatureName"`
+	// Mode defines how a failed verification is handled. Defaults to "Fail".
+	// +optional
+	Mode SignatureVerificationMode `json:"mode,omitempty"`
+}
+
+// SignatureVerificationMode describes how a failed signature verification is handled.
+type SignatureVerificationMode string
+
+const (
+	// SignatureVerificationModeFail lets an installation fail if the signature of its component descriptor is invalid.
+	SignatureVerificationModeFail SignatureVerificationMode = "Fail"
+	// SignatureVerificationModeWarn only reports an invalid signature in the conditions of the installation.
+	SignatureVerificationModeWarn SignatureVerificationMode = "Warn"
+)
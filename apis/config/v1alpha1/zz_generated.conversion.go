@@ -38,6 +38,56 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*ApprovalHookConfiguration)(nil), (*config.ApprovalHookConfiguration)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_ApprovalHookConfiguration_To_config_ApprovalHookConfiguration(a.(*ApprovalHookConfiguration), b.(*config.ApprovalHookConfiguration), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*config.ApprovalHookConfiguration)(nil), (*ApprovalHookConfiguration)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_config_ApprovalHookConfiguration_To_v1alpha1_ApprovalHookConfiguration(a.(*config.ApprovalHookConfiguration), b.(*ApprovalHookConfiguration), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*ApprovalWebhookConfiguration)(nil), (*config.ApprovalWebhookConfiguration)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_ApprovalWebhookConfiguration_To_config_ApprovalWebhookConfiguration(a.(*ApprovalWebhookConfiguration), b.(*config.ApprovalWebhookConfiguration), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*config.ApprovalWebhookConfiguration)(nil), (*ApprovalWebhookConfiguration)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_config_ApprovalWebhookConfiguration_To_v1alpha1_ApprovalWebhookConfiguration(a.(*config.ApprovalWebhookConfiguration), b.(*ApprovalWebhookConfiguration), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*ArchiveConfiguration)(nil), (*config.ArchiveConfiguration)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_ArchiveConfiguration_To_config_ArchiveConfiguration(a.(*ArchiveConfiguration), b.(*config.ArchiveConfiguration), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*config.ArchiveConfiguration)(nil), (*ArchiveConfiguration)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_config_ArchiveConfiguration_To_v1alpha1_ArchiveConfiguration(a.(*config.ArchiveConfiguration), b.(*ArchiveConfiguration), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*ArchiveRegistryConfiguration)(nil), (*config.ArchiveRegistryConfiguration)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_ArchiveRegistryConfiguration_To_config_ArchiveRegistryConfiguration(a.(*ArchiveRegistryConfiguration), b.(*config.ArchiveRegistryConfiguration), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*config.ArchiveRegistryConfiguration)(nil), (*ArchiveRegistryConfiguration)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_config_ArchiveRegistryConfiguration_To_v1alpha1_ArchiveRegistryConfiguration(a.(*config.ArchiveRegistryConfiguration), b.(*ArchiveRegistryConfiguration), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*BlueprintBlobCacheConfiguration)(nil), (*config.BlueprintBlobCacheConfiguration)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_BlueprintBlobCacheConfiguration_To_config_BlueprintBlobCacheConfiguration(a.(*BlueprintBlobCacheConfiguration), b.(*config.BlueprintBlobCacheConfiguration), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*config.BlueprintBlobCacheConfiguration)(nil), (*BlueprintBlobCacheConfiguration)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_config_BlueprintBlobCacheConfiguration_To_v1alpha1_BlueprintBlobCacheConfiguration(a.(*config.BlueprintBlobCacheConfiguration), b.(*BlueprintBlobCacheConfiguration), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*BlueprintStore)(nil), (*config.BlueprintStore)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha1_BlueprintStore_To_config_BlueprintStore(a.(*BlueprintStore), b.(*config.BlueprintStore), scope)
 	}); err != nil {
@@ -48,6 +98,26 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*ClusterConfiguration)(nil), (*config.ClusterConfiguration)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_ClusterConfiguration_To_config_ClusterConfiguration(a.(*ClusterConfiguration), b.(*config.ClusterConfiguration), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*config.ClusterConfiguration)(nil), (*ClusterConfiguration)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_config_ClusterConfiguration_To_v1alpha1_ClusterConfiguration(a.(*config.ClusterConfiguration), b.(*ClusterConfiguration), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*ClustersConfiguration)(nil), (*config.ClustersConfiguration)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_ClustersConfiguration_To_config_ClustersConfiguration(a.(*ClustersConfiguration), b.(*config.ClustersConfiguration), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*config.ClustersConfiguration)(nil), (*ClustersConfiguration)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_config_ClustersConfiguration_To_v1alpha1_ClustersConfiguration(a.(*config.ClustersConfiguration), b.(*ClustersConfiguration), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*CommonControllerConfig)(nil), (*config.CommonControllerConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha1_CommonControllerConfig_To_config_CommonControllerConfig(a.(*CommonControllerConfig), b.(*config.CommonControllerConfig), scope)
 	}); err != nil {
@@ -58,6 +128,26 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*ComponentOverwritesConfiguration)(nil), (*config.ComponentOverwritesConfiguration)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_ComponentOverwritesConfiguration_To_config_ComponentOverwritesConfiguration(a.(*ComponentOverwritesConfiguration), b.(*config.ComponentOverwritesConfiguration), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*config.ComponentOverwritesConfiguration)(nil), (*ComponentOverwritesConfiguration)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_config_ComponentOverwritesConfiguration_To_v1alpha1_ComponentOverwritesConfiguration(a.(*config.ComponentOverwritesConfiguration), b.(*ComponentOverwritesConfiguration), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*ComponentVersionCacheConfiguration)(nil), (*config.ComponentVersionCacheConfiguration)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_ComponentVersionCacheConfiguration_To_config_ComponentVersionCacheConfiguration(a.(*ComponentVersionCacheConfiguration), b.(*config.ComponentVersionCacheConfiguration), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*config.ComponentVersionCacheConfiguration)(nil), (*ComponentVersionCacheConfiguration)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_config_ComponentVersionCacheConfiguration_To_v1alpha1_ComponentVersionCacheConfiguration(a.(*config.ComponentVersionCacheConfiguration), b.(*ComponentVersionCacheConfiguration), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*ContextControllerConfig)(nil), (*config.ContextControllerConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha1_ContextControllerConfig_To_config_ContextControllerConfig(a.(*ContextControllerConfig), b.(*config.ContextControllerConfig), scope)
 	}); err != nil {
@@ -128,6 +218,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*EncryptionConfiguration)(nil), (*config.EncryptionConfiguration)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_EncryptionConfiguration_To_config_EncryptionConfiguration(a.(*EncryptionConfiguration), b.(*config.EncryptionConfiguration), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*config.EncryptionConfiguration)(nil), (*EncryptionConfiguration)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_config_EncryptionConfiguration_To_v1alpha1_EncryptionConfiguration(a.(*config.EncryptionConfiguration), b.(*EncryptionConfiguration), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*ExecutionsController)(nil), (*config.ExecutionsController)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha1_ExecutionsController_To_config_ExecutionsController(a.(*ExecutionsController), b.(*config.ExecutionsController), scope)
 	}); err != nil {
@@ -138,6 +238,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*FeatureGates)(nil), (*config.FeatureGates)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_FeatureGates_To_config_FeatureGates(a.(*FeatureGates), b.(*config.FeatureGates), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*config.FeatureGates)(nil), (*FeatureGates)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_config_FeatureGates_To_v1alpha1_FeatureGates(a.(*config.FeatureGates), b.(*FeatureGates), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*GarbageCollectionConfiguration)(nil), (*config.GarbageCollectionConfiguration)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha1_GarbageCollectionConfiguration_To_config_GarbageCollectionConfiguration(a.(*GarbageCollectionConfiguration), b.(*config.GarbageCollectionConfiguration), scope)
 	}); err != nil {
@@ -148,6 +258,36 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*GitAuthConfiguration)(nil), (*config.GitAuthConfiguration)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_GitAuthConfiguration_To_config_GitAuthConfiguration(a.(*GitAuthConfiguration), b.(*config.GitAuthConfiguration), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*config.GitAuthConfiguration)(nil), (*GitAuthConfiguration)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_config_GitAuthConfiguration_To_v1alpha1_GitAuthConfiguration(a.(*config.GitAuthConfiguration), b.(*GitAuthConfiguration), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*GitRegistryConfiguration)(nil), (*config.GitRegistryConfiguration)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_GitRegistryConfiguration_To_config_GitRegistryConfiguration(a.(*GitRegistryConfiguration), b.(*config.GitRegistryConfiguration), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*config.GitRegistryConfiguration)(nil), (*GitRegistryConfiguration)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_config_GitRegistryConfiguration_To_v1alpha1_GitRegistryConfiguration(a.(*config.GitRegistryConfiguration), b.(*GitRegistryConfiguration), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*GitRepositoryConfiguration)(nil), (*config.GitRepositoryConfiguration)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_GitRepositoryConfiguration_To_config_GitRepositoryConfiguration(a.(*GitRepositoryConfiguration), b.(*config.GitRepositoryConfiguration), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*config.GitRepositoryConfiguration)(nil), (*GitRepositoryConfiguration)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_config_GitRepositoryConfiguration_To_v1alpha1_GitRepositoryConfiguration(a.(*config.GitRepositoryConfiguration), b.(*GitRepositoryConfiguration), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*HPAMainConfiguration)(nil), (*config.HPAMainConfiguration)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha1_HPAMainConfiguration_To_config_HPAMainConfiguration(a.(*HPAMainConfiguration), b.(*config.HPAMainConfiguration), scope)
 	}); err != nil {
@@ -158,6 +298,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*HTTPImportsConfiguration)(nil), (*config.HTTPImportsConfiguration)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_HTTPImportsConfiguration_To_config_HTTPImportsConfiguration(a.(*HTTPImportsConfiguration), b.(*config.HTTPImportsConfiguration), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*config.HTTPImportsConfiguration)(nil), (*HTTPImportsConfiguration)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_config_HTTPImportsConfiguration_To_v1alpha1_HTTPImportsConfiguration(a.(*config.HTTPImportsConfiguration), b.(*HTTPImportsConfiguration), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*InstallationsController)(nil), (*config.InstallationsController)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha1_InstallationsController_To_config_InstallationsController(a.(*InstallationsController), b.(*config.InstallationsController), scope)
 	}); err != nil {
@@ -168,6 +318,36 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*InventoryConfig)(nil), (*config.InventoryConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_InventoryConfig_To_config_InventoryConfig(a.(*InventoryConfig), b.(*config.InventoryConfig), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*config.InventoryConfig)(nil), (*InventoryConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_config_InventoryConfig_To_v1alpha1_InventoryConfig(a.(*config.InventoryConfig), b.(*InventoryConfig), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*InventoryResourceType)(nil), (*config.InventoryResourceType)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_InventoryResourceType_To_config_InventoryResourceType(a.(*InventoryResourceType), b.(*config.InventoryResourceType), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*config.InventoryResourceType)(nil), (*InventoryResourceType)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_config_InventoryResourceType_To_v1alpha1_InventoryResourceType(a.(*config.InventoryResourceType), b.(*InventoryResourceType), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*KMSEncryptionConfiguration)(nil), (*config.KMSEncryptionConfiguration)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_KMSEncryptionConfiguration_To_config_KMSEncryptionConfiguration(a.(*KMSEncryptionConfiguration), b.(*config.KMSEncryptionConfiguration), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*config.KMSEncryptionConfiguration)(nil), (*KMSEncryptionConfiguration)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_config_KMSEncryptionConfiguration_To_v1alpha1_KMSEncryptionConfiguration(a.(*config.KMSEncryptionConfiguration), b.(*KMSEncryptionConfiguration), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*LandscaperConfiguration)(nil), (*config.LandscaperConfiguration)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha1_LandscaperConfiguration_To_config_LandscaperConfiguration(a.(*LandscaperConfiguration), b.(*config.LandscaperConfiguration), scope)
 	}); err != nil {
@@ -178,6 +358,26 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*LocalEncryptionConfiguration)(nil), (*config.LocalEncryptionConfiguration)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_LocalEncryptionConfiguration_To_config_LocalEncryptionConfiguration(a.(*LocalEncryptionConfiguration), b.(*config.LocalEncryptionConfiguration), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*config.LocalEncryptionConfiguration)(nil), (*LocalEncryptionConfiguration)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_config_LocalEncryptionConfiguration_To_v1alpha1_LocalEncryptionConfiguration(a.(*config.LocalEncryptionConfiguration), b.(*LocalEncryptionConfiguration), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*LocalEncryptionKey)(nil), (*config.LocalEncryptionKey)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_LocalEncryptionKey_To_config_LocalEncryptionKey(a.(*LocalEncryptionKey), b.(*config.LocalEncryptionKey), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*config.LocalEncryptionKey)(nil), (*LocalEncryptionKey)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_config_LocalEncryptionKey_To_v1alpha1_LocalEncryptionKey(a.(*config.LocalEncryptionKey), b.(*LocalEncryptionKey), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*LocalRegistryConfiguration)(nil), (*config.LocalRegistryConfiguration)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha1_LocalRegistryConfiguration_To_config_LocalRegistryConfiguration(a.(*LocalRegistryConfiguration), b.(*config.LocalRegistryConfiguration), scope)
 	}); err != nil {
@@ -208,6 +408,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*OCICABundleReference)(nil), (*config.OCICABundleReference)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_OCICABundleReference_To_config_OCICABundleReference(a.(*OCICABundleReference), b.(*config.OCICABundleReference), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*config.OCICABundleReference)(nil), (*OCICABundleReference)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_config_OCICABundleReference_To_v1alpha1_OCICABundleReference(a.(*config.OCICABundleReference), b.(*OCICABundleReference), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*OCICacheConfiguration)(nil), (*config.OCICacheConfiguration)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha1_OCICacheConfiguration_To_config_OCICacheConfiguration(a.(*OCICacheConfiguration), b.(*config.OCICacheConfiguration), scope)
 	}); err != nil {
@@ -228,6 +438,26 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*OCICredentialHelper)(nil), (*config.OCICredentialHelper)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_OCICredentialHelper_To_config_OCICredentialHelper(a.(*OCICredentialHelper), b.(*config.OCICredentialHelper), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*config.OCICredentialHelper)(nil), (*OCICredentialHelper)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_config_OCICredentialHelper_To_v1alpha1_OCICredentialHelper(a.(*config.OCICredentialHelper), b.(*OCICredentialHelper), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*OCIRegistryConfiguration)(nil), (*config.OCIRegistryConfiguration)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_OCIRegistryConfiguration_To_config_OCIRegistryConfiguration(a.(*OCIRegistryConfiguration), b.(*config.OCIRegistryConfiguration), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*config.OCIRegistryConfiguration)(nil), (*OCIRegistryConfiguration)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_config_OCIRegistryConfiguration_To_v1alpha1_OCIRegistryConfiguration(a.(*config.OCIRegistryConfiguration), b.(*OCIRegistryConfiguration), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*RegistryConfiguration)(nil), (*config.RegistryConfiguration)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha1_RegistryConfiguration_To_config_RegistryConfiguration(a.(*RegistryConfiguration), b.(*config.RegistryConfiguration), scope)
 	}); err != nil {
@@ -238,6 +468,66 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*SchemaStoreConfiguration)(nil), (*config.SchemaStoreConfiguration)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_SchemaStoreConfiguration_To_config_SchemaStoreConfiguration(a.(*SchemaStoreConfiguration), b.(*config.SchemaStoreConfiguration), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*config.SchemaStoreConfiguration)(nil), (*SchemaStoreConfiguration)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_config_SchemaStoreConfiguration_To_v1alpha1_SchemaStoreConfiguration(a.(*config.SchemaStoreConfiguration), b.(*SchemaStoreConfiguration), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*SignatureVerificationRule)(nil), (*config.SignatureVerificationRule)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_SignatureVerificationRule_To_config_SignatureVerificationRule(a.(*SignatureVerificationRule), b.(*config.SignatureVerificationRule), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*config.SignatureVerificationRule)(nil), (*SignatureVerificationRule)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_config_SignatureVerificationRule_To_v1alpha1_SignatureVerificationRule(a.(*config.SignatureVerificationRule), b.(*SignatureVerificationRule), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*StartupPriorityConfiguration)(nil), (*config.StartupPriorityConfiguration)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_StartupPriorityConfiguration_To_config_StartupPriorityConfiguration(a.(*StartupPriorityConfiguration), b.(*config.StartupPriorityConfiguration), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*config.StartupPriorityConfiguration)(nil), (*StartupPriorityConfiguration)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_config_StartupPriorityConfiguration_To_v1alpha1_StartupPriorityConfiguration(a.(*config.StartupPriorityConfiguration), b.(*StartupPriorityConfiguration), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*TargetClientConfig)(nil), (*config.TargetClientConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_TargetClientConfig_To_config_TargetClientConfig(a.(*TargetClientConfig), b.(*config.TargetClientConfig), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*config.TargetClientConfig)(nil), (*TargetClientConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_config_TargetClientConfig_To_v1alpha1_TargetClientConfig(a.(*config.TargetClientConfig), b.(*TargetClientConfig), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*WriteAuditConfiguration)(nil), (*config.WriteAuditConfiguration)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_WriteAuditConfiguration_To_config_WriteAuditConfiguration(a.(*WriteAuditConfiguration), b.(*config.WriteAuditConfiguration), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*config.WriteAuditConfiguration)(nil), (*WriteAuditConfiguration)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_config_WriteAuditConfiguration_To_v1alpha1_WriteAuditConfiguration(a.(*config.WriteAuditConfiguration), b.(*WriteAuditConfiguration), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*WriteAuditHistoryConfiguration)(nil), (*config.WriteAuditHistoryConfiguration)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_WriteAuditHistoryConfiguration_To_config_WriteAuditHistoryConfiguration(a.(*WriteAuditHistoryConfiguration), b.(*config.WriteAuditHistoryConfiguration), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*config.WriteAuditHistoryConfiguration)(nil), (*WriteAuditHistoryConfiguration)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_config_WriteAuditHistoryConfiguration_To_v1alpha1_WriteAuditHistoryConfiguration(a.(*config.WriteAuditHistoryConfiguration), b.(*WriteAuditHistoryConfiguration), scope)
+	}); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -261,10 +551,133 @@ func Convert_config_AdditionalDeployments_To_v1alpha1_AdditionalDeployments(in *
 	return autoConvert_config_AdditionalDeployments_To_v1alpha1_AdditionalDeployments(in, out, s)
 }
 
+func autoConvert_v1alpha1_ApprovalHookConfiguration_To_config_ApprovalHookConfiguration(in *ApprovalHookConfiguration, out *config.ApprovalHookConfiguration, s conversion.Scope) error {
+	out.Name = in.Name
+	out.Phases = *(*[]core.InstallationPhase)(unsafe.Pointer(&in.Phases))
+	out.ContextSelector = (*v1.LabelSelector)(unsafe.Pointer(in.ContextSelector))
+	out.Timeout = (*core.Duration)(unsafe.Pointer(in.Timeout))
+	if err := Convert_v1alpha1_ApprovalWebhookConfiguration_To_config_ApprovalWebhookConfiguration(&in.Webhook, &out.Webhook, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_v1alpha1_ApprovalHookConfiguration_To_config_ApprovalHookConfiguration is an autogenerated conversion function.
+func Convert_v1alpha1_ApprovalHookConfiguration_To_config_ApprovalHookConfiguration(in *ApprovalHookConfiguration, out *config.ApprovalHookConfiguration, s conversion.Scope) error {
+	return autoConvert_v1alpha1_ApprovalHookConfiguration_To_config_ApprovalHookConfiguration(in, out, s)
+}
+
+func autoConvert_config_ApprovalHookConfiguration_To_v1alpha1_ApprovalHookConfiguration(in *config.ApprovalHookConfiguration, out *ApprovalHookConfiguration, s conversion.Scope) error {
+	out.Name = in.Name
+	out.Phases = *(*[]corev1alpha1.InstallationPhase)(unsafe.Pointer(&in.Phases))
+	out.ContextSelector = (*v1.LabelSelector)(unsafe.Pointer(in.ContextSelector))
+	out.Timeout = (*corev1alpha1.Duration)(unsafe.Pointer(in.Timeout))
+	if err := Convert_config_ApprovalWebhookConfiguration_To_v1alpha1_ApprovalWebhookConfiguration(&in.Webhook, &out.Webhook, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_config_ApprovalHookConfiguration_To_v1alpha1_ApprovalHookConfiguration is an autogenerated conversion function.
+func Convert_config_ApprovalHookConfiguration_To_v1alpha1_ApprovalHookConfiguration(in *config.ApprovalHookConfiguration, out *ApprovalHookConfiguration, s conversion.Scope) error {
+	return autoConvert_config_ApprovalHookConfiguration_To_v1alpha1_ApprovalHookConfiguration(in, out, s)
+}
+
+func autoConvert_v1alpha1_ApprovalWebhookConfiguration_To_config_ApprovalWebhookConfiguration(in *ApprovalWebhookConfiguration, out *config.ApprovalWebhookConfiguration, s conversion.Scope) error {
+	out.URL = in.URL
+	out.AuthSecretRef = (*core.SecretReference)(unsafe.Pointer(in.AuthSecretRef))
+	return nil
+}
+
+// Convert_v1alpha1_ApprovalWebhookConfiguration_To_config_ApprovalWebhookConfiguration is an autogenerated conversion function.
+func Convert_v1alpha1_ApprovalWebhookConfiguration_To_config_ApprovalWebhookConfiguration(in *ApprovalWebhookConfiguration, out *config.ApprovalWebhookConfiguration, s conversion.Scope) error {
+	return autoConvert_v1alpha1_ApprovalWebhookConfiguration_To_config_ApprovalWebhookConfiguration(in, out, s)
+}
+
+func autoConvert_config_ApprovalWebhookConfiguration_To_v1alpha1_ApprovalWebhookConfiguration(in *config.ApprovalWebhookConfiguration, out *ApprovalWebhookConfiguration, s conversion.Scope) error {
+	out.URL = in.URL
+	out.AuthSecretRef = (*corev1alpha1.SecretReference)(unsafe.Pointer(in.AuthSecretRef))
+	return nil
+}
+
+// Convert_config_ApprovalWebhookConfiguration_To_v1alpha1_ApprovalWebhookConfiguration is an autogenerated conversion function.
+func Convert_config_ApprovalWebhookConfiguration_To_v1alpha1_ApprovalWebhookConfiguration(in *config.ApprovalWebhookConfiguration, out *ApprovalWebhookConfiguration, s conversion.Scope) error {
+	return autoConvert_config_ApprovalWebhookConfiguration_To_v1alpha1_ApprovalWebhookConfiguration(in, out, s)
+}
+
+func autoConvert_v1alpha1_ArchiveConfiguration_To_config_ArchiveConfiguration(in *ArchiveConfiguration, out *config.ArchiveConfiguration, s conversion.Scope) error {
+	out.Name = in.Name
+	out.Path = in.Path
+	out.OCIArtifact = in.OCIArtifact
+	return nil
+}
+
+// Convert_v1alpha1_ArchiveConfiguration_To_config_ArchiveConfiguration is an autogenerated conversion function.
+func Convert_v1alpha1_ArchiveConfiguration_To_config_ArchiveConfiguration(in *ArchiveConfiguration, out *config.ArchiveConfiguration, s conversion.Scope) error {
+	return autoConvert_v1alpha1_ArchiveConfiguration_To_config_ArchiveConfiguration(in, out, s)
+}
+
+func autoConvert_config_ArchiveConfiguration_To_v1alpha1_ArchiveConfiguration(in *config.ArchiveConfiguration, out *ArchiveConfiguration, s conversion.Scope) error {
+	out.Name = in.Name
+	out.Path = in.Path
+	out.OCIArtifact = in.OCIArtifact
+	return nil
+}
+
+// Convert_config_ArchiveConfiguration_To_v1alpha1_ArchiveConfiguration is an autogenerated conversion function.
+func Convert_config_ArchiveConfiguration_To_v1alpha1_ArchiveConfiguration(in *config.ArchiveConfiguration, out *ArchiveConfiguration, s conversion.Scope) error {
+	return autoConvert_config_ArchiveConfiguration_To_v1alpha1_ArchiveConfiguration(in, out, s)
+}
+
+func autoConvert_v1alpha1_ArchiveRegistryConfiguration_To_config_ArchiveRegistryConfiguration(in *ArchiveRegistryConfiguration, out *config.ArchiveRegistryConfiguration, s conversion.Scope) error {
+	out.Archives = *(*[]config.ArchiveConfiguration)(unsafe.Pointer(&in.Archives))
+	out.CacheDir = in.CacheDir
+	return nil
+}
+
+// Convert_v1alpha1_ArchiveRegistryConfiguration_To_config_ArchiveRegistryConfiguration is an autogenerated conversion function.
+func Convert_v1alpha1_ArchiveRegistryConfiguration_To_config_ArchiveRegistryConfiguration(in *ArchiveRegistryConfiguration, out *config.ArchiveRegistryConfiguration, s conversion.Scope) error {
+	return autoConvert_v1alpha1_ArchiveRegistryConfiguration_To_config_ArchiveRegistryConfiguration(in, out, s)
+}
+
+func autoConvert_config_ArchiveRegistryConfiguration_To_v1alpha1_ArchiveRegistryConfiguration(in *config.ArchiveRegistryConfiguration, out *ArchiveRegistryConfiguration, s conversion.Scope) error {
+	out.Archives = *(*[]ArchiveConfiguration)(unsafe.Pointer(&in.Archives))
+	out.CacheDir = in.CacheDir
+	return nil
+}
+
+// Convert_config_ArchiveRegistryConfiguration_To_v1alpha1_ArchiveRegistryConfiguration is an autogenerated conversion function.
+func Convert_config_ArchiveRegistryConfiguration_To_v1alpha1_ArchiveRegistryConfiguration(in *config.ArchiveRegistryConfiguration, out *ArchiveRegistryConfiguration, s conversion.Scope) error {
+	return autoConvert_config_ArchiveRegistryConfiguration_To_v1alpha1_ArchiveRegistryConfiguration(in, out, s)
+}
+
+func autoConvert_v1alpha1_BlueprintBlobCacheConfiguration_To_config_BlueprintBlobCacheConfiguration(in *BlueprintBlobCacheConfiguration, out *config.BlueprintBlobCacheConfiguration, s conversion.Scope) error {
+	out.MemorySize = in.MemorySize
+	out.DiskSize = in.DiskSize
+	return nil
+}
+
+// Convert_v1alpha1_BlueprintBlobCacheConfiguration_To_config_BlueprintBlobCacheConfiguration is an autogenerated conversion function.
+func Convert_v1alpha1_BlueprintBlobCacheConfiguration_To_config_BlueprintBlobCacheConfiguration(in *BlueprintBlobCacheConfiguration, out *config.BlueprintBlobCacheConfiguration, s conversion.Scope) error {
+	return autoConvert_v1alpha1_BlueprintBlobCacheConfiguration_To_config_BlueprintBlobCacheConfiguration(in, out, s)
+}
+
+func autoConvert_config_BlueprintBlobCacheConfiguration_To_v1alpha1_BlueprintBlobCacheConfiguration(in *config.BlueprintBlobCacheConfiguration, out *BlueprintBlobCacheConfiguration, s conversion.Scope) error {
+	out.MemorySize = in.MemorySize
+	out.DiskSize = in.DiskSize
+	return nil
+}
+
+// Convert_config_BlueprintBlobCacheConfiguration_To_v1alpha1_BlueprintBlobCacheConfiguration is an autogenerated conversion function.
+func Convert_config_BlueprintBlobCacheConfiguration_To_v1alpha1_BlueprintBlobCacheConfiguration(in *config.BlueprintBlobCacheConfiguration, out *BlueprintBlobCacheConfiguration, s conversion.Scope) error {
+	return autoConvert_config_BlueprintBlobCacheConfiguration_To_v1alpha1_BlueprintBlobCacheConfiguration(in, out, s)
+}
+
 func autoConvert_v1alpha1_BlueprintStore_To_config_BlueprintStore(in *BlueprintStore, out *config.BlueprintStore, s conversion.Scope) error {
 	out.Path = in.Path
 	out.DisableCache = in.DisableCache
 	out.IndexMethod = config.IndexMethod(in.IndexMethod)
+	out.BlobCache = (*config.BlueprintBlobCacheConfiguration)(unsafe.Pointer(in.BlobCache))
 	if err := Convert_v1alpha1_GarbageCollectionConfiguration_To_config_GarbageCollectionConfiguration(&in.GarbageCollectionConfiguration, &out.GarbageCollectionConfiguration, s); err != nil {
 		return err
 	}
@@ -280,6 +693,7 @@ func autoConvert_config_BlueprintStore_To_v1alpha1_BlueprintStore(in *config.Blu
 	out.Path = in.Path
 	out.DisableCache = in.DisableCache
 	out.IndexMethod = IndexMethod(in.IndexMethod)
+	out.BlobCache = (*BlueprintBlobCacheConfiguration)(unsafe.Pointer(in.BlobCache))
 	if err := Convert_config_GarbageCollectionConfiguration_To_v1alpha1_GarbageCollectionConfiguration(&in.GarbageCollectionConfiguration, &out.GarbageCollectionConfiguration, s); err != nil {
 		return err
 	}
@@ -291,6 +705,50 @@ func Convert_config_BlueprintStore_To_v1alpha1_BlueprintStore(in *config.Bluepri
 	return autoConvert_config_BlueprintStore_To_v1alpha1_BlueprintStore(in, out, s)
 }
 
+func autoConvert_v1alpha1_ClusterConfiguration_To_config_ClusterConfiguration(in *ClusterConfiguration, out *config.ClusterConfiguration, s conversion.Scope) error {
+	out.Kubeconfig = in.Kubeconfig
+	out.Context = in.Context
+	return nil
+}
+
+// Convert_v1alpha1_ClusterConfiguration_To_config_ClusterConfiguration is an autogenerated conversion function.
+func Convert_v1alpha1_ClusterConfiguration_To_config_ClusterConfiguration(in *ClusterConfiguration, out *config.ClusterConfiguration, s conversion.Scope) error {
+	return autoConvert_v1alpha1_ClusterConfiguration_To_config_ClusterConfiguration(in, out, s)
+}
+
+func autoConvert_config_ClusterConfiguration_To_v1alpha1_ClusterConfiguration(in *config.ClusterConfiguration, out *ClusterConfiguration, s conversion.Scope) error {
+	out.Kubeconfig = in.Kubeconfig
+	out.Context = in.Context
+	return nil
+}
+
+// Convert_config_ClusterConfiguration_To_v1alpha1_ClusterConfiguration is an autogenerated conversion function.
+func Convert_config_ClusterConfiguration_To_v1alpha1_ClusterConfiguration(in *config.ClusterConfiguration, out *ClusterConfiguration, s conversion.Scope) error {
+	return autoConvert_config_ClusterConfiguration_To_v1alpha1_ClusterConfiguration(in, out, s)
+}
+
+func autoConvert_v1alpha1_ClustersConfiguration_To_config_ClustersConfiguration(in *ClustersConfiguration, out *config.ClustersConfiguration, s conversion.Scope) error {
+	out.ResourceCluster = (*config.ClusterConfiguration)(unsafe.Pointer(in.ResourceCluster))
+	out.HostCluster = (*config.ClusterConfiguration)(unsafe.Pointer(in.HostCluster))
+	return nil
+}
+
+// Convert_v1alpha1_ClustersConfiguration_To_config_ClustersConfiguration is an autogenerated conversion function.
+func Convert_v1alpha1_ClustersConfiguration_To_config_ClustersConfiguration(in *ClustersConfiguration, out *config.ClustersConfiguration, s conversion.Scope) error {
+	return autoConvert_v1alpha1_ClustersConfiguration_To_config_ClustersConfiguration(in, out, s)
+}
+
+func autoConvert_config_ClustersConfiguration_To_v1alpha1_ClustersConfiguration(in *config.ClustersConfiguration, out *ClustersConfiguration, s conversion.Scope) error {
+	out.ResourceCluster = (*ClusterConfiguration)(unsafe.Pointer(in.ResourceCluster))
+	out.HostCluster = (*ClusterConfiguration)(unsafe.Pointer(in.HostCluster))
+	return nil
+}
+
+// Convert_config_ClustersConfiguration_To_v1alpha1_ClustersConfiguration is an autogenerated conversion function.
+func Convert_config_ClustersConfiguration_To_v1alpha1_ClustersConfiguration(in *config.ClustersConfiguration, out *ClustersConfiguration, s conversion.Scope) error {
+	return autoConvert_config_ClustersConfiguration_To_v1alpha1_ClustersConfiguration(in, out, s)
+}
+
 func autoConvert_v1alpha1_CommonControllerConfig_To_config_CommonControllerConfig(in *CommonControllerConfig, out *config.CommonControllerConfig, s conversion.Scope) error {
 	out.Workers = in.Workers
 	out.CacheSyncTimeout = (*v1.Duration)(unsafe.Pointer(in.CacheSyncTimeout))
@@ -313,6 +771,48 @@ func Convert_config_CommonControllerConfig_To_v1alpha1_CommonControllerConfig(in
 	return autoConvert_config_CommonControllerConfig_To_v1alpha1_CommonControllerConfig(in, out, s)
 }
 
+func autoConvert_v1alpha1_ComponentOverwritesConfiguration_To_config_ComponentOverwritesConfiguration(in *ComponentOverwritesConfiguration, out *config.ComponentOverwritesConfiguration, s conversion.Scope) error {
+	out.References = *(*[]core.ObjectReference)(unsafe.Pointer(&in.References))
+	return nil
+}
+
+// Convert_v1alpha1_ComponentOverwritesConfiguration_To_config_ComponentOverwritesConfiguration is an autogenerated conversion function.
+func Convert_v1alpha1_ComponentOverwritesConfiguration_To_config_ComponentOverwritesConfiguration(in *ComponentOverwritesConfiguration, out *config.ComponentOverwritesConfiguration, s conversion.Scope) error {
+	return autoConvert_v1alpha1_ComponentOverwritesConfiguration_To_config_ComponentOverwritesConfiguration(in, out, s)
+}
+
+func autoConvert_config_ComponentOverwritesConfiguration_To_v1alpha1_ComponentOverwritesConfiguration(in *config.ComponentOverwritesConfiguration, out *ComponentOverwritesConfiguration, s conversion.Scope) error {
+	out.References = *(*[]corev1alpha1.ObjectReference)(unsafe.Pointer(&in.References))
+	return nil
+}
+
+// Convert_config_ComponentOverwritesConfiguration_To_v1alpha1_ComponentOverwritesConfiguration is an autogenerated conversion function.
+func Convert_config_ComponentOverwritesConfiguration_To_v1alpha1_ComponentOverwritesConfiguration(in *config.ComponentOverwritesConfiguration, out *ComponentOverwritesConfiguration, s conversion.Scope) error {
+	return autoConvert_config_ComponentOverwritesConfiguration_To_v1alpha1_ComponentOverwritesConfiguration(in, out, s)
+}
+
+func autoConvert_v1alpha1_ComponentVersionCacheConfiguration_To_config_ComponentVersionCacheConfiguration(in *ComponentVersionCacheConfiguration, out *config.ComponentVersionCacheConfiguration, s conversion.Scope) error {
+	out.Size = in.Size
+	out.TTL = (*v1.Duration)(unsafe.Pointer(in.TTL))
+	return nil
+}
+
+// Convert_v1alpha1_ComponentVersionCacheConfiguration_To_config_ComponentVersionCacheConfiguration is an autogenerated conversion function.
+func Convert_v1alpha1_ComponentVersionCacheConfiguration_To_config_ComponentVersionCacheConfiguration(in *ComponentVersionCacheConfiguration, out *config.ComponentVersionCacheConfiguration, s conversion.Scope) error {
+	return autoConvert_v1alpha1_ComponentVersionCacheConfiguration_To_config_ComponentVersionCacheConfiguration(in, out, s)
+}
+
+func autoConvert_config_ComponentVersionCacheConfiguration_To_v1alpha1_ComponentVersionCacheConfiguration(in *config.ComponentVersionCacheConfiguration, out *ComponentVersionCacheConfiguration, s conversion.Scope) error {
+	out.Size = in.Size
+	out.TTL = (*v1.Duration)(unsafe.Pointer(in.TTL))
+	return nil
+}
+
+// Convert_config_ComponentVersionCacheConfiguration_To_v1alpha1_ComponentVersionCacheConfiguration is an autogenerated conversion function.
+func Convert_config_ComponentVersionCacheConfiguration_To_v1alpha1_ComponentVersionCacheConfiguration(in *config.ComponentVersionCacheConfiguration, out *ComponentVersionCacheConfiguration, s conversion.Scope) error {
+	return autoConvert_config_ComponentVersionCacheConfiguration_To_v1alpha1_ComponentVersionCacheConfiguration(in, out, s)
+}
+
 func autoConvert_v1alpha1_ContextControllerConfig_To_config_ContextControllerConfig(in *ContextControllerConfig, out *config.ContextControllerConfig, s conversion.Scope) error {
 	if err := Convert_v1alpha1_ContextControllerDefaultConfig_To_config_ContextControllerDefaultConfig(&in.Default, &out.Default, s); err != nil {
 		return err
@@ -459,6 +959,7 @@ func Convert_config_CrdManagementConfiguration_To_v1alpha1_CrdManagementConfigur
 
 func autoConvert_v1alpha1_DeployItemTimeouts_To_config_DeployItemTimeouts(in *DeployItemTimeouts, out *config.DeployItemTimeouts, s conversion.Scope) error {
 	out.Pickup = (*core.Duration)(unsafe.Pointer(in.Pickup))
+	out.ProgressingDefault = (*core.Duration)(unsafe.Pointer(in.ProgressingDefault))
 	out.Abort = (*core.Duration)(unsafe.Pointer(in.Abort))
 	return nil
 }
@@ -470,6 +971,7 @@ func Convert_v1alpha1_DeployItemTimeouts_To_config_DeployItemTimeouts(in *Deploy
 
 func autoConvert_config_DeployItemTimeouts_To_v1alpha1_DeployItemTimeouts(in *config.DeployItemTimeouts, out *DeployItemTimeouts, s conversion.Scope) error {
 	out.Pickup = (*corev1alpha1.Duration)(unsafe.Pointer(in.Pickup))
+	out.ProgressingDefault = (*corev1alpha1.Duration)(unsafe.Pointer(in.ProgressingDefault))
 	out.Abort = (*corev1alpha1.Duration)(unsafe.Pointer(in.Abort))
 	return nil
 }
@@ -483,6 +985,7 @@ func autoConvert_v1alpha1_DeployItemsController_To_config_DeployItemsController(
 	if err := Convert_v1alpha1_CommonControllerConfig_To_config_CommonControllerConfig(&in.CommonControllerConfig, &out.CommonControllerConfig, s); err != nil {
 		return err
 	}
+	out.MaxWorkersPerNamespace = in.MaxWorkersPerNamespace
 	return nil
 }
 
@@ -495,6 +998,7 @@ func autoConvert_config_DeployItemsController_To_v1alpha1_DeployItemsController(
 	if err := Convert_config_CommonControllerConfig_To_v1alpha1_CommonControllerConfig(&in.CommonControllerConfig, &out.CommonControllerConfig, s); err != nil {
 		return err
 	}
+	out.MaxWorkersPerNamespace = in.MaxWorkersPerNamespace
 	return nil
 }
 
@@ -503,10 +1007,33 @@ func Convert_config_DeployItemsController_To_v1alpha1_DeployItemsController(in *
 	return autoConvert_config_DeployItemsController_To_v1alpha1_DeployItemsController(in, out, s)
 }
 
+func autoConvert_v1alpha1_EncryptionConfiguration_To_config_EncryptionConfiguration(in *EncryptionConfiguration, out *config.EncryptionConfiguration, s conversion.Scope) error {
+	out.Local = (*config.LocalEncryptionConfiguration)(unsafe.Pointer(in.Local))
+	out.KMS = (*config.KMSEncryptionConfiguration)(unsafe.Pointer(in.KMS))
+	return nil
+}
+
+// Convert_v1alpha1_EncryptionConfiguration_To_config_EncryptionConfiguration is an autogenerated conversion function.
+func Convert_v1alpha1_EncryptionConfiguration_To_config_EncryptionConfiguration(in *EncryptionConfiguration, out *config.EncryptionConfiguration, s conversion.Scope) error {
+	return autoConvert_v1alpha1_EncryptionConfiguration_To_config_EncryptionConfiguration(in, out, s)
+}
+
+func autoConvert_config_EncryptionConfiguration_To_v1alpha1_EncryptionConfiguration(in *config.EncryptionConfiguration, out *EncryptionConfiguration, s conversion.Scope) error {
+	out.Local = (*LocalEncryptionConfiguration)(unsafe.Pointer(in.Local))
+	out.KMS = (*KMSEncryptionConfiguration)(unsafe.Pointer(in.KMS))
+	return nil
+}
+
+// Convert_config_EncryptionConfiguration_To_v1alpha1_EncryptionConfiguration is an autogenerated conversion function.
+func Convert_config_EncryptionConfiguration_To_v1alpha1_EncryptionConfiguration(in *config.EncryptionConfiguration, out *EncryptionConfiguration, s conversion.Scope) error {
+	return autoConvert_config_EncryptionConfiguration_To_v1alpha1_EncryptionConfiguration(in, out, s)
+}
+
 func autoConvert_v1alpha1_ExecutionsController_To_config_ExecutionsController(in *ExecutionsController, out *config.ExecutionsController, s conversion.Scope) error {
 	if err := Convert_v1alpha1_CommonControllerConfig_To_config_CommonControllerConfig(&in.CommonControllerConfig, &out.CommonControllerConfig, s); err != nil {
 		return err
 	}
+	out.MaxWorkersPerNamespace = in.MaxWorkersPerNamespace
 	return nil
 }
 
@@ -519,6 +1046,7 @@ func autoConvert_config_ExecutionsController_To_v1alpha1_ExecutionsController(in
 	if err := Convert_config_CommonControllerConfig_To_v1alpha1_CommonControllerConfig(&in.CommonControllerConfig, &out.CommonControllerConfig, s); err != nil {
 		return err
 	}
+	out.MaxWorkersPerNamespace = in.MaxWorkersPerNamespace
 	return nil
 }
 
@@ -527,6 +1055,30 @@ func Convert_config_ExecutionsController_To_v1alpha1_ExecutionsController(in *co
 	return autoConvert_config_ExecutionsController_To_v1alpha1_ExecutionsController(in, out, s)
 }
 
+func autoConvert_v1alpha1_FeatureGates_To_config_FeatureGates(in *FeatureGates, out *config.FeatureGates, s conversion.Scope) error {
+	out.ReconcileOnReferencedDataChange = in.ReconcileOnReferencedDataChange
+	out.OwnerReferenceGarbageCollection = in.OwnerReferenceGarbageCollection
+	out.DisablePartialRedeployment = in.DisablePartialRedeployment
+	return nil
+}
+
+// Convert_v1alpha1_FeatureGates_To_config_FeatureGates is an autogenerated conversion function.
+func Convert_v1alpha1_FeatureGates_To_config_FeatureGates(in *FeatureGates, out *config.FeatureGates, s conversion.Scope) error {
+	return autoConvert_v1alpha1_FeatureGates_To_config_FeatureGates(in, out, s)
+}
+
+func autoConvert_config_FeatureGates_To_v1alpha1_FeatureGates(in *config.FeatureGates, out *FeatureGates, s conversion.Scope) error {
+	out.ReconcileOnReferencedDataChange = in.ReconcileOnReferencedDataChange
+	out.OwnerReferenceGarbageCollection = in.OwnerReferenceGarbageCollection
+	out.DisablePartialRedeployment = in.DisablePartialRedeployment
+	return nil
+}
+
+// Convert_config_FeatureGates_To_v1alpha1_FeatureGates is an autogenerated conversion function.
+func Convert_config_FeatureGates_To_v1alpha1_FeatureGates(in *config.FeatureGates, out *FeatureGates, s conversion.Scope) error {
+	return autoConvert_config_FeatureGates_To_v1alpha1_FeatureGates(in, out, s)
+}
+
 func autoConvert_v1alpha1_GarbageCollectionConfiguration_To_config_GarbageCollectionConfiguration(in *GarbageCollectionConfiguration, out *config.GarbageCollectionConfiguration, s conversion.Scope) error {
 	out.Size = in.Size
 	out.GCHighThreshold = in.GCHighThreshold
@@ -559,6 +1111,82 @@ func Convert_config_GarbageCollectionConfiguration_To_v1alpha1_GarbageCollection
 	return autoConvert_config_GarbageCollectionConfiguration_To_v1alpha1_GarbageCollectionConfiguration(in, out, s)
 }
 
+func autoConvert_v1alpha1_GitAuthConfiguration_To_config_GitAuthConfiguration(in *GitAuthConfiguration, out *config.GitAuthConfiguration, s conversion.Scope) error {
+	out.SSHPrivateKeyFile = in.SSHPrivateKeyFile
+	out.SSHKnownHostsFile = in.SSHKnownHostsFile
+	out.Username = in.Username
+	out.TokenFile = in.TokenFile
+	return nil
+}
+
+// Convert_v1alpha1_GitAuthConfiguration_To_config_GitAuthConfiguration is an autogenerated conversion function.
+func Convert_v1alpha1_GitAuthConfiguration_To_config_GitAuthConfiguration(in *GitAuthConfiguration, out *config.GitAuthConfiguration, s conversion.Scope) error {
+	return autoConvert_v1alpha1_GitAuthConfiguration_To_config_GitAuthConfiguration(in, out, s)
+}
+
+func autoConvert_config_GitAuthConfiguration_To_v1alpha1_GitAuthConfiguration(in *config.GitAuthConfiguration, out *GitAuthConfiguration, s conversion.Scope) error {
+	out.SSHPrivateKeyFile = in.SSHPrivateKeyFile
+	out.SSHKnownHostsFile = in.SSHKnownHostsFile
+	out.Username = in.Username
+	out.TokenFile = in.TokenFile
+	return nil
+}
+
+// Convert_config_GitAuthConfiguration_To_v1alpha1_GitAuthConfiguration is an autogenerated conversion function.
+func Convert_config_GitAuthConfiguration_To_v1alpha1_GitAuthConfiguration(in *config.GitAuthConfiguration, out *GitAuthConfiguration, s conversion.Scope) error {
+	return autoConvert_config_GitAuthConfiguration_To_v1alpha1_GitAuthConfiguration(in, out, s)
+}
+
+func autoConvert_v1alpha1_GitRegistryConfiguration_To_config_GitRegistryConfiguration(in *GitRegistryConfiguration, out *config.GitRegistryConfiguration, s conversion.Scope) error {
+	out.Repositories = *(*[]config.GitRepositoryConfiguration)(unsafe.Pointer(&in.Repositories))
+	out.Interval = (*v1.Duration)(unsafe.Pointer(in.Interval))
+	out.CacheDir = in.CacheDir
+	return nil
+}
+
+// Convert_v1alpha1_GitRegistryConfiguration_To_config_GitRegistryConfiguration is an autogenerated conversion function.
+func Convert_v1alpha1_GitRegistryConfiguration_To_config_GitRegistryConfiguration(in *GitRegistryConfiguration, out *config.GitRegistryConfiguration, s conversion.Scope) error {
+	return autoConvert_v1alpha1_GitRegistryConfiguration_To_config_GitRegistryConfiguration(in, out, s)
+}
+
+func autoConvert_config_GitRegistryConfiguration_To_v1alpha1_GitRegistryConfiguration(in *config.GitRegistryConfiguration, out *GitRegistryConfiguration, s conversion.Scope) error {
+	out.Repositories = *(*[]GitRepositoryConfiguration)(unsafe.Pointer(&in.Repositories))
+	out.Interval = (*v1.Duration)(unsafe.Pointer(in.Interval))
+	out.CacheDir = in.CacheDir
+	return nil
+}
+
+// Convert_config_GitRegistryConfiguration_To_v1alpha1_GitRegistryConfiguration is an autogenerated conversion function.
+func Convert_config_GitRegistryConfiguration_To_v1alpha1_GitRegistryConfiguration(in *config.GitRegistryConfiguration, out *GitRegistryConfiguration, s conversion.Scope) error {
+	return autoConvert_config_GitRegistryConfiguration_To_v1alpha1_GitRegistryConfiguration(in, out, s)
+}
+
+func autoConvert_v1alpha1_GitRepositoryConfiguration_To_config_GitRepositoryConfiguration(in *GitRepositoryConfiguration, out *config.GitRepositoryConfiguration, s conversion.Scope) error {
+	out.Name = in.Name
+	out.URL = in.URL
+	out.Ref = in.Ref
+	out.Auth = (*config.GitAuthConfiguration)(unsafe.Pointer(in.Auth))
+	return nil
+}
+
+// Convert_v1alpha1_GitRepositoryConfiguration_To_config_GitRepositoryConfiguration is an autogenerated conversion function.
+func Convert_v1alpha1_GitRepositoryConfiguration_To_config_GitRepositoryConfiguration(in *GitRepositoryConfiguration, out *config.GitRepositoryConfiguration, s conversion.Scope) error {
+	return autoConvert_v1alpha1_GitRepositoryConfiguration_To_config_GitRepositoryConfiguration(in, out, s)
+}
+
+func autoConvert_config_GitRepositoryConfiguration_To_v1alpha1_GitRepositoryConfiguration(in *config.GitRepositoryConfiguration, out *GitRepositoryConfiguration, s conversion.Scope) error {
+	out.Name = in.Name
+	out.URL = in.URL
+	out.Ref = in.Ref
+	out.Auth = (*GitAuthConfiguration)(unsafe.Pointer(in.Auth))
+	return nil
+}
+
+// Convert_config_GitRepositoryConfiguration_To_v1alpha1_GitRepositoryConfiguration is an autogenerated conversion function.
+func Convert_config_GitRepositoryConfiguration_To_v1alpha1_GitRepositoryConfiguration(in *config.GitRepositoryConfiguration, out *GitRepositoryConfiguration, s conversion.Scope) error {
+	return autoConvert_config_GitRepositoryConfiguration_To_v1alpha1_GitRepositoryConfiguration(in, out, s)
+}
+
 func autoConvert_v1alpha1_HPAMainConfiguration_To_config_HPAMainConfiguration(in *HPAMainConfiguration, out *config.HPAMainConfiguration, s conversion.Scope) error {
 	out.MaxReplicas = in.MaxReplicas
 	return nil
@@ -579,10 +1207,32 @@ func Convert_config_HPAMainConfiguration_To_v1alpha1_HPAMainConfiguration(in *co
 	return autoConvert_config_HPAMainConfiguration_To_v1alpha1_HPAMainConfiguration(in, out, s)
 }
 
+func autoConvert_v1alpha1_HTTPImportsConfiguration_To_config_HTTPImportsConfiguration(in *HTTPImportsConfiguration, out *config.HTTPImportsConfiguration, s conversion.Scope) error {
+	out.AllowedHosts = *(*[]string)(unsafe.Pointer(&in.AllowedHosts))
+	return nil
+}
+
+// Convert_v1alpha1_HTTPImportsConfiguration_To_config_HTTPImportsConfiguration is an autogenerated conversion function.
+func Convert_v1alpha1_HTTPImportsConfiguration_To_config_HTTPImportsConfiguration(in *HTTPImportsConfiguration, out *config.HTTPImportsConfiguration, s conversion.Scope) error {
+	return autoConvert_v1alpha1_HTTPImportsConfiguration_To_config_HTTPImportsConfiguration(in, out, s)
+}
+
+func autoConvert_config_HTTPImportsConfiguration_To_v1alpha1_HTTPImportsConfiguration(in *config.HTTPImportsConfiguration, out *HTTPImportsConfiguration, s conversion.Scope) error {
+	out.AllowedHosts = *(*[]string)(unsafe.Pointer(&in.AllowedHosts))
+	return nil
+}
+
+// Convert_config_HTTPImportsConfiguration_To_v1alpha1_HTTPImportsConfiguration is an autogenerated conversion function.
+func Convert_config_HTTPImportsConfiguration_To_v1alpha1_HTTPImportsConfiguration(in *config.HTTPImportsConfiguration, out *HTTPImportsConfiguration, s conversion.Scope) error {
+	return autoConvert_config_HTTPImportsConfiguration_To_v1alpha1_HTTPImportsConfiguration(in, out, s)
+}
+
 func autoConvert_v1alpha1_InstallationsController_To_config_InstallationsController(in *InstallationsController, out *config.InstallationsController, s conversion.Scope) error {
 	if err := Convert_v1alpha1_CommonControllerConfig_To_config_CommonControllerConfig(&in.CommonControllerConfig, &out.CommonControllerConfig, s); err != nil {
 		return err
 	}
+	out.MaxWorkersPerNamespace = in.MaxWorkersPerNamespace
+	out.StartupPriority = (*config.StartupPriorityConfiguration)(unsafe.Pointer(in.StartupPriority))
 	return nil
 }
 
@@ -595,6 +1245,8 @@ func autoConvert_config_InstallationsController_To_v1alpha1_InstallationsControl
 	if err := Convert_config_CommonControllerConfig_To_v1alpha1_CommonControllerConfig(&in.CommonControllerConfig, &out.CommonControllerConfig, s); err != nil {
 		return err
 	}
+	out.MaxWorkersPerNamespace = in.MaxWorkersPerNamespace
+	out.StartupPriority = (*StartupPriorityConfiguration)(unsafe.Pointer(in.StartupPriority))
 	return nil
 }
 
@@ -603,6 +1255,82 @@ func Convert_config_InstallationsController_To_v1alpha1_InstallationsController(
 	return autoConvert_config_InstallationsController_To_v1alpha1_InstallationsController(in, out, s)
 }
 
+func autoConvert_v1alpha1_InventoryConfig_To_config_InventoryConfig(in *InventoryConfig, out *config.InventoryConfig, s conversion.Scope) error {
+	out.Interval = (*v1.Duration)(unsafe.Pointer(in.Interval))
+	out.MinAge = (*v1.Duration)(unsafe.Pointer(in.MinAge))
+	out.ResourceTypes = *(*[]config.InventoryResourceType)(unsafe.Pointer(&in.ResourceTypes))
+	out.Cleanup = in.Cleanup
+	return nil
+}
+
+// Convert_v1alpha1_InventoryConfig_To_config_InventoryConfig is an autogenerated conversion function.
+func Convert_v1alpha1_InventoryConfig_To_config_InventoryConfig(in *InventoryConfig, out *config.InventoryConfig, s conversion.Scope) error {
+	return autoConvert_v1alpha1_InventoryConfig_To_config_InventoryConfig(in, out, s)
+}
+
+func autoConvert_config_InventoryConfig_To_v1alpha1_InventoryConfig(in *config.InventoryConfig, out *InventoryConfig, s conversion.Scope) error {
+	out.Interval = (*v1.Duration)(unsafe.Pointer(in.Interval))
+	out.MinAge = (*v1.Duration)(unsafe.Pointer(in.MinAge))
+	out.ResourceTypes = *(*[]InventoryResourceType)(unsafe.Pointer(&in.ResourceTypes))
+	out.Cleanup = in.Cleanup
+	return nil
+}
+
+// Convert_config_InventoryConfig_To_v1alpha1_InventoryConfig is an autogenerated conversion function.
+func Convert_config_InventoryConfig_To_v1alpha1_InventoryConfig(in *config.InventoryConfig, out *InventoryConfig, s conversion.Scope) error {
+	return autoConvert_config_InventoryConfig_To_v1alpha1_InventoryConfig(in, out, s)
+}
+
+func autoConvert_v1alpha1_InventoryResourceType_To_config_InventoryResourceType(in *InventoryResourceType, out *config.InventoryResourceType, s conversion.Scope) error {
+	out.APIVersion = in.APIVersion
+	out.Kind = in.Kind
+	return nil
+}
+
+// Convert_v1alpha1_InventoryResourceType_To_config_InventoryResourceType is an autogenerated conversion function.
+func Convert_v1alpha1_InventoryResourceType_To_config_InventoryResourceType(in *InventoryResourceType, out *config.InventoryResourceType, s conversion.Scope) error {
+	return autoConvert_v1alpha1_InventoryResourceType_To_config_InventoryResourceType(in, out, s)
+}
+
+func autoConvert_config_InventoryResourceType_To_v1alpha1_InventoryResourceType(in *config.InventoryResourceType, out *InventoryResourceType, s conversion.Scope) error {
+	out.APIVersion = in.APIVersion
+	out.Kind = in.Kind
+	return nil
+}
+
+// Convert_config_InventoryResourceType_To_v1alpha1_InventoryResourceType is an autogenerated conversion function.
+func Convert_config_InventoryResourceType_To_v1alpha1_InventoryResourceType(in *config.InventoryResourceType, out *InventoryResourceType, s conversion.Scope) error {
+	return autoConvert_config_InventoryResourceType_To_v1alpha1_InventoryResourceType(in, out, s)
+}
+
+func autoConvert_v1alpha1_KMSEncryptionConfiguration_To_config_KMSEncryptionConfiguration(in *KMSEncryptionConfiguration, out *config.KMSEncryptionConfiguration, s conversion.Scope) error {
+	out.Address = in.Address
+	out.MountPath = in.MountPath
+	out.KeyName = in.KeyName
+	out.TokenFile = in.TokenFile
+	out.CAFile = in.CAFile
+	return nil
+}
+
+// Convert_v1alpha1_KMSEncryptionConfiguration_To_config_KMSEncryptionConfiguration is an autogenerated conversion function.
+func Convert_v1alpha1_KMSEncryptionConfiguration_To_config_KMSEncryptionConfiguration(in *KMSEncryptionConfiguration, out *config.KMSEncryptionConfiguration, s conversion.Scope) error {
+	return autoConvert_v1alpha1_KMSEncryptionConfiguration_To_config_KMSEncryptionConfiguration(in, out, s)
+}
+
+func autoConvert_config_KMSEncryptionConfiguration_To_v1alpha1_KMSEncryptionConfiguration(in *config.KMSEncryptionConfiguration, out *KMSEncryptionConfiguration, s conversion.Scope) error {
+	out.Address = in.Address
+	out.MountPath = in.MountPath
+	out.KeyName = in.KeyName
+	out.TokenFile = in.TokenFile
+	out.CAFile = in.CAFile
+	return nil
+}
+
+// Convert_config_KMSEncryptionConfiguration_To_v1alpha1_KMSEncryptionConfiguration is an autogenerated conversion function.
+func Convert_config_KMSEncryptionConfiguration_To_v1alpha1_KMSEncryptionConfiguration(in *config.KMSEncryptionConfiguration, out *KMSEncryptionConfiguration, s conversion.Scope) error {
+	return autoConvert_config_KMSEncryptionConfiguration_To_v1alpha1_KMSEncryptionConfiguration(in, out, s)
+}
+
 func autoConvert_v1alpha1_LandscaperConfiguration_To_config_LandscaperConfiguration(in *LandscaperConfiguration, out *config.LandscaperConfiguration, s conversion.Scope) error {
 	if err := Convert_v1alpha1_Controllers_To_config_Controllers(&in.Controllers, &out.Controllers, s); err != nil {
 		return err
@@ -623,6 +1351,18 @@ func autoConvert_v1alpha1_LandscaperConfiguration_To_config_LandscaperConfigurat
 	out.HPAMainConfiguration = (*config.HPAMainConfiguration)(unsafe.Pointer(in.HPAMainConfiguration))
 	out.UseOCMLib = in.UseOCMLib
 	out.SignatureVerificationEnforcementPolicy = config.SignatureVerificationEnforcementPolicy(in.SignatureVerificationEnforcementPolicy)
+	out.SignatureVerificationRules = *(*[]config.SignatureVerificationRule)(unsafe.Pointer(&in.SignatureVerificationRules))
+	if err := Convert_v1alpha1_FeatureGates_To_config_FeatureGates(&in.FeatureGates, &out.FeatureGates, s); err != nil {
+		return err
+	}
+	out.ApprovalHooks = *(*[]config.ApprovalHookConfiguration)(unsafe.Pointer(&in.ApprovalHooks))
+	out.WriteAudit = (*config.WriteAuditConfiguration)(unsafe.Pointer(in.WriteAudit))
+	out.Clusters = (*config.ClustersConfiguration)(unsafe.Pointer(in.Clusters))
+	out.SchemaStore = (*config.SchemaStoreConfiguration)(unsafe.Pointer(in.SchemaStore))
+	out.ComponentOverwrites = (*config.ComponentOverwritesConfiguration)(unsafe.Pointer(in.ComponentOverwrites))
+	out.Encryption = (*config.EncryptionConfiguration)(unsafe.Pointer(in.Encryption))
+	out.HTTPImports = (*config.HTTPImportsConfiguration)(unsafe.Pointer(in.HTTPImports))
+	out.RejectLocalKubeconfigCredentials = in.RejectLocalKubeconfigCredentials
 	return nil
 }
 
@@ -651,6 +1391,18 @@ func autoConvert_config_LandscaperConfiguration_To_v1alpha1_LandscaperConfigurat
 	out.HPAMainConfiguration = (*HPAMainConfiguration)(unsafe.Pointer(in.HPAMainConfiguration))
 	out.UseOCMLib = in.UseOCMLib
 	out.SignatureVerificationEnforcementPolicy = SignatureVerificationEnforcementPolicy(in.SignatureVerificationEnforcementPolicy)
+	out.SignatureVerificationRules = *(*[]SignatureVerificationRule)(unsafe.Pointer(&in.SignatureVerificationRules))
+	if err := Convert_config_FeatureGates_To_v1alpha1_FeatureGates(&in.FeatureGates, &out.FeatureGates, s); err != nil {
+		return err
+	}
+	out.ApprovalHooks = *(*[]ApprovalHookConfiguration)(unsafe.Pointer(&in.ApprovalHooks))
+	out.WriteAudit = (*WriteAuditConfiguration)(unsafe.Pointer(in.WriteAudit))
+	out.Clusters = (*ClustersConfiguration)(unsafe.Pointer(in.Clusters))
+	out.SchemaStore = (*SchemaStoreConfiguration)(unsafe.Pointer(in.SchemaStore))
+	out.ComponentOverwrites = (*ComponentOverwritesConfiguration)(unsafe.Pointer(in.ComponentOverwrites))
+	out.Encryption = (*EncryptionConfiguration)(unsafe.Pointer(in.Encryption))
+	out.HTTPImports = (*HTTPImportsConfiguration)(unsafe.Pointer(in.HTTPImports))
+	out.RejectLocalKubeconfigCredentials = in.RejectLocalKubeconfigCredentials
 	return nil
 }
 
@@ -659,8 +1411,51 @@ func Convert_config_LandscaperConfiguration_To_v1alpha1_LandscaperConfiguration(
 	return autoConvert_config_LandscaperConfiguration_To_v1alpha1_LandscaperConfiguration(in, out, s)
 }
 
+func autoConvert_v1alpha1_LocalEncryptionConfiguration_To_config_LocalEncryptionConfiguration(in *LocalEncryptionConfiguration, out *config.LocalEncryptionConfiguration, s conversion.Scope) error {
+	out.Keys = *(*[]config.LocalEncryptionKey)(unsafe.Pointer(&in.Keys))
+	return nil
+}
+
+// Convert_v1alpha1_LocalEncryptionConfiguration_To_config_LocalEncryptionConfiguration is an autogenerated conversion function.
+func Convert_v1alpha1_LocalEncryptionConfiguration_To_config_LocalEncryptionConfiguration(in *LocalEncryptionConfiguration, out *config.LocalEncryptionConfiguration, s conversion.Scope) error {
+	return autoConvert_v1alpha1_LocalEncryptionConfiguration_To_config_LocalEncryptionConfiguration(in, out, s)
+}
+
+func autoConvert_config_LocalEncryptionConfiguration_To_v1alpha1_LocalEncryptionConfiguration(in *config.LocalEncryptionConfiguration, out *LocalEncryptionConfiguration, s conversion.Scope) error {
+	out.Keys = *(*[]LocalEncryptionKey)(unsafe.Pointer(&in.Keys))
+	return nil
+}
+
+// Convert_config_LocalEncryptionConfiguration_To_v1alpha1_LocalEncryptionConfiguration is an autogenerated conversion function.
+func Convert_config_LocalEncryptionConfiguration_To_v1alpha1_LocalEncryptionConfiguration(in *config.LocalEncryptionConfiguration, out *LocalEncryptionConfiguration, s conversion.Scope) error {
+	return autoConvert_config_LocalEncryptionConfiguration_To_v1alpha1_LocalEncryptionConfiguration(in, out, s)
+}
+
+func autoConvert_v1alpha1_LocalEncryptionKey_To_config_LocalEncryptionKey(in *LocalEncryptionKey, out *config.LocalEncryptionKey, s conversion.Scope) error {
+	out.Name = in.Name
+	out.KeyFile = in.KeyFile
+	return nil
+}
+
+// Convert_v1alpha1_LocalEncryptionKey_To_config_LocalEncryptionKey is an autogenerated conversion function.
+func Convert_v1alpha1_LocalEncryptionKey_To_config_LocalEncryptionKey(in *LocalEncryptionKey, out *config.LocalEncryptionKey, s conversion.Scope) error {
+	return autoConvert_v1alpha1_LocalEncryptionKey_To_config_LocalEncryptionKey(in, out, s)
+}
+
+func autoConvert_config_LocalEncryptionKey_To_v1alpha1_LocalEncryptionKey(in *config.LocalEncryptionKey, out *LocalEncryptionKey, s conversion.Scope) error {
+	out.Name = in.Name
+	out.KeyFile = in.KeyFile
+	return nil
+}
+
+// Convert_config_LocalEncryptionKey_To_v1alpha1_LocalEncryptionKey is an autogenerated conversion function.
+func Convert_config_LocalEncryptionKey_To_v1alpha1_LocalEncryptionKey(in *config.LocalEncryptionKey, out *LocalEncryptionKey, s conversion.Scope) error {
+	return autoConvert_config_LocalEncryptionKey_To_v1alpha1_LocalEncryptionKey(in, out, s)
+}
+
 func autoConvert_v1alpha1_LocalRegistryConfiguration_To_config_LocalRegistryConfiguration(in *LocalRegistryConfiguration, out *config.LocalRegistryConfiguration, s conversion.Scope) error {
 	out.RootPath = in.RootPath
+	out.Watch = in.Watch
 	return nil
 }
 
@@ -671,6 +1466,7 @@ func Convert_v1alpha1_LocalRegistryConfiguration_To_config_LocalRegistryConfigur
 
 func autoConvert_config_LocalRegistryConfiguration_To_v1alpha1_LocalRegistryConfiguration(in *config.LocalRegistryConfiguration, out *LocalRegistryConfiguration, s conversion.Scope) error {
 	out.RootPath = in.RootPath
+	out.Watch = in.Watch
 	return nil
 }
 
@@ -729,6 +1525,28 @@ func Convert_config_MetricsConfiguration_To_v1alpha1_MetricsConfiguration(in *co
 	return autoConvert_config_MetricsConfiguration_To_v1alpha1_MetricsConfiguration(in, out, s)
 }
 
+func autoConvert_v1alpha1_OCICABundleReference_To_config_OCICABundleReference(in *OCICABundleReference, out *config.OCICABundleReference, s conversion.Scope) error {
+	out.File = in.File
+	out.SecretRef = (*core.SecretReference)(unsafe.Pointer(in.SecretRef))
+	return nil
+}
+
+// Convert_v1alpha1_OCICABundleReference_To_config_OCICABundleReference is an autogenerated conversion function.
+func Convert_v1alpha1_OCICABundleReference_To_config_OCICABundleReference(in *OCICABundleReference, out *config.OCICABundleReference, s conversion.Scope) error {
+	return autoConvert_v1alpha1_OCICABundleReference_To_config_OCICABundleReference(in, out, s)
+}
+
+func autoConvert_config_OCICABundleReference_To_v1alpha1_OCICABundleReference(in *config.OCICABundleReference, out *OCICABundleReference, s conversion.Scope) error {
+	out.File = in.File
+	out.SecretRef = (*corev1alpha1.SecretReference)(unsafe.Pointer(in.SecretRef))
+	return nil
+}
+
+// Convert_config_OCICABundleReference_To_v1alpha1_OCICABundleReference is an autogenerated conversion function.
+func Convert_config_OCICABundleReference_To_v1alpha1_OCICABundleReference(in *config.OCICABundleReference, out *OCICABundleReference, s conversion.Scope) error {
+	return autoConvert_config_OCICABundleReference_To_v1alpha1_OCICABundleReference(in, out, s)
+}
+
 func autoConvert_v1alpha1_OCICacheConfiguration_To_config_OCICacheConfiguration(in *OCICacheConfiguration, out *config.OCICacheConfiguration, s conversion.Scope) error {
 	out.UseInMemoryOverlay = in.UseInMemoryOverlay
 	out.Path = in.Path
@@ -756,6 +1574,8 @@ func autoConvert_v1alpha1_OCIConfiguration_To_config_OCIConfiguration(in *OCICon
 	out.Cache = (*config.OCICacheConfiguration)(unsafe.Pointer(in.Cache))
 	out.AllowPlainHttp = in.AllowPlainHttp
 	out.InsecureSkipVerify = in.InsecureSkipVerify
+	out.CredentialHelpers = *(*[]config.OCICredentialHelper)(unsafe.Pointer(&in.CredentialHelpers))
+	out.Registries = *(*[]config.OCIRegistryConfiguration)(unsafe.Pointer(&in.Registries))
 	return nil
 }
 
@@ -769,6 +1589,8 @@ func autoConvert_config_OCIConfiguration_To_v1alpha1_OCIConfiguration(in *config
 	out.Cache = (*OCICacheConfiguration)(unsafe.Pointer(in.Cache))
 	out.AllowPlainHttp = in.AllowPlainHttp
 	out.InsecureSkipVerify = in.InsecureSkipVerify
+	out.CredentialHelpers = *(*[]OCICredentialHelper)(unsafe.Pointer(&in.CredentialHelpers))
+	out.Registries = *(*[]OCIRegistryConfiguration)(unsafe.Pointer(&in.Registries))
 	return nil
 }
 
@@ -777,9 +1599,60 @@ func Convert_config_OCIConfiguration_To_v1alpha1_OCIConfiguration(in *config.OCI
 	return autoConvert_config_OCIConfiguration_To_v1alpha1_OCIConfiguration(in, out, s)
 }
 
+func autoConvert_v1alpha1_OCICredentialHelper_To_config_OCICredentialHelper(in *OCICredentialHelper, out *config.OCICredentialHelper, s conversion.Scope) error {
+	out.Host = in.Host
+	out.Type = config.OCICredentialHelperType(in.Type)
+	out.Command = in.Command
+	return nil
+}
+
+// Convert_v1alpha1_OCICredentialHelper_To_config_OCICredentialHelper is an autogenerated conversion function.
+func Convert_v1alpha1_OCICredentialHelper_To_config_OCICredentialHelper(in *OCICredentialHelper, out *config.OCICredentialHelper, s conversion.Scope) error {
+	return autoConvert_v1alpha1_OCICredentialHelper_To_config_OCICredentialHelper(in, out, s)
+}
+
+func autoConvert_config_OCICredentialHelper_To_v1alpha1_OCICredentialHelper(in *config.OCICredentialHelper, out *OCICredentialHelper, s conversion.Scope) error {
+	out.Host = in.Host
+	out.Type = OCICredentialHelperType(in.Type)
+	out.Command = in.Command
+	return nil
+}
+
+// Convert_config_OCICredentialHelper_To_v1alpha1_OCICredentialHelper is an autogenerated conversion function.
+func Convert_config_OCICredentialHelper_To_v1alpha1_OCICredentialHelper(in *config.OCICredentialHelper, out *OCICredentialHelper, s conversion.Scope) error {
+	return autoConvert_config_OCICredentialHelper_To_v1alpha1_OCICredentialHelper(in, out, s)
+}
+
+func autoConvert_v1alpha1_OCIRegistryConfiguration_To_config_OCIRegistryConfiguration(in *OCIRegistryConfiguration, out *config.OCIRegistryConfiguration, s conversion.Scope) error {
+	out.Host = in.Host
+	out.Proxy = in.Proxy
+	out.CABundle = (*config.OCICABundleReference)(unsafe.Pointer(in.CABundle))
+	return nil
+}
+
+// Convert_v1alpha1_OCIRegistryConfiguration_To_config_OCIRegistryConfiguration is an autogenerated conversion function.
+func Convert_v1alpha1_OCIRegistryConfiguration_To_config_OCIRegistryConfiguration(in *OCIRegistryConfiguration, out *config.OCIRegistryConfiguration, s conversion.Scope) error {
+	return autoConvert_v1alpha1_OCIRegistryConfiguration_To_config_OCIRegistryConfiguration(in, out, s)
+}
+
+func autoConvert_config_OCIRegistryConfiguration_To_v1alpha1_OCIRegistryConfiguration(in *config.OCIRegistryConfiguration, out *OCIRegistryConfiguration, s conversion.Scope) error {
+	out.Host = in.Host
+	out.Proxy = in.Proxy
+	out.CABundle = (*OCICABundleReference)(unsafe.Pointer(in.CABundle))
+	return nil
+}
+
+// Convert_config_OCIRegistryConfiguration_To_v1alpha1_OCIRegistryConfiguration is an autogenerated conversion function.
+func Convert_config_OCIRegistryConfiguration_To_v1alpha1_OCIRegistryConfiguration(in *config.OCIRegistryConfiguration, out *OCIRegistryConfiguration, s conversion.Scope) error {
+	return autoConvert_config_OCIRegistryConfiguration_To_v1alpha1_OCIRegistryConfiguration(in, out, s)
+}
+
 func autoConvert_v1alpha1_RegistryConfiguration_To_config_RegistryConfiguration(in *RegistryConfiguration, out *config.RegistryConfiguration, s conversion.Scope) error {
 	out.Local = (*config.LocalRegistryConfiguration)(unsafe.Pointer(in.Local))
 	out.OCI = (*config.OCIConfiguration)(unsafe.Pointer(in.OCI))
+	out.Git = (*config.GitRegistryConfiguration)(unsafe.Pointer(in.Git))
+	out.Archives = (*config.ArchiveRegistryConfiguration)(unsafe.Pointer(in.Archives))
+	out.ComponentVersionCache = (*config.ComponentVersionCacheConfiguration)(unsafe.Pointer(in.ComponentVersionCache))
 	return nil
 }
 
@@ -791,6 +1664,9 @@ func Convert_v1alpha1_RegistryConfiguration_To_config_RegistryConfiguration(in *
 func autoConvert_config_RegistryConfiguration_To_v1alpha1_RegistryConfiguration(in *config.RegistryConfiguration, out *RegistryConfiguration, s conversion.Scope) error {
 	out.Local = (*LocalRegistryConfiguration)(unsafe.Pointer(in.Local))
 	out.OCI = (*OCIConfiguration)(unsafe.Pointer(in.OCI))
+	out.Git = (*GitRegistryConfiguration)(unsafe.Pointer(in.Git))
+	out.Archives = (*ArchiveRegistryConfiguration)(unsafe.Pointer(in.Archives))
+	out.ComponentVersionCache = (*ComponentVersionCacheConfiguration)(unsafe.Pointer(in.ComponentVersionCache))
 	return nil
 }
 
@@ -798,3 +1674,143 @@ func autoConvert_config_RegistryConfiguration_To_v1alpha1_RegistryConfiguration(
 func Convert_config_RegistryConfiguration_To_v1alpha1_RegistryConfiguration(in *config.RegistryConfiguration, out *RegistryConfiguration, s conversion.Scope) error {
 	return autoConvert_config_RegistryConfiguration_To_v1alpha1_RegistryConfiguration(in, out, s)
 }
+
+func autoConvert_v1alpha1_SchemaStoreConfiguration_To_config_SchemaStoreConfiguration(in *SchemaStoreConfiguration, out *config.SchemaStoreConfiguration, s conversion.Scope) error {
+	out.AllowedRemoteURLs = *(*[]string)(unsafe.Pointer(&in.AllowedRemoteURLs))
+	out.RemoteCacheDuration = (*v1.Duration)(unsafe.Pointer(in.RemoteCacheDuration))
+	return nil
+}
+
+// Convert_v1alpha1_SchemaStoreConfiguration_To_config_SchemaStoreConfiguration is an autogenerated conversion function.
+func Convert_v1alpha1_SchemaStoreConfiguration_To_config_SchemaStoreConfiguration(in *SchemaStoreConfiguration, out *config.SchemaStoreConfiguration, s conversion.Scope) error {
+	return autoConvert_v1alpha1_SchemaStoreConfiguration_To_config_SchemaStoreConfiguration(in, out, s)
+}
+
+func autoConvert_config_SchemaStoreConfiguration_To_v1alpha1_SchemaStoreConfiguration(in *config.SchemaStoreConfiguration, out *SchemaStoreConfiguration, s conversion.Scope) error {
+	out.AllowedRemoteURLs = *(*[]string)(unsafe.Pointer(&in.AllowedRemoteURLs))
+	out.RemoteCacheDuration = (*v1.Duration)(unsafe.Pointer(in.RemoteCacheDuration))
+	return nil
+}
+
+// Convert_config_SchemaStoreConfiguration_To_v1alpha1_SchemaStoreConfiguration is an autogenerated conversion function.
+func Convert_config_SchemaStoreConfiguration_To_v1alpha1_SchemaStoreConfiguration(in *config.SchemaStoreConfiguration, out *SchemaStoreConfiguration, s conversion.Scope) error {
+	return autoConvert_config_SchemaStoreConfiguration_To_v1alpha1_SchemaStoreConfiguration(in, out, s)
+}
+
+func autoConvert_v1alpha1_SignatureVerificationRule_To_config_SignatureVerificationRule(in *SignatureVerificationRule, out *config.SignatureVerificationRule, s conversion.Scope) error {
+	out.RepositoryBaseURL = in.RepositoryBaseURL
+	out.SignatureName = in.SignatureName
+	out.Mode = config.SignatureVerificationMode(in.Mode)
+	return nil
+}
+
+// Convert_v1alpha1_SignatureVerificationRule_To_config_SignatureVerificationRule is an autogenerated conversion function.
+func Convert_v1alpha1_SignatureVerificationRule_To_config_SignatureVerificationRule(in *SignatureVerificationRule, out *config.SignatureVerificationRule, s conversion.Scope) error {
+	return autoConvert_v1alpha1_SignatureVerificationRule_To_config_SignatureVerificationRule(in, out, s)
+}
+
+func autoConvert_config_SignatureVerificationRule_To_v1alpha1_SignatureVerificationRule(in *config.SignatureVerificationRule, out *SignatureVerificationRule, s conversion.Scope) error {
+	out.RepositoryBaseURL = in.RepositoryBaseURL
+	out.SignatureName = in.SignatureName
+	out.Mode = SignatureVerificationMode(in.Mode)
+	return nil
+}
+
+// Convert_config_SignatureVerificationRule_To_v1alpha1_SignatureVerificationRule is an autogenerated conversion function.
+func Convert_config_SignatureVerificationRule_To_v1alpha1_SignatureVerificationRule(in *config.SignatureVerificationRule, out *SignatureVerificationRule, s conversion.Scope) error {
+	return autoConvert_config_SignatureVerificationRule_To_v1alpha1_SignatureVerificationRule(in, out, s)
+}
+
+func autoConvert_v1alpha1_StartupPriorityConfiguration_To_config_StartupPriorityConfiguration(in *StartupPriorityConfiguration, out *config.StartupPriorityConfiguration, s conversion.Scope) error {
+	out.Timeout = (*v1.Duration)(unsafe.Pointer(in.Timeout))
+	return nil
+}
+
+// Convert_v1alpha1_StartupPriorityConfiguration_To_config_StartupPriorityConfiguration is an autogenerated conversion function.
+func Convert_v1alpha1_StartupPriorityConfiguration_To_config_StartupPriorityConfiguration(in *StartupPriorityConfiguration, out *config.StartupPriorityConfiguration, s conversion.Scope) error {
+	return autoConvert_v1alpha1_StartupPriorityConfiguration_To_config_StartupPriorityConfiguration(in, out, s)
+}
+
+func autoConvert_config_StartupPriorityConfiguration_To_v1alpha1_StartupPriorityConfiguration(in *config.StartupPriorityConfiguration, out *StartupPriorityConfiguration, s conversion.Scope) error {
+	out.Timeout = (*v1.Duration)(unsafe.Pointer(in.Timeout))
+	return nil
+}
+
+// Convert_config_StartupPriorityConfiguration_To_v1alpha1_StartupPriorityConfiguration is an autogenerated conversion function.
+func Convert_config_StartupPriorityConfiguration_To_v1alpha1_StartupPriorityConfiguration(in *config.StartupPriorityConfiguration, out *StartupPriorityConfiguration, s conversion.Scope) error {
+	return autoConvert_config_StartupPriorityConfiguration_To_v1alpha1_StartupPriorityConfiguration(in, out, s)
+}
+
+func autoConvert_v1alpha1_TargetClientConfig_To_config_TargetClientConfig(in *TargetClientConfig, out *config.TargetClientConfig, s conversion.Scope) error {
+	out.QPS = in.QPS
+	out.Burst = in.Burst
+	out.ApplyBatchSize = in.ApplyBatchSize
+	out.CheckResourceQuotas = in.CheckResourceQuotas
+	out.LandscapeInstanceID = in.LandscapeInstanceID
+	out.Inventory = (*config.InventoryConfig)(unsafe.Pointer(in.Inventory))
+	out.RejectLocalCredentials = in.RejectLocalCredentials
+	return nil
+}
+
+// Convert_v1alpha1_TargetClientConfig_To_config_TargetClientConfig is an autogenerated conversion function.
+func Convert_v1alpha1_TargetClientConfig_To_config_TargetClientConfig(in *TargetClientConfig, out *config.TargetClientConfig, s conversion.Scope) error {
+	return autoConvert_v1alpha1_TargetClientConfig_To_config_TargetClientConfig(in, out, s)
+}
+
+func autoConvert_config_TargetClientConfig_To_v1alpha1_TargetClientConfig(in *config.TargetClientConfig, out *TargetClientConfig, s conversion.Scope) error {
+	out.QPS = in.QPS
+	out.Burst = in.Burst
+	out.ApplyBatchSize = in.ApplyBatchSize
+	out.CheckResourceQuotas = in.CheckResourceQuotas
+	out.LandscapeInstanceID = in.LandscapeInstanceID
+	out.Inventory = (*InventoryConfig)(unsafe.Pointer(in.Inventory))
+	out.RejectLocalCredentials = in.RejectLocalCredentials
+	return nil
+}
+
+// Convert_config_TargetClientConfig_To_v1alpha1_TargetClientConfig is an autogenerated conversion function.
+func Convert_config_TargetClientConfig_To_v1alpha1_TargetClientConfig(in *config.TargetClientConfig, out *TargetClientConfig, s conversion.Scope) error {
+	return autoConvert_config_TargetClientConfig_To_v1alpha1_TargetClientConfig(in, out, s)
+}
+
+func autoConvert_v1alpha1_WriteAuditConfiguration_To_config_WriteAuditConfiguration(in *WriteAuditConfiguration, out *config.WriteAuditConfiguration, s conversion.Scope) error {
+	out.Events = in.Events
+	out.History = (*config.WriteAuditHistoryConfiguration)(unsafe.Pointer(in.History))
+	return nil
+}
+
+// Convert_v1alpha1_WriteAuditConfiguration_To_config_WriteAuditConfiguration is an autogenerated conversion function.
+func Convert_v1alpha1_WriteAuditConfiguration_To_config_WriteAuditConfiguration(in *WriteAuditConfiguration, out *config.WriteAuditConfiguration, s conversion.Scope) error {
+	return autoConvert_v1alpha1_WriteAuditConfiguration_To_config_WriteAuditConfiguration(in, out, s)
+}
+
+func autoConvert_config_WriteAuditConfiguration_To_v1alpha1_WriteAuditConfiguration(in *config.WriteAuditConfiguration, out *WriteAuditConfiguration, s conversion.Scope) error {
+	out.Events = in.Events
+	out.History = (*WriteAuditHistoryConfiguration)(unsafe.Pointer(in.History))
+	return nil
+}
+
+// Convert_config_WriteAuditConfiguration_To_v1alpha1_WriteAuditConfiguration is an autogenerated conversion function.
+func Convert_config_WriteAuditConfiguration_To_v1alpha1_WriteAuditConfiguration(in *config.WriteAuditConfiguration, out *WriteAuditConfiguration, s conversion.Scope) error {
+	return autoConvert_config_WriteAuditConfiguration_To_v1alpha1_WriteAuditConfiguration(in, out, s)
+}
+
+func autoConvert_v1alpha1_WriteAuditHistoryConfiguration_To_config_WriteAuditHistoryConfiguration(in *WriteAuditHistoryConfiguration, out *config.WriteAuditHistoryConfiguration, s conversion.Scope) error {
+	out.MaxEntries = in.MaxEntries
+	return nil
+}
+
+// Convert_v1alpha1_WriteAuditHistoryConfiguration_To_config_WriteAuditHistoryConfiguration is an autogenerated conversion function.
+func Convert_v1alpha1_WriteAuditHistoryConfiguration_To_config_WriteAuditHistoryConfiguration(in *WriteAuditHistoryConfiguration, out *config.WriteAuditHistoryConfiguration, s conversion.Scope) error {
+	return autoConvert_v1alpha1_WriteAuditHistoryConfiguration_To_config_WriteAuditHistoryConfiguration(in, out, s)
+}
+
+func autoConvert_config_WriteAuditHistoryConfiguration_To_v1alpha1_WriteAuditHistoryConfiguration(in *config.WriteAuditHistoryConfiguration, out *WriteAuditHistoryConfiguration, s conversion.Scope) error {
+	out.MaxEntries = in.MaxEntries
+	return nil
+}
+
+// Convert_config_WriteAuditHistoryConfiguration_To_v1alpha1_WriteAuditHistoryConfiguration is an autogenerated conversion function.
+func Convert_config_WriteAuditHistoryConfiguration_To_v1alpha1_WriteAuditHistoryConfiguration(in *config.WriteAuditHistoryConfiguration, out *WriteAuditHistoryConfiguration, s conversion.Scope) error {
+	return autoConvert_config_WriteAuditHistoryConfiguration_To_v1alpha1_WriteAuditHistoryConfiguration(in, out, s)
+}
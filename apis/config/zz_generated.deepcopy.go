@@ -36,9 +36,120 @@ func (in *AdditionalDeployments) DeepCopy() *AdditionalDeployments {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ApprovalHookConfiguration) DeepCopyInto(out *ApprovalHookConfiguration) {
+	*out = *in
+	if in.Phases != nil {
+		in, out := &in.Phases, &out.Phases
+		*out = make([]core.InstallationPhase, len(*in))
+		copy(*out, *in)
+	}
+	if in.ContextSelector != nil {
+		in, out := &in.ContextSelector, &out.ContextSelector
+		*out = new(v1.LabelSelector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Timeout != nil {
+		in, out := &in.Timeout, &out.Timeout
+		*out = new(core.Duration)
+		**out = **in
+	}
+	in.Webhook.DeepCopyInto(&out.Webhook)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ApprovalHookConfiguration.
+func (in *ApprovalHookConfiguration) DeepCopy() *ApprovalHookConfiguration {
+	if in == nil {
+		return nil
+	}
+	out := new(ApprovalHookConfiguration)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ApprovalWebhookConfiguration) DeepCopyInto(out *ApprovalWebhookConfiguration) {
+	*out = *in
+	if in.AuthSecretRef != nil {
+		in, out := &in.AuthSecretRef, &out.AuthSecretRef
+		*out = new(core.SecretReference)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ApprovalWebhookConfiguration.
+func (in *ApprovalWebhookConfiguration) DeepCopy() *ApprovalWebhookConfiguration {
+	if in == nil {
+		return nil
+	}
+	out := new(ApprovalWebhookConfiguration)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ArchiveConfiguration) DeepCopyInto(out *ArchiveConfiguration) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ArchiveConfiguration.
+func (in *ArchiveConfiguration) DeepCopy() *ArchiveConfiguration {
+	if in == nil {
+		return nil
+	}
+	out := new(ArchiveConfiguration)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ArchiveRegistryConfiguration) DeepCopyInto(out *ArchiveRegistryConfiguration) {
+	*out = *in
+	if in.Archives != nil {
+		in, out := &in.Archives, &out.Archives
+		*out = make([]ArchiveConfiguration, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ArchiveRegistryConfiguration.
+func (in *ArchiveRegistryConfiguration) DeepCopy() *ArchiveRegistryConfiguration {
+	if in == nil {
+		return nil
+	}
+	out := new(ArchiveRegistryConfiguration)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BlueprintBlobCacheConfiguration) DeepCopyInto(out *BlueprintBlobCacheConfiguration) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BlueprintBlobCacheConfiguration.
+func (in *BlueprintBlobCacheConfiguration) DeepCopy() *BlueprintBlobCacheConfiguration {
+	if in == nil {
+		return nil
+	}
+	out := new(BlueprintBlobCacheConfiguration)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *BlueprintStore) DeepCopyInto(out *BlueprintStore) {
 	*out = *in
+	if in.BlobCache != nil {
+		in, out := &in.BlobCache, &out.BlobCache
+		*out = new(BlueprintBlobCacheConfiguration)
+		**out = **in
+	}
 	out.GarbageCollectionConfiguration = in.GarbageCollectionConfiguration
 	return
 }
@@ -53,6 +164,48 @@ func (in *BlueprintStore) DeepCopy() *BlueprintStore {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ClusterConfiguration) DeepCopyInto(out *ClusterConfiguration) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterConfiguration.
+func (in *ClusterConfiguration) DeepCopy() *ClusterConfiguration {
+	if in == nil {
+		return nil
+	}
+	out := new(ClusterConfiguration)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ClustersConfiguration) DeepCopyInto(out *ClustersConfiguration) {
+	*out = *in
+	if in.ResourceCluster != nil {
+		in, out := &in.ResourceCluster, &out.ResourceCluster
+		*out = new(ClusterConfiguration)
+		**out = **in
+	}
+	if in.HostCluster != nil {
+		in, out := &in.HostCluster, &out.HostCluster
+		*out = new(ClusterConfiguration)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClustersConfiguration.
+func (in *ClustersConfiguration) DeepCopy() *ClustersConfiguration {
+	if in == nil {
+		return nil
+	}
+	out := new(ClustersConfiguration)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *CommonControllerConfig) DeepCopyInto(out *CommonControllerConfig) {
 	*out = *in
@@ -74,6 +227,48 @@ func (in *CommonControllerConfig) DeepCopy() *CommonControllerConfig {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ComponentOverwritesConfiguration) DeepCopyInto(out *ComponentOverwritesConfiguration) {
+	*out = *in
+	if in.References != nil {
+		in, out := &in.References, &out.References
+		*out = make([]core.ObjectReference, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ComponentOverwritesConfiguration.
+func (in *ComponentOverwritesConfiguration) DeepCopy() *ComponentOverwritesConfiguration {
+	if in == nil {
+		return nil
+	}
+	out := new(ComponentOverwritesConfiguration)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ComponentVersionCacheConfiguration) DeepCopyInto(out *ComponentVersionCacheConfiguration) {
+	*out = *in
+	if in.TTL != nil {
+		in, out := &in.TTL, &out.TTL
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ComponentVersionCacheConfiguration.
+func (in *ComponentVersionCacheConfiguration) DeepCopy() *ComponentVersionCacheConfiguration {
+	if in == nil {
+		return nil
+	}
+	out := new(ComponentVersionCacheConfiguration)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ContextControllerConfig) DeepCopyInto(out *ContextControllerConfig) {
 	*out = *in
@@ -193,6 +388,11 @@ func (in *DeployItemTimeouts) DeepCopyInto(out *DeployItemTimeouts) {
 		*out = new(core.Duration)
 		**out = **in
 	}
+	if in.ProgressingDefault != nil {
+		in, out := &in.ProgressingDefault, &out.ProgressingDefault
+		*out = new(core.Duration)
+		**out = **in
+	}
 	if in.Abort != nil {
 		in, out := &in.Abort, &out.Abort
 		*out = new(core.Duration)
@@ -228,6 +428,32 @@ func (in *DeployItemsController) DeepCopy() *DeployItemsController {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *EncryptionConfiguration) DeepCopyInto(out *EncryptionConfiguration) {
+	*out = *in
+	if in.Local != nil {
+		in, out := &in.Local, &out.Local
+		*out = new(LocalEncryptionConfiguration)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.KMS != nil {
+		in, out := &in.KMS, &out.KMS
+		*out = new(KMSEncryptionConfiguration)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EncryptionConfiguration.
+func (in *EncryptionConfiguration) DeepCopy() *EncryptionConfiguration {
+	if in == nil {
+		return nil
+	}
+	out := new(EncryptionConfiguration)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ExecutionsController) DeepCopyInto(out *ExecutionsController) {
 	*out = *in
@@ -245,6 +471,22 @@ func (in *ExecutionsController) DeepCopy() *ExecutionsController {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *FeatureGates) DeepCopyInto(out *FeatureGates) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FeatureGates.
+func (in *FeatureGates) DeepCopy() *FeatureGates {
+	if in == nil {
+		return nil
+	}
+	out := new(FeatureGates)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *GarbageCollectionConfiguration) DeepCopyInto(out *GarbageCollectionConfiguration) {
 	*out = *in
@@ -262,6 +504,71 @@ func (in *GarbageCollectionConfiguration) DeepCopy() *GarbageCollectionConfigura
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GitAuthConfiguration) DeepCopyInto(out *GitAuthConfiguration) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GitAuthConfiguration.
+func (in *GitAuthConfiguration) DeepCopy() *GitAuthConfiguration {
+	if in == nil {
+		return nil
+	}
+	out := new(GitAuthConfiguration)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GitRegistryConfiguration) DeepCopyInto(out *GitRegistryConfiguration) {
+	*out = *in
+	if in.Repositories != nil {
+		in, out := &in.Repositories, &out.Repositories
+		*out = make([]GitRepositoryConfiguration, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Interval != nil {
+		in, out := &in.Interval, &out.Interval
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GitRegistryConfiguration.
+func (in *GitRegistryConfiguration) DeepCopy() *GitRegistryConfiguration {
+	if in == nil {
+		return nil
+	}
+	out := new(GitRegistryConfiguration)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GitRepositoryConfiguration) DeepCopyInto(out *GitRepositoryConfiguration) {
+	*out = *in
+	if in.Auth != nil {
+		in, out := &in.Auth, &out.Auth
+		*out = new(GitAuthConfiguration)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GitRepositoryConfiguration.
+func (in *GitRepositoryConfiguration) DeepCopy() *GitRepositoryConfiguration {
+	if in == nil {
+		return nil
+	}
+	out := new(GitRepositoryConfiguration)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *HPAMainConfiguration) DeepCopyInto(out *HPAMainConfiguration) {
 	*out = *in
@@ -278,10 +585,36 @@ func (in *HPAMainConfiguration) DeepCopy() *HPAMainConfiguration {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *HTTPImportsConfiguration) DeepCopyInto(out *HTTPImportsConfiguration) {
+	*out = *in
+	if in.AllowedHosts != nil {
+		in, out := &in.AllowedHosts, &out.AllowedHosts
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HTTPImportsConfiguration.
+func (in *HTTPImportsConfiguration) DeepCopy() *HTTPImportsConfiguration {
+	if in == nil {
+		return nil
+	}
+	out := new(HTTPImportsConfiguration)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *InstallationsController) DeepCopyInto(out *InstallationsController) {
 	*out = *in
 	in.CommonControllerConfig.DeepCopyInto(&out.CommonControllerConfig)
+	if in.StartupPriority != nil {
+		in, out := &in.StartupPriority, &out.StartupPriority
+		*out = new(StartupPriorityConfiguration)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -295,6 +628,69 @@ func (in *InstallationsController) DeepCopy() *InstallationsController {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *InventoryConfig) DeepCopyInto(out *InventoryConfig) {
+	*out = *in
+	if in.Interval != nil {
+		in, out := &in.Interval, &out.Interval
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	if in.MinAge != nil {
+		in, out := &in.MinAge, &out.MinAge
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	if in.ResourceTypes != nil {
+		in, out := &in.ResourceTypes, &out.ResourceTypes
+		*out = make([]InventoryResourceType, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InventoryConfig.
+func (in *InventoryConfig) DeepCopy() *InventoryConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(InventoryConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *InventoryResourceType) DeepCopyInto(out *InventoryResourceType) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InventoryResourceType.
+func (in *InventoryResourceType) DeepCopy() *InventoryResourceType {
+	if in == nil {
+		return nil
+	}
+	out := new(InventoryResourceType)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KMSEncryptionConfiguration) DeepCopyInto(out *KMSEncryptionConfiguration) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KMSEncryptionConfiguration.
+func (in *KMSEncryptionConfiguration) DeepCopy() *KMSEncryptionConfiguration {
+	if in == nil {
+		return nil
+	}
+	out := new(KMSEncryptionConfiguration)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *LandscaperConfiguration) DeepCopyInto(out *LandscaperConfiguration) {
 	*out = *in
@@ -327,6 +723,49 @@ func (in *LandscaperConfiguration) DeepCopyInto(out *LandscaperConfiguration) {
 		*out = new(HPAMainConfiguration)
 		**out = **in
 	}
+	if in.SignatureVerificationRules != nil {
+		in, out := &in.SignatureVerificationRules, &out.SignatureVerificationRules
+		*out = make([]SignatureVerificationRule, len(*in))
+		copy(*out, *in)
+	}
+	out.FeatureGates = in.FeatureGates
+	if in.ApprovalHooks != nil {
+		in, out := &in.ApprovalHooks, &out.ApprovalHooks
+		*out = make([]ApprovalHookConfiguration, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.WriteAudit != nil {
+		in, out := &in.WriteAudit, &out.WriteAudit
+		*out = new(WriteAuditConfiguration)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Clusters != nil {
+		in, out := &in.Clusters, &out.Clusters
+		*out = new(ClustersConfiguration)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.SchemaStore != nil {
+		in, out := &in.SchemaStore, &out.SchemaStore
+		*out = new(SchemaStoreConfiguration)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ComponentOverwrites != nil {
+		in, out := &in.ComponentOverwrites, &out.ComponentOverwrites
+		*out = new(ComponentOverwritesConfiguration)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Encryption != nil {
+		in, out := &in.Encryption, &out.Encryption
+		*out = new(EncryptionConfiguration)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.HTTPImports != nil {
+		in, out := &in.HTTPImports, &out.HTTPImports
+		*out = new(HTTPImportsConfiguration)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -348,6 +787,43 @@ func (in *LandscaperConfiguration) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LocalEncryptionConfiguration) DeepCopyInto(out *LocalEncryptionConfiguration) {
+	*out = *in
+	if in.Keys != nil {
+		in, out := &in.Keys, &out.Keys
+		*out = make([]LocalEncryptionKey, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LocalEncryptionConfiguration.
+func (in *LocalEncryptionConfiguration) DeepCopy() *LocalEncryptionConfiguration {
+	if in == nil {
+		return nil
+	}
+	out := new(LocalEncryptionConfiguration)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LocalEncryptionKey) DeepCopyInto(out *LocalEncryptionKey) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LocalEncryptionKey.
+func (in *LocalEncryptionKey) DeepCopy() *LocalEncryptionKey {
+	if in == nil {
+		return nil
+	}
+	out := new(LocalEncryptionKey)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *LocalRegistryConfiguration) DeepCopyInto(out *LocalRegistryConfiguration) {
 	*out = *in
@@ -401,6 +877,27 @@ func (in *MetricsConfiguration) DeepCopy() *MetricsConfiguration {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OCICABundleReference) DeepCopyInto(out *OCICABundleReference) {
+	*out = *in
+	if in.SecretRef != nil {
+		in, out := &in.SecretRef, &out.SecretRef
+		*out = new(core.SecretReference)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OCICABundleReference.
+func (in *OCICABundleReference) DeepCopy() *OCICABundleReference {
+	if in == nil {
+		return nil
+	}
+	out := new(OCICABundleReference)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *OCICacheConfiguration) DeepCopyInto(out *OCICacheConfiguration) {
 	*out = *in
@@ -430,6 +927,18 @@ func (in *OCIConfiguration) DeepCopyInto(out *OCIConfiguration) {
 		*out = new(OCICacheConfiguration)
 		**out = **in
 	}
+	if in.CredentialHelpers != nil {
+		in, out := &in.CredentialHelpers, &out.CredentialHelpers
+		*out = make([]OCICredentialHelper, len(*in))
+		copy(*out, *in)
+	}
+	if in.Registries != nil {
+		in, out := &in.Registries, &out.Registries
+		*out = make([]OCIRegistryConfiguration, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	return
 }
 
@@ -443,6 +952,43 @@ func (in *OCIConfiguration) DeepCopy() *OCIConfiguration {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OCICredentialHelper) DeepCopyInto(out *OCICredentialHelper) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OCICredentialHelper.
+func (in *OCICredentialHelper) DeepCopy() *OCICredentialHelper {
+	if in == nil {
+		return nil
+	}
+	out := new(OCICredentialHelper)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OCIRegistryConfiguration) DeepCopyInto(out *OCIRegistryConfiguration) {
+	*out = *in
+	if in.CABundle != nil {
+		in, out := &in.CABundle, &out.CABundle
+		*out = new(OCICABundleReference)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OCIRegistryConfiguration.
+func (in *OCIRegistryConfiguration) DeepCopy() *OCIRegistryConfiguration {
+	if in == nil {
+		return nil
+	}
+	out := new(OCIRegistryConfiguration)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *RegistryConfiguration) DeepCopyInto(out *RegistryConfiguration) {
 	*out = *in
@@ -456,6 +1002,21 @@ func (in *RegistryConfiguration) DeepCopyInto(out *RegistryConfiguration) {
 		*out = new(OCIConfiguration)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.Git != nil {
+		in, out := &in.Git, &out.Git
+		*out = new(GitRegistryConfiguration)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Archives != nil {
+		in, out := &in.Archives, &out.Archives
+		*out = new(ArchiveRegistryConfiguration)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ComponentVersionCache != nil {
+		in, out := &in.ComponentVersionCache, &out.ComponentVersionCache
+		*out = new(ComponentVersionCacheConfiguration)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -468,3 +1029,124 @@ func (in *RegistryConfiguration) DeepCopy() *RegistryConfiguration {
 	in.DeepCopyInto(out)
 	return out
 }
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SchemaStoreConfiguration) DeepCopyInto(out *SchemaStoreConfiguration) {
+	*out = *in
+	if in.AllowedRemoteURLs != nil {
+		in, out := &in.AllowedRemoteURLs, &out.AllowedRemoteURLs
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.RemoteCacheDuration != nil {
+		in, out := &in.RemoteCacheDuration, &out.RemoteCacheDuration
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SchemaStoreConfiguration.
+func (in *SchemaStoreConfiguration) DeepCopy() *SchemaStoreConfiguration {
+	if in == nil {
+		return nil
+	}
+	out := new(SchemaStoreConfiguration)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SignatureVerificationRule) DeepCopyInto(out *SignatureVerificationRule) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SignatureVerificationRule.
+func (in *SignatureVerificationRule) DeepCopy() *SignatureVerificationRule {
+	if in == nil {
+		return nil
+	}
+	out := new(SignatureVerificationRule)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *StartupPriorityConfiguration) DeepCopyInto(out *StartupPriorityConfiguration) {
+	*out = *in
+	if in.Timeout != nil {
+		in, out := &in.Timeout, &out.Timeout
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new StartupPriorityConfiguration.
+func (in *StartupPriorityConfiguration) DeepCopy() *StartupPriorityConfiguration {
+	if in == nil {
+		return nil
+	}
+	out := new(StartupPriorityConfiguration)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TargetClientConfig) DeepCopyInto(out *TargetClientConfig) {
+	*out = *in
+	if in.Inventory != nil {
+		in, out := &in.Inventory, &out.Inventory
+		*out = new(InventoryConfig)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TargetClientConfig.
+func (in *TargetClientConfig) DeepCopy() *TargetClientConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(TargetClientConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *WriteAuditConfiguration) DeepCopyInto(out *WriteAuditConfiguration) {
+	*out = *in
+	if in.History != nil {
+		in, out := &in.History, &out.History
+		*out = new(WriteAuditHistoryConfiguration)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WriteAuditConfiguration.
+func (in *WriteAuditConfiguration) DeepCopy() *WriteAuditConfiguration {
+	if in == nil {
+		return nil
+	}
+	out := new(WriteAuditConfiguration)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *WriteAuditHistoryConfiguration) DeepCopyInto(out *WriteAuditHistoryConfiguration) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WriteAuditHistoryConfiguration.
+func (in *WriteAuditHistoryConfiguration) DeepCopy() *WriteAuditHistoryConfiguration {
+	if in == nil {
+		return nil
+	}
+	out := new(WriteAuditHistoryConfiguration)
+	in.DeepCopyInto(out)
+	return out
+}
@@ -58,6 +58,14 @@ func addKnownTypes(scheme *runtime.Scheme) error {
 		&TargetSyncList{},
 		&CriticalProblems{},
 		&CriticalProblemsList{},
+		&DeployerRegistration{},
+		&DeployerRegistrationList{},
+		&TestRun{},
+		&TestRunList{},
+		&LandscapeHealth{},
+		&LandscapeHealthList{},
+		&TargetTypeDefinition{},
+		&TargetTypeDefinitionList{},
 	)
 	return nil
 }
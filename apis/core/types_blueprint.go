@@ -73,6 +73,12 @@ type Blueprint struct {
 	// +optional
 	Subinstallations SubinstallationTemplateList `json:"subinstallations,omitempty"`
 
+	// RenderStages defines stages that are sequentially templated before the subinstallation and deploy executions.
+	// The values of a stage are validated against the schema of the stage
+	// and are available in all subsequent stages and executions as "stages.<stage name>".
+	// +optional
+	RenderStages []RenderStage `json:"renderStages,omitempty"`
+
 	// SubinstallationExecutions defines the templating executors that are sequentially executed by the landscaper.
 	// The templates must return a list of installation templates.
 	// Both subinstallations and SubinstallationExecutions are valid options and will be merged.
@@ -87,6 +93,24 @@ type Blueprint struct {
 	// ExportExecutions defines the templating executors that are used to generate the exports.
 	// +optional
 	ExportExecutions []TemplateExecutor `json:"exportExecutions,omitempty"`
+
+	// FeatureFlags declares the named feature flags that are used by the blueprint.
+	// The value of a flag is taken from the context of the installation or, if it is not defined there, from its default.
+	// The resolved values are available in all templates as "featureFlags.<flag name>".
+	// +optional
+	FeatureFlags []FeatureFlagDefinition `json:"featureFlags,omitempty"`
+}
+
+// FeatureFlagDefinition declares a named feature flag of a blueprint.
+type FeatureFlagDefinition struct {
+	// Name is the unique name of the feature flag.
+	Name string `json:"name"`
+	// Default is the value of the feature flag if it is not defined by the context.
+	// +optional
+	Default bool `json:"default,omitempty"`
+	// Description describes the behavior that is toggled by the feature flag.
+	// +optional
+	Description string `json:"description,omitempty"`
 }
 
 // ImportDefinitionList defines a list of import defiinitions.
@@ -175,6 +199,22 @@ const GOTemplateType TemplateType = "GoTemplate"
 // SpiffTemplateType describes the spiff type.
 const SpiffTemplateType TemplateType = "Spiff"
 
+// CUETemplateType describes the cue templating type.
+const CUETemplateType TemplateType = "CUE"
+
+// RenderStage defines a stage of the blueprint rendering that computes intermediate values.
+type RenderStage struct {
+	// Name is the unique name of the stage.
+	Name string `json:"name"`
+	// Executions defines the templating executors of the stage that are sequentially executed.
+	// The templates must return the values of the stage in the field "values".
+	Executions []TemplateExecutor `json:"executions"`
+	// Schema is the jsonschema the values of the stage are validated against
+	// before they are passed to the next stage.
+	// +optional
+	Schema *JSONSchemaDefinition `json:"schema,omitempty"`
+}
+
 // TemplateExecutor describes a templating mechanism and configuration.
 type TemplateExecutor struct {
 	// Name is the unique name of the template
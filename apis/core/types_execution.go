@@ -102,6 +102,63 @@ type ExecutionStatus struct {
 	// TransitionTimes contains timestamps of status transitions
 	// +optional
 	TransitionTimes *TransitionTimes `json:"transitionTimes,omitempty"`
+
+	// Progress describes the progress of the deploy items in the current job.
+	// +optional
+	Progress *ExecutionProgress `json:"progress,omitempty"`
+
+	// DeferredDeployItems lists the deploy items of the current job whose update or deletion is deferred
+	// because one of their exclusion windows is active.
+	// +optional
+	DeferredDeployItems []DeferredDeployItem `json:"deferredDeployItems,omitempty"`
+
+	// DeployItemHashes contains the hashes of the deploy item templates with which the deploy items have been
+	// deployed the last time. A new job only redeploys the deploy items whose template or dependencies have changed,
+	// unless the partial redeployment is disabled in the landscaper configuration.
+	// +optional
+	DeployItemHashes []DeployItemHash `json:"deployItemHashes,omitempty"`
+
+	// RedeployAllDeployItems is set if the current job has been started by an explicit reconcile operation
+	// of the installation. The job then redeploys all deploy items, regardless of their hashes.
+	// +optional
+	RedeployAllDeployItems bool `json:"redeployAllDeployItems,omitempty"`
+}
+
+// ExecutionProgress describes the progress of the deploy items of an execution in the current job.
+type ExecutionProgress struct {
+	// Total is the number of deploy items of the execution.
+	Total int32 `json:"total"`
+
+	// Completed is the number of deploy items that have successfully finished the current job.
+	Completed int32 `json:"completed"`
+
+	// Failed is the number of deploy items that have failed in the current job.
+	Failed int32 `json:"failed"`
+
+	// Running is the number of deploy items that are currently processed by a deployer.
+	Running int32 `json:"running"`
+
+	// DeployItems contains the progress of the single deploy items.
+	// +optional
+	DeployItems []DeployItemProgress `json:"deployItems,omitempty"`
+}
+
+// DeployItemProgress describes the progress of a deploy item of an execution in the current job.
+type DeployItemProgress struct {
+	// Name is the name of the deploy item in the execution.
+	Name string `json:"name"`
+
+	// Phase is the phase of the deploy item. It is empty if the deploy item has not yet been started in the current job.
+	// +optional
+	Phase DeployItemPhase `json:"phase,omitempty"`
+
+	// StartTime is the time when the deploy item was started in the current job.
+	// +optional
+	StartTime *metav1.Time `json:"startTime,omitempty"`
+
+	// FinishedTime is the time when the deploy item has finished the current job.
+	// +optional
+	FinishedTime *metav1.Time `json:"finishedTime,omitempty"`
 }
 
 // DeployItemTemplateList is a list of deploy item templates
@@ -136,13 +193,46 @@ type DeployItemTemplate struct {
 	// Defaults to ten minutes if not specified.
 	// +optional
 	Timeout *Duration `json:"timeout,omitempty"`
+	// PickupTimeout overwrites the globally configured pickup timeout for this deploy item.
+	// It specifies how long a deployer may take to start processing the deploy item before it is marked as failed.
+	// Value has to be parsable by time.ParseDuration (or 'none' to deactivate the timeout).
+	// +optional
+	PickupTimeout *Duration `json:"pickupTimeout,omitempty"`
+	// AbortTimeout overwrites the globally configured abort timeout for this deploy item.
+	// It specifies how long the deployer may take to finish the deploy item after its timeout has been exceeded,
+	// before it is marked as failed.
+	// Value has to be parsable by time.ParseDuration (or 'none' to deactivate the timeout).
+	// +optional
+	AbortTimeout *Duration `json:"abortTimeout,omitempty"`
 
 	// UpdateOnChangeOnly specifies if redeployment is executed only if the specification of the deploy item has changed.
 	// +optional
 	UpdateOnChangeOnly bool `json:"updateOnChangeOnly,omitempty"`
 
+	// ConsumedImports lists the imports of the installation that are consumed by the deploy item.
+	// It is only set if partial import updates are enabled for the installation.
+	// +optional
+	ConsumedImports []string `json:"consumedImports,omitempty"`
+
+	// ConsumedImportsHash is the hash of the values of the consumed imports.
+	// It changes the specification of the deploy item whenever a consumed import changes,
+	// even if the templated configuration remains the same, e.g. because only the referenced target has changed.
+	// +optional
+	ConsumedImportsHash string `json:"consumedImportsHash,omitempty"`
+
 	// OnDelete specifies particular setting when deleting a deploy item
 	OnDelete *OnDeleteConfig `json:"onDelete,omitempty"`
+
+	// ExclusionWindows define recurring time windows during which an already deployed deploy item is neither updated
+	// nor deleted. Updates and deletions are deferred until the window has ended, while the other deploy items
+	// of the execution proceed.
+	// +optional
+	ExclusionWindows []ExclusionWindow `json:"exclusionWindows,omitempty"`
+
+	// RetryPolicy defines how often and after which intervals the deployer retries a failed reconcile or deletion
+	// of the deploy item. Without a retry policy, failed operations are retried until the deploy item times out.
+	// +optional
+	RetryPolicy *RetryPolicy `json:"retryPolicy,omitempty"`
 }
 
 // OnDeleteConfig specifies particular setting when deleting a deploy item
@@ -153,6 +243,85 @@ type OnDeleteConfig struct {
 	SkipUninstallIfClusterRemoved bool `json:"skipUninstallIfClusterRemoved,omitempty"`
 }
 
+// ExclusionWindow defines a recurring time window during which a deploy item must not be updated or deleted.
+type ExclusionWindow struct {
+	// Days are the weekdays on which the window begins, e.g. "Monday".
+	// The window begins every day if no days are given.
+	// +optional
+	Days []string `json:"days,omitempty"`
+
+	// Begin is the time of day in the format "HH:MM" at which the window begins.
+	Begin string `json:"begin"`
+
+	// End is the time of day in the format "HH:MM" at which the window ends.
+	// If the end is not after the begin, the window ends on the following day.
+	End string `json:"end"`
+
+	// TimeZone is the name of the time zone of begin and end, e.g. "Europe/Berlin".
+	// Defaults to UTC.
+	// +optional
+	TimeZone string `json:"timeZone,omitempty"`
+}
+
+// RetryPolicy defines how a deploy item is retried after a reconcile or deletion has failed.
+type RetryPolicy struct {
+	// MaxRetries is the maximal number of retries of a failed operation.
+	// The deploy item fails if the operation still fails after the last retry.
+	MaxRetries int32 `json:"maxRetries"`
+
+	// InitialBackoff is the interval before the first retry. It is doubled with every further retry.
+	// Defaults to 5 seconds.
+	// +optional
+	InitialBackoff *Duration `json:"initialBackoff,omitempty"`
+
+	// MaxBackoff is the maximal interval between two retries. Defaults to 5 minutes.
+	// +optional
+	MaxBackoff *Duration `json:"maxBackoff,omitempty"`
+
+	// RetryableErrorCodes are the error codes of failures that are retried.
+	// Failures without one of these codes let the deploy item fail immediately.
+	// All failures except those with unrecoverable error codes are retried if no codes are given.
+	// +optional
+	RetryableErrorCodes []ErrorCode `json:"retryableErrorCodes,omitempty"`
+}
+
+// DeferredOperation is the operation of a deploy item that is deferred.
+type DeferredOperation string
+
+const (
+	// DeferredOperationUpdate is the deferred update of a deploy item.
+	DeferredOperationUpdate DeferredOperation = "Update"
+	// DeferredOperationDelete is the deferred deletion of a deploy item.
+	DeferredOperationDelete DeferredOperation = "Delete"
+)
+
+// DeployItemHash contains the hash of the deploy item template with which a deploy item has been deployed.
+type DeployItemHash struct {
+	// Name is the name of the deploy item in the execution.
+	Name string `json:"name"`
+	// Hash is the hash of the deploy item template.
+	Hash string `json:"hash"`
+	// JobID is the job of the execution in which the deploy item has been deployed.
+	JobID string `json:"jobID"`
+}
+
+// DeferredDeployItem describes a deploy item whose update or deletion is deferred because of an exclusion window.
+type DeferredDeployItem struct {
+	// Name is the name of the deploy item in the execution.
+	// It is empty for orphaned deploy items that are no longer part of the execution.
+	// +optional
+	Name string `json:"name,omitempty"`
+
+	// ObjectName is the name of the deploy item object.
+	ObjectName string `json:"objectName"`
+
+	// Operation is the deferred operation.
+	Operation DeferredOperation `json:"operation"`
+
+	// Until is the end of the active exclusion window, after which the operation is performed.
+	Until metav1.Time `json:"until"`
+}
+
 func (r *ExecutionSpec) UnmarshalJSON(data []byte) error {
 	type Alias ExecutionSpec
 	a := (*Alias)(r)
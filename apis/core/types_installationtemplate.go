@@ -47,6 +47,31 @@ type InstallationTemplate struct {
 	// Optimization contains settings to improve execution performance.
 	// +optional
 	Optimization *Optimization `json:"optimization,omitempty"`
+
+	// ForEach creates a subinstallation for every element of a list data import of the parent installation.
+	// +optional
+	ForEach *InstallationTemplateForEach `json:"forEach,omitempty"`
+
+	// IncludeIf is the name of a data import of the parent installation.
+	// The subinstallation is only created if the import is set to a value other than false, null, 0,
+	// an empty string, an empty list or an empty map.
+	// +optional
+	IncludeIf string `json:"includeIf,omitempty"`
+}
+
+// InstallationTemplateForEach defines the list data import of the parent installation
+// from which the subinstallations of a template are generated.
+type InstallationTemplateForEach struct {
+	// Import is the name of the data import of the parent installation that contains the list.
+	Import string `json:"import"`
+
+	// As is the name of the data import of the subinstallation that is set to the element of the list.
+	As string `json:"as"`
+
+	// Key is the name of a field of the list elements whose value is appended to the name of a subinstallation.
+	// If not set, the index of the element is appended.
+	// +optional
+	Key string `json:"key,omitempty"`
 }
 
 // InstallationTemplateList is a list of installation templates.
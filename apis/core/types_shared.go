@@ -162,6 +162,8 @@ const (
 	ErrorForInfoOnly ErrorCode = "ERR_FOR_INFO_ONLY"
 	// ErrorNoRetry indicates that no retry is required.
 	ErrorNoRetry ErrorCode = "ERR_NO_RETRY"
+	// ErrorQuotaExceeded indicates that the resource quotas of a target namespace do not admit the deployed resources.
+	ErrorQuotaExceeded ErrorCode = "ERR_QUOTA_EXCEEDED"
 )
 
 // Condition holds the information about the state of a resource.
@@ -207,7 +209,9 @@ const (
 	// If set it triggers a reconciliation for all dependent resources.
 	ReconcileOperation Operation = "reconcile"
 
-	// ForceReconcileOperation is currently not used.
+	// ForceReconcileOperation is an annotation for the landscaper to reconcile root installations like the
+	// ReconcileOperation. In contrast to it, all deploy items are redeployed, even if a reconcile reason is set.
+	// If set at a deploy item, the deployer creates or updates its resources without further checks.
 	ForceReconcileOperation Operation = "force-reconcile"
 
 	// InterruptOperation is the annotation to let the landscaper interrupt all currently running deploy items of an
@@ -368,4 +372,6 @@ type Optimization struct {
 	HasNoSiblingImports bool `json:"hasNoSiblingImports,omitempty"`
 	// set this on true if the installation does not export data to its siblings or has no siblings at all
 	HasNoSiblingExports bool `json:"hasNoSiblingExports,omitempty"`
+	// set this on true to redeploy only those deploy items whose specification or consumed imports have changed
+	PartialImportUpdates bool `json:"partialImportUpdates,omitempty"`
 }
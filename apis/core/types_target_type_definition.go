@@ -0,0 +1,45 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package core
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// TargetTypeDefinitionList contains a list of TargetTypeDefinitions
+type TargetTypeDefinitionList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []TargetTypeDefinition `json:"items"`
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+// +kubebuilder:resource:scope="Cluster",shortName=ttd,singular=targettypedefinition
+// +kubebuilder:printcolumn:name="Type",type=string,JSONPath=`.spec.type`
+// +kubebuilder:printcolumn:name="Age",type=date,JSONPath=`.metadata.creationTimestamp`
+
+// TargetTypeDefinition defines the JSON schema of the configuration of all targets of a type.
+// The configuration of targets of the type is validated against the schema when the targets are created or updated,
+// and when they are imported by installations.
+type TargetTypeDefinition struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	// Spec contains the specification of the target type.
+	Spec TargetTypeDefinitionSpec `json:"spec"`
+}
+
+// TargetTypeDefinitionSpec defines a target type and the schema of its configuration.
+type TargetTypeDefinitionSpec struct {
+	// Type is the type of the targets whose configuration is defined, e.g. "landscaper.gardener.cloud/kubernetes-cluster".
+	Type TargetType `json:"type"`
+
+	// Schema is the JSON schema of the configuration of the targets of the type.
+	// +kubebuilder:pruning:PreserveUnknownFields
+	// +kubebuilder:validation:Schemaless
+	Schema AnyJSON `json:"schema"`
+}
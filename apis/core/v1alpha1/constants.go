@@ -34,6 +34,10 @@ const (
 	// CacheHelmChartsAnnotation specifies if helm charts of an installation should be cached
 	CacheHelmChartsAnnotation = LandscaperDomain + "/cache-helm-charts"
 
+	// RequireReadinessAnnotation specifies that the exports of an installation are only published
+	// if the readiness checks of all its deploy items have succeeded.
+	RequireReadinessAnnotation = LandscaperDomain + "/require-readiness"
+
 	// DeleteIgnoreSuccessors is the annotation that specifies that an installation is deleted even if there
 	// are dependent installations.
 	DeleteIgnoreSuccessors = LandscaperDomain + "/delete-ignore-successors"
@@ -51,6 +55,18 @@ const (
 	// Will only have an effect if set to 'true'.
 	IgnoreAnnotation = LandscaperDomain + "/ignore"
 
+	// TraceImportsAnnotation can be used to record the decisions of the import resolution of an installation
+	// in a configmap. Will only have an effect if set to 'true'.
+	TraceImportsAnnotation = LandscaperDomain + "/trace-imports"
+
+	// RefreshBlueprintAnnotation can be used to invalidate the cached blueprints and component versions,
+	// so that the blueprint of an installation is resolved again. Will only have an effect if set to 'true'.
+	RefreshBlueprintAnnotation = LandscaperDomain + "/refresh-blueprint"
+
+	// ResumeImportsAnnotation can be used to release an installation from the quarantine that is caused by
+	// malformed import data, so that its imports are constructed again. Will only have an effect if set to 'true'.
+	ResumeImportsAnnotation = LandscaperDomain + "/resume-imports"
+
 	// TouchAnnotation can be used to trigger a reconciliation event for a landscaper resource.
 	TouchAnnotation = LandscaperDomain + "/touch"
 
@@ -64,12 +80,33 @@ const (
 	DeployerTargetNameAnnotation = LandscaperDomain + "/deployer-target-name"
 	NoTargetNameValue            = ".noTargetName"
 
+	// ManagedByDeployItemAnnotation is the annotation that the deployers add to all resources they apply to target
+	// clusters. It contains the namespace and name of the deploy item that manages the resource as "<namespace>/<name>".
+	ManagedByDeployItemAnnotation = LandscaperDomain + "/managed-by-deployitem"
+
+	// ManagedByExecutionAnnotation is the annotation that the deployers add to all resources they apply to target
+	// clusters. It contains the namespace and name of the execution of the managing deploy item as "<namespace>/<name>".
+	ManagedByExecutionAnnotation = LandscaperDomain + "/managed-by-execution"
+
+	// ManagedByInstallationAnnotation is the annotation that the deployers add to all resources they apply to target
+	// clusters. It contains the namespace and name of the installation of the managing deploy item as "<namespace>/<name>".
+	// The annotation is also set on deploy items by the execution controller.
+	ManagedByInstallationAnnotation = LandscaperDomain + "/managed-by-installation"
+
 	// Labels
 
 	// LandscaperComponentLabelName is the name of the labels the holds the information about landscaper components.
 	// This label should be set on landscaper related components like the landscaper controller or deployers.
 	LandscaperComponentLabelName = LandscaperDomain + "/component"
 
+	// LandscapeInstanceIDLabel is the label that the deployers add to all resources they apply to target clusters,
+	// if a landscape instance id is configured. It identifies the landscaper instance that manages the resources.
+	LandscapeInstanceIDLabel = LandscaperDomain + "/landscape-instance-id"
+
+	// StartupPriorityLabel marks namespaces and installations whose installations are reconciled before all other
+	// installations after a restart of the landscaper controller. The label has to be set to "true".
+	StartupPriorityLabel = LandscaperDomain + "/startup-priority"
+
 	// DeployerRegistrationLabelName is the name of the label that holds the reference to the deployer registration
 	// that installation originated from.
 	DeployerRegistrationLabelName = "deployers.landscaper.gardener.cloud/deployer-registration"
@@ -109,6 +146,43 @@ const (
 	PickupTimeoutReason      = "PickupTimeout"      // for error messages
 	PickupTimeoutOperation   = "WaitingForPickup"   // for error messages
 	ProgressingTimeoutReason = "ProgressingTimeout" // for error messages
+	AbortTimeoutReason       = "AbortTimeout"       // for error messages
+	AbortTimeoutOperation    = "WaitingForAbort"    // for error messages
+)
+
+// Error reasons that are set by the landscaper and the deployers.
+// The reasons are part of the error catalog, so that automation can rely on them.
+const (
+	// ImportNotFoundReason indicates that an import of an installation could not be found.
+	ImportNotFoundReason = "ImportNotFound"
+	// ImportNotSatisfiedReason indicates that an import of an installation is not satisfied.
+	ImportNotSatisfiedReason = "ImportNotSatisfied"
+	// InvalidDefaultValueReason indicates that the default value of an import is invalid.
+	InvalidDefaultValueReason = "InvalidDefaultValue"
+	// NotCompletedDependentsReason indicates that an installation this installation depends on is not completed.
+	NotCompletedDependentsReason = "NotCompletedDependents"
+	// SchemaValidationFailedReason indicates that an import does not match the schema of the blueprint.
+	SchemaValidationFailedReason = "SchemaValidationFailed"
+	// AmbiguousImportReason indicates that an import of an installation with strict import ownership
+	// is provided by another source than the recorded one.
+	AmbiguousImportReason = "AmbiguousImport"
+	// ImportValidationFailedReason indicates that the import executions or the validation of the imports failed.
+	ImportValidationFailedReason = "ImportValidationFailed"
+	// TemplatingFailedReason indicates that the templating of the deploy items or subinstallations failed.
+	TemplatingFailedReason = "TemplatingFailed"
+	// WaitingForApprovalReason indicates that an installation waits for the approval of its approval hook.
+	WaitingForApprovalReason = "WaitingForApproval"
+	// ApprovalNotGrantedReason indicates that the approval hook of an installation denied the approval.
+	ApprovalNotGrantedReason = "ApprovalNotGranted"
+	// QuotaExceededReason indicates that the resource quotas of a target namespace do not admit the deployed resources.
+	QuotaExceededReason = "QuotaExceeded"
+	// PruningNotAllowedReason indicates that resources are no longer defined in the manifests of a deploy item,
+	// but their pruning policy does not allow to delete or release them.
+	PruningNotAllowedReason = "PruningNotAllowed"
+	// AbortedReason indicates that the processing of an object was aborted by an abort or interrupt operation.
+	AbortedReason = "Aborted"
+	// CyclicDependencyReason indicates that installations or deploy items depend on each other.
+	CyclicDependencyReason = "CyclicDependency"
 )
 
 // define common constants for phase names here, so all phases which use any of them
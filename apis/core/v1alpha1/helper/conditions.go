@@ -0,0 +1,146 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package helper
+
+import (
+	"github.com/gardener/landscaper/apis/core/v1alpha1"
+)
+
+const (
+	// ConditionReasonSucceeded is the reason of standard conditions that are true.
+	ConditionReasonSucceeded = "Succeeded"
+	// ConditionReasonFailed is the reason of standard conditions that are false because the current job has failed.
+	ConditionReasonFailed = "Failed"
+	// ConditionReasonPending is the reason of standard conditions whose check has not yet been completed.
+	ConditionReasonPending = "Pending"
+	// ConditionReasonProgressing is the reason of standard conditions whose check is running.
+	ConditionReasonProgressing = "Progressing"
+	// ConditionReasonDeleting is the reason of the deleted condition during the deletion.
+	ConditionReasonDeleting = "Deleting"
+	// ConditionReasonDeleteFailed is the reason of the deleted condition if the deletion has failed.
+	ConditionReasonDeleteFailed = "DeleteFailed"
+)
+
+// UpdateInstallationConditions updates the standard conditions of an installation after a change of its phase
+// from previousPhase to phase. The last error of the installation is used as message of failed conditions.
+func UpdateInstallationConditions(conditions []v1alpha1.Condition, previousPhase, phase v1alpha1.InstallationPhase,
+	lastError *v1alpha1.Error) []v1alpha1.Condition {
+
+	phases := v1alpha1.InstallationPhases
+	switch phase {
+	case phases.Init:
+		conditions = setReconciledInProgress(conditions, string(phase), lastError)
+		if lastError != nil {
+			conditions = CreateOrUpdateConditions(conditions, v1alpha1.ImportsSatisfiedCondition, v1alpha1.ConditionFalse,
+				ConditionReasonPending, lastError.Message, lastError.Codes...)
+		}
+	case phases.CleanupOrphaned, phases.ObjectsCreated, phases.Progressing:
+		conditions = setReconciledInProgress(conditions, string(phase), lastError)
+		conditions = CreateOrUpdateConditions(conditions, v1alpha1.ImportsSatisfiedCondition, v1alpha1.ConditionTrue,
+			ConditionReasonSucceeded, "The imports are satisfied.")
+		conditions = CreateOrUpdateConditions(conditions, v1alpha1.DeployItemsHealthyCondition, v1alpha1.ConditionUnknown,
+			ConditionReasonProgressing, "The executions and subinstallations are being processed.")
+	case phases.Completing:
+		conditions = setReconciledInProgress(conditions, string(phase), lastError)
+		conditions = CreateOrUpdateConditions(conditions, v1alpha1.DeployItemsHealthyCondition, v1alpha1.ConditionTrue,
+			ConditionReasonSucceeded, "The executions and subinstallations have succeeded.")
+	case phases.Succeeded:
+		conditions = CreateOrUpdateConditions(conditions, v1alpha1.ReconciledCondition, v1alpha1.ConditionTrue,
+			ConditionReasonSucceeded, "The installation has been reconciled successfully.")
+		conditions = CreateOrUpdateConditions(conditions, v1alpha1.ImportsSatisfiedCondition, v1alpha1.ConditionTrue,
+			ConditionReasonSucceeded, "The imports are satisfied.")
+		conditions = CreateOrUpdateConditions(conditions, v1alpha1.DeployItemsHealthyCondition, v1alpha1.ConditionTrue,
+			ConditionReasonSucceeded, "The executions and subinstallations have succeeded.")
+		conditions = CreateOrUpdateConditions(conditions, v1alpha1.ExportsReadyCondition, v1alpha1.ConditionTrue,
+			ConditionReasonSucceeded, "The exports are up-to-date.")
+	case phases.Failed:
+		conditions = setFailed(conditions, v1alpha1.ReconciledCondition, lastError, "The installation has failed.")
+		switch previousPhase {
+		case phases.Init:
+			conditions = setFailed(conditions, v1alpha1.ImportsSatisfiedCondition, lastError, "The imports are not satisfied.")
+		case phases.CleanupOrphaned, phases.ObjectsCreated, phases.Progressing:
+			conditions = setFailed(conditions, v1alpha1.DeployItemsHealthyCondition, lastError, "The executions or subinstallations have failed.")
+		}
+		conditions = CreateOrUpdateConditions(conditions, v1alpha1.ExportsReadyCondition, v1alpha1.ConditionFalse,
+			ConditionReasonFailed, "The exports are outdated, because the installation has failed.")
+	case phases.InitDelete, phases.TriggerDelete, phases.Deleting, phases.DeleteFailed:
+		conditions = setDeletionConditions(conditions, phase == phases.DeleteFailed, lastError)
+	}
+	return conditions
+}
+
+// UpdateExecutionConditions updates the standard conditions of an execution after a change of its phase
+// from previousPhase to phase. The last error of the execution is used as message of failed conditions.
+func UpdateExecutionConditions(conditions []v1alpha1.Condition, previousPhase, phase v1alpha1.ExecutionPhase,
+	lastError *v1alpha1.Error) []v1alpha1.Condition {
+
+	phases := v1alpha1.ExecutionPhases
+	switch phase {
+	case phases.Init:
+		conditions = setReconciledInProgress(conditions, string(phase), lastError)
+	case phases.Progressing:
+		conditions = setReconciledInProgress(conditions, string(phase), lastError)
+		conditions = CreateOrUpdateConditions(conditions, v1alpha1.DeployItemsHealthyCondition, v1alpha1.ConditionUnknown,
+			ConditionReasonProgressing, "The deploy items are being processed.")
+	case phases.Completing:
+		conditions = setReconciledInProgress(conditions, string(phase), lastError)
+		conditions = CreateOrUpdateConditions(conditions, v1alpha1.DeployItemsHealthyCondition, v1alpha1.ConditionTrue,
+			ConditionReasonSucceeded, "The deploy items have succeeded.")
+	case phases.Succeeded:
+		conditions = CreateOrUpdateConditions(conditions, v1alpha1.ReconciledCondition, v1alpha1.ConditionTrue,
+			ConditionReasonSucceeded, "The execution has been reconciled successfully.")
+		conditions = CreateOrUpdateConditions(conditions, v1alpha1.DeployItemsHealthyCondition, v1alpha1.ConditionTrue,
+			ConditionReasonSucceeded, "The deploy items have succeeded.")
+		conditions = CreateOrUpdateConditions(conditions, v1alpha1.ExportsReadyCondition, v1alpha1.ConditionTrue,
+			ConditionReasonSucceeded, "The exports are up-to-date.")
+	case phases.Failed:
+		conditions = setFailed(conditions, v1alpha1.ReconciledCondition, lastError, "The execution has failed.")
+		if previousPhase == phases.Progressing {
+			conditions = setFailed(conditions, v1alpha1.DeployItemsHealthyCondition, lastError, "The deploy items have failed.")
+		}
+		conditions = CreateOrUpdateConditions(conditions, v1alpha1.ExportsReadyCondition, v1alpha1.ConditionFalse,
+			ConditionReasonFailed, "The exports are outdated, because the execution has failed.")
+	case phases.InitDelete, phases.TriggerDelete, phases.Deleting, phases.DeleteFailed:
+		conditions = setDeletionConditions(conditions, phase == phases.DeleteFailed, lastError)
+	}
+	return conditions
+}
+
+// setReconciledInProgress sets the reconciled condition to false while a reconcile job is running.
+func setReconciledInProgress(conditions []v1alpha1.Condition, phase string, lastError *v1alpha1.Error) []v1alpha1.Condition {
+	message := "The reconcile job is running."
+	var codes []v1alpha1.ErrorCode
+	if lastError != nil {
+		message = lastError.Message
+		codes = lastError.Codes
+	}
+	return CreateOrUpdateConditions(conditions, v1alpha1.ReconciledCondition, v1alpha1.ConditionFalse, phase, message, codes...)
+}
+
+func setFailed(conditions []v1alpha1.Condition, condType v1alpha1.ConditionType, lastError *v1alpha1.Error,
+	defaultMessage string) []v1alpha1.Condition {
+
+	if lastError == nil {
+		return CreateOrUpdateConditions(conditions, condType, v1alpha1.ConditionFalse, ConditionReasonFailed, defaultMessage)
+	}
+	return CreateOrUpdateConditions(conditions, condType, v1alpha1.ConditionFalse, ConditionReasonFailed, lastError.Message, lastError.Codes...)
+}
+
+func setDeletionConditions(conditions []v1alpha1.Condition, failed bool, lastError *v1alpha1.Error) []v1alpha1.Condition {
+	if failed {
+		conditions = setFailed(conditions, v1alpha1.ReconciledCondition, lastError, "The deletion has failed.")
+		if lastError == nil {
+			return CreateOrUpdateConditions(conditions, v1alpha1.DeletedCondition, v1alpha1.ConditionFalse,
+				ConditionReasonDeleteFailed, "The deletion has failed.")
+		}
+		return CreateOrUpdateConditions(conditions, v1alpha1.DeletedCondition, v1alpha1.ConditionFalse,
+			ConditionReasonDeleteFailed, lastError.Message, lastError.Codes...)
+	}
+
+	conditions = CreateOrUpdateConditions(conditions, v1alpha1.ReconciledCondition, v1alpha1.ConditionFalse,
+		ConditionReasonDeleting, "The object is being deleted.")
+	return CreateOrUpdateConditions(conditions, v1alpha1.DeletedCondition, v1alpha1.ConditionFalse,
+		ConditionReasonDeleting, "The object is being deleted.")
+}
@@ -0,0 +1,108 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package helper_test
+
+import (
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gstruct"
+
+	"github.com/gardener/landscaper/apis/core/v1alpha1"
+	"github.com/gardener/landscaper/apis/core/v1alpha1/helper"
+)
+
+var _ = Describe("Standard conditions", func() {
+
+	status := func(conditions []v1alpha1.Condition, condType v1alpha1.ConditionType) v1alpha1.ConditionStatus {
+		cond := helper.GetCondition(conditions, condType)
+		if cond == nil {
+			return ""
+		}
+		return cond.Status
+	}
+
+	Context("Installation", func() {
+		phases := v1alpha1.InstallationPhases
+
+		It("should set all conditions to true when the installation has succeeded", func() {
+			conditions := helper.UpdateInstallationConditions(nil, phases.Completing, phases.Succeeded, nil)
+
+			Expect(status(conditions, v1alpha1.ReconciledCondition)).To(Equal(v1alpha1.ConditionTrue))
+			Expect(status(conditions, v1alpha1.ImportsSatisfiedCondition)).To(Equal(v1alpha1.ConditionTrue))
+			Expect(status(conditions, v1alpha1.DeployItemsHealthyCondition)).To(Equal(v1alpha1.ConditionTrue))
+			Expect(status(conditions, v1alpha1.ExportsReadyCondition)).To(Equal(v1alpha1.ConditionTrue))
+			Expect(status(conditions, v1alpha1.DeletedCondition)).To(BeEmpty())
+		})
+
+		It("should set the reconciled condition to false while a job is running", func() {
+			conditions := helper.UpdateInstallationConditions(nil, phases.Completing, phases.Succeeded, nil)
+			conditions = helper.UpdateInstallationConditions(conditions, phases.Succeeded, phases.Init, nil)
+
+			Expect(helper.GetCondition(conditions, v1alpha1.ReconciledCondition)).To(PointTo(MatchFields(IgnoreExtras, Fields{
+				"Status": Equal(v1alpha1.ConditionFalse),
+				"Reason": Equal(string(phases.Init)),
+			})))
+			Expect(status(conditions, v1alpha1.ImportsSatisfiedCondition)).To(Equal(v1alpha1.ConditionTrue))
+
+			conditions = helper.UpdateInstallationConditions(conditions, phases.ObjectsCreated, phases.Progressing, nil)
+			Expect(status(conditions, v1alpha1.DeployItemsHealthyCondition)).To(Equal(v1alpha1.ConditionUnknown))
+		})
+
+		It("should report the failed condition depending on the phase in which the installation has failed", func() {
+			lastError := &v1alpha1.Error{Message: "import not found", Codes: []v1alpha1.ErrorCode{v1alpha1.ErrorConfigurationProblem}}
+
+			conditions := helper.UpdateInstallationConditions(nil, phases.Init, phases.Failed, lastError)
+			Expect(helper.GetCondition(conditions, v1alpha1.ImportsSatisfiedCondition)).To(PointTo(MatchFields(IgnoreExtras, Fields{
+				"Status":  Equal(v1alpha1.ConditionFalse),
+				"Reason":  Equal(helper.ConditionReasonFailed),
+				"Message": Equal("import not found"),
+				"Codes":   ConsistOf(v1alpha1.ErrorConfigurationProblem),
+			})))
+			Expect(status(conditions, v1alpha1.ReconciledCondition)).To(Equal(v1alpha1.ConditionFalse))
+			Expect(status(conditions, v1alpha1.DeployItemsHealthyCondition)).To(BeEmpty())
+			Expect(status(conditions, v1alpha1.ExportsReadyCondition)).To(Equal(v1alpha1.ConditionFalse))
+
+			conditions = helper.UpdateInstallationConditions(nil, phases.Progressing, phases.Failed, lastError)
+			Expect(status(conditions, v1alpha1.DeployItemsHealthyCondition)).To(Equal(v1alpha1.ConditionFalse))
+			Expect(status(conditions, v1alpha1.ImportsSatisfiedCondition)).To(BeEmpty())
+		})
+
+		It("should set the deleted condition during the deletion", func() {
+			conditions := helper.UpdateInstallationConditions(nil, phases.Succeeded, phases.InitDelete, nil)
+			Expect(helper.GetCondition(conditions, v1alpha1.DeletedCondition)).To(PointTo(MatchFields(IgnoreExtras, Fields{
+				"Status": Equal(v1alpha1.ConditionFalse),
+				"Reason": Equal(helper.ConditionReasonDeleting),
+			})))
+
+			conditions = helper.UpdateInstallationConditions(conditions, phases.Deleting, phases.DeleteFailed, &v1alpha1.Error{Message: "stuck"})
+			Expect(helper.GetCondition(conditions, v1alpha1.DeletedCondition)).To(PointTo(MatchFields(IgnoreExtras, Fields{
+				"Status":  Equal(v1alpha1.ConditionFalse),
+				"Reason":  Equal(helper.ConditionReasonDeleteFailed),
+				"Message": Equal("stuck"),
+			})))
+		})
+	})
+
+	Context("Execution", func() {
+		phases := v1alpha1.ExecutionPhases
+
+		It("should maintain the conditions of an execution", func() {
+			conditions := helper.UpdateExecutionConditions(nil, phases.Init, phases.Progressing, nil)
+			Expect(status(conditions, v1alpha1.ReconciledCondition)).To(Equal(v1alpha1.ConditionFalse))
+			Expect(status(conditions, v1alpha1.DeployItemsHealthyCondition)).To(Equal(v1alpha1.ConditionUnknown))
+
+			conditions = helper.UpdateExecutionConditions(conditions, phases.Progressing, phases.Failed, &v1alpha1.Error{Message: "deploy item failed"})
+			Expect(status(conditions, v1alpha1.ReconciledCondition)).To(Equal(v1alpha1.ConditionFalse))
+			Expect(status(conditions, v1alpha1.DeployItemsHealthyCondition)).To(Equal(v1alpha1.ConditionFalse))
+			Expect(status(conditions, v1alpha1.ExportsReadyCondition)).To(Equal(v1alpha1.ConditionFalse))
+			Expect(status(conditions, v1alpha1.ImportsSatisfiedCondition)).To(BeEmpty())
+
+			conditions = helper.UpdateExecutionConditions(conditions, phases.Completing, phases.Succeeded, nil)
+			Expect(status(conditions, v1alpha1.ReconciledCondition)).To(Equal(v1alpha1.ConditionTrue))
+			Expect(status(conditions, v1alpha1.DeployItemsHealthyCondition)).To(Equal(v1alpha1.ConditionTrue))
+			Expect(status(conditions, v1alpha1.ExportsReadyCondition)).To(Equal(v1alpha1.ConditionTrue))
+		})
+	})
+})
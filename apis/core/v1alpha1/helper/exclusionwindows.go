@@ -0,0 +1,101 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package helper
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/gardener/landscaper/apis/core/v1alpha1"
+)
+
+// ParseTimeOfDay parses a time of day in the format "HH:MM" and returns the duration since midnight.
+func ParseTimeOfDay(value string) (time.Duration, error) {
+	t, err := time.Parse("15:04", value)
+	if err != nil {
+		return 0, fmt.Errorf("invalid time of day %q, expected the format HH:MM", value)
+	}
+	return time.Duration(t.Hour())*time.Hour + time.Duration(t.Minute())*time.Minute, nil
+}
+
+// ParseWeekday parses the name of a weekday, e.g. "Monday" or "Mon". The name is case-insensitive.
+func ParseWeekday(value string) (time.Weekday, error) {
+	for day := time.Sunday; day <= time.Saturday; day++ {
+		if strings.EqualFold(value, day.String()) || strings.EqualFold(value, day.String()[:3]) {
+			return day, nil
+		}
+	}
+	return 0, fmt.Errorf("invalid weekday %q", value)
+}
+
+// ActiveExclusionWindowEnd returns the end of the exclusion window that is active at the given time.
+// If several windows are active, the latest end is returned.
+// The second return value is false if no window is active.
+func ActiveExclusionWindowEnd(windows []v1alpha1.ExclusionWindow, now time.Time) (time.Time, bool, error) {
+	var (
+		end    time.Time
+		active bool
+	)
+	for _, window := range windows {
+		windowEnd, ok, err := exclusionWindowEnd(window, now)
+		if err != nil {
+			return time.Time{}, false, err
+		}
+		if ok && (!active || windowEnd.After(end)) {
+			end = windowEnd
+			active = true
+		}
+	}
+	return end, active, nil
+}
+
+// exclusionWindowEnd returns the end of the given window if it is active at the given time.
+// A window lasts at most one day, so that only the windows beginning on the current and on the previous day
+// can be active.
+func exclusionWindowEnd(window v1alpha1.ExclusionWindow, now time.Time) (time.Time, bool, error) {
+	begin, err := ParseTimeOfDay(window.Begin)
+	if err != nil {
+		return time.Time{}, false, err
+	}
+	end, err := ParseTimeOfDay(window.End)
+	if err != nil {
+		return time.Time{}, false, err
+	}
+	if end <= begin {
+		end += 24 * time.Hour
+	}
+
+	location := time.UTC
+	if len(window.TimeZone) != 0 {
+		location, err = time.LoadLocation(window.TimeZone)
+		if err != nil {
+			return time.Time{}, false, fmt.Errorf("invalid time zone %q: %w", window.TimeZone, err)
+		}
+	}
+
+	days := map[time.Weekday]bool{}
+	for _, value := range window.Days {
+		day, err := ParseWeekday(value)
+		if err != nil {
+			return time.Time{}, false, err
+		}
+		days[day] = true
+	}
+
+	local := now.In(location)
+	for _, offset := range []int{0, -1} {
+		midnight := time.Date(local.Year(), local.Month(), local.Day()+offset, 0, 0, 0, 0, location)
+		if len(days) != 0 && !days[midnight.Weekday()] {
+			continue
+		}
+		windowBegin := midnight.Add(begin)
+		windowEnd := midnight.Add(end)
+		if !now.Before(windowBegin) && now.Before(windowEnd) {
+			return windowEnd, true, nil
+		}
+	}
+	return time.Time{}, false, nil
+}
@@ -0,0 +1,77 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package helper_test
+
+import (
+	"time"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	"github.com/gardener/landscaper/apis/core/v1alpha1"
+	"github.com/gardener/landscaper/apis/core/v1alpha1/helper"
+)
+
+var _ = Describe("Exclusion windows", func() {
+
+	// 2024-06-15 is a Saturday
+	at := func(value string) time.Time {
+		t, err := time.Parse(time.RFC3339, value)
+		Expect(err).ToNot(HaveOccurred())
+		return t
+	}
+
+	It("should return the end of an active window", func() {
+		windows := []v1alpha1.ExclusionWindow{{Begin: "08:00", End: "10:00"}}
+
+		end, active, err := helper.ActiveExclusionWindowEnd(windows, at("2024-06-15T09:00:00Z"))
+		Expect(err).ToNot(HaveOccurred())
+		Expect(active).To(BeTrue())
+		Expect(end).To(BeTemporally("==", at("2024-06-15T10:00:00Z")))
+
+		_, active, err = helper.ActiveExclusionWindowEnd(windows, at("2024-06-15T10:00:00Z"))
+		Expect(err).ToNot(HaveOccurred())
+		Expect(active).To(BeFalse())
+	})
+
+	It("should handle windows that end on the following day", func() {
+		windows := []v1alpha1.ExclusionWindow{{Days: []string{"Fri"}, Begin: "22:00", End: "02:00"}}
+
+		end, active, err := helper.ActiveExclusionWindowEnd(windows, at("2024-06-15T01:00:00Z"))
+		Expect(err).ToNot(HaveOccurred())
+		Expect(active).To(BeTrue())
+		Expect(end).To(BeTemporally("==", at("2024-06-15T02:00:00Z")))
+
+		// the window does not begin on saturdays
+		_, active, err = helper.ActiveExclusionWindowEnd(windows, at("2024-06-15T23:00:00Z"))
+		Expect(err).ToNot(HaveOccurred())
+		Expect(active).To(BeFalse())
+	})
+
+	It("should use the time zone of the window", func() {
+		windows := []v1alpha1.ExclusionWindow{{Days: []string{"saturday"}, Begin: "00:00", End: "06:00", TimeZone: "Europe/Berlin"}}
+
+		end, active, err := helper.ActiveExclusionWindowEnd(windows, at("2024-06-14T23:00:00Z"))
+		Expect(err).ToNot(HaveOccurred())
+		Expect(active).To(BeTrue())
+		Expect(end).To(BeTemporally("==", at("2024-06-15T04:00:00Z")))
+	})
+
+	It("should return the latest end of all active windows", func() {
+		windows := []v1alpha1.ExclusionWindow{{Begin: "08:00", End: "10:00"}, {Begin: "09:00", End: "12:00"}, {Begin: "11:00", End: "13:00"}}
+
+		end, active, err := helper.ActiveExclusionWindowEnd(windows, at("2024-06-15T09:30:00Z"))
+		Expect(err).ToNot(HaveOccurred())
+		Expect(active).To(BeTrue())
+		Expect(end).To(BeTemporally("==", at("2024-06-15T12:00:00Z")))
+	})
+
+	It("should fail for invalid windows", func() {
+		_, _, err := helper.ActiveExclusionWindowEnd([]v1alpha1.ExclusionWindow{{Begin: "8", End: "10:00"}}, time.Now())
+		Expect(err).To(HaveOccurred())
+		_, _, err = helper.ActiveExclusionWindowEnd([]v1alpha1.ExclusionWindow{{Begin: "08:00", End: "10:00", TimeZone: "Mars/Olympus"}}, time.Now())
+		Expect(err).To(HaveOccurred())
+	})
+})
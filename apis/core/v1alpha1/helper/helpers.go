@@ -126,6 +126,16 @@ func CreateOrUpdateConditions(conditions []v1alpha1.Condition, condType v1alpha1
 	return append(conditions, UpdatedCondition(InitCondition(condType), status, reason, message, codes...))
 }
 
+// RemoveCondition removes the condition of the given type from a condition list.
+func RemoveCondition(conditions []v1alpha1.Condition, condType v1alpha1.ConditionType) []v1alpha1.Condition {
+	for i, foundCondition := range conditions {
+		if foundCondition.Type == condType {
+			return append(conditions[:i:i], conditions[i+1:]...)
+		}
+	}
+	return conditions
+}
+
 // MergeConditions merges the given <oldConditions> with the <newConditions>. Existing conditions are superseded by
 // the <newConditions> (depending on the condition type).
 func MergeConditions(oldConditions []v1alpha1.Condition, newConditions ...v1alpha1.Condition) []v1alpha1.Condition {
@@ -234,6 +244,30 @@ func HasIgnoreAnnotation(obj metav1.ObjectMeta) bool {
 	return ok && v == "true"
 }
 
+// HasTraceImportsAnnotation returns true only if the given object
+// has the 'landscaper.gardener.cloud/trace-imports' annotation
+// and its value is 'true'.
+func HasTraceImportsAnnotation(obj metav1.ObjectMeta) bool {
+	v, ok := obj.GetAnnotations()[v1alpha1.TraceImportsAnnotation]
+	return ok && v == "true"
+}
+
+// HasRefreshBlueprintAnnotation returns true only if the given object
+// has the 'landscaper.gardener.cloud/refresh-blueprint' annotation
+// and its value is 'true'.
+func HasRefreshBlueprintAnnotation(obj metav1.ObjectMeta) bool {
+	v, ok := obj.GetAnnotations()[v1alpha1.RefreshBlueprintAnnotation]
+	return ok && v == "true"
+}
+
+// HasResumeImportsAnnotation returns true only if the given object
+// has the 'landscaper.gardener.cloud/resume-imports' annotation
+// and its value is 'true'.
+func HasResumeImportsAnnotation(obj metav1.ObjectMeta) bool {
+	v, ok := obj.GetAnnotations()[v1alpha1.ResumeImportsAnnotation]
+	return ok && v == "true"
+}
+
 // HasDeleteWithoutUninstallAnnotation returns true only if the given object
 // has the 'landscaper.gardener.cloud/delete-without-uninstall' annotation
 // and its value is 'true'.
@@ -255,6 +289,29 @@ func DeleteCacheHelmChartsAnnotation(obj *metav1.ObjectMeta) {
 	delete(obj.GetAnnotations(), v1alpha1.CacheHelmChartsAnnotation)
 }
 
+// HasRequireReadinessAnnotation returns true only if the given object
+// has the 'landscaper.gardener.cloud/require-readiness' annotation
+// and its value is 'true'.
+func HasRequireReadinessAnnotation(obj *metav1.ObjectMeta) bool {
+	v, ok := obj.GetAnnotations()[v1alpha1.RequireReadinessAnnotation]
+	return ok && v == "true"
+}
+
+// PropagateRequireReadinessAnnotation sets or removes the require-readiness annotation of the given object,
+// depending on whether the parent object has the annotation.
+func PropagateRequireReadinessAnnotation(parent, obj *metav1.ObjectMeta) {
+	delete(obj.GetAnnotations(), v1alpha1.RequireReadinessAnnotation)
+	if HasRequireReadinessAnnotation(parent) {
+		metav1.SetMetaDataAnnotation(obj, v1alpha1.RequireReadinessAnnotation, "true")
+	}
+}
+
+// IsReadinessChecked returns true if the readiness checks of the deploy item have succeeded during its last reconcile.
+func IsReadinessChecked(di *v1alpha1.DeployItem) bool {
+	cond := GetCondition(di.Status.Conditions, v1alpha1.DeployItemReadinessCondition)
+	return cond != nil && cond.Status == v1alpha1.ConditionTrue
+}
+
 // SetDeployItemToFailed sets status.phase of the DeployItem to a failure phase
 // If the DeployItem has a DeletionTimestamp, 'DeleteFailed' is used, otherwise it will be set to 'Failed'.
 // Afterwards, the set phase is returned.
@@ -0,0 +1,44 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package helper
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/robfig/cron/v3"
+
+	"github.com/gardener/landscaper/apis/core/v1alpha1"
+)
+
+// MaintenanceWindow returns whether a maintenance window of the given schedule is active at the given time.
+// If a window is active, its end is returned, otherwise the begin of the next window.
+func MaintenanceWindow(schedule v1alpha1.ReconcileSchedule, now time.Time) (time.Time, bool, error) {
+	specSchedule, err := cron.ParseStandard(schedule.CronSpec)
+	if err != nil {
+		return time.Time{}, false, fmt.Errorf("invalid cron spec %q: %w", schedule.CronSpec, err)
+	}
+	if schedule.Duration.Duration <= 0 {
+		return time.Time{}, false, fmt.Errorf("invalid duration %q: duration must be positive", schedule.Duration.Duration)
+	}
+
+	location := time.UTC
+	if len(schedule.TimeZone) != 0 {
+		location, err = time.LoadLocation(schedule.TimeZone)
+		if err != nil {
+			return time.Time{}, false, fmt.Errorf("invalid time zone %q: %w", schedule.TimeZone, err)
+		}
+	}
+
+	// the first window beginning after the given time minus the duration is either active or the next window
+	begin := specSchedule.Next(now.In(location).Add(-schedule.Duration.Duration))
+	if begin.IsZero() {
+		return time.Time{}, false, fmt.Errorf("cron spec %q does not define a begin of a maintenance window", schedule.CronSpec)
+	}
+	if !begin.After(now) {
+		return begin.Add(schedule.Duration.Duration), true, nil
+	}
+	return begin, false, nil
+}
@@ -0,0 +1,77 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package helper_test
+
+import (
+	"time"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	"github.com/gardener/landscaper/apis/core/v1alpha1"
+	"github.com/gardener/landscaper/apis/core/v1alpha1/helper"
+)
+
+var _ = Describe("Maintenance windows", func() {
+
+	// 2024-06-15 is a Saturday
+	at := func(value string) time.Time {
+		t, err := time.Parse(time.RFC3339, value)
+		Expect(err).ToNot(HaveOccurred())
+		return t
+	}
+
+	// the window is active on saturdays from 02:00 to 04:00
+	schedule := v1alpha1.ReconcileSchedule{
+		CronSpec: "0 2 * * 6",
+		Duration: v1alpha1.Duration{Duration: 2 * time.Hour},
+	}
+
+	It("should return the end of an active window", func() {
+		end, active, err := helper.MaintenanceWindow(schedule, at("2024-06-15T02:00:00Z"))
+		Expect(err).ToNot(HaveOccurred())
+		Expect(active).To(BeTrue())
+		Expect(end).To(BeTemporally("==", at("2024-06-15T04:00:00Z")))
+
+		end, active, err = helper.MaintenanceWindow(schedule, at("2024-06-15T03:59:00Z"))
+		Expect(err).ToNot(HaveOccurred())
+		Expect(active).To(BeTrue())
+		Expect(end).To(BeTemporally("==", at("2024-06-15T04:00:00Z")))
+	})
+
+	It("should return the begin of the next window", func() {
+		begin, active, err := helper.MaintenanceWindow(schedule, at("2024-06-15T01:00:00Z"))
+		Expect(err).ToNot(HaveOccurred())
+		Expect(active).To(BeFalse())
+		Expect(begin).To(BeTemporally("==", at("2024-06-15T02:00:00Z")))
+
+		begin, active, err = helper.MaintenanceWindow(schedule, at("2024-06-15T04:00:00Z"))
+		Expect(err).ToNot(HaveOccurred())
+		Expect(active).To(BeFalse())
+		Expect(begin).To(BeTemporally("==", at("2024-06-22T02:00:00Z")))
+	})
+
+	It("should use the time zone of the schedule", func() {
+		berlin := schedule
+		berlin.TimeZone = "Europe/Berlin"
+
+		// 02:00 in Berlin is 00:00 UTC in summer
+		end, active, err := helper.MaintenanceWindow(berlin, at("2024-06-15T00:30:00Z"))
+		Expect(err).ToNot(HaveOccurred())
+		Expect(active).To(BeTrue())
+		Expect(end).To(BeTemporally("==", at("2024-06-15T02:00:00Z")))
+	})
+
+	It("should fail for an invalid schedule", func() {
+		_, _, err := helper.MaintenanceWindow(v1alpha1.ReconcileSchedule{CronSpec: "every day", Duration: schedule.Duration}, time.Now())
+		Expect(err).To(HaveOccurred())
+
+		_, _, err = helper.MaintenanceWindow(v1alpha1.ReconcileSchedule{CronSpec: schedule.CronSpec}, time.Now())
+		Expect(err).To(HaveOccurred())
+
+		_, _, err = helper.MaintenanceWindow(v1alpha1.ReconcileSchedule{CronSpec: schedule.CronSpec, Duration: schedule.Duration, TimeZone: "Mars/Olympus"}, time.Now())
+		Expect(err).To(HaveOccurred())
+	})
+})
@@ -61,6 +61,14 @@ func addKnownTypes(scheme *runtime.Scheme) error {
 		&TargetSyncList{},
 		&CriticalProblems{},
 		&CriticalProblemsList{},
+		&DeployerRegistration{},
+		&DeployerRegistrationList{},
+		&TestRun{},
+		&TestRunList{},
+		&LandscapeHealth{},
+		&LandscapeHealthList{},
+		&TargetTypeDefinition{},
+		&TargetTypeDefinitionList{},
 	)
 	if err := RegisterConversions(scheme); err != nil {
 		return err
@@ -0,0 +1,21 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package targettypes
+
+import (
+	"github.com/gardener/landscaper/apis/core"
+	"github.com/gardener/landscaper/apis/core/v1alpha1"
+)
+
+// AgentTargetType defines the landscaper agent target.
+// The target contains no credentials. Its deploy items are only executed by the landscaper agent
+// with the name of the target, which runs in the target cluster and uses its own credentials.
+const AgentTargetType v1alpha1.TargetType = core.GroupName + "/agent"
+
+// AgentTargetConfig defines the landscaper agent target config.
+type AgentTargetConfig struct {
+	// Name is the name of the agent that executes the deploy items of the target.
+	Name string `json:"name"`
+}
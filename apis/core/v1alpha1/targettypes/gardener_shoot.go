@@ -0,0 +1,56 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package targettypes
+
+import (
+	"github.com/gardener/landscaper/apis/core"
+	"github.com/gardener/landscaper/apis/core/v1alpha1"
+)
+
+// GardenerShootTargetType defines the landscaper gardener shoot target.
+// The kubeconfig of the shoot cluster is requested from the garden cluster whenever the target is used.
+const GardenerShootTargetType v1alpha1.TargetType = core.GroupName + "/gardener-shoot"
+
+// GardenerShootAccessLevel defines the permissions of a kubeconfig that is requested for a shoot cluster.
+type GardenerShootAccessLevel string
+
+const (
+	// GardenerShootAdminAccess requests an admin kubeconfig for the shoot cluster.
+	GardenerShootAdminAccess GardenerShootAccessLevel = "admin"
+	// GardenerShootViewerAccess requests a viewer kubeconfig for the shoot cluster.
+	GardenerShootViewerAccess GardenerShootAccessLevel = "viewer"
+)
+
+// DefaultGardenerShootKubeconfigExpirationSeconds is the default validity of a requested shoot kubeconfig.
+const DefaultGardenerShootKubeconfigExpirationSeconds int64 = 1800
+
+// GardenerShootTargetConfig defines the landscaper gardener shoot target config.
+type GardenerShootTargetConfig struct {
+	// GardenKubeconfig defines the kubeconfig of the garden cluster that is used to request the shoot kubeconfig.
+	// It needs the permission to create admin resp. viewer kubeconfig requests for the shoot.
+	GardenKubeconfig ValueRef `json:"gardenKubeconfig"`
+
+	// Name is the name of the shoot.
+	Name string `json:"name"`
+
+	// Namespace is the namespace of the shoot in the garden cluster.
+	// Either the namespace or the project of the shoot has to be defined.
+	// +optional
+	Namespace string `json:"namespace,omitempty"`
+
+	// Project is the name of the gardener project of the shoot.
+	// It is used to determine the namespace of the shoot if no namespace is defined.
+	// +optional
+	Project string `json:"project,omitempty"`
+
+	// AccessLevel defines whether an admin or viewer kubeconfig is requested. Defaults to admin.
+	// +optional
+	AccessLevel GardenerShootAccessLevel `json:"accessLevel,omitempty"`
+
+	// ExpirationSeconds defines the validity of the requested kubeconfig.
+	// Defaults to DefaultGardenerShootKubeconfigExpirationSeconds.
+	// +optional
+	ExpirationSeconds *int64 `json:"expirationSeconds,omitempty"`
+}
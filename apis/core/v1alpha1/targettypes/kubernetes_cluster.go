@@ -20,6 +20,34 @@ const KubernetesClusterTargetType v1alpha1.TargetType = core.GroupName + "/kuber
 type KubernetesClusterTargetConfig struct {
 	// Kubeconfig defines kubeconfig as string.
 	Kubeconfig ValueRef `json:"kubeconfig"`
+
+	// ServiceAccountToken configures that the kubeconfig is only used to request short-lived tokens
+	// for a service account in the target cluster. The deployers access the target cluster with these tokens.
+	// The identity of the kubeconfig needs the permission to create tokens for the service account.
+	// +optional
+	ServiceAccountToken *ServiceAccountTokenConfig `json:"serviceAccountToken,omitempty"`
+}
+
+// DefaultServiceAccountTokenExpirationSeconds is the default validity of a requested service account token.
+const DefaultServiceAccountTokenExpirationSeconds int64 = 3600
+
+// ServiceAccountTokenConfig defines a service account in the target cluster for which tokens are requested.
+type ServiceAccountTokenConfig struct {
+	// Name is the name of the service account.
+	Name string `json:"name"`
+
+	// Namespace is the namespace of the service account.
+	Namespace string `json:"namespace"`
+
+	// Audiences are the intended audiences of the requested tokens.
+	// Defaults to the audiences of the api server of the target cluster.
+	// +optional
+	Audiences []string `json:"audiences,omitempty"`
+
+	// ExpirationSeconds defines the validity of the requested tokens.
+	// Defaults to DefaultServiceAccountTokenExpirationSeconds.
+	// +optional
+	ExpirationSeconds *int64 `json:"expirationSeconds,omitempty"`
 }
 
 // DefaultKubeconfigKey is the default that is used to hold a kubeconfig.
@@ -35,7 +63,8 @@ type ValueRef struct {
 
 // kubeconfigJSON is a helper struct for decoding.
 type kubeconfigJSON struct {
-	Kubeconfig *ValueRef `json:"kubeconfig"`
+	Kubeconfig          *ValueRef                  `json:"kubeconfig"`
+	ServiceAccountToken *ServiceAccountTokenConfig `json:"serviceAccountToken,omitempty"`
 }
 
 // valueRefJSON is a helper struct to decode json into a secret ref object.
@@ -80,6 +109,7 @@ func (kc *KubernetesClusterTargetConfig) UnmarshalJSON(data []byte) error {
 	if err == nil && kj.Kubeconfig != nil {
 		// parsing was successful
 		kc.Kubeconfig = *kj.Kubeconfig
+		kc.ServiceAccountToken = kj.ServiceAccountToken
 		return nil
 	}
 	return kc.Kubeconfig.UnmarshalJSON(data)
@@ -72,6 +72,51 @@ type ContextConfiguration struct {
 	// VerificationSignatures maps a signature name to the trusted verification information
 	// +optional
 	VerificationSignatures map[string]VerificationSignature `json:"verificationSignatures,omitempty"`
+
+	// ImagePullSecrets defines image pull secrets that are propagated to the workloads
+	// that are created by the deployers for the deploy items of this context.
+	// +optional
+	ImagePullSecrets *ImagePullSecretsConfiguration `json:"imagePullSecrets,omitempty"`
+
+	// FeatureFlags defines the values of named feature flags for all installations that reference this context.
+	// The value of a flag overwrites the default value that is declared by the blueprint of an installation.
+	// +optional
+	FeatureFlags map[string]bool `json:"featureFlags,omitempty"`
+
+	// Scheduling defines scheduling defaults for the workloads
+	// that are created by the deployers for the deploy items of this context.
+	// +optional
+	Scheduling *SchedulingConfiguration `json:"scheduling,omitempty"`
+}
+
+// ImagePullSecretsConfiguration defines image pull secrets that are propagated to the workloads of deploy items.
+type ImagePullSecretsConfiguration struct {
+	// Secrets reference secrets of type "kubernetes.io/dockerconfigjson" in the namespace of the context.
+	// The container deployer adds their credentials to the image pull secrets of its pods.
+	Secrets []corev1.LocalObjectReference `json:"secrets"`
+
+	// InjectIntoHelmWorkloads defines whether the helm deployer creates an image pull secret with the credentials
+	// in the release namespace and adds it to the image pull secrets of all rendered pods and pod templates.
+	// +optional
+	InjectIntoHelmWorkloads bool `json:"injectIntoHelmWorkloads,omitempty"`
+}
+
+// SchedulingConfiguration defines scheduling defaults that are injected into the workloads of deploy items.
+type SchedulingConfiguration struct {
+	// NodeSelector is merged into the node selector of the workloads.
+	// Keys that are already set by a workload are not overwritten.
+	// +optional
+	NodeSelector map[string]string `json:"nodeSelector,omitempty"`
+
+	// Tolerations are added to the tolerations of the workloads, unless a workload already has a matching toleration.
+	// +optional
+	Tolerations []corev1.Toleration `json:"tolerations,omitempty"`
+
+	// InjectIntoHelmWorkloads defines whether the helm deployer injects the scheduling defaults
+	// into all rendered pods and pod templates.
+	// The container deployer always injects them into its pods.
+	// +optional
+	InjectIntoHelmWorkloads bool `json:"injectIntoHelmWorkloads,omitempty"`
 }
 
 // VerificationSignatures contains the trusted verification information
@@ -45,6 +45,14 @@ const DataObjectJobIDLabel = "data.landscaper.gardener.cloud/jobid"
 // DataObjectHashAnnotation defines the name of the annotation that specifies the hash of the data.
 const DataObjectHashAnnotation = "data.landscaper.gardener.cloud/hash"
 
+// DataObjectLineageAnnotation defines the name of the annotation that contains the chain of objects
+// (installations, executions, deploy items and data objects) that produced the data of an exported data object.
+const DataObjectLineageAnnotation = "data.landscaper.gardener.cloud/lineage"
+
+// DataObjectOutdatedSinceAnnotation defines the name of the annotation that marks an exported data object as outdated.
+// It contains the time (RFC 3339) since when the installation that exported the data object is deleted or failed.
+const DataObjectOutdatedSinceAnnotation = "data.landscaper.gardener.cloud/outdatedSince"
+
 // +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
 
 // DataObjectList contains a list of DataObject
@@ -58,6 +66,7 @@ type DataObjectList struct {
 // +kubebuilder:resource:shortName=dobj;do
 // +kubebuilder:printcolumn:name="Context",type=string,JSONPath=`.metadata.labels['data\.landscaper\.gardener\.cloud\/context']`
 // +kubebuilder:printcolumn:name="Key",type=string,JSONPath=`.metadata.labels['data\.landscaper\.gardener\.cloud\/key']`
+// +kubebuilder:printcolumn:name="Outdated Since",type=string,JSONPath=`.metadata.annotations['data\.landscaper\.gardener\.cloud\/outdatedSince']`
 // +kubebuilder:printcolumn:name="Age",type="date",JSONPath=".metadata.creationTimestamp"
 
 // DataObject are resources that can hold any kind json or yaml data.
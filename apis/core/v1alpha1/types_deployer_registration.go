@@ -0,0 +1,103 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// DeployerRegistrationList contains a list of DeployerRegistrations
+type DeployerRegistrationList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []DeployerRegistration `json:"items"`
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+// +kubebuilder:resource:scope="Cluster",shortName=deployerreg;dreg,singular=deployerregistration
+// +kubebuilder:subresource:status
+// +kubebuilder:printcolumn:name="Phase",type=string,JSONPath=`.status.phase`
+// +kubebuilder:printcolumn:name="Age",type=date,JSONPath=`.metadata.creationTimestamp`
+
+// DeployerRegistration registers a deployer for deploy item types at runtime.
+// The deployer itself runs independently of the landscaper and reconciles the deploy items of the registered types.
+type DeployerRegistration struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	// Spec contains the specification of the registered deployer.
+	Spec DeployerRegistrationSpec `json:"spec"`
+
+	// Status contains the observed state of the registered deployer.
+	// +optional
+	Status DeployerRegistrationStatus `json:"status"`
+}
+
+// DeployerRegistrationSpec defines the specification of a registered deployer.
+type DeployerRegistrationSpec struct {
+	// DeployItemTypes lists the types of the deploy items that are reconciled by the deployer.
+	DeployItemTypes []DeployItemType `json:"deployItemTypes"`
+
+	// TargetTypes lists the types of the targets that are supported by the deployer.
+	// If empty, all target types are supported.
+	// +optional
+	TargetTypes []TargetType `json:"targetTypes,omitempty"`
+
+	// HealthCheck configures the periodic health check of the deployer.
+	// +optional
+	HealthCheck *DeployerHealthCheck `json:"healthCheck,omitempty"`
+}
+
+// DeployerHealthCheck defines the health check of a registered deployer.
+type DeployerHealthCheck struct {
+	// URL is the http(s) endpoint of the deployer that is probed.
+	// The deployer is healthy if a GET request on the endpoint returns a 2xx status code.
+	URL string `json:"url"`
+
+	// Interval is the time between two health checks.
+	// Defaults to 1 minute.
+	// +optional
+	Interval *Duration `json:"interval,omitempty"`
+
+	// Timeout is the timeout of a single health check request.
+	// Defaults to 10 seconds.
+	// +optional
+	Timeout *Duration `json:"timeout,omitempty"`
+}
+
+// DeployerRegistrationPhase describes the phase of a registered deployer.
+type DeployerRegistrationPhase string
+
+const (
+	// DeployerRegistrationPhaseRegistered is the phase of a valid registration without health check.
+	DeployerRegistrationPhaseRegistered DeployerRegistrationPhase = "Registered"
+	// DeployerRegistrationPhaseHealthy is the phase of a valid registration whose last health check succeeded.
+	DeployerRegistrationPhaseHealthy DeployerRegistrationPhase = "Healthy"
+	// DeployerRegistrationPhaseUnhealthy is the phase of a valid registration whose last health check failed.
+	DeployerRegistrationPhaseUnhealthy DeployerRegistrationPhase = "Unhealthy"
+	// DeployerRegistrationPhaseInvalid is the phase of a registration that is invalid or conflicts with other registrations.
+	DeployerRegistrationPhaseInvalid DeployerRegistrationPhase = "Invalid"
+)
+
+// DeployerRegistrationStatus contains the observed state of a registered deployer.
+type DeployerRegistrationStatus struct {
+	// ObservedGeneration is the most recent generation observed for this registration.
+	// +optional
+	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
+
+	// Phase is the current phase of the registration.
+	// +optional
+	Phase DeployerRegistrationPhase `json:"phase,omitempty"`
+
+	// Message contains details about the current phase.
+	// +optional
+	Message string `json:"message,omitempty"`
+
+	// LastHealthCheckTime is the time of the last health check.
+	// +optional
+	LastHealthCheckTime *metav1.Time `json:"lastHealthCheckTime,omitempty"`
+}
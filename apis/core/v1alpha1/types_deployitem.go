@@ -13,6 +13,10 @@ import (
 // DeployItemValidationCondition is the Conditions type to indicate the deploy items configuration validation status.
 const DeployItemValidationCondition ConditionType = "DeployItemValidation"
 
+// DeployItemReadinessCondition is the Conditions type to indicate whether the readiness checks of the resources
+// of a deploy item have succeeded during its last reconcile.
+const DeployItemReadinessCondition ConditionType = "ReadinessChecksSucceeded"
+
 // DeployItemType defines the type of the deploy item
 type DeployItemType string
 
@@ -121,12 +125,45 @@ type DeployItemSpec struct {
 	// Defaults to ten minutes if not specified.
 	// +optional
 	Timeout *Duration `json:"timeout,omitempty"`
+	// PickupTimeout overwrites the globally configured pickup timeout for this deploy item.
+	// It specifies how long a deployer may take to start processing the deploy item before it is marked as failed.
+	// Value has to be parsable by time.ParseDuration (or 'none' to deactivate the timeout).
+	// +optional
+	PickupTimeout *Duration `json:"pickupTimeout,omitempty"`
+	// AbortTimeout overwrites the globally configured abort timeout for this deploy item.
+	// It specifies how long the deployer may take to finish the deploy item after its timeout has been exceeded,
+	// before it is marked as failed.
+	// Value has to be parsable by time.ParseDuration (or 'none' to deactivate the timeout).
+	// +optional
+	AbortTimeout *Duration `json:"abortTimeout,omitempty"`
 	// UpdateOnChangeOnly specifies if redeployment is executed only if the specification of the deploy item has changed.
 	// +optional
 	UpdateOnChangeOnly bool `json:"updateOnChangeOnly,omitempty"`
 
+	// ConsumedImports lists the imports of the installation that are consumed by the deploy item.
+	// It is only set if partial import updates are enabled for the installation.
+	// +optional
+	ConsumedImports []string `json:"consumedImports,omitempty"`
+
+	// ConsumedImportsHash is the hash of the values of the consumed imports.
+	// It changes the specification of the deploy item whenever a consumed import changes,
+	// even if the templated configuration remains the same, e.g. because only the referenced target has changed.
+	// +optional
+	ConsumedImportsHash string `json:"consumedImportsHash,omitempty"`
+
 	// OnDelete specifies particular setting when deleting a deploy item
 	OnDelete *OnDeleteConfig `json:"onDelete,omitempty"`
+
+	// ExclusionWindows define recurring time windows during which an already deployed deploy item is neither updated
+	// nor deleted. Updates and deletions are deferred until the window has ended, while the other deploy items
+	// of the execution proceed.
+	// +optional
+	ExclusionWindows []ExclusionWindow `json:"exclusionWindows,omitempty"`
+
+	// RetryPolicy defines how often and after which intervals the deployer retries a failed reconcile or deletion
+	// of the deploy item. Without a retry policy, failed operations are retried until the deploy item times out.
+	// +optional
+	RetryPolicy *RetryPolicy `json:"retryPolicy,omitempty"`
 }
 
 // DeployItemStatus contains the status of a deploy item.
@@ -183,6 +220,19 @@ type DeployItemStatus struct {
 	// TransitionTimes contains timestamps of status transitions
 	// +optional
 	TransitionTimes *TransitionTimes `json:"transitionTimes,omitempty"`
+
+	// Progress describes the progress of the current operation as reported by the deployer.
+	// It is only set by deployers with long-running operations.
+	// +optional
+	Progress *DeployerProgress `json:"progress,omitempty"`
+
+	// RetryCount is the number of retries of the current operation according to the retry policy of the deploy item.
+	// +optional
+	RetryCount int32 `json:"retryCount,omitempty"`
+
+	// NextRetryTime is the time after which the current operation is retried according to the retry policy.
+	// +optional
+	NextRetryTime *metav1.Time `json:"nextRetryTime,omitempty"`
 }
 
 func (r *DeployItemStatus) GetLastError() *Error {
@@ -228,6 +278,20 @@ type DeployerInformation struct {
 	Version string `json:"version"`
 }
 
+// DeployerProgress describes the progress of the current operation of a deploy item.
+type DeployerProgress struct {
+	// Percent is the estimated completion of the current operation in percent.
+	Percent int32 `json:"percent"`
+	// Step is the current step of the operation.
+	// +optional
+	Step string `json:"step,omitempty"`
+	// Message is a human-readable description of the progress.
+	// +optional
+	Message string `json:"message,omitempty"`
+	// LastUpdateTime is the time when the progress has been updated the last time.
+	LastUpdateTime metav1.Time `json:"lastUpdateTime"`
+}
+
 // TargetSelector describes a selector that matches specific targets.
 // +k8s:deepcopy-gen=true
 type TargetSelector struct {
@@ -25,6 +25,10 @@ const SubinstallationNameAnnotation = "landscaper.gardener.cloud/subinstallation
 // todo: keep only subinstallations?
 const KeepChildrenAnnotation = "landscaper.gardener.cloud/keep-children"
 
+// ExportSinkSourceAnnotation is the annotation of the secrets and config maps that are written by an export sink.
+// It contains the namespace and name of the installation that wrote the object.
+const ExportSinkSourceAnnotation = "landscaper.gardener.cloud/export-sink-source"
+
 // EnsureSubInstallationsCondition is the Conditions type to indicate the sub installation status.
 const EnsureSubInstallationsCondition ConditionType = "EnsureSubInstallations"
 
@@ -49,6 +53,26 @@ const ValidateExportCondition ConditionType = "ValidateExport"
 // ComponentReferenceOverwriteCondition is the Conditions type to indicate that the component reference was overwritten.
 const ComponentReferenceOverwriteCondition ConditionType = "ComponentReferenceOverwrite"
 
+// ImportsUpToDateCondition is the Conditions type to indicate whether some imported data objects are outdated,
+// because their exporting installation has been deleted or has failed.
+const ImportsUpToDateCondition ConditionType = "ImportsUpToDate"
+
+// DeletionAllowedCondition is the Conditions type to indicate whether the deletion of an installation is blocked,
+// because sibling installations still import its exports.
+const DeletionAllowedCondition ConditionType = "DeletionAllowed"
+
+// SignatureVerifiedCondition is the Conditions type to indicate whether the signature of the component descriptor
+// of an installation has been verified successfully.
+const SignatureVerifiedCondition ConditionType = "SignatureVerified"
+
+// QuarantinedBadImportCondition is the Conditions type to indicate that an installation is quarantined,
+// because the data of an import source is malformed and the import construction failed repeatedly.
+const QuarantinedBadImportCondition ConditionType = "QuarantinedBadImport"
+
+// PendingWindowCondition is the Conditions type to indicate that the reconciliation of an installation is pending
+// until its next maintenance window begins.
+const PendingWindowCondition ConditionType = "PendingWindow"
+
 type InstallationPhase string
 
 func (p InstallationPhase) String() string {
@@ -192,6 +216,17 @@ type InstallationSpec struct {
 	// Optimization contains settings to improve execution performance.
 	// +optional
 	Optimization *Optimization `json:"optimization,omitempty"`
+
+	// StrictImportOwnership only allows the recorded sources of the data and target imports to satisfy them.
+	// If an import is provided by another source, the installation fails with an AmbiguousImport error
+	// instead of silently switching to the new source.
+	// +optional
+	StrictImportOwnership bool `json:"strictImportOwnership,omitempty"`
+
+	// ReconcileSchedule defines the maintenance windows of a root installation.
+	// If set, new jobs to roll out changes are only started during a maintenance window.
+	// +optional
+	ReconcileSchedule *ReconcileSchedule `json:"reconcileSchedule,omitempty"`
 }
 
 // Verification defines the necessary data to verify the signature of the refered component
@@ -241,6 +276,19 @@ type FailedReconcile struct {
 	CronSpec string `json:"cronSpec,omitempty"`
 }
 
+// ReconcileSchedule defines recurring maintenance windows, during which new jobs of an installation are started.
+type ReconcileSchedule struct {
+	// CronSpec describes the begin of the maintenance windows according to the cron syntax "https://pkg.go.dev/github.com/robfig/cron#hdr-CRON_Expression_Format".
+	CronSpec string `json:"cronSpec"`
+
+	// Duration is the length of a maintenance window.
+	Duration Duration `json:"duration"`
+
+	// TimeZone is the name of the time zone of the cron spec, e.g. "Europe/Berlin". It defaults to UTC.
+	// +optional
+	TimeZone string `json:"timeZone,omitempty"`
+}
+
 // InstallationStatus contains the current status of a Installation.
 type InstallationStatus struct {
 	// ObservedGeneration is the most recent generation observed for this ControllerInstallations.
@@ -257,9 +305,19 @@ type InstallationStatus struct {
 	// +optional
 	SubInstCache *SubInstCache `json:"subInstCache,omitempty"`
 
+	// RenderedSubinstallations describes the subinstallations that have been rendered from the templates of the blueprint.
+	// +optional
+	RenderedSubinstallations []RenderedSubinstallation `json:"renderedSubinstallations,omitempty"`
+
 	// ExecutionReference is the reference to the execution that schedules the templated execution items.
 	ExecutionReference *ObjectReference `json:"executionRef,omitempty"`
 
+	// ChainedExecutionReferences are the references to the executions that follow the execution of ExecutionReference.
+	// They are only set if the templated execution items do not fit into a single execution.
+	// The executions are processed in the given order, each one after its predecessor has succeeded.
+	// +optional
+	ChainedExecutionReferences []ObjectReference `json:"chainedExecutionRefs,omitempty"`
+
 	// JobID is the ID of the current working request.
 	JobID string `json:"jobID,omitempty"`
 
@@ -284,9 +342,121 @@ type InstallationStatus struct {
 	// +optional
 	DependentsToTrigger []DependentToTrigger `json:"dependentsToTrigger,omitempty"`
 
+	// Predecessors lists the sibling installations this installation depends on via its imports,
+	// together with their state observed during the last dependency check.
+	// +optional
+	Predecessors []PredecessorStatus `json:"predecessors,omitempty"`
+
 	// TransitionTimes contains timestamps of status transitions
 	// +optional
 	TransitionTimes *TransitionTimes `json:"transitionTimes,omitempty"`
+
+	// Approvals is the audit trail of the approvals requested from external approval systems
+	// for the phase transitions of the installation.
+	// +optional
+	Approvals []ApprovalRecord `json:"approvals,omitempty"`
+
+	// ImportSources records the sources of the data and target imports of the installation.
+	// It is only maintained if the strict import ownership is enabled.
+	// +optional
+	ImportSources []ImportSource `json:"importSources,omitempty"`
+
+	// ResolvedComponentVersions contains the component versions that have been resolved by semver constraints
+	// during the templating of the installation. They are reused by subsequent reconciliations to keep them deterministic.
+	// +optional
+	ResolvedComponentVersions []ResolvedComponentVersion `json:"resolvedComponentVersions,omitempty"`
+
+	// ImportQuarantine tracks the repeated failures of the import construction that are caused by malformed data
+	// of an import source. The installation is quarantined if the same source fails repeatedly.
+	// +optional
+	ImportQuarantine *ImportQuarantine `json:"importQuarantine,omitempty"`
+
+	// ExportSinks references the secrets and config maps that have been written by the export sinks of the installation.
+	// Objects of sinks that are removed from the installation are deleted with the next successful export.
+	// +optional
+	ExportSinks []ExportSinkReference `json:"exportSinks,omitempty"`
+
+	// RedeployAllDeployItems is set if the current job has been started by an explicit reconcile operation,
+	// i.e. by a reconcile annotation without a reconcile reason or by a force-reconcile annotation.
+	// The executions of the installation and its subinstallations then redeploy all their deploy items in this job.
+	// +optional
+	RedeployAllDeployItems bool `json:"redeployAllDeployItems,omitempty"`
+}
+
+// ImportSource describes the source that provides an import of an installation.
+type ImportSource struct {
+	// Name is the name of the import.
+	Name string `json:"name"`
+	// Key is the data object or target from which the import is read.
+	Key string `json:"key"`
+	// Source is the source of the data object or target, e.g. the exporting installation.
+	// It is empty if the data object or target has not been created by the landscaper.
+	// +optional
+	Source string `json:"source,omitempty"`
+}
+
+// ResolvedComponentVersion describes the version of a component that has been resolved by a semver constraint.
+type ResolvedComponentVersion struct {
+	// ComponentName is the name of the component.
+	ComponentName string `json:"componentName"`
+	// Constraint is the semver constraint that has been resolved.
+	Constraint string `json:"constraint"`
+	// Version is the latest version of the component that matched the constraint.
+	Version string `json:"version"`
+}
+
+// ImportQuarantine describes an import source whose data could not be imported by an installation.
+type ImportQuarantine struct {
+	// ImportName is the name of the import that could not be constructed.
+	ImportName string `json:"importName"`
+	// Source is the object that contains the malformed data of the import.
+	Source TypedObjectReference `json:"source"`
+	// SourceResourceVersion is the resourceVersion of the source object when the import failed.
+	// +optional
+	SourceResourceVersion string `json:"sourceResourceVersion,omitempty"`
+	// Failures is the number of consecutive failures caused by the same version of the source object.
+	Failures int32 `json:"failures"`
+	// QuarantineTime is the time when the installation has been quarantined.
+	// The installation is not retried until the source object changes or the imports are resumed.
+	// +optional
+	QuarantineTime *metav1.Time `json:"quarantineTime,omitempty"`
+}
+
+// ApprovalState is the state of an approval requested from an external approval system.
+type ApprovalState string
+
+const (
+	// ApprovalStatePending is the state of an approval that has been requested but not yet been decided.
+	ApprovalStatePending ApprovalState = "Pending"
+	// ApprovalStateApproved is the state of an approved phase transition.
+	ApprovalStateApproved ApprovalState = "Approved"
+	// ApprovalStateRejected is the state of a rejected phase transition.
+	ApprovalStateRejected ApprovalState = "Rejected"
+	// ApprovalStateTimedOut is the state of an approval that has not been decided within its timeout.
+	ApprovalStateTimedOut ApprovalState = "TimedOut"
+)
+
+// ApprovalRecord describes an approval requested from an external approval system for a phase transition of an installation.
+type ApprovalRecord struct {
+	// Hook is the name of the approval hook that requested the approval.
+	Hook string `json:"hook"`
+	// Phase is the installation phase whose entry has to be approved.
+	Phase InstallationPhase `json:"phase"`
+	// JobID is the ID of the job for which the approval has been requested.
+	JobID string `json:"jobID"`
+	// State is the state of the approval.
+	State ApprovalState `json:"state"`
+	// Reference is the identifier of the request in the external approval system, e.g. the number of a change request.
+	// +optional
+	Reference string `json:"reference,omitempty"`
+	// Message is the message of the external approval system.
+	// +optional
+	Message string `json:"message,omitempty"`
+	// RequestTime is the time when the approval has been requested.
+	RequestTime metav1.Time `json:"requestTime"`
+	// DecisionTime is the time when the approval has been decided.
+	// +optional
+	DecisionTime *metav1.Time `json:"decisionTime,omitempty"`
 }
 
 type DependentToTrigger struct {
@@ -294,6 +464,20 @@ type DependentToTrigger struct {
 	Name string `json:"name,omitempty"`
 }
 
+// PredecessorStatus describes the observed state of a sibling installation on which an installation depends.
+type PredecessorStatus struct {
+	// Name is the name of the predecessor installation.
+	Name string `json:"name"`
+	// ObservedGeneration is the generation of the predecessor installation that was observed.
+	ObservedGeneration int64 `json:"observedGeneration"`
+	// Phase is the phase of the predecessor installation.
+	// +optional
+	Phase InstallationPhase `json:"phase,omitempty"`
+	// JobIDFinished is the ID of the last finished job of the predecessor installation.
+	// +optional
+	JobIDFinished string `json:"jobIDFinished,omitempty"`
+}
+
 // AutomaticReconcileStatus describes the status of automatically triggered reconciles.
 type AutomaticReconcileStatus struct {
 	// Generation describes the generation of the installation for which the status holds.
@@ -331,6 +515,69 @@ type InstallationExports struct {
 	// Targets defines all target exports.
 	// +optional
 	Targets []TargetExport `json:"targets,omitempty"`
+
+	// Sinks defines secrets and config maps in target clusters to which data exports are additionally written,
+	// so that workloads that are not managed by the landscaper can consume them.
+	// +optional
+	Sinks []ExportSink `json:"sinks,omitempty"`
+}
+
+// ExportSinkType defines the kind of object that is written by an export sink.
+type ExportSinkType string
+
+const (
+	// SecretExportSinkType writes the exports into a secret.
+	SecretExportSinkType ExportSinkType = "Secret"
+	// ConfigMapExportSinkType writes the exports into a config map.
+	ConfigMapExportSinkType ExportSinkType = "ConfigMap"
+)
+
+// ExportSink writes data exports of an installation into a secret or config map in the cluster of a target.
+type ExportSink struct {
+	// Name is the name of the secret or config map in the target cluster.
+	Name string `json:"name"`
+
+	// Namespace is the namespace of the secret or config map in the target cluster.
+	// Defaults to "default".
+	// +optional
+	Namespace string `json:"namespace,omitempty"`
+
+	// Type is the kind of object that is written, either "Secret" or "ConfigMap".
+	// Defaults to "Secret".
+	// +optional
+	Type ExportSinkType `json:"type,omitempty"`
+
+	// Target is the name of a target of type landscaper.gardener.cloud/kubernetes-cluster
+	// in the namespace of the installation.
+	Target string `json:"target"`
+
+	// Data maps the keys of the secret or config map to data exports of the installation.
+	Data []ExportSinkData `json:"data"`
+}
+
+// ExportSinkData maps a data export of an installation to a key of an export sink.
+type ExportSinkData struct {
+	// Key is the key of the value in the secret or config map.
+	Key string `json:"key"`
+
+	// Export is the name of a data export of the installation.
+	// String values are written as they are, all other values are written as json.
+	Export string `json:"export"`
+}
+
+// ExportSinkReference references a secret or config map that has been written by an export sink.
+type ExportSinkReference struct {
+	// Target is the name of the target of the cluster that contains the object.
+	Target string `json:"target"`
+
+	// Type is the kind of the object, either "Secret" or "ConfigMap".
+	Type ExportSinkType `json:"type"`
+
+	// Namespace is the namespace of the object in the target cluster.
+	Namespace string `json:"namespace"`
+
+	// Name is the name of the object in the target cluster.
+	Name string `json:"name"`
 }
 
 // DataImport is a data object import.
@@ -357,6 +604,78 @@ type DataImport struct {
 	// This method is not allowed in installation templates.
 	// +optional
 	ConfigMapRef *LocalConfigMapReference `json:"configMapRef,omitempty"`
+
+	// HTTPRef defines a data reference to a JSON document that is fetched from an HTTP endpoint.
+	// This method is not allowed in installation templates.
+	// +optional
+	HTTPRef *HTTPDataReference `json:"httpRef,omitempty"`
+
+	// Transformations are applied in the given order to the imported data,
+	// before it is validated against the import schema of the blueprint.
+	// +optional
+	Transformations []ImportTransformation `json:"transformations,omitempty"`
+}
+
+// ImportTransformationType defines the type of an import transformation.
+type ImportTransformationType string
+
+const (
+	// ImportTransformationTypeJSONPath selects a value of the imported data by a jsonpath.
+	ImportTransformationTypeJSONPath ImportTransformationType = "JSONPath"
+	// ImportTransformationTypeDefault sets a default value for imported data that is null,
+	// resp. adds missing fields to imported objects.
+	ImportTransformationTypeDefault ImportTransformationType = "Default"
+	// ImportTransformationTypeBase64Decode decodes imported base64 encoded strings.
+	ImportTransformationTypeBase64Decode ImportTransformationType = "Base64Decode"
+	// ImportTransformationTypeGoTemplate maps the imported data with a go template.
+	ImportTransformationTypeGoTemplate ImportTransformationType = "GoTemplate"
+)
+
+// ImportTransformation defines a single transformation of imported data.
+type ImportTransformation struct {
+	// Type is the type of the transformation.
+	// Supported types are "JSONPath", "Default", "Base64Decode" and "GoTemplate".
+	Type ImportTransformationType `json:"type"`
+
+	// JSONPath selects the value of the imported data, e.g. ".outputs.endpoint".
+	// Required for transformations of type JSONPath.
+	// +optional
+	JSONPath string `json:"jsonPath,omitempty"`
+
+	// Value is the default value for transformations of type Default.
+	// It replaces imported data that is null. If both, the imported data and the value are objects,
+	// the fields of the value are added recursively to the imported data if they are missing there.
+	// +optional
+	// +kubebuilder:pruning:PreserveUnknownFields
+	// +kubebuilder:validation:Schemaless
+	Value *AnyJSON `json:"value,omitempty"`
+
+	// Template is the go template for transformations of type GoTemplate.
+	// The imported data is available as ".value" and the result is parsed as yaml.
+	// +optional
+	Template string `json:"template,omitempty"`
+}
+
+// HTTPDataReference is a reference to a JSON document that is fetched from an HTTP endpoint,
+// e.g. the outputs of a Terraform Cloud workspace.
+type HTTPDataReference struct {
+	// URL is the http or https url of the JSON document.
+	URL string `json:"url"`
+
+	// AuthSecretRef references the key of a secret that contains the value of the Authorization header
+	// that is sent with the request, e.g. "Bearer <token>".
+	// +optional
+	AuthSecretRef *LocalSecretReference `json:"authSecretRef,omitempty"`
+
+	// JSONPath selects the imported value in the fetched document, e.g. ".outputs.vpc_id.value".
+	// The complete document is imported if not set.
+	// +optional
+	JSONPath string `json:"jsonPath,omitempty"`
+
+	// CacheDuration defines how long a fetched document is reused before it is fetched again.
+	// Defaults to five minutes.
+	// +optional
+	CacheDuration *Duration `json:"cacheDuration,omitempty"`
 }
 
 // DataExport is a data object export.
@@ -388,6 +707,12 @@ type TargetImport struct {
 	// +optional
 	TargetListReference string `json:"targetListRef,omitempty"`
 
+	// TargetSelector imports all targets in the namespace of the installation whose labels match the selector
+	// as a target list. The targets are sorted by name.
+	// It can only be used in root installations.
+	// +optional
+	TargetSelector *metav1.LabelSelector `json:"targetSelector,omitempty"`
+
 	// +optional
 	TargetMap map[string]string `json:"targetMap,omitempty"`
 
@@ -413,6 +738,9 @@ type BlueprintDefinition struct {
 	// Inline defines a inline yaml filesystem with a blueprint.
 	// +optional
 	Inline *InlineBlueprint `json:"inline,omitempty"`
+	// Git defines a reference to a blueprint in a git repository.
+	// +optional
+	Git *GitBlueprintReference `json:"git,omitempty"`
 }
 
 // RemoteBlueprintReference describes a reference to a blueprint defined by a component descriptor.
@@ -421,6 +749,21 @@ type RemoteBlueprintReference struct {
 	ResourceName string `json:"resourceName"`
 }
 
+// GitBlueprintReference describes a reference to a blueprint in a git repository
+// that is configured in the git registry of the landscaper.
+type GitBlueprintReference struct {
+	// Repository is the name of the git repository as configured in the git registry of the landscaper.
+	Repository string `json:"repository"`
+	// Path is the path of the blueprint directory in the repository.
+	// Defaults to the root directory of the repository.
+	// +optional
+	Path string `json:"path,omitempty"`
+	// Revision is the branch, tag or commit from which the blueprint is read.
+	// Defaults to the ref that is configured for the repository.
+	// +optional
+	Revision string `json:"revision,omitempty"`
+}
+
 // InlineBlueprint defines a inline blueprint with component descriptor and
 // filesystem.
 type InlineBlueprint struct {
@@ -510,20 +853,22 @@ type SecretLabelSelectorRef struct {
 func (ti TargetImport) MarshalJSON() ([]byte, error) {
 
 	type TargetImportWithTargets struct {
-		Name                string            `json:"name"`
-		Target              string            `json:"target,omitempty"`
-		Targets             []string          `json:"targets"`
-		TargetListReference string            `json:"targetListRef,omitempty"`
-		TargetMap           map[string]string `json:"targetMap,omitempty"`
-		TargetMapReference  string            `json:"targetMapRef,omitempty"`
+		Name                string                `json:"name"`
+		Target              string                `json:"target,omitempty"`
+		Targets             []string              `json:"targets"`
+		TargetListReference string                `json:"targetListRef,omitempty"`
+		TargetSelector      *metav1.LabelSelector `json:"targetSelector,omitempty"`
+		TargetMap           map[string]string     `json:"targetMap,omitempty"`
+		TargetMapReference  string                `json:"targetMapRef,omitempty"`
 	}
 	type TargetImportWithoutTargets struct {
-		Name                string            `json:"name"`
-		Target              string            `json:"target,omitempty"`
-		Targets             []string          `json:"targets,omitempty"`
-		TargetListReference string            `json:"targetListRef,omitempty"`
-		TargetMap           map[string]string `json:"targetMap,omitempty"`
-		TargetMapReference  string            `json:"targetMapRef,omitempty"`
+		Name                string                `json:"name"`
+		Target              string                `json:"target,omitempty"`
+		Targets             []string              `json:"targets,omitempty"`
+		TargetListReference string                `json:"targetListRef,omitempty"`
+		TargetSelector      *metav1.LabelSelector `json:"targetSelector,omitempty"`
+		TargetMap           map[string]string     `json:"targetMap,omitempty"`
+		TargetMapReference  string                `json:"targetMapRef,omitempty"`
 	}
 
 	if ti.Targets == nil {
@@ -569,6 +914,22 @@ func (inst *Installation) IsImportingTarget(name string) bool {
 	return false
 }
 
+// RenderedSubinstallation describes a subinstallation that has been rendered from a template of the blueprint.
+type RenderedSubinstallation struct {
+	// Name is the name of the subinstallation in the blueprint.
+	Name string `json:"name"`
+
+	// Template is the name of the template from which the subinstallation has been generated.
+	Template string `json:"template"`
+
+	// ForEachKey is the key or the index of the list element from which the subinstallation has been generated.
+	// +optional
+	ForEachKey string `json:"forEachKey,omitempty"`
+
+	// Hash is the hash of the rendered installation template.
+	Hash string `json:"hash"`
+}
+
 // SubInstCache contains the existing sub installations
 type SubInstCache struct {
 	ActiveSubs   []SubNamePair `json:"activeSubs,omitempty"`
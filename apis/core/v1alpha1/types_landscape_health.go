@@ -0,0 +1,126 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// LandscapeHealthList contains a list of LandscapeHealths
+type LandscapeHealthList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []LandscapeHealth `json:"items"`
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+// +kubebuilder:resource:shortName=lshealth,singular=landscapehealth
+// +kubebuilder:subresource:status
+// +kubebuilder:printcolumn:name="Health",type=string,JSONPath=`.status.health`
+// +kubebuilder:printcolumn:name="Installations",type=integer,JSONPath=`.status.installations`
+// +kubebuilder:printcolumn:name="Failed",type=integer,JSONPath=`.status.failedInstallations`
+// +kubebuilder:printcolumn:name="Age",type=date,JSONPath=`.metadata.creationTimestamp`
+
+// LandscapeHealth summarizes the health of the installations in its namespace,
+// so that dashboards and alerting do not have to evaluate every installation on their own.
+type LandscapeHealth struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	// Spec selects the installations whose health is summarized.
+	Spec LandscapeHealthSpec `json:"spec"`
+
+	// Status contains the summarized health of the selected installations.
+	// +optional
+	Status LandscapeHealthStatus `json:"status"`
+}
+
+// LandscapeHealthSpec selects the installations whose health is summarized.
+type LandscapeHealthSpec struct {
+	// Selector selects the installations in the namespace of the LandscapeHealth by their labels.
+	// All installations of the namespace are selected if not set.
+	// +optional
+	Selector *metav1.LabelSelector `json:"selector,omitempty"`
+
+	// RootInstallationsOnly restricts the summary to root installations.
+	// +optional
+	RootInstallationsOnly bool `json:"rootInstallationsOnly,omitempty"`
+}
+
+// LandscapeHealthState describes the summarized health of a set of installations.
+type LandscapeHealthState string
+
+const (
+	// LandscapeHealthStateHealthy is the state of a set of installations that all succeeded.
+	LandscapeHealthStateHealthy LandscapeHealthState = "Healthy"
+	// LandscapeHealthStateProgressing is the state of a set of installations without failed installations
+	// of which at least one is not yet finished.
+	LandscapeHealthStateProgressing LandscapeHealthState = "Progressing"
+	// LandscapeHealthStateUnhealthy is the state of a set of installations of which at least one failed.
+	LandscapeHealthStateUnhealthy LandscapeHealthState = "Unhealthy"
+)
+
+// LandscapeHealthStatus contains the summarized health of the selected installations.
+type LandscapeHealthStatus struct {
+	// ObservedGeneration is the generation of the LandscapeHealth that was used for the summary.
+	// +optional
+	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
+
+	// Health is the summarized health of the selected installations.
+	// +optional
+	Health LandscapeHealthState `json:"health,omitempty"`
+
+	// Installations is the number of selected installations.
+	// +optional
+	Installations int32 `json:"installations,omitempty"`
+
+	// FailedInstallations is the number of selected installations in a failed phase.
+	// +optional
+	FailedInstallations int32 `json:"failedInstallations,omitempty"`
+
+	// PhaseCounts contains the number of selected installations per phase.
+	// +optional
+	PhaseCounts []InstallationPhaseCount `json:"phaseCounts,omitempty"`
+
+	// OldestFailure is the selected installation that is failed for the longest time.
+	// +optional
+	OldestFailure *InstallationHealthRecord `json:"oldestFailure,omitempty"`
+
+	// LastSuccessfulRun is the selected installation that succeeded most recently.
+	// +optional
+	LastSuccessfulRun *InstallationHealthRecord `json:"lastSuccessfulRun,omitempty"`
+
+	// LastUpdateTime is the time when the summary was computed.
+	// +optional
+	LastUpdateTime *metav1.Time `json:"lastUpdateTime,omitempty"`
+}
+
+// InstallationPhaseCount is the number of installations in a phase.
+type InstallationPhaseCount struct {
+	// Phase is the installation phase.
+	Phase InstallationPhase `json:"phase"`
+
+	// Count is the number of installations in the phase.
+	Count int32 `json:"count"`
+}
+
+// InstallationHealthRecord describes an installation of a LandscapeHealth summary.
+type InstallationHealthRecord struct {
+	// Name is the name of the installation.
+	Name string `json:"name"`
+
+	// Phase is the phase of the installation.
+	Phase InstallationPhase `json:"phase"`
+
+	// Time is the time when the installation entered the phase.
+	// +optional
+	Time *metav1.Time `json:"time,omitempty"`
+
+	// Message is the message of the last error of a failed installation.
+	// +optional
+	Message string `json:"message,omitempty"`
+}
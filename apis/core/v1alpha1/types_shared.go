@@ -147,6 +147,23 @@ const (
 	ConditionCheckError = "ConditionCheckError"
 )
 
+// Standard condition types of installations and executions. They are maintained by the controllers
+// according to the phase of the objects, so that standard tooling like "kubectl wait" can be used.
+const (
+	// ReconciledCondition indicates whether the last reconcile job of an installation or execution has succeeded.
+	ReconciledCondition ConditionType = "Reconciled"
+	// ImportsSatisfiedCondition indicates whether the imports of an installation are available and valid.
+	ImportsSatisfiedCondition ConditionType = "ImportsSatisfied"
+	// DeployItemsHealthyCondition indicates whether the deploy items of an execution, respectively the executions and
+	// subinstallations of an installation, have succeeded.
+	DeployItemsHealthyCondition ConditionType = "DeployItemsHealthy"
+	// ExportsReadyCondition indicates whether the exports of an installation or execution are up-to-date.
+	ExportsReadyCondition ConditionType = "ExportsReady"
+	// DeletedCondition indicates the progress of the deletion of an installation or execution. It is only set during
+	// the deletion and is never true, because the object is gone as soon as the deletion has succeeded.
+	DeletedCondition ConditionType = "Deleted"
+)
+
 // ErrorCode is a string alias.
 type ErrorCode string
 
@@ -171,6 +188,8 @@ const (
 	ErrorForInfoOnly ErrorCode = "ERR_FOR_INFO_ONLY"
 	// ErrorNoRetry indicates that no retry is required.
 	ErrorNoRetry ErrorCode = "ERR_NO_RETRY"
+	// ErrorQuotaExceeded indicates that the resource quotas of a target namespace do not admit the deployed resources.
+	ErrorQuotaExceeded ErrorCode = "ERR_QUOTA_EXCEEDED"
 )
 
 // UnrecoverableErrorCodes defines unrecoverable error codes
@@ -224,7 +243,9 @@ const (
 	// If set it triggers a reconciliation for all dependent resources.
 	ReconcileOperation Operation = "reconcile"
 
-	// ForceReconcileOperation is currently not used.
+	// ForceReconcileOperation is an annotation for the landscaper to reconcile root installations like the
+	// ReconcileOperation. In contrast to it, all deploy items are redeployed, even if a reconcile reason is set.
+	// If set at a deploy item, the deployer creates or updates its resources without further checks.
 	ForceReconcileOperation Operation = "force-reconcile"
 
 	// InterruptOperation is the annotation to let the landscaper interrupt all currently running deploy items of an
@@ -232,6 +253,12 @@ const (
 	// deployer could do some cleanup.
 	InterruptOperation Operation = "interrupt"
 
+	// AbortOperation is the annotation to let the responsible deployer abort the processing of a deploy item.
+	// The deployer stops its ongoing work, e.g. kills a running pod or cancels an in-flight apply, and sets the
+	// deploy item to failed if it is not yet finished. The landscaper sets it on all deploy items that are not
+	// in a final phase when their execution is interrupted.
+	AbortOperation Operation = "abort"
+
 	// TestReconcileOperation is only used for test purposes. If set at a DeployItem, it triggers a reconciliation
 	// of that DeployItem. It must not be used in a productive scenario.
 	TestReconcileOperation Operation = "test-reconcile"
@@ -385,4 +412,6 @@ type Optimization struct {
 	HasNoSiblingImports bool `json:"hasNoSiblingImports,omitempty"`
 	// set this on true if the installation does not export data to its siblings or has no siblings at all
 	HasNoSiblingExports bool `json:"hasNoSiblingExports,omitempty"`
+	// set this on true to redeploy only those deploy items whose specification or consumed imports have changed
+	PartialImportUpdates bool `json:"partialImportUpdates,omitempty"`
 }
@@ -41,7 +41,7 @@ type Target struct {
 // TargetSpec contains the definition of a target.
 type TargetSpec struct {
 	// Type is the type of the target that defines its data structure.
-	// The actual schema may be defined by a target type crd in the future.
+	// The schema of its configuration can be defined by a TargetTypeDefinition.
 	Type TargetType `json:"type"`
 
 	// Configuration contains the target type specific configuration.
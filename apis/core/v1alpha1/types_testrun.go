@@ -0,0 +1,182 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// TestRunLabel is the label that references the TestRun of a verification installation.
+const TestRunLabel = LandscaperDomain + "/testrun"
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// TestRunList contains a list of TestRuns
+type TestRunList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []TestRun `json:"items"`
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+// +kubebuilder:resource:shortName=trun,singular=testrun
+// +kubebuilder:subresource:status
+// +kubebuilder:printcolumn:name="Phase",type=string,JSONPath=`.status.phase`
+// +kubebuilder:printcolumn:name="Started",type=date,JSONPath=`.status.startTime`
+// +kubebuilder:printcolumn:name="Age",type=date,JSONPath=`.metadata.creationTimestamp`
+
+// TestRun executes verification installations against a landscape on demand or on a schedule
+// and records whether they succeeded together with their exports.
+type TestRun struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	// Spec contains the specification of the tests.
+	Spec TestRunSpec `json:"spec"`
+
+	// Status contains the results of the current resp. last run.
+	// +optional
+	Status TestRunStatus `json:"status"`
+}
+
+// TestRunSpec defines the tests of a TestRun and when they are executed.
+type TestRunSpec struct {
+	// Tests are the verification installations that are executed in every run.
+	Tests []TestDefinition `json:"tests"`
+
+	// Schedule configures automatically repeated runs.
+	// If not set, the tests only run after the creation and modification of the TestRun,
+	// and when the TestRun is annotated with the reconcile operation.
+	// +optional
+	Schedule *TestRunSchedule `json:"schedule,omitempty"`
+
+	// Timeout is the maximal duration of a run. Tests that are not finished after the timeout are failed.
+	// Defaults to 30 minutes.
+	// +optional
+	Timeout *Duration `json:"timeout,omitempty"`
+}
+
+// TestRunSchedule defines automatically repeated runs.
+type TestRunSchedule struct {
+	// Interval specifies the interval between the start of two subsequent runs.
+	// +optional
+	Interval *Duration `json:"interval,omitempty"`
+
+	// CronSpec describes the start times of the runs according to the cron syntax "https://pkg.go.dev/github.com/robfig/cron#hdr-CRON_Expression_Format".
+	// If not empty, this specification is used instead of Interval.
+	// +optional
+	CronSpec string `json:"cronSpec,omitempty"`
+}
+
+// TestDefinition defines a verification installation, e.g. of a smoke test blueprint.
+type TestDefinition struct {
+	// Name is the unique name of the test within the TestRun.
+	Name string `json:"name"`
+
+	// Installation is the specification of the root installation that executes the test.
+	// The installation is created in the namespace of the TestRun and reconciled in every run.
+	Installation InstallationSpec `json:"installation"`
+}
+
+// TestRunPhase describes the phase of a run resp. of a single test.
+type TestRunPhase string
+
+const (
+	// TestRunPhasePending is the phase of a test whose installation has been triggered but not yet started.
+	TestRunPhasePending TestRunPhase = "Pending"
+	// TestRunPhaseRunning is the phase of a run resp. test that is not yet finished.
+	TestRunPhaseRunning TestRunPhase = "Running"
+	// TestRunPhaseSucceeded is the phase of a run resp. test that finished successfully.
+	TestRunPhaseSucceeded TestRunPhase = "Succeeded"
+	// TestRunPhaseFailed is the phase of a run resp. test that failed or did not finish within the timeout.
+	TestRunPhaseFailed TestRunPhase = "Failed"
+)
+
+// IsFinal returns true if the run resp. test is finished.
+func (p TestRunPhase) IsFinal() bool {
+	return p == TestRunPhaseSucceeded || p == TestRunPhaseFailed
+}
+
+// TestRunStatus contains the results of the current resp. last run of a TestRun.
+type TestRunStatus struct {
+	// ObservedGeneration is the generation of the TestRun that was used for the current resp. last run.
+	// +optional
+	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
+
+	// Phase is the phase of the current resp. last run.
+	// +optional
+	Phase TestRunPhase `json:"phase,omitempty"`
+
+	// Message contains details about the current phase, e.g. an invalid schedule.
+	// +optional
+	Message string `json:"message,omitempty"`
+
+	// StartTime is the time when the current resp. last run was started.
+	// +optional
+	StartTime *metav1.Time `json:"startTime,omitempty"`
+
+	// FinishedTime is the time when the last run finished.
+	// +optional
+	FinishedTime *metav1.Time `json:"finishedTime,omitempty"`
+
+	// NextRunTime is the time when the next scheduled run is started.
+	// +optional
+	NextRunTime *metav1.Time `json:"nextRunTime,omitempty"`
+
+	// Results contains the results of the tests of the current resp. last run.
+	// +optional
+	Results []TestResult `json:"results,omitempty"`
+}
+
+// TestResult contains the result of a single test.
+type TestResult struct {
+	// Name is the name of the test.
+	Name string `json:"name"`
+
+	// InstallationRef references the installation that executes the test.
+	InstallationRef ObjectReference `json:"installationRef"`
+
+	// Phase is the phase of the test.
+	Phase TestRunPhase `json:"phase"`
+
+	// JobID is the job ID of the installation the result is observed for.
+	// As long as the test is pending, it is the job ID of the previous run.
+	// +optional
+	JobID string `json:"jobID,omitempty"`
+
+	// Message contains details about a failed test.
+	// +optional
+	Message string `json:"message,omitempty"`
+
+	// FinishedTime is the time when the test finished.
+	// +optional
+	FinishedTime *metav1.Time `json:"finishedTime,omitempty"`
+
+	// Artifacts references the data objects and targets that were exported by a finished test.
+	// +optional
+	Artifacts []TestArtifact `json:"artifacts,omitempty"`
+}
+
+// TestArtifactKind is the kind of the object that contains an exported artifact.
+type TestArtifactKind string
+
+const (
+	// TestArtifactKindDataObject is the kind of artifacts that are exported as data objects.
+	TestArtifactKindDataObject TestArtifactKind = "DataObject"
+	// TestArtifactKindTarget is the kind of artifacts that are exported as targets.
+	TestArtifactKindTarget TestArtifactKind = "Target"
+)
+
+// TestArtifact references an export of a verification installation.
+type TestArtifact struct {
+	// Name is the name of the export.
+	Name string `json:"name"`
+
+	// Kind is the kind of the object that contains the exported artifact.
+	Kind TestArtifactKind `json:"kind"`
+
+	// ObjectRef references the object that contains the exported artifact.
+	ObjectRef ObjectReference `json:"objectRef"`
+}
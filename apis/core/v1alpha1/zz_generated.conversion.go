@@ -40,6 +40,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*ApprovalRecord)(nil), (*core.ApprovalRecord)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_ApprovalRecord_To_core_ApprovalRecord(a.(*ApprovalRecord), b.(*core.ApprovalRecord), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*core.ApprovalRecord)(nil), (*ApprovalRecord)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_core_ApprovalRecord_To_v1alpha1_ApprovalRecord(a.(*core.ApprovalRecord), b.(*ApprovalRecord), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*AutomaticReconcile)(nil), (*core.AutomaticReconcile)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha1_AutomaticReconcile_To_core_AutomaticReconcile(a.(*AutomaticReconcile), b.(*core.AutomaticReconcile), scope)
 	}); err != nil {
@@ -310,6 +320,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*DeferredDeployItem)(nil), (*core.DeferredDeployItem)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_DeferredDeployItem_To_core_DeferredDeployItem(a.(*DeferredDeployItem), b.(*core.DeferredDeployItem), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*core.DeferredDeployItem)(nil), (*DeferredDeployItem)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_core_DeferredDeployItem_To_v1alpha1_DeferredDeployItem(a.(*core.DeferredDeployItem), b.(*DeferredDeployItem), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*DependentToTrigger)(nil), (*core.DependentToTrigger)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha1_DependentToTrigger_To_core_DependentToTrigger(a.(*DependentToTrigger), b.(*core.DependentToTrigger), scope)
 	}); err != nil {
@@ -340,6 +360,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*DeployItemHash)(nil), (*core.DeployItemHash)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_DeployItemHash_To_core_DeployItemHash(a.(*DeployItemHash), b.(*core.DeployItemHash), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*core.DeployItemHash)(nil), (*DeployItemHash)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_core_DeployItemHash_To_v1alpha1_DeployItemHash(a.(*core.DeployItemHash), b.(*DeployItemHash), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*DeployItemList)(nil), (*core.DeployItemList)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha1_DeployItemList_To_core_DeployItemList(a.(*DeployItemList), b.(*core.DeployItemList), scope)
 	}); err != nil {
@@ -350,6 +380,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*DeployItemProgress)(nil), (*core.DeployItemProgress)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_DeployItemProgress_To_core_DeployItemProgress(a.(*DeployItemProgress), b.(*core.DeployItemProgress), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*core.DeployItemProgress)(nil), (*DeployItemProgress)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_core_DeployItemProgress_To_v1alpha1_DeployItemProgress(a.(*core.DeployItemProgress), b.(*DeployItemProgress), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*DeployItemSpec)(nil), (*core.DeployItemSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha1_DeployItemSpec_To_core_DeployItemSpec(a.(*DeployItemSpec), b.(*core.DeployItemSpec), scope)
 	}); err != nil {
@@ -380,6 +420,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*DeployerHealthCheck)(nil), (*core.DeployerHealthCheck)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_DeployerHealthCheck_To_core_DeployerHealthCheck(a.(*DeployerHealthCheck), b.(*core.DeployerHealthCheck), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*core.DeployerHealthCheck)(nil), (*DeployerHealthCheck)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_core_DeployerHealthCheck_To_v1alpha1_DeployerHealthCheck(a.(*core.DeployerHealthCheck), b.(*DeployerHealthCheck), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*DeployerInformation)(nil), (*core.DeployerInformation)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha1_DeployerInformation_To_core_DeployerInformation(a.(*DeployerInformation), b.(*core.DeployerInformation), scope)
 	}); err != nil {
@@ -390,6 +440,56 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*DeployerProgress)(nil), (*core.DeployerProgress)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_DeployerProgress_To_core_DeployerProgress(a.(*DeployerProgress), b.(*core.DeployerProgress), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*core.DeployerProgress)(nil), (*DeployerProgress)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_core_DeployerProgress_To_v1alpha1_DeployerProgress(a.(*core.DeployerProgress), b.(*DeployerProgress), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*DeployerRegistration)(nil), (*core.DeployerRegistration)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_DeployerRegistration_To_core_DeployerRegistration(a.(*DeployerRegistration), b.(*core.DeployerRegistration), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*core.DeployerRegistration)(nil), (*DeployerRegistration)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_core_DeployerRegistration_To_v1alpha1_DeployerRegistration(a.(*core.DeployerRegistration), b.(*DeployerRegistration), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*DeployerRegistrationList)(nil), (*core.DeployerRegistrationList)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_DeployerRegistrationList_To_core_DeployerRegistrationList(a.(*DeployerRegistrationList), b.(*core.DeployerRegistrationList), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*core.DeployerRegistrationList)(nil), (*DeployerRegistrationList)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_core_DeployerRegistrationList_To_v1alpha1_DeployerRegistrationList(a.(*core.DeployerRegistrationList), b.(*DeployerRegistrationList), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*DeployerRegistrationSpec)(nil), (*core.DeployerRegistrationSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_DeployerRegistrationSpec_To_core_DeployerRegistrationSpec(a.(*DeployerRegistrationSpec), b.(*core.DeployerRegistrationSpec), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*core.DeployerRegistrationSpec)(nil), (*DeployerRegistrationSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_core_DeployerRegistrationSpec_To_v1alpha1_DeployerRegistrationSpec(a.(*core.DeployerRegistrationSpec), b.(*DeployerRegistrationSpec), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*DeployerRegistrationStatus)(nil), (*core.DeployerRegistrationStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_DeployerRegistrationStatus_To_core_DeployerRegistrationStatus(a.(*DeployerRegistrationStatus), b.(*core.DeployerRegistrationStatus), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*core.DeployerRegistrationStatus)(nil), (*DeployerRegistrationStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_core_DeployerRegistrationStatus_To_v1alpha1_DeployerRegistrationStatus(a.(*core.DeployerRegistrationStatus), b.(*DeployerRegistrationStatus), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*DiNamePair)(nil), (*core.DiNamePair)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha1_DiNamePair_To_core_DiNamePair(a.(*DiNamePair), b.(*core.DiNamePair), scope)
 	}); err != nil {
@@ -420,6 +520,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*ExclusionWindow)(nil), (*core.ExclusionWindow)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_ExclusionWindow_To_core_ExclusionWindow(a.(*ExclusionWindow), b.(*core.ExclusionWindow), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*core.ExclusionWindow)(nil), (*ExclusionWindow)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_core_ExclusionWindow_To_v1alpha1_ExclusionWindow(a.(*core.ExclusionWindow), b.(*ExclusionWindow), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*Execution)(nil), (*core.Execution)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha1_Execution_To_core_Execution(a.(*Execution), b.(*core.Execution), scope)
 	}); err != nil {
@@ -440,6 +550,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*ExecutionProgress)(nil), (*core.ExecutionProgress)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_ExecutionProgress_To_core_ExecutionProgress(a.(*ExecutionProgress), b.(*core.ExecutionProgress), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*core.ExecutionProgress)(nil), (*ExecutionProgress)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_core_ExecutionProgress_To_v1alpha1_ExecutionProgress(a.(*core.ExecutionProgress), b.(*ExecutionProgress), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*ExecutionStatus)(nil), (*core.ExecutionStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha1_ExecutionStatus_To_core_ExecutionStatus(a.(*ExecutionStatus), b.(*core.ExecutionStatus), scope)
 	}); err != nil {
@@ -460,6 +580,36 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*ExportSink)(nil), (*core.ExportSink)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_ExportSink_To_core_ExportSink(a.(*ExportSink), b.(*core.ExportSink), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*core.ExportSink)(nil), (*ExportSink)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_core_ExportSink_To_v1alpha1_ExportSink(a.(*core.ExportSink), b.(*ExportSink), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*ExportSinkData)(nil), (*core.ExportSinkData)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_ExportSinkData_To_core_ExportSinkData(a.(*ExportSinkData), b.(*core.ExportSinkData), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*core.ExportSinkData)(nil), (*ExportSinkData)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_core_ExportSinkData_To_v1alpha1_ExportSinkData(a.(*core.ExportSinkData), b.(*ExportSinkData), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*ExportSinkReference)(nil), (*core.ExportSinkReference)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_ExportSinkReference_To_core_ExportSinkReference(a.(*ExportSinkReference), b.(*core.ExportSinkReference), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*core.ExportSinkReference)(nil), (*ExportSinkReference)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_core_ExportSinkReference_To_v1alpha1_ExportSinkReference(a.(*core.ExportSinkReference), b.(*ExportSinkReference), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*FailedReconcile)(nil), (*core.FailedReconcile)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha1_FailedReconcile_To_core_FailedReconcile(a.(*FailedReconcile), b.(*core.FailedReconcile), scope)
 	}); err != nil {
@@ -470,6 +620,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*FeatureFlagDefinition)(nil), (*core.FeatureFlagDefinition)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_FeatureFlagDefinition_To_core_FeatureFlagDefinition(a.(*FeatureFlagDefinition), b.(*core.FeatureFlagDefinition), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*core.FeatureFlagDefinition)(nil), (*FeatureFlagDefinition)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_core_FeatureFlagDefinition_To_v1alpha1_FeatureFlagDefinition(a.(*core.FeatureFlagDefinition), b.(*FeatureFlagDefinition), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*FieldValueDefinition)(nil), (*core.FieldValueDefinition)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha1_FieldValueDefinition_To_core_FieldValueDefinition(a.(*FieldValueDefinition), b.(*core.FieldValueDefinition), scope)
 	}); err != nil {
@@ -480,6 +640,36 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*GitBlueprintReference)(nil), (*core.GitBlueprintReference)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_GitBlueprintReference_To_core_GitBlueprintReference(a.(*GitBlueprintReference), b.(*core.GitBlueprintReference), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*core.GitBlueprintReference)(nil), (*GitBlueprintReference)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_core_GitBlueprintReference_To_v1alpha1_GitBlueprintReference(a.(*core.GitBlueprintReference), b.(*GitBlueprintReference), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*HTTPDataReference)(nil), (*core.HTTPDataReference)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_HTTPDataReference_To_core_HTTPDataReference(a.(*HTTPDataReference), b.(*core.HTTPDataReference), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*core.HTTPDataReference)(nil), (*HTTPDataReference)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_core_HTTPDataReference_To_v1alpha1_HTTPDataReference(a.(*core.HTTPDataReference), b.(*HTTPDataReference), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*ImagePullSecretsConfiguration)(nil), (*core.ImagePullSecretsConfiguration)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_ImagePullSecretsConfiguration_To_core_ImagePullSecretsConfiguration(a.(*ImagePullSecretsConfiguration), b.(*core.ImagePullSecretsConfiguration), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*core.ImagePullSecretsConfiguration)(nil), (*ImagePullSecretsConfiguration)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_core_ImagePullSecretsConfiguration_To_v1alpha1_ImagePullSecretsConfiguration(a.(*core.ImagePullSecretsConfiguration), b.(*ImagePullSecretsConfiguration), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*ImportDefinition)(nil), (*core.ImportDefinition)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha1_ImportDefinition_To_core_ImportDefinition(a.(*ImportDefinition), b.(*core.ImportDefinition), scope)
 	}); err != nil {
@@ -490,6 +680,36 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*ImportQuarantine)(nil), (*core.ImportQuarantine)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_ImportQuarantine_To_core_ImportQuarantine(a.(*ImportQuarantine), b.(*core.ImportQuarantine), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*core.ImportQuarantine)(nil), (*ImportQuarantine)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_core_ImportQuarantine_To_v1alpha1_ImportQuarantine(a.(*core.ImportQuarantine), b.(*ImportQuarantine), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*ImportSource)(nil), (*core.ImportSource)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_ImportSource_To_core_ImportSource(a.(*ImportSource), b.(*core.ImportSource), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*core.ImportSource)(nil), (*ImportSource)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_core_ImportSource_To_v1alpha1_ImportSource(a.(*core.ImportSource), b.(*ImportSource), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*ImportTransformation)(nil), (*core.ImportTransformation)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_ImportTransformation_To_core_ImportTransformation(a.(*ImportTransformation), b.(*core.ImportTransformation), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*core.ImportTransformation)(nil), (*ImportTransformation)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_core_ImportTransformation_To_v1alpha1_ImportTransformation(a.(*core.ImportTransformation), b.(*ImportTransformation), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*InlineBlueprint)(nil), (*core.InlineBlueprint)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha1_InlineBlueprint_To_core_InlineBlueprint(a.(*InlineBlueprint), b.(*core.InlineBlueprint), scope)
 	}); err != nil {
@@ -520,6 +740,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*InstallationHealthRecord)(nil), (*core.InstallationHealthRecord)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_InstallationHealthRecord_To_core_InstallationHealthRecord(a.(*InstallationHealthRecord), b.(*core.InstallationHealthRecord), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*core.InstallationHealthRecord)(nil), (*InstallationHealthRecord)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_core_InstallationHealthRecord_To_v1alpha1_InstallationHealthRecord(a.(*core.InstallationHealthRecord), b.(*InstallationHealthRecord), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*InstallationImports)(nil), (*core.InstallationImports)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha1_InstallationImports_To_core_InstallationImports(a.(*InstallationImports), b.(*core.InstallationImports), scope)
 	}); err != nil {
@@ -540,6 +770,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*InstallationPhaseCount)(nil), (*core.InstallationPhaseCount)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_InstallationPhaseCount_To_core_InstallationPhaseCount(a.(*InstallationPhaseCount), b.(*core.InstallationPhaseCount), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*core.InstallationPhaseCount)(nil), (*InstallationPhaseCount)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_core_InstallationPhaseCount_To_v1alpha1_InstallationPhaseCount(a.(*core.InstallationPhaseCount), b.(*InstallationPhaseCount), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*InstallationSpec)(nil), (*core.InstallationSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha1_InstallationSpec_To_core_InstallationSpec(a.(*InstallationSpec), b.(*core.InstallationSpec), scope)
 	}); err != nil {
@@ -580,6 +820,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*InstallationTemplateForEach)(nil), (*core.InstallationTemplateForEach)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_InstallationTemplateForEach_To_core_InstallationTemplateForEach(a.(*InstallationTemplateForEach), b.(*core.InstallationTemplateForEach), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*core.InstallationTemplateForEach)(nil), (*InstallationTemplateForEach)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_core_InstallationTemplateForEach_To_v1alpha1_InstallationTemplateForEach(a.(*core.InstallationTemplateForEach), b.(*InstallationTemplateForEach), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*JSONSchemaDefinition)(nil), (*core.JSONSchemaDefinition)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha1_JSONSchemaDefinition_To_core_JSONSchemaDefinition(a.(*JSONSchemaDefinition), b.(*core.JSONSchemaDefinition), scope)
 	}); err != nil {
@@ -590,6 +840,46 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*LandscapeHealth)(nil), (*core.LandscapeHealth)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_LandscapeHealth_To_core_LandscapeHealth(a.(*LandscapeHealth), b.(*core.LandscapeHealth), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*core.LandscapeHealth)(nil), (*LandscapeHealth)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_core_LandscapeHealth_To_v1alpha1_LandscapeHealth(a.(*core.LandscapeHealth), b.(*LandscapeHealth), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*LandscapeHealthList)(nil), (*core.LandscapeHealthList)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_LandscapeHealthList_To_core_LandscapeHealthList(a.(*LandscapeHealthList), b.(*core.LandscapeHealthList), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*core.LandscapeHealthList)(nil), (*LandscapeHealthList)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_core_LandscapeHealthList_To_v1alpha1_LandscapeHealthList(a.(*core.LandscapeHealthList), b.(*LandscapeHealthList), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*LandscapeHealthSpec)(nil), (*core.LandscapeHealthSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_LandscapeHealthSpec_To_core_LandscapeHealthSpec(a.(*LandscapeHealthSpec), b.(*core.LandscapeHealthSpec), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*core.LandscapeHealthSpec)(nil), (*LandscapeHealthSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_core_LandscapeHealthSpec_To_v1alpha1_LandscapeHealthSpec(a.(*core.LandscapeHealthSpec), b.(*LandscapeHealthSpec), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*LandscapeHealthStatus)(nil), (*core.LandscapeHealthStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_LandscapeHealthStatus_To_core_LandscapeHealthStatus(a.(*LandscapeHealthStatus), b.(*core.LandscapeHealthStatus), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*core.LandscapeHealthStatus)(nil), (*LandscapeHealthStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_core_LandscapeHealthStatus_To_v1alpha1_LandscapeHealthStatus(a.(*core.LandscapeHealthStatus), b.(*LandscapeHealthStatus), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*LocalConfigMapReference)(nil), (*core.LocalConfigMapReference)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha1_LocalConfigMapReference_To_core_LocalConfigMapReference(a.(*LocalConfigMapReference), b.(*core.LocalConfigMapReference), scope)
 	}); err != nil {
@@ -670,6 +960,26 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*PredecessorStatus)(nil), (*core.PredecessorStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_PredecessorStatus_To_core_PredecessorStatus(a.(*PredecessorStatus), b.(*core.PredecessorStatus), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*core.PredecessorStatus)(nil), (*PredecessorStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_core_PredecessorStatus_To_v1alpha1_PredecessorStatus(a.(*core.PredecessorStatus), b.(*PredecessorStatus), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*ReconcileSchedule)(nil), (*core.ReconcileSchedule)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_ReconcileSchedule_To_core_ReconcileSchedule(a.(*ReconcileSchedule), b.(*core.ReconcileSchedule), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*core.ReconcileSchedule)(nil), (*ReconcileSchedule)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_core_ReconcileSchedule_To_v1alpha1_ReconcileSchedule(a.(*core.ReconcileSchedule), b.(*ReconcileSchedule), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*RemoteBlueprintReference)(nil), (*core.RemoteBlueprintReference)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha1_RemoteBlueprintReference_To_core_RemoteBlueprintReference(a.(*RemoteBlueprintReference), b.(*core.RemoteBlueprintReference), scope)
 	}); err != nil {
@@ -680,6 +990,26 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*RenderStage)(nil), (*core.RenderStage)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_RenderStage_To_core_RenderStage(a.(*RenderStage), b.(*core.RenderStage), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*core.RenderStage)(nil), (*RenderStage)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_core_RenderStage_To_v1alpha1_RenderStage(a.(*core.RenderStage), b.(*RenderStage), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*RenderedSubinstallation)(nil), (*core.RenderedSubinstallation)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_RenderedSubinstallation_To_core_RenderedSubinstallation(a.(*RenderedSubinstallation), b.(*core.RenderedSubinstallation), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*core.RenderedSubinstallation)(nil), (*RenderedSubinstallation)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_core_RenderedSubinstallation_To_v1alpha1_RenderedSubinstallation(a.(*core.RenderedSubinstallation), b.(*RenderedSubinstallation), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*Requirement)(nil), (*core.Requirement)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha1_Requirement_To_core_Requirement(a.(*Requirement), b.(*core.Requirement), scope)
 	}); err != nil {
@@ -690,6 +1020,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*ResolvedComponentVersion)(nil), (*core.ResolvedComponentVersion)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_ResolvedComponentVersion_To_core_ResolvedComponentVersion(a.(*ResolvedComponentVersion), b.(*core.ResolvedComponentVersion), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*core.ResolvedComponentVersion)(nil), (*ResolvedComponentVersion)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_core_ResolvedComponentVersion_To_v1alpha1_ResolvedComponentVersion(a.(*core.ResolvedComponentVersion), b.(*ResolvedComponentVersion), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*ResolvedTarget)(nil), (*core.ResolvedTarget)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha1_ResolvedTarget_To_core_ResolvedTarget(a.(*ResolvedTarget), b.(*core.ResolvedTarget), scope)
 	}); err != nil {
@@ -710,6 +1050,26 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*RetryPolicy)(nil), (*core.RetryPolicy)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_RetryPolicy_To_core_RetryPolicy(a.(*RetryPolicy), b.(*core.RetryPolicy), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*core.RetryPolicy)(nil), (*RetryPolicy)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_core_RetryPolicy_To_v1alpha1_RetryPolicy(a.(*core.RetryPolicy), b.(*RetryPolicy), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*SchedulingConfiguration)(nil), (*core.SchedulingConfiguration)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_SchedulingConfiguration_To_core_SchedulingConfiguration(a.(*SchedulingConfiguration), b.(*core.SchedulingConfiguration), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*core.SchedulingConfiguration)(nil), (*SchedulingConfiguration)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_core_SchedulingConfiguration_To_v1alpha1_SchedulingConfiguration(a.(*core.SchedulingConfiguration), b.(*SchedulingConfiguration), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*SecretLabelSelectorRef)(nil), (*core.SecretLabelSelectorRef)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha1_SecretLabelSelectorRef_To_core_SecretLabelSelectorRef(a.(*SecretLabelSelectorRef), b.(*core.SecretLabelSelectorRef), scope)
 	}); err != nil {
@@ -940,93 +1300,203 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
-	if err := s.AddGeneratedConversionFunc((*TemplateExecutor)(nil), (*core.TemplateExecutor)(nil), func(a, b interface{}, scope conversion.Scope) error {
-		return Convert_v1alpha1_TemplateExecutor_To_core_TemplateExecutor(a.(*TemplateExecutor), b.(*core.TemplateExecutor), scope)
+	if err := s.AddGeneratedConversionFunc((*TargetTypeDefinition)(nil), (*core.TargetTypeDefinition)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_TargetTypeDefinition_To_core_TargetTypeDefinition(a.(*TargetTypeDefinition), b.(*core.TargetTypeDefinition), scope)
 	}); err != nil {
 		return err
 	}
-	if err := s.AddGeneratedConversionFunc((*core.TemplateExecutor)(nil), (*TemplateExecutor)(nil), func(a, b interface{}, scope conversion.Scope) error {
-		return Convert_core_TemplateExecutor_To_v1alpha1_TemplateExecutor(a.(*core.TemplateExecutor), b.(*TemplateExecutor), scope)
+	if err := s.AddGeneratedConversionFunc((*core.TargetTypeDefinition)(nil), (*TargetTypeDefinition)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_core_TargetTypeDefinition_To_v1alpha1_TargetTypeDefinition(a.(*core.TargetTypeDefinition), b.(*TargetTypeDefinition), scope)
 	}); err != nil {
 		return err
 	}
-	if err := s.AddGeneratedConversionFunc((*TokenRotation)(nil), (*core.TokenRotation)(nil), func(a, b interface{}, scope conversion.Scope) error {
-		return Convert_v1alpha1_TokenRotation_To_core_TokenRotation(a.(*TokenRotation), b.(*core.TokenRotation), scope)
+	if err := s.AddGeneratedConversionFunc((*TargetTypeDefinitionList)(nil), (*core.TargetTypeDefinitionList)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_TargetTypeDefinitionList_To_core_TargetTypeDefinitionList(a.(*TargetTypeDefinitionList), b.(*core.TargetTypeDefinitionList), scope)
 	}); err != nil {
 		return err
 	}
-	if err := s.AddGeneratedConversionFunc((*core.TokenRotation)(nil), (*TokenRotation)(nil), func(a, b interface{}, scope conversion.Scope) error {
-		return Convert_core_TokenRotation_To_v1alpha1_TokenRotation(a.(*core.TokenRotation), b.(*TokenRotation), scope)
+	if err := s.AddGeneratedConversionFunc((*core.TargetTypeDefinitionList)(nil), (*TargetTypeDefinitionList)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_core_TargetTypeDefinitionList_To_v1alpha1_TargetTypeDefinitionList(a.(*core.TargetTypeDefinitionList), b.(*TargetTypeDefinitionList), scope)
 	}); err != nil {
 		return err
 	}
-	if err := s.AddGeneratedConversionFunc((*TransitionTimes)(nil), (*core.TransitionTimes)(nil), func(a, b interface{}, scope conversion.Scope) error {
-		return Convert_v1alpha1_TransitionTimes_To_core_TransitionTimes(a.(*TransitionTimes), b.(*core.TransitionTimes), scope)
+	if err := s.AddGeneratedConversionFunc((*TargetTypeDefinitionSpec)(nil), (*core.TargetTypeDefinitionSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_TargetTypeDefinitionSpec_To_core_TargetTypeDefinitionSpec(a.(*TargetTypeDefinitionSpec), b.(*core.TargetTypeDefinitionSpec), scope)
 	}); err != nil {
 		return err
 	}
-	if err := s.AddGeneratedConversionFunc((*core.TransitionTimes)(nil), (*TransitionTimes)(nil), func(a, b interface{}, scope conversion.Scope) error {
-		return Convert_core_TransitionTimes_To_v1alpha1_TransitionTimes(a.(*core.TransitionTimes), b.(*TransitionTimes), scope)
+	if err := s.AddGeneratedConversionFunc((*core.TargetTypeDefinitionSpec)(nil), (*TargetTypeDefinitionSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_core_TargetTypeDefinitionSpec_To_v1alpha1_TargetTypeDefinitionSpec(a.(*core.TargetTypeDefinitionSpec), b.(*TargetTypeDefinitionSpec), scope)
 	}); err != nil {
 		return err
 	}
-	if err := s.AddGeneratedConversionFunc((*TypedObjectReference)(nil), (*core.TypedObjectReference)(nil), func(a, b interface{}, scope conversion.Scope) error {
-		return Convert_v1alpha1_TypedObjectReference_To_core_TypedObjectReference(a.(*TypedObjectReference), b.(*core.TypedObjectReference), scope)
+	if err := s.AddGeneratedConversionFunc((*TemplateExecutor)(nil), (*core.TemplateExecutor)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_TemplateExecutor_To_core_TemplateExecutor(a.(*TemplateExecutor), b.(*core.TemplateExecutor), scope)
 	}); err != nil {
 		return err
 	}
-	if err := s.AddGeneratedConversionFunc((*core.TypedObjectReference)(nil), (*TypedObjectReference)(nil), func(a, b interface{}, scope conversion.Scope) error {
-		return Convert_core_TypedObjectReference_To_v1alpha1_TypedObjectReference(a.(*core.TypedObjectReference), b.(*TypedObjectReference), scope)
+	if err := s.AddGeneratedConversionFunc((*core.TemplateExecutor)(nil), (*TemplateExecutor)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_core_TemplateExecutor_To_v1alpha1_TemplateExecutor(a.(*core.TemplateExecutor), b.(*TemplateExecutor), scope)
 	}); err != nil {
 		return err
 	}
-	if err := s.AddGeneratedConversionFunc((*Verification)(nil), (*core.Verification)(nil), func(a, b interface{}, scope conversion.Scope) error {
-		return Convert_v1alpha1_Verification_To_core_Verification(a.(*Verification), b.(*core.Verification), scope)
+	if err := s.AddGeneratedConversionFunc((*TestArtifact)(nil), (*core.TestArtifact)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_TestArtifact_To_core_TestArtifact(a.(*TestArtifact), b.(*core.TestArtifact), scope)
 	}); err != nil {
 		return err
 	}
-	if err := s.AddGeneratedConversionFunc((*core.Verification)(nil), (*Verification)(nil), func(a, b interface{}, scope conversion.Scope) error {
-		return Convert_core_Verification_To_v1alpha1_Verification(a.(*core.Verification), b.(*Verification), scope)
+	if err := s.AddGeneratedConversionFunc((*core.TestArtifact)(nil), (*TestArtifact)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_core_TestArtifact_To_v1alpha1_TestArtifact(a.(*core.TestArtifact), b.(*TestArtifact), scope)
 	}); err != nil {
 		return err
 	}
-	if err := s.AddGeneratedConversionFunc((*VerificationSignature)(nil), (*core.VerificationSignature)(nil), func(a, b interface{}, scope conversion.Scope) error {
-		return Convert_v1alpha1_VerificationSignature_To_core_VerificationSignature(a.(*VerificationSignature), b.(*core.VerificationSignature), scope)
+	if err := s.AddGeneratedConversionFunc((*TestDefinition)(nil), (*core.TestDefinition)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_TestDefinition_To_core_TestDefinition(a.(*TestDefinition), b.(*core.TestDefinition), scope)
 	}); err != nil {
 		return err
 	}
-	if err := s.AddGeneratedConversionFunc((*core.VerificationSignature)(nil), (*VerificationSignature)(nil), func(a, b interface{}, scope conversion.Scope) error {
-		return Convert_core_VerificationSignature_To_v1alpha1_VerificationSignature(a.(*core.VerificationSignature), b.(*VerificationSignature), scope)
+	if err := s.AddGeneratedConversionFunc((*core.TestDefinition)(nil), (*TestDefinition)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_core_TestDefinition_To_v1alpha1_TestDefinition(a.(*core.TestDefinition), b.(*TestDefinition), scope)
 	}); err != nil {
 		return err
 	}
-	if err := s.AddGeneratedConversionFunc((*VersionedNamedObjectReference)(nil), (*core.VersionedNamedObjectReference)(nil), func(a, b interface{}, scope conversion.Scope) error {
-		return Convert_v1alpha1_VersionedNamedObjectReference_To_core_VersionedNamedObjectReference(a.(*VersionedNamedObjectReference), b.(*core.VersionedNamedObjectReference), scope)
+	if err := s.AddGeneratedConversionFunc((*TestResult)(nil), (*core.TestResult)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_TestResult_To_core_TestResult(a.(*TestResult), b.(*core.TestResult), scope)
 	}); err != nil {
 		return err
 	}
-	if err := s.AddGeneratedConversionFunc((*core.VersionedNamedObjectReference)(nil), (*VersionedNamedObjectReference)(nil), func(a, b interface{}, scope conversion.Scope) error {
-		return Convert_core_VersionedNamedObjectReference_To_v1alpha1_VersionedNamedObjectReference(a.(*core.VersionedNamedObjectReference), b.(*VersionedNamedObjectReference), scope)
+	if err := s.AddGeneratedConversionFunc((*core.TestResult)(nil), (*TestResult)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_core_TestResult_To_v1alpha1_TestResult(a.(*core.TestResult), b.(*TestResult), scope)
 	}); err != nil {
 		return err
 	}
-	if err := s.AddGeneratedConversionFunc((*VersionedObjectReference)(nil), (*core.VersionedObjectReference)(nil), func(a, b interface{}, scope conversion.Scope) error {
-		return Convert_v1alpha1_VersionedObjectReference_To_core_VersionedObjectReference(a.(*VersionedObjectReference), b.(*core.VersionedObjectReference), scope)
+	if err := s.AddGeneratedConversionFunc((*TestRun)(nil), (*core.TestRun)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_TestRun_To_core_TestRun(a.(*TestRun), b.(*core.TestRun), scope)
 	}); err != nil {
 		return err
 	}
-	if err := s.AddGeneratedConversionFunc((*core.VersionedObjectReference)(nil), (*VersionedObjectReference)(nil), func(a, b interface{}, scope conversion.Scope) error {
-		return Convert_core_VersionedObjectReference_To_v1alpha1_VersionedObjectReference(a.(*core.VersionedObjectReference), b.(*VersionedObjectReference), scope)
+	if err := s.AddGeneratedConversionFunc((*core.TestRun)(nil), (*TestRun)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_core_TestRun_To_v1alpha1_TestRun(a.(*core.TestRun), b.(*TestRun), scope)
 	}); err != nil {
 		return err
 	}
-	if err := s.AddGeneratedConversionFunc((*VersionedResourceReference)(nil), (*core.VersionedResourceReference)(nil), func(a, b interface{}, scope conversion.Scope) error {
-		return Convert_v1alpha1_VersionedResourceReference_To_core_VersionedResourceReference(a.(*VersionedResourceReference), b.(*core.VersionedResourceReference), scope)
+	if err := s.AddGeneratedConversionFunc((*TestRunList)(nil), (*core.TestRunList)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_TestRunList_To_core_TestRunList(a.(*TestRunList), b.(*core.TestRunList), scope)
 	}); err != nil {
 		return err
 	}
-	if err := s.AddGeneratedConversionFunc((*core.VersionedResourceReference)(nil), (*VersionedResourceReference)(nil), func(a, b interface{}, scope conversion.Scope) error {
-		return Convert_core_VersionedResourceReference_To_v1alpha1_VersionedResourceReference(a.(*core.VersionedResourceReference), b.(*VersionedResourceReference), scope)
+	if err := s.AddGeneratedConversionFunc((*core.TestRunList)(nil), (*TestRunList)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_core_TestRunList_To_v1alpha1_TestRunList(a.(*core.TestRunList), b.(*TestRunList), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*TestRunSchedule)(nil), (*core.TestRunSchedule)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_TestRunSchedule_To_core_TestRunSchedule(a.(*TestRunSchedule), b.(*core.TestRunSchedule), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*core.TestRunSchedule)(nil), (*TestRunSchedule)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_core_TestRunSchedule_To_v1alpha1_TestRunSchedule(a.(*core.TestRunSchedule), b.(*TestRunSchedule), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*TestRunSpec)(nil), (*core.TestRunSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_TestRunSpec_To_core_TestRunSpec(a.(*TestRunSpec), b.(*core.TestRunSpec), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*core.TestRunSpec)(nil), (*TestRunSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_core_TestRunSpec_To_v1alpha1_TestRunSpec(a.(*core.TestRunSpec), b.(*TestRunSpec), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*TestRunStatus)(nil), (*core.TestRunStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_TestRunStatus_To_core_TestRunStatus(a.(*TestRunStatus), b.(*core.TestRunStatus), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*core.TestRunStatus)(nil), (*TestRunStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_core_TestRunStatus_To_v1alpha1_TestRunStatus(a.(*core.TestRunStatus), b.(*TestRunStatus), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*TokenRotation)(nil), (*core.TokenRotation)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_TokenRotation_To_core_TokenRotation(a.(*TokenRotation), b.(*core.TokenRotation), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*core.TokenRotation)(nil), (*TokenRotation)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_core_TokenRotation_To_v1alpha1_TokenRotation(a.(*core.TokenRotation), b.(*TokenRotation), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*TransitionTimes)(nil), (*core.TransitionTimes)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_TransitionTimes_To_core_TransitionTimes(a.(*TransitionTimes), b.(*core.TransitionTimes), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*core.TransitionTimes)(nil), (*TransitionTimes)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_core_TransitionTimes_To_v1alpha1_TransitionTimes(a.(*core.TransitionTimes), b.(*TransitionTimes), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*TypedObjectReference)(nil), (*core.TypedObjectReference)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_TypedObjectReference_To_core_TypedObjectReference(a.(*TypedObjectReference), b.(*core.TypedObjectReference), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*core.TypedObjectReference)(nil), (*TypedObjectReference)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_core_TypedObjectReference_To_v1alpha1_TypedObjectReference(a.(*core.TypedObjectReference), b.(*TypedObjectReference), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*Verification)(nil), (*core.Verification)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_Verification_To_core_Verification(a.(*Verification), b.(*core.Verification), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*core.Verification)(nil), (*Verification)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_core_Verification_To_v1alpha1_Verification(a.(*core.Verification), b.(*Verification), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*VerificationSignature)(nil), (*core.VerificationSignature)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_VerificationSignature_To_core_VerificationSignature(a.(*VerificationSignature), b.(*core.VerificationSignature), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*core.VerificationSignature)(nil), (*VerificationSignature)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_core_VerificationSignature_To_v1alpha1_VerificationSignature(a.(*core.VerificationSignature), b.(*VerificationSignature), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*VersionedNamedObjectReference)(nil), (*core.VersionedNamedObjectReference)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_VersionedNamedObjectReference_To_core_VersionedNamedObjectReference(a.(*VersionedNamedObjectReference), b.(*core.VersionedNamedObjectReference), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*core.VersionedNamedObjectReference)(nil), (*VersionedNamedObjectReference)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_core_VersionedNamedObjectReference_To_v1alpha1_VersionedNamedObjectReference(a.(*core.VersionedNamedObjectReference), b.(*VersionedNamedObjectReference), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*VersionedObjectReference)(nil), (*core.VersionedObjectReference)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_VersionedObjectReference_To_core_VersionedObjectReference(a.(*VersionedObjectReference), b.(*core.VersionedObjectReference), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*core.VersionedObjectReference)(nil), (*VersionedObjectReference)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_core_VersionedObjectReference_To_v1alpha1_VersionedObjectReference(a.(*core.VersionedObjectReference), b.(*VersionedObjectReference), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*VersionedResourceReference)(nil), (*core.VersionedResourceReference)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_VersionedResourceReference_To_core_VersionedResourceReference(a.(*VersionedResourceReference), b.(*core.VersionedResourceReference), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*core.VersionedResourceReference)(nil), (*VersionedResourceReference)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_core_VersionedResourceReference_To_v1alpha1_VersionedResourceReference(a.(*core.VersionedResourceReference), b.(*VersionedResourceReference), scope)
 	}); err != nil {
 		return err
 	}
@@ -1073,6 +1543,40 @@ func Convert_core_AnyJSON_To_v1alpha1_AnyJSON(in *core.AnyJSON, out *AnyJSON, s
 	return autoConvert_core_AnyJSON_To_v1alpha1_AnyJSON(in, out, s)
 }
 
+func autoConvert_v1alpha1_ApprovalRecord_To_core_ApprovalRecord(in *ApprovalRecord, out *core.ApprovalRecord, s conversion.Scope) error {
+	out.Hook = in.Hook
+	out.Phase = core.InstallationPhase(in.Phase)
+	out.JobID = in.JobID
+	out.State = core.ApprovalState(in.State)
+	out.Reference = in.Reference
+	out.Message = in.Message
+	out.RequestTime = in.RequestTime
+	out.DecisionTime = (*metav1.Time)(unsafe.Pointer(in.DecisionTime))
+	return nil
+}
+
+// Convert_v1alpha1_ApprovalRecord_To_core_ApprovalRecord is an autogenerated conversion function.
+func Convert_v1alpha1_ApprovalRecord_To_core_ApprovalRecord(in *ApprovalRecord, out *core.ApprovalRecord, s conversion.Scope) error {
+	return autoConvert_v1alpha1_ApprovalRecord_To_core_ApprovalRecord(in, out, s)
+}
+
+func autoConvert_core_ApprovalRecord_To_v1alpha1_ApprovalRecord(in *core.ApprovalRecord, out *ApprovalRecord, s conversion.Scope) error {
+	out.Hook = in.Hook
+	out.Phase = InstallationPhase(in.Phase)
+	out.JobID = in.JobID
+	out.State = ApprovalState(in.State)
+	out.Reference = in.Reference
+	out.Message = in.Message
+	out.RequestTime = in.RequestTime
+	out.DecisionTime = (*metav1.Time)(unsafe.Pointer(in.DecisionTime))
+	return nil
+}
+
+// Convert_core_ApprovalRecord_To_v1alpha1_ApprovalRecord is an autogenerated conversion function.
+func Convert_core_ApprovalRecord_To_v1alpha1_ApprovalRecord(in *core.ApprovalRecord, out *ApprovalRecord, s conversion.Scope) error {
+	return autoConvert_core_ApprovalRecord_To_v1alpha1_ApprovalRecord(in, out, s)
+}
+
 func autoConvert_v1alpha1_AutomaticReconcile_To_core_AutomaticReconcile(in *AutomaticReconcile, out *core.AutomaticReconcile, s conversion.Scope) error {
 	out.SucceededReconcile = (*core.SucceededReconcile)(unsafe.Pointer(in.SucceededReconcile))
 	out.FailedReconcile = (*core.FailedReconcile)(unsafe.Pointer(in.FailedReconcile))
@@ -1129,9 +1633,11 @@ func autoConvert_v1alpha1_Blueprint_To_core_Blueprint(in *Blueprint, out *core.B
 	out.ImportExecutions = *(*[]core.TemplateExecutor)(unsafe.Pointer(&in.ImportExecutions))
 	out.Exports = *(*core.ExportDefinitionList)(unsafe.Pointer(&in.Exports))
 	out.Subinstallations = *(*core.SubinstallationTemplateList)(unsafe.Pointer(&in.Subinstallations))
+	out.RenderStages = *(*[]core.RenderStage)(unsafe.Pointer(&in.RenderStages))
 	out.SubinstallationExecutions = *(*[]core.TemplateExecutor)(unsafe.Pointer(&in.SubinstallationExecutions))
 	out.DeployExecutions = *(*[]core.TemplateExecutor)(unsafe.Pointer(&in.DeployExecutions))
 	out.ExportExecutions = *(*[]core.TemplateExecutor)(unsafe.Pointer(&in.ExportExecutions))
+	out.FeatureFlags = *(*[]core.FeatureFlagDefinition)(unsafe.Pointer(&in.FeatureFlags))
 	return nil
 }
 
@@ -1148,9 +1654,11 @@ func autoConvert_core_Blueprint_To_v1alpha1_Blueprint(in *core.Blueprint, out *B
 	out.Exports = *(*ExportDefinitionList)(unsafe.Pointer(&in.Exports))
 	out.ImportExecutions = *(*[]TemplateExecutor)(unsafe.Pointer(&in.ImportExecutions))
 	out.Subinstallations = *(*SubinstallationTemplateList)(unsafe.Pointer(&in.Subinstallations))
+	out.RenderStages = *(*[]RenderStage)(unsafe.Pointer(&in.RenderStages))
 	out.SubinstallationExecutions = *(*[]TemplateExecutor)(unsafe.Pointer(&in.SubinstallationExecutions))
 	out.DeployExecutions = *(*[]TemplateExecutor)(unsafe.Pointer(&in.DeployExecutions))
 	out.ExportExecutions = *(*[]TemplateExecutor)(unsafe.Pointer(&in.ExportExecutions))
+	out.FeatureFlags = *(*[]FeatureFlagDefinition)(unsafe.Pointer(&in.FeatureFlags))
 	return nil
 }
 
@@ -1162,6 +1670,7 @@ func Convert_core_Blueprint_To_v1alpha1_Blueprint(in *core.Blueprint, out *Bluep
 func autoConvert_v1alpha1_BlueprintDefinition_To_core_BlueprintDefinition(in *BlueprintDefinition, out *core.BlueprintDefinition, s conversion.Scope) error {
 	out.Reference = (*core.RemoteBlueprintReference)(unsafe.Pointer(in.Reference))
 	out.Inline = (*core.InlineBlueprint)(unsafe.Pointer(in.Inline))
+	out.Git = (*core.GitBlueprintReference)(unsafe.Pointer(in.Git))
 	return nil
 }
 
@@ -1173,6 +1682,7 @@ func Convert_v1alpha1_BlueprintDefinition_To_core_BlueprintDefinition(in *Bluepr
 func autoConvert_core_BlueprintDefinition_To_v1alpha1_BlueprintDefinition(in *core.BlueprintDefinition, out *BlueprintDefinition, s conversion.Scope) error {
 	out.Reference = (*RemoteBlueprintReference)(unsafe.Pointer(in.Reference))
 	out.Inline = (*InlineBlueprint)(unsafe.Pointer(in.Inline))
+	out.Git = (*GitBlueprintReference)(unsafe.Pointer(in.Git))
 	return nil
 }
 
@@ -1463,6 +1973,9 @@ func autoConvert_v1alpha1_ContextConfiguration_To_core_ContextConfiguration(in *
 	out.Configurations = *(*map[string]core.AnyJSON)(unsafe.Pointer(&in.Configurations))
 	out.ComponentVersionOverwritesReference = in.ComponentVersionOverwritesReference
 	out.VerificationSignatures = *(*map[string]core.VerificationSignature)(unsafe.Pointer(&in.VerificationSignatures))
+	out.ImagePullSecrets = (*core.ImagePullSecretsConfiguration)(unsafe.Pointer(in.ImagePullSecrets))
+	out.FeatureFlags = *(*map[string]bool)(unsafe.Pointer(&in.FeatureFlags))
+	out.Scheduling = (*core.SchedulingConfiguration)(unsafe.Pointer(in.Scheduling))
 	return nil
 }
 
@@ -1479,6 +1992,9 @@ func autoConvert_core_ContextConfiguration_To_v1alpha1_ContextConfiguration(in *
 	out.Configurations = *(*map[string]AnyJSON)(unsafe.Pointer(&in.Configurations))
 	out.ComponentVersionOverwritesReference = in.ComponentVersionOverwritesReference
 	out.VerificationSignatures = *(*map[string]VerificationSignature)(unsafe.Pointer(&in.VerificationSignatures))
+	out.ImagePullSecrets = (*ImagePullSecretsConfiguration)(unsafe.Pointer(in.ImagePullSecrets))
+	out.FeatureFlags = *(*map[string]bool)(unsafe.Pointer(&in.FeatureFlags))
+	out.Scheduling = (*SchedulingConfiguration)(unsafe.Pointer(in.Scheduling))
 	return nil
 }
 
@@ -1653,6 +2169,8 @@ func autoConvert_v1alpha1_DataImport_To_core_DataImport(in *DataImport, out *cor
 	out.Version = in.Version
 	out.SecretRef = (*core.LocalSecretReference)(unsafe.Pointer(in.SecretRef))
 	out.ConfigMapRef = (*core.LocalConfigMapReference)(unsafe.Pointer(in.ConfigMapRef))
+	out.HTTPRef = (*core.HTTPDataReference)(unsafe.Pointer(in.HTTPRef))
+	out.Transformations = *(*[]core.ImportTransformation)(unsafe.Pointer(&in.Transformations))
 	return nil
 }
 
@@ -1667,6 +2185,8 @@ func autoConvert_core_DataImport_To_v1alpha1_DataImport(in *core.DataImport, out
 	out.Version = in.Version
 	out.SecretRef = (*LocalSecretReference)(unsafe.Pointer(in.SecretRef))
 	out.ConfigMapRef = (*LocalConfigMapReference)(unsafe.Pointer(in.ConfigMapRef))
+	out.HTTPRef = (*HTTPDataReference)(unsafe.Pointer(in.HTTPRef))
+	out.Transformations = *(*[]ImportTransformation)(unsafe.Pointer(&in.Transformations))
 	return nil
 }
 
@@ -1747,6 +2267,32 @@ func Convert_core_Default_To_v1alpha1_Default(in *core.Default, out *Default, s
 	return autoConvert_core_Default_To_v1alpha1_Default(in, out, s)
 }
 
+func autoConvert_v1alpha1_DeferredDeployItem_To_core_DeferredDeployItem(in *DeferredDeployItem, out *core.DeferredDeployItem, s conversion.Scope) error {
+	out.Name = in.Name
+	out.ObjectName = in.ObjectName
+	out.Operation = core.DeferredOperation(in.Operation)
+	out.Until = in.Until
+	return nil
+}
+
+// Convert_v1alpha1_DeferredDeployItem_To_core_DeferredDeployItem is an autogenerated conversion function.
+func Convert_v1alpha1_DeferredDeployItem_To_core_DeferredDeployItem(in *DeferredDeployItem, out *core.DeferredDeployItem, s conversion.Scope) error {
+	return autoConvert_v1alpha1_DeferredDeployItem_To_core_DeferredDeployItem(in, out, s)
+}
+
+func autoConvert_core_DeferredDeployItem_To_v1alpha1_DeferredDeployItem(in *core.DeferredDeployItem, out *DeferredDeployItem, s conversion.Scope) error {
+	out.Name = in.Name
+	out.ObjectName = in.ObjectName
+	out.Operation = DeferredOperation(in.Operation)
+	out.Until = in.Until
+	return nil
+}
+
+// Convert_core_DeferredDeployItem_To_v1alpha1_DeferredDeployItem is an autogenerated conversion function.
+func Convert_core_DeferredDeployItem_To_v1alpha1_DeferredDeployItem(in *core.DeferredDeployItem, out *DeferredDeployItem, s conversion.Scope) error {
+	return autoConvert_core_DeferredDeployItem_To_v1alpha1_DeferredDeployItem(in, out, s)
+}
+
 func autoConvert_v1alpha1_DependentToTrigger_To_core_DependentToTrigger(in *DependentToTrigger, out *core.DependentToTrigger, s conversion.Scope) error {
 	out.Name = in.Name
 	return nil
@@ -1821,6 +2367,30 @@ func Convert_core_DeployItemCache_To_v1alpha1_DeployItemCache(in *core.DeployIte
 	return autoConvert_core_DeployItemCache_To_v1alpha1_DeployItemCache(in, out, s)
 }
 
+func autoConvert_v1alpha1_DeployItemHash_To_core_DeployItemHash(in *DeployItemHash, out *core.DeployItemHash, s conversion.Scope) error {
+	out.Name = in.Name
+	out.Hash = in.Hash
+	out.JobID = in.JobID
+	return nil
+}
+
+// Convert_v1alpha1_DeployItemHash_To_core_DeployItemHash is an autogenerated conversion function.
+func Convert_v1alpha1_DeployItemHash_To_core_DeployItemHash(in *DeployItemHash, out *core.DeployItemHash, s conversion.Scope) error {
+	return autoConvert_v1alpha1_DeployItemHash_To_core_DeployItemHash(in, out, s)
+}
+
+func autoConvert_core_DeployItemHash_To_v1alpha1_DeployItemHash(in *core.DeployItemHash, out *DeployItemHash, s conversion.Scope) error {
+	out.Name = in.Name
+	out.Hash = in.Hash
+	out.JobID = in.JobID
+	return nil
+}
+
+// Convert_core_DeployItemHash_To_v1alpha1_DeployItemHash is an autogenerated conversion function.
+func Convert_core_DeployItemHash_To_v1alpha1_DeployItemHash(in *core.DeployItemHash, out *DeployItemHash, s conversion.Scope) error {
+	return autoConvert_core_DeployItemHash_To_v1alpha1_DeployItemHash(in, out, s)
+}
+
 func autoConvert_v1alpha1_DeployItemList_To_core_DeployItemList(in *DeployItemList, out *core.DeployItemList, s conversion.Scope) error {
 	out.ListMeta = in.ListMeta
 	out.Items = *(*[]core.DeployItem)(unsafe.Pointer(&in.Items))
@@ -1843,14 +2413,46 @@ func Convert_core_DeployItemList_To_v1alpha1_DeployItemList(in *core.DeployItemL
 	return autoConvert_core_DeployItemList_To_v1alpha1_DeployItemList(in, out, s)
 }
 
+func autoConvert_v1alpha1_DeployItemProgress_To_core_DeployItemProgress(in *DeployItemProgress, out *core.DeployItemProgress, s conversion.Scope) error {
+	out.Name = in.Name
+	out.Phase = core.DeployItemPhase(in.Phase)
+	out.StartTime = (*metav1.Time)(unsafe.Pointer(in.StartTime))
+	out.FinishedTime = (*metav1.Time)(unsafe.Pointer(in.FinishedTime))
+	return nil
+}
+
+// Convert_v1alpha1_DeployItemProgress_To_core_DeployItemProgress is an autogenerated conversion function.
+func Convert_v1alpha1_DeployItemProgress_To_core_DeployItemProgress(in *DeployItemProgress, out *core.DeployItemProgress, s conversion.Scope) error {
+	return autoConvert_v1alpha1_DeployItemProgress_To_core_DeployItemProgress(in, out, s)
+}
+
+func autoConvert_core_DeployItemProgress_To_v1alpha1_DeployItemProgress(in *core.DeployItemProgress, out *DeployItemProgress, s conversion.Scope) error {
+	out.Name = in.Name
+	out.Phase = DeployItemPhase(in.Phase)
+	out.StartTime = (*metav1.Time)(unsafe.Pointer(in.StartTime))
+	out.FinishedTime = (*metav1.Time)(unsafe.Pointer(in.FinishedTime))
+	return nil
+}
+
+// Convert_core_DeployItemProgress_To_v1alpha1_DeployItemProgress is an autogenerated conversion function.
+func Convert_core_DeployItemProgress_To_v1alpha1_DeployItemProgress(in *core.DeployItemProgress, out *DeployItemProgress, s conversion.Scope) error {
+	return autoConvert_core_DeployItemProgress_To_v1alpha1_DeployItemProgress(in, out, s)
+}
+
 func autoConvert_v1alpha1_DeployItemSpec_To_core_DeployItemSpec(in *DeployItemSpec, out *core.DeployItemSpec, s conversion.Scope) error {
 	out.Type = core.DeployItemType(in.Type)
 	out.Target = (*core.ObjectReference)(unsafe.Pointer(in.Target))
 	out.Context = in.Context
 	out.Configuration = (*runtime.RawExtension)(unsafe.Pointer(in.Configuration))
 	out.Timeout = (*core.Duration)(unsafe.Pointer(in.Timeout))
+	out.PickupTimeout = (*core.Duration)(unsafe.Pointer(in.PickupTimeout))
+	out.AbortTimeout = (*core.Duration)(unsafe.Pointer(in.AbortTimeout))
 	out.UpdateOnChangeOnly = in.UpdateOnChangeOnly
+	out.ConsumedImports = *(*[]string)(unsafe.Pointer(&in.ConsumedImports))
+	out.ConsumedImportsHash = in.ConsumedImportsHash
 	out.OnDelete = (*core.OnDeleteConfig)(unsafe.Pointer(in.OnDelete))
+	out.ExclusionWindows = *(*[]core.ExclusionWindow)(unsafe.Pointer(&in.ExclusionWindows))
+	out.RetryPolicy = (*core.RetryPolicy)(unsafe.Pointer(in.RetryPolicy))
 	return nil
 }
 
@@ -1865,8 +2467,14 @@ func autoConvert_core_DeployItemSpec_To_v1alpha1_DeployItemSpec(in *core.DeployI
 	out.Context = in.Context
 	out.Configuration = (*runtime.RawExtension)(unsafe.Pointer(in.Configuration))
 	out.Timeout = (*Duration)(unsafe.Pointer(in.Timeout))
+	out.PickupTimeout = (*Duration)(unsafe.Pointer(in.PickupTimeout))
+	out.AbortTimeout = (*Duration)(unsafe.Pointer(in.AbortTimeout))
 	out.UpdateOnChangeOnly = in.UpdateOnChangeOnly
+	out.ConsumedImports = *(*[]string)(unsafe.Pointer(&in.ConsumedImports))
+	out.ConsumedImportsHash = in.ConsumedImportsHash
 	out.OnDelete = (*OnDeleteConfig)(unsafe.Pointer(in.OnDelete))
+	out.ExclusionWindows = *(*[]ExclusionWindow)(unsafe.Pointer(&in.ExclusionWindows))
+	out.RetryPolicy = (*RetryPolicy)(unsafe.Pointer(in.RetryPolicy))
 	return nil
 }
 
@@ -1893,6 +2501,9 @@ func autoConvert_v1alpha1_DeployItemStatus_To_core_DeployItemStatus(in *DeployIt
 	out.JobIDGenerationTime = (*metav1.Time)(unsafe.Pointer(in.JobIDGenerationTime))
 	out.DeployerPhase = (*string)(unsafe.Pointer(in.DeployerPhase))
 	out.TransitionTimes = (*core.TransitionTimes)(unsafe.Pointer(in.TransitionTimes))
+	out.Progress = (*core.DeployerProgress)(unsafe.Pointer(in.Progress))
+	out.RetryCount = in.RetryCount
+	out.NextRetryTime = (*metav1.Time)(unsafe.Pointer(in.NextRetryTime))
 	return nil
 }
 
@@ -1919,6 +2530,9 @@ func autoConvert_core_DeployItemStatus_To_v1alpha1_DeployItemStatus(in *core.Dep
 	out.JobIDGenerationTime = (*metav1.Time)(unsafe.Pointer(in.JobIDGenerationTime))
 	out.DeployerPhase = (*string)(unsafe.Pointer(in.DeployerPhase))
 	out.TransitionTimes = (*TransitionTimes)(unsafe.Pointer(in.TransitionTimes))
+	out.Progress = (*DeployerProgress)(unsafe.Pointer(in.Progress))
+	out.RetryCount = in.RetryCount
+	out.NextRetryTime = (*metav1.Time)(unsafe.Pointer(in.NextRetryTime))
 	return nil
 }
 
@@ -1935,8 +2549,14 @@ func autoConvert_v1alpha1_DeployItemTemplate_To_core_DeployItemTemplate(in *Depl
 	out.Configuration = (*runtime.RawExtension)(unsafe.Pointer(in.Configuration))
 	out.DependsOn = *(*[]string)(unsafe.Pointer(&in.DependsOn))
 	out.Timeout = (*core.Duration)(unsafe.Pointer(in.Timeout))
+	out.PickupTimeout = (*core.Duration)(unsafe.Pointer(in.PickupTimeout))
+	out.AbortTimeout = (*core.Duration)(unsafe.Pointer(in.AbortTimeout))
 	out.UpdateOnChangeOnly = in.UpdateOnChangeOnly
+	out.ConsumedImports = *(*[]string)(unsafe.Pointer(&in.ConsumedImports))
+	out.ConsumedImportsHash = in.ConsumedImportsHash
 	out.OnDelete = (*core.OnDeleteConfig)(unsafe.Pointer(in.OnDelete))
+	out.ExclusionWindows = *(*[]core.ExclusionWindow)(unsafe.Pointer(&in.ExclusionWindows))
+	out.RetryPolicy = (*core.RetryPolicy)(unsafe.Pointer(in.RetryPolicy))
 	return nil
 }
 
@@ -1953,8 +2573,14 @@ func autoConvert_core_DeployItemTemplate_To_v1alpha1_DeployItemTemplate(in *core
 	out.Configuration = (*runtime.RawExtension)(unsafe.Pointer(in.Configuration))
 	out.DependsOn = *(*[]string)(unsafe.Pointer(&in.DependsOn))
 	out.Timeout = (*Duration)(unsafe.Pointer(in.Timeout))
+	out.PickupTimeout = (*Duration)(unsafe.Pointer(in.PickupTimeout))
+	out.AbortTimeout = (*Duration)(unsafe.Pointer(in.AbortTimeout))
 	out.UpdateOnChangeOnly = in.UpdateOnChangeOnly
+	out.ConsumedImports = *(*[]string)(unsafe.Pointer(&in.ConsumedImports))
+	out.ConsumedImportsHash = in.ConsumedImportsHash
 	out.OnDelete = (*OnDeleteConfig)(unsafe.Pointer(in.OnDelete))
+	out.ExclusionWindows = *(*[]ExclusionWindow)(unsafe.Pointer(&in.ExclusionWindows))
+	out.RetryPolicy = (*RetryPolicy)(unsafe.Pointer(in.RetryPolicy))
 	return nil
 }
 
@@ -1963,6 +2589,30 @@ func Convert_core_DeployItemTemplate_To_v1alpha1_DeployItemTemplate(in *core.Dep
 	return autoConvert_core_DeployItemTemplate_To_v1alpha1_DeployItemTemplate(in, out, s)
 }
 
+func autoConvert_v1alpha1_DeployerHealthCheck_To_core_DeployerHealthCheck(in *DeployerHealthCheck, out *core.DeployerHealthCheck, s conversion.Scope) error {
+	out.URL = in.URL
+	out.Interval = (*core.Duration)(unsafe.Pointer(in.Interval))
+	out.Timeout = (*core.Duration)(unsafe.Pointer(in.Timeout))
+	return nil
+}
+
+// Convert_v1alpha1_DeployerHealthCheck_To_core_DeployerHealthCheck is an autogenerated conversion function.
+func Convert_v1alpha1_DeployerHealthCheck_To_core_DeployerHealthCheck(in *DeployerHealthCheck, out *core.DeployerHealthCheck, s conversion.Scope) error {
+	return autoConvert_v1alpha1_DeployerHealthCheck_To_core_DeployerHealthCheck(in, out, s)
+}
+
+func autoConvert_core_DeployerHealthCheck_To_v1alpha1_DeployerHealthCheck(in *core.DeployerHealthCheck, out *DeployerHealthCheck, s conversion.Scope) error {
+	out.URL = in.URL
+	out.Interval = (*Duration)(unsafe.Pointer(in.Interval))
+	out.Timeout = (*Duration)(unsafe.Pointer(in.Timeout))
+	return nil
+}
+
+// Convert_core_DeployerHealthCheck_To_v1alpha1_DeployerHealthCheck is an autogenerated conversion function.
+func Convert_core_DeployerHealthCheck_To_v1alpha1_DeployerHealthCheck(in *core.DeployerHealthCheck, out *DeployerHealthCheck, s conversion.Scope) error {
+	return autoConvert_core_DeployerHealthCheck_To_v1alpha1_DeployerHealthCheck(in, out, s)
+}
+
 func autoConvert_v1alpha1_DeployerInformation_To_core_DeployerInformation(in *DeployerInformation, out *core.DeployerInformation, s conversion.Scope) error {
 	out.Identity = in.Identity
 	out.Name = in.Name
@@ -1987,6 +2637,136 @@ func Convert_core_DeployerInformation_To_v1alpha1_DeployerInformation(in *core.D
 	return autoConvert_core_DeployerInformation_To_v1alpha1_DeployerInformation(in, out, s)
 }
 
+func autoConvert_v1alpha1_DeployerProgress_To_core_DeployerProgress(in *DeployerProgress, out *core.DeployerProgress, s conversion.Scope) error {
+	out.Percent = in.Percent
+	out.Step = in.Step
+	out.Message = in.Message
+	out.LastUpdateTime = in.LastUpdateTime
+	return nil
+}
+
+// Convert_v1alpha1_DeployerProgress_To_core_DeployerProgress is an autogenerated conversion function.
+func Convert_v1alpha1_DeployerProgress_To_core_DeployerProgress(in *DeployerProgress, out *core.DeployerProgress, s conversion.Scope) error {
+	return autoConvert_v1alpha1_DeployerProgress_To_core_DeployerProgress(in, out, s)
+}
+
+func autoConvert_core_DeployerProgress_To_v1alpha1_DeployerProgress(in *core.DeployerProgress, out *DeployerProgress, s conversion.Scope) error {
+	out.Percent = in.Percent
+	out.Step = in.Step
+	out.Message = in.Message
+	out.LastUpdateTime = in.LastUpdateTime
+	return nil
+}
+
+// Convert_core_DeployerProgress_To_v1alpha1_DeployerProgress is an autogenerated conversion function.
+func Convert_core_DeployerProgress_To_v1alpha1_DeployerProgress(in *core.DeployerProgress, out *DeployerProgress, s conversion.Scope) error {
+	return autoConvert_core_DeployerProgress_To_v1alpha1_DeployerProgress(in, out, s)
+}
+
+func autoConvert_v1alpha1_DeployerRegistration_To_core_DeployerRegistration(in *DeployerRegistration, out *core.DeployerRegistration, s conversion.Scope) error {
+	out.ObjectMeta = in.ObjectMeta
+	if err := Convert_v1alpha1_DeployerRegistrationSpec_To_core_DeployerRegistrationSpec(&in.Spec, &out.Spec, s); err != nil {
+		return err
+	}
+	if err := Convert_v1alpha1_DeployerRegistrationStatus_To_core_DeployerRegistrationStatus(&in.Status, &out.Status, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_v1alpha1_DeployerRegistration_To_core_DeployerRegistration is an autogenerated conversion function.
+func Convert_v1alpha1_DeployerRegistration_To_core_DeployerRegistration(in *DeployerRegistration, out *core.DeployerRegistration, s conversion.Scope) error {
+	return autoConvert_v1alpha1_DeployerRegistration_To_core_DeployerRegistration(in, out, s)
+}
+
+func autoConvert_core_DeployerRegistration_To_v1alpha1_DeployerRegistration(in *core.DeployerRegistration, out *DeployerRegistration, s conversion.Scope) error {
+	out.ObjectMeta = in.ObjectMeta
+	if err := Convert_core_DeployerRegistrationSpec_To_v1alpha1_DeployerRegistrationSpec(&in.Spec, &out.Spec, s); err != nil {
+		return err
+	}
+	if err := Convert_core_DeployerRegistrationStatus_To_v1alpha1_DeployerRegistrationStatus(&in.Status, &out.Status, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_core_DeployerRegistration_To_v1alpha1_DeployerRegistration is an autogenerated conversion function.
+func Convert_core_DeployerRegistration_To_v1alpha1_DeployerRegistration(in *core.DeployerRegistration, out *DeployerRegistration, s conversion.Scope) error {
+	return autoConvert_core_DeployerRegistration_To_v1alpha1_DeployerRegistration(in, out, s)
+}
+
+func autoConvert_v1alpha1_DeployerRegistrationList_To_core_DeployerRegistrationList(in *DeployerRegistrationList, out *core.DeployerRegistrationList, s conversion.Scope) error {
+	out.ListMeta = in.ListMeta
+	out.Items = *(*[]core.DeployerRegistration)(unsafe.Pointer(&in.Items))
+	return nil
+}
+
+// Convert_v1alpha1_DeployerRegistrationList_To_core_DeployerRegistrationList is an autogenerated conversion function.
+func Convert_v1alpha1_DeployerRegistrationList_To_core_DeployerRegistrationList(in *DeployerRegistrationList, out *core.DeployerRegistrationList, s conversion.Scope) error {
+	return autoConvert_v1alpha1_DeployerRegistrationList_To_core_DeployerRegistrationList(in, out, s)
+}
+
+func autoConvert_core_DeployerRegistrationList_To_v1alpha1_DeployerRegistrationList(in *core.DeployerRegistrationList, out *DeployerRegistrationList, s conversion.Scope) error {
+	out.ListMeta = in.ListMeta
+	out.Items = *(*[]DeployerRegistration)(unsafe.Pointer(&in.Items))
+	return nil
+}
+
+// Convert_core_DeployerRegistrationList_To_v1alpha1_DeployerRegistrationList is an autogenerated conversion function.
+func Convert_core_DeployerRegistrationList_To_v1alpha1_DeployerRegistrationList(in *core.DeployerRegistrationList, out *DeployerRegistrationList, s conversion.Scope) error {
+	return autoConvert_core_DeployerRegistrationList_To_v1alpha1_DeployerRegistrationList(in, out, s)
+}
+
+func autoConvert_v1alpha1_DeployerRegistrationSpec_To_core_DeployerRegistrationSpec(in *DeployerRegistrationSpec, out *core.DeployerRegistrationSpec, s conversion.Scope) error {
+	out.DeployItemTypes = *(*[]core.DeployItemType)(unsafe.Pointer(&in.DeployItemTypes))
+	out.TargetTypes = *(*[]core.TargetType)(unsafe.Pointer(&in.TargetTypes))
+	out.HealthCheck = (*core.DeployerHealthCheck)(unsafe.Pointer(in.HealthCheck))
+	return nil
+}
+
+// Convert_v1alpha1_DeployerRegistrationSpec_To_core_DeployerRegistrationSpec is an autogenerated conversion function.
+func Convert_v1alpha1_DeployerRegistrationSpec_To_core_DeployerRegistrationSpec(in *DeployerRegistrationSpec, out *core.DeployerRegistrationSpec, s conversion.Scope) error {
+	return autoConvert_v1alpha1_DeployerRegistrationSpec_To_core_DeployerRegistrationSpec(in, out, s)
+}
+
+func autoConvert_core_DeployerRegistrationSpec_To_v1alpha1_DeployerRegistrationSpec(in *core.DeployerRegistrationSpec, out *DeployerRegistrationSpec, s conversion.Scope) error {
+	out.DeployItemTypes = *(*[]DeployItemType)(unsafe.Pointer(&in.DeployItemTypes))
+	out.TargetTypes = *(*[]TargetType)(unsafe.Pointer(&in.TargetTypes))
+	out.HealthCheck = (*DeployerHealthCheck)(unsafe.Pointer(in.HealthCheck))
+	return nil
+}
+
+// Convert_core_DeployerRegistrationSpec_To_v1alpha1_DeployerRegistrationSpec is an autogenerated conversion function.
+func Convert_core_DeployerRegistrationSpec_To_v1alpha1_DeployerRegistrationSpec(in *core.DeployerRegistrationSpec, out *DeployerRegistrationSpec, s conversion.Scope) error {
+	return autoConvert_core_DeployerRegistrationSpec_To_v1alpha1_DeployerRegistrationSpec(in, out, s)
+}
+
+func autoConvert_v1alpha1_DeployerRegistrationStatus_To_core_DeployerRegistrationStatus(in *DeployerRegistrationStatus, out *core.DeployerRegistrationStatus, s conversion.Scope) error {
+	out.ObservedGeneration = in.ObservedGeneration
+	out.Phase = core.DeployerRegistrationPhase(in.Phase)
+	out.Message = in.Message
+	out.LastHealthCheckTime = (*metav1.Time)(unsafe.Pointer(in.LastHealthCheckTime))
+	return nil
+}
+
+// Convert_v1alpha1_DeployerRegistrationStatus_To_core_DeployerRegistrationStatus is an autogenerated conversion function.
+func Convert_v1alpha1_DeployerRegistrationStatus_To_core_DeployerRegistrationStatus(in *DeployerRegistrationStatus, out *core.DeployerRegistrationStatus, s conversion.Scope) error {
+	return autoConvert_v1alpha1_DeployerRegistrationStatus_To_core_DeployerRegistrationStatus(in, out, s)
+}
+
+func autoConvert_core_DeployerRegistrationStatus_To_v1alpha1_DeployerRegistrationStatus(in *core.DeployerRegistrationStatus, out *DeployerRegistrationStatus, s conversion.Scope) error {
+	out.ObservedGeneration = in.ObservedGeneration
+	out.Phase = DeployerRegistrationPhase(in.Phase)
+	out.Message = in.Message
+	out.LastHealthCheckTime = (*metav1.Time)(unsafe.Pointer(in.LastHealthCheckTime))
+	return nil
+}
+
+// Convert_core_DeployerRegistrationStatus_To_v1alpha1_DeployerRegistrationStatus is an autogenerated conversion function.
+func Convert_core_DeployerRegistrationStatus_To_v1alpha1_DeployerRegistrationStatus(in *core.DeployerRegistrationStatus, out *DeployerRegistrationStatus, s conversion.Scope) error {
+	return autoConvert_core_DeployerRegistrationStatus_To_v1alpha1_DeployerRegistrationStatus(in, out, s)
+}
+
 func autoConvert_v1alpha1_DiNamePair_To_core_DiNamePair(in *DiNamePair, out *core.DiNamePair, s conversion.Scope) error {
 	out.SpecName = in.SpecName
 	out.ObjectName = in.ObjectName
@@ -2059,6 +2839,32 @@ func Convert_core_Error_To_v1alpha1_Error(in *core.Error, out *Error, s conversi
 	return autoConvert_core_Error_To_v1alpha1_Error(in, out, s)
 }
 
+func autoConvert_v1alpha1_ExclusionWindow_To_core_ExclusionWindow(in *ExclusionWindow, out *core.ExclusionWindow, s conversion.Scope) error {
+	out.Days = *(*[]string)(unsafe.Pointer(&in.Days))
+	out.Begin = in.Begin
+	out.End = in.End
+	out.TimeZone = in.TimeZone
+	return nil
+}
+
+// Convert_v1alpha1_ExclusionWindow_To_core_ExclusionWindow is an autogenerated conversion function.
+func Convert_v1alpha1_ExclusionWindow_To_core_ExclusionWindow(in *ExclusionWindow, out *core.ExclusionWindow, s conversion.Scope) error {
+	return autoConvert_v1alpha1_ExclusionWindow_To_core_ExclusionWindow(in, out, s)
+}
+
+func autoConvert_core_ExclusionWindow_To_v1alpha1_ExclusionWindow(in *core.ExclusionWindow, out *ExclusionWindow, s conversion.Scope) error {
+	out.Days = *(*[]string)(unsafe.Pointer(&in.Days))
+	out.Begin = in.Begin
+	out.End = in.End
+	out.TimeZone = in.TimeZone
+	return nil
+}
+
+// Convert_core_ExclusionWindow_To_v1alpha1_ExclusionWindow is an autogenerated conversion function.
+func Convert_core_ExclusionWindow_To_v1alpha1_ExclusionWindow(in *core.ExclusionWindow, out *ExclusionWindow, s conversion.Scope) error {
+	return autoConvert_core_ExclusionWindow_To_v1alpha1_ExclusionWindow(in, out, s)
+}
+
 func autoConvert_v1alpha1_Execution_To_core_Execution(in *Execution, out *core.Execution, s conversion.Scope) error {
 	out.ObjectMeta = in.ObjectMeta
 	if err := Convert_v1alpha1_ExecutionSpec_To_core_ExecutionSpec(&in.Spec, &out.Spec, s); err != nil {
@@ -2133,15 +2939,43 @@ func Convert_core_ExecutionList_To_v1alpha1_ExecutionList(in *core.ExecutionList
 	return autoConvert_core_ExecutionList_To_v1alpha1_ExecutionList(in, out, s)
 }
 
-func autoConvert_v1alpha1_ExecutionSpec_To_core_ExecutionSpec(in *ExecutionSpec, out *core.ExecutionSpec, s conversion.Scope) error {
-	out.Context = in.Context
-	out.DeployItems = *(*core.DeployItemTemplateList)(unsafe.Pointer(&in.DeployItems))
-	out.DeployItemsCompressed = *(*[]byte)(unsafe.Pointer(&in.DeployItemsCompressed))
+func autoConvert_v1alpha1_ExecutionProgress_To_core_ExecutionProgress(in *ExecutionProgress, out *core.ExecutionProgress, s conversion.Scope) error {
+	out.Total = in.Total
+	out.Completed = in.Completed
+	out.Failed = in.Failed
+	out.Running = in.Running
+	out.DeployItems = *(*[]core.DeployItemProgress)(unsafe.Pointer(&in.DeployItems))
 	return nil
 }
 
-func autoConvert_core_ExecutionSpec_To_v1alpha1_ExecutionSpec(in *core.ExecutionSpec, out *ExecutionSpec, s conversion.Scope) error {
-	out.Context = in.Context
+// Convert_v1alpha1_ExecutionProgress_To_core_ExecutionProgress is an autogenerated conversion function.
+func Convert_v1alpha1_ExecutionProgress_To_core_ExecutionProgress(in *ExecutionProgress, out *core.ExecutionProgress, s conversion.Scope) error {
+	return autoConvert_v1alpha1_ExecutionProgress_To_core_ExecutionProgress(in, out, s)
+}
+
+func autoConvert_core_ExecutionProgress_To_v1alpha1_ExecutionProgress(in *core.ExecutionProgress, out *ExecutionProgress, s conversion.Scope) error {
+	out.Total = in.Total
+	out.Completed = in.Completed
+	out.Failed = in.Failed
+	out.Running = in.Running
+	out.DeployItems = *(*[]DeployItemProgress)(unsafe.Pointer(&in.DeployItems))
+	return nil
+}
+
+// Convert_core_ExecutionProgress_To_v1alpha1_ExecutionProgress is an autogenerated conversion function.
+func Convert_core_ExecutionProgress_To_v1alpha1_ExecutionProgress(in *core.ExecutionProgress, out *ExecutionProgress, s conversion.Scope) error {
+	return autoConvert_core_ExecutionProgress_To_v1alpha1_ExecutionProgress(in, out, s)
+}
+
+func autoConvert_v1alpha1_ExecutionSpec_To_core_ExecutionSpec(in *ExecutionSpec, out *core.ExecutionSpec, s conversion.Scope) error {
+	out.Context = in.Context
+	out.DeployItems = *(*core.DeployItemTemplateList)(unsafe.Pointer(&in.DeployItems))
+	out.DeployItemsCompressed = *(*[]byte)(unsafe.Pointer(&in.DeployItemsCompressed))
+	return nil
+}
+
+func autoConvert_core_ExecutionSpec_To_v1alpha1_ExecutionSpec(in *core.ExecutionSpec, out *ExecutionSpec, s conversion.Scope) error {
+	out.Context = in.Context
 	out.DeployItems = *(*DeployItemTemplateList)(unsafe.Pointer(&in.DeployItems))
 	out.DeployItemsCompressed = *(*[]byte)(unsafe.Pointer(&in.DeployItemsCompressed))
 	return nil
@@ -2158,6 +2992,10 @@ func autoConvert_v1alpha1_ExecutionStatus_To_core_ExecutionStatus(in *ExecutionS
 	out.ExecutionPhase = core.ExecutionPhase(in.ExecutionPhase)
 	out.PhaseTransitionTime = (*metav1.Time)(unsafe.Pointer(in.PhaseTransitionTime))
 	out.TransitionTimes = (*core.TransitionTimes)(unsafe.Pointer(in.TransitionTimes))
+	out.Progress = (*core.ExecutionProgress)(unsafe.Pointer(in.Progress))
+	out.DeferredDeployItems = *(*[]core.DeferredDeployItem)(unsafe.Pointer(&in.DeferredDeployItems))
+	out.DeployItemHashes = *(*[]core.DeployItemHash)(unsafe.Pointer(&in.DeployItemHashes))
+	out.RedeployAllDeployItems = in.RedeployAllDeployItems
 	return nil
 }
 
@@ -2177,6 +3015,10 @@ func autoConvert_core_ExecutionStatus_To_v1alpha1_ExecutionStatus(in *core.Execu
 	out.ExecutionPhase = ExecutionPhase(in.ExecutionPhase)
 	out.PhaseTransitionTime = (*metav1.Time)(unsafe.Pointer(in.PhaseTransitionTime))
 	out.TransitionTimes = (*TransitionTimes)(unsafe.Pointer(in.TransitionTimes))
+	out.Progress = (*ExecutionProgress)(unsafe.Pointer(in.Progress))
+	out.DeferredDeployItems = *(*[]DeferredDeployItem)(unsafe.Pointer(&in.DeferredDeployItems))
+	out.DeployItemHashes = *(*[]DeployItemHash)(unsafe.Pointer(&in.DeployItemHashes))
+	out.RedeployAllDeployItems = in.RedeployAllDeployItems
 	return nil
 }
 
@@ -2211,6 +3053,82 @@ func Convert_core_ExportDefinition_To_v1alpha1_ExportDefinition(in *core.ExportD
 	return autoConvert_core_ExportDefinition_To_v1alpha1_ExportDefinition(in, out, s)
 }
 
+func autoConvert_v1alpha1_ExportSink_To_core_ExportSink(in *ExportSink, out *core.ExportSink, s conversion.Scope) error {
+	out.Name = in.Name
+	out.Namespace = in.Namespace
+	out.Type = core.ExportSinkType(in.Type)
+	out.Target = in.Target
+	out.Data = *(*[]core.ExportSinkData)(unsafe.Pointer(&in.Data))
+	return nil
+}
+
+// Convert_v1alpha1_ExportSink_To_core_ExportSink is an autogenerated conversion function.
+func Convert_v1alpha1_ExportSink_To_core_ExportSink(in *ExportSink, out *core.ExportSink, s conversion.Scope) error {
+	return autoConvert_v1alpha1_ExportSink_To_core_ExportSink(in, out, s)
+}
+
+func autoConvert_core_ExportSink_To_v1alpha1_ExportSink(in *core.ExportSink, out *ExportSink, s conversion.Scope) error {
+	out.Name = in.Name
+	out.Namespace = in.Namespace
+	out.Type = ExportSinkType(in.Type)
+	out.Target = in.Target
+	out.Data = *(*[]ExportSinkData)(unsafe.Pointer(&in.Data))
+	return nil
+}
+
+// Convert_core_ExportSink_To_v1alpha1_ExportSink is an autogenerated conversion function.
+func Convert_core_ExportSink_To_v1alpha1_ExportSink(in *core.ExportSink, out *ExportSink, s conversion.Scope) error {
+	return autoConvert_core_ExportSink_To_v1alpha1_ExportSink(in, out, s)
+}
+
+func autoConvert_v1alpha1_ExportSinkData_To_core_ExportSinkData(in *ExportSinkData, out *core.ExportSinkData, s conversion.Scope) error {
+	out.Key = in.Key
+	out.Export = in.Export
+	return nil
+}
+
+// Convert_v1alpha1_ExportSinkData_To_core_ExportSinkData is an autogenerated conversion function.
+func Convert_v1alpha1_ExportSinkData_To_core_ExportSinkData(in *ExportSinkData, out *core.ExportSinkData, s conversion.Scope) error {
+	return autoConvert_v1alpha1_ExportSinkData_To_core_ExportSinkData(in, out, s)
+}
+
+func autoConvert_core_ExportSinkData_To_v1alpha1_ExportSinkData(in *core.ExportSinkData, out *ExportSinkData, s conversion.Scope) error {
+	out.Key = in.Key
+	out.Export = in.Export
+	return nil
+}
+
+// Convert_core_ExportSinkData_To_v1alpha1_ExportSinkData is an autogenerated conversion function.
+func Convert_core_ExportSinkData_To_v1alpha1_ExportSinkData(in *core.ExportSinkData, out *ExportSinkData, s conversion.Scope) error {
+	return autoConvert_core_ExportSinkData_To_v1alpha1_ExportSinkData(in, out, s)
+}
+
+func autoConvert_v1alpha1_ExportSinkReference_To_core_ExportSinkReference(in *ExportSinkReference, out *core.ExportSinkReference, s conversion.Scope) error {
+	out.Target = in.Target
+	out.Type = core.ExportSinkType(in.Type)
+	out.Namespace = in.Namespace
+	out.Name = in.Name
+	return nil
+}
+
+// Convert_v1alpha1_ExportSinkReference_To_core_ExportSinkReference is an autogenerated conversion function.
+func Convert_v1alpha1_ExportSinkReference_To_core_ExportSinkReference(in *ExportSinkReference, out *core.ExportSinkReference, s conversion.Scope) error {
+	return autoConvert_v1alpha1_ExportSinkReference_To_core_ExportSinkReference(in, out, s)
+}
+
+func autoConvert_core_ExportSinkReference_To_v1alpha1_ExportSinkReference(in *core.ExportSinkReference, out *ExportSinkReference, s conversion.Scope) error {
+	out.Target = in.Target
+	out.Type = ExportSinkType(in.Type)
+	out.Namespace = in.Namespace
+	out.Name = in.Name
+	return nil
+}
+
+// Convert_core_ExportSinkReference_To_v1alpha1_ExportSinkReference is an autogenerated conversion function.
+func Convert_core_ExportSinkReference_To_v1alpha1_ExportSinkReference(in *core.ExportSinkReference, out *ExportSinkReference, s conversion.Scope) error {
+	return autoConvert_core_ExportSinkReference_To_v1alpha1_ExportSinkReference(in, out, s)
+}
+
 func autoConvert_v1alpha1_FailedReconcile_To_core_FailedReconcile(in *FailedReconcile, out *core.FailedReconcile, s conversion.Scope) error {
 	out.NumberOfReconciles = (*int)(unsafe.Pointer(in.NumberOfReconciles))
 	out.Interval = (*core.Duration)(unsafe.Pointer(in.Interval))
@@ -2235,6 +3153,30 @@ func Convert_core_FailedReconcile_To_v1alpha1_FailedReconcile(in *core.FailedRec
 	return autoConvert_core_FailedReconcile_To_v1alpha1_FailedReconcile(in, out, s)
 }
 
+func autoConvert_v1alpha1_FeatureFlagDefinition_To_core_FeatureFlagDefinition(in *FeatureFlagDefinition, out *core.FeatureFlagDefinition, s conversion.Scope) error {
+	out.Name = in.Name
+	out.Default = in.Default
+	out.Description = in.Description
+	return nil
+}
+
+// Convert_v1alpha1_FeatureFlagDefinition_To_core_FeatureFlagDefinition is an autogenerated conversion function.
+func Convert_v1alpha1_FeatureFlagDefinition_To_core_FeatureFlagDefinition(in *FeatureFlagDefinition, out *core.FeatureFlagDefinition, s conversion.Scope) error {
+	return autoConvert_v1alpha1_FeatureFlagDefinition_To_core_FeatureFlagDefinition(in, out, s)
+}
+
+func autoConvert_core_FeatureFlagDefinition_To_v1alpha1_FeatureFlagDefinition(in *core.FeatureFlagDefinition, out *FeatureFlagDefinition, s conversion.Scope) error {
+	out.Name = in.Name
+	out.Default = in.Default
+	out.Description = in.Description
+	return nil
+}
+
+// Convert_core_FeatureFlagDefinition_To_v1alpha1_FeatureFlagDefinition is an autogenerated conversion function.
+func Convert_core_FeatureFlagDefinition_To_v1alpha1_FeatureFlagDefinition(in *core.FeatureFlagDefinition, out *FeatureFlagDefinition, s conversion.Scope) error {
+	return autoConvert_core_FeatureFlagDefinition_To_v1alpha1_FeatureFlagDefinition(in, out, s)
+}
+
 func autoConvert_v1alpha1_FieldValueDefinition_To_core_FieldValueDefinition(in *FieldValueDefinition, out *core.FieldValueDefinition, s conversion.Scope) error {
 	out.Name = in.Name
 	out.Schema = (*core.JSONSchemaDefinition)(unsafe.Pointer(in.Schema))
@@ -2259,6 +3201,78 @@ func Convert_core_FieldValueDefinition_To_v1alpha1_FieldValueDefinition(in *core
 	return autoConvert_core_FieldValueDefinition_To_v1alpha1_FieldValueDefinition(in, out, s)
 }
 
+func autoConvert_v1alpha1_GitBlueprintReference_To_core_GitBlueprintReference(in *GitBlueprintReference, out *core.GitBlueprintReference, s conversion.Scope) error {
+	out.Repository = in.Repository
+	out.Path = in.Path
+	out.Revision = in.Revision
+	return nil
+}
+
+// Convert_v1alpha1_GitBlueprintReference_To_core_GitBlueprintReference is an autogenerated conversion function.
+func Convert_v1alpha1_GitBlueprintReference_To_core_GitBlueprintReference(in *GitBlueprintReference, out *core.GitBlueprintReference, s conversion.Scope) error {
+	return autoConvert_v1alpha1_GitBlueprintReference_To_core_GitBlueprintReference(in, out, s)
+}
+
+func autoConvert_core_GitBlueprintReference_To_v1alpha1_GitBlueprintReference(in *core.GitBlueprintReference, out *GitBlueprintReference, s conversion.Scope) error {
+	out.Repository = in.Repository
+	out.Path = in.Path
+	out.Revision = in.Revision
+	return nil
+}
+
+// Convert_core_GitBlueprintReference_To_v1alpha1_GitBlueprintReference is an autogenerated conversion function.
+func Convert_core_GitBlueprintReference_To_v1alpha1_GitBlueprintReference(in *core.GitBlueprintReference, out *GitBlueprintReference, s conversion.Scope) error {
+	return autoConvert_core_GitBlueprintReference_To_v1alpha1_GitBlueprintReference(in, out, s)
+}
+
+func autoConvert_v1alpha1_HTTPDataReference_To_core_HTTPDataReference(in *HTTPDataReference, out *core.HTTPDataReference, s conversion.Scope) error {
+	out.URL = in.URL
+	out.AuthSecretRef = (*core.LocalSecretReference)(unsafe.Pointer(in.AuthSecretRef))
+	out.JSONPath = in.JSONPath
+	out.CacheDuration = (*core.Duration)(unsafe.Pointer(in.CacheDuration))
+	return nil
+}
+
+// Convert_v1alpha1_HTTPDataReference_To_core_HTTPDataReference is an autogenerated conversion function.
+func Convert_v1alpha1_HTTPDataReference_To_core_HTTPDataReference(in *HTTPDataReference, out *core.HTTPDataReference, s conversion.Scope) error {
+	return autoConvert_v1alpha1_HTTPDataReference_To_core_HTTPDataReference(in, out, s)
+}
+
+func autoConvert_core_HTTPDataReference_To_v1alpha1_HTTPDataReference(in *core.HTTPDataReference, out *HTTPDataReference, s conversion.Scope) error {
+	out.URL = in.URL
+	out.AuthSecretRef = (*LocalSecretReference)(unsafe.Pointer(in.AuthSecretRef))
+	out.JSONPath = in.JSONPath
+	out.CacheDuration = (*Duration)(unsafe.Pointer(in.CacheDuration))
+	return nil
+}
+
+// Convert_core_HTTPDataReference_To_v1alpha1_HTTPDataReference is an autogenerated conversion function.
+func Convert_core_HTTPDataReference_To_v1alpha1_HTTPDataReference(in *core.HTTPDataReference, out *HTTPDataReference, s conversion.Scope) error {
+	return autoConvert_core_HTTPDataReference_To_v1alpha1_HTTPDataReference(in, out, s)
+}
+
+func autoConvert_v1alpha1_ImagePullSecretsConfiguration_To_core_ImagePullSecretsConfiguration(in *ImagePullSecretsConfiguration, out *core.ImagePullSecretsConfiguration, s conversion.Scope) error {
+	out.Secrets = *(*[]v1.LocalObjectReference)(unsafe.Pointer(&in.Secrets))
+	out.InjectIntoHelmWorkloads = in.InjectIntoHelmWorkloads
+	return nil
+}
+
+// Convert_v1alpha1_ImagePullSecretsConfiguration_To_core_ImagePullSecretsConfiguration is an autogenerated conversion function.
+func Convert_v1alpha1_ImagePullSecretsConfiguration_To_core_ImagePullSecretsConfiguration(in *ImagePullSecretsConfiguration, out *core.ImagePullSecretsConfiguration, s conversion.Scope) error {
+	return autoConvert_v1alpha1_ImagePullSecretsConfiguration_To_core_ImagePullSecretsConfiguration(in, out, s)
+}
+
+func autoConvert_core_ImagePullSecretsConfiguration_To_v1alpha1_ImagePullSecretsConfiguration(in *core.ImagePullSecretsConfiguration, out *ImagePullSecretsConfiguration, s conversion.Scope) error {
+	out.Secrets = *(*[]v1.LocalObjectReference)(unsafe.Pointer(&in.Secrets))
+	out.InjectIntoHelmWorkloads = in.InjectIntoHelmWorkloads
+	return nil
+}
+
+// Convert_core_ImagePullSecretsConfiguration_To_v1alpha1_ImagePullSecretsConfiguration is an autogenerated conversion function.
+func Convert_core_ImagePullSecretsConfiguration_To_v1alpha1_ImagePullSecretsConfiguration(in *core.ImagePullSecretsConfiguration, out *ImagePullSecretsConfiguration, s conversion.Scope) error {
+	return autoConvert_core_ImagePullSecretsConfiguration_To_v1alpha1_ImagePullSecretsConfiguration(in, out, s)
+}
+
 func autoConvert_v1alpha1_ImportDefinition_To_core_ImportDefinition(in *ImportDefinition, out *core.ImportDefinition, s conversion.Scope) error {
 	if err := Convert_v1alpha1_FieldValueDefinition_To_core_FieldValueDefinition(&in.FieldValueDefinition, &out.FieldValueDefinition, s); err != nil {
 		return err
@@ -2295,6 +3309,88 @@ func Convert_core_ImportDefinition_To_v1alpha1_ImportDefinition(in *core.ImportD
 	return autoConvert_core_ImportDefinition_To_v1alpha1_ImportDefinition(in, out, s)
 }
 
+func autoConvert_v1alpha1_ImportQuarantine_To_core_ImportQuarantine(in *ImportQuarantine, out *core.ImportQuarantine, s conversion.Scope) error {
+	out.ImportName = in.ImportName
+	if err := Convert_v1alpha1_TypedObjectReference_To_core_TypedObjectReference(&in.Source, &out.Source, s); err != nil {
+		return err
+	}
+	out.SourceResourceVersion = in.SourceResourceVersion
+	out.Failures = in.Failures
+	out.QuarantineTime = (*metav1.Time)(unsafe.Pointer(in.QuarantineTime))
+	return nil
+}
+
+// Convert_v1alpha1_ImportQuarantine_To_core_ImportQuarantine is an autogenerated conversion function.
+func Convert_v1alpha1_ImportQuarantine_To_core_ImportQuarantine(in *ImportQuarantine, out *core.ImportQuarantine, s conversion.Scope) error {
+	return autoConvert_v1alpha1_ImportQuarantine_To_core_ImportQuarantine(in, out, s)
+}
+
+func autoConvert_core_ImportQuarantine_To_v1alpha1_ImportQuarantine(in *core.ImportQuarantine, out *ImportQuarantine, s conversion.Scope) error {
+	out.ImportName = in.ImportName
+	if err := Convert_core_TypedObjectReference_To_v1alpha1_TypedObjectReference(&in.Source, &out.Source, s); err != nil {
+		return err
+	}
+	out.SourceResourceVersion = in.SourceResourceVersion
+	out.Failures = in.Failures
+	out.QuarantineTime = (*metav1.Time)(unsafe.Pointer(in.QuarantineTime))
+	return nil
+}
+
+// Convert_core_ImportQuarantine_To_v1alpha1_ImportQuarantine is an autogenerated conversion function.
+func Convert_core_ImportQuarantine_To_v1alpha1_ImportQuarantine(in *core.ImportQuarantine, out *ImportQuarantine, s conversion.Scope) error {
+	return autoConvert_core_ImportQuarantine_To_v1alpha1_ImportQuarantine(in, out, s)
+}
+
+func autoConvert_v1alpha1_ImportSource_To_core_ImportSource(in *ImportSource, out *core.ImportSource, s conversion.Scope) error {
+	out.Name = in.Name
+	out.Key = in.Key
+	out.Source = in.Source
+	return nil
+}
+
+// Convert_v1alpha1_ImportSource_To_core_ImportSource is an autogenerated conversion function.
+func Convert_v1alpha1_ImportSource_To_core_ImportSource(in *ImportSource, out *core.ImportSource, s conversion.Scope) error {
+	return autoConvert_v1alpha1_ImportSource_To_core_ImportSource(in, out, s)
+}
+
+func autoConvert_core_ImportSource_To_v1alpha1_ImportSource(in *core.ImportSource, out *ImportSource, s conversion.Scope) error {
+	out.Name = in.Name
+	out.Key = in.Key
+	out.Source = in.Source
+	return nil
+}
+
+// Convert_core_ImportSource_To_v1alpha1_ImportSource is an autogenerated conversion function.
+func Convert_core_ImportSource_To_v1alpha1_ImportSource(in *core.ImportSource, out *ImportSource, s conversion.Scope) error {
+	return autoConvert_core_ImportSource_To_v1alpha1_ImportSource(in, out, s)
+}
+
+func autoConvert_v1alpha1_ImportTransformation_To_core_ImportTransformation(in *ImportTransformation, out *core.ImportTransformation, s conversion.Scope) error {
+	out.Type = core.ImportTransformationType(in.Type)
+	out.JSONPath = in.JSONPath
+	out.Value = (*core.AnyJSON)(unsafe.Pointer(in.Value))
+	out.Template = in.Template
+	return nil
+}
+
+// Convert_v1alpha1_ImportTransformation_To_core_ImportTransformation is an autogenerated conversion function.
+func Convert_v1alpha1_ImportTransformation_To_core_ImportTransformation(in *ImportTransformation, out *core.ImportTransformation, s conversion.Scope) error {
+	return autoConvert_v1alpha1_ImportTransformation_To_core_ImportTransformation(in, out, s)
+}
+
+func autoConvert_core_ImportTransformation_To_v1alpha1_ImportTransformation(in *core.ImportTransformation, out *ImportTransformation, s conversion.Scope) error {
+	out.Type = ImportTransformationType(in.Type)
+	out.JSONPath = in.JSONPath
+	out.Value = (*AnyJSON)(unsafe.Pointer(in.Value))
+	out.Template = in.Template
+	return nil
+}
+
+// Convert_core_ImportTransformation_To_v1alpha1_ImportTransformation is an autogenerated conversion function.
+func Convert_core_ImportTransformation_To_v1alpha1_ImportTransformation(in *core.ImportTransformation, out *ImportTransformation, s conversion.Scope) error {
+	return autoConvert_core_ImportTransformation_To_v1alpha1_ImportTransformation(in, out, s)
+}
+
 func autoConvert_v1alpha1_InlineBlueprint_To_core_InlineBlueprint(in *InlineBlueprint, out *core.InlineBlueprint, s conversion.Scope) error {
 	if err := Convert_v1alpha1_AnyJSON_To_core_AnyJSON(&in.Filesystem, &out.Filesystem, s); err != nil {
 		return err
@@ -2354,6 +3450,7 @@ func Convert_core_Installation_To_v1alpha1_Installation(in *core.Installation, o
 func autoConvert_v1alpha1_InstallationExports_To_core_InstallationExports(in *InstallationExports, out *core.InstallationExports, s conversion.Scope) error {
 	out.Data = *(*[]core.DataExport)(unsafe.Pointer(&in.Data))
 	out.Targets = *(*[]core.TargetExport)(unsafe.Pointer(&in.Targets))
+	out.Sinks = *(*[]core.ExportSink)(unsafe.Pointer(&in.Sinks))
 	return nil
 }
 
@@ -2365,6 +3462,7 @@ func Convert_v1alpha1_InstallationExports_To_core_InstallationExports(in *Instal
 func autoConvert_core_InstallationExports_To_v1alpha1_InstallationExports(in *core.InstallationExports, out *InstallationExports, s conversion.Scope) error {
 	out.Data = *(*[]DataExport)(unsafe.Pointer(&in.Data))
 	out.Targets = *(*[]TargetExport)(unsafe.Pointer(&in.Targets))
+	out.Sinks = *(*[]ExportSink)(unsafe.Pointer(&in.Sinks))
 	return nil
 }
 
@@ -2373,6 +3471,32 @@ func Convert_core_InstallationExports_To_v1alpha1_InstallationExports(in *core.I
 	return autoConvert_core_InstallationExports_To_v1alpha1_InstallationExports(in, out, s)
 }
 
+func autoConvert_v1alpha1_InstallationHealthRecord_To_core_InstallationHealthRecord(in *InstallationHealthRecord, out *core.InstallationHealthRecord, s conversion.Scope) error {
+	out.Name = in.Name
+	out.Phase = core.InstallationPhase(in.Phase)
+	out.Time = (*metav1.Time)(unsafe.Pointer(in.Time))
+	out.Message = in.Message
+	return nil
+}
+
+// Convert_v1alpha1_InstallationHealthRecord_To_core_InstallationHealthRecord is an autogenerated conversion function.
+func Convert_v1alpha1_InstallationHealthRecord_To_core_InstallationHealthRecord(in *InstallationHealthRecord, out *core.InstallationHealthRecord, s conversion.Scope) error {
+	return autoConvert_v1alpha1_InstallationHealthRecord_To_core_InstallationHealthRecord(in, out, s)
+}
+
+func autoConvert_core_InstallationHealthRecord_To_v1alpha1_InstallationHealthRecord(in *core.InstallationHealthRecord, out *InstallationHealthRecord, s conversion.Scope) error {
+	out.Name = in.Name
+	out.Phase = InstallationPhase(in.Phase)
+	out.Time = (*metav1.Time)(unsafe.Pointer(in.Time))
+	out.Message = in.Message
+	return nil
+}
+
+// Convert_core_InstallationHealthRecord_To_v1alpha1_InstallationHealthRecord is an autogenerated conversion function.
+func Convert_core_InstallationHealthRecord_To_v1alpha1_InstallationHealthRecord(in *core.InstallationHealthRecord, out *InstallationHealthRecord, s conversion.Scope) error {
+	return autoConvert_core_InstallationHealthRecord_To_v1alpha1_InstallationHealthRecord(in, out, s)
+}
+
 func autoConvert_v1alpha1_InstallationImports_To_core_InstallationImports(in *InstallationImports, out *core.InstallationImports, s conversion.Scope) error {
 	out.Data = *(*[]core.DataImport)(unsafe.Pointer(&in.Data))
 	out.Targets = *(*[]core.TargetImport)(unsafe.Pointer(&in.Targets))
@@ -2417,6 +3541,28 @@ func Convert_core_InstallationList_To_v1alpha1_InstallationList(in *core.Install
 	return autoConvert_core_InstallationList_To_v1alpha1_InstallationList(in, out, s)
 }
 
+func autoConvert_v1alpha1_InstallationPhaseCount_To_core_InstallationPhaseCount(in *InstallationPhaseCount, out *core.InstallationPhaseCount, s conversion.Scope) error {
+	out.Phase = core.InstallationPhase(in.Phase)
+	out.Count = in.Count
+	return nil
+}
+
+// Convert_v1alpha1_InstallationPhaseCount_To_core_InstallationPhaseCount is an autogenerated conversion function.
+func Convert_v1alpha1_InstallationPhaseCount_To_core_InstallationPhaseCount(in *InstallationPhaseCount, out *core.InstallationPhaseCount, s conversion.Scope) error {
+	return autoConvert_v1alpha1_InstallationPhaseCount_To_core_InstallationPhaseCount(in, out, s)
+}
+
+func autoConvert_core_InstallationPhaseCount_To_v1alpha1_InstallationPhaseCount(in *core.InstallationPhaseCount, out *InstallationPhaseCount, s conversion.Scope) error {
+	out.Phase = InstallationPhase(in.Phase)
+	out.Count = in.Count
+	return nil
+}
+
+// Convert_core_InstallationPhaseCount_To_v1alpha1_InstallationPhaseCount is an autogenerated conversion function.
+func Convert_core_InstallationPhaseCount_To_v1alpha1_InstallationPhaseCount(in *core.InstallationPhaseCount, out *InstallationPhaseCount, s conversion.Scope) error {
+	return autoConvert_core_InstallationPhaseCount_To_v1alpha1_InstallationPhaseCount(in, out, s)
+}
+
 func autoConvert_v1alpha1_InstallationSpec_To_core_InstallationSpec(in *InstallationSpec, out *core.InstallationSpec, s conversion.Scope) error {
 	out.Context = in.Context
 	out.Verification = (*core.Verification)(unsafe.Pointer(in.Verification))
@@ -2434,6 +3580,8 @@ func autoConvert_v1alpha1_InstallationSpec_To_core_InstallationSpec(in *Installa
 	out.ExportDataMappings = *(*map[string]core.AnyJSON)(unsafe.Pointer(&in.ExportDataMappings))
 	out.AutomaticReconcile = (*core.AutomaticReconcile)(unsafe.Pointer(in.AutomaticReconcile))
 	out.Optimization = (*core.Optimization)(unsafe.Pointer(in.Optimization))
+	out.StrictImportOwnership = in.StrictImportOwnership
+	out.ReconcileSchedule = (*core.ReconcileSchedule)(unsafe.Pointer(in.ReconcileSchedule))
 	return nil
 }
 
@@ -2459,6 +3607,8 @@ func autoConvert_core_InstallationSpec_To_v1alpha1_InstallationSpec(in *core.Ins
 	out.ExportDataMappings = *(*map[string]AnyJSON)(unsafe.Pointer(&in.ExportDataMappings))
 	out.AutomaticReconcile = (*AutomaticReconcile)(unsafe.Pointer(in.AutomaticReconcile))
 	out.Optimization = (*Optimization)(unsafe.Pointer(in.Optimization))
+	out.StrictImportOwnership = in.StrictImportOwnership
+	out.ReconcileSchedule = (*ReconcileSchedule)(unsafe.Pointer(in.ReconcileSchedule))
 	return nil
 }
 
@@ -2472,7 +3622,9 @@ func autoConvert_v1alpha1_InstallationStatus_To_core_InstallationStatus(in *Inst
 	out.Conditions = *(*[]core.Condition)(unsafe.Pointer(&in.Conditions))
 	out.LastError = (*core.Error)(unsafe.Pointer(in.LastError))
 	out.SubInstCache = (*core.SubInstCache)(unsafe.Pointer(in.SubInstCache))
+	out.RenderedSubinstallations = *(*[]core.RenderedSubinstallation)(unsafe.Pointer(&in.RenderedSubinstallations))
 	out.ExecutionReference = (*core.ObjectReference)(unsafe.Pointer(in.ExecutionReference))
+	out.ChainedExecutionReferences = *(*[]core.ObjectReference)(unsafe.Pointer(&in.ChainedExecutionReferences))
 	out.JobID = in.JobID
 	out.JobIDFinished = in.JobIDFinished
 	out.InstallationPhase = core.InstallationPhase(in.InstallationPhase)
@@ -2480,7 +3632,14 @@ func autoConvert_v1alpha1_InstallationStatus_To_core_InstallationStatus(in *Inst
 	out.ImportsHash = in.ImportsHash
 	out.AutomaticReconcileStatus = (*core.AutomaticReconcileStatus)(unsafe.Pointer(in.AutomaticReconcileStatus))
 	out.DependentsToTrigger = *(*[]core.DependentToTrigger)(unsafe.Pointer(&in.DependentsToTrigger))
+	out.Predecessors = *(*[]core.PredecessorStatus)(unsafe.Pointer(&in.Predecessors))
 	out.TransitionTimes = (*core.TransitionTimes)(unsafe.Pointer(in.TransitionTimes))
+	out.Approvals = *(*[]core.ApprovalRecord)(unsafe.Pointer(&in.Approvals))
+	out.ImportSources = *(*[]core.ImportSource)(unsafe.Pointer(&in.ImportSources))
+	out.ResolvedComponentVersions = *(*[]core.ResolvedComponentVersion)(unsafe.Pointer(&in.ResolvedComponentVersions))
+	out.ImportQuarantine = (*core.ImportQuarantine)(unsafe.Pointer(in.ImportQuarantine))
+	out.ExportSinks = *(*[]core.ExportSinkReference)(unsafe.Pointer(&in.ExportSinks))
+	out.RedeployAllDeployItems = in.RedeployAllDeployItems
 	return nil
 }
 
@@ -2494,7 +3653,9 @@ func autoConvert_core_InstallationStatus_To_v1alpha1_InstallationStatus(in *core
 	out.Conditions = *(*[]Condition)(unsafe.Pointer(&in.Conditions))
 	out.LastError = (*Error)(unsafe.Pointer(in.LastError))
 	out.SubInstCache = (*SubInstCache)(unsafe.Pointer(in.SubInstCache))
+	out.RenderedSubinstallations = *(*[]RenderedSubinstallation)(unsafe.Pointer(&in.RenderedSubinstallations))
 	out.ExecutionReference = (*ObjectReference)(unsafe.Pointer(in.ExecutionReference))
+	out.ChainedExecutionReferences = *(*[]ObjectReference)(unsafe.Pointer(&in.ChainedExecutionReferences))
 	out.JobID = in.JobID
 	out.JobIDFinished = in.JobIDFinished
 	out.InstallationPhase = InstallationPhase(in.InstallationPhase)
@@ -2502,7 +3663,14 @@ func autoConvert_core_InstallationStatus_To_v1alpha1_InstallationStatus(in *core
 	out.ImportsHash = in.ImportsHash
 	out.AutomaticReconcileStatus = (*AutomaticReconcileStatus)(unsafe.Pointer(in.AutomaticReconcileStatus))
 	out.DependentsToTrigger = *(*[]DependentToTrigger)(unsafe.Pointer(&in.DependentsToTrigger))
+	out.Predecessors = *(*[]PredecessorStatus)(unsafe.Pointer(&in.Predecessors))
 	out.TransitionTimes = (*TransitionTimes)(unsafe.Pointer(in.TransitionTimes))
+	out.Approvals = *(*[]ApprovalRecord)(unsafe.Pointer(&in.Approvals))
+	out.ImportSources = *(*[]ImportSource)(unsafe.Pointer(&in.ImportSources))
+	out.ResolvedComponentVersions = *(*[]ResolvedComponentVersion)(unsafe.Pointer(&in.ResolvedComponentVersions))
+	out.ImportQuarantine = (*ImportQuarantine)(unsafe.Pointer(in.ImportQuarantine))
+	out.ExportSinks = *(*[]ExportSinkReference)(unsafe.Pointer(&in.ExportSinks))
+	out.RedeployAllDeployItems = in.RedeployAllDeployItems
 	return nil
 }
 
@@ -2525,6 +3693,8 @@ func autoConvert_v1alpha1_InstallationTemplate_To_core_InstallationTemplate(in *
 	}
 	out.ExportDataMappings = *(*map[string]core.AnyJSON)(unsafe.Pointer(&in.ExportDataMappings))
 	out.Optimization = (*core.Optimization)(unsafe.Pointer(in.Optimization))
+	out.ForEach = (*core.InstallationTemplateForEach)(unsafe.Pointer(in.ForEach))
+	out.IncludeIf = in.IncludeIf
 	return nil
 }
 
@@ -2547,6 +3717,8 @@ func autoConvert_core_InstallationTemplate_To_v1alpha1_InstallationTemplate(in *
 	}
 	out.ExportDataMappings = *(*map[string]AnyJSON)(unsafe.Pointer(&in.ExportDataMappings))
 	out.Optimization = (*Optimization)(unsafe.Pointer(in.Optimization))
+	out.ForEach = (*InstallationTemplateForEach)(unsafe.Pointer(in.ForEach))
+	out.IncludeIf = in.IncludeIf
 	return nil
 }
 
@@ -2581,6 +3753,30 @@ func Convert_core_InstallationTemplateBlueprintDefinition_To_v1alpha1_Installati
 	return autoConvert_core_InstallationTemplateBlueprintDefinition_To_v1alpha1_InstallationTemplateBlueprintDefinition(in, out, s)
 }
 
+func autoConvert_v1alpha1_InstallationTemplateForEach_To_core_InstallationTemplateForEach(in *InstallationTemplateForEach, out *core.InstallationTemplateForEach, s conversion.Scope) error {
+	out.Import = in.Import
+	out.As = in.As
+	out.Key = in.Key
+	return nil
+}
+
+// Convert_v1alpha1_InstallationTemplateForEach_To_core_InstallationTemplateForEach is an autogenerated conversion function.
+func Convert_v1alpha1_InstallationTemplateForEach_To_core_InstallationTemplateForEach(in *InstallationTemplateForEach, out *core.InstallationTemplateForEach, s conversion.Scope) error {
+	return autoConvert_v1alpha1_InstallationTemplateForEach_To_core_InstallationTemplateForEach(in, out, s)
+}
+
+func autoConvert_core_InstallationTemplateForEach_To_v1alpha1_InstallationTemplateForEach(in *core.InstallationTemplateForEach, out *InstallationTemplateForEach, s conversion.Scope) error {
+	out.Import = in.Import
+	out.As = in.As
+	out.Key = in.Key
+	return nil
+}
+
+// Convert_core_InstallationTemplateForEach_To_v1alpha1_InstallationTemplateForEach is an autogenerated conversion function.
+func Convert_core_InstallationTemplateForEach_To_v1alpha1_InstallationTemplateForEach(in *core.InstallationTemplateForEach, out *InstallationTemplateForEach, s conversion.Scope) error {
+	return autoConvert_core_InstallationTemplateForEach_To_v1alpha1_InstallationTemplateForEach(in, out, s)
+}
+
 func autoConvert_v1alpha1_JSONSchemaDefinition_To_core_JSONSchemaDefinition(in *JSONSchemaDefinition, out *core.JSONSchemaDefinition, s conversion.Scope) error {
 	out.RawMessage = *(*json.RawMessage)(unsafe.Pointer(&in.RawMessage))
 	return nil
@@ -2601,6 +3797,116 @@ func Convert_core_JSONSchemaDefinition_To_v1alpha1_JSONSchemaDefinition(in *core
 	return autoConvert_core_JSONSchemaDefinition_To_v1alpha1_JSONSchemaDefinition(in, out, s)
 }
 
+func autoConvert_v1alpha1_LandscapeHealth_To_core_LandscapeHealth(in *LandscapeHealth, out *core.LandscapeHealth, s conversion.Scope) error {
+	out.ObjectMeta = in.ObjectMeta
+	if err := Convert_v1alpha1_LandscapeHealthSpec_To_core_LandscapeHealthSpec(&in.Spec, &out.Spec, s); err != nil {
+		return err
+	}
+	if err := Convert_v1alpha1_LandscapeHealthStatus_To_core_LandscapeHealthStatus(&in.Status, &out.Status, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_v1alpha1_LandscapeHealth_To_core_LandscapeHealth is an autogenerated conversion function.
+func Convert_v1alpha1_LandscapeHealth_To_core_LandscapeHealth(in *LandscapeHealth, out *core.LandscapeHealth, s conversion.Scope) error {
+	return autoConvert_v1alpha1_LandscapeHealth_To_core_LandscapeHealth(in, out, s)
+}
+
+func autoConvert_core_LandscapeHealth_To_v1alpha1_LandscapeHealth(in *core.LandscapeHealth, out *LandscapeHealth, s conversion.Scope) error {
+	out.ObjectMeta = in.ObjectMeta
+	if err := Convert_core_LandscapeHealthSpec_To_v1alpha1_LandscapeHealthSpec(&in.Spec, &out.Spec, s); err != nil {
+		return err
+	}
+	if err := Convert_core_LandscapeHealthStatus_To_v1alpha1_LandscapeHealthStatus(&in.Status, &out.Status, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_core_LandscapeHealth_To_v1alpha1_LandscapeHealth is an autogenerated conversion function.
+func Convert_core_LandscapeHealth_To_v1alpha1_LandscapeHealth(in *core.LandscapeHealth, out *LandscapeHealth, s conversion.Scope) error {
+	return autoConvert_core_LandscapeHealth_To_v1alpha1_LandscapeHealth(in, out, s)
+}
+
+func autoConvert_v1alpha1_LandscapeHealthList_To_core_LandscapeHealthList(in *LandscapeHealthList, out *core.LandscapeHealthList, s conversion.Scope) error {
+	out.ListMeta = in.ListMeta
+	out.Items = *(*[]core.LandscapeHealth)(unsafe.Pointer(&in.Items))
+	return nil
+}
+
+// Convert_v1alpha1_LandscapeHealthList_To_core_LandscapeHealthList is an autogenerated conversion function.
+func Convert_v1alpha1_LandscapeHealthList_To_core_LandscapeHealthList(in *LandscapeHealthList, out *core.LandscapeHealthList, s conversion.Scope) error {
+	return autoConvert_v1alpha1_LandscapeHealthList_To_core_LandscapeHealthList(in, out, s)
+}
+
+func autoConvert_core_LandscapeHealthList_To_v1alpha1_LandscapeHealthList(in *core.LandscapeHealthList, out *LandscapeHealthList, s conversion.Scope) error {
+	out.ListMeta = in.ListMeta
+	out.Items = *(*[]LandscapeHealth)(unsafe.Pointer(&in.Items))
+	return nil
+}
+
+// Convert_core_LandscapeHealthList_To_v1alpha1_LandscapeHealthList is an autogenerated conversion function.
+func Convert_core_LandscapeHealthList_To_v1alpha1_LandscapeHealthList(in *core.LandscapeHealthList, out *LandscapeHealthList, s conversion.Scope) error {
+	return autoConvert_core_LandscapeHealthList_To_v1alpha1_LandscapeHealthList(in, out, s)
+}
+
+func autoConvert_v1alpha1_LandscapeHealthSpec_To_core_LandscapeHealthSpec(in *LandscapeHealthSpec, out *core.LandscapeHealthSpec, s conversion.Scope) error {
+	out.Selector = (*metav1.LabelSelector)(unsafe.Pointer(in.Selector))
+	out.RootInstallationsOnly = in.RootInstallationsOnly
+	return nil
+}
+
+// Convert_v1alpha1_LandscapeHealthSpec_To_core_LandscapeHealthSpec is an autogenerated conversion function.
+func Convert_v1alpha1_LandscapeHealthSpec_To_core_LandscapeHealthSpec(in *LandscapeHealthSpec, out *core.LandscapeHealthSpec, s conversion.Scope) error {
+	return autoConvert_v1alpha1_LandscapeHealthSpec_To_core_LandscapeHealthSpec(in, out, s)
+}
+
+func autoConvert_core_LandscapeHealthSpec_To_v1alpha1_LandscapeHealthSpec(in *core.LandscapeHealthSpec, out *LandscapeHealthSpec, s conversion.Scope) error {
+	out.Selector = (*metav1.LabelSelector)(unsafe.Pointer(in.Selector))
+	out.RootInstallationsOnly = in.RootInstallationsOnly
+	return nil
+}
+
+// Convert_core_LandscapeHealthSpec_To_v1alpha1_LandscapeHealthSpec is an autogenerated conversion function.
+func Convert_core_LandscapeHealthSpec_To_v1alpha1_LandscapeHealthSpec(in *core.LandscapeHealthSpec, out *LandscapeHealthSpec, s conversion.Scope) error {
+	return autoConvert_core_LandscapeHealthSpec_To_v1alpha1_LandscapeHealthSpec(in, out, s)
+}
+
+func autoConvert_v1alpha1_LandscapeHealthStatus_To_core_LandscapeHealthStatus(in *LandscapeHealthStatus, out *core.LandscapeHealthStatus, s conversion.Scope) error {
+	out.ObservedGeneration = in.ObservedGeneration
+	out.Health = core.LandscapeHealthState(in.Health)
+	out.Installations = in.Installations
+	out.FailedInstallations = in.FailedInstallations
+	out.PhaseCounts = *(*[]core.InstallationPhaseCount)(unsafe.Pointer(&in.PhaseCounts))
+	out.OldestFailure = (*core.InstallationHealthRecord)(unsafe.Pointer(in.OldestFailure))
+	out.LastSuccessfulRun = (*core.InstallationHealthRecord)(unsafe.Pointer(in.LastSuccessfulRun))
+	out.LastUpdateTime = (*metav1.Time)(unsafe.Pointer(in.LastUpdateTime))
+	return nil
+}
+
+// Convert_v1alpha1_LandscapeHealthStatus_To_core_LandscapeHealthStatus is an autogenerated conversion function.
+func Convert_v1alpha1_LandscapeHealthStatus_To_core_LandscapeHealthStatus(in *LandscapeHealthStatus, out *core.LandscapeHealthStatus, s conversion.Scope) error {
+	return autoConvert_v1alpha1_LandscapeHealthStatus_To_core_LandscapeHealthStatus(in, out, s)
+}
+
+func autoConvert_core_LandscapeHealthStatus_To_v1alpha1_LandscapeHealthStatus(in *core.LandscapeHealthStatus, out *LandscapeHealthStatus, s conversion.Scope) error {
+	out.ObservedGeneration = in.ObservedGeneration
+	out.Health = LandscapeHealthState(in.Health)
+	out.Installations = in.Installations
+	out.FailedInstallations = in.FailedInstallations
+	out.PhaseCounts = *(*[]InstallationPhaseCount)(unsafe.Pointer(&in.PhaseCounts))
+	out.OldestFailure = (*InstallationHealthRecord)(unsafe.Pointer(in.OldestFailure))
+	out.LastSuccessfulRun = (*InstallationHealthRecord)(unsafe.Pointer(in.LastSuccessfulRun))
+	out.LastUpdateTime = (*metav1.Time)(unsafe.Pointer(in.LastUpdateTime))
+	return nil
+}
+
+// Convert_core_LandscapeHealthStatus_To_v1alpha1_LandscapeHealthStatus is an autogenerated conversion function.
+func Convert_core_LandscapeHealthStatus_To_v1alpha1_LandscapeHealthStatus(in *core.LandscapeHealthStatus, out *LandscapeHealthStatus, s conversion.Scope) error {
+	return autoConvert_core_LandscapeHealthStatus_To_v1alpha1_LandscapeHealthStatus(in, out, s)
+}
+
 func autoConvert_v1alpha1_LocalConfigMapReference_To_core_LocalConfigMapReference(in *LocalConfigMapReference, out *core.LocalConfigMapReference, s conversion.Scope) error {
 	out.Name = in.Name
 	out.Key = in.Key
@@ -2764,6 +4070,7 @@ func Convert_core_OnDeleteConfig_To_v1alpha1_OnDeleteConfig(in *core.OnDeleteCon
 func autoConvert_v1alpha1_Optimization_To_core_Optimization(in *Optimization, out *core.Optimization, s conversion.Scope) error {
 	out.HasNoSiblingImports = in.HasNoSiblingImports
 	out.HasNoSiblingExports = in.HasNoSiblingExports
+	out.PartialImportUpdates = in.PartialImportUpdates
 	return nil
 }
 
@@ -2775,6 +4082,7 @@ func Convert_v1alpha1_Optimization_To_core_Optimization(in *Optimization, out *c
 func autoConvert_core_Optimization_To_v1alpha1_Optimization(in *core.Optimization, out *Optimization, s conversion.Scope) error {
 	out.HasNoSiblingImports = in.HasNoSiblingImports
 	out.HasNoSiblingExports = in.HasNoSiblingExports
+	out.PartialImportUpdates = in.PartialImportUpdates
 	return nil
 }
 
@@ -2783,6 +4091,60 @@ func Convert_core_Optimization_To_v1alpha1_Optimization(in *core.Optimization, o
 	return autoConvert_core_Optimization_To_v1alpha1_Optimization(in, out, s)
 }
 
+func autoConvert_v1alpha1_PredecessorStatus_To_core_PredecessorStatus(in *PredecessorStatus, out *core.PredecessorStatus, s conversion.Scope) error {
+	out.Name = in.Name
+	out.ObservedGeneration = in.ObservedGeneration
+	out.Phase = core.InstallationPhase(in.Phase)
+	out.JobIDFinished = in.JobIDFinished
+	return nil
+}
+
+// Convert_v1alpha1_PredecessorStatus_To_core_PredecessorStatus is an autogenerated conversion function.
+func Convert_v1alpha1_PredecessorStatus_To_core_PredecessorStatus(in *PredecessorStatus, out *core.PredecessorStatus, s conversion.Scope) error {
+	return autoConvert_v1alpha1_PredecessorStatus_To_core_PredecessorStatus(in, out, s)
+}
+
+func autoConvert_core_PredecessorStatus_To_v1alpha1_PredecessorStatus(in *core.PredecessorStatus, out *PredecessorStatus, s conversion.Scope) error {
+	out.Name = in.Name
+	out.ObservedGeneration = in.ObservedGeneration
+	out.Phase = InstallationPhase(in.Phase)
+	out.JobIDFinished = in.JobIDFinished
+	return nil
+}
+
+// Convert_core_PredecessorStatus_To_v1alpha1_PredecessorStatus is an autogenerated conversion function.
+func Convert_core_PredecessorStatus_To_v1alpha1_PredecessorStatus(in *core.PredecessorStatus, out *PredecessorStatus, s conversion.Scope) error {
+	return autoConvert_core_PredecessorStatus_To_v1alpha1_PredecessorStatus(in, out, s)
+}
+
+func autoConvert_v1alpha1_ReconcileSchedule_To_core_ReconcileSchedule(in *ReconcileSchedule, out *core.ReconcileSchedule, s conversion.Scope) error {
+	out.CronSpec = in.CronSpec
+	if err := Convert_v1alpha1_Duration_To_core_Duration(&in.Duration, &out.Duration, s); err != nil {
+		return err
+	}
+	out.TimeZone = in.TimeZone
+	return nil
+}
+
+// Convert_v1alpha1_ReconcileSchedule_To_core_ReconcileSchedule is an autogenerated conversion function.
+func Convert_v1alpha1_ReconcileSchedule_To_core_ReconcileSchedule(in *ReconcileSchedule, out *core.ReconcileSchedule, s conversion.Scope) error {
+	return autoConvert_v1alpha1_ReconcileSchedule_To_core_ReconcileSchedule(in, out, s)
+}
+
+func autoConvert_core_ReconcileSchedule_To_v1alpha1_ReconcileSchedule(in *core.ReconcileSchedule, out *ReconcileSchedule, s conversion.Scope) error {
+	out.CronSpec = in.CronSpec
+	if err := Convert_core_Duration_To_v1alpha1_Duration(&in.Duration, &out.Duration, s); err != nil {
+		return err
+	}
+	out.TimeZone = in.TimeZone
+	return nil
+}
+
+// Convert_core_ReconcileSchedule_To_v1alpha1_ReconcileSchedule is an autogenerated conversion function.
+func Convert_core_ReconcileSchedule_To_v1alpha1_ReconcileSchedule(in *core.ReconcileSchedule, out *ReconcileSchedule, s conversion.Scope) error {
+	return autoConvert_core_ReconcileSchedule_To_v1alpha1_ReconcileSchedule(in, out, s)
+}
+
 func autoConvert_v1alpha1_RemoteBlueprintReference_To_core_RemoteBlueprintReference(in *RemoteBlueprintReference, out *core.RemoteBlueprintReference, s conversion.Scope) error {
 	out.ResourceName = in.ResourceName
 	return nil
@@ -2803,6 +4165,56 @@ func Convert_core_RemoteBlueprintReference_To_v1alpha1_RemoteBlueprintReference(
 	return autoConvert_core_RemoteBlueprintReference_To_v1alpha1_RemoteBlueprintReference(in, out, s)
 }
 
+func autoConvert_v1alpha1_RenderStage_To_core_RenderStage(in *RenderStage, out *core.RenderStage, s conversion.Scope) error {
+	out.Name = in.Name
+	out.Executions = *(*[]core.TemplateExecutor)(unsafe.Pointer(&in.Executions))
+	out.Schema = (*core.JSONSchemaDefinition)(unsafe.Pointer(in.Schema))
+	return nil
+}
+
+// Convert_v1alpha1_RenderStage_To_core_RenderStage is an autogenerated conversion function.
+func Convert_v1alpha1_RenderStage_To_core_RenderStage(in *RenderStage, out *core.RenderStage, s conversion.Scope) error {
+	return autoConvert_v1alpha1_RenderStage_To_core_RenderStage(in, out, s)
+}
+
+func autoConvert_core_RenderStage_To_v1alpha1_RenderStage(in *core.RenderStage, out *RenderStage, s conversion.Scope) error {
+	out.Name = in.Name
+	out.Executions = *(*[]TemplateExecutor)(unsafe.Pointer(&in.Executions))
+	out.Schema = (*JSONSchemaDefinition)(unsafe.Pointer(in.Schema))
+	return nil
+}
+
+// Convert_core_RenderStage_To_v1alpha1_RenderStage is an autogenerated conversion function.
+func Convert_core_RenderStage_To_v1alpha1_RenderStage(in *core.RenderStage, out *RenderStage, s conversion.Scope) error {
+	return autoConvert_core_RenderStage_To_v1alpha1_RenderStage(in, out, s)
+}
+
+func autoConvert_v1alpha1_RenderedSubinstallation_To_core_RenderedSubinstallation(in *RenderedSubinstallation, out *core.RenderedSubinstallation, s conversion.Scope) error {
+	out.Name = in.Name
+	out.Template = in.Template
+	out.ForEachKey = in.ForEachKey
+	out.Hash = in.Hash
+	return nil
+}
+
+// Convert_v1alpha1_RenderedSubinstallation_To_core_RenderedSubinstallation is an autogenerated conversion function.
+func Convert_v1alpha1_RenderedSubinstallation_To_core_RenderedSubinstallation(in *RenderedSubinstallation, out *core.RenderedSubinstallation, s conversion.Scope) error {
+	return autoConvert_v1alpha1_RenderedSubinstallation_To_core_RenderedSubinstallation(in, out, s)
+}
+
+func autoConvert_core_RenderedSubinstallation_To_v1alpha1_RenderedSubinstallation(in *core.RenderedSubinstallation, out *RenderedSubinstallation, s conversion.Scope) error {
+	out.Name = in.Name
+	out.Template = in.Template
+	out.ForEachKey = in.ForEachKey
+	out.Hash = in.Hash
+	return nil
+}
+
+// Convert_core_RenderedSubinstallation_To_v1alpha1_RenderedSubinstallation is an autogenerated conversion function.
+func Convert_core_RenderedSubinstallation_To_v1alpha1_RenderedSubinstallation(in *core.RenderedSubinstallation, out *RenderedSubinstallation, s conversion.Scope) error {
+	return autoConvert_core_RenderedSubinstallation_To_v1alpha1_RenderedSubinstallation(in, out, s)
+}
+
 func autoConvert_v1alpha1_Requirement_To_core_Requirement(in *Requirement, out *core.Requirement, s conversion.Scope) error {
 	out.Key = in.Key
 	out.Operator = selection.Operator(in.Operator)
@@ -2827,6 +4239,30 @@ func Convert_core_Requirement_To_v1alpha1_Requirement(in *core.Requirement, out
 	return autoConvert_core_Requirement_To_v1alpha1_Requirement(in, out, s)
 }
 
+func autoConvert_v1alpha1_ResolvedComponentVersion_To_core_ResolvedComponentVersion(in *ResolvedComponentVersion, out *core.ResolvedComponentVersion, s conversion.Scope) error {
+	out.ComponentName = in.ComponentName
+	out.Constraint = in.Constraint
+	out.Version = in.Version
+	return nil
+}
+
+// Convert_v1alpha1_ResolvedComponentVersion_To_core_ResolvedComponentVersion is an autogenerated conversion function.
+func Convert_v1alpha1_ResolvedComponentVersion_To_core_ResolvedComponentVersion(in *ResolvedComponentVersion, out *core.ResolvedComponentVersion, s conversion.Scope) error {
+	return autoConvert_v1alpha1_ResolvedComponentVersion_To_core_ResolvedComponentVersion(in, out, s)
+}
+
+func autoConvert_core_ResolvedComponentVersion_To_v1alpha1_ResolvedComponentVersion(in *core.ResolvedComponentVersion, out *ResolvedComponentVersion, s conversion.Scope) error {
+	out.ComponentName = in.ComponentName
+	out.Constraint = in.Constraint
+	out.Version = in.Version
+	return nil
+}
+
+// Convert_core_ResolvedComponentVersion_To_v1alpha1_ResolvedComponentVersion is an autogenerated conversion function.
+func Convert_core_ResolvedComponentVersion_To_v1alpha1_ResolvedComponentVersion(in *core.ResolvedComponentVersion, out *ResolvedComponentVersion, s conversion.Scope) error {
+	return autoConvert_core_ResolvedComponentVersion_To_v1alpha1_ResolvedComponentVersion(in, out, s)
+}
+
 func autoConvert_v1alpha1_ResolvedTarget_To_core_ResolvedTarget(in *ResolvedTarget, out *core.ResolvedTarget, s conversion.Scope) error {
 	out.Target = (*core.Target)(unsafe.Pointer(in.Target))
 	out.Content = in.Content
@@ -2871,6 +4307,56 @@ func Convert_core_ResourceReference_To_v1alpha1_ResourceReference(in *core.Resou
 	return autoConvert_core_ResourceReference_To_v1alpha1_ResourceReference(in, out, s)
 }
 
+func autoConvert_v1alpha1_RetryPolicy_To_core_RetryPolicy(in *RetryPolicy, out *core.RetryPolicy, s conversion.Scope) error {
+	out.MaxRetries = in.MaxRetries
+	out.InitialBackoff = (*core.Duration)(unsafe.Pointer(in.InitialBackoff))
+	out.MaxBackoff = (*core.Duration)(unsafe.Pointer(in.MaxBackoff))
+	out.RetryableErrorCodes = *(*[]core.ErrorCode)(unsafe.Pointer(&in.RetryableErrorCodes))
+	return nil
+}
+
+// Convert_v1alpha1_RetryPolicy_To_core_RetryPolicy is an autogenerated conversion function.
+func Convert_v1alpha1_RetryPolicy_To_core_RetryPolicy(in *RetryPolicy, out *core.RetryPolicy, s conversion.Scope) error {
+	return autoConvert_v1alpha1_RetryPolicy_To_core_RetryPolicy(in, out, s)
+}
+
+func autoConvert_core_RetryPolicy_To_v1alpha1_RetryPolicy(in *core.RetryPolicy, out *RetryPolicy, s conversion.Scope) error {
+	out.MaxRetries = in.MaxRetries
+	out.InitialBackoff = (*Duration)(unsafe.Pointer(in.InitialBackoff))
+	out.MaxBackoff = (*Duration)(unsafe.Pointer(in.MaxBackoff))
+	out.RetryableErrorCodes = *(*[]ErrorCode)(unsafe.Pointer(&in.RetryableErrorCodes))
+	return nil
+}
+
+// Convert_core_RetryPolicy_To_v1alpha1_RetryPolicy is an autogenerated conversion function.
+func Convert_core_RetryPolicy_To_v1alpha1_RetryPolicy(in *core.RetryPolicy, out *RetryPolicy, s conversion.Scope) error {
+	return autoConvert_core_RetryPolicy_To_v1alpha1_RetryPolicy(in, out, s)
+}
+
+func autoConvert_v1alpha1_SchedulingConfiguration_To_core_SchedulingConfiguration(in *SchedulingConfiguration, out *core.SchedulingConfiguration, s conversion.Scope) error {
+	out.NodeSelector = *(*map[string]string)(unsafe.Pointer(&in.NodeSelector))
+	out.Tolerations = *(*[]v1.Toleration)(unsafe.Pointer(&in.Tolerations))
+	out.InjectIntoHelmWorkloads = in.InjectIntoHelmWorkloads
+	return nil
+}
+
+// Convert_v1alpha1_SchedulingConfiguration_To_core_SchedulingConfiguration is an autogenerated conversion function.
+func Convert_v1alpha1_SchedulingConfiguration_To_core_SchedulingConfiguration(in *SchedulingConfiguration, out *core.SchedulingConfiguration, s conversion.Scope) error {
+	return autoConvert_v1alpha1_SchedulingConfiguration_To_core_SchedulingConfiguration(in, out, s)
+}
+
+func autoConvert_core_SchedulingConfiguration_To_v1alpha1_SchedulingConfiguration(in *core.SchedulingConfiguration, out *SchedulingConfiguration, s conversion.Scope) error {
+	out.NodeSelector = *(*map[string]string)(unsafe.Pointer(&in.NodeSelector))
+	out.Tolerations = *(*[]v1.Toleration)(unsafe.Pointer(&in.Tolerations))
+	out.InjectIntoHelmWorkloads = in.InjectIntoHelmWorkloads
+	return nil
+}
+
+// Convert_core_SchedulingConfiguration_To_v1alpha1_SchedulingConfiguration is an autogenerated conversion function.
+func Convert_core_SchedulingConfiguration_To_v1alpha1_SchedulingConfiguration(in *core.SchedulingConfiguration, out *SchedulingConfiguration, s conversion.Scope) error {
+	return autoConvert_core_SchedulingConfiguration_To_v1alpha1_SchedulingConfiguration(in, out, s)
+}
+
 func autoConvert_v1alpha1_SecretLabelSelectorRef_To_core_SecretLabelSelectorRef(in *SecretLabelSelectorRef, out *core.SecretLabelSelectorRef, s conversion.Scope) error {
 	out.Selector = *(*map[string]string)(unsafe.Pointer(&in.Selector))
 	out.Key = in.Key
@@ -3208,6 +4694,7 @@ func autoConvert_v1alpha1_TargetImport_To_core_TargetImport(in *TargetImport, ou
 	out.Target = in.Target
 	out.Targets = *(*[]string)(unsafe.Pointer(&in.Targets))
 	out.TargetListReference = in.TargetListReference
+	out.TargetSelector = (*metav1.LabelSelector)(unsafe.Pointer(in.TargetSelector))
 	out.TargetMap = *(*map[string]string)(unsafe.Pointer(&in.TargetMap))
 	out.TargetMapReference = in.TargetMapReference
 	return nil
@@ -3223,6 +4710,7 @@ func autoConvert_core_TargetImport_To_v1alpha1_TargetImport(in *core.TargetImpor
 	out.Target = in.Target
 	out.Targets = *(*[]string)(unsafe.Pointer(&in.Targets))
 	out.TargetListReference = in.TargetListReference
+	out.TargetSelector = (*metav1.LabelSelector)(unsafe.Pointer(in.TargetSelector))
 	out.TargetMap = *(*map[string]string)(unsafe.Pointer(&in.TargetMap))
 	out.TargetMapReference = in.TargetMapReference
 	return nil
@@ -3447,6 +4935,80 @@ func Convert_core_TargetTemplate_To_v1alpha1_TargetTemplate(in *core.TargetTempl
 	return autoConvert_core_TargetTemplate_To_v1alpha1_TargetTemplate(in, out, s)
 }
 
+func autoConvert_v1alpha1_TargetTypeDefinition_To_core_TargetTypeDefinition(in *TargetTypeDefinition, out *core.TargetTypeDefinition, s conversion.Scope) error {
+	out.ObjectMeta = in.ObjectMeta
+	if err := Convert_v1alpha1_TargetTypeDefinitionSpec_To_core_TargetTypeDefinitionSpec(&in.Spec, &out.Spec, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_v1alpha1_TargetTypeDefinition_To_core_TargetTypeDefinition is an autogenerated conversion function.
+func Convert_v1alpha1_TargetTypeDefinition_To_core_TargetTypeDefinition(in *TargetTypeDefinition, out *core.TargetTypeDefinition, s conversion.Scope) error {
+	return autoConvert_v1alpha1_TargetTypeDefinition_To_core_TargetTypeDefinition(in, out, s)
+}
+
+func autoConvert_core_TargetTypeDefinition_To_v1alpha1_TargetTypeDefinition(in *core.TargetTypeDefinition, out *TargetTypeDefinition, s conversion.Scope) error {
+	out.ObjectMeta = in.ObjectMeta
+	if err := Convert_core_TargetTypeDefinitionSpec_To_v1alpha1_TargetTypeDefinitionSpec(&in.Spec, &out.Spec, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_core_TargetTypeDefinition_To_v1alpha1_TargetTypeDefinition is an autogenerated conversion function.
+func Convert_core_TargetTypeDefinition_To_v1alpha1_TargetTypeDefinition(in *core.TargetTypeDefinition, out *TargetTypeDefinition, s conversion.Scope) error {
+	return autoConvert_core_TargetTypeDefinition_To_v1alpha1_TargetTypeDefinition(in, out, s)
+}
+
+func autoConvert_v1alpha1_TargetTypeDefinitionList_To_core_TargetTypeDefinitionList(in *TargetTypeDefinitionList, out *core.TargetTypeDefinitionList, s conversion.Scope) error {
+	out.ListMeta = in.ListMeta
+	out.Items = *(*[]core.TargetTypeDefinition)(unsafe.Pointer(&in.Items))
+	return nil
+}
+
+// Convert_v1alpha1_TargetTypeDefinitionList_To_core_TargetTypeDefinitionList is an autogenerated conversion function.
+func Convert_v1alpha1_TargetTypeDefinitionList_To_core_TargetTypeDefinitionList(in *TargetTypeDefinitionList, out *core.TargetTypeDefinitionList, s conversion.Scope) error {
+	return autoConvert_v1alpha1_TargetTypeDefinitionList_To_core_TargetTypeDefinitionList(in, out, s)
+}
+
+func autoConvert_core_TargetTypeDefinitionList_To_v1alpha1_TargetTypeDefinitionList(in *core.TargetTypeDefinitionList, out *TargetTypeDefinitionList, s conversion.Scope) error {
+	out.ListMeta = in.ListMeta
+	out.Items = *(*[]TargetTypeDefinition)(unsafe.Pointer(&in.Items))
+	return nil
+}
+
+// Convert_core_TargetTypeDefinitionList_To_v1alpha1_TargetTypeDefinitionList is an autogenerated conversion function.
+func Convert_core_TargetTypeDefinitionList_To_v1alpha1_TargetTypeDefinitionList(in *core.TargetTypeDefinitionList, out *TargetTypeDefinitionList, s conversion.Scope) error {
+	return autoConvert_core_TargetTypeDefinitionList_To_v1alpha1_TargetTypeDefinitionList(in, out, s)
+}
+
+func autoConvert_v1alpha1_TargetTypeDefinitionSpec_To_core_TargetTypeDefinitionSpec(in *TargetTypeDefinitionSpec, out *core.TargetTypeDefinitionSpec, s conversion.Scope) error {
+	out.Type = core.TargetType(in.Type)
+	if err := Convert_v1alpha1_AnyJSON_To_core_AnyJSON(&in.Schema, &out.Schema, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_v1alpha1_TargetTypeDefinitionSpec_To_core_TargetTypeDefinitionSpec is an autogenerated conversion function.
+func Convert_v1alpha1_TargetTypeDefinitionSpec_To_core_TargetTypeDefinitionSpec(in *TargetTypeDefinitionSpec, out *core.TargetTypeDefinitionSpec, s conversion.Scope) error {
+	return autoConvert_v1alpha1_TargetTypeDefinitionSpec_To_core_TargetTypeDefinitionSpec(in, out, s)
+}
+
+func autoConvert_core_TargetTypeDefinitionSpec_To_v1alpha1_TargetTypeDefinitionSpec(in *core.TargetTypeDefinitionSpec, out *TargetTypeDefinitionSpec, s conversion.Scope) error {
+	out.Type = TargetType(in.Type)
+	if err := Convert_core_AnyJSON_To_v1alpha1_AnyJSON(&in.Schema, &out.Schema, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_core_TargetTypeDefinitionSpec_To_v1alpha1_TargetTypeDefinitionSpec is an autogenerated conversion function.
+func Convert_core_TargetTypeDefinitionSpec_To_v1alpha1_TargetTypeDefinitionSpec(in *core.TargetTypeDefinitionSpec, out *TargetTypeDefinitionSpec, s conversion.Scope) error {
+	return autoConvert_core_TargetTypeDefinitionSpec_To_v1alpha1_TargetTypeDefinitionSpec(in, out, s)
+}
+
 func autoConvert_v1alpha1_TemplateExecutor_To_core_TemplateExecutor(in *TemplateExecutor, out *core.TemplateExecutor, s conversion.Scope) error {
 	out.Name = in.Name
 	out.Type = core.TemplateType(in.Type)
@@ -3477,6 +5039,228 @@ func Convert_core_TemplateExecutor_To_v1alpha1_TemplateExecutor(in *core.Templat
 	return autoConvert_core_TemplateExecutor_To_v1alpha1_TemplateExecutor(in, out, s)
 }
 
+func autoConvert_v1alpha1_TestArtifact_To_core_TestArtifact(in *TestArtifact, out *core.TestArtifact, s conversion.Scope) error {
+	out.Name = in.Name
+	out.Kind = core.TestArtifactKind(in.Kind)
+	if err := Convert_v1alpha1_ObjectReference_To_core_ObjectReference(&in.ObjectRef, &out.ObjectRef, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_v1alpha1_TestArtifact_To_core_TestArtifact is an autogenerated conversion function.
+func Convert_v1alpha1_TestArtifact_To_core_TestArtifact(in *TestArtifact, out *core.TestArtifact, s conversion.Scope) error {
+	return autoConvert_v1alpha1_TestArtifact_To_core_TestArtifact(in, out, s)
+}
+
+func autoConvert_core_TestArtifact_To_v1alpha1_TestArtifact(in *core.TestArtifact, out *TestArtifact, s conversion.Scope) error {
+	out.Name = in.Name
+	out.Kind = TestArtifactKind(in.Kind)
+	if err := Convert_core_ObjectReference_To_v1alpha1_ObjectReference(&in.ObjectRef, &out.ObjectRef, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_core_TestArtifact_To_v1alpha1_TestArtifact is an autogenerated conversion function.
+func Convert_core_TestArtifact_To_v1alpha1_TestArtifact(in *core.TestArtifact, out *TestArtifact, s conversion.Scope) error {
+	return autoConvert_core_TestArtifact_To_v1alpha1_TestArtifact(in, out, s)
+}
+
+func autoConvert_v1alpha1_TestDefinition_To_core_TestDefinition(in *TestDefinition, out *core.TestDefinition, s conversion.Scope) error {
+	out.Name = in.Name
+	if err := Convert_v1alpha1_InstallationSpec_To_core_InstallationSpec(&in.Installation, &out.Installation, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_v1alpha1_TestDefinition_To_core_TestDefinition is an autogenerated conversion function.
+func Convert_v1alpha1_TestDefinition_To_core_TestDefinition(in *TestDefinition, out *core.TestDefinition, s conversion.Scope) error {
+	return autoConvert_v1alpha1_TestDefinition_To_core_TestDefinition(in, out, s)
+}
+
+func autoConvert_core_TestDefinition_To_v1alpha1_TestDefinition(in *core.TestDefinition, out *TestDefinition, s conversion.Scope) error {
+	out.Name = in.Name
+	if err := Convert_core_InstallationSpec_To_v1alpha1_InstallationSpec(&in.Installation, &out.Installation, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_core_TestDefinition_To_v1alpha1_TestDefinition is an autogenerated conversion function.
+func Convert_core_TestDefinition_To_v1alpha1_TestDefinition(in *core.TestDefinition, out *TestDefinition, s conversion.Scope) error {
+	return autoConvert_core_TestDefinition_To_v1alpha1_TestDefinition(in, out, s)
+}
+
+func autoConvert_v1alpha1_TestResult_To_core_TestResult(in *TestResult, out *core.TestResult, s conversion.Scope) error {
+	out.Name = in.Name
+	if err := Convert_v1alpha1_ObjectReference_To_core_ObjectReference(&in.InstallationRef, &out.InstallationRef, s); err != nil {
+		return err
+	}
+	out.Phase = core.TestRunPhase(in.Phase)
+	out.JobID = in.JobID
+	out.Message = in.Message
+	out.FinishedTime = (*metav1.Time)(unsafe.Pointer(in.FinishedTime))
+	out.Artifacts = *(*[]core.TestArtifact)(unsafe.Pointer(&in.Artifacts))
+	return nil
+}
+
+// Convert_v1alpha1_TestResult_To_core_TestResult is an autogenerated conversion function.
+func Convert_v1alpha1_TestResult_To_core_TestResult(in *TestResult, out *core.TestResult, s conversion.Scope) error {
+	return autoConvert_v1alpha1_TestResult_To_core_TestResult(in, out, s)
+}
+
+func autoConvert_core_TestResult_To_v1alpha1_TestResult(in *core.TestResult, out *TestResult, s conversion.Scope) error {
+	out.Name = in.Name
+	if err := Convert_core_ObjectReference_To_v1alpha1_ObjectReference(&in.InstallationRef, &out.InstallationRef, s); err != nil {
+		return err
+	}
+	out.Phase = TestRunPhase(in.Phase)
+	out.JobID = in.JobID
+	out.Message = in.Message
+	out.FinishedTime = (*metav1.Time)(unsafe.Pointer(in.FinishedTime))
+	out.Artifacts = *(*[]TestArtifact)(unsafe.Pointer(&in.Artifacts))
+	return nil
+}
+
+// Convert_core_TestResult_To_v1alpha1_TestResult is an autogenerated conversion function.
+func Convert_core_TestResult_To_v1alpha1_TestResult(in *core.TestResult, out *TestResult, s conversion.Scope) error {
+	return autoConvert_core_TestResult_To_v1alpha1_TestResult(in, out, s)
+}
+
+func autoConvert_v1alpha1_TestRun_To_core_TestRun(in *TestRun, out *core.TestRun, s conversion.Scope) error {
+	out.ObjectMeta = in.ObjectMeta
+	if err := Convert_v1alpha1_TestRunSpec_To_core_TestRunSpec(&in.Spec, &out.Spec, s); err != nil {
+		return err
+	}
+	if err := Convert_v1alpha1_TestRunStatus_To_core_TestRunStatus(&in.Status, &out.Status, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_v1alpha1_TestRun_To_core_TestRun is an autogenerated conversion function.
+func Convert_v1alpha1_TestRun_To_core_TestRun(in *TestRun, out *core.TestRun, s conversion.Scope) error {
+	return autoConvert_v1alpha1_TestRun_To_core_TestRun(in, out, s)
+}
+
+func autoConvert_core_TestRun_To_v1alpha1_TestRun(in *core.TestRun, out *TestRun, s conversion.Scope) error {
+	out.ObjectMeta = in.ObjectMeta
+	if err := Convert_core_TestRunSpec_To_v1alpha1_TestRunSpec(&in.Spec, &out.Spec, s); err != nil {
+		return err
+	}
+	if err := Convert_core_TestRunStatus_To_v1alpha1_TestRunStatus(&in.Status, &out.Status, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_core_TestRun_To_v1alpha1_TestRun is an autogenerated conversion function.
+func Convert_core_TestRun_To_v1alpha1_TestRun(in *core.TestRun, out *TestRun, s conversion.Scope) error {
+	return autoConvert_core_TestRun_To_v1alpha1_TestRun(in, out, s)
+}
+
+func autoConvert_v1alpha1_TestRunList_To_core_TestRunList(in *TestRunList, out *core.TestRunList, s conversion.Scope) error {
+	out.ListMeta = in.ListMeta
+	out.Items = *(*[]core.TestRun)(unsafe.Pointer(&in.Items))
+	return nil
+}
+
+// Convert_v1alpha1_TestRunList_To_core_TestRunList is an autogenerated conversion function.
+func Convert_v1alpha1_TestRunList_To_core_TestRunList(in *TestRunList, out *core.TestRunList, s conversion.Scope) error {
+	return autoConvert_v1alpha1_TestRunList_To_core_TestRunList(in, out, s)
+}
+
+func autoConvert_core_TestRunList_To_v1alpha1_TestRunList(in *core.TestRunList, out *TestRunList, s conversion.Scope) error {
+	out.ListMeta = in.ListMeta
+	out.Items = *(*[]TestRun)(unsafe.Pointer(&in.Items))
+	return nil
+}
+
+// Convert_core_TestRunList_To_v1alpha1_TestRunList is an autogenerated conversion function.
+func Convert_core_TestRunList_To_v1alpha1_TestRunList(in *core.TestRunList, out *TestRunList, s conversion.Scope) error {
+	return autoConvert_core_TestRunList_To_v1alpha1_TestRunList(in, out, s)
+}
+
+func autoConvert_v1alpha1_TestRunSchedule_To_core_TestRunSchedule(in *TestRunSchedule, out *core.TestRunSchedule, s conversion.Scope) error {
+	out.Interval = (*core.Duration)(unsafe.Pointer(in.Interval))
+	out.CronSpec = in.CronSpec
+	return nil
+}
+
+// Convert_v1alpha1_TestRunSchedule_To_core_TestRunSchedule is an autogenerated conversion function.
+func Convert_v1alpha1_TestRunSchedule_To_core_TestRunSchedule(in *TestRunSchedule, out *core.TestRunSchedule, s conversion.Scope) error {
+	return autoConvert_v1alpha1_TestRunSchedule_To_core_TestRunSchedule(in, out, s)
+}
+
+func autoConvert_core_TestRunSchedule_To_v1alpha1_TestRunSchedule(in *core.TestRunSchedule, out *TestRunSchedule, s conversion.Scope) error {
+	out.Interval = (*Duration)(unsafe.Pointer(in.Interval))
+	out.CronSpec = in.CronSpec
+	return nil
+}
+
+// Convert_core_TestRunSchedule_To_v1alpha1_TestRunSchedule is an autogenerated conversion function.
+func Convert_core_TestRunSchedule_To_v1alpha1_TestRunSchedule(in *core.TestRunSchedule, out *TestRunSchedule, s conversion.Scope) error {
+	return autoConvert_core_TestRunSchedule_To_v1alpha1_TestRunSchedule(in, out, s)
+}
+
+func autoConvert_v1alpha1_TestRunSpec_To_core_TestRunSpec(in *TestRunSpec, out *core.TestRunSpec, s conversion.Scope) error {
+	out.Tests = *(*[]core.TestDefinition)(unsafe.Pointer(&in.Tests))
+	out.Schedule = (*core.TestRunSchedule)(unsafe.Pointer(in.Schedule))
+	out.Timeout = (*core.Duration)(unsafe.Pointer(in.Timeout))
+	return nil
+}
+
+// Convert_v1alpha1_TestRunSpec_To_core_TestRunSpec is an autogenerated conversion function.
+func Convert_v1alpha1_TestRunSpec_To_core_TestRunSpec(in *TestRunSpec, out *core.TestRunSpec, s conversion.Scope) error {
+	return autoConvert_v1alpha1_TestRunSpec_To_core_TestRunSpec(in, out, s)
+}
+
+func autoConvert_core_TestRunSpec_To_v1alpha1_TestRunSpec(in *core.TestRunSpec, out *TestRunSpec, s conversion.Scope) error {
+	out.Tests = *(*[]TestDefinition)(unsafe.Pointer(&in.Tests))
+	out.Schedule = (*TestRunSchedule)(unsafe.Pointer(in.Schedule))
+	out.Timeout = (*Duration)(unsafe.Pointer(in.Timeout))
+	return nil
+}
+
+// Convert_core_TestRunSpec_To_v1alpha1_TestRunSpec is an autogenerated conversion function.
+func Convert_core_TestRunSpec_To_v1alpha1_TestRunSpec(in *core.TestRunSpec, out *TestRunSpec, s conversion.Scope) error {
+	return autoConvert_core_TestRunSpec_To_v1alpha1_TestRunSpec(in, out, s)
+}
+
+func autoConvert_v1alpha1_TestRunStatus_To_core_TestRunStatus(in *TestRunStatus, out *core.TestRunStatus, s conversion.Scope) error {
+	out.ObservedGeneration = in.ObservedGeneration
+	out.Phase = core.TestRunPhase(in.Phase)
+	out.Message = in.Message
+	out.StartTime = (*metav1.Time)(unsafe.Pointer(in.StartTime))
+	out.FinishedTime = (*metav1.Time)(unsafe.Pointer(in.FinishedTime))
+	out.NextRunTime = (*metav1.Time)(unsafe.Pointer(in.NextRunTime))
+	out.Results = *(*[]core.TestResult)(unsafe.Pointer(&in.Results))
+	return nil
+}
+
+// Convert_v1alpha1_TestRunStatus_To_core_TestRunStatus is an autogenerated conversion function.
+func Convert_v1alpha1_TestRunStatus_To_core_TestRunStatus(in *TestRunStatus, out *core.TestRunStatus, s conversion.Scope) error {
+	return autoConvert_v1alpha1_TestRunStatus_To_core_TestRunStatus(in, out, s)
+}
+
+func autoConvert_core_TestRunStatus_To_v1alpha1_TestRunStatus(in *core.TestRunStatus, out *TestRunStatus, s conversion.Scope) error {
+	out.ObservedGeneration = in.ObservedGeneration
+	out.Phase = TestRunPhase(in.Phase)
+	out.Message = in.Message
+	out.StartTime = (*metav1.Time)(unsafe.Pointer(in.StartTime))
+	out.FinishedTime = (*metav1.Time)(unsafe.Pointer(in.FinishedTime))
+	out.NextRunTime = (*metav1.Time)(unsafe.Pointer(in.NextRunTime))
+	out.Results = *(*[]TestResult)(unsafe.Pointer(&in.Results))
+	return nil
+}
+
+// Convert_core_TestRunStatus_To_v1alpha1_TestRunStatus is an autogenerated conversion function.
+func Convert_core_TestRunStatus_To_v1alpha1_TestRunStatus(in *core.TestRunStatus, out *TestRunStatus, s conversion.Scope) error {
+	return autoConvert_core_TestRunStatus_To_v1alpha1_TestRunStatus(in, out, s)
+}
+
 func autoConvert_v1alpha1_TokenRotation_To_core_TokenRotation(in *TokenRotation, out *core.TokenRotation, s conversion.Scope) error {
 	out.Enabled = in.Enabled
 	return nil
@@ -36,6 +36,8 @@ func ValidateBlueprint(blueprint *core.Blueprint) field.ErrorList {
 	allErrs = append(allErrs, ValidateTemplateExecutorList(field.NewPath("deployExecutions"), blueprint.DeployExecutions)...)
 	allErrs = append(allErrs, ValidateTemplateExecutorList(field.NewPath("exportExecutions"), blueprint.ExportExecutions)...)
 	allErrs = append(allErrs, ValidateSubinstallations(field.NewPath("subinstallations"), blueprint.Subinstallations)...)
+	allErrs = append(allErrs, ValidateRenderStages(field.NewPath("renderStages"), blueprint.RenderStages)...)
+	allErrs = append(allErrs, ValidateFeatureFlagDefinitions(field.NewPath("featureFlags"), blueprint.FeatureFlags)...)
 	allErrs = append(allErrs, ValidateTemplateExecutorList(field.NewPath("subinstallationExecutions"), blueprint.SubinstallationExecutions)...)
 	return allErrs
 }
@@ -229,6 +231,49 @@ func ValidateTemplateExecutorList(fldPath *field.Path, list []core.TemplateExecu
 	return allErrs
 }
 
+// ValidateRenderStages validates the render stages of a blueprint.
+func ValidateRenderStages(fldPath *field.Path, stages []core.RenderStage) field.ErrorList {
+	allErrs := field.ErrorList{}
+	names := sets.NewString()
+	for i, stage := range stages {
+		stagePath := fldPath.Index(i)
+		if len(stage.Name) == 0 {
+			allErrs = append(allErrs, field.Required(stagePath.Child("name"), "name must be defined"))
+		} else {
+			stagePath = stagePath.Key(stage.Name)
+			if names.Has(stage.Name) {
+				allErrs = append(allErrs, field.Duplicate(stagePath, "duplicated stage name"))
+			}
+			names.Insert(stage.Name)
+		}
+
+		if len(stage.Executions) == 0 {
+			allErrs = append(allErrs, field.Required(stagePath.Child("executions"), "at least one execution must be defined"))
+		}
+		allErrs = append(allErrs, ValidateTemplateExecutorList(stagePath.Child("executions"), stage.Executions)...)
+	}
+	return allErrs
+}
+
+// ValidateFeatureFlagDefinitions validates the feature flags that are declared by a blueprint.
+func ValidateFeatureFlagDefinitions(fldPath *field.Path, flags []core.FeatureFlagDefinition) field.ErrorList {
+	allErrs := field.ErrorList{}
+	names := sets.NewString()
+	for i, flag := range flags {
+		flagPath := fldPath.Index(i)
+		if len(flag.Name) == 0 {
+			allErrs = append(allErrs, field.Required(flagPath.Child("name"), "name must be defined"))
+			continue
+		}
+		flagPath = flagPath.Key(flag.Name)
+		if names.Has(flag.Name) {
+			allErrs = append(allErrs, field.Duplicate(flagPath, "duplicated feature flag name"))
+		}
+		names.Insert(flag.Name)
+	}
+	return allErrs
+}
+
 // ValidateSubinstallations validates all inline subinstallation and installation templates from a file
 func ValidateSubinstallations(fldPath *field.Path, subinstallations []core.SubinstallationTemplate) field.ErrorList {
 	allErrs := field.ErrorList{}
@@ -379,6 +424,13 @@ func ValidateInstallationTemplates(fldPath *field.Path, blueprintImportDefs []co
 			// invalid definition if no if matches, but this is validated at another point already
 		}
 
+		if instTmpl.ForEach != nil && len(instTmpl.ForEach.Import) != 0 && !blueprintDataImports.Has(instTmpl.ForEach.Import) {
+			allErrs = append(allErrs, field.NotFound(instPath.Child("forEach", "import"), "import not satisfied"))
+		}
+		if len(instTmpl.IncludeIf) != 0 && !blueprintDataImports.Has(instTmpl.IncludeIf) {
+			allErrs = append(allErrs, field.NotFound(instPath.Child("includeIf"), "import not satisfied"))
+		}
+
 		allErrs = append(allErrs, ValidateInstallationTemplate(instPath, instTmpl)...)
 		if len(instTmpl.Name) != 0 && names.Has(instTmpl.Name) {
 			allErrs = append(allErrs, field.Duplicate(instPath, "duplicated subinstallation"))
@@ -458,6 +510,32 @@ func ValidateInstallationTemplate(fldPath *field.Path, template *core.Installati
 
 	allErrs = append(allErrs, ValidateInstallationTemplateImports(template.Imports, fldPath.Child("imports"))...)
 	allErrs = append(allErrs, ValidateInstallationExports(template.Exports, fldPath.Child("exports"))...)
+	if template.ForEach != nil {
+		allErrs = append(allErrs, ValidateInstallationTemplateForEach(template, fldPath)...)
+	}
+
+	return allErrs
+}
+
+// ValidateInstallationTemplateForEach validates the forEach definition of an InstallationTemplate.
+// The generated subinstallations must not define exports, as these would be exported by all of them.
+func ValidateInstallationTemplateForEach(template *core.InstallationTemplate, tmplPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+	fldPath := tmplPath.Child("forEach")
+
+	if len(template.ForEach.Import) == 0 {
+		allErrs = append(allErrs, field.Required(fldPath.Child("import"), "import must be defined"))
+	}
+	if len(template.ForEach.As) == 0 {
+		allErrs = append(allErrs, field.Required(fldPath.Child("as"), "as must be defined"))
+	} else if _, ok := template.ImportDataMappings[template.ForEach.As]; ok {
+		allErrs = append(allErrs, field.Forbidden(fldPath.Child("as"),
+			fmt.Sprintf("import data mapping %q is set by the forEach definition", template.ForEach.As)))
+	}
+	if len(template.Exports.Data) != 0 || len(template.Exports.Targets) != 0 {
+		allErrs = append(allErrs, field.Forbidden(tmplPath.Child("exports"),
+			"subinstallations generated by a forEach definition cannot define exports"))
+	}
 
 	return allErrs
 }
@@ -472,6 +550,12 @@ func ValidateInstallationTemplateImports(imports core.InstallationImports, fldPa
 	allErrs = append(allErrs, tmpErrs...)
 	tmpErrs, _ = ValidateInstallationTargetImports(imports.Targets, fldPath.Child("targets"), importNames)
 	allErrs = append(allErrs, tmpErrs...)
+	for idx, imp := range imports.Targets {
+		if imp.TargetSelector != nil {
+			allErrs = append(allErrs, field.Forbidden(fldPath.Child("targets").Index(idx).Child("targetSelector"),
+				"a target selector can only be used in root installations"))
+		}
+	}
 
 	return allErrs
 }
@@ -493,6 +577,9 @@ func ValidateInstallationTemplateDataImports(imports []core.DataImport, fldPath
 		if imp.ConfigMapRef != nil {
 			allErrs = append(allErrs, field.Forbidden(impPath.Child("configMapRef"), "configMap references are not allowed in a installation template"))
 		}
+		if imp.HTTPRef != nil {
+			allErrs = append(allErrs, field.Forbidden(impPath.Child("httpRef"), "http references are not allowed in a installation template"))
+		}
 
 		if imp.Name == "" {
 			allErrs = append(allErrs, field.Required(impPath.Child("name"), "name must not be empty"))
@@ -8,6 +8,7 @@ import (
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
 	. "github.com/onsi/gomega/gstruct"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/util/validation/field"
 
 	"github.com/gardener/landscaper/apis/core"
@@ -236,6 +237,80 @@ var _ = Describe("Blueprint", func() {
 		})
 	})
 
+	Context("RenderStages", func() {
+		It("should pass if the render stages are valid", func() {
+			stages := []core.RenderStage{
+				{
+					Name:       "a",
+					Executions: []core.TemplateExecutor{{Name: "exec", Type: core.GOTemplateType}},
+				},
+				{
+					Name:       "b",
+					Executions: []core.TemplateExecutor{{Name: "exec", Type: core.SpiffTemplateType}},
+				},
+			}
+
+			allErrs := validation.ValidateRenderStages(field.NewPath("renderStages"), stages)
+			Expect(allErrs).To(HaveLen(0))
+		})
+
+		It("should fail if a stage has no executions", func() {
+			stages := []core.RenderStage{{Name: "a"}}
+
+			allErrs := validation.ValidateRenderStages(field.NewPath("renderStages"), stages)
+			Expect(allErrs).To(ContainElement(PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeRequired),
+				"Field": Equal("renderStages[0][a].executions"),
+			}))))
+		})
+
+		It("should fail if stage names are duplicated", func() {
+			executions := []core.TemplateExecutor{{Name: "exec", Type: core.GOTemplateType}}
+			stages := []core.RenderStage{
+				{Name: "a", Executions: executions},
+				{Name: "a", Executions: executions},
+			}
+
+			allErrs := validation.ValidateRenderStages(field.NewPath("renderStages"), stages)
+			Expect(allErrs).To(ContainElement(PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeDuplicate),
+				"Field": Equal("renderStages[1][a]"),
+			}))))
+		})
+	})
+
+	Context("FeatureFlags", func() {
+		It("should pass if the feature flags are valid", func() {
+			flags := []core.FeatureFlagDefinition{
+				{Name: "a"},
+				{Name: "b", Default: true},
+			}
+
+			allErrs := validation.ValidateFeatureFlagDefinitions(field.NewPath("featureFlags"), flags)
+			Expect(allErrs).To(HaveLen(0))
+		})
+
+		It("should fail if a feature flag has no name", func() {
+			flags := []core.FeatureFlagDefinition{{Default: true}}
+
+			allErrs := validation.ValidateFeatureFlagDefinitions(field.NewPath("featureFlags"), flags)
+			Expect(allErrs).To(ContainElement(PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeRequired),
+				"Field": Equal("featureFlags[0].name"),
+			}))))
+		})
+
+		It("should fail if feature flag names are duplicated", func() {
+			flags := []core.FeatureFlagDefinition{{Name: "a"}, {Name: "a"}}
+
+			allErrs := validation.ValidateFeatureFlagDefinitions(field.NewPath("featureFlags"), flags)
+			Expect(allErrs).To(ContainElement(PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeDuplicate),
+				"Field": Equal("featureFlags[1][a]"),
+			}))))
+		})
+	})
+
 	Context("InstallationTemplate", func() {
 		It("should pass if a InstallationTemplate is valid", func() {
 			installationTemplate := &core.InstallationTemplate{}
@@ -258,6 +333,28 @@ var _ = Describe("Blueprint", func() {
 			}))))
 		})
 
+		It("should fail if an InstallationTemplate imports targets by a target selector", func() {
+			installationTemplate := &core.InstallationTemplate{}
+			installationTemplate.Name = "myname"
+			installationTemplate.Blueprint = core.InstallationTemplateBlueprintDefinition{
+				Ref: "my-ref",
+			}
+			installationTemplate.Imports.Targets = []core.TargetImport{
+				{
+					Name: "clusters",
+					TargetSelector: &metav1.LabelSelector{
+						MatchLabels: map[string]string{"fleet": "prod"},
+					},
+				},
+			}
+
+			allErrs := validation.ValidateInstallationTemplate(field.NewPath("b"), installationTemplate)
+			Expect(allErrs).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeForbidden),
+				"Field": Equal("b.imports.targets[0].targetSelector"),
+			}))))
+		})
+
 		It("should fail if InstallationTemplate.name is invalid", func() {
 			installationTemplate := &core.InstallationTemplate{}
 			installationTemplate.Name = "%$.-"
@@ -279,6 +376,50 @@ var _ = Describe("Blueprint", func() {
 				"Field": Equal("b.blueprint"),
 			}))))
 		})
+
+		It("should fail if an InstallationTemplate with forEach definition is incomplete or defines exports", func() {
+			installationTemplate := &core.InstallationTemplate{}
+			installationTemplate.Name = "myname"
+			installationTemplate.Blueprint = core.InstallationTemplateBlueprintDefinition{
+				Ref: "my-ref",
+			}
+			installationTemplate.ForEach = &core.InstallationTemplateForEach{}
+			installationTemplate.Exports.Data = []core.DataExport{{Name: "a", DataRef: "b"}}
+
+			allErrs := validation.ValidateInstallationTemplate(field.NewPath("b"), installationTemplate)
+			Expect(allErrs).To(ConsistOf(
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeRequired),
+					"Field": Equal("b.forEach.import"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeRequired),
+					"Field": Equal("b.forEach.as"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeForbidden),
+					"Field": Equal("b.exports"),
+				})),
+			))
+		})
+
+		It("should fail if the forEach element is also set by an import data mapping", func() {
+			installationTemplate := &core.InstallationTemplate{}
+			installationTemplate.Name = "myname"
+			installationTemplate.Blueprint = core.InstallationTemplateBlueprintDefinition{
+				Ref: "my-ref",
+			}
+			installationTemplate.ForEach = &core.InstallationTemplateForEach{Import: "clusters", As: "cluster"}
+			installationTemplate.ImportDataMappings = map[string]core.AnyJSON{
+				"cluster": core.NewAnyJSON([]byte(`"a"`)),
+			}
+
+			allErrs := validation.ValidateInstallationTemplate(field.NewPath("b"), installationTemplate)
+			Expect(allErrs).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeForbidden),
+				"Field": Equal("b.forEach.as"),
+			}))))
+		})
 	})
 
 	Context("Subinstallations", func() {
@@ -354,6 +495,28 @@ var _ = Describe("Blueprint", func() {
 				Expect(allErrs).To(HaveLen(0))
 			})
 
+			It("should fail if the forEach or includeIf import of a subinstallation is not imported by its parent", func() {
+				imports := []core.ImportDefinition{
+					{
+						FieldValueDefinition: core.FieldValueDefinition{
+							Name:   "clusters",
+							Schema: &core.JSONSchemaDefinition{RawMessage: []byte("type: array")},
+						},
+					},
+				}
+				tmpl := &core.InstallationTemplate{}
+				tmpl.Name = "my-inst"
+				tmpl.Blueprint.Ref = "myref"
+				tmpl.ForEach = &core.InstallationTemplateForEach{Import: "clusters", As: "cluster"}
+				tmpl.IncludeIf = "enabled"
+
+				allErrs := validation.ValidateInstallationTemplates(field.NewPath("b"), imports, []*core.InstallationTemplate{tmpl})
+				Expect(allErrs).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeNotFound),
+					"Field": Equal("b.my-inst.includeIf"),
+				}))))
+			})
+
 			It("should pass if a target import of a subinstallation is imported by its parent", func() {
 				imports := []core.ImportDefinition{
 					{
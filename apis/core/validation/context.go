@@ -0,0 +1,145 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package validation
+
+import (
+	"sort"
+
+	cdv2 "github.com/gardener/component-spec/bindings-go/apis/v2"
+	corev1 "k8s.io/api/core/v1"
+	metav1validation "k8s.io/apimachinery/pkg/apis/meta/v1/validation"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+
+	"github.com/gardener/landscaper/apis/core"
+)
+
+// ValidateContext validates a Context
+func ValidateContext(ctx *core.Context) field.ErrorList {
+	allErrs := field.ErrorList{}
+	conf := &ctx.ContextConfiguration
+
+	if conf.RepositoryContext != nil {
+		allErrs = append(allErrs, ValidateRepositoryContext(conf.RepositoryContext, field.NewPath("repositoryContext"))...)
+	}
+
+	if conf.OCMConfig != nil && len(conf.OCMConfig.Name) == 0 {
+		allErrs = append(allErrs, field.Required(field.NewPath("ocmConfig", "name"), "must not be empty"))
+	}
+
+	allErrs = append(allErrs, validateLocalObjectReferences(conf.RegistryPullSecrets, field.NewPath("registryPullSecrets"))...)
+
+	if conf.ImagePullSecrets != nil {
+		allErrs = append(allErrs, validateLocalObjectReferences(conf.ImagePullSecrets.Secrets, field.NewPath("imagePullSecrets", "secrets"))...)
+	}
+
+	if conf.Scheduling != nil {
+		allErrs = append(allErrs, validateScheduling(conf.Scheduling, field.NewPath("scheduling"))...)
+	}
+
+	// sort the signature names to get a stable order of the errors
+	signatureNames := make([]string, 0, len(conf.VerificationSignatures))
+	for name := range conf.VerificationSignatures {
+		signatureNames = append(signatureNames, name)
+	}
+	sort.Strings(signatureNames)
+	for _, name := range signatureNames {
+		signature := conf.VerificationSignatures[name]
+		sigPath := field.NewPath("verificationSignatures").Key(name)
+		if signature.PublicKeySecretReference == nil && signature.CaCertificateSecretReference == nil {
+			allErrs = append(allErrs, field.Required(sigPath, "either publicKeySecretReference or caCertificateSecretReference must be set"))
+		}
+		if signature.PublicKeySecretReference != nil && len(signature.PublicKeySecretReference.Name) == 0 {
+			allErrs = append(allErrs, field.Required(sigPath.Child("publicKeySecretReference", "name"), "must not be empty"))
+		}
+		if signature.CaCertificateSecretReference != nil && len(signature.CaCertificateSecretReference.Name) == 0 {
+			allErrs = append(allErrs, field.Required(sigPath.Child("caCertificateSecretReference", "name"), "must not be empty"))
+		}
+	}
+
+	return allErrs
+}
+
+// ValidateRepositoryContext validates a repository context.
+// Repository contexts of type ociRegistry have to define a base url.
+func ValidateRepositoryContext(repoCtx *cdv2.UnstructuredTypedObject, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if len(repoCtx.GetType()) == 0 {
+		allErrs = append(allErrs, field.Required(fldPath.Child("type"), "must not be empty"))
+		return allErrs
+	}
+
+	if repoCtx.GetType() == cdv2.OCIRegistryType {
+		if baseURL, _ := repoCtx.Object["baseUrl"].(string); len(baseURL) == 0 {
+			allErrs = append(allErrs, field.Required(fldPath.Child("baseUrl"), "must not be empty for repository contexts of type ociRegistry"))
+		}
+	}
+
+	return allErrs
+}
+
+// validateScheduling validates the node selector and the tolerations of the scheduling defaults
+// like the node selector and the tolerations of a pod.
+func validateScheduling(scheduling *core.SchedulingConfiguration, fldPath *field.Path) field.ErrorList {
+	allErrs := metav1validation.ValidateLabels(scheduling.NodeSelector, fldPath.Child("nodeSelector"))
+	allErrs = append(allErrs, ValidateTolerations(scheduling.Tolerations, fldPath.Child("tolerations"))...)
+	return allErrs
+}
+
+// ValidateTolerations validates the tolerations of a pod.
+func ValidateTolerations(tolerations []corev1.Toleration, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+	for i, toleration := range tolerations {
+		tolPath := fldPath.Index(i)
+		if len(toleration.Key) != 0 {
+			allErrs = append(allErrs, metav1validation.ValidateLabelName(toleration.Key, tolPath.Child("key"))...)
+		} else if toleration.Operator != corev1.TolerationOpExists {
+			allErrs = append(allErrs, field.Invalid(tolPath.Child("operator"), toleration.Operator,
+				"operator must be Exists when the key is empty"))
+		}
+
+		switch toleration.Operator {
+		case corev1.TolerationOpEqual, "":
+		case corev1.TolerationOpExists:
+			if len(toleration.Value) != 0 {
+				allErrs = append(allErrs, field.Invalid(tolPath.Child("value"), toleration.Value,
+					"value must be empty when the operator is Exists"))
+			}
+		default:
+			allErrs = append(allErrs, field.NotSupported(tolPath.Child("operator"), toleration.Operator,
+				[]string{string(corev1.TolerationOpEqual), string(corev1.TolerationOpExists)}))
+		}
+
+		switch toleration.Effect {
+		case "", corev1.TaintEffectNoSchedule, corev1.TaintEffectPreferNoSchedule, corev1.TaintEffectNoExecute:
+		default:
+			allErrs = append(allErrs, field.NotSupported(tolPath.Child("effect"), toleration.Effect,
+				[]string{string(corev1.TaintEffectNoSchedule), string(corev1.TaintEffectPreferNoSchedule), string(corev1.TaintEffectNoExecute)}))
+		}
+		if toleration.TolerationSeconds != nil && toleration.Effect != corev1.TaintEffectNoExecute {
+			allErrs = append(allErrs, field.Invalid(tolPath.Child("effect"), toleration.Effect,
+				"effect must be NoExecute when tolerationSeconds is set"))
+		}
+	}
+	return allErrs
+}
+
+// validateLocalObjectReferences validates that the given references have a name and that no object is referenced twice.
+func validateLocalObjectReferences(refs []corev1.LocalObjectReference, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+	names := map[string]bool{}
+	for i, ref := range refs {
+		refPath := fldPath.Index(i).Child("name")
+		if len(ref.Name) == 0 {
+			allErrs = append(allErrs, field.Required(refPath, "must not be empty"))
+			continue
+		}
+		if names[ref.Name] {
+			allErrs = append(allErrs, field.Duplicate(refPath, ref.Name))
+		}
+		names[ref.Name] = true
+	}
+	return allErrs
+}
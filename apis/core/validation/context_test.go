@@ -0,0 +1,138 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package validation_test
+
+import (
+	cdv2 "github.com/gardener/component-spec/bindings-go/apis/v2"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gstruct"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+
+	"github.com/gardener/landscaper/apis/core"
+	"github.com/gardener/landscaper/apis/core/validation"
+)
+
+var _ = Describe("Context", func() {
+
+	It("should accept a valid Context", func() {
+		repoCtx, err := cdv2.NewUnstructured(cdv2.NewOCIRegistryRepository("example.com/components", ""))
+		Expect(err).ToNot(HaveOccurred())
+
+		ctx := &core.Context{
+			ContextConfiguration: core.ContextConfiguration{
+				RepositoryContext:   &repoCtx,
+				OCMConfig:           &corev1.LocalObjectReference{Name: "ocm-config"},
+				RegistryPullSecrets: []corev1.LocalObjectReference{{Name: "a"}, {Name: "b"}},
+				ImagePullSecrets: &core.ImagePullSecretsConfiguration{
+					Secrets: []corev1.LocalObjectReference{{Name: "a"}},
+				},
+				Scheduling: &core.SchedulingConfiguration{
+					NodeSelector: map[string]string{"example.com/pool": "tenant"},
+					Tolerations: []corev1.Toleration{
+						{Key: "example.com/pool", Operator: corev1.TolerationOpEqual, Value: "tenant", Effect: corev1.TaintEffectNoSchedule},
+						{Operator: corev1.TolerationOpExists},
+					},
+				},
+				VerificationSignatures: map[string]core.VerificationSignature{
+					"sig": {PublicKeySecretReference: &core.SecretReference{ObjectReference: core.ObjectReference{Name: "key"}}},
+				},
+			},
+		}
+
+		Expect(validation.ValidateContext(ctx)).To(BeEmpty())
+		Expect(validation.ValidateContext(&core.Context{})).To(BeEmpty())
+	})
+
+	It("should reject an ociRegistry repository context without a base url", func() {
+		repoCtx, err := cdv2.NewUnstructured(cdv2.NewOCIRegistryRepository("", ""))
+		Expect(err).ToNot(HaveOccurred())
+
+		allErrs := validation.ValidateContext(&core.Context{
+			ContextConfiguration: core.ContextConfiguration{RepositoryContext: &repoCtx},
+		})
+		Expect(allErrs).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+			"Type":  Equal(field.ErrorTypeRequired),
+			"Field": Equal("repositoryContext.baseUrl"),
+		}))))
+	})
+
+	It("should reject empty and duplicate secret references", func() {
+		allErrs := validation.ValidateContext(&core.Context{
+			ContextConfiguration: core.ContextConfiguration{
+				OCMConfig:           &corev1.LocalObjectReference{},
+				RegistryPullSecrets: []corev1.LocalObjectReference{{Name: "a"}, {Name: "a"}},
+				ImagePullSecrets: &core.ImagePullSecretsConfiguration{
+					Secrets: []corev1.LocalObjectReference{{}},
+				},
+				VerificationSignatures: map[string]core.VerificationSignature{
+					"sig": {},
+				},
+			},
+		})
+		Expect(allErrs).To(ConsistOf(
+			PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeRequired),
+				"Field": Equal("ocmConfig.name"),
+			})),
+			PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeDuplicate),
+				"Field": Equal("registryPullSecrets[1].name"),
+			})),
+			PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeRequired),
+				"Field": Equal("imagePullSecrets.secrets[0].name"),
+			})),
+			PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeRequired),
+				"Field": Equal("verificationSignatures[sig]"),
+			})),
+		))
+	})
+
+	It("should reject invalid scheduling defaults", func() {
+		tolerationSeconds := int64(60)
+		allErrs := validation.ValidateContext(&core.Context{
+			ContextConfiguration: core.ContextConfiguration{
+				Scheduling: &core.SchedulingConfiguration{
+					NodeSelector: map[string]string{"pool": "not a valid value"},
+					Tolerations: []corev1.Toleration{
+						{Value: "tenant"},
+						{Key: "pool", Operator: corev1.TolerationOpExists, Value: "tenant"},
+						{Key: "pool", Operator: "In", Effect: "Never"},
+						{Key: "pool", Effect: corev1.TaintEffectNoSchedule, TolerationSeconds: &tolerationSeconds},
+					},
+				},
+			},
+		})
+		Expect(allErrs).To(ConsistOf(
+			PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeInvalid),
+				"Field": Equal("scheduling.nodeSelector"),
+			})),
+			PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeInvalid),
+				"Field": Equal("scheduling.tolerations[0].operator"),
+			})),
+			PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeInvalid),
+				"Field": Equal("scheduling.tolerations[1].value"),
+			})),
+			PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeNotSupported),
+				"Field": Equal("scheduling.tolerations[2].operator"),
+			})),
+			PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeNotSupported),
+				"Field": Equal("scheduling.tolerations[2].effect"),
+			})),
+			PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeInvalid),
+				"Field": Equal("scheduling.tolerations[3].effect"),
+			})),
+		))
+	})
+})
@@ -34,5 +34,8 @@ func ValidateDeployItemSpec(fldPath *field.Path, diSpec core.DeployItemSpec) fie
 		}
 	}
 
+	allErrs = append(allErrs, ValidateExclusionWindows(fldPath.Child("exclusionWindows"), diSpec.ExclusionWindows)...)
+	allErrs = append(allErrs, ValidateRetryPolicy(fldPath.Child("retryPolicy"), diSpec.RetryPolicy)...)
+
 	return allErrs
 }
@@ -5,11 +5,14 @@
 package validation
 
 import (
+	"time"
+
 	metav1validation "k8s.io/apimachinery/pkg/apis/meta/v1/validation"
 	"k8s.io/apimachinery/pkg/util/sets"
 	"k8s.io/apimachinery/pkg/util/validation/field"
 
 	"github.com/gardener/landscaper/apis/core"
+	"github.com/gardener/landscaper/apis/core/v1alpha1/helper"
 )
 
 // ValidateExecution validates an Execution
@@ -173,5 +176,54 @@ func ValidateDeployItemTemplate(fldPath *field.Path, tmpl core.DeployItemTemplat
 		allErrs = append(allErrs, metav1validation.ValidateLabels(tmpl.Labels, fldPath.Child("labels"))...)
 	}
 
+	allErrs = append(allErrs, ValidateExclusionWindows(fldPath.Child("exclusionWindows"), tmpl.ExclusionWindows)...)
+	allErrs = append(allErrs, ValidateRetryPolicy(fldPath.Child("retryPolicy"), tmpl.RetryPolicy)...)
+
+	return allErrs
+}
+
+// ValidateExclusionWindows validates the exclusion windows of a deploy item.
+func ValidateExclusionWindows(fldPath *field.Path, windows []core.ExclusionWindow) field.ErrorList {
+	allErrs := field.ErrorList{}
+	for i, window := range windows {
+		windowPath := fldPath.Index(i)
+		for j, day := range window.Days {
+			if _, err := helper.ParseWeekday(day); err != nil {
+				allErrs = append(allErrs, field.Invalid(windowPath.Child("days").Index(j), day, err.Error()))
+			}
+		}
+		if _, err := helper.ParseTimeOfDay(window.Begin); err != nil {
+			allErrs = append(allErrs, field.Invalid(windowPath.Child("begin"), window.Begin, err.Error()))
+		}
+		if _, err := helper.ParseTimeOfDay(window.End); err != nil {
+			allErrs = append(allErrs, field.Invalid(windowPath.Child("end"), window.End, err.Error()))
+		}
+		if len(window.TimeZone) != 0 {
+			if _, err := time.LoadLocation(window.TimeZone); err != nil {
+				allErrs = append(allErrs, field.Invalid(windowPath.Child("timeZone"), window.TimeZone, err.Error()))
+			}
+		}
+	}
+	return allErrs
+}
+
+// ValidateRetryPolicy validates the retry policy of a deploy item.
+func ValidateRetryPolicy(fldPath *field.Path, policy *core.RetryPolicy) field.ErrorList {
+	allErrs := field.ErrorList{}
+	if policy == nil {
+		return allErrs
+	}
+	if policy.MaxRetries < 0 {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("maxRetries"), policy.MaxRetries, "must not be negative"))
+	}
+	if policy.InitialBackoff != nil && policy.InitialBackoff.Duration <= 0 {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("initialBackoff"), policy.InitialBackoff.Duration.String(), "must be positive"))
+	}
+	if policy.MaxBackoff != nil && policy.MaxBackoff.Duration <= 0 {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("maxBackoff"), policy.MaxBackoff.Duration.String(), "must be positive"))
+	}
+	if policy.InitialBackoff != nil && policy.MaxBackoff != nil && policy.InitialBackoff.Duration > policy.MaxBackoff.Duration {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("initialBackoff"), policy.InitialBackoff.Duration.String(), "must not be greater than the max backoff"))
+	}
 	return allErrs
 }
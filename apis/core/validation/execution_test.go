@@ -5,6 +5,8 @@
 package validation_test
 
 import (
+	"time"
+
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
 	. "github.com/onsi/gomega/gstruct"
@@ -45,6 +47,50 @@ var _ = Describe("Execution", func() {
 				"Field": Equal("b.type"),
 			}))))
 		})
+
+		It("should fail if an exclusion window is invalid", func() {
+			tmpl := core.DeployItemTemplate{}
+			tmpl.Name = "my-import"
+			tmpl.Type = "mytype"
+			tmpl.ExclusionWindows = []core.ExclusionWindow{
+				{Days: []string{"Saturday", "Caturday"}, Begin: "22:00", End: "4am", TimeZone: "Europe/Berlin"},
+			}
+
+			allErrs := validation.ValidateDeployItemTemplate(field.NewPath("b"), tmpl)
+			Expect(allErrs).To(ConsistOf(
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("b.exclusionWindows[0].days[1]"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("b.exclusionWindows[0].end"),
+				})),
+			))
+		})
+
+		It("should fail if a retry policy is invalid", func() {
+			tmpl := core.DeployItemTemplate{}
+			tmpl.Name = "my-import"
+			tmpl.Type = "mytype"
+			tmpl.RetryPolicy = &core.RetryPolicy{
+				MaxRetries:     -1,
+				InitialBackoff: &core.Duration{Duration: 10 * time.Minute},
+				MaxBackoff:     &core.Duration{Duration: time.Minute},
+			}
+
+			allErrs := validation.ValidateDeployItemTemplate(field.NewPath("b"), tmpl)
+			Expect(allErrs).To(ConsistOf(
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("b.retryPolicy.maxRetries"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("b.retryPolicy.initialBackoff"),
+				})),
+			))
+		})
 	})
 
 	Context("ValidateDeployItemTemplateList", func() {
@@ -5,11 +5,14 @@
 package validation
 
 import (
+	"net/url"
 	"regexp"
+	"time"
 
 	"github.com/robfig/cron/v3"
 	apivalidation "k8s.io/apimachinery/pkg/api/validation"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	metav1validation "k8s.io/apimachinery/pkg/apis/meta/v1/validation"
 	"k8s.io/apimachinery/pkg/util/sets"
 	"k8s.io/apimachinery/pkg/util/validation"
 	"k8s.io/apimachinery/pkg/util/validation/field"
@@ -60,6 +63,7 @@ func ValidateInstallationSpec(spec *core.InstallationSpec, fldPath *field.Path)
 	allErrs = append(allErrs, ValidateInstallationComponentDescriptor(spec.ComponentDescriptor, fldPath.Child("componentDescriptor"))...)
 
 	allErrs = append(allErrs, ValidateInstallationAutomaticReconcile(spec.AutomaticReconcile, fldPath.Child("automaticReconcile"))...)
+	allErrs = append(allErrs, ValidateInstallationReconcileSchedule(spec.ReconcileSchedule, fldPath.Child("reconcileSchedule"))...)
 
 	return allErrs
 }
@@ -68,8 +72,12 @@ func ValidateInstallationSpec(spec *core.InstallationSpec, fldPath *field.Path)
 func ValidateInstallationBlueprint(bp core.BlueprintDefinition, fldPath *field.Path) field.ErrorList {
 	allErrs := field.ErrorList{}
 
-	// check that either inline blueprint or reference is provided (and not both)
-	allErrs = append(allErrs, ValidateExactlyOneOf(fldPath.Child("definition"), bp, "Inline", "Reference")...)
+	// check that exactly one of inline blueprint, reference or git reference is provided
+	allErrs = append(allErrs, ValidateExactlyOneOf(fldPath.Child("definition"), bp, "Inline", "Reference", "Git")...)
+
+	if bp.Git != nil && len(bp.Git.Repository) == 0 {
+		allErrs = append(allErrs, field.Required(fldPath.Child("git", "repository"), "the name of the git repository is required"))
+	}
 
 	return allErrs
 }
@@ -129,6 +137,31 @@ func ValidateInstallationSucceededReconcile(succeededReconcile *core.SucceededRe
 	return allErrs
 }
 
+// ValidateInstallationReconcileSchedule validates the maintenance windows of an Installation
+func ValidateInstallationReconcileSchedule(schedule *core.ReconcileSchedule, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if schedule == nil {
+		return allErrs
+	}
+
+	if _, err := cron.ParseStandard(schedule.CronSpec); err != nil {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("cronSpec"), schedule.CronSpec,
+			"field must be a valid cron spec"))
+	}
+	if schedule.Duration.Duration <= 0 {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("duration"), schedule.Duration.Duration.String(),
+			"duration must be positive"))
+	}
+	if len(schedule.TimeZone) != 0 {
+		if _, err := time.LoadLocation(schedule.TimeZone); err != nil {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("timeZone"), schedule.TimeZone, err.Error()))
+		}
+	}
+
+	return allErrs
+}
+
 // ValidateInstallationImports validates the imports of an Installation
 func ValidateInstallationImports(imports core.InstallationImports, fldPath *field.Path) field.ErrorList {
 	allErrs := field.ErrorList{}
@@ -150,7 +183,7 @@ func ValidateInstallationDataImports(imports []core.DataImport, fldPath *field.P
 	for idx, imp := range imports {
 		impPath := fldPath.Index(idx)
 
-		allErrs = append(allErrs, ValidateExactlyOneOf(impPath, imp, "DataRef", "SecretRef", "ConfigMapRef")...)
+		allErrs = append(allErrs, ValidateExactlyOneOf(impPath, imp, "DataRef", "SecretRef", "ConfigMapRef", "HTTPRef")...)
 
 		if imp.SecretRef != nil {
 			allErrs = append(allErrs, ValidateLocalSecretReference(*imp.SecretRef, impPath.Child("secretRef"))...)
@@ -160,6 +193,14 @@ func ValidateInstallationDataImports(imports []core.DataImport, fldPath *field.P
 			allErrs = append(allErrs, ValidateLocalConfigMapReference(*imp.ConfigMapRef, impPath.Child("configMapRef"))...)
 		}
 
+		if imp.HTTPRef != nil {
+			allErrs = append(allErrs, ValidateHTTPDataReference(*imp.HTTPRef, impPath.Child("httpRef"))...)
+		}
+
+		for i, t := range imp.Transformations {
+			allErrs = append(allErrs, ValidateImportTransformation(t, impPath.Child("transformations").Index(i))...)
+		}
+
 		if imp.Name == "" {
 			allErrs = append(allErrs, field.Required(impPath.Child("name"), "name must not be empty"))
 			continue
@@ -182,7 +223,7 @@ func ValidateInstallationTargetImports(imports []core.TargetImport, fldPath *fie
 		if imp.Name == "" {
 			allErrs = append(allErrs, field.Required(fldPathIdx.Child("name"), "name must not be empty"))
 		}
-		allErrs = append(allErrs, ValidateExactlyOneOf(fldPathIdx, imp, "Target", "Targets", "TargetMap", "TargetMapReference", "TargetListReference")...)
+		allErrs = append(allErrs, ValidateExactlyOneOf(fldPathIdx, imp, "Target", "Targets", "TargetMap", "TargetMapReference", "TargetListReference", "TargetSelector")...)
 		if len(imp.Targets) > 0 {
 			for idx2, tg := range imp.Targets {
 				if len(tg) == 0 {
@@ -190,6 +231,10 @@ func ValidateInstallationTargetImports(imports []core.TargetImport, fldPath *fie
 				}
 			}
 		}
+		if imp.TargetSelector != nil {
+			allErrs = append(allErrs, metav1validation.ValidateLabelSelector(imp.TargetSelector,
+				metav1validation.LabelSelectorValidationOptions{}, fldPathIdx.Child("targetSelector"))...)
+		}
 		if imp.TargetMap != nil {
 			for key, tg := range imp.TargetMap {
 				if !targetMapKeyRegExp.MatchString(key) {
@@ -218,6 +263,55 @@ func ValidateInstallationExports(exports core.InstallationExports, fldPath *fiel
 
 	allErrs = append(allErrs, ValidateInstallationDataExports(exports.Data, fldPath.Child("data"))...)
 	allErrs = append(allErrs, ValidateInstallationTargetExports(exports.Targets, fldPath.Child("targets"))...)
+	allErrs = append(allErrs, ValidateInstallationExportSinks(exports.Sinks, exports.Data, fldPath.Child("sinks"))...)
+
+	return allErrs
+}
+
+// ValidateInstallationExportSinks validates the export sinks of an Installation
+func ValidateInstallationExportSinks(sinks []core.ExportSink, dataExports []core.DataExport, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	exportNames := sets.New[string]()
+	for _, exp := range dataExports {
+		exportNames.Insert(exp.Name)
+	}
+
+	for idx, sink := range sinks {
+		sinkPath := fldPath.Index(idx)
+		if sink.Name == "" {
+			allErrs = append(allErrs, field.Required(sinkPath.Child("name"), "name must not be empty"))
+		}
+		if sink.Target == "" {
+			allErrs = append(allErrs, field.Required(sinkPath.Child("target"), "target must not be empty"))
+		}
+		switch sink.Type {
+		case "", core.SecretExportSinkType, core.ConfigMapExportSinkType:
+		default:
+			allErrs = append(allErrs, field.NotSupported(sinkPath.Child("type"), sink.Type,
+				[]string{string(core.SecretExportSinkType), string(core.ConfigMapExportSinkType)}))
+		}
+		if len(sink.Data) == 0 {
+			allErrs = append(allErrs, field.Required(sinkPath.Child("data"), "at least one data export must be defined"))
+		}
+
+		keys := sets.New[string]()
+		for i, data := range sink.Data {
+			dataPath := sinkPath.Child("data").Index(i)
+			if data.Key == "" {
+				allErrs = append(allErrs, field.Required(dataPath.Child("key"), "key must not be empty"))
+			} else if keys.Has(data.Key) {
+				allErrs = append(allErrs, field.Duplicate(dataPath.Child("key"), data.Key))
+			}
+			keys.Insert(data.Key)
+
+			if data.Export == "" {
+				allErrs = append(allErrs, field.Required(dataPath.Child("export"), "export must not be empty"))
+			} else if !exportNames.Has(data.Export) {
+				allErrs = append(allErrs, field.Invalid(dataPath.Child("export"), data.Export, "export must be a data export of the installation"))
+			}
+		}
+	}
 
 	return allErrs
 }
@@ -300,6 +394,54 @@ func ValidateLocalSecretReference(sr core.LocalSecretReference, fldPath *field.P
 	return allErrs
 }
 
+// ValidateHTTPDataReference validates that the http data reference is valid
+func ValidateHTTPDataReference(ref core.HTTPDataReference, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+	if ref.URL == "" {
+		allErrs = append(allErrs, field.Required(fldPath.Child("url"), "url must not be empty"))
+	} else if u, err := url.Parse(ref.URL); err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("url"), ref.URL, "url must be an absolute http or https url"))
+	}
+	if ref.AuthSecretRef != nil {
+		allErrs = append(allErrs, ValidateLocalSecretReference(*ref.AuthSecretRef, fldPath.Child("authSecretRef"))...)
+		if ref.AuthSecretRef.Key == "" {
+			allErrs = append(allErrs, field.Required(fldPath.Child("authSecretRef", "key"), "key must not be empty"))
+		}
+	}
+	if ref.CacheDuration != nil && ref.CacheDuration.Duration < 0 {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("cacheDuration"), ref.CacheDuration.Duration.String(), "cacheDuration must not be negative"))
+	}
+	return allErrs
+}
+
+// ValidateImportTransformation validates that a transformation of imported data is valid
+func ValidateImportTransformation(t core.ImportTransformation, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+	switch t.Type {
+	case core.ImportTransformationTypeJSONPath:
+		if t.JSONPath == "" {
+			allErrs = append(allErrs, field.Required(fldPath.Child("jsonPath"), "jsonPath must not be empty"))
+		}
+	case core.ImportTransformationTypeDefault:
+		if t.Value == nil {
+			allErrs = append(allErrs, field.Required(fldPath.Child("value"), "value must be defined"))
+		}
+	case core.ImportTransformationTypeBase64Decode:
+	case core.ImportTransformationTypeGoTemplate:
+		if t.Template == "" {
+			allErrs = append(allErrs, field.Required(fldPath.Child("template"), "template must not be empty"))
+		}
+	default:
+		allErrs = append(allErrs, field.NotSupported(fldPath.Child("type"), t.Type, []string{
+			string(core.ImportTransformationTypeJSONPath),
+			string(core.ImportTransformationTypeDefault),
+			string(core.ImportTransformationTypeBase64Decode),
+			string(core.ImportTransformationTypeGoTemplate),
+		}))
+	}
+	return allErrs
+}
+
 // ValidateLocalConfigMapReference validates that the local configmap reference is valid
 func ValidateLocalConfigMapReference(cmr core.LocalConfigMapReference, fldPath *field.Path) field.ErrorList {
 	allErrs := field.ErrorList{}
@@ -5,10 +5,13 @@
 package validation_test
 
 import (
+	"time"
+
 	cdv2 "github.com/gardener/component-spec/bindings-go/apis/v2"
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
 	. "github.com/onsi/gomega/gstruct"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/util/validation/field"
 
 	"github.com/gardener/landscaper/apis/core"
@@ -136,6 +139,30 @@ var _ = Describe("Installation", func() {
 				"Field": Equal("blueprint.definition"),
 			}))))
 		})
+
+		It("should accept a Blueprint in a git repository", func() {
+			bpDef := core.BlueprintDefinition{
+				Git: &core.GitBlueprintReference{
+					Repository: "blueprints",
+					Path:       "echo-server",
+				},
+			}
+			allErrs := validation.ValidateInstallationBlueprint(bpDef, field.NewPath("blueprint"))
+			Expect(allErrs).To(HaveLen(0))
+		})
+
+		It("should reject a Blueprint in a git repository without repository name", func() {
+			bpDef := core.BlueprintDefinition{
+				Git: &core.GitBlueprintReference{
+					Path: "echo-server",
+				},
+			}
+			allErrs := validation.ValidateInstallationBlueprint(bpDef, field.NewPath("blueprint"))
+			Expect(allErrs).To(ContainElement(PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeRequired),
+				"Field": Equal("blueprint.git.repository"),
+			}))))
+		})
 	})
 
 	Context("InstallationComponentDescriptor", func() {
@@ -203,6 +230,92 @@ var _ = Describe("Installation", func() {
 		})
 	})
 
+	Context("InstallationExportSinks", func() {
+		var dataExports = []core.DataExport{{Name: "endpoint", DataRef: "my-endpoint"}}
+
+		It("should pass if the export sinks are valid", func() {
+			sinks := []core.ExportSink{
+				{
+					Name:   "endpoint",
+					Target: "my-cluster",
+					Data:   []core.ExportSinkData{{Key: "url", Export: "endpoint"}},
+				},
+				{
+					Name:      "endpoint",
+					Namespace: "app",
+					Type:      core.ConfigMapExportSinkType,
+					Target:    "my-cluster",
+					Data:      []core.ExportSinkData{{Key: "url", Export: "endpoint"}},
+				},
+			}
+
+			allErrs := validation.ValidateInstallationExportSinks(sinks, dataExports, field.NewPath("sinks"))
+			Expect(allErrs).To(HaveLen(0))
+		})
+
+		It("should fail if an export sink contains empty values", func() {
+			sinks := []core.ExportSink{{Data: []core.ExportSinkData{{}}}}
+
+			allErrs := validation.ValidateInstallationExportSinks(sinks, dataExports, field.NewPath("sinks"))
+			Expect(allErrs).To(ConsistOf(
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeRequired),
+					"Field": Equal("sinks[0].name"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeRequired),
+					"Field": Equal("sinks[0].target"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeRequired),
+					"Field": Equal("sinks[0].data[0].key"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeRequired),
+					"Field": Equal("sinks[0].data[0].export"),
+				})),
+			))
+		})
+
+		It("should fail if an export sink has an unknown type", func() {
+			sinks := []core.ExportSink{{
+				Name:   "endpoint",
+				Type:   "Service",
+				Target: "my-cluster",
+				Data:   []core.ExportSinkData{{Key: "url", Export: "endpoint"}},
+			}}
+
+			allErrs := validation.ValidateInstallationExportSinks(sinks, dataExports, field.NewPath("sinks"))
+			Expect(allErrs).To(ContainElement(PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeNotSupported),
+				"Field": Equal("sinks[0].type"),
+			}))))
+		})
+
+		It("should fail if an export sink references an unknown export or contains duplicate keys", func() {
+			sinks := []core.ExportSink{{
+				Name:   "endpoint",
+				Target: "my-cluster",
+				Data: []core.ExportSinkData{
+					{Key: "url", Export: "endpoint"},
+					{Key: "url", Export: "password"},
+				},
+			}}
+
+			allErrs := validation.ValidateInstallationExportSinks(sinks, dataExports, field.NewPath("sinks"))
+			Expect(allErrs).To(ConsistOf(
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeDuplicate),
+					"Field": Equal("sinks[0].data[1].key"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("sinks[0].data[1].export"),
+				})),
+			))
+		})
+	})
+
 	Context("InstallationImports", func() {
 		It("should pass if imports are valid", func() {
 			imp := core.InstallationImports{
@@ -404,6 +517,46 @@ var _ = Describe("Installation", func() {
 			}
 		})
 
+		It("should validate the label selector of a target selector import", func() {
+			imp := core.InstallationImports{
+				Targets: []core.TargetImport{
+					{
+						Name: "foo",
+						TargetSelector: &metav1.LabelSelector{
+							MatchLabels: map[string]string{"fleet": "prod"},
+						},
+					},
+					{
+						Name:    "bar",
+						Targets: []string{"t1"},
+						TargetSelector: &metav1.LabelSelector{
+							MatchLabels: map[string]string{"fleet": "prod"},
+						},
+					},
+					{
+						Name: "baz",
+						TargetSelector: &metav1.LabelSelector{
+							MatchExpressions: []metav1.LabelSelectorRequirement{
+								{Key: "fleet", Operator: "Invalid"},
+							},
+						},
+					},
+				},
+			}
+
+			allErrs := validation.ValidateInstallationImports(imp, field.NewPath("imports"))
+			Expect(allErrs).To(HaveLen(2))
+			Expect(allErrs).To(ContainElement(PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":   Equal(field.ErrorTypeInvalid),
+				"Field":  Equal("imports.targets[1]"),
+				"Detail": And(ContainSubstring("Targets"), ContainSubstring("TargetSelector")),
+			}))))
+			Expect(allErrs).To(ContainElement(PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeInvalid),
+				"Field": Equal("imports.targets[2].targetSelector.matchExpressions[0].operator"),
+			}))))
+		})
+
 		It("should fail if a key in a targetmap is invalid", func() {
 			imp := core.InstallationImports{
 				Targets: []core.TargetImport{
@@ -508,5 +661,145 @@ var _ = Describe("Installation", func() {
 				"Field": Equal("imports.data[0]"),
 			}))))
 		})
+
+		It("should fail if a http import has no valid url", func() {
+			imp := core.InstallationImports{
+				Data: []core.DataImport{
+					{
+						Name:    "imp1",
+						HTTPRef: &core.HTTPDataReference{},
+					},
+					{
+						Name: "imp2",
+						HTTPRef: &core.HTTPDataReference{
+							URL: "ftp://example.com/outputs.json",
+						},
+					},
+				},
+			}
+
+			allErrs := validation.ValidateInstallationImports(imp, field.NewPath("imports"))
+			Expect(allErrs).To(HaveLen(2))
+			Expect(allErrs).To(ContainElement(PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeRequired),
+				"Field": Equal("imports.data[0].httpRef.url"),
+			}))))
+			Expect(allErrs).To(ContainElement(PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeInvalid),
+				"Field": Equal("imports.data[1].httpRef.url"),
+			}))))
+		})
+
+		It("should fail if the auth secret of a http import contains empty values", func() {
+			imp := core.InstallationImports{
+				Data: []core.DataImport{
+					{
+						Name: "imp",
+						HTTPRef: &core.HTTPDataReference{
+							URL:           "https://example.com/outputs.json",
+							AuthSecretRef: &core.LocalSecretReference{},
+						},
+					},
+				},
+			}
+
+			allErrs := validation.ValidateInstallationImports(imp, field.NewPath("imports"))
+			Expect(allErrs).To(ContainElement(PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeRequired),
+				"Field": Equal("imports.data[0].httpRef.authSecretRef.name"),
+			}))))
+		})
+
+		It("should pass if the transformations of a data import are valid", func() {
+			imp := core.InstallationImports{
+				Data: []core.DataImport{
+					{
+						Name:    "imp",
+						DataRef: "do",
+						Transformations: []core.ImportTransformation{
+							{Type: core.ImportTransformationTypeJSONPath, JSONPath: ".outputs.endpoint"},
+							{Type: core.ImportTransformationTypeBase64Decode},
+							{Type: core.ImportTransformationTypeDefault, Value: &core.AnyJSON{RawMessage: []byte(`"default"`)}},
+							{Type: core.ImportTransformationTypeGoTemplate, Template: "{{ .value | upper }}"},
+						},
+					},
+				},
+			}
+
+			allErrs := validation.ValidateInstallationImports(imp, field.NewPath("imports"))
+			Expect(allErrs).To(BeEmpty())
+		})
+
+		It("should fail if the transformations of a data import are invalid", func() {
+			imp := core.InstallationImports{
+				Data: []core.DataImport{
+					{
+						Name:    "imp",
+						DataRef: "do",
+						Transformations: []core.ImportTransformation{
+							{Type: core.ImportTransformationTypeJSONPath},
+							{Type: core.ImportTransformationTypeDefault},
+							{Type: core.ImportTransformationTypeGoTemplate},
+							{Type: "Unknown"},
+						},
+					},
+				},
+			}
+
+			allErrs := validation.ValidateInstallationImports(imp, field.NewPath("imports"))
+			Expect(allErrs).To(HaveLen(4))
+			Expect(allErrs).To(ContainElement(PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeRequired),
+				"Field": Equal("imports.data[0].transformations[0].jsonPath"),
+			}))))
+			Expect(allErrs).To(ContainElement(PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeRequired),
+				"Field": Equal("imports.data[0].transformations[1].value"),
+			}))))
+			Expect(allErrs).To(ContainElement(PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeRequired),
+				"Field": Equal("imports.data[0].transformations[2].template"),
+			}))))
+			Expect(allErrs).To(ContainElement(PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeNotSupported),
+				"Field": Equal("imports.data[0].transformations[3].type"),
+			}))))
+		})
+	})
+
+	Context("InstallationReconcileSchedule", func() {
+		It("should pass if the reconcile schedule is valid", func() {
+			schedule := &core.ReconcileSchedule{
+				CronSpec: "0 2 * * 6",
+				Duration: core.Duration{Duration: 2 * time.Hour},
+				TimeZone: "Europe/Berlin",
+			}
+
+			allErrs := validation.ValidateInstallationReconcileSchedule(schedule, field.NewPath("reconcileSchedule"))
+			Expect(allErrs).To(HaveLen(0))
+		})
+
+		It("should fail if the reconcile schedule contains invalid values", func() {
+			schedule := &core.ReconcileSchedule{
+				CronSpec: "every saturday",
+				TimeZone: "Mars/Olympus",
+			}
+
+			allErrs := validation.ValidateInstallationReconcileSchedule(schedule, field.NewPath("reconcileSchedule"))
+			Expect(allErrs).To(ConsistOf(
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("reconcileSchedule.cronSpec"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("reconcileSchedule.duration"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("reconcileSchedule.timeZone"),
+				})),
+			))
+		})
 	})
 })
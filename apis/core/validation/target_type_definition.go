@@ -0,0 +1,41 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package validation
+
+import (
+	"encoding/json"
+
+	"k8s.io/apimachinery/pkg/util/validation/field"
+
+	"github.com/gardener/landscaper/apis/core"
+)
+
+// ValidateTargetTypeDefinition validates a TargetTypeDefinition
+func ValidateTargetTypeDefinition(def *core.TargetTypeDefinition) field.ErrorList {
+	allErrs := field.ErrorList{}
+	allErrs = append(allErrs, ValidateTargetTypeDefinitionSpec(&def.Spec, field.NewPath("spec"))...)
+	return allErrs
+}
+
+// ValidateTargetTypeDefinitionSpec validates the spec of a TargetTypeDefinition
+func ValidateTargetTypeDefinitionSpec(spec *core.TargetTypeDefinitionSpec, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if len(spec.Type) == 0 {
+		allErrs = append(allErrs, field.Required(fldPath.Child("type"), "must not be empty"))
+	}
+
+	schemaPath := fldPath.Child("schema")
+	if len(spec.Schema.RawMessage) == 0 {
+		allErrs = append(allErrs, field.Required(schemaPath, "must not be empty"))
+	} else {
+		var schema map[string]interface{}
+		if err := json.Unmarshal(spec.Schema.RawMessage, &schema); err != nil {
+			allErrs = append(allErrs, field.Invalid(schemaPath, string(spec.Schema.RawMessage), "must be a json object"))
+		}
+	}
+
+	return allErrs
+}
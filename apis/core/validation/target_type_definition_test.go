@@ -0,0 +1,61 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package validation_test
+
+import (
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gstruct"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+
+	"github.com/gardener/landscaper/apis/core"
+	"github.com/gardener/landscaper/apis/core/validation"
+)
+
+var _ = Describe("TargetTypeDefinition", func() {
+
+	It("should accept a TargetTypeDefinition with a type and a schema", func() {
+		def := &core.TargetTypeDefinition{
+			Spec: core.TargetTypeDefinitionSpec{
+				Type:   "example.com/my-type",
+				Schema: core.NewAnyJSON([]byte(`{"type": "object"}`)),
+			},
+		}
+
+		allErrs := validation.ValidateTargetTypeDefinition(def)
+		Expect(allErrs).To(BeEmpty())
+	})
+
+	It("should reject a TargetTypeDefinition without a type and a schema", func() {
+		def := &core.TargetTypeDefinition{}
+
+		allErrs := validation.ValidateTargetTypeDefinition(def)
+		Expect(allErrs).To(ConsistOf(
+			PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeRequired),
+				"Field": Equal("spec.type"),
+			})),
+			PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeRequired),
+				"Field": Equal("spec.schema"),
+			})),
+		))
+	})
+
+	It("should reject a TargetTypeDefinition whose schema is not a json object", func() {
+		def := &core.TargetTypeDefinition{
+			Spec: core.TargetTypeDefinitionSpec{
+				Type:   "example.com/my-type",
+				Schema: core.NewAnyJSON([]byte(`"string"`)),
+			},
+		}
+
+		allErrs := validation.ValidateTargetTypeDefinition(def)
+		Expect(allErrs).To(ContainElement(PointTo(MatchFields(IgnoreExtras, Fields{
+			"Type":  Equal(field.ErrorTypeInvalid),
+			"Field": Equal("spec.schema"),
+		}))))
+	})
+})
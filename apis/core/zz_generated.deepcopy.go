@@ -13,6 +13,7 @@ import (
 
 	v2 "github.com/gardener/component-spec/bindings-go/apis/v2"
 	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	runtime "k8s.io/apimachinery/pkg/runtime"
 )
 
@@ -37,6 +38,27 @@ func (in *AnyJSON) DeepCopy() *AnyJSON {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ApprovalRecord) DeepCopyInto(out *ApprovalRecord) {
+	*out = *in
+	in.RequestTime.DeepCopyInto(&out.RequestTime)
+	if in.DecisionTime != nil {
+		in, out := &in.DecisionTime, &out.DecisionTime
+		*out = (*in).DeepCopy()
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ApprovalRecord.
+func (in *ApprovalRecord) DeepCopy() *ApprovalRecord {
+	if in == nil {
+		return nil
+	}
+	out := new(ApprovalRecord)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *AutomaticReconcile) DeepCopyInto(out *AutomaticReconcile) {
 	*out = *in
@@ -126,6 +148,13 @@ func (in *Blueprint) DeepCopyInto(out *Blueprint) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.RenderStages != nil {
+		in, out := &in.RenderStages, &out.RenderStages
+		*out = make([]RenderStage, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	if in.SubinstallationExecutions != nil {
 		in, out := &in.SubinstallationExecutions, &out.SubinstallationExecutions
 		*out = make([]TemplateExecutor, len(*in))
@@ -147,6 +176,11 @@ func (in *Blueprint) DeepCopyInto(out *Blueprint) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.FeatureFlags != nil {
+		in, out := &in.FeatureFlags, &out.FeatureFlags
+		*out = make([]FeatureFlagDefinition, len(*in))
+		copy(*out, *in)
+	}
 	return
 }
 
@@ -181,6 +215,11 @@ func (in *BlueprintDefinition) DeepCopyInto(out *BlueprintDefinition) {
 		*out = new(InlineBlueprint)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.Git != nil {
+		in, out := &in.Git, &out.Git
+		*out = new(GitBlueprintReference)
+		**out = **in
+	}
 	return
 }
 
@@ -502,6 +541,23 @@ func (in *ContextConfiguration) DeepCopyInto(out *ContextConfiguration) {
 			(*out)[key] = *val.DeepCopy()
 		}
 	}
+	if in.ImagePullSecrets != nil {
+		in, out := &in.ImagePullSecrets, &out.ImagePullSecrets
+		*out = new(ImagePullSecretsConfiguration)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.FeatureFlags != nil {
+		in, out := &in.FeatureFlags, &out.FeatureFlags
+		*out = make(map[string]bool, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.Scheduling != nil {
+		in, out := &in.Scheduling, &out.Scheduling
+		*out = new(SchedulingConfiguration)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -694,6 +750,18 @@ func (in *DataImport) DeepCopyInto(out *DataImport) {
 		*out = new(LocalConfigMapReference)
 		**out = **in
 	}
+	if in.HTTPRef != nil {
+		in, out := &in.HTTPRef, &out.HTTPRef
+		*out = new(HTTPDataReference)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Transformations != nil {
+		in, out := &in.Transformations, &out.Transformations
+		*out = make([]ImportTransformation, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	return
 }
 
@@ -784,6 +852,23 @@ func (in *Default) DeepCopy() *Default {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DeferredDeployItem) DeepCopyInto(out *DeferredDeployItem) {
+	*out = *in
+	in.Until.DeepCopyInto(&out.Until)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DeferredDeployItem.
+func (in *DeferredDeployItem) DeepCopy() *DeferredDeployItem {
+	if in == nil {
+		return nil
+	}
+	out := new(DeferredDeployItem)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *DependentToTrigger) DeepCopyInto(out *DependentToTrigger) {
 	*out = *in
@@ -854,6 +939,22 @@ func (in *DeployItemCache) DeepCopy() *DeployItemCache {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DeployItemHash) DeepCopyInto(out *DeployItemHash) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DeployItemHash.
+func (in *DeployItemHash) DeepCopy() *DeployItemHash {
+	if in == nil {
+		return nil
+	}
+	out := new(DeployItemHash)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *DeployItemList) DeepCopyInto(out *DeployItemList) {
 	*out = *in
@@ -887,6 +988,30 @@ func (in *DeployItemList) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DeployItemProgress) DeepCopyInto(out *DeployItemProgress) {
+	*out = *in
+	if in.StartTime != nil {
+		in, out := &in.StartTime, &out.StartTime
+		*out = (*in).DeepCopy()
+	}
+	if in.FinishedTime != nil {
+		in, out := &in.FinishedTime, &out.FinishedTime
+		*out = (*in).DeepCopy()
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DeployItemProgress.
+func (in *DeployItemProgress) DeepCopy() *DeployItemProgress {
+	if in == nil {
+		return nil
+	}
+	out := new(DeployItemProgress)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *DeployItemSpec) DeepCopyInto(out *DeployItemSpec) {
 	*out = *in
@@ -905,11 +1030,38 @@ func (in *DeployItemSpec) DeepCopyInto(out *DeployItemSpec) {
 		*out = new(Duration)
 		**out = **in
 	}
+	if in.PickupTimeout != nil {
+		in, out := &in.PickupTimeout, &out.PickupTimeout
+		*out = new(Duration)
+		**out = **in
+	}
+	if in.AbortTimeout != nil {
+		in, out := &in.AbortTimeout, &out.AbortTimeout
+		*out = new(Duration)
+		**out = **in
+	}
+	if in.ConsumedImports != nil {
+		in, out := &in.ConsumedImports, &out.ConsumedImports
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
 	if in.OnDelete != nil {
 		in, out := &in.OnDelete, &out.OnDelete
 		*out = new(OnDeleteConfig)
 		**out = **in
 	}
+	if in.ExclusionWindows != nil {
+		in, out := &in.ExclusionWindows, &out.ExclusionWindows
+		*out = make([]ExclusionWindow, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.RetryPolicy != nil {
+		in, out := &in.RetryPolicy, &out.RetryPolicy
+		*out = new(RetryPolicy)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -983,6 +1135,15 @@ func (in *DeployItemStatus) DeepCopyInto(out *DeployItemStatus) {
 		*out = new(TransitionTimes)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.Progress != nil {
+		in, out := &in.Progress, &out.Progress
+		*out = new(DeployerProgress)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.NextRetryTime != nil {
+		in, out := &in.NextRetryTime, &out.NextRetryTime
+		*out = (*in).DeepCopy()
+	}
 	return
 }
 
@@ -1026,11 +1187,38 @@ func (in *DeployItemTemplate) DeepCopyInto(out *DeployItemTemplate) {
 		*out = new(Duration)
 		**out = **in
 	}
+	if in.PickupTimeout != nil {
+		in, out := &in.PickupTimeout, &out.PickupTimeout
+		*out = new(Duration)
+		**out = **in
+	}
+	if in.AbortTimeout != nil {
+		in, out := &in.AbortTimeout, &out.AbortTimeout
+		*out = new(Duration)
+		**out = **in
+	}
+	if in.ConsumedImports != nil {
+		in, out := &in.ConsumedImports, &out.ConsumedImports
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
 	if in.OnDelete != nil {
 		in, out := &in.OnDelete, &out.OnDelete
 		*out = new(OnDeleteConfig)
 		**out = **in
 	}
+	if in.ExclusionWindows != nil {
+		in, out := &in.ExclusionWindows, &out.ExclusionWindows
+		*out = make([]ExclusionWindow, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.RetryPolicy != nil {
+		in, out := &in.RetryPolicy, &out.RetryPolicy
+		*out = new(RetryPolicy)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -1066,6 +1254,32 @@ func (in DeployItemTemplateList) DeepCopy() DeployItemTemplateList {
 	return *out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DeployerHealthCheck) DeepCopyInto(out *DeployerHealthCheck) {
+	*out = *in
+	if in.Interval != nil {
+		in, out := &in.Interval, &out.Interval
+		*out = new(Duration)
+		**out = **in
+	}
+	if in.Timeout != nil {
+		in, out := &in.Timeout, &out.Timeout
+		*out = new(Duration)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DeployerHealthCheck.
+func (in *DeployerHealthCheck) DeepCopy() *DeployerHealthCheck {
+	if in == nil {
+		return nil
+	}
+	out := new(DeployerHealthCheck)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *DeployerInformation) DeepCopyInto(out *DeployerInformation) {
 	*out = *in
@@ -1082,6 +1296,135 @@ func (in *DeployerInformation) DeepCopy() *DeployerInformation {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DeployerProgress) DeepCopyInto(out *DeployerProgress) {
+	*out = *in
+	in.LastUpdateTime.DeepCopyInto(&out.LastUpdateTime)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DeployerProgress.
+func (in *DeployerProgress) DeepCopy() *DeployerProgress {
+	if in == nil {
+		return nil
+	}
+	out := new(DeployerProgress)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DeployerRegistration) DeepCopyInto(out *DeployerRegistration) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DeployerRegistration.
+func (in *DeployerRegistration) DeepCopy() *DeployerRegistration {
+	if in == nil {
+		return nil
+	}
+	out := new(DeployerRegistration)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *DeployerRegistration) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DeployerRegistrationList) DeepCopyInto(out *DeployerRegistrationList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]DeployerRegistration, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DeployerRegistrationList.
+func (in *DeployerRegistrationList) DeepCopy() *DeployerRegistrationList {
+	if in == nil {
+		return nil
+	}
+	out := new(DeployerRegistrationList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *DeployerRegistrationList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DeployerRegistrationSpec) DeepCopyInto(out *DeployerRegistrationSpec) {
+	*out = *in
+	if in.DeployItemTypes != nil {
+		in, out := &in.DeployItemTypes, &out.DeployItemTypes
+		*out = make([]DeployItemType, len(*in))
+		copy(*out, *in)
+	}
+	if in.TargetTypes != nil {
+		in, out := &in.TargetTypes, &out.TargetTypes
+		*out = make([]TargetType, len(*in))
+		copy(*out, *in)
+	}
+	if in.HealthCheck != nil {
+		in, out := &in.HealthCheck, &out.HealthCheck
+		*out = new(DeployerHealthCheck)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DeployerRegistrationSpec.
+func (in *DeployerRegistrationSpec) DeepCopy() *DeployerRegistrationSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(DeployerRegistrationSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DeployerRegistrationStatus) DeepCopyInto(out *DeployerRegistrationStatus) {
+	*out = *in
+	if in.LastHealthCheckTime != nil {
+		in, out := &in.LastHealthCheckTime, &out.LastHealthCheckTime
+		*out = (*in).DeepCopy()
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DeployerRegistrationStatus.
+func (in *DeployerRegistrationStatus) DeepCopy() *DeployerRegistrationStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(DeployerRegistrationStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *DiNamePair) DeepCopyInto(out *DiNamePair) {
 	*out = *in
@@ -1137,6 +1480,27 @@ func (in *Error) DeepCopy() *Error {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ExclusionWindow) DeepCopyInto(out *ExclusionWindow) {
+	*out = *in
+	if in.Days != nil {
+		in, out := &in.Days, &out.Days
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ExclusionWindow.
+func (in *ExclusionWindow) DeepCopy() *ExclusionWindow {
+	if in == nil {
+		return nil
+	}
+	out := new(ExclusionWindow)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Execution) DeepCopyInto(out *Execution) {
 	*out = *in
@@ -1199,35 +1563,58 @@ func (in *ExecutionList) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ExecutionSpec) DeepCopyInto(out *ExecutionSpec) {
+func (in *ExecutionProgress) DeepCopyInto(out *ExecutionProgress) {
 	*out = *in
 	if in.DeployItems != nil {
 		in, out := &in.DeployItems, &out.DeployItems
-		*out = make(DeployItemTemplateList, len(*in))
+		*out = make([]DeployItemProgress, len(*in))
 		for i := range *in {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
-	if in.DeployItemsCompressed != nil {
-		in, out := &in.DeployItemsCompressed, &out.DeployItemsCompressed
-		*out = make([]byte, len(*in))
-		copy(*out, *in)
-	}
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ExecutionSpec.
-func (in *ExecutionSpec) DeepCopy() *ExecutionSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ExecutionProgress.
+func (in *ExecutionProgress) DeepCopy() *ExecutionProgress {
 	if in == nil {
 		return nil
 	}
-	out := new(ExecutionSpec)
+	out := new(ExecutionProgress)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ExecutionStatus) DeepCopyInto(out *ExecutionStatus) {
+func (in *ExecutionSpec) DeepCopyInto(out *ExecutionSpec) {
+	*out = *in
+	if in.DeployItems != nil {
+		in, out := &in.DeployItems, &out.DeployItems
+		*out = make(DeployItemTemplateList, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.DeployItemsCompressed != nil {
+		in, out := &in.DeployItemsCompressed, &out.DeployItemsCompressed
+		*out = make([]byte, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ExecutionSpec.
+func (in *ExecutionSpec) DeepCopy() *ExecutionSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(ExecutionSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ExecutionStatus) DeepCopyInto(out *ExecutionStatus) {
 	*out = *in
 	if in.Conditions != nil {
 		in, out := &in.Conditions, &out.Conditions
@@ -1260,6 +1647,23 @@ func (in *ExecutionStatus) DeepCopyInto(out *ExecutionStatus) {
 		*out = new(TransitionTimes)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.Progress != nil {
+		in, out := &in.Progress, &out.Progress
+		*out = new(ExecutionProgress)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.DeferredDeployItems != nil {
+		in, out := &in.DeferredDeployItems, &out.DeferredDeployItems
+		*out = make([]DeferredDeployItem, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.DeployItemHashes != nil {
+		in, out := &in.DeployItemHashes, &out.DeployItemHashes
+		*out = make([]DeployItemHash, len(*in))
+		copy(*out, *in)
+	}
 	return
 }
 
@@ -1312,6 +1716,59 @@ func (in ExportDefinitionList) DeepCopy() ExportDefinitionList {
 	return *out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ExportSink) DeepCopyInto(out *ExportSink) {
+	*out = *in
+	if in.Data != nil {
+		in, out := &in.Data, &out.Data
+		*out = make([]ExportSinkData, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ExportSink.
+func (in *ExportSink) DeepCopy() *ExportSink {
+	if in == nil {
+		return nil
+	}
+	out := new(ExportSink)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ExportSinkData) DeepCopyInto(out *ExportSinkData) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ExportSinkData.
+func (in *ExportSinkData) DeepCopy() *ExportSinkData {
+	if in == nil {
+		return nil
+	}
+	out := new(ExportSinkData)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ExportSinkReference) DeepCopyInto(out *ExportSinkReference) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ExportSinkReference.
+func (in *ExportSinkReference) DeepCopy() *ExportSinkReference {
+	if in == nil {
+		return nil
+	}
+	out := new(ExportSinkReference)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *FailedReconcile) DeepCopyInto(out *FailedReconcile) {
 	*out = *in
@@ -1338,6 +1795,22 @@ func (in *FailedReconcile) DeepCopy() *FailedReconcile {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *FeatureFlagDefinition) DeepCopyInto(out *FeatureFlagDefinition) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FeatureFlagDefinition.
+func (in *FeatureFlagDefinition) DeepCopy() *FeatureFlagDefinition {
+	if in == nil {
+		return nil
+	}
+	out := new(FeatureFlagDefinition)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *FieldValueDefinition) DeepCopyInto(out *FieldValueDefinition) {
 	*out = *in
@@ -1359,6 +1832,69 @@ func (in *FieldValueDefinition) DeepCopy() *FieldValueDefinition {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GitBlueprintReference) DeepCopyInto(out *GitBlueprintReference) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GitBlueprintReference.
+func (in *GitBlueprintReference) DeepCopy() *GitBlueprintReference {
+	if in == nil {
+		return nil
+	}
+	out := new(GitBlueprintReference)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *HTTPDataReference) DeepCopyInto(out *HTTPDataReference) {
+	*out = *in
+	if in.AuthSecretRef != nil {
+		in, out := &in.AuthSecretRef, &out.AuthSecretRef
+		*out = new(LocalSecretReference)
+		**out = **in
+	}
+	if in.CacheDuration != nil {
+		in, out := &in.CacheDuration, &out.CacheDuration
+		*out = new(Duration)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HTTPDataReference.
+func (in *HTTPDataReference) DeepCopy() *HTTPDataReference {
+	if in == nil {
+		return nil
+	}
+	out := new(HTTPDataReference)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ImagePullSecretsConfiguration) DeepCopyInto(out *ImagePullSecretsConfiguration) {
+	*out = *in
+	if in.Secrets != nil {
+		in, out := &in.Secrets, &out.Secrets
+		*out = make([]v1.LocalObjectReference, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ImagePullSecretsConfiguration.
+func (in *ImagePullSecretsConfiguration) DeepCopy() *ImagePullSecretsConfiguration {
+	if in == nil {
+		return nil
+	}
+	out := new(ImagePullSecretsConfiguration)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ImportDefinition) DeepCopyInto(out *ImportDefinition) {
 	*out = *in
@@ -1411,6 +1947,64 @@ func (in ImportDefinitionList) DeepCopy() ImportDefinitionList {
 	return *out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ImportQuarantine) DeepCopyInto(out *ImportQuarantine) {
+	*out = *in
+	out.Source = in.Source
+	if in.QuarantineTime != nil {
+		in, out := &in.QuarantineTime, &out.QuarantineTime
+		*out = (*in).DeepCopy()
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ImportQuarantine.
+func (in *ImportQuarantine) DeepCopy() *ImportQuarantine {
+	if in == nil {
+		return nil
+	}
+	out := new(ImportQuarantine)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ImportSource) DeepCopyInto(out *ImportSource) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ImportSource.
+func (in *ImportSource) DeepCopy() *ImportSource {
+	if in == nil {
+		return nil
+	}
+	out := new(ImportSource)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ImportTransformation) DeepCopyInto(out *ImportTransformation) {
+	*out = *in
+	if in.Value != nil {
+		in, out := &in.Value, &out.Value
+		*out = new(AnyJSON)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ImportTransformation.
+func (in *ImportTransformation) DeepCopy() *ImportTransformation {
+	if in == nil {
+		return nil
+	}
+	out := new(ImportTransformation)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *InlineBlueprint) DeepCopyInto(out *InlineBlueprint) {
 	*out = *in
@@ -1469,6 +2063,13 @@ func (in *InstallationExports) DeepCopyInto(out *InstallationExports) {
 		*out = make([]TargetExport, len(*in))
 		copy(*out, *in)
 	}
+	if in.Sinks != nil {
+		in, out := &in.Sinks, &out.Sinks
+		*out = make([]ExportSink, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	return
 }
 
@@ -1482,6 +2083,26 @@ func (in *InstallationExports) DeepCopy() *InstallationExports {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *InstallationHealthRecord) DeepCopyInto(out *InstallationHealthRecord) {
+	*out = *in
+	if in.Time != nil {
+		in, out := &in.Time, &out.Time
+		*out = (*in).DeepCopy()
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InstallationHealthRecord.
+func (in *InstallationHealthRecord) DeepCopy() *InstallationHealthRecord {
+	if in == nil {
+		return nil
+	}
+	out := new(InstallationHealthRecord)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *InstallationImports) DeepCopyInto(out *InstallationImports) {
 	*out = *in
@@ -1545,6 +2166,22 @@ func (in *InstallationList) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *InstallationPhaseCount) DeepCopyInto(out *InstallationPhaseCount) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InstallationPhaseCount.
+func (in *InstallationPhaseCount) DeepCopy() *InstallationPhaseCount {
+	if in == nil {
+		return nil
+	}
+	out := new(InstallationPhaseCount)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *InstallationSpec) DeepCopyInto(out *InstallationSpec) {
 	*out = *in
@@ -1585,6 +2222,11 @@ func (in *InstallationSpec) DeepCopyInto(out *InstallationSpec) {
 		*out = new(Optimization)
 		**out = **in
 	}
+	if in.ReconcileSchedule != nil {
+		in, out := &in.ReconcileSchedule, &out.ReconcileSchedule
+		*out = new(ReconcileSchedule)
+		**out = **in
+	}
 	return
 }
 
@@ -1618,11 +2260,21 @@ func (in *InstallationStatus) DeepCopyInto(out *InstallationStatus) {
 		*out = new(SubInstCache)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.RenderedSubinstallations != nil {
+		in, out := &in.RenderedSubinstallations, &out.RenderedSubinstallations
+		*out = make([]RenderedSubinstallation, len(*in))
+		copy(*out, *in)
+	}
 	if in.ExecutionReference != nil {
 		in, out := &in.ExecutionReference, &out.ExecutionReference
 		*out = new(ObjectReference)
 		**out = **in
 	}
+	if in.ChainedExecutionReferences != nil {
+		in, out := &in.ChainedExecutionReferences, &out.ChainedExecutionReferences
+		*out = make([]ObjectReference, len(*in))
+		copy(*out, *in)
+	}
 	if in.PhaseTransitionTime != nil {
 		in, out := &in.PhaseTransitionTime, &out.PhaseTransitionTime
 		*out = (*in).DeepCopy()
@@ -1637,11 +2289,43 @@ func (in *InstallationStatus) DeepCopyInto(out *InstallationStatus) {
 		*out = make([]DependentToTrigger, len(*in))
 		copy(*out, *in)
 	}
+	if in.Predecessors != nil {
+		in, out := &in.Predecessors, &out.Predecessors
+		*out = make([]PredecessorStatus, len(*in))
+		copy(*out, *in)
+	}
 	if in.TransitionTimes != nil {
 		in, out := &in.TransitionTimes, &out.TransitionTimes
 		*out = new(TransitionTimes)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.Approvals != nil {
+		in, out := &in.Approvals, &out.Approvals
+		*out = make([]ApprovalRecord, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.ImportSources != nil {
+		in, out := &in.ImportSources, &out.ImportSources
+		*out = make([]ImportSource, len(*in))
+		copy(*out, *in)
+	}
+	if in.ResolvedComponentVersions != nil {
+		in, out := &in.ResolvedComponentVersions, &out.ResolvedComponentVersions
+		*out = make([]ResolvedComponentVersion, len(*in))
+		copy(*out, *in)
+	}
+	if in.ImportQuarantine != nil {
+		in, out := &in.ImportQuarantine, &out.ImportQuarantine
+		*out = new(ImportQuarantine)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ExportSinks != nil {
+		in, out := &in.ExportSinks, &out.ExportSinks
+		*out = make([]ExportSinkReference, len(*in))
+		copy(*out, *in)
+	}
 	return
 }
 
@@ -1681,6 +2365,11 @@ func (in *InstallationTemplate) DeepCopyInto(out *InstallationTemplate) {
 		*out = new(Optimization)
 		**out = **in
 	}
+	if in.ForEach != nil {
+		in, out := &in.ForEach, &out.ForEach
+		*out = new(InstallationTemplateForEach)
+		**out = **in
+	}
 	return
 }
 
@@ -1745,6 +2434,22 @@ func (in InstallationTemplateList) DeepCopy() InstallationTemplateList {
 	return *out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *InstallationTemplateForEach) DeepCopyInto(out *InstallationTemplateForEach) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InstallationTemplateForEach.
+func (in *InstallationTemplateForEach) DeepCopy() *InstallationTemplateForEach {
+	if in == nil {
+		return nil
+	}
+	out := new(InstallationTemplateForEach)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *JSONSchemaDefinition) DeepCopyInto(out *JSONSchemaDefinition) {
 	*out = *in
@@ -1766,6 +2471,123 @@ func (in *JSONSchemaDefinition) DeepCopy() *JSONSchemaDefinition {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LandscapeHealth) DeepCopyInto(out *LandscapeHealth) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LandscapeHealth.
+func (in *LandscapeHealth) DeepCopy() *LandscapeHealth {
+	if in == nil {
+		return nil
+	}
+	out := new(LandscapeHealth)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *LandscapeHealth) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LandscapeHealthList) DeepCopyInto(out *LandscapeHealthList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]LandscapeHealth, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LandscapeHealthList.
+func (in *LandscapeHealthList) DeepCopy() *LandscapeHealthList {
+	if in == nil {
+		return nil
+	}
+	out := new(LandscapeHealthList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *LandscapeHealthList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LandscapeHealthSpec) DeepCopyInto(out *LandscapeHealthSpec) {
+	*out = *in
+	if in.Selector != nil {
+		in, out := &in.Selector, &out.Selector
+		*out = new(metav1.LabelSelector)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LandscapeHealthSpec.
+func (in *LandscapeHealthSpec) DeepCopy() *LandscapeHealthSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(LandscapeHealthSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LandscapeHealthStatus) DeepCopyInto(out *LandscapeHealthStatus) {
+	*out = *in
+	if in.PhaseCounts != nil {
+		in, out := &in.PhaseCounts, &out.PhaseCounts
+		*out = make([]InstallationPhaseCount, len(*in))
+		copy(*out, *in)
+	}
+	if in.OldestFailure != nil {
+		in, out := &in.OldestFailure, &out.OldestFailure
+		*out = new(InstallationHealthRecord)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.LastSuccessfulRun != nil {
+		in, out := &in.LastSuccessfulRun, &out.LastSuccessfulRun
+		*out = new(InstallationHealthRecord)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.LastUpdateTime != nil {
+		in, out := &in.LastUpdateTime, &out.LastUpdateTime
+		*out = (*in).DeepCopy()
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LandscapeHealthStatus.
+func (in *LandscapeHealthStatus) DeepCopy() *LandscapeHealthStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(LandscapeHealthStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *LocalConfigMapReference) DeepCopyInto(out *LocalConfigMapReference) {
 	*out = *in
@@ -1923,6 +2745,39 @@ func (in *Optimization) DeepCopy() *Optimization {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PredecessorStatus) DeepCopyInto(out *PredecessorStatus) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PredecessorStatus.
+func (in *PredecessorStatus) DeepCopy() *PredecessorStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(PredecessorStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ReconcileSchedule) DeepCopyInto(out *ReconcileSchedule) {
+	*out = *in
+	out.Duration = in.Duration
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ReconcileSchedule.
+func (in *ReconcileSchedule) DeepCopy() *ReconcileSchedule {
+	if in == nil {
+		return nil
+	}
+	out := new(ReconcileSchedule)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *RemoteBlueprintReference) DeepCopyInto(out *RemoteBlueprintReference) {
 	*out = *in
@@ -1939,6 +2794,50 @@ func (in *RemoteBlueprintReference) DeepCopy() *RemoteBlueprintReference {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RenderStage) DeepCopyInto(out *RenderStage) {
+	*out = *in
+	if in.Executions != nil {
+		in, out := &in.Executions, &out.Executions
+		*out = make([]TemplateExecutor, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Schema != nil {
+		in, out := &in.Schema, &out.Schema
+		*out = new(JSONSchemaDefinition)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RenderStage.
+func (in *RenderStage) DeepCopy() *RenderStage {
+	if in == nil {
+		return nil
+	}
+	out := new(RenderStage)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RenderedSubinstallation) DeepCopyInto(out *RenderedSubinstallation) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RenderedSubinstallation.
+func (in *RenderedSubinstallation) DeepCopy() *RenderedSubinstallation {
+	if in == nil {
+		return nil
+	}
+	out := new(RenderedSubinstallation)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Requirement) DeepCopyInto(out *Requirement) {
 	*out = *in
@@ -1950,49 +2849,126 @@ func (in *Requirement) DeepCopyInto(out *Requirement) {
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Requirement.
-func (in *Requirement) DeepCopy() *Requirement {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Requirement.
+func (in *Requirement) DeepCopy() *Requirement {
+	if in == nil {
+		return nil
+	}
+	out := new(Requirement)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ResolvedComponentVersion) DeepCopyInto(out *ResolvedComponentVersion) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ResolvedComponentVersion.
+func (in *ResolvedComponentVersion) DeepCopy() *ResolvedComponentVersion {
+	if in == nil {
+		return nil
+	}
+	out := new(ResolvedComponentVersion)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ResolvedTarget) DeepCopyInto(out *ResolvedTarget) {
+	*out = *in
+	if in.Target != nil {
+		in, out := &in.Target, &out.Target
+		*out = new(Target)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ResolvedTarget.
+func (in *ResolvedTarget) DeepCopy() *ResolvedTarget {
+	if in == nil {
+		return nil
+	}
+	out := new(ResolvedTarget)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ResourceReference) DeepCopyInto(out *ResourceReference) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ResourceReference.
+func (in *ResourceReference) DeepCopy() *ResourceReference {
 	if in == nil {
 		return nil
 	}
-	out := new(Requirement)
+	out := new(ResourceReference)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ResolvedTarget) DeepCopyInto(out *ResolvedTarget) {
+func (in *RetryPolicy) DeepCopyInto(out *RetryPolicy) {
 	*out = *in
-	if in.Target != nil {
-		in, out := &in.Target, &out.Target
-		*out = new(Target)
-		(*in).DeepCopyInto(*out)
+	if in.InitialBackoff != nil {
+		in, out := &in.InitialBackoff, &out.InitialBackoff
+		*out = new(Duration)
+		**out = **in
+	}
+	if in.MaxBackoff != nil {
+		in, out := &in.MaxBackoff, &out.MaxBackoff
+		*out = new(Duration)
+		**out = **in
+	}
+	if in.RetryableErrorCodes != nil {
+		in, out := &in.RetryableErrorCodes, &out.RetryableErrorCodes
+		*out = make([]ErrorCode, len(*in))
+		copy(*out, *in)
 	}
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ResolvedTarget.
-func (in *ResolvedTarget) DeepCopy() *ResolvedTarget {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RetryPolicy.
+func (in *RetryPolicy) DeepCopy() *RetryPolicy {
 	if in == nil {
 		return nil
 	}
-	out := new(ResolvedTarget)
+	out := new(RetryPolicy)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ResourceReference) DeepCopyInto(out *ResourceReference) {
+func (in *SchedulingConfiguration) DeepCopyInto(out *SchedulingConfiguration) {
 	*out = *in
+	if in.NodeSelector != nil {
+		in, out := &in.NodeSelector, &out.NodeSelector
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.Tolerations != nil {
+		in, out := &in.Tolerations, &out.Tolerations
+		*out = make([]v1.Toleration, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ResourceReference.
-func (in *ResourceReference) DeepCopy() *ResourceReference {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SchedulingConfiguration.
+func (in *SchedulingConfiguration) DeepCopy() *SchedulingConfiguration {
 	if in == nil {
 		return nil
 	}
-	out := new(ResourceReference)
+	out := new(SchedulingConfiguration)
 	in.DeepCopyInto(out)
 	return out
 }
@@ -2336,6 +3312,11 @@ func (in *TargetImport) DeepCopyInto(out *TargetImport) {
 		*out = make([]string, len(*in))
 		copy(*out, *in)
 	}
+	if in.TargetSelector != nil {
+		in, out := &in.TargetSelector, &out.TargetSelector
+		*out = new(metav1.LabelSelector)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.TargetMap != nil {
 		in, out := &in.TargetMap, &out.TargetMap
 		*out = make(map[string]string, len(*in))
@@ -2593,6 +3574,83 @@ func (in *TargetTemplate) DeepCopy() *TargetTemplate {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TargetTypeDefinition) DeepCopyInto(out *TargetTypeDefinition) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TargetTypeDefinition.
+func (in *TargetTypeDefinition) DeepCopy() *TargetTypeDefinition {
+	if in == nil {
+		return nil
+	}
+	out := new(TargetTypeDefinition)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *TargetTypeDefinition) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TargetTypeDefinitionList) DeepCopyInto(out *TargetTypeDefinitionList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]TargetTypeDefinition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TargetTypeDefinitionList.
+func (in *TargetTypeDefinitionList) DeepCopy() *TargetTypeDefinitionList {
+	if in == nil {
+		return nil
+	}
+	out := new(TargetTypeDefinitionList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *TargetTypeDefinitionList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TargetTypeDefinitionSpec) DeepCopyInto(out *TargetTypeDefinitionSpec) {
+	*out = *in
+	in.Schema.DeepCopyInto(&out.Schema)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TargetTypeDefinitionSpec.
+func (in *TargetTypeDefinitionSpec) DeepCopy() *TargetTypeDefinitionSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(TargetTypeDefinitionSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *TemplateExecutor) DeepCopyInto(out *TemplateExecutor) {
 	*out = *in
@@ -2610,6 +3668,216 @@ func (in *TemplateExecutor) DeepCopy() *TemplateExecutor {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TestArtifact) DeepCopyInto(out *TestArtifact) {
+	*out = *in
+	out.ObjectRef = in.ObjectRef
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TestArtifact.
+func (in *TestArtifact) DeepCopy() *TestArtifact {
+	if in == nil {
+		return nil
+	}
+	out := new(TestArtifact)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TestDefinition) DeepCopyInto(out *TestDefinition) {
+	*out = *in
+	in.Installation.DeepCopyInto(&out.Installation)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TestDefinition.
+func (in *TestDefinition) DeepCopy() *TestDefinition {
+	if in == nil {
+		return nil
+	}
+	out := new(TestDefinition)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TestResult) DeepCopyInto(out *TestResult) {
+	*out = *in
+	out.InstallationRef = in.InstallationRef
+	if in.FinishedTime != nil {
+		in, out := &in.FinishedTime, &out.FinishedTime
+		*out = (*in).DeepCopy()
+	}
+	if in.Artifacts != nil {
+		in, out := &in.Artifacts, &out.Artifacts
+		*out = make([]TestArtifact, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TestResult.
+func (in *TestResult) DeepCopy() *TestResult {
+	if in == nil {
+		return nil
+	}
+	out := new(TestResult)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TestRun) DeepCopyInto(out *TestRun) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TestRun.
+func (in *TestRun) DeepCopy() *TestRun {
+	if in == nil {
+		return nil
+	}
+	out := new(TestRun)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *TestRun) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TestRunList) DeepCopyInto(out *TestRunList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]TestRun, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TestRunList.
+func (in *TestRunList) DeepCopy() *TestRunList {
+	if in == nil {
+		return nil
+	}
+	out := new(TestRunList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *TestRunList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TestRunSchedule) DeepCopyInto(out *TestRunSchedule) {
+	*out = *in
+	if in.Interval != nil {
+		in, out := &in.Interval, &out.Interval
+		*out = new(Duration)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TestRunSchedule.
+func (in *TestRunSchedule) DeepCopy() *TestRunSchedule {
+	if in == nil {
+		return nil
+	}
+	out := new(TestRunSchedule)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TestRunSpec) DeepCopyInto(out *TestRunSpec) {
+	*out = *in
+	if in.Tests != nil {
+		in, out := &in.Tests, &out.Tests
+		*out = make([]TestDefinition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Schedule != nil {
+		in, out := &in.Schedule, &out.Schedule
+		*out = new(TestRunSchedule)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Timeout != nil {
+		in, out := &in.Timeout, &out.Timeout
+		*out = new(Duration)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TestRunSpec.
+func (in *TestRunSpec) DeepCopy() *TestRunSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(TestRunSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TestRunStatus) DeepCopyInto(out *TestRunStatus) {
+	*out = *in
+	if in.StartTime != nil {
+		in, out := &in.StartTime, &out.StartTime
+		*out = (*in).DeepCopy()
+	}
+	if in.FinishedTime != nil {
+		in, out := &in.FinishedTime, &out.FinishedTime
+		*out = (*in).DeepCopy()
+	}
+	if in.NextRunTime != nil {
+		in, out := &in.NextRunTime, &out.NextRunTime
+		*out = (*in).DeepCopy()
+	}
+	if in.Results != nil {
+		in, out := &in.Results, &out.Results
+		*out = make([]TestResult, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TestRunStatus.
+func (in *TestRunStatus) DeepCopy() *TestRunStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(TestRunStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *TokenRotation) DeepCopyInto(out *TokenRotation) {
 	*out = *in
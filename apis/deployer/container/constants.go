@@ -104,6 +104,15 @@ const StatePathName = "STATE_PATH"
 // StatePath is the path to the state directory.
 var StatePath = filepath.Join(SharedBasePath, "state")
 
+// EncryptionConfigPathName is the name of the env var that points to the encryption configuration of the state.
+const EncryptionConfigPathName = "ENCRYPTION_CONFIG_PATH"
+
+// EncryptionDir is the directory of the mounted state encryption secret.
+const EncryptionDir = "/etc/landscaper/encryption"
+
+// EncryptionConfigPath is the path to the encryption configuration of the state.
+var EncryptionConfigPath = filepath.Join(EncryptionDir, "encryption.yaml")
+
 // ConfigurationPathName is the name of the env var that points to the provider configuration file.
 const ConfigurationPathName = "CONFIGURATION_PATH"
 
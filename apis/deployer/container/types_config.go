@@ -53,6 +53,11 @@ type Configuration struct {
 	// DebugOptions configure additional debug options.
 	DebugOptions *DebugOptions `json:"debug,omitempty"`
 
+	// FailureLogs configures that the last lines of the logs of failed pods are written
+	// to the provider status of the deploy items.
+	// +optional
+	FailureLogs *FailureLogs `json:"failureLogs,omitempty"`
+
 	// HPAConfiguration contains the configuration for horizontal pod autoscaling.
 	HPAConfiguration *HPAConfiguration `json:"hpa,omitempty"`
 
@@ -61,6 +66,16 @@ type Configuration struct {
 
 	// +optional
 	UseOCMLib bool `json:"useOCMLib,omitempty"`
+
+	// StateEncryption configures the encryption of the container state that is stored in secrets.
+	// +optional
+	StateEncryption *StateEncryption `json:"stateEncryption,omitempty"`
+
+	// AllowedServiceAccounts are the names of the service accounts in the namespace of the pods
+	// that may be used by the pods of deploy items.
+	// By default, deploy items may not use any service account.
+	// +optional
+	AllowedServiceAccounts []string `json:"allowedServiceAccounts,omitempty"`
 }
 
 // ContainerSpec defines a container specification
@@ -116,11 +131,29 @@ type DebugOptions struct {
 	KeepPod bool `json:"keepPod,omitempty"`
 }
 
+// FailureLogs configures the collection of the logs of failed pods.
+type FailureLogs struct {
+	// TailLines is the number of lines from the end of the logs of the main container
+	// that are written to the provider status of a failed deploy item.
+	// Defaults to 50.
+	// +optional
+	TailLines int64 `json:"tailLines,omitempty"`
+}
+
 // HPAConfiguration contains the configuration for horizontal pod autoscaling.
 type HPAConfiguration struct {
 	MaxReplicas int32 `json:"maxReplicas,omitempty"`
 }
 
+// StateEncryption configures the encryption of the container state.
+type StateEncryption struct {
+	// SecretName is the name of a secret in the namespace of the pods.
+	// The secret is mounted into the init and wait containers, which backup and restore the state.
+	// It has to contain the encryption configuration with the key "encryption.yaml" and the files that are referenced
+	// by the configuration, which are available in the directory /etc/landscaper/encryption.
+	SecretName string `json:"secretName"`
+}
+
 // Controller contains configuration concerning the controller framework.
 type Controller struct {
 	lsconfigv1alpha1.CommonControllerConfig
@@ -45,6 +45,13 @@ type ProviderConfiguration struct {
 	// More info: https://kubernetes.io/docs/tasks/inject-data-application/define-command-argument-container/#running-a-command-in-a-shell
 	// +optional
 	Args []string `json:"args,omitempty"`
+	// Resources are the compute resources of the container.
+	// More info: https://kubernetes.io/docs/concepts/configuration/manage-resources-containers/
+	// +optional
+	Resources *corev1.ResourceRequirements `json:"resources,omitempty"`
+	// Pod customizes the pod that executes the container.
+	// +optional
+	Pod *PodConfiguration `json:"pod,omitempty"`
 	// ImportValues contains the import values for the container.
 	// +optional
 	ImportValues json.RawMessage `json:"importValues,omitempty"`
@@ -65,6 +72,34 @@ type ProviderConfiguration struct {
 	ContinuousReconcile *cr.ContinuousReconcileSpec `json:"continuousReconcile,omitempty"`
 }
 
+// PodConfiguration customizes the pod that executes the container.
+type PodConfiguration struct {
+	// Labels are added to the labels of the pod.
+	// The labels that are set by the container deployer cannot be overwritten.
+	// +optional
+	Labels map[string]string `json:"labels,omitempty"`
+	// Annotations are added to the annotations of the pod.
+	// +optional
+	Annotations map[string]string `json:"annotations,omitempty"`
+	// NodeSelector is the node selector of the pod.
+	// The node selector of the scheduling defaults of the context is added for keys that are not set.
+	// +optional
+	NodeSelector map[string]string `json:"nodeSelector,omitempty"`
+	// Tolerations are the tolerations of the pod.
+	// The tolerations of the scheduling defaults of the context are added.
+	// +optional
+	Tolerations []corev1.Toleration `json:"tolerations,omitempty"`
+	// Affinity defines the scheduling constraints of the pod.
+	// +optional
+	Affinity *corev1.Affinity `json:"affinity,omitempty"`
+	// ServiceAccountName is the name of a service account in the namespace of the pods,
+	// whose token is mounted into the container.
+	// The service account has to be allowed in the configuration of the container deployer.
+	// By default, no service account token is mounted into the container.
+	// +optional
+	ServiceAccountName string `json:"serviceAccountName,omitempty"`
+}
+
 // +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
 // ProviderStatus is the container provider specific status
 type ProviderStatus struct {
@@ -114,4 +149,8 @@ type ContainerStatus struct {
 	// ExitCode of the main container.
 	// +optional
 	ExitCode *int32 `json:"exitCode,omitempty"`
+	// Logs contains the last lines of the logs of the main container.
+	// The logs are only collected if the pod failed and the collection is enabled in the deployer configuration.
+	// +optional
+	Logs string `json:"logs,omitempty"`
 }
@@ -22,6 +22,16 @@ func SetDefaults_Configuration(obj *Configuration) {
 		obj.DefaultImage.Image = "ubuntu:18.04"
 	}
 	SetDefaults_GarbageCollection(&obj.GarbageCollection)
+	if obj.FailureLogs != nil {
+		SetDefaults_FailureLogs(obj.FailureLogs)
+	}
+}
+
+// SetDefaults_FailureLogs sets the defaults for the collection of the logs of failed pods.
+func SetDefaults_FailureLogs(obj *FailureLogs) {
+	if obj.TailLines <= 0 {
+		obj.TailLines = 50
+	}
 }
 
 // SetDefaults_GarbageCollection sets the defaults for the container deployer configuration.
@@ -5,6 +5,8 @@
 package validation
 
 import (
+	apivalidation "k8s.io/apimachinery/pkg/api/validation"
+	metav1validation "k8s.io/apimachinery/pkg/apis/meta/v1/validation"
 	"k8s.io/apimachinery/pkg/util/validation/field"
 
 	"github.com/gardener/landscaper/apis/core"
@@ -26,5 +28,22 @@ func ValidateProviderConfiguration(config *containerv1alpha1.ProviderConfigurati
 	}
 
 	allErrs = append(allErrs, crval.ValidateContinuousReconcileSpec(field.NewPath("continuousReconcile"), config.ContinuousReconcile)...)
+	if config.Pod != nil {
+		allErrs = append(allErrs, validatePodConfiguration(config.Pod, field.NewPath("pod"))...)
+	}
 	return allErrs.ToAggregate()
 }
+
+// validatePodConfiguration validates the customization of the pod that executes the container.
+func validatePodConfiguration(pod *containerv1alpha1.PodConfiguration, fldPath *field.Path) field.ErrorList {
+	allErrs := metav1validation.ValidateLabels(pod.Labels, fldPath.Child("labels"))
+	allErrs = append(allErrs, apivalidation.ValidateAnnotations(pod.Annotations, fldPath.Child("annotations"))...)
+	allErrs = append(allErrs, metav1validation.ValidateLabels(pod.NodeSelector, fldPath.Child("nodeSelector"))...)
+	allErrs = append(allErrs, validation.ValidateTolerations(pod.Tolerations, fldPath.Child("tolerations"))...)
+	if len(pod.ServiceAccountName) != 0 {
+		for _, msg := range apivalidation.ValidateServiceAccountName(pod.ServiceAccountName, false) {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("serviceAccountName"), pod.ServiceAccountName, msg))
+		}
+	}
+	return allErrs
+}
@@ -80,6 +80,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*FailureLogs)(nil), (*container.FailureLogs)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_FailureLogs_To_container_FailureLogs(a.(*FailureLogs), b.(*container.FailureLogs), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*container.FailureLogs)(nil), (*FailureLogs)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_container_FailureLogs_To_v1alpha1_FailureLogs(a.(*container.FailureLogs), b.(*FailureLogs), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*GarbageCollection)(nil), (*container.GarbageCollection)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha1_GarbageCollection_To_container_GarbageCollection(a.(*GarbageCollection), b.(*container.GarbageCollection), scope)
 	}); err != nil {
@@ -100,6 +110,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*PodConfiguration)(nil), (*container.PodConfiguration)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_PodConfiguration_To_container_PodConfiguration(a.(*PodConfiguration), b.(*container.PodConfiguration), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*container.PodConfiguration)(nil), (*PodConfiguration)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_container_PodConfiguration_To_v1alpha1_PodConfiguration(a.(*container.PodConfiguration), b.(*PodConfiguration), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*PodStatus)(nil), (*container.PodStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha1_PodStatus_To_container_PodStatus(a.(*PodStatus), b.(*container.PodStatus), scope)
 	}); err != nil {
@@ -130,6 +150,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*StateEncryption)(nil), (*container.StateEncryption)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_StateEncryption_To_container_StateEncryption(a.(*StateEncryption), b.(*container.StateEncryption), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*container.StateEncryption)(nil), (*StateEncryption)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_container_StateEncryption_To_v1alpha1_StateEncryption(a.(*container.StateEncryption), b.(*StateEncryption), scope)
+	}); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -151,11 +181,14 @@ func autoConvert_v1alpha1_Configuration_To_container_Configuration(in *Configura
 		return err
 	}
 	out.DebugOptions = (*container.DebugOptions)(unsafe.Pointer(in.DebugOptions))
+	out.FailureLogs = (*container.FailureLogs)(unsafe.Pointer(in.FailureLogs))
 	out.HPAConfiguration = (*container.HPAConfiguration)(unsafe.Pointer(in.HPAConfiguration))
 	if err := Convert_v1alpha1_Controller_To_container_Controller(&in.Controller, &out.Controller, s); err != nil {
 		return err
 	}
 	out.UseOCMLib = in.UseOCMLib
+	out.StateEncryption = (*container.StateEncryption)(unsafe.Pointer(in.StateEncryption))
+	out.AllowedServiceAccounts = *(*[]string)(unsafe.Pointer(&in.AllowedServiceAccounts))
 	return nil
 }
 
@@ -182,11 +215,14 @@ func autoConvert_container_Configuration_To_v1alpha1_Configuration(in *container
 		return err
 	}
 	out.DebugOptions = (*DebugOptions)(unsafe.Pointer(in.DebugOptions))
+	out.FailureLogs = (*FailureLogs)(unsafe.Pointer(in.FailureLogs))
 	out.HPAConfiguration = (*HPAConfiguration)(unsafe.Pointer(in.HPAConfiguration))
 	if err := Convert_container_Controller_To_v1alpha1_Controller(&in.Controller, &out.Controller, s); err != nil {
 		return err
 	}
 	out.UseOCMLib = in.UseOCMLib
+	out.StateEncryption = (*StateEncryption)(unsafe.Pointer(in.StateEncryption))
+	out.AllowedServiceAccounts = *(*[]string)(unsafe.Pointer(&in.AllowedServiceAccounts))
 	return nil
 }
 
@@ -228,6 +264,7 @@ func autoConvert_v1alpha1_ContainerStatus_To_container_ContainerStatus(in *Conta
 	out.Image = in.Image
 	out.ImageID = in.ImageID
 	out.ExitCode = (*int32)(unsafe.Pointer(in.ExitCode))
+	out.Logs = in.Logs
 	return nil
 }
 
@@ -243,6 +280,7 @@ func autoConvert_container_ContainerStatus_To_v1alpha1_ContainerStatus(in *conta
 	out.Image = in.Image
 	out.ImageID = in.ImageID
 	out.ExitCode = (*int32)(unsafe.Pointer(in.ExitCode))
+	out.Logs = in.Logs
 	return nil
 }
 
@@ -291,6 +329,26 @@ func Convert_container_DebugOptions_To_v1alpha1_DebugOptions(in *container.Debug
 	return autoConvert_container_DebugOptions_To_v1alpha1_DebugOptions(in, out, s)
 }
 
+func autoConvert_v1alpha1_FailureLogs_To_container_FailureLogs(in *FailureLogs, out *container.FailureLogs, s conversion.Scope) error {
+	out.TailLines = in.TailLines
+	return nil
+}
+
+// Convert_v1alpha1_FailureLogs_To_container_FailureLogs is an autogenerated conversion function.
+func Convert_v1alpha1_FailureLogs_To_container_FailureLogs(in *FailureLogs, out *container.FailureLogs, s conversion.Scope) error {
+	return autoConvert_v1alpha1_FailureLogs_To_container_FailureLogs(in, out, s)
+}
+
+func autoConvert_container_FailureLogs_To_v1alpha1_FailureLogs(in *container.FailureLogs, out *FailureLogs, s conversion.Scope) error {
+	out.TailLines = in.TailLines
+	return nil
+}
+
+// Convert_container_FailureLogs_To_v1alpha1_FailureLogs is an autogenerated conversion function.
+func Convert_container_FailureLogs_To_v1alpha1_FailureLogs(in *container.FailureLogs, out *FailureLogs, s conversion.Scope) error {
+	return autoConvert_container_FailureLogs_To_v1alpha1_FailureLogs(in, out, s)
+}
+
 func autoConvert_v1alpha1_GarbageCollection_To_container_GarbageCollection(in *GarbageCollection, out *container.GarbageCollection, s conversion.Scope) error {
 	out.Disable = in.Disable
 	out.Worker = in.Worker
@@ -335,6 +393,36 @@ func Convert_container_HPAConfiguration_To_v1alpha1_HPAConfiguration(in *contain
 	return autoConvert_container_HPAConfiguration_To_v1alpha1_HPAConfiguration(in, out, s)
 }
 
+func autoConvert_v1alpha1_PodConfiguration_To_container_PodConfiguration(in *PodConfiguration, out *container.PodConfiguration, s conversion.Scope) error {
+	out.Labels = *(*map[string]string)(unsafe.Pointer(&in.Labels))
+	out.Annotations = *(*map[string]string)(unsafe.Pointer(&in.Annotations))
+	out.NodeSelector = *(*map[string]string)(unsafe.Pointer(&in.NodeSelector))
+	out.Tolerations = *(*[]v1.Toleration)(unsafe.Pointer(&in.Tolerations))
+	out.Affinity = (*v1.Affinity)(unsafe.Pointer(in.Affinity))
+	out.ServiceAccountName = in.ServiceAccountName
+	return nil
+}
+
+// Convert_v1alpha1_PodConfiguration_To_container_PodConfiguration is an autogenerated conversion function.
+func Convert_v1alpha1_PodConfiguration_To_container_PodConfiguration(in *PodConfiguration, out *container.PodConfiguration, s conversion.Scope) error {
+	return autoConvert_v1alpha1_PodConfiguration_To_container_PodConfiguration(in, out, s)
+}
+
+func autoConvert_container_PodConfiguration_To_v1alpha1_PodConfiguration(in *container.PodConfiguration, out *PodConfiguration, s conversion.Scope) error {
+	out.Labels = *(*map[string]string)(unsafe.Pointer(&in.Labels))
+	out.Annotations = *(*map[string]string)(unsafe.Pointer(&in.Annotations))
+	out.NodeSelector = *(*map[string]string)(unsafe.Pointer(&in.NodeSelector))
+	out.Tolerations = *(*[]v1.Toleration)(unsafe.Pointer(&in.Tolerations))
+	out.Affinity = (*v1.Affinity)(unsafe.Pointer(in.Affinity))
+	out.ServiceAccountName = in.ServiceAccountName
+	return nil
+}
+
+// Convert_container_PodConfiguration_To_v1alpha1_PodConfiguration is an autogenerated conversion function.
+func Convert_container_PodConfiguration_To_v1alpha1_PodConfiguration(in *container.PodConfiguration, out *PodConfiguration, s conversion.Scope) error {
+	return autoConvert_container_PodConfiguration_To_v1alpha1_PodConfiguration(in, out, s)
+}
+
 func autoConvert_v1alpha1_PodStatus_To_container_PodStatus(in *PodStatus, out *container.PodStatus, s conversion.Scope) error {
 	out.PodName = in.PodName
 	out.LastRun = (*metav1.Time)(unsafe.Pointer(in.LastRun))
@@ -381,6 +469,8 @@ func autoConvert_v1alpha1_ProviderConfiguration_To_container_ProviderConfigurati
 	out.Image = in.Image
 	out.Command = *(*[]string)(unsafe.Pointer(&in.Command))
 	out.Args = *(*[]string)(unsafe.Pointer(&in.Args))
+	out.Resources = (*v1.ResourceRequirements)(unsafe.Pointer(in.Resources))
+	out.Pod = (*container.PodConfiguration)(unsafe.Pointer(in.Pod))
 	out.ImportValues = *(*json.RawMessage)(unsafe.Pointer(&in.ImportValues))
 	out.Blueprint = (*corev1alpha1.BlueprintDefinition)(unsafe.Pointer(in.Blueprint))
 	out.ComponentDescriptor = (*corev1alpha1.ComponentDescriptorDefinition)(unsafe.Pointer(in.ComponentDescriptor))
@@ -398,6 +488,8 @@ func autoConvert_container_ProviderConfiguration_To_v1alpha1_ProviderConfigurati
 	out.Image = in.Image
 	out.Command = *(*[]string)(unsafe.Pointer(&in.Command))
 	out.Args = *(*[]string)(unsafe.Pointer(&in.Args))
+	out.Resources = (*v1.ResourceRequirements)(unsafe.Pointer(in.Resources))
+	out.Pod = (*PodConfiguration)(unsafe.Pointer(in.Pod))
 	out.ImportValues = *(*json.RawMessage)(unsafe.Pointer(&in.ImportValues))
 	out.Blueprint = (*corev1alpha1.BlueprintDefinition)(unsafe.Pointer(in.Blueprint))
 	out.ComponentDescriptor = (*corev1alpha1.ComponentDescriptorDefinition)(unsafe.Pointer(in.ComponentDescriptor))
@@ -432,3 +524,23 @@ func autoConvert_container_ProviderStatus_To_v1alpha1_ProviderStatus(in *contain
 func Convert_container_ProviderStatus_To_v1alpha1_ProviderStatus(in *container.ProviderStatus, out *ProviderStatus, s conversion.Scope) error {
 	return autoConvert_container_ProviderStatus_To_v1alpha1_ProviderStatus(in, out, s)
 }
+
+func autoConvert_v1alpha1_StateEncryption_To_container_StateEncryption(in *StateEncryption, out *container.StateEncryption, s conversion.Scope) error {
+	out.SecretName = in.SecretName
+	return nil
+}
+
+// Convert_v1alpha1_StateEncryption_To_container_StateEncryption is an autogenerated conversion function.
+func Convert_v1alpha1_StateEncryption_To_container_StateEncryption(in *StateEncryption, out *container.StateEncryption, s conversion.Scope) error {
+	return autoConvert_v1alpha1_StateEncryption_To_container_StateEncryption(in, out, s)
+}
+
+func autoConvert_container_StateEncryption_To_v1alpha1_StateEncryption(in *container.StateEncryption, out *StateEncryption, s conversion.Scope) error {
+	out.SecretName = in.SecretName
+	return nil
+}
+
+// Convert_container_StateEncryption_To_v1alpha1_StateEncryption is an autogenerated conversion function.
+func Convert_container_StateEncryption_To_v1alpha1_StateEncryption(in *container.StateEncryption, out *StateEncryption, s conversion.Scope) error {
+	return autoConvert_container_StateEncryption_To_v1alpha1_StateEncryption(in, out, s)
+}
@@ -24,6 +24,9 @@ func RegisterDefaults(scheme *runtime.Scheme) error {
 
 func SetObjectDefaults_Configuration(in *Configuration) {
 	SetDefaults_Configuration(in)
+	if in.FailureLogs != nil {
+		SetDefaults_FailureLogs(in.FailureLogs)
+	}
 	SetDefaults_GarbageCollection(&in.GarbageCollection)
 	v1alpha1.SetDefaults_CommonControllerConfig(&in.Controller.CommonControllerConfig)
 }
@@ -31,8 +31,15 @@ type Configuration struct {
 	HPAConfiguration *HPAConfiguration `json:"hpa,omitempty"`
 	// Controller contains configuration concerning the controller framework.
 	Controller Controller `json:"controller,omitempty"`
+	// TargetClient configures the clients that are used to access the target clusters.
+	// +optional
+	TargetClient *lsconfigv1alpha1.TargetClientConfig `json:"targetClient,omitempty"`
 	// +optional
 	UseOCMLib bool `json:"useOCMLib,omitempty"`
+	// HelmChartRepoCredentials contains the credentials to access helm chart repositories.
+	// They are used in addition to the credentials that are configured in the context of a deploy item.
+	// +optional
+	HelmChartRepoCredentials *HelmChartRepoCredentials `json:"helmChartRepoCredentials,omitempty"`
 }
 
 // ExportConfiguration defines the export configuration for the deployer.
@@ -56,6 +56,12 @@ type ProviderConfiguration struct {
 	// Values are the values that are used for templating.
 	Values json.RawMessage `json:"values,omitempty"`
 
+	// ValuesFrom defines sources of values that are not inlined into the deploy item, e.g. credentials stored in secrets.
+	// The values of the sources are merged in the given order, values of later sources override values of earlier sources.
+	// The inline Values are merged on top of them.
+	// +optional
+	ValuesFrom []ValuesFromSource `json:"valuesFrom,omitempty"`
+
 	// ExportsFromManifests describe the exports from the templated manifests that should be exported by the helm deployer.
 	// +optional
 	// DEPRECATED
@@ -85,6 +91,21 @@ type ProviderConfiguration struct {
 	// DeletionGroupsDuringUpdate defines the order in which objects are deleted during an update.
 	// +optional
 	DeletionGroupsDuringUpdate []managedresource.DeletionGroupDefinition `json:"deletionGroupsDuringUpdate,omitempty"`
+
+	// Tests configures the execution of the chart tests after an install or upgrade.
+	// If enabled, the deploy item only succeeds if all tests have succeeded.
+	// +optional
+	Tests *HelmTestConfiguration `json:"tests,omitempty"`
+
+	// PostRenderer configures modifications of the rendered manifests of the chart before they are applied.
+	// +optional
+	PostRenderer *PostRendererConfiguration `json:"postRenderer,omitempty"`
+
+	// ChartMetadataExportKey is the key under which the metadata of the deployed chart are exported,
+	// i.e. its name, version, appVersion, annotations and digest.
+	// Like the keys of the exports, it may define a path, e.g. "deployed.chart".
+	// +optional
+	ChartMetadataExportKey string `json:"chartMetadataExportKey,omitempty"`
 }
 
 // UpdateStrategy defines the strategy that is used to apply resources to the cluster.
@@ -93,6 +114,8 @@ type UpdateStrategy string
 const (
 	UpdateStrategyUpdate UpdateStrategy = "update"
 	UpdateStrategyPatch  UpdateStrategy = "patch"
+	// UpdateStrategyThreeWayMerge applies the manifests like a client-side "kubectl apply".
+	UpdateStrategyThreeWayMerge UpdateStrategy = "threeWayMerge"
 )
 
 // Chart defines the helm chart to render and apply.
@@ -115,6 +138,84 @@ type Chart struct {
 	// defined in the blueprint
 	// +optional
 	ResourceRef string `json:"resourceRef,omitempty"`
+	// Digest is the expected digest of the chart archive in the format "sha256:<hex>".
+	// The chart is not deployed if the digest of the pulled chart archive differs.
+	// +optional
+	Digest string `json:"digest,omitempty"`
+	// Provenance defines the provenance file of the chart.
+	// The chart is only deployed if the provenance file is signed by a trusted key
+	// and contains the digest of the pulled chart archive.
+	// +optional
+	Provenance *ChartProvenance `json:"provenance,omitempty"`
+}
+
+// ChartProvenance defines the provenance file of a chart and the keys that are trusted to sign it.
+type ChartProvenance struct {
+	// Raw contains the content of the provenance file (.prov) of the chart.
+	Raw string `json:"raw"`
+	// Keyring contains the public keys that are trusted to sign the provenance file,
+	// either as ASCII armored keyring or as base64 encoded binary keyring (e.g. the output of "gpg --export").
+	Keyring string `json:"keyring"`
+}
+
+// ValuesFromSource defines a source of values that are used for templating.
+// Exactly one of the fields SecretRef, ConfigMapRef, DataObjectRef and Raw has to be set.
+type ValuesFromSource struct {
+	// SecretRef references an entry of a secret in the namespace of the deploy item that contains the values as yaml or json.
+	// The key defaults to "values.yaml".
+	// +optional
+	SecretRef *lsv1alpha1.LocalSecretReference `json:"secretRef,omitempty"`
+	// ConfigMapRef references an entry of a configmap in the namespace of the deploy item that contains the values as yaml or json.
+	// The key defaults to "values.yaml".
+	// +optional
+	ConfigMapRef *lsv1alpha1.LocalConfigMapReference `json:"configMapRef,omitempty"`
+	// DataObjectRef is the name of a data object in the namespace of the deploy item whose data are used as values.
+	// +optional
+	DataObjectRef string `json:"dataObjectRef,omitempty"`
+	// Raw contains the values as yaml or json string, e.g. the content of a file of the blueprint.
+	// +optional
+	Raw string `json:"raw,omitempty"`
+	// Optional defines whether a missing secret, configmap, data object, or key is ignored.
+	// +optional
+	Optional bool `json:"optional,omitempty"`
+}
+
+// PostRendererConfiguration defines modifications of the rendered manifests of a chart,
+// e.g. to adapt third-party charts without forking them.
+type PostRendererConfiguration struct {
+	// Patches are applied in the given order to the rendered manifests.
+	// +optional
+	Patches []Patch `json:"patches,omitempty"`
+}
+
+// Patch defines a strategic merge patch or a JSON6902 patch of the rendered manifests, similar to the patches of Kustomize.
+type Patch struct {
+	// Patch is the strategic merge patch or the list of JSON6902 operations as yaml or json string.
+	Patch string `json:"patch"`
+	// Target selects the manifests the patch is applied to.
+	// It is required for JSON6902 patches. A strategic merge patch without target is applied to the manifest
+	// with the kind, name and namespace of the patch.
+	// +optional
+	Target *PatchTarget `json:"target,omitempty"`
+}
+
+// PatchTarget selects rendered manifests. Empty fields match all manifests.
+type PatchTarget struct {
+	// Group is the api group of the selected manifests.
+	// +optional
+	Group string `json:"group,omitempty"`
+	// Version is the api version of the selected manifests.
+	// +optional
+	Version string `json:"version,omitempty"`
+	// Kind is the kind of the selected manifests.
+	// +optional
+	Kind string `json:"kind,omitempty"`
+	// Name is the name of the selected manifests.
+	// +optional
+	Name string `json:"name,omitempty"`
+	// Namespace is the namespace of the selected manifests.
+	// +optional
+	Namespace string `json:"namespace,omitempty"`
 }
 
 // HelmChartRepo defines a reference to a chart in a helm chart repo
@@ -153,6 +254,7 @@ type HelmDeploymentConfiguration struct {
 	Install   map[string]lscore.AnyJSON `json:"install,omitempty"`
 	Upgrade   map[string]lscore.AnyJSON `json:"upgrade,omitempty"`
 	Uninstall map[string]lscore.AnyJSON `json:"uninstall,omitempty"`
+	Rollback  map[string]lscore.AnyJSON `json:"rollback,omitempty"`
 }
 
 // HelmInstallConfiguration defines settings for a helm install operation.
@@ -173,6 +275,43 @@ type HelmUninstallConfiguration struct {
 	Timeout *lsv1alpha1.Duration `json:"timeout,omitempty"`
 }
 
+// HelmTestConfiguration defines settings for the execution of the chart tests.
+// The test hooks of the chart are created one after another after the readiness check.
+// Running tests are checked with the following reconciliations until they are finished.
+// Without a real helm deployment the test hooks are not applied together with the other manifests.
+type HelmTestConfiguration struct {
+	// Enabled defines whether the tests of the chart are executed.
+	Enabled bool `json:"enabled"`
+	// Timeout is the timeout for the test execution.
+	// Defaults to 5 minutes.
+	// +optional
+	Timeout *lsv1alpha1.Duration `json:"timeout,omitempty"`
+}
+
+// HelmTestPhase describes the phase of a chart test.
+type HelmTestPhase string
+
+const (
+	HelmTestPhaseUnknown   HelmTestPhase = "Unknown"
+	HelmTestPhaseRunning   HelmTestPhase = "Running"
+	HelmTestPhaseSucceeded HelmTestPhase = "Succeeded"
+	HelmTestPhaseFailed    HelmTestPhase = "Failed"
+)
+
+// HelmTestResult describes the result of a chart test.
+type HelmTestResult struct {
+	// Name is the name of the test resource.
+	Name string `json:"name"`
+	// Kind is the kind of the test resource.
+	// +optional
+	Kind string `json:"kind,omitempty"`
+	// Phase is the phase of the test.
+	Phase HelmTestPhase `json:"phase"`
+	// Message contains additional information about the test result.
+	// +optional
+	Message string `json:"message,omitempty"`
+}
+
 // +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
 
 // ProviderStatus is the helm provider specific status
@@ -181,6 +320,42 @@ type ProviderStatus struct {
 
 	// ManagedResources contains all kubernetes resources that are deployed by the helm deployer.
 	ManagedResources managedresource.ManagedResourceStatusList `json:"managedResources,omitempty"`
+	// ApplyProgress describes the progress of applying the manifests.
+	// It is only set if the manifests are applied in batches.
+	// +optional
+	ApplyProgress *managedresource.ApplyProgress `json:"applyProgress,omitempty"`
+
+	// TestResults contains the results of the last execution of the chart tests.
+	// +optional
+	TestResults []HelmTestResult `json:"testResults,omitempty"`
+	// TestsJobID is the job ID of the deploy item for which the chart tests are running.
+	// It is only set as long as the tests are not finished.
+	// +optional
+	TestsJobID string `json:"testsJobID,omitempty"`
+	// TestsStartTime is the time when the running chart tests have been started.
+	// +optional
+	TestsStartTime *metav1.Time `json:"testsStartTime,omitempty"`
+
+	// ChartDigest is the digest of the chart archive that has been deployed last.
+	// +optional
+	ChartDigest string `json:"chartDigest,omitempty"`
+	// ChartMetadata contains the metadata of the chart that has been deployed last.
+	// +optional
+	ChartMetadata *ChartMetadata `json:"chartMetadata,omitempty"`
+}
+
+// ChartMetadata contains the metadata of a deployed chart.
+type ChartMetadata struct {
+	// Name is the name of the chart.
+	Name string `json:"name"`
+	// Version is the version of the chart.
+	Version string `json:"version"`
+	// AppVersion is the version of the application that is deployed by the chart.
+	// +optional
+	AppVersion string `json:"appVersion,omitempty"`
+	// Annotations are the annotations of the chart as defined in its Chart.yaml.
+	// +optional
+	Annotations map[string]string `json:"annotations,omitempty"`
 }
 
 // HelmChartRepoCredentials contains the credentials to access hepl chart repos
@@ -6,9 +6,11 @@ package validation
 
 import (
 	"fmt"
+	"regexp"
 
 	"k8s.io/apimachinery/pkg/util/sets"
 	"k8s.io/apimachinery/pkg/util/validation/field"
+	"sigs.k8s.io/yaml"
 
 	lsv1alpha1 "github.com/gardener/landscaper/apis/core/v1alpha1"
 	helmv1alpha1 "github.com/gardener/landscaper/apis/deployer/helm/v1alpha1"
@@ -22,14 +24,20 @@ const (
 	helmArgumentTimeout = "timeout"
 )
 
+var chartDigestRegexp = regexp.MustCompile(`^sha256:[a-f0-9]{64}$`)
+
 // ValidateProviderConfiguration validates a helm deployer configuration
 func ValidateProviderConfiguration(config *helmv1alpha1.ProviderConfiguration) error {
 	allErrs := field.ErrorList{}
 	allErrs = append(allErrs, health.ValidateReadinessCheckConfiguration(field.NewPath("readinessChecks"), &config.ReadinessChecks)...)
 	allErrs = append(allErrs, ValidateChart(field.NewPath("chart"), config.Chart)...)
 	allErrs = append(allErrs, ValidateHelmDeploymentConfiguration(field.NewPath("helmDeploymentConfig"), config.HelmDeploymentConfig)...)
+	allErrs = append(allErrs, ValidateTestConfiguration(field.NewPath("tests"), config.Tests)...)
+	allErrs = append(allErrs, ValidateValuesFrom(field.NewPath("valuesFrom"), config.ValuesFrom)...)
+	allErrs = append(allErrs, ValidatePostRenderer(field.NewPath("postRenderer"), config.PostRenderer)...)
 	allErrs = append(allErrs, crval.ValidateContinuousReconcileSpec(field.NewPath("continuousReconcile"), config.ContinuousReconcile)...)
 	allErrs = append(allErrs, validation.ValidateDeletionGroups(field.NewPath("deletionGroups"), config.DeletionGroups)...)
+	allErrs = append(allErrs, validation.ValidateExports(field.NewPath("exports"), config.Exports)...)
 
 	if len(config.Name) == 0 {
 		allErrs = append(allErrs, field.Required(field.NewPath("name"), "must not be empty"))
@@ -54,6 +62,7 @@ func ValidateProviderConfiguration(config *helmv1alpha1.ProviderConfiguration) e
 		if len(export.JSONPath) == 0 {
 			allErrs = append(allErrs, field.Required(indexFldPath.Child("jsonPath"), "must not be empty"))
 		}
+		allErrs = append(allErrs, validation.ValidateExportDecoding(indexFldPath.Child("decode"), export.Decode)...)
 
 		if export.FromResource != nil {
 			resFldPath := indexFldPath.Child("resource")
@@ -92,6 +101,25 @@ func ValidateChart(fldPath *field.Path, chart helmv1alpha1.Chart) field.ErrorLis
 		allErrs = append(allErrs, ValidateHelmChartRepo(fldPath.Child("helmChartRepo"), chart.HelmChartRepo)...)
 	}
 
+	if len(chart.Digest) != 0 && !chartDigestRegexp.MatchString(chart.Digest) {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("digest"), chart.Digest, "must have the format sha256:<hex>"))
+	}
+	if chart.Provenance != nil {
+		allErrs = append(allErrs, ValidateChartProvenance(fldPath.Child("provenance"), chart.Provenance)...)
+	}
+
+	return allErrs
+}
+
+// ValidateChartProvenance validates the provenance file and keyring of a chart.
+func ValidateChartProvenance(fldPath *field.Path, provenance *helmv1alpha1.ChartProvenance) field.ErrorList {
+	allErrs := field.ErrorList{}
+	if len(provenance.Raw) == 0 {
+		allErrs = append(allErrs, field.Required(fldPath.Child("raw"), "must not be empty"))
+	}
+	if len(provenance.Keyring) == 0 {
+		allErrs = append(allErrs, field.Required(fldPath.Child("keyring"), "must not be empty"))
+	}
 	return allErrs
 }
 
@@ -105,6 +133,86 @@ func ValidateHelmDeploymentConfiguration(fldPath *field.Path, deployConfig *helm
 	return allErrs
 }
 
+// ValidateTestConfiguration validates the configuration of the chart tests.
+func ValidateTestConfiguration(fldPath *field.Path, testConfig *helmv1alpha1.HelmTestConfiguration) field.ErrorList {
+	allErrs := field.ErrorList{}
+	if testConfig != nil && testConfig.Timeout != nil {
+		allErrs = append(allErrs, ValidateTimeout(fldPath.Child("timeout"), testConfig.Timeout)...)
+	}
+	return allErrs
+}
+
+// ValidateValuesFrom validates the sources of the values.
+func ValidateValuesFrom(fldPath *field.Path, valuesFrom []helmv1alpha1.ValuesFromSource) field.ErrorList {
+	allErrs := field.ErrorList{}
+	for i, source := range valuesFrom {
+		idxPath := fldPath.Index(i)
+		count := 0
+		if source.SecretRef != nil {
+			count++
+			if len(source.SecretRef.Name) == 0 {
+				allErrs = append(allErrs, field.Required(idxPath.Child("secretRef", "name"), "must not be empty"))
+			}
+		}
+		if source.ConfigMapRef != nil {
+			count++
+			if len(source.ConfigMapRef.Name) == 0 {
+				allErrs = append(allErrs, field.Required(idxPath.Child("configMapRef", "name"), "must not be empty"))
+			}
+		}
+		if len(source.DataObjectRef) != 0 {
+			count++
+		}
+		if len(source.Raw) != 0 {
+			count++
+		}
+		if count != 1 {
+			allErrs = append(allErrs, field.Invalid(idxPath, source, "exactly one of secretRef, configMapRef, dataObjectRef, and raw has to be set"))
+		}
+	}
+	return allErrs
+}
+
+// ValidatePostRenderer validates the patches of the post renderer.
+func ValidatePostRenderer(fldPath *field.Path, postRenderer *helmv1alpha1.PostRendererConfiguration) field.ErrorList {
+	allErrs := field.ErrorList{}
+	if postRenderer == nil {
+		return allErrs
+	}
+	for i, patch := range postRenderer.Patches {
+		idxPath := fldPath.Child("patches").Index(i)
+		if len(patch.Patch) == 0 {
+			allErrs = append(allErrs, field.Required(idxPath.Child("patch"), "must not be empty"))
+			continue
+		}
+
+		var content interface{}
+		if err := yaml.Unmarshal([]byte(patch.Patch), &content); err != nil {
+			allErrs = append(allErrs, field.Invalid(idxPath.Child("patch"), patch.Patch, fmt.Sprintf("unable to parse patch: %s", err.Error())))
+			continue
+		}
+		switch c := content.(type) {
+		case []interface{}:
+			// json6902 patches have no kind and name from which their target could be derived
+			if patch.Target == nil {
+				allErrs = append(allErrs, field.Required(idxPath.Child("target"), "must be set for JSON6902 patches"))
+			}
+		case map[string]interface{}:
+			if patch.Target == nil {
+				if kind, _ := c["kind"].(string); len(kind) == 0 {
+					allErrs = append(allErrs, field.Required(idxPath.Child("patch", "kind"), "must be set for a strategic merge patch without target"))
+				}
+				if metadata, _ := c["metadata"].(map[string]interface{}); metadata == nil || metadata["name"] == nil {
+					allErrs = append(allErrs, field.Required(idxPath.Child("patch", "metadata", "name"), "must be set for a strategic merge patch without target"))
+				}
+			}
+		default:
+			allErrs = append(allErrs, field.Invalid(idxPath.Child("patch"), patch.Patch, "must be a strategic merge patch or a list of JSON6902 operations"))
+		}
+	}
+	return allErrs
+}
+
 func ValidateInstallConfiguration(fldPath *field.Path, conf map[string]lsv1alpha1.AnyJSON) field.ErrorList {
 	return validateHelmArguments(fldPath, conf, []string{helmArgumentAtomic, helmArgumentTimeout})
 }
@@ -12,10 +12,12 @@ import (
 	json "encoding/json"
 	unsafe "unsafe"
 
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	conversion "k8s.io/apimachinery/pkg/conversion"
 	runtime "k8s.io/apimachinery/pkg/runtime"
 
 	config "github.com/gardener/landscaper/apis/config"
+	configv1alpha1 "github.com/gardener/landscaper/apis/config/v1alpha1"
 	core "github.com/gardener/landscaper/apis/core"
 	corev1alpha1 "github.com/gardener/landscaper/apis/core/v1alpha1"
 	helm "github.com/gardener/landscaper/apis/deployer/helm"
@@ -60,6 +62,26 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*ChartMetadata)(nil), (*helm.ChartMetadata)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_ChartMetadata_To_helm_ChartMetadata(a.(*ChartMetadata), b.(*helm.ChartMetadata), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*helm.ChartMetadata)(nil), (*ChartMetadata)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_helm_ChartMetadata_To_v1alpha1_ChartMetadata(a.(*helm.ChartMetadata), b.(*ChartMetadata), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*ChartProvenance)(nil), (*helm.ChartProvenance)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_ChartProvenance_To_helm_ChartProvenance(a.(*ChartProvenance), b.(*helm.ChartProvenance), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*helm.ChartProvenance)(nil), (*ChartProvenance)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_helm_ChartProvenance_To_v1alpha1_ChartProvenance(a.(*helm.ChartProvenance), b.(*ChartProvenance), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*Configuration)(nil), (*helm.Configuration)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha1_Configuration_To_helm_Configuration(a.(*Configuration), b.(*helm.Configuration), scope)
 	}); err != nil {
@@ -140,6 +162,26 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*HelmTestConfiguration)(nil), (*helm.HelmTestConfiguration)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_HelmTestConfiguration_To_helm_HelmTestConfiguration(a.(*HelmTestConfiguration), b.(*helm.HelmTestConfiguration), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*helm.HelmTestConfiguration)(nil), (*HelmTestConfiguration)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_helm_HelmTestConfiguration_To_v1alpha1_HelmTestConfiguration(a.(*helm.HelmTestConfiguration), b.(*HelmTestConfiguration), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*HelmTestResult)(nil), (*helm.HelmTestResult)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_HelmTestResult_To_helm_HelmTestResult(a.(*HelmTestResult), b.(*helm.HelmTestResult), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*helm.HelmTestResult)(nil), (*HelmTestResult)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_helm_HelmTestResult_To_v1alpha1_HelmTestResult(a.(*helm.HelmTestResult), b.(*HelmTestResult), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*HelmUninstallConfiguration)(nil), (*helm.HelmUninstallConfiguration)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha1_HelmUninstallConfiguration_To_helm_HelmUninstallConfiguration(a.(*HelmUninstallConfiguration), b.(*helm.HelmUninstallConfiguration), scope)
 	}); err != nil {
@@ -150,6 +192,36 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*Patch)(nil), (*helm.Patch)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_Patch_To_helm_Patch(a.(*Patch), b.(*helm.Patch), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*helm.Patch)(nil), (*Patch)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_helm_Patch_To_v1alpha1_Patch(a.(*helm.Patch), b.(*Patch), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*PatchTarget)(nil), (*helm.PatchTarget)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_PatchTarget_To_helm_PatchTarget(a.(*PatchTarget), b.(*helm.PatchTarget), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*helm.PatchTarget)(nil), (*PatchTarget)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_helm_PatchTarget_To_v1alpha1_PatchTarget(a.(*helm.PatchTarget), b.(*PatchTarget), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*PostRendererConfiguration)(nil), (*helm.PostRendererConfiguration)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_PostRendererConfiguration_To_helm_PostRendererConfiguration(a.(*PostRendererConfiguration), b.(*helm.PostRendererConfiguration), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*helm.PostRendererConfiguration)(nil), (*PostRendererConfiguration)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_helm_PostRendererConfiguration_To_v1alpha1_PostRendererConfiguration(a.(*helm.PostRendererConfiguration), b.(*PostRendererConfiguration), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*ProviderConfiguration)(nil), (*helm.ProviderConfiguration)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha1_ProviderConfiguration_To_helm_ProviderConfiguration(a.(*ProviderConfiguration), b.(*helm.ProviderConfiguration), scope)
 	}); err != nil {
@@ -190,6 +262,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*ValuesFromSource)(nil), (*helm.ValuesFromSource)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_ValuesFromSource_To_helm_ValuesFromSource(a.(*ValuesFromSource), b.(*helm.ValuesFromSource), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*helm.ValuesFromSource)(nil), (*ValuesFromSource)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_helm_ValuesFromSource_To_v1alpha1_ValuesFromSource(a.(*helm.ValuesFromSource), b.(*ValuesFromSource), scope)
+	}); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -247,6 +329,8 @@ func autoConvert_v1alpha1_Chart_To_helm_Chart(in *Chart, out *helm.Chart, s conv
 	out.Archive = (*helm.ArchiveAccess)(unsafe.Pointer(in.Archive))
 	out.HelmChartRepo = (*helm.HelmChartRepo)(unsafe.Pointer(in.HelmChartRepo))
 	out.ResourceRef = in.ResourceRef
+	out.Digest = in.Digest
+	out.Provenance = (*helm.ChartProvenance)(unsafe.Pointer(in.Provenance))
 	return nil
 }
 
@@ -261,6 +345,8 @@ func autoConvert_helm_Chart_To_v1alpha1_Chart(in *helm.Chart, out *Chart, s conv
 	out.Archive = (*ArchiveAccess)(unsafe.Pointer(in.Archive))
 	out.HelmChartRepo = (*HelmChartRepo)(unsafe.Pointer(in.HelmChartRepo))
 	out.ResourceRef = in.ResourceRef
+	out.Digest = in.Digest
+	out.Provenance = (*ChartProvenance)(unsafe.Pointer(in.Provenance))
 	return nil
 }
 
@@ -269,6 +355,54 @@ func Convert_helm_Chart_To_v1alpha1_Chart(in *helm.Chart, out *Chart, s conversi
 	return autoConvert_helm_Chart_To_v1alpha1_Chart(in, out, s)
 }
 
+func autoConvert_v1alpha1_ChartMetadata_To_helm_ChartMetadata(in *ChartMetadata, out *helm.ChartMetadata, s conversion.Scope) error {
+	out.Name = in.Name
+	out.Version = in.Version
+	out.AppVersion = in.AppVersion
+	out.Annotations = *(*map[string]string)(unsafe.Pointer(&in.Annotations))
+	return nil
+}
+
+// Convert_v1alpha1_ChartMetadata_To_helm_ChartMetadata is an autogenerated conversion function.
+func Convert_v1alpha1_ChartMetadata_To_helm_ChartMetadata(in *ChartMetadata, out *helm.ChartMetadata, s conversion.Scope) error {
+	return autoConvert_v1alpha1_ChartMetadata_To_helm_ChartMetadata(in, out, s)
+}
+
+func autoConvert_helm_ChartMetadata_To_v1alpha1_ChartMetadata(in *helm.ChartMetadata, out *ChartMetadata, s conversion.Scope) error {
+	out.Name = in.Name
+	out.Version = in.Version
+	out.AppVersion = in.AppVersion
+	out.Annotations = *(*map[string]string)(unsafe.Pointer(&in.Annotations))
+	return nil
+}
+
+// Convert_helm_ChartMetadata_To_v1alpha1_ChartMetadata is an autogenerated conversion function.
+func Convert_helm_ChartMetadata_To_v1alpha1_ChartMetadata(in *helm.ChartMetadata, out *ChartMetadata, s conversion.Scope) error {
+	return autoConvert_helm_ChartMetadata_To_v1alpha1_ChartMetadata(in, out, s)
+}
+
+func autoConvert_v1alpha1_ChartProvenance_To_helm_ChartProvenance(in *ChartProvenance, out *helm.ChartProvenance, s conversion.Scope) error {
+	out.Raw = in.Raw
+	out.Keyring = in.Keyring
+	return nil
+}
+
+// Convert_v1alpha1_ChartProvenance_To_helm_ChartProvenance is an autogenerated conversion function.
+func Convert_v1alpha1_ChartProvenance_To_helm_ChartProvenance(in *ChartProvenance, out *helm.ChartProvenance, s conversion.Scope) error {
+	return autoConvert_v1alpha1_ChartProvenance_To_helm_ChartProvenance(in, out, s)
+}
+
+func autoConvert_helm_ChartProvenance_To_v1alpha1_ChartProvenance(in *helm.ChartProvenance, out *ChartProvenance, s conversion.Scope) error {
+	out.Raw = in.Raw
+	out.Keyring = in.Keyring
+	return nil
+}
+
+// Convert_helm_ChartProvenance_To_v1alpha1_ChartProvenance is an autogenerated conversion function.
+func Convert_helm_ChartProvenance_To_v1alpha1_ChartProvenance(in *helm.ChartProvenance, out *ChartProvenance, s conversion.Scope) error {
+	return autoConvert_helm_ChartProvenance_To_v1alpha1_ChartProvenance(in, out, s)
+}
+
 func autoConvert_v1alpha1_Configuration_To_helm_Configuration(in *Configuration, out *helm.Configuration, s conversion.Scope) error {
 	out.Identity = in.Identity
 	out.OCI = (*config.OCIConfiguration)(unsafe.Pointer(in.OCI))
@@ -280,7 +414,9 @@ func autoConvert_v1alpha1_Configuration_To_helm_Configuration(in *Configuration,
 	if err := Convert_v1alpha1_Controller_To_helm_Controller(&in.Controller, &out.Controller, s); err != nil {
 		return err
 	}
+	out.TargetClient = (*configv1alpha1.TargetClientConfig)(unsafe.Pointer(in.TargetClient))
 	out.UseOCMLib = in.UseOCMLib
+	out.HelmChartRepoCredentials = (*helm.HelmChartRepoCredentials)(unsafe.Pointer(in.HelmChartRepoCredentials))
 	return nil
 }
 
@@ -300,7 +436,9 @@ func autoConvert_helm_Configuration_To_v1alpha1_Configuration(in *helm.Configura
 	if err := Convert_helm_Controller_To_v1alpha1_Controller(&in.Controller, &out.Controller, s); err != nil {
 		return err
 	}
+	out.TargetClient = (*configv1alpha1.TargetClientConfig)(unsafe.Pointer(in.TargetClient))
 	out.UseOCMLib = in.UseOCMLib
+	out.HelmChartRepoCredentials = (*HelmChartRepoCredentials)(unsafe.Pointer(in.HelmChartRepoCredentials))
 	return nil
 }
 
@@ -417,6 +555,7 @@ func autoConvert_v1alpha1_HelmDeploymentConfiguration_To_helm_HelmDeploymentConf
 	out.Install = *(*map[string]core.AnyJSON)(unsafe.Pointer(&in.Install))
 	out.Upgrade = *(*map[string]core.AnyJSON)(unsafe.Pointer(&in.Upgrade))
 	out.Uninstall = *(*map[string]core.AnyJSON)(unsafe.Pointer(&in.Uninstall))
+	out.Rollback = *(*map[string]core.AnyJSON)(unsafe.Pointer(&in.Rollback))
 	return nil
 }
 
@@ -429,6 +568,7 @@ func autoConvert_helm_HelmDeploymentConfiguration_To_v1alpha1_HelmDeploymentConf
 	out.Install = *(*map[string]corev1alpha1.AnyJSON)(unsafe.Pointer(&in.Install))
 	out.Upgrade = *(*map[string]corev1alpha1.AnyJSON)(unsafe.Pointer(&in.Upgrade))
 	out.Uninstall = *(*map[string]corev1alpha1.AnyJSON)(unsafe.Pointer(&in.Uninstall))
+	out.Rollback = *(*map[string]corev1alpha1.AnyJSON)(unsafe.Pointer(&in.Rollback))
 	return nil
 }
 
@@ -459,6 +599,54 @@ func Convert_helm_HelmInstallConfiguration_To_v1alpha1_HelmInstallConfiguration(
 	return autoConvert_helm_HelmInstallConfiguration_To_v1alpha1_HelmInstallConfiguration(in, out, s)
 }
 
+func autoConvert_v1alpha1_HelmTestConfiguration_To_helm_HelmTestConfiguration(in *HelmTestConfiguration, out *helm.HelmTestConfiguration, s conversion.Scope) error {
+	out.Enabled = in.Enabled
+	out.Timeout = (*corev1alpha1.Duration)(unsafe.Pointer(in.Timeout))
+	return nil
+}
+
+// Convert_v1alpha1_HelmTestConfiguration_To_helm_HelmTestConfiguration is an autogenerated conversion function.
+func Convert_v1alpha1_HelmTestConfiguration_To_helm_HelmTestConfiguration(in *HelmTestConfiguration, out *helm.HelmTestConfiguration, s conversion.Scope) error {
+	return autoConvert_v1alpha1_HelmTestConfiguration_To_helm_HelmTestConfiguration(in, out, s)
+}
+
+func autoConvert_helm_HelmTestConfiguration_To_v1alpha1_HelmTestConfiguration(in *helm.HelmTestConfiguration, out *HelmTestConfiguration, s conversion.Scope) error {
+	out.Enabled = in.Enabled
+	out.Timeout = (*corev1alpha1.Duration)(unsafe.Pointer(in.Timeout))
+	return nil
+}
+
+// Convert_helm_HelmTestConfiguration_To_v1alpha1_HelmTestConfiguration is an autogenerated conversion function.
+func Convert_helm_HelmTestConfiguration_To_v1alpha1_HelmTestConfiguration(in *helm.HelmTestConfiguration, out *HelmTestConfiguration, s conversion.Scope) error {
+	return autoConvert_helm_HelmTestConfiguration_To_v1alpha1_HelmTestConfiguration(in, out, s)
+}
+
+func autoConvert_v1alpha1_HelmTestResult_To_helm_HelmTestResult(in *HelmTestResult, out *helm.HelmTestResult, s conversion.Scope) error {
+	out.Name = in.Name
+	out.Kind = in.Kind
+	out.Phase = helm.HelmTestPhase(in.Phase)
+	out.Message = in.Message
+	return nil
+}
+
+// Convert_v1alpha1_HelmTestResult_To_helm_HelmTestResult is an autogenerated conversion function.
+func Convert_v1alpha1_HelmTestResult_To_helm_HelmTestResult(in *HelmTestResult, out *helm.HelmTestResult, s conversion.Scope) error {
+	return autoConvert_v1alpha1_HelmTestResult_To_helm_HelmTestResult(in, out, s)
+}
+
+func autoConvert_helm_HelmTestResult_To_v1alpha1_HelmTestResult(in *helm.HelmTestResult, out *HelmTestResult, s conversion.Scope) error {
+	out.Name = in.Name
+	out.Kind = in.Kind
+	out.Phase = HelmTestPhase(in.Phase)
+	out.Message = in.Message
+	return nil
+}
+
+// Convert_helm_HelmTestResult_To_v1alpha1_HelmTestResult is an autogenerated conversion function.
+func Convert_helm_HelmTestResult_To_v1alpha1_HelmTestResult(in *helm.HelmTestResult, out *HelmTestResult, s conversion.Scope) error {
+	return autoConvert_helm_HelmTestResult_To_v1alpha1_HelmTestResult(in, out, s)
+}
+
 func autoConvert_v1alpha1_HelmUninstallConfiguration_To_helm_HelmUninstallConfiguration(in *HelmUninstallConfiguration, out *helm.HelmUninstallConfiguration, s conversion.Scope) error {
 	out.Timeout = (*corev1alpha1.Duration)(unsafe.Pointer(in.Timeout))
 	return nil
@@ -479,6 +667,76 @@ func Convert_helm_HelmUninstallConfiguration_To_v1alpha1_HelmUninstallConfigurat
 	return autoConvert_helm_HelmUninstallConfiguration_To_v1alpha1_HelmUninstallConfiguration(in, out, s)
 }
 
+func autoConvert_v1alpha1_Patch_To_helm_Patch(in *Patch, out *helm.Patch, s conversion.Scope) error {
+	out.Patch = in.Patch
+	out.Target = (*helm.PatchTarget)(unsafe.Pointer(in.Target))
+	return nil
+}
+
+// Convert_v1alpha1_Patch_To_helm_Patch is an autogenerated conversion function.
+func Convert_v1alpha1_Patch_To_helm_Patch(in *Patch, out *helm.Patch, s conversion.Scope) error {
+	return autoConvert_v1alpha1_Patch_To_helm_Patch(in, out, s)
+}
+
+func autoConvert_helm_Patch_To_v1alpha1_Patch(in *helm.Patch, out *Patch, s conversion.Scope) error {
+	out.Patch = in.Patch
+	out.Target = (*PatchTarget)(unsafe.Pointer(in.Target))
+	return nil
+}
+
+// Convert_helm_Patch_To_v1alpha1_Patch is an autogenerated conversion function.
+func Convert_helm_Patch_To_v1alpha1_Patch(in *helm.Patch, out *Patch, s conversion.Scope) error {
+	return autoConvert_helm_Patch_To_v1alpha1_Patch(in, out, s)
+}
+
+func autoConvert_v1alpha1_PatchTarget_To_helm_PatchTarget(in *PatchTarget, out *helm.PatchTarget, s conversion.Scope) error {
+	out.Group = in.Group
+	out.Version = in.Version
+	out.Kind = in.Kind
+	out.Name = in.Name
+	out.Namespace = in.Namespace
+	return nil
+}
+
+// Convert_v1alpha1_PatchTarget_To_helm_PatchTarget is an autogenerated conversion function.
+func Convert_v1alpha1_PatchTarget_To_helm_PatchTarget(in *PatchTarget, out *helm.PatchTarget, s conversion.Scope) error {
+	return autoConvert_v1alpha1_PatchTarget_To_helm_PatchTarget(in, out, s)
+}
+
+func autoConvert_helm_PatchTarget_To_v1alpha1_PatchTarget(in *helm.PatchTarget, out *PatchTarget, s conversion.Scope) error {
+	out.Group = in.Group
+	out.Version = in.Version
+	out.Kind = in.Kind
+	out.Name = in.Name
+	out.Namespace = in.Namespace
+	return nil
+}
+
+// Convert_helm_PatchTarget_To_v1alpha1_PatchTarget is an autogenerated conversion function.
+func Convert_helm_PatchTarget_To_v1alpha1_PatchTarget(in *helm.PatchTarget, out *PatchTarget, s conversion.Scope) error {
+	return autoConvert_helm_PatchTarget_To_v1alpha1_PatchTarget(in, out, s)
+}
+
+func autoConvert_v1alpha1_PostRendererConfiguration_To_helm_PostRendererConfiguration(in *PostRendererConfiguration, out *helm.PostRendererConfiguration, s conversion.Scope) error {
+	out.Patches = *(*[]helm.Patch)(unsafe.Pointer(&in.Patches))
+	return nil
+}
+
+// Convert_v1alpha1_PostRendererConfiguration_To_helm_PostRendererConfiguration is an autogenerated conversion function.
+func Convert_v1alpha1_PostRendererConfiguration_To_helm_PostRendererConfiguration(in *PostRendererConfiguration, out *helm.PostRendererConfiguration, s conversion.Scope) error {
+	return autoConvert_v1alpha1_PostRendererConfiguration_To_helm_PostRendererConfiguration(in, out, s)
+}
+
+func autoConvert_helm_PostRendererConfiguration_To_v1alpha1_PostRendererConfiguration(in *helm.PostRendererConfiguration, out *PostRendererConfiguration, s conversion.Scope) error {
+	out.Patches = *(*[]Patch)(unsafe.Pointer(&in.Patches))
+	return nil
+}
+
+// Convert_helm_PostRendererConfiguration_To_v1alpha1_PostRendererConfiguration is an autogenerated conversion function.
+func Convert_helm_PostRendererConfiguration_To_v1alpha1_PostRendererConfiguration(in *helm.PostRendererConfiguration, out *PostRendererConfiguration, s conversion.Scope) error {
+	return autoConvert_helm_PostRendererConfiguration_To_v1alpha1_PostRendererConfiguration(in, out, s)
+}
+
 func autoConvert_v1alpha1_ProviderConfiguration_To_helm_ProviderConfiguration(in *ProviderConfiguration, out *helm.ProviderConfiguration, s conversion.Scope) error {
 	out.Kubeconfig = in.Kubeconfig
 	out.UpdateStrategy = helm.UpdateStrategy(in.UpdateStrategy)
@@ -490,6 +748,7 @@ func autoConvert_v1alpha1_ProviderConfiguration_To_helm_ProviderConfiguration(in
 	out.Namespace = in.Namespace
 	out.CreateNamespace = in.CreateNamespace
 	out.Values = *(*json.RawMessage)(unsafe.Pointer(&in.Values))
+	out.ValuesFrom = *(*[]helm.ValuesFromSource)(unsafe.Pointer(&in.ValuesFrom))
 	out.ExportsFromManifests = *(*[]managedresource.Export)(unsafe.Pointer(&in.ExportsFromManifests))
 	out.Exports = (*managedresource.Exports)(unsafe.Pointer(in.Exports))
 	out.ContinuousReconcile = (*continuousreconcile.ContinuousReconcileSpec)(unsafe.Pointer(in.ContinuousReconcile))
@@ -497,6 +756,9 @@ func autoConvert_v1alpha1_ProviderConfiguration_To_helm_ProviderConfiguration(in
 	out.HelmDeploymentConfig = (*helm.HelmDeploymentConfiguration)(unsafe.Pointer(in.HelmDeploymentConfig))
 	out.DeletionGroups = *(*[]managedresource.DeletionGroupDefinition)(unsafe.Pointer(&in.DeletionGroups))
 	out.DeletionGroupsDuringUpdate = *(*[]managedresource.DeletionGroupDefinition)(unsafe.Pointer(&in.DeletionGroupsDuringUpdate))
+	out.Tests = (*helm.HelmTestConfiguration)(unsafe.Pointer(in.Tests))
+	out.PostRenderer = (*helm.PostRendererConfiguration)(unsafe.Pointer(in.PostRenderer))
+	out.ChartMetadataExportKey = in.ChartMetadataExportKey
 	return nil
 }
 
@@ -516,6 +778,7 @@ func autoConvert_helm_ProviderConfiguration_To_v1alpha1_ProviderConfiguration(in
 	out.Namespace = in.Namespace
 	out.CreateNamespace = in.CreateNamespace
 	out.Values = *(*json.RawMessage)(unsafe.Pointer(&in.Values))
+	out.ValuesFrom = *(*[]ValuesFromSource)(unsafe.Pointer(&in.ValuesFrom))
 	out.ExportsFromManifests = *(*[]managedresource.Export)(unsafe.Pointer(&in.ExportsFromManifests))
 	out.Exports = (*managedresource.Exports)(unsafe.Pointer(in.Exports))
 	out.ContinuousReconcile = (*continuousreconcile.ContinuousReconcileSpec)(unsafe.Pointer(in.ContinuousReconcile))
@@ -523,6 +786,9 @@ func autoConvert_helm_ProviderConfiguration_To_v1alpha1_ProviderConfiguration(in
 	out.HelmDeploymentConfig = (*HelmDeploymentConfiguration)(unsafe.Pointer(in.HelmDeploymentConfig))
 	out.DeletionGroups = *(*[]managedresource.DeletionGroupDefinition)(unsafe.Pointer(&in.DeletionGroups))
 	out.DeletionGroupsDuringUpdate = *(*[]managedresource.DeletionGroupDefinition)(unsafe.Pointer(&in.DeletionGroupsDuringUpdate))
+	out.Tests = (*HelmTestConfiguration)(unsafe.Pointer(in.Tests))
+	out.PostRenderer = (*PostRendererConfiguration)(unsafe.Pointer(in.PostRenderer))
+	out.ChartMetadataExportKey = in.ChartMetadataExportKey
 	return nil
 }
 
@@ -533,6 +799,12 @@ func Convert_helm_ProviderConfiguration_To_v1alpha1_ProviderConfiguration(in *he
 
 func autoConvert_v1alpha1_ProviderStatus_To_helm_ProviderStatus(in *ProviderStatus, out *helm.ProviderStatus, s conversion.Scope) error {
 	out.ManagedResources = *(*managedresource.ManagedResourceStatusList)(unsafe.Pointer(&in.ManagedResources))
+	out.ApplyProgress = (*managedresource.ApplyProgress)(unsafe.Pointer(in.ApplyProgress))
+	out.TestResults = *(*[]helm.HelmTestResult)(unsafe.Pointer(&in.TestResults))
+	out.TestsJobID = in.TestsJobID
+	out.TestsStartTime = (*v1.Time)(unsafe.Pointer(in.TestsStartTime))
+	out.ChartDigest = in.ChartDigest
+	out.ChartMetadata = (*helm.ChartMetadata)(unsafe.Pointer(in.ChartMetadata))
 	return nil
 }
 
@@ -543,6 +815,12 @@ func Convert_v1alpha1_ProviderStatus_To_helm_ProviderStatus(in *ProviderStatus,
 
 func autoConvert_helm_ProviderStatus_To_v1alpha1_ProviderStatus(in *helm.ProviderStatus, out *ProviderStatus, s conversion.Scope) error {
 	out.ManagedResources = *(*managedresource.ManagedResourceStatusList)(unsafe.Pointer(&in.ManagedResources))
+	out.ApplyProgress = (*managedresource.ApplyProgress)(unsafe.Pointer(in.ApplyProgress))
+	out.TestResults = *(*[]HelmTestResult)(unsafe.Pointer(&in.TestResults))
+	out.TestsJobID = in.TestsJobID
+	out.TestsStartTime = (*v1.Time)(unsafe.Pointer(in.TestsStartTime))
+	out.ChartDigest = in.ChartDigest
+	out.ChartMetadata = (*ChartMetadata)(unsafe.Pointer(in.ChartMetadata))
 	return nil
 }
 
@@ -592,3 +870,31 @@ func autoConvert_helm_RemoteChartReference_To_v1alpha1_RemoteChartReference(in *
 func Convert_helm_RemoteChartReference_To_v1alpha1_RemoteChartReference(in *helm.RemoteChartReference, out *RemoteChartReference, s conversion.Scope) error {
 	return autoConvert_helm_RemoteChartReference_To_v1alpha1_RemoteChartReference(in, out, s)
 }
+
+func autoConvert_v1alpha1_ValuesFromSource_To_helm_ValuesFromSource(in *ValuesFromSource, out *helm.ValuesFromSource, s conversion.Scope) error {
+	out.SecretRef = (*corev1alpha1.LocalSecretReference)(unsafe.Pointer(in.SecretRef))
+	out.ConfigMapRef = (*corev1alpha1.LocalConfigMapReference)(unsafe.Pointer(in.ConfigMapRef))
+	out.DataObjectRef = in.DataObjectRef
+	out.Raw = in.Raw
+	out.Optional = in.Optional
+	return nil
+}
+
+// Convert_v1alpha1_ValuesFromSource_To_helm_ValuesFromSource is an autogenerated conversion function.
+func Convert_v1alpha1_ValuesFromSource_To_helm_ValuesFromSource(in *ValuesFromSource, out *helm.ValuesFromSource, s conversion.Scope) error {
+	return autoConvert_v1alpha1_ValuesFromSource_To_helm_ValuesFromSource(in, out, s)
+}
+
+func autoConvert_helm_ValuesFromSource_To_v1alpha1_ValuesFromSource(in *helm.ValuesFromSource, out *ValuesFromSource, s conversion.Scope) error {
+	out.SecretRef = (*corev1alpha1.LocalSecretReference)(unsafe.Pointer(in.SecretRef))
+	out.ConfigMapRef = (*corev1alpha1.LocalConfigMapReference)(unsafe.Pointer(in.ConfigMapRef))
+	out.DataObjectRef = in.DataObjectRef
+	out.Raw = in.Raw
+	out.Optional = in.Optional
+	return nil
+}
+
+// Convert_helm_ValuesFromSource_To_v1alpha1_ValuesFromSource is an autogenerated conversion function.
+func Convert_helm_ValuesFromSource_To_v1alpha1_ValuesFromSource(in *helm.ValuesFromSource, out *ValuesFromSource, s conversion.Scope) error {
+	return autoConvert_helm_ValuesFromSource_To_v1alpha1_ValuesFromSource(in, out, s)
+}
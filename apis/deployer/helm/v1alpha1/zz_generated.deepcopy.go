@@ -14,6 +14,7 @@ import (
 	runtime "k8s.io/apimachinery/pkg/runtime"
 
 	config "github.com/gardener/landscaper/apis/config"
+	configv1alpha1 "github.com/gardener/landscaper/apis/config/v1alpha1"
 	corev1alpha1 "github.com/gardener/landscaper/apis/core/v1alpha1"
 	continuousreconcile "github.com/gardener/landscaper/apis/deployer/utils/continuousreconcile"
 	managedresource "github.com/gardener/landscaper/apis/deployer/utils/managedresource"
@@ -79,6 +80,11 @@ func (in *Chart) DeepCopyInto(out *Chart) {
 		*out = new(HelmChartRepo)
 		**out = **in
 	}
+	if in.Provenance != nil {
+		in, out := &in.Provenance, &out.Provenance
+		*out = new(ChartProvenance)
+		**out = **in
+	}
 	return
 }
 
@@ -92,6 +98,45 @@ func (in *Chart) DeepCopy() *Chart {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ChartMetadata) DeepCopyInto(out *ChartMetadata) {
+	*out = *in
+	if in.Annotations != nil {
+		in, out := &in.Annotations, &out.Annotations
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ChartMetadata.
+func (in *ChartMetadata) DeepCopy() *ChartMetadata {
+	if in == nil {
+		return nil
+	}
+	out := new(ChartMetadata)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ChartProvenance) DeepCopyInto(out *ChartProvenance) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ChartProvenance.
+func (in *ChartProvenance) DeepCopy() *ChartProvenance {
+	if in == nil {
+		return nil
+	}
+	out := new(ChartProvenance)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Configuration) DeepCopyInto(out *Configuration) {
 	*out = *in
@@ -115,6 +160,16 @@ func (in *Configuration) DeepCopyInto(out *Configuration) {
 		**out = **in
 	}
 	in.Controller.DeepCopyInto(&out.Controller)
+	if in.TargetClient != nil {
+		in, out := &in.TargetClient, &out.TargetClient
+		*out = new(configv1alpha1.TargetClientConfig)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.HelmChartRepoCredentials != nil {
+		in, out := &in.HelmChartRepoCredentials, &out.HelmChartRepoCredentials
+		*out = new(HelmChartRepoCredentials)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -253,6 +308,13 @@ func (in *HelmDeploymentConfiguration) DeepCopyInto(out *HelmDeploymentConfigura
 			(*out)[key] = *val.DeepCopy()
 		}
 	}
+	if in.Rollback != nil {
+		in, out := &in.Rollback, &out.Rollback
+		*out = make(map[string]corev1alpha1.AnyJSON, len(*in))
+		for key, val := range *in {
+			(*out)[key] = *val.DeepCopy()
+		}
+	}
 	return
 }
 
@@ -287,6 +349,43 @@ func (in *HelmInstallConfiguration) DeepCopy() *HelmInstallConfiguration {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *HelmTestConfiguration) DeepCopyInto(out *HelmTestConfiguration) {
+	*out = *in
+	if in.Timeout != nil {
+		in, out := &in.Timeout, &out.Timeout
+		*out = new(corev1alpha1.Duration)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HelmTestConfiguration.
+func (in *HelmTestConfiguration) DeepCopy() *HelmTestConfiguration {
+	if in == nil {
+		return nil
+	}
+	out := new(HelmTestConfiguration)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *HelmTestResult) DeepCopyInto(out *HelmTestResult) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HelmTestResult.
+func (in *HelmTestResult) DeepCopy() *HelmTestResult {
+	if in == nil {
+		return nil
+	}
+	out := new(HelmTestResult)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *HelmUninstallConfiguration) DeepCopyInto(out *HelmUninstallConfiguration) {
 	*out = *in
@@ -308,6 +407,66 @@ func (in *HelmUninstallConfiguration) DeepCopy() *HelmUninstallConfiguration {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Patch) DeepCopyInto(out *Patch) {
+	*out = *in
+	if in.Target != nil {
+		in, out := &in.Target, &out.Target
+		*out = new(PatchTarget)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Patch.
+func (in *Patch) DeepCopy() *Patch {
+	if in == nil {
+		return nil
+	}
+	out := new(Patch)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PatchTarget) DeepCopyInto(out *PatchTarget) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PatchTarget.
+func (in *PatchTarget) DeepCopy() *PatchTarget {
+	if in == nil {
+		return nil
+	}
+	out := new(PatchTarget)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PostRendererConfiguration) DeepCopyInto(out *PostRendererConfiguration) {
+	*out = *in
+	if in.Patches != nil {
+		in, out := &in.Patches, &out.Patches
+		*out = make([]Patch, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PostRendererConfiguration.
+func (in *PostRendererConfiguration) DeepCopy() *PostRendererConfiguration {
+	if in == nil {
+		return nil
+	}
+	out := new(PostRendererConfiguration)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ProviderConfiguration) DeepCopyInto(out *ProviderConfiguration) {
 	*out = *in
@@ -319,6 +478,13 @@ func (in *ProviderConfiguration) DeepCopyInto(out *ProviderConfiguration) {
 		*out = make(json.RawMessage, len(*in))
 		copy(*out, *in)
 	}
+	if in.ValuesFrom != nil {
+		in, out := &in.ValuesFrom, &out.ValuesFrom
+		*out = make([]ValuesFromSource, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	if in.ExportsFromManifests != nil {
 		in, out := &in.ExportsFromManifests, &out.ExportsFromManifests
 		*out = make([]managedresource.Export, len(*in))
@@ -360,6 +526,16 @@ func (in *ProviderConfiguration) DeepCopyInto(out *ProviderConfiguration) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.Tests != nil {
+		in, out := &in.Tests, &out.Tests
+		*out = new(HelmTestConfiguration)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.PostRenderer != nil {
+		in, out := &in.PostRenderer, &out.PostRenderer
+		*out = new(PostRendererConfiguration)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -392,6 +568,25 @@ func (in *ProviderStatus) DeepCopyInto(out *ProviderStatus) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.ApplyProgress != nil {
+		in, out := &in.ApplyProgress, &out.ApplyProgress
+		*out = new(managedresource.ApplyProgress)
+		**out = **in
+	}
+	if in.TestResults != nil {
+		in, out := &in.TestResults, &out.TestResults
+		*out = make([]HelmTestResult, len(*in))
+		copy(*out, *in)
+	}
+	if in.TestsStartTime != nil {
+		in, out := &in.TestsStartTime, &out.TestsStartTime
+		*out = (*in).DeepCopy()
+	}
+	if in.ChartMetadata != nil {
+		in, out := &in.ChartMetadata, &out.ChartMetadata
+		*out = new(ChartMetadata)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -461,3 +656,29 @@ func (in *ResourceRef) DeepCopy() *ResourceRef {
 	in.DeepCopyInto(out)
 	return out
 }
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ValuesFromSource) DeepCopyInto(out *ValuesFromSource) {
+	*out = *in
+	if in.SecretRef != nil {
+		in, out := &in.SecretRef, &out.SecretRef
+		*out = new(corev1alpha1.LocalSecretReference)
+		**out = **in
+	}
+	if in.ConfigMapRef != nil {
+		in, out := &in.ConfigMapRef, &out.ConfigMapRef
+		*out = new(corev1alpha1.LocalConfigMapReference)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ValuesFromSource.
+func (in *ValuesFromSource) DeepCopy() *ValuesFromSource {
+	if in == nil {
+		return nil
+	}
+	out := new(ValuesFromSource)
+	in.DeepCopyInto(out)
+	return out
+}
@@ -25,11 +25,19 @@ type ProviderConfiguration struct {
 	// UpdateStrategy defines the strategy how the manifest are updated in the cluster.
 	// +optional
 	UpdateStrategy UpdateStrategy `json:"updateStrategy"`
+	// ServerSideApply configures the server-side apply of the manifests.
+	// It is only used with the update strategies "serverSideApply" and "auto".
+	// +optional
+	ServerSideApply *ServerSideApplyConfiguration `json:"serverSideApply,omitempty"`
 	// ReadinessChecks configures the readiness checks.
 	// +optional
 	ReadinessChecks health.ReadinessCheckConfiguration `json:"readiness,omitempty"`
 	// Manifests contains a list of manifests that should be applied in the target cluster
 	Manifests []managedresource.Manifest `json:"manifests,omitempty"`
+	// Presets contains a list of commonly needed resources, like DNS entries and certificates,
+	// that are rendered by the deployer and applied in the target cluster together with the manifests.
+	// +optional
+	Presets []Preset `json:"presets,omitempty"`
 	// Exports describe the exports from the templated manifests that should be exported by the helm deployer.
 	// +optional
 	Exports *managedresource.Exports `json:"exports,omitempty"`
@@ -42,6 +50,10 @@ type ProviderConfiguration struct {
 	// DeletionGroupsDuringUpdate defines the order in which objects are deleted during an update.
 	// +optional
 	DeletionGroupsDuringUpdate []managedresource.DeletionGroupDefinition `json:"deletionGroupsDuringUpdate,omitempty"`
+	// Pruning defines how the resources are handled that have been deployed before,
+	// but are no longer defined in the manifests. By default, they are deleted.
+	// +optional
+	Pruning *managedresource.PruningConfiguration `json:"pruning,omitempty"`
 }
 
 // UpdateStrategy defines the strategy that is used to apply resources to the cluster.
@@ -52,8 +64,106 @@ const (
 	UpdateStrategyPatch          UpdateStrategy = "patch"
 	UpdateStrategyMerge          UpdateStrategy = "merge"
 	UpdateStrategyMergeOverwrite UpdateStrategy = "mergeOverwrite"
+	// UpdateStrategyServerSideApply applies the manifests with server-side apply.
+	// Only the fields that are defined in the manifests are owned by the deployer,
+	// so fields that are managed by other controllers are not reverted.
+	UpdateStrategyServerSideApply UpdateStrategy = "serverSideApply"
+	// UpdateStrategyThreeWayMerge applies the manifests like a client-side "kubectl apply".
+	// The patch is computed from the last applied manifest, the rendered manifest and the resource in the cluster,
+	// so that fields which have been removed from the manifest are removed, and fields of other controllers are kept.
+	UpdateStrategyThreeWayMerge UpdateStrategy = "threeWayMerge"
+	// UpdateStrategyAuto applies the manifests with server-side apply if the target cluster supports it in a mature
+	// version, and with a three-way merge otherwise.
+	UpdateStrategyAuto UpdateStrategy = "auto"
+)
+
+// ServerSideApplyConfiguration configures how the manifests are applied with server-side apply.
+type ServerSideApplyConfiguration struct {
+	// FieldManager is the name of the field manager that owns the applied fields.
+	// Defaults to "landscaper-manifest-deployer".
+	// +optional
+	FieldManager string `json:"fieldManager,omitempty"`
+	// ConflictPolicy defines how conflicts with fields that are owned by other field managers are handled.
+	// Defaults to "force".
+	// +optional
+	ConflictPolicy ConflictPolicy `json:"conflictPolicy,omitempty"`
+}
+
+// ConflictPolicy defines how conflicts are handled during server-side apply.
+type ConflictPolicy string
+
+const (
+	// ConflictPolicyForce takes over the ownership of conflicting fields.
+	ConflictPolicyForce ConflictPolicy = "force"
+	// ConflictPolicyFail fails the apply if a field is owned by another field manager.
+	ConflictPolicyFail ConflictPolicy = "fail"
 )
 
+// Preset describes a commonly needed auxiliary resource that is rendered by the deployer
+// and applied together with the manifests. Exactly one resource has to be defined.
+type Preset struct {
+	// Policy defines the manage policy for the rendered resource.
+	// Defaults to "manage".
+	// +optional
+	Policy managedresource.ManifestPolicy `json:"policy,omitempty"`
+	// DNSEntry renders a Gardener DNSEntry resource.
+	// +optional
+	DNSEntry *DNSEntryPreset `json:"dnsEntry,omitempty"`
+	// Certificate renders a Gardener Certificate resource.
+	// +optional
+	Certificate *CertificatePreset `json:"certificate,omitempty"`
+}
+
+// DNSEntryPreset defines a DNSEntry resource of the Gardener DNS controller manager.
+type DNSEntryPreset struct {
+	// Name is the name of the DNSEntry.
+	Name string `json:"name"`
+	// Namespace is the namespace of the DNSEntry.
+	Namespace string `json:"namespace"`
+	// Class is the dns class that selects the responsible DNS controller.
+	// +optional
+	Class string `json:"class,omitempty"`
+	// DNSName is the full qualified domain name of the entry.
+	DNSName string `json:"dnsName"`
+	// Targets are the target IP addresses or domain names of the entry.
+	// +optional
+	Targets []string `json:"targets,omitempty"`
+	// Text are the text records of the entry.
+	// +optional
+	Text []string `json:"text,omitempty"`
+	// TTL is the time to live of the records in seconds.
+	// +optional
+	TTL *int64 `json:"ttl,omitempty"`
+}
+
+// CertificatePreset defines a Certificate resource of the Gardener certificate management.
+type CertificatePreset struct {
+	// Name is the name of the Certificate.
+	Name string `json:"name"`
+	// Namespace is the namespace of the Certificate.
+	Namespace string `json:"namespace"`
+	// Class is the certificate class that selects the responsible certificate controller.
+	// +optional
+	Class string `json:"class,omitempty"`
+	// CommonName is the common name of the certificate.
+	// +optional
+	CommonName string `json:"commonName,omitempty"`
+	// DNSNames are the additional domain names of the certificate.
+	// +optional
+	DNSNames []string `json:"dnsNames,omitempty"`
+	// SecretName is the name of the secret the certificate is stored in.
+	// Defaults to the name of the certificate.
+	// +optional
+	SecretName string `json:"secretName,omitempty"`
+	// IssuerName is the name of the issuer of the certificate.
+	// The default issuer of the certificate controller is used if not set.
+	// +optional
+	IssuerName string `json:"issuerName,omitempty"`
+	// IssuerNamespace is the namespace of the issuer of the certificate.
+	// +optional
+	IssuerNamespace string `json:"issuerNamespace,omitempty"`
+}
+
 // +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
 
 // ProviderStatus is the manifest provider specific status.
@@ -61,6 +171,10 @@ type ProviderStatus struct {
 	metav1.TypeMeta `json:",inline"`
 	// ManagedResources contains all kubernetes resources that are deployed by the deployer.
 	ManagedResources managedresource.ManagedResourceStatusList `json:"managedResources,omitempty"`
+	// ApplyProgress describes the progress of applying the manifests.
+	// It is only set if the manifests are applied in batches.
+	// +optional
+	ApplyProgress *managedresource.ApplyProgress `json:"applyProgress,omitempty"`
 	// AnnotateBeforeCreate defines annotations that are being set before the manifest is being created.
 	// +optional
 	AnnotateBeforeCreate map[string]string `json:"annotateBeforeCreate,omitempty"`
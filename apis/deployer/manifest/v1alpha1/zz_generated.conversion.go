@@ -14,6 +14,7 @@ import (
 	conversion "k8s.io/apimachinery/pkg/conversion"
 	runtime "k8s.io/apimachinery/pkg/runtime"
 
+	configv1alpha1 "github.com/gardener/landscaper/apis/config/v1alpha1"
 	corev1alpha1 "github.com/gardener/landscaper/apis/core/v1alpha1"
 	manifest "github.com/gardener/landscaper/apis/deployer/manifest"
 )
@@ -98,6 +99,7 @@ func autoConvert_v1alpha1_Configuration_To_manifest_Configuration(in *Configurat
 	if err := Convert_v1alpha1_Controller_To_manifest_Controller(&in.Controller, &out.Controller, s); err != nil {
 		return err
 	}
+	out.TargetClient = (*configv1alpha1.TargetClientConfig)(unsafe.Pointer(in.TargetClient))
 	out.UseOCMLib = in.UseOCMLib
 	return nil
 }
@@ -117,6 +119,7 @@ func autoConvert_manifest_Configuration_To_v1alpha1_Configuration(in *manifest.C
 	if err := Convert_manifest_Controller_To_v1alpha1_Controller(&in.Controller, &out.Controller, s); err != nil {
 		return err
 	}
+	out.TargetClient = (*configv1alpha1.TargetClientConfig)(unsafe.Pointer(in.TargetClient))
 	out.UseOCMLib = in.UseOCMLib
 	return nil
 }
@@ -11,6 +11,7 @@ package v1alpha1
 import (
 	runtime "k8s.io/apimachinery/pkg/runtime"
 
+	configv1alpha1 "github.com/gardener/landscaper/apis/config/v1alpha1"
 	corev1alpha1 "github.com/gardener/landscaper/apis/core/v1alpha1"
 	managedresource "github.com/gardener/landscaper/apis/deployer/utils/managedresource"
 )
@@ -33,6 +34,11 @@ func (in *Configuration) DeepCopyInto(out *Configuration) {
 		**out = **in
 	}
 	in.Controller.DeepCopyInto(&out.Controller)
+	if in.TargetClient != nil {
+		in, out := &in.TargetClient, &out.TargetClient
+		*out = new(configv1alpha1.TargetClientConfig)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
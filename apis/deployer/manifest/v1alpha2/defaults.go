@@ -21,11 +21,27 @@ func SetDefaults_ProviderConfiguration(obj *ProviderConfiguration) {
 	if len(obj.UpdateStrategy) == 0 {
 		obj.UpdateStrategy = UpdateStrategyUpdate
 	}
+	if obj.UpdateStrategy == UpdateStrategyServerSideApply || obj.UpdateStrategy == UpdateStrategyAuto {
+		if obj.ServerSideApply == nil {
+			obj.ServerSideApply = &ServerSideApplyConfiguration{}
+		}
+		if len(obj.ServerSideApply.FieldManager) == 0 {
+			obj.ServerSideApply.FieldManager = DefaultFieldManager
+		}
+		if len(obj.ServerSideApply.ConflictPolicy) == 0 {
+			obj.ServerSideApply.ConflictPolicy = ConflictPolicyForce
+		}
+	}
 	for i := range obj.Manifests {
 		if len(obj.Manifests[i].Policy) == 0 {
 			obj.Manifests[i].Policy = managedresource.ManagePolicy
 		}
 	}
+	for i := range obj.Presets {
+		if len(obj.Presets[i].Policy) == 0 {
+			obj.Presets[i].Policy = managedresource.ManagePolicy
+		}
+	}
 }
 
 // SetDefaults_Configuration sets the defaults for the manifest deployer controller configuration.
@@ -36,6 +36,9 @@ type Configuration struct {
 	HPAConfiguration *HPAConfiguration `json:"hpa,omitempty"`
 	// Controller contains configuration concerning the controller framework.
 	Controller Controller `json:"controller,omitempty"`
+	// TargetClient configures the clients that are used to access the target clusters.
+	// +optional
+	TargetClient *lsconfigv1alpha1.TargetClientConfig `json:"targetClient,omitempty"`
 	// +optional
 	UseOCMLib bool `json:"useOCMLib,omitempty"`
 }
@@ -14,7 +14,8 @@ import (
 	conversion "k8s.io/apimachinery/pkg/conversion"
 	runtime "k8s.io/apimachinery/pkg/runtime"
 
-	v1alpha1 "github.com/gardener/landscaper/apis/core/v1alpha1"
+	configv1alpha1 "github.com/gardener/landscaper/apis/config/v1alpha1"
+	corev1alpha1 "github.com/gardener/landscaper/apis/core/v1alpha1"
 	manifest "github.com/gardener/landscaper/apis/deployer/manifest"
 	continuousreconcile "github.com/gardener/landscaper/apis/deployer/utils/continuousreconcile"
 	managedresource "github.com/gardener/landscaper/apis/deployer/utils/managedresource"
@@ -27,6 +28,16 @@ func init() {
 // RegisterConversions adds conversion functions to the given scheme.
 // Public to allow building arbitrary schemes.
 func RegisterConversions(s *runtime.Scheme) error {
+	if err := s.AddGeneratedConversionFunc((*CertificatePreset)(nil), (*manifest.CertificatePreset)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha2_CertificatePreset_To_manifest_CertificatePreset(a.(*CertificatePreset), b.(*manifest.CertificatePreset), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*manifest.CertificatePreset)(nil), (*CertificatePreset)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_manifest_CertificatePreset_To_v1alpha2_CertificatePreset(a.(*manifest.CertificatePreset), b.(*CertificatePreset), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*Configuration)(nil), (*manifest.Configuration)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha2_Configuration_To_manifest_Configuration(a.(*Configuration), b.(*manifest.Configuration), scope)
 	}); err != nil {
@@ -47,6 +58,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*DNSEntryPreset)(nil), (*manifest.DNSEntryPreset)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha2_DNSEntryPreset_To_manifest_DNSEntryPreset(a.(*DNSEntryPreset), b.(*manifest.DNSEntryPreset), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*manifest.DNSEntryPreset)(nil), (*DNSEntryPreset)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_manifest_DNSEntryPreset_To_v1alpha2_DNSEntryPreset(a.(*manifest.DNSEntryPreset), b.(*DNSEntryPreset), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*ExportConfiguration)(nil), (*manifest.ExportConfiguration)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha2_ExportConfiguration_To_manifest_ExportConfiguration(a.(*ExportConfiguration), b.(*manifest.ExportConfiguration), scope)
 	}); err != nil {
@@ -67,6 +88,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*Preset)(nil), (*manifest.Preset)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha2_Preset_To_manifest_Preset(a.(*Preset), b.(*manifest.Preset), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*manifest.Preset)(nil), (*Preset)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_manifest_Preset_To_v1alpha2_Preset(a.(*manifest.Preset), b.(*Preset), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*ProviderConfiguration)(nil), (*manifest.ProviderConfiguration)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha2_ProviderConfiguration_To_manifest_ProviderConfiguration(a.(*ProviderConfiguration), b.(*manifest.ProviderConfiguration), scope)
 	}); err != nil {
@@ -82,6 +113,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*ServerSideApplyConfiguration)(nil), (*manifest.ServerSideApplyConfiguration)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha2_ServerSideApplyConfiguration_To_manifest_ServerSideApplyConfiguration(a.(*ServerSideApplyConfiguration), b.(*manifest.ServerSideApplyConfiguration), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*manifest.ServerSideApplyConfiguration)(nil), (*ServerSideApplyConfiguration)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_manifest_ServerSideApplyConfiguration_To_v1alpha2_ServerSideApplyConfiguration(a.(*manifest.ServerSideApplyConfiguration), b.(*ServerSideApplyConfiguration), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddConversionFunc((*manifest.ProviderStatus)(nil), (*ProviderStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_manifest_ProviderStatus_To_v1alpha2_ProviderStatus(a.(*manifest.ProviderStatus), b.(*ProviderStatus), scope)
 	}); err != nil {
@@ -90,9 +131,43 @@ func RegisterConversions(s *runtime.Scheme) error {
 	return nil
 }
 
+func autoConvert_v1alpha2_CertificatePreset_To_manifest_CertificatePreset(in *CertificatePreset, out *manifest.CertificatePreset, s conversion.Scope) error {
+	out.Name = in.Name
+	out.Namespace = in.Namespace
+	out.Class = in.Class
+	out.CommonName = in.CommonName
+	out.DNSNames = *(*[]string)(unsafe.Pointer(&in.DNSNames))
+	out.SecretName = in.SecretName
+	out.IssuerName = in.IssuerName
+	out.IssuerNamespace = in.IssuerNamespace
+	return nil
+}
+
+// Convert_v1alpha2_CertificatePreset_To_manifest_CertificatePreset is an autogenerated conversion function.
+func Convert_v1alpha2_CertificatePreset_To_manifest_CertificatePreset(in *CertificatePreset, out *manifest.CertificatePreset, s conversion.Scope) error {
+	return autoConvert_v1alpha2_CertificatePreset_To_manifest_CertificatePreset(in, out, s)
+}
+
+func autoConvert_manifest_CertificatePreset_To_v1alpha2_CertificatePreset(in *manifest.CertificatePreset, out *CertificatePreset, s conversion.Scope) error {
+	out.Name = in.Name
+	out.Namespace = in.Namespace
+	out.Class = in.Class
+	out.CommonName = in.CommonName
+	out.DNSNames = *(*[]string)(unsafe.Pointer(&in.DNSNames))
+	out.SecretName = in.SecretName
+	out.IssuerName = in.IssuerName
+	out.IssuerNamespace = in.IssuerNamespace
+	return nil
+}
+
+// Convert_manifest_CertificatePreset_To_v1alpha2_CertificatePreset is an autogenerated conversion function.
+func Convert_manifest_CertificatePreset_To_v1alpha2_CertificatePreset(in *manifest.CertificatePreset, out *CertificatePreset, s conversion.Scope) error {
+	return autoConvert_manifest_CertificatePreset_To_v1alpha2_CertificatePreset(in, out, s)
+}
+
 func autoConvert_v1alpha2_Configuration_To_manifest_Configuration(in *Configuration, out *manifest.Configuration, s conversion.Scope) error {
 	out.Identity = in.Identity
-	out.TargetSelector = *(*[]v1alpha1.TargetSelector)(unsafe.Pointer(&in.TargetSelector))
+	out.TargetSelector = *(*[]corev1alpha1.TargetSelector)(unsafe.Pointer(&in.TargetSelector))
 	if err := Convert_v1alpha2_ExportConfiguration_To_manifest_ExportConfiguration(&in.Export, &out.Export, s); err != nil {
 		return err
 	}
@@ -100,6 +175,7 @@ func autoConvert_v1alpha2_Configuration_To_manifest_Configuration(in *Configurat
 	if err := Convert_v1alpha2_Controller_To_manifest_Controller(&in.Controller, &out.Controller, s); err != nil {
 		return err
 	}
+	out.TargetClient = (*configv1alpha1.TargetClientConfig)(unsafe.Pointer(in.TargetClient))
 	out.UseOCMLib = in.UseOCMLib
 	return nil
 }
@@ -111,7 +187,7 @@ func Convert_v1alpha2_Configuration_To_manifest_Configuration(in *Configuration,
 
 func autoConvert_manifest_Configuration_To_v1alpha2_Configuration(in *manifest.Configuration, out *Configuration, s conversion.Scope) error {
 	out.Identity = in.Identity
-	out.TargetSelector = *(*[]v1alpha1.TargetSelector)(unsafe.Pointer(&in.TargetSelector))
+	out.TargetSelector = *(*[]corev1alpha1.TargetSelector)(unsafe.Pointer(&in.TargetSelector))
 	if err := Convert_manifest_ExportConfiguration_To_v1alpha2_ExportConfiguration(&in.Export, &out.Export, s); err != nil {
 		return err
 	}
@@ -119,6 +195,7 @@ func autoConvert_manifest_Configuration_To_v1alpha2_Configuration(in *manifest.C
 	if err := Convert_manifest_Controller_To_v1alpha2_Controller(&in.Controller, &out.Controller, s); err != nil {
 		return err
 	}
+	out.TargetClient = (*configv1alpha1.TargetClientConfig)(unsafe.Pointer(in.TargetClient))
 	out.UseOCMLib = in.UseOCMLib
 	return nil
 }
@@ -148,8 +225,40 @@ func Convert_manifest_Controller_To_v1alpha2_Controller(in *manifest.Controller,
 	return autoConvert_manifest_Controller_To_v1alpha2_Controller(in, out, s)
 }
 
+func autoConvert_v1alpha2_DNSEntryPreset_To_manifest_DNSEntryPreset(in *DNSEntryPreset, out *manifest.DNSEntryPreset, s conversion.Scope) error {
+	out.Name = in.Name
+	out.Namespace = in.Namespace
+	out.Class = in.Class
+	out.DNSName = in.DNSName
+	out.Targets = *(*[]string)(unsafe.Pointer(&in.Targets))
+	out.Text = *(*[]string)(unsafe.Pointer(&in.Text))
+	out.TTL = (*int64)(unsafe.Pointer(in.TTL))
+	return nil
+}
+
+// Convert_v1alpha2_DNSEntryPreset_To_manifest_DNSEntryPreset is an autogenerated conversion function.
+func Convert_v1alpha2_DNSEntryPreset_To_manifest_DNSEntryPreset(in *DNSEntryPreset, out *manifest.DNSEntryPreset, s conversion.Scope) error {
+	return autoConvert_v1alpha2_DNSEntryPreset_To_manifest_DNSEntryPreset(in, out, s)
+}
+
+func autoConvert_manifest_DNSEntryPreset_To_v1alpha2_DNSEntryPreset(in *manifest.DNSEntryPreset, out *DNSEntryPreset, s conversion.Scope) error {
+	out.Name = in.Name
+	out.Namespace = in.Namespace
+	out.Class = in.Class
+	out.DNSName = in.DNSName
+	out.Targets = *(*[]string)(unsafe.Pointer(&in.Targets))
+	out.Text = *(*[]string)(unsafe.Pointer(&in.Text))
+	out.TTL = (*int64)(unsafe.Pointer(in.TTL))
+	return nil
+}
+
+// Convert_manifest_DNSEntryPreset_To_v1alpha2_DNSEntryPreset is an autogenerated conversion function.
+func Convert_manifest_DNSEntryPreset_To_v1alpha2_DNSEntryPreset(in *manifest.DNSEntryPreset, out *DNSEntryPreset, s conversion.Scope) error {
+	return autoConvert_manifest_DNSEntryPreset_To_v1alpha2_DNSEntryPreset(in, out, s)
+}
+
 func autoConvert_v1alpha2_ExportConfiguration_To_manifest_ExportConfiguration(in *ExportConfiguration, out *manifest.ExportConfiguration, s conversion.Scope) error {
-	out.DefaultTimeout = (*v1alpha1.Duration)(unsafe.Pointer(in.DefaultTimeout))
+	out.DefaultTimeout = (*corev1alpha1.Duration)(unsafe.Pointer(in.DefaultTimeout))
 	return nil
 }
 
@@ -159,7 +268,7 @@ func Convert_v1alpha2_ExportConfiguration_To_manifest_ExportConfiguration(in *Ex
 }
 
 func autoConvert_manifest_ExportConfiguration_To_v1alpha2_ExportConfiguration(in *manifest.ExportConfiguration, out *ExportConfiguration, s conversion.Scope) error {
-	out.DefaultTimeout = (*v1alpha1.Duration)(unsafe.Pointer(in.DefaultTimeout))
+	out.DefaultTimeout = (*corev1alpha1.Duration)(unsafe.Pointer(in.DefaultTimeout))
 	return nil
 }
 
@@ -188,15 +297,42 @@ func Convert_manifest_HPAConfiguration_To_v1alpha2_HPAConfiguration(in *manifest
 	return autoConvert_manifest_HPAConfiguration_To_v1alpha2_HPAConfiguration(in, out, s)
 }
 
+func autoConvert_v1alpha2_Preset_To_manifest_Preset(in *Preset, out *manifest.Preset, s conversion.Scope) error {
+	out.Policy = managedresource.ManifestPolicy(in.Policy)
+	out.DNSEntry = (*manifest.DNSEntryPreset)(unsafe.Pointer(in.DNSEntry))
+	out.Certificate = (*manifest.CertificatePreset)(unsafe.Pointer(in.Certificate))
+	return nil
+}
+
+// Convert_v1alpha2_Preset_To_manifest_Preset is an autogenerated conversion function.
+func Convert_v1alpha2_Preset_To_manifest_Preset(in *Preset, out *manifest.Preset, s conversion.Scope) error {
+	return autoConvert_v1alpha2_Preset_To_manifest_Preset(in, out, s)
+}
+
+func autoConvert_manifest_Preset_To_v1alpha2_Preset(in *manifest.Preset, out *Preset, s conversion.Scope) error {
+	out.Policy = managedresource.ManifestPolicy(in.Policy)
+	out.DNSEntry = (*DNSEntryPreset)(unsafe.Pointer(in.DNSEntry))
+	out.Certificate = (*CertificatePreset)(unsafe.Pointer(in.Certificate))
+	return nil
+}
+
+// Convert_manifest_Preset_To_v1alpha2_Preset is an autogenerated conversion function.
+func Convert_manifest_Preset_To_v1alpha2_Preset(in *manifest.Preset, out *Preset, s conversion.Scope) error {
+	return autoConvert_manifest_Preset_To_v1alpha2_Preset(in, out, s)
+}
+
 func autoConvert_v1alpha2_ProviderConfiguration_To_manifest_ProviderConfiguration(in *ProviderConfiguration, out *manifest.ProviderConfiguration, s conversion.Scope) error {
 	out.Kubeconfig = in.Kubeconfig
 	out.UpdateStrategy = manifest.UpdateStrategy(in.UpdateStrategy)
+	out.ServerSideApply = (*manifest.ServerSideApplyConfiguration)(unsafe.Pointer(in.ServerSideApply))
 	out.ReadinessChecks = in.ReadinessChecks
 	out.Manifests = *(*[]managedresource.Manifest)(unsafe.Pointer(&in.Manifests))
+	out.Presets = *(*[]manifest.Preset)(unsafe.Pointer(&in.Presets))
 	out.Exports = (*managedresource.Exports)(unsafe.Pointer(in.Exports))
 	out.ContinuousReconcile = (*continuousreconcile.ContinuousReconcileSpec)(unsafe.Pointer(in.ContinuousReconcile))
 	out.DeletionGroups = *(*[]managedresource.DeletionGroupDefinition)(unsafe.Pointer(&in.DeletionGroups))
 	out.DeletionGroupsDuringUpdate = *(*[]managedresource.DeletionGroupDefinition)(unsafe.Pointer(&in.DeletionGroupsDuringUpdate))
+	out.Pruning = (*managedresource.PruningConfiguration)(unsafe.Pointer(in.Pruning))
 	return nil
 }
 
@@ -208,12 +344,15 @@ func Convert_v1alpha2_ProviderConfiguration_To_manifest_ProviderConfiguration(in
 func autoConvert_manifest_ProviderConfiguration_To_v1alpha2_ProviderConfiguration(in *manifest.ProviderConfiguration, out *ProviderConfiguration, s conversion.Scope) error {
 	out.Kubeconfig = in.Kubeconfig
 	out.UpdateStrategy = UpdateStrategy(in.UpdateStrategy)
+	out.ServerSideApply = (*ServerSideApplyConfiguration)(unsafe.Pointer(in.ServerSideApply))
 	out.ReadinessChecks = in.ReadinessChecks
 	out.Manifests = *(*[]managedresource.Manifest)(unsafe.Pointer(&in.Manifests))
+	out.Presets = *(*[]Preset)(unsafe.Pointer(&in.Presets))
 	out.Exports = (*managedresource.Exports)(unsafe.Pointer(in.Exports))
 	out.ContinuousReconcile = (*continuousreconcile.ContinuousReconcileSpec)(unsafe.Pointer(in.ContinuousReconcile))
 	out.DeletionGroups = *(*[]managedresource.DeletionGroupDefinition)(unsafe.Pointer(&in.DeletionGroups))
 	out.DeletionGroupsDuringUpdate = *(*[]managedresource.DeletionGroupDefinition)(unsafe.Pointer(&in.DeletionGroupsDuringUpdate))
+	out.Pruning = (*managedresource.PruningConfiguration)(unsafe.Pointer(in.Pruning))
 	return nil
 }
 
@@ -224,6 +363,7 @@ func Convert_manifest_ProviderConfiguration_To_v1alpha2_ProviderConfiguration(in
 
 func autoConvert_v1alpha2_ProviderStatus_To_manifest_ProviderStatus(in *ProviderStatus, out *manifest.ProviderStatus, s conversion.Scope) error {
 	out.ManagedResources = *(*managedresource.ManagedResourceStatusList)(unsafe.Pointer(&in.ManagedResources))
+	out.ApplyProgress = (*managedresource.ApplyProgress)(unsafe.Pointer(in.ApplyProgress))
 	return nil
 }
 
@@ -234,7 +374,30 @@ func Convert_v1alpha2_ProviderStatus_To_manifest_ProviderStatus(in *ProviderStat
 
 func autoConvert_manifest_ProviderStatus_To_v1alpha2_ProviderStatus(in *manifest.ProviderStatus, out *ProviderStatus, s conversion.Scope) error {
 	out.ManagedResources = *(*managedresource.ManagedResourceStatusList)(unsafe.Pointer(&in.ManagedResources))
+	out.ApplyProgress = (*managedresource.ApplyProgress)(unsafe.Pointer(in.ApplyProgress))
 	// WARNING: in.AnnotateBeforeCreate requires manual conversion: does not exist in peer-type
 	// WARNING: in.AnnotateBeforeDelete requires manual conversion: does not exist in peer-type
 	return nil
 }
+
+func autoConvert_v1alpha2_ServerSideApplyConfiguration_To_manifest_ServerSideApplyConfiguration(in *ServerSideApplyConfiguration, out *manifest.ServerSideApplyConfiguration, s conversion.Scope) error {
+	out.FieldManager = in.FieldManager
+	out.ConflictPolicy = manifest.ConflictPolicy(in.ConflictPolicy)
+	return nil
+}
+
+// Convert_v1alpha2_ServerSideApplyConfiguration_To_manifest_ServerSideApplyConfiguration is an autogenerated conversion function.
+func Convert_v1alpha2_ServerSideApplyConfiguration_To_manifest_ServerSideApplyConfiguration(in *ServerSideApplyConfiguration, out *manifest.ServerSideApplyConfiguration, s conversion.Scope) error {
+	return autoConvert_v1alpha2_ServerSideApplyConfiguration_To_manifest_ServerSideApplyConfiguration(in, out, s)
+}
+
+func autoConvert_manifest_ServerSideApplyConfiguration_To_v1alpha2_ServerSideApplyConfiguration(in *manifest.ServerSideApplyConfiguration, out *ServerSideApplyConfiguration, s conversion.Scope) error {
+	out.FieldManager = in.FieldManager
+	out.ConflictPolicy = ConflictPolicy(in.ConflictPolicy)
+	return nil
+}
+
+// Convert_manifest_ServerSideApplyConfiguration_To_v1alpha2_ServerSideApplyConfiguration is an autogenerated conversion function.
+func Convert_manifest_ServerSideApplyConfiguration_To_v1alpha2_ServerSideApplyConfiguration(in *manifest.ServerSideApplyConfiguration, out *ServerSideApplyConfiguration, s conversion.Scope) error {
+	return autoConvert_manifest_ServerSideApplyConfiguration_To_v1alpha2_ServerSideApplyConfiguration(in, out, s)
+}
@@ -5,6 +5,8 @@
 package validation
 
 import (
+	"fmt"
+
 	"k8s.io/apimachinery/pkg/util/validation/field"
 
 	lsv1alpha1 "github.com/gardener/landscaper/apis/core/v1alpha1"
@@ -21,9 +23,88 @@ func ValidateProviderConfiguration(config *manifestv1alpha2.ProviderConfiguratio
 	allErrs = append(allErrs, health.ValidateReadinessCheckConfiguration(field.NewPath(""), &config.ReadinessChecks)...)
 	allErrs = append(allErrs, crval.ValidateContinuousReconcileSpec(field.NewPath("continuousReconcile"), config.ContinuousReconcile)...)
 	allErrs = append(allErrs, validation.ValidateDeletionGroups(field.NewPath("deletionGroups"), config.DeletionGroups)...)
+	allErrs = append(allErrs, ValidateServerSideApply(field.NewPath("serverSideApply"), config.UpdateStrategy, config.ServerSideApply)...)
+	allErrs = append(allErrs, ValidatePresets(field.NewPath("presets"), config.Presets)...)
+	allErrs = append(allErrs, validation.ValidateExports(field.NewPath("exports"), config.Exports)...)
+	allErrs = append(allErrs, validation.ValidatePruning(field.NewPath("pruning"), config.Pruning)...)
 	return allErrs.ToAggregate()
 }
 
+// ValidateServerSideApply validates the server-side apply configuration.
+func ValidateServerSideApply(fldPath *field.Path, strategy manifestv1alpha2.UpdateStrategy, config *manifestv1alpha2.ServerSideApplyConfiguration) field.ErrorList {
+	allErrs := field.ErrorList{}
+	if config == nil {
+		return allErrs
+	}
+	if strategy != manifestv1alpha2.UpdateStrategyServerSideApply && strategy != manifestv1alpha2.UpdateStrategyAuto {
+		allErrs = append(allErrs, field.Forbidden(fldPath,
+			fmt.Sprintf("server-side apply can only be configured with the update strategies %q and %q",
+				manifestv1alpha2.UpdateStrategyServerSideApply, manifestv1alpha2.UpdateStrategyAuto)))
+	}
+	switch config.ConflictPolicy {
+	case "", manifestv1alpha2.ConflictPolicyForce, manifestv1alpha2.ConflictPolicyFail:
+	default:
+		allErrs = append(allErrs, field.NotSupported(fldPath.Child("conflictPolicy"), config.ConflictPolicy,
+			[]string{string(manifestv1alpha2.ConflictPolicyForce), string(manifestv1alpha2.ConflictPolicyFail)}))
+	}
+	return allErrs
+}
+
+// ValidatePresets validates a list of presets.
+func ValidatePresets(fldPath *field.Path, presets []manifestv1alpha2.Preset) field.ErrorList {
+	allErrs := field.ErrorList{}
+	for i, preset := range presets {
+		presetPath := fldPath.Index(i)
+		switch {
+		case preset.DNSEntry != nil && preset.Certificate != nil:
+			allErrs = append(allErrs, field.Forbidden(presetPath, "only one of dnsEntry and certificate may be defined"))
+		case preset.DNSEntry != nil:
+			allErrs = append(allErrs, validateDNSEntryPreset(presetPath.Child("dnsEntry"), preset.DNSEntry)...)
+		case preset.Certificate != nil:
+			allErrs = append(allErrs, validateCertificatePreset(presetPath.Child("certificate"), preset.Certificate)...)
+		default:
+			allErrs = append(allErrs, field.Required(presetPath, "one of dnsEntry and certificate must be defined"))
+		}
+	}
+	return allErrs
+}
+
+func validateDNSEntryPreset(fldPath *field.Path, entry *manifestv1alpha2.DNSEntryPreset) field.ErrorList {
+	allErrs := validatePresetObjectName(fldPath, entry.Name, entry.Namespace)
+	if len(entry.DNSName) == 0 {
+		allErrs = append(allErrs, field.Required(fldPath.Child("dnsName"), "dns name must be defined"))
+	}
+	if len(entry.Targets) == 0 && len(entry.Text) == 0 {
+		allErrs = append(allErrs, field.Required(fldPath.Child("targets"), "targets or text records must be defined"))
+	}
+	if entry.TTL != nil && *entry.TTL <= 0 {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("ttl"), *entry.TTL, "ttl must be positive"))
+	}
+	return allErrs
+}
+
+func validateCertificatePreset(fldPath *field.Path, cert *manifestv1alpha2.CertificatePreset) field.ErrorList {
+	allErrs := validatePresetObjectName(fldPath, cert.Name, cert.Namespace)
+	if len(cert.CommonName) == 0 && len(cert.DNSNames) == 0 {
+		allErrs = append(allErrs, field.Required(fldPath.Child("commonName"), "common name or dns names must be defined"))
+	}
+	if len(cert.IssuerNamespace) != 0 && len(cert.IssuerName) == 0 {
+		allErrs = append(allErrs, field.Required(fldPath.Child("issuerName"), "issuer name must be defined if an issuer namespace is defined"))
+	}
+	return allErrs
+}
+
+func validatePresetObjectName(fldPath *field.Path, name, namespace string) field.ErrorList {
+	allErrs := field.ErrorList{}
+	if len(name) == 0 {
+		allErrs = append(allErrs, field.Required(fldPath.Child("name"), "name must be defined"))
+	}
+	if len(namespace) == 0 {
+		allErrs = append(allErrs, field.Required(fldPath.Child("namespace"), "namespace must be defined"))
+	}
+	return allErrs
+}
+
 // ValidateTimeout validates a timeout.
 func ValidateTimeout(fldPath *field.Path, timeout *lsv1alpha1.Duration) field.ErrorList {
 	allErrs := field.ErrorList{}
@@ -11,18 +11,40 @@ package manifest
 import (
 	runtime "k8s.io/apimachinery/pkg/runtime"
 
-	v1alpha1 "github.com/gardener/landscaper/apis/core/v1alpha1"
+	configv1alpha1 "github.com/gardener/landscaper/apis/config/v1alpha1"
+	corev1alpha1 "github.com/gardener/landscaper/apis/core/v1alpha1"
 	continuousreconcile "github.com/gardener/landscaper/apis/deployer/utils/continuousreconcile"
 	managedresource "github.com/gardener/landscaper/apis/deployer/utils/managedresource"
 )
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CertificatePreset) DeepCopyInto(out *CertificatePreset) {
+	*out = *in
+	if in.DNSNames != nil {
+		in, out := &in.DNSNames, &out.DNSNames
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CertificatePreset.
+func (in *CertificatePreset) DeepCopy() *CertificatePreset {
+	if in == nil {
+		return nil
+	}
+	out := new(CertificatePreset)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Configuration) DeepCopyInto(out *Configuration) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	if in.TargetSelector != nil {
 		in, out := &in.TargetSelector, &out.TargetSelector
-		*out = make([]v1alpha1.TargetSelector, len(*in))
+		*out = make([]corev1alpha1.TargetSelector, len(*in))
 		for i := range *in {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
@@ -34,6 +56,11 @@ func (in *Configuration) DeepCopyInto(out *Configuration) {
 		**out = **in
 	}
 	in.Controller.DeepCopyInto(&out.Controller)
+	if in.TargetClient != nil {
+		in, out := &in.TargetClient, &out.TargetClient
+		*out = new(configv1alpha1.TargetClientConfig)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -72,12 +99,43 @@ func (in *Controller) DeepCopy() *Controller {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DNSEntryPreset) DeepCopyInto(out *DNSEntryPreset) {
+	*out = *in
+	if in.Targets != nil {
+		in, out := &in.Targets, &out.Targets
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Text != nil {
+		in, out := &in.Text, &out.Text
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.TTL != nil {
+		in, out := &in.TTL, &out.TTL
+		*out = new(int64)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DNSEntryPreset.
+func (in *DNSEntryPreset) DeepCopy() *DNSEntryPreset {
+	if in == nil {
+		return nil
+	}
+	out := new(DNSEntryPreset)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ExportConfiguration) DeepCopyInto(out *ExportConfiguration) {
 	*out = *in
 	if in.DefaultTimeout != nil {
 		in, out := &in.DefaultTimeout, &out.DefaultTimeout
-		*out = new(v1alpha1.Duration)
+		*out = new(corev1alpha1.Duration)
 		**out = **in
 	}
 	return
@@ -109,10 +167,41 @@ func (in *HPAConfiguration) DeepCopy() *HPAConfiguration {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Preset) DeepCopyInto(out *Preset) {
+	*out = *in
+	if in.DNSEntry != nil {
+		in, out := &in.DNSEntry, &out.DNSEntry
+		*out = new(DNSEntryPreset)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Certificate != nil {
+		in, out := &in.Certificate, &out.Certificate
+		*out = new(CertificatePreset)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Preset.
+func (in *Preset) DeepCopy() *Preset {
+	if in == nil {
+		return nil
+	}
+	out := new(Preset)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ProviderConfiguration) DeepCopyInto(out *ProviderConfiguration) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
+	if in.ServerSideApply != nil {
+		in, out := &in.ServerSideApply, &out.ServerSideApply
+		*out = new(ServerSideApplyConfiguration)
+		**out = **in
+	}
 	in.ReadinessChecks.DeepCopyInto(&out.ReadinessChecks)
 	if in.Manifests != nil {
 		in, out := &in.Manifests, &out.Manifests
@@ -121,6 +210,13 @@ func (in *ProviderConfiguration) DeepCopyInto(out *ProviderConfiguration) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.Presets != nil {
+		in, out := &in.Presets, &out.Presets
+		*out = make([]Preset, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	if in.Exports != nil {
 		in, out := &in.Exports, &out.Exports
 		*out = new(managedresource.Exports)
@@ -145,6 +241,11 @@ func (in *ProviderConfiguration) DeepCopyInto(out *ProviderConfiguration) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.Pruning != nil {
+		in, out := &in.Pruning, &out.Pruning
+		*out = new(managedresource.PruningConfiguration)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -177,6 +278,11 @@ func (in *ProviderStatus) DeepCopyInto(out *ProviderStatus) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.ApplyProgress != nil {
+		in, out := &in.ApplyProgress, &out.ApplyProgress
+		*out = new(managedresource.ApplyProgress)
+		**out = **in
+	}
 	if in.AnnotateBeforeCreate != nil {
 		in, out := &in.AnnotateBeforeCreate, &out.AnnotateBeforeCreate
 		*out = make(map[string]string, len(*in))
@@ -211,3 +317,19 @@ func (in *ProviderStatus) DeepCopyObject() runtime.Object {
 	}
 	return nil
 }
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ServerSideApplyConfiguration) DeepCopyInto(out *ServerSideApplyConfiguration) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ServerSideApplyConfiguration.
+func (in *ServerSideApplyConfiguration) DeepCopy() *ServerSideApplyConfiguration {
+	if in == nil {
+		return nil
+	}
+	out := new(ServerSideApplyConfiguration)
+	in.DeepCopyInto(out)
+	return out
+}
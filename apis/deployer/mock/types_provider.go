@@ -36,4 +36,42 @@ type ProviderConfiguration struct {
 	// ContinuousReconcile contains the schedule for continuous reconciliation.
 	// +optional
 	ContinuousReconcile *cr.ContinuousReconcileSpec `json:"continuousReconcile,omitempty"`
+
+	// Steps defines a sequence of phases the DeployItem runs through.
+	// If set, the fields Phase, InitialPhase, ProviderStatus and Export are ignored.
+	// +optional
+	Steps []Step `json:"steps,omitempty"`
+}
+
+// Step defines one step of the phase sequence of a DeployItem.
+type Step struct {
+	// Phase is the phase of the DeployItem during the step.
+	Phase lsv1alpha1.DeployItemPhase `json:"phase"`
+
+	// Duration is the time after which the next step is started.
+	// If not set, the next step is started with the next reconciliation of the DeployItem.
+	// The last step is never left.
+	// +optional
+	Duration *lsv1alpha1.Duration `json:"duration,omitempty"`
+
+	// Export sets the exported configuration to the given value when the step is started.
+	// +optional
+	Export *json.RawMessage `json:"export,omitempty"`
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// ProviderStatus is the mock deployer status of a DeployItem with a phase sequence.
+type ProviderStatus struct {
+	metav1.TypeMeta `json:",inline"`
+
+	// ObservedGeneration is the generation of the DeployItem for which the phase sequence is run.
+	// The sequence is restarted if the DeployItem is changed.
+	ObservedGeneration int64 `json:"observedGeneration"`
+
+	// Step is the index of the current step.
+	Step int `json:"step"`
+
+	// StepStartTime is the time when the current step has been started.
+	StepStartTime metav1.Time `json:"stepStartTime"`
 }
@@ -47,6 +47,26 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*ProviderStatus)(nil), (*mock.ProviderStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_ProviderStatus_To_mock_ProviderStatus(a.(*ProviderStatus), b.(*mock.ProviderStatus), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*mock.ProviderStatus)(nil), (*ProviderStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_mock_ProviderStatus_To_v1alpha1_ProviderStatus(a.(*mock.ProviderStatus), b.(*ProviderStatus), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*Step)(nil), (*mock.Step)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_Step_To_mock_Step(a.(*Step), b.(*mock.Step), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*mock.Step)(nil), (*Step)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_mock_Step_To_v1alpha1_Step(a.(*mock.Step), b.(*Step), scope)
+	}); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -78,6 +98,7 @@ func autoConvert_v1alpha1_ProviderConfiguration_To_mock_ProviderConfiguration(in
 	out.ProviderStatus = (*runtime.RawExtension)(unsafe.Pointer(in.ProviderStatus))
 	out.Export = (*json.RawMessage)(unsafe.Pointer(in.Export))
 	out.ContinuousReconcile = (*continuousreconcile.ContinuousReconcileSpec)(unsafe.Pointer(in.ContinuousReconcile))
+	out.Steps = *(*[]mock.Step)(unsafe.Pointer(&in.Steps))
 	return nil
 }
 
@@ -92,6 +113,7 @@ func autoConvert_mock_ProviderConfiguration_To_v1alpha1_ProviderConfiguration(in
 	out.ProviderStatus = (*runtime.RawExtension)(unsafe.Pointer(in.ProviderStatus))
 	out.Export = (*json.RawMessage)(unsafe.Pointer(in.Export))
 	out.ContinuousReconcile = (*continuousreconcile.ContinuousReconcileSpec)(unsafe.Pointer(in.ContinuousReconcile))
+	out.Steps = *(*[]Step)(unsafe.Pointer(&in.Steps))
 	return nil
 }
 
@@ -99,3 +121,51 @@ func autoConvert_mock_ProviderConfiguration_To_v1alpha1_ProviderConfiguration(in
 func Convert_mock_ProviderConfiguration_To_v1alpha1_ProviderConfiguration(in *mock.ProviderConfiguration, out *ProviderConfiguration, s conversion.Scope) error {
 	return autoConvert_mock_ProviderConfiguration_To_v1alpha1_ProviderConfiguration(in, out, s)
 }
+
+func autoConvert_v1alpha1_ProviderStatus_To_mock_ProviderStatus(in *ProviderStatus, out *mock.ProviderStatus, s conversion.Scope) error {
+	out.ObservedGeneration = in.ObservedGeneration
+	out.Step = in.Step
+	out.StepStartTime = in.StepStartTime
+	return nil
+}
+
+// Convert_v1alpha1_ProviderStatus_To_mock_ProviderStatus is an autogenerated conversion function.
+func Convert_v1alpha1_ProviderStatus_To_mock_ProviderStatus(in *ProviderStatus, out *mock.ProviderStatus, s conversion.Scope) error {
+	return autoConvert_v1alpha1_ProviderStatus_To_mock_ProviderStatus(in, out, s)
+}
+
+func autoConvert_mock_ProviderStatus_To_v1alpha1_ProviderStatus(in *mock.ProviderStatus, out *ProviderStatus, s conversion.Scope) error {
+	out.ObservedGeneration = in.ObservedGeneration
+	out.Step = in.Step
+	out.StepStartTime = in.StepStartTime
+	return nil
+}
+
+// Convert_mock_ProviderStatus_To_v1alpha1_ProviderStatus is an autogenerated conversion function.
+func Convert_mock_ProviderStatus_To_v1alpha1_ProviderStatus(in *mock.ProviderStatus, out *ProviderStatus, s conversion.Scope) error {
+	return autoConvert_mock_ProviderStatus_To_v1alpha1_ProviderStatus(in, out, s)
+}
+
+func autoConvert_v1alpha1_Step_To_mock_Step(in *Step, out *mock.Step, s conversion.Scope) error {
+	out.Phase = corev1alpha1.DeployItemPhase(in.Phase)
+	out.Duration = (*corev1alpha1.Duration)(unsafe.Pointer(in.Duration))
+	out.Export = (*json.RawMessage)(unsafe.Pointer(in.Export))
+	return nil
+}
+
+// Convert_v1alpha1_Step_To_mock_Step is an autogenerated conversion function.
+func Convert_v1alpha1_Step_To_mock_Step(in *Step, out *mock.Step, s conversion.Scope) error {
+	return autoConvert_v1alpha1_Step_To_mock_Step(in, out, s)
+}
+
+func autoConvert_mock_Step_To_v1alpha1_Step(in *mock.Step, out *Step, s conversion.Scope) error {
+	out.Phase = corev1alpha1.DeployItemPhase(in.Phase)
+	out.Duration = (*corev1alpha1.Duration)(unsafe.Pointer(in.Duration))
+	out.Export = (*json.RawMessage)(unsafe.Pointer(in.Export))
+	return nil
+}
+
+// Convert_mock_Step_To_v1alpha1_Step is an autogenerated conversion function.
+func Convert_mock_Step_To_v1alpha1_Step(in *mock.Step, out *Step, s conversion.Scope) error {
+	return autoConvert_mock_Step_To_v1alpha1_Step(in, out, s)
+}
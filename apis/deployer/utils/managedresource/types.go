@@ -84,6 +84,14 @@ type ManagedResourceStatus struct {
 	Resource corev1.ObjectReference `json:"resource"`
 }
 
+// ApplyProgress describes the progress of applying the manifests of a deploy item.
+type ApplyProgress struct {
+	// AppliedManifests is the number of manifests that have already been applied.
+	AppliedManifests int32 `json:"appliedManifests"`
+	// TotalManifests is the total number of manifests that are applied.
+	TotalManifests int32 `json:"totalManifests"`
+}
+
 // Exports describes one export that is read from a resource.
 type Exports struct {
 	Exports []Export `json:"exports,omitempty"`
@@ -104,8 +112,22 @@ type Export struct {
 	// FromObjectReference describes that the jsonpath points to a object reference where the actual value is read from.
 	// This is helpful if for example a deployed resource referenced a secret and that exported value is in that secret.
 	FromObjectReference *FromObjectReference `json:"fromObjectRef,omitempty"`
+
+	// Decode defines how the read value is decoded before it is exported.
+	// This is helpful if for example the value is read from the data of a secret, which is base64 encoded.
+	// Only "base64" is supported. By default, the value is exported as it is.
+	// +optional
+	Decode ExportDecoding `json:"decode,omitempty"`
 }
 
+// ExportDecoding defines how the value of an export is decoded.
+type ExportDecoding string
+
+const (
+	// ExportDecodingBase64 decodes a base64 encoded string value.
+	ExportDecodingBase64 ExportDecoding = "base64"
+)
+
 // FromObjectReference describes that the jsonpath points to a object reference where the actual value is read from.
 // This is helpful if for example a deployed resource referenced a secret and that exported value is in that secret.
 type FromObjectReference struct {
@@ -0,0 +1,39 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package managedresource
+
+// PruningPolicy defines how a managed resource is handled that is no longer defined in the manifests of a deploy item.
+type PruningPolicy string
+
+const (
+	// PruningPolicyDelete deletes a resource that is no longer defined.
+	// This is the default policy.
+	PruningPolicyDelete PruningPolicy = "delete"
+	// PruningPolicyKeep keeps a resource that is no longer defined in the cluster, but it is not managed by the deployer anymore.
+	PruningPolicyKeep PruningPolicy = "keep"
+	// PruningPolicyFail fails the deploy item if a resource is no longer defined.
+	// The resource is neither deleted nor released until it is defined again or the policy is changed.
+	PruningPolicyFail PruningPolicy = "fail"
+)
+
+// PruningConfiguration defines how the resources are handled that have been deployed by a deploy item,
+// but are no longer defined in its manifests.
+type PruningConfiguration struct {
+	// Policy is the pruning policy of the resources that do not match any rule.
+	// Defaults to "delete".
+	// +optional
+	Policy PruningPolicy `json:"policy,omitempty"`
+	// Rules define the pruning policies of specific resources. The policy of the first matching rule is used.
+	// +optional
+	Rules []PruningRule `json:"rules,omitempty"`
+}
+
+// PruningRule defines the pruning policy of the matching resources.
+type PruningRule struct {
+	// Resources are the resource types that are matched by the rule.
+	Resources []ResourceType `json:"resources"`
+	// Policy is the pruning policy of the matching resources.
+	Policy PruningPolicy `json:"policy"`
+}
@@ -45,10 +45,32 @@ func ValidateManifestExport(fldPath *field.Path, export *managedresource.Export)
 	if export.FromObjectReference != nil {
 		allErrs = append(allErrs, ValidateFromObjectReference(fldPath.Child("fromObjectRef"), export.FromObjectReference)...)
 	}
+	allErrs = append(allErrs, ValidateExportDecoding(fldPath.Child("decode"), export.Decode)...)
 
 	return allErrs
 }
 
+// ValidateExports validates the decoding of the given exports.
+func ValidateExports(fldPath *field.Path, exports *managedresource.Exports) field.ErrorList {
+	var allErrs field.ErrorList
+	if exports == nil {
+		return allErrs
+	}
+	for i, export := range exports.Exports {
+		allErrs = append(allErrs, ValidateExportDecoding(fldPath.Child("exports").Index(i).Child("decode"), export.Decode)...)
+	}
+	return allErrs
+}
+
+// ValidateExportDecoding validates the decoding of an export.
+func ValidateExportDecoding(fldPath *field.Path, decoding managedresource.ExportDecoding) field.ErrorList {
+	var allErrs field.ErrorList
+	if len(decoding) != 0 && decoding != managedresource.ExportDecodingBase64 {
+		allErrs = append(allErrs, field.NotSupported(fldPath, decoding, []string{string(managedresource.ExportDecodingBase64)}))
+	}
+	return allErrs
+}
+
 // ValidateTypedObjectReference validates a typed object reference.
 func ValidateTypedObjectReference(fldPath *field.Path, ref *lsv1alpha1.TypedObjectReference) field.ErrorList {
 	var allErrs field.ErrorList
@@ -140,3 +162,48 @@ func validateCustomResourceGroup(fldPath *field.Path, c *managedresource.CustomR
 
 	return allErrs
 }
+
+// ValidatePruning validates a pruning configuration.
+func ValidatePruning(fldPath *field.Path, pruning *managedresource.PruningConfiguration) field.ErrorList {
+	var allErrs field.ErrorList
+	if pruning == nil {
+		return allErrs
+	}
+	if len(pruning.Policy) != 0 {
+		allErrs = append(allErrs, validatePruningPolicy(fldPath.Child("policy"), pruning.Policy)...)
+	}
+	for i, rule := range pruning.Rules {
+		rulePath := fldPath.Child("rules").Index(i)
+		if len(rule.Resources) == 0 {
+			allErrs = append(allErrs, field.Required(rulePath.Child("resources"), "must not be empty"))
+		}
+		for j, res := range rule.Resources {
+			if len(res.APIVersion) == 0 {
+				allErrs = append(allErrs, field.Required(rulePath.Child("resources").Index(j).Child("apiVersion"), "must not be empty"))
+			}
+			if len(res.Kind) == 0 {
+				allErrs = append(allErrs, field.Required(rulePath.Child("resources").Index(j).Child("kind"), "must not be empty"))
+			}
+		}
+		if len(rule.Policy) == 0 {
+			allErrs = append(allErrs, field.Required(rulePath.Child("policy"), "must not be empty"))
+		} else {
+			allErrs = append(allErrs, validatePruningPolicy(rulePath.Child("policy"), rule.Policy)...)
+		}
+	}
+	return allErrs
+}
+
+func validatePruningPolicy(fldPath *field.Path, policy managedresource.PruningPolicy) field.ErrorList {
+	var allErrs field.ErrorList
+	switch policy {
+	case managedresource.PruningPolicyDelete, managedresource.PruningPolicyKeep, managedresource.PruningPolicyFail:
+	default:
+		allErrs = append(allErrs, field.NotSupported(fldPath, policy, []string{
+			string(managedresource.PruningPolicyDelete),
+			string(managedresource.PruningPolicyKeep),
+			string(managedresource.PruningPolicyFail),
+		}))
+	}
+	return allErrs
+}
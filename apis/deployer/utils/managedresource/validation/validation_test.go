@@ -104,6 +104,30 @@ var _ = Describe("Validation", func() {
 				"Field": Equal("a.fromObjectRef.jsonPath"),
 			}))))
 		})
+
+		It("should accept the base64 decoding", func() {
+			export := &managedresource.Export{
+				Key:      "abc",
+				JSONPath: "b",
+				Decode:   managedresource.ExportDecodingBase64,
+			}
+			allErrs := validation.ValidateManifestExport(fld, export)
+			Expect(allErrs).To(HaveLen(0))
+		})
+
+		It("should deny an unknown decoding", func() {
+			exports := &managedresource.Exports{
+				Exports: []managedresource.Export{
+					{Key: "abc", JSONPath: "b", Decode: managedresource.ExportDecodingBase64},
+					{Key: "def", JSONPath: "b", Decode: "hex"},
+				},
+			}
+			allErrs := validation.ValidateExports(fld, exports)
+			Expect(allErrs).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeNotSupported),
+				"Field": Equal("a.exports[1].decode"),
+			}))))
+		})
 	})
 
 	Context("Deletion groups", func() {
@@ -188,4 +212,76 @@ var _ = Describe("Validation", func() {
 		})
 
 	})
+
+	Context("Pruning", func() {
+
+		It("should accept a valid pruning configuration", func() {
+			pruning := &managedresource.PruningConfiguration{
+				Policy: managedresource.PruningPolicyKeep,
+				Rules: []managedresource.PruningRule{
+					{
+						Resources: []managedresource.ResourceType{{APIVersion: "v1", Kind: "Secret"}},
+						Policy:    managedresource.PruningPolicyFail,
+					},
+					{
+						Resources: []managedresource.ResourceType{{APIVersion: "v1", Kind: "ConfigMap", Names: []string{"a"}}},
+						Policy:    managedresource.PruningPolicyDelete,
+					},
+				},
+			}
+			Expect(validation.ValidatePruning(fld, pruning)).To(BeEmpty())
+			Expect(validation.ValidatePruning(fld, nil)).To(BeEmpty())
+		})
+
+		It("should reject unsupported policies", func() {
+			pruning := &managedresource.PruningConfiguration{
+				Policy: "orphan",
+				Rules: []managedresource.PruningRule{
+					{
+						Resources: []managedresource.ResourceType{{APIVersion: "v1", Kind: "Secret"}},
+						Policy:    "ignore",
+					},
+				},
+			}
+			allErrs := validation.ValidatePruning(fld, pruning)
+			Expect(allErrs).To(ConsistOf(
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeNotSupported),
+					"Field": Equal("a.policy"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeNotSupported),
+					"Field": Equal("a.rules[0].policy"),
+				})),
+			))
+		})
+
+		It("should reject rules without resources or policy", func() {
+			pruning := &managedresource.PruningConfiguration{
+				Rules: []managedresource.PruningRule{
+					{},
+					{
+						Resources: []managedresource.ResourceType{{APIVersion: "v1"}},
+						Policy:    managedresource.PruningPolicyKeep,
+					},
+				},
+			}
+			allErrs := validation.ValidatePruning(fld, pruning)
+			Expect(allErrs).To(ConsistOf(
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeRequired),
+					"Field": Equal("a.rules[0].resources"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeRequired),
+					"Field": Equal("a.rules[0].policy"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeRequired),
+					"Field": Equal("a.rules[1].resources[0].kind"),
+				})),
+			))
+		})
+
+	})
 })
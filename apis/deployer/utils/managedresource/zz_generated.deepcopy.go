@@ -14,6 +14,22 @@ import (
 	v1alpha1 "github.com/gardener/landscaper/apis/core/v1alpha1"
 )
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ApplyProgress) DeepCopyInto(out *ApplyProgress) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ApplyProgress.
+func (in *ApplyProgress) DeepCopy() *ApplyProgress {
+	if in == nil {
+		return nil
+	}
+	out := new(ApplyProgress)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *CustomResourceGroup) DeepCopyInto(out *CustomResourceGroup) {
 	*out = *in
@@ -225,6 +241,52 @@ func (in *PredefinedResourceGroup) DeepCopy() *PredefinedResourceGroup {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PruningConfiguration) DeepCopyInto(out *PruningConfiguration) {
+	*out = *in
+	if in.Rules != nil {
+		in, out := &in.Rules, &out.Rules
+		*out = make([]PruningRule, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PruningConfiguration.
+func (in *PruningConfiguration) DeepCopy() *PruningConfiguration {
+	if in == nil {
+		return nil
+	}
+	out := new(PruningConfiguration)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PruningRule) DeepCopyInto(out *PruningRule) {
+	*out = *in
+	if in.Resources != nil {
+		in, out := &in.Resources, &out.Resources
+		*out = make([]ResourceType, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PruningRule.
+func (in *PruningRule) DeepCopy() *PruningRule {
+	if in == nil {
+		return nil
+	}
+	out := new(PruningRule)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ResourceType) DeepCopyInto(out *ResourceType) {
 	*out = *in
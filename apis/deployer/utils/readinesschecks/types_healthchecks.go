@@ -36,6 +36,10 @@ type CustomReadinessCheckConfiguration struct {
 	LabelSelector *LabelSelectorSpec `json:"labelSelector,omitempty"`
 	// Requirements is the actual readiness check which compares an object's property to a value
 	Requirements []RequirementSpec `json:"requirements"`
+	// Timeout is the maximal time to wait until the requirements of this readiness check are fulfilled.
+	// It is limited by the remaining time of the timeout of the deploy item, which is used if no timeout is set.
+	// +optional
+	Timeout *lsv1alpha1.Duration `json:"timeout,omitempty"`
 }
 
 // LabelSelectorSpec contains paramters used to select objects by their labels
@@ -51,6 +51,10 @@ func ValidateCustomReadinessCheckConfiguration(fldPath *field.Path, config *read
 		allErrs = append(allErrs, ValidateRequirementSpec(fldPath.Child("requirements"), &r)...)
 	}
 
+	if config.Timeout != nil && config.Timeout.Duration <= 0 {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("timeout"), config.Timeout.Duration.String(), "must be positive"))
+	}
+
 	return allErrs
 }
 
@@ -6,6 +6,7 @@ package validation_test
 
 import (
 	"testing"
+	"time"
 
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
@@ -139,6 +140,20 @@ var _ = Describe("Validation", func() {
 		Expect(allErrs).To(HaveLen(1))
 	})
 
+	It("should accept a custom readiness check with a positive timeout", func() {
+		rc.CustomReadinessChecks[0].Timeout = &lsv1alpha1.Duration{Duration: 2 * time.Minute}
+
+		allErrs := validation.ValidateReadinessCheckConfiguration(fld, &rc)
+		Expect(allErrs).To(HaveLen(0))
+	})
+
+	It("should reject a custom readiness check with a timeout that is not positive", func() {
+		rc.CustomReadinessChecks[0].Timeout = &lsv1alpha1.Duration{Duration: 0}
+
+		allErrs := validation.ValidateReadinessCheckConfiguration(fld, &rc)
+		Expect(allErrs).To(HaveLen(1))
+	})
+
 	It("should only accept allowed operators in a requirement spec", func() {
 		var allErrs field.ErrorList
 
@@ -34,6 +34,11 @@ func (in *CustomReadinessCheckConfiguration) DeepCopyInto(out *CustomReadinessCh
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.Timeout != nil {
+		in, out := &in.Timeout, &out.Timeout
+		*out = new(v1alpha1.Duration)
+		**out = **in
+	}
 	return
 }
 
@@ -0,0 +1,173 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package errors
+
+import (
+	"encoding/json"
+	"net/http"
+
+	lsv1alpha1 "github.com/gardener/landscaper/apis/core/v1alpha1"
+)
+
+// CatalogPath is the path under which the error catalog is served by the landscaper.
+const CatalogPath = "/errors"
+
+// Catalog lists the error codes and reasons that are set in the "lastError" of landscaper objects.
+// Automation should branch on the codes and reasons of the catalog instead of parsing error messages.
+type Catalog struct {
+	// Codes are the error codes.
+	Codes []ErrorCodeEntry `json:"codes"`
+	// Reasons are the error reasons.
+	Reasons []ErrorReasonEntry `json:"reasons"`
+}
+
+// ErrorCodeEntry describes an error code.
+type ErrorCodeEntry struct {
+	// Code is the error code.
+	Code lsv1alpha1.ErrorCode `json:"code"`
+	// Description describes the cause of the error.
+	Description string `json:"description"`
+	// Unrecoverable defines whether an error with this code is not retried and fails the object.
+	Unrecoverable bool `json:"unrecoverable"`
+}
+
+// ErrorReasonEntry describes an error reason.
+type ErrorReasonEntry struct {
+	// Reason is the error reason.
+	Reason string `json:"reason"`
+	// Operation is the operation of the error, if the reason is always set with the same operation.
+	Operation string `json:"operation,omitempty"`
+	// Codes are the error codes that are set together with the reason.
+	Codes []lsv1alpha1.ErrorCode `json:"codes,omitempty"`
+	// Description describes the cause of the error.
+	Description string `json:"description"`
+}
+
+var errorCodeDescriptions = []ErrorCodeEntry{
+	{Code: lsv1alpha1.ErrorUnauthorized, Description: "The credentials to access a cluster or registry are invalid."},
+	{Code: lsv1alpha1.ErrorCleanupResources, Description: "Resources are stuck in deletion."},
+	{Code: lsv1alpha1.ErrorConfigurationProblem, Description: "The configuration of an object is invalid."},
+	{Code: lsv1alpha1.ErrorInternalProblem, Description: "A severe internal error occurred."},
+	{Code: lsv1alpha1.ErrorTimeout, Description: "An operation timed out."},
+	{Code: lsv1alpha1.ErrorCyclicDependencies, Description: "There are cyclic dependencies between installations or deploy items."},
+	{Code: lsv1alpha1.ErrorWebhook, Description: "There is an intermediate problem with a webhook."},
+	{Code: lsv1alpha1.ErrorUnfinished, Description: "There are unfinished sub-objects."},
+	{Code: lsv1alpha1.ErrorForInfoOnly, Description: "The error is no real error but an information."},
+	{Code: lsv1alpha1.ErrorNoRetry, Description: "The operation is not retried."},
+	{Code: lsv1alpha1.ErrorQuotaExceeded, Description: "The resource quotas of a target namespace do not admit the deployed resources."},
+}
+
+var errorReasons = []ErrorReasonEntry{
+	{
+		Reason:      lsv1alpha1.PickupTimeoutReason,
+		Operation:   lsv1alpha1.PickupTimeoutOperation,
+		Codes:       []lsv1alpha1.ErrorCode{lsv1alpha1.ErrorTimeout},
+		Description: "No deployer has picked up the deploy item within the pickup timeout.",
+	},
+	{
+		Reason:      lsv1alpha1.ProgressingTimeoutReason,
+		Codes:       []lsv1alpha1.ErrorCode{lsv1alpha1.ErrorTimeout},
+		Description: "The deployer has not finished the deploy item within the progressing timeout.",
+	},
+	{
+		Reason:      lsv1alpha1.AbortTimeoutReason,
+		Operation:   lsv1alpha1.AbortTimeoutOperation,
+		Codes:       []lsv1alpha1.ErrorCode{lsv1alpha1.ErrorTimeout},
+		Description: "The deployer has not aborted the deploy item within the abort timeout.",
+	},
+	{
+		Reason:      lsv1alpha1.ImportNotFoundReason,
+		Operation:   lsv1alpha1.ImportNotFoundReason,
+		Description: "An import of the installation could not be found.",
+	},
+	{
+		Reason:      lsv1alpha1.ImportNotSatisfiedReason,
+		Operation:   lsv1alpha1.ImportNotSatisfiedReason,
+		Description: "An import of the installation is not satisfied.",
+	},
+	{
+		Reason:      lsv1alpha1.InvalidDefaultValueReason,
+		Operation:   lsv1alpha1.InvalidDefaultValueReason,
+		Description: "The default value of an import is invalid.",
+	},
+	{
+		Reason:      lsv1alpha1.NotCompletedDependentsReason,
+		Operation:   lsv1alpha1.NotCompletedDependentsReason,
+		Description: "An installation the installation depends on is not completed.",
+	},
+	{
+		Reason:      lsv1alpha1.SchemaValidationFailedReason,
+		Operation:   lsv1alpha1.SchemaValidationFailedReason,
+		Description: "An import does not match the schema defined in the blueprint.",
+	},
+	{
+		Reason:      lsv1alpha1.AmbiguousImportReason,
+		Operation:   lsv1alpha1.AmbiguousImportReason,
+		Description: "An import of an installation with strict import ownership is provided by another source than the recorded one.",
+	},
+	{
+		Reason:      lsv1alpha1.ImportValidationFailedReason,
+		Description: "The import executions or the validation of the imports failed.",
+	},
+	{
+		Reason:      lsv1alpha1.TemplatingFailedReason,
+		Description: "The templating of the deploy items or subinstallations failed.",
+	},
+	{
+		Reason:      lsv1alpha1.WaitingForApprovalReason,
+		Codes:       []lsv1alpha1.ErrorCode{lsv1alpha1.ErrorForInfoOnly},
+		Description: "The installation waits for the approval of its approval hook.",
+	},
+	{
+		Reason:      lsv1alpha1.ApprovalNotGrantedReason,
+		Description: "The approval hook of the installation denied the approval.",
+	},
+	{
+		Reason:      lsv1alpha1.QuotaExceededReason,
+		Operation:   "CheckResourceQuotas",
+		Codes:       []lsv1alpha1.ErrorCode{lsv1alpha1.ErrorQuotaExceeded},
+		Description: "The resource quotas of a target namespace do not admit the deployed resources.",
+	},
+	{
+		Reason:      lsv1alpha1.PruningNotAllowedReason,
+		Operation:   "ApplyObjects",
+		Codes:       []lsv1alpha1.ErrorCode{lsv1alpha1.ErrorConfigurationProblem},
+		Description: "Resources are no longer defined in the manifests of the deploy item, but their pruning policy is \"fail\".",
+	},
+	{
+		Reason:      lsv1alpha1.AbortedReason,
+		Description: "The processing of the object was aborted by an abort or interrupt operation.",
+	},
+	{
+		Reason:      lsv1alpha1.CyclicDependencyReason,
+		Codes:       []lsv1alpha1.ErrorCode{lsv1alpha1.ErrorCyclicDependencies},
+		Description: "The installations or deploy items depend on each other, so that no processing order exists.",
+	},
+}
+
+// GetCatalog returns the catalog of all error codes and reasons.
+func GetCatalog() Catalog {
+	catalog := Catalog{
+		Codes:   make([]ErrorCodeEntry, len(errorCodeDescriptions)),
+		Reasons: make([]ErrorReasonEntry, len(errorReasons)),
+	}
+	for i, entry := range errorCodeDescriptions {
+		entry.Unrecoverable = HasErrorCode(lsv1alpha1.UnrecoverableErrorCodes, entry.Code)
+		catalog.Codes[i] = entry
+	}
+	for i, entry := range errorReasons {
+		entry.Codes = append([]lsv1alpha1.ErrorCode(nil), entry.Codes...)
+		catalog.Reasons[i] = entry
+	}
+	return catalog
+}
+
+// CatalogHandler returns a http handler that serves the error catalog as json.
+func CatalogHandler() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(GetCatalog())
+	})
+}
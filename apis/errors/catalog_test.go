@@ -0,0 +1,55 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package errors_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	lsv1alpha1 "github.com/gardener/landscaper/apis/core/v1alpha1"
+	lserrors "github.com/gardener/landscaper/apis/errors"
+)
+
+var _ = Describe("Catalog", func() {
+
+	It("should contain all unrecoverable error codes", func() {
+		catalog := lserrors.GetCatalog()
+		unrecoverable := []lsv1alpha1.ErrorCode{}
+		for _, entry := range catalog.Codes {
+			Expect(entry.Description).ToNot(BeEmpty())
+			if entry.Unrecoverable {
+				unrecoverable = append(unrecoverable, entry.Code)
+			}
+		}
+		Expect(unrecoverable).To(ConsistOf(lsv1alpha1.UnrecoverableErrorCodes))
+	})
+
+	It("should only reference error codes of the catalog", func() {
+		catalog := lserrors.GetCatalog()
+		codes := []lsv1alpha1.ErrorCode{}
+		for _, entry := range catalog.Codes {
+			codes = append(codes, entry.Code)
+		}
+		for _, entry := range catalog.Reasons {
+			Expect(entry.Reason).ToNot(BeEmpty())
+			Expect(codes).To(ContainElements(entry.Codes))
+		}
+	})
+
+	It("should serve the catalog as json", func() {
+		recorder := httptest.NewRecorder()
+		lserrors.CatalogHandler().ServeHTTP(recorder, httptest.NewRequest(http.MethodGet, lserrors.CatalogPath, nil))
+		Expect(recorder.Code).To(Equal(http.StatusOK))
+		Expect(recorder.Header().Get("Content-Type")).To(Equal("application/json"))
+
+		catalog := lserrors.Catalog{}
+		Expect(json.Unmarshal(recorder.Body.Bytes(), &catalog)).To(Succeed())
+		Expect(catalog).To(Equal(lserrors.GetCatalog()))
+	})
+})
@@ -90,11 +90,31 @@ oci:
   - /app/ls/registry/secrets/{{ $key }}
   {{- end }}
   {{- end }}
+  {{- with .Values.deployer.oci.credentialHelpers }}
+  credentialHelpers:
+{{ toYaml . | indent 2 }}
+  {{- end }}
+  {{- with .Values.deployer.oci.registries }}
+  registries:
+{{ toYaml . | indent 2 }}
+  {{- end }}
 {{- end }}
 {{- with .Values.deployer.targetSelector }}
 targetSelector:
 {{ toYaml . }}
 {{- end }}
+{{- with .Values.deployer.failureLogs }}
+failureLogs:
+{{ toYaml . | indent 2 }}
+{{- end }}
+{{- with .Values.deployer.stateEncryption }}
+stateEncryption:
+{{ toYaml . | indent 2 }}
+{{- end }}
+{{- with .Values.deployer.allowedServiceAccounts }}
+allowedServiceAccounts:
+{{ toYaml . }}
+{{- end }}
 {{- if .Values.hpa }}
 hpa:
 {{ .Values.hpa | toYaml | indent 2 }}
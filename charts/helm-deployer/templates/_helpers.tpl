@@ -82,6 +82,18 @@ oci:
   - /app/ls/registry/secrets/{{ $key }}
   {{- end }}
   {{- end }}
+  {{- with .Values.deployer.oci.credentialHelpers }}
+  credentialHelpers:
+{{ toYaml . | indent 2 }}
+  {{- end }}
+  {{- with .Values.deployer.oci.registries }}
+  registries:
+{{ toYaml . | indent 2 }}
+  {{- end }}
+{{- end }}
+{{- with .Values.deployer.helmChartRepoCredentials }}
+helmChartRepoCredentials:
+{{ toYaml . | indent 2 }}
 {{- end }}
 {{- with .Values.deployer.targetSelector }}
 targetSelector:
@@ -95,6 +107,10 @@ hpa:
 controller:
 {{ .Values.deployer.controller | toYaml | indent 2 }}
 {{- end }}
+{{- with .Values.deployer.targetClient }}
+targetClient:
+{{ toYaml . | indent 2 }}
+{{- end }}
 {{- if .Values.useOCMLib }}
 useOCMLib: true
 {{- end }}
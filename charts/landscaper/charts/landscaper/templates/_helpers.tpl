@@ -136,10 +136,26 @@ registry:
       {{- range $key, $value := .Values.landscaper.registryConfig.secrets }}
       - /app/ls/registry/secrets/{{ $key }}
       {{- end }}
+      {{- end }}
+      {{- with .Values.landscaper.registryConfig.credentialHelpers }}
+      credentialHelpers:
+{{ toYaml . | indent 6 }}
+      {{- end }}
+      {{- with .Values.landscaper.registryConfig.registries }}
+      registries:
+{{ toYaml . | indent 6 }}
       {{- end }}
       cache:
         path: /app/ls/oci-cache/
         useInMemoryOverlay: {{ .Values.landscaper.registryConfig.cache.useInMemoryOverlay | default false }}
+    {{- if .Values.landscaper.registryConfig.componentVersionCache }}
+    componentVersionCache:
+{{ .Values.landscaper.registryConfig.componentVersionCache | toYaml | indent 6 }}
+    {{- end }}
+    {{- with .Values.landscaper.registryConfig.archives }}
+    archives:
+{{ toYaml . | indent 6 }}
+    {{- end }}
 {{ end }}
 {{- if .Values.landscaper.metrics }}
 metrics:
@@ -193,6 +209,50 @@ hpaMain:
 useOCMLib: true
 {{- end }}
 
+{{- if .Values.landscaper.featureGates }}
+featureGates:
+{{ .Values.landscaper.featureGates | toYaml | indent 2 }}
+{{- end }}
+
+{{- if .Values.landscaper.approvalHooks }}
+approvalHooks:
+{{ .Values.landscaper.approvalHooks | toYaml | indent 2 }}
+{{- end }}
+
+{{- if .Values.landscaper.writeAudit }}
+writeAudit:
+{{ .Values.landscaper.writeAudit | toYaml | indent 2 }}
+{{- end }}
+
+{{- if .Values.landscaper.blueprintStore }}
+blueprintStore:
+{{ .Values.landscaper.blueprintStore | toYaml | indent 2 }}
+{{- end }}
+
+{{- if .Values.landscaper.schemaStore }}
+schemaStore:
+{{ .Values.landscaper.schemaStore | toYaml | indent 2 }}
+{{- end }}
+
+{{- if .Values.landscaper.httpImports }}
+httpImports:
+{{ .Values.landscaper.httpImports | toYaml | indent 2 }}
+{{- end }}
+
+{{- if .Values.landscaper.rejectLocalKubeconfigCredentials }}
+rejectLocalKubeconfigCredentials: true
+{{- end }}
+
+{{- if .Values.landscaper.componentOverwrites }}
+componentOverwrites:
+{{ .Values.landscaper.componentOverwrites | toYaml | indent 2 }}
+{{- end }}
+
+{{- if .Values.landscaper.encryption }}
+encryption:
+{{ omit .Values.landscaper.encryption "secretRef" | toYaml | indent 2 }}
+{{- end }}
+
 {{- end }}
 
 {{- define "landscaper-image" -}}
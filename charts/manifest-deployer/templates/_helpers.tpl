@@ -84,6 +84,10 @@ hpa:
 controller:
 {{ .Values.deployer.controller | toYaml | indent 2 }}
 {{- end }}
+{{- with .Values.deployer.targetClient }}
+targetClient:
+{{ toYaml . | indent 2 }}
+{{- end }}
 {{- if .Values.useOCMLib }}
 useOCMLib: true
 {{- end }}
@@ -8,9 +8,11 @@ import (
 	"context"
 	"fmt"
 
+	"github.com/mandelsoft/vfs/pkg/osfs"
 	"github.com/spf13/cobra"
 
 	lc "github.com/gardener/landscaper/controller-utils/pkg/logging/constants"
+	"github.com/gardener/landscaper/pkg/components/registryconnections"
 	containerctlr "github.com/gardener/landscaper/pkg/deployer/container"
 	"github.com/gardener/landscaper/pkg/version"
 )
@@ -36,6 +38,9 @@ func NewContainerDeployerControllerCommand(ctx context.Context) *cobra.Command {
 
 func (o *options) run(ctx context.Context) error {
 	o.DeployerOptions.Log.Info("Starting Container Deployer", lc.KeyVersion, version.Get().GitVersion)
+	if err := registryconnections.Load(ctx, osfs.New(), o.DeployerOptions.HostUncachedClient, o.Config.OCI); err != nil {
+		return fmt.Errorf("unable to load oci registry connections: %w", err)
+	}
 
 	gc, err := containerctlr.AddControllerToManager(
 		o.DeployerOptions.LsUncachedClient, o.DeployerOptions.LsCachedClient, o.DeployerOptions.HostUncachedClient, o.DeployerOptions.HostCachedClient,
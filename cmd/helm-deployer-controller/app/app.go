@@ -10,8 +10,10 @@ import (
 
 	lc "github.com/gardener/landscaper/controller-utils/pkg/logging/constants"
 
+	"github.com/mandelsoft/vfs/pkg/osfs"
 	"github.com/spf13/cobra"
 
+	"github.com/gardener/landscaper/pkg/components/registryconnections"
 	helmctrl "github.com/gardener/landscaper/pkg/deployer/helm"
 	"github.com/gardener/landscaper/pkg/version"
 )
@@ -37,6 +39,9 @@ func NewHelmDeployerControllerCommand(ctx context.Context) *cobra.Command {
 
 func (o *options) run(ctx context.Context) error {
 	o.DeployerOptions.Log.Info("Starting helm deployer", lc.KeyVersion, version.Get().GitVersion)
+	if err := registryconnections.Load(ctx, osfs.New(), o.DeployerOptions.HostUncachedClient, o.Config.OCI); err != nil {
+		return fmt.Errorf("unable to load oci registry connections: %w", err)
+	}
 	if err := helmctrl.AddDeployerToManager(
 		o.DeployerOptions.LsUncachedClient, o.DeployerOptions.LsCachedClient, o.DeployerOptions.HostUncachedClient, o.DeployerOptions.HostCachedClient,
 		o.DeployerOptions.FinishedObjectCache,
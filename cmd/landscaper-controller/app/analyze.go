@@ -0,0 +1,144 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package app
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	"github.com/spf13/cobra"
+	flag "github.com/spf13/pflag"
+	"k8s.io/client-go/rest"
+	"k8s.io/client-go/tools/clientcmd"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/yaml"
+
+	"github.com/gardener/landscaper/controller-utils/pkg/logging"
+	"github.com/gardener/landscaper/pkg/api"
+	"github.com/gardener/landscaper/pkg/landscaper/analyze"
+)
+
+// AnalyzeOptions describes the options of the analyze command.
+type AnalyzeOptions struct {
+	// Installation is the namespaced name of the analyzed installation in the format "namespace/name".
+	Installation             string
+	OutputPath               string
+	landscaperKubeconfigPath string
+}
+
+// NewAnalyzeCommand creates a new command that dumps and analyzes the object tree of an installation.
+func NewAnalyzeCommand(ctx context.Context) *cobra.Command {
+	options := &AnalyzeOptions{}
+
+	cmd := &cobra.Command{
+		Use:   "analyze",
+		Short: "Collects the object tree of an installation into a redacted bundle for support cases",
+		Long: "Collects an installation together with its subinstallations, executions, deploy items, data objects, " +
+			"targets and events into a single bundle, and analyzes it for failed objects, missing imports and warnings. " +
+			"All configurations and data in the bundle are redacted.",
+		Example: "landscaper-controller analyze --installation my-namespace/my-installation --output bundle.yaml",
+
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if err := options.Validate(); err != nil {
+				return err
+			}
+			return options.Run(ctx, cmd.OutOrStdout())
+		},
+	}
+
+	options.AddFlags(cmd.Flags())
+	return cmd
+}
+
+func (o *AnalyzeOptions) AddFlags(fs *flag.FlagSet) {
+	fs.StringVar(&o.Installation, "installation", "", "Specify the installation in the format namespace/name")
+	fs.StringVarP(&o.OutputPath, "output", "o", "", "Specify the path of the bundle file, defaults to stdout")
+	fs.StringVar(&o.landscaperKubeconfigPath, "landscaper-kubeconfig", "", "Specify the path to the landscaper kubeconfig cluster")
+}
+
+// Validate validates the options.
+func (o *AnalyzeOptions) Validate() error {
+	if _, err := o.installationKey(); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Run collects the bundle and writes it as yaml to the output file resp. to the given writer.
+func (o *AnalyzeOptions) Run(ctx context.Context, out io.Writer) error {
+	key, err := o.installationKey()
+	if err != nil {
+		return err
+	}
+
+	restConfig, err := o.restConfig()
+	if err != nil {
+		return err
+	}
+	lsClient, err := client.New(restConfig, client.Options{Scheme: api.LandscaperScheme})
+	if err != nil {
+		return fmt.Errorf("unable to build landscaper cluster client: %w", err)
+	}
+
+	bundle, err := analyze.Collect(logging.NewContextWithDiscard(ctx), lsClient, key)
+	if err != nil {
+		return err
+	}
+
+	data, err := yaml.Marshal(bundle)
+	if err != nil {
+		return fmt.Errorf("unable to marshal bundle: %w", err)
+	}
+
+	if len(o.OutputPath) != 0 {
+		if err := os.WriteFile(o.OutputPath, data, 0600); err != nil {
+			return fmt.Errorf("unable to write bundle to %s: %w", o.OutputPath, err)
+		}
+		_, err = fmt.Fprintf(out, "bundle of installation %s with %d findings written to %s\n", key.String(), len(bundle.Findings), o.OutputPath)
+		return err
+	}
+	_, err = out.Write(data)
+	return err
+}
+
+func (o *AnalyzeOptions) installationKey() (client.ObjectKey, error) {
+	return parseInstallationKey(o.Installation)
+}
+
+func (o *AnalyzeOptions) restConfig() (*rest.Config, error) {
+	return landscaperRestConfig(o.landscaperKubeconfigPath)
+}
+
+// parseInstallationKey parses an installation in the format "namespace/name".
+func parseInstallationKey(installation string) (client.ObjectKey, error) {
+	namespace, name, found := strings.Cut(installation, "/")
+	if !found || len(namespace) == 0 || len(name) == 0 {
+		return client.ObjectKey{}, errors.New("the installation has to be specified in the format namespace/name")
+	}
+	return client.ObjectKey{Namespace: namespace, Name: name}, nil
+}
+
+// landscaperRestConfig returns the rest config of the landscaper cluster from the given kubeconfig,
+// or from the environment if no kubeconfig is given.
+func landscaperRestConfig(kubeconfigPath string) (*rest.Config, error) {
+	if len(kubeconfigPath) == 0 {
+		return ctrl.GetConfig()
+	}
+
+	data, err := os.ReadFile(kubeconfigPath)
+	if err != nil {
+		return nil, fmt.Errorf("unable to read landscaper kubeconfig from %s: %w", kubeconfigPath, err)
+	}
+	restConfig, err := clientcmd.RESTConfigFromKubeConfig(data)
+	if err != nil {
+		return nil, fmt.Errorf("unable to build landscaper cluster rest config: %w", err)
+	}
+	return restConfig, nil
+}
@@ -7,13 +7,14 @@ package app
 import (
 	"context"
 	"fmt"
+	"net/http"
 	"os"
 	"time"
 
 	"github.com/mandelsoft/vfs/pkg/osfs"
 	"github.com/spf13/cobra"
 	"golang.org/x/sync/errgroup"
-	"k8s.io/client-go/tools/clientcmd"
+	"k8s.io/client-go/rest"
 	"k8s.io/utils/ptr"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/cache"
@@ -23,21 +24,33 @@ import (
 	metricsserver "sigs.k8s.io/controller-runtime/pkg/metrics/server"
 	"sigs.k8s.io/yaml"
 
+	"github.com/gardener/landscaper/apis/config"
 	"github.com/gardener/landscaper/apis/core/install"
+	lserrors "github.com/gardener/landscaper/apis/errors"
 	"github.com/gardener/landscaper/controller-utils/pkg/logging"
 	lc "github.com/gardener/landscaper/controller-utils/pkg/logging/constants"
 	"github.com/gardener/landscaper/pkg/components/cache/blueprint"
+	"github.com/gardener/landscaper/pkg/components/registries/archive"
+	"github.com/gardener/landscaper/pkg/components/registries/git"
+	"github.com/gardener/landscaper/pkg/components/registryconnections"
 	contextctrl "github.com/gardener/landscaper/pkg/landscaper/controllers/context"
+	deployerregistrationctrl "github.com/gardener/landscaper/pkg/landscaper/controllers/deployerregistration"
 	deployitemctrl "github.com/gardener/landscaper/pkg/landscaper/controllers/deployitem"
 	executionactrl "github.com/gardener/landscaper/pkg/landscaper/controllers/execution"
 	"github.com/gardener/landscaper/pkg/landscaper/controllers/healthcheck"
 	installationsctrl "github.com/gardener/landscaper/pkg/landscaper/controllers/installations"
+	landscapehealthctrl "github.com/gardener/landscaper/pkg/landscaper/controllers/landscapehealth"
 	"github.com/gardener/landscaper/pkg/landscaper/controllers/targetsync"
+	testrunctrl "github.com/gardener/landscaper/pkg/landscaper/controllers/testrun"
 	"github.com/gardener/landscaper/pkg/landscaper/crdmanager"
+	"github.com/gardener/landscaper/pkg/landscaper/installations"
+	"github.com/gardener/landscaper/pkg/landscaper/jsonschema"
 	"github.com/gardener/landscaper/pkg/metrics"
 	lsutils "github.com/gardener/landscaper/pkg/utils"
+	"github.com/gardener/landscaper/pkg/utils/encryption"
 	"github.com/gardener/landscaper/pkg/utils/lock"
 	"github.com/gardener/landscaper/pkg/utils/monitoring"
+	"github.com/gardener/landscaper/pkg/utils/read_write_layer"
 	"github.com/gardener/landscaper/pkg/version"
 )
 
@@ -62,6 +75,8 @@ func NewLandscaperControllerCommand(ctx context.Context) *cobra.Command {
 	}
 
 	options.AddFlags(cmd.Flags())
+	cmd.AddCommand(NewAnalyzeCommand(ctx))
+	cmd.AddCommand(NewStatusCommand(ctx))
 
 	return cmd
 }
@@ -76,7 +91,11 @@ func (o *Options) run(ctx context.Context) error {
 	}
 	_, _ = fmt.Fprintln(os.Stderr, string(configBytes))
 
-	hostAndResourceClusterDifferent := len(o.landscaperKubeconfigPath) > 0
+	hostRestConfig, lsRestConfig, err := o.getRestConfigs()
+	if err != nil {
+		return err
+	}
+	hostAndResourceClusterDifferent := lsRestConfig != nil
 
 	burst, qps := lsutils.GetHostClientRequestRestrictions(setupLogger, hostAndResourceClusterDifferent)
 
@@ -93,9 +112,11 @@ func (o *Options) run(ctx context.Context) error {
 
 	if o.Config.Metrics != nil {
 		opts.Metrics.BindAddress = fmt.Sprintf(":%d", o.Config.Metrics.Port)
+		opts.Metrics.ExtraHandlers = map[string]http.Handler{
+			lserrors.CatalogPath: lserrors.CatalogHandler(),
+		}
 	}
 
-	hostRestConfig := ctrl.GetConfigOrDie()
 	hostRestConfig = lsutils.RestConfigWithModifiedClientRequestRestrictions(setupLogger, hostRestConfig, burst, qps)
 
 	hostMgr, err := ctrl.NewManager(hostRestConfig, opts)
@@ -105,21 +126,12 @@ func (o *Options) run(ctx context.Context) error {
 
 	lsMgr := hostMgr
 	if hostAndResourceClusterDifferent {
-		data, err := os.ReadFile(o.landscaperKubeconfigPath)
-		if err != nil {
-			return fmt.Errorf("unable to read landscaper kubeconfig from %s: %w", o.landscaperKubeconfigPath, err)
-		}
-
-		lsRestConfig, err := clientcmd.RESTConfigFromKubeConfig(data)
-		if err != nil {
-			return fmt.Errorf("unable to build landscaper cluster rest client: %w", err)
-		}
 		burst, qps = lsutils.GetResourceClientRequestRestrictions(setupLogger)
 		lsRestConfig = lsutils.RestConfigWithModifiedClientRequestRestrictions(setupLogger, lsRestConfig, burst, qps)
 
 		lsMgr, err = ctrl.NewManager(lsRestConfig, opts)
 		if err != nil {
-			return fmt.Errorf("unable to setup landscaper cluster manager from %s: %w", o.landscaperKubeconfigPath, err)
+			return fmt.Errorf("unable to setup landscaper cluster manager: %w", err)
 		}
 	}
 
@@ -141,6 +153,28 @@ func (o *Options) run(ctx context.Context) error {
 		return err
 	}
 
+	read_write_layer.SetAuditRecorder(read_write_layer.NewAuditRecorderFromConfig(lsUncachedClient,
+		lsMgr.GetEventRecorderFor("landscaper-write-audit"), o.Config.WriteAudit))
+
+	encryptor, err := encryption.NewFromConfig(o.Config.Encryption)
+	if err != nil {
+		return fmt.Errorf("unable to setup the encryption: %w", err)
+	}
+	if encryptor != nil {
+		read_write_layer.SetDeployItemEncryptor(encryptor)
+	}
+
+	lsutils.SetRejectLocalKubeconfigCredentials(o.Config.RejectLocalKubeconfigCredentials)
+
+	if err := registryconnections.Load(ctx, osfs.New(), hostUncachedClient, o.Config.Registry.OCI); err != nil {
+		return fmt.Errorf("unable to load oci registry connections: %w", err)
+	}
+
+	if o.DevMode.Enabled {
+		return o.startDevMode(ctx, hostRestConfig, lsUncachedClient, lsCachedClient, hostUncachedClient, hostCachedClient,
+			lsMgr, hostMgr, ctrlLogger, setupLogger)
+	}
+
 	if os.Getenv("LANDSCAPER_MODE") == "central-landscaper" {
 		return o.startCentralLandscaper(ctx, lsUncachedClient, lsCachedClient, hostUncachedClient, hostCachedClient,
 			lsMgr, hostMgr, ctrlLogger, setupLogger)
@@ -150,16 +184,95 @@ func (o *Options) run(ctx context.Context) error {
 	}
 }
 
+// getRestConfigs returns the rest configs of the host cluster and of the resource cluster.
+// The rest config of the resource cluster is nil if the landscaper resources are located in the host cluster.
+func (o *Options) getRestConfigs() (hostRestConfig, lsRestConfig *rest.Config, err error) {
+	var hostCluster, resourceCluster *config.ClusterConfiguration
+	if o.Config.Clusters != nil {
+		hostCluster = o.Config.Clusters.HostCluster
+		resourceCluster = o.Config.Clusters.ResourceCluster
+	}
+	if len(o.landscaperKubeconfigPath) > 0 {
+		resourceCluster = &config.ClusterConfiguration{Kubeconfig: o.landscaperKubeconfigPath}
+	}
+
+	hostRestConfig, err = lsutils.RestConfigFromClusterConfiguration(hostCluster)
+	if err != nil {
+		return nil, nil, fmt.Errorf("unable to build host cluster rest config: %w", err)
+	}
+	if hostRestConfig == nil {
+		hostRestConfig = ctrl.GetConfigOrDie()
+	}
+
+	lsRestConfig, err = lsutils.RestConfigFromClusterConfiguration(resourceCluster)
+	if err != nil {
+		return nil, nil, fmt.Errorf("unable to build landscaper cluster rest config: %w", err)
+	}
+	return hostRestConfig, lsRestConfig, nil
+}
+
 func (o *Options) startMainController(ctx context.Context,
 	lsUncachedClient, lsCachedClient, hostUncachedClient, hostCachedClient client.Client,
 	lsMgr, hostMgr manager.Manager, ctrlLogger, setupLogger logging.Logger) error {
 
+	if err := o.addMainControllers(ctx, lsUncachedClient, lsCachedClient, hostUncachedClient, hostCachedClient,
+		lsMgr, hostMgr, ctrlLogger); err != nil {
+		return err
+	}
+
+	return startManagers(ctx, lsMgr, hostMgr, setupLogger)
+}
+
+// addMainControllers adds the installation and execution controllers to the managers.
+func (o *Options) addMainControllers(ctx context.Context,
+	lsUncachedClient, lsCachedClient, hostUncachedClient, hostCachedClient client.Client,
+	lsMgr, hostMgr manager.Manager, ctrlLogger logging.Logger) error {
+
 	store, err := blueprint.NewStore(o.Log.WithName("blueprintStore"), osfs.New(), o.Config.BlueprintStore)
 	if err != nil {
 		return fmt.Errorf("unable to setup blueprint store: %w", err)
 	}
 	blueprint.SetStore(store)
 
+	var ociCacheConfig *config.OCICacheConfiguration
+	if o.Config.Registry.OCI != nil {
+		ociCacheConfig = o.Config.Registry.OCI.Cache
+	}
+	blobCache, err := blueprint.NewBlobCacheFromConfig(o.Log.WithName("blueprintBlobCache"), osfs.New(),
+		o.Config.BlueprintStore.BlobCache, ociCacheConfig)
+	if err != nil {
+		return fmt.Errorf("unable to setup blueprint blob cache: %w", err)
+	}
+	blueprint.SetBlobCache(blobCache)
+
+	if o.Config.SchemaStore != nil {
+		jsonschema.SetSchemaStore(jsonschema.NewSchemaStore(*o.Config.SchemaStore))
+	}
+	installations.SetGlobalComponentOverwrites(o.Config.ComponentOverwrites)
+	installations.SetHTTPImportsConfiguration(o.Config.HTTPImports)
+
+	if o.Config.Registry.Git != nil {
+		gitRegistry, err := git.NewRegistry(o.Log.WithName("gitRegistry"), o.Config.Registry.Git)
+		if err != nil {
+			return fmt.Errorf("unable to setup git registry: %w", err)
+		}
+		git.SetRegistry(gitRegistry)
+		if err := lsMgr.Add(manager.RunnableFunc(gitRegistry.Start)); err != nil {
+			return fmt.Errorf("unable to add git registry: %w", err)
+		}
+	}
+
+	if o.Config.Registry.Archives != nil {
+		archiveRegistry, err := archive.NewRegistry(o.Log.WithName("archiveRegistry"), osfs.New(), o.Config.Registry.Archives)
+		if err != nil {
+			return fmt.Errorf("unable to setup archive registry: %w", err)
+		}
+		if err := archiveRegistry.Load(ctx, o.Config.Registry.OCI); err != nil {
+			return fmt.Errorf("unable to load archives of the archive registry: %w", err)
+		}
+		archive.SetRegistry(archiveRegistry)
+	}
+
 	if err := installationsctrl.AddControllerToManager(lsUncachedClient, lsCachedClient, hostUncachedClient, hostCachedClient,
 		ctrlLogger, lsMgr, o.Config, "installations"); err != nil {
 		return fmt.Errorf("unable to setup installation controller: %w", err)
@@ -170,34 +283,36 @@ func (o *Options) startMainController(ctx context.Context,
 		return fmt.Errorf("unable to setup execution controller: %w", err)
 	}
 
-	eg, ctx := errgroup.WithContext(ctx)
+	return nil
+}
 
-	setupLogger.Info("starting the controllers")
-	if lsMgr != hostMgr {
-		eg.Go(func() error {
-			if err := hostMgr.Start(ctx); err != nil {
-				return fmt.Errorf("error while running host manager: %w", err)
+func (o *Options) startCentralLandscaper(ctx context.Context,
+	lsUncachedClient, lsCachedClient, hostUncachedClient, hostCachedClient client.Client,
+	lsMgr, hostMgr manager.Manager, ctrlLogger, setupLogger logging.Logger) error {
+
+	if err := o.addCentralControllers(lsUncachedClient, lsCachedClient, lsMgr, ctrlLogger); err != nil {
+		return err
+	}
+
+	return startManagers(ctx, lsMgr, hostMgr, setupLogger,
+		func(ctx context.Context) error {
+			healthChecker := healthcheck.NewHealthChecker(o.Config.LsDeployments, hostUncachedClient)
+			if err := healthChecker.StartPeriodicalHealthCheck(ctx, ctrlLogger); err != nil {
+				return err
 			}
 			return nil
+		},
+		lockCleanerJob(lsUncachedClient, ctrlLogger),
+		func(ctx context.Context) error {
+			monitor := monitoring.NewMonitor(lsutils.GetCurrentPodNamespace(), hostUncachedClient)
+			monitor.StartMonitoring(ctx, ctrlLogger)
+			return nil
 		})
-		setupLogger.Info("Waiting for host cluster cache to sync")
-		if !hostMgr.GetCache().WaitForCacheSync(ctx) {
-			return fmt.Errorf("unable to sync host cluster cache")
-		}
-		setupLogger.Info("Cache of host cluster successfully synced")
-	}
-	eg.Go(func() error {
-		if err := lsMgr.Start(ctx); err != nil {
-			return fmt.Errorf("error while running landscaper manager: %w", err)
-		}
-		return nil
-	})
-	return eg.Wait()
 }
 
-func (o *Options) startCentralLandscaper(ctx context.Context,
-	lsUncachedClient, lsCachedClient, hostUncachedClient, hostCachedClient client.Client,
-	lsMgr, hostMgr manager.Manager, ctrlLogger, setupLogger logging.Logger) error {
+// addCentralControllers adds the controllers of the central landscaper to the landscaper manager.
+func (o *Options) addCentralControllers(lsUncachedClient, lsCachedClient client.Client, lsMgr manager.Manager,
+	ctrlLogger logging.Logger) error {
 
 	if err := contextctrl.AddControllerToManager(lsUncachedClient, lsCachedClient, ctrlLogger, lsMgr, o.Config); err != nil {
 		return fmt.Errorf("unable to setup context controller: %w", err)
@@ -207,7 +322,7 @@ func (o *Options) startCentralLandscaper(ctx context.Context,
 		ctrlLogger,
 		lsMgr,
 		o.Config.Controllers.DeployItems,
-		o.Config.DeployItemTimeouts.Pickup); err != nil {
+		o.Config.DeployItemTimeouts); err != nil {
 		return fmt.Errorf("unable to setup deployitem controller: %w", err)
 	}
 
@@ -215,27 +330,43 @@ func (o *Options) startCentralLandscaper(ctx context.Context,
 		return fmt.Errorf("unable to register target sync controller: %w", err)
 	}
 
-	eg, ctx := errgroup.WithContext(ctx)
+	if err := deployerregistrationctrl.AddControllerToManager(lsUncachedClient, lsCachedClient, ctrlLogger, lsMgr); err != nil {
+		return fmt.Errorf("unable to setup deployer registration controller: %w", err)
+	}
 
-	eg.Go(func() error {
-		healthChecker := healthcheck.NewHealthChecker(o.Config.LsDeployments, hostUncachedClient)
-		if err := healthChecker.StartPeriodicalHealthCheck(ctx, ctrlLogger); err != nil {
-			return err
-		}
-		return nil
-	})
+	if err := testrunctrl.AddControllerToManager(lsUncachedClient, lsCachedClient, ctrlLogger, lsMgr); err != nil {
+		return fmt.Errorf("unable to setup test run controller: %w", err)
+	}
 
-	eg.Go(func() error {
+	if err := landscapehealthctrl.AddControllerToManager(lsUncachedClient, lsCachedClient, ctrlLogger, lsMgr); err != nil {
+		return fmt.Errorf("unable to setup landscape health controller: %w", err)
+	}
+
+	return nil
+}
+
+// lockCleanerJob returns a job that periodically removes the sync objects of deleted objects.
+func lockCleanerJob(lsUncachedClient client.Client, ctrlLogger logging.Logger) func(ctx context.Context) error {
+	return func(ctx context.Context) error {
 		lockCleaner := lock.NewLockCleaner(lsUncachedClient)
 		lockCleaner.StartPeriodicalSyncObjectCleanup(ctx, ctrlLogger)
 		return nil
-	})
+	}
+}
 
-	eg.Go(func() error {
-		monitor := monitoring.NewMonitor(lsutils.GetCurrentPodNamespace(), hostUncachedClient)
-		monitor.StartMonitoring(ctx, ctrlLogger)
-		return nil
-	})
+// startManagers starts the host and landscaper managers, and the given jobs.
+// It blocks until the managers or one of the jobs have failed, or the context is cancelled.
+func startManagers(ctx context.Context, lsMgr, hostMgr manager.Manager, setupLogger logging.Logger,
+	jobs ...func(ctx context.Context) error) error {
+
+	eg, ctx := errgroup.WithContext(ctx)
+
+	for _, job := range jobs {
+		job := job
+		eg.Go(func() error {
+			return job(ctx)
+		})
+	}
 
 	setupLogger.Info("starting the controllers")
 	if lsMgr != hostMgr {
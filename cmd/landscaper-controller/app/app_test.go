@@ -11,10 +11,17 @@ import (
 
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
+	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/manager"
 	metricsserver "sigs.k8s.io/controller-runtime/pkg/metrics/server"
 
+	"github.com/gardener/landscaper/apis/config"
 	lsinstall "github.com/gardener/landscaper/apis/core/install"
+	lsv1alpha1 "github.com/gardener/landscaper/apis/core/v1alpha1"
+	lsv1alpha1helper "github.com/gardener/landscaper/apis/core/v1alpha1/helper"
+	"github.com/gardener/landscaper/apis/core/v1alpha1/targettypes"
+	"github.com/gardener/landscaper/cmd/landscaper-controller/app"
+	"github.com/gardener/landscaper/controller-utils/pkg/logging"
 	lsutils "github.com/gardener/landscaper/pkg/utils"
 	"github.com/gardener/landscaper/test/utils/envtest"
 )
@@ -73,4 +80,68 @@ var _ = Describe("Landscaper Controller", func() {
 			Expect(state.CleanupState(ctx)).To(Succeed())
 		})
 	})
+
+	Context("Development Mode", func() {
+
+		var (
+			ctx   context.Context
+			state *envtest.State
+		)
+
+		BeforeEach(func() {
+			var err error
+			ctx = context.Background()
+			state, err = testenv.InitState(ctx)
+			Expect(err).ToNot(HaveOccurred())
+		})
+
+		AfterEach(func() {
+			Expect(state.CleanupState(ctx)).To(Succeed())
+		})
+
+		It("should validate and apply the development mode options", func() {
+			opts := &app.DevModeOptions{LocalRegistryPath: "."}
+			Expect(opts.Validate()).ToNot(Succeed())
+
+			opts.Enabled = true
+			opts.TargetNamespace = "default"
+			Expect(opts.Validate()).To(Succeed())
+
+			cfg := &config.LandscaperConfiguration{
+				ApprovalHooks: []config.ApprovalHookConfiguration{{Name: "change-management"}},
+			}
+			opts.Apply(cfg)
+			Expect(cfg.Registry.Local).ToNot(BeNil())
+			Expect(cfg.Registry.Local.RootPath).To(Equal("."))
+			Expect(cfg.ApprovalHooks).To(BeEmpty())
+
+			opts.LocalRegistryPath = "./does-not-exist"
+			Expect(opts.Validate()).ToNot(Succeed())
+		})
+
+		It("should create the self target", func() {
+			target, err := app.EnsureSelfTarget(ctx, testenv.Client, state.Namespace, testenv.Env.Config)
+			Expect(err).ToNot(HaveOccurred())
+
+			Expect(testenv.Client.Get(ctx, client.ObjectKeyFromObject(target), target)).To(Succeed())
+			Expect(target.Name).To(Equal(app.DevModeTargetName))
+			Expect(target.Spec.Type).To(Equal(targettypes.KubernetesClusterTargetType))
+
+			// the target is updated if it already exists
+			_, err = app.EnsureSelfTarget(ctx, testenv.Client, state.Namespace, testenv.Env.Config)
+			Expect(err).ToNot(HaveOccurred())
+		})
+
+		It("should reconcile the root installations", func() {
+			inst := &lsv1alpha1.Installation{}
+			inst.Name = "root"
+			inst.Namespace = state.Namespace
+			Expect(state.Create(ctx, inst)).To(Succeed())
+
+			Expect(app.ReconcileRootInstallations(ctx, testenv.Client, logging.Discard())).To(Succeed())
+
+			Expect(testenv.Client.Get(ctx, client.ObjectKeyFromObject(inst), inst)).To(Succeed())
+			Expect(lsv1alpha1helper.HasOperation(inst.ObjectMeta, lsv1alpha1.ReconcileOperation)).To(BeTrue())
+		})
+	})
 })
@@ -0,0 +1,195 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package app
+
+import (
+	"context"
+	"fmt"
+	"os"
+
+	"github.com/mandelsoft/vfs/pkg/osfs"
+	flag "github.com/spf13/pflag"
+	"k8s.io/client-go/rest"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/manager"
+
+	"github.com/gardener/landscaper/apis/config"
+	lsv1alpha1 "github.com/gardener/landscaper/apis/core/v1alpha1"
+	lsv1alpha1helper "github.com/gardener/landscaper/apis/core/v1alpha1/helper"
+	helmv1alpha1 "github.com/gardener/landscaper/apis/deployer/helm/v1alpha1"
+	manifestv1alpha2 "github.com/gardener/landscaper/apis/deployer/manifest/v1alpha2"
+	"github.com/gardener/landscaper/controller-utils/pkg/logging"
+	lc "github.com/gardener/landscaper/controller-utils/pkg/logging/constants"
+	"github.com/gardener/landscaper/pkg/components/registries"
+	helmctrl "github.com/gardener/landscaper/pkg/deployer/helm"
+	manifestctrl "github.com/gardener/landscaper/pkg/deployer/manifest"
+	"github.com/gardener/landscaper/pkg/landscaper/installations"
+	lsutils "github.com/gardener/landscaper/pkg/utils"
+	lslandscaperutils "github.com/gardener/landscaper/pkg/utils/landscaper"
+	"github.com/gardener/landscaper/pkg/utils/read_write_layer"
+)
+
+const (
+	// DevModeTargetName is the name of the target that is created in development mode.
+	// It points to the cluster in which the landscaper resources are located.
+	DevModeTargetName = "self"
+
+	defaultDevModeTargetNamespace = "default"
+)
+
+// DevModeOptions describes the development mode of the landscaper controller.
+// In development mode, the landscaper controllers, the central landscaper controllers, and the helm and manifest
+// deployers run in one process against a local cluster, e.g. a kind cluster, so that blueprints can be developed
+// without building images or pushing components to a registry.
+type DevModeOptions struct {
+	// Enabled enables the development mode.
+	Enabled bool
+	// LocalRegistryPath is the root path of a local registry from which components and blueprints are read.
+	// The path is watched for changes.
+	LocalRegistryPath string
+	// TargetNamespace is the namespace of the target that points to the local cluster.
+	TargetNamespace string
+}
+
+func (o *DevModeOptions) AddFlags(fs *flag.FlagSet) {
+	fs.BoolVar(&o.Enabled, "dev", false, "Run all controllers and the helm and manifest deployers in one process "+
+		"against a local cluster, e.g. a kind cluster. Not intended for productive use")
+	fs.StringVar(&o.LocalRegistryPath, "dev-local-registry", "", "Specify the path of a local registry "+
+		"from which components and blueprints are read in development mode. Changes below the path are picked up automatically")
+	fs.StringVar(&o.TargetNamespace, "dev-target-namespace", defaultDevModeTargetNamespace, "Specify the namespace "+
+		"of the target \""+DevModeTargetName+"\" that is created in development mode")
+}
+
+// Validate validates the development mode options.
+func (o *DevModeOptions) Validate() error {
+	if !o.Enabled {
+		if len(o.LocalRegistryPath) != 0 {
+			return fmt.Errorf("the flag --dev-local-registry is only supported in development mode (--dev)")
+		}
+		return nil
+	}
+
+	if len(o.TargetNamespace) == 0 {
+		return fmt.Errorf("the namespace of the target %q must not be empty", DevModeTargetName)
+	}
+	if len(o.LocalRegistryPath) != 0 {
+		info, err := os.Stat(o.LocalRegistryPath)
+		if err != nil {
+			return fmt.Errorf("invalid local registry path: %w", err)
+		}
+		if !info.IsDir() {
+			return fmt.Errorf("local registry path %q is not a directory", o.LocalRegistryPath)
+		}
+	}
+	return nil
+}
+
+// Apply adapts the landscaper configuration to the development mode.
+func (o *DevModeOptions) Apply(cfg *config.LandscaperConfiguration) {
+	if len(o.LocalRegistryPath) != 0 {
+		// the local registry is not watched by the installation controller,
+		// but by the development mode, which also reconciles the root installations after a change.
+		cfg.Registry.Local = &config.LocalRegistryConfiguration{
+			RootPath: o.LocalRegistryPath,
+		}
+	}
+
+	// approval webhooks, e.g. of change management systems, are not available for local clusters
+	cfg.ApprovalHooks = nil
+}
+
+// startDevMode runs all controllers and the helm and manifest deployers in one process.
+func (o *Options) startDevMode(ctx context.Context, hostRestConfig *rest.Config,
+	lsUncachedClient, lsCachedClient, hostUncachedClient, hostCachedClient client.Client,
+	lsMgr, hostMgr manager.Manager, ctrlLogger, setupLogger logging.Logger) error {
+
+	setupLogger.Info("Running in development mode", "localRegistry", o.DevMode.LocalRegistryPath)
+
+	if err := o.addMainControllers(ctx, lsUncachedClient, lsCachedClient, hostUncachedClient, hostCachedClient,
+		lsMgr, hostMgr, ctrlLogger); err != nil {
+		return err
+	}
+
+	if err := o.addCentralControllers(lsUncachedClient, lsCachedClient, lsMgr, ctrlLogger); err != nil {
+		return err
+	}
+
+	finishedObjectCache := lsutils.NewFinishedObjectCache()
+	deployerLogger := o.Log.WithName("deployer")
+
+	helmConfig := &helmv1alpha1.Configuration{}
+	helmctrl.HelmScheme.Default(helmConfig)
+	if err := helmctrl.AddDeployerToManager(lsUncachedClient, lsCachedClient, hostUncachedClient, hostCachedClient,
+		finishedObjectCache, deployerLogger, lsMgr, hostMgr, *helmConfig, "helm"); err != nil {
+		return fmt.Errorf("unable to setup helm deployer: %w", err)
+	}
+
+	manifestConfig := &manifestv1alpha2.Configuration{}
+	manifestctrl.Scheme.Default(manifestConfig)
+	if err := manifestctrl.AddDeployerToManager(lsUncachedClient, lsCachedClient, hostUncachedClient, hostCachedClient,
+		finishedObjectCache, deployerLogger, lsMgr, hostMgr, *manifestConfig, "manifest"); err != nil {
+		return fmt.Errorf("unable to setup manifest deployer: %w", err)
+	}
+
+	if len(o.DevMode.LocalRegistryPath) != 0 {
+		watcher := registries.NewLocalRegistryWatcher(ctrlLogger.WithName("localRegistryWatcher"), osfs.New(), o.DevMode.LocalRegistryPath).
+			WithOnChange(func(ctx context.Context) error {
+				return ReconcileRootInstallations(ctx, lsUncachedClient, ctrlLogger)
+			})
+		if err := lsMgr.Add(manager.RunnableFunc(watcher.Start)); err != nil {
+			return fmt.Errorf("unable to add local registry watcher: %w", err)
+		}
+	}
+
+	target, err := EnsureSelfTarget(ctx, lsUncachedClient, o.DevMode.TargetNamespace, hostRestConfig)
+	if err != nil {
+		return fmt.Errorf("unable to create target %q: %w", DevModeTargetName, err)
+	}
+	setupLogger.Info("Target for the local cluster is available", "target", client.ObjectKeyFromObject(target).String())
+
+	return startManagers(ctx, lsMgr, hostMgr, setupLogger, lockCleanerJob(lsUncachedClient, ctrlLogger))
+}
+
+// EnsureSelfTarget creates or updates a target of type kubernetes-cluster with the name "self" in the given namespace,
+// which contains a kubeconfig for the given rest config.
+func EnsureSelfTarget(ctx context.Context, lsUncachedClient client.Client, namespace string,
+	restConfig *rest.Config) (*lsv1alpha1.Target, error) {
+
+	target := &lsv1alpha1.Target{}
+	target.Name = DevModeTargetName
+	target.Namespace = namespace
+
+	if _, err := read_write_layer.NewWriter(lsUncachedClient).CreateOrUpdateCoreTarget(ctx, read_write_layer.W000177, target, func() error {
+		return lslandscaperutils.BuildKubernetesTarget(target, restConfig)
+	}); err != nil {
+		return nil, err
+	}
+	return target, nil
+}
+
+// ReconcileRootInstallations adds the reconcile operation annotation to all root installations,
+// so that they pick up the changed components and blueprints of a local registry.
+func ReconcileRootInstallations(ctx context.Context, lsUncachedClient client.Client, log logging.Logger) error {
+	instList := &lsv1alpha1.InstallationList{}
+	if err := read_write_layer.ListInstallations(ctx, lsUncachedClient, instList, read_write_layer.R000157); err != nil {
+		return err
+	}
+
+	for i := range instList.Items {
+		inst := &instList.Items[i]
+		if !installations.IsRootInstallation(inst) || !inst.DeletionTimestamp.IsZero() ||
+			lsv1alpha1helper.HasOperation(inst.ObjectMeta, lsv1alpha1.ReconcileOperation) {
+			continue
+		}
+
+		log.Info("triggering reconcile of root installation because the local registry has changed",
+			lc.KeyResource, client.ObjectKeyFromObject(inst).String())
+		lsv1alpha1helper.SetOperation(&inst.ObjectMeta, lsv1alpha1.ReconcileOperation)
+		if err := read_write_layer.NewWriter(lsUncachedClient).UpdateInstallation(ctx, read_write_layer.W000178, inst); err != nil {
+			return err
+		}
+	}
+	return nil
+}
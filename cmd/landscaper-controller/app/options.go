@@ -26,6 +26,9 @@ type Options struct {
 	ConfigPath               string
 	landscaperKubeconfigPath string
 
+	// DevMode configures the development mode, in which all controllers run in one process against a local cluster.
+	DevMode DevModeOptions
+
 	Config *config.LandscaperConfiguration
 }
 
@@ -36,6 +39,7 @@ func NewOptions() *Options {
 func (o *Options) AddFlags(fs *flag.FlagSet) {
 	fs.StringVar(&o.ConfigPath, "config", "", "Specify the path to the configuration file")
 	fs.StringVar(&o.landscaperKubeconfigPath, "landscaper-kubeconfig", "", "Specify the path to the landscaper kubeconfig cluster")
+	o.DevMode.AddFlags(fs)
 	logging.InitFlags(fs)
 
 	flag.CommandLine.AddGoFlagSet(goflag.CommandLine)
@@ -60,6 +64,10 @@ func (o *Options) Complete(ctx context.Context) error {
 		return err
 	}
 
+	if o.DevMode.Enabled {
+		o.DevMode.Apply(o.Config)
+	}
+
 	return nil
 }
 
@@ -93,5 +101,5 @@ func (o *Options) parseConfigurationFile(ctx context.Context) (*config.Landscape
 
 // validates the Options
 func (o *Options) validate() error {
-	return nil
+	return o.DevMode.Validate()
 }
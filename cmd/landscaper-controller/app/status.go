@@ -0,0 +1,111 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package app
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"time"
+
+	"github.com/spf13/cobra"
+	flag "github.com/spf13/pflag"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/gardener/landscaper/controller-utils/pkg/logging"
+	"github.com/gardener/landscaper/pkg/api"
+	"github.com/gardener/landscaper/pkg/utils/landscaper"
+)
+
+// StatusWatchOptions describes the options of the status watch command.
+type StatusWatchOptions struct {
+	// Installation is the namespaced name of the watched installation in the format "namespace/name".
+	Installation             string
+	Timeout                  time.Duration
+	Interval                 time.Duration
+	landscaperKubeconfigPath string
+}
+
+// NewStatusCommand creates a new command that groups the commands for the status of landscaper resources.
+func NewStatusCommand(ctx context.Context) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "status",
+		Short: "Commands for the status of landscaper resources",
+	}
+	cmd.AddCommand(NewStatusWatchCommand(ctx))
+	return cmd
+}
+
+// NewStatusWatchCommand creates a new command that waits until the current job of an installation has finished.
+func NewStatusWatchCommand(ctx context.Context) *cobra.Command {
+	options := &StatusWatchOptions{}
+
+	cmd := &cobra.Command{
+		Use:   "watch",
+		Short: "Waits until the current job of an installation has finished",
+		Long: "Watches an installation until its current job has finished resp. until it has been deleted. " +
+			"Every observed change is written as json event per line. The last event contains the result with the " +
+			"final phase, the error and the exports of the installation. The command fails if the installation failed.",
+		Example:      "landscaper-controller status watch --installation my-namespace/my-installation --timeout 30m",
+		SilenceUsage: true,
+
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if _, err := parseInstallationKey(options.Installation); err != nil {
+				return err
+			}
+			return options.Run(ctx, cmd.OutOrStdout())
+		},
+	}
+
+	options.AddFlags(cmd.Flags())
+	return cmd
+}
+
+func (o *StatusWatchOptions) AddFlags(fs *flag.FlagSet) {
+	fs.StringVar(&o.Installation, "installation", "", "Specify the installation in the format namespace/name")
+	fs.DurationVar(&o.Timeout, "timeout", 0, "Specify the maximal duration of the watch, no timeout if not set")
+	fs.DurationVar(&o.Interval, "interval", landscaper.DefaultStatusWatchInterval, "Specify the interval in which the installation is read")
+	fs.StringVar(&o.landscaperKubeconfigPath, "landscaper-kubeconfig", "", "Specify the path to the landscaper kubeconfig cluster")
+}
+
+// Run watches the installation and writes the events to the given writer.
+func (o *StatusWatchOptions) Run(ctx context.Context, out io.Writer) error {
+	key, err := parseInstallationKey(o.Installation)
+	if err != nil {
+		return err
+	}
+
+	restConfig, err := landscaperRestConfig(o.landscaperKubeconfigPath)
+	if err != nil {
+		return err
+	}
+	lsClient, err := client.New(restConfig, client.Options{Scheme: api.LandscaperScheme})
+	if err != nil {
+		return fmt.Errorf("unable to build landscaper cluster client: %w", err)
+	}
+
+	encoder := json.NewEncoder(out)
+	var writeErr error
+	result, err := landscaper.WatchInstallationStatus(logging.NewContextWithDiscard(ctx), lsClient, key, landscaper.StatusWatchOptions{
+		Interval: o.Interval,
+		Timeout:  o.Timeout,
+		OnEvent: func(event landscaper.InstallationEvent) {
+			if err := encoder.Encode(event); err != nil && writeErr == nil {
+				writeErr = fmt.Errorf("unable to write event: %w", err)
+			}
+		},
+	})
+	if err != nil {
+		return err
+	}
+	if writeErr != nil {
+		return writeErr
+	}
+	if !result.Succeeded {
+		return fmt.Errorf("installation %s finished with phase %s", key.String(), result.Phase)
+	}
+	return nil
+}
@@ -25,6 +25,7 @@ import (
 	"github.com/gardener/landscaper/controller-utils/pkg/logging"
 	lc "github.com/gardener/landscaper/controller-utils/pkg/logging/constants"
 	webhooklib "github.com/gardener/landscaper/controller-utils/pkg/webhook"
+	webhook "github.com/gardener/landscaper/pkg/utils/webhook"
 )
 
 func NewLandscaperWebhooksCommand(ctx context.Context) *cobra.Command {
@@ -78,6 +79,16 @@ func (o *options) run(ctx context.Context) error {
 		return fmt.Errorf("unable to get client: %w", err)
 	}
 
+	// the installation webhook needs a client to resolve the blueprints of the installations
+	if installationWebhook, ok := defaultWebhooks["installations"]; ok {
+		installationWebhook.Process = webhook.NewInstallationWebhookLogic(webhook.NewBlueprintResolver(kubeClient))
+	}
+
+	// the target webhook needs a client to validate the target configurations against the target type definitions
+	if targetWebhook, ok := defaultWebhooks["targets"]; ok {
+		targetWebhook.Process = webhook.NewTargetWebhookLogic(kubeClient)
+	}
+
 	if err := webhooklib.ApplyWebhooks(ctx, &webhooklib.ApplyWebhooksOptions{
 		NameValidating: &webhooklib.WebhookNaming{
 			Name:          "landscaper-validation-webhook",
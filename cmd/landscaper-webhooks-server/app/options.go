@@ -6,15 +6,19 @@ package app
 
 import (
 	goflag "flag"
+	"fmt"
 
+	"github.com/gardener/landscaper/apis/config"
 	"github.com/gardener/landscaper/apis/core"
 
+	"github.com/mandelsoft/vfs/pkg/osfs"
 	flag "github.com/spf13/pflag"
 
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	"github.com/gardener/landscaper/controller-utils/pkg/logging"
 	webhooklib "github.com/gardener/landscaper/controller-utils/pkg/webhook"
+	"github.com/gardener/landscaper/pkg/components/cache/blueprint"
 	webhook "github.com/gardener/landscaper/pkg/utils/webhook"
 )
 
@@ -68,11 +72,36 @@ var defaultWebhooks = webhooklib.NewWebhookRegistry().
 		Operations:    webhooklib.Operations(webhooklib.CREATE, webhooklib.UPDATE),
 		LabelSelector: landscaperSkipValidationSelector,
 		Process:       webhook.TargetWebhookLogic,
+	}).
+	Register(&webhooklib.Webhook{
+		Name:          "targettypedefinitions",
+		Type:          webhooklib.ValidatingWebhook,
+		APIGroup:      core.GroupName,
+		APIVersions:   []string{"v1alpha1"},
+		ResourceName:  "targettypedefinitions",
+		Operations:    webhooklib.Operations(webhooklib.CREATE, webhooklib.UPDATE),
+		LabelSelector: landscaperSkipValidationSelector,
+		Process:       webhook.TargetTypeDefinitionWebhookLogic,
+	}).
+	Register(&webhooklib.Webhook{
+		Name:          "contexts",
+		Type:          webhooklib.ValidatingWebhook,
+		APIGroup:      core.GroupName,
+		APIVersions:   []string{"v1alpha1"},
+		ResourceName:  "contexts",
+		Operations:    webhooklib.Operations(webhooklib.CREATE, webhooklib.UPDATE),
+		LabelSelector: landscaperSkipValidationSelector,
+		Process:       webhook.ContextWebhookLogic,
 	})
 
 type options struct {
 	log           logging.Logger
 	webhookConfig *webhooklib.WebhookFlags
+
+	// blueprintBlobCache configures the cache of the blueprint blobs that are resolved by the installation webhook.
+	blueprintBlobCache config.BlueprintBlobCacheConfiguration
+	// ociCachePath is the path of the oci cache to which evicted blueprint blobs are written.
+	ociCachePath string
 }
 
 func NewOptions() *options {
@@ -83,6 +112,12 @@ func NewOptions() *options {
 
 func (o *options) AddFlags(fs *flag.FlagSet) {
 	o.webhookConfig.AddFlags(fs)
+	fs.StringVar(&o.blueprintBlobCache.MemorySize, "blueprint-blob-cache-memory-size", "",
+		"Specify the maximal size of the blueprint blobs that are kept in memory (defaults to 64Mi)")
+	fs.StringVar(&o.blueprintBlobCache.DiskSize, "blueprint-blob-cache-disk-size", "",
+		"Specify the maximal size of the blueprint blobs that are written to disk when they are evicted from memory")
+	fs.StringVar(&o.ociCachePath, "oci-cache-path", "",
+		fmt.Sprintf("Specify the path of the oci cache, evicted blueprint blobs are written to its directory %q", blueprint.BlobCacheDirName))
 	logging.InitFlags(fs)
 	flag.CommandLine.AddGoFlagSet(goflag.CommandLine)
 }
@@ -100,5 +135,12 @@ func (o *options) Complete() error {
 		return err
 	}
 
+	blobCache, err := blueprint.NewBlobCacheFromConfig(log.WithName("blueprintBlobCache"), osfs.New(), &o.blueprintBlobCache,
+		&config.OCICacheConfiguration{Path: o.ociCachePath})
+	if err != nil {
+		return err
+	}
+	blueprint.SetBlobCache(blobCache)
+
 	return nil
 }
@@ -25,6 +25,7 @@ CGO_ENABLED=1 go test -race -coverprofile=${PROJECT_ROOT}/coverage.main.out -cov
                     ${PROJECT_ROOT}/pkg/... \
                     ${PROJECT_ROOT}/test/framework/... \
                     ${PROJECT_ROOT}/test/utils/... \
+                    ${PROJECT_ROOT}/test/loadtest/... \
                     ${PROJECT_ROOT}/test/landscaper/...
 EXIT_STATUS_MAIN_TEST=$?
 go tool cover -html=${PROJECT_ROOT}/coverage.main.out -o ${PROJECT_ROOT}/coverage.main.html
@@ -0,0 +1,374 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package blueprint
+
+import (
+	"bytes"
+	"container/list"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"path/filepath"
+	"sync"
+
+	"github.com/mandelsoft/vfs/pkg/projectionfs"
+	"github.com/mandelsoft/vfs/pkg/vfs"
+	"golang.org/x/sync/singleflight"
+	"k8s.io/apimachinery/pkg/api/resource"
+
+	"github.com/gardener/landscaper/apis/config"
+	"github.com/gardener/landscaper/controller-utils/pkg/logging"
+	"github.com/gardener/landscaper/pkg/components/cache"
+)
+
+const (
+	// DefaultBlobCacheMemorySize is the default maximal size in bytes of the blueprint blobs that are kept in memory.
+	DefaultBlobCacheMemorySize int64 = 64 * 1024 * 1024
+	// BlobCacheDirName is the name of the directory in the path of the oci cache to which evicted blobs are written.
+	BlobCacheDirName = "blueprint-blobs"
+
+	// blobCacheMetricsID is the id of the blob cache in the cache metrics.
+	blobCacheMetricsID = "blueprint-blobs"
+)
+
+var blobCacheSingleton *BlobCache
+
+func init() {
+	blobCache, err := NewBlobCache(logging.Discard(), DefaultBlobCacheMemorySize, nil, 0)
+	if err != nil {
+		panic(err)
+	}
+	SetBlobCache(blobCache)
+}
+
+// GetBlobCache returns the currently active blob cache.
+func GetBlobCache() *BlobCache {
+	return blobCacheSingleton
+}
+
+// SetBlobCache sets the currently active blob cache.
+func SetBlobCache(blobCache *BlobCache) {
+	blobCacheSingleton = blobCache
+}
+
+// Blob is the blob of a blueprint resource together with its media type.
+type Blob struct {
+	MediaType string
+	Data      []byte
+}
+
+// BlobCache caches the blobs of blueprint resources by the caching identity of the resources,
+// so that a blueprint is extracted from the cached blob instead of being downloaded again.
+// The blobs are kept in memory up to a maximal size. If the size is exceeded, the least recently used blobs are
+// evicted from memory and written to disk, if a disk size is configured. Blobs that are read from disk are moved
+// back into memory. If the disk size is exceeded as well, the least recently used blobs are deleted from disk.
+type BlobCache struct {
+	log logging.Logger
+	mux sync.Mutex
+	// fs is the filesystem to which evicted blobs are written. It is nil if blobs are not written to disk.
+	fs     vfs.FileSystem
+	memory *lruIndex
+	disk   *lruIndex
+
+	// fetchGroup deduplicates concurrent fetches of the same blob.
+	fetchGroup singleflight.Group
+}
+
+// NewBlobCache creates a new blob cache that keeps up to memorySize bytes in memory and writes up to diskSize bytes
+// of evicted blobs to the root of the given filesystem. Existing files in the filesystem are deleted.
+// Evicted blobs are discarded if the filesystem is nil or the disk size is 0.
+func NewBlobCache(log logging.Logger, memorySize int64, fs vfs.FileSystem, diskSize int64) (*BlobCache, error) {
+	c := &BlobCache{
+		log:    log,
+		memory: newLRUIndex(memorySize),
+		disk:   newLRUIndex(diskSize),
+	}
+	if fs != nil && diskSize > 0 {
+		if err := cleanDir(fs, "/"); err != nil {
+			return nil, fmt.Errorf("unable to clean directory of the blueprint blob cache: %w", err)
+		}
+		c.fs = fs
+	}
+	return c, nil
+}
+
+// NewBlobCacheFromConfig creates a new blob cache from the configuration of the blob cache and the oci cache.
+// Evicted blobs are written to the directory BlobCacheDirName of the path of the oci cache.
+func NewBlobCacheFromConfig(log logging.Logger, baseFs vfs.FileSystem, cfg *config.BlueprintBlobCacheConfiguration,
+	ociCacheConfig *config.OCICacheConfiguration) (*BlobCache, error) {
+	memorySize := DefaultBlobCacheMemorySize
+	var diskSize int64
+	if cfg != nil {
+		var err error
+		if len(cfg.MemorySize) != 0 {
+			memorySize, err = parseSize(cfg.MemorySize)
+			if err != nil {
+				return nil, fmt.Errorf("invalid memory size of the blueprint blob cache: %w", err)
+			}
+		}
+		if len(cfg.DiskSize) != 0 {
+			diskSize, err = parseSize(cfg.DiskSize)
+			if err != nil {
+				return nil, fmt.Errorf("invalid disk size of the blueprint blob cache: %w", err)
+			}
+		}
+	}
+
+	if diskSize == 0 || ociCacheConfig == nil || len(ociCacheConfig.Path) == 0 {
+		return NewBlobCache(log, memorySize, nil, 0)
+	}
+	dir := filepath.Join(ociCacheConfig.Path, BlobCacheDirName)
+	if err := baseFs.MkdirAll(dir, 0o700); err != nil {
+		return nil, fmt.Errorf("unable to create directory of the blueprint blob cache: %w", err)
+	}
+	fs, err := projectionfs.New(baseFs, dir)
+	if err != nil {
+		return nil, fmt.Errorf("unable to create filesystem of the blueprint blob cache: %w", err)
+	}
+	return NewBlobCache(log, memorySize, fs, diskSize)
+}
+
+// Get returns the blob with the given caching identity. If the blob is not cached, it is fetched with the given function
+// and added to the cache. Concurrent fetches of the same blob are deduplicated.
+// Blobs without a caching identity are always fetched.
+func (c *BlobCache) Get(ctx context.Context, blobID string, fetch func() (*Blob, error)) (*Blob, error) {
+	if blobID == "" {
+		return fetch()
+	}
+	if blob := c.get(blobID); blob != nil {
+		return blob, nil
+	}
+
+	res, err, _ := c.fetchGroup.Do(blobID, func() (interface{}, error) {
+		blob, err := fetch()
+		if err != nil {
+			return nil, err
+		}
+		c.add(blobID, blob)
+		return blob, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return res.(*Blob), nil
+}
+
+// MemorySize returns the size of the blobs in memory.
+func (c *BlobCache) MemorySize() int64 {
+	c.mux.Lock()
+	defer c.mux.Unlock()
+	return c.memory.size
+}
+
+// DiskSize returns the size of the blobs on disk.
+func (c *BlobCache) DiskSize() int64 {
+	c.mux.Lock()
+	defer c.mux.Unlock()
+	return c.disk.size
+}
+
+// get returns the cached blob or nil if the blob is not cached.
+// A blob that is read from disk is moved back into memory.
+func (c *BlobCache) get(blobID string) *Blob {
+	c.mux.Lock()
+	defer c.mux.Unlock()
+
+	if entry := c.memory.get(blobID); entry != nil {
+		cache.CacheHitsMemory.WithLabelValues(blobCacheMetricsID).Inc()
+		return entry.blob
+	}
+	if entry := c.disk.get(blobID); entry != nil {
+		blob, err := c.readBlob(blobID)
+		c.removeFromDisk(blobID)
+		if err != nil {
+			c.log.Error(err, "unable to read blueprint blob from disk", "blobID", blobID)
+			return nil
+		}
+		cache.CacheHitsDisk.WithLabelValues(blobCacheMetricsID).Inc()
+		c.addToMemory(blobID, blob)
+		c.updateMetrics()
+		return blob
+	}
+	return nil
+}
+
+func (c *BlobCache) add(blobID string, blob *Blob) {
+	c.mux.Lock()
+	defer c.mux.Unlock()
+	if c.memory.get(blobID) == nil {
+		c.addToMemory(blobID, blob)
+	}
+	c.updateMetrics()
+}
+
+// addToMemory adds a blob to memory and evicts the least recently used blobs if the memory size is exceeded.
+// Blobs that are larger than the memory size are directly written to disk.
+// The caller has to hold the lock of the cache.
+func (c *BlobCache) addToMemory(blobID string, blob *Blob) {
+	size := int64(len(blob.Data))
+	if size > c.memory.maxSize {
+		c.spill(blobID, blob)
+		return
+	}
+	c.memory.add(&blobEntry{id: blobID, size: size, blob: blob})
+	for c.memory.size > c.memory.maxSize {
+		evicted := c.memory.removeOldest()
+		c.spill(evicted.id, evicted.blob)
+	}
+}
+
+// spill writes an evicted blob to disk and deletes the least recently used blobs from disk if the disk size is exceeded.
+// The blob is discarded if no disk is configured or the blob is larger than the disk size.
+// The caller has to hold the lock of the cache.
+func (c *BlobCache) spill(blobID string, blob *Blob) {
+	size := int64(len(blob.Data))
+	if c.fs == nil || size > c.disk.maxSize {
+		return
+	}
+	if err := c.writeBlob(blobID, blob); err != nil {
+		c.log.Error(err, "unable to write blueprint blob to disk", "blobID", blobID)
+		return
+	}
+	c.disk.add(&blobEntry{id: blobID, size: size})
+	for c.disk.size > c.disk.maxSize {
+		oldest := c.disk.oldest()
+		c.removeFromDisk(oldest.id)
+	}
+}
+
+// removeFromDisk deletes a blob from disk. The caller has to hold the lock of the cache.
+func (c *BlobCache) removeFromDisk(blobID string) {
+	c.disk.remove(blobID)
+	if err := c.fs.Remove(blobFileName(blobID)); err != nil {
+		c.log.Error(err, "unable to delete blueprint blob from disk", "blobID", blobID)
+	}
+}
+
+// writeBlob writes a blob to disk. The first line of the file contains the media type of the blob.
+func (c *BlobCache) writeBlob(blobID string, blob *Blob) error {
+	var buf bytes.Buffer
+	buf.Grow(len(blob.MediaType) + 1 + len(blob.Data))
+	buf.WriteString(blob.MediaType)
+	buf.WriteByte('\n')
+	buf.Write(blob.Data)
+	return vfs.WriteFile(c.fs, blobFileName(blobID), buf.Bytes(), 0o600)
+}
+
+func (c *BlobCache) readBlob(blobID string) (*Blob, error) {
+	data, err := vfs.ReadFile(c.fs, blobFileName(blobID))
+	if err != nil {
+		return nil, err
+	}
+	i := bytes.IndexByte(data, '\n')
+	if i < 0 {
+		return nil, errors.New("the media type of the blob is missing")
+	}
+	return &Blob{MediaType: string(data[:i]), Data: data[i+1:]}, nil
+}
+
+func (c *BlobCache) updateMetrics() {
+	cache.CachedItems.WithLabelValues(blobCacheMetricsID).Set(float64(c.memory.len() + c.disk.len()))
+	cache.CacheMemoryUsage.WithLabelValues(blobCacheMetricsID).Set(float64(c.memory.size))
+	cache.CacheDiskUsage.WithLabelValues(blobCacheMetricsID).Set(float64(c.disk.size))
+}
+
+// blobFileName returns the name of the file of a blob on disk.
+// The caching identity is hashed, as it might contain characters that are not allowed in file names.
+func blobFileName(blobID string) string {
+	hash := sha256.Sum256([]byte(blobID))
+	return "/" + hex.EncodeToString(hash[:])
+}
+
+func parseSize(size string) (int64, error) {
+	quantity, err := resource.ParseQuantity(size)
+	if err != nil {
+		return 0, fmt.Errorf("unable to parse size %q: %w", size, err)
+	}
+	sizeInBytes, ok := quantity.AsInt64()
+	if !ok {
+		return 0, fmt.Errorf("unable to parse size %q as int", size)
+	}
+	return sizeInBytes, nil
+}
+
+func cleanDir(fs vfs.FileSystem, dir string) error {
+	infos, err := vfs.ReadDir(fs, dir)
+	if err != nil {
+		return err
+	}
+	for _, info := range infos {
+		if err := fs.RemoveAll(filepath.Join(dir, info.Name())); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+type blobEntry struct {
+	id   string
+	size int64
+	// blob is the cached blob. It is nil for blobs on disk.
+	blob *Blob
+}
+
+// lruIndex keeps blob entries in the order of their last usage together with their total size.
+type lruIndex struct {
+	maxSize  int64
+	size     int64
+	order    *list.List
+	elements map[string]*list.Element
+}
+
+func newLRUIndex(maxSize int64) *lruIndex {
+	return &lruIndex{
+		maxSize:  maxSize,
+		order:    list.New(),
+		elements: map[string]*list.Element{},
+	}
+}
+
+// get returns the entry with the given id and marks it as most recently used.
+func (l *lruIndex) get(id string) *blobEntry {
+	elem, ok := l.elements[id]
+	if !ok {
+		return nil
+	}
+	l.order.MoveToFront(elem)
+	return elem.Value.(*blobEntry)
+}
+
+func (l *lruIndex) add(entry *blobEntry) {
+	l.remove(entry.id)
+	l.elements[entry.id] = l.order.PushFront(entry)
+	l.size += entry.size
+}
+
+func (l *lruIndex) remove(id string) {
+	elem, ok := l.elements[id]
+	if !ok {
+		return
+	}
+	l.order.Remove(elem)
+	delete(l.elements, id)
+	l.size -= elem.Value.(*blobEntry).size
+}
+
+// oldest returns the least recently used entry.
+func (l *lruIndex) oldest() *blobEntry {
+	return l.order.Back().Value.(*blobEntry)
+}
+
+// removeOldest removes and returns the least recently used entry.
+func (l *lruIndex) removeOldest() *blobEntry {
+	entry := l.oldest()
+	l.remove(entry.id)
+	return entry
+}
+
+func (l *lruIndex) len() int {
+	return l.order.Len()
+}
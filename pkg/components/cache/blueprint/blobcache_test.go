@@ -0,0 +1,183 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package blueprint_test
+
+import (
+	"context"
+	"errors"
+
+	"github.com/mandelsoft/vfs/pkg/memoryfs"
+	"github.com/mandelsoft/vfs/pkg/vfs"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/gardener/landscaper/apis/config"
+	"github.com/gardener/landscaper/controller-utils/pkg/logging"
+	"github.com/gardener/landscaper/pkg/components/cache/blueprint"
+)
+
+var _ = Describe("BlobCache", func() {
+
+	var (
+		ctx     context.Context
+		fetches map[string]int
+	)
+
+	BeforeEach(func() {
+		ctx = context.Background()
+		fetches = map[string]int{}
+	})
+
+	fetchFunc := func(id string, size int) func() (*blueprint.Blob, error) {
+		return func() (*blueprint.Blob, error) {
+			fetches[id]++
+			return &blueprint.Blob{MediaType: "application/x-tar", Data: make([]byte, size)}, nil
+		}
+	}
+
+	get := func(c *blueprint.BlobCache, id string, size int) *blueprint.Blob {
+		blob, err := c.Get(ctx, id, fetchFunc(id, size))
+		Expect(err).ToNot(HaveOccurred())
+		Expect(blob.Data).To(HaveLen(size))
+		Expect(blob.MediaType).To(Equal("application/x-tar"))
+		return blob
+	}
+
+	It("should fetch a blob only once", func() {
+		c, err := blueprint.NewBlobCache(logging.Discard(), 100, nil, 0)
+		Expect(err).ToNot(HaveOccurred())
+
+		get(c, "a", 10)
+		get(c, "a", 10)
+		Expect(fetches).To(Equal(map[string]int{"a": 1}))
+		Expect(c.MemorySize()).To(BeEquivalentTo(10))
+	})
+
+	It("should always fetch blobs without an id", func() {
+		c, err := blueprint.NewBlobCache(logging.Discard(), 100, nil, 0)
+		Expect(err).ToNot(HaveOccurred())
+
+		get(c, "", 10)
+		get(c, "", 10)
+		Expect(fetches).To(Equal(map[string]int{"": 2}))
+		Expect(c.MemorySize()).To(BeZero())
+	})
+
+	It("should not cache a blob if the fetch fails", func() {
+		c, err := blueprint.NewBlobCache(logging.Discard(), 100, nil, 0)
+		Expect(err).ToNot(HaveOccurred())
+
+		_, err = c.Get(ctx, "a", func() (*blueprint.Blob, error) {
+			return nil, errors.New("fetch failed")
+		})
+		Expect(err).To(HaveOccurred())
+		get(c, "a", 10)
+		Expect(fetches).To(Equal(map[string]int{"a": 1}))
+	})
+
+	It("should discard the least recently used blobs if the memory size is exceeded and no disk is configured", func() {
+		c, err := blueprint.NewBlobCache(logging.Discard(), 25, nil, 0)
+		Expect(err).ToNot(HaveOccurred())
+
+		get(c, "a", 10)
+		get(c, "b", 10)
+		get(c, "a", 10)
+		get(c, "c", 10) // evicts b
+		Expect(c.MemorySize()).To(BeEquivalentTo(20))
+
+		get(c, "a", 10)
+		get(c, "b", 10)
+		Expect(fetches).To(Equal(map[string]int{"a": 1, "b": 2, "c": 1}))
+	})
+
+	It("should spill evicted blobs to disk and read them from disk", func() {
+		fs := memoryfs.New()
+		c, err := blueprint.NewBlobCache(logging.Discard(), 25, fs, 100)
+		Expect(err).ToNot(HaveOccurred())
+
+		get(c, "a", 10)
+		get(c, "b", 10)
+		get(c, "c", 10) // evicts a to disk
+		Expect(c.MemorySize()).To(BeEquivalentTo(20))
+		Expect(c.DiskSize()).To(BeEquivalentTo(10))
+		files, err := vfs.ReadDir(fs, "/")
+		Expect(err).ToNot(HaveOccurred())
+		Expect(files).To(HaveLen(1))
+
+		get(c, "a", 10) // moves a back into memory and evicts b to disk
+		Expect(c.MemorySize()).To(BeEquivalentTo(20))
+		Expect(c.DiskSize()).To(BeEquivalentTo(10))
+		get(c, "b", 10)
+		Expect(fetches).To(Equal(map[string]int{"a": 1, "b": 1, "c": 1}))
+	})
+
+	It("should write blobs that are larger than the memory size directly to disk", func() {
+		c, err := blueprint.NewBlobCache(logging.Discard(), 25, memoryfs.New(), 100)
+		Expect(err).ToNot(HaveOccurred())
+
+		get(c, "large", 50)
+		Expect(c.MemorySize()).To(BeZero())
+		Expect(c.DiskSize()).To(BeEquivalentTo(50))
+		get(c, "large", 50)
+		Expect(fetches).To(Equal(map[string]int{"large": 1}))
+	})
+
+	It("should delete the least recently used blobs from disk if the disk size is exceeded", func() {
+		fs := memoryfs.New()
+		c, err := blueprint.NewBlobCache(logging.Discard(), 10, fs, 25)
+		Expect(err).ToNot(HaveOccurred())
+
+		get(c, "a", 10)
+		get(c, "b", 10) // a on disk
+		get(c, "c", 10) // b on disk
+		get(c, "d", 10) // c on disk, a deleted
+		Expect(c.DiskSize()).To(BeEquivalentTo(20))
+		files, err := vfs.ReadDir(fs, "/")
+		Expect(err).ToNot(HaveOccurred())
+		Expect(files).To(HaveLen(2))
+
+		get(c, "a", 10)
+		Expect(fetches).To(Equal(map[string]int{"a": 2, "b": 1, "c": 1, "d": 1}))
+	})
+
+	It("should delete existing files on disk", func() {
+		fs := memoryfs.New()
+		Expect(vfs.WriteFile(fs, "/stale", []byte("data"), 0o600)).To(Succeed())
+		_, err := blueprint.NewBlobCache(logging.Discard(), 10, fs, 25)
+		Expect(err).ToNot(HaveOccurred())
+		files, err := vfs.ReadDir(fs, "/")
+		Expect(err).ToNot(HaveOccurred())
+		Expect(files).To(BeEmpty())
+	})
+
+	Context("NewBlobCacheFromConfig", func() {
+		It("should write evicted blobs to the directory of the oci cache", func() {
+			fs := memoryfs.New()
+			c, err := blueprint.NewBlobCacheFromConfig(logging.Discard(), fs,
+				&config.BlueprintBlobCacheConfiguration{MemorySize: "10", DiskSize: "1Ki"},
+				&config.OCICacheConfiguration{Path: "/oci-cache"})
+			Expect(err).ToNot(HaveOccurred())
+
+			get(c, "a", 10)
+			get(c, "b", 10)
+			files, err := vfs.ReadDir(fs, "/oci-cache/"+blueprint.BlobCacheDirName)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(files).To(HaveLen(1))
+		})
+
+		It("should use the default memory size", func() {
+			c, err := blueprint.NewBlobCacheFromConfig(logging.Discard(), memoryfs.New(), nil, nil)
+			Expect(err).ToNot(HaveOccurred())
+			get(c, "a", 1024*1024)
+			Expect(c.MemorySize()).To(BeEquivalentTo(1024 * 1024))
+		})
+
+		It("should reject an invalid size", func() {
+			_, err := blueprint.NewBlobCacheFromConfig(logging.Discard(), memoryfs.New(),
+				&config.BlueprintBlobCacheConfiguration{MemorySize: "ten"}, nil)
+			Expect(err).To(HaveOccurred())
+		})
+	})
+})
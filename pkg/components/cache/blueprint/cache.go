@@ -22,6 +22,7 @@ import (
 	"github.com/mandelsoft/vfs/pkg/projectionfs"
 	"github.com/mandelsoft/vfs/pkg/readonlyfs"
 	"github.com/mandelsoft/vfs/pkg/vfs"
+	"golang.org/x/sync/singleflight"
 	"k8s.io/apimachinery/pkg/api/resource"
 
 	"github.com/gardener/landscaper/apis/config"
@@ -67,6 +68,9 @@ var StoreClosedError = errors.New("STORE_CLOSED")
 //   - ... some other data
 //
 // The hash is calculated using the component descriptor and the name of the blueprint.
+//
+// Additionally, the parsed blueprint definitions are kept in memory, so that the definition of a blueprint
+// is only decoded once per process and reused by all installations that reference the same blueprint.
 type Store struct {
 	log         logging.Logger
 	disabled    bool
@@ -75,6 +79,13 @@ type Store struct {
 	index       cache.Index
 	fs          vfs.FileSystem
 
+	// parsed contains the parsed blueprint definitions by their blueprint id.
+	parsed    map[string]*lsv1alpha1.Blueprint
+	parsedMux sync.Mutex
+
+	// fetchGroup deduplicates concurrent fetches of the same blueprint.
+	fetchGroup singleflight.Group
+
 	size        int64
 	currentSize int64
 	// usage describes the actual usage of the filesystem.
@@ -111,6 +122,7 @@ func NewStore(log logging.Logger, baseFs vfs.FileSystem, config config.Blueprint
 		indexMethod: config.IndexMethod,
 		index:       cache.NewIndex(),
 		fs:          fs,
+		parsed:      map[string]*lsv1alpha1.Blueprint{},
 		gcConfig:    config.GarbageCollectionConfiguration,
 	}
 
@@ -185,6 +197,7 @@ func (s *Store) Put(ctx context.Context, blueprintID string, content *model.Type
 	if err != nil {
 		return true, fmt.Errorf("unable to get size of blueprint directory: %w", err)
 	}
+	s.setParsed(blueprintID, blueprint.Info)
 	s.index.Add(blueprintID, size, time.Now())
 	s.updateUsage(size)
 	cache.StoredItems.Inc()
@@ -195,6 +208,41 @@ func (s *Store) Put(ctx context.Context, blueprintID string, content *model.Type
 	return true, nil
 }
 
+// Fetch fetches the blueprint with the given id using the given function and stores it.
+// Concurrent fetches of the same blueprint are deduplicated, so that a blueprint which is required by several
+// installations at the same time, e.g. after a restart of the landscaper, is only downloaded once.
+// All callers of a deduplicated fetch get the same result.
+func (s *Store) Fetch(ctx context.Context, blueprintID string, fetch func() (*model.TypedResourceContent, error)) (*model.TypedResourceContent, error) {
+	fetchAndPut := func() (*model.TypedResourceContent, error) {
+		content, err := fetch()
+		if err != nil {
+			return nil, err
+		}
+		if _, err := s.Put(ctx, blueprintID, content); err != nil {
+			return nil, err
+		}
+		return content, nil
+	}
+
+	// blueprints without an id cannot be identified, so that their fetches cannot be deduplicated
+	if blueprintID == "" {
+		return fetchAndPut()
+	}
+
+	executed := false
+	res, err, _ := s.fetchGroup.Do(blueprintID, func() (interface{}, error) {
+		executed = true
+		return fetchAndPut()
+	})
+	if !executed {
+		cache.DeduplicatedBlueprintFetches.Inc()
+	}
+	if err != nil {
+		return nil, err
+	}
+	return res.(*model.TypedResourceContent), nil
+}
+
 // CurrentSize returns the current used storage.
 func (s *Store) CurrentSize() int64 {
 	return s.currentSize
@@ -227,7 +275,98 @@ func (s *Store) Get(_ context.Context, blueprintID string) (*blueprints.Blueprin
 		return nil, err
 	}
 	s.index.Hit(blueprintID)
-	return BuildBlueprintFromPath(s.fs, bpPath)
+
+	if parsed := s.getParsed(blueprintID); parsed != nil {
+		cache.ParsedBlueprintHits.Inc()
+		bpFs, err := blueprintFs(s.fs, bpPath)
+		if err != nil {
+			return nil, err
+		}
+		return blueprints.New(parsed, bpFs), nil
+	}
+
+	cache.ParsedBlueprintMisses.Inc()
+	bp, err := BuildBlueprintFromPath(s.fs, bpPath)
+	if err != nil {
+		return nil, err
+	}
+	s.setParsed(blueprintID, bp.Info)
+	return bp, nil
+}
+
+// Invalidate removes the blueprint with the given id from the store,
+// so that it is fetched and parsed again on the next access.
+// This is useful for the development with a local registry, where the content of a blueprint can change
+// without a change of its caching identity.
+func (s *Store) Invalidate(blueprintID string) error {
+	if blueprintID == "" {
+		return nil
+	}
+	s.mux.Lock()
+	defer s.mux.Unlock()
+	return s.remove(blueprintID)
+}
+
+// InvalidateAll removes all blueprints from the store.
+func (s *Store) InvalidateAll() error {
+	s.mux.Lock()
+	defer s.mux.Unlock()
+	var allErrs []error
+	for _, item := range s.index.DeepCopy().PriorityList() {
+		if err := s.remove(item.Name); err != nil {
+			allErrs = append(allErrs, err)
+		}
+	}
+	return errors.Join(allErrs...)
+}
+
+// remove deletes a blueprint from the filesystem, the index and the parsed blueprints.
+// The caller has to hold the write lock of the store.
+func (s *Store) remove(blueprintID string) error {
+	s.deleteParsed(blueprintID)
+	bpPath := filepath.Join("/", blueprintID)
+	if _, err := s.fs.Stat(bpPath); err != nil {
+		if os.IsNotExist(err) {
+			s.index.Remove(blueprintID)
+			return nil
+		}
+		return err
+	}
+	if err := s.fs.RemoveAll(bpPath); err != nil {
+		return fmt.Errorf("unable to delete blueprint directory %q: %w", blueprintID, err)
+	}
+	item := s.index.Get(blueprintID)
+	s.index.Remove(blueprintID)
+	s.updateUsage(-item.Size)
+	cache.StoredItems.Dec()
+	cache.ParsedBlueprintInvalidations.Inc()
+	return nil
+}
+
+// getParsed returns a copy of the parsed blueprint definition or nil if the blueprint has not been parsed yet.
+func (s *Store) getParsed(blueprintID string) *lsv1alpha1.Blueprint {
+	s.parsedMux.Lock()
+	defer s.parsedMux.Unlock()
+	parsed, ok := s.parsed[blueprintID]
+	if !ok {
+		return nil
+	}
+	return parsed.DeepCopy()
+}
+
+func (s *Store) setParsed(blueprintID string, blueprint *lsv1alpha1.Blueprint) {
+	if blueprint == nil {
+		return
+	}
+	s.parsedMux.Lock()
+	defer s.parsedMux.Unlock()
+	s.parsed[blueprintID] = blueprint.DeepCopy()
+}
+
+func (s *Store) deleteParsed(blueprintID string) {
+	s.parsedMux.Lock()
+	defer s.parsedMux.Unlock()
+	delete(s.parsed, blueprintID)
 }
 
 // BuildBlueprintFromPath creates a read-only blueprint from an extracted blueprint.
@@ -240,11 +379,20 @@ func BuildBlueprintFromPath(fs vfs.FileSystem, bpPath string) (*blueprints.Bluep
 	if _, _, err := api.Decoder.Decode(blueprintBytes, nil, blueprint); err != nil {
 		return nil, fmt.Errorf("unable to decode blueprint definition: %w", err)
 	}
+	bpFs, err := blueprintFs(fs, bpPath)
+	if err != nil {
+		return nil, err
+	}
+	return blueprints.New(blueprint, bpFs), nil
+}
+
+// blueprintFs returns a read-only filesystem of an extracted blueprint.
+func blueprintFs(fs vfs.FileSystem, bpPath string) (vfs.FileSystem, error) {
 	bpFs, err := projectionfs.New(readonlyfs.New(fs), bpPath)
 	if err != nil {
 		return nil, fmt.Errorf("unable to create blueprint filesystem: %w", err)
 	}
-	return blueprints.New(blueprint, readonlyfs.New(bpFs)), nil
+	return readonlyfs.New(bpFs), nil
 }
 
 ////////////////////////
@@ -303,6 +451,7 @@ func (s *Store) RunGarbageCollection() {
 		if err := s.fs.RemoveAll(filepath.Join("/", item.Name)); err != nil {
 			s.log.Error(err, "unable to delete blueprint directory", "file", item.Name)
 		}
+		s.deleteParsed(item.Name)
 		s.log.Debug("garbage collected", "item", item.Name)
 		s.updateUsage(-item.Size)
 		cache.StoredItems.Dec()
@@ -6,7 +6,11 @@ package blueprint_test
 
 import (
 	"context"
+	"errors"
 	"os"
+	"sync"
+	"sync/atomic"
+	"time"
 
 	"github.com/mandelsoft/vfs/pkg/osfs"
 
@@ -144,6 +148,82 @@ var _ = Describe("Put", func() {
 			Expect(bpFromCache).To(BeNil())
 		})
 
+		It("should reuse the parsed blueprint definition", func() {
+			ctx := context.Background()
+			memFs := memoryfs.New()
+			Expect(memFs.Mkdir("/store", os.ModePerm)).To(Succeed())
+			defaultStoreConfig.Path = "/store"
+			store, err := NewStore(logging.Discard(), memFs, defaultStoreConfig)
+			Expect(err).ToNot(HaveOccurred())
+
+			fs := memoryfs.New()
+			err = vfs.CopyDir(osfs.New(), TESTDATA_PATH, fs, "/")
+			Expect(err).ToNot(HaveOccurred())
+
+			bp, err := BuildBlueprintFromPath(fs, BLUEPRINT_SUBPATH)
+			Expect(err).ToNot(HaveOccurred())
+
+			ok, err := store.Put(ctx, BLUEPRINT_ID, &model.TypedResourceContent{
+				Type:     mediatype.BlueprintType,
+				Resource: bp,
+			})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(ok).To(BeTrue())
+
+			// the stored definition must not be parsed again
+			Expect(memFs.Remove("/store/" + BLUEPRINT_ID + "/blueprint.yaml")).To(Succeed())
+
+			bpFromCache, err := store.Get(ctx, BLUEPRINT_ID)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(bpFromCache.Info.Annotations).To(HaveKeyWithValue("test", "original"))
+
+			// modifications of a returned blueprint must not affect the cache
+			bpFromCache.Info.Annotations["test"] = "modified"
+			bpFromCache, err = store.Get(ctx, BLUEPRINT_ID)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(bpFromCache.Info.Annotations).To(HaveKeyWithValue("test", "original"))
+		})
+
+		It("should remove invalidated blueprints", func() {
+			ctx := context.Background()
+			memFs := memoryfs.New()
+			store, err := NewStore(logging.Discard(), memFs, defaultStoreConfig)
+			Expect(err).ToNot(HaveOccurred())
+
+			fs := memoryfs.New()
+			err = vfs.CopyDir(osfs.New(), TESTDATA_PATH, fs, "/")
+			Expect(err).ToNot(HaveOccurred())
+
+			bp, err := BuildBlueprintFromPath(fs, BLUEPRINT_SUBPATH)
+			Expect(err).ToNot(HaveOccurred())
+			for _, id := range []string{"a", "b", "c"} {
+				_, err := store.Put(ctx, id, &model.TypedResourceContent{
+					Type:     mediatype.BlueprintType,
+					Resource: bp,
+				})
+				Expect(err).ToNot(HaveOccurred())
+			}
+			size := store.CurrentSize()
+
+			Expect(store.Invalidate("a")).To(Succeed())
+			bpFromCache, err := store.Get(ctx, "a")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(bpFromCache).To(BeNil())
+			Expect(store.CurrentSize()).To(Equal(size / 3 * 2))
+
+			bpFromCache, err = store.Get(ctx, "b")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(bpFromCache).ToNot(BeNil())
+
+			Expect(store.InvalidateAll()).To(Succeed())
+			for _, id := range []string{"a", "b", "c"} {
+				bpFromCache, err := store.Get(ctx, id)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(bpFromCache).To(BeNil())
+			}
+			Expect(store.CurrentSize()).To(BeZero())
+		})
+
 	})
 
 	Context("GarbageCollection", func() {
@@ -255,4 +335,66 @@ var _ = Describe("Put", func() {
 		})
 	})
 
+	Context("fetch", func() {
+		It("should fetch a blueprint only once for concurrent callers and store it", func() {
+			ctx := context.Background()
+			store, err := NewStore(logging.Discard(), memoryfs.New(), defaultStoreConfig)
+			Expect(err).ToNot(HaveOccurred())
+
+			fs := memoryfs.New()
+			Expect(vfs.CopyDir(osfs.New(), TESTDATA_PATH, fs, "/")).To(Succeed())
+			bp, err := BuildBlueprintFromPath(fs, BLUEPRINT_SUBPATH)
+			Expect(err).ToNot(HaveOccurred())
+
+			fetches := atomic.Int32{}
+			release := make(chan struct{})
+			fetch := func() (*model.TypedResourceContent, error) {
+				fetches.Add(1)
+				<-release
+				return &model.TypedResourceContent{
+					Type:     mediatype.BlueprintType,
+					Resource: bp,
+				}, nil
+			}
+
+			const callers = 5
+			wg := sync.WaitGroup{}
+			for i := 0; i < callers; i++ {
+				wg.Add(1)
+				go func() {
+					defer GinkgoRecover()
+					defer wg.Done()
+					content, err := store.Fetch(ctx, BLUEPRINT_ID, fetch)
+					Expect(err).ToNot(HaveOccurred())
+					Expect(content.Resource).To(BeIdenticalTo(bp))
+				}()
+			}
+			// give the callers the chance to join the first fetch
+			Eventually(fetches.Load).Should(Equal(int32(1)))
+			time.Sleep(100 * time.Millisecond)
+			close(release)
+			wg.Wait()
+
+			Expect(fetches.Load()).To(Equal(int32(1)))
+			bpFromCache, err := store.Get(ctx, BLUEPRINT_ID)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(bpFromCache.Info.Annotations).To(HaveKeyWithValue("test", "original"))
+		})
+
+		It("should return the error of a failed fetch and not store the blueprint", func() {
+			ctx := context.Background()
+			store, err := NewStore(logging.Discard(), memoryfs.New(), defaultStoreConfig)
+			Expect(err).ToNot(HaveOccurred())
+
+			_, err = store.Fetch(ctx, BLUEPRINT_ID, func() (*model.TypedResourceContent, error) {
+				return nil, errors.New("not found")
+			})
+			Expect(err).To(MatchError("not found"))
+
+			bp, err := store.Get(ctx, BLUEPRINT_ID)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(bp).To(BeNil())
+		})
+	})
+
 })
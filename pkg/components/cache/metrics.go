@@ -38,6 +38,49 @@ var (
 		},
 	)
 
+	// ParsedBlueprintHits discloses the number of blueprints that have been read from the blueprint store
+	// without parsing their definition again.
+	ParsedBlueprintHits = prometheus.NewCounter(
+		prometheus.CounterOpts{
+			Namespace: lsv1alpha1.LandscaperMetricsNamespaceName,
+			Subsystem: storeSubsystemName,
+			Name:      "parsed_hits_total",
+			Help:      "Total number of blueprints that have been read from the blueprint store without parsing their definition.",
+		},
+	)
+
+	// ParsedBlueprintMisses discloses the number of blueprints whose definition had to be parsed
+	// when they have been read from the blueprint store.
+	ParsedBlueprintMisses = prometheus.NewCounter(
+		prometheus.CounterOpts{
+			Namespace: lsv1alpha1.LandscaperMetricsNamespaceName,
+			Subsystem: storeSubsystemName,
+			Name:      "parsed_misses_total",
+			Help:      "Total number of blueprints whose definition had to be parsed when they have been read from the blueprint store.",
+		},
+	)
+
+	// ParsedBlueprintInvalidations discloses the number of blueprints that have been invalidated in the blueprint store.
+	ParsedBlueprintInvalidations = prometheus.NewCounter(
+		prometheus.CounterOpts{
+			Namespace: lsv1alpha1.LandscaperMetricsNamespaceName,
+			Subsystem: storeSubsystemName,
+			Name:      "invalidations_total",
+			Help:      "Total number of blueprints that have been invalidated in the blueprint store.",
+		},
+	)
+
+	// DeduplicatedBlueprintFetches discloses the number of blueprint fetches that have been deduplicated,
+	// because the same blueprint was already fetched concurrently.
+	DeduplicatedBlueprintFetches = prometheus.NewCounter(
+		prometheus.CounterOpts{
+			Namespace: lsv1alpha1.LandscaperMetricsNamespaceName,
+			Subsystem: storeSubsystemName,
+			Name:      "deduplicated_fetches_total",
+			Help:      "Total number of blueprint fetches that have been deduplicated because the same blueprint was fetched concurrently.",
+		},
+	)
+
 	// CacheMemoryUsage discloses memory used by caches
 	CacheMemoryUsage = prometheus.NewGaugeVec(
 		prometheus.GaugeOpts{
@@ -98,6 +141,10 @@ var (
 func RegisterStoreMetrics(reg prometheus.Registerer) {
 	reg.MustRegister(DiskUsage)
 	reg.MustRegister(StoredItems)
+	reg.MustRegister(ParsedBlueprintHits)
+	reg.MustRegister(ParsedBlueprintMisses)
+	reg.MustRegister(ParsedBlueprintInvalidations)
+	reg.MustRegister(DeduplicatedBlueprintFetches)
 
 	reg.MustRegister(CacheHitsDisk)
 	reg.MustRegister(CacheHitsMemory)
@@ -81,6 +81,9 @@ func (*Factory) NewRegistryAccess(ctx context.Context,
 	if err != nil {
 		return nil, err
 	}
+	if err := cnudieutils.AddCredentialHelpers(ociKeyring, ociRegistryConfig); err != nil {
+		return nil, err
+	}
 
 	ociClient, err := ociclient.NewClient(logger.Logr(),
 		cnudieutils.WithConfiguration(ociRegistryConfig),
@@ -8,6 +8,7 @@ import (
 	"context"
 	"fmt"
 	"io"
+	"strings"
 
 	"github.com/gardener/component-cli/ociclient"
 	"github.com/gardener/component-cli/ociclient/cache"
@@ -15,6 +16,7 @@ import (
 	cdv2 "github.com/gardener/component-spec/bindings-go/apis/v2"
 	"github.com/gardener/component-spec/bindings-go/ctf"
 	"github.com/mandelsoft/vfs/pkg/osfs"
+	ocispecv1 "github.com/opencontainers/image-spec/specs-go/v1"
 	corev1 "k8s.io/api/core/v1"
 
 	"github.com/gardener/landscaper/apis/config"
@@ -62,6 +64,9 @@ func createOCIClient(ctx context.Context, registryPullSecrets []corev1.Secret, o
 	if err != nil {
 		return nil, err
 	}
+	if err := cnudieutils.AddCredentialHelpers(ociKeyring, ociConfig); err != nil {
+		return nil, err
+	}
 	ociClient, err := ociclient.NewClient(logger.Logr(),
 		cnudieutils.WithConfiguration(ociConfig),
 		ociclient.WithKeyring(ociKeyring),
@@ -138,5 +143,39 @@ func (h BlobResolverForHelmOCI) resolve(ctx context.Context, res types.Resource,
 		}, nil
 	}
 
+	// charts that are pushed with oras might use arbitrary media types,
+	// so that the chart layer can only be identified by the file name in its title annotation.
+	if chartLayer := getORASChartLayer(manifest.Layers); chartLayer != nil {
+		logging.FromContextOrDiscard(ctx).Info("Helm Chart pushed with oras used", "ref", ociArtifactAccess.ImageReference,
+			"mediaType", chartLayer.MediaType)
+		if writer != nil {
+			if err := h.ociClient.Fetch(ctx, ociArtifactAccess.ImageReference, *chartLayer, writer); err != nil {
+				return nil, err
+			}
+		}
+		return &types.BlobInfo{
+			MediaType: chartLayer.MediaType,
+			Digest:    chartLayer.Digest.String(),
+			Size:      chartLayer.Size,
+		}, nil
+	}
+
 	return nil, fmt.Errorf("unknown oci artifact of type %s", manifest.Config.MediaType)
 }
+
+// getORASChartLayer returns the single layer whose title annotation denotes a chart archive.
+// Nil is returned if there is no such layer or if it is ambiguous.
+func getORASChartLayer(layers []ocispecv1.Descriptor) *ocispecv1.Descriptor {
+	var chartLayer *ocispecv1.Descriptor
+	for i, layer := range layers {
+		title := layer.Annotations[OCIImageTitleAnnotation]
+		if !strings.HasSuffix(title, ".tgz") && !strings.HasSuffix(title, ".tar.gz") {
+			continue
+		}
+		if chartLayer != nil {
+			return nil
+		}
+		chartLayer = &layers[i]
+	}
+	return chartLayer
+}
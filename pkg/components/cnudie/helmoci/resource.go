@@ -27,6 +27,10 @@ const (
 
 	// LegacyChartLayerMediaType is the legacy reserved media type for Helm chart package content.
 	LegacyChartLayerMediaType = "application/tar+gzip"
+
+	// OCIImageTitleAnnotation is the annotation that contains the file name of a layer.
+	// It is set by oras when a chart archive is pushed with a custom media type.
+	OCIImageTitleAnnotation = "org.opencontainers.image.title"
 )
 
 func NewResourceDataForHelmOCI(ociImageRef string) (*types.Resource, error) {
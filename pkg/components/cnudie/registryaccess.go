@@ -9,6 +9,7 @@ import (
 	"errors"
 	"fmt"
 
+	cdv2 "github.com/gardener/component-spec/bindings-go/apis/v2"
 	"github.com/gardener/component-spec/bindings-go/ctf"
 
 	lsv1alpha1 "github.com/gardener/landscaper/apis/core/v1alpha1"
@@ -28,6 +29,11 @@ func (r *RegistryAccess) VerifySignature(componentVersion model.ComponentVersion
 	return errors.New("VerifySignature is not supported in CNUDIE sbom")
 }
 
+// ListComponentVersions is NOT supported in cnudie, only in OCM. Will always return error.
+func (r *RegistryAccess) ListComponentVersions(ctx context.Context, repositoryContext *cdv2.UnstructuredTypedObject, componentName string) ([]string, error) {
+	return nil, errors.New("ListComponentVersions is not supported in CNUDIE sbom")
+}
+
 func (r *RegistryAccess) GetComponentVersion(ctx context.Context, cdRef *lsv1alpha1.ComponentDescriptorReference) (model.ComponentVersion, error) {
 	if cdRef == nil {
 		return nil, errors.New("component descriptor reference cannot be nil")
@@ -35,7 +35,8 @@ func New() *BlueprintHandler {
 }
 
 func (h *BlueprintHandler) GetResourceContent(ctx context.Context, r model.Resource, blobResolver model.BlobResolver) (*model.TypedResourceContent, error) {
-	res, err := blueprint.GetBlueprintStore().Get(ctx, r.GetCachingIdentity(ctx))
+	blueprintID := r.GetCachingIdentity(ctx)
+	res, err := blueprint.GetBlueprintStore().Get(ctx, blueprintID)
 	if err != nil {
 		return nil, err
 	}
@@ -46,26 +47,24 @@ func (h *BlueprintHandler) GetResourceContent(ctx context.Context, r model.Resou
 		}, nil
 	}
 
-	buffer := new(bytes.Buffer)
-	resource, err := r.GetResource()
-	if err != nil {
-		return nil, err
-	}
-	blobInfo, err := blobResolver.Resolve(ctx, *resource, buffer)
-	if err != nil {
-		return nil, err
-	}
-	typedResourceContent, err := h.Prepare(ctx, buffer, blobInfo)
-	if err != nil {
-		return nil, err
-	}
-
-	_, err = blueprint.GetBlueprintStore().Put(ctx, r.GetCachingIdentity(ctx), typedResourceContent)
-	if err != nil {
-		return nil, err
-	}
-
-	return typedResourceContent, nil
+	return blueprint.GetBlueprintStore().Fetch(ctx, blueprintID, func() (*model.TypedResourceContent, error) {
+		blob, err := blueprint.GetBlobCache().Get(ctx, blueprintID, func() (*blueprint.Blob, error) {
+			buffer := new(bytes.Buffer)
+			resource, err := r.GetResource()
+			if err != nil {
+				return nil, err
+			}
+			blobInfo, err := blobResolver.Resolve(ctx, *resource, buffer)
+			if err != nil {
+				return nil, err
+			}
+			return &blueprint.Blob{MediaType: blobInfo.MediaType, Data: buffer.Bytes()}, nil
+		})
+		if err != nil {
+			return nil, err
+		}
+		return h.Prepare(ctx, bytes.NewReader(blob.Data), &types.BlobInfo{MediaType: blob.MediaType})
+	})
 }
 
 func (h *BlueprintHandler) Prepare(ctx context.Context, data io.Reader, info *types.BlobInfo) (_ *model.TypedResourceContent, rerr error) {
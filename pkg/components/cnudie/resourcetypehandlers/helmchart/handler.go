@@ -10,6 +10,7 @@ import (
 	"fmt"
 	"io"
 
+	"github.com/opencontainers/go-digest"
 	chartloader "helm.sh/helm/v3/pkg/chart/loader"
 
 	"github.com/gardener/landscaper/pkg/components/cnudie/registries"
@@ -38,10 +39,12 @@ func (h *HelmChartHandler) GetResourceContent(ctx context.Context, r model.Resou
 	if err != nil {
 		return nil, err
 	}
+	chartDigest := digest.FromBytes(buffer.Bytes()).String()
 	typedResourceContent, err := h.Prepare(ctx, buffer, blobInfo)
 	if err != nil {
 		return nil, err
 	}
+	typedResourceContent.Digest = chartDigest
 
 	return typedResourceContent, nil
 }
@@ -5,13 +5,17 @@
 package utils
 
 import (
-	"crypto/tls"
+	"context"
 	"net/http"
 
+	"github.com/docker/cli/cli/config/types"
 	"github.com/gardener/component-cli/ociclient"
 	"github.com/gardener/component-cli/ociclient/cache"
+	"github.com/gardener/component-cli/ociclient/credentials"
 
 	"github.com/gardener/landscaper/apis/config"
+	"github.com/gardener/landscaper/pkg/components/credentialhelpers"
+	"github.com/gardener/landscaper/pkg/components/registryconnections"
 )
 
 // WithConfigurationStruct applies external oci configuration as internal options.
@@ -31,11 +35,9 @@ func (c *WithConfigurationStruct) ApplyOption(options *ociclient.Options) {
 		}
 	}
 	options.AllowPlainHttp = c.AllowPlainHttp
-	if c.InsecureSkipVerify {
-		tlsConfig := &tls.Config{
-			InsecureSkipVerify: true,
-		}
-		options.HTTPClient = &http.Client{Transport: &http.Transport{TLSClientConfig: tlsConfig}}
+	// the connections to registry hosts with a proxy or custom certificate authorities are loaded at startup
+	if c.InsecureSkipVerify || len(registryconnections.List()) != 0 {
+		options.HTTPClient = &http.Client{Transport: registryconnections.NewTransport(c.InsecureSkipVerify)}
 	}
 }
 
@@ -60,3 +62,31 @@ func ToOCICacheOptions(cfg *config.OCICacheConfiguration, uid string) []cache.Op
 	cacheOpts = append(cacheOpts, cache.WithUID(uid))
 	return cacheOpts
 }
+
+// AddCredentialHelpers adds the credential helpers of the oci configuration to the keyring.
+// The credentials of a registry host are obtained from its credential helper whenever the keyring is asked for them.
+func AddCredentialHelpers(keyring *credentials.GeneralOciKeyring, cfg *config.OCIConfiguration) error {
+	if cfg == nil {
+		return nil
+	}
+	if err := credentialhelpers.Validate(cfg.CredentialHelpers); err != nil {
+		return err
+	}
+	for _, helper := range cfg.CredentialHelpers {
+		helper := helper
+		err := keyring.AddAuthConfigGetter(helper.Host, func(_ string) (credentials.Auth, error) {
+			creds, err := credentialhelpers.GetCredentials(context.Background(), helper)
+			if err != nil {
+				return nil, err
+			}
+			return credentials.FromAuthConfig(types.AuthConfig{
+				Username: creds.Username,
+				Password: creds.Password,
+			}, "credential-helper", string(helper.Type)), nil
+		})
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,71 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package credentialhelpers
+
+import (
+	"context"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"regexp"
+	"strings"
+
+	awsconfig "github.com/aws/aws-sdk-go-v2/config"
+	"github.com/aws/aws-sdk-go-v2/service/ecr"
+
+	"github.com/gardener/landscaper/apis/config"
+)
+
+// ecrHostPattern matches the hosts of private elastic container registries.
+// The third submatch is the region of the registry.
+var ecrHostPattern = regexp.MustCompile(`^(\d{12})\.dkr\.ecr(-fips)?\.([a-z0-9-]+)\.amazonaws\.com(\.cn)?$`)
+
+// awsHelper obtains an authorization token of the elastic container registry
+// from the default aws credential chain, e.g. the web identity of an EKS pod.
+type awsHelper struct {
+	region string
+}
+
+func newAWSHelper(cfg config.OCICredentialHelper) (Helper, error) {
+	match := ecrHostPattern.FindStringSubmatch(cfg.Host)
+	if match == nil {
+		return nil, fmt.Errorf("host %q is not an aws elastic container registry", cfg.Host)
+	}
+	return &awsHelper{region: match[3]}, nil
+}
+
+func (h *awsHelper) Credentials(ctx context.Context, _ string) (*Credentials, error) {
+	awsCfg, err := awsconfig.LoadDefaultConfig(ctx, awsconfig.WithRegion(h.region))
+	if err != nil {
+		return nil, fmt.Errorf("unable to load aws default configuration: %w", err)
+	}
+
+	out, err := ecr.NewFromConfig(awsCfg).GetAuthorizationToken(ctx, &ecr.GetAuthorizationTokenInput{})
+	if err != nil {
+		return nil, fmt.Errorf("unable to get ecr authorization token: %w", err)
+	}
+	if len(out.AuthorizationData) == 0 || out.AuthorizationData[0].AuthorizationToken == nil {
+		return nil, errors.New("ecr did not return an authorization token")
+	}
+
+	authData := out.AuthorizationData[0]
+	decoded, err := base64.StdEncoding.DecodeString(*authData.AuthorizationToken)
+	if err != nil {
+		return nil, fmt.Errorf("unable to decode ecr authorization token: %w", err)
+	}
+	username, password, ok := strings.Cut(string(decoded), ":")
+	if !ok {
+		return nil, errors.New("ecr authorization token is not of the form <username>:<password>")
+	}
+
+	creds := &Credentials{
+		Username: username,
+		Password: password,
+	}
+	if authData.ExpiresAt != nil {
+		creds.ExpiresAt = *authData.ExpiresAt
+	}
+	return creds, nil
+}
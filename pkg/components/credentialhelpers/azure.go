@@ -0,0 +1,137 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package credentialhelpers
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"strings"
+	"time"
+
+	"golang.org/x/oauth2"
+	"golang.org/x/oauth2/clientcredentials"
+
+	"github.com/gardener/landscaper/apis/config"
+)
+
+const (
+	// azureRegistryUsername is the username that azure container registries expect for refresh tokens.
+	azureRegistryUsername = "00000000-0000-0000-0000-000000000000"
+
+	azureDefaultAuthorityHost = "https://login.microsoftonline.com/"
+	azureManagementScope      = "https://management.azure.com/.default"
+	azureClientAssertionType  = "urn:ietf:params:oauth:client-assertion-type:jwt-bearer"
+
+	// environment variables that are injected into pods by the azure workload identity webhook.
+	azureClientIDEnv           = "AZURE_CLIENT_ID"
+	azureTenantIDEnv           = "AZURE_TENANT_ID"
+	azureFederatedTokenFileEnv = "AZURE_FEDERATED_TOKEN_FILE"
+	azureAuthorityHostEnv      = "AZURE_AUTHORITY_HOST"
+)
+
+// azureHelper exchanges the azure workload identity of the pod for a refresh token of the azure container registry.
+type azureHelper struct {
+	httpClient *http.Client
+	// registryScheme is the scheme of the token exchange endpoint of the registry.
+	registryScheme string
+}
+
+func newAzureHelper(_ config.OCICredentialHelper) (Helper, error) {
+	return &azureHelper{
+		httpClient:     &http.Client{Timeout: 30 * time.Second},
+		registryScheme: "https",
+	}, nil
+}
+
+func (h *azureHelper) Credentials(ctx context.Context, host string) (*Credentials, error) {
+	clientID := os.Getenv(azureClientIDEnv)
+	tenantID := os.Getenv(azureTenantIDEnv)
+	tokenFile := os.Getenv(azureFederatedTokenFileEnv)
+	if len(clientID) == 0 || len(tenantID) == 0 || len(tokenFile) == 0 {
+		return nil, fmt.Errorf("azure workload identity is not configured: %s, %s and %s have to be set",
+			azureClientIDEnv, azureTenantIDEnv, azureFederatedTokenFileEnv)
+	}
+	authorityHost := os.Getenv(azureAuthorityHostEnv)
+	if len(authorityHost) == 0 {
+		authorityHost = azureDefaultAuthorityHost
+	}
+
+	// the federated token is rotated by kubernetes, therefore it is read on every request.
+	federatedToken, err := os.ReadFile(tokenFile)
+	if err != nil {
+		return nil, fmt.Errorf("unable to read azure federated token: %w", err)
+	}
+
+	aadConfig := clientcredentials.Config{
+		ClientID: clientID,
+		TokenURL: strings.TrimSuffix(authorityHost, "/") + "/" + tenantID + "/oauth2/v2.0/token",
+		Scopes:   []string{azureManagementScope},
+		EndpointParams: url.Values{
+			"client_assertion_type": {azureClientAssertionType},
+			"client_assertion":      {strings.TrimSpace(string(federatedToken))},
+		},
+		AuthStyle: oauth2.AuthStyleInParams,
+	}
+	aadToken, err := aadConfig.Token(context.WithValue(ctx, oauth2.HTTPClient, h.httpClient))
+	if err != nil {
+		return nil, fmt.Errorf("unable to get azure active directory token: %w", err)
+	}
+
+	refreshToken, err := h.exchangeRefreshToken(ctx, host, tenantID, aadToken.AccessToken)
+	if err != nil {
+		return nil, err
+	}
+	return &Credentials{
+		Username:  azureRegistryUsername,
+		Password:  refreshToken,
+		ExpiresAt: aadToken.Expiry,
+	}, nil
+}
+
+// exchangeRefreshToken exchanges an azure active directory access token for a refresh token of the registry.
+func (h *azureHelper) exchangeRefreshToken(ctx context.Context, host, tenantID, accessToken string) (string, error) {
+	form := url.Values{
+		"grant_type":   {"access_token"},
+		"service":      {host},
+		"tenant":       {tenantID},
+		"access_token": {accessToken},
+	}
+	exchangeURL := fmt.Sprintf("%s://%s/oauth2/exchange", h.registryScheme, host)
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, exchangeURL, strings.NewReader(form.Encode()))
+	if err != nil {
+		return "", fmt.Errorf("unable to create token exchange request for registry %q: %w", host, err)
+	}
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+
+	resp, err := h.httpClient.Do(req)
+	if err != nil {
+		return "", fmt.Errorf("unable to exchange token at registry %q: %w", host, err)
+	}
+	defer resp.Body.Close()
+	body, err := io.ReadAll(io.LimitReader(resp.Body, 1024*1024))
+	if err != nil {
+		return "", fmt.Errorf("unable to read token exchange response of registry %q: %w", host, err)
+	}
+	if resp.StatusCode != http.StatusOK {
+		return "", fmt.Errorf("unable to exchange token at registry %q: unexpected status code %d", host, resp.StatusCode)
+	}
+
+	exchangeResponse := struct {
+		RefreshToken string `json:"refresh_token"`
+	}{}
+	if err := json.Unmarshal(body, &exchangeResponse); err != nil {
+		return "", fmt.Errorf("unable to decode token exchange response of registry %q: %w", host, err)
+	}
+	if len(exchangeResponse.RefreshToken) == 0 {
+		return "", errors.New("token exchange response does not contain a refresh token")
+	}
+	return exchangeResponse.RefreshToken, nil
+}
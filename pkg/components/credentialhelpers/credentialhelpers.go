@@ -0,0 +1,132 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package credentialhelpers
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/gardener/landscaper/apis/config"
+)
+
+const (
+	// DefaultCredentialsTTL is the time credentials without an expiration date are reused.
+	DefaultCredentialsTTL = 10 * time.Minute
+
+	// expirationBuffer is the time before the expiration of credentials at which new credentials are requested.
+	expirationBuffer = 5 * time.Minute
+)
+
+// Credentials are the credentials of a registry host that are obtained by a credential helper.
+type Credentials struct {
+	Username string
+	Password string
+	// ExpiresAt is the time until the credentials are valid.
+	// Credentials without expiration date are requested again after DefaultCredentialsTTL.
+	ExpiresAt time.Time
+}
+
+// Helper obtains the credentials of a registry host.
+type Helper interface {
+	Credentials(ctx context.Context, host string) (*Credentials, error)
+}
+
+// HelperFactory creates a credential helper for the given configuration.
+type HelperFactory func(cfg config.OCICredentialHelper) (Helper, error)
+
+var (
+	factoriesMux sync.RWMutex
+	factories    = map[config.OCICredentialHelperType]HelperFactory{
+		config.GCPCredentialHelper:   newGCPHelper,
+		config.AWSCredentialHelper:   newAWSHelper,
+		config.AzureCredentialHelper: newAzureHelper,
+		config.ExecCredentialHelper:  newExecHelper,
+	}
+)
+
+// Register registers a factory for credential helpers of the given type.
+// An already registered factory of the type is replaced.
+func Register(helperType config.OCICredentialHelperType, factory HelperFactory) {
+	factoriesMux.Lock()
+	defer factoriesMux.Unlock()
+	factories[helperType] = factory
+}
+
+// New creates the credential helper of the given configuration.
+func New(cfg config.OCICredentialHelper) (Helper, error) {
+	if len(cfg.Host) == 0 {
+		return nil, fmt.Errorf("no host defined for credential helper of type %q", cfg.Type)
+	}
+	factoriesMux.RLock()
+	factory, ok := factories[cfg.Type]
+	factoriesMux.RUnlock()
+	if !ok {
+		return nil, fmt.Errorf("unknown credential helper type %q for host %q", cfg.Type, cfg.Host)
+	}
+	return factory(cfg)
+}
+
+// Validate checks that credential helpers can be created for all given configurations.
+func Validate(cfgs []config.OCICredentialHelper) error {
+	for _, cfg := range cfgs {
+		if _, err := New(cfg); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Cache caches the credentials obtained by credential helpers until shortly before they expire.
+type Cache struct {
+	mux     sync.Mutex
+	entries map[config.OCICredentialHelper]*Credentials
+	now     func() time.Time
+}
+
+// NewCache creates a new cache for the credentials of credential helpers.
+func NewCache() *Cache {
+	return &Cache{
+		entries: map[config.OCICredentialHelper]*Credentials{},
+		now:     time.Now,
+	}
+}
+
+var defaultCache = NewCache()
+
+// GetCredentials returns the credentials of the registry host of the given credential helper configuration.
+// The credentials are shared by all registry accesses of the process.
+func GetCredentials(ctx context.Context, cfg config.OCICredentialHelper) (*Credentials, error) {
+	return defaultCache.Get(ctx, cfg)
+}
+
+// Get returns the cached credentials of the given credential helper configuration.
+// New credentials are requested from the helper if there are no cached credentials or if they are about to expire.
+func (c *Cache) Get(ctx context.Context, cfg config.OCICredentialHelper) (*Credentials, error) {
+	c.mux.Lock()
+	creds, ok := c.entries[cfg]
+	c.mux.Unlock()
+	if ok && c.now().Add(expirationBuffer).Before(creds.ExpiresAt) {
+		return creds, nil
+	}
+
+	helper, err := New(cfg)
+	if err != nil {
+		return nil, err
+	}
+	creds, err = helper.Credentials(ctx, cfg.Host)
+	if err != nil {
+		return nil, fmt.Errorf("unable to get credentials of host %q from credential helper of type %q: %w", cfg.Host, cfg.Type, err)
+	}
+	if creds.ExpiresAt.IsZero() {
+		creds.ExpiresAt = c.now().Add(DefaultCredentialsTTL + expirationBuffer)
+	}
+
+	c.mux.Lock()
+	defer c.mux.Unlock()
+	c.entries[cfg] = creds
+	return creds, nil
+}
@@ -0,0 +1,181 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package credentialhelpers
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/gardener/landscaper/apis/config"
+)
+
+type countingHelper struct {
+	calls     int
+	expiresAt time.Time
+}
+
+func (h *countingHelper) Credentials(_ context.Context, host string) (*Credentials, error) {
+	h.calls++
+	return &Credentials{Username: "user", Password: host, ExpiresAt: h.expiresAt}, nil
+}
+
+var _ = Describe("Credential Helpers", func() {
+
+	var ctx context.Context
+
+	BeforeEach(func() {
+		ctx = context.Background()
+	})
+
+	Context("Cache", func() {
+
+		const testHelperType config.OCICredentialHelperType = "test"
+
+		var (
+			now    time.Time
+			cache  *Cache
+			helper *countingHelper
+			cfg    config.OCICredentialHelper
+		)
+
+		BeforeEach(func() {
+			now = time.Now()
+			cache = NewCache()
+			cache.now = func() time.Time { return now }
+			helper = &countingHelper{expiresAt: now.Add(time.Hour)}
+			Register(testHelperType, func(_ config.OCICredentialHelper) (Helper, error) {
+				return helper, nil
+			})
+			cfg = config.OCICredentialHelper{Host: "registry.example.com", Type: testHelperType}
+		})
+
+		It("should reuse credentials until shortly before they expire", func() {
+			creds, err := cache.Get(ctx, cfg)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(creds.Password).To(Equal("registry.example.com"))
+
+			_, err = cache.Get(ctx, cfg)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(helper.calls).To(Equal(1))
+
+			now = now.Add(time.Hour - expirationBuffer)
+			_, err = cache.Get(ctx, cfg)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(helper.calls).To(Equal(2))
+		})
+
+		It("should request credentials without expiration date again after the default ttl", func() {
+			helper.expiresAt = time.Time{}
+			_, err := cache.Get(ctx, cfg)
+			Expect(err).ToNot(HaveOccurred())
+
+			now = now.Add(DefaultCredentialsTTL - time.Second)
+			_, err = cache.Get(ctx, cfg)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(helper.calls).To(Equal(1))
+
+			now = now.Add(time.Second)
+			_, err = cache.Get(ctx, cfg)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(helper.calls).To(Equal(2))
+		})
+	})
+
+	Context("Validate", func() {
+
+		It("should accept valid configurations", func() {
+			Expect(Validate([]config.OCICredentialHelper{
+				{Host: "europe-docker.pkg.dev", Type: config.GCPCredentialHelper},
+				{Host: "123456789012.dkr.ecr.eu-central-1.amazonaws.com", Type: config.AWSCredentialHelper},
+				{Host: "example.azurecr.io", Type: config.AzureCredentialHelper},
+				{Host: "registry.example.com", Type: config.ExecCredentialHelper, Command: "docker-credential-example"},
+			})).To(Succeed())
+		})
+
+		It("should reject unknown credential helper types", func() {
+			err := Validate([]config.OCICredentialHelper{{Host: "registry.example.com", Type: "unknown"}})
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("unknown credential helper type"))
+		})
+
+		It("should reject aws credential helpers for hosts that are no elastic container registries", func() {
+			err := Validate([]config.OCICredentialHelper{{Host: "registry.example.com", Type: config.AWSCredentialHelper}})
+			Expect(err).To(HaveOccurred())
+		})
+
+		It("should reject exec credential helpers without command", func() {
+			err := Validate([]config.OCICredentialHelper{{Host: "registry.example.com", Type: config.ExecCredentialHelper}})
+			Expect(err).To(HaveOccurred())
+		})
+	})
+
+	Context("Exec", func() {
+
+		It("should get the credentials from a docker credential helper", func() {
+			script := filepath.Join(GinkgoT().TempDir(), "docker-credential-test")
+			Expect(os.WriteFile(script, []byte(`#!/bin/sh
+read host
+echo "{\"ServerURL\": \"$host\", \"Username\": \"user\", \"Secret\": \"secret-$host\"}"
+`), 0o755)).To(Succeed())
+
+			helper, err := New(config.OCICredentialHelper{Host: "registry.example.com", Type: config.ExecCredentialHelper, Command: script})
+			Expect(err).ToNot(HaveOccurred())
+
+			creds, err := helper.Credentials(ctx, "registry.example.com")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(creds.Username).To(Equal("user"))
+			Expect(creds.Password).To(Equal("secret-registry.example.com"))
+		})
+	})
+
+	Context("Azure", func() {
+
+		It("should exchange the federated token for a refresh token of the registry", func() {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				Expect(r.ParseForm()).To(Succeed())
+				w.Header().Set("Content-Type", "application/json")
+				switch r.URL.Path {
+				case "/tenant/oauth2/v2.0/token":
+					Expect(r.PostForm.Get("client_id")).To(Equal("client"))
+					Expect(r.PostForm.Get("client_assertion")).To(Equal("federated-token"))
+					_ = json.NewEncoder(w).Encode(map[string]interface{}{
+						"access_token": "aad-token",
+						"token_type":   "Bearer",
+						"expires_in":   3600,
+					})
+				case "/oauth2/exchange":
+					Expect(r.PostForm.Get("access_token")).To(Equal("aad-token"))
+					_ = json.NewEncoder(w).Encode(map[string]string{"refresh_token": "acr-token"})
+				default:
+					w.WriteHeader(http.StatusNotFound)
+				}
+			}))
+			defer server.Close()
+
+			tokenFile := filepath.Join(GinkgoT().TempDir(), "token")
+			Expect(os.WriteFile(tokenFile, []byte("federated-token\n"), 0o600)).To(Succeed())
+			GinkgoT().Setenv(azureClientIDEnv, "client")
+			GinkgoT().Setenv(azureTenantIDEnv, "tenant")
+			GinkgoT().Setenv(azureFederatedTokenFileEnv, tokenFile)
+			GinkgoT().Setenv(azureAuthorityHostEnv, server.URL)
+
+			helper := &azureHelper{httpClient: server.Client(), registryScheme: "http"}
+			creds, err := helper.Credentials(ctx, strings.TrimPrefix(server.URL, "http://"))
+			Expect(err).ToNot(HaveOccurred())
+			Expect(creds.Username).To(Equal(azureRegistryUsername))
+			Expect(creds.Password).To(Equal("acr-token"))
+			Expect(creds.ExpiresAt).To(BeTemporally(">", time.Now()))
+		})
+	})
+})
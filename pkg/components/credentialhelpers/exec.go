@@ -0,0 +1,52 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package credentialhelpers
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"os/exec"
+	"strings"
+
+	"github.com/gardener/landscaper/apis/config"
+)
+
+// execHelper executes a binary that implements the docker credential helper protocol.
+// See https://github.com/docker/docker-credential-helpers for the protocol.
+type execHelper struct {
+	command string
+}
+
+func newExecHelper(cfg config.OCICredentialHelper) (Helper, error) {
+	if len(cfg.Command) == 0 {
+		return nil, fmt.Errorf("no command defined for credential helper of host %q", cfg.Host)
+	}
+	return &execHelper{command: cfg.Command}, nil
+}
+
+func (h *execHelper) Credentials(ctx context.Context, host string) (*Credentials, error) {
+	var stdout, stderr bytes.Buffer
+	cmd := exec.CommandContext(ctx, h.command, "get")
+	cmd.Stdin = strings.NewReader(host)
+	cmd.Stdout = &stdout
+	cmd.Stderr = &stderr
+	if err := cmd.Run(); err != nil {
+		return nil, fmt.Errorf("credential helper %q failed: %w: %s", h.command, err, strings.TrimSpace(stderr.String()))
+	}
+
+	out := struct {
+		Username string `json:"Username"`
+		Secret   string `json:"Secret"`
+	}{}
+	if err := json.Unmarshal(stdout.Bytes(), &out); err != nil {
+		return nil, fmt.Errorf("unable to decode the output of credential helper %q: %w", h.command, err)
+	}
+	return &Credentials{
+		Username: out.Username,
+		Password: out.Secret,
+	}, nil
+}
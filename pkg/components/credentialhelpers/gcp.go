@@ -0,0 +1,45 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package credentialhelpers
+
+import (
+	"context"
+	"fmt"
+
+	"golang.org/x/oauth2/google"
+
+	"github.com/gardener/landscaper/apis/config"
+)
+
+const (
+	// gcpRegistryUsername is the username that google registries expect for oauth2 access tokens.
+	gcpRegistryUsername = "oauth2accesstoken"
+
+	gcpCloudPlatformScope = "https://www.googleapis.com/auth/cloud-platform"
+)
+
+// gcpHelper obtains an access token of the google artifact or container registry
+// from the application default credentials, e.g. the workload identity of a GKE pod.
+type gcpHelper struct{}
+
+func newGCPHelper(_ config.OCICredentialHelper) (Helper, error) {
+	return &gcpHelper{}, nil
+}
+
+func (h *gcpHelper) Credentials(ctx context.Context, _ string) (*Credentials, error) {
+	tokenSource, err := google.DefaultTokenSource(ctx, gcpCloudPlatformScope)
+	if err != nil {
+		return nil, fmt.Errorf("unable to find gcp default credentials: %w", err)
+	}
+	token, err := tokenSource.Token()
+	if err != nil {
+		return nil, fmt.Errorf("unable to get gcp access token: %w", err)
+	}
+	return &Credentials{
+		Username:  gcpRegistryUsername,
+		Password:  token.AccessToken,
+		ExpiresAt: token.Expiry,
+	}, nil
+}
@@ -7,6 +7,8 @@ package model
 import (
 	"context"
 
+	cdv2 "github.com/gardener/component-spec/bindings-go/apis/v2"
+
 	lsv1alpha1 "github.com/gardener/landscaper/apis/core/v1alpha1"
 	"github.com/gardener/landscaper/pkg/components/model/componentoverwrites"
 )
@@ -16,6 +18,9 @@ type RegistryAccess interface {
 
 	//VerifySignature calls the ocm lib to verify the named signature in the component version with the public key or ca cert data.
 	VerifySignature(componentVersion ComponentVersion, name string, pkeyData []byte, caCertData []byte) error
+
+	// ListComponentVersions returns all versions of the named component that are available in the given repository.
+	ListComponentVersions(ctx context.Context, repositoryContext *cdv2.UnstructuredTypedObject, componentName string) ([]string, error)
 }
 
 // GetComponentVersionWithOverwriter is like registryAccess.GetComponentVersion, but applies the given overwrites first.
@@ -41,6 +41,9 @@ type TypedResourceProvider interface {
 type TypedResourceContent struct {
 	Type     string
 	Resource interface{}
+	// Digest is the digest of the raw content of the resource in the format "sha256:<hex>".
+	// It is only set for resource types that require it, e.g. helm charts.
+	Digest string
 }
 
 type GlobalResourceIdentity struct {
@@ -0,0 +1,65 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package model
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/Masterminds/semver/v3"
+	cdv2 "github.com/gardener/component-spec/bindings-go/apis/v2"
+)
+
+// ResolveComponentVersion returns the latest version of the named component in the given repository
+// that matches the semver constraint.
+func ResolveComponentVersion(ctx context.Context,
+	registryAccess RegistryAccess,
+	repositoryContext *cdv2.UnstructuredTypedObject,
+	componentName string,
+	constraint string) (string, error) {
+
+	versions, err := registryAccess.ListComponentVersions(ctx, repositoryContext, componentName)
+	if err != nil {
+		return "", fmt.Errorf("unable to list versions of component %q: %w", componentName, err)
+	}
+
+	version, err := LatestMatchingVersion(versions, constraint)
+	if err != nil {
+		return "", fmt.Errorf("unable to resolve version of component %q: %w", componentName, err)
+	}
+	return version, nil
+}
+
+// LatestMatchingVersion returns the highest of the given versions that matches the semver constraint.
+// Versions that are no valid semantic versions are ignored.
+func LatestMatchingVersion(versions []string, constraint string) (string, error) {
+	c, err := semver.NewConstraint(constraint)
+	if err != nil {
+		return "", fmt.Errorf("invalid version constraint %q: %w", constraint, err)
+	}
+
+	var (
+		latest        *semver.Version
+		latestVersion string
+	)
+	for _, v := range versions {
+		sv, err := semver.NewVersion(v)
+		if err != nil {
+			continue
+		}
+		if !c.Check(sv) {
+			continue
+		}
+		if latest == nil || sv.GreaterThan(latest) {
+			latest = sv
+			latestVersion = v
+		}
+	}
+
+	if latest == nil {
+		return "", fmt.Errorf("no version matches the constraint %q", constraint)
+	}
+	return latestVersion, nil
+}
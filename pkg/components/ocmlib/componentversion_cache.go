@@ -0,0 +1,289 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package ocmlib
+
+import (
+	"container/list"
+	"crypto/sha256"
+	"encoding/hex"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/open-component-model/ocm/pkg/contexts/ocm"
+	"github.com/prometheus/client_golang/prometheus"
+	corev1 "k8s.io/api/core/v1"
+
+	"github.com/gardener/landscaper/apis/config"
+	lsv1alpha1 "github.com/gardener/landscaper/apis/core/v1alpha1"
+	"github.com/gardener/landscaper/pkg/components/model/types"
+)
+
+const (
+	componentVersionCacheSubsystemName = "componentVersionCache"
+
+	// DefaultComponentVersionCacheTTL is the default time a resolved component version is kept in the cache.
+	DefaultComponentVersionCacheTTL = 10 * time.Minute
+)
+
+var (
+	// ComponentVersionCacheHits discloses the number of component versions that were taken from the cache.
+	ComponentVersionCacheHits = prometheus.NewCounter(
+		prometheus.CounterOpts{
+			Namespace: lsv1alpha1.LandscaperMetricsNamespaceName,
+			Subsystem: componentVersionCacheSubsystemName,
+			Name:      "hits_total",
+			Help:      "Total number of component versions that were taken from the component version cache.",
+		},
+	)
+
+	// ComponentVersionCacheMisses discloses the number of component versions that had to be resolved from a repository.
+	ComponentVersionCacheMisses = prometheus.NewCounter(
+		prometheus.CounterOpts{
+			Namespace: lsv1alpha1.LandscaperMetricsNamespaceName,
+			Subsystem: componentVersionCacheSubsystemName,
+			Name:      "misses_total",
+			Help:      "Total number of component versions that were not found in the component version cache.",
+		},
+	)
+
+	// ComponentVersionCacheItems discloses the number of component versions currently stored in the cache.
+	ComponentVersionCacheItems = prometheus.NewGauge(
+		prometheus.GaugeOpts{
+			Namespace: lsv1alpha1.LandscaperMetricsNamespaceName,
+			Subsystem: componentVersionCacheSubsystemName,
+			Name:      "items_total",
+			Help:      "Total number of component versions currently stored in the component version cache.",
+		},
+	)
+)
+
+// RegisterComponentVersionCacheMetrics allows to register the component version cache metrics with a given prometheus registerer
+func RegisterComponentVersionCacheMetrics(reg prometheus.Registerer) {
+	reg.MustRegister(ComponentVersionCacheHits)
+	reg.MustRegister(ComponentVersionCacheMisses)
+	reg.MustRegister(ComponentVersionCacheItems)
+	reg.MustRegister(ComponentVersionDeduplicatedLookups)
+}
+
+var (
+	componentVersionCache     *ComponentVersionCache
+	componentVersionCacheOnce sync.Once
+)
+
+// SetComponentVersionCache configures the process-wide component version cache that is shared by all registry accesses.
+// It can only be set once as it is determined by the landscaper configuration.
+// Component versions are not cached if no cache configuration is given.
+func SetComponentVersionCache(cacheConfig *config.ComponentVersionCacheConfiguration) {
+	componentVersionCacheOnce.Do(func() {
+		if cacheConfig == nil || cacheConfig.Size <= 0 {
+			return
+		}
+		ttl := DefaultComponentVersionCacheTTL
+		if cacheConfig.TTL != nil {
+			ttl = cacheConfig.TTL.Duration
+		}
+		componentVersionCache = NewComponentVersionCache(cacheConfig.Size, ttl)
+	})
+}
+
+// InvalidateComponentVersionCache removes all entries from the process-wide component version cache.
+func InvalidateComponentVersionCache() {
+	if cvCache := getComponentVersionCache(); cvCache != nil {
+		cvCache.Clear()
+	}
+}
+
+// getComponentVersionCache returns the process-wide component version cache or nil if caching is disabled.
+func getComponentVersionCache() *ComponentVersionCache {
+	return componentVersionCache
+}
+
+// ComponentVersionCache is a size limited cache of resolved component versions whose entries expire after a ttl.
+// If the cache is full, the least recently used entry is evicted.
+type ComponentVersionCache struct {
+	mux     sync.Mutex
+	size    int
+	ttl     time.Duration
+	entries map[string]*list.Element
+	lru     *list.List
+	now     func() time.Time
+}
+
+type componentVersionCacheEntry struct {
+	key        string
+	expiration time.Time
+	// componentVersionAccess is an own view of the component version, which is kept open until the entry is removed.
+	componentVersionAccess ocm.ComponentVersionAccess
+	componentDescriptor    types.ComponentDescriptor
+}
+
+// NewComponentVersionCache creates a new component version cache with the given maximal number of entries and ttl.
+func NewComponentVersionCache(size int, ttl time.Duration) *ComponentVersionCache {
+	return &ComponentVersionCache{
+		size:    size,
+		ttl:     ttl,
+		entries: map[string]*list.Element{},
+		lru:     list.New(),
+		now:     time.Now,
+	}
+}
+
+// Get returns a new view of the cached component version access and the cached component descriptor.
+// The caller is responsible to close the returned view.
+func (c *ComponentVersionCache) Get(key string) (ocm.ComponentVersionAccess, *types.ComponentDescriptor, bool) {
+	c.mux.Lock()
+	defer c.mux.Unlock()
+
+	elem, ok := c.entries[key]
+	if !ok {
+		ComponentVersionCacheMisses.Inc()
+		return nil, nil, false
+	}
+
+	entry := elem.Value.(*componentVersionCacheEntry)
+	if c.now().After(entry.expiration) {
+		c.removeElement(elem)
+		ComponentVersionCacheMisses.Inc()
+		return nil, nil, false
+	}
+
+	view, err := entry.componentVersionAccess.Dup()
+	if err != nil {
+		c.removeElement(elem)
+		ComponentVersionCacheMisses.Inc()
+		return nil, nil, false
+	}
+
+	c.lru.MoveToFront(elem)
+	ComponentVersionCacheHits.Inc()
+	cd := entry.componentDescriptor.DeepCopy()
+	return view, cd, true
+}
+
+// Add stores a new view of the given component version access in the cache.
+func (c *ComponentVersionCache) Add(key string, cv ocm.ComponentVersionAccess, cd *types.ComponentDescriptor) {
+	view, err := cv.Dup()
+	if err != nil {
+		return
+	}
+
+	c.mux.Lock()
+	defer c.mux.Unlock()
+
+	if elem, ok := c.entries[key]; ok {
+		c.removeElement(elem)
+	}
+
+	c.entries[key] = c.lru.PushFront(&componentVersionCacheEntry{
+		key:                    key,
+		expiration:             c.now().Add(c.ttl),
+		componentVersionAccess: view,
+		componentDescriptor:    *cd.DeepCopy(),
+	})
+
+	for c.lru.Len() > c.size {
+		c.removeElement(c.lru.Back())
+	}
+	ComponentVersionCacheItems.Set(float64(c.lru.Len()))
+}
+
+// Len returns the number of cached component versions.
+func (c *ComponentVersionCache) Len() int {
+	c.mux.Lock()
+	defer c.mux.Unlock()
+	return c.lru.Len()
+}
+
+// Clear removes all cached component versions.
+func (c *ComponentVersionCache) Clear() {
+	c.mux.Lock()
+	defer c.mux.Unlock()
+	for c.lru.Len() > 0 {
+		c.removeElement(c.lru.Back())
+	}
+}
+
+func (c *ComponentVersionCache) removeElement(elem *list.Element) {
+	entry := c.lru.Remove(elem).(*componentVersionCacheEntry)
+	delete(c.entries, entry.key)
+	_ = entry.componentVersionAccess.Close()
+	ComponentVersionCacheItems.Set(float64(c.lru.Len()))
+}
+
+// componentVersionCacheKey returns the key of a component version in the cache.
+// The key contains the fingerprint of the credentials of the registry access,
+// so that a component version is only shared between registry accesses with the same credentials.
+func componentVersionCacheKey(credentialsFingerprint string, cdRef *lsv1alpha1.ComponentDescriptorReference) string {
+	repoCtx := ""
+	if cdRef.RepositoryContext != nil {
+		repoCtx = string(cdRef.RepositoryContext.Raw)
+	}
+	h := sha256.New()
+	for _, s := range []string{credentialsFingerprint, repoCtx, cdRef.ComponentName, cdRef.Version} {
+		h.Write([]byte(s))
+		h.Write([]byte{0})
+	}
+	return hex.EncodeToString(h.Sum(nil))
+}
+
+// credentialsFingerprint computes a hash of all configuration of a registry access that influences
+// which component versions can be accessed.
+func credentialsFingerprint(ocmconfig *corev1.ConfigMap, secrets []corev1.Secret,
+	localRegistryConfig *config.LocalRegistryConfiguration, ociRegistryConfig *config.OCIConfiguration) string {
+
+	h := sha256.New()
+	write := func(s string) {
+		h.Write([]byte(s))
+		h.Write([]byte{0})
+	}
+
+	if ocmconfig != nil {
+		write(ocmconfig.Namespace + "/" + ocmconfig.Name)
+		writeSortedMap(write, ocmconfig.Data)
+	}
+	for _, secret := range secrets {
+		write(secret.Namespace + "/" + secret.Name)
+		for _, k := range sortedKeys(secret.Data) {
+			write(k)
+			write(string(secret.Data[k]))
+		}
+	}
+	if localRegistryConfig != nil {
+		write(localRegistryConfig.RootPath)
+	}
+	if ociRegistryConfig != nil {
+		for _, f := range ociRegistryConfig.ConfigFiles {
+			write(f)
+		}
+		for _, helper := range ociRegistryConfig.CredentialHelpers {
+			write(helper.Host)
+			write(string(helper.Type))
+			write(helper.Command)
+		}
+	}
+	return hex.EncodeToString(h.Sum(nil))
+}
+
+func writeSortedMap(write func(string), m map[string]string) {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	for _, k := range keys {
+		write(k)
+		write(m[k])
+	}
+}
+
+func sortedKeys(m map[string][]byte) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
@@ -0,0 +1,91 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package ocmlib
+
+import (
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/open-component-model/ocm/pkg/contexts/ocm/cpi"
+	corev1 "k8s.io/api/core/v1"
+
+	lsv1alpha1 "github.com/gardener/landscaper/apis/core/v1alpha1"
+	"github.com/gardener/landscaper/pkg/components/model/types"
+)
+
+var _ = Describe("ComponentVersionCache", func() {
+
+	var (
+		now     time.Time
+		cvCache *ComponentVersionCache
+		cv      *cpi.DummyComponentVersionAccess
+	)
+
+	buildDescriptor := func(name string) *types.ComponentDescriptor {
+		cd := &types.ComponentDescriptor{}
+		cd.Name = name
+		cd.Version = "v1.0.0"
+		return cd
+	}
+
+	BeforeEach(func() {
+		now = time.Now()
+		cvCache = NewComponentVersionCache(2, time.Minute)
+		cvCache.now = func() time.Time { return now }
+		cv = &cpi.DummyComponentVersionAccess{}
+	})
+
+	It("should return a cached component version", func() {
+		cvCache.Add("a", cv, buildDescriptor("example.com/a"))
+
+		cached, cd, ok := cvCache.Get("a")
+		Expect(ok).To(BeTrue())
+		Expect(cached).ToNot(BeNil())
+		Expect(cd.Name).To(Equal("example.com/a"))
+
+		_, _, ok = cvCache.Get("b")
+		Expect(ok).To(BeFalse())
+	})
+
+	It("should not return expired component versions", func() {
+		cvCache.Add("a", cv, buildDescriptor("example.com/a"))
+
+		now = now.Add(2 * time.Minute)
+		_, _, ok := cvCache.Get("a")
+		Expect(ok).To(BeFalse())
+		Expect(cvCache.Len()).To(Equal(0))
+	})
+
+	It("should evict the least recently used component version if the cache is full", func() {
+		cvCache.Add("a", cv, buildDescriptor("example.com/a"))
+		cvCache.Add("b", cv, buildDescriptor("example.com/b"))
+		_, _, ok := cvCache.Get("a")
+		Expect(ok).To(BeTrue())
+
+		cvCache.Add("c", cv, buildDescriptor("example.com/c"))
+		Expect(cvCache.Len()).To(Equal(2))
+
+		_, _, ok = cvCache.Get("a")
+		Expect(ok).To(BeTrue())
+		_, _, ok = cvCache.Get("b")
+		Expect(ok).To(BeFalse())
+		_, _, ok = cvCache.Get("c")
+		Expect(ok).To(BeTrue())
+	})
+
+	It("should not share component versions between registry accesses with different credentials", func() {
+		cdRef := &lsv1alpha1.ComponentDescriptorReference{
+			ComponentName: "example.com/a",
+			Version:       "v1.0.0",
+		}
+		secrets := []corev1.Secret{{Data: map[string][]byte{"config.json": []byte("abc")}}}
+		otherSecrets := []corev1.Secret{{Data: map[string][]byte{"config.json": []byte("xyz")}}}
+
+		key := componentVersionCacheKey(credentialsFingerprint(nil, secrets, nil, nil), cdRef)
+		Expect(componentVersionCacheKey(credentialsFingerprint(nil, secrets, nil, nil), cdRef)).To(Equal(key))
+		Expect(componentVersionCacheKey(credentialsFingerprint(nil, otherSecrets, nil, nil), cdRef)).ToNot(Equal(key))
+	})
+})
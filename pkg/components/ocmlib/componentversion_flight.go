@@ -0,0 +1,128 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package ocmlib
+
+import (
+	"fmt"
+	"sync"
+
+	"github.com/open-component-model/ocm/pkg/contexts/ocm"
+	"github.com/prometheus/client_golang/prometheus"
+
+	lsv1alpha1 "github.com/gardener/landscaper/apis/core/v1alpha1"
+)
+
+var (
+	// ComponentVersionDeduplicatedLookups discloses the number of component version lookups that have been deduplicated,
+	// because the same component version was looked up concurrently.
+	ComponentVersionDeduplicatedLookups = prometheus.NewCounter(
+		prometheus.CounterOpts{
+			Namespace: lsv1alpha1.LandscaperMetricsNamespaceName,
+			Subsystem: componentVersionCacheSubsystemName,
+			Name:      "deduplicated_lookups_total",
+			Help:      "Total number of component version lookups that have been deduplicated because the same component version was looked up concurrently.",
+		},
+	)
+)
+
+// componentVersionLookups deduplicates the concurrent lookups of component versions of all registry accesses.
+var componentVersionLookups = newComponentVersionFlightGroup()
+
+// componentVersionFlightGroup deduplicates concurrent lookups of the same component version,
+// so that a component version which is required by several installations at the same time,
+// e.g. after a restart of the landscaper, is only fetched once from its repository.
+//
+// In contrast to a plain singleflight group, every caller gets its own view of the looked up component version,
+// as a component version access is bound to the session of the registry access that uses it.
+type componentVersionFlightGroup struct {
+	mux     sync.Mutex
+	flights map[string]*componentVersionFlight
+}
+
+type componentVersionFlight struct {
+	wg sync.WaitGroup
+	// waiters is the number of callers that wait for the result of the lookup and still need the shared view.
+	waiters int
+	// componentVersionAccess is a view of the looked up component version, which is shared by all waiting callers.
+	// It is closed as soon as all waiting callers have taken their own view.
+	componentVersionAccess ocm.ComponentVersionAccess
+	err                    error
+}
+
+func newComponentVersionFlightGroup() *componentVersionFlightGroup {
+	return &componentVersionFlightGroup{
+		flights: map[string]*componentVersionFlight{},
+	}
+}
+
+// Do executes the given lookup for the given key, unless a lookup for the same key is already in progress.
+// In this case, the caller waits for the lookup in progress and gets a new view of its result.
+// The returned bool is true if the result has been shared by the lookup of another caller.
+// A shared view is owned by the caller, which is responsible to close it.
+func (g *componentVersionFlightGroup) Do(key string, lookup func() (ocm.ComponentVersionAccess, error)) (ocm.ComponentVersionAccess, bool, error) {
+	g.mux.Lock()
+	if flight, ok := g.flights[key]; ok {
+		flight.waiters++
+		g.mux.Unlock()
+		ComponentVersionDeduplicatedLookups.Inc()
+		return g.wait(flight)
+	}
+	flight := &componentVersionFlight{}
+	flight.wg.Add(1)
+	g.flights[key] = flight
+	g.mux.Unlock()
+
+	cv, err := g.execute(lookup)
+
+	g.mux.Lock()
+	delete(g.flights, key)
+	// no further callers can join the flight, as it has been removed from the group
+	if flight.waiters > 0 {
+		if err != nil {
+			flight.err = err
+		} else if flight.componentVersionAccess, flight.err = cv.Dup(); flight.err != nil {
+			flight.err = fmt.Errorf("unable to share component version: %w", flight.err)
+		}
+	}
+	g.mux.Unlock()
+	flight.wg.Done()
+
+	return cv, false, err
+}
+
+// execute runs the lookup and converts a panic into an error, so that waiting callers are always released.
+func (g *componentVersionFlightGroup) execute(lookup func() (ocm.ComponentVersionAccess, error)) (cv ocm.ComponentVersionAccess, err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			err = fmt.Errorf("component version lookup panicked: %v", r)
+		}
+	}()
+	return lookup()
+}
+
+func (g *componentVersionFlightGroup) wait(flight *componentVersionFlight) (ocm.ComponentVersionAccess, bool, error) {
+	flight.wg.Wait()
+	defer g.release(flight)
+
+	if flight.err != nil {
+		return nil, true, flight.err
+	}
+	view, err := flight.componentVersionAccess.Dup()
+	if err != nil {
+		return nil, true, fmt.Errorf("unable to share component version: %w", err)
+	}
+	return view, true, nil
+}
+
+// release removes a waiting caller from the flight and closes the shared view after the last caller.
+func (g *componentVersionFlightGroup) release(flight *componentVersionFlight) {
+	g.mux.Lock()
+	defer g.mux.Unlock()
+	flight.waiters--
+	if flight.waiters == 0 && flight.componentVersionAccess != nil {
+		_ = flight.componentVersionAccess.Close()
+		flight.componentVersionAccess = nil
+	}
+}
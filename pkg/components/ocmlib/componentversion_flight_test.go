@@ -0,0 +1,153 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package ocmlib
+
+import (
+	"errors"
+	"sync"
+	"sync/atomic"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/open-component-model/ocm/pkg/contexts/ocm"
+	"github.com/open-component-model/ocm/pkg/contexts/ocm/cpi"
+)
+
+// countingComponentVersionAccess counts the views that are opened and closed.
+type countingComponentVersionAccess struct {
+	cpi.DummyComponentVersionAccess
+	views atomic.Int32
+}
+
+func (c *countingComponentVersionAccess) Dup() (ocm.ComponentVersionAccess, error) {
+	c.views.Add(1)
+	return c, nil
+}
+
+func (c *countingComponentVersionAccess) Close() error {
+	c.views.Add(-1)
+	return nil
+}
+
+var _ = Describe("ComponentVersionFlightGroup", func() {
+
+	var (
+		group   *componentVersionFlightGroup
+		waiters = func(key string) int {
+			group.mux.Lock()
+			defer group.mux.Unlock()
+			if flight, ok := group.flights[key]; ok {
+				return flight.waiters
+			}
+			return -1
+		}
+	)
+
+	BeforeEach(func() {
+		group = newComponentVersionFlightGroup()
+	})
+
+	It("should look up a component version only once for concurrent callers", func() {
+		cv := &countingComponentVersionAccess{}
+		lookups := atomic.Int32{}
+		release := make(chan struct{})
+
+		var leaderShared bool
+		var leaderErr error
+		leaderDone := make(chan struct{})
+		go func() {
+			defer GinkgoRecover()
+			defer close(leaderDone)
+			_, leaderShared, leaderErr = group.Do("a", func() (ocm.ComponentVersionAccess, error) {
+				lookups.Add(1)
+				<-release
+				return cv, nil
+			})
+		}()
+		Eventually(func() int { return waiters("a") }).Should(Equal(0))
+
+		const followers = 5
+		wg := sync.WaitGroup{}
+		views := make([]ocm.ComponentVersionAccess, followers)
+		shared := make([]bool, followers)
+		for i := 0; i < followers; i++ {
+			wg.Add(1)
+			go func(i int) {
+				defer GinkgoRecover()
+				defer wg.Done()
+				var err error
+				views[i], shared[i], err = group.Do("a", func() (ocm.ComponentVersionAccess, error) {
+					lookups.Add(1)
+					return cv, nil
+				})
+				Expect(err).ToNot(HaveOccurred())
+			}(i)
+		}
+		Eventually(func() int { return waiters("a") }).Should(Equal(followers))
+
+		close(release)
+		wg.Wait()
+		Eventually(leaderDone).Should(BeClosed())
+
+		Expect(lookups.Load()).To(Equal(int32(1)))
+		Expect(leaderErr).ToNot(HaveOccurred())
+		Expect(leaderShared).To(BeFalse())
+		for i := 0; i < followers; i++ {
+			Expect(shared[i]).To(BeTrue())
+			Expect(views[i]).To(BeIdenticalTo(cv))
+		}
+		Expect(waiters("a")).To(Equal(-1))
+
+		// the shared view has been closed, only the views of the followers are still open
+		Expect(cv.views.Load()).To(Equal(int32(followers)))
+	})
+
+	It("should share the error of a failed lookup with concurrent callers", func() {
+		release := make(chan struct{})
+		leaderDone := make(chan struct{})
+		go func() {
+			defer GinkgoRecover()
+			defer close(leaderDone)
+			_, _, err := group.Do("a", func() (ocm.ComponentVersionAccess, error) {
+				<-release
+				return nil, errors.New("not found")
+			})
+			Expect(err).To(MatchError("not found"))
+		}()
+		Eventually(func() int { return waiters("a") }).Should(Equal(0))
+
+		followerDone := make(chan struct{})
+		go func() {
+			defer GinkgoRecover()
+			defer close(followerDone)
+			_, shared, err := group.Do("a", func() (ocm.ComponentVersionAccess, error) {
+				return &countingComponentVersionAccess{}, nil
+			})
+			Expect(shared).To(BeTrue())
+			Expect(err).To(MatchError("not found"))
+		}()
+		Eventually(func() int { return waiters("a") }).Should(Equal(1))
+
+		close(release)
+		Eventually(leaderDone).Should(BeClosed())
+		Eventually(followerDone).Should(BeClosed())
+	})
+
+	It("should look up a component version again after the previous lookup has finished", func() {
+		lookups := 0
+		lookup := func() (ocm.ComponentVersionAccess, error) {
+			lookups++
+			return &countingComponentVersionAccess{}, nil
+		}
+
+		_, shared, err := group.Do("a", lookup)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(shared).To(BeFalse())
+		_, shared, err = group.Do("a", lookup)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(shared).To(BeFalse())
+		Expect(lookups).To(Equal(2))
+	})
+})
@@ -25,8 +25,10 @@ import (
 	ocmcommon "github.com/open-component-model/ocm/pkg/common"
 	"github.com/open-component-model/ocm/pkg/contexts/credentials"
 	helmid "github.com/open-component-model/ocm/pkg/contexts/credentials/builtin/helm/identity"
+	ociid "github.com/open-component-model/ocm/pkg/contexts/credentials/builtin/oci/identity"
 	"github.com/open-component-model/ocm/pkg/contexts/credentials/repositories/dockerconfig"
 	"github.com/open-component-model/ocm/pkg/contexts/datacontext"
+	"github.com/open-component-model/ocm/pkg/contexts/datacontext/attrs/rootcertsattr"
 	"github.com/open-component-model/ocm/pkg/contexts/datacontext/attrs/vfsattr"
 	"github.com/open-component-model/ocm/pkg/contexts/oci"
 	"github.com/open-component-model/ocm/pkg/contexts/ocm"
@@ -41,10 +43,12 @@ import (
 	helmv1alpha1 "github.com/gardener/landscaper/apis/deployer/helm/v1alpha1"
 	lserrors "github.com/gardener/landscaper/apis/errors"
 	"github.com/gardener/landscaper/pkg/components/common"
+	"github.com/gardener/landscaper/pkg/components/credentialhelpers"
 	"github.com/gardener/landscaper/pkg/components/model"
 	"github.com/gardener/landscaper/pkg/components/model/types"
 	"github.com/gardener/landscaper/pkg/components/ocmlib/inlinecompdesc"
 	"github.com/gardener/landscaper/pkg/components/ocmlib/repository"
+	"github.com/gardener/landscaper/pkg/components/registryconnections"
 )
 
 type Factory struct{}
@@ -69,7 +73,9 @@ func (*Factory) NewRegistryAccess(ctx context.Context,
 		fs = osfs.New()
 	}
 
-	registryAccess := &RegistryAccess{}
+	registryAccess := &RegistryAccess{
+		credentialsFingerprint: credentialsFingerprint(ocmconfig, secrets, localRegistryConfig, ociRegistryConfig),
+	}
 	registryAccess.octx = ocm.FromContext(ctx)
 	registryAccess.octx.Finalizer().Close(registryAccess)
 	registryAccess.session = ocm.NewSession(datacontext.NewSession())
@@ -150,6 +156,15 @@ func (*Factory) NewRegistryAccess(ctx context.Context,
 		if err := addConfigFileCredsToCredContext(fs, ociRegistryConfig.ConfigFiles, registryAccess.octx); err != nil {
 			return nil, err
 		}
+		// set credentials from credential helpers
+		if err := addCredentialHelpersToCredContext(ociRegistryConfig.CredentialHelpers, registryAccess.octx); err != nil {
+			return nil, err
+		}
+	}
+
+	// trust the certificate authorities of the registry hosts
+	if err := addRegistryCABundlesToContext(registryAccess.octx); err != nil {
+		return nil, err
 	}
 
 	// set credentials from pull secrets
@@ -262,6 +277,15 @@ func (f *Factory) NewHelmOCIResource(ctx context.Context,
 		if err = addConfigFileCredsToCredContext(fs, ociConfig.ConfigFiles, provider.ocictx); err != nil {
 			return nil, err
 		}
+		// set credentials from credential helpers
+		if err = addCredentialHelpersToCredContext(ociConfig.CredentialHelpers, provider.ocictx); err != nil {
+			return nil, err
+		}
+	}
+
+	// trust the certificate authorities of the registry hosts
+	if err = addRegistryCABundlesToContext(provider.ocictx); err != nil {
+		return nil, err
 	}
 
 	// set credentials from pull secrets
@@ -290,6 +314,56 @@ func addConfigFileCredsToCredContext(fs vfs.FileSystem, filePaths []string, prov
 	return nil
 }
 
+// addCredentialHelpersToCredContext sets the configured credential helpers as credential sources of their registry hosts.
+func addCredentialHelpersToCredContext(helpers []config.OCICredentialHelper, provider credentials.ContextProvider) error {
+	if err := credentialhelpers.Validate(helpers); err != nil {
+		return err
+	}
+	credctx := provider.CredentialsContext()
+	for _, helper := range helpers {
+		credctx.SetCredentialsForConsumer(ociid.GetConsumerId(helper.Host, ""), &CredentialHelperSource{config: helper})
+	}
+	return nil
+}
+
+// registryCABundlesAttrKey marks contexts to which the certificate authorities of the registry hosts have been added.
+const registryCABundlesAttrKey = "landscaper.gardener.cloud/registry-ca-bundles"
+
+// addRegistryCABundlesToContext adds the certificate authorities of the loaded registry connections to the root
+// certificates of the context. The certificates are added only once per context, as contexts are reused.
+// The ocm library connects to registries via the proxy of the environment (HTTPS_PROXY, NO_PROXY),
+// a proxy per registry host is only supported for component descriptors of the legacy cnudie library.
+func addRegistryCABundlesToContext(ctx rootcertsattr.ContextProvider) error {
+	var err error
+	attr := rootcertsattr.Get(ctx)
+	ctx.AttributesContext().GetAttributes().GetOrCreateAttribute(registryCABundlesAttrKey, func(_ datacontext.Context) interface{} {
+		for _, conn := range registryconnections.List() {
+			if len(conn.CABundle) == 0 {
+				continue
+			}
+			if err = attr.RegisterRootCertificates(conn.CABundle); err != nil {
+				err = fmt.Errorf("unable to register ca bundle of host %q: %w", conn.Host, err)
+				break
+			}
+		}
+		return true
+	})
+	return err
+}
+
+// CredentialHelperSource is a credential source that obtains the credentials of a registry host from a credential helper.
+type CredentialHelperSource struct {
+	config config.OCICredentialHelper
+}
+
+func (c *CredentialHelperSource) Credentials(_ credentials.Context, _ ...credentials.CredentialsSource) (credentials.Credentials, error) {
+	creds, err := credentialhelpers.GetCredentials(context.Background(), c.config)
+	if err != nil {
+		return nil, err
+	}
+	return ociid.SimpleCredentials(creds.Username, creds.Password), nil
+}
+
 func AddSecretCredsToCredContext(secrets []corev1.Secret, provider credentials.ContextProvider) error {
 	credctx := provider.CredentialsContext()
 	cfgctx := credctx.ConfigContext()
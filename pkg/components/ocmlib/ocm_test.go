@@ -29,6 +29,7 @@ import (
 	corev1 "k8s.io/api/core/v1"
 
 	"github.com/gardener/landscaper/pkg/components/model/types"
+	"github.com/gardener/landscaper/pkg/components/registries/archive"
 	"github.com/gardener/landscaper/pkg/landscaper/blueprints"
 
 	. "github.com/onsi/ginkgo/v2"
@@ -604,6 +605,33 @@ configurations:
 		var notfounderr *errors.NotFoundError
 		Expect(errors.As(err, &notfounderr)).To(BeTrue())
 	})
+	It("get component version from the archive registry before the referenced repository", func() {
+		fs := memoryfs.New()
+		MustBeSuccessful(fs.MkdirAll("/archives/ca", os.ModePerm))
+		cd := Must(os.ReadFile(filepath.Join(LOCALCNUDIEREPOPATH, COMPDESC_V2_FILENAME)))
+		MustBeSuccessful(vfs.WriteFile(fs, "/archives/ca/component-descriptor.yaml", cd, os.ModePerm))
+
+		registry := Must(archive.NewRegistry(logging.Discard(), fs, &config.ArchiveRegistryConfiguration{
+			Archives: []config.ArchiveConfiguration{{Name: "ca", Path: "/archives/ca"}},
+		}))
+		MustBeSuccessful(registry.Load(ctx, nil))
+		archive.SetRegistry(registry)
+		defer archive.SetRegistry(nil)
+
+		// the referenced repository is not accessible
+		cdref := &v1alpha1.ComponentDescriptorReference{}
+		MustBeSuccessful(runtime.DefaultYAMLEncoding.Unmarshal([]byte(`
+repositoryContext:
+  type: OCIRegistry
+  baseUrl: registry.example.invalid
+componentName: example.com/landscaper-component
+version: 1.0.0
+`), &cdref))
+		r := Must(factory.NewRegistryAccess(ctx, nil, nil, nil, nil, nil, nil, nil))
+		cv := Must(r.GetComponentVersion(ctx, cdref))
+		Expect(cv.GetName()).To(Equal("example.com/landscaper-component"))
+	})
+
 	It("repository context is not set and ocm config does not set resolvers", func() {
 		cdref := &v1alpha1.ComponentDescriptorReference{}
 		MustBeSuccessful(runtime.DefaultYAMLEncoding.Unmarshal([]byte(componentReferenceWithoutContext), &cdref))
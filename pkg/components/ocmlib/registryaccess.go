@@ -28,6 +28,7 @@ import (
 	"github.com/gardener/landscaper/pkg/components/model"
 	_ "github.com/gardener/landscaper/pkg/components/ocmlib/repository/inline"
 	_ "github.com/gardener/landscaper/pkg/components/ocmlib/repository/local"
+	"github.com/gardener/landscaper/pkg/components/registries/archive"
 )
 
 type RegistryAccess struct {
@@ -36,6 +37,8 @@ type RegistryAccess struct {
 	inlineSpec       ocm.RepositorySpec
 	inlineRepository ocm.Repository
 	resolver         ocm.ComponentVersionResolver
+	// credentialsFingerprint identifies the credentials of the registry access in the component version cache.
+	credentialsFingerprint string
 }
 
 var _ model.RegistryAccess = (*RegistryAccess)(nil)
@@ -120,6 +123,56 @@ func (r *RegistryAccess) GetComponentVersion(ctx context.Context, cdRef *lsv1alp
 		return nil, errors.New("component descriptor reference cannot be nil")
 	}
 
+	// component versions of inline component descriptors are neither cached nor shared, as they are specific to an installation
+	if r.inlineRepository != nil {
+		cv, err := r.lookupComponentVersion(logger, cdRef)
+		if err != nil {
+			return nil, err
+		}
+		return r.NewComponentVersion(cv)
+	}
+
+	cvCache := getComponentVersionCache()
+	cacheKey := componentVersionCacheKey(r.credentialsFingerprint, cdRef)
+	if cvCache != nil {
+		if cv, cd, ok := cvCache.Get(cacheKey); ok {
+			_ = r.session.AddCloser(cv)
+			return &ComponentVersion{
+				registryAccess:         r,
+				componentVersionAccess: cv,
+				componentDescriptorV2:  *cd,
+			}, nil
+		}
+	}
+
+	// concurrent lookups of the same component version are deduplicated
+	var componentVersion model.ComponentVersion
+	cv, shared, err := componentVersionLookups.Do(cacheKey, func() (ocm.ComponentVersionAccess, error) {
+		cv, err := r.lookupComponentVersion(logger, cdRef)
+		if err != nil {
+			return nil, err
+		}
+		componentVersion, err = r.NewComponentVersion(cv)
+		if err != nil {
+			return nil, err
+		}
+		if cvCache != nil {
+			cvCache.Add(cacheKey, cv, componentVersion.GetComponentDescriptor())
+		}
+		return cv, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	if !shared {
+		return componentVersion, nil
+	}
+	_ = r.session.AddCloser(cv)
+	return r.NewComponentVersion(cv)
+}
+
+// lookupComponentVersion looks up the referenced component version in its repository.
+func (r *RegistryAccess) lookupComponentVersion(logger logging.Logger, cdRef *lsv1alpha1.ComponentDescriptorReference) (ocm.ComponentVersionAccess, error) {
 	var resolver ocm.ComponentVersionResolver
 
 	if cdRef.RepositoryContext != nil {
@@ -151,18 +204,70 @@ func (r *RegistryAccess) GetComponentVersion(ctx context.Context, cdRef *lsv1alp
 		pm1.StopDebug()
 	}
 
+	// the archives of the archive registry are searched before the referenced repository
+	archiveResolvers, err := r.archiveResolvers()
+	if err != nil {
+		return nil, err
+	}
+	if len(archiveResolvers) != 0 {
+		resolver = ocm.NewCompoundResolver(append(archiveResolvers, resolver)...)
+	}
+
 	if resolver == nil {
 		return nil, errors.New("no repository or ocm resolvers found")
 	}
 
 	pm2 := utils.StartPerformanceMeasurement(&logger, "GetComponentVersion-LookupComponentVersion")
-	cv, err := r.session.LookupComponentVersion(resolver, cdRef.ComponentName, cdRef.Version)
-	pm2.StopDebug()
+	defer pm2.StopDebug()
+	return r.session.LookupComponentVersion(resolver, cdRef.ComponentName, cdRef.Version)
+}
+
+// archiveResolvers returns the repositories of the archives of the archive registry, if an archive registry is configured.
+func (r *RegistryAccess) archiveResolvers() ([]ocm.ComponentVersionResolver, error) {
+	registry := archive.GetRegistry()
+	if registry == nil {
+		return nil, nil
+	}
+	specs, err := registry.RepositorySpecs()
 	if err != nil {
 		return nil, err
 	}
+	resolvers := make([]ocm.ComponentVersionResolver, 0, len(specs))
+	for _, spec := range specs {
+		repo, err := r.session.LookupRepository(r.octx, spec)
+		if err != nil {
+			return nil, fmt.Errorf("unable to open archive: %w", err)
+		}
+		resolvers = append(resolvers, repo)
+	}
+	return resolvers, nil
+}
 
-	return r.NewComponentVersion(cv)
+func (r *RegistryAccess) ListComponentVersions(ctx context.Context, repositoryContext *v2.UnstructuredTypedObject, componentName string) ([]string, error) {
+	logger, _ := logging.FromContextOrNew(ctx, nil, "componentName", componentName)
+	pm := utils.StartPerformanceMeasurement(&logger, "ListComponentVersions")
+	defer pm.StopDebug()
+
+	if repositoryContext == nil {
+		return nil, errors.New("repository context cannot be nil")
+	}
+
+	spec, err := r.octx.RepositorySpecForConfig(repositoryContext.Raw, runtime.DefaultYAMLEncoding)
+	if err != nil {
+		return nil, err
+	}
+
+	repo, err := r.session.LookupRepository(r.octx, spec)
+	if err != nil {
+		return nil, err
+	}
+
+	component, err := r.session.LookupComponent(repo, componentName)
+	if err != nil {
+		return nil, err
+	}
+
+	return component.ListVersions()
 }
 
 func (r *RegistryAccess) Close() error {
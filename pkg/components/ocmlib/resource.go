@@ -11,6 +11,7 @@ import (
 	"github.com/gardener/landscaper/controller-utils/pkg/logging"
 
 	cdv2 "github.com/gardener/component-spec/bindings-go/apis/v2"
+	"github.com/opencontainers/go-digest"
 
 	"github.com/open-component-model/ocm/pkg/common"
 	"github.com/open-component-model/ocm/pkg/contexts/oci"
@@ -138,10 +139,31 @@ func (h *HelmChartProvider) GetTypedContent(ctx context.Context) (_ *model.Typed
 		return nil, err
 	}
 
+	chartDigest, err := getChartDigest(access)
+	if err != nil {
+		return nil, err
+	}
+
 	return &model.TypedResourceContent{
 		Type:     types.HelmChartResourceType,
 		Resource: helmChart,
+		Digest:   chartDigest,
 	}, nil
 }
 
+// getChartDigest returns the digest of the downloaded chart archive.
+func getChartDigest(access helm.ChartAccess) (_ string, rerr error) {
+	blob, err := access.Chart()
+	if err != nil {
+		return "", err
+	}
+	defer errors.PropagateError(&rerr, blob.Close)
+
+	data, err := blob.Get()
+	if err != nil {
+		return "", fmt.Errorf("unable to read chart archive: %w", err)
+	}
+	return digest.FromBytes(data).String(), nil
+}
+
 ////////////////////////////////////////////////////////////////////////////////////////////////////////////////////////
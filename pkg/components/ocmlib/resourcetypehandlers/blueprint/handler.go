@@ -8,7 +8,12 @@ import (
 	"context"
 	"fmt"
 
+	"github.com/open-component-model/ocm/pkg/blobaccess"
 	"github.com/open-component-model/ocm/pkg/common"
+	"github.com/open-component-model/ocm/pkg/contexts/oci/artdesc"
+	"github.com/open-component-model/ocm/pkg/errors"
+	"github.com/open-component-model/ocm/pkg/mime"
+	"k8s.io/apimachinery/pkg/util/sets"
 
 	"github.com/mandelsoft/filepath/pkg/filepath"
 	"github.com/mandelsoft/vfs/pkg/memoryfs"
@@ -23,6 +28,22 @@ import (
 	"github.com/gardener/landscaper/pkg/components/ocmlib/registries"
 )
 
+var (
+	// archiveMediaTypes are the media types of blueprints that are stored as (compressed) tar archives.
+	archiveMediaTypes = sets.New[string](
+		mime.MIME_TAR,
+		mime.MIME_TGZ,
+		mime.MIME_TGZ_ALT,
+		bpdownload.BLUEPRINT_MIMETYPE,
+		bpdownload.BLUEPRINT_MIMETYPE_COMPRESSED,
+		bpdownload.BLUEPRINT_MIMETYPE_LEGACY,
+		bpdownload.BLUEPRINT_MIMETYPE_LEGACY_COMPRESSED,
+	)
+	// artifactMediaTypes are the media types of blueprints that are stored as oci artifacts.
+	artifactMediaTypes = sets.New[string](append(artdesc.ToArchiveMediaTypes(artdesc.MediaTypeImageManifest),
+		artdesc.ToArchiveMediaTypes(artdesc.MediaTypeDockerSchema2Manifest)...)...)
+)
+
 func init() {
 	registries.Registry.Register(mediatype.BlueprintType, New())
 	registries.Registry.Register(mediatype.OldBlueprintType, New())
@@ -38,7 +59,8 @@ func New() *BlueprintHandler {
 	}
 }
 func (h *BlueprintHandler) GetResourceContent(ctx context.Context, r model.Resource, access ocm.ResourceAccess) (*model.TypedResourceContent, error) {
-	res, err := blueprint.GetBlueprintStore().Get(ctx, r.GetCachingIdentity(ctx))
+	blueprintID := r.GetCachingIdentity(ctx)
+	res, err := blueprint.GetBlueprintStore().Get(ctx, blueprintID)
 	if err != nil {
 		return nil, err
 	}
@@ -49,27 +71,25 @@ func (h *BlueprintHandler) GetResourceContent(ctx context.Context, r model.Resou
 		}, nil
 	}
 
-	fs := memoryfs.New()
-	pr := common.NewPrinter(nil)
-	ok, _, err := bpdownload.New().Download(pr, access, filepath.Join("/"), fs)
-	if err != nil {
-		return nil, err
-	}
-	if !ok {
-		return nil, fmt.Errorf("artifact does not match blueprint downloader (check config media type)")
-	}
+	return blueprint.GetBlueprintStore().Fetch(ctx, blueprintID, func() (*model.TypedResourceContent, error) {
+		blob, err := blueprint.GetBlobCache().Get(ctx, blueprintID, func() (*blueprint.Blob, error) {
+			return readBlob(access)
+		})
+		if err != nil {
+			return nil, err
+		}
 
-	typedResourceContent, err := h.Prepare(ctx, fs)
-	if err != nil {
-		return nil, err
-	}
-
-	_, err = blueprint.GetBlueprintStore().Put(ctx, r.GetCachingIdentity(ctx), typedResourceContent)
-	if err != nil {
-		return nil, err
-	}
+		fs := memoryfs.New()
+		ok, err := extractBlob(common.NewPrinter(nil), blob, filepath.Join("/"), fs)
+		if err != nil {
+			return nil, err
+		}
+		if !ok {
+			return nil, fmt.Errorf("artifact does not match blueprint downloader (check config media type)")
+		}
 
-	return typedResourceContent, nil
+		return h.Prepare(ctx, fs)
+	})
 }
 
 func (h *BlueprintHandler) Prepare(ctx context.Context, fs vfs.FileSystem) (*model.TypedResourceContent, error) {
@@ -83,3 +103,31 @@ func (h *BlueprintHandler) Prepare(ctx context.Context, fs vfs.FileSystem) (*mod
 		Resource: bp,
 	}, nil
 }
+
+// readBlob reads the blob of a blueprint resource.
+func readBlob(access ocm.ResourceAccess) (_ *blueprint.Blob, rerr error) {
+	meth, err := access.AccessMethod()
+	if err != nil {
+		return nil, err
+	}
+	defer errors.PropagateError(&rerr, meth.Close)
+
+	data, err := meth.Get()
+	if err != nil {
+		return nil, err
+	}
+	return &blueprint.Blob{MediaType: meth.MimeType(), Data: data}, nil
+}
+
+// extractBlob extracts the blob of a blueprint resource like the blueprint downloader of the ocm library.
+// False is returned if the media type of the blob is not supported.
+func extractBlob(pr common.Printer, blob *blueprint.Blob, path string, fs vfs.FileSystem) (bool, error) {
+	data := blobaccess.DataAccessForBytes(blob.Data)
+	switch {
+	case archiveMediaTypes.Has(blob.MediaType):
+		return bpdownload.ExtractArchive(pr, nil, data, path, fs)
+	case artifactMediaTypes.Has(blob.MediaType):
+		return bpdownload.ExtractArtifact(pr, bpdownload.New(), data, path, fs)
+	}
+	return false, nil
+}
@@ -0,0 +1,301 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package archive
+
+import (
+	"bufio"
+	"bytes"
+	"compress/gzip"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"path/filepath"
+
+	"github.com/gardener/component-cli/ociclient"
+	"github.com/gardener/component-cli/ociclient/credentials"
+	"github.com/mandelsoft/vfs/pkg/osfs"
+	"github.com/mandelsoft/vfs/pkg/projectionfs"
+	"github.com/mandelsoft/vfs/pkg/vfs"
+	"github.com/open-component-model/ocm/pkg/common/accessio"
+	"github.com/open-component-model/ocm/pkg/common/accessobj"
+	"github.com/open-component-model/ocm/pkg/contexts/ocm"
+	"github.com/open-component-model/ocm/pkg/contexts/ocm/repositories/comparch"
+	"github.com/open-component-model/ocm/pkg/contexts/ocm/repositories/ctf"
+	"github.com/open-component-model/ocm/pkg/utils/tarutils"
+
+	"github.com/gardener/landscaper/apis/config"
+	"github.com/gardener/landscaper/controller-utils/pkg/logging"
+	cnudieutils "github.com/gardener/landscaper/pkg/components/cnudie/utils"
+)
+
+const (
+	// componentDescriptorFileName is the name of the component descriptor file at the root of a component archive.
+	componentDescriptorFileName = "component-descriptor.yaml"
+	// artifactIndexFileName is the name of the index file at the root of a common transport format archive.
+	artifactIndexFileName = "artifact-index.json"
+)
+
+var registrySingleton *Registry
+
+// GetRegistry returns the archive registry of the landscaper.
+// Nil is returned if no archive registry is configured.
+func GetRegistry() *Registry {
+	return registrySingleton
+}
+
+// SetRegistry sets the archive registry of the landscaper.
+func SetRegistry(registry *Registry) {
+	registrySingleton = registry
+}
+
+// Registry reads component versions from common transport format archives and component archives.
+// The archives are either mounted into the landscaper or downloaded from oci artifacts.
+// Archives in tar or tar.gz files are extracted into a cache directory, because the ocm library writes such files
+// when they are closed, which is not possible for read-only mounts.
+type Registry struct {
+	log      logging.Logger
+	fs       vfs.FileSystem
+	cacheDir string
+	archives []*archive
+}
+
+type archive struct {
+	config config.ArchiveConfiguration
+	// dir is the directory of the archive in the filesystem of the registry.
+	// It is empty as long as the archive has not been loaded.
+	dir string
+	// typ is the ocm repository type of the archive, i.e. a common transport format or a component archive.
+	typ string
+}
+
+// NewRegistry creates a new archive registry for the configured archives.
+// The archives have to be loaded with Load before they are used.
+func NewRegistry(log logging.Logger, fs vfs.FileSystem, registryConfig *config.ArchiveRegistryConfiguration) (*Registry, error) {
+	if registryConfig == nil {
+		return nil, errors.New("no archive registry configuration defined")
+	}
+
+	r := &Registry{
+		log:      log,
+		fs:       fs,
+		cacheDir: registryConfig.CacheDir,
+	}
+	names := map[string]bool{}
+	for _, archiveConfig := range registryConfig.Archives {
+		if len(archiveConfig.Name) == 0 {
+			return nil, errors.New("the name of an archive is missing")
+		}
+		if names[archiveConfig.Name] {
+			return nil, fmt.Errorf("archive %q is configured more than once", archiveConfig.Name)
+		}
+		names[archiveConfig.Name] = true
+		if (len(archiveConfig.Path) == 0) == (len(archiveConfig.OCIArtifact) == 0) {
+			return nil, fmt.Errorf("exactly one of path and ociArtifact has to be defined for archive %q", archiveConfig.Name)
+		}
+		r.archives = append(r.archives, &archive{config: archiveConfig})
+	}
+	return r, nil
+}
+
+// Load prepares all archives that have not been loaded yet.
+// Archives in tar or tar.gz files and archives of oci artifacts are extracted into the cache directory.
+// The oci configuration provides the credentials for the oci registries.
+func (r *Registry) Load(ctx context.Context, ociConfig *config.OCIConfiguration) error {
+	var client ociclient.Client
+	for _, a := range r.archives {
+		if len(a.dir) != 0 {
+			continue
+		}
+
+		if len(a.config.Path) != 0 {
+			if err := r.loadPath(a); err != nil {
+				return fmt.Errorf("unable to load archive %q from %q: %w", a.config.Name, a.config.Path, err)
+			}
+			continue
+		}
+
+		if client == nil {
+			var err error
+			client, err = newOCIClient(r.log, ociConfig)
+			if err != nil {
+				return fmt.Errorf("unable to build oci client of the archive registry: %w", err)
+			}
+		}
+		if err := r.download(ctx, client, a); err != nil {
+			return fmt.Errorf("unable to download archive %q from %q: %w", a.config.Name, a.config.OCIArtifact, err)
+		}
+		r.log.Info("downloaded archive", "archive", a.config.Name, "ociArtifact", a.config.OCIArtifact)
+	}
+	return nil
+}
+
+// RepositorySpecs returns the ocm repository specifications of the archives in the configured order.
+// Archives that have not been loaded are skipped.
+func (r *Registry) RepositorySpecs() ([]ocm.RepositorySpec, error) {
+	specs := make([]ocm.RepositorySpec, 0, len(r.archives))
+	for _, a := range r.archives {
+		if len(a.dir) == 0 {
+			continue
+		}
+		var (
+			spec ocm.RepositorySpec
+			err  error
+		)
+		opts := []accessio.Option{accessio.PathFileSystem(r.fs), accessio.FileFormat(accessio.FormatDirectory)}
+		switch a.typ {
+		case comparch.Type:
+			spec, err = comparch.NewRepositorySpec(accessobj.ACC_READONLY, a.dir, opts...)
+		default:
+			spec, err = ctf.NewRepositorySpec(accessobj.ACC_READONLY, a.dir, opts...)
+		}
+		if err != nil {
+			return nil, fmt.Errorf("unable to create repository specification of archive %q: %w", a.config.Name, err)
+		}
+		specs = append(specs, spec)
+	}
+	return specs, nil
+}
+
+// loadPath loads a mounted archive. Directories are used as they are, files are extracted into the cache directory.
+func (r *Registry) loadPath(a *archive) error {
+	info, err := r.fs.Stat(a.config.Path)
+	if err != nil {
+		return err
+	}
+	dir := a.config.Path
+	if !info.IsDir() {
+		file, err := r.fs.Open(a.config.Path)
+		if err != nil {
+			return err
+		}
+		defer file.Close()
+		dir, err = r.extract(a, file)
+		if err != nil {
+			return err
+		}
+	}
+	return a.setDir(r.fs, dir)
+}
+
+// download fetches the single layer of the oci artifact of an archive and extracts it into the cache directory.
+func (r *Registry) download(ctx context.Context, client ociclient.Client, a *archive) error {
+	manifest, err := client.GetManifest(ctx, a.config.OCIArtifact)
+	if err != nil {
+		return err
+	}
+	if len(manifest.Layers) != 1 {
+		return fmt.Errorf("the oci artifact has %d layers but exactly one layer with the archive is expected", len(manifest.Layers))
+	}
+
+	var blob bytes.Buffer
+	if err := client.Fetch(ctx, a.config.OCIArtifact, manifest.Layers[0], &blob); err != nil {
+		return err
+	}
+	dir, err := r.extract(a, &blob)
+	if err != nil {
+		return err
+	}
+	return a.setDir(r.fs, dir)
+}
+
+// extract extracts a tar or tar.gz stream of an archive into a directory of the cache directory.
+func (r *Registry) extract(a *archive, stream io.Reader) (string, error) {
+	if len(r.cacheDir) == 0 {
+		dir, err := vfs.TempDir(r.fs, "", "landscaper-archives-")
+		if err != nil {
+			return "", fmt.Errorf("unable to create cache directory of the archive registry: %w", err)
+		}
+		r.cacheDir = dir
+	} else if err := r.fs.MkdirAll(r.cacheDir, 0o700); err != nil {
+		return "", fmt.Errorf("unable to create cache directory of the archive registry: %w", err)
+	}
+
+	hash := sha256.Sum256([]byte(a.config.Name + "\n" + a.config.Path + "\n" + a.config.OCIArtifact))
+	dir := filepath.Join(r.cacheDir, hex.EncodeToString(hash[:8]))
+	if err := r.fs.RemoveAll(dir); err != nil {
+		return "", err
+	}
+	if err := r.fs.Mkdir(dir, 0o700); err != nil {
+		return "", err
+	}
+
+	reader, err := decompress(stream)
+	if err != nil {
+		return "", err
+	}
+	// the archive is extracted into a projection, so that its files cannot be written outside the directory
+	dirFs, err := projectionfs.New(r.fs, dir)
+	if err != nil {
+		return "", err
+	}
+	if err := tarutils.ExtractTarToFs(dirFs, reader); err != nil {
+		return "", fmt.Errorf("unable to extract archive: %w", err)
+	}
+	return dir, nil
+}
+
+// setDir sets the directory of the archive after its type has been detected.
+func (a *archive) setDir(fs vfs.FileSystem, dir string) error {
+	typ, err := detectType(fs, dir)
+	if err != nil {
+		return err
+	}
+	a.dir = dir
+	a.typ = typ
+	return nil
+}
+
+// detectType returns whether the archive in the given directory is a component archive
+// or a common transport format archive.
+func detectType(fs vfs.FileSystem, dir string) (string, error) {
+	if ok, err := vfs.FileExists(fs, filepath.Join(dir, componentDescriptorFileName)); err != nil {
+		return "", err
+	} else if ok {
+		return comparch.Type, nil
+	}
+	if ok, err := vfs.FileExists(fs, filepath.Join(dir, artifactIndexFileName)); err != nil {
+		return "", err
+	} else if ok {
+		return ctf.Type, nil
+	}
+	return "", errors.New("the archive is neither a component archive nor a common transport format archive")
+}
+
+// decompress returns a reader of the uncompressed stream if the stream is gzip compressed.
+func decompress(stream io.Reader) (io.Reader, error) {
+	buffered := bufio.NewReader(stream)
+	magic, err := buffered.Peek(2)
+	if err != nil && err != io.EOF {
+		return nil, err
+	}
+	if len(magic) == 2 && magic[0] == 0x1f && magic[1] == 0x8b {
+		return gzip.NewReader(buffered)
+	}
+	return buffered, nil
+}
+
+func newOCIClient(log logging.Logger, ociConfig *config.OCIConfiguration) (ociclient.Client, error) {
+	ociConfigFiles := make([]string, 0)
+	if ociConfig != nil {
+		ociConfigFiles = ociConfig.ConfigFiles
+	}
+	keyring, err := credentials.NewBuilder(log.WithName("ociKeyring").Logr()).
+		WithFS(osfs.New()).
+		FromConfigFiles(ociConfigFiles...).
+		Build()
+	if err != nil {
+		return nil, err
+	}
+	if err := cnudieutils.AddCredentialHelpers(keyring, ociConfig); err != nil {
+		return nil, err
+	}
+	return ociclient.NewClient(log.Logr(),
+		cnudieutils.WithConfiguration(ociConfig),
+		ociclient.WithKeyring(keyring),
+	)
+}
@@ -0,0 +1,162 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package archive_test
+
+import (
+	"context"
+
+	"github.com/mandelsoft/vfs/pkg/vfs"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/open-component-model/ocm/pkg/common/accessio"
+	"github.com/open-component-model/ocm/pkg/contexts/ocm"
+	"github.com/open-component-model/ocm/pkg/contexts/ocm/repositories/comparch"
+	"github.com/open-component-model/ocm/pkg/contexts/ocm/repositories/ctf"
+	tenv "github.com/open-component-model/ocm/pkg/env"
+	. "github.com/open-component-model/ocm/pkg/env/builder"
+	"github.com/open-component-model/ocm/pkg/mime"
+
+	"github.com/gardener/landscaper/apis/config"
+	"github.com/gardener/landscaper/controller-utils/pkg/logging"
+	"github.com/gardener/landscaper/pkg/components/registries/archive"
+)
+
+var _ = Describe("Archive Registry", func() {
+
+	var (
+		env     *Builder
+		session ocm.Session
+	)
+
+	BeforeEach(func() {
+		session = ocm.NewSession(nil)
+		env = NewBuilder(tenv.NewEnvironment())
+		Expect(env.FileSystem().MkdirAll("/archives", 0o700)).To(Succeed())
+
+		env.OCMCommonTransport("/archives/ctf", accessio.FormatDirectory, func() {
+			env.Component("example.com/a", func() {
+				env.Version("1.0.0", func() {
+					env.Provider("example")
+				})
+			})
+		})
+		env.OCMCommonTransport("/archives/ctf.tgz", accessio.FormatTGZ, func() {
+			env.Component("example.com/b", func() {
+				env.Version("1.0.0", func() {
+					env.Provider("example")
+				})
+			})
+		})
+		env.ComponentArchive("/archives/ca.tar", accessio.FormatTar, "example.com/c", "1.0.0", func() {
+			env.Provider("example")
+			env.Resource("blueprint", "1.0.0", "landscaper.gardener.cloud/blueprint", "local", func() {
+				env.BlobStringData(mime.MIME_TEXT, "blueprint")
+			})
+		})
+	})
+
+	AfterEach(func() {
+		Expect(session.Close()).To(Succeed())
+		Expect(env.Cleanup()).To(Succeed())
+	})
+
+	newRegistry := func(archives ...config.ArchiveConfiguration) (*archive.Registry, error) {
+		registry, err := archive.NewRegistry(logging.Discard(), env.FileSystem(), &config.ArchiveRegistryConfiguration{
+			Archives: archives,
+			CacheDir: "/cache",
+		})
+		if err != nil {
+			return nil, err
+		}
+		return registry, registry.Load(context.Background(), nil)
+	}
+
+	lookup := func(registry *archive.Registry, name, version string) (ocm.ComponentVersionAccess, error) {
+		specs, err := registry.RepositorySpecs()
+		Expect(err).ToNot(HaveOccurred())
+		resolvers := []ocm.ComponentVersionResolver{}
+		for _, spec := range specs {
+			repo, err := session.LookupRepository(env.OCMContext(), spec)
+			Expect(err).ToNot(HaveOccurred())
+			resolvers = append(resolvers, repo)
+		}
+		return session.LookupComponentVersion(ocm.NewCompoundResolver(resolvers...), name, version)
+	}
+
+	It("should detect the type of the archives", func() {
+		registry, err := newRegistry(
+			config.ArchiveConfiguration{Name: "ctf", Path: "/archives/ctf"},
+			config.ArchiveConfiguration{Name: "ctf-tgz", Path: "/archives/ctf.tgz"},
+			config.ArchiveConfiguration{Name: "ca", Path: "/archives/ca.tar"},
+		)
+		Expect(err).ToNot(HaveOccurred())
+
+		specs, err := registry.RepositorySpecs()
+		Expect(err).ToNot(HaveOccurred())
+		Expect(specs).To(HaveLen(3))
+		Expect(specs[0].GetKind()).To(Equal(ctf.Type))
+		Expect(specs[1].GetKind()).To(Equal(ctf.Type))
+		Expect(specs[2].GetKind()).To(Equal(comparch.Type))
+	})
+
+	It("should read component versions from all archives", func() {
+		registry, err := newRegistry(
+			config.ArchiveConfiguration{Name: "ctf", Path: "/archives/ctf"},
+			config.ArchiveConfiguration{Name: "ctf-tgz", Path: "/archives/ctf.tgz"},
+			config.ArchiveConfiguration{Name: "ca", Path: "/archives/ca.tar"},
+		)
+		Expect(err).ToNot(HaveOccurred())
+
+		for _, name := range []string{"example.com/a", "example.com/b", "example.com/c"} {
+			cv, err := lookup(registry, name, "1.0.0")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(cv.GetName()).To(Equal(name))
+		}
+
+		cv, err := lookup(registry, "example.com/c", "1.0.0")
+		Expect(err).ToNot(HaveOccurred())
+		res, err := cv.GetResource(map[string]string{"name": "blueprint"})
+		Expect(err).ToNot(HaveOccurred())
+		data, err := res.BlobAccess()
+		Expect(err).ToNot(HaveOccurred())
+		defer data.Close()
+		Expect(data.Get()).To(Equal([]byte("blueprint")))
+
+		_, err = lookup(registry, "example.com/d", "1.0.0")
+		Expect(err).To(HaveOccurred())
+	})
+
+	It("should not modify mounted archive files", func() {
+		tgz, err := vfs.ReadFile(env.FileSystem(), "/archives/ctf.tgz")
+		Expect(err).ToNot(HaveOccurred())
+
+		registry, err := newRegistry(config.ArchiveConfiguration{Name: "ctf-tgz", Path: "/archives/ctf.tgz"})
+		Expect(err).ToNot(HaveOccurred())
+		_, err = lookup(registry, "example.com/b", "1.0.0")
+		Expect(err).ToNot(HaveOccurred())
+		Expect(session.Close()).To(Succeed())
+		session = ocm.NewSession(nil)
+
+		Expect(vfs.ReadFile(env.FileSystem(), "/archives/ctf.tgz")).To(Equal(tgz))
+	})
+
+	It("should reject invalid archives", func() {
+		Expect(env.FileSystem().MkdirAll("/archives/empty", 0o700)).To(Succeed())
+		_, err := newRegistry(config.ArchiveConfiguration{Name: "empty", Path: "/archives/empty"})
+		Expect(err).To(HaveOccurred())
+
+		_, err = newRegistry(config.ArchiveConfiguration{Name: "missing", Path: "/archives/missing"})
+		Expect(err).To(HaveOccurred())
+
+		_, err = newRegistry(config.ArchiveConfiguration{Name: "both", Path: "/archives/ctf", OCIArtifact: "example.com/archive:1.0.0"})
+		Expect(err).To(HaveOccurred())
+
+		_, err = newRegistry(
+			config.ArchiveConfiguration{Name: "ctf", Path: "/archives/ctf"},
+			config.ArchiveConfiguration{Name: "ctf", Path: "/archives/ctf.tgz"},
+		)
+		Expect(err).To(HaveOccurred())
+	})
+})
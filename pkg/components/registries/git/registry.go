@@ -0,0 +1,304 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package git
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"os"
+	"os/exec"
+	"path"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/mandelsoft/vfs/pkg/memoryfs"
+	"github.com/mandelsoft/vfs/pkg/vfs"
+	"k8s.io/apimachinery/pkg/util/wait"
+
+	"github.com/gardener/landscaper/apis/config"
+	lsv1alpha1 "github.com/gardener/landscaper/apis/core/v1alpha1"
+	"github.com/gardener/landscaper/controller-utils/pkg/logging"
+	"github.com/gardener/landscaper/pkg/components/model/tar"
+)
+
+// DefaultInterval is the interval in which the repositories are pulled if no interval is configured.
+const DefaultInterval = 5 * time.Minute
+
+// DefaultUsername is the username that is used for token authentication if no username is configured.
+const DefaultUsername = "git"
+
+var registrySingleton *Registry
+
+// GetRegistry returns the git registry of the landscaper.
+// Nil is returned if no git registry is configured.
+func GetRegistry() *Registry {
+	return registrySingleton
+}
+
+// SetRegistry sets the git registry of the landscaper.
+func SetRegistry(registry *Registry) {
+	registrySingleton = registry
+}
+
+// Registry reads blueprints from git repositories.
+// The repositories are cloned into a cache directory and pulled periodically.
+// The git command line tool is used to access the repositories.
+type Registry struct {
+	log          logging.Logger
+	interval     time.Duration
+	repositories map[string]*repository
+}
+
+type repository struct {
+	config config.GitRepositoryConfiguration
+	// dir is the directory of the bare mirror of the repository.
+	dir string
+	mux sync.Mutex
+	// cloned is true if the mirror of the repository exists.
+	cloned bool
+}
+
+// NewRegistry creates a new git registry for the configured repositories.
+func NewRegistry(log logging.Logger, registryConfig *config.GitRegistryConfiguration) (*Registry, error) {
+	if registryConfig == nil {
+		return nil, errors.New("no git registry configuration defined")
+	}
+
+	cacheDir := registryConfig.CacheDir
+	if len(cacheDir) == 0 {
+		dir, err := os.MkdirTemp("", "landscaper-git-")
+		if err != nil {
+			return nil, fmt.Errorf("unable to create cache directory of the git registry: %w", err)
+		}
+		cacheDir = dir
+	} else if err := os.MkdirAll(cacheDir, 0o700); err != nil {
+		return nil, fmt.Errorf("unable to create cache directory of the git registry: %w", err)
+	}
+
+	r := &Registry{
+		log:          log,
+		interval:     DefaultInterval,
+		repositories: map[string]*repository{},
+	}
+	if registryConfig.Interval != nil && registryConfig.Interval.Duration > 0 {
+		r.interval = registryConfig.Interval.Duration
+	}
+
+	for _, repoConfig := range registryConfig.Repositories {
+		if len(repoConfig.Name) == 0 {
+			return nil, errors.New("the name of a git repository is missing")
+		}
+		if len(repoConfig.URL) == 0 {
+			return nil, fmt.Errorf("the url of git repository %q is missing", repoConfig.Name)
+		}
+		if _, ok := r.repositories[repoConfig.Name]; ok {
+			return nil, fmt.Errorf("git repository %q is configured more than once", repoConfig.Name)
+		}
+		hash := sha256.Sum256([]byte(repoConfig.Name + "\n" + repoConfig.URL))
+		r.repositories[repoConfig.Name] = &repository{
+			config: repoConfig,
+			dir:    filepath.Join(cacheDir, hex.EncodeToString(hash[:8])),
+		}
+	}
+	return r, nil
+}
+
+// Start pulls the repositories periodically until the context is cancelled.
+func (r *Registry) Start(ctx context.Context) error {
+	r.log.Info("pulling git repositories of the git registry", "numberOfRepositories", len(r.repositories), "interval", r.interval.String())
+	wait.UntilWithContext(ctx, func(ctx context.Context) {
+		if err := r.Sync(ctx); err != nil {
+			r.log.Error(err, "unable to pull git repositories")
+		}
+	}, r.interval)
+	return nil
+}
+
+// Sync clones or pulls all repositories.
+func (r *Registry) Sync(ctx context.Context) error {
+	var allErrs []error
+	for name, repo := range r.repositories {
+		if err := repo.sync(ctx); err != nil {
+			allErrs = append(allErrs, fmt.Errorf("unable to pull git repository %q: %w", name, err))
+		}
+	}
+	return errors.Join(allErrs...)
+}
+
+// GetBlueprintFs returns the content of the blueprint directory that is referenced by the given reference
+// as in-memory filesystem.
+func (r *Registry) GetBlueprintFs(ctx context.Context, ref *lsv1alpha1.GitBlueprintReference) (vfs.FileSystem, error) {
+	if ref == nil {
+		return nil, errors.New("no git reference defined")
+	}
+	repo, ok := r.repositories[ref.Repository]
+	if !ok {
+		return nil, fmt.Errorf("git repository %q is not configured in the git registry", ref.Repository)
+	}
+	return repo.read(ctx, ref.Path, ref.Revision)
+}
+
+// read returns the content of a directory of the repository at the given revision.
+func (r *repository) read(ctx context.Context, dirPath, revision string) (vfs.FileSystem, error) {
+	if len(revision) == 0 {
+		revision = r.config.Ref
+	}
+	if len(revision) == 0 {
+		revision = "HEAD"
+	}
+	if strings.HasPrefix(revision, "-") {
+		return nil, fmt.Errorf("invalid revision %q", revision)
+	}
+
+	if err := r.ensureCloned(ctx); err != nil {
+		return nil, err
+	}
+	commit, err := r.resolve(ctx, revision)
+	if err != nil {
+		// the revision might have been created after the last pull
+		if err := r.sync(ctx); err != nil {
+			return nil, err
+		}
+		commit, err = r.resolve(ctx, revision)
+		if err != nil {
+			return nil, fmt.Errorf("unable to resolve revision %q of git repository %q: %w", revision, r.config.Name, err)
+		}
+	}
+
+	// the path is cleaned, so that it cannot point outside the repository
+	treeish := commit
+	if p := strings.TrimPrefix(path.Clean("/"+dirPath), "/"); len(p) != 0 {
+		treeish = commit + ":" + p
+	}
+	archive, err := r.git(ctx, "archive", "--format=tar", treeish)
+	if err != nil {
+		return nil, fmt.Errorf("unable to read path %q of git repository %q at revision %q: %w", dirPath, r.config.Name, revision, err)
+	}
+
+	fs := memoryfs.New()
+	if err := tar.ExtractTar(ctx, bytes.NewReader(archive), fs); err != nil {
+		return nil, fmt.Errorf("unable to extract path %q of git repository %q: %w", dirPath, r.config.Name, err)
+	}
+	return fs, nil
+}
+
+// resolve returns the commit of a revision.
+func (r *repository) resolve(ctx context.Context, revision string) (string, error) {
+	out, err := r.git(ctx, "rev-parse", "--verify", "--quiet", revision+"^{commit}")
+	if err != nil {
+		return "", err
+	}
+	return strings.TrimSpace(string(out)), nil
+}
+
+// ensureCloned clones the repository if it has not been cloned yet.
+func (r *repository) ensureCloned(ctx context.Context) error {
+	r.mux.Lock()
+	cloned := r.cloned
+	r.mux.Unlock()
+	if cloned {
+		return nil
+	}
+	return r.sync(ctx)
+}
+
+// sync clones the repository as bare mirror or fetches all refs if the mirror already exists.
+func (r *repository) sync(ctx context.Context) error {
+	r.mux.Lock()
+	defer r.mux.Unlock()
+
+	if _, err := os.Stat(filepath.Join(r.dir, "HEAD")); err == nil {
+		if _, err := r.git(ctx, "fetch", "--prune", "--quiet", "origin"); err != nil {
+			return err
+		}
+		r.cloned = true
+		return nil
+	} else if !os.IsNotExist(err) {
+		return err
+	}
+
+	// remove the remains of a failed clone
+	if err := os.RemoveAll(r.dir); err != nil {
+		return err
+	}
+	if _, err := r.gitWithoutRepository(ctx, "clone", "--mirror", "--quiet", "--", r.config.URL, r.dir); err != nil {
+		return err
+	}
+	r.cloned = true
+	return nil
+}
+
+// git executes a git command on the mirror of the repository and returns its output.
+func (r *repository) git(ctx context.Context, args ...string) ([]byte, error) {
+	return r.gitWithoutRepository(ctx, append([]string{"--git-dir", r.dir}, args...)...)
+}
+
+func (r *repository) gitWithoutRepository(ctx context.Context, args ...string) ([]byte, error) {
+	env, err := r.env()
+	if err != nil {
+		return nil, err
+	}
+
+	var stdout, stderr bytes.Buffer
+	cmd := exec.CommandContext(ctx, "git", args...)
+	cmd.Env = env
+	cmd.Stdout = &stdout
+	cmd.Stderr = &stderr
+	if err := cmd.Run(); err != nil {
+		if msg := strings.TrimSpace(stderr.String()); len(msg) != 0 {
+			return nil, fmt.Errorf("%w: %s", err, msg)
+		}
+		return nil, err
+	}
+	return stdout.Bytes(), nil
+}
+
+// env returns the environment of the git commands, which contains the configured credentials.
+// The credentials are passed via environment variables, so that they are neither part of the command line
+// nor written to the git configuration on disk.
+func (r *repository) env() ([]string, error) {
+	env := append(os.Environ(), "GIT_TERMINAL_PROMPT=0")
+	auth := r.config.Auth
+	if auth == nil {
+		return env, nil
+	}
+
+	if len(auth.SSHPrivateKeyFile) != 0 {
+		sshCommand := "ssh -o BatchMode=yes -o IdentitiesOnly=yes -i " + shellQuote(auth.SSHPrivateKeyFile)
+		if len(auth.SSHKnownHostsFile) != 0 {
+			sshCommand += " -o StrictHostKeyChecking=yes -o UserKnownHostsFile=" + shellQuote(auth.SSHKnownHostsFile)
+		}
+		env = append(env, "GIT_SSH_COMMAND="+sshCommand)
+	}
+
+	if len(auth.TokenFile) != 0 {
+		token, err := os.ReadFile(auth.TokenFile)
+		if err != nil {
+			return nil, fmt.Errorf("unable to read token of git repository %q: %w", r.config.Name, err)
+		}
+		username := auth.Username
+		if len(username) == 0 {
+			username = DefaultUsername
+		}
+		credentials := base64.StdEncoding.EncodeToString([]byte(username + ":" + strings.TrimSpace(string(token))))
+		env = append(env,
+			"GIT_CONFIG_COUNT=1",
+			"GIT_CONFIG_KEY_0=http.extraHeader",
+			"GIT_CONFIG_VALUE_0=Authorization: Basic "+credentials)
+	}
+	return env, nil
+}
+
+// shellQuote quotes a value for the shell that executes the ssh command of git.
+func shellQuote(value string) string {
+	return "'" + strings.ReplaceAll(value, "'", `'\''`) + "'"
+}
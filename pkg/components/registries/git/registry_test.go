@@ -0,0 +1,148 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package git
+
+import (
+	"context"
+	"encoding/base64"
+	"os"
+	"os/exec"
+	"path/filepath"
+
+	"github.com/mandelsoft/vfs/pkg/vfs"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/gardener/landscaper/apis/config"
+	lsv1alpha1 "github.com/gardener/landscaper/apis/core/v1alpha1"
+	"github.com/gardener/landscaper/controller-utils/pkg/logging"
+)
+
+var _ = Describe("Git Registry", func() {
+
+	var (
+		ctx      context.Context
+		repoDir  string
+		registry *Registry
+	)
+
+	git := func(args ...string) {
+		cmd := exec.Command("git", args...)
+		cmd.Dir = repoDir
+		cmd.Env = append(os.Environ(),
+			"GIT_AUTHOR_NAME=test", "GIT_AUTHOR_EMAIL=test@example.com",
+			"GIT_COMMITTER_NAME=test", "GIT_COMMITTER_EMAIL=test@example.com")
+		out, err := cmd.CombinedOutput()
+		Expect(err).ToNot(HaveOccurred(), string(out))
+	}
+
+	commitBlueprint := func(dir, content string) {
+		Expect(os.MkdirAll(filepath.Join(repoDir, dir), 0o755)).To(Succeed())
+		Expect(os.WriteFile(filepath.Join(repoDir, dir, lsv1alpha1.BlueprintFileName), []byte(content), 0o644)).To(Succeed())
+		git("add", "-A")
+		git("commit", "--quiet", "-m", "update "+dir)
+	}
+
+	readBlueprint := func(ref *lsv1alpha1.GitBlueprintReference) string {
+		fs, err := registry.GetBlueprintFs(ctx, ref)
+		Expect(err).ToNot(HaveOccurred())
+		data, err := vfs.ReadFile(fs, lsv1alpha1.BlueprintFileName)
+		Expect(err).ToNot(HaveOccurred())
+		return string(data)
+	}
+
+	BeforeEach(func() {
+		if _, err := exec.LookPath("git"); err != nil {
+			Skip("git is not installed")
+		}
+		ctx = context.Background()
+		repoDir = GinkgoT().TempDir()
+		git("init", "--quiet", "--initial-branch", "main")
+		commitBlueprint("blueprints/echo", "version: 1")
+		git("tag", "v1")
+
+		var err error
+		registry, err = NewRegistry(logging.Discard(), &config.GitRegistryConfiguration{
+			CacheDir: GinkgoT().TempDir(),
+			Repositories: []config.GitRepositoryConfiguration{
+				{Name: "blueprints", URL: repoDir},
+			},
+		})
+		Expect(err).ToNot(HaveOccurred())
+	})
+
+	It("should read a blueprint by path and revision", func() {
+		commitBlueprint("blueprints/echo", "version: 2")
+
+		Expect(readBlueprint(&lsv1alpha1.GitBlueprintReference{Repository: "blueprints", Path: "blueprints/echo"})).To(Equal("version: 2"))
+		Expect(readBlueprint(&lsv1alpha1.GitBlueprintReference{Repository: "blueprints", Path: "/blueprints/echo/", Revision: "v1"})).To(Equal("version: 1"))
+	})
+
+	It("should read new commits after the repository has been pulled", func() {
+		ref := &lsv1alpha1.GitBlueprintReference{Repository: "blueprints", Path: "blueprints/echo"}
+		Expect(readBlueprint(ref)).To(Equal("version: 1"))
+
+		commitBlueprint("blueprints/echo", "version: 2")
+		Expect(readBlueprint(ref)).To(Equal("version: 1"))
+
+		Expect(registry.Sync(ctx)).To(Succeed())
+		Expect(readBlueprint(ref)).To(Equal("version: 2"))
+	})
+
+	It("should pull the repository if a revision is not yet known", func() {
+		Expect(registry.Sync(ctx)).To(Succeed())
+
+		commitBlueprint("blueprints/echo", "version: 2")
+		git("tag", "v2")
+		Expect(readBlueprint(&lsv1alpha1.GitBlueprintReference{Repository: "blueprints", Path: "blueprints/echo", Revision: "v2"})).To(Equal("version: 2"))
+	})
+
+	It("should fail for unknown repositories, revisions and paths", func() {
+		_, err := registry.GetBlueprintFs(ctx, &lsv1alpha1.GitBlueprintReference{Repository: "unknown"})
+		Expect(err).To(MatchError(ContainSubstring("not configured")))
+
+		_, err = registry.GetBlueprintFs(ctx, &lsv1alpha1.GitBlueprintReference{Repository: "blueprints", Revision: "v9"})
+		Expect(err).To(HaveOccurred())
+
+		_, err = registry.GetBlueprintFs(ctx, &lsv1alpha1.GitBlueprintReference{Repository: "blueprints", Revision: "--output=/tmp/x"})
+		Expect(err).To(MatchError(ContainSubstring("invalid revision")))
+
+		_, err = registry.GetBlueprintFs(ctx, &lsv1alpha1.GitBlueprintReference{Repository: "blueprints", Path: "unknown"})
+		Expect(err).To(HaveOccurred())
+	})
+
+	It("should reject invalid repository configurations", func() {
+		_, err := NewRegistry(logging.Discard(), &config.GitRegistryConfiguration{
+			CacheDir: GinkgoT().TempDir(),
+			Repositories: []config.GitRepositoryConfiguration{
+				{Name: "blueprints", URL: repoDir},
+				{Name: "blueprints", URL: repoDir},
+			},
+		})
+		Expect(err).To(MatchError(ContainSubstring("more than once")))
+	})
+
+	It("should pass the credentials of the repository via the environment", func() {
+		tokenFile := filepath.Join(GinkgoT().TempDir(), "token")
+		Expect(os.WriteFile(tokenFile, []byte("my-token\n"), 0o600)).To(Succeed())
+
+		repo := &repository{config: config.GitRepositoryConfiguration{
+			Name: "blueprints",
+			Auth: &config.GitAuthConfiguration{
+				SSHPrivateKeyFile: "/etc/git/id_rsa",
+				SSHKnownHostsFile: "/etc/git/known_hosts",
+				TokenFile:         tokenFile,
+			},
+		}}
+		env, err := repo.env()
+		Expect(err).ToNot(HaveOccurred())
+		Expect(env).To(ContainElements(
+			"GIT_TERMINAL_PROMPT=0",
+			"GIT_SSH_COMMAND=ssh -o BatchMode=yes -o IdentitiesOnly=yes -i '/etc/git/id_rsa' -o StrictHostKeyChecking=yes -o UserKnownHostsFile='/etc/git/known_hosts'",
+			"GIT_CONFIG_KEY_0=http.extraHeader",
+			"GIT_CONFIG_VALUE_0=Authorization: Basic "+base64.StdEncoding.EncodeToString([]byte("git:my-token")),
+		))
+	})
+})
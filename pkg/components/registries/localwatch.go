@@ -0,0 +1,121 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package registries
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/mandelsoft/vfs/pkg/vfs"
+	"k8s.io/apimachinery/pkg/util/wait"
+
+	"github.com/gardener/landscaper/controller-utils/pkg/logging"
+	"github.com/gardener/landscaper/pkg/components/cache/blueprint"
+	"github.com/gardener/landscaper/pkg/components/ocmlib"
+	"github.com/gardener/landscaper/pkg/landscaper/jsonschema"
+)
+
+// DefaultLocalRegistryWatchInterval is the interval in which the root path of a local registry is checked for changes.
+const DefaultLocalRegistryWatchInterval = 2 * time.Second
+
+// InvalidateCaches removes all cached blueprints, component versions and json schemas,
+// so that they are read again from their registries on the next access.
+func InvalidateCaches() error {
+	ocmlib.InvalidateComponentVersionCache()
+	jsonschema.GetSchemaStore().InvalidateAll()
+	if store := blueprint.GetBlueprintStore(); store != nil {
+		return store.InvalidateAll()
+	}
+	return nil
+}
+
+// LocalRegistryWatcher checks the root path of a local registry periodically for changed files
+// and invalidates the caches if a change is detected.
+// This allows to develop blueprints with a local registry without restarting the landscaper.
+type LocalRegistryWatcher struct {
+	log         logging.Logger
+	fs          vfs.FileSystem
+	rootPath    string
+	interval    time.Duration
+	fingerprint string
+	invalidate  func() error
+	onChange    func(ctx context.Context) error
+}
+
+// NewLocalRegistryWatcher creates a new watcher for the given root path of a local registry.
+func NewLocalRegistryWatcher(log logging.Logger, fs vfs.FileSystem, rootPath string) *LocalRegistryWatcher {
+	return &LocalRegistryWatcher{
+		log:        log,
+		fs:         fs,
+		rootPath:   rootPath,
+		interval:   DefaultLocalRegistryWatchInterval,
+		invalidate: InvalidateCaches,
+	}
+}
+
+// WithOnChange sets a function that is called after the caches have been invalidated because of a change.
+func (w *LocalRegistryWatcher) WithOnChange(onChange func(ctx context.Context) error) *LocalRegistryWatcher {
+	w.onChange = onChange
+	return w
+}
+
+// Start checks the local registry until the context is cancelled.
+func (w *LocalRegistryWatcher) Start(ctx context.Context) error {
+	w.log.Info("watching local registry for changes", "rootPath", w.rootPath)
+	wait.UntilWithContext(ctx, func(ctx context.Context) {
+		changed, err := w.Check()
+		if err != nil {
+			w.log.Error(err, "unable to check local registry for changes", "rootPath", w.rootPath)
+			return
+		}
+		if changed && w.onChange != nil {
+			if err := w.onChange(ctx); err != nil {
+				w.log.Error(err, "unable to handle change of local registry", "rootPath", w.rootPath)
+			}
+		}
+	}, w.interval)
+	return nil
+}
+
+// Check invalidates the caches if a file below the root path has been added, removed or modified
+// since the last check. It returns true if the caches have been invalidated.
+// The first check only records the current state of the local registry.
+func (w *LocalRegistryWatcher) Check() (bool, error) {
+	fingerprint, err := w.computeFingerprint()
+	if err != nil {
+		return false, err
+	}
+	if len(w.fingerprint) == 0 || fingerprint == w.fingerprint {
+		w.fingerprint = fingerprint
+		return false, nil
+	}
+
+	if err := w.invalidate(); err != nil {
+		return false, fmt.Errorf("unable to invalidate caches: %w", err)
+	}
+	w.fingerprint = fingerprint
+	w.log.Info("local registry has changed, invalidated cached blueprints and component versions", "rootPath", w.rootPath)
+	return true, nil
+}
+
+// computeFingerprint returns a hash over the paths, sizes and modification times of all files below the root path.
+func (w *LocalRegistryWatcher) computeFingerprint() (string, error) {
+	hash := sha256.New()
+	err := vfs.Walk(w.fs, w.rootPath, func(path string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+		_, _ = fmt.Fprintf(hash, "%s:%t:%d:%d\n", path, info.IsDir(), info.Size(), info.ModTime().UnixNano())
+		return nil
+	})
+	if err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(hash.Sum(nil)), nil
+}
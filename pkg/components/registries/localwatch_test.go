@@ -0,0 +1,55 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package registries
+
+import (
+	"github.com/mandelsoft/vfs/pkg/memoryfs"
+	"github.com/mandelsoft/vfs/pkg/vfs"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/gardener/landscaper/controller-utils/pkg/logging"
+)
+
+var _ = Describe("Local Registry Watcher", func() {
+
+	It("should invalidate the caches if a file of the local registry changes", func() {
+		fs := memoryfs.New()
+		Expect(fs.MkdirAll("/registry/blueprint", 0755)).To(Succeed())
+		Expect(vfs.WriteFile(fs, "/registry/blueprint/blueprint.yaml", []byte("kind: Blueprint"), 0644)).To(Succeed())
+
+		invalidations := 0
+		watcher := NewLocalRegistryWatcher(logging.Discard(), fs, "/registry")
+		watcher.invalidate = func() error {
+			invalidations++
+			return nil
+		}
+
+		changed, err := watcher.Check()
+		Expect(err).ToNot(HaveOccurred())
+		Expect(changed).To(BeFalse())
+
+		changed, err = watcher.Check()
+		Expect(err).ToNot(HaveOccurred())
+		Expect(changed).To(BeFalse())
+
+		Expect(vfs.WriteFile(fs, "/registry/blueprint/blueprint.yaml", []byte("kind: Blueprint\nimports: []"), 0644)).To(Succeed())
+		changed, err = watcher.Check()
+		Expect(err).ToNot(HaveOccurred())
+		Expect(changed).To(BeTrue())
+
+		Expect(vfs.WriteFile(fs, "/registry/blueprint/deploy.yaml", []byte("deployItems: []"), 0644)).To(Succeed())
+		changed, err = watcher.Check()
+		Expect(err).ToNot(HaveOccurred())
+		Expect(changed).To(BeTrue())
+		Expect(invalidations).To(Equal(2))
+	})
+
+	It("should fail if the root path does not exist", func() {
+		watcher := NewLocalRegistryWatcher(logging.Discard(), memoryfs.New(), "/registry")
+		_, err := watcher.Check()
+		Expect(err).To(HaveOccurred())
+	})
+})
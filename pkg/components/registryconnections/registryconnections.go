@@ -0,0 +1,206 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package registryconnections
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"net/http"
+	"net/url"
+	"sync"
+
+	"github.com/mandelsoft/vfs/pkg/vfs"
+	corev1 "k8s.io/api/core/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/gardener/landscaper/apis/config"
+)
+
+// Connection contains the resolved connection settings of a registry host.
+type Connection struct {
+	Host string
+	// Proxy is the url of the http proxy that is used to connect to the registry.
+	Proxy *url.URL
+	// CABundle contains the PEM encoded certificates that are trusted in addition to the system certificates.
+	CABundle []byte
+}
+
+var (
+	connectionsMux sync.RWMutex
+	connections    = map[string]Connection{}
+)
+
+// Load resolves the registry configurations of the oci configuration and keeps the connections for the process.
+// Certificate bundles are read from the file system or from secrets of the host cluster.
+// Previously loaded connections are replaced.
+func Load(ctx context.Context, fs vfs.FileSystem, kubeClient client.Client, cfg *config.OCIConfiguration) error {
+	loaded := map[string]Connection{}
+	if cfg != nil {
+		for _, registry := range cfg.Registries {
+			conn, err := resolve(ctx, fs, kubeClient, registry)
+			if err != nil {
+				return err
+			}
+			loaded[conn.Host] = conn
+		}
+	}
+
+	connectionsMux.Lock()
+	defer connectionsMux.Unlock()
+	connections = loaded
+	return nil
+}
+
+// List returns the loaded connections.
+func List() []Connection {
+	connectionsMux.RLock()
+	defer connectionsMux.RUnlock()
+	list := make([]Connection, 0, len(connections))
+	for _, conn := range connections {
+		list = append(list, conn)
+	}
+	return list
+}
+
+// Validate checks the registry configurations without reading the certificate bundles.
+func Validate(registries []config.OCIRegistryConfiguration) error {
+	hosts := map[string]bool{}
+	for _, registry := range registries {
+		if len(registry.Host) == 0 {
+			return fmt.Errorf("no host defined for registry configuration")
+		}
+		if hosts[registry.Host] {
+			return fmt.Errorf("duplicate registry configuration for host %q", registry.Host)
+		}
+		hosts[registry.Host] = true
+		if _, err := parseProxy(registry); err != nil {
+			return err
+		}
+		if registry.CABundle == nil {
+			continue
+		}
+		if (len(registry.CABundle.File) == 0) == (registry.CABundle.SecretRef == nil) {
+			return fmt.Errorf("exactly one of file and secretRef has to be defined for the ca bundle of host %q", registry.Host)
+		}
+		if ref := registry.CABundle.SecretRef; ref != nil && (len(ref.Name) == 0 || len(ref.Namespace) == 0 || len(ref.Key) == 0) {
+			return fmt.Errorf("name, namespace and key have to be defined for the ca bundle secret of host %q", registry.Host)
+		}
+	}
+	return nil
+}
+
+// NewTransport returns a transport that connects to the registry hosts of the loaded connections
+// with their proxy and certificate authorities.
+// Requests to other hosts use the settings of the default transport.
+func NewTransport(insecureSkipVerify bool) http.RoundTripper {
+	base := http.DefaultTransport.(*http.Transport).Clone()
+	if insecureSkipVerify {
+		base.TLSClientConfig = &tls.Config{InsecureSkipVerify: true}
+	}
+
+	t := &transport{
+		base:  base,
+		hosts: map[string]*http.Transport{},
+	}
+	for _, conn := range List() {
+		hostTransport := base.Clone()
+		if conn.Proxy != nil {
+			hostTransport.Proxy = http.ProxyURL(conn.Proxy)
+		}
+		if len(conn.CABundle) != 0 {
+			if hostTransport.TLSClientConfig == nil {
+				hostTransport.TLSClientConfig = &tls.Config{}
+			}
+			hostTransport.TLSClientConfig.RootCAs = certPool(conn.CABundle)
+		}
+		t.hosts[conn.Host] = hostTransport
+	}
+	return t
+}
+
+// transport selects the transport of a request by the host of the request url.
+type transport struct {
+	base  *http.Transport
+	hosts map[string]*http.Transport
+}
+
+func (t *transport) RoundTrip(req *http.Request) (*http.Response, error) {
+	if hostTransport, ok := t.hosts[req.URL.Host]; ok {
+		return hostTransport.RoundTrip(req)
+	}
+	if hostTransport, ok := t.hosts[req.URL.Hostname()]; ok {
+		return hostTransport.RoundTrip(req)
+	}
+	return t.base.RoundTrip(req)
+}
+
+func resolve(ctx context.Context, fs vfs.FileSystem, kubeClient client.Client, registry config.OCIRegistryConfiguration) (Connection, error) {
+	if err := Validate([]config.OCIRegistryConfiguration{registry}); err != nil {
+		return Connection{}, err
+	}
+	proxy, err := parseProxy(registry)
+	if err != nil {
+		return Connection{}, err
+	}
+	conn := Connection{
+		Host:  registry.Host,
+		Proxy: proxy,
+	}
+	if registry.CABundle == nil {
+		return conn, nil
+	}
+
+	if len(registry.CABundle.File) != 0 {
+		conn.CABundle, err = vfs.ReadFile(fs, registry.CABundle.File)
+		if err != nil {
+			return Connection{}, fmt.Errorf("unable to read ca bundle of host %q: %w", registry.Host, err)
+		}
+	} else {
+		ref := registry.CABundle.SecretRef
+		secret := &corev1.Secret{}
+		if err := kubeClient.Get(ctx, client.ObjectKey{Name: ref.Name, Namespace: ref.Namespace}, secret); err != nil {
+			return Connection{}, fmt.Errorf("unable to get ca bundle secret %s/%s of host %q: %w", ref.Namespace, ref.Name, registry.Host, err)
+		}
+		data, ok := secret.Data[ref.Key]
+		if !ok {
+			return Connection{}, fmt.Errorf("key %q not found in ca bundle secret %s/%s of host %q", ref.Key, ref.Namespace, ref.Name, registry.Host)
+		}
+		conn.CABundle = data
+	}
+
+	if !x509.NewCertPool().AppendCertsFromPEM(conn.CABundle) {
+		return Connection{}, fmt.Errorf("ca bundle of host %q does not contain a PEM encoded certificate", registry.Host)
+	}
+	return conn, nil
+}
+
+func parseProxy(registry config.OCIRegistryConfiguration) (*url.URL, error) {
+	if len(registry.Proxy) == 0 {
+		return nil, nil
+	}
+	proxy, err := url.Parse(registry.Proxy)
+	if err != nil {
+		return nil, fmt.Errorf("invalid proxy of host %q: %w", registry.Host, err)
+	}
+	if proxy.Scheme != "http" && proxy.Scheme != "https" {
+		return nil, fmt.Errorf("invalid proxy of host %q: scheme has to be http or https", registry.Host)
+	}
+	if len(proxy.Host) == 0 {
+		return nil, fmt.Errorf("invalid proxy of host %q: no host defined", registry.Host)
+	}
+	return proxy, nil
+}
+
+// certPool returns the system certificate pool extended by the given PEM encoded certificates.
+func certPool(caBundle []byte) *x509.CertPool {
+	pool, err := x509.SystemCertPool()
+	if err != nil || pool == nil {
+		pool = x509.NewCertPool()
+	}
+	pool.AppendCertsFromPEM(caBundle)
+	return pool
+}
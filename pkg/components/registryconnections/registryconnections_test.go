@@ -0,0 +1,208 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package registryconnections
+
+import (
+	"context"
+	"encoding/pem"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+
+	"github.com/mandelsoft/vfs/pkg/memoryfs"
+	"github.com/mandelsoft/vfs/pkg/vfs"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	"github.com/gardener/landscaper/apis/config"
+	lscore "github.com/gardener/landscaper/apis/core"
+	"github.com/gardener/landscaper/pkg/api"
+)
+
+var _ = Describe("Registry Connections", func() {
+
+	var (
+		ctx        context.Context
+		fs         vfs.FileSystem
+		kubeClient client.Client
+		server     *httptest.Server
+		caBundle   []byte
+	)
+
+	BeforeEach(func() {
+		ctx = context.Background()
+		fs = memoryfs.New()
+		kubeClient = fake.NewClientBuilder().WithScheme(api.LandscaperScheme).Build()
+		server = httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+			w.WriteHeader(http.StatusOK)
+		}))
+		caBundle = pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: server.Certificate().Raw})
+	})
+
+	AfterEach(func() {
+		server.Close()
+		Expect(Load(ctx, fs, kubeClient, nil)).To(Succeed())
+	})
+
+	Context("Validate", func() {
+
+		It("should accept a registry with a proxy and a ca bundle file", func() {
+			Expect(Validate([]config.OCIRegistryConfiguration{{
+				Host:     "registry.example.com:5000",
+				Proxy:    "http://proxy.example.com:3128",
+				CABundle: &config.OCICABundleReference{File: "/etc/ssl/registry.pem"},
+			}})).To(Succeed())
+		})
+
+		It("should reject a registry without host", func() {
+			Expect(Validate([]config.OCIRegistryConfiguration{{Proxy: "http://proxy.example.com"}})).To(HaveOccurred())
+		})
+
+		It("should reject duplicate hosts", func() {
+			Expect(Validate([]config.OCIRegistryConfiguration{
+				{Host: "registry.example.com"},
+				{Host: "registry.example.com"},
+			})).To(HaveOccurred())
+		})
+
+		It("should reject a proxy without http or https scheme", func() {
+			Expect(Validate([]config.OCIRegistryConfiguration{{
+				Host:  "registry.example.com",
+				Proxy: "ftp://proxy.example.com",
+			}})).To(HaveOccurred())
+		})
+
+		It("should reject a ca bundle with file and secret reference", func() {
+			Expect(Validate([]config.OCIRegistryConfiguration{{
+				Host: "registry.example.com",
+				CABundle: &config.OCICABundleReference{
+					File:      "/etc/ssl/registry.pem",
+					SecretRef: &lscore.SecretReference{ObjectReference: lscore.ObjectReference{Name: "ca", Namespace: "ls-system"}, Key: "ca.crt"},
+				},
+			}})).To(HaveOccurred())
+		})
+
+		It("should reject a ca bundle secret reference without key", func() {
+			Expect(Validate([]config.OCIRegistryConfiguration{{
+				Host: "registry.example.com",
+				CABundle: &config.OCICABundleReference{
+					SecretRef: &lscore.SecretReference{ObjectReference: lscore.ObjectReference{Name: "ca", Namespace: "ls-system"}},
+				},
+			}})).To(HaveOccurred())
+		})
+	})
+
+	Context("Load", func() {
+
+		It("should read the ca bundle from a file", func() {
+			Expect(vfs.WriteFile(fs, "/ca.pem", caBundle, 0644)).To(Succeed())
+			Expect(Load(ctx, fs, kubeClient, &config.OCIConfiguration{
+				Registries: []config.OCIRegistryConfiguration{{
+					Host:     "registry.example.com",
+					CABundle: &config.OCICABundleReference{File: "/ca.pem"},
+				}},
+			})).To(Succeed())
+
+			Expect(List()).To(ConsistOf(Connection{Host: "registry.example.com", CABundle: caBundle}))
+		})
+
+		It("should read the ca bundle from a secret", func() {
+			Expect(kubeClient.Create(ctx, &corev1.Secret{
+				ObjectMeta: metav1.ObjectMeta{Name: "ca", Namespace: "ls-system"},
+				Data:       map[string][]byte{"ca.crt": caBundle},
+			})).To(Succeed())
+			Expect(Load(ctx, fs, kubeClient, &config.OCIConfiguration{
+				Registries: []config.OCIRegistryConfiguration{{
+					Host:  "registry.example.com",
+					Proxy: "http://proxy.example.com:3128",
+					CABundle: &config.OCICABundleReference{
+						SecretRef: &lscore.SecretReference{ObjectReference: lscore.ObjectReference{Name: "ca", Namespace: "ls-system"}, Key: "ca.crt"},
+					},
+				}},
+			})).To(Succeed())
+
+			proxy, err := url.Parse("http://proxy.example.com:3128")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(List()).To(ConsistOf(Connection{Host: "registry.example.com", Proxy: proxy, CABundle: caBundle}))
+		})
+
+		It("should fail if the secret does not contain the key", func() {
+			Expect(kubeClient.Create(ctx, &corev1.Secret{
+				ObjectMeta: metav1.ObjectMeta{Name: "ca", Namespace: "ls-system"},
+				Data:       map[string][]byte{"other": caBundle},
+			})).To(Succeed())
+			Expect(Load(ctx, fs, kubeClient, &config.OCIConfiguration{
+				Registries: []config.OCIRegistryConfiguration{{
+					Host: "registry.example.com",
+					CABundle: &config.OCICABundleReference{
+						SecretRef: &lscore.SecretReference{ObjectReference: lscore.ObjectReference{Name: "ca", Namespace: "ls-system"}, Key: "ca.crt"},
+					},
+				}},
+			})).To(HaveOccurred())
+		})
+
+		It("should fail if the ca bundle contains no certificate", func() {
+			Expect(vfs.WriteFile(fs, "/ca.pem", []byte("no certificate"), 0644)).To(Succeed())
+			Expect(Load(ctx, fs, kubeClient, &config.OCIConfiguration{
+				Registries: []config.OCIRegistryConfiguration{{
+					Host:     "registry.example.com",
+					CABundle: &config.OCICABundleReference{File: "/ca.pem"},
+				}},
+			})).To(HaveOccurred())
+		})
+	})
+
+	Context("NewTransport", func() {
+
+		It("should trust the ca bundle of the registry host", func() {
+			serverURL, err := url.Parse(server.URL)
+			Expect(err).ToNot(HaveOccurred())
+
+			httpClient := &http.Client{Transport: NewTransport(false)}
+			_, err = httpClient.Get(server.URL)
+			Expect(err).To(HaveOccurred())
+
+			Expect(vfs.WriteFile(fs, "/ca.pem", caBundle, 0644)).To(Succeed())
+			Expect(Load(ctx, fs, kubeClient, &config.OCIConfiguration{
+				Registries: []config.OCIRegistryConfiguration{{
+					Host:     serverURL.Host,
+					CABundle: &config.OCICABundleReference{File: "/ca.pem"},
+				}},
+			})).To(Succeed())
+
+			httpClient = &http.Client{Transport: NewTransport(false)}
+			res, err := httpClient.Get(server.URL)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(res.Body.Close()).To(Succeed())
+			Expect(res.StatusCode).To(Equal(http.StatusOK))
+		})
+
+		It("should connect to the registry host via its proxy", func() {
+			var proxiedHosts []string
+			proxy := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				proxiedHosts = append(proxiedHosts, r.URL.Host)
+				w.WriteHeader(http.StatusOK)
+			}))
+			defer proxy.Close()
+
+			Expect(Load(ctx, fs, kubeClient, &config.OCIConfiguration{
+				Registries: []config.OCIRegistryConfiguration{{
+					Host:  "registry.example.com",
+					Proxy: proxy.URL,
+				}},
+			})).To(Succeed())
+
+			httpClient := &http.Client{Transport: NewTransport(false)}
+			res, err := httpClient.Get("http://registry.example.com/v2/")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(res.Body.Close()).To(Succeed())
+			Expect(proxiedHosts).To(ConsistOf("registry.example.com"))
+		})
+	})
+})
@@ -8,6 +8,8 @@ import (
 	"context"
 	"fmt"
 
+	cdv2 "github.com/gardener/component-spec/bindings-go/apis/v2"
+
 	lsv1alpha1 "github.com/gardener/landscaper/apis/core/v1alpha1"
 	"github.com/gardener/landscaper/pkg/components/model"
 	"github.com/gardener/landscaper/pkg/components/model/types"
@@ -44,6 +46,17 @@ func (t *TestRegistryAccess) GetComponentVersion(ctx context.Context, cdRef *lsv
 	return nil, fmt.Errorf("component not found: %v", cdRef)
 }
 
+func (t *TestRegistryAccess) ListComponentVersions(ctx context.Context, repositoryContext *cdv2.UnstructuredTypedObject, componentName string) ([]string, error) {
+	versions := []string{}
+	for i := range t.componentDescriptors {
+		cd := &t.componentDescriptors[i]
+		if cd.GetName() == componentName {
+			versions = append(versions, cd.GetVersion())
+		}
+	}
+	return versions, nil
+}
+
 func (r *TestRegistryAccess) VerifySignature(componentVersion model.ComponentVersion, name string, pkeyData []byte, caCertData []byte) error {
 	return nil
 }
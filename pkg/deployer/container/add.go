@@ -8,6 +8,7 @@ import (
 	"context"
 	"fmt"
 
+	"k8s.io/client-go/kubernetes"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/controller"
 	"sigs.k8s.io/controller-runtime/pkg/manager"
@@ -38,8 +39,19 @@ func AddControllerToManager(lsUncachedClient, lsCachedClient, hostUncachedClient
 	}
 	log.Info("access to critical problems allowed")
 
+	// a clientset is required to read the logs of failed pods
+	var hostClientset kubernetes.Interface
+	if config.FailureLogs != nil {
+		clientset, err := kubernetes.NewForConfig(hostMgr.GetConfig())
+		if err != nil {
+			return nil, fmt.Errorf("unable to create clientset for the host cluster: %w", err)
+		}
+		hostClientset = clientset
+	}
+
 	containerDeployer, err := NewDeployer(
 		lsUncachedClient, lsCachedClient, hostUncachedClient, hostCachedClient,
+		hostClientset,
 		log,
 		config)
 	if err != nil {
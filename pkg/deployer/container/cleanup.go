@@ -105,6 +105,7 @@ func CleanupDeployItem(ctx context.Context, deployItem *lsv1alpha1.DeployItem, l
 		ConfigurationSecretName(deployItem.Namespace, deployItem.Name),
 		ExportSecretName(deployItem.Namespace, deployItem.Name),
 		ImagePullSecretName(deployItem.Namespace, deployItem.Name),
+		ContextImagePullSecretName(deployItem.Namespace, deployItem.Name),
 		ComponentDescriptorPullSecretName(deployItem.Namespace, deployItem.Name),
 		BluePrintPullSecretName(deployItem.Namespace, deployItem.Name),
 	}
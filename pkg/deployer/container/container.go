@@ -8,6 +8,7 @@ import (
 	"github.com/gardener/component-cli/ociclient/cache"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/kubernetes"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	lserrors "github.com/gardener/landscaper/apis/errors"
@@ -45,6 +46,8 @@ type Container struct {
 	lsCachedClient     client.Client
 	hostUncachedClient client.Client
 	hostCachedClient   client.Client
+	// hostClientset is only set if the logs of failed pods are collected.
+	hostClientset kubernetes.Interface
 
 	Configuration containerv1alpha1.Configuration
 
@@ -62,6 +65,7 @@ type Container struct {
 
 // New creates a new internal container item
 func New(lsUncachedClient, lsCachedClient, hostUncachedClient, hostCachedClient client.Client,
+	hostClientset kubernetes.Interface,
 	config containerv1alpha1.Configuration,
 	item *lsv1alpha1.DeployItem,
 	lsCtx *lsv1alpha1.Context,
@@ -84,6 +88,11 @@ func New(lsUncachedClient, lsCachedClient, hostUncachedClient, hostCachedClient
 			currOp, "ValidateProviderConfiguration", err.Error(), lsv1alpha1.ErrorConfigurationProblem)
 	}
 
+	if err := ValidateServiceAccount(config, providerConfig); err != nil {
+		return nil, lserrors.NewWrappedError(err,
+			currOp, "ValidateServiceAccount", err.Error(), lsv1alpha1.ErrorConfigurationProblem)
+	}
+
 	status, err := DecodeProviderStatus(item.Status.ProviderStatus)
 	if err != nil {
 		return nil, lserrors.NewWrappedError(err,
@@ -95,6 +104,7 @@ func New(lsUncachedClient, lsCachedClient, hostUncachedClient, hostCachedClient
 		lsCachedClient:        lsCachedClient,
 		hostUncachedClient:    hostUncachedClient,
 		hostCachedClient:      hostCachedClient,
+		hostClientset:         hostClientset,
 		Configuration:         config,
 		DeployItem:            item,
 		Context:               lsCtx,
@@ -0,0 +1,37 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package container
+
+import (
+	"context"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+
+	lserrors "github.com/gardener/landscaper/apis/errors"
+	kutil "github.com/gardener/landscaper/controller-utils/pkg/kubernetes"
+	"github.com/gardener/landscaper/controller-utils/pkg/logging"
+	lc "github.com/gardener/landscaper/controller-utils/pkg/logging/constants"
+)
+
+// Abort kills the running pod of the deploy item.
+// The pod is deleted even if the debug options are configured to keep it, as it would continue to run otherwise.
+func (c *Container) Abort(ctx context.Context) error {
+	logger, ctx := logging.FromContextOrNew(ctx, []interface{}{lc.KeyMethod, "Abort"})
+
+	pod, err := c.getPod(ctx)
+	if err != nil {
+		if apierrors.IsNotFound(err) {
+			return nil
+		}
+		return lserrors.NewWrappedError(err, "Abort", "FetchRunningPod", err.Error())
+	}
+	if pod.Status.Phase == corev1.PodSucceeded || pod.Status.Phase == corev1.PodFailed {
+		return nil
+	}
+
+	logger.Info("killing running pod", lc.KeyResource, kutil.ObjectKeyFromObject(pod).String())
+	return CleanupPod(ctx, c.hostUncachedClient, pod, false)
+}
@@ -71,6 +71,7 @@ func (c *Container) Reconcile(ctx context.Context, operation container.Operation
 			// check if pod is in error state
 			if err := podIsInErrorState(pod); err != nil {
 				lsv1alpha1helper.SetDeployItemToFailed(c.DeployItem)
+				c.collectFailureLogs(ctx, pod)
 				if err := lsWriter.UpdateDeployItemStatus(ctx, read_write_layer.W000055, c.DeployItem); err != nil {
 					return err // returns the error and retry
 				}
@@ -118,6 +119,11 @@ func (c *Container) Reconcile(ctx context.Context, operation container.Operation
 			return lserrors.NewWrappedError(err,
 				operationName, "ParseAndSyncSecrets", err.Error())
 		}
+		contextImagePullSecret, err := c.syncContextImagePullSecret(ctx, defaultLabels)
+		if err != nil {
+			return lserrors.NewWrappedError(err,
+				operationName, "SyncContextImagePullSecret", err.Error())
+		}
 		// ensure new pod
 		serviceAccountSecrets, err := EnsureServiceAccounts(ctx, c.hostUncachedClient, c.DeployItem, c.Configuration.Namespace, defaultLabels)
 		if err != nil {
@@ -139,12 +145,17 @@ func (c *Container) Reconcile(ctx context.Context, operation container.Operation
 			TargetSecretName:                  TargetSecretName(c.DeployItem.Namespace, c.DeployItem.Name),
 
 			ImagePullSecret:               imagePullSecret,
+			ContextImagePullSecret:        contextImagePullSecret,
 			BluePrintPullSecret:           blueprintSecret,
 			ComponentDescriptorPullSecret: componentDescriptorSecret,
 
 			OCMConfigConfigMapName: OCMConfigConfigMapName(c.DeployItem.Namespace, c.DeployItem.Name),
 			UseOCM:                 c.Context.UseOCM,
 
+			StateEncryptionSecretName: stateEncryptionSecretName(c.Configuration),
+
+			Scheduling: lib.GetContextScheduling(c.Context),
+
 			Name:                 c.DeployItem.Name,
 			Namespace:            c.Configuration.Namespace,
 			DeployItemName:       c.DeployItem.Name,
@@ -207,6 +218,9 @@ func (c *Container) Reconcile(ctx context.Context, operation container.Operation
 			return lserrors.NewWrappedError(err,
 				"Reconcile", "UpdatePodStatus", err.Error())
 		}
+		if pod.Status.Phase == corev1.PodFailed {
+			c.collectFailureLogs(ctx, pod)
+		}
 
 		// write status to ensure podStatus is saved before deleting the pod
 		if err := lsWriter.UpdateDeployItemStatus(ctx, read_write_layer.W000031, c.DeployItem); err != nil {
@@ -229,6 +243,7 @@ func (c *Container) Reconcile(ctx context.Context, operation container.Operation
 // collectAndSetPodStatus the pod status and updates the container provider status
 func (c *Container) collectAndSetPodStatus(pod *corev1.Pod, updateLastSuccessfulJobID bool) error {
 	c.DeployItem.Status.Conditions = setConditionsFromPod(pod, c.DeployItem.Status.Conditions)
+	setProgressFromPod(c.DeployItem, pod)
 	var jobID *string
 	if updateLastSuccessfulJobID {
 		jobID = ptr.To[string](c.DeployItem.Status.JobID)
@@ -302,6 +317,30 @@ func setStatusFromPod(pod *corev1.Pod, providerStatus *containerv1alpha1.Provide
 	return nil
 }
 
+// setProgressFromPod derives the progress of the deploy item from the states of the containers of the pod.
+// The progress is not changed for a failed pod, so that it shows the step in which the pod has failed.
+func setProgressFromPod(di *lsv1alpha1.DeployItem, pod *corev1.Pod) {
+	switch pod.Status.Phase {
+	case corev1.PodSucceeded:
+		lib.SetProgress(di, 100, "Completed", "The pod has successfully finished")
+		return
+	case corev1.PodFailed:
+		return
+	}
+
+	if initStatus, err := kutil.GetStatusForContainer(pod.Status.InitContainerStatuses, container.InitContainerName); err != nil ||
+		initStatus.State.Terminated == nil {
+		lib.SetProgress(di, 10, "Init", "The init container prepares the imports, the blueprint and the component descriptor")
+		return
+	}
+	if mainStatus, err := kutil.GetStatusForContainer(pod.Status.ContainerStatuses, container.MainContainerName); err != nil ||
+		mainStatus.State.Terminated == nil {
+		lib.SetProgress(di, 30, "Main", "The main container is running")
+		return
+	}
+	lib.SetProgress(di, 90, "Wait", "The wait container collects the exports and the state")
+}
+
 // convertCoreContainerStatusToV1alpha1Container converts a kubernetes container status into a container deployer container status.
 func convertCoreContainerStatusToV1alpha1Container(containerStatus corev1.ContainerStatus) containerv1alpha1.ContainerStatus {
 	cs := containerv1alpha1.ContainerStatus{}
@@ -498,6 +537,38 @@ func (c *Container) syncSecrets(ctx context.Context,
 	return authSecret.Name, nil
 }
 
+// syncContextImagePullSecret creates or updates an image pull secret in the host cluster that contains the credentials
+// of the image pull secrets of the context. An empty name is returned if the context defines no image pull secrets.
+func (c *Container) syncContextImagePullSecret(ctx context.Context, defaultLabels map[string]string) (string, error) {
+	dockerConfig, err := lib.GetContextImagePullSecretsDockerConfig(ctx, c.lsUncachedClient, c.Context)
+	if err != nil {
+		return "", err
+	}
+
+	secret := &corev1.Secret{}
+	secret.Name = ContextImagePullSecretName(c.DeployItem.Namespace, c.DeployItem.Name)
+	secret.Namespace = c.Configuration.Namespace
+	if dockerConfig == nil {
+		if err := c.hostUncachedClient.Delete(ctx, secret); err != nil && !apierrors.IsNotFound(err) {
+			return "", fmt.Errorf("unable to delete context image pull secret from host cluster: %w", err)
+		}
+		return "", nil
+	}
+
+	secret.Type = corev1.SecretTypeDockerConfigJson
+	if _, err := controllerutil.CreateOrUpdate(ctx, c.hostUncachedClient, secret, func() error {
+		InjectDefaultLabels(secret, defaultLabels)
+		kutil.SetMetaDataLabel(&secret.ObjectMeta, container.ContainerDeployerTypeLabel, "registry-pull-secret")
+		secret.Data = map[string][]byte{
+			corev1.DockerConfigJsonKey: dockerConfig,
+		}
+		return nil
+	}); err != nil {
+		return "", fmt.Errorf("unable to sync context image pull secret to host cluster: %w", err)
+	}
+	return secret.Name, nil
+}
+
 // parseAndSyncSecrets parses and synchronizes relevant pull secrets for container image, blueprint & component descriptor secrets from the landscaper and host cluster.
 func (c *Container) parseAndSyncSecrets(ctx context.Context, defaultLabels map[string]string) (imagePullSecret, blueprintSecret, componentDescriptorSecret string, erro error) {
 	log, ctx := logging.FromContextOrNew(ctx, nil)
@@ -42,6 +42,7 @@ import (
 	"github.com/gardener/landscaper/pkg/deployer/container/state"
 	"github.com/gardener/landscaper/pkg/landscaper/blueprints"
 	"github.com/gardener/landscaper/pkg/utils"
+	"github.com/gardener/landscaper/pkg/utils/encryption"
 )
 
 // Run downloads the import config, the component descriptor and the blob content
@@ -216,7 +217,15 @@ func run(ctx context.Context, opts *options, kubeClient client.Client, fs vfs.Fi
 	log.Info("Copied target content to shared volume.")
 
 	log.Info("Restoring state")
-	if err := state.New(kubeClient, opts.podNamespace, opts.DeployItemKey, opts.StateDirPath).WithFs(fs).Restore(ctx); err != nil {
+	stateHandler := state.New(kubeClient, opts.podNamespace, opts.DeployItemKey, opts.StateDirPath).WithFs(fs)
+	if len(opts.EncryptionConfigFilePath) != 0 {
+		encryptor, err := encryption.NewFromFile(opts.EncryptionConfigFilePath)
+		if err != nil {
+			return err
+		}
+		stateHandler.WithEncryptor(encryptor)
+	}
+	if err := stateHandler.Restore(ctx); err != nil {
 		return err
 	}
 	log.Info("State has been successfully restored")
@@ -31,6 +31,7 @@ type options struct {
 	StateDirPath                string
 	RegistrySecretBasePath      string
 	OCMConfigFilePath           string
+	EncryptionConfigFilePath    string
 
 	UseOCM bool
 
@@ -53,6 +54,7 @@ func (o *options) Complete() {
 	o.StateDirPath = os.Getenv(container.StatePathName)
 	o.RegistrySecretBasePath = os.Getenv(container.RegistrySecretBasePathName)
 	o.OCMConfigFilePath = os.Getenv(container.OCMConfigPathName)
+	o.EncryptionConfigFilePath = os.Getenv(container.EncryptionConfigPathName)
 
 	o.UseOCM = os.Getenv(container.UseOCMName) == "true"
 
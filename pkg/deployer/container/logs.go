@@ -0,0 +1,64 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package container
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/client-go/kubernetes"
+
+	"github.com/gardener/landscaper/apis/deployer/container"
+	"github.com/gardener/landscaper/controller-utils/pkg/logging"
+	lc "github.com/gardener/landscaper/controller-utils/pkg/logging/constants"
+)
+
+// MaxFailureLogsBytes limits the size of the logs that are written to the provider status of a deploy item.
+const MaxFailureLogsBytes = 16 * 1024
+
+// collectFailureLogs writes the last lines of the logs of the main container of a failed pod
+// to the provider status, if this is enabled in the deployer configuration.
+// Errors are only logged, as the failure of the deploy item is reported anyway.
+func (c *Container) collectFailureLogs(ctx context.Context, pod *corev1.Pod) {
+	if c.hostClientset == nil || c.Configuration.FailureLogs == nil || c.ProviderStatus == nil || c.ProviderStatus.PodStatus == nil {
+		return
+	}
+	logger, ctx := logging.FromContextOrNew(ctx, nil, lc.KeyMethod, "collectFailureLogs")
+
+	logs, err := GetContainerLogs(ctx, c.hostClientset, pod, container.MainContainerName,
+		c.Configuration.FailureLogs.TailLines, MaxFailureLogsBytes)
+	if err != nil {
+		logger.Info("Unable to read the logs of the failed pod", lc.KeyResource, pod.Name, lc.KeyError, err.Error())
+		return
+	}
+	c.ProviderStatus.PodStatus.ContainerStatus.Logs = logs
+}
+
+// GetContainerLogs returns the last lines of the logs of a container of the given pod.
+// If the logs exceed the given number of bytes, only the last complete lines that fit are returned.
+func GetContainerLogs(ctx context.Context, clientset kubernetes.Interface, pod *corev1.Pod, containerName string,
+	tailLines int64, maxBytes int) (string, error) {
+
+	opts := &corev1.PodLogOptions{
+		Container: containerName,
+		TailLines: &tailLines,
+	}
+	data, err := clientset.CoreV1().Pods(pod.Namespace).GetLogs(pod.Name, opts).DoRaw(ctx)
+	if err != nil {
+		return "", fmt.Errorf("unable to get logs of container %q of pod %s/%s: %w", containerName, pod.Namespace, pod.Name, err)
+	}
+
+	logs := string(data)
+	if maxBytes > 0 && len(logs) > maxBytes {
+		logs = logs[len(logs)-maxBytes:]
+		// drop the incomplete first line
+		if i := strings.Index(logs, "\n"); i >= 0 && i < len(logs)-1 {
+			logs = logs[i+1:]
+		}
+	}
+	return logs, nil
+}
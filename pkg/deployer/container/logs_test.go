@@ -0,0 +1,40 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package container_test
+
+import (
+	"context"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+
+	"github.com/gardener/landscaper/apis/deployer/container"
+	containerctlr "github.com/gardener/landscaper/pkg/deployer/container"
+)
+
+var _ = Describe("Container logs", func() {
+
+	pod := &corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{Name: "test", Namespace: "default"},
+	}
+
+	It("should read the logs of the container", func() {
+		clientset := fake.NewSimpleClientset(pod)
+		logs, err := containerctlr.GetContainerLogs(context.Background(), clientset, pod, container.MainContainerName, 50, containerctlr.MaxFailureLogsBytes)
+		Expect(err).ToNot(HaveOccurred())
+		// the fake clientset always returns the same logs
+		Expect(logs).To(Equal("fake logs"))
+	})
+
+	It("should keep the end of the logs if they are too large", func() {
+		clientset := fake.NewSimpleClientset(pod)
+		logs, err := containerctlr.GetContainerLogs(context.Background(), clientset, pod, container.MainContainerName, 50, 4)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(logs).To(Equal("logs"))
+	})
+})
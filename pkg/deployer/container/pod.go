@@ -8,6 +8,7 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"maps"
 	"path/filepath"
 	"strconv"
 
@@ -29,6 +30,7 @@ import (
 	containerv1alpha1 "github.com/gardener/landscaper/apis/deployer/container/v1alpha1"
 	kutil "github.com/gardener/landscaper/controller-utils/pkg/kubernetes"
 	"github.com/gardener/landscaper/controller-utils/pkg/logging"
+	"github.com/gardener/landscaper/pkg/deployer/lib"
 )
 
 // PodTokenPath is the path in the pod that contains the service account token.
@@ -78,6 +80,12 @@ func ImagePullSecretName(deployItemNamespace, deployItemName string) string {
 	return fmt.Sprintf("%s-%s-imgpullsec", deployItemNamespace, deployItemName)
 }
 
+// ContextImagePullSecretName generates the secret name for the image pull secret that contains
+// the credentials of the image pull secrets of the context.
+func ContextImagePullSecretName(deployItemNamespace, deployItemName string) string {
+	return fmt.Sprintf("%s-%s-ctxpullsec", deployItemNamespace, deployItemName)
+}
+
 // BluePrintPullSecretName generates the secret name for the image pull secret.
 // todo: use container identity
 func BluePrintPullSecretName(deployItemNamespace, deployItemName string) string {
@@ -124,12 +132,20 @@ type PodOptions struct {
 	ConfigurationSecretName           string
 	TargetSecretName                  string
 	ImagePullSecret                   string
+	ContextImagePullSecret            string
 	BluePrintPullSecret               string
 	ComponentDescriptorPullSecret     string
 
 	OCMConfigConfigMapName string
 	UseOCM                 bool
 
+	// StateEncryptionSecretName is the name of the secret with the encryption configuration of the state.
+	// The state is not encrypted if the name is empty.
+	StateEncryptionSecretName string
+
+	// Scheduling contains the scheduling defaults of the context that are applied to the pod.
+	Scheduling *lsv1alpha1.SchedulingConfiguration
+
 	Name                 string
 	Namespace            string
 	DeployItemName       string
@@ -154,15 +170,28 @@ func (o *PodOptions) Complete() error {
 	return nil
 }
 
+// stateEncryptionSecretName returns the name of the secret with the encryption configuration of the state
+// or an empty string if the state is not encrypted.
+func stateEncryptionSecretName(config containerv1alpha1.Configuration) string {
+	if config.StateEncryption == nil {
+		return ""
+	}
+	return config.StateEncryption.SecretName
+}
+
 func generatePod(opts PodOptions) (*corev1.Pod, error) {
 	if err := opts.Complete(); err != nil {
 		return nil, err
 	}
 
+	// the shared volume contains the target with the credentials of the target cluster,
+	// therefore it is backed by memory, so that the credentials are never written to the disk of the node.
 	sharedVolume := corev1.Volume{
 		Name: "shared-volume",
 		VolumeSource: corev1.VolumeSource{
-			EmptyDir: &corev1.EmptyDirVolumeSource{},
+			EmptyDir: &corev1.EmptyDirVolumeSource{
+				Medium: corev1.StorageMediumMemory,
+			},
 		},
 	}
 	sharedVolumeMount := corev1.VolumeMount{
@@ -319,6 +348,33 @@ func generatePod(opts PodOptions) (*corev1.Pod, error) {
 		})
 	}
 
+	waitMounts := []corev1.VolumeMount{waitServiceAccountMount, sharedVolumeMount}
+
+	if len(opts.StateEncryptionSecretName) != 0 {
+		encryptionVolume := corev1.Volume{
+			Name: "state-encryption",
+			VolumeSource: corev1.VolumeSource{
+				Secret: &corev1.SecretVolumeSource{
+					SecretName: opts.StateEncryptionSecretName,
+				},
+			},
+		}
+		encryptionVolumeMount := corev1.VolumeMount{
+			Name:      encryptionVolume.Name,
+			ReadOnly:  true,
+			MountPath: container.EncryptionDir,
+		}
+		encryptionEnvVar := corev1.EnvVar{
+			Name:  container.EncryptionConfigPathName,
+			Value: container.EncryptionConfigPath,
+		}
+		volumes = append(volumes, encryptionVolume)
+		initMounts = append(initMounts, encryptionVolumeMount)
+		waitMounts = append(waitMounts, encryptionVolumeMount)
+		additionalInitEnvVars = append(additionalInitEnvVars, encryptionEnvVar)
+		additionalSidecarEnvVars = append(additionalSidecarEnvVars, encryptionEnvVar)
+	}
+
 	initContainer := corev1.Container{
 		Name:                     container.InitContainerName,
 		Image:                    opts.InitContainer.Image,
@@ -340,10 +396,7 @@ func generatePod(opts PodOptions) (*corev1.Pod, error) {
 		Resources:                corev1.ResourceRequirements{},
 		TerminationMessagePolicy: corev1.TerminationMessageFallbackToLogsOnError,
 		ImagePullPolicy:          opts.WaitContainer.ImagePullPolicy,
-		VolumeMounts: []corev1.VolumeMount{
-			waitServiceAccountMount,
-			sharedVolumeMount,
-		},
+		VolumeMounts:             waitMounts,
 	}
 
 	mainContainer := corev1.Container{
@@ -357,6 +410,9 @@ func generatePod(opts PodOptions) (*corev1.Pod, error) {
 		ImagePullPolicy:          corev1.PullIfNotPresent,
 		VolumeMounts:             []corev1.VolumeMount{sharedVolumeMount},
 	}
+	if opts.ProviderConfiguration.Resources != nil {
+		mainContainer.Resources = *opts.ProviderConfiguration.Resources.DeepCopy()
+	}
 
 	if opts.Debug {
 		initContainer.ImagePullPolicy = corev1.PullAlways
@@ -366,6 +422,12 @@ func generatePod(opts PodOptions) (*corev1.Pod, error) {
 	pod := &corev1.Pod{}
 	pod.GenerateName = opts.Name + "-"
 	pod.Namespace = opts.Namespace
+	podConfig := opts.ProviderConfiguration.Pod
+	if podConfig != nil {
+		// the default labels are injected afterwards, so that they cannot be overwritten.
+		pod.Labels = maps.Clone(podConfig.Labels)
+		pod.Annotations = maps.Clone(podConfig.Annotations)
+	}
 	InjectDefaultLabels(pod, DefaultLabels(opts.DeployerID, opts.Name, opts.DeployItemName, opts.DeployItemNamespace))
 	pod.Labels[container.ContainerDeployerDeployItemGenerationLabel] = strconv.Itoa(int(opts.DeployItemGeneration))
 	pod.Finalizers = []string{container.ContainerDeployerFinalizer}
@@ -382,15 +444,46 @@ func generatePod(opts PodOptions) (*corev1.Pod, error) {
 	pod.Spec.InitContainers = []corev1.Container{initContainer}
 	pod.Spec.Containers = []corev1.Container{mainContainer, waitContainer}
 	if len(opts.ImagePullSecret) != 0 {
-		pod.Spec.ImagePullSecrets = []corev1.LocalObjectReference{
-			{
-				Name: opts.ImagePullSecret,
-			},
+		pod.Spec.ImagePullSecrets = append(pod.Spec.ImagePullSecrets, corev1.LocalObjectReference{
+			Name: opts.ImagePullSecret,
+		})
+	}
+	if len(opts.ContextImagePullSecret) != 0 {
+		pod.Spec.ImagePullSecrets = append(pod.Spec.ImagePullSecrets, corev1.LocalObjectReference{
+			Name: opts.ContextImagePullSecret,
+		})
+	}
+	if podConfig != nil {
+		pod.Spec.NodeSelector = maps.Clone(podConfig.NodeSelector)
+		for i := range podConfig.Tolerations {
+			pod.Spec.Tolerations = append(pod.Spec.Tolerations, *podConfig.Tolerations[i].DeepCopy())
+		}
+		pod.Spec.Affinity = podConfig.Affinity.DeepCopy()
+		if len(podConfig.ServiceAccountName) != 0 {
+			// the init and wait containers mount the tokens of their own service accounts at the default path,
+			// so that only the main container gets the token of this service account.
+			pod.Spec.ServiceAccountName = podConfig.ServiceAccountName
+			pod.Spec.AutomountServiceAccountToken = ptr.To[bool](true)
 		}
 	}
+	lib.ApplySchedulingDefaults(&pod.Spec, opts.Scheduling)
 	return pod, nil
 }
 
+// ValidateServiceAccount validates that the service account of the pod configuration is allowed
+// by the deployer configuration.
+func ValidateServiceAccount(config containerv1alpha1.Configuration, providerConfig *containerv1alpha1.ProviderConfiguration) error {
+	if providerConfig.Pod == nil || len(providerConfig.Pod.ServiceAccountName) == 0 {
+		return nil
+	}
+	for _, name := range config.AllowedServiceAccounts {
+		if name == providerConfig.Pod.ServiceAccountName {
+			return nil
+		}
+	}
+	return fmt.Errorf("service account %q is not allowed by the container deployer configuration", providerConfig.Pod.ServiceAccountName)
+}
+
 // getPod returns the latest executed pod.
 // Pods that have no finalizer are ignored.
 func (c *Container) getPod(ctx context.Context) (*corev1.Pod, error) {
@@ -0,0 +1,104 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package container
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	"k8s.io/utils/ptr"
+
+	lsv1alpha1 "github.com/gardener/landscaper/apis/core/v1alpha1"
+	"github.com/gardener/landscaper/apis/deployer/container"
+	containerv1alpha1 "github.com/gardener/landscaper/apis/deployer/container/v1alpha1"
+)
+
+var _ = Describe("Pod", func() {
+
+	var opts PodOptions
+
+	BeforeEach(func() {
+		opts = PodOptions{
+			DeployerID:            "test",
+			ProviderConfiguration: &containerv1alpha1.ProviderConfiguration{Image: "example.com/image:1.0.0"},
+			Name:                  "my-item",
+			Namespace:             "default",
+			DeployItemName:        "my-item",
+			DeployItemNamespace:   "test",
+		}
+	})
+
+	It("should set the resources of the main container", func() {
+		opts.ProviderConfiguration.Resources = &corev1.ResourceRequirements{
+			Requests: corev1.ResourceList{corev1.ResourceMemory: resource.MustParse("128Mi")},
+			Limits:   corev1.ResourceList{corev1.ResourceMemory: resource.MustParse("256Mi")},
+		}
+		pod, err := generatePod(opts)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(pod.Spec.Containers[0].Name).To(Equal(container.MainContainerName))
+		Expect(pod.Spec.Containers[0].Resources).To(Equal(*opts.ProviderConfiguration.Resources))
+		Expect(pod.Spec.Containers[1].Resources).To(Equal(corev1.ResourceRequirements{}))
+	})
+
+	It("should customize the pod", func() {
+		opts.ProviderConfiguration.Pod = &containerv1alpha1.PodConfiguration{
+			Labels: map[string]string{
+				"app": "test",
+				container.ContainerDeployerDeployItemNameLabel: "other",
+			},
+			Annotations:  map[string]string{"a": "b"},
+			NodeSelector: map[string]string{"pool": "a"},
+			Tolerations: []corev1.Toleration{
+				{Key: "dedicated", Operator: corev1.TolerationOpExists, Effect: corev1.TaintEffectNoSchedule},
+			},
+			Affinity: &corev1.Affinity{
+				NodeAffinity: &corev1.NodeAffinity{},
+			},
+		}
+		opts.Scheduling = &lsv1alpha1.SchedulingConfiguration{
+			NodeSelector: map[string]string{"pool": "b", "zone": "a"},
+			Tolerations: []corev1.Toleration{
+				{Key: "landscaper", Operator: corev1.TolerationOpExists},
+			},
+		}
+		pod, err := generatePod(opts)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(pod.Labels).To(HaveKeyWithValue("app", "test"))
+		Expect(pod.Labels).To(HaveKeyWithValue(container.ContainerDeployerDeployItemNameLabel, "my-item"))
+		Expect(pod.Annotations).To(Equal(map[string]string{"a": "b"}))
+		Expect(pod.Spec.NodeSelector).To(Equal(map[string]string{"pool": "a", "zone": "a"}))
+		Expect(pod.Spec.Tolerations).To(HaveLen(2))
+		Expect(pod.Spec.Affinity).To(Equal(opts.ProviderConfiguration.Pod.Affinity))
+		Expect(pod.Spec.ServiceAccountName).To(BeEmpty())
+		Expect(pod.Spec.AutomountServiceAccountToken).To(Equal(ptr.To(false)))
+	})
+
+	It("should mount the token of the service account", func() {
+		opts.ProviderConfiguration.Pod = &containerv1alpha1.PodConfiguration{ServiceAccountName: "my-sa"}
+		pod, err := generatePod(opts)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(pod.Spec.ServiceAccountName).To(Equal("my-sa"))
+		Expect(pod.Spec.AutomountServiceAccountToken).To(Equal(ptr.To(true)))
+	})
+
+	Context("ValidateServiceAccount", func() {
+		It("should allow pods without a service account", func() {
+			Expect(ValidateServiceAccount(containerv1alpha1.Configuration{}, opts.ProviderConfiguration)).To(Succeed())
+		})
+
+		It("should allow a service account of the configuration", func() {
+			opts.ProviderConfiguration.Pod = &containerv1alpha1.PodConfiguration{ServiceAccountName: "my-sa"}
+			config := containerv1alpha1.Configuration{AllowedServiceAccounts: []string{"other", "my-sa"}}
+			Expect(ValidateServiceAccount(config, opts.ProviderConfiguration)).To(Succeed())
+		})
+
+		It("should reject a service account that is not allowed", func() {
+			opts.ProviderConfiguration.Pod = &containerv1alpha1.PodConfiguration{ServiceAccountName: "my-sa"}
+			config := containerv1alpha1.Configuration{AllowedServiceAccounts: []string{"other"}}
+			Expect(ValidateServiceAccount(config, opts.ProviderConfiguration)).ToNot(Succeed())
+		})
+	})
+})
@@ -9,6 +9,7 @@ import (
 	"time"
 
 	"github.com/gardener/component-cli/ociclient/cache"
+	"k8s.io/client-go/kubernetes"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	lsv1alpha1 "github.com/gardener/landscaper/apis/core/v1alpha1"
@@ -33,6 +34,7 @@ const (
 
 // NewDeployer creates a new deployer that reconciles deploy items of type "landscaper.gardener.cloud/container".
 func NewDeployer(lsUncachedClient, lsCachedClient, hostUncachedClient, hostCachedClient client.Client,
+	hostClientset kubernetes.Interface,
 	log logging.Logger,
 	config containerv1alpha1.Configuration) (*deployer, error) {
 
@@ -52,6 +54,7 @@ func NewDeployer(lsUncachedClient, lsCachedClient, hostUncachedClient, hostCache
 		lsCachedClient:     lsCachedClient,
 		hostUncachedClient: hostUncachedClient,
 		hostCachedClient:   hostCachedClient,
+		hostClientset:      hostClientset,
 		log:                log,
 		config:             config,
 		sharedCache:        sharedCache,
@@ -66,6 +69,7 @@ type deployer struct {
 	lsCachedClient     client.Client
 	hostUncachedClient client.Client
 	hostCachedClient   client.Client
+	hostClientset      kubernetes.Interface
 
 	log         logging.Logger
 	config      containerv1alpha1.Configuration
@@ -74,7 +78,7 @@ type deployer struct {
 }
 
 func (d *deployer) Reconcile(ctx context.Context, lsCtx *lsv1alpha1.Context, di *lsv1alpha1.DeployItem, rt *lsv1alpha1.ResolvedTarget) error {
-	containerOp, err := New(d.lsUncachedClient, d.lsCachedClient, d.hostUncachedClient, d.hostCachedClient, d.config, di, lsCtx, d.sharedCache, rt)
+	containerOp, err := New(d.lsUncachedClient, d.lsCachedClient, d.hostUncachedClient, d.hostCachedClient, d.hostClientset, d.config, di, lsCtx, d.sharedCache, rt)
 	if err != nil {
 		return err
 	}
@@ -83,7 +87,7 @@ func (d *deployer) Reconcile(ctx context.Context, lsCtx *lsv1alpha1.Context, di
 }
 
 func (d deployer) Delete(ctx context.Context, lsCtx *lsv1alpha1.Context, di *lsv1alpha1.DeployItem, rt *lsv1alpha1.ResolvedTarget) error {
-	containerOp, err := New(d.lsUncachedClient, d.lsCachedClient, d.hostUncachedClient, d.hostCachedClient, d.config, di, lsCtx, d.sharedCache, rt)
+	containerOp, err := New(d.lsUncachedClient, d.lsCachedClient, d.hostUncachedClient, d.hostCachedClient, d.hostClientset, d.config, di, lsCtx, d.sharedCache, rt)
 	if err != nil {
 		return err
 	}
@@ -91,9 +95,13 @@ func (d deployer) Delete(ctx context.Context, lsCtx *lsv1alpha1.Context, di *lsv
 	return containerOp.Delete(ctx)
 }
 
-func (d *deployer) Abort(ctx context.Context, lsCtx *lsv1alpha1.Context, di *lsv1alpha1.DeployItem, _ *lsv1alpha1.ResolvedTarget) error {
-	d.log.Info("abort is not yet implemented")
-	return nil
+func (d *deployer) Abort(ctx context.Context, lsCtx *lsv1alpha1.Context, di *lsv1alpha1.DeployItem, rt *lsv1alpha1.ResolvedTarget) error {
+	containerOp, err := New(d.lsUncachedClient, d.lsCachedClient, d.hostUncachedClient, d.hostCachedClient, d.hostClientset, d.config, di, lsCtx, d.sharedCache, rt)
+	if err != nil {
+		return err
+	}
+	ctx = logging.NewContext(ctx, d.log)
+	return containerOp.Abort(ctx)
 }
 
 func (d *deployer) ExtensionHooks() extension.ReconcileExtensionHooks {
@@ -102,7 +110,7 @@ func (d *deployer) ExtensionHooks() extension.ReconcileExtensionHooks {
 
 func (d *deployer) NextReconcile(ctx context.Context, last time.Time, di *lsv1alpha1.DeployItem) (*time.Time, error) {
 	// TODO: parse provider configuration directly and do not init the container helper struct
-	containerOp, err := New(d.lsUncachedClient, d.lsCachedClient, d.hostUncachedClient, d.hostCachedClient, d.config, di, nil, d.sharedCache, nil)
+	containerOp, err := New(d.lsUncachedClient, d.lsCachedClient, d.hostUncachedClient, d.hostCachedClient, d.hostClientset, d.config, di, nil, d.sharedCache, nil)
 	if err != nil {
 		return nil, err
 	}
@@ -32,6 +32,7 @@ import (
 	"github.com/gardener/landscaper/controller-utils/pkg/logging"
 	lc "github.com/gardener/landscaper/controller-utils/pkg/logging/constants"
 	"github.com/gardener/landscaper/pkg/components/model/tar"
+	"github.com/gardener/landscaper/pkg/utils/encryption"
 )
 
 // State handles the backup and restore of state of container deploy item.
@@ -42,6 +43,8 @@ type State struct {
 	kubeClient client.Client
 	fs         vfs.FileSystem
 	path       string
+	// encryptor encrypts the state before it is stored in the secrets.
+	encryptor *encryption.Encryptor
 }
 
 // New creates a new state instance.
@@ -61,6 +64,13 @@ func (s *State) WithFs(fs vfs.FileSystem) *State {
 	return s
 }
 
+// WithEncryptor sets the encryptor for the state.
+// If it is set, the state is encrypted before it is stored in the secrets.
+func (s *State) WithEncryptor(encryptor *encryption.Encryptor) *State {
+	s.encryptor = encryptor
+	return s
+}
+
 // Backup tars the content of the State directory and stores it in a secrets in the cluster.
 func (s *State) Backup(ctx context.Context) error {
 	// do nothing if there is no State to persist
@@ -92,6 +102,12 @@ func (s *State) Backup(ctx context.Context) error {
 		}
 	}()
 
+	if s.encryptor != nil {
+		if err := s.encryptFile(ctx, tmpFile.Name()); err != nil {
+			return err
+		}
+	}
+
 	// split the file in chunks of 1MB (Secret size limit)
 	_, err = s.splitFileAndUploadChunks(ctx, tmpFile.Name())
 	if err != nil {
@@ -154,7 +170,7 @@ func (s *State) Restore(ctx context.Context) error {
 		return nil
 	}
 	newestUuid := newest.Annotations[container.ContainerDeployerStateUUIDAnnotation]
-	if err := s.restoreFromSecrets(secrets[newestUuid]); err != nil {
+	if err := s.restoreFromSecrets(ctx, secrets[newestUuid]); err != nil {
 		return err
 	}
 
@@ -175,7 +191,20 @@ func (s *State) Restore(ctx context.Context) error {
 	return nil
 }
 
-func (s *State) restoreFromSecrets(secrets []*corev1.Secret) error {
+// encryptFile replaces the content of the given file by its encrypted form.
+func (s *State) encryptFile(ctx context.Context, filePath string) error {
+	data, err := vfs.ReadFile(s.fs, filePath)
+	if err != nil {
+		return err
+	}
+	encrypted, err := s.encryptor.Encrypt(ctx, data)
+	if err != nil {
+		return errors.Wrap(err, "unable to encrypt State")
+	}
+	return vfs.WriteFile(s.fs, filePath, encrypted, os.ModePerm)
+}
+
+func (s *State) restoreFromSecrets(ctx context.Context, secrets []*corev1.Secret) error {
 	sort.Sort(stateSecretsList(secrets))
 
 	// todo: need to write to filesystem
@@ -188,7 +217,19 @@ func (s *State) restoreFromSecrets(secrets []*corev1.Secret) error {
 		data.Write(chunk)
 	}
 
-	return tar.ExtractTarGzip(context.TODO(), &data, s.fs, tar.ToPath(s.path))
+	if encryption.IsEncrypted(data.Bytes()) {
+		if s.encryptor == nil {
+			return errors.New("the State is encrypted but no encryption is configured")
+		}
+		decrypted, err := s.encryptor.Decrypt(ctx, data.Bytes())
+		if err != nil {
+			return errors.Wrap(err, "unable to decrypt State")
+		}
+		data.Reset()
+		data.Write(decrypted)
+	}
+
+	return tar.ExtractTarGzip(ctx, &data, s.fs, tar.ToPath(s.path))
 }
 
 func (s *State) gcOldSecrets(ctx context.Context, secrets []*corev1.Secret) {
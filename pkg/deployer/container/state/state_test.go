@@ -6,6 +6,7 @@ package state_test
 
 import (
 	"context"
+	"encoding/base64"
 	"os"
 	"path"
 
@@ -16,9 +17,11 @@ import (
 	corev1 "k8s.io/api/core/v1"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
+	"github.com/gardener/landscaper/apis/config"
 	lsv1alpha1 "github.com/gardener/landscaper/apis/core/v1alpha1"
 	"github.com/gardener/landscaper/controller-utils/pkg/logging"
 	"github.com/gardener/landscaper/pkg/deployer/container/state"
+	"github.com/gardener/landscaper/pkg/utils/encryption"
 	"github.com/gardener/landscaper/test/utils"
 	"github.com/gardener/landscaper/test/utils/envtest"
 )
@@ -65,6 +68,47 @@ var _ = Describe("Container Deployer State", func() {
 		Expect(resData).To(Equal(testData))
 	})
 
+	It("should encrypt the state and restore it", func() {
+		ctx := logging.NewContextWithDiscard(context.Background())
+		defer ctx.Done()
+		var (
+			fs           = memoryfs.New()
+			resFs        = memoryfs.New()
+			testDir      = "/mystate"
+			testFilePath = path.Join(testDir, "my-file")
+			testData     = []byte("text")
+			keyFile      = path.Join(GinkgoT().TempDir(), "key")
+		)
+
+		utils.ExpectNoError(os.WriteFile(keyFile, []byte(base64.StdEncoding.EncodeToString(make([]byte, 32))), 0600))
+		encryptor, err := encryption.NewFromConfig(&config.EncryptionConfiguration{
+			Local: &config.LocalEncryptionConfiguration{
+				Keys: []config.LocalEncryptionKey{{Name: "key1", KeyFile: keyFile}},
+			},
+		})
+		utils.ExpectNoError(err)
+
+		utils.ExpectNoError(fs.MkdirAll(testDir, os.ModePerm))
+		utils.ExpectNoError(vfs.WriteFile(fs, testFilePath, testData, os.ModePerm))
+
+		deployItem := lsv1alpha1.ObjectReference{Name: "testname", Namespace: "testns"}
+		s := state.New(testenv.Client, testState.Namespace, deployItem, testDir).WithFs(fs).WithEncryptor(encryptor)
+		utils.ExpectNoError(s.Backup(ctx))
+
+		secrets := &corev1.SecretList{}
+		utils.ExpectNoError(testenv.Client.List(ctx, secrets, state.StateSecretListOptions(testState.Namespace, deployItem)...))
+		Expect(secrets.Items).To(HaveLen(1))
+		Expect(encryption.IsEncrypted(secrets.Items[0].Data[lsv1alpha1.DataObjectSecretDataKey])).To(BeTrue())
+
+		s.WithFs(resFs)
+		Expect(s.WithEncryptor(nil).Restore(ctx)).ToNot(Succeed())
+		utils.ExpectNoError(s.WithEncryptor(encryptor).Restore(ctx))
+
+		resData, err := vfs.ReadFile(resFs, testFilePath)
+		utils.ExpectNoError(err)
+		Expect(resData).To(Equal(testData))
+	})
+
 	It("should garbage collect old state secrets", func() {
 		ctx := logging.NewContextWithDiscard(context.Background())
 		defer ctx.Done()
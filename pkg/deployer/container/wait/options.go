@@ -20,8 +20,9 @@ import (
 type options struct {
 	DefaultBackoff wait.Backoff
 
-	ExportFilePath string
-	StatePath      string
+	ExportFilePath           string
+	StatePath                string
+	EncryptionConfigFilePath string
 
 	podName      string
 	podNamespace string
@@ -36,6 +37,7 @@ type options struct {
 func (o *options) Setup() {
 	o.ExportFilePath = os.Getenv(container.ExportsPathName)
 	o.StatePath = os.Getenv(container.StatePathName)
+	o.EncryptionConfigFilePath = os.Getenv(container.EncryptionConfigPathName)
 
 	o.podName = os.Getenv(container.PodName)
 	o.podNamespace = os.Getenv(container.PodNamespaceName)
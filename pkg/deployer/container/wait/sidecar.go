@@ -15,6 +15,7 @@ import (
 	"github.com/gardener/landscaper/controller-utils/pkg/logging"
 	"github.com/gardener/landscaper/pkg/api"
 	"github.com/gardener/landscaper/pkg/deployer/container/state"
+	"github.com/gardener/landscaper/pkg/utils/encryption"
 )
 
 // Run runs the container deployer sidecar.
@@ -55,7 +56,15 @@ func Run(ctx context.Context) error {
 	}
 
 	// backup state
-	if err := state.New(kubeClient, opts.podNamespace, opts.DeployItemKey, opts.StatePath).Backup(ctx); err != nil {
+	stateHandler := state.New(kubeClient, opts.podNamespace, opts.DeployItemKey, opts.StatePath)
+	if len(opts.EncryptionConfigFilePath) != 0 {
+		encryptor, err := encryption.NewFromFile(opts.EncryptionConfigFilePath)
+		if err != nil {
+			return withTerminationLog(log, err)
+		}
+		stateHandler.WithEncryptor(encryptor)
+	}
+	if err := stateHandler.Backup(ctx); err != nil {
 		return withTerminationLog(log, err)
 	}
 
@@ -55,6 +55,12 @@ func AddDeployerToManager(lsUncachedClient, lsCachedClient, hostUncachedClient,
 		options.CacheSyncTimeout = config.Controller.CacheSyncTimeout.Duration
 	}
 
+	deployerlib.SetKubeconfigPolicy(config.TargetClient)
+
+	if err := deployerlib.AddInventoryToManager(lsUncachedClient, log, lsMgr, config.TargetSelector, config.TargetClient); err != nil {
+		return err
+	}
+
 	return deployerlib.Add(lsUncachedClient, lsCachedClient, hostUncachedClient, hostCachedClient,
 		finishedObjectCache,
 		log, lsMgr, hostMgr, deployerlib.DeployerArgs{
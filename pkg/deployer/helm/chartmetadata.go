@@ -0,0 +1,46 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package helm
+
+import (
+	"helm.sh/helm/v3/pkg/chart"
+
+	helmv1alpha1 "github.com/gardener/landscaper/apis/deployer/helm/v1alpha1"
+	"github.com/gardener/landscaper/pkg/landscaper/dataobjects/jsonpath"
+)
+
+// GetChartMetadata returns the metadata of a chart that are stored in the provider status.
+func GetChartMetadata(ch *chart.Chart) *helmv1alpha1.ChartMetadata {
+	if ch == nil || ch.Metadata == nil {
+		return nil
+	}
+	return &helmv1alpha1.ChartMetadata{
+		Name:        ch.Metadata.Name,
+		Version:     ch.Metadata.Version,
+		AppVersion:  ch.Metadata.AppVersion,
+		Annotations: ch.Metadata.Annotations,
+	}
+}
+
+// ConstructChartMetadataExport returns the export of the metadata and the digest of a chart under the given key.
+// No export is returned if the key is empty.
+func ConstructChartMetadataExport(key string, ch *chart.Chart, chartDigest string) (map[string]interface{}, error) {
+	metadata := GetChartMetadata(ch)
+	if len(key) == 0 || metadata == nil {
+		return nil, nil
+	}
+
+	annotations := map[string]interface{}{}
+	for k, v := range metadata.Annotations {
+		annotations[k] = v
+	}
+	return jsonpath.Construct(key, map[string]interface{}{
+		"name":        metadata.Name,
+		"version":     metadata.Version,
+		"appVersion":  metadata.AppVersion,
+		"annotations": annotations,
+		"digest":      chartDigest,
+	})
+}
@@ -0,0 +1,61 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package helm_test
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"helm.sh/helm/v3/pkg/chart"
+
+	helmv1alpha1 "github.com/gardener/landscaper/apis/deployer/helm/v1alpha1"
+	"github.com/gardener/landscaper/pkg/deployer/helm"
+)
+
+var _ = Describe("Chart Metadata", func() {
+
+	const chartDigest = "sha256:0123456789abcdef0123456789abcdef0123456789abcdef0123456789abcdef"
+
+	ch := &chart.Chart{
+		Metadata: &chart.Metadata{
+			Name:        "testchart",
+			Version:     "1.2.3",
+			AppVersion:  "v4.5.6",
+			Annotations: map[string]string{"category": "test"},
+		},
+	}
+
+	It("should return the metadata of a chart", func() {
+		Expect(helm.GetChartMetadata(ch)).To(Equal(&helmv1alpha1.ChartMetadata{
+			Name:        "testchart",
+			Version:     "1.2.3",
+			AppVersion:  "v4.5.6",
+			Annotations: map[string]string{"category": "test"},
+		}))
+		Expect(helm.GetChartMetadata(&chart.Chart{})).To(BeNil())
+	})
+
+	It("should export the metadata of a chart under the configured key", func() {
+		exports, err := helm.ConstructChartMetadataExport("deployed.chart", ch, chartDigest)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(exports).To(Equal(map[string]interface{}{
+			"deployed": map[string]interface{}{
+				"chart": map[string]interface{}{
+					"name":        "testchart",
+					"version":     "1.2.3",
+					"appVersion":  "v4.5.6",
+					"annotations": map[string]interface{}{"category": "test"},
+					"digest":      chartDigest,
+				},
+			},
+		}))
+	})
+
+	It("should not export the metadata of a chart if no key is configured", func() {
+		exports, err := helm.ConstructChartMetadataExport("", ch, chartDigest)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(exports).To(BeNil())
+	})
+
+})
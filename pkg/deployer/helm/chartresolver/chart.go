@@ -10,10 +10,12 @@ import (
 	"encoding/base64"
 	"errors"
 	"fmt"
+	"io"
 	"net/http"
 
 	"github.com/mandelsoft/filepath/pkg/filepath"
 	"github.com/mandelsoft/vfs/pkg/memoryfs"
+	"github.com/mandelsoft/vfs/pkg/vfs"
 	"github.com/open-component-model/ocm/pkg/contexts/ocm/download"
 	"github.com/open-component-model/ocm/pkg/helm/loader"
 
@@ -21,6 +23,7 @@ import (
 	helmid "github.com/open-component-model/ocm/pkg/contexts/credentials/builtin/helm/identity"
 	"github.com/open-component-model/ocm/pkg/contexts/ocm"
 	"github.com/open-component-model/ocm/pkg/runtime"
+	"github.com/opencontainers/go-digest"
 	"sigs.k8s.io/yaml"
 
 	lserrors "github.com/gardener/landscaper/apis/errors"
@@ -45,6 +48,8 @@ import (
 var NoChartDefinedError = errors.New("no chart was provided")
 
 // GetChart resolves the chart based on a chart access configuration.
+// Besides the chart, the digest of the chart archive is returned in the format "sha256:<hex>".
+// The digest is empty if it cannot be determined for the chart source.
 func GetChart(ctx context.Context,
 	chartConfig *helmv1alpha1.Chart,
 	lsClient client.Client,
@@ -52,7 +57,7 @@ func GetChart(ctx context.Context,
 	registryPullSecrets []corev1.Secret,
 	ociConfig *config.OCIConfiguration,
 	sharedCache cache.Cache,
-	useChartCache bool) (*chart.Chart, error) {
+	useChartCache bool) (*chart.Chart, string, error) {
 
 	var ocmConfig *corev1.ConfigMap
 	if contextObj.OCMConfig != nil {
@@ -61,7 +66,7 @@ func GetChart(ctx context.Context,
 			Namespace: contextObj.Namespace,
 			Name:      contextObj.OCMConfig.Name,
 		}, ocmConfig); err != nil {
-			return nil, err
+			return nil, "", err
 		}
 	}
 
@@ -70,56 +75,63 @@ func GetChart(ctx context.Context,
 	}
 
 	var chart *chart.Chart
+	var chartDigest string
 	var err error
 
+	// charts from a helm chart repo that are referenced by a version constraint must not be cached,
+	// because a newer chart version that matches the constraint could be published at any time.
+	if chartConfig.HelmChartRepo != nil && !isExactChartVersion(chartConfig.HelmChartRepo.HelmChartVersion) {
+		useChartCache = false
+	}
+
 	if useChartCache {
-		chart, err = GetHelmChartCache(MaxSizeInByteDefault, RemoveOutdatedDurationDefault).getChart(chartConfig.Ref,
+		chart, chartDigest, err = GetHelmChartCache(MaxSizeInByteDefault, RemoveOutdatedDurationDefault).getChart(chartConfig.Ref,
 			chartConfig.HelmChartRepo, chartConfig.ResourceRef)
 		if err != nil {
-			return nil, err
+			return nil, "", err
 		}
 	}
 
 	if chart == nil {
 		if len(chartConfig.Ref) != 0 {
-			chart, err = getChartFromOCIRef(ctx, ocmConfig, contextObj, chartConfig.Ref, registryPullSecrets, ociConfig, sharedCache)
+			chart, chartDigest, err = getChartFromOCIRef(ctx, ocmConfig, contextObj, chartConfig.Ref, registryPullSecrets, ociConfig, sharedCache)
 		} else if chartConfig.HelmChartRepo != nil {
-			chart, err = getChartFromHelmChartRepo(ctx, ocmConfig, lsClient, contextObj, chartConfig.HelmChartRepo)
+			chart, chartDigest, err = getChartFromHelmChartRepo(ctx, ocmConfig, lsClient, contextObj, chartConfig.HelmChartRepo)
 		} else if chartConfig.FromResource != nil {
 			chart, err = nil, errors.New("chart.fromResource is no longer supported")
 		} else if chartConfig.ResourceRef != "" {
-			chart, err = getChartFromResourceRef(ctx, ocmConfig, chartConfig.ResourceRef, contextObj, lsClient)
+			chart, chartDigest, err = getChartFromResourceRef(ctx, ocmConfig, chartConfig.ResourceRef, contextObj, lsClient)
 		} else {
 			chart, err = nil, NoChartDefinedError
 		}
 
 		if err != nil {
-			return nil, err
+			return nil, "", err
 		}
 	}
 
 	if useChartCache {
 		if err = GetHelmChartCache(MaxSizeInByteDefault, RemoveOutdatedDurationDefault).addOrUpdateChart(ctx,
-			chartConfig.Ref, chartConfig.HelmChartRepo, chartConfig.ResourceRef, chart); err != nil {
-			return nil, err
+			chartConfig.Ref, chartConfig.HelmChartRepo, chartConfig.ResourceRef, chart, chartDigest); err != nil {
+			return nil, "", err
 		}
 	}
 
-	return chart, nil
+	return chart, chartDigest, nil
 }
 
 func getChartFromResourceRef(ctx context.Context, ocmConfig *corev1.ConfigMap, resourceRef string, lsCtx *lsv1alpha1.Context,
-	lsClient client.Client) (_ *chart.Chart, err error) {
+	lsClient client.Client) (_ *chart.Chart, _ string, err error) {
 
 	op := "getChartFromResourceRef"
 
 	octx := ocm.FromContext(ctx)
 	if err := ocmlib.ApplyOCMConfigMapToOCMContext(octx, ocmConfig); err != nil {
-		return nil, err
+		return nil, "", err
 	}
 
 	if lsCtx == nil {
-		return nil, lserrors.NewError(op, "NoContext", "landscaper context cannot be nil", lsv1alpha1.ErrorForInfoOnly,
+		return nil, "", lserrors.NewError(op, "NoContext", "landscaper context cannot be nil", lsv1alpha1.ErrorForInfoOnly,
 			lsv1alpha1.ErrorConfigurationProblem)
 	}
 
@@ -128,12 +140,12 @@ func getChartFromResourceRef(ctx context.Context, ocmConfig *corev1.ConfigMap, r
 	registryPullSecretRefs := lib.GetRegistryPullSecretsFromContext(lsCtx)
 	registryPullSecrets, err := kutil.ResolveSecrets(ctx, lsClient, registryPullSecretRefs)
 	if err != nil {
-		return nil, fmt.Errorf("error resolving secrets: %w", err)
+		return nil, "", fmt.Errorf("error resolving secrets: %w", err)
 	}
 
 	err = ocmlib.AddSecretCredsToCredContext(registryPullSecrets, octx)
 	if err != nil {
-		return nil, err
+		return nil, "", err
 	}
 
 	// resolve all credentials for helm chart repositories
@@ -142,7 +154,7 @@ func getChartFromResourceRef(ctx context.Context, ocmConfig *corev1.ConfigMap, r
 			repoCredentials := helmv1alpha1.HelmChartRepoCredentials{}
 			err := yaml.Unmarshal(rawAuths.RawMessage, &repoCredentials)
 			if err != nil {
-				return nil, lserrors.NewWrappedError(err, "NewHelmChartRepoClient", "ParsingAuths", err.Error(), lsv1alpha1.ErrorConfigurationProblem)
+				return nil, "", lserrors.NewWrappedError(err, "NewHelmChartRepoClient", "ParsingAuths", err.Error(), lsv1alpha1.ErrorConfigurationProblem)
 			}
 
 			for _, a := range repoCredentials.Auths {
@@ -155,20 +167,20 @@ func getChartFromResourceRef(ctx context.Context, ocmConfig *corev1.ConfigMap, r
 	// Business Logic
 	key, err := base64.StdEncoding.DecodeString(resourceRef)
 	if err != nil {
-		return nil, err
+		return nil, "", err
 	}
 
 	// TODO: implement a MUX so this could deal with multiple kinds of requests
 	globalId := model.GlobalResourceIdentity{}
 	err = runtime.DefaultYAMLEncoding.Unmarshal(key, &globalId)
 	if err != nil {
-		return nil, err
+		return nil, "", err
 	}
 
 	if lsCtx != nil && lsCtx.RepositoryContext != nil && lsCtx.RepositoryContext.Raw != nil {
 		spec, err := octx.RepositorySpecForConfig(lsCtx.RepositoryContext.Raw, runtime.DefaultYAMLEncoding)
 		if err != nil {
-			return nil, err
+			return nil, "", err
 		}
 		octx.AddResolverRule("", spec, int(^uint(0)>>1))
 	}
@@ -178,62 +190,76 @@ func getChartFromResourceRef(ctx context.Context, ocmConfig *corev1.ConfigMap, r
 
 	resolver := octx.GetResolver()
 	if resolver == nil {
-		return nil, errors.New("no repository or ocm resolvers found")
+		return nil, "", errors.New("no repository or ocm resolvers found")
 	}
 
 	compvers, err := resolver.LookupComponentVersion(globalId.ComponentIdentity.Name, globalId.ComponentIdentity.Version)
 	if err != nil {
-		return nil, err
+		return nil, "", err
 	}
 	finalize.Close(compvers)
 
 	res, err := compvers.GetResource(globalId.ResourceIdentity)
 	if err != nil {
-		return nil, err
+		return nil, "", err
 	}
 
 	fs := memoryfs.New()
 	path, err := download.DownloadResource(octx, res, filepath.Join("/", "chart"), download.WithFileSystem(fs))
 	if err != nil {
-		return nil, err
+		return nil, "", err
 	}
 	chart, err := loader.Load(path, fs)
 	if err != nil {
-		return nil, err
+		return nil, "", err
 	}
-	return chart, nil
+
+	// the digest is only known if the resource has been downloaded as chart archive
+	var chartDigest string
+	if ok, err := vfs.IsFile(fs, path); err == nil && ok {
+		data, err := vfs.ReadFile(fs, path)
+		if err != nil {
+			return nil, "", err
+		}
+		chartDigest = digest.FromBytes(data).String()
+	}
+	return chart, chartDigest, nil
 }
 
-func getChartFromArchive(archiveConfig *helmv1alpha1.ArchiveAccess) (*chart.Chart, error) {
+func getChartFromArchive(archiveConfig *helmv1alpha1.ArchiveAccess) (*chart.Chart, string, error) {
 	if len(archiveConfig.Raw) != 0 {
 		data, err := base64.StdEncoding.DecodeString(archiveConfig.Raw)
 		if err != nil {
-			return nil, fmt.Errorf("unable to decode helm archive: %w", err)
+			return nil, "", fmt.Errorf("unable to decode helm archive: %w", err)
 		}
 		ch, err := chartloader.LoadArchive(bytes.NewBuffer(data))
 		if err != nil {
-			return nil, fmt.Errorf("unable to load chart from archive: %w", err)
+			return nil, "", fmt.Errorf("unable to load chart from archive: %w", err)
 		}
-		return ch, err
+		return ch, digest.FromBytes(data).String(), err
 	}
 	if archiveConfig.Remote != nil {
 		res, err := http.Get(archiveConfig.Remote.URL)
 		if err != nil {
-			return nil, fmt.Errorf("unable to fetch helm chart from %q: %w", archiveConfig.Remote.URL, err)
+			return nil, "", fmt.Errorf("unable to fetch helm chart from %q: %w", archiveConfig.Remote.URL, err)
 		}
 		if res.StatusCode < 200 || res.StatusCode > 299 {
-			return nil, fmt.Errorf("unable to fetch helm chart from %q: %s", archiveConfig.Remote.URL, res.Status)
+			return nil, "", fmt.Errorf("unable to fetch helm chart from %q: %s", archiveConfig.Remote.URL, res.Status)
 		}
-		ch, err := chartloader.LoadArchive(res.Body)
+		data, err := io.ReadAll(res.Body)
 		if err != nil {
-			return nil, fmt.Errorf("unable to load chart from %q: %w", archiveConfig.Remote.URL, err)
+			return nil, "", fmt.Errorf("unable to read helm chart from %q: %w", archiveConfig.Remote.URL, err)
 		}
 		if err := res.Body.Close(); err != nil {
-			return nil, fmt.Errorf("unable to close remote stream from %q: %w", archiveConfig.Remote.URL, err)
+			return nil, "", fmt.Errorf("unable to close remote stream from %q: %w", archiveConfig.Remote.URL, err)
+		}
+		ch, err := chartloader.LoadArchive(bytes.NewBuffer(data))
+		if err != nil {
+			return nil, "", fmt.Errorf("unable to load chart from %q: %w", archiveConfig.Remote.URL, err)
 		}
-		return ch, err
+		return ch, digest.FromBytes(data).String(), err
 	}
-	return nil, NoChartDefinedError
+	return nil, "", NoChartDefinedError
 }
 
 func getChartFromOCIRef(ctx context.Context,
@@ -242,43 +268,43 @@ func getChartFromOCIRef(ctx context.Context,
 	ociImageRef string,
 	registryPullSecrets []corev1.Secret,
 	ociConfig *config.OCIConfiguration,
-	sharedCache cache.Cache) (*chart.Chart, error) {
+	sharedCache cache.Cache) (*chart.Chart, string, error) {
 
 	resource, err := registries.GetFactory(contextObj.UseOCM).NewHelmOCIResource(ctx, nil, ocmConfig, ociImageRef, registryPullSecrets, ociConfig, sharedCache)
 	if err != nil {
-		return nil, err
+		return nil, "", err
 	}
 
 	resourceContent, err := resource.GetTypedContent(ctx)
 	if err != nil {
-		return nil, err
+		return nil, "", err
 	}
 	content, ok := resourceContent.Resource.(*chart.Chart)
 	if !ok {
-		return nil, fmt.Errorf("received resource of type %T but expected type *Chart", content)
+		return nil, "", fmt.Errorf("received resource of type %T but expected type *Chart", content)
 	}
-	return content, nil
+	return content, resourceContent.Digest, nil
 }
 
 func getChartFromHelmChartRepo(ctx context.Context,
 	ocmConfig *corev1.ConfigMap,
 	lsClient client.Client,
 	contextObj *lsv1alpha1.Context,
-	repo *helmv1alpha1.HelmChartRepo) (*chart.Chart, error) {
+	repo *helmv1alpha1.HelmChartRepo) (*chart.Chart, string, error) {
 
 	resource, err := registries.GetFactory(contextObj.UseOCM).NewHelmRepoResource(ctx, ocmConfig, repo, lsClient, contextObj)
 	if err != nil {
-		return nil, fmt.Errorf("unable to construct resource for chart %q with version %q from helm chart repo %q: %w",
+		return nil, "", fmt.Errorf("unable to construct resource for chart %q with version %q from helm chart repo %q: %w",
 			repo.HelmChartName, repo.HelmChartVersion, repo.HelmChartRepoUrl, err)
 	}
 
 	resourceContent, err := resource.GetTypedContent(ctx)
 	if err != nil {
-		return nil, err
+		return nil, "", err
 	}
 	content, ok := resourceContent.Resource.(*chart.Chart)
 	if !ok {
-		return nil, fmt.Errorf("received resource of type %T but expected type *Chart", content)
+		return nil, "", fmt.Errorf("received resource of type %T but expected type *Chart", content)
 	}
-	return content, err
+	return content, resourceContent.Digest, err
 }
@@ -34,7 +34,9 @@ type HelmChartCache struct {
 
 type cacheEntry struct {
 	chartBytesCompressed []byte
-	timestamp            time.Time
+	// chartDigest is the digest of the archive from which the chart has been loaded.
+	chartDigest string
+	timestamp   time.Time
 }
 
 func (c *cacheEntry) GetEntries() ([]byte, time.Time) {
@@ -77,42 +79,42 @@ func createHelmChartCacheSync(initMaxSizeInByte int64, initRemoveOutdatedDuratio
 	return chartCache
 }
 
-func (c *HelmChartCache) getChart(ociRef string, helmRepo *helmv1alpha1.HelmChartRepo, ocmKey string) (*chart.Chart, error) {
+func (c *HelmChartCache) getChart(ociRef string, helmRepo *helmv1alpha1.HelmChartRepo, ocmKey string) (*chart.Chart, string, error) {
 	hash, err := c.getHash(ociRef, helmRepo, ocmKey)
 
 	if err != nil {
-		return nil, err
+		return nil, "", err
 	}
 
-	chartBytesCompressed := c.getChartBytesCompressed(hash)
+	chartBytesCompressed, chartDigest := c.getChartBytesCompressed(hash)
 	if len(chartBytesCompressed) == 0 {
-		return nil, nil
+		return nil, "", nil
 	}
 
 	chartBytesUncompressed, err := utils.Gunzip(chartBytesCompressed)
 	if err != nil {
-		return nil, err
+		return nil, "", err
 	}
 
 	helmChart, err := UnmarshalChart(chartBytesUncompressed)
 	if err != nil {
-		return nil, err
+		return nil, "", err
 	}
 
-	return helmChart, nil
+	return helmChart, chartDigest, nil
 }
 
-func (c *HelmChartCache) getChartBytesCompressed(hash string) []byte {
+func (c *HelmChartCache) getChartBytesCompressed(hash string) ([]byte, string) {
 	c.rwLock.RLock()
 	defer c.rwLock.RUnlock()
 
 	entry := c.chartCache[hash]
 
 	if entry == nil {
-		return nil
+		return nil, ""
 	}
 
-	return entry.chartBytesCompressed
+	return entry.chartBytesCompressed, entry.chartDigest
 }
 
 func (c *HelmChartCache) HasKey(ociRef string, helmRepo *helmv1alpha1.HelmChartRepo, ocmKey string) (bool, error) {
@@ -134,7 +136,7 @@ func (c *HelmChartCache) HasKey(ociRef string, helmRepo *helmv1alpha1.HelmChartR
 }
 
 func (c *HelmChartCache) addOrUpdateChart(ctx context.Context, ociRef string, helmRepo *helmv1alpha1.HelmChartRepo,
-	ocmKey string, chart *chart.Chart) error {
+	ocmKey string, chart *chart.Chart, chartDigest string) error {
 
 	if chart == nil {
 		return errors.New("chart is nil")
@@ -150,7 +152,7 @@ func (c *HelmChartCache) addOrUpdateChart(ctx context.Context, ociRef string, he
 
 	entry := c.chartCache[hash]
 	if entry == nil {
-		entry, err = c.createEntry(chart)
+		entry, err = c.createEntry(chart, chartDigest)
 		if err != nil {
 			return err
 		}
@@ -210,7 +212,7 @@ func (c *HelmChartCache) SetLastCleanup(lastCleanup time.Time) {
 	c.lastCleanup = lastCleanup
 }
 
-func (c *HelmChartCache) createEntry(ch *chart.Chart) (*cacheEntry, error) {
+func (c *HelmChartCache) createEntry(ch *chart.Chart, chartDigest string) (*cacheEntry, error) {
 	var chartMarshaled []byte
 	chartMarshaled, err := MarshalChart(ch)
 	if err != nil {
@@ -224,6 +226,7 @@ func (c *HelmChartCache) createEntry(ch *chart.Chart) (*cacheEntry, error) {
 
 	return &cacheEntry{
 		chartBytesCompressed: chartCompressed,
+		chartDigest:          chartDigest,
 		timestamp:            time.Now(),
 	}, nil
 }
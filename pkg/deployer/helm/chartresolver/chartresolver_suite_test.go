@@ -22,6 +22,7 @@ import (
 
 	cdv2 "github.com/gardener/component-spec/bindings-go/apis/v2"
 	"github.com/open-component-model/ocm/pkg/runtime"
+	"github.com/opencontainers/go-digest"
 
 	"github.com/gardener/landscaper/apis/config"
 	"github.com/gardener/landscaper/pkg/components/registries"
@@ -75,7 +76,7 @@ var _ = Describe("GetChart", func() {
 		It("should resolve a chart from public readable helm ociClient artifact", func() {
 			ref := "eu.gcr.io/gardener-project/landscaper/tutorials/charts/ingress-nginx:3.29.0"
 
-			chart, err := getChartFromOCIRef(ctx, nil, &lsv1alpha1.Context{ContextConfiguration: lsv1alpha1.ContextConfiguration{UseOCM: false}}, ref, nil, nil, nil)
+			chart, _, err := getChartFromOCIRef(ctx, nil, &lsv1alpha1.Context{ContextConfiguration: lsv1alpha1.ContextConfiguration{UseOCM: false}}, ref, nil, nil, nil)
 			Expect(err).ToNot(HaveOccurred())
 			Expect(chart.Metadata.Name).To(Equal("ingress-nginx"))
 		})
@@ -83,7 +84,7 @@ var _ = Describe("GetChart", func() {
 		It("should resolve a legacy chart from public readable helm ociClient artifact", func() {
 			ref := "eu.gcr.io/gardener-project/landscaper/tutorials/charts/ingress-nginx:v3.29.0"
 
-			chart, err := getChartFromOCIRef(ctx, nil, &lsv1alpha1.Context{ContextConfiguration: lsv1alpha1.ContextConfiguration{UseOCM: false}}, ref, nil, nil, nil)
+			chart, _, err := getChartFromOCIRef(ctx, nil, &lsv1alpha1.Context{ContextConfiguration: lsv1alpha1.ContextConfiguration{UseOCM: false}}, ref, nil, nil, nil)
 			Expect(err).ToNot(HaveOccurred())
 			Expect(chart.Metadata.Name).To(Equal("ingress-nginx"))
 		})
@@ -108,7 +109,7 @@ var _ = Describe("GetChart", func() {
 				Ref: "eu.gcr.io/gardener-project/landscaper/tutorials/charts/ingress-nginx:v3.29.0",
 			}
 
-			chart1, err := GetChart(ctx, chartAccess1, nil,
+			chart1, _, err := GetChart(ctx, chartAccess1, nil,
 				&lsv1alpha1.Context{ContextConfiguration: lsv1alpha1.ContextConfiguration{UseOCM: true}},
 				nil, nil, nil, true)
 			Expect(err).ToNot(HaveOccurred())
@@ -125,7 +126,7 @@ var _ = Describe("GetChart", func() {
 			timeBefore = time.Now()
 			time.Sleep(time.Duration(10) * time.Millisecond)
 
-			chart2, err := GetChart(ctx, chartAccess1, nil,
+			chart2, _, err := GetChart(ctx, chartAccess1, nil,
 				&lsv1alpha1.Context{ContextConfiguration: lsv1alpha1.ContextConfiguration{UseOCM: true}},
 				nil, nil, nil, true)
 			Expect(err).ToNot(HaveOccurred())
@@ -149,7 +150,7 @@ var _ = Describe("GetChart", func() {
 			// fetch a 3. time
 			time.Sleep(time.Duration(10) * time.Millisecond)
 
-			chart3, err := GetChart(ctx, chartAccess1, nil,
+			chart3, _, err := GetChart(ctx, chartAccess1, nil,
 				&lsv1alpha1.Context{ContextConfiguration: lsv1alpha1.ContextConfiguration{UseOCM: true}},
 				nil, nil, nil, true)
 			Expect(err).ToNot(HaveOccurred())
@@ -166,7 +167,7 @@ var _ = Describe("GetChart", func() {
 				Ref: "eu.gcr.io/gardener-project/landscaper/tutorials/charts/ingress-nginx:4.0.17",
 			}
 
-			chart4, err := GetChart(ctx, chartAccess4, nil,
+			chart4, _, err := GetChart(ctx, chartAccess4, nil,
 				&lsv1alpha1.Context{ContextConfiguration: lsv1alpha1.ContextConfiguration{UseOCM: true}},
 				nil, nil, nil, true)
 			Expect(err).ToNot(HaveOccurred())
@@ -193,7 +194,7 @@ var _ = Describe("GetChart", func() {
 				Ref: "eu.gcr.io/gardener-project/landscaper/tutorials/charts/ingress-nginx:4.0.18",
 			}
 
-			_, err = GetChart(ctx, chartAccess5, nil,
+			_, _, err = GetChart(ctx, chartAccess5, nil,
 				&lsv1alpha1.Context{ContextConfiguration: lsv1alpha1.ContextConfiguration{UseOCM: true}},
 				nil, nil, nil, true)
 			Expect(err).ToNot(HaveOccurred())
@@ -218,7 +219,7 @@ var _ = Describe("GetChart", func() {
 			timeBefore = time.Now()
 			helmChartCache.SetMaxSizeInByte(MaxSizeInByteDefault)
 
-			_, _ = GetChart(ctx, chartAccess1, nil,
+			_, _, _ = GetChart(ctx, chartAccess1, nil,
 				&lsv1alpha1.Context{ContextConfiguration: lsv1alpha1.ContextConfiguration{UseOCM: true}},
 				nil, nil, nil, true)
 
@@ -226,7 +227,7 @@ var _ = Describe("GetChart", func() {
 			helmChartCache.SetOutdatedDuration(outdatedDuration)
 			helmChartCache.SetLastCleanup(time.Now().Add(-(time.Duration(61) * time.Minute)))
 
-			_, _ = GetChart(ctx, chartAccess1, nil,
+			_, _, _ = GetChart(ctx, chartAccess1, nil,
 				&lsv1alpha1.Context{ContextConfiguration: lsv1alpha1.ContextConfiguration{UseOCM: true}},
 				nil, nil, nil, true)
 
@@ -252,9 +253,10 @@ var _ = Describe("GetChart", func() {
 			Raw: base64.StdEncoding.EncodeToString(chartBytes),
 		}
 
-		chart, err := getChartFromArchive(Archive)
+		chart, chartDigest, err := getChartFromArchive(Archive)
 		Expect(err).ToNot(HaveOccurred())
 		Expect(chart.Metadata.Name).To(Equal("testchart"))
+		Expect(chartDigest).To(Equal(digest.FromBytes(chartBytes).String()))
 	})
 
 	Context("remote url", func() {
@@ -285,7 +287,7 @@ var _ = Describe("GetChart", func() {
 				},
 			}
 
-			chart, err := getChartFromArchive(Archive)
+			chart, _, err := getChartFromArchive(Archive)
 			Expect(err).ToNot(HaveOccurred())
 			Expect(chart.Metadata.Name).To(Equal("testchart"))
 		})
@@ -304,7 +306,7 @@ var _ = Describe("GetChart", func() {
 				},
 			}
 
-			chart, err := getChartFromArchive(Archive)
+			chart, _, err := getChartFromArchive(Archive)
 			Expect(chart).To(BeNil())
 			Expect(err).To(HaveOccurred())
 			Expect(err.Error()).To(ContainSubstring(http.StatusText(401)))
@@ -347,7 +349,7 @@ var _ = Describe("GetChart", func() {
 		})
 
 		It("should resolve a chart from a local ocm resource", func() {
-			chart, err := getChartFromResourceRef(ctx, nil, resourceRef, &lsv1alpha1.Context{
+			chart, _, err := getChartFromResourceRef(ctx, nil, resourceRef, &lsv1alpha1.Context{
 				ContextConfiguration: lsv1alpha1.ContextConfiguration{RepositoryContext: repoCtx},
 			}, nil)
 			Expect(err).To(BeNil())
@@ -366,7 +368,7 @@ configurations:
         priority: 10
 `},
 			}
-			chart, err := getChartFromResourceRef(ctx, ocmConfig, resourceRef, &lsv1alpha1.Context{}, nil)
+			chart, _, err := getChartFromResourceRef(ctx, ocmConfig, resourceRef, &lsv1alpha1.Context{}, nil)
 			Expect(err).To(BeNil())
 			Expect(chart).ToNot(BeNil())
 		})
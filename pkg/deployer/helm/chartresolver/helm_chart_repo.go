@@ -0,0 +1,65 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package chartresolver
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/Masterminds/semver/v3"
+	"sigs.k8s.io/yaml"
+
+	lsv1alpha1 "github.com/gardener/landscaper/apis/core/v1alpha1"
+	helmv1alpha1 "github.com/gardener/landscaper/apis/deployer/helm/v1alpha1"
+	"github.com/gardener/landscaper/pkg/components/common"
+)
+
+// AddHelmChartRepoCredentials returns a copy of the given context that additionally contains the given
+// helm chart repo credentials of the deployer configuration.
+// Credentials of the context take precedence over the given credentials for the same repository url.
+func AddHelmChartRepoCredentials(contextObj *lsv1alpha1.Context,
+	credentials *helmv1alpha1.HelmChartRepoCredentials) (*lsv1alpha1.Context, error) {
+
+	if contextObj == nil || credentials == nil || len(credentials.Auths) == 0 {
+		return contextObj, nil
+	}
+
+	repoCredentials := helmv1alpha1.HelmChartRepoCredentials{}
+	if rawAuths, ok := contextObj.Configurations[helmv1alpha1.HelmChartRepoCredentialsKey]; ok {
+		if err := yaml.Unmarshal(rawAuths.RawMessage, &repoCredentials); err != nil {
+			return nil, fmt.Errorf("unable to parse helm chart repo credentials of context %s: %w", contextObj.Name, err)
+		}
+	}
+
+	contextURLs := map[string]bool{}
+	for _, auth := range repoCredentials.Auths {
+		contextURLs[common.NormalizeUrl(auth.URL)] = true
+	}
+	for _, auth := range credentials.Auths {
+		if !contextURLs[common.NormalizeUrl(auth.URL)] {
+			repoCredentials.Auths = append(repoCredentials.Auths, auth)
+		}
+	}
+
+	rawAuths, err := json.Marshal(repoCredentials)
+	if err != nil {
+		return nil, fmt.Errorf("unable to marshal helm chart repo credentials: %w", err)
+	}
+
+	result := contextObj.DeepCopy()
+	if result.Configurations == nil {
+		result.Configurations = map[string]lsv1alpha1.AnyJSON{}
+	}
+	result.Configurations[helmv1alpha1.HelmChartRepoCredentialsKey] = lsv1alpha1.NewAnyJSON(rawAuths)
+	return result, nil
+}
+
+// isExactChartVersion checks whether the version of a chart in a helm chart repo is a fixed version.
+// Otherwise, the version is a constraint like "^1.2.0" or "~1.2", which could resolve to a newer chart at any time.
+func isExactChartVersion(version string) bool {
+	_, err := semver.StrictNewVersion(strings.TrimPrefix(version, "v"))
+	return err == nil
+}
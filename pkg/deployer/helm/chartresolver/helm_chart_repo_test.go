@@ -0,0 +1,65 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package chartresolver_test
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"sigs.k8s.io/yaml"
+
+	lsv1alpha1 "github.com/gardener/landscaper/apis/core/v1alpha1"
+	helmv1alpha1 "github.com/gardener/landscaper/apis/deployer/helm/v1alpha1"
+	"github.com/gardener/landscaper/pkg/deployer/helm/chartresolver"
+)
+
+var _ = Describe("Helm chart repo credentials", func() {
+
+	getAuths := func(contextObj *lsv1alpha1.Context) []helmv1alpha1.Auth {
+		raw, ok := contextObj.Configurations[helmv1alpha1.HelmChartRepoCredentialsKey]
+		Expect(ok).To(BeTrue())
+		creds := helmv1alpha1.HelmChartRepoCredentials{}
+		Expect(yaml.Unmarshal(raw.RawMessage, &creds)).To(Succeed())
+		return creds.Auths
+	}
+
+	It("should return the context unchanged if no credentials are configured", func() {
+		contextObj := &lsv1alpha1.Context{}
+		result, err := chartresolver.AddHelmChartRepoCredentials(contextObj, nil)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(result).To(BeIdenticalTo(contextObj))
+	})
+
+	It("should add the credentials of the deployer configuration to a copy of the context", func() {
+		contextObj := &lsv1alpha1.Context{}
+		credentials := &helmv1alpha1.HelmChartRepoCredentials{
+			Auths: []helmv1alpha1.Auth{{URL: "https://charts.example.com", AuthHeader: "Basic dXNlcjpwYXNz"}},
+		}
+
+		result, err := chartresolver.AddHelmChartRepoCredentials(contextObj, credentials)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(contextObj.Configurations).To(BeEmpty())
+		Expect(getAuths(result)).To(ConsistOf(credentials.Auths[0]))
+	})
+
+	It("should prefer the credentials of the context for the same url", func() {
+		contextObj := &lsv1alpha1.Context{}
+		contextObj.Configurations = map[string]lsv1alpha1.AnyJSON{
+			helmv1alpha1.HelmChartRepoCredentialsKey: lsv1alpha1.NewAnyJSON([]byte(`{"auths":[{"url":"https://charts.example.com/","authHeader":"Basic Y3R4OnBhc3M="}]}`)),
+		}
+		credentials := &helmv1alpha1.HelmChartRepoCredentials{
+			Auths: []helmv1alpha1.Auth{
+				{URL: "https://charts.example.com", AuthHeader: "Basic dXNlcjpwYXNz"},
+				{URL: "https://other.example.com", CustomCAData: "ca"},
+			},
+		}
+
+		result, err := chartresolver.AddHelmChartRepoCredentials(contextObj, credentials)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(getAuths(result)).To(ConsistOf(
+			helmv1alpha1.Auth{URL: "https://charts.example.com/", AuthHeader: "Basic Y3R4OnBhc3M="},
+			helmv1alpha1.Auth{URL: "https://other.example.com", CustomCAData: "ca"},
+		))
+	})
+})
@@ -0,0 +1,97 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package chartresolver
+
+import (
+	"bytes"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"strings"
+
+	"golang.org/x/crypto/openpgp"           //nolint:staticcheck // helm uses the same package to sign charts
+	"golang.org/x/crypto/openpgp/clearsign" //nolint:staticcheck // helm uses the same package to sign charts
+	"helm.sh/helm/v3/pkg/provenance"
+	"sigs.k8s.io/yaml"
+
+	helmv1alpha1 "github.com/gardener/landscaper/apis/deployer/helm/v1alpha1"
+)
+
+// VerifyChart verifies the digest of a chart archive against the digest and the provenance file
+// that are declared in the chart configuration.
+// An error is returned if the chart archive does not match, or if its digest is unknown although a verification is configured.
+func VerifyChart(chartConfig *helmv1alpha1.Chart, chartDigest string) error {
+	if len(chartConfig.Digest) == 0 && chartConfig.Provenance == nil {
+		return nil
+	}
+	if len(chartDigest) == 0 {
+		return errors.New("the digest of the chart archive cannot be determined, so that the chart cannot be verified")
+	}
+
+	if len(chartConfig.Digest) != 0 && chartConfig.Digest != chartDigest {
+		return fmt.Errorf("the digest %s of the chart archive does not match the expected digest %s", chartDigest, chartConfig.Digest)
+	}
+
+	if chartConfig.Provenance != nil {
+		if err := verifyProvenance(chartConfig.Provenance, chartDigest); err != nil {
+			return fmt.Errorf("unable to verify the provenance of the chart: %w", err)
+		}
+	}
+	return nil
+}
+
+// verifyProvenance checks that the provenance file is signed by a key of the keyring
+// and that it contains the digest of the chart archive.
+func verifyProvenance(prov *helmv1alpha1.ChartProvenance, chartDigest string) error {
+	keyring, err := readKeyring(prov.Keyring)
+	if err != nil {
+		return err
+	}
+
+	block, _ := clearsign.Decode([]byte(prov.Raw))
+	if block == nil {
+		return errors.New("the provenance file contains no signature")
+	}
+	if _, err := openpgp.CheckDetachedSignature(keyring, bytes.NewReader(block.Bytes), block.ArmoredSignature.Body); err != nil {
+		return fmt.Errorf("invalid signature: %w", err)
+	}
+
+	// the signed message consists of the chart metadata and the digests of the files, separated by "...".
+	parts := bytes.Split(block.Plaintext, []byte("\n...\n"))
+	if len(parts) < 2 {
+		return errors.New("the provenance file contains no file digests")
+	}
+	sums := &provenance.SumCollection{}
+	if err := yaml.Unmarshal(parts[1], sums); err != nil {
+		return fmt.Errorf("unable to parse the file digests of the provenance file: %w", err)
+	}
+	for _, sum := range sums.Files {
+		if sum == chartDigest {
+			return nil
+		}
+	}
+	return fmt.Errorf("the provenance file does not contain the digest %s of the chart archive", chartDigest)
+}
+
+// readKeyring reads an ASCII armored or a base64 encoded binary keyring.
+func readKeyring(keyring string) (openpgp.EntityList, error) {
+	if strings.HasPrefix(strings.TrimSpace(keyring), "-----BEGIN PGP") {
+		entities, err := openpgp.ReadArmoredKeyRing(strings.NewReader(keyring))
+		if err != nil {
+			return nil, fmt.Errorf("unable to read armored keyring: %w", err)
+		}
+		return entities, nil
+	}
+
+	data, err := base64.StdEncoding.DecodeString(keyring)
+	if err != nil {
+		return nil, fmt.Errorf("unable to decode keyring: %w", err)
+	}
+	entities, err := openpgp.ReadKeyRing(bytes.NewReader(data))
+	if err != nil {
+		return nil, fmt.Errorf("unable to read keyring: %w", err)
+	}
+	return entities, nil
+}
@@ -0,0 +1,102 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package chartresolver
+
+import (
+	"bytes"
+	"encoding/base64"
+	"fmt"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"golang.org/x/crypto/openpgp"           //nolint:staticcheck
+	"golang.org/x/crypto/openpgp/armor"     //nolint:staticcheck
+	"golang.org/x/crypto/openpgp/clearsign" //nolint:staticcheck
+
+	helmv1alpha1 "github.com/gardener/landscaper/apis/deployer/helm/v1alpha1"
+)
+
+var _ = Describe("VerifyChart", func() {
+
+	const (
+		chartDigest = "sha256:0123456789abcdef0123456789abcdef0123456789abcdef0123456789abcdef"
+		otherDigest = "sha256:fedcba9876543210fedcba9876543210fedcba9876543210fedcba9876543210"
+	)
+
+	newEntity := func() *openpgp.Entity {
+		entity, err := openpgp.NewEntity("test", "", "test@example.com", nil)
+		Expect(err).ToNot(HaveOccurred())
+		return entity
+	}
+
+	// sign creates a provenance file like "helm package --sign".
+	sign := func(entity *openpgp.Entity, fileDigest string) string {
+		message := fmt.Sprintf("apiVersion: v2\nname: testchart\nversion: 0.1.0\n\n...\nfiles:\n  testchart-0.1.0.tgz: %s\n", fileDigest)
+		buf := &bytes.Buffer{}
+		w, err := clearsign.Encode(buf, entity.PrivateKey, nil)
+		Expect(err).ToNot(HaveOccurred())
+		_, err = w.Write([]byte(message))
+		Expect(err).ToNot(HaveOccurred())
+		Expect(w.Close()).To(Succeed())
+		return buf.String()
+	}
+
+	armoredKeyring := func(entity *openpgp.Entity) string {
+		buf := &bytes.Buffer{}
+		w, err := armor.Encode(buf, openpgp.PublicKeyType, nil)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(entity.Serialize(w)).To(Succeed())
+		Expect(w.Close()).To(Succeed())
+		return buf.String()
+	}
+
+	binaryKeyring := func(entity *openpgp.Entity) string {
+		buf := &bytes.Buffer{}
+		Expect(entity.Serialize(buf)).To(Succeed())
+		return base64.StdEncoding.EncodeToString(buf.Bytes())
+	}
+
+	It("should succeed if no verification is configured", func() {
+		Expect(VerifyChart(&helmv1alpha1.Chart{}, "")).To(Succeed())
+	})
+
+	It("should verify the digest of the chart archive", func() {
+		Expect(VerifyChart(&helmv1alpha1.Chart{Digest: chartDigest}, chartDigest)).To(Succeed())
+		Expect(VerifyChart(&helmv1alpha1.Chart{Digest: chartDigest}, otherDigest)).To(MatchError(ContainSubstring("does not match the expected digest")))
+		Expect(VerifyChart(&helmv1alpha1.Chart{Digest: chartDigest}, "")).To(MatchError(ContainSubstring("cannot be determined")))
+	})
+
+	It("should verify a provenance file with an armored and a binary keyring", func() {
+		entity := newEntity()
+		prov := sign(entity, chartDigest)
+
+		Expect(VerifyChart(&helmv1alpha1.Chart{Provenance: &helmv1alpha1.ChartProvenance{
+			Raw:     prov,
+			Keyring: armoredKeyring(entity),
+		}}, chartDigest)).To(Succeed())
+		Expect(VerifyChart(&helmv1alpha1.Chart{Provenance: &helmv1alpha1.ChartProvenance{
+			Raw:     prov,
+			Keyring: binaryKeyring(entity),
+		}}, chartDigest)).To(Succeed())
+	})
+
+	It("should fail if the provenance file does not contain the digest of the chart archive", func() {
+		entity := newEntity()
+		err := VerifyChart(&helmv1alpha1.Chart{Provenance: &helmv1alpha1.ChartProvenance{
+			Raw:     sign(entity, otherDigest),
+			Keyring: armoredKeyring(entity),
+		}}, chartDigest)
+		Expect(err).To(MatchError(ContainSubstring("does not contain the digest")))
+	})
+
+	It("should fail if the provenance file is signed by an untrusted key", func() {
+		err := VerifyChart(&helmv1alpha1.Chart{Provenance: &helmv1alpha1.ChartProvenance{
+			Raw:     sign(newEntity(), chartDigest),
+			Keyring: armoredKeyring(newEntity()),
+		}}, chartDigest)
+		Expect(err).To(MatchError(ContainSubstring("invalid signature")))
+	})
+
+})
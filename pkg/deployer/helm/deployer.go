@@ -23,6 +23,7 @@ import (
 	cr "github.com/gardener/landscaper/pkg/deployer/lib/continuousreconcile"
 	"github.com/gardener/landscaper/pkg/deployer/lib/extension"
 	"github.com/gardener/landscaper/pkg/deployer/lib/timeout"
+	"github.com/gardener/landscaper/pkg/utils"
 )
 
 const (
@@ -41,6 +42,7 @@ const (
 	TimeoutCheckpointHelmStartCreateManifests      = "helm deployer: start create manifests"
 	TimeoutCheckpointHelmDefaultReadinessChecks    = "helm deployer: default readiness checks"
 	TimeoutCheckpointHelmCustomReadinessChecks     = "helm deployer: custom readiness checks"
+	TimeoutCheckpointHelmBeforeRunningTests        = "helm deployer: before running tests"
 )
 
 // NewDeployer creates a new deployer that reconciles deploy items of type helm.
@@ -115,6 +117,14 @@ func (d *deployer) Reconcile(ctx context.Context, lsCtx *lsv1alpha1.Context, di
 		return err
 	}
 
+	chartMetadataExport, err := ConstructChartMetadataExport(helm.ProviderConfiguration.ChartMetadataExportKey, ch, helm.chartDigest)
+	if err != nil {
+		err = lserrors.NewWrappedError(err, "Reconcile", "ConstructChartMetadataExport", err.Error(),
+			lsv1alpha1.ErrorConfigurationProblem)
+		return err
+	}
+	exports = utils.MergeMaps(exports, chartMetadataExport)
+
 	if _, err := timeout.TimeoutExceeded(ctx, di, TimeoutCheckpointHelmStartApplyFiles); err != nil {
 		return err
 	}
@@ -139,8 +149,9 @@ func (d *deployer) Delete(ctx context.Context, lsCtx *lsv1alpha1.Context, di *ls
 	return helm.DeleteFiles(ctx)
 }
 
+// Abort has nothing to do, as an in-flight installation or upgrade of the release and the application of the manifests
+// are already cancelled by the interruption checks during the reconciliation of the deploy item.
 func (d *deployer) Abort(ctx context.Context, lsCtx *lsv1alpha1.Context, di *lsv1alpha1.DeployItem, rt *lsv1alpha1.ResolvedTarget) error {
-	d.log.Info("abort is not yet implemented")
 	return nil
 }
 
@@ -11,6 +11,8 @@ import (
 	"helm.sh/helm/v3/pkg/chart"
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/runtime/serializer"
 	"k8s.io/client-go/kubernetes"
 	"k8s.io/client-go/kubernetes/scheme"
@@ -59,15 +61,57 @@ func (h *Helm) ApplyFiles(ctx context.Context, filesForManifestDeployer, crdsFor
 		}
 	}
 
-	var deployErr error
+	if h.testsInProgress() {
+		// the chart has already been deployed in the current job and is ready, only the running tests are checked
+		testHooks, err := h.getTestHooks(ctx, currOp, targetClientSet, filesForManifestDeployer, crdsForManifestDeployer, ch)
+		if err != nil {
+			return err
+		}
+		return h.testAndReadExports(ctx, currOp, targetClient, testHooks, exports)
+	}
+
+	// the results of a previous test execution are outdated as soon as the chart is deployed again
+	h.ProviderStatus.TestResults = nil
+	h.ProviderStatus.ChartDigest = h.chartDigest
+	h.ProviderStatus.ChartMetadata = GetChartMetadata(ch)
+
+	var (
+		deployErr        error
+		realHelmDeployer *realhelmdeployer.RealHelmDeployer
+		testHooks        []*unstructured.Unstructured
+	)
+
+	imagePullSecret, err := h.contextImagePullSecret(ctx)
+	if err != nil {
+		return lserrors.NewWrappedError(err, currOp, "GetContextImagePullSecret", err.Error())
+	}
 
 	shouldUseRealHelmDeployer := ptr.Deref[bool](h.ProviderConfiguration.HelmDeployment, true)
 
 	if shouldUseRealHelmDeployer {
 		// Apply helm install/upgrade. Afterwards get the list of deployed resources by helm get release.
 		// The list is filtered, i.e. it contains only the resources that are needed for the default readiness check.
-		realHelmDeployer := realhelmdeployer.NewRealHelmDeployer(ch, h.ProviderConfiguration, h.TargetRestConfig, targetClientSet, h.DeployItem)
-		deployErr = realHelmDeployer.Deploy(ctx)
+		realHelmDeployer = realhelmdeployer.NewRealHelmDeployer(ch, h.ProviderConfiguration, h.TargetRestConfig, targetClientSet, h.DeployItem)
+		if imagePullSecret != nil {
+			realHelmDeployer.SetImagePullSecret(imagePullSecret)
+		}
+		realHelmDeployer.SetScheduling(h.contextScheduling())
+		realHelmDeployer.SetMetadata(deployerlib.ManagedByLabels(nil, h.Configuration.TargetClient), deployerlib.ManagedByAnnotations(h.DeployItem))
+		values, err := ResolveValues(ctx, h.lsUncachedClient, h.DeployItem.Namespace, h.ProviderConfiguration)
+		if err != nil {
+			return lserrors.NewWrappedError(err, currOp, "ResolveHelmValues", err.Error(), lsv1alpha1.ErrorConfigurationProblem)
+		}
+		realHelmDeployer.SetValues(values)
+		if deployerlib.ResourceQuotaCheckEnabled(h.Configuration.TargetClient) {
+			if err := h.checkResourceQuotas(ctx, currOp, targetClient, filesForManifestDeployer); err != nil {
+				return err
+			}
+		}
+		// the in-flight installation or upgrade of the release is cancelled if the deploy item is aborted
+		deployCtx, cancel := interruption.WithInterruption(ctx, interruption.NewStandardInterruptionChecker(h.DeployItem, h.lsUncachedClient),
+			interruption.DefaultWatchInterval)
+		deployErr = realHelmDeployer.Deploy(deployCtx)
+		cancel()
 		if deployErr == nil {
 			managedResourceStatusList, err := realHelmDeployer.GetManagedResourcesStatus(ctx)
 			if err != nil {
@@ -77,10 +121,11 @@ func (h *Helm) ApplyFiles(ctx context.Context, filesForManifestDeployer, crdsFor
 		}
 
 	} else {
-		manifests, err := h.createManifests(ctx, currOp, filesForManifestDeployer, crdsForManifestDeployer)
+		manifests, tests, err := h.createManifests(ctx, currOp, filesForManifestDeployer, crdsForManifestDeployer, imagePullSecret)
 		if err != nil {
 			return err
 		}
+		testHooks = tests
 
 		deployErr = h.applyManifests(ctx, targetClient, targetClientSet, manifests)
 	}
@@ -112,6 +157,28 @@ func (h *Helm) ApplyFiles(ctx context.Context, filesForManifestDeployer, crdsFor
 		return err
 	}
 
+	if h.testsEnabled() && realHelmDeployer != nil {
+		testHooks, err = realHelmDeployer.GetTestHooks(ctx)
+		if err != nil {
+			return lserrors.NewWrappedError(err, currOp, "GetTestHooks", err.Error())
+		}
+	}
+
+	return h.testAndReadExports(ctx, currOp, targetClient, testHooks, exports)
+}
+
+// testAndReadExports runs the chart tests if they are enabled and reads the export values once all tests have succeeded.
+func (h *Helm) testAndReadExports(ctx context.Context, currOp string, targetClient client.Client,
+	testHooks []*unstructured.Unstructured, exports map[string]interface{}) error {
+
+	if h.testsEnabled() {
+		finished, err := h.runTests(ctx, targetClient, testHooks)
+		if err != nil || !finished {
+			// running tests are checked again with the next reconcile, as the deploy item stays progressing
+			return err
+		}
+	}
+
 	if _, err := timeout.TimeoutExceeded(ctx, h.DeployItem, TimeoutCheckpointHelmBeforeReadingExportValues); err != nil {
 		return err
 	}
@@ -132,6 +199,8 @@ func (h *Helm) applyManifests(ctx context.Context, targetClient client.Client, t
 		return err
 	}
 
+	// the progress of a previous reconciliation is outdated
+	h.ProviderStatus.ApplyProgress = nil
 	applier := resourcemanager.NewManifestApplier(resourcemanager.ManifestApplierOptions{
 		Decoder:          serializer.NewCodecFactory(scheme.Scheme).UniversalDecoder(),
 		KubeClient:       targetClient,
@@ -142,11 +211,15 @@ func (h *Helm) applyManifests(ctx context.Context, targetClient client.Client, t
 		UpdateStrategy:   manifestv1alpha2.UpdateStrategy(h.ProviderConfiguration.UpdateStrategy),
 		Manifests:        manifests,
 		ManagedResources: h.ProviderStatus.ManagedResources,
-		Labels: map[string]string{
+		Labels: deployerlib.ManagedByLabels(map[string]string{
 			helmv1alpha1.ManagedDeployItemLabel: h.DeployItem.Name,
-		},
+		}, h.Configuration.TargetClient),
+		Annotations:                deployerlib.ManagedByAnnotations(h.DeployItem),
 		DeletionGroupsDuringUpdate: h.ProviderConfiguration.DeletionGroupsDuringUpdate,
 		InterruptionChecker:        interruption.NewStandardInterruptionChecker(h.DeployItem, h.lsUncachedClient),
+		BatchSize:                  deployerlib.GetApplyBatchSize(h.Configuration.TargetClient),
+		ProgressCheckpoint:         h.checkpointApplyProgress,
+		CheckResourceQuotas:        deployerlib.ResourceQuotaCheckEnabled(h.Configuration.TargetClient),
 	})
 
 	err := applier.Apply(ctx)
@@ -155,27 +228,117 @@ func (h *Helm) applyManifests(ctx context.Context, targetClient client.Client, t
 	return err
 }
 
-func (h *Helm) createManifests(ctx context.Context, currOp string, files, crds map[string]string) ([]managedresource.Manifest, error) {
+// checkResourceQuotas checks whether the resource quotas of the target namespaces admit the templated files
+// before they are deployed by helm.
+func (h *Helm) checkResourceQuotas(ctx context.Context, currOp string, targetClient client.Client, files map[string]string) error {
+	logger, ctx := logging.FromContextOrNew(ctx, []interface{}{lc.KeyMethod, "checkResourceQuotas"})
+
+	objects, err := kutil.ParseFilesToRawExtension(logger, files)
+	if err != nil {
+		return lserrors.NewWrappedError(err, currOp, "DecodeHelmTemplatedObjects", err.Error())
+	}
+	objects, err = deployerlib.ExpandManifests(objects)
+	if err != nil {
+		return lserrors.NewWrappedError(err, currOp, "ExpandManifests", err.Error())
+	}
+	objects, err = h.applyPostRendererPatches(currOp, objects)
+	if err != nil {
+		return err
+	}
+	// test hooks are not deployed together with the release
+	objects, _, err = separateTestHooks(objects)
+	if err != nil {
+		return lserrors.NewWrappedError(err, currOp, "SeparateTestHooks", err.Error())
+	}
+	return deployerlib.CheckResourceQuotas(ctx, targetClient, objects, h.ProviderConfiguration.Namespace)
+}
+
+// applyPostRendererPatches applies the patches of the post renderer configuration to the rendered manifests.
+func (h *Helm) applyPostRendererPatches(currOp string, objects []*runtime.RawExtension) ([]*runtime.RawExtension, error) {
+	postRenderer := h.ProviderConfiguration.PostRenderer
+	if postRenderer == nil || len(postRenderer.Patches) == 0 {
+		return objects, nil
+	}
+	objects, err := deployerlib.ApplyPatchesToManifests(objects, postRenderer.Patches)
+	if err != nil {
+		return nil, lserrors.NewWrappedError(err, currOp, "ApplyPostRendererPatches", err.Error(), lsv1alpha1.ErrorConfigurationProblem)
+	}
+	return objects, nil
+}
+
+// checkpointApplyProgress writes the progress of applying the manifests to the provider status of the deploy item.
+func (h *Helm) checkpointApplyProgress(ctx context.Context, progress managedresource.ApplyProgress) {
+	logger, ctx := logging.FromContextOrNew(ctx, []interface{}{lc.KeyMethod, "checkpointApplyProgress"})
+
+	h.ProviderStatus.ApplyProgress = &progress
+	providerStatus, err := kutil.ConvertToRawExtension(h.ProviderStatus, HelmScheme)
+	if err != nil {
+		logger.Error(err, "unable to encode status")
+		return
+	}
+	h.DeployItem.Status.ProviderStatus = providerStatus
+	if err := h.Writer().UpdateDeployItemStatus(ctx, read_write_layer.W000151, h.DeployItem); err != nil {
+		logger.Error(err, "unable to write apply progress", "applied", progress.AppliedManifests, "total", progress.TotalManifests)
+	}
+}
+
+// createManifests creates the manifests for the applier from the templated files.
+// If the chart tests are enabled, the test hooks are not part of the manifests but returned separately.
+// If an image pull secret is given, it is added to the manifests and injected into all pods and pod templates.
+// The scheduling defaults of the context are injected as well, if this is configured.
+func (h *Helm) createManifests(ctx context.Context, currOp string, files, crds map[string]string, imagePullSecret *corev1.Secret) ([]managedresource.Manifest, []*unstructured.Unstructured, error) {
 	logger, _ := logging.FromContextOrNew(ctx, []interface{}{lc.KeyMethod, "createManifests"})
 
 	if _, err := timeout.TimeoutExceeded(ctx, h.DeployItem, TimeoutCheckpointHelmStartCreateManifests); err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 
 	objects, err := kutil.ParseFilesToRawExtension(logger, files)
 	if err != nil {
-		return nil, lserrors.NewWrappedError(err,
+		return nil, nil, lserrors.NewWrappedError(err,
 			currOp, "DecodeHelmTemplatedObjects", err.Error())
 	}
 
 	objects, err = deployerlib.ExpandManifests(objects)
 	if err != nil {
-		return nil, lserrors.NewWrappedError(err, currOp, "ExpandManifests", err.Error())
+		return nil, nil, lserrors.NewWrappedError(err, currOp, "ExpandManifests", err.Error())
+	}
+
+	objects, err = h.applyPostRendererPatches(currOp, objects)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if imagePullSecret != nil {
+		objects, err = deployerlib.InjectImagePullSecretIntoManifests(objects, imagePullSecret.Name)
+		if err != nil {
+			return nil, nil, lserrors.NewWrappedError(err, currOp, "InjectImagePullSecret", err.Error())
+		}
+		rawSecret, err := kutil.ConvertToRawExtension(imagePullSecret, scheme.Scheme)
+		if err != nil {
+			return nil, nil, fmt.Errorf("unable to marshal image pull secret: %w", err)
+		}
+		objects = append(objects, rawSecret)
+	}
+
+	if scheduling := h.contextScheduling(); scheduling != nil {
+		objects, err = deployerlib.InjectSchedulingDefaultsIntoManifests(objects, scheduling)
+		if err != nil {
+			return nil, nil, lserrors.NewWrappedError(err, currOp, "InjectSchedulingDefaults", err.Error())
+		}
+	}
+
+	var tests []*unstructured.Unstructured
+	if h.testsEnabled() {
+		objects, tests, err = separateTestHooks(objects)
+		if err != nil {
+			return nil, nil, lserrors.NewWrappedError(err, currOp, "SeparateTestHooks", err.Error())
+		}
 	}
 
 	crdObjects, err := kutil.ParseFilesToRawExtension(logger, crds)
 	if err != nil {
-		return nil, lserrors.NewWrappedError(err,
+		return nil, nil, lserrors.NewWrappedError(err,
 			currOp, "DecodeHelmTemplatedObjects", err.Error())
 	}
 
@@ -201,7 +364,7 @@ func (h *Helm) createManifests(ctx context.Context, currOp string, files, crds m
 		ns.Name = h.ProviderConfiguration.Namespace
 		rawNs, err := kutil.ConvertToRawExtension(ns, scheme.Scheme)
 		if err != nil {
-			return nil, fmt.Errorf("unable to marshal release namespace: %w", err)
+			return nil, nil, fmt.Errorf("unable to marshal release namespace: %w", err)
 		}
 		nsManifest := managedresource.Manifest{
 			Policy:   managedresource.KeepPolicy,
@@ -211,11 +374,12 @@ func (h *Helm) createManifests(ctx context.Context, currOp string, files, crds m
 		manifests = append(manifests, nsManifest)
 	}
 
-	return manifests, nil
+	return manifests, tests, nil
 }
 
 // checkResourcesReady checks if the managed resources are Ready/Healthy.
 func (h *Helm) checkResourcesReady(ctx context.Context, client client.Client, failOnMissingObject bool) error {
+	health.SetReadinessChecksRunning(h.DeployItem)
 
 	if !h.ProviderConfiguration.ReadinessChecks.DisableDefault {
 		t, lserr := timeout.TimeoutExceeded(ctx, h.DeployItem, TimeoutCheckpointHelmDefaultReadinessChecks)
@@ -260,6 +424,7 @@ func (h *Helm) checkResourcesReady(ctx context.Context, client client.Client, fa
 		}
 	}
 
+	health.SetReadinessChecksSucceeded(h.DeployItem, h.ProviderConfiguration.ReadinessChecks)
 	return nil
 }
 
@@ -383,6 +548,11 @@ func (h *Helm) constructExportsFromValues(values map[string]interface{}) (map[st
 			return nil, err
 		}
 
+		val, err := resourcemanager.DecodeExportValue(export, val)
+		if err != nil {
+			return nil, err
+		}
+
 		newValue, err := jsonpath.Construct(export.Key, val)
 		if err != nil {
 			return nil, err
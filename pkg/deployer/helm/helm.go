@@ -19,15 +19,13 @@ import (
 	"helm.sh/helm/v3/pkg/chart"
 	"helm.sh/helm/v3/pkg/chartutil"
 	"helm.sh/helm/v3/pkg/engine"
+	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/runtime"
-	"k8s.io/apimachinery/pkg/util/yaml"
 	"k8s.io/client-go/kubernetes"
 	"k8s.io/client-go/rest"
-	"k8s.io/client-go/tools/clientcmd"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	lsv1alpha1 "github.com/gardener/landscaper/apis/core/v1alpha1"
-	"github.com/gardener/landscaper/apis/core/v1alpha1/targettypes"
 	helminstall "github.com/gardener/landscaper/apis/deployer/helm/install"
 	helmv1alpha1 "github.com/gardener/landscaper/apis/deployer/helm/v1alpha1"
 	helmv1alpha1validation "github.com/gardener/landscaper/apis/deployer/helm/v1alpha1/validation"
@@ -71,6 +69,9 @@ type Helm struct {
 	ProviderStatus        *helmv1alpha1.ProviderStatus
 	SharedCache           cache.Cache
 
+	// chartDigest is the digest of the chart archive that has been resolved by Template.
+	chartDigest string
+
 	TargetKubeClient client.Client
 	TargetRestConfig *rest.Config
 	TargetClientSet  kubernetes.Interface
@@ -145,7 +146,13 @@ func (h *Helm) Template(ctx context.Context) (map[string]string, map[string]stri
 
 	useChartCache := helper.HasCacheHelmChartsAnnotation(&h.DeployItem.ObjectMeta)
 
-	ch, err := chartresolver.GetChart(ctx, &h.ProviderConfiguration.Chart, h.lsUncachedClient, h.Context,
+	contextObj, err := chartresolver.AddHelmChartRepoCredentials(h.Context, h.Configuration.HelmChartRepoCredentials)
+	if err != nil {
+		return nil, nil, nil, nil, lserrors.NewWrappedError(err, currOp, "AddHelmChartRepoCredentials", err.Error(),
+			lsv1alpha1.ErrorConfigurationProblem)
+	}
+
+	ch, chartDigest, err := chartresolver.GetChart(ctx, &h.ProviderConfiguration.Chart, h.lsUncachedClient, contextObj,
 		registryPullSecrets, h.Configuration.OCI, h.SharedCache, useChartCache)
 	if err != nil {
 		if h.isDownloadInfoError(err) {
@@ -154,6 +161,13 @@ func (h *Helm) Template(ctx context.Context) (map[string]string, map[string]stri
 		return nil, nil, nil, nil, lserrors.NewWrappedError(err, currOp, "GetHelmChart", err.Error())
 	}
 
+	// the chart is not deployed if it does not match the declared digest or provenance file
+	if err := chartresolver.VerifyChart(&h.ProviderConfiguration.Chart, chartDigest); err != nil {
+		return nil, nil, nil, nil, lserrors.NewWrappedError(err, currOp, "VerifyHelmChart", err.Error(),
+			lsv1alpha1.ErrorConfigurationProblem)
+	}
+	h.chartDigest = chartDigest
+
 	//template chart
 	options := chartutil.ReleaseOptions{
 		Name:      h.ProviderConfiguration.Name,
@@ -162,8 +176,8 @@ func (h *Helm) Template(ctx context.Context) (map[string]string, map[string]stri
 		IsInstall: true,
 	}
 
-	values := make(map[string]interface{})
-	if err := yaml.Unmarshal(h.ProviderConfiguration.Values, &values); err != nil {
+	values, err := ResolveValues(ctx, h.lsUncachedClient, h.DeployItem.Namespace, h.ProviderConfiguration)
+	if err != nil {
 		return nil, nil, nil, nil, lserrors.NewWrappedError(
 			err, currOp, "ParseHelmValues", err.Error(), lsv1alpha1.ErrorConfigurationProblem)
 	}
@@ -173,11 +187,11 @@ func (h *Helm) Template(ctx context.Context) (map[string]string, map[string]stri
 			err, currOp, "PrepareHelmValues", err.Error(), lsv1alpha1.ErrorConfigurationProblem)
 	}
 
-	// the files are only required for the manifest helm deployer
+	// the files are only required for the manifest helm deployer and for the check of the resource quotas
 	var filesForManifestDeployer map[string]string
 	crdsForManifestDeployer := map[string]string{}
 	shouldUseRealHelmDeployer := ptr.Deref[bool](h.ProviderConfiguration.HelmDeployment, true)
-	if !shouldUseRealHelmDeployer {
+	if !shouldUseRealHelmDeployer || lib.ResourceQuotaCheckEnabled(h.Configuration.TargetClient) {
 		filesForManifestDeployer, err = engine.RenderWithClient(ch, values, restConfig)
 		if err != nil {
 			return nil, nil, nil, nil, lserrors.NewWrappedError(
@@ -192,74 +206,67 @@ func (h *Helm) Template(ctx context.Context) (map[string]string, map[string]stri
 	return filesForManifestDeployer, crdsForManifestDeployer, values, ch, nil
 }
 
+// ImagePullSecretName returns the name of the image pull secret that is created in the release namespace
+// if the image pull secrets of the context are injected into the rendered workloads.
+func ImagePullSecretName(releaseName string) string {
+	return fmt.Sprintf("%s-imgpullsec", releaseName)
+}
+
+// contextImagePullSecret returns the image pull secret with the credentials of the image pull secrets of the context,
+// if they have to be injected into the rendered workloads. Otherwise, nil is returned.
+func (h *Helm) contextImagePullSecret(ctx context.Context) (*corev1.Secret, error) {
+	if h.Context == nil || h.Context.ImagePullSecrets == nil || !h.Context.ImagePullSecrets.InjectIntoHelmWorkloads {
+		return nil, nil
+	}
+	dockerConfig, err := lib.GetContextImagePullSecretsDockerConfig(ctx, h.lsUncachedClient, h.Context)
+	if err != nil || dockerConfig == nil {
+		return nil, err
+	}
+	return lib.NewImagePullSecret(ImagePullSecretName(h.ProviderConfiguration.Name), h.ProviderConfiguration.Namespace, dockerConfig), nil
+}
+
+// contextScheduling returns the scheduling defaults of the context, if they have to be injected into the rendered workloads.
+// Otherwise, nil is returned.
+func (h *Helm) contextScheduling() *lsv1alpha1.SchedulingConfiguration {
+	scheduling := lib.GetContextScheduling(h.Context)
+	if scheduling == nil || !scheduling.InjectIntoHelmWorkloads {
+		return nil
+	}
+	return scheduling
+}
+
 func (h *Helm) TargetClient(ctx context.Context) (*rest.Config, client.Client, kubernetes.Interface, error) {
 	if h.TargetKubeClient != nil {
 		return h.TargetRestConfig, h.TargetKubeClient, h.TargetClientSet, nil
 	}
+
+	var (
+		targetClients *lib.TargetClients
+		err           error
+	)
 	// use the configured kubeconfig over the target if defined
 	if len(h.ProviderConfiguration.Kubeconfig) != 0 {
 		kubeconfig, err := base64.StdEncoding.DecodeString(h.ProviderConfiguration.Kubeconfig)
 		if err != nil {
 			return nil, nil, nil, err
 		}
-		cConfig, err := clientcmd.NewClientConfigFromBytes(kubeconfig)
-		if err != nil {
-			return nil, nil, nil, err
-		}
-		restConfig, err := cConfig.ClientConfig()
-		if err != nil {
-			return nil, nil, nil, err
-		}
-
-		kubeClient, err := client.New(restConfig, client.Options{})
+		targetClients, err = lib.NewTargetClientsFromKubeconfig(kubeconfig, h.Configuration.TargetClient)
 		if err != nil {
 			return nil, nil, nil, err
 		}
-
-		clientset, err := kubernetes.NewForConfig(restConfig)
+	} else if h.Target != nil {
+		targetClients, err = lib.NewTargetClients(ctx, h.Target, h.lsUncachedClient, h.Configuration.TargetClient)
 		if err != nil {
 			return nil, nil, nil, err
 		}
-
-		h.TargetRestConfig = restConfig
-		h.TargetKubeClient = kubeClient
-		return restConfig, kubeClient, clientset, nil
+	} else {
+		return nil, nil, nil, errors.New("neither a target nor kubeconfig are defined")
 	}
-	if h.Target != nil {
-		targetConfig := &targettypes.KubernetesClusterTargetConfig{}
-		if err := yaml.Unmarshal([]byte(h.Target.Content), targetConfig); err != nil {
-			return nil, nil, nil, fmt.Errorf("unable to parse target confíguration: %w", err)
-		}
-
-		kubeconfigBytes, err := lib.GetKubeconfigFromTargetConfig(ctx, targetConfig, h.Target.Namespace, h.lsUncachedClient)
-		if err != nil {
-			return nil, nil, nil, err
-		}
 
-		kubeconfig, err := clientcmd.NewClientConfigFromBytes(kubeconfigBytes)
-		if err != nil {
-			return nil, nil, nil, err
-		}
-		restConfig, err := kubeconfig.ClientConfig()
-		if err != nil {
-			return nil, nil, nil, err
-		}
-
-		kubeClient, err := client.New(restConfig, client.Options{})
-		if err != nil {
-			return nil, nil, nil, err
-		}
-		clientset, err := kubernetes.NewForConfig(restConfig)
-		if err != nil {
-			return nil, nil, nil, err
-		}
-
-		h.TargetRestConfig = restConfig
-		h.TargetKubeClient = kubeClient
-		h.TargetClientSet = clientset
-		return restConfig, kubeClient, clientset, nil
-	}
-	return nil, nil, nil, errors.New("neither a target nor kubeconfig are defined")
+	h.TargetRestConfig = targetClients.RestConfig
+	h.TargetKubeClient = targetClients.Client
+	h.TargetClientSet = targetClients.ClientSet
+	return targetClients.RestConfig, targetClients.Client, targetClients.ClientSet, nil
 }
 
 func (h *Helm) isDownloadInfoError(err error) bool {
@@ -76,3 +76,46 @@ func newUpgradeConfiguration(conf *helmv1alpha1.HelmDeploymentConfiguration) (*u
 
 	return upgradeConf, nil
 }
+
+// rollbackConfiguration defines settings for the rollback of a failed helm upgrade operation.
+type rollbackConfiguration struct {
+	// Enabled defines whether a failed upgrade is rolled back to the last deployed revision of the release.
+	Enabled bool `json:"enabled,omitempty"`
+	// CleanupOnFail allows deletion of new resources created in this rollback when the rollback fails.
+	CleanupOnFail bool `json:"cleanupOnFail,omitempty"`
+	// Force forces resource updates through a replacement strategy.
+	Force   bool                 `json:"force,omitempty"`
+	Timeout *lsv1alpha1.Duration `json:"timeout,omitempty"`
+}
+
+func newRollbackConfiguration(conf *helmv1alpha1.HelmDeploymentConfiguration) (*rollbackConfiguration, error) {
+	currOp := "NewRollbackConfiguration"
+
+	rollbackConf := &rollbackConfiguration{}
+
+	if conf != nil && len(conf.Rollback) > 0 {
+		rawConf, err := json.Marshal(conf.Rollback)
+		if err != nil {
+			return nil, lserror.NewWrappedError(err, currOp, "MarshalConfig", err.Error())
+		}
+
+		if err := json.Unmarshal(rawConf, rollbackConf); err != nil {
+			return nil, lserror.NewWrappedError(err, currOp, "UnmarshalConfig", err.Error())
+		}
+	}
+
+	// set defaults
+	if rollbackConf.Timeout == nil {
+		rollbackConf.Timeout = &lsv1alpha1.Duration{Duration: defaultTimeout}
+	}
+
+	return rollbackConf, nil
+}
+
+// GetTestTimeout returns the configured timeout for the chart tests or the default timeout if none is configured.
+func GetTestTimeout(conf *helmv1alpha1.HelmTestConfiguration) time.Duration {
+	if conf == nil || conf.Timeout == nil {
+		return defaultTimeout
+	}
+	return conf.Timeout.Duration
+}
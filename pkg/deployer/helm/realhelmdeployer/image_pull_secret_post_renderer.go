@@ -0,0 +1,77 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package realhelmdeployer
+
+import (
+	"bytes"
+	"fmt"
+	"sort"
+
+	"helm.sh/helm/v3/pkg/postrender"
+	"helm.sh/helm/v3/pkg/releaseutil"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"sigs.k8s.io/yaml"
+
+	"github.com/gardener/landscaper/pkg/deployer/lib"
+)
+
+var _ postrender.PostRenderer = &imagePullSecretPostRenderer{}
+
+// imagePullSecretPostRenderer adds an image pull secret to the rendered manifests of a release
+// and injects it into all rendered pods and pod templates.
+type imagePullSecretPostRenderer struct {
+	secret *corev1.Secret
+}
+
+// Run implements the helm post renderer interface.
+func (r *imagePullSecretPostRenderer) Run(renderedManifests *bytes.Buffer) (*bytes.Buffer, error) {
+	manifests := releaseutil.SplitManifests(renderedManifests.String())
+
+	keys := make([]string, 0, len(manifests))
+	for key := range manifests {
+		keys = append(keys, key)
+	}
+	sort.Sort(releaseutil.BySplitManifestsOrder(keys))
+
+	result := &bytes.Buffer{}
+	for _, key := range keys {
+		obj := &unstructured.Unstructured{}
+		if err := yaml.Unmarshal([]byte(manifests[key]), &obj.Object); err != nil {
+			return nil, fmt.Errorf("unable to decode rendered manifest: %w", err)
+		}
+		if len(obj.Object) == 0 {
+			continue
+		}
+
+		modified, err := lib.InjectImagePullSecret(obj, r.secret.Name)
+		if err != nil {
+			return nil, fmt.Errorf("unable to inject image pull secret into %s %s: %w", obj.GetKind(), obj.GetName(), err)
+		}
+		if !modified {
+			// unmodified manifests are kept as they are, including the comments about their source template
+			result.WriteString("---\n" + manifests[key] + "\n")
+			continue
+		}
+		if err := writeManifest(result, obj.Object); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := writeManifest(result, r.secret); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+func writeManifest(buf *bytes.Buffer, obj interface{}) error {
+	data, err := yaml.Marshal(obj)
+	if err != nil {
+		return fmt.Errorf("unable to encode manifest: %w", err)
+	}
+	buf.WriteString("---\n")
+	buf.Write(data)
+	return nil
+}
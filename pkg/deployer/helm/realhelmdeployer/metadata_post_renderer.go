@@ -0,0 +1,42 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package realhelmdeployer
+
+import (
+	"bytes"
+
+	"helm.sh/helm/v3/pkg/postrender"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+var _ postrender.PostRenderer = &metadataPostRenderer{}
+
+// metadataPostRenderer adds labels and annotations to all rendered manifests of a release.
+type metadataPostRenderer struct {
+	labels      map[string]string
+	annotations map[string]string
+}
+
+// Run implements the helm post renderer interface.
+func (r *metadataPostRenderer) Run(renderedManifests *bytes.Buffer) (*bytes.Buffer, error) {
+	return modifyManifests(renderedManifests, func(obj *unstructured.Unstructured) (bool, error) {
+		obj.SetLabels(mergeMetadata(obj.GetLabels(), r.labels))
+		obj.SetAnnotations(mergeMetadata(obj.GetAnnotations(), r.annotations))
+		return true, nil
+	})
+}
+
+func mergeMetadata(current, additional map[string]string) map[string]string {
+	if len(additional) == 0 {
+		return current
+	}
+	if current == nil {
+		current = make(map[string]string, len(additional))
+	}
+	for key, value := range additional {
+		current[key] = value
+	}
+	return current
+}
@@ -0,0 +1,29 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package realhelmdeployer
+
+import (
+	"bytes"
+
+	"helm.sh/helm/v3/pkg/postrender"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	helmv1alpha1 "github.com/gardener/landscaper/apis/deployer/helm/v1alpha1"
+	"github.com/gardener/landscaper/pkg/deployer/lib"
+)
+
+var _ postrender.PostRenderer = &patchPostRenderer{}
+
+// patchPostRenderer applies the patches of the post renderer configuration to the rendered manifests of a release.
+type patchPostRenderer struct {
+	patches []helmv1alpha1.Patch
+}
+
+// Run implements the helm post renderer interface.
+func (r *patchPostRenderer) Run(renderedManifests *bytes.Buffer) (*bytes.Buffer, error) {
+	return modifyManifests(renderedManifests, func(obj *unstructured.Unstructured) (bool, error) {
+		return lib.ApplyPatches(obj, r.patches)
+	})
+}
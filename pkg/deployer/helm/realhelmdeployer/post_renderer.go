@@ -0,0 +1,69 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package realhelmdeployer
+
+import (
+	"bytes"
+	"fmt"
+	"sort"
+
+	"helm.sh/helm/v3/pkg/postrender"
+	"helm.sh/helm/v3/pkg/releaseutil"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"sigs.k8s.io/yaml"
+)
+
+var _ postrender.PostRenderer = postRendererChain{}
+
+// postRendererChain runs the given post renderers one after another.
+type postRendererChain []postrender.PostRenderer
+
+// Run implements the helm post renderer interface.
+func (c postRendererChain) Run(renderedManifests *bytes.Buffer) (*bytes.Buffer, error) {
+	var err error
+	for _, r := range c {
+		renderedManifests, err = r.Run(renderedManifests)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return renderedManifests, nil
+}
+
+// modifyManifests calls the given function for all rendered manifests.
+// Manifests that are not modified by the function are kept as they are, including the comments about their source template.
+func modifyManifests(renderedManifests *bytes.Buffer, modify func(obj *unstructured.Unstructured) (bool, error)) (*bytes.Buffer, error) {
+	manifests := releaseutil.SplitManifests(renderedManifests.String())
+
+	keys := make([]string, 0, len(manifests))
+	for key := range manifests {
+		keys = append(keys, key)
+	}
+	sort.Sort(releaseutil.BySplitManifestsOrder(keys))
+
+	result := &bytes.Buffer{}
+	for _, key := range keys {
+		obj := &unstructured.Unstructured{}
+		if err := yaml.Unmarshal([]byte(manifests[key]), &obj.Object); err != nil {
+			return nil, fmt.Errorf("unable to decode rendered manifest: %w", err)
+		}
+		if len(obj.Object) == 0 {
+			continue
+		}
+
+		modified, err := modify(obj)
+		if err != nil {
+			return nil, err
+		}
+		if !modified {
+			result.WriteString("---\n" + manifests[key] + "\n")
+			continue
+		}
+		if err := writeManifest(result, obj.Object); err != nil {
+			return nil, err
+		}
+	}
+	return result, nil
+}
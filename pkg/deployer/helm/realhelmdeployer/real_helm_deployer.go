@@ -6,19 +6,21 @@ package realhelmdeployer
 
 import (
 	"context"
-	"encoding/json"
 	"fmt"
 	"io"
 	"os"
 	"strings"
+	"time"
 
 	"helm.sh/helm/v3/pkg/action"
 	"helm.sh/helm/v3/pkg/chart"
 	"helm.sh/helm/v3/pkg/kube"
+	"helm.sh/helm/v3/pkg/postrender"
 	"helm.sh/helm/v3/pkg/release"
 	"helm.sh/helm/v3/pkg/storage"
 	"helm.sh/helm/v3/pkg/storage/driver"
 	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/apimachinery/pkg/runtime/serializer"
@@ -33,7 +35,6 @@ import (
 	helmv1alpha1 "github.com/gardener/landscaper/apis/deployer/helm/v1alpha1"
 	"github.com/gardener/landscaper/apis/deployer/utils/managedresource"
 	lserror "github.com/gardener/landscaper/apis/errors"
-	lserrors "github.com/gardener/landscaper/apis/errors"
 	"github.com/gardener/landscaper/controller-utils/pkg/logging"
 	lc "github.com/gardener/landscaper/controller-utils/pkg/logging/constants"
 	"github.com/gardener/landscaper/pkg/deployer/lib/readinesscheck"
@@ -45,6 +46,7 @@ const (
 	TimeoutCheckpointHelmBeforeInstallingRelease = "helm deployer: before installing release"
 	TimeoutCheckpointHelmBeforeUpgradingRelease  = "helm deployer: before upgrading release"
 	TimeoutCheckpointHelmBeforeDeletingRelease   = "helm deployer: before deleting release"
+	TimeoutCheckpointHelmBeforeRollingBack       = "helm deployer: before rolling back release"
 )
 
 type RealHelmDeployer struct {
@@ -52,13 +54,19 @@ type RealHelmDeployer struct {
 	decoder            runtime.Decoder
 	releaseName        string
 	defaultNamespace   string
-	rawValues          json.RawMessage
+	values             map[string]interface{}
 	helmConfig         *helmv1alpha1.HelmDeploymentConfiguration
+	testConfig         *helmv1alpha1.HelmTestConfiguration
 	createNamespace    bool
 	targetRestConfig   *rest.Config
 	apiResourceHandler *resourcemanager.ApiResourceHandler
 	helmSecretManager  *HelmSecretManager
 	di                 *lsv1alpha1.DeployItem
+	imagePullSecret    *corev1.Secret
+	scheduling         *lsv1alpha1.SchedulingConfiguration
+	postRendererConfig *helmv1alpha1.PostRendererConfiguration
+	labels             map[string]string
+	annotations        map[string]string
 }
 
 func NewRealHelmDeployer(ch *chart.Chart, providerConfig *helmv1alpha1.ProviderConfiguration, targetRestConfig *rest.Config,
@@ -69,21 +77,70 @@ func NewRealHelmDeployer(ch *chart.Chart, providerConfig *helmv1alpha1.ProviderC
 		decoder:            serializer.NewCodecFactory(scheme.Scheme).UniversalDecoder(),
 		releaseName:        providerConfig.Name,
 		defaultNamespace:   providerConfig.Namespace,
-		rawValues:          providerConfig.Values,
 		helmConfig:         providerConfig.HelmDeploymentConfig,
+		testConfig:         providerConfig.Tests,
 		createNamespace:    providerConfig.CreateNamespace,
 		targetRestConfig:   targetRestConfig,
 		apiResourceHandler: resourcemanager.CreateApiResourceHandler(clientset),
 		helmSecretManager:  nil,
 		di:                 di,
+		postRendererConfig: providerConfig.PostRenderer,
 	}
 }
 
+// SetImagePullSecret sets an image pull secret that is added to the release and injected into all rendered pods and pod templates.
+func (c *RealHelmDeployer) SetImagePullSecret(secret *corev1.Secret) {
+	c.imagePullSecret = secret
+}
+
+// SetScheduling sets scheduling defaults that are injected into all rendered pods and pod templates.
+func (c *RealHelmDeployer) SetScheduling(scheduling *lsv1alpha1.SchedulingConfiguration) {
+	c.scheduling = scheduling
+}
+
+// SetMetadata sets labels and annotations that are added to all rendered manifests of the release.
+func (c *RealHelmDeployer) SetMetadata(labels, annotations map[string]string) {
+	c.labels = labels
+	c.annotations = annotations
+}
+
+// SetValues sets the values of the release.
+func (c *RealHelmDeployer) SetValues(values map[string]interface{}) {
+	c.values = values
+}
+
+// postRenderer returns the post renderer of the release, which is nil if the rendered manifests need no modification.
+func (c *RealHelmDeployer) postRenderer() postrender.PostRenderer {
+	chain := postRendererChain{}
+	if c.postRendererConfig != nil && len(c.postRendererConfig.Patches) != 0 {
+		chain = append(chain, &patchPostRenderer{patches: c.postRendererConfig.Patches})
+	}
+	if c.imagePullSecret != nil {
+		chain = append(chain, &imagePullSecretPostRenderer{secret: c.imagePullSecret})
+	}
+	if c.scheduling != nil {
+		chain = append(chain, &schedulingPostRenderer{scheduling: c.scheduling})
+	}
+	if len(c.labels) != 0 || len(c.annotations) != 0 {
+		chain = append(chain, &metadataPostRenderer{labels: c.labels, annotations: c.annotations})
+	}
+
+	switch len(chain) {
+	case 0:
+		return nil
+	case 1:
+		return chain[0]
+	default:
+		return chain
+	}
+}
+
+// Deploy installs or upgrades the release.
+// An in-flight installation or upgrade is cancelled when the context is cancelled.
 func (c *RealHelmDeployer) Deploy(ctx context.Context) error {
-	values := make(map[string]interface{})
-	if err := yaml.Unmarshal(c.rawValues, &values); err != nil {
-		return lserrors.NewWrappedError(
-			err, "Deploy", "ParseHelmValues", err.Error(), lsv1alpha1.ErrorConfigurationProblem)
+	values := c.values
+	if values == nil {
+		values = make(map[string]interface{})
 	}
 
 	_, err := c.getRelease(ctx)
@@ -149,6 +206,7 @@ func (c *RealHelmDeployer) installRelease(ctx context.Context, values map[string
 	install.Namespace = c.defaultNamespace
 	install.CreateNamespace = c.createNamespace
 	install.Atomic = installConfig.Atomic
+	install.PostRenderer = c.postRenderer()
 
 	timeout, err := timeout.TimeoutExceeded(ctx, c.di, TimeoutCheckpointHelmBeforeInstallingRelease)
 	if err != nil {
@@ -158,8 +216,10 @@ func (c *RealHelmDeployer) installRelease(ctx context.Context, values map[string
 
 	logger.Debug(fmt.Sprintf("installing helm chart release %s", c.releaseName))
 
-	rel, err := install.Run(c.chart, values)
+	rel, err := install.RunWithContext(ctx, c.chart, values)
 	if err != nil {
+		// the context is cancelled if the deploy item has been aborted, but the release has to be unblocked anyway
+		ctx = context.WithoutCancel(ctx)
 		c.unblockPendingHelmRelease(ctx, logger)
 
 		message := fmt.Sprintf("unable to install helm chart release: %s", err.Error())
@@ -201,6 +261,7 @@ func (c *RealHelmDeployer) upgradeRelease(ctx context.Context, values map[string
 	upgrade.Namespace = c.defaultNamespace
 	upgrade.MaxHistory = 10
 	upgrade.Atomic = upgradeConfig.Atomic
+	upgrade.PostRenderer = c.postRenderer()
 
 	timeout, err := timeout.TimeoutExceeded(ctx, c.di, TimeoutCheckpointHelmBeforeUpgradingRelease)
 	if err != nil {
@@ -210,8 +271,15 @@ func (c *RealHelmDeployer) upgradeRelease(ctx context.Context, values map[string
 
 	logger.Info(fmt.Sprintf("upgrading helm chart release %s", c.releaseName))
 
-	rel, err := upgrade.Run(c.releaseName, c.chart, values)
+	rel, err := upgrade.RunWithContext(ctx, c.releaseName, c.chart, values)
 	if err != nil {
+		// the context is cancelled if the deploy item has been aborted, but the release has to be unblocked anyway
+		ctx = context.WithoutCancel(ctx)
+
+		// an atomic upgrade is already rolled back by helm
+		if !upgradeConfig.Atomic {
+			c.rollbackRelease(ctx, logger)
+		}
 		c.unblockPendingHelmRelease(ctx, logger)
 
 		message := fmt.Sprintf("unable to upgrade helm chart release: %s", err.Error())
@@ -228,6 +296,92 @@ func (c *RealHelmDeployer) upgradeRelease(ctx context.Context, values map[string
 	return rel, nil
 }
 
+// rollbackRelease rolls back a release after a failed upgrade to the last deployed revision, if this is configured.
+// A failed rollback is only logged, because the error of the upgrade is the relevant one for the deploy item.
+func (c *RealHelmDeployer) rollbackRelease(ctx context.Context, logger logging.Logger) {
+	rollbackConfig, err := newRollbackConfiguration(c.helmConfig)
+	if err != nil {
+		logger.Error(err, "unable to read rollback configuration")
+		return
+	}
+
+	if !rollbackConfig.Enabled {
+		return
+	}
+
+	remaining, err := timeout.TimeoutExceeded(ctx, c.di, TimeoutCheckpointHelmBeforeRollingBack)
+	if err != nil {
+		logger.Info("skipping rollback of helm chart release", lc.KeyError, err.Error())
+		return
+	}
+
+	actionConfig, err := c.initActionConfig(ctx)
+	if err != nil {
+		logger.Error(err, "unable to init helm action configuration for rollback")
+		return
+	}
+
+	// the rollback must not exceed the progressing timeout of the deploy item
+	rollbackTimeout := rollbackConfig.Timeout.Duration
+	if remaining < rollbackTimeout {
+		rollbackTimeout = remaining
+	}
+
+	revision, err := c.rollback(actionConfig, rollbackConfig, rollbackTimeout)
+	if err != nil {
+		logger.Error(err, "unable to roll back helm chart release")
+		return
+	}
+	if revision == 0 {
+		logger.Info(fmt.Sprintf("skipping rollback of helm chart release %s, because its latest revision has neither failed nor is pending", c.releaseName))
+		return
+	}
+
+	logger.Info(fmt.Sprintf("%s successfully rolled back to revision %d in %s", c.releaseName, revision, c.defaultNamespace))
+}
+
+// rollback rolls back the release to its last deployed revision, if the latest revision has failed or is pending.
+// It returns the revision to which the release has been rolled back, or 0 if no rollback was necessary.
+func (c *RealHelmDeployer) rollback(actionConfig *action.Configuration, rollbackConfig *rollbackConfiguration,
+	rollbackTimeout time.Duration) (int, error) {
+
+	last, err := actionConfig.Releases.Last(c.releaseName)
+	if err != nil {
+		return 0, fmt.Errorf("unable to get latest revision: %w", err)
+	}
+	if last.Info == nil || (last.Info.Status != release.StatusFailed && !last.Info.Status.IsPending()) {
+		return 0, nil
+	}
+
+	history, err := actionConfig.Releases.History(c.releaseName)
+	if err != nil {
+		return 0, fmt.Errorf("unable to get release history: %w", err)
+	}
+
+	revision := 0
+	for _, rel := range history {
+		if rel.Info != nil && rel.Info.Status == release.StatusDeployed && rel.Version > revision && rel.Version < last.Version {
+			revision = rel.Version
+		}
+	}
+	if revision == 0 {
+		return 0, fmt.Errorf("release %s has no deployed revision to roll back to", c.releaseName)
+	}
+
+	rollback := action.NewRollback(actionConfig)
+	rollback.Version = revision
+	rollback.MaxHistory = 10
+	rollback.CleanupOnFail = rollbackConfig.CleanupOnFail
+	rollback.Force = rollbackConfig.Force
+	rollback.Wait = true
+	rollback.Timeout = rollbackTimeout
+
+	if err := rollback.Run(c.releaseName); err != nil {
+		return 0, err
+	}
+	return revision, nil
+}
+
 func (c *RealHelmDeployer) isHelmUpgradeMessage(message string) bool {
 	return strings.Contains(message, "rendered manifests contain a resource that already exists. Unable to continue with update") ||
 		strings.Contains(message, "pre-upgrade hooks failed") ||
@@ -235,6 +389,40 @@ func (c *RealHelmDeployer) isHelmUpgradeMessage(message string) bool {
 		strings.Contains(message, "YAML parse error on")
 }
 
+// GetTestHooks returns the test hooks of the deployed release.
+// The tests are not executed by helm, so that the deployer can await them without blocking the reconciliation.
+func (c *RealHelmDeployer) GetTestHooks(ctx context.Context) ([]*unstructured.Unstructured, error) {
+	currOp := "GetTestHooks"
+
+	rel, err := c.getRelease(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	tests := make([]*unstructured.Unstructured, 0)
+	for _, hook := range rel.Hooks {
+		if !isTestHook(hook) {
+			continue
+		}
+
+		obj := &unstructured.Unstructured{}
+		if err := yaml.Unmarshal([]byte(hook.Manifest), &obj.Object); err != nil {
+			return nil, lserror.NewWrappedError(err, currOp, "DecodeTestHook", err.Error())
+		}
+		tests = append(tests, obj)
+	}
+	return tests, nil
+}
+
+func isTestHook(hook *release.Hook) bool {
+	for _, event := range hook.Events {
+		if event == release.HookTest {
+			return true
+		}
+	}
+	return false
+}
+
 func (c *RealHelmDeployer) deleteRelease(ctx context.Context) error {
 	currOp := "DeleteHelmRelease"
 	logger, ctx := logging.FromContextOrNew(ctx, []interface{}{lc.KeyMethod, currOp})
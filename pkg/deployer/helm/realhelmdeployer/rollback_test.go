@@ -0,0 +1,112 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package realhelmdeployer
+
+import (
+	"io"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"helm.sh/helm/v3/pkg/action"
+	kubefake "helm.sh/helm/v3/pkg/kube/fake"
+	"helm.sh/helm/v3/pkg/release"
+	"helm.sh/helm/v3/pkg/storage"
+	"helm.sh/helm/v3/pkg/storage/driver"
+)
+
+var _ = Describe("Rollback", func() {
+
+	const (
+		releaseName = "test"
+		namespace   = "default"
+	)
+
+	var (
+		actionConfig *action.Configuration
+		deployer     *RealHelmDeployer
+	)
+
+	addRevision := func(version int, status release.Status) {
+		rel := release.Mock(&release.MockReleaseOptions{
+			Name:      releaseName,
+			Namespace: namespace,
+			Version:   version,
+			Status:    status,
+		})
+		Expect(actionConfig.Releases.Create(rel)).To(Succeed())
+	}
+
+	getStatus := func(version int) release.Status {
+		rel, err := actionConfig.Releases.Get(releaseName, version)
+		Expect(err).ToNot(HaveOccurred())
+		return rel.Info.Status
+	}
+
+	BeforeEach(func() {
+		actionConfig = &action.Configuration{
+			Releases:   storage.Init(driver.NewMemory()),
+			KubeClient: &kubefake.PrintingKubeClient{Out: io.Discard},
+			Log:        func(string, ...interface{}) {},
+		}
+		deployer = &RealHelmDeployer{releaseName: releaseName, defaultNamespace: namespace}
+	})
+
+	It("should roll back a failed revision to the last deployed revision", func() {
+		addRevision(1, release.StatusSuperseded)
+		addRevision(2, release.StatusDeployed)
+		addRevision(3, release.StatusFailed)
+
+		revision, err := deployer.rollback(actionConfig, &rollbackConfiguration{}, time.Minute)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(revision).To(Equal(2))
+
+		last, err := actionConfig.Releases.Last(releaseName)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(last.Version).To(Equal(4))
+		Expect(last.Info.Status).To(Equal(release.StatusDeployed))
+		Expect(last.Info.Description).To(Equal("Rollback to 2"))
+		Expect(getStatus(2)).To(Equal(release.StatusSuperseded))
+	})
+
+	It("should roll back a pending revision", func() {
+		addRevision(1, release.StatusDeployed)
+		addRevision(2, release.StatusPendingUpgrade)
+
+		revision, err := deployer.rollback(actionConfig, &rollbackConfiguration{}, time.Minute)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(revision).To(Equal(1))
+	})
+
+	It("should skip failed revisions when searching the revision to roll back to", func() {
+		addRevision(1, release.StatusDeployed)
+		addRevision(2, release.StatusFailed)
+		addRevision(3, release.StatusFailed)
+
+		revision, err := deployer.rollback(actionConfig, &rollbackConfiguration{}, time.Minute)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(revision).To(Equal(1))
+	})
+
+	It("should not roll back if the latest revision is deployed", func() {
+		addRevision(1, release.StatusSuperseded)
+		addRevision(2, release.StatusDeployed)
+
+		revision, err := deployer.rollback(actionConfig, &rollbackConfiguration{}, time.Minute)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(revision).To(Equal(0))
+
+		last, err := actionConfig.Releases.Last(releaseName)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(last.Version).To(Equal(2))
+	})
+
+	It("should fail if there is no deployed revision", func() {
+		addRevision(1, release.StatusFailed)
+
+		_, err := deployer.rollback(actionConfig, &rollbackConfiguration{}, time.Minute)
+		Expect(err).To(HaveOccurred())
+	})
+})
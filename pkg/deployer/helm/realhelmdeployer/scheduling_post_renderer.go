@@ -0,0 +1,35 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package realhelmdeployer
+
+import (
+	"bytes"
+	"fmt"
+
+	"helm.sh/helm/v3/pkg/postrender"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	lsv1alpha1 "github.com/gardener/landscaper/apis/core/v1alpha1"
+	"github.com/gardener/landscaper/pkg/deployer/lib"
+)
+
+var _ postrender.PostRenderer = &schedulingPostRenderer{}
+
+// schedulingPostRenderer injects the node selector and the tolerations of the scheduling defaults
+// into all rendered pods and pod templates of a release.
+type schedulingPostRenderer struct {
+	scheduling *lsv1alpha1.SchedulingConfiguration
+}
+
+// Run implements the helm post renderer interface.
+func (r *schedulingPostRenderer) Run(renderedManifests *bytes.Buffer) (*bytes.Buffer, error) {
+	return modifyManifests(renderedManifests, func(obj *unstructured.Unstructured) (bool, error) {
+		modified, err := lib.InjectSchedulingDefaults(obj, r.scheduling)
+		if err != nil {
+			return false, fmt.Errorf("unable to inject scheduling defaults into %s %s: %w", obj.GetKind(), obj.GetName(), err)
+		}
+		return modified, nil
+	})
+}
@@ -0,0 +1,328 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package helm
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"helm.sh/helm/v3/pkg/chart"
+	"helm.sh/helm/v3/pkg/release"
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	helmv1alpha1 "github.com/gardener/landscaper/apis/deployer/helm/v1alpha1"
+	lserrors "github.com/gardener/landscaper/apis/errors"
+	kutil "github.com/gardener/landscaper/controller-utils/pkg/kubernetes"
+	"github.com/gardener/landscaper/controller-utils/pkg/logging"
+	lc "github.com/gardener/landscaper/controller-utils/pkg/logging/constants"
+	"github.com/gardener/landscaper/pkg/deployer/helm/realhelmdeployer"
+	"github.com/gardener/landscaper/pkg/deployer/lib/timeout"
+)
+
+// legacyHookTestSuccess is the helm 2 name of the test hook which is still supported by helm 3.
+const legacyHookTestSuccess = "test-success"
+
+// testsEnabled checks whether the chart tests have to be executed after an install or upgrade.
+func (h *Helm) testsEnabled() bool {
+	return h.ProviderConfiguration.Tests != nil && h.ProviderConfiguration.Tests.Enabled
+}
+
+// isTestHook checks whether the given object is a test hook of a chart.
+func isTestHook(obj *unstructured.Unstructured) bool {
+	hooks, ok := obj.GetAnnotations()[release.HookAnnotation]
+	if !ok {
+		return false
+	}
+	for _, hook := range strings.Split(hooks, ",") {
+		hook = strings.TrimSpace(hook)
+		if hook == string(release.HookTest) || hook == legacyHookTestSuccess {
+			return true
+		}
+	}
+	return false
+}
+
+// hasHookDeletePolicy checks whether the given hook object has the given delete policy.
+func hasHookDeletePolicy(obj *unstructured.Unstructured, policy release.HookDeletePolicy) bool {
+	policies := obj.GetAnnotations()[release.HookDeleteAnnotation]
+	for _, p := range strings.Split(policies, ",") {
+		if strings.TrimSpace(p) == string(policy) {
+			return true
+		}
+	}
+	return false
+}
+
+// separateTestHooks removes the test hooks from the given objects and returns them separately.
+func separateTestHooks(objects []*runtime.RawExtension) ([]*runtime.RawExtension, []*unstructured.Unstructured, error) {
+	others := make([]*runtime.RawExtension, 0, len(objects))
+	tests := make([]*unstructured.Unstructured, 0)
+	for _, raw := range objects {
+		obj := &unstructured.Unstructured{}
+		if err := json.Unmarshal(raw.Raw, &obj.Object); err != nil {
+			return nil, nil, fmt.Errorf("unable to decode object: %w", err)
+		}
+		if isTestHook(obj) {
+			tests = append(tests, obj)
+		} else {
+			others = append(others, raw)
+		}
+	}
+	return others, tests, nil
+}
+
+// sortTestHooks sorts the test hooks by their weight and name like helm does.
+func sortTestHooks(tests []*unstructured.Unstructured) {
+	weight := func(obj *unstructured.Unstructured) int {
+		w, err := strconv.Atoi(obj.GetAnnotations()[release.HookWeightAnnotation])
+		if err != nil {
+			return 0
+		}
+		return w
+	}
+	sort.SliceStable(tests, func(i, j int) bool {
+		wi, wj := weight(tests[i]), weight(tests[j])
+		if wi != wj {
+			return wi < wj
+		}
+		return tests[i].GetName() < tests[j].GetName()
+	})
+}
+
+// testsInProgress checks whether the chart tests have been started for the current job of the deploy item,
+// but are not finished yet. In this case the chart is not deployed again, only the tests are continued.
+func (h *Helm) testsInProgress() bool {
+	return h.testsEnabled() && h.ProviderStatus != nil && len(h.ProviderStatus.TestsJobID) != 0 &&
+		h.ProviderStatus.TestsJobID == h.DeployItem.Status.GetJobID()
+}
+
+// getTestHooks returns the test hooks of an already deployed chart.
+// With a real helm deployment they are taken from the release, otherwise the chart is templated again.
+func (h *Helm) getTestHooks(ctx context.Context, currOp string, targetClientSet kubernetes.Interface,
+	files, crds map[string]string, ch *chart.Chart) ([]*unstructured.Unstructured, error) {
+
+	if ptr.Deref[bool](h.ProviderConfiguration.HelmDeployment, true) {
+		realHelmDeployer := realhelmdeployer.NewRealHelmDeployer(ch, h.ProviderConfiguration, h.TargetRestConfig, targetClientSet, h.DeployItem)
+		tests, err := realHelmDeployer.GetTestHooks(ctx)
+		if err != nil {
+			return nil, lserrors.NewWrappedError(err, currOp, "GetTestHooks", err.Error())
+		}
+		return tests, nil
+	}
+
+	imagePullSecret, err := h.contextImagePullSecret(ctx)
+	if err != nil {
+		return nil, lserrors.NewWrappedError(err, currOp, "GetContextImagePullSecret", err.Error())
+	}
+	_, tests, err := h.createManifests(ctx, currOp, files, crds, imagePullSecret)
+	return tests, err
+}
+
+// runTests starts or continues the execution of the chart tests and stores their results in the provider status.
+// The tests are not awaited. It returns true if all tests have succeeded. As long as a test is running,
+// false is returned and the deploy item stays progressing, so that the tests are checked again with the next reconcile.
+func (h *Helm) runTests(ctx context.Context, targetClient client.Client, testHooks []*unstructured.Unstructured) (bool, error) {
+	currOp := "RunTests"
+
+	if !h.testsInProgress() {
+		h.ProviderStatus.TestsJobID = h.DeployItem.Status.GetJobID()
+		h.ProviderStatus.TestsStartTime = &metav1.Time{Time: time.Now()}
+		h.ProviderStatus.TestResults = nil
+	}
+
+	finished, testErr := h.runTestHooks(ctx, targetClient, testHooks)
+	if finished || testErr != nil {
+		h.ProviderStatus.TestsJobID = ""
+		h.ProviderStatus.TestsStartTime = nil
+	}
+
+	var err error
+	h.DeployItem.Status.ProviderStatus, err = kutil.ConvertToRawExtension(h.ProviderStatus, HelmScheme)
+	if err != nil {
+		return false, lserrors.NewWrappedError(err, currOp, "ProviderStatus", err.Error())
+	}
+
+	return finished, testErr
+}
+
+// runTestHooks creates the test hooks one after another. A test hook is only created when the previous one
+// has succeeded. The execution stops at the first failed test.
+func (h *Helm) runTestHooks(ctx context.Context, targetClient client.Client,
+	tests []*unstructured.Unstructured) (bool, error) {
+
+	currOp := "RunTestHooks"
+	_, ctx = logging.FromContextOrNew(ctx, nil, lc.KeyMethod, currOp)
+
+	if _, lsErr := timeout.TimeoutExceeded(ctx, h.DeployItem, TimeoutCheckpointHelmBeforeRunningTests); lsErr != nil {
+		return false, lsErr
+	}
+	testTimeout := realhelmdeployer.GetTestTimeout(h.ProviderConfiguration.Tests)
+	if time.Since(h.ProviderStatus.TestsStartTime.Time) > testTimeout {
+		msg := fmt.Sprintf("tests did not finish within %s", testTimeout.String())
+		return false, lserrors.NewError(currOp, "TestTimeout", msg)
+	}
+
+	sortTestHooks(tests)
+
+	results := make([]helmv1alpha1.HelmTestResult, 0, len(tests))
+	for _, test := range tests {
+		result := getTestResult(h.ProviderStatus.TestResults, test)
+		finished, err := h.runTestHook(ctx, targetClient, test, &result)
+		results = append(results, result)
+		if err != nil {
+			h.ProviderStatus.TestResults = results
+			return false, lserrors.NewWrappedError(err, currOp, "Test", err.Error())
+		}
+		if !finished {
+			h.ProviderStatus.TestResults = results
+			return false, nil
+		}
+	}
+
+	h.ProviderStatus.TestResults = results
+	return true, nil
+}
+
+// getTestResult returns the stored result of the given test hook, or a new result if the test has not been started.
+func getTestResult(results []helmv1alpha1.HelmTestResult, test *unstructured.Unstructured) helmv1alpha1.HelmTestResult {
+	for _, result := range results {
+		if result.Name == test.GetName() && result.Kind == test.GetKind() {
+			return result
+		}
+	}
+	return helmv1alpha1.HelmTestResult{
+		Name:  test.GetName(),
+		Kind:  test.GetKind(),
+		Phase: helmv1alpha1.HelmTestPhaseUnknown,
+	}
+}
+
+// runTestHook starts a test hook or checks the phase of a running test hook and updates the given result.
+// It returns true if the test has succeeded.
+func (h *Helm) runTestHook(ctx context.Context, targetClient client.Client,
+	test *unstructured.Unstructured, result *helmv1alpha1.HelmTestResult) (bool, error) {
+
+	if result.Phase == helmv1alpha1.HelmTestPhaseSucceeded {
+		return true, nil
+	}
+
+	obj := test.DeepCopy()
+	if len(obj.GetNamespace()) == 0 {
+		obj.SetNamespace(h.ProviderConfiguration.Namespace)
+	}
+
+	logger, ctx := logging.FromContextOrNew(ctx, nil,
+		lc.KeyResourceKind, obj.GetKind(), lc.KeyResource, client.ObjectKeyFromObject(obj).String())
+
+	if result.Phase == helmv1alpha1.HelmTestPhaseRunning {
+		if err := targetClient.Get(ctx, client.ObjectKeyFromObject(obj), obj); err != nil {
+			result.Message = fmt.Sprintf("unable to get test: %s", err.Error())
+			return false, fmt.Errorf("unable to get test %s %s: %w", obj.GetKind(), obj.GetName(), err)
+		}
+	} else {
+		// the object of a previous test run has to be removed before the test can be started again
+		deleted, err := deleteTestHook(ctx, targetClient, obj)
+		if err != nil {
+			result.Message = err.Error()
+			return false, err
+		}
+		if !deleted {
+			result.Message = "waiting for the deletion of the previous test"
+			return false, nil
+		}
+
+		logger.Info("starting test")
+		if err := targetClient.Create(ctx, obj); err != nil {
+			result.Message = fmt.Sprintf("unable to create test: %s", err.Error())
+			return false, fmt.Errorf("unable to create test %s %s: %w", obj.GetKind(), obj.GetName(), err)
+		}
+	}
+
+	result.Phase, result.Message = getTestHookPhase(obj)
+	switch result.Phase {
+	case helmv1alpha1.HelmTestPhaseFailed:
+		return false, fmt.Errorf("test %s %s failed: %s", obj.GetKind(), obj.GetName(), result.Message)
+	case helmv1alpha1.HelmTestPhaseSucceeded:
+		if hasHookDeletePolicy(test, release.HookSucceeded) {
+			if _, err := deleteTestHook(ctx, targetClient, obj); err != nil {
+				logger.Info("unable to delete succeeded test", lc.KeyError, err.Error())
+			}
+		}
+		return true, nil
+	default:
+		return false, nil
+	}
+}
+
+// deleteTestHook deletes the given test hook object. It returns true if the object does not exist anymore.
+func deleteTestHook(ctx context.Context, targetClient client.Client, obj *unstructured.Unstructured) (bool, error) {
+	existing := &unstructured.Unstructured{}
+	existing.SetGroupVersionKind(obj.GroupVersionKind())
+	existing.SetNamespace(obj.GetNamespace())
+	existing.SetName(obj.GetName())
+
+	propagation := metav1.DeletePropagationBackground
+	if err := targetClient.Delete(ctx, existing, &client.DeleteOptions{PropagationPolicy: &propagation}); err != nil {
+		if apierrors.IsNotFound(err) {
+			return true, nil
+		}
+		return false, fmt.Errorf("unable to delete previous test %s %s: %w", obj.GetKind(), obj.GetName(), err)
+	}
+	return false, nil
+}
+
+// getTestHookPhase determines the phase of a test hook.
+// Like in helm only pods and jobs are awaited, all other kinds are considered to be succeeded once they are created.
+func getTestHookPhase(obj *unstructured.Unstructured) (helmv1alpha1.HelmTestPhase, string) {
+	switch obj.GroupVersionKind().GroupKind() {
+	case corev1.SchemeGroupVersion.WithKind("Pod").GroupKind():
+		pod := &corev1.Pod{}
+		if err := runtime.DefaultUnstructuredConverter.FromUnstructured(obj.Object, pod); err != nil {
+			return helmv1alpha1.HelmTestPhaseUnknown, err.Error()
+		}
+		switch pod.Status.Phase {
+		case corev1.PodSucceeded:
+			return helmv1alpha1.HelmTestPhaseSucceeded, pod.Status.Message
+		case corev1.PodFailed:
+			return helmv1alpha1.HelmTestPhaseFailed, pod.Status.Message
+		default:
+			return helmv1alpha1.HelmTestPhaseRunning, pod.Status.Message
+		}
+
+	case batchv1.SchemeGroupVersion.WithKind("Job").GroupKind():
+		job := &batchv1.Job{}
+		if err := runtime.DefaultUnstructuredConverter.FromUnstructured(obj.Object, job); err != nil {
+			return helmv1alpha1.HelmTestPhaseUnknown, err.Error()
+		}
+		for _, cond := range job.Status.Conditions {
+			if cond.Status != corev1.ConditionTrue {
+				continue
+			}
+			switch cond.Type {
+			case batchv1.JobComplete:
+				return helmv1alpha1.HelmTestPhaseSucceeded, cond.Message
+			case batchv1.JobFailed:
+				return helmv1alpha1.HelmTestPhaseFailed, cond.Message
+			}
+		}
+		return helmv1alpha1.HelmTestPhaseRunning, ""
+
+	default:
+		return helmv1alpha1.HelmTestPhaseSucceeded, ""
+	}
+}
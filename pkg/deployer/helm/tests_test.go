@@ -0,0 +1,210 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package helm
+
+import (
+	"context"
+	"encoding/json"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"helm.sh/helm/v3/pkg/release"
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/kubernetes/scheme"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	helmv1alpha1 "github.com/gardener/landscaper/apis/deployer/helm/v1alpha1"
+	"github.com/gardener/landscaper/controller-utils/pkg/logging"
+)
+
+var _ = Describe("Chart Tests", func() {
+
+	newObject := func(apiVersion, kind, name string, annotations map[string]string) *unstructured.Unstructured {
+		obj := &unstructured.Unstructured{}
+		obj.SetAPIVersion(apiVersion)
+		obj.SetKind(kind)
+		obj.SetName(name)
+		obj.SetAnnotations(annotations)
+		return obj
+	}
+
+	toRaw := func(obj *unstructured.Unstructured) *runtime.RawExtension {
+		data, err := json.Marshal(obj.Object)
+		Expect(err).ToNot(HaveOccurred())
+		return &runtime.RawExtension{Raw: data}
+	}
+
+	Context("separateTestHooks", func() {
+
+		It("should separate test hooks from the other objects", func() {
+			objects := []*runtime.RawExtension{
+				toRaw(newObject("v1", "ConfigMap", "config", nil)),
+				toRaw(newObject("v1", "Pod", "test", map[string]string{release.HookAnnotation: "test"})),
+				toRaw(newObject("v1", "Pod", "legacy", map[string]string{release.HookAnnotation: "pre-install, test-success"})),
+				toRaw(newObject("v1", "Pod", "hook", map[string]string{release.HookAnnotation: "post-install"})),
+			}
+
+			others, tests, err := separateTestHooks(objects)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(others).To(HaveLen(2))
+			Expect(tests).To(HaveLen(2))
+			Expect(tests[0].GetName()).To(Equal("test"))
+			Expect(tests[1].GetName()).To(Equal("legacy"))
+		})
+
+		It("should fail for objects that cannot be decoded", func() {
+			_, _, err := separateTestHooks([]*runtime.RawExtension{{Raw: []byte("{")}})
+			Expect(err).To(HaveOccurred())
+		})
+	})
+
+	Context("sortTestHooks", func() {
+
+		It("should sort the test hooks by their weight and name", func() {
+			tests := []*unstructured.Unstructured{
+				newObject("v1", "Pod", "c", map[string]string{release.HookWeightAnnotation: "5"}),
+				newObject("v1", "Pod", "b", nil),
+				newObject("v1", "Pod", "a", map[string]string{release.HookWeightAnnotation: "-1"}),
+				newObject("v1", "Pod", "d", map[string]string{release.HookWeightAnnotation: "invalid"}),
+			}
+
+			sortTestHooks(tests)
+			names := make([]string, 0, len(tests))
+			for _, test := range tests {
+				names = append(names, test.GetName())
+			}
+			Expect(names).To(Equal([]string{"a", "b", "d", "c"}))
+		})
+	})
+
+	Context("getTestHookPhase", func() {
+
+		toUnstructured := func(obj client.Object) *unstructured.Unstructured {
+			content, err := runtime.DefaultUnstructuredConverter.ToUnstructured(obj)
+			Expect(err).ToNot(HaveOccurred())
+			return &unstructured.Unstructured{Object: content}
+		}
+
+		It("should determine the phase of pods", func() {
+			pod := &corev1.Pod{}
+			pod.APIVersion = "v1"
+			pod.Kind = "Pod"
+
+			pod.Status.Phase = corev1.PodRunning
+			phase, _ := getTestHookPhase(toUnstructured(pod))
+			Expect(phase).To(Equal(helmv1alpha1.HelmTestPhaseRunning))
+
+			pod.Status.Phase = corev1.PodSucceeded
+			phase, _ = getTestHookPhase(toUnstructured(pod))
+			Expect(phase).To(Equal(helmv1alpha1.HelmTestPhaseSucceeded))
+
+			pod.Status.Phase = corev1.PodFailed
+			pod.Status.Message = "exit code 1"
+			phase, msg := getTestHookPhase(toUnstructured(pod))
+			Expect(phase).To(Equal(helmv1alpha1.HelmTestPhaseFailed))
+			Expect(msg).To(Equal("exit code 1"))
+		})
+
+		It("should determine the phase of jobs", func() {
+			job := &batchv1.Job{}
+			job.APIVersion = "batch/v1"
+			job.Kind = "Job"
+
+			phase, _ := getTestHookPhase(toUnstructured(job))
+			Expect(phase).To(Equal(helmv1alpha1.HelmTestPhaseRunning))
+
+			job.Status.Conditions = []batchv1.JobCondition{{Type: batchv1.JobComplete, Status: corev1.ConditionTrue}}
+			phase, _ = getTestHookPhase(toUnstructured(job))
+			Expect(phase).To(Equal(helmv1alpha1.HelmTestPhaseSucceeded))
+
+			job.Status.Conditions = []batchv1.JobCondition{{Type: batchv1.JobFailed, Status: corev1.ConditionTrue, Message: "backoff"}}
+			phase, msg := getTestHookPhase(toUnstructured(job))
+			Expect(phase).To(Equal(helmv1alpha1.HelmTestPhaseFailed))
+			Expect(msg).To(Equal("backoff"))
+		})
+
+		It("should consider other kinds as succeeded", func() {
+			phase, _ := getTestHookPhase(newObject("v1", "ConfigMap", "config", nil))
+			Expect(phase).To(Equal(helmv1alpha1.HelmTestPhaseSucceeded))
+		})
+	})
+
+	Context("runTestHook", func() {
+
+		var (
+			ctx          context.Context
+			targetClient client.Client
+			h            *Helm
+		)
+
+		BeforeEach(func() {
+			ctx = logging.NewContext(context.Background(), logging.Discard())
+			targetClient = fake.NewClientBuilder().WithScheme(scheme.Scheme).WithStatusSubresource(&corev1.Pod{}).Build()
+			h = &Helm{ProviderConfiguration: &helmv1alpha1.ProviderConfiguration{Namespace: "default"}}
+		})
+
+		It("should start a test without waiting for it", func() {
+			test := newObject("v1", "Pod", "test", map[string]string{release.HookAnnotation: "test"})
+			result := getTestResult(nil, test)
+
+			finished, err := h.runTestHook(ctx, targetClient, test, &result)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(finished).To(BeFalse())
+			Expect(result.Phase).To(Equal(helmv1alpha1.HelmTestPhaseRunning))
+
+			pod := &corev1.Pod{}
+			Expect(targetClient.Get(ctx, client.ObjectKey{Namespace: "default", Name: "test"}, pod)).To(Succeed())
+			pod.Status.Phase = corev1.PodSucceeded
+			Expect(targetClient.Status().Update(ctx, pod)).To(Succeed())
+
+			finished, err = h.runTestHook(ctx, targetClient, test, &result)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(finished).To(BeTrue())
+			Expect(result.Phase).To(Equal(helmv1alpha1.HelmTestPhaseSucceeded))
+		})
+
+		It("should fail if a running test has failed", func() {
+			test := newObject("v1", "Pod", "test", nil)
+			result := getTestResult(nil, test)
+
+			_, err := h.runTestHook(ctx, targetClient, test, &result)
+			Expect(err).ToNot(HaveOccurred())
+
+			pod := &corev1.Pod{}
+			Expect(targetClient.Get(ctx, client.ObjectKey{Namespace: "default", Name: "test"}, pod)).To(Succeed())
+			pod.Status.Phase = corev1.PodFailed
+			Expect(targetClient.Status().Update(ctx, pod)).To(Succeed())
+
+			finished, err := h.runTestHook(ctx, targetClient, test, &result)
+			Expect(err).To(HaveOccurred())
+			Expect(finished).To(BeFalse())
+			Expect(result.Phase).To(Equal(helmv1alpha1.HelmTestPhaseFailed))
+		})
+
+		It("should delete the test of a previous run before it is started again", func() {
+			previous := &corev1.Pod{}
+			previous.Namespace = "default"
+			previous.Name = "test"
+			Expect(targetClient.Create(ctx, previous)).To(Succeed())
+
+			test := newObject("v1", "Pod", "test", nil)
+			result := getTestResult(nil, test)
+
+			finished, err := h.runTestHook(ctx, targetClient, test, &result)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(finished).To(BeFalse())
+			Expect(result.Phase).To(Equal(helmv1alpha1.HelmTestPhaseUnknown))
+
+			finished, err = h.runTestHook(ctx, targetClient, test, &result)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(finished).To(BeFalse())
+			Expect(result.Phase).To(Equal(helmv1alpha1.HelmTestPhaseRunning))
+		})
+	})
+})
@@ -0,0 +1,118 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package helm
+
+import (
+	"context"
+	"fmt"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/util/yaml"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	lsv1alpha1 "github.com/gardener/landscaper/apis/core/v1alpha1"
+	helmv1alpha1 "github.com/gardener/landscaper/apis/deployer/helm/v1alpha1"
+	"github.com/gardener/landscaper/pkg/utils"
+	"github.com/gardener/landscaper/pkg/utils/read_write_layer"
+)
+
+// DefaultValuesKey is the key of the values in secrets and configmaps that are referenced without a key.
+const DefaultValuesKey = "values.yaml"
+
+// ResolveValues returns the values that are used for templating the chart.
+// The values of the sources in "valuesFrom" are merged in the given order, so that values of later sources override
+// values of earlier sources. The inline values of the provider configuration are merged on top of them.
+// Secrets, configmaps and data objects are read from the given namespace, which is the namespace of the deploy item.
+func ResolveValues(ctx context.Context, lsClient client.Client, namespace string, config *helmv1alpha1.ProviderConfiguration) (map[string]interface{}, error) {
+	values := map[string]interface{}{}
+	for i, source := range config.ValuesFrom {
+		sourceValues, err := resolveValuesFromSource(ctx, lsClient, namespace, source)
+		if err != nil {
+			return nil, fmt.Errorf("unable to get values from valuesFrom[%d]: %w", i, err)
+		}
+		values = utils.MergeMaps(values, sourceValues)
+	}
+
+	inlineValues := map[string]interface{}{}
+	if err := yaml.Unmarshal(config.Values, &inlineValues); err != nil {
+		return nil, fmt.Errorf("unable to parse values: %w", err)
+	}
+	return utils.MergeMaps(values, inlineValues), nil
+}
+
+// resolveValuesFromSource reads the values of a single source.
+// Nil is returned if an optional source does not exist.
+func resolveValuesFromSource(ctx context.Context, lsClient client.Client, namespace string, source helmv1alpha1.ValuesFromSource) (map[string]interface{}, error) {
+	var (
+		data []byte
+		ok   bool
+	)
+
+	switch {
+	case source.SecretRef != nil:
+		secret := &corev1.Secret{}
+		key := client.ObjectKey{Namespace: namespace, Name: source.SecretRef.Name}
+		if err := read_write_layer.GetSecret(ctx, lsClient, key, secret, read_write_layer.R000129); err != nil {
+			if apierrors.IsNotFound(err) && source.Optional {
+				return nil, nil
+			}
+			return nil, fmt.Errorf("unable to get secret %s: %w", key.String(), err)
+		}
+		valuesKey := valuesKeyOrDefault(source.SecretRef.Key)
+		if data, ok = secret.Data[valuesKey]; !ok {
+			if source.Optional {
+				return nil, nil
+			}
+			return nil, fmt.Errorf("secret %s has no key %q", key.String(), valuesKey)
+		}
+
+	case source.ConfigMapRef != nil:
+		configMap := &corev1.ConfigMap{}
+		key := client.ObjectKey{Namespace: namespace, Name: source.ConfigMapRef.Name}
+		if err := read_write_layer.GetConfigMap(ctx, lsClient, key, configMap, read_write_layer.R000130); err != nil {
+			if apierrors.IsNotFound(err) && source.Optional {
+				return nil, nil
+			}
+			return nil, fmt.Errorf("unable to get configmap %s: %w", key.String(), err)
+		}
+		valuesKey := valuesKeyOrDefault(source.ConfigMapRef.Key)
+		if value, found := configMap.Data[valuesKey]; found {
+			data = []byte(value)
+		} else if data, ok = configMap.BinaryData[valuesKey]; !ok {
+			if source.Optional {
+				return nil, nil
+			}
+			return nil, fmt.Errorf("configmap %s has no key %q", key.String(), valuesKey)
+		}
+
+	case len(source.DataObjectRef) != 0:
+		dataObject := &lsv1alpha1.DataObject{}
+		key := client.ObjectKey{Namespace: namespace, Name: source.DataObjectRef}
+		if err := read_write_layer.GetDataObject(ctx, lsClient, key, dataObject, read_write_layer.R000131); err != nil {
+			if apierrors.IsNotFound(err) && source.Optional {
+				return nil, nil
+			}
+			return nil, fmt.Errorf("unable to get data object %s: %w", key.String(), err)
+		}
+		data = dataObject.Data.RawMessage
+
+	default:
+		data = []byte(source.Raw)
+	}
+
+	values := map[string]interface{}{}
+	if err := yaml.Unmarshal(data, &values); err != nil {
+		return nil, fmt.Errorf("values are no valid yaml or json object: %w", err)
+	}
+	return values, nil
+}
+
+func valuesKeyOrDefault(key string) string {
+	if len(key) == 0 {
+		return DefaultValuesKey
+	}
+	return key
+}
@@ -0,0 +1,119 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package helm_test
+
+import (
+	"context"
+	"encoding/json"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	lsv1alpha1 "github.com/gardener/landscaper/apis/core/v1alpha1"
+	helmv1alpha1 "github.com/gardener/landscaper/apis/deployer/helm/v1alpha1"
+	"github.com/gardener/landscaper/pkg/api"
+	"github.com/gardener/landscaper/pkg/deployer/helm"
+)
+
+var _ = Describe("ResolveValues", func() {
+
+	var (
+		ctx        context.Context
+		secret     *corev1.Secret
+		configMap  *corev1.ConfigMap
+		dataObject *lsv1alpha1.DataObject
+	)
+
+	BeforeEach(func() {
+		ctx = context.Background()
+		secret = &corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{Name: "credentials", Namespace: "test"},
+			Data: map[string][]byte{
+				"values.yaml": []byte("auth:\n  user: admin\n  password: secret\n"),
+				"other":       []byte(`{"auth": {"password": "other"}}`),
+			},
+		}
+		configMap = &corev1.ConfigMap{
+			ObjectMeta: metav1.ObjectMeta{Name: "defaults", Namespace: "test"},
+			Data: map[string]string{
+				"values.yaml": "replicas: 1\nauth:\n  user: nobody\n",
+			},
+		}
+		dataObject = &lsv1alpha1.DataObject{
+			ObjectMeta: metav1.ObjectMeta{Name: "imported", Namespace: "test"},
+			Data:       lsv1alpha1.NewAnyJSON([]byte(`{"image": {"tag": "1.0.0"}}`)),
+		}
+	})
+
+	resolve := func(config *helmv1alpha1.ProviderConfiguration) (map[string]interface{}, error) {
+		lsClient := fake.NewClientBuilder().WithScheme(api.LandscaperScheme).WithObjects(secret, configMap, dataObject).Build()
+		return helm.ResolveValues(ctx, lsClient, "test", config)
+	}
+
+	It("should merge the sources in the given order and the inline values on top", func() {
+		values, err := resolve(&helmv1alpha1.ProviderConfiguration{
+			ValuesFrom: []helmv1alpha1.ValuesFromSource{
+				{ConfigMapRef: &lsv1alpha1.LocalConfigMapReference{Name: "defaults"}},
+				{SecretRef: &lsv1alpha1.LocalSecretReference{Name: "credentials"}},
+				{DataObjectRef: "imported"},
+				{Raw: "image:\n  repository: example.com/app\n"},
+			},
+			Values: json.RawMessage(`{"replicas": 3}`),
+		})
+		Expect(err).ToNot(HaveOccurred())
+
+		raw, err := json.Marshal(values)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(raw).To(MatchJSON(`{
+			"replicas": 3,
+			"auth": {"user": "admin", "password": "secret"},
+			"image": {"tag": "1.0.0", "repository": "example.com/app"}
+		}`))
+	})
+
+	It("should read the given key of a secret", func() {
+		values, err := resolve(&helmv1alpha1.ProviderConfiguration{
+			ValuesFrom: []helmv1alpha1.ValuesFromSource{
+				{SecretRef: &lsv1alpha1.LocalSecretReference{Name: "credentials"}},
+				{SecretRef: &lsv1alpha1.LocalSecretReference{Name: "credentials", Key: "other"}},
+			},
+		})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(values).To(HaveKeyWithValue("auth", map[string]interface{}{"user": "admin", "password": "other"}))
+	})
+
+	It("should fail if a source does not exist", func() {
+		_, err := resolve(&helmv1alpha1.ProviderConfiguration{
+			ValuesFrom: []helmv1alpha1.ValuesFromSource{
+				{SecretRef: &lsv1alpha1.LocalSecretReference{Name: "missing"}},
+			},
+		})
+		Expect(err).To(HaveOccurred())
+
+		_, err = resolve(&helmv1alpha1.ProviderConfiguration{
+			ValuesFrom: []helmv1alpha1.ValuesFromSource{
+				{ConfigMapRef: &lsv1alpha1.LocalConfigMapReference{Name: "defaults", Key: "missing"}},
+			},
+		})
+		Expect(err).To(HaveOccurred())
+	})
+
+	It("should ignore optional sources that do not exist", func() {
+		values, err := resolve(&helmv1alpha1.ProviderConfiguration{
+			ValuesFrom: []helmv1alpha1.ValuesFromSource{
+				{SecretRef: &lsv1alpha1.LocalSecretReference{Name: "missing"}, Optional: true},
+				{ConfigMapRef: &lsv1alpha1.LocalConfigMapReference{Name: "defaults", Key: "missing"}, Optional: true},
+				{DataObjectRef: "missing", Optional: true},
+			},
+			Values: json.RawMessage(`{"replicas": 3}`),
+		})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(values).To(HaveLen(1))
+		Expect(values).To(HaveKey("replicas"))
+	})
+})
@@ -0,0 +1,92 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package lib
+
+import (
+	"errors"
+	"fmt"
+
+	"k8s.io/client-go/rest"
+	"sigs.k8s.io/yaml"
+
+	lsv1alpha1 "github.com/gardener/landscaper/apis/core/v1alpha1"
+	"github.com/gardener/landscaper/apis/core/v1alpha1/targettypes"
+)
+
+// Agent describes a deployer that runs as landscaper agent in a target cluster.
+// The agent only executes the deploy items of agent targets with its name. It accesses the cluster in which it runs
+// with its own credentials, so that the landscaper cluster does not need any credentials for this cluster.
+type Agent struct {
+	// Name is the name of the agent.
+	Name string
+	// Namespace is the namespace in the landscaper cluster whose deploy items are executed by the agent.
+	// All namespaces are watched if it is empty, which has to be enabled explicitly with the flag "--agent-all-namespaces".
+	Namespace string
+	// RestConfig is the rest config of the cluster in which the agent runs.
+	RestConfig *rest.Config
+}
+
+var agent *Agent
+
+// SetAgent configures that the deployers of the process run as the given agent.
+// The deployers run as regular deployers if the agent is nil.
+func SetAgent(a *Agent) {
+	agent = a
+}
+
+// GetAgent returns the agent as which the deployers of the process run, or nil for regular deployers.
+func GetAgent() *Agent {
+	return agent
+}
+
+// IsAgentTarget returns whether the target is an agent target.
+func IsAgentTarget(target *lsv1alpha1.Target) bool {
+	return target != nil && target.Spec.Type == targettypes.AgentTargetType
+}
+
+// GetAgentName returns the name of the agent of an agent target.
+func GetAgentName(target *lsv1alpha1.Target) (string, error) {
+	config := &targettypes.AgentTargetConfig{}
+	if target.Spec.Configuration != nil && len(target.Spec.Configuration.RawMessage) != 0 {
+		if err := yaml.Unmarshal(target.Spec.Configuration.RawMessage, config); err != nil {
+			return "", fmt.Errorf("unable to parse agent target configuration: %w", err)
+		}
+	}
+	if len(config.Name) == 0 {
+		return "", errors.New("no agent name defined in agent target")
+	}
+	return config.Name, nil
+}
+
+// IsResponsibleForTarget returns whether the deployers of the process are responsible for the deploy items of a target
+// with respect to agents: the deploy items of agent targets are only executed by the agent with the name of the target,
+// and an agent only executes the deploy items of its agent targets.
+func IsResponsibleForTarget(target *lsv1alpha1.Target) (bool, error) {
+	if !IsAgentTarget(target) {
+		return agent == nil, nil
+	}
+	if agent == nil {
+		return false, nil
+	}
+	name, err := GetAgentName(target)
+	if err != nil {
+		return false, err
+	}
+	return name == agent.Name, nil
+}
+
+// getAgentRestConfig returns the rest config for the cluster of an agent target,
+// which is the cluster in which the agent runs.
+func getAgentRestConfig(target *lsv1alpha1.ResolvedTarget) (*rest.Config, error) {
+	responsible, err := IsResponsibleForTarget(target.Target)
+	if err != nil {
+		return nil, err
+	}
+	if !responsible {
+		return nil, fmt.Errorf("the deploy items of agent target %s/%s can only be executed by the agent of the target",
+			target.Namespace, target.Name)
+	}
+	return rest.CopyConfig(agent.RestConfig), nil
+}
@@ -0,0 +1,106 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package lib_test
+
+import (
+	"context"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/rest"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	lsv1alpha1 "github.com/gardener/landscaper/apis/core/v1alpha1"
+	"github.com/gardener/landscaper/apis/core/v1alpha1/targettypes"
+	"github.com/gardener/landscaper/pkg/api"
+	"github.com/gardener/landscaper/pkg/deployer/lib"
+)
+
+var _ = Describe("Agent", func() {
+
+	var ctx context.Context
+
+	BeforeEach(func() {
+		ctx = context.Background()
+	})
+
+	AfterEach(func() {
+		lib.SetAgent(nil)
+	})
+
+	agentTarget := func(name, agentName string) *lsv1alpha1.Target {
+		target := &lsv1alpha1.Target{}
+		target.Name = name
+		target.Namespace = "default"
+		target.Spec.Type = targettypes.AgentTargetType
+		target.Spec.Configuration = lsv1alpha1.NewAnyJSONPointer([]byte(`{"name": "` + agentName + `"}`))
+		return target
+	}
+
+	clusterTarget := func(name string) *lsv1alpha1.Target {
+		target := &lsv1alpha1.Target{}
+		target.Name = name
+		target.Namespace = "default"
+		target.Spec.Type = targettypes.KubernetesClusterTargetType
+		target.Spec.Configuration = lsv1alpha1.NewAnyJSONPointer([]byte(`{"kubeconfig": "abc"}`))
+		return target
+	}
+
+	deployItem := func(targetName string) *metav1.PartialObjectMetadata {
+		di := &metav1.PartialObjectMetadata{}
+		di.Name = "my-item"
+		di.Namespace = "default"
+		di.Annotations = map[string]string{
+			lsv1alpha1.DeployerTypeAnnotation:       "landscaper.gardener.cloud/kubernetes-manifest",
+			lsv1alpha1.DeployerTargetNameAnnotation: targetName,
+		}
+		return di
+	}
+
+	checkResponsibility := func(targetName string) bool {
+		lsClient := fake.NewClientBuilder().WithScheme(api.LandscaperScheme).
+			WithObjects(agentTarget("agent-a", "a"), agentTarget("agent-b", "b"), clusterTarget("cluster")).Build()
+		_, responsible, _, err := lib.CheckResponsibility(ctx, lsClient, deployItem(targetName),
+			"landscaper.gardener.cloud/kubernetes-manifest", nil)
+		Expect(err).ToNot(HaveOccurred())
+		return responsible
+	}
+
+	It("should not execute the deploy items of agent targets by regular deployers", func() {
+		Expect(checkResponsibility("agent-a")).To(BeFalse())
+		Expect(checkResponsibility("cluster")).To(BeTrue())
+		Expect(checkResponsibility(lsv1alpha1.NoTargetNameValue)).To(BeTrue())
+	})
+
+	It("should only execute the deploy items of the agent targets of the agent", func() {
+		lib.SetAgent(&lib.Agent{Name: "a", RestConfig: &rest.Config{Host: "https://agent.example.com"}})
+		Expect(checkResponsibility("agent-a")).To(BeTrue())
+		Expect(checkResponsibility("agent-b")).To(BeFalse())
+		Expect(checkResponsibility("cluster")).To(BeFalse())
+		Expect(checkResponsibility(lsv1alpha1.NoTargetNameValue)).To(BeFalse())
+		Expect(checkResponsibility("missing")).To(BeFalse())
+	})
+
+	It("should reject agent targets without agent name", func() {
+		target := agentTarget("agent", "")
+		_, err := lib.IsResponsibleForTarget(target)
+		Expect(err).ToNot(HaveOccurred())
+
+		lib.SetAgent(&lib.Agent{Name: "a"})
+		_, err = lib.IsResponsibleForTarget(target)
+		Expect(err).To(HaveOccurred())
+	})
+
+	It("should create the clients for the cluster of the agent", func() {
+		lib.SetAgent(&lib.Agent{Name: "a", RestConfig: &rest.Config{Host: "https://agent.example.com"}})
+		clients, err := lib.NewTargetClients(ctx, lsv1alpha1.NewResolvedTarget(agentTarget("agent-a", "a")), nil, nil)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(clients.RestConfig.Host).To(Equal("https://agent.example.com"))
+
+		_, err = lib.NewTargetClients(ctx, lsv1alpha1.NewResolvedTarget(agentTarget("agent-b", "b")), nil, nil)
+		Expect(err).To(HaveOccurred())
+	})
+})
@@ -15,7 +15,9 @@ import (
 	flag "github.com/spf13/pflag"
 	"golang.org/x/sync/errgroup"
 	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/rest"
 	"k8s.io/client-go/tools/clientcmd"
 	"k8s.io/utils/ptr"
 	ctrl "sigs.k8s.io/controller-runtime"
@@ -31,6 +33,7 @@ import (
 	"github.com/gardener/landscaper/pkg/api"
 	"github.com/gardener/landscaper/pkg/deployer/lib"
 	lsutils "github.com/gardener/landscaper/pkg/utils"
+	"github.com/gardener/landscaper/pkg/utils/encryption"
 	"github.com/gardener/landscaper/pkg/utils/read_write_layer"
 )
 
@@ -41,8 +44,13 @@ type DefaultOptions struct {
 	HostUncachedClient client.Client
 	HostCachedClient   client.Client
 
-	configPath   string
-	LsKubeconfig string
+	configPath           string
+	LsKubeconfig         string
+	encryptionConfigPath string
+	agentName            string
+	agentNamespace       string
+	agentAllNamespaces   bool
+	statusComparison     string
 
 	Log     logging.Logger
 	LsMgr   manager.Manager
@@ -63,6 +71,11 @@ func NewDefaultOptions(deployerScheme *runtime.Scheme) *DefaultOptions {
 func (o *DefaultOptions) AddFlags(fs *flag.FlagSet) {
 	fs.StringVar(&o.configPath, "config", "", "Specify the path to the configuration file")
 	fs.StringVar(&o.LsKubeconfig, "landscaper-kubeconfig", "", "Specify the path to the landscaper kubeconfig cluster")
+	fs.StringVar(&o.encryptionConfigPath, "encryption-config", "", "Specify the path to the encryption configuration of the deploy item configurations")
+	fs.StringVar(&o.agentName, "agent-name", "", "Run the deployer as landscaper agent with the given name, which executes the deploy items of its agent targets in the cluster in which it runs")
+	fs.StringVar(&o.agentNamespace, "agent-namespace", "", "Specify the namespace in the landscaper cluster whose deploy items are executed by the agent")
+	fs.BoolVar(&o.agentAllNamespaces, "agent-all-namespaces", false, "Let the agent execute the deploy items of its agent targets in all namespaces of the landscaper cluster instead of a single namespace")
+	fs.StringVar(&o.statusComparison, "status-comparison", string(lib.StatusComparisonSemantic), "Specify when the status of a deploy item is written after a reconcile: \"Semantic\" if it has changed semantically, or \"Strict\" if it differs in any way")
 	logging.InitFlags(fs)
 
 	flag.CommandLine.AddGoFlagSet(goflag.CommandLine)
@@ -79,7 +92,33 @@ func (o *DefaultOptions) Complete() error {
 	ctrl.SetLogger(log.Logr())
 	ctx := logging.NewContext(context.Background(), o.Log)
 
+	if len(o.encryptionConfigPath) != 0 {
+		encryptor, err := encryption.NewFromFile(o.encryptionConfigPath)
+		if err != nil {
+			return err
+		}
+		read_write_layer.SetDeployItemEncryptor(encryptor)
+	}
+
+	if err := lib.SetDefaultStatusComparison(lib.StatusComparison(o.statusComparison)); err != nil {
+		return err
+	}
+
 	hostAndResourceClusterDifferent := len(o.LsKubeconfig) != 0
+	if len(o.agentName) == 0 && (len(o.agentNamespace) != 0 || o.agentAllNamespaces) {
+		return errors.New("the agent namespace or all namespaces can only be specified together with the agent name")
+	}
+	if len(o.agentNamespace) != 0 && o.agentAllNamespaces {
+		return errors.New("the agent namespace and all namespaces cannot be specified together")
+	}
+	if len(o.agentName) != 0 && len(o.agentNamespace) == 0 && !o.agentAllNamespaces {
+		// an agent for all namespaces executes the deploy items of every agent target with its name in the landscaper
+		// cluster, so that it has to be enabled explicitly
+		return errors.New("the agent namespace is required, unless the agent is explicitly enabled for all namespaces")
+	}
+	if len(o.agentName) != 0 && !hostAndResourceClusterDifferent {
+		return errors.New("the landscaper kubeconfig is required to run the deployer as agent")
+	}
 
 	burst, qps := lsutils.GetHostClientRequestRestrictions(log, hostAndResourceClusterDifferent)
 
@@ -101,6 +140,20 @@ func (o *DefaultOptions) Complete() error {
 	}
 	o.LsMgr = o.HostMgr
 
+	if len(o.agentName) != 0 {
+		// the agent executes the deploy items of its agent targets in the cluster in which it runs
+		lib.SetAgent(&lib.Agent{
+			Name:       o.agentName,
+			Namespace:  o.agentNamespace,
+			RestConfig: rest.CopyConfig(hostRestConfig),
+		})
+		if len(o.agentNamespace) != 0 {
+			// the agent only needs permissions for its namespace in the landscaper cluster
+			opts.Cache.DefaultNamespaces = map[string]cache.Config{o.agentNamespace: {}}
+		}
+		log.Info("Running as landscaper agent", "agent", o.agentName, "namespace", o.agentNamespace)
+	}
+
 	if hostAndResourceClusterDifferent {
 		data, err := os.ReadFile(o.LsKubeconfig)
 		if err != nil {
@@ -139,7 +192,9 @@ func (o *DefaultOptions) prepareFinishedObjectCache(ctx context.Context) error {
 
 	o.FinishedObjectCache = lsutils.NewFinishedObjectCache()
 	namespaces := &v1.NamespaceList{}
-	if err := read_write_layer.ListNamespaces(ctx, o.LsUncachedClient, namespaces, read_write_layer.R000093); err != nil {
+	if len(o.agentNamespace) != 0 {
+		namespaces.Items = []v1.Namespace{{ObjectMeta: metav1.ObjectMeta{Name: o.agentNamespace}}}
+	} else if err := read_write_layer.ListNamespaces(ctx, o.LsUncachedClient, namespaces, read_write_layer.R000093); err != nil {
 		return err
 	}
 
@@ -63,6 +63,10 @@ type DeployerArgs struct {
 	Deployer        Deployer
 	TargetSelectors []lsv1alpha1.TargetSelector
 	Options         ctrl.Options
+	// StatusComparison defines when the status of a deploy item is written after a reconcile.
+	// Defaults to the comparison that is set with SetDefaultStatusComparison, which is the semantic comparison
+	// unless configured otherwise.
+	StatusComparison StatusComparison
 }
 
 // Default defaults deployer arguments
@@ -76,6 +80,9 @@ func (args *DeployerArgs) Default() {
 	if len(args.Identity) == 0 {
 		args.Identity = fmt.Sprintf("%s-%d", args.Name, time.Now().UTC().Unix())
 	}
+	if len(args.StatusComparison) == 0 {
+		args.StatusComparison = defaultStatusComparison
+	}
 }
 
 // Validate validates the provided deployer arguments
@@ -87,6 +94,9 @@ func (args DeployerArgs) Validate() error {
 	if args.Deployer == nil {
 		allErrs = append(allErrs, fmt.Errorf("a deployer implementation must be provided"))
 	}
+	if err := args.StatusComparison.Validate(); err != nil {
+		allErrs = append(allErrs, err)
+	}
 	return errors.NewAggregate(allErrs)
 }
 
@@ -130,8 +140,9 @@ type controller struct {
 	deployer Deployer
 	info     lsv1alpha1.DeployerInformation
 	// deployerType defines the deployer type the deployer is responsible for.
-	deployerType    lsv1alpha1.DeployItemType
-	targetSelectors []lsv1alpha1.TargetSelector
+	deployerType     lsv1alpha1.DeployItemType
+	targetSelectors  []lsv1alpha1.TargetSelector
+	statusComparison StatusComparison
 
 	lsScheme        *runtime.Scheme
 	lsEventRecorder record.EventRecorder
@@ -169,14 +180,15 @@ func NewController(lsUncachedClient, lsCachedClient, hostUncachedClient, hostCac
 			Name:     args.Name,
 			Version:  args.Version,
 		},
-		targetSelectors: args.TargetSelectors,
-		lsScheme:        lsScheme,
-		lsEventRecorder: lsEventRecorder,
-		hostScheme:      hostScheme,
-		workerCounter:   wc,
-		lockingEnabled:  lockingEnabled,
-		callerName:      callerName,
-		locker:          *lock.NewLocker(lsUncachedClient, hostUncachedClient, callerName),
+		targetSelectors:  args.TargetSelectors,
+		statusComparison: args.StatusComparison,
+		lsScheme:         lsScheme,
+		lsEventRecorder:  lsEventRecorder,
+		hostScheme:       hostScheme,
+		workerCounter:    wc,
+		lockingEnabled:   lockingEnabled,
+		callerName:       callerName,
+		locker:           *lock.NewLocker(lsUncachedClient, hostUncachedClient, callerName),
 	}
 }
 
@@ -286,6 +298,21 @@ func (c *controller) reconcilePrivate(ctx context.Context, metadata *metav1.Part
 
 	old := di.DeepCopy()
 
+	if lsv1alpha1helper.HasOperation(di.ObjectMeta, lsv1alpha1.AbortOperation) {
+		if lsError := c.abort(ctx, di, rt); lsError != nil {
+			return lsutil.LogHelper{}.LogErrorAndGetReconcileResult(ctx, lsError)
+		}
+		if IsDeployItemFinished(di) {
+			return reconcile.Result{}, nil
+		}
+
+		lsError := lserrors.NewError(op, lsv1alpha1.AbortedReason, "setting deploy item to failed because it was aborted")
+		logger.Info(lsError.Error())
+		lsv1alpha1helper.SetDeployItemToFailed(di)
+		_ = c.handleReconcileResult(ctx, lsError, old, di)
+		return c.buildResult(ctx, di.Status.Phase, nil)
+	}
+
 	hasTestReconcileAnnotation := lsv1alpha1helper.HasOperation(di.ObjectMeta, lsv1alpha1.TestReconcileOperation)
 
 	if IsDeployItemFinished(di) {
@@ -327,14 +354,12 @@ func (c *controller) reconcilePrivate(ctx context.Context, metadata *metav1.Part
 
 			// initialize deployitem for reconcile
 			logger.Debug("Setting deployitem to phase 'Init'", "updateOnChangeOnly", di.Spec.UpdateOnChangeOnly, lc.KeyGeneration, di.GetGeneration(), lc.KeyObservedGeneration, di.Status.ObservedGeneration, lc.KeyDeployItemPhase, di.Status.Phase)
-			di.Status.Phase = lsv1alpha1.DeployItemPhases.Init
-			if err := c.initAndUpdateStatus(ctx, di); err != nil {
+			if err := c.initAndUpdateStatus(ctx, di, lsv1alpha1.DeployItemPhases.Init); err != nil {
 				return lsutil.LogHelper{}.LogStandardErrorAndGetReconcileResult(ctx, err)
 			}
 		} else {
 			// initialize deployitem for delete
-			di.Status.Phase = lsv1alpha1.DeployItemPhases.InitDelete
-			if err := c.initAndUpdateStatus(ctx, di); err != nil {
+			if err := c.initAndUpdateStatus(ctx, di, lsv1alpha1.DeployItemPhases.InitDelete); err != nil {
 				return lsutil.LogHelper{}.LogStandardErrorAndGetReconcileResult(ctx, err)
 			}
 		}
@@ -342,20 +367,30 @@ func (c *controller) reconcilePrivate(ctx context.Context, metadata *metav1.Part
 
 	// Deployitem has been initialized, proceed with reconcile/delete
 
+	if waitTime := timeUntilNextRetry(di, time.Now()); waitTime > 0 {
+		// status updates trigger further reconciles, which must not retry the failed operation before its backoff
+		logger.Debug("deploy item waits for the next retry of its failed operation", "retryCount", di.Status.RetryCount,
+			"nextRetryTime", di.Status.NextRetryTime.String())
+		return reconcile.Result{RequeueAfter: waitTime}, nil
+	}
+
 	if di.DeletionTimestamp.IsZero() {
 		lsError := c.reconcile(ctx, di, rt)
+		retryAfter := applyRetryPolicy(di, lsError, time.Now())
 		_ = c.handleReconcileResult(ctx, lsError, old, di)
-		return c.buildResult(ctx, di.Status.Phase, lsError)
+		return c.buildRetryResult(ctx, di.Status.Phase, lsError, retryAfter)
 
 	} else {
 		lsError := c.delete(ctx, di, rt)
+		retryAfter := applyRetryPolicy(di, lsError, time.Now())
 		_ = c.handleReconcileResult(ctx, lsError, old, di)
-		return c.buildResult(ctx, di.Status.Phase, lsError)
+		return c.buildRetryResult(ctx, di.Status.Phase, lsError, retryAfter)
 	}
 }
 
 func (c *controller) handleReconcileResult(ctx context.Context, err lserrors.LsError, oldDeployItem, deployItem *lsv1alpha1.DeployItem) error {
-	return HandleReconcileResult(ctx, err, oldDeployItem, deployItem, c.lsUncachedClient, c.lsEventRecorder, c.finishedObjectCache)
+	return HandleReconcileResultWithStatusComparison(ctx, err, oldDeployItem, deployItem, c.lsUncachedClient, c.lsEventRecorder, c.finishedObjectCache,
+		c.statusComparison)
 }
 
 func (c *controller) buildResult(ctx context.Context, phase lsv1alpha1.DeployItemPhase, lsError lserrors.LsError) (reconcile.Result, error) {
@@ -378,6 +413,17 @@ func (c *controller) buildResult(ctx context.Context, phase lsv1alpha1.DeployIte
 	}
 }
 
+// buildRetryResult builds the result of a reconcile like buildResult, but requeues the deploy item after the given
+// interval if a retry of a failed operation has been scheduled.
+func (c *controller) buildRetryResult(ctx context.Context, phase lsv1alpha1.DeployItemPhase, lsError lserrors.LsError,
+	retryAfter time.Duration) (reconcile.Result, error) {
+	result, err := c.buildResult(ctx, phase, lsError)
+	if err == nil && retryAfter > 0 && !phase.IsFinal() {
+		result.RequeueAfter = retryAfter
+	}
+	return result, err
+}
+
 func (c *controller) getContext(ctx context.Context, deployItem *lsv1alpha1.DeployItem,
 	operation string) (*lsv1alpha1.Context, lserrors.LsError) {
 
@@ -450,14 +496,43 @@ func (c *controller) delete(ctx context.Context, deployItem *lsv1alpha1.DeployIt
 	return nil
 }
 
+// abort lets the deployer stop its ongoing work for a deploy item with the abort operation
+// and removes the abort annotation.
+func (c *controller) abort(ctx context.Context, deployItem *lsv1alpha1.DeployItem,
+	rt *lsv1alpha1.ResolvedTarget) lserrors.LsError {
+
+	logger, ctx := logging.FromContextOrNew(ctx, nil)
+	operation := "abort"
+
+	logger.Info("aborting deploy item")
+	lsCtx, lsErr := c.getContext(ctx, deployItem, operation)
+	if lsErr != nil {
+		return lsErr
+	}
+	if err := c.deployer.Abort(ctx, lsCtx, deployItem, rt); err != nil {
+		return lserrors.BuildLsError(err, operation, "Abort", err.Error())
+	}
+
+	delete(deployItem.Annotations, lsv1alpha1.OperationAnnotation)
+	if err := c.Writer().UpdateDeployItem(ctx, read_write_layer.W000162, deployItem); err != nil {
+		return lserrors.NewWrappedError(err, operation, "RemoveAbortAnnotation", err.Error())
+	}
+	return nil
+}
+
 func (c *controller) Writer() *read_write_layer.Writer {
 	return read_write_layer.NewWriter(c.lsUncachedClient)
 }
 
-func (c *controller) initAndUpdateStatus(ctx context.Context, di *lsv1alpha1.DeployItem) error {
-	c.initStatus(ctx, di)
+func (c *controller) initAndUpdateStatus(ctx context.Context, di *lsv1alpha1.DeployItem, phase lsv1alpha1.DeployItemPhase) error {
+	initStatus := func(di *lsv1alpha1.DeployItem) error {
+		di.Status.Phase = phase
+		c.initStatus(ctx, di)
+		return nil
+	}
 
-	if err := c.Writer().UpdateDeployItemStatus(ctx, read_write_layer.W000004, di); err != nil {
+	if err := c.Writer().UpdateDeployItemStatusWithRetry(ctx, read_write_layer.W000004, read_write_layer.R000162,
+		di, initStatus); err != nil {
 		return err
 	}
 
@@ -470,6 +545,10 @@ func (c *controller) initStatus(ctx context.Context, di *lsv1alpha1.DeployItem)
 	now := metav1.Now()
 	di.Status.LastReconcileTime = &now
 	di.Status.Deployer = c.info
+	// the progress and the retries of a previous operation are outdated
+	di.Status.Progress = nil
+	di.Status.RetryCount = 0
+	di.Status.NextRetryTime = nil
 	lsutil.InitErrors(&di.Status)
 }
 
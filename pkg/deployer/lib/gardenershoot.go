@@ -0,0 +1,184 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package lib
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"k8s.io/apimachinery/pkg/util/yaml"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	lsv1alpha1 "github.com/gardener/landscaper/apis/core/v1alpha1"
+	"github.com/gardener/landscaper/apis/core/v1alpha1/targettypes"
+	"github.com/gardener/landscaper/pkg/utils/clusters"
+)
+
+// GetKubeconfigFromTarget returns the kubeconfig of the cluster of a resolved target.
+// Targets of type gardener-shoot get a short-lived kubeconfig that is requested from the garden cluster,
+// all other targets are treated as kubernetes-cluster targets. If a kubernetes-cluster target configures
+// a service account token, the returned kubeconfig contains a short-lived token of the service account.
+func GetKubeconfigFromTarget(ctx context.Context, target *lsv1alpha1.ResolvedTarget, lsClient client.Client) ([]byte, error) {
+	if target.Target != nil && target.Spec.Type == targettypes.GardenerShootTargetType {
+		shootConfig := &targettypes.GardenerShootTargetConfig{}
+		if err := yaml.Unmarshal([]byte(target.Content), shootConfig); err != nil {
+			return nil, fmt.Errorf("unable to parse gardener shoot target configuration: %w", err)
+		}
+		return GetKubeconfigFromGardenerShootTargetConfig(ctx, shootConfig, target.Namespace, lsClient)
+	}
+
+	targetConfig := &targettypes.KubernetesClusterTargetConfig{}
+	if err := yaml.Unmarshal([]byte(target.Content), targetConfig); err != nil {
+		return nil, fmt.Errorf("unable to parse target confíguration: %w", err)
+	}
+	kubeconfig, err := GetKubeconfigFromTargetConfig(ctx, targetConfig, target.Namespace, lsClient)
+	if err != nil || targetConfig.ServiceAccountToken == nil {
+		return kubeconfig, err
+	}
+	defer Zeroize(kubeconfig)
+	return GetServiceAccountKubeconfig(ctx, kubeconfig, targetConfig.ServiceAccountToken)
+}
+
+// GetKubeconfigFromGardenerShootTargetConfig returns a kubeconfig of the shoot cluster of a gardener shoot target.
+// Requested kubeconfigs are shared by all deploy items of the process until half of their validity has passed.
+func GetKubeconfigFromGardenerShootTargetConfig(ctx context.Context, config *targettypes.GardenerShootTargetConfig,
+	targetNamespace string, lsClient client.Client) ([]byte, error) {
+	if len(config.Name) == 0 {
+		return nil, errors.New("no shoot name defined in gardener shoot target")
+	}
+	if len(config.Namespace) == 0 && len(config.Project) == 0 {
+		return nil, errors.New("neither a shoot namespace nor a project defined in gardener shoot target")
+	}
+	switch config.AccessLevel {
+	case "", targettypes.GardenerShootAdminAccess, targettypes.GardenerShootViewerAccess:
+	default:
+		return nil, fmt.Errorf("unknown access level %q in gardener shoot target", config.AccessLevel)
+	}
+
+	gardenKubeconfig, err := GetKubeconfigFromTargetConfig(ctx, &targettypes.KubernetesClusterTargetConfig{
+		Kubeconfig: config.GardenKubeconfig,
+	}, targetNamespace, lsClient)
+	if err != nil {
+		return nil, fmt.Errorf("unable to get garden kubeconfig of gardener shoot target: %w", err)
+	}
+	defer Zeroize(gardenKubeconfig)
+
+	return defaultShootKubeconfigCache.Get(ctx, gardenKubeconfig, config)
+}
+
+// shootKubeconfigKey identifies a requested shoot kubeconfig.
+type shootKubeconfigKey struct {
+	garden      string
+	namespace   string
+	project     string
+	name        string
+	accessLevel targettypes.GardenerShootAccessLevel
+}
+
+type cachedShootKubeconfig struct {
+	kubeconfig []byte
+	renewAt    time.Time
+}
+
+// requestShootKubeconfigFunc requests a kubeconfig for a shoot from the garden cluster
+// and returns it together with its expiration time.
+type requestShootKubeconfigFunc func(ctx context.Context, gardenKubeconfig []byte,
+	config *targettypes.GardenerShootTargetConfig, expirationSeconds int64) ([]byte, time.Time, error)
+
+// shootKubeconfigCache caches requested shoot kubeconfigs until half of their validity has passed.
+type shootKubeconfigCache struct {
+	mux     sync.Mutex
+	entries map[shootKubeconfigKey]cachedShootKubeconfig
+	now     func() time.Time
+	request requestShootKubeconfigFunc
+}
+
+func newShootKubeconfigCache() *shootKubeconfigCache {
+	return &shootKubeconfigCache{
+		entries: map[shootKubeconfigKey]cachedShootKubeconfig{},
+		now:     time.Now,
+		request: requestShootKubeconfig,
+	}
+}
+
+var defaultShootKubeconfigCache = newShootKubeconfigCache()
+
+// Get returns a cached or newly requested kubeconfig of the shoot of the given target configuration.
+func (c *shootKubeconfigCache) Get(ctx context.Context, gardenKubeconfig []byte, config *targettypes.GardenerShootTargetConfig) ([]byte, error) {
+	hash := sha256.Sum256(gardenKubeconfig)
+	key := shootKubeconfigKey{
+		garden:      hex.EncodeToString(hash[:]),
+		namespace:   config.Namespace,
+		project:     config.Project,
+		name:        config.Name,
+		accessLevel: config.AccessLevel,
+	}
+
+	c.mux.Lock()
+	entry, ok := c.entries[key]
+	c.mux.Unlock()
+	if ok && c.now().Before(entry.renewAt) {
+		// callers may zeroize the returned kubeconfig after use
+		return bytes.Clone(entry.kubeconfig), nil
+	}
+
+	expirationSeconds := targettypes.DefaultGardenerShootKubeconfigExpirationSeconds
+	if config.ExpirationSeconds != nil && *config.ExpirationSeconds > 0 {
+		expirationSeconds = *config.ExpirationSeconds
+	}
+
+	requestTime := c.now()
+	kubeconfig, expiration, err := c.request(ctx, gardenKubeconfig, config, expirationSeconds)
+	if err != nil {
+		return nil, err
+	}
+
+	c.mux.Lock()
+	defer c.mux.Unlock()
+	c.entries[key] = cachedShootKubeconfig{
+		kubeconfig: kubeconfig,
+		renewAt:    requestTime.Add(expiration.Sub(requestTime) / 2),
+	}
+	return bytes.Clone(kubeconfig), nil
+}
+
+// requestShootKubeconfig requests an admin or viewer kubeconfig for a shoot from the garden cluster.
+func requestShootKubeconfig(ctx context.Context, gardenKubeconfig []byte, config *targettypes.GardenerShootTargetConfig,
+	expirationSeconds int64) ([]byte, time.Time, error) {
+	shootClient, err := clusters.NewShootClient(gardenKubeconfig)
+	if err != nil {
+		return nil, time.Time{}, err
+	}
+
+	namespace := config.Namespace
+	if len(namespace) == 0 {
+		namespace, err = shootClient.GetProjectNamespace(ctx, config.Project)
+		if err != nil {
+			return nil, time.Time{}, err
+		}
+	}
+
+	getKubeconfig := shootClient.GetShootAdminKubeconfig
+	if config.AccessLevel == targettypes.GardenerShootViewerAccess {
+		getKubeconfig = shootClient.GetShootViewerKubeconfig
+	}
+	kubeconfigBase64, expirationTimestamp, err := getKubeconfig(ctx, config.Name, namespace, expirationSeconds)
+	if err != nil {
+		return nil, time.Time{}, err
+	}
+
+	kubeconfig, err := base64.StdEncoding.DecodeString(kubeconfigBase64)
+	if err != nil {
+		return nil, time.Time{}, fmt.Errorf("unable to decode kubeconfig of shoot %s/%s: %w", namespace, config.Name, err)
+	}
+	return kubeconfig, expirationTimestamp.Time, nil
+}
@@ -0,0 +1,97 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package lib
+
+import (
+	"context"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"k8s.io/utils/ptr"
+
+	"github.com/gardener/landscaper/apis/core/v1alpha1/targettypes"
+)
+
+var _ = Describe("Gardener Shoot Kubeconfig Cache", func() {
+
+	var (
+		ctx      context.Context
+		now      time.Time
+		requests int
+		cache    *shootKubeconfigCache
+	)
+
+	BeforeEach(func() {
+		ctx = context.Background()
+		now = time.Now()
+		requests = 0
+		cache = newShootKubeconfigCache()
+		cache.now = func() time.Time { return now }
+		cache.request = func(_ context.Context, _ []byte, config *targettypes.GardenerShootTargetConfig, expirationSeconds int64) ([]byte, time.Time, error) {
+			requests++
+			return []byte(string(config.AccessLevel) + "-" + config.Name), now.Add(time.Duration(expirationSeconds) * time.Second), nil
+		}
+	})
+
+	It("should reuse a kubeconfig until half of its validity has passed", func() {
+		config := &targettypes.GardenerShootTargetConfig{
+			Name:              "my-shoot",
+			Project:           "my-project",
+			ExpirationSeconds: ptr.To[int64](600),
+		}
+
+		kubeconfig, err := cache.Get(ctx, []byte("garden"), config)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(string(kubeconfig)).To(Equal("-my-shoot"))
+
+		now = now.Add(4 * time.Minute)
+		_, err = cache.Get(ctx, []byte("garden"), config)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(requests).To(Equal(1))
+
+		now = now.Add(2 * time.Minute)
+		_, err = cache.Get(ctx, []byte("garden"), config)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(requests).To(Equal(2))
+	})
+
+	It("should request separate kubeconfigs for different gardens and access levels", func() {
+		config := &targettypes.GardenerShootTargetConfig{
+			Name:      "my-shoot",
+			Namespace: "garden-my-project",
+		}
+		viewerConfig := &targettypes.GardenerShootTargetConfig{
+			Name:        "my-shoot",
+			Namespace:   "garden-my-project",
+			AccessLevel: targettypes.GardenerShootViewerAccess,
+		}
+
+		_, err := cache.Get(ctx, []byte("garden"), config)
+		Expect(err).ToNot(HaveOccurred())
+		_, err = cache.Get(ctx, []byte("other-garden"), config)
+		Expect(err).ToNot(HaveOccurred())
+		kubeconfig, err := cache.Get(ctx, []byte("garden"), viewerConfig)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(string(kubeconfig)).To(Equal("viewer-my-shoot"))
+		Expect(requests).To(Equal(3))
+	})
+
+	It("should return copies of the cached kubeconfigs", func() {
+		config := &targettypes.GardenerShootTargetConfig{
+			Name:      "my-shoot",
+			Namespace: "garden-my-project",
+		}
+
+		kubeconfig, err := cache.Get(ctx, []byte("garden"), config)
+		Expect(err).ToNot(HaveOccurred())
+		Zeroize(kubeconfig)
+
+		kubeconfig, err = cache.Get(ctx, []byte("garden"), config)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(string(kubeconfig)).To(Equal("-my-shoot"))
+		Expect(requests).To(Equal(1))
+	})
+})
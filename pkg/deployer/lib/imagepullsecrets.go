@@ -0,0 +1,142 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package lib
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	lsv1alpha1 "github.com/gardener/landscaper/apis/core/v1alpha1"
+	"github.com/gardener/landscaper/pkg/utils/read_write_layer"
+)
+
+// podSpecPaths maps the kinds of the workload resources to the path of their pod spec.
+var podSpecPaths = map[schema.GroupKind][]string{
+	{Group: "", Kind: "Pod"}:                   {"spec"},
+	{Group: "", Kind: "PodTemplate"}:           {"template", "spec"},
+	{Group: "", Kind: "ReplicationController"}: {"spec", "template", "spec"},
+	{Group: "apps", Kind: "Deployment"}:        {"spec", "template", "spec"},
+	{Group: "apps", Kind: "StatefulSet"}:       {"spec", "template", "spec"},
+	{Group: "apps", Kind: "DaemonSet"}:         {"spec", "template", "spec"},
+	{Group: "apps", Kind: "ReplicaSet"}:        {"spec", "template", "spec"},
+	{Group: "batch", Kind: "Job"}:              {"spec", "template", "spec"},
+	{Group: "batch", Kind: "CronJob"}:          {"spec", "jobTemplate", "spec", "template", "spec"},
+}
+
+// dockerConfig is the content of a secret of type "kubernetes.io/dockerconfigjson".
+type dockerConfig struct {
+	Auths map[string]json.RawMessage `json:"auths"`
+}
+
+// GetContextImagePullSecretsDockerConfig merges the image pull secrets of the context into one docker config.
+// If several secrets contain credentials for the same registry, the credentials of the first secret are used.
+// Nil is returned if the context defines no image pull secrets.
+func GetContextImagePullSecretsDockerConfig(ctx context.Context, lsClient client.Client, lsCtx *lsv1alpha1.Context) ([]byte, error) {
+	if lsCtx == nil || lsCtx.ImagePullSecrets == nil || len(lsCtx.ImagePullSecrets.Secrets) == 0 {
+		return nil, nil
+	}
+
+	merged := dockerConfig{Auths: map[string]json.RawMessage{}}
+	for _, ref := range lsCtx.ImagePullSecrets.Secrets {
+		key := client.ObjectKey{Namespace: lsCtx.Namespace, Name: ref.Name}
+		secret := &corev1.Secret{}
+		if err := read_write_layer.GetSecret(ctx, lsClient, key, secret, read_write_layer.R000126); err != nil {
+			return nil, fmt.Errorf("unable to get image pull secret %s: %w", key.String(), err)
+		}
+
+		data, ok := secret.Data[corev1.DockerConfigJsonKey]
+		if !ok {
+			return nil, fmt.Errorf("image pull secret %s has no key %q", key.String(), corev1.DockerConfigJsonKey)
+		}
+		config := dockerConfig{}
+		if err := json.Unmarshal(data, &config); err != nil {
+			return nil, fmt.Errorf("image pull secret %s contains no valid docker config: %w", key.String(), err)
+		}
+		for registry, auth := range config.Auths {
+			if _, ok := merged.Auths[registry]; !ok {
+				merged.Auths[registry] = auth
+			}
+		}
+	}
+
+	return json.Marshal(merged)
+}
+
+// NewImagePullSecret creates an image pull secret with the given docker config.
+func NewImagePullSecret(name, namespace string, dockerConfig []byte) *corev1.Secret {
+	secret := &corev1.Secret{}
+	secret.APIVersion = corev1.SchemeGroupVersion.String()
+	secret.Kind = "Secret"
+	secret.Name = name
+	secret.Namespace = namespace
+	secret.Type = corev1.SecretTypeDockerConfigJson
+	secret.Data = map[string][]byte{
+		corev1.DockerConfigJsonKey: dockerConfig,
+	}
+	return secret
+}
+
+// InjectImagePullSecret adds the image pull secret to the pod spec of pods and of the pod templates of workload resources.
+// Other objects are not modified. It returns whether the object has been modified.
+func InjectImagePullSecret(obj *unstructured.Unstructured, secretName string) (bool, error) {
+	path, ok := podSpecPaths[obj.GroupVersionKind().GroupKind()]
+	if !ok {
+		return false, nil
+	}
+	if _, found, err := unstructured.NestedMap(obj.Object, path...); err != nil || !found {
+		return false, err
+	}
+
+	secretsPath := append(append([]string{}, path...), "imagePullSecrets")
+	secrets, _, err := unstructured.NestedSlice(obj.Object, secretsPath...)
+	if err != nil {
+		return false, err
+	}
+	for _, s := range secrets {
+		if ref, ok := s.(map[string]interface{}); ok && ref["name"] == secretName {
+			return false, nil
+		}
+	}
+
+	secrets = append(secrets, map[string]interface{}{"name": secretName})
+	if err := unstructured.SetNestedSlice(obj.Object, secrets, secretsPath...); err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+// InjectImagePullSecretIntoManifests adds the image pull secret to all pods and pod templates of the given manifests.
+func InjectImagePullSecretIntoManifests(manifests []*runtime.RawExtension, secretName string) ([]*runtime.RawExtension, error) {
+	result := make([]*runtime.RawExtension, len(manifests))
+	for i, manifest := range manifests {
+		obj := &unstructured.Unstructured{}
+		if err := json.Unmarshal(manifest.Raw, &obj.Object); err != nil {
+			return nil, fmt.Errorf("unable to decode manifest: %w", err)
+		}
+
+		modified, err := InjectImagePullSecret(obj, secretName)
+		if err != nil {
+			return nil, fmt.Errorf("unable to inject image pull secret into %s %s: %w", obj.GetKind(), obj.GetName(), err)
+		}
+		if !modified {
+			result[i] = manifest
+			continue
+		}
+
+		raw, err := json.Marshal(obj.Object)
+		if err != nil {
+			return nil, fmt.Errorf("unable to encode manifest: %w", err)
+		}
+		result[i] = &runtime.RawExtension{Raw: raw}
+	}
+	return result, nil
+}
@@ -0,0 +1,111 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package lib
+
+import (
+	"context"
+	"encoding/json"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	lsv1alpha1 "github.com/gardener/landscaper/apis/core/v1alpha1"
+	"github.com/gardener/landscaper/pkg/api"
+)
+
+var _ = Describe("Image pull secrets", func() {
+
+	raw := func(a any) *runtime.RawExtension {
+		bytes, err := json.Marshal(a)
+		Expect(err).NotTo(HaveOccurred())
+		return &runtime.RawExtension{Raw: bytes}
+	}
+
+	pullSecret := func(name, dockerConfig string) *corev1.Secret {
+		return &corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "test"},
+			Type:       corev1.SecretTypeDockerConfigJson,
+			Data:       map[string][]byte{corev1.DockerConfigJsonKey: []byte(dockerConfig)},
+		}
+	}
+
+	Context("GetContextImagePullSecretsDockerConfig", func() {
+
+		It("should return nil if the context defines no image pull secrets", func() {
+			lsClient := fake.NewClientBuilder().WithScheme(api.LandscaperScheme).Build()
+			config, err := GetContextImagePullSecretsDockerConfig(context.Background(), lsClient, &lsv1alpha1.Context{})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(config).To(BeNil())
+		})
+
+		It("should merge the docker configs of all image pull secrets", func() {
+			lsClient := fake.NewClientBuilder().WithScheme(api.LandscaperScheme).WithObjects(
+				pullSecret("s1", `{"auths": {"a.example.com": {"auth": "YTph"}}}`),
+				pullSecret("s2", `{"auths": {"a.example.com": {"auth": "Yjpi"}, "b.example.com": {"auth": "Yzpj"}}}`),
+			).Build()
+			lsCtx := &lsv1alpha1.Context{}
+			lsCtx.Namespace = "test"
+			lsCtx.ImagePullSecrets = &lsv1alpha1.ImagePullSecretsConfiguration{
+				Secrets: []corev1.LocalObjectReference{{Name: "s1"}, {Name: "s2"}},
+			}
+
+			config, err := GetContextImagePullSecretsDockerConfig(context.Background(), lsClient, lsCtx)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(config).To(MatchJSON(`{"auths": {"a.example.com": {"auth": "YTph"}, "b.example.com": {"auth": "Yzpj"}}}`))
+		})
+
+		It("should fail if an image pull secret does not exist", func() {
+			lsClient := fake.NewClientBuilder().WithScheme(api.LandscaperScheme).Build()
+			lsCtx := &lsv1alpha1.Context{}
+			lsCtx.Namespace = "test"
+			lsCtx.ImagePullSecrets = &lsv1alpha1.ImagePullSecretsConfiguration{
+				Secrets: []corev1.LocalObjectReference{{Name: "s1"}},
+			}
+
+			_, err := GetContextImagePullSecretsDockerConfig(context.Background(), lsClient, lsCtx)
+			Expect(err).To(HaveOccurred())
+		})
+	})
+
+	Context("InjectImagePullSecretIntoManifests", func() {
+
+		It("should add the image pull secret to pod templates and keep other objects", func() {
+			deployment := &appsv1.Deployment{
+				TypeMeta:   metav1.TypeMeta{APIVersion: "apps/v1", Kind: "Deployment"},
+				ObjectMeta: metav1.ObjectMeta{Name: "app", Namespace: "test"},
+			}
+			deployment.Spec.Template.Spec.Containers = []corev1.Container{{Name: "app", Image: "a.example.com/app:1.0.0"}}
+			deployment.Spec.Template.Spec.ImagePullSecrets = []corev1.LocalObjectReference{{Name: "existing"}}
+			configMap := &corev1.ConfigMap{
+				TypeMeta:   metav1.TypeMeta{APIVersion: "v1", Kind: "ConfigMap"},
+				ObjectMeta: metav1.ObjectMeta{Name: "config", Namespace: "test"},
+			}
+			manifests := []*runtime.RawExtension{raw(deployment), raw(configMap)}
+
+			result, err := InjectImagePullSecretIntoManifests(manifests, "pull")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(result).To(HaveLen(2))
+			Expect(result[1]).To(BeIdenticalTo(manifests[1]))
+
+			injected := &appsv1.Deployment{}
+			Expect(json.Unmarshal(result[0].Raw, injected)).To(Succeed())
+			Expect(injected.Spec.Template.Spec.ImagePullSecrets).To(ConsistOf(
+				corev1.LocalObjectReference{Name: "existing"},
+				corev1.LocalObjectReference{Name: "pull"},
+			))
+
+			// a second injection must not add the secret again
+			result, err = InjectImagePullSecretIntoManifests(result, "pull")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(json.Unmarshal(result[0].Raw, injected)).To(Succeed())
+			Expect(injected.Spec.Template.Spec.ImagePullSecrets).To(HaveLen(2))
+		})
+	})
+})
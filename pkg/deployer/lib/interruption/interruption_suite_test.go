@@ -0,0 +1,13 @@
+package interruption_test
+
+import (
+	"testing"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+func TestInterruption(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "Interruption Test Suite")
+}
@@ -6,6 +6,7 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	lsv1alpha1 "github.com/gardener/landscaper/apis/core/v1alpha1"
+	lsv1alpha1helper "github.com/gardener/landscaper/apis/core/v1alpha1/helper"
 	"github.com/gardener/landscaper/pkg/utils/read_write_layer"
 )
 
@@ -32,7 +33,7 @@ func (c *standardInterruptionChecker) Check(ctx context.Context) error {
 		return err
 	}
 
-	if di.Status.Phase.IsFailed() {
+	if di.Status.Phase.IsFailed() || lsv1alpha1helper.HasOperation(di.ObjectMeta, lsv1alpha1.AbortOperation) {
 		return ErrInterruption
 	}
 
@@ -0,0 +1,38 @@
+package interruption
+
+import (
+	"context"
+	"errors"
+	"time"
+)
+
+// DefaultWatchInterval is the default interval in which a watched context checks for interrupts.
+const DefaultWatchInterval = 5 * time.Second
+
+// WithInterruption returns a copy of the context that is cancelled as soon as the interruption checker reports an
+// interrupt, e.g. because the deploy item has been aborted. The checker is called in the given interval.
+// Other errors of the checker are ignored, so that temporary problems do not cancel the processing.
+// The returned cancel function must be called to stop the watch.
+func WithInterruption(ctx context.Context, checker InterruptionChecker, interval time.Duration) (context.Context, context.CancelFunc) {
+	ctx, cancel := context.WithCancel(ctx)
+	if checker == nil {
+		return ctx, cancel
+	}
+
+	go func() {
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+				if err := checker.Check(ctx); errors.Is(err, ErrInterruption) {
+					cancel()
+					return
+				}
+			}
+		}
+	}()
+	return ctx, cancel
+}
@@ -0,0 +1,44 @@
+package interruption_test
+
+import (
+	"context"
+	"fmt"
+	"sync/atomic"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/gardener/landscaper/pkg/deployer/lib/interruption"
+)
+
+type fakeChecker struct {
+	err atomic.Value
+}
+
+func (c *fakeChecker) Check(_ context.Context) error {
+	err, _ := c.err.Load().(error)
+	return err
+}
+
+var _ = Describe("WithInterruption", func() {
+
+	It("should cancel the context when an interrupt is reported", func() {
+		checker := &fakeChecker{}
+		ctx, cancel := interruption.WithInterruption(context.Background(), checker, 10*time.Millisecond)
+		defer cancel()
+
+		checker.err.Store(fmt.Errorf("temporary problem"))
+		Consistently(ctx.Done(), 50*time.Millisecond).ShouldNot(BeClosed())
+
+		checker.err.Store(interruption.ErrInterruption)
+		Eventually(ctx.Done(), time.Second).Should(BeClosed())
+	})
+
+	It("should not cancel the context without interruption checker", func() {
+		ctx, cancel := interruption.WithInterruption(context.Background(), nil, 10*time.Millisecond)
+		Consistently(ctx.Done(), 50*time.Millisecond).ShouldNot(BeClosed())
+		cancel()
+		Expect(ctx.Done()).To(BeClosed())
+	})
+})
@@ -0,0 +1,278 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package lib
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/selection"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/manager"
+
+	lsconfigv1alpha1 "github.com/gardener/landscaper/apis/config/v1alpha1"
+	lsv1alpha1 "github.com/gardener/landscaper/apis/core/v1alpha1"
+	"github.com/gardener/landscaper/apis/core/v1alpha1/targettypes"
+	kutil "github.com/gardener/landscaper/controller-utils/pkg/kubernetes"
+	"github.com/gardener/landscaper/controller-utils/pkg/landscaper/targetresolver"
+	"github.com/gardener/landscaper/controller-utils/pkg/logging"
+	lc "github.com/gardener/landscaper/controller-utils/pkg/logging/constants"
+	"github.com/gardener/landscaper/pkg/deployer/lib/targetselector"
+	"github.com/gardener/landscaper/pkg/utils/read_write_layer"
+)
+
+const (
+	// DefaultInventoryInterval is the default time between two inventory runs.
+	DefaultInventoryInterval = time.Hour
+	// DefaultInventoryMinAge is the default minimal age of a resource to be considered as orphaned.
+	DefaultInventoryMinAge = time.Hour
+)
+
+// OrphanedResource is a resource in a target cluster that is labeled as managed by the landscaper instance,
+// but whose deploy item does not exist anymore.
+type OrphanedResource struct {
+	// Target is the key of the target that points to the cluster of the resource.
+	Target client.ObjectKey
+	// GroupVersionKind is the type of the resource.
+	GroupVersionKind schema.GroupVersionKind
+	// Resource is the key of the resource in the target cluster.
+	Resource client.ObjectKey
+	// DeployItem is the key of the deploy item that has applied the resource.
+	DeployItem string
+	// Deleted is true if the resource has been deleted by the inventory.
+	Deleted bool
+}
+
+// Inventory periodically lists the resources in the target clusters of a deployer that carry the management labels
+// of the landscaper instance, and reports or deletes the resources whose deploy item does not exist anymore.
+// Such resources are left behind if a deployer crashes after it has applied a resource,
+// but before the resource has been persisted in the status of the deploy item.
+type Inventory struct {
+	lsUncachedClient client.Client
+	log              logging.Logger
+	targetSelectors  []lsv1alpha1.TargetSelector
+	targetConfig     *lsconfigv1alpha1.TargetClientConfig
+	selector         labels.Selector
+	interval         time.Duration
+	minAge           time.Duration
+}
+
+// NewInventory creates a new inventory for the given target client configuration.
+// Only the clusters of targets that match the target selectors of the deployer are checked.
+func NewInventory(lsUncachedClient client.Client, log logging.Logger,
+	targetSelectors []lsv1alpha1.TargetSelector, targetConfig *lsconfigv1alpha1.TargetClientConfig) (*Inventory, error) {
+	if targetConfig == nil || targetConfig.Inventory == nil {
+		return nil, errors.New("no inventory configured")
+	}
+	if len(targetConfig.LandscapeInstanceID) == 0 {
+		return nil, errors.New("the inventory requires a landscape instance id in the target client configuration")
+	}
+	if len(targetConfig.Inventory.ResourceTypes) == 0 {
+		return nil, errors.New("the inventory requires at least one resource type")
+	}
+
+	instanceLabel, err := labels.NewRequirement(lsv1alpha1.LandscapeInstanceIDLabel, selection.Equals,
+		[]string{targetConfig.LandscapeInstanceID})
+	if err != nil {
+		return nil, err
+	}
+
+	inv := &Inventory{
+		lsUncachedClient: lsUncachedClient,
+		log:              log,
+		targetSelectors:  targetSelectors,
+		targetConfig:     targetConfig,
+		selector:         labels.NewSelector().Add(*instanceLabel),
+		interval:         DefaultInventoryInterval,
+		minAge:           DefaultInventoryMinAge,
+	}
+	if targetConfig.Inventory.Interval != nil && targetConfig.Inventory.Interval.Duration > 0 {
+		inv.interval = targetConfig.Inventory.Interval.Duration
+	}
+	if targetConfig.Inventory.MinAge != nil {
+		inv.minAge = targetConfig.Inventory.MinAge.Duration
+	}
+	return inv, nil
+}
+
+// AddInventoryToManager adds an inventory to the manager if it is configured in the target client configuration.
+func AddInventoryToManager(lsUncachedClient client.Client, log logging.Logger, lsMgr manager.Manager,
+	targetSelectors []lsv1alpha1.TargetSelector, targetConfig *lsconfigv1alpha1.TargetClientConfig) error {
+	if targetConfig == nil || targetConfig.Inventory == nil {
+		return nil
+	}
+	inv, err := NewInventory(lsUncachedClient, log.WithName("inventory"), targetSelectors, targetConfig)
+	if err != nil {
+		return fmt.Errorf("unable to create inventory: %w", err)
+	}
+	log.Info("inventory of target cluster resources enabled", "interval", inv.interval.String(),
+		"cleanup", targetConfig.Inventory.Cleanup)
+	return lsMgr.Add(manager.RunnableFunc(inv.Start))
+}
+
+// Start runs the inventory periodically until the context is cancelled.
+func (inv *Inventory) Start(ctx context.Context) error {
+	wait.UntilWithContext(ctx, func(ctx context.Context) {
+		if _, err := inv.Run(ctx); err != nil {
+			inv.log.Error(err, "inventory of target cluster resources failed")
+		}
+	}, inv.interval)
+	return nil
+}
+
+// Run checks the clusters of all targets that the deployer is responsible for.
+// Errors of single targets are logged, so that they do not prevent the check of the other targets.
+func (inv *Inventory) Run(ctx context.Context) ([]OrphanedResource, error) {
+	ctx = logging.NewContext(ctx, inv.log)
+
+	targets := &lsv1alpha1.TargetList{}
+	var listOpts []client.ListOption
+	if a := GetAgent(); a != nil && len(a.Namespace) != 0 {
+		listOpts = append(listOpts, client.InNamespace(a.Namespace))
+	}
+	if err := read_write_layer.ListTargets(ctx, inv.lsUncachedClient, targets, read_write_layer.R000136, listOpts...); err != nil {
+		return nil, fmt.Errorf("unable to list targets: %w", err)
+	}
+
+	orphans := []OrphanedResource{}
+	for i := range targets.Items {
+		target := &targets.Items[i]
+		if target.Spec.Type != targettypes.KubernetesClusterTargetType && target.Spec.Type != targettypes.GardenerShootTargetType &&
+			target.Spec.Type != targettypes.AgentTargetType {
+			continue
+		}
+		if responsible, err := IsResponsibleForTarget(target); err != nil || !responsible {
+			continue
+		}
+		if len(inv.targetSelectors) != 0 {
+			matched, err := targetselector.MatchOne(target, inv.targetSelectors)
+			if err != nil || !matched {
+				continue
+			}
+		}
+
+		targetClient, err := inv.newTargetClient(ctx, target)
+		if err != nil {
+			inv.log.Error(err, "unable to create client for target", lc.KeyResource, kutil.ObjectKeyFromObject(target).String())
+			continue
+		}
+		targetOrphans, err := inv.CheckTarget(ctx, client.ObjectKeyFromObject(target), targetClient)
+		if err != nil {
+			inv.log.Error(err, "inventory of target failed", lc.KeyResource, kutil.ObjectKeyFromObject(target).String())
+		}
+		orphans = append(orphans, targetOrphans...)
+	}
+
+	inv.log.Info("inventory of target cluster resources finished", "numberOfTargets", len(targets.Items),
+		"numberOfOrphanedResources", len(orphans))
+	return orphans, nil
+}
+
+// CheckTarget checks the resources in the cluster of a single target using the given target cluster client.
+func (inv *Inventory) CheckTarget(ctx context.Context, targetKey client.ObjectKey, targetClient client.Client) ([]OrphanedResource, error) {
+	orphans := []OrphanedResource{}
+	var allErrs []error
+	for _, resourceType := range inv.targetConfig.Inventory.ResourceTypes {
+		gvk := schema.FromAPIVersionAndKind(resourceType.APIVersion, resourceType.Kind)
+		list := &unstructured.UnstructuredList{}
+		list.SetGroupVersionKind(gvk.GroupVersion().WithKind(gvk.Kind + "List"))
+		if err := targetClient.List(ctx, list, client.MatchingLabelsSelector{Selector: inv.selector}); err != nil {
+			allErrs = append(allErrs, fmt.Errorf("unable to list %s: %w", gvk.String(), err))
+			continue
+		}
+
+		for i := range list.Items {
+			obj := &list.Items[i]
+			orphan, err := inv.checkResource(ctx, targetKey, targetClient, gvk, obj)
+			if err != nil {
+				allErrs = append(allErrs, err)
+				continue
+			}
+			if orphan != nil {
+				orphans = append(orphans, *orphan)
+			}
+		}
+	}
+	return orphans, errors.Join(allErrs...)
+}
+
+func (inv *Inventory) checkResource(ctx context.Context, targetKey client.ObjectKey, targetClient client.Client,
+	gvk schema.GroupVersionKind, obj *unstructured.Unstructured) (*OrphanedResource, error) {
+	diRef, ok := obj.GetAnnotations()[lsv1alpha1.ManagedByDeployItemAnnotation]
+	if !ok {
+		// the resource has been applied by an older deployer version that did not annotate the deploy item
+		return nil, nil
+	}
+	if time.Since(obj.GetCreationTimestamp().Time) < inv.minAge {
+		return nil, nil
+	}
+
+	diKey, err := parseObjectKey(diRef)
+	if err != nil {
+		return nil, err
+	}
+	di := &lsv1alpha1.DeployItem{}
+	err = read_write_layer.GetDeployItem(ctx, inv.lsUncachedClient, diKey, di, read_write_layer.R000137)
+	if err == nil {
+		return nil, nil
+	}
+	if !apierrors.IsNotFound(err) {
+		return nil, fmt.Errorf("unable to get deploy item %s: %w", diRef, err)
+	}
+
+	orphan := &OrphanedResource{
+		Target:           targetKey,
+		GroupVersionKind: gvk,
+		Resource:         client.ObjectKeyFromObject(obj),
+		DeployItem:       diRef,
+	}
+	logValues := []interface{}{
+		lc.KeyResource, orphan.Resource.String(),
+		lc.KeyResourceKind, gvk.Kind,
+		"target", targetKey.String(),
+		"deployItem", diRef,
+	}
+
+	if !inv.targetConfig.Inventory.Cleanup {
+		inv.log.Info("found orphaned resource in target cluster", logValues...)
+		return orphan, nil
+	}
+
+	if err := targetClient.Delete(ctx, obj); err != nil && !apierrors.IsNotFound(err) {
+		return orphan, fmt.Errorf("unable to delete orphaned resource %s: %w", orphan.Resource.String(), err)
+	}
+	orphan.Deleted = true
+	inv.log.Info("deleted orphaned resource in target cluster", logValues...)
+	return orphan, nil
+}
+
+func (inv *Inventory) newTargetClient(ctx context.Context, target *lsv1alpha1.Target) (client.Client, error) {
+	rt, err := targetresolver.Resolve(ctx, target, inv.lsUncachedClient)
+	if err != nil {
+		return nil, err
+	}
+	targetClients, err := NewTargetClients(ctx, rt, inv.lsUncachedClient, inv.targetConfig)
+	if err != nil {
+		return nil, err
+	}
+	return targetClients.Client, nil
+}
+
+// parseObjectKey parses an object key of the form namespace/name.
+func parseObjectKey(key string) (client.ObjectKey, error) {
+	namespace, name, found := strings.Cut(key, "/")
+	if !found {
+		return client.ObjectKey{}, fmt.Errorf("invalid object key %q", key)
+	}
+	return client.ObjectKey{Namespace: namespace, Name: name}, nil
+}
@@ -0,0 +1,116 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package lib_test
+
+import (
+	"context"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	lsconfigv1alpha1 "github.com/gardener/landscaper/apis/config/v1alpha1"
+	lsv1alpha1 "github.com/gardener/landscaper/apis/core/v1alpha1"
+	"github.com/gardener/landscaper/controller-utils/pkg/logging"
+	"github.com/gardener/landscaper/pkg/api"
+	"github.com/gardener/landscaper/pkg/deployer/lib"
+)
+
+var _ = Describe("Inventory", func() {
+
+	var (
+		ctx       context.Context
+		targetKey = client.ObjectKey{Name: "my-target", Namespace: "default"}
+	)
+
+	BeforeEach(func() {
+		ctx = context.Background()
+	})
+
+	configMap := func(name, instanceID, deployItem string, age time.Duration) *corev1.ConfigMap {
+		cm := &corev1.ConfigMap{}
+		cm.Name = name
+		cm.Namespace = "app"
+		cm.CreationTimestamp = metav1.NewTime(time.Now().Add(-age))
+		if len(instanceID) != 0 {
+			cm.Labels = map[string]string{lsv1alpha1.LandscapeInstanceIDLabel: instanceID}
+		}
+		if len(deployItem) != 0 {
+			cm.Annotations = map[string]string{lsv1alpha1.ManagedByDeployItemAnnotation: deployItem}
+		}
+		return cm
+	}
+
+	newClients := func() (client.Client, client.Client) {
+		di := &lsv1alpha1.DeployItem{}
+		di.Name = "existing"
+		di.Namespace = "default"
+		lsClient := fake.NewClientBuilder().WithScheme(api.LandscaperScheme).WithObjects(di).Build()
+
+		targetClient := fake.NewClientBuilder().WithObjects(
+			configMap("referenced", "ls1", "default/existing", 2*time.Hour),
+			configMap("orphaned", "ls1", "default/deleted", 2*time.Hour),
+			configMap("young", "ls1", "default/deleted", time.Minute),
+			configMap("other-instance", "ls2", "default/deleted", 2*time.Hour),
+			configMap("unmanaged", "", "", 2*time.Hour),
+		).Build()
+		return lsClient, targetClient
+	}
+
+	targetConfig := func(cleanup bool) *lsconfigv1alpha1.TargetClientConfig {
+		return &lsconfigv1alpha1.TargetClientConfig{
+			LandscapeInstanceID: "ls1",
+			Inventory: &lsconfigv1alpha1.InventoryConfig{
+				ResourceTypes: []lsconfigv1alpha1.InventoryResourceType{{APIVersion: "v1", Kind: "ConfigMap"}},
+				Cleanup:       cleanup,
+			},
+		}
+	}
+
+	It("should report resources of the landscaper instance whose deploy item does not exist", func() {
+		lsClient, targetClient := newClients()
+		inv, err := lib.NewInventory(lsClient, logging.Discard(), nil, targetConfig(false))
+		Expect(err).ToNot(HaveOccurred())
+
+		orphans, err := inv.CheckTarget(ctx, targetKey, targetClient)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(orphans).To(HaveLen(1))
+		Expect(orphans[0].Target).To(Equal(targetKey))
+		Expect(orphans[0].Resource).To(Equal(client.ObjectKey{Name: "orphaned", Namespace: "app"}))
+		Expect(orphans[0].GroupVersionKind.Kind).To(Equal("ConfigMap"))
+		Expect(orphans[0].DeployItem).To(Equal("default/deleted"))
+		Expect(orphans[0].Deleted).To(BeFalse())
+
+		Expect(targetClient.Get(ctx, orphans[0].Resource, &corev1.ConfigMap{})).To(Succeed())
+	})
+
+	It("should delete orphaned resources if cleanup is enabled", func() {
+		lsClient, targetClient := newClients()
+		inv, err := lib.NewInventory(lsClient, logging.Discard(), nil, targetConfig(true))
+		Expect(err).ToNot(HaveOccurred())
+
+		orphans, err := inv.CheckTarget(ctx, targetKey, targetClient)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(orphans).To(HaveLen(1))
+		Expect(orphans[0].Deleted).To(BeTrue())
+
+		err = targetClient.Get(ctx, orphans[0].Resource, &corev1.ConfigMap{})
+		Expect(apierrors.IsNotFound(err)).To(BeTrue())
+		Expect(targetClient.Get(ctx, client.ObjectKey{Name: "referenced", Namespace: "app"}, &corev1.ConfigMap{})).To(Succeed())
+		Expect(targetClient.Get(ctx, client.ObjectKey{Name: "young", Namespace: "app"}, &corev1.ConfigMap{})).To(Succeed())
+	})
+
+	It("should require a landscape instance id", func() {
+		config := targetConfig(false)
+		config.LandscapeInstanceID = ""
+		_, err := lib.NewInventory(nil, logging.Discard(), nil, config)
+		Expect(err).To(HaveOccurred())
+	})
+})
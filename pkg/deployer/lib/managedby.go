@@ -0,0 +1,40 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package lib
+
+import (
+	lsconfigv1alpha1 "github.com/gardener/landscaper/apis/config/v1alpha1"
+	lsv1alpha1 "github.com/gardener/landscaper/apis/core/v1alpha1"
+	kutil "github.com/gardener/landscaper/controller-utils/pkg/kubernetes"
+)
+
+// ManagedByAnnotations returns the annotations that identify the deploy item, execution and installation
+// that manage the resources a deployer applies to a target cluster for the given deploy item.
+// They enable a reverse lookup from a resource in a target cluster to the landscaper objects that manage it.
+func ManagedByAnnotations(di *lsv1alpha1.DeployItem) map[string]string {
+	annotations := map[string]string{
+		lsv1alpha1.ManagedByDeployItemAnnotation: kutil.ObjectKeyFromObject(di).String(),
+	}
+	if execName := di.Labels[lsv1alpha1.ExecutionManagedByLabel]; len(execName) != 0 {
+		annotations[lsv1alpha1.ManagedByExecutionAnnotation] = kutil.ObjectKey(execName, di.Namespace).String()
+	}
+	if inst := di.Annotations[lsv1alpha1.ManagedByInstallationAnnotation]; len(inst) != 0 {
+		annotations[lsv1alpha1.ManagedByInstallationAnnotation] = inst
+	}
+	return annotations
+}
+
+// ManagedByLabels adds the landscape instance id of the target client configuration to the given labels
+// of the resources a deployer applies to a target cluster. The labels are returned unchanged if no id is configured.
+func ManagedByLabels(labels map[string]string, config *lsconfigv1alpha1.TargetClientConfig) map[string]string {
+	if config == nil || len(config.LandscapeInstanceID) == 0 {
+		return labels
+	}
+	if labels == nil {
+		labels = map[string]string{}
+	}
+	labels[lsv1alpha1.LandscapeInstanceIDLabel] = config.LandscapeInstanceID
+	return labels
+}
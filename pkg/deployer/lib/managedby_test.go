@@ -0,0 +1,54 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package lib
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	lsconfigv1alpha1 "github.com/gardener/landscaper/apis/config/v1alpha1"
+	lsv1alpha1 "github.com/gardener/landscaper/apis/core/v1alpha1"
+)
+
+var _ = Describe("Managed By", func() {
+
+	It("should identify the deploy item, execution and installation", func() {
+		di := &lsv1alpha1.DeployItem{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "di",
+				Namespace: "ns",
+				Labels: map[string]string{
+					lsv1alpha1.ExecutionManagedByLabel: "exec",
+				},
+				Annotations: map[string]string{
+					lsv1alpha1.ManagedByInstallationAnnotation: "ns/inst",
+				},
+			},
+		}
+		Expect(ManagedByAnnotations(di)).To(Equal(map[string]string{
+			lsv1alpha1.ManagedByDeployItemAnnotation:   "ns/di",
+			lsv1alpha1.ManagedByExecutionAnnotation:    "ns/exec",
+			lsv1alpha1.ManagedByInstallationAnnotation: "ns/inst",
+		}))
+	})
+
+	It("should only identify the deploy item if it is not managed by an execution", func() {
+		di := &lsv1alpha1.DeployItem{ObjectMeta: metav1.ObjectMeta{Name: "di", Namespace: "ns"}}
+		Expect(ManagedByAnnotations(di)).To(Equal(map[string]string{
+			lsv1alpha1.ManagedByDeployItemAnnotation: "ns/di",
+		}))
+	})
+
+	It("should add the landscape instance id label if configured", func() {
+		labels := map[string]string{"a": "b"}
+		Expect(ManagedByLabels(labels, nil)).To(Equal(map[string]string{"a": "b"}))
+		Expect(ManagedByLabels(nil, &lsconfigv1alpha1.TargetClientConfig{})).To(BeNil())
+		Expect(ManagedByLabels(labels, &lsconfigv1alpha1.TargetClientConfig{LandscapeInstanceID: "dev"})).To(Equal(map[string]string{
+			"a":                                 "b",
+			lsv1alpha1.LandscapeInstanceIDLabel: "dev",
+		}))
+	})
+})
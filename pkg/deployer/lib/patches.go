@@ -0,0 +1,146 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package lib
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+
+	jsonpatch "github.com/evanphx/json-patch/v5"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/util/strategicpatch"
+	"k8s.io/client-go/kubernetes/scheme"
+	"sigs.k8s.io/yaml"
+
+	helmv1alpha1 "github.com/gardener/landscaper/apis/deployer/helm/v1alpha1"
+)
+
+// ApplyPatches applies the given strategic merge and JSON6902 patches in the given order to the object,
+// if the object is selected by their target. It returns whether a patch has been applied.
+// Strategic merge patches of kinds that are unknown to the kubernetes scheme, e.g. custom resources,
+// are applied as JSON merge patches.
+func ApplyPatches(obj *unstructured.Unstructured, patches []helmv1alpha1.Patch) (bool, error) {
+	modified := false
+	for i, patch := range patches {
+		patchJSON, err := yaml.YAMLToJSON([]byte(patch.Patch))
+		if err != nil {
+			return false, fmt.Errorf("unable to parse patch %d: %w", i, err)
+		}
+		isJSON6902 := bytes.HasPrefix(bytes.TrimSpace(patchJSON), []byte("["))
+
+		target := patch.Target
+		if target == nil {
+			if isJSON6902 {
+				return false, fmt.Errorf("patch %d is a JSON6902 patch without target", i)
+			}
+			if target, err = targetOfStrategicMergePatch(patchJSON); err != nil {
+				return false, fmt.Errorf("unable to determine the target of patch %d: %w", i, err)
+			}
+		}
+		if !patchTargetMatches(obj, target) {
+			continue
+		}
+
+		objJSON, err := obj.MarshalJSON()
+		if err != nil {
+			return false, fmt.Errorf("unable to encode %s %s: %w", obj.GetKind(), obj.GetName(), err)
+		}
+		if isJSON6902 {
+			objJSON, err = applyJSON6902Patch(objJSON, patchJSON)
+		} else {
+			objJSON, err = applyStrategicMergePatch(obj.GroupVersionKind(), objJSON, patchJSON)
+		}
+		if err != nil {
+			return false, fmt.Errorf("unable to apply patch %d to %s %s: %w", i, obj.GetKind(), obj.GetName(), err)
+		}
+
+		patched := &unstructured.Unstructured{}
+		if err := patched.UnmarshalJSON(objJSON); err != nil {
+			return false, fmt.Errorf("unable to decode patched %s %s: %w", obj.GetKind(), obj.GetName(), err)
+		}
+		obj.Object = patched.Object
+		modified = true
+	}
+	return modified, nil
+}
+
+// ApplyPatchesToManifests applies the given patches to all manifests.
+func ApplyPatchesToManifests(manifests []*runtime.RawExtension, patches []helmv1alpha1.Patch) ([]*runtime.RawExtension, error) {
+	result := make([]*runtime.RawExtension, len(manifests))
+	for i, manifest := range manifests {
+		obj := &unstructured.Unstructured{}
+		if err := json.Unmarshal(manifest.Raw, &obj.Object); err != nil {
+			return nil, fmt.Errorf("unable to decode manifest: %w", err)
+		}
+
+		modified, err := ApplyPatches(obj, patches)
+		if err != nil {
+			return nil, err
+		}
+		if !modified {
+			result[i] = manifest
+			continue
+		}
+
+		raw, err := json.Marshal(obj.Object)
+		if err != nil {
+			return nil, fmt.Errorf("unable to encode manifest: %w", err)
+		}
+		result[i] = &runtime.RawExtension{Raw: raw}
+	}
+	return result, nil
+}
+
+// targetOfStrategicMergePatch derives the target of a strategic merge patch from its kind, name and namespace.
+func targetOfStrategicMergePatch(patchJSON []byte) (*helmv1alpha1.PatchTarget, error) {
+	patchObj := &unstructured.Unstructured{}
+	if err := json.Unmarshal(patchJSON, &patchObj.Object); err != nil {
+		return nil, err
+	}
+	if len(patchObj.GetKind()) == 0 || len(patchObj.GetName()) == 0 {
+		return nil, fmt.Errorf("the patch has no kind and name")
+	}
+	gvk := patchObj.GroupVersionKind()
+	return &helmv1alpha1.PatchTarget{
+		Group:     gvk.Group,
+		Version:   gvk.Version,
+		Kind:      gvk.Kind,
+		Name:      patchObj.GetName(),
+		Namespace: patchObj.GetNamespace(),
+	}, nil
+}
+
+func patchTargetMatches(obj *unstructured.Unstructured, target *helmv1alpha1.PatchTarget) bool {
+	gvk := obj.GroupVersionKind()
+	return matchesIfSet(target.Group, gvk.Group) &&
+		matchesIfSet(target.Version, gvk.Version) &&
+		matchesIfSet(target.Kind, gvk.Kind) &&
+		matchesIfSet(target.Name, obj.GetName()) &&
+		matchesIfSet(target.Namespace, obj.GetNamespace())
+}
+
+func matchesIfSet(expected, actual string) bool {
+	return len(expected) == 0 || expected == actual
+}
+
+func applyJSON6902Patch(objJSON, patchJSON []byte) ([]byte, error) {
+	patch, err := jsonpatch.DecodePatch(patchJSON)
+	if err != nil {
+		return nil, err
+	}
+	return patch.Apply(objJSON)
+}
+
+func applyStrategicMergePatch(gvk schema.GroupVersionKind, objJSON, patchJSON []byte) ([]byte, error) {
+	dataStruct, err := scheme.Scheme.New(gvk)
+	if err != nil {
+		// the patch strategies of unknown kinds are not known
+		return jsonpatch.MergePatch(objJSON, patchJSON)
+	}
+	return strategicpatch.StrategicMergePatch(objJSON, patchJSON, dataStruct)
+}
@@ -0,0 +1,139 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package lib
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"sigs.k8s.io/yaml"
+
+	helmv1alpha1 "github.com/gardener/landscaper/apis/deployer/helm/v1alpha1"
+)
+
+var _ = Describe("Patches", func() {
+
+	parse := func(s string) *unstructured.Unstructured {
+		data, err := yaml.YAMLToJSON([]byte(s))
+		Expect(err).ToNot(HaveOccurred())
+		obj := &unstructured.Unstructured{}
+		Expect(obj.UnmarshalJSON(data)).To(Succeed())
+		return obj
+	}
+
+	deployment := func() *unstructured.Unstructured {
+		return parse(`
+apiVersion: apps/v1
+kind: Deployment
+metadata:
+  name: app
+  namespace: default
+spec:
+  replicas: 1
+  template:
+    spec:
+      containers:
+      - name: app
+        image: app:1.0
+      - name: sidecar
+        image: sidecar:1.0
+`)
+	}
+
+	It("should apply a strategic merge patch to the object with the kind and name of the patch", func() {
+		patches := []helmv1alpha1.Patch{{Patch: `
+apiVersion: apps/v1
+kind: Deployment
+metadata:
+  name: app
+spec:
+  template:
+    spec:
+      containers:
+      - name: sidecar
+        image: sidecar:2.0
+`}}
+
+		obj := deployment()
+		modified, err := ApplyPatches(obj, patches)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(modified).To(BeTrue())
+		Expect(obj).To(Equal(parse(`
+apiVersion: apps/v1
+kind: Deployment
+metadata:
+  name: app
+  namespace: default
+spec:
+  replicas: 1
+  template:
+    spec:
+      containers:
+      - name: app
+        image: app:1.0
+      - name: sidecar
+        image: sidecar:2.0
+`)))
+
+		other := deployment()
+		other.SetName("other")
+		modified, err = ApplyPatches(other, patches)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(modified).To(BeFalse())
+	})
+
+	It("should apply JSON6902 patches and merge patches of unknown kinds to the selected objects", func() {
+		patches := []helmv1alpha1.Patch{
+			{
+				Target: &helmv1alpha1.PatchTarget{Kind: "Deployment"},
+				Patch: `
+- op: replace
+  path: /spec/replicas
+  value: 3
+- op: add
+  path: /metadata/labels
+  value:
+    patched: "true"
+`,
+			},
+			{
+				Target: &helmv1alpha1.PatchTarget{Group: "example.com", Kind: "Custom"},
+				Patch:  `{"spec": {"list": ["c"], "removed": null}}`,
+			},
+		}
+
+		obj := deployment()
+		modified, err := ApplyPatches(obj, patches)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(modified).To(BeTrue())
+		Expect(obj.GetLabels()).To(HaveKeyWithValue("patched", "true"))
+		Expect(obj.Object["spec"]).To(HaveKeyWithValue("replicas", BeNumerically("==", 3)))
+
+		custom := parse(`
+apiVersion: example.com/v1
+kind: Custom
+metadata:
+  name: custom
+spec:
+  list: ["a", "b"]
+  removed: value
+`)
+		modified, err = ApplyPatches(custom, patches)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(modified).To(BeTrue())
+		Expect(custom.Object["spec"]).To(Equal(map[string]interface{}{"list": []interface{}{"c"}}))
+	})
+
+	It("should fail for invalid patches", func() {
+		_, err := ApplyPatches(deployment(), []helmv1alpha1.Patch{{Patch: `[{"op": "replace", "path": "/spec/replicas", "value": 3}]`}})
+		Expect(err).To(HaveOccurred())
+
+		_, err = ApplyPatches(deployment(), []helmv1alpha1.Patch{{
+			Target: &helmv1alpha1.PatchTarget{Kind: "Deployment"},
+			Patch:  `[{"op": "remove", "path": "/spec/missing"}]`,
+		}})
+		Expect(err).To(HaveOccurred())
+	})
+})
@@ -0,0 +1,49 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package lib
+
+import (
+	"context"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	lsv1alpha1 "github.com/gardener/landscaper/apis/core/v1alpha1"
+	"github.com/gardener/landscaper/pkg/utils/read_write_layer"
+)
+
+// SetProgress sets the progress of the current operation in the status of the deploy item.
+// The percentage is limited to the range from 0 to 100.
+// The deploy item is only modified if the progress has changed, which is indicated by the return value.
+func SetProgress(di *lsv1alpha1.DeployItem, percent int32, step, message string) bool {
+	if percent < 0 {
+		percent = 0
+	} else if percent > 100 {
+		percent = 100
+	}
+
+	old := di.Status.Progress
+	if old != nil && old.Percent == percent && old.Step == step && old.Message == message {
+		return false
+	}
+	di.Status.Progress = &lsv1alpha1.DeployerProgress{
+		Percent:        percent,
+		Step:           step,
+		Message:        message,
+		LastUpdateTime: metav1.Now(),
+	}
+	return true
+}
+
+// UpdateProgress sets the progress of the current operation and writes the status of the deploy item
+// if the progress has changed. Long-running deployers use it to report their progress during an operation.
+func UpdateProgress(ctx context.Context, lsUncachedClient client.Client, di *lsv1alpha1.DeployItem,
+	percent int32, step, message string) error {
+
+	if !SetProgress(di, percent, step, message) {
+		return nil
+	}
+	return read_write_layer.NewWriter(lsUncachedClient).UpdateDeployItemStatus(ctx, read_write_layer.W000164, di)
+}
@@ -0,0 +1,37 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package lib
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	lsv1alpha1 "github.com/gardener/landscaper/apis/core/v1alpha1"
+)
+
+var _ = Describe("Progress", func() {
+
+	It("should set the progress and limit the percentage", func() {
+		di := &lsv1alpha1.DeployItem{}
+		Expect(SetProgress(di, 150, "Apply", "applying")).To(BeTrue())
+		Expect(di.Status.Progress).ToNot(BeNil())
+		Expect(di.Status.Progress.Percent).To(Equal(int32(100)))
+		Expect(di.Status.Progress.Step).To(Equal("Apply"))
+		Expect(di.Status.Progress.Message).To(Equal("applying"))
+		Expect(di.Status.Progress.LastUpdateTime.IsZero()).To(BeFalse())
+
+		Expect(SetProgress(di, -1, "Apply", "applying")).To(BeTrue())
+		Expect(di.Status.Progress.Percent).To(Equal(int32(0)))
+	})
+
+	It("should not modify an unchanged progress", func() {
+		di := &lsv1alpha1.DeployItem{}
+		Expect(SetProgress(di, 50, "Apply", "applying")).To(BeTrue())
+		progress := di.Status.Progress
+
+		Expect(SetProgress(di, 50, "Apply", "applying")).To(BeFalse())
+		Expect(di.Status.Progress).To(BeIdenticalTo(progress))
+	})
+})
@@ -0,0 +1,299 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package lib
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/resource"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/yaml"
+
+	lsconfigv1alpha1 "github.com/gardener/landscaper/apis/config/v1alpha1"
+	lsv1alpha1 "github.com/gardener/landscaper/apis/core/v1alpha1"
+	lserrors "github.com/gardener/landscaper/apis/errors"
+	"github.com/gardener/landscaper/controller-utils/pkg/logging"
+	lc "github.com/gardener/landscaper/controller-utils/pkg/logging/constants"
+	"github.com/gardener/landscaper/pkg/utils/read_write_layer"
+)
+
+// unprefixedQuotaResources are the resources whose requests can also be limited by quotas without the "requests." prefix.
+var unprefixedQuotaResources = sets.New[corev1.ResourceName](
+	corev1.ResourceCPU,
+	corev1.ResourceMemory,
+	corev1.ResourceEphemeralStorage,
+)
+
+// resourceDemand maps the resource names of quotas to the quantities that are demanded by pods.
+type resourceDemand map[corev1.ResourceName]resource.Quantity
+
+func (d resourceDemand) add(name corev1.ResourceName, quantity resource.Quantity) {
+	sum := d[name]
+	sum.Add(quantity)
+	d[name] = sum
+}
+
+func (d resourceDemand) addAll(other resourceDemand) {
+	for name, quantity := range other {
+		d.add(name, quantity)
+	}
+}
+
+// subtractAll subtracts the given demand. Quantities do not become negative.
+func (d resourceDemand) subtractAll(other resourceDemand) {
+	for name, quantity := range other {
+		diff, ok := d[name]
+		if !ok {
+			continue
+		}
+		diff.Sub(quantity)
+		if diff.Sign() < 0 {
+			diff = resource.Quantity{}
+		}
+		d[name] = diff
+	}
+}
+
+// ResourceQuotaCheckEnabled returns whether the resource quotas of the target namespaces should be checked
+// before the manifests are applied.
+func ResourceQuotaCheckEnabled(config *lsconfigv1alpha1.TargetClientConfig) bool {
+	return config != nil && config.CheckResourceQuotas
+}
+
+// CheckResourceQuotas checks whether the resource quotas of the target namespaces admit the pods of the given manifests.
+// The pods of workload resources that already exist in the target cluster are already counted by the quotas,
+// so that only the additional demand of the manifests is compared with the quotas.
+// Quotas with scopes are ignored, as well as namespaces in which the resource quotas must not be listed.
+// An error with the code ERR_QUOTA_EXCEEDED that lists the shortfall is returned if a quota is exceeded.
+func CheckResourceQuotas(ctx context.Context, kubeClient client.Client, manifests []*runtime.RawExtension, defaultNamespace string) error {
+	logger, ctx := logging.FromContextOrNew(ctx, nil, lc.KeyMethod, "CheckResourceQuotas")
+
+	demands := map[string]resourceDemand{}
+	for _, manifest := range manifests {
+		if manifest == nil || len(manifest.Raw) == 0 {
+			continue
+		}
+		obj := &unstructured.Unstructured{}
+		if err := yaml.Unmarshal(manifest.Raw, &obj.Object); err != nil {
+			return lserrors.NewWrappedError(err, "CheckResourceQuotas", "DecodeManifest", err.Error())
+		}
+		if len(obj.GetNamespace()) == 0 {
+			obj.SetNamespace(defaultNamespace)
+		}
+		if len(obj.GetNamespace()) == 0 {
+			continue
+		}
+
+		demand, err := workloadDemand(obj)
+		if err != nil {
+			return lserrors.NewWrappedError(err, "CheckResourceQuotas", "WorkloadDemand", err.Error())
+		}
+		if demand == nil {
+			continue
+		}
+
+		current := &unstructured.Unstructured{}
+		current.SetGroupVersionKind(obj.GroupVersionKind())
+		key := client.ObjectKeyFromObject(obj)
+		if err := read_write_layer.GetUnstructured(ctx, kubeClient, key, current, read_write_layer.R000132); err != nil {
+			if !apierrors.IsNotFound(err) {
+				err = fmt.Errorf("unable to get %s %s: %w", obj.GetKind(), key.String(), err)
+				return lserrors.NewWrappedError(err, "CheckResourceQuotas", "GetCurrentObject", err.Error())
+			}
+		} else {
+			currentDemand, err := workloadDemand(current)
+			if err != nil {
+				return lserrors.NewWrappedError(err, "CheckResourceQuotas", "WorkloadDemand", err.Error())
+			}
+			demand.subtractAll(currentDemand)
+		}
+
+		if _, ok := demands[obj.GetNamespace()]; !ok {
+			demands[obj.GetNamespace()] = resourceDemand{}
+		}
+		demands[obj.GetNamespace()].addAll(demand)
+	}
+
+	shortfalls := []string{}
+	for _, namespace := range sets.List(sets.KeySet(demands)) {
+		demand := demands[namespace]
+		quotas := &corev1.ResourceQuotaList{}
+		if err := read_write_layer.ListResourceQuotas(ctx, kubeClient, quotas, read_write_layer.R000133, client.InNamespace(namespace)); err != nil {
+			if apierrors.IsForbidden(err) {
+				logger.Info("Skipping resource quota check, resource quotas must not be listed", "namespace", namespace)
+				continue
+			}
+			err = fmt.Errorf("unable to list resource quotas in namespace %q: %w", namespace, err)
+			return lserrors.NewWrappedError(err, "CheckResourceQuotas", "ListResourceQuotas", err.Error())
+		}
+
+		sort.Slice(quotas.Items, func(i, j int) bool { return quotas.Items[i].Name < quotas.Items[j].Name })
+		for _, quota := range quotas.Items {
+			shortfalls = append(shortfalls, quotaShortfalls(&quota, demand)...)
+		}
+	}
+
+	if len(shortfalls) != 0 {
+		return lserrors.NewError("CheckResourceQuotas", lsv1alpha1.QuotaExceededReason, strings.Join(shortfalls, "; "),
+			lsv1alpha1.ErrorQuotaExceeded)
+	}
+	return nil
+}
+
+// quotaShortfalls returns a description of every resource of the quota that does not admit the given demand.
+func quotaShortfalls(quota *corev1.ResourceQuota, demand resourceDemand) []string {
+	if len(quota.Spec.Scopes) != 0 || quota.Spec.ScopeSelector != nil {
+		return nil
+	}
+	hard := quota.Status.Hard
+	if len(hard) == 0 {
+		hard = quota.Spec.Hard
+	}
+
+	shortfalls := []string{}
+	for _, name := range sets.List(sets.KeySet(hard)) {
+		requested, ok := demand[name]
+		if !ok || requested.Sign() <= 0 {
+			continue
+		}
+		used := quota.Status.Used[name]
+		available := hard[name].DeepCopy()
+		available.Sub(used)
+		if requested.Cmp(available) > 0 {
+			limit := hard[name]
+			shortfalls = append(shortfalls, fmt.Sprintf("quota %q in namespace %q is exceeded: %s requested %s, available %s (hard %s, used %s)",
+				quota.Name, quota.Namespace, name, requested.String(), available.String(), limit.String(), used.String()))
+		}
+	}
+	return shortfalls
+}
+
+// workloadDemand returns the resources that are demanded by the pods of a workload resource.
+// Nil is returned if the object is no workload resource or creates no pods by itself.
+func workloadDemand(obj *unstructured.Unstructured) (resourceDemand, error) {
+	gk := obj.GroupVersionKind().GroupKind()
+	path, ok := podSpecPaths[gk]
+	if !ok {
+		return nil, nil
+	}
+
+	var (
+		pods int64 = 1
+		err  error
+	)
+	switch gk.Kind {
+	case "PodTemplate", "CronJob":
+		// pod templates create no pods and the pods of cron jobs are created at a later point in time
+		return nil, nil
+	case "Deployment", "StatefulSet", "ReplicaSet", "ReplicationController":
+		pods, err = nestedInt64OrDefault(obj, 1, "spec", "replicas")
+	case "Job":
+		pods, err = nestedInt64OrDefault(obj, 1, "spec", "parallelism")
+	}
+	if err != nil {
+		return nil, fmt.Errorf("unable to get the number of pods of %s %s: %w", obj.GetKind(), obj.GetName(), err)
+	}
+
+	rawSpec, found, err := unstructured.NestedMap(obj.Object, path...)
+	if err != nil {
+		return nil, fmt.Errorf("unable to get the pod spec of %s %s: %w", obj.GetKind(), obj.GetName(), err)
+	}
+	podSpec := &corev1.PodSpec{}
+	if found {
+		if err := runtime.DefaultUnstructuredConverter.FromUnstructured(rawSpec, podSpec); err != nil {
+			return nil, fmt.Errorf("unable to decode the pod spec of %s %s: %w", obj.GetKind(), obj.GetName(), err)
+		}
+	}
+	return podDemand(podSpec, pods), nil
+}
+
+// podDemand returns the resources that are demanded by the given number of pods with the given spec.
+// Like the kubernetes scheduler, the demand of a pod is the maximum of the sum of its containers
+// and each of its init containers.
+func podDemand(podSpec *corev1.PodSpec, pods int64) resourceDemand {
+	requests, limits := corev1.ResourceList{}, corev1.ResourceList{}
+	for _, container := range podSpec.Containers {
+		containerRequests, containerLimits := containerResources(&container)
+		addResourceList(requests, containerRequests)
+		addResourceList(limits, containerLimits)
+	}
+	for _, container := range podSpec.InitContainers {
+		containerRequests, containerLimits := containerResources(&container)
+		maxResourceList(requests, containerRequests)
+		maxResourceList(limits, containerLimits)
+	}
+
+	demand := resourceDemand{}
+	demand[corev1.ResourcePods] = *resource.NewQuantity(pods, resource.DecimalSI)
+	for name, quantity := range requests {
+		quantity = multiply(quantity, pods)
+		demand.add(corev1.ResourceName("requests."+name), quantity)
+		if unprefixedQuotaResources.Has(name) {
+			demand.add(name, quantity)
+		}
+	}
+	for name, quantity := range limits {
+		demand.add(corev1.ResourceName("limits."+name), multiply(quantity, pods))
+	}
+	return demand
+}
+
+// containerResources returns the requests and limits of a container.
+// Like the defaulting of the api server, the limits are used as requests if no requests are set.
+func containerResources(container *corev1.Container) (corev1.ResourceList, corev1.ResourceList) {
+	requests := container.Resources.Requests.DeepCopy()
+	if requests == nil {
+		requests = corev1.ResourceList{}
+	}
+	for name, quantity := range container.Resources.Limits {
+		if _, ok := requests[name]; !ok {
+			requests[name] = quantity.DeepCopy()
+		}
+	}
+	return requests, container.Resources.Limits
+}
+
+func addResourceList(list, other corev1.ResourceList) {
+	for name, quantity := range other {
+		sum := list[name]
+		sum.Add(quantity)
+		list[name] = sum
+	}
+}
+
+func maxResourceList(list, other corev1.ResourceList) {
+	for name, quantity := range other {
+		if current, ok := list[name]; !ok || quantity.Cmp(current) > 0 {
+			list[name] = quantity.DeepCopy()
+		}
+	}
+}
+
+func multiply(quantity resource.Quantity, factor int64) resource.Quantity {
+	return *resource.NewMilliQuantity(quantity.MilliValue()*factor, quantity.Format)
+}
+
+func nestedInt64OrDefault(obj *unstructured.Unstructured, defaultValue int64, fields ...string) (int64, error) {
+	value, found, err := unstructured.NestedFieldNoCopy(obj.Object, fields...)
+	if err != nil || !found || value == nil {
+		return defaultValue, err
+	}
+	switch v := value.(type) {
+	case int64:
+		return v, nil
+	case float64:
+		return int64(v), nil
+	default:
+		return 0, fmt.Errorf("%s is no number", strings.Join(fields, "."))
+	}
+}
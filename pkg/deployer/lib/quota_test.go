@@ -0,0 +1,147 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package lib
+
+import (
+	"context"
+	"encoding/json"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	lsv1alpha1 "github.com/gardener/landscaper/apis/core/v1alpha1"
+	lserrors "github.com/gardener/landscaper/apis/errors"
+	"github.com/gardener/landscaper/pkg/api"
+)
+
+var _ = Describe("Resource quotas", func() {
+
+	var ctx context.Context
+
+	BeforeEach(func() {
+		ctx = context.Background()
+	})
+
+	raw := func(a any) *runtime.RawExtension {
+		bytes, err := json.Marshal(a)
+		Expect(err).NotTo(HaveOccurred())
+		return &runtime.RawExtension{Raw: bytes}
+	}
+
+	deployment := func(replicas int32, cpu string) *appsv1.Deployment {
+		return &appsv1.Deployment{
+			TypeMeta:   metav1.TypeMeta{APIVersion: "apps/v1", Kind: "Deployment"},
+			ObjectMeta: metav1.ObjectMeta{Name: "app", Namespace: "test"},
+			Spec: appsv1.DeploymentSpec{
+				Replicas: ptr.To(replicas),
+				Template: corev1.PodTemplateSpec{
+					Spec: corev1.PodSpec{
+						Containers: []corev1.Container{{
+							Name: "app",
+							Resources: corev1.ResourceRequirements{
+								Requests: corev1.ResourceList{corev1.ResourceCPU: resource.MustParse(cpu)},
+								Limits:   corev1.ResourceList{corev1.ResourceMemory: resource.MustParse("1Gi")},
+							},
+						}},
+						InitContainers: []corev1.Container{{
+							Name: "init",
+							Resources: corev1.ResourceRequirements{
+								Requests: corev1.ResourceList{corev1.ResourceCPU: resource.MustParse("1500m")},
+							},
+						}},
+					},
+				},
+			},
+		}
+	}
+
+	quota := func(name string, hard, used corev1.ResourceList) *corev1.ResourceQuota {
+		return &corev1.ResourceQuota{
+			ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "test"},
+			Spec:       corev1.ResourceQuotaSpec{Hard: hard},
+			Status:     corev1.ResourceQuotaStatus{Hard: hard, Used: used},
+		}
+	}
+
+	check := func(manifests []*runtime.RawExtension, objects ...client.Object) error {
+		kubeClient := fake.NewClientBuilder().WithScheme(api.LandscaperScheme).WithObjects(objects...).Build()
+		return CheckResourceQuotas(ctx, kubeClient, manifests, "test")
+	}
+
+	It("should succeed if the quotas admit the pods", func() {
+		err := check([]*runtime.RawExtension{raw(deployment(3, "500m"))},
+			quota("compute", corev1.ResourceList{
+				corev1.ResourcePods:         resource.MustParse("10"),
+				corev1.ResourceRequestsCPU:  resource.MustParse("8"),
+				corev1.ResourceLimitsMemory: resource.MustParse("4Gi"),
+				corev1.ResourceConfigMaps:   resource.MustParse("1"),
+			}, corev1.ResourceList{
+				corev1.ResourceRequestsCPU: resource.MustParse("2"),
+			}))
+		Expect(err).ToNot(HaveOccurred())
+	})
+
+	It("should report the shortfall if a quota is exceeded", func() {
+		err := check([]*runtime.RawExtension{raw(deployment(4, "1"))},
+			quota("compute", corev1.ResourceList{
+				corev1.ResourceCPU:          resource.MustParse("8"),
+				corev1.ResourceLimitsMemory: resource.MustParse("8Gi"),
+			}, corev1.ResourceList{
+				corev1.ResourceCPU: resource.MustParse("6500m"),
+			}))
+		Expect(err).To(HaveOccurred())
+
+		lsErr, ok := lserrors.IsError(err)
+		Expect(ok).To(BeTrue())
+		Expect(lsErr.LandscaperError().Codes).To(ContainElement(lsv1alpha1.ErrorQuotaExceeded))
+		// a quota shortfall is transient, so that the deploy item is retried
+		Expect(lserrors.ContainsAnyErrorCode(lsErr.LandscaperError().Codes, lsv1alpha1.UnrecoverableErrorCodes)).To(BeFalse())
+		Expect(err.Error()).To(ContainSubstring(`quota "compute" in namespace "test" is exceeded: cpu requested 6, available 1500m (hard 8, used 6500m)`))
+		Expect(err.Error()).ToNot(ContainSubstring("limits.memory"))
+	})
+
+	It("should use the demand of the init containers if it is higher", func() {
+		err := check([]*runtime.RawExtension{raw(deployment(1, "500m"))},
+			quota("compute", corev1.ResourceList{
+				corev1.ResourceRequestsCPU: resource.MustParse("1"),
+			}, nil))
+		Expect(err).To(HaveOccurred())
+		Expect(err.Error()).To(ContainSubstring("requests.cpu requested 1500m, available 1"))
+	})
+
+	It("should only consider the additional pods of existing objects", func() {
+		existing := deployment(2, "1")
+		err := check([]*runtime.RawExtension{raw(deployment(3, "1"))}, existing,
+			quota("compute", corev1.ResourceList{
+				corev1.ResourcePods:        resource.MustParse("3"),
+				corev1.ResourceRequestsCPU: resource.MustParse("6"),
+			}, corev1.ResourceList{
+				corev1.ResourcePods:        resource.MustParse("2"),
+				corev1.ResourceRequestsCPU: resource.MustParse("4"),
+			}))
+		Expect(err).ToNot(HaveOccurred())
+	})
+
+	It("should ignore scoped quotas and resources that create no pods", func() {
+		scoped := quota("best-effort", corev1.ResourceList{corev1.ResourcePods: resource.MustParse("0")}, nil)
+		scoped.Spec.Scopes = []corev1.ResourceQuotaScope{corev1.ResourceQuotaScopeBestEffort}
+		configMap := &corev1.ConfigMap{
+			TypeMeta:   metav1.TypeMeta{APIVersion: "v1", Kind: "ConfigMap"},
+			ObjectMeta: metav1.ObjectMeta{Name: "config"},
+		}
+
+		err := check([]*runtime.RawExtension{raw(deployment(1, "1")), raw(configMap)}, scoped,
+			quota("objects", corev1.ResourceList{corev1.ResourceConfigMaps: resource.MustParse("0")}, nil))
+		Expect(err).ToNot(HaveOccurred())
+	})
+})
@@ -0,0 +1,37 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package readinesscheck
+
+import (
+	lsv1alpha1 "github.com/gardener/landscaper/apis/core/v1alpha1"
+	lsv1alpha1helper "github.com/gardener/landscaper/apis/core/v1alpha1/helper"
+	health "github.com/gardener/landscaper/apis/deployer/utils/readinesschecks"
+)
+
+// ConditionReasonReadinessChecksDisabled is the reason of the readiness condition of a deploy item
+// whose provider configuration disables all readiness checks.
+const ConditionReasonReadinessChecksDisabled = "ReadinessChecksDisabled"
+
+// SetReadinessChecksRunning resets the readiness condition of a deploy item before its readiness checks are started.
+func SetReadinessChecksRunning(di *lsv1alpha1.DeployItem) {
+	di.Status.Conditions = lsv1alpha1helper.CreateOrUpdateConditions(di.Status.Conditions,
+		lsv1alpha1.DeployItemReadinessCondition, lsv1alpha1.ConditionUnknown,
+		lsv1alpha1helper.ConditionReasonProgressing, "The readiness checks are running.")
+}
+
+// SetReadinessChecksSucceeded sets the readiness condition of a deploy item after its readiness checks have succeeded.
+// The condition is false if the given configuration disables all readiness checks,
+// as the readiness of the resources is unknown in this case.
+func SetReadinessChecksSucceeded(di *lsv1alpha1.DeployItem, config health.ReadinessCheckConfiguration) {
+	if config.DisableDefault && len(config.CustomReadinessChecks) == 0 {
+		di.Status.Conditions = lsv1alpha1helper.CreateOrUpdateConditions(di.Status.Conditions,
+			lsv1alpha1.DeployItemReadinessCondition, lsv1alpha1.ConditionFalse,
+			ConditionReasonReadinessChecksDisabled, "The readiness checks are disabled.")
+		return
+	}
+	di.Status.Conditions = lsv1alpha1helper.CreateOrUpdateConditions(di.Status.Conditions,
+		lsv1alpha1.DeployItemReadinessCondition, lsv1alpha1.ConditionTrue,
+		lsv1alpha1helper.ConditionReasonSucceeded, "The readiness checks have succeeded.")
+}
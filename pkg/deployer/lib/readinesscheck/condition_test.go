@@ -0,0 +1,43 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package readinesscheck
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	lsv1alpha1 "github.com/gardener/landscaper/apis/core/v1alpha1"
+	lsv1alpha1helper "github.com/gardener/landscaper/apis/core/v1alpha1/helper"
+	health "github.com/gardener/landscaper/apis/deployer/utils/readinesschecks"
+)
+
+var _ = Describe("Readiness condition", func() {
+
+	It("should mark a deploy item as checked after its readiness checks have succeeded", func() {
+		di := &lsv1alpha1.DeployItem{}
+		SetReadinessChecksRunning(di)
+		Expect(lsv1alpha1helper.IsReadinessChecked(di)).To(BeFalse())
+
+		SetReadinessChecksSucceeded(di, health.ReadinessCheckConfiguration{})
+		Expect(lsv1alpha1helper.IsReadinessChecked(di)).To(BeTrue())
+
+		SetReadinessChecksRunning(di)
+		Expect(lsv1alpha1helper.IsReadinessChecked(di)).To(BeFalse())
+	})
+
+	It("should not mark a deploy item as checked if its readiness checks are disabled", func() {
+		di := &lsv1alpha1.DeployItem{}
+		SetReadinessChecksSucceeded(di, health.ReadinessCheckConfiguration{DisableDefault: true})
+		Expect(lsv1alpha1helper.IsReadinessChecked(di)).To(BeFalse())
+		cond := lsv1alpha1helper.GetCondition(di.Status.Conditions, lsv1alpha1.DeployItemReadinessCondition)
+		Expect(cond.Reason).To(Equal(ConditionReasonReadinessChecksDisabled))
+
+		SetReadinessChecksSucceeded(di, health.ReadinessCheckConfiguration{
+			DisableDefault:        true,
+			CustomReadinessChecks: []health.CustomReadinessCheckConfiguration{{Name: "custom"}},
+		})
+		Expect(lsv1alpha1helper.IsReadinessChecked(di)).To(BeTrue())
+	})
+})
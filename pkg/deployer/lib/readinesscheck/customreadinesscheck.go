@@ -68,6 +68,9 @@ func (c *CustomReadinessCheck) CheckResourcesReady() error {
 	}
 
 	timeout := c.Timeout.Duration
+	if c.Configuration.Timeout != nil && c.Configuration.Timeout.Duration < timeout {
+		timeout = c.Configuration.Timeout.Duration
+	}
 	if err := WaitForObjectsReady(c.Context, timeout, c.Client, getObjectsFunc, c.CheckObject, c.InterruptionChecker, c.CurrentOp); err != nil {
 		return err
 	}
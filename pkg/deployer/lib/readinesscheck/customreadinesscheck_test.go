@@ -22,6 +22,7 @@ import (
 
 	lsv1alpha1 "github.com/gardener/landscaper/apis/core/v1alpha1"
 	health "github.com/gardener/landscaper/apis/deployer/utils/readinesschecks"
+	lserror "github.com/gardener/landscaper/apis/errors"
 	kutil "github.com/gardener/landscaper/controller-utils/pkg/kubernetes"
 	"github.com/gardener/landscaper/controller-utils/pkg/logging"
 	"github.com/gardener/landscaper/pkg/deployer/lib/interruption"
@@ -383,6 +384,35 @@ var _ = Describe("Custom health checks", func() {
 		}).WithTimeout(1 * time.Minute).Should(BeTrue())
 
 	})
+
+	It("should stop waiting for the requirements after the timeout of the readiness check", func() {
+		customHealthCheck.Configuration = health.CustomReadinessCheckConfiguration{
+			Name: "check-missing",
+			Resource: []lsv1alpha1.TypedObjectReference{
+				{
+					APIVersion: "v1",
+					Kind:       "ConfigMap",
+					ObjectReference: lsv1alpha1.ObjectReference{
+						Name:      "missing",
+						Namespace: state.Namespace,
+					},
+				},
+			},
+			Requirements: []health.RequirementSpec{
+				{
+					JsonPath: "data.ready",
+					Operator: selection.Exists,
+				},
+			},
+			Timeout: &lsv1alpha1.Duration{Duration: 1 * time.Second},
+		}
+
+		start := time.Now()
+		err := customHealthCheck.CheckResourcesReady()
+		Expect(err).To(HaveOccurred())
+		Expect(lserror.ContainsErrorCode(err, lsv1alpha1.ErrorTimeout)).To(BeTrue())
+		Expect(time.Since(start)).To(BeNumerically("<", 30*time.Second))
+	})
 })
 
 func loadSingleObjectFromFile(fileName string) ([]*unstructured.Unstructured, []lsv1alpha1.TypedObjectReference) {
@@ -0,0 +1,135 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package resourcemanager
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/jsonmergepatch"
+	"k8s.io/apimachinery/pkg/util/mergepatch"
+	"k8s.io/apimachinery/pkg/util/strategicpatch"
+	"k8s.io/apimachinery/pkg/util/version"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/kubernetes/scheme"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	manifestv1alpha2 "github.com/gardener/landscaper/apis/deployer/manifest/v1alpha2"
+	"github.com/gardener/landscaper/controller-utils/pkg/logging"
+)
+
+// minServerSideApplyVersion is the kubernetes version in which server-side apply became generally available.
+var minServerSideApplyVersion = version.MustParseGeneric("1.22.0")
+
+// resolveUpdateStrategy resolves the update strategy "auto" to server-side apply, if the target cluster supports
+// server-side apply in a mature version, and to a three-way merge otherwise.
+// All other update strategies are returned unchanged.
+func resolveUpdateStrategy(ctx context.Context, clientset kubernetes.Interface, strategy manifestv1alpha2.UpdateStrategy) manifestv1alpha2.UpdateStrategy {
+	if strategy != manifestv1alpha2.UpdateStrategyAuto {
+		return strategy
+	}
+
+	logger, _ := logging.FromContextOrNew(ctx, nil)
+	if clientset == nil {
+		return manifestv1alpha2.UpdateStrategyThreeWayMerge
+	}
+	serverVersion, err := clientset.Discovery().ServerVersion()
+	if err != nil {
+		logger.Info("unable to get the version of the target cluster, falling back to three-way merge", "error", err.Error())
+		return manifestv1alpha2.UpdateStrategyThreeWayMerge
+	}
+	v, err := version.ParseGeneric(serverVersion.GitVersion)
+	if err != nil {
+		logger.Info("unable to parse the version of the target cluster, falling back to three-way merge",
+			"version", serverVersion.GitVersion, "error", err.Error())
+		return manifestv1alpha2.UpdateStrategyThreeWayMerge
+	}
+	if v.LessThan(minServerSideApplyVersion) {
+		logger.Debug("target cluster does not support server-side apply in a mature version, using three-way merge",
+			"version", serverVersion.GitVersion)
+		return manifestv1alpha2.UpdateStrategyThreeWayMerge
+	}
+	return manifestv1alpha2.UpdateStrategyServerSideApply
+}
+
+// setLastAppliedConfiguration stores the object without the last applied configuration in the annotation
+// of the last applied configuration, and returns the resulting object as json.
+func setLastAppliedConfiguration(obj *unstructured.Unstructured) ([]byte, error) {
+	annotations := obj.GetAnnotations()
+	if annotations == nil {
+		annotations = map[string]string{}
+	}
+	delete(annotations, corev1.LastAppliedConfigAnnotation)
+	if len(annotations) == 0 {
+		obj.SetAnnotations(nil)
+	} else {
+		obj.SetAnnotations(annotations)
+	}
+
+	original, err := json.Marshal(obj)
+	if err != nil {
+		return nil, fmt.Errorf("unable to serialize object: %w", err)
+	}
+
+	annotations[corev1.LastAppliedConfigAnnotation] = string(original)
+	obj.SetAnnotations(annotations)
+	modified, err := json.Marshal(obj)
+	if err != nil {
+		return nil, fmt.Errorf("unable to serialize object: %w", err)
+	}
+	return modified, nil
+}
+
+// applyThreeWayMerge patches the current object in the cluster like a client-side "kubectl apply".
+// The patch is computed from the last applied configuration of the current object, the new object and the current
+// object, so that fields which have been removed from the new object are removed, and fields which have been set by
+// other controllers are kept.
+// Kinds which are known to the client scheme are patched with a strategic merge patch, all others with a json merge patch.
+func (a *ManifestApplier) applyThreeWayMerge(ctx context.Context, currObj, obj *unstructured.Unstructured) error {
+	modified, err := setLastAppliedConfiguration(obj)
+	if err != nil {
+		return err
+	}
+	current, err := json.Marshal(currObj)
+	if err != nil {
+		return fmt.Errorf("unable to serialize current object: %w", err)
+	}
+	original := []byte(currObj.GetAnnotations()[corev1.LastAppliedConfigAnnotation])
+
+	patchType, patch, err := createThreeWayMergePatch(obj, original, modified, current)
+	if err != nil {
+		return fmt.Errorf("unable to create three-way merge patch: %w", err)
+	}
+	if string(patch) == "{}" {
+		return nil
+	}
+	return a.kubeClient.Patch(ctx, currObj, client.RawPatch(patchType, patch))
+}
+
+func createThreeWayMergePatch(obj *unstructured.Unstructured, original, modified, current []byte) (types.PatchType, []byte, error) {
+	preconditions := []mergepatch.PreconditionFunc{
+		mergepatch.RequireKeyUnchanged("apiVersion"),
+		mergepatch.RequireKeyUnchanged("kind"),
+		mergepatch.RequireMetadataKeyUnchanged("name"),
+	}
+
+	dataStruct, err := scheme.Scheme.New(obj.GroupVersionKind())
+	if err != nil {
+		// the patch strategies of unknown kinds are not known
+		patch, err := jsonmergepatch.CreateThreeWayJSONMergePatch(original, modified, current, preconditions...)
+		return types.MergePatchType, patch, err
+	}
+
+	lookupPatchMeta, err := strategicpatch.NewPatchMetaFromStruct(dataStruct)
+	if err != nil {
+		return "", nil, err
+	}
+	patch, err := strategicpatch.CreateThreeWayMergePatch(original, modified, current, lookupPatchMeta, true, preconditions...)
+	return types.StrategicMergePatchType, patch, err
+}
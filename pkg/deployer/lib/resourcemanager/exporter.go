@@ -6,6 +6,7 @@ package resourcemanager
 
 import (
 	"context"
+	"encoding/base64"
 	"fmt"
 	"time"
 
@@ -124,6 +125,11 @@ func (e *Exporter) doExport(ctx context.Context, export managedresource.Export)
 		}
 	}
 
+	val, err := DecodeExportValue(export, val)
+	if err != nil {
+		return nil, err
+	}
+
 	newValue, err := jsonpath.Construct(export.Key, val)
 	if err != nil {
 		return nil, err
@@ -176,3 +182,24 @@ func (e *Exporter) exportFromReferencedResource(ctx context.Context, export mana
 	}
 	return val, nil
 }
+
+// DecodeExportValue decodes the value of an export according to its decoding.
+// The value is returned unchanged if the export defines no decoding.
+func DecodeExportValue(export managedresource.Export, val interface{}) (interface{}, error) {
+	switch export.Decode {
+	case "":
+		return val, nil
+	case managedresource.ExportDecodingBase64:
+		encoded, ok := val.(string)
+		if !ok {
+			return nil, fmt.Errorf("expected value of export %q to be a base64 encoded string but got %T", export.Key, val)
+		}
+		decoded, err := base64.StdEncoding.DecodeString(encoded)
+		if err != nil {
+			return nil, fmt.Errorf("unable to base64 decode the value of export %q: %w", export.Key, err)
+		}
+		return string(decoded), nil
+	default:
+		return nil, fmt.Errorf("unsupported decoding %q of export %q", export.Decode, export.Key)
+	}
+}
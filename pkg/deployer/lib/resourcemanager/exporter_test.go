@@ -199,6 +199,43 @@ var _ = Describe("Exporter", func() {
 		Expect(err).ToNot(HaveOccurred())
 	})
 
+	It("should export a decoded value from a secret", func() {
+		ctx := context.Background()
+
+		secret := &corev1.Secret{}
+		secret.Name = "generated"
+		secret.Namespace = state.Namespace
+		secret.Data = map[string][]byte{
+			"password": []byte("abc"),
+		}
+		Expect(state.Create(ctx, secret)).To(Succeed())
+
+		exports := &managedresource.Exports{
+			Exports: []managedresource.Export{
+				{
+					Key:      "password",
+					JSONPath: ".data.password",
+					FromResource: &lsv1alpha1.TypedObjectReference{
+						APIVersion: "v1",
+						Kind:       "Secret",
+						ObjectReference: lsv1alpha1.ObjectReference{
+							Name:      secret.Name,
+							Namespace: secret.Namespace,
+						},
+					},
+					Decode: managedresource.ExportDecodingBase64,
+				},
+			},
+		}
+		res, err := resourcemanager.NewExporter(resourcemanager.ExporterOptions{
+			KubeClient: testenv.Client,
+		}).Export(ctx, exports)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(res).To(Equal(map[string]interface{}{
+			"password": "abc",
+		}))
+	})
+
 	Context("referenced resource", func() {
 		It("should export from a referenced configmap", func() {
 			ctx := context.Background()
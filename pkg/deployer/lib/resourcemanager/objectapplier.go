@@ -9,6 +9,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"sort"
+	"strings"
 	"sync"
 
 	"dario.cat/mergo"
@@ -61,31 +62,55 @@ type ManifestApplierOptions struct {
 	Clientset        kubernetes.Interface
 	DefaultNamespace string
 
-	DeployItemName   string
-	DeployItem       *lsv1alpha1.DeployItem
-	UpdateStrategy   manifestv1alpha2.UpdateStrategy
+	DeployItemName string
+	DeployItem     *lsv1alpha1.DeployItem
+	UpdateStrategy manifestv1alpha2.UpdateStrategy
+	// ServerSideApply configures the server-side apply of the manifests.
+	// It is only used with the update strategies "serverSideApply" and "auto".
+	ServerSideApply  *manifestv1alpha2.ServerSideApplyConfiguration
 	Manifests        []managedresource.Manifest
 	ManagedResources managedresource.ManagedResourceStatusList
 	// Labels defines additional labels that are automatically injected into all resources.
-	Labels                     map[string]string
+	Labels map[string]string
+	// Annotations defines additional annotations that are automatically injected into all resources.
+	Annotations                map[string]string
 	DeletionGroupsDuringUpdate []managedresource.DeletionGroupDefinition
 	InterruptionChecker        interruption.InterruptionChecker
+	// BatchSize defines the maximum number of manifests that are applied concurrently.
+	// All manifests of an execution group are applied concurrently if not set.
+	BatchSize int
+	// ProgressCheckpoint is called after each applied batch of manifests.
+	// It is only called if a batch size is set.
+	ProgressCheckpoint func(ctx context.Context, progress managedresource.ApplyProgress)
+	// CheckResourceQuotas defines whether the resource quotas of the target namespaces are checked
+	// before any manifest is applied.
+	CheckResourceQuotas bool
+	// Pruning defines how the managed resources are handled that are no longer defined in the manifests.
+	// They are deleted if not set.
+	Pruning *managedresource.PruningConfiguration
 }
 
 // ManifestApplier creates or updated manifest based on their definition.
 type ManifestApplier struct {
 	decoder          runtime.Decoder
 	kubeClient       client.Client
+	clientset        kubernetes.Interface
 	defaultNamespace string
 
 	deployItemName             string
 	deployItem                 *lsv1alpha1.DeployItem
 	updateStrategy             manifestv1alpha2.UpdateStrategy
+	serverSideApply            *manifestv1alpha2.ServerSideApplyConfiguration
 	manifests                  []managedresource.Manifest
 	managedResources           managedresource.ManagedResourceStatusList
 	labels                     map[string]string
+	annotations                map[string]string
 	deletionGroupsDuringUpdate []managedresource.DeletionGroupDefinition
 	interruptionChecker        interruption.InterruptionChecker
+	batchSize                  int
+	progressCheckpoint         func(ctx context.Context, progress managedresource.ApplyProgress)
+	checkResourceQuotas        bool
+	pruning                    *managedresource.PruningConfiguration
 
 	// properties created during runtime
 
@@ -122,15 +147,22 @@ func NewManifestApplier(opts ManifestApplierOptions) *ManifestApplier {
 	return &ManifestApplier{
 		decoder:                    opts.Decoder,
 		kubeClient:                 opts.KubeClient,
+		clientset:                  opts.Clientset,
 		defaultNamespace:           opts.DefaultNamespace,
 		deployItem:                 opts.DeployItem,
 		deployItemName:             opts.DeployItemName,
 		updateStrategy:             opts.UpdateStrategy,
+		serverSideApply:            opts.ServerSideApply,
 		manifests:                  opts.Manifests,
 		managedResources:           opts.ManagedResources,
 		labels:                     opts.Labels,
+		annotations:                opts.Annotations,
 		deletionGroupsDuringUpdate: opts.DeletionGroupsDuringUpdate,
 		interruptionChecker:        opts.InterruptionChecker,
+		batchSize:                  opts.BatchSize,
+		progressCheckpoint:         opts.ProgressCheckpoint,
+		checkResourceQuotas:        opts.CheckResourceQuotas,
+		pruning:                    opts.Pruning,
 		apiResourceHandler:         CreateApiResourceHandler(opts.Clientset),
 	}
 }
@@ -146,6 +178,14 @@ func (a *ManifestApplier) Apply(ctx context.Context) error {
 		return err
 	}
 
+	a.updateStrategy = resolveUpdateStrategy(ctx, a.clientset, a.updateStrategy)
+
+	if a.checkResourceQuotas {
+		if err := a.checkQuotas(ctx); err != nil {
+			return err
+		}
+	}
+
 	var (
 		allErrs []error
 		errMux  sync.Mutex
@@ -155,39 +195,49 @@ func (a *ManifestApplier) Apply(ctx context.Context) error {
 	oldManagedResources := a.managedResources
 	a.managedResources = make(managedresource.ManagedResourceStatusList, 0)
 
-	var timeoutErr lserrors.LsError
+	var (
+		timeoutErr lserrors.LsError
+		progress   = managedresource.ApplyProgress{TotalManifests: int32(a.numberOfManifests())}
+	)
 	for _, list := range a.manifestExecutions {
 		var (
-			wg               = sync.WaitGroup{}
 			managedResources = make([]managedresource.ManagedResourceStatus, 0)
 			mux              sync.Mutex
 		)
-		for _, m := range list {
+		for _, batch := range a.batches(list) {
+			wg := sync.WaitGroup{}
+			for _, m := range batch {
+
+				if _, timeoutErr = timeout.TimeoutExceeded(ctx, a.deployItem, TimeoutCheckpointDeployerApplyManifests); timeoutErr != nil {
+					break
+				}
 
-			if _, timeoutErr = timeout.TimeoutExceeded(ctx, a.deployItem, TimeoutCheckpointDeployerApplyManifests); timeoutErr != nil {
-				break
+				wg.Add(1)
+				go func(m *Manifest) {
+					defer wg.Done()
+					mr, err := a.applyObject(ctx, m)
+					if err != nil {
+						errMux.Lock()
+						defer errMux.Unlock()
+						allErrs = append(allErrs, err)
+					}
+					if mr != nil {
+						mux.Lock()
+						managedResources = append(managedResources, *mr)
+						mux.Unlock()
+					}
+				}(m)
 			}
+			wg.Wait()
 
-			wg.Add(1)
-			go func(m *Manifest) {
-				defer wg.Done()
-				mr, err := a.applyObject(ctx, m)
-				if err != nil {
-					errMux.Lock()
-					defer errMux.Unlock()
-					allErrs = append(allErrs, err)
-				}
-				if mr != nil {
-					mux.Lock()
-					managedResources = append(managedResources, *mr)
-					mux.Unlock()
-				}
-			}(m)
-		}
-		wg.Wait()
+			if timeoutErr != nil {
+				return timeoutErr
+			}
 
-		if timeoutErr != nil {
-			return timeoutErr
+			progress.AppliedManifests += int32(len(batch))
+			if a.batchSize > 0 && a.progressCheckpoint != nil {
+				a.progressCheckpoint(ctx, progress)
+			}
 		}
 
 		sort.Sort(managesResourceList(managedResources))
@@ -201,7 +251,17 @@ func (a *ManifestApplier) Apply(ctx context.Context) error {
 	}
 
 	// remove old objects
-	if err := a.cleanupOrphanedResourcesInGroups(ctx, oldManagedResources); err != nil {
+	orphanedResources, err := a.orphanedResources(ctx, oldManagedResources)
+	if err != nil {
+		err = fmt.Errorf("unable to cleanup orphaned resources: %w", err)
+		return lserrors.NewWrappedError(err,
+			"ApplyObjects", "cleanupOrphanedResourcesInGroups", err.Error())
+	}
+	orphanedResources, err = a.applyPruningPolicies(ctx, orphanedResources)
+	if err != nil {
+		return err
+	}
+	if err := a.cleanupOrphanedResourcesInGroups(ctx, orphanedResources); err != nil {
 		err = fmt.Errorf("unable to cleanup orphaned resources: %w", err)
 		return lserrors.NewWrappedError(err,
 			"ApplyObjects", "cleanupOrphanedResourcesInGroups", err.Error())
@@ -209,6 +269,44 @@ func (a *ManifestApplier) Apply(ctx context.Context) error {
 	return nil
 }
 
+// checkQuotas checks whether the resource quotas of the target namespaces admit the namespaced manifests
+// that are applied.
+func (a *ManifestApplier) checkQuotas(ctx context.Context) error {
+	manifests := []*runtime.RawExtension{}
+	for _, m := range a.manifestExecutions[ExecutionGroupNamespaced] {
+		if m.Policy != managedresource.IgnorePolicy {
+			manifests = append(manifests, m.Manifest)
+		}
+	}
+	return lib.CheckResourceQuotas(ctx, a.kubeClient, manifests, a.defaultNamespace)
+}
+
+// batches splits the given manifests into batches of the configured batch size.
+// All manifests are returned as a single batch if no batch size is configured.
+func (a *ManifestApplier) batches(manifests []*Manifest) [][]*Manifest {
+	if len(manifests) == 0 {
+		return nil
+	}
+	if a.batchSize <= 0 || len(manifests) <= a.batchSize {
+		return [][]*Manifest{manifests}
+	}
+	batches := make([][]*Manifest, 0, (len(manifests)+a.batchSize-1)/a.batchSize)
+	for len(manifests) > a.batchSize {
+		batches = append(batches, manifests[:a.batchSize])
+		manifests = manifests[a.batchSize:]
+	}
+	return append(batches, manifests)
+}
+
+// numberOfManifests returns the number of all manifests of all execution groups.
+func (a *ManifestApplier) numberOfManifests() int {
+	n := 0
+	for _, list := range a.manifestExecutions {
+		n += len(list)
+	}
+	return n
+}
+
 // applyObject applies a managed resource to the target cluster.
 func (a *ManifestApplier) applyObject(ctx context.Context, manifest *Manifest) (*managedresource.ManagedResourceStatus, error) {
 	logger, ctx := logging.FromContextOrNew(ctx, nil, lc.KeyMethod, "applyObject")
@@ -245,10 +343,33 @@ func (a *ManifestApplier) applyObject(ctx context.Context, manifest *Manifest) (
 		if !apierrors.IsNotFound(err) {
 			return nil, fmt.Errorf("unable to get object: %w", err)
 		}
-		// inject labels
+		// inject labels and annotations
 		a.injectLabels(obj)
+		a.injectAnnotations(obj)
 		kutil.SetMetaDataLabel(obj, manifestv1alpha2.ManagedDeployItemLabel, a.deployItemName)
 
+		if a.updateStrategy == manifestv1alpha2.UpdateStrategyServerSideApply {
+			if err := a.applyServerSide(ctx, obj); err != nil {
+				return nil, fmt.Errorf("unable to create resource %s: %w", key.String(), err)
+			}
+			if err := a.annotateAfterServerSideCreate(ctx, obj, manifest.AnnotateBeforeCreate); err != nil {
+				return nil, fmt.Errorf("unable to set annotations before create for resource %s: %w", key.String(), err)
+			}
+			return &managedresource.ManagedResourceStatus{
+				AnnotateBeforeDelete: manifest.AnnotateBeforeDelete,
+				Policy:               manifest.Policy,
+				Resource:             *kutil.CoreObjectReferenceFromUnstructuredObject(obj),
+			}, nil
+		}
+
+		if a.updateStrategy == manifestv1alpha2.UpdateStrategyThreeWayMerge {
+			// the annotations that are only set on creation are not part of the last applied configuration,
+			// so that they are not removed by the next apply
+			if _, err := setLastAppliedConfiguration(obj); err != nil {
+				return nil, fmt.Errorf("unable to set last applied configuration for resource %s: %w", key.String(), err)
+			}
+		}
+
 		if manifest.AnnotateBeforeCreate != nil {
 			objAnnotations := obj.GetAnnotations()
 			if objAnnotations == nil {
@@ -294,8 +415,9 @@ func (a *ManifestApplier) applyObject(ctx context.Context, manifest *Manifest) (
 	case manifestv1alpha2.UpdateStrategyUpdate:
 		fallthrough
 	case manifestv1alpha2.UpdateStrategyPatch:
-		// inject manifest specific labels
+		// inject manifest specific labels and annotations
 		a.injectLabels(obj)
+		a.injectAnnotations(obj)
 		kutil.SetMetaDataLabel(obj, manifestv1alpha2.ManagedDeployItemLabel, a.deployItemName)
 
 		// Set the required and immutable fields from the current object.
@@ -329,19 +451,88 @@ func (a *ManifestApplier) applyObject(ctx context.Context, manifest *Manifest) (
 			return mr, fmt.Errorf("unable to merge changes for resource %s: %w", key.String(), err)
 		}
 
-		// inject manifest specific labels
+		// inject manifest specific labels and annotations
 		a.injectLabels(&currObj)
+		a.injectAnnotations(&currObj)
 		kutil.SetMetaDataLabel(&currObj, manifestv1alpha2.ManagedDeployItemLabel, a.deployItemName)
 
 		if err := a.kubeClient.Update(ctx, &currObj); err != nil {
 			return mr, fmt.Errorf("unable to update resource %s: %w", key.String(), err)
 		}
+	case manifestv1alpha2.UpdateStrategyServerSideApply:
+		// inject manifest specific labels and annotations
+		a.injectLabels(obj)
+		a.injectAnnotations(obj)
+		kutil.SetMetaDataLabel(obj, manifestv1alpha2.ManagedDeployItemLabel, a.deployItemName)
+
+		if err := a.applyServerSide(ctx, obj); err != nil {
+			return mr, fmt.Errorf("unable to apply resource %s: %w", key.String(), err)
+		}
+	case manifestv1alpha2.UpdateStrategyThreeWayMerge:
+		// inject manifest specific labels and annotations
+		a.injectLabels(obj)
+		a.injectAnnotations(obj)
+		kutil.SetMetaDataLabel(obj, manifestv1alpha2.ManagedDeployItemLabel, a.deployItemName)
+
+		if err := a.applyThreeWayMerge(ctx, &currObj, obj); err != nil {
+			return mr, fmt.Errorf("unable to apply resource %s: %w", key.String(), err)
+		}
 	default:
 		return mr, fmt.Errorf("%s is not a valid update strategy", a.updateStrategy)
 	}
 	return mr, nil
 }
 
+// applyServerSide applies the object with server-side apply.
+// Depending on the conflict policy, the ownership of fields that are owned by other field managers is taken over
+// or the apply fails.
+func (a *ManifestApplier) applyServerSide(ctx context.Context, obj *unstructured.Unstructured) error {
+	fieldManager := manifestv1alpha2.DefaultFieldManager
+	conflictPolicy := manifestv1alpha2.ConflictPolicyForce
+	if a.serverSideApply != nil {
+		if len(a.serverSideApply.FieldManager) != 0 {
+			fieldManager = a.serverSideApply.FieldManager
+		}
+		if len(a.serverSideApply.ConflictPolicy) != 0 {
+			conflictPolicy = a.serverSideApply.ConflictPolicy
+		}
+	}
+
+	// apply configurations must not contain a resource version or managed fields
+	obj.SetResourceVersion("")
+	obj.SetManagedFields(nil)
+
+	opts := []client.PatchOption{client.FieldOwner(fieldManager)}
+	if conflictPolicy == manifestv1alpha2.ConflictPolicyForce {
+		opts = append(opts, client.ForceOwnership)
+	}
+	if err := a.kubeClient.Patch(ctx, obj, client.Apply, opts...); err != nil {
+		if apierrors.IsConflict(err) {
+			return fmt.Errorf("fields are owned by other field managers: %w", err)
+		}
+		return err
+	}
+	return nil
+}
+
+// annotateAfterServerSideCreate sets the annotations that are only set on creation of a server-side applied object.
+// They are set with a merge patch, so that they are not owned by the apply and not removed by the next apply.
+func (a *ManifestApplier) annotateAfterServerSideCreate(ctx context.Context, obj *unstructured.Unstructured, annotations map[string]string) error {
+	if len(annotations) == 0 {
+		return nil
+	}
+	currObj := obj.DeepCopy()
+	objAnnotations := obj.GetAnnotations()
+	if objAnnotations == nil {
+		objAnnotations = map[string]string{}
+	}
+	for key, val := range annotations {
+		objAnnotations[key] = val
+	}
+	obj.SetAnnotations(objAnnotations)
+	return a.kubeClient.Patch(ctx, obj, client.MergeFrom(currObj))
+}
+
 func (a *ManifestApplier) injectLabels(obj client.Object) {
 	if len(a.labels) == 0 {
 		return
@@ -356,13 +547,29 @@ func (a *ManifestApplier) injectLabels(obj client.Object) {
 	obj.SetLabels(labels)
 }
 
-func (a *ManifestApplier) cleanupOrphanedResourcesInGroups(ctx context.Context, oldManagedResources []managedresource.ManagedResourceStatus) error {
-	logger, ctx := logging.FromContextOrNew(ctx, nil, lc.KeyMethod, "cleanupOrphanedResourcesInGroups")
+func (a *ManifestApplier) injectAnnotations(obj client.Object) {
+	if len(a.annotations) == 0 {
+		return
+	}
+	annotations := obj.GetAnnotations()
+	if annotations == nil {
+		annotations = make(map[string]string)
+	}
+	for key, val := range a.annotations {
+		annotations[key] = val
+	}
+	obj.SetAnnotations(annotations)
+}
+
+// orphanedResources returns the old managed resources that are no longer defined in the manifests,
+// and that may be cleaned up according to their manage policy.
+func (a *ManifestApplier) orphanedResources(ctx context.Context, oldManagedResources []managedresource.ManagedResourceStatus) ([]managedresource.ManagedResourceStatus, error) {
+	logger, ctx := logging.FromContextOrNew(ctx, nil, lc.KeyMethod, "orphanedResources")
 	orphanedManagedResources := []managedresource.ManagedResourceStatus{}
 
 	_, err := timeout.TimeoutExceeded(ctx, a.deployItem, TimeoutCheckpointDeployerCleanupOrphaned)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
 	for i := range oldManagedResources {
@@ -375,7 +582,7 @@ func (a *ManifestApplier) cleanupOrphanedResourcesInGroups(ctx context.Context,
 
 		ok, err := FilterByPolicy(mrCtx, mr, a.kubeClient, a.deployItemName)
 		if err != nil {
-			return err
+			return nil, err
 		}
 		if !ok {
 			continue
@@ -386,10 +593,64 @@ func (a *ManifestApplier) cleanupOrphanedResourcesInGroups(ctx context.Context,
 			continue
 		}
 
-		mrLogger.Debug("Object is orphaned and will be deleted")
+		mrLogger.Debug("Object is orphaned")
 		orphanedManagedResources = append(orphanedManagedResources, *mr)
 	}
+	return orphanedManagedResources, nil
+}
+
+// applyPruningPolicies returns the orphaned resources that are deleted according to the pruning configuration.
+// Resources with the pruning policy "keep" are released, i.e. they are kept in the cluster but not managed anymore.
+// If any resource has the pruning policy "fail", no resource is deleted or released and an error is returned.
+func (a *ManifestApplier) applyPruningPolicies(ctx context.Context, orphanedResources []managedresource.ManagedResourceStatus) ([]managedresource.ManagedResourceStatus, error) {
+	logger, _ := logging.FromContextOrNew(ctx, nil, lc.KeyMethod, "applyPruningPolicies")
+
+	resourcesToDelete := []managedresource.ManagedResourceStatus{}
+	notAllowed := []string{}
+	for _, mr := range orphanedResources {
+		key := types.NamespacedName{Namespace: mr.Resource.Namespace, Name: mr.Resource.Name}.String()
+		mrLogger := logger.WithValues(lc.KeyResource, key, lc.KeyResourceKind, mr.Resource.Kind)
+		switch a.pruningPolicy(&mr) {
+		case managedresource.PruningPolicyKeep:
+			mrLogger.Info("Orphaned object is kept due to its pruning policy")
+		case managedresource.PruningPolicyFail:
+			notAllowed = append(notAllowed, fmt.Sprintf("%s %s", mr.Resource.Kind, key))
+		default:
+			mrLogger.Debug("Orphaned object will be deleted")
+			resourcesToDelete = append(resourcesToDelete, mr)
+		}
+	}
+
+	if len(notAllowed) != 0 {
+		// the orphaned resources stay managed, so that they are pruned as soon as the pruning is allowed
+		a.managedResources = append(a.managedResources, orphanedResources...)
+		return nil, lserrors.NewError("ApplyObjects", lsv1alpha1.PruningNotAllowedReason,
+			fmt.Sprintf("the pruning policy does not allow to prune the resources that are no longer defined: %s",
+				strings.Join(notAllowed, ", ")),
+			lsv1alpha1.ErrorConfigurationProblem)
+	}
+	return resourcesToDelete, nil
+}
+
+// pruningPolicy returns the pruning policy of an orphaned resource, which is the policy of the first matching rule
+// or the default policy of the pruning configuration.
+func (a *ManifestApplier) pruningPolicy(mr *managedresource.ManagedResourceStatus) managedresource.PruningPolicy {
+	if a.pruning == nil {
+		return managedresource.PruningPolicyDelete
+	}
+	for _, rule := range a.pruning.Rules {
+		if (&CustomMatcher{resourceTypes: rule.Resources}).Match(mr) {
+			return rule.Policy
+		}
+	}
+	if len(a.pruning.Policy) == 0 {
+		return managedresource.PruningPolicyDelete
+	}
+	return a.pruning.Policy
+}
 
+// cleanupOrphanedResourcesInGroups deletes the given orphaned resources in the order of the deletion groups.
+func (a *ManifestApplier) cleanupOrphanedResourcesInGroups(ctx context.Context, orphanedManagedResources []managedresource.ManagedResourceStatus) error {
 	return DeleteManagedResources(
 		ctx,
 		orphanedManagedResources,
@@ -14,8 +14,10 @@ import (
 	"k8s.io/client-go/kubernetes/scheme"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
+	lsv1alpha1 "github.com/gardener/landscaper/apis/core/v1alpha1"
 	manifestv1alpha2 "github.com/gardener/landscaper/apis/deployer/manifest/v1alpha2"
 	"github.com/gardener/landscaper/apis/deployer/utils/managedresource"
+	lserrors "github.com/gardener/landscaper/apis/errors"
 	kutil "github.com/gardener/landscaper/controller-utils/pkg/kubernetes"
 	"github.com/gardener/landscaper/controller-utils/pkg/logging"
 	"github.com/gardener/landscaper/pkg/api"
@@ -100,6 +102,134 @@ var _ = Describe("ObjectApplier", func() {
 		Expect(res.Data).To(HaveKeyWithValue("key", "modified"))
 	})
 
+	It("should apply a configmap with server-side apply without reverting fields of other field managers", func() {
+		cm := &corev1.ConfigMap{}
+		cm.Name = "my-cm"
+		cm.Namespace = state.Namespace
+		cm.Data = map[string]string{
+			"key": "val",
+		}
+		cmRaw, err := kutil.ConvertToRawExtension(cm, scheme.Scheme)
+		Expect(err).ToNot(HaveOccurred())
+
+		opts := resourcemanager.ManifestApplierOptions{
+			Decoder:          api.NewDecoder(scheme.Scheme),
+			KubeClient:       testenv.Client,
+			Clientset:        clientset,
+			DefaultNamespace: state.Namespace,
+			UpdateStrategy:   manifestv1alpha2.UpdateStrategyServerSideApply,
+			ServerSideApply: &manifestv1alpha2.ServerSideApplyConfiguration{
+				FieldManager:   "my-manager",
+				ConflictPolicy: manifestv1alpha2.ConflictPolicyFail,
+			},
+			Manifests: []managedresource.Manifest{
+				{
+					Manifest: cmRaw,
+					AnnotateBeforeCreate: map[string]string{
+						"created": "true",
+					},
+				},
+			},
+			ManagedResources: managedresource.ManagedResourceStatusList{},
+		}
+		managedResources, err := resourcemanager.ApplyManifests(ctx, opts)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(managedResources).To(HaveLen(1))
+
+		// another controller manages an additional field
+		other := &corev1.ConfigMap{}
+		other.APIVersion = "v1"
+		other.Kind = "ConfigMap"
+		other.Name = cm.Name
+		other.Namespace = cm.Namespace
+		other.Data = map[string]string{
+			"other": "val",
+		}
+		Expect(testenv.Client.Patch(ctx, other, client.Apply, client.FieldOwner("other-manager"))).To(Succeed())
+
+		opts.ManagedResources = managedResources
+		_, err = resourcemanager.ApplyManifests(ctx, opts)
+		Expect(err).ToNot(HaveOccurred())
+
+		res := &corev1.ConfigMap{}
+		Expect(testenv.Client.Get(ctx, kutil.ObjectKeyFromObject(cm), res)).To(Succeed())
+		Expect(res.Data).To(HaveKeyWithValue("key", "val"))
+		Expect(res.Data).To(HaveKeyWithValue("other", "val"))
+		Expect(res.Annotations).To(HaveKeyWithValue("created", "true"))
+
+		// the other controller takes over a field of the manifest
+		other.Data["key"] = "modified"
+		Expect(testenv.Client.Patch(ctx, other, client.Apply, client.FieldOwner("other-manager"), client.ForceOwnership)).To(Succeed())
+
+		_, err = resourcemanager.ApplyManifests(ctx, opts)
+		Expect(err).To(HaveOccurred())
+
+		opts.ServerSideApply.ConflictPolicy = manifestv1alpha2.ConflictPolicyForce
+		_, err = resourcemanager.ApplyManifests(ctx, opts)
+		Expect(err).ToNot(HaveOccurred())
+
+		res = &corev1.ConfigMap{}
+		Expect(testenv.Client.Get(ctx, kutil.ObjectKeyFromObject(cm), res)).To(Succeed())
+		Expect(res.Data).To(HaveKeyWithValue("key", "val"))
+	})
+
+	It("should apply a configmap with a three-way merge without reverting fields of other controllers", func() {
+		cm := &corev1.ConfigMap{}
+		cm.Name = "my-cm"
+		cm.Namespace = state.Namespace
+		cm.Data = map[string]string{
+			"key":     "val",
+			"removed": "val",
+		}
+		cmRaw, err := kutil.ConvertToRawExtension(cm, scheme.Scheme)
+		Expect(err).ToNot(HaveOccurred())
+
+		opts := resourcemanager.ManifestApplierOptions{
+			Decoder:          api.NewDecoder(scheme.Scheme),
+			KubeClient:       testenv.Client,
+			Clientset:        clientset,
+			DefaultNamespace: state.Namespace,
+			UpdateStrategy:   manifestv1alpha2.UpdateStrategyThreeWayMerge,
+			Manifests: []managedresource.Manifest{
+				{
+					Manifest: cmRaw,
+					AnnotateBeforeCreate: map[string]string{
+						"created": "true",
+					},
+				},
+			},
+			ManagedResources: managedresource.ManagedResourceStatusList{},
+		}
+		managedResources, err := resourcemanager.ApplyManifests(ctx, opts)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(managedResources).To(HaveLen(1))
+
+		// another controller sets an additional field
+		res := &corev1.ConfigMap{}
+		Expect(testenv.Client.Get(ctx, kutil.ObjectKeyFromObject(cm), res)).To(Succeed())
+		Expect(res.Annotations).To(HaveKey(corev1.LastAppliedConfigAnnotation))
+		res.Data["other"] = "val"
+		Expect(testenv.Client.Update(ctx, res)).To(Succeed())
+
+		// a field is removed from the manifest
+		delete(cm.Data, "removed")
+		cm.Data["key"] = "modified"
+		cmRaw, err = kutil.ConvertToRawExtension(cm, scheme.Scheme)
+		Expect(err).ToNot(HaveOccurred())
+		opts.Manifests[0].Manifest = cmRaw
+		opts.ManagedResources = managedResources
+		_, err = resourcemanager.ApplyManifests(ctx, opts)
+		Expect(err).ToNot(HaveOccurred())
+
+		res = &corev1.ConfigMap{}
+		Expect(testenv.Client.Get(ctx, kutil.ObjectKeyFromObject(cm), res)).To(Succeed())
+		Expect(res.Data).To(Equal(map[string]string{
+			"key":   "modified",
+			"other": "val",
+		}))
+		Expect(res.Annotations).To(HaveKeyWithValue("created", "true"))
+	})
+
 	It("should delete a orphaned resource", func() {
 		cm := &corev1.ConfigMap{}
 		cm.Name = "my-cm"
@@ -148,6 +278,95 @@ var _ = Describe("ObjectApplier", func() {
 		Expect(managedResources).To(HaveLen(0))
 	})
 
+	Context("Pruning", func() {
+
+		var (
+			cm   *corev1.ConfigMap
+			opts resourcemanager.ManifestApplierOptions
+		)
+
+		BeforeEach(func() {
+			cm = &corev1.ConfigMap{}
+			cm.Name = "my-cm"
+			cm.Namespace = state.Namespace
+			cm.Data = map[string]string{
+				"key": "val",
+			}
+			cmRaw, err := kutil.ConvertToRawExtension(cm, scheme.Scheme)
+			Expect(err).ToNot(HaveOccurred())
+
+			opts = resourcemanager.ManifestApplierOptions{
+				Decoder:          api.NewDecoder(scheme.Scheme),
+				KubeClient:       testenv.Client,
+				Clientset:        clientset,
+				DefaultNamespace: state.Namespace,
+				UpdateStrategy:   manifestv1alpha2.UpdateStrategyUpdate,
+				Manifests: []managedresource.Manifest{
+					{
+						Manifest: cmRaw,
+					},
+				},
+				ManagedResources: managedresource.ManagedResourceStatusList{},
+			}
+			managedResources, err := resourcemanager.ApplyManifests(ctx, opts)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(managedResources).To(HaveLen(1))
+
+			opts.Manifests = []managedresource.Manifest{}
+			opts.ManagedResources = managedResources
+		})
+
+		It("should keep an orphaned resource but not manage it anymore", func() {
+			opts.Pruning = &managedresource.PruningConfiguration{
+				Policy: managedresource.PruningPolicyKeep,
+			}
+			managedResources, err := resourcemanager.ApplyManifests(ctx, opts)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(managedResources).To(HaveLen(0))
+
+			res := &corev1.ConfigMap{}
+			Expect(testenv.Client.Get(ctx, kutil.ObjectKeyFromObject(cm), res)).To(Succeed())
+		})
+
+		It("should fail if the pruning policy of an orphaned resource is fail", func() {
+			opts.Pruning = &managedresource.PruningConfiguration{
+				Rules: []managedresource.PruningRule{
+					{
+						Resources: []managedresource.ResourceType{{APIVersion: "v1", Kind: "ConfigMap"}},
+						Policy:    managedresource.PruningPolicyFail,
+					},
+				},
+			}
+			applier := resourcemanager.NewManifestApplier(opts)
+			err := applier.Apply(ctx)
+			Expect(err).To(HaveOccurred())
+			lsErr, ok := lserrors.IsError(err)
+			Expect(ok).To(BeTrue())
+			Expect(lsErr.LandscaperError().Reason).To(Equal(lsv1alpha1.PruningNotAllowedReason))
+			Expect(applier.GetManagedResourcesStatus()).To(Equal(opts.ManagedResources))
+
+			res := &corev1.ConfigMap{}
+			Expect(testenv.Client.Get(ctx, kutil.ObjectKeyFromObject(cm), res)).To(Succeed())
+		})
+
+		It("should delete an orphaned resource that matches no rule", func() {
+			opts.Pruning = &managedresource.PruningConfiguration{
+				Rules: []managedresource.PruningRule{
+					{
+						Resources: []managedresource.ResourceType{{APIVersion: "v1", Kind: "Secret"}},
+						Policy:    managedresource.PruningPolicyFail,
+					},
+				},
+			}
+			managedResources, err := resourcemanager.ApplyManifests(ctx, opts)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(managedResources).To(HaveLen(0))
+
+			res := &corev1.ConfigMap{}
+			Expect(testenv.Client.Get(ctx, kutil.ObjectKeyFromObject(cm), res)).To(HaveOccurred())
+		})
+	})
+
 	It("should keep a sorted list of managed resources", func() {
 		cm := &corev1.ConfigMap{}
 		cm.Name = "my-cm"
@@ -210,6 +429,44 @@ var _ = Describe("ObjectApplier", func() {
 
 	})
 
+	It("should apply manifests in batches and report the progress after each batch", func() {
+		manifests := make([]managedresource.Manifest, 0)
+		for i := 0; i < 5; i++ {
+			cm := &corev1.ConfigMap{}
+			cm.Name = fmt.Sprintf("my-cm-%d", i)
+			cm.Namespace = state.Namespace
+			cm.Data = map[string]string{
+				"key": "val",
+			}
+			cmRaw, err := kutil.ConvertToRawExtension(cm, scheme.Scheme)
+			Expect(err).ToNot(HaveOccurred())
+			manifests = append(manifests, managedresource.Manifest{Manifest: cmRaw})
+		}
+
+		checkpoints := make([]managedresource.ApplyProgress, 0)
+		opts := resourcemanager.ManifestApplierOptions{
+			Decoder:          api.NewDecoder(scheme.Scheme),
+			KubeClient:       testenv.Client,
+			Clientset:        clientset,
+			DefaultNamespace: state.Namespace,
+			UpdateStrategy:   manifestv1alpha2.UpdateStrategyUpdate,
+			Manifests:        manifests,
+			ManagedResources: managedresource.ManagedResourceStatusList{},
+			BatchSize:        2,
+			ProgressCheckpoint: func(_ context.Context, progress managedresource.ApplyProgress) {
+				checkpoints = append(checkpoints, progress)
+			},
+		}
+		managedResources, err := resourcemanager.ApplyManifests(ctx, opts)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(managedResources).To(HaveLen(5))
+		Expect(checkpoints).To(Equal([]managedresource.ApplyProgress{
+			{AppliedManifests: 2, TotalManifests: 5},
+			{AppliedManifests: 4, TotalManifests: 5},
+			{AppliedManifests: 5, TotalManifests: 5},
+		}))
+	})
+
 	It("should create a namespace before other resources", func() {
 		cm := &corev1.ConfigMap{}
 		cm.Name = "my-cm"
@@ -0,0 +1,86 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package lib
+
+import (
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	lsv1alpha1 "github.com/gardener/landscaper/apis/core/v1alpha1"
+	lsv1alpha1helper "github.com/gardener/landscaper/apis/core/v1alpha1/helper"
+	lserrors "github.com/gardener/landscaper/apis/errors"
+)
+
+const (
+	// DefaultRetryInitialBackoff is the default interval before the first retry of a failed operation.
+	DefaultRetryInitialBackoff = 5 * time.Second
+	// DefaultRetryMaxBackoff is the default maximal interval between two retries of a failed operation.
+	DefaultRetryMaxBackoff = 5 * time.Minute
+)
+
+// RetryBackoff returns the interval before the given retry of a failed operation.
+// The interval starts with the initial backoff of the policy and is doubled with every retry up to the max backoff.
+func RetryBackoff(policy *lsv1alpha1.RetryPolicy, retry int32) time.Duration {
+	backoff := DefaultRetryInitialBackoff
+	if policy.InitialBackoff != nil && policy.InitialBackoff.Duration > 0 {
+		backoff = policy.InitialBackoff.Duration
+	}
+	maxBackoff := DefaultRetryMaxBackoff
+	if policy.MaxBackoff != nil && policy.MaxBackoff.Duration > 0 {
+		maxBackoff = policy.MaxBackoff.Duration
+	}
+
+	for i := int32(1); i < retry && backoff < maxBackoff; i++ {
+		backoff *= 2
+	}
+	if backoff > maxBackoff {
+		return maxBackoff
+	}
+	return backoff
+}
+
+// timeUntilNextRetry returns the time that the deploy item still has to wait for the next retry of its current operation.
+func timeUntilNextRetry(di *lsv1alpha1.DeployItem, now time.Time) time.Duration {
+	if di.Spec.RetryPolicy == nil || di.Status.NextRetryTime == nil {
+		return 0
+	}
+	return di.Status.NextRetryTime.Time.Sub(now)
+}
+
+// applyRetryPolicy handles a failed reconcile or deletion according to the retry policy of the deploy item.
+// If the operation is retried, the retry is scheduled in the status of the deploy item and the interval until the
+// retry is returned. If the retries are exhausted or the error is not retryable, the deploy item is set to failed.
+// Without a retry policy, the deploy item is left unchanged and the operation is retried until the deploy item
+// times out.
+func applyRetryPolicy(di *lsv1alpha1.DeployItem, lsError lserrors.LsError, now time.Time) time.Duration {
+	policy := di.Spec.RetryPolicy
+	if policy == nil || lsError == nil || di.Status.Phase.IsFinal() {
+		return 0
+	}
+
+	codes := lserrors.CollectErrorCodes(lsError)
+	if lserrors.HasErrorCode(codes, lsv1alpha1.ErrorForInfoOnly) {
+		// the deployer only reports that its operation is still ongoing
+		return 0
+	}
+	if lserrors.ContainsAnyErrorCode(codes, lsv1alpha1.UnrecoverableErrorCodes) {
+		// the deploy item fails anyway
+		return 0
+	}
+
+	retryable := len(policy.RetryableErrorCodes) == 0 || lserrors.ContainsAnyErrorCode(codes, policy.RetryableErrorCodes)
+	if !retryable || di.Status.RetryCount >= policy.MaxRetries {
+		di.Status.NextRetryTime = nil
+		lsv1alpha1helper.SetDeployItemToFailed(di)
+		return 0
+	}
+
+	di.Status.RetryCount++
+	backoff := RetryBackoff(policy, di.Status.RetryCount)
+	nextRetryTime := metav1.NewTime(now.Add(backoff))
+	di.Status.NextRetryTime = &nextRetryTime
+	return backoff
+}
@@ -0,0 +1,88 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package lib
+
+import (
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	lsv1alpha1 "github.com/gardener/landscaper/apis/core/v1alpha1"
+	lserrors "github.com/gardener/landscaper/apis/errors"
+)
+
+var _ = Describe("Retry Policy", func() {
+
+	var now time.Time
+
+	BeforeEach(func() {
+		now = time.Now()
+	})
+
+	newDeployItem := func(policy *lsv1alpha1.RetryPolicy) *lsv1alpha1.DeployItem {
+		di := &lsv1alpha1.DeployItem{}
+		di.Spec.RetryPolicy = policy
+		di.Status.Phase = lsv1alpha1.DeployItemPhases.Progressing
+		return di
+	}
+
+	It("should double the backoff with every retry up to the max backoff", func() {
+		policy := &lsv1alpha1.RetryPolicy{
+			InitialBackoff: &lsv1alpha1.Duration{Duration: 10 * time.Second},
+			MaxBackoff:     &lsv1alpha1.Duration{Duration: time.Minute},
+		}
+		Expect(RetryBackoff(policy, 1)).To(Equal(10 * time.Second))
+		Expect(RetryBackoff(policy, 2)).To(Equal(20 * time.Second))
+		Expect(RetryBackoff(policy, 3)).To(Equal(40 * time.Second))
+		Expect(RetryBackoff(policy, 4)).To(Equal(time.Minute))
+		Expect(RetryBackoff(policy, 100)).To(Equal(time.Minute))
+
+		Expect(RetryBackoff(&lsv1alpha1.RetryPolicy{}, 1)).To(Equal(DefaultRetryInitialBackoff))
+	})
+
+	It("should schedule retries until the max retries are exhausted", func() {
+		di := newDeployItem(&lsv1alpha1.RetryPolicy{MaxRetries: 2})
+		lsError := lserrors.NewError("Reconcile", "ApplyFailed", "apply failed")
+
+		Expect(applyRetryPolicy(di, lsError, now)).To(Equal(DefaultRetryInitialBackoff))
+		Expect(di.Status.RetryCount).To(Equal(int32(1)))
+		Expect(di.Status.NextRetryTime.Time).To(BeTemporally("~", now.Add(DefaultRetryInitialBackoff), time.Second))
+		Expect(timeUntilNextRetry(di, now)).To(BeNumerically(">", 0))
+		Expect(timeUntilNextRetry(di, now.Add(time.Minute))).To(BeNumerically("<", 0))
+
+		Expect(applyRetryPolicy(di, lsError, now)).To(Equal(2 * DefaultRetryInitialBackoff))
+		Expect(di.Status.RetryCount).To(Equal(int32(2)))
+
+		Expect(applyRetryPolicy(di, lsError, now)).To(BeZero())
+		Expect(di.Status.Phase).To(Equal(lsv1alpha1.DeployItemPhases.Failed))
+		Expect(di.Status.NextRetryTime).To(BeNil())
+	})
+
+	It("should fail immediately if the error code is not retryable", func() {
+		di := newDeployItem(&lsv1alpha1.RetryPolicy{
+			MaxRetries:          3,
+			RetryableErrorCodes: []lsv1alpha1.ErrorCode{lsv1alpha1.ErrorWebhook},
+		})
+
+		Expect(applyRetryPolicy(di, lserrors.NewError("Reconcile", "ApplyFailed", "apply failed",
+			lsv1alpha1.ErrorWebhook), now)).To(Equal(DefaultRetryInitialBackoff))
+		Expect(applyRetryPolicy(di, lserrors.NewError("Reconcile", "ApplyFailed", "apply failed"), now)).To(BeZero())
+		Expect(di.Status.Phase).To(Equal(lsv1alpha1.DeployItemPhases.Failed))
+	})
+
+	It("should not change deploy items without retry policy or error", func() {
+		di := newDeployItem(nil)
+		Expect(applyRetryPolicy(di, lserrors.NewError("Reconcile", "ApplyFailed", "apply failed"), now)).To(BeZero())
+		Expect(di.Status.Phase).To(Equal(lsv1alpha1.DeployItemPhases.Progressing))
+
+		di = newDeployItem(&lsv1alpha1.RetryPolicy{MaxRetries: 1})
+		Expect(applyRetryPolicy(di, nil, now)).To(BeZero())
+		Expect(applyRetryPolicy(di, lserrors.NewError("Reconcile", "Waiting", "waiting",
+			lsv1alpha1.ErrorForInfoOnly), now)).To(BeZero())
+		Expect(di.Status.RetryCount).To(BeZero())
+		Expect(di.Status.Phase).To(Equal(lsv1alpha1.DeployItemPhases.Progressing))
+	})
+})
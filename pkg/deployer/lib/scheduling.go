@@ -0,0 +1,160 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package lib
+
+import (
+	"encoding/json"
+	"fmt"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	lsv1alpha1 "github.com/gardener/landscaper/apis/core/v1alpha1"
+)
+
+// GetContextScheduling returns the scheduling defaults of the context.
+// Nil is returned if the context defines no node selector and no tolerations.
+func GetContextScheduling(lsCtx *lsv1alpha1.Context) *lsv1alpha1.SchedulingConfiguration {
+	if lsCtx == nil || lsCtx.Scheduling == nil {
+		return nil
+	}
+	if len(lsCtx.Scheduling.NodeSelector) == 0 && len(lsCtx.Scheduling.Tolerations) == 0 {
+		return nil
+	}
+	return lsCtx.Scheduling
+}
+
+// ApplySchedulingDefaults adds the node selector and the tolerations of the scheduling configuration to a pod spec.
+// Keys of the node selector that are already set and tolerations that already match are not modified.
+func ApplySchedulingDefaults(spec *corev1.PodSpec, scheduling *lsv1alpha1.SchedulingConfiguration) {
+	if scheduling == nil {
+		return
+	}
+	for key, value := range scheduling.NodeSelector {
+		if spec.NodeSelector == nil {
+			spec.NodeSelector = map[string]string{}
+		}
+		if _, ok := spec.NodeSelector[key]; !ok {
+			spec.NodeSelector[key] = value
+		}
+	}
+	for i := range scheduling.Tolerations {
+		if !containsToleration(spec.Tolerations, &scheduling.Tolerations[i]) {
+			spec.Tolerations = append(spec.Tolerations, scheduling.Tolerations[i])
+		}
+	}
+}
+
+// InjectSchedulingDefaults adds the node selector and the tolerations of the scheduling configuration to the pod spec
+// of pods and of the pod templates of workload resources.
+// Keys of the node selector that are already set and tolerations that already match are not modified.
+// Other objects are not modified. It returns whether the object has been modified.
+func InjectSchedulingDefaults(obj *unstructured.Unstructured, scheduling *lsv1alpha1.SchedulingConfiguration) (bool, error) {
+	if scheduling == nil {
+		return false, nil
+	}
+	path, ok := podSpecPaths[obj.GroupVersionKind().GroupKind()]
+	if !ok {
+		return false, nil
+	}
+	if _, found, err := unstructured.NestedMap(obj.Object, path...); err != nil || !found {
+		return false, err
+	}
+
+	nodeSelectorModified := false
+	nodeSelectorPath := append(append([]string{}, path...), "nodeSelector")
+	nodeSelector, _, err := unstructured.NestedStringMap(obj.Object, nodeSelectorPath...)
+	if err != nil {
+		return false, err
+	}
+	for key, value := range scheduling.NodeSelector {
+		if nodeSelector == nil {
+			nodeSelector = map[string]string{}
+		}
+		if _, ok := nodeSelector[key]; !ok {
+			nodeSelector[key] = value
+			nodeSelectorModified = true
+		}
+	}
+	if nodeSelectorModified {
+		if err := unstructured.SetNestedStringMap(obj.Object, nodeSelector, nodeSelectorPath...); err != nil {
+			return false, err
+		}
+	}
+
+	tolerationsPath := append(append([]string{}, path...), "tolerations")
+	rawTolerations, _, err := unstructured.NestedSlice(obj.Object, tolerationsPath...)
+	if err != nil {
+		return false, err
+	}
+	tolerations := make([]corev1.Toleration, len(rawTolerations))
+	for i, raw := range rawTolerations {
+		rawMap, ok := raw.(map[string]interface{})
+		if !ok {
+			return false, fmt.Errorf("toleration %d is not an object", i)
+		}
+		if err := runtime.DefaultUnstructuredConverter.FromUnstructured(rawMap, &tolerations[i]); err != nil {
+			return false, fmt.Errorf("unable to decode toleration %d: %w", i, err)
+		}
+	}
+	tolerationsModified := false
+	for i := range scheduling.Tolerations {
+		if containsToleration(tolerations, &scheduling.Tolerations[i]) {
+			continue
+		}
+		raw, err := runtime.DefaultUnstructuredConverter.ToUnstructured(&scheduling.Tolerations[i])
+		if err != nil {
+			return false, fmt.Errorf("unable to encode toleration: %w", err)
+		}
+		rawTolerations = append(rawTolerations, raw)
+		tolerations = append(tolerations, scheduling.Tolerations[i])
+		tolerationsModified = true
+	}
+	if tolerationsModified {
+		if err := unstructured.SetNestedSlice(obj.Object, rawTolerations, tolerationsPath...); err != nil {
+			return false, err
+		}
+	}
+
+	return nodeSelectorModified || tolerationsModified, nil
+}
+
+// InjectSchedulingDefaultsIntoManifests adds the scheduling defaults to all pods and pod templates of the given manifests.
+func InjectSchedulingDefaultsIntoManifests(manifests []*runtime.RawExtension, scheduling *lsv1alpha1.SchedulingConfiguration) ([]*runtime.RawExtension, error) {
+	result := make([]*runtime.RawExtension, len(manifests))
+	for i, manifest := range manifests {
+		obj := &unstructured.Unstructured{}
+		if err := json.Unmarshal(manifest.Raw, &obj.Object); err != nil {
+			return nil, fmt.Errorf("unable to decode manifest: %w", err)
+		}
+
+		modified, err := InjectSchedulingDefaults(obj, scheduling)
+		if err != nil {
+			return nil, fmt.Errorf("unable to inject scheduling defaults into %s %s: %w", obj.GetKind(), obj.GetName(), err)
+		}
+		if !modified {
+			result[i] = manifest
+			continue
+		}
+
+		raw, err := json.Marshal(obj.Object)
+		if err != nil {
+			return nil, fmt.Errorf("unable to encode manifest: %w", err)
+		}
+		result[i] = &runtime.RawExtension{Raw: raw}
+	}
+	return result, nil
+}
+
+// containsToleration returns whether one of the tolerations matches the given toleration.
+func containsToleration(tolerations []corev1.Toleration, toleration *corev1.Toleration) bool {
+	for i := range tolerations {
+		if tolerations[i].MatchToleration(toleration) {
+			return true
+		}
+	}
+	return false
+}
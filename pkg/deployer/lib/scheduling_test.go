@@ -0,0 +1,116 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package lib
+
+import (
+	"encoding/json"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	appsv1 "k8s.io/api/apps/v1"
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/utils/ptr"
+
+	lsv1alpha1 "github.com/gardener/landscaper/apis/core/v1alpha1"
+)
+
+var _ = Describe("Scheduling defaults", func() {
+
+	raw := func(a any) *runtime.RawExtension {
+		bytes, err := json.Marshal(a)
+		Expect(err).NotTo(HaveOccurred())
+		return &runtime.RawExtension{Raw: bytes}
+	}
+
+	poolToleration := corev1.Toleration{
+		Key:      "pool",
+		Operator: corev1.TolerationOpEqual,
+		Value:    "tenant",
+		Effect:   corev1.TaintEffectNoSchedule,
+	}
+	scheduling := &lsv1alpha1.SchedulingConfiguration{
+		NodeSelector: map[string]string{"pool": "tenant", "zone": "a"},
+		Tolerations: []corev1.Toleration{
+			poolToleration,
+			{Key: "unreachable", Operator: corev1.TolerationOpExists, Effect: corev1.TaintEffectNoExecute, TolerationSeconds: ptr.To[int64](60)},
+		},
+	}
+
+	Context("GetContextScheduling", func() {
+
+		It("should return nil if the context defines no node selector and no tolerations", func() {
+			Expect(GetContextScheduling(nil)).To(BeNil())
+			Expect(GetContextScheduling(&lsv1alpha1.Context{})).To(BeNil())
+
+			lsCtx := &lsv1alpha1.Context{}
+			lsCtx.Scheduling = &lsv1alpha1.SchedulingConfiguration{InjectIntoHelmWorkloads: true}
+			Expect(GetContextScheduling(lsCtx)).To(BeNil())
+
+			lsCtx.Scheduling = scheduling
+			Expect(GetContextScheduling(lsCtx)).To(BeIdenticalTo(scheduling))
+		})
+	})
+
+	Context("ApplySchedulingDefaults", func() {
+
+		It("should add the node selector and the tolerations without overwriting existing values", func() {
+			spec := &corev1.PodSpec{
+				NodeSelector: map[string]string{"zone": "b"},
+				Tolerations:  []corev1.Toleration{poolToleration},
+			}
+			ApplySchedulingDefaults(spec, scheduling)
+
+			Expect(spec.NodeSelector).To(Equal(map[string]string{"pool": "tenant", "zone": "b"}))
+			Expect(spec.Tolerations).To(Equal(scheduling.Tolerations))
+		})
+	})
+
+	Context("InjectSchedulingDefaultsIntoManifests", func() {
+
+		It("should add the scheduling defaults to pod templates and keep other objects", func() {
+			deployment := &appsv1.Deployment{
+				TypeMeta:   metav1.TypeMeta{APIVersion: "apps/v1", Kind: "Deployment"},
+				ObjectMeta: metav1.ObjectMeta{Name: "app", Namespace: "test"},
+			}
+			deployment.Spec.Template.Spec.Containers = []corev1.Container{{Name: "app", Image: "app:1.0.0"}}
+			deployment.Spec.Template.Spec.NodeSelector = map[string]string{"zone": "b"}
+			cronJob := &batchv1.CronJob{
+				TypeMeta:   metav1.TypeMeta{APIVersion: "batch/v1", Kind: "CronJob"},
+				ObjectMeta: metav1.ObjectMeta{Name: "job", Namespace: "test"},
+			}
+			cronJob.Spec.JobTemplate.Spec.Template.Spec.Containers = []corev1.Container{{Name: "job", Image: "job:1.0.0"}}
+			cronJob.Spec.JobTemplate.Spec.Template.Spec.Tolerations = []corev1.Toleration{poolToleration}
+			configMap := &corev1.ConfigMap{
+				TypeMeta:   metav1.TypeMeta{APIVersion: "v1", Kind: "ConfigMap"},
+				ObjectMeta: metav1.ObjectMeta{Name: "config", Namespace: "test"},
+			}
+			manifests := []*runtime.RawExtension{raw(deployment), raw(cronJob), raw(configMap)}
+
+			result, err := InjectSchedulingDefaultsIntoManifests(manifests, scheduling)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(result).To(HaveLen(3))
+			Expect(result[2]).To(BeIdenticalTo(manifests[2]))
+
+			injectedDeployment := &appsv1.Deployment{}
+			Expect(json.Unmarshal(result[0].Raw, injectedDeployment)).To(Succeed())
+			Expect(injectedDeployment.Spec.Template.Spec.NodeSelector).To(Equal(map[string]string{"pool": "tenant", "zone": "b"}))
+			Expect(injectedDeployment.Spec.Template.Spec.Tolerations).To(Equal(scheduling.Tolerations))
+
+			injectedCronJob := &batchv1.CronJob{}
+			Expect(json.Unmarshal(result[1].Raw, injectedCronJob)).To(Succeed())
+			Expect(injectedCronJob.Spec.JobTemplate.Spec.Template.Spec.NodeSelector).To(Equal(scheduling.NodeSelector))
+			Expect(injectedCronJob.Spec.JobTemplate.Spec.Template.Spec.Tolerations).To(Equal(scheduling.Tolerations))
+
+			// a second injection must not modify the manifests again
+			again, err := InjectSchedulingDefaultsIntoManifests(result, scheduling)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(again[0]).To(BeIdenticalTo(result[0]))
+			Expect(again[1]).To(BeIdenticalTo(result[1]))
+		})
+	})
+})
@@ -0,0 +1,168 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package lib
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	authenticationv1 "k8s.io/api/authentication/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/tools/clientcmd"
+	clientcmdapi "k8s.io/client-go/tools/clientcmd/api"
+
+	"github.com/gardener/landscaper/apis/core/v1alpha1/targettypes"
+	"github.com/gardener/landscaper/pkg/utils"
+)
+
+// serviceAccountTokenKey identifies a requested service account token.
+type serviceAccountTokenKey struct {
+	kubeconfig string
+	namespace  string
+	name       string
+	audiences  string
+}
+
+type cachedServiceAccountKubeconfig struct {
+	kubeconfig []byte
+	renewAt    time.Time
+}
+
+// requestServiceAccountTokenFunc requests a token for a service account in the cluster of the given kubeconfig
+// and returns it together with its expiration time.
+type requestServiceAccountTokenFunc func(ctx context.Context, kubeconfig []byte,
+	config *targettypes.ServiceAccountTokenConfig, expirationSeconds int64) (string, time.Time, error)
+
+// serviceAccountKubeconfigCache caches the kubeconfigs with requested service account tokens
+// until half of their validity has passed.
+type serviceAccountKubeconfigCache struct {
+	mux     sync.Mutex
+	entries map[serviceAccountTokenKey]cachedServiceAccountKubeconfig
+	now     func() time.Time
+	request requestServiceAccountTokenFunc
+}
+
+func newServiceAccountKubeconfigCache() *serviceAccountKubeconfigCache {
+	return &serviceAccountKubeconfigCache{
+		entries: map[serviceAccountTokenKey]cachedServiceAccountKubeconfig{},
+		now:     time.Now,
+		request: requestServiceAccountToken,
+	}
+}
+
+var defaultServiceAccountKubeconfigCache = newServiceAccountKubeconfigCache()
+
+// GetServiceAccountKubeconfig returns a kubeconfig for the cluster of the given kubeconfig that authenticates
+// with a short-lived token of the configured service account.
+// Requested kubeconfigs are shared by all deploy items of the process until half of their validity has passed.
+func GetServiceAccountKubeconfig(ctx context.Context, kubeconfig []byte, config *targettypes.ServiceAccountTokenConfig) ([]byte, error) {
+	if len(config.Name) == 0 || len(config.Namespace) == 0 {
+		return nil, errors.New("no service account name or namespace defined in kubernetes cluster target")
+	}
+	return defaultServiceAccountKubeconfigCache.Get(ctx, kubeconfig, config)
+}
+
+// Get returns a cached kubeconfig or a kubeconfig with a newly requested token for the given service account.
+func (c *serviceAccountKubeconfigCache) Get(ctx context.Context, kubeconfig []byte, config *targettypes.ServiceAccountTokenConfig) ([]byte, error) {
+	hash := sha256.Sum256(kubeconfig)
+	key := serviceAccountTokenKey{
+		kubeconfig: hex.EncodeToString(hash[:]),
+		namespace:  config.Namespace,
+		name:       config.Name,
+		audiences:  strings.Join(config.Audiences, ","),
+	}
+
+	c.mux.Lock()
+	entry, ok := c.entries[key]
+	c.mux.Unlock()
+	if ok && c.now().Before(entry.renewAt) {
+		// callers may zeroize the returned kubeconfig after use
+		return bytes.Clone(entry.kubeconfig), nil
+	}
+
+	expirationSeconds := targettypes.DefaultServiceAccountTokenExpirationSeconds
+	if config.ExpirationSeconds != nil && *config.ExpirationSeconds > 0 {
+		expirationSeconds = *config.ExpirationSeconds
+	}
+
+	requestTime := c.now()
+	token, expiration, err := c.request(ctx, kubeconfig, config, expirationSeconds)
+	if err != nil {
+		return nil, err
+	}
+	tokenKubeconfig, err := buildTokenKubeconfig(kubeconfig, token)
+	if err != nil {
+		return nil, err
+	}
+
+	c.mux.Lock()
+	defer c.mux.Unlock()
+	c.entries[key] = cachedServiceAccountKubeconfig{
+		kubeconfig: tokenKubeconfig,
+		renewAt:    requestTime.Add(expiration.Sub(requestTime) / 2),
+	}
+	return bytes.Clone(tokenKubeconfig), nil
+}
+
+// requestServiceAccountToken requests a token for a service account with the TokenRequest api.
+func requestServiceAccountToken(ctx context.Context, kubeconfig []byte, config *targettypes.ServiceAccountTokenConfig,
+	expirationSeconds int64) (string, time.Time, error) {
+	restConfig, err := utils.RestConfigFromKubeconfig(kubeconfig)
+	if err != nil {
+		return "", time.Time{}, fmt.Errorf("unable to create rest config for token request: %w", err)
+	}
+	clientset, err := kubernetes.NewForConfig(restConfig)
+	if err != nil {
+		return "", time.Time{}, fmt.Errorf("unable to create client for token request: %w", err)
+	}
+
+	tokenRequest := &authenticationv1.TokenRequest{
+		Spec: authenticationv1.TokenRequestSpec{
+			Audiences:         config.Audiences,
+			ExpirationSeconds: &expirationSeconds,
+		},
+	}
+	tokenRequest, err = clientset.CoreV1().ServiceAccounts(config.Namespace).CreateToken(ctx, config.Name, tokenRequest,
+		metav1.CreateOptions{})
+	if err != nil {
+		return "", time.Time{}, fmt.Errorf("unable to request token for service account %s/%s: %w",
+			config.Namespace, config.Name, err)
+	}
+	return tokenRequest.Status.Token, tokenRequest.Status.ExpirationTimestamp.Time, nil
+}
+
+// buildTokenKubeconfig returns a kubeconfig for the cluster of the current context of the given kubeconfig
+// that authenticates with the given token. Other credentials of the kubeconfig, like client certificates,
+// auth providers or exec plugins, are not taken over.
+func buildTokenKubeconfig(kubeconfig []byte, token string) ([]byte, error) {
+	rawConfig, err := utils.LoadKubeconfig(kubeconfig)
+	if err != nil {
+		return nil, err
+	}
+	currentContext, ok := rawConfig.Contexts[rawConfig.CurrentContext]
+	if !ok {
+		return nil, fmt.Errorf("current context %q not found in kubeconfig", rawConfig.CurrentContext)
+	}
+	cluster, ok := rawConfig.Clusters[currentContext.Cluster]
+	if !ok {
+		return nil, fmt.Errorf("cluster %q not found in kubeconfig", currentContext.Cluster)
+	}
+
+	const name = "service-account"
+	tokenConfig := clientcmdapi.NewConfig()
+	tokenConfig.Clusters[name] = cluster
+	tokenConfig.AuthInfos[name] = &clientcmdapi.AuthInfo{Token: token}
+	tokenConfig.Contexts[name] = &clientcmdapi.Context{Cluster: name, AuthInfo: name}
+	tokenConfig.CurrentContext = name
+	return clientcmd.Write(*tokenConfig)
+}
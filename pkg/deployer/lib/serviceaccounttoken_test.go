@@ -0,0 +1,116 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package lib
+
+import (
+	"context"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"k8s.io/client-go/tools/clientcmd"
+	"k8s.io/utils/ptr"
+
+	"github.com/gardener/landscaper/apis/core/v1alpha1/targettypes"
+)
+
+var _ = Describe("Service Account Kubeconfig Cache", func() {
+
+	const kubeconfig = `apiVersion: v1
+kind: Config
+current-context: target
+clusters:
+- name: target
+  cluster:
+    server: https://api.example.com
+    certificate-authority-data: Y2E=
+contexts:
+- name: target
+  context:
+    cluster: target
+    user: admin
+users:
+- name: admin
+  user:
+    exec:
+      apiVersion: client.authentication.k8s.io/v1
+      command: get-token
+`
+
+	var (
+		ctx      context.Context
+		now      time.Time
+		requests int
+		cache    *serviceAccountKubeconfigCache
+	)
+
+	BeforeEach(func() {
+		ctx = context.Background()
+		now = time.Now()
+		requests = 0
+		cache = newServiceAccountKubeconfigCache()
+		cache.now = func() time.Time { return now }
+		cache.request = func(_ context.Context, _ []byte, config *targettypes.ServiceAccountTokenConfig, expirationSeconds int64) (string, time.Time, error) {
+			requests++
+			return config.Namespace + "-" + config.Name, now.Add(time.Duration(expirationSeconds) * time.Second), nil
+		}
+	})
+
+	It("should build a kubeconfig that only contains the token of the service account", func() {
+		config := &targettypes.ServiceAccountTokenConfig{
+			Name:      "deployer",
+			Namespace: "kube-system",
+		}
+
+		tokenKubeconfig, err := cache.Get(ctx, []byte(kubeconfig), config)
+		Expect(err).ToNot(HaveOccurred())
+
+		restConfig, err := clientcmd.RESTConfigFromKubeConfig(tokenKubeconfig)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(restConfig.Host).To(Equal("https://api.example.com"))
+		Expect(restConfig.CAData).To(Equal([]byte("ca")))
+		Expect(restConfig.BearerToken).To(Equal("kube-system-deployer"))
+		Expect(restConfig.ExecProvider).To(BeNil())
+	})
+
+	It("should reuse a kubeconfig until half of the validity of its token has passed", func() {
+		config := &targettypes.ServiceAccountTokenConfig{
+			Name:              "deployer",
+			Namespace:         "kube-system",
+			ExpirationSeconds: ptr.To[int64](600),
+		}
+
+		_, err := cache.Get(ctx, []byte(kubeconfig), config)
+		Expect(err).ToNot(HaveOccurred())
+
+		now = now.Add(4 * time.Minute)
+		_, err = cache.Get(ctx, []byte(kubeconfig), config)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(requests).To(Equal(1))
+
+		now = now.Add(2 * time.Minute)
+		_, err = cache.Get(ctx, []byte(kubeconfig), config)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(requests).To(Equal(2))
+
+		_, err = cache.Get(ctx, []byte(kubeconfig), &targettypes.ServiceAccountTokenConfig{
+			Name:      "deployer",
+			Namespace: "kube-system",
+			Audiences: []string{"other"},
+		})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(requests).To(Equal(3))
+	})
+
+	It("should parse the service account token configuration of a target", func() {
+		targetConfig := &targettypes.KubernetesClusterTargetConfig{}
+		Expect(targetConfig.UnmarshalJSON([]byte(`{"kubeconfig": "abc", "serviceAccountToken": {"name": "deployer", "namespace": "kube-system"}}`))).To(Succeed())
+		Expect(*targetConfig.Kubeconfig.StrVal).To(Equal("abc"))
+		Expect(targetConfig.ServiceAccountToken).To(Equal(&targettypes.ServiceAccountTokenConfig{
+			Name:      "deployer",
+			Namespace: "kube-system",
+		}))
+	})
+})
@@ -0,0 +1,181 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package lib
+
+import (
+	"context"
+
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	lsv1alpha1 "github.com/gardener/landscaper/apis/core/v1alpha1"
+	lsv1alpha1helper "github.com/gardener/landscaper/apis/core/v1alpha1/helper"
+	lserrors "github.com/gardener/landscaper/apis/errors"
+	"github.com/gardener/landscaper/controller-utils/pkg/logging"
+	"github.com/gardener/landscaper/pkg/deployer/lib/extension"
+	"github.com/gardener/landscaper/pkg/deployer/lib/timeout"
+	"github.com/gardener/landscaper/pkg/utils/read_write_layer"
+)
+
+const (
+	TimeoutCheckpointSimpleDeployerStartReconcile = "simple deployer: start reconcile"
+	TimeoutCheckpointSimpleDeployerBeforeExport   = "simple deployer: before export"
+)
+
+// SimpleDeployer is a small interface to build custom deployers on top of the generic deployer controller.
+// In contrast to the Deployer interface, an implementation only has to deal with its own resources.
+// The finalizer of the deploy item, its phase, the operation annotations, the progressing timeout,
+// the provider status and the exports are handled by the deployer library.
+// Use NewSimpleDeployer to get a Deployer that can be added with Add.
+type SimpleDeployer interface {
+	// Reconcile creates or updates the resources of a deploy item.
+	Reconcile(ctx context.Context, req *DeployRequest) (*DeployResult, error)
+	// ForceReconcile creates or updates the resources of a deploy item, which has the force-reconcile operation
+	// annotation. An implementation should recreate resources that cannot be updated, and must not skip any work
+	// because the deploy item seems to be unchanged.
+	ForceReconcile(ctx context.Context, req *DeployRequest) (*DeployResult, error)
+	// Delete removes the resources of a deploy item.
+	// The finalizer of the deploy item is removed as soon as Delete returns without error.
+	// While resources are still being deleted, an error should be returned, so that the deletion is retried.
+	Delete(ctx context.Context, req *DeployRequest) error
+	// Abort stops the ongoing work for a deploy item, e.g. by cancelling a running job.
+	// The deploy item is set to failed afterwards, if it is not yet finished.
+	Abort(ctx context.Context, req *DeployRequest) error
+}
+
+// DeployRequest describes a deploy item that is processed by a SimpleDeployer.
+type DeployRequest struct {
+	// LsContext is the landscaper context of the deploy item.
+	LsContext *lsv1alpha1.Context
+	// DeployItem is the processed deploy item. Its status is managed by the deployer library.
+	DeployItem *lsv1alpha1.DeployItem
+	// Target is the resolved target of the deploy item. It is nil if the deploy item has no target.
+	Target *lsv1alpha1.ResolvedTarget
+}
+
+// DeployResult is the result of a reconcile of a SimpleDeployer.
+type DeployResult struct {
+	// Pending defines that the resources of the deploy item are not yet ready.
+	// The deploy item remains in phase Progressing and is reconciled again, until the progressing timeout is exceeded.
+	Pending bool
+	// ProviderStatus is the deployer specific status, which is written to the status of the deploy item.
+	// It must be registered in the scheme of the deployer.
+	ProviderStatus runtime.Object
+	// Exports are the values exported by the deploy item. They are only written if the deploy item is not pending.
+	Exports map[string]interface{}
+}
+
+// SimpleDeployerOptions defines optional settings of a SimpleDeployer.
+type SimpleDeployerOptions struct {
+	// Scheme is the scheme that is used to encode the provider status. Defaults to the scheme of the landscaper client.
+	Scheme *runtime.Scheme
+	// Hooks are the extension hooks of the deployer.
+	Hooks extension.ReconcileExtensionHooks
+}
+
+// simpleDeployer adapts a SimpleDeployer to the Deployer interface of the generic deployer controller.
+type simpleDeployer struct {
+	lsUncachedClient client.Client
+	scheme           *runtime.Scheme
+	hooks            extension.ReconcileExtensionHooks
+	deployer         SimpleDeployer
+}
+
+var _ Deployer = &simpleDeployer{}
+
+// NewSimpleDeployer creates a Deployer that delegates the deployer specific work to the given SimpleDeployer.
+func NewSimpleDeployer(lsUncachedClient client.Client, deployer SimpleDeployer, opts SimpleDeployerOptions) Deployer {
+	if opts.Scheme == nil {
+		opts.Scheme = lsUncachedClient.Scheme()
+	}
+	if opts.Hooks == nil {
+		opts.Hooks = extension.ReconcileExtensionHooks{}
+	}
+	return &simpleDeployer{
+		lsUncachedClient: lsUncachedClient,
+		scheme:           opts.Scheme,
+		hooks:            opts.Hooks,
+		deployer:         deployer,
+	}
+}
+
+func (d *simpleDeployer) Reconcile(ctx context.Context, lsCtx *lsv1alpha1.Context, di *lsv1alpha1.DeployItem, rt *lsv1alpha1.ResolvedTarget) error {
+	op := "SimpleDeployerReconcile"
+	logger, ctx := logging.FromContextOrNew(ctx, nil)
+
+	if _, err := timeout.TimeoutExceeded(ctx, di, TimeoutCheckpointSimpleDeployerStartReconcile); err != nil {
+		return err
+	}
+
+	di.Status.Phase = lsv1alpha1.DeployItemPhases.Progressing
+	req := &DeployRequest{LsContext: lsCtx, DeployItem: di, Target: rt}
+
+	var (
+		res *DeployResult
+		err error
+	)
+	if lsv1alpha1helper.HasOperation(di.ObjectMeta, lsv1alpha1.ForceReconcileOperation) {
+		logger.Info("force-reconciling deploy item")
+		res, err = d.deployer.ForceReconcile(ctx, req)
+		if err == nil {
+			err = d.removeForceReconcileAnnotation(ctx, di)
+		}
+	} else {
+		res, err = d.deployer.Reconcile(ctx, req)
+	}
+	if err != nil {
+		return err
+	}
+	if res == nil {
+		res = &DeployResult{}
+	}
+
+	if res.ProviderStatus != nil {
+		if err := SetProviderStatus(di, res.ProviderStatus, d.scheme); err != nil {
+			return lserrors.NewWrappedError(err, op, "ProviderStatus", err.Error())
+		}
+	}
+
+	if res.Pending {
+		logger.Debug("resources of deploy item are not yet ready")
+		return nil
+	}
+
+	if res.Exports != nil {
+		if _, err := timeout.TimeoutExceeded(ctx, di, TimeoutCheckpointSimpleDeployerBeforeExport); err != nil {
+			return err
+		}
+		if err := CreateOrUpdateExport(ctx, read_write_layer.NewWriter(d.lsUncachedClient), d.lsUncachedClient, di, res.Exports); err != nil {
+			return err
+		}
+	}
+
+	di.Status.Phase = lsv1alpha1.DeployItemPhases.Succeeded
+	return nil
+}
+
+func (d *simpleDeployer) Delete(ctx context.Context, lsCtx *lsv1alpha1.Context, di *lsv1alpha1.DeployItem, rt *lsv1alpha1.ResolvedTarget) error {
+	return d.deployer.Delete(ctx, &DeployRequest{LsContext: lsCtx, DeployItem: di, Target: rt})
+}
+
+func (d *simpleDeployer) Abort(ctx context.Context, lsCtx *lsv1alpha1.Context, di *lsv1alpha1.DeployItem, rt *lsv1alpha1.ResolvedTarget) error {
+	return d.deployer.Abort(ctx, &DeployRequest{LsContext: lsCtx, DeployItem: di, Target: rt})
+}
+
+func (d *simpleDeployer) ExtensionHooks() extension.ReconcileExtensionHooks {
+	return d.hooks
+}
+
+// removeForceReconcileAnnotation removes the force-reconcile annotation from the deploy item.
+// The status of the deploy item is kept, as it is written by the generic deployer controller at the end of the reconcile.
+func (d *simpleDeployer) removeForceReconcileAnnotation(ctx context.Context, di *lsv1alpha1.DeployItem) error {
+	status := di.Status.DeepCopy()
+	delete(di.Annotations, lsv1alpha1.OperationAnnotation)
+	if err := read_write_layer.NewWriter(d.lsUncachedClient).UpdateDeployItem(ctx, read_write_layer.W000175, di); err != nil {
+		return lserrors.NewWrappedError(err, "SimpleDeployerReconcile", "RemoveForceReconcileAnnotation", err.Error())
+	}
+	di.Status = *status
+	return nil
+}
@@ -0,0 +1,127 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package lib
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	lsv1alpha1 "github.com/gardener/landscaper/apis/core/v1alpha1"
+	"github.com/gardener/landscaper/pkg/api"
+)
+
+type testSimpleDeployer struct {
+	result          *DeployResult
+	err             error
+	reconciles      int
+	forceReconciles int
+}
+
+func (d *testSimpleDeployer) Reconcile(_ context.Context, _ *DeployRequest) (*DeployResult, error) {
+	d.reconciles++
+	return d.result, d.err
+}
+
+func (d *testSimpleDeployer) ForceReconcile(_ context.Context, _ *DeployRequest) (*DeployResult, error) {
+	d.forceReconciles++
+	return d.result, d.err
+}
+
+func (d *testSimpleDeployer) Delete(_ context.Context, _ *DeployRequest) error {
+	return nil
+}
+
+func (d *testSimpleDeployer) Abort(_ context.Context, _ *DeployRequest) error {
+	return nil
+}
+
+var _ = Describe("SimpleDeployer", func() {
+
+	var (
+		ctx      context.Context
+		lsClient client.Client
+		di       *lsv1alpha1.DeployItem
+		impl     *testSimpleDeployer
+		deployer Deployer
+	)
+
+	BeforeEach(func() {
+		ctx = context.Background()
+		now := metav1.NewTime(time.Now())
+		di = &lsv1alpha1.DeployItem{}
+		di.Name = "test"
+		di.Namespace = "default"
+		di.Status.Phase = lsv1alpha1.DeployItemPhases.Init
+		di.Status.TransitionTimes = &lsv1alpha1.TransitionTimes{InitTime: &now}
+
+		lsClient = fake.NewClientBuilder().WithScheme(api.LandscaperScheme).
+			WithStatusSubresource(&lsv1alpha1.DeployItem{}).
+			WithObjects(di).
+			Build()
+		Expect(lsClient.Get(ctx, client.ObjectKeyFromObject(di), di)).To(Succeed())
+
+		impl = &testSimpleDeployer{}
+		deployer = NewSimpleDeployer(lsClient, impl, SimpleDeployerOptions{})
+	})
+
+	It("should set the deploy item to succeeded and write the exports", func() {
+		impl.result = &DeployResult{Exports: map[string]interface{}{"key": "value"}}
+
+		Expect(deployer.Reconcile(ctx, nil, di, nil)).To(Succeed())
+		Expect(impl.reconciles).To(Equal(1))
+		Expect(di.Status.Phase).To(Equal(lsv1alpha1.DeployItemPhases.Succeeded))
+		Expect(di.Status.ExportReference).ToNot(BeNil())
+
+		secret := &corev1.Secret{}
+		Expect(lsClient.Get(ctx, client.ObjectKey{Name: di.Status.ExportReference.Name, Namespace: di.Status.ExportReference.Namespace}, secret)).To(Succeed())
+		Expect(secret.Data).To(HaveKeyWithValue(lsv1alpha1.DataObjectSecretDataKey, []byte(`{"key":"value"}`)))
+	})
+
+	It("should keep a pending deploy item in phase progressing without writing exports", func() {
+		impl.result = &DeployResult{Pending: true, Exports: map[string]interface{}{"key": "value"}}
+
+		Expect(deployer.Reconcile(ctx, nil, di, nil)).To(Succeed())
+		Expect(di.Status.Phase).To(Equal(lsv1alpha1.DeployItemPhases.Progressing))
+		Expect(di.Status.ExportReference).To(BeNil())
+	})
+
+	It("should return the error of the deployer", func() {
+		impl.err = errors.New("failed")
+
+		Expect(deployer.Reconcile(ctx, nil, di, nil)).To(MatchError("failed"))
+		Expect(di.Status.Phase).To(Equal(lsv1alpha1.DeployItemPhases.Progressing))
+	})
+
+	It("should force-reconcile a deploy item with the force-reconcile annotation and remove the annotation", func() {
+		metav1.SetMetaDataAnnotation(&di.ObjectMeta, lsv1alpha1.OperationAnnotation, string(lsv1alpha1.ForceReconcileOperation))
+		Expect(lsClient.Update(ctx, di)).To(Succeed())
+
+		Expect(deployer.Reconcile(ctx, nil, di, nil)).To(Succeed())
+		Expect(impl.forceReconciles).To(Equal(1))
+		Expect(impl.reconciles).To(Equal(0))
+		Expect(di.Status.Phase).To(Equal(lsv1alpha1.DeployItemPhases.Succeeded))
+
+		Expect(lsClient.Get(ctx, client.ObjectKeyFromObject(di), di)).To(Succeed())
+		Expect(di.Annotations).ToNot(HaveKey(lsv1alpha1.OperationAnnotation))
+	})
+
+	It("should fail if the progressing timeout is exceeded", func() {
+		initTime := metav1.NewTime(time.Now().Add(-time.Hour))
+		di.Status.TransitionTimes.InitTime = &initTime
+
+		err := deployer.Reconcile(ctx, nil, di, nil)
+		Expect(err).To(HaveOccurred())
+		Expect(err.Error()).To(ContainSubstring(TimeoutCheckpointSimpleDeployerStartReconcile))
+		Expect(impl.reconciles).To(Equal(0))
+	})
+})
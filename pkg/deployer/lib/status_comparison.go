@@ -0,0 +1,118 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package lib
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"sort"
+
+	apiequality "k8s.io/apimachinery/pkg/api/equality"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	lsv1alpha1 "github.com/gardener/landscaper/apis/core/v1alpha1"
+)
+
+// StatusComparison defines how a deployer decides whether the status of a deploy item has changed
+// and has to be written.
+type StatusComparison string
+
+const (
+	// StatusComparisonSemantic writes the status only if it has changed semantically.
+	// The order of conditions and error codes, nil and empty values, the formatting of the provider status
+	// and changes that only touch the last update timestamps are ignored.
+	StatusComparisonSemantic StatusComparison = "Semantic"
+	// StatusComparisonStrict writes the status if it differs in any way.
+	StatusComparisonStrict StatusComparison = "Strict"
+)
+
+var defaultStatusComparison = StatusComparisonSemantic
+
+// SetDefaultStatusComparison sets the status comparison of the deployers of the process that do not define one
+// in their deployer arguments. It is configured with the flag "--status-comparison" of the deployers.
+func SetDefaultStatusComparison(comparison StatusComparison) error {
+	if err := comparison.Validate(); err != nil {
+		return err
+	}
+	if len(comparison) == 0 {
+		comparison = StatusComparisonSemantic
+	}
+	defaultStatusComparison = comparison
+	return nil
+}
+
+// Validate returns an error for unknown status comparisons. An empty comparison is valid.
+func (c StatusComparison) Validate() error {
+	switch c {
+	case "", StatusComparisonSemantic, StatusComparisonStrict:
+		return nil
+	default:
+		return fmt.Errorf("unknown status comparison %q, expected %q or %q", c, StatusComparisonSemantic, StatusComparisonStrict)
+	}
+}
+
+// DeployItemStatusEqual reports whether two deploy item statuses are equal according to the given comparison.
+// The semantic comparison is used if no comparison is given.
+func DeployItemStatusEqual(comparison StatusComparison, a, b *lsv1alpha1.DeployItemStatus) bool {
+	if comparison == StatusComparisonStrict {
+		return reflect.DeepEqual(a, b)
+	}
+	if a == nil || b == nil {
+		return a == b
+	}
+	return apiequality.Semantic.DeepEqual(normalizeDeployItemStatus(a), normalizeDeployItemStatus(b))
+}
+
+// normalizeDeployItemStatus returns a copy of the status without the differences that are ignored
+// by the semantic comparison.
+func normalizeDeployItemStatus(status *lsv1alpha1.DeployItemStatus) *lsv1alpha1.DeployItemStatus {
+	normalized := status.DeepCopy()
+
+	for i := range normalized.Conditions {
+		normalized.Conditions[i].LastUpdateTime = metav1.Time{}
+		sortErrorCodes(normalized.Conditions[i].Codes)
+	}
+	sort.SliceStable(normalized.Conditions, func(i, j int) bool {
+		return normalized.Conditions[i].Type < normalized.Conditions[j].Type
+	})
+
+	normalizeError(normalized.LastError)
+	normalizeError(normalized.FirstError)
+	for _, err := range normalized.LastErrors {
+		normalizeError(err)
+	}
+
+	if normalized.Progress != nil {
+		normalized.Progress.LastUpdateTime = metav1.Time{}
+	}
+
+	if normalized.ProviderStatus != nil && len(normalized.ProviderStatus.Raw) != 0 {
+		var providerStatus interface{}
+		if err := json.Unmarshal(normalized.ProviderStatus.Raw, &providerStatus); err == nil {
+			// compare the decoded provider status, so that the key order and whitespace do not matter
+			if raw, err := json.Marshal(providerStatus); err == nil {
+				normalized.ProviderStatus.Raw = raw
+			}
+		}
+		normalized.ProviderStatus.Object = nil
+	}
+
+	return normalized
+}
+
+func normalizeError(err *lsv1alpha1.Error) {
+	if err == nil {
+		return
+	}
+	err.LastUpdateTime = metav1.Time{}
+	sortErrorCodes(err.Codes)
+}
+
+func sortErrorCodes(codes []lsv1alpha1.ErrorCode) {
+	sort.Slice(codes, func(i, j int) bool {
+		return codes[i] < codes[j]
+	})
+}
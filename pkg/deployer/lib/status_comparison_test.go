@@ -0,0 +1,91 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package lib
+
+import (
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	lsv1alpha1 "github.com/gardener/landscaper/apis/core/v1alpha1"
+)
+
+var _ = Describe("Status Comparison", func() {
+
+	var status *lsv1alpha1.DeployItemStatus
+
+	BeforeEach(func() {
+		now := metav1.Now()
+		status = &lsv1alpha1.DeployItemStatus{
+			Phase: lsv1alpha1.DeployItemPhases.Progressing,
+			Conditions: []lsv1alpha1.Condition{
+				{Type: "A", Status: lsv1alpha1.ConditionTrue, LastUpdateTime: now},
+				{Type: "B", Status: lsv1alpha1.ConditionFalse, LastUpdateTime: now},
+			},
+			LastError: &lsv1alpha1.Error{
+				Reason:         "ApplyFailed",
+				Codes:          []lsv1alpha1.ErrorCode{lsv1alpha1.ErrorWebhook, lsv1alpha1.ErrorForInfoOnly},
+				LastUpdateTime: now,
+			},
+			ProviderStatus: &runtime.RawExtension{Raw: []byte(`{"a": 1, "b": "x"}`)},
+		}
+	})
+
+	It("should ignore the order of conditions and codes, timestamp-only changes and the provider status format", func() {
+		later := metav1.NewTime(time.Now().Add(time.Minute))
+		changed := status.DeepCopy()
+		changed.Conditions[0], changed.Conditions[1] = changed.Conditions[1], changed.Conditions[0]
+		changed.Conditions[0].LastUpdateTime = later
+		changed.LastError.Codes = []lsv1alpha1.ErrorCode{lsv1alpha1.ErrorForInfoOnly, lsv1alpha1.ErrorWebhook}
+		changed.LastError.LastUpdateTime = later
+		changed.LastErrors = []*lsv1alpha1.Error{}
+		changed.ProviderStatus = &runtime.RawExtension{Raw: []byte(`{"b":"x","a":1}`)}
+
+		Expect(DeployItemStatusEqual(StatusComparisonSemantic, status, changed)).To(BeTrue())
+		Expect(DeployItemStatusEqual("", status, changed)).To(BeTrue())
+		Expect(DeployItemStatusEqual(StatusComparisonStrict, status, changed)).To(BeFalse())
+
+		Expect(status.Conditions[0].Type).To(Equal(lsv1alpha1.ConditionType("A")))
+	})
+
+	It("should detect semantic changes", func() {
+		changed := status.DeepCopy()
+		changed.Conditions[1].Status = lsv1alpha1.ConditionTrue
+		Expect(DeployItemStatusEqual(StatusComparisonSemantic, status, changed)).To(BeFalse())
+
+		changed = status.DeepCopy()
+		changed.LastError.Codes = []lsv1alpha1.ErrorCode{lsv1alpha1.ErrorWebhook}
+		Expect(DeployItemStatusEqual(StatusComparisonSemantic, status, changed)).To(BeFalse())
+
+		changed = status.DeepCopy()
+		changed.ProviderStatus = &runtime.RawExtension{Raw: []byte(`{"a": 2, "b": "x"}`)}
+		Expect(DeployItemStatusEqual(StatusComparisonSemantic, status, changed)).To(BeFalse())
+
+		changed = status.DeepCopy()
+		changed.Phase = lsv1alpha1.DeployItemPhases.Succeeded
+		Expect(DeployItemStatusEqual(StatusComparisonSemantic, status, changed)).To(BeFalse())
+	})
+
+	It("should default the status comparison of the deployer arguments to the configured comparison", func() {
+		defer func() {
+			Expect(SetDefaultStatusComparison("")).To(Succeed())
+		}()
+
+		args := DeployerArgs{}
+		args.Default()
+		Expect(args.StatusComparison).To(Equal(StatusComparisonSemantic))
+
+		Expect(SetDefaultStatusComparison(StatusComparisonStrict)).To(Succeed())
+		args = DeployerArgs{}
+		args.Default()
+		Expect(args.StatusComparison).To(Equal(StatusComparisonStrict))
+
+		Expect(SetDefaultStatusComparison("Unknown")).ToNot(Succeed())
+		Expect(DeployerArgs{StatusComparison: "Unknown"}.Validate()).To(HaveOccurred())
+	})
+})
@@ -0,0 +1,125 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package lib
+
+import (
+	"context"
+	"errors"
+
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/rest"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	lsconfigv1alpha1 "github.com/gardener/landscaper/apis/config/v1alpha1"
+	lsv1alpha1 "github.com/gardener/landscaper/apis/core/v1alpha1"
+	"github.com/gardener/landscaper/pkg/utils"
+)
+
+// TargetClients contains the clients for the cluster of a target.
+type TargetClients struct {
+	RestConfig *rest.Config
+	Client     client.Client
+	ClientSet  kubernetes.Interface
+}
+
+// NewTargetClients creates the clients for the cluster of a resolved target.
+// Deployers should use these clients instead of handling the credentials of the target themselves.
+// The credentials are only kept in memory: the kubeconfig is never written to disk,
+// and it is zeroized as soon as the clients have been constructed.
+func NewTargetClients(ctx context.Context, target *lsv1alpha1.ResolvedTarget, lsClient client.Client,
+	config *lsconfigv1alpha1.TargetClientConfig) (*TargetClients, error) {
+
+	if target == nil {
+		return nil, errors.New("no target defined")
+	}
+
+	if IsAgentTarget(target.Target) {
+		restConfig, err := getAgentRestConfig(target)
+		if err != nil {
+			return nil, err
+		}
+		return newTargetClientsFromRestConfig(restConfig, config)
+	}
+
+	kubeconfig, err := GetKubeconfigFromTarget(ctx, target, lsClient)
+	if err != nil {
+		return nil, err
+	}
+	return NewTargetClientsFromKubeconfig(kubeconfig, config)
+}
+
+// NewTargetClientsFromKubeconfig creates the clients for the cluster of a kubeconfig.
+// The kubeconfig is zeroized afterwards, so that the caller must not use it anymore.
+func NewTargetClientsFromKubeconfig(kubeconfig []byte, config *lsconfigv1alpha1.TargetClientConfig) (*TargetClients, error) {
+	defer Zeroize(kubeconfig)
+
+	restConfig, err := RestConfigFromKubeconfig(kubeconfig)
+	if err != nil {
+		return nil, err
+	}
+	return newTargetClientsFromRestConfig(restConfig, config)
+}
+
+func newTargetClientsFromRestConfig(restConfig *rest.Config, config *lsconfigv1alpha1.TargetClientConfig) (*TargetClients, error) {
+	SetTargetClientRateLimits(restConfig, config)
+
+	kubeClient, err := client.New(restConfig, client.Options{})
+	if err != nil {
+		return nil, err
+	}
+	clientset, err := kubernetes.NewForConfig(restConfig)
+	if err != nil {
+		return nil, err
+	}
+
+	return &TargetClients{
+		RestConfig: restConfig,
+		Client:     kubeClient,
+		ClientSet:  clientset,
+	}, nil
+}
+
+// RestConfigFromKubeconfig creates a rest config from a kubeconfig that is only kept in memory.
+// Kubeconfigs that reference local files or credential plugins are rejected if this is enabled
+// in the target client configuration, see SetKubeconfigPolicy.
+func RestConfigFromKubeconfig(kubeconfig []byte) (*rest.Config, error) {
+	return utils.RestConfigFromKubeconfig(kubeconfig)
+}
+
+// SetKubeconfigPolicy applies the kubeconfig policy of the target client configuration of a deployer.
+// If RejectLocalCredentials is set, kubeconfigs of targets that reference local files or credential plugins are rejected,
+// as they would give targets access to the files and executables of the deployer.
+func SetKubeconfigPolicy(config *lsconfigv1alpha1.TargetClientConfig) {
+	utils.SetRejectLocalKubeconfigCredentials(config != nil && config.RejectLocalCredentials)
+}
+
+// Zeroize overwrites credentials with zeros, so that they do not remain in memory after use.
+func Zeroize(data []byte) {
+	utils.ZeroizeKubeconfig(data)
+}
+
+// SetTargetClientRateLimits overwrites the client side rate limits of a target cluster rest config
+// with the values of the target client configuration of a deployer.
+// The defaults of the kubernetes client are kept for all values that are not configured.
+func SetTargetClientRateLimits(restConfig *rest.Config, config *lsconfigv1alpha1.TargetClientConfig) {
+	if restConfig == nil || config == nil {
+		return
+	}
+	if config.QPS > 0 {
+		restConfig.QPS = float32(config.QPS)
+	}
+	if config.Burst > 0 {
+		restConfig.Burst = config.Burst
+	}
+}
+
+// GetApplyBatchSize returns the number of manifests that should be applied concurrently.
+// Zero is returned if the manifests should not be applied in batches.
+func GetApplyBatchSize(config *lsconfigv1alpha1.TargetClientConfig) int {
+	if config == nil || config.ApplyBatchSize < 0 {
+		return 0
+	}
+	return config.ApplyBatchSize
+}
@@ -0,0 +1,70 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package lib
+
+import (
+	"fmt"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	lsconfigv1alpha1 "github.com/gardener/landscaper/apis/config/v1alpha1"
+)
+
+var _ = Describe("Target Clients", func() {
+
+	kubeconfig := func(user string) []byte {
+		return []byte(fmt.Sprintf(`apiVersion: v1
+kind: Config
+current-context: default
+clusters:
+- name: default
+  cluster:
+    server: https://127.0.0.1:6443
+    insecure-skip-tls-verify: true
+contexts:
+- name: default
+  context:
+    cluster: default
+    user: default
+users:
+- name: default
+  user:
+%s
+`, user))
+	}
+
+	It("should create a rest config from a kubeconfig with inline credentials", func() {
+		restConfig, err := RestConfigFromKubeconfig(kubeconfig("    token: my-token"))
+		Expect(err).ToNot(HaveOccurred())
+		Expect(restConfig.Host).To(Equal("https://127.0.0.1:6443"))
+		Expect(restConfig.BearerToken).To(Equal("my-token"))
+	})
+
+	It("should reject kubeconfigs that reference local files or use credential plugins if configured", func() {
+		defer SetKubeconfigPolicy(nil)
+
+		user := "    exec:\n      apiVersion: client.authentication.k8s.io/v1\n      command: my-plugin\n      interactiveMode: Never"
+		_, err := RestConfigFromKubeconfig(kubeconfig(user))
+		Expect(err).ToNot(HaveOccurred())
+
+		SetKubeconfigPolicy(&lsconfigv1alpha1.TargetClientConfig{RejectLocalCredentials: true})
+		_, err = RestConfigFromKubeconfig(kubeconfig(user))
+		Expect(err).To(HaveOccurred())
+		_, err = RestConfigFromKubeconfig(kubeconfig("    tokenFile: /var/run/secrets/kubernetes.io/serviceaccount/token"))
+		Expect(err).To(HaveOccurred())
+	})
+
+	It("should create the clients and zeroize the kubeconfig", func() {
+		data := kubeconfig("    token: my-token")
+
+		targetClients, err := NewTargetClientsFromKubeconfig(data, &lsconfigv1alpha1.TargetClientConfig{QPS: 50, Burst: 100})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(targetClients.Client).ToNot(BeNil())
+		Expect(targetClients.ClientSet).ToNot(BeNil())
+		Expect(targetClients.RestConfig.QPS).To(BeNumerically("==", 50))
+		Expect(data).To(HaveEach(byte(0)))
+	})
+})
@@ -16,6 +16,7 @@ const (
 
 // standardTimeoutChecker is a TimeoutChecker which raises a timeout error if it takes more time to process a DeployItem
 // than the default timeout, resp. the timeout specified in the DeployItem (spec.timeout).
+// A timeout of zero, i.e. "none", deactivates the check.
 // The processing time starts with the Init phase (status.transitionTimes.initTime) of the DeployItem.
 type standardTimeoutChecker struct{}
 
@@ -40,6 +41,10 @@ func (t *standardTimeoutChecker) TimeoutExceeded(ctx context.Context, deployItem
 	}
 
 	timeout := t.getTimeout(deployItem)
+	if timeout.Duration == 0 {
+		// the progressing timeout is deactivated
+		return defaultTimeout, nil
+	}
 
 	currentTime := time.Now()
 	endTime := deployItem.Status.TransitionTimes.InitTime.Time.Add(timeout.Duration)
@@ -75,5 +75,12 @@ var _ = Describe("Timeout checker", func() {
 			Expect(err.LandscaperError()).NotTo(BeNil())
 			Expect(err.LandscaperError().Codes).To(ContainElement(lsv1alpha1.ErrorTimeout))
 		})
+
+		It("should not detect a timeout if the timeout is deactivated", func() {
+			deployItem := buildDeployItemWithTimeoutData(time.Now().Add(-2*time.Minute), 0)
+			remainingDuration, err := TimeoutExceeded(context.Background(), deployItem, "")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(remainingDuration).To(Equal(defaultTimeout))
+		})
 	})
 })
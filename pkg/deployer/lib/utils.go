@@ -9,7 +9,6 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
-	"reflect"
 
 	corev1 "k8s.io/api/core/v1"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
@@ -31,6 +30,7 @@ import (
 	"github.com/gardener/landscaper/pkg/api"
 	"github.com/gardener/landscaper/pkg/deployer/lib/targetselector"
 	lsutil "github.com/gardener/landscaper/pkg/utils"
+	"github.com/gardener/landscaper/pkg/utils/events"
 	"github.com/gardener/landscaper/pkg/utils/read_write_layer"
 )
 
@@ -144,9 +144,21 @@ func GetRegistryPullSecretsFromContext(lsCtx *lsv1alpha1.Context) []lsv1alpha1.O
 	return refs
 }
 
+// HandleReconcileResult sets the error and the phase of the deploy item after a reconcile and writes its status
+// if it has changed semantically.
 func HandleReconcileResult(ctx context.Context, err lserrors.LsError, oldDeployItem, deployItem *lsv1alpha1.DeployItem,
 	lsClient client.Client, lsEventRecorder record.EventRecorder, finishedObjectCache *lsutil.FinishedObjectCache) error {
 
+	return HandleReconcileResultWithStatusComparison(ctx, err, oldDeployItem, deployItem, lsClient, lsEventRecorder,
+		finishedObjectCache, StatusComparisonSemantic)
+}
+
+// HandleReconcileResultWithStatusComparison is like HandleReconcileResult, but decides with the given comparison
+// whether the status has changed.
+func HandleReconcileResultWithStatusComparison(ctx context.Context, err lserrors.LsError, oldDeployItem, deployItem *lsv1alpha1.DeployItem,
+	lsClient client.Client, lsEventRecorder record.EventRecorder, finishedObjectCache *lsutil.FinishedObjectCache,
+	statusComparison StatusComparison) error {
+
 	logger, ctx := logging.FromContextOrNew(ctx, nil)
 	lsutil.SetLastError(&deployItem.Status, lserrors.TryUpdateLsError(deployItem.Status.GetLastError(), err))
 
@@ -155,8 +167,7 @@ func HandleReconcileResult(ctx context.Context, err lserrors.LsError, oldDeployI
 			lsv1alpha1helper.SetDeployItemToFailed(deployItem)
 		}
 
-		lastErr := deployItem.Status.GetLastError()
-		lsEventRecorder.Event(deployItem, corev1.EventTypeWarning, lastErr.Reason, lastErr.Message)
+		events.Error(lsEventRecorder, deployItem, events.ReasonDeployItemFailed, deployItem.Status.GetLastError())
 	}
 
 	// if a reconciliation ends in a final phase, the current job is done
@@ -165,8 +176,25 @@ func HandleReconcileResult(ctx context.Context, err lserrors.LsError, oldDeployI
 		deployItem.Status.TransitionTimes = lsutil.SetFinishedTransitionTime(deployItem.Status.TransitionTimes)
 	}
 
-	if !reflect.DeepEqual(&oldDeployItem.Status, &deployItem.Status) {
-		if err2 := read_write_layer.NewWriter(lsClient).UpdateDeployItemStatus(ctx, read_write_layer.W000092, deployItem); err2 != nil {
+	if !DeployItemStatusEqual(statusComparison, &oldDeployItem.Status, &deployItem.Status) {
+		// After a conflict, e.g. because the deploy item has been touched, the computed status is written to the latest
+		// version of the deploy item, unless its job has been replaced or finished in the meantime.
+		status := deployItem.Status.DeepCopy()
+		resourceVersion := deployItem.ResourceVersion
+		setStatus := func(latest *lsv1alpha1.DeployItem) error {
+			if latest.ResourceVersion == resourceVersion {
+				// the status has been computed for this version
+				return nil
+			}
+			if latest.Status.GetJobID() != status.GetJobID() || latest.Status.JobIDFinished != oldDeployItem.Status.JobIDFinished {
+				return read_write_layer.ErrPreconditionChanged
+			}
+			latest.Status = *status.DeepCopy()
+			return nil
+		}
+
+		if err2 := read_write_layer.NewWriter(lsClient).UpdateDeployItemStatusWithRetry(ctx, read_write_layer.W000092,
+			read_write_layer.R000171, deployItem, setStatus); err2 != nil {
 			if !deployItem.DeletionTimestamp.IsZero() {
 				// recheck if already deleted
 				diRecheck := &lsv1alpha1.DeployItem{}
@@ -177,7 +205,7 @@ func HandleReconcileResult(ctx context.Context, err lserrors.LsError, oldDeployI
 				}
 			}
 
-			if apierrors.IsConflict(err2) { // reduce logging
+			if apierrors.IsConflict(err2) || errors.Is(err2, read_write_layer.ErrPreconditionChanged) { // reduce logging
 				logger.Debug("Unable to update status", lc.KeyError, err2.Error())
 			} else {
 				logger.Error(err2, "Unable to update status")
@@ -260,7 +288,8 @@ func checkTargetResponsibility(ctx context.Context, lsClient client.Client,
 
 	if targetName == lsv1alpha1.NoTargetNameValue {
 		logger.Debug("No target defined")
-		return nil, true, false, nil
+		// an agent only executes the deploy items of its agent targets
+		return nil, GetAgent() == nil, false, nil
 	}
 
 	logger.Debug("Found target. Checking responsibility")
@@ -269,10 +298,19 @@ func checkTargetResponsibility(ctx context.Context, lsClient client.Client,
 		target, read_write_layer.R000051); err != nil {
 		lsError := lserrors.NewWrappedError(err, op, "FetchTarget", "unable to get target for deploy item - other error")
 		if apierrors.IsNotFound(err) {
-			return nil, true, true, nil
+			return nil, GetAgent() == nil, true, nil
 		}
 		return nil, false, false, lsError
 	}
+	responsible, err := IsResponsibleForTarget(target)
+	if err != nil {
+		lsError := lserrors.NewWrappedError(err, op, "CheckAgent", "unable to check the agent of the target")
+		return nil, false, false, lsError
+	}
+	if !responsible {
+		logger.Debug("The deployitem's target is executed by another agent or deployer", "target", target.Name)
+		return nil, false, false, nil
+	}
 	if len(targetSelectors) == 0 {
 		logger.Debug("No target selectors defined")
 		return target, true, false, nil
@@ -0,0 +1,78 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package lib
+
+import (
+	"context"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"k8s.io/client-go/tools/record"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	lsv1alpha1 "github.com/gardener/landscaper/apis/core/v1alpha1"
+	"github.com/gardener/landscaper/pkg/api"
+)
+
+var _ = Describe("Handle reconcile result", func() {
+
+	var (
+		ctx      context.Context
+		lsClient client.Client
+	)
+
+	BeforeEach(func() {
+		ctx = context.Background()
+		di := &lsv1alpha1.DeployItem{}
+		di.Name = "a"
+		di.Namespace = "test"
+		di.Status.SetJobID("job-1")
+		lsClient = fake.NewClientBuilder().WithScheme(api.LandscaperScheme).
+			WithObjects(di).WithStatusSubresource(di).Build()
+	})
+
+	getDeployItem := func() *lsv1alpha1.DeployItem {
+		di := &lsv1alpha1.DeployItem{}
+		Expect(lsClient.Get(ctx, client.ObjectKey{Name: "a", Namespace: "test"}, di)).To(Succeed())
+		return di
+	}
+
+	succeed := func(di *lsv1alpha1.DeployItem) (*lsv1alpha1.DeployItem, *lsv1alpha1.DeployItem) {
+		old := di.DeepCopy()
+		di.Status.Phase = lsv1alpha1.DeployItemPhases.Succeeded
+		return old, di
+	}
+
+	It("should write the status to the latest version of the deploy item after a conflict", func() {
+		old, di := succeed(getDeployItem())
+
+		touched := getDeployItem()
+		touched.Annotations = map[string]string{"touched": "true"}
+		Expect(lsClient.Update(ctx, touched)).To(Succeed())
+
+		Expect(HandleReconcileResult(ctx, nil, old, di, lsClient, record.NewFakeRecorder(10), nil)).To(Succeed())
+
+		latest := getDeployItem()
+		Expect(latest.Annotations).To(HaveKeyWithValue("touched", "true"))
+		Expect(latest.Status.Phase).To(Equal(lsv1alpha1.DeployItemPhases.Succeeded))
+		Expect(latest.Status.JobIDFinished).To(Equal("job-1"))
+	})
+
+	It("should not write the status if a new job has been started in the meantime", func() {
+		old, di := succeed(getDeployItem())
+
+		restarted := getDeployItem()
+		restarted.Status.SetJobID("job-2")
+		Expect(lsClient.Status().Update(ctx, restarted)).To(Succeed())
+
+		Expect(HandleReconcileResultWithStatusComparison(ctx, nil, old, di, lsClient, record.NewFakeRecorder(10), nil,
+			StatusComparisonStrict)).ToNot(Succeed())
+
+		latest := getDeployItem()
+		Expect(latest.Status.GetJobID()).To(Equal("job-2"))
+		Expect(latest.Status.Phase).To(BeEmpty())
+	})
+})
@@ -73,8 +73,9 @@ func (d deployer) Delete(ctx context.Context, _ *lsv1alpha1.Context, di *lsv1alp
 	return manifest.Delete(ctx)
 }
 
+// Abort has nothing to do, as the application of the manifests is already cancelled by the interruption checks
+// during the reconciliation of the deploy item.
 func (d *deployer) Abort(ctx context.Context, lsCtx *lsv1alpha1.Context, di *lsv1alpha1.DeployItem, rt *lsv1alpha1.ResolvedTarget) error {
-	d.log.Info("abort is not yet implemented")
 	return nil
 }
 
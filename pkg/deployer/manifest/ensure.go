@@ -58,6 +58,8 @@ func (m *Manifest) Reconcile(ctx context.Context) error {
 		}
 	}
 
+	// the progress of a previous reconciliation is outdated
+	m.ProviderStatus.ApplyProgress = nil
 	applier := resourcemanager.NewManifestApplier(resourcemanager.ManifestApplierOptions{
 		Decoder:          serializer.NewCodecFactory(Scheme).UniversalDecoder(),
 		KubeClient:       targetClient,
@@ -65,13 +67,19 @@ func (m *Manifest) Reconcile(ctx context.Context) error {
 		DeployItemName:   m.DeployItem.Name,
 		DeployItem:       m.DeployItem,
 		UpdateStrategy:   m.ProviderConfiguration.UpdateStrategy,
+		ServerSideApply:  m.ProviderConfiguration.ServerSideApply,
 		Manifests:        m.ProviderConfiguration.Manifests,
 		ManagedResources: m.ProviderStatus.ManagedResources,
-		Labels: map[string]string{
+		Labels: deployerlib.ManagedByLabels(map[string]string{
 			manifestv1alpha2.ManagedDeployItemLabel: m.DeployItem.Name,
-		},
+		}, m.targetClientConfig()),
+		Annotations:                deployerlib.ManagedByAnnotations(m.DeployItem),
 		DeletionGroupsDuringUpdate: m.ProviderConfiguration.DeletionGroupsDuringUpdate,
 		InterruptionChecker:        interruption.NewStandardInterruptionChecker(m.DeployItem, m.lsUncachedClient),
+		BatchSize:                  deployerlib.GetApplyBatchSize(m.targetClientConfig()),
+		ProgressCheckpoint:         m.checkpointApplyProgress,
+		CheckResourceQuotas:        deployerlib.ResourceQuotaCheckEnabled(m.targetClientConfig()),
+		Pruning:                    m.ProviderConfiguration.Pruning,
 	})
 
 	err = applier.Apply(ctx)
@@ -130,8 +138,29 @@ func (m *Manifest) Reconcile(ctx context.Context) error {
 	return nil
 }
 
+// checkpointApplyProgress writes the progress of applying the manifests to the provider status of the deploy item.
+func (m *Manifest) checkpointApplyProgress(ctx context.Context, progress managedresource.ApplyProgress) {
+	logger, ctx := logging.FromContextOrNew(ctx, []interface{}{lc.KeyMethod, "checkpointApplyProgress"})
+
+	m.ProviderStatus.ApplyProgress = &progress
+	providerStatus, err := kutil.ConvertToRawExtension(m.ProviderStatus, Scheme)
+	if err != nil {
+		logger.Error(err, "unable to encode status")
+		return
+	}
+	m.DeployItem.Status.ProviderStatus = providerStatus
+	if progress.TotalManifests > 0 {
+		deployerlib.SetProgress(m.DeployItem, progress.AppliedManifests*100/progress.TotalManifests, "ApplyManifests",
+			fmt.Sprintf("%d of %d manifests applied", progress.AppliedManifests, progress.TotalManifests))
+	}
+	if err := m.Writer().UpdateDeployItemStatus(ctx, read_write_layer.W000150, m.DeployItem); err != nil {
+		logger.Error(err, "unable to write apply progress", "applied", progress.AppliedManifests, "total", progress.TotalManifests)
+	}
+}
+
 // CheckResourcesReady checks if the managed resources are Ready/Healthy.
 func (m *Manifest) CheckResourcesReady(ctx context.Context, client client.Client) error {
+	health.SetReadinessChecksRunning(m.DeployItem)
 
 	managedresources := m.ProviderStatus.ManagedResources.TypedObjectReferenceList()
 	if !m.ProviderConfiguration.ReadinessChecks.DisableDefault {
@@ -178,6 +207,7 @@ func (m *Manifest) CheckResourcesReady(ctx context.Context, client client.Client
 		}
 	}
 
+	health.SetReadinessChecksSucceeded(m.DeployItem, m.ProviderConfiguration.ReadinessChecks)
 	return nil
 }
 
@@ -8,12 +8,10 @@ import (
 	"context"
 	"encoding/base64"
 	"errors"
-	"fmt"
 
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/client-go/kubernetes"
 	"k8s.io/client-go/rest"
-	"k8s.io/client-go/tools/clientcmd"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	lserrors "github.com/gardener/landscaper/apis/errors"
@@ -22,10 +20,8 @@ import (
 
 	"github.com/gardener/landscaper/pkg/utils"
 
-	"k8s.io/apimachinery/pkg/util/yaml"
-
+	lsconfigv1alpha1 "github.com/gardener/landscaper/apis/config/v1alpha1"
 	lsv1alpha1 "github.com/gardener/landscaper/apis/core/v1alpha1"
-	"github.com/gardener/landscaper/apis/core/v1alpha1/targettypes"
 	manifestinstall "github.com/gardener/landscaper/apis/deployer/manifest/install"
 	manifestv1alpha2 "github.com/gardener/landscaper/apis/deployer/manifest/v1alpha2"
 
@@ -87,6 +83,14 @@ func New(lsUncachedClient client.Client, hostUncachedClient client.Client,
 			currOp, "ValidateProviderConfiguration", err.Error(), lsv1alpha1.ErrorConfigurationProblem)
 	}
 
+	// the resources of the presets are applied together with the manifests
+	presetManifests, err := RenderPresets(config.Presets)
+	if err != nil {
+		return nil, lserrors.NewWrappedError(err,
+			currOp, "RenderPresets", err.Error(), lsv1alpha1.ErrorConfigurationProblem)
+	}
+	config.Manifests = append(config.Manifests, presetManifests...)
+
 	var status *manifestv1alpha2.ProviderStatus
 	if item.Status.ProviderStatus != nil {
 		status = &manifestv1alpha2.ProviderStatus{}
@@ -111,68 +115,40 @@ func (m *Manifest) TargetClient(ctx context.Context) (*rest.Config, client.Clien
 	if m.TargetKubeClient != nil {
 		return m.TargetRestConfig, m.TargetKubeClient, m.TargetClientSet, nil
 	}
+
+	var (
+		targetClients *lib.TargetClients
+		err           error
+	)
 	// use the configured kubeconfig over the target if defined
 	if len(m.ProviderConfiguration.Kubeconfig) != 0 {
 		kubeconfig, err := base64.StdEncoding.DecodeString(m.ProviderConfiguration.Kubeconfig)
 		if err != nil {
 			return nil, nil, nil, err
 		}
-		cConfig, err := clientcmd.NewClientConfigFromBytes(kubeconfig)
+		targetClients, err = lib.NewTargetClientsFromKubeconfig(kubeconfig, m.targetClientConfig())
 		if err != nil {
 			return nil, nil, nil, err
 		}
-		restConfig, err := cConfig.ClientConfig()
-		if err != nil {
-			return nil, nil, nil, err
-		}
-
-		kubeClient, err := client.New(restConfig, client.Options{})
+	} else if m.Target != nil {
+		targetClients, err = lib.NewTargetClients(ctx, m.Target, m.lsUncachedClient, m.targetClientConfig())
 		if err != nil {
 			return nil, nil, nil, err
 		}
-
-		clientset, err := kubernetes.NewForConfig(restConfig)
-		if err != nil {
-			return nil, nil, nil, err
-		}
-
-		m.TargetRestConfig = restConfig
-		m.TargetKubeClient = kubeClient
-		return restConfig, kubeClient, clientset, nil
+	} else {
+		return nil, nil, nil, errors.New("neither a target nor kubeconfig are defined")
 	}
-	if m.Target != nil {
-		targetConfig := &targettypes.KubernetesClusterTargetConfig{}
-		if err := yaml.Unmarshal([]byte(m.Target.Content), targetConfig); err != nil {
-			return nil, nil, nil, fmt.Errorf("unable to parse target confíguration: %w", err)
-		}
 
-		kubeconfigBytes, err := lib.GetKubeconfigFromTargetConfig(ctx, targetConfig, m.Target.Namespace, m.lsUncachedClient)
-		if err != nil {
-			return nil, nil, nil, err
-		}
-
-		kubeconfig, err := clientcmd.NewClientConfigFromBytes(kubeconfigBytes)
-		if err != nil {
-			return nil, nil, nil, err
-		}
-		restConfig, err := kubeconfig.ClientConfig()
-		if err != nil {
-			return nil, nil, nil, err
-		}
-
-		kubeClient, err := client.New(restConfig, client.Options{})
-		if err != nil {
-			return nil, nil, nil, err
-		}
-		clientset, err := kubernetes.NewForConfig(restConfig)
-		if err != nil {
-			return nil, nil, nil, err
-		}
+	m.TargetRestConfig = targetClients.RestConfig
+	m.TargetKubeClient = targetClients.Client
+	m.TargetClientSet = targetClients.ClientSet
+	return targetClients.RestConfig, targetClients.Client, targetClients.ClientSet, nil
+}
 
-		m.TargetRestConfig = restConfig
-		m.TargetKubeClient = kubeClient
-		m.TargetClientSet = clientset
-		return restConfig, kubeClient, clientset, nil
+// targetClientConfig returns the configuration of the target cluster clients of the deployer.
+func (m *Manifest) targetClientConfig() *lsconfigv1alpha1.TargetClientConfig {
+	if m.Configuration == nil {
+		return nil
 	}
-	return nil, nil, nil, errors.New("neither a target nor kubeconfig are defined")
+	return m.Configuration.TargetClient
 }
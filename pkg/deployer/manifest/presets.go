@@ -0,0 +1,126 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package manifest
+
+import (
+	"fmt"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	manifestv1alpha2 "github.com/gardener/landscaper/apis/deployer/manifest/v1alpha2"
+	"github.com/gardener/landscaper/apis/deployer/utils/managedresource"
+)
+
+const (
+	// DNSEntryAPIVersion is the api version of the DNSEntry resources that are rendered from presets.
+	DNSEntryAPIVersion = "dns.gardener.cloud/v1alpha1"
+	// DNSClassAnnotation is the annotation that selects the responsible DNS controller of a DNSEntry.
+	DNSClassAnnotation = "dns.gardener.cloud/class"
+	// CertificateAPIVersion is the api version of the Certificate resources that are rendered from presets.
+	CertificateAPIVersion = "cert.gardener.cloud/v1alpha1"
+	// CertificateClassAnnotation is the annotation that selects the responsible certificate controller of a Certificate.
+	CertificateClassAnnotation = "cert.gardener.cloud/class"
+)
+
+// RenderPresets renders the resources that are defined by the given presets as manifests.
+func RenderPresets(presets []manifestv1alpha2.Preset) ([]managedresource.Manifest, error) {
+	manifests := make([]managedresource.Manifest, 0, len(presets))
+	for i, preset := range presets {
+		var obj *unstructured.Unstructured
+		switch {
+		case preset.DNSEntry != nil:
+			obj = renderDNSEntry(preset.DNSEntry)
+		case preset.Certificate != nil:
+			obj = renderCertificate(preset.Certificate)
+		default:
+			return nil, fmt.Errorf("preset %d defines no resource", i)
+		}
+
+		raw, err := obj.MarshalJSON()
+		if err != nil {
+			return nil, fmt.Errorf("unable to encode %s %s of preset %d: %w", obj.GetKind(), obj.GetName(), i, err)
+		}
+		manifests = append(manifests, managedresource.Manifest{
+			Policy:   preset.Policy,
+			Manifest: &runtime.RawExtension{Raw: raw},
+		})
+	}
+	return manifests, nil
+}
+
+func renderDNSEntry(entry *manifestv1alpha2.DNSEntryPreset) *unstructured.Unstructured {
+	spec := map[string]interface{}{
+		"dnsName": entry.DNSName,
+	}
+	if len(entry.Targets) != 0 {
+		spec["targets"] = toInterfaceSlice(entry.Targets)
+	}
+	if len(entry.Text) != 0 {
+		spec["text"] = toInterfaceSlice(entry.Text)
+	}
+	if entry.TTL != nil {
+		spec["ttl"] = *entry.TTL
+	}
+
+	obj := newPresetObject(DNSEntryAPIVersion, "DNSEntry", entry.Name, entry.Namespace, spec)
+	setClassAnnotation(obj, DNSClassAnnotation, entry.Class)
+	return obj
+}
+
+func renderCertificate(cert *manifestv1alpha2.CertificatePreset) *unstructured.Unstructured {
+	secretName := cert.SecretName
+	if len(secretName) == 0 {
+		secretName = cert.Name
+	}
+	spec := map[string]interface{}{
+		"secretName": secretName,
+	}
+	if len(cert.CommonName) != 0 {
+		spec["commonName"] = cert.CommonName
+	}
+	if len(cert.DNSNames) != 0 {
+		spec["dnsNames"] = toInterfaceSlice(cert.DNSNames)
+	}
+	if len(cert.IssuerName) != 0 {
+		issuerRef := map[string]interface{}{
+			"name": cert.IssuerName,
+		}
+		if len(cert.IssuerNamespace) != 0 {
+			issuerRef["namespace"] = cert.IssuerNamespace
+		}
+		spec["issuerRef"] = issuerRef
+	}
+
+	obj := newPresetObject(CertificateAPIVersion, "Certificate", cert.Name, cert.Namespace, spec)
+	setClassAnnotation(obj, CertificateClassAnnotation, cert.Class)
+	return obj
+}
+
+func newPresetObject(apiVersion, kind, name, namespace string, spec map[string]interface{}) *unstructured.Unstructured {
+	obj := &unstructured.Unstructured{Object: map[string]interface{}{
+		"spec": spec,
+	}}
+	obj.SetAPIVersion(apiVersion)
+	obj.SetKind(kind)
+	obj.SetName(name)
+	obj.SetNamespace(namespace)
+	return obj
+}
+
+func setClassAnnotation(obj *unstructured.Unstructured, annotation, class string) {
+	if len(class) == 0 {
+		return
+	}
+	obj.SetAnnotations(map[string]string{annotation: class})
+}
+
+func toInterfaceSlice(values []string) []interface{} {
+	result := make([]interface{}, len(values))
+	for i, v := range values {
+		result[i] = v
+	}
+	return result
+}
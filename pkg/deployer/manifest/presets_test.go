@@ -0,0 +1,87 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package manifest_test
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"k8s.io/utils/ptr"
+
+	manifestv1alpha2 "github.com/gardener/landscaper/apis/deployer/manifest/v1alpha2"
+	"github.com/gardener/landscaper/apis/deployer/utils/managedresource"
+	"github.com/gardener/landscaper/pkg/deployer/manifest"
+)
+
+var _ = Describe("Presets", func() {
+
+	It("should render a DNSEntry", func() {
+		manifests, err := manifest.RenderPresets([]manifestv1alpha2.Preset{
+			{
+				Policy: managedresource.ManagePolicy,
+				DNSEntry: &manifestv1alpha2.DNSEntryPreset{
+					Name:      "my-entry",
+					Namespace: "default",
+					Class:     "garden",
+					DNSName:   "app.example.com",
+					Targets:   []string{"1.2.3.4"},
+					TTL:       ptr.To[int64](120),
+				},
+			},
+		})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(manifests).To(HaveLen(1))
+		Expect(manifests[0].Policy).To(Equal(managedresource.ManagePolicy))
+		Expect(manifests[0].Manifest.Raw).To(MatchJSON(`{
+			"apiVersion": "dns.gardener.cloud/v1alpha1",
+			"kind": "DNSEntry",
+			"metadata": {
+				"name": "my-entry",
+				"namespace": "default",
+				"annotations": {"dns.gardener.cloud/class": "garden"}
+			},
+			"spec": {
+				"dnsName": "app.example.com",
+				"targets": ["1.2.3.4"],
+				"ttl": 120
+			}
+		}`))
+	})
+
+	It("should render a Certificate and default its secret name", func() {
+		manifests, err := manifest.RenderPresets([]manifestv1alpha2.Preset{
+			{
+				Certificate: &manifestv1alpha2.CertificatePreset{
+					Name:            "my-cert",
+					Namespace:       "default",
+					CommonName:      "app.example.com",
+					DNSNames:        []string{"www.example.com"},
+					IssuerName:      "issuer",
+					IssuerNamespace: "garden",
+				},
+			},
+		})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(manifests).To(HaveLen(1))
+		Expect(manifests[0].Manifest.Raw).To(MatchJSON(`{
+			"apiVersion": "cert.gardener.cloud/v1alpha1",
+			"kind": "Certificate",
+			"metadata": {
+				"name": "my-cert",
+				"namespace": "default"
+			},
+			"spec": {
+				"commonName": "app.example.com",
+				"dnsNames": ["www.example.com"],
+				"secretName": "my-cert",
+				"issuerRef": {"name": "issuer", "namespace": "garden"}
+			}
+		}`))
+	})
+
+	It("should fail if a preset defines no resource", func() {
+		_, err := manifest.RenderPresets([]manifestv1alpha2.Preset{{}})
+		Expect(err).To(HaveOccurred())
+	})
+})
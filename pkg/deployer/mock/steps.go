@@ -0,0 +1,93 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package mock
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	lsv1alpha1 "github.com/gardener/landscaper/apis/core/v1alpha1"
+	mockv1alpha1 "github.com/gardener/landscaper/apis/deployer/mock/v1alpha1"
+	"github.com/gardener/landscaper/controller-utils/pkg/logging"
+	"github.com/gardener/landscaper/pkg/utils/read_write_layer"
+)
+
+// reconcileSteps runs the DeployItem through the configured phase sequence.
+// The current step is stored in the provider status of the DeployItem.
+func (d *deployer) reconcileSteps(ctx context.Context, di *lsv1alpha1.DeployItem, steps []mockv1alpha1.Step) error {
+	logger, ctx := logging.FromContextOrNew(ctx, nil)
+
+	status, started := nextStep(di, steps, time.Now())
+	step := steps[status.Step]
+	if started {
+		logger.Info("starting mock step", "step", status.Step, "phase", step.Phase)
+		if err := d.ensureExport(ctx, di, step.Export); err != nil {
+			return err
+		}
+	}
+
+	rawStatus, err := encodeProviderStatus(status)
+	if err != nil {
+		return err
+	}
+	di.Status.ProviderStatus = rawStatus
+	di.Status.Phase = step.Phase
+
+	return d.Writer().UpdateDeployItemStatus(ctx, read_write_layer.W000059, di)
+}
+
+// nextStep computes the step of the phase sequence the DeployItem is in at the given time.
+// It returns whether the step has been started with this reconciliation.
+// The sequence starts from the beginning if the DeployItem has no valid status or has been changed.
+func nextStep(di *lsv1alpha1.DeployItem, steps []mockv1alpha1.Step, now time.Time) (*mockv1alpha1.ProviderStatus, bool) {
+	status, err := decodeProviderStatus(di.Status.ProviderStatus)
+	if err != nil || status.ObservedGeneration != di.Generation || status.Step < 0 || status.Step >= len(steps) {
+		return newProviderStatus(di.Generation, 0, now), true
+	}
+
+	if status.Step == len(steps)-1 {
+		return status, false
+	}
+
+	current := steps[status.Step]
+	if current.Duration != nil && now.Sub(status.StepStartTime.Time) < current.Duration.Duration {
+		return status, false
+	}
+	return newProviderStatus(di.Generation, status.Step+1, now), true
+}
+
+func newProviderStatus(generation int64, step int, now time.Time) *mockv1alpha1.ProviderStatus {
+	status := &mockv1alpha1.ProviderStatus{
+		ObservedGeneration: generation,
+		Step:               step,
+		StepStartTime:      metav1.NewTime(now),
+	}
+	status.SetGroupVersionKind(mockv1alpha1.SchemeGroupVersion.WithKind("ProviderStatus"))
+	return status
+}
+
+func decodeProviderStatus(raw *runtime.RawExtension) (*mockv1alpha1.ProviderStatus, error) {
+	if raw == nil || len(raw.Raw) == 0 {
+		return nil, fmt.Errorf("no provider status defined")
+	}
+	status := &mockv1alpha1.ProviderStatus{}
+	if _, _, err := Decoder.Decode(raw.Raw, nil, status); err != nil {
+		return nil, err
+	}
+	return status, nil
+}
+
+func encodeProviderStatus(status *mockv1alpha1.ProviderStatus) (*runtime.RawExtension, error) {
+	raw, err := json.Marshal(status)
+	if err != nil {
+		return nil, fmt.Errorf("unable to encode provider status: %w", err)
+	}
+	return &runtime.RawExtension{Raw: raw}, nil
+}
@@ -0,0 +1,81 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package mock
+
+import (
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	lsv1alpha1 "github.com/gardener/landscaper/apis/core/v1alpha1"
+	mockv1alpha1 "github.com/gardener/landscaper/apis/deployer/mock/v1alpha1"
+)
+
+var _ = Describe("Phase sequences", func() {
+
+	var (
+		now   time.Time
+		steps []mockv1alpha1.Step
+	)
+
+	BeforeEach(func() {
+		now = time.Now()
+		steps = []mockv1alpha1.Step{
+			{Phase: lsv1alpha1.DeployItemPhases.Progressing, Duration: &lsv1alpha1.Duration{Duration: 10 * time.Second}},
+			{Phase: lsv1alpha1.DeployItemPhases.Succeeded},
+			{Phase: lsv1alpha1.DeployItemPhases.Failed},
+		}
+	})
+
+	withStatus := func(di *lsv1alpha1.DeployItem, status *mockv1alpha1.ProviderStatus) *lsv1alpha1.DeployItem {
+		raw, err := encodeProviderStatus(status)
+		Expect(err).ToNot(HaveOccurred())
+		di.Status.ProviderStatus = raw
+		return di
+	}
+
+	It("should start with the first step if the deploy item has no status", func() {
+		di := &lsv1alpha1.DeployItem{}
+		di.Generation = 1
+		status, started := nextStep(di, steps, now)
+		Expect(started).To(BeTrue())
+		Expect(status.Step).To(Equal(0))
+		Expect(status.ObservedGeneration).To(Equal(int64(1)))
+	})
+
+	It("should keep a step until its duration is over", func() {
+		di := withStatus(&lsv1alpha1.DeployItem{}, newProviderStatus(0, 0, now.Add(-5*time.Second)))
+		status, started := nextStep(di, steps, now)
+		Expect(started).To(BeFalse())
+		Expect(status.Step).To(Equal(0))
+
+		di = withStatus(&lsv1alpha1.DeployItem{}, newProviderStatus(0, 0, now.Add(-10*time.Second)))
+		status, started = nextStep(di, steps, now)
+		Expect(started).To(BeTrue())
+		Expect(status.Step).To(Equal(1))
+	})
+
+	It("should start a step without duration with the next reconciliation and keep the last step", func() {
+		di := withStatus(&lsv1alpha1.DeployItem{}, newProviderStatus(0, 1, now))
+		status, started := nextStep(di, steps, now)
+		Expect(started).To(BeTrue())
+		Expect(status.Step).To(Equal(2))
+
+		di = withStatus(&lsv1alpha1.DeployItem{}, status)
+		status, started = nextStep(di, steps, now)
+		Expect(started).To(BeFalse())
+		Expect(status.Step).To(Equal(2))
+	})
+
+	It("should restart the sequence if the deploy item has been changed", func() {
+		di := withStatus(&lsv1alpha1.DeployItem{}, newProviderStatus(1, 2, now))
+		di.Generation = 2
+		status, started := nextStep(di, steps, now)
+		Expect(started).To(BeTrue())
+		Expect(status.Step).To(Equal(0))
+		Expect(status.ObservedGeneration).To(Equal(int64(2)))
+	})
+})
@@ -0,0 +1,144 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package analyze
+
+import (
+	"fmt"
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+
+	lsv1alpha1 "github.com/gardener/landscaper/apis/core/v1alpha1"
+)
+
+// FindingReason describes the kind of a detected problem.
+type FindingReason string
+
+const (
+	// FindingReasonFailed is the reason of findings for failed objects.
+	FindingReasonFailed FindingReason = "Failed"
+	// FindingReasonError is the reason of findings for objects that are not failed but report an error.
+	FindingReasonError FindingReason = "Error"
+	// FindingReasonMissingImport is the reason of findings for imported data objects and targets that do not exist.
+	FindingReasonMissingImport FindingReason = "MissingImport"
+	// FindingReasonWarningEvent is the reason of findings for warning events.
+	FindingReasonWarningEvent FindingReason = "WarningEvent"
+)
+
+// Finding describes a problem that was detected in the object tree of an installation.
+type Finding struct {
+	Reason    FindingReason `json:"reason"`
+	Kind      string        `json:"kind"`
+	Namespace string        `json:"namespace"`
+	Name      string        `json:"name"`
+	Phase     string        `json:"phase,omitempty"`
+	Message   string        `json:"message,omitempty"`
+}
+
+// Analyze detects problems in the object tree of a bundle.
+// Problems of deploy items are reported first, followed by problems of executions and installations,
+// as the most specific problems usually point to the root cause.
+func Analyze(bundle *Bundle) []Finding {
+	findings := make([]Finding, 0)
+
+	for i := len(bundle.DeployItems) - 1; i >= 0; i-- {
+		di := &bundle.DeployItems[i]
+		findings = appendErrorFinding(findings, "DeployItem", di.Namespace, di.Name, string(di.Status.Phase),
+			di.Status.Phase.IsFailed(), di.Status.Phase.IsFinal(), di.Status.LastError)
+	}
+	for i := len(bundle.Executions) - 1; i >= 0; i-- {
+		exec := &bundle.Executions[i]
+		findings = appendErrorFinding(findings, "Execution", exec.Namespace, exec.Name, string(exec.Status.ExecutionPhase),
+			exec.Status.ExecutionPhase.IsFailed(), exec.Status.ExecutionPhase.IsFinal(), exec.Status.LastError)
+	}
+	for i := len(bundle.Installations) - 1; i >= 0; i-- {
+		inst := &bundle.Installations[i]
+		findings = appendErrorFinding(findings, "Installation", inst.Namespace, inst.Name, string(inst.Status.InstallationPhase),
+			inst.Status.InstallationPhase.IsFailed(), inst.Status.InstallationPhase.IsFinal(), inst.Status.LastError)
+	}
+
+	if len(bundle.Installations) > 0 {
+		findings = append(findings, missingImports(bundle, &bundle.Installations[0])...)
+	}
+
+	for _, event := range bundle.Events {
+		if event.Type != corev1.EventTypeWarning {
+			continue
+		}
+		findings = append(findings, Finding{
+			Reason:    FindingReasonWarningEvent,
+			Kind:      event.InvolvedObject.Kind,
+			Namespace: event.InvolvedObject.Namespace,
+			Name:      event.InvolvedObject.Name,
+			Message:   event.Reason + ": " + event.Message,
+		})
+	}
+
+	return findings
+}
+
+// appendErrorFinding appends a finding for a failed object resp. for an unfinished object that reports an error.
+// Errors of successfully finished objects are outdated and ignored.
+func appendErrorFinding(findings []Finding, kind, namespace, name, phase string, failed, finished bool,
+	lastError *lsv1alpha1.Error) []Finding {
+	finding := Finding{
+		Kind:      kind,
+		Namespace: namespace,
+		Name:      name,
+		Phase:     phase,
+	}
+	if lastError != nil {
+		finding.Message = lastError.Operation + ": " + lastError.Message
+	}
+
+	switch {
+	case failed:
+		finding.Reason = FindingReasonFailed
+		if lastError == nil {
+			finding.Message = "no error has been recorded"
+		}
+	case !finished && lastError != nil:
+		finding.Reason = FindingReasonError
+	default:
+		return findings
+	}
+	return append(findings, finding)
+}
+
+// missingImports returns findings for the imported data objects and targets of an installation that do not exist.
+func missingImports(bundle *Bundle, inst *lsv1alpha1.Installation) []Finding {
+	importContext, importedDataObjects, importedTargets := importedObjectNames(inst)
+
+	existing := map[string]bool{}
+	for _, do := range bundle.DataObjects {
+		if do.Labels[lsv1alpha1.DataObjectContextLabel] == importContext {
+			existing["DataObject/"+do.Name] = true
+		}
+	}
+	for _, target := range bundle.Targets {
+		if target.Labels[lsv1alpha1.DataObjectContextLabel] == importContext {
+			existing["Target/"+target.Name] = true
+		}
+	}
+
+	findings := make([]Finding, 0)
+	appendMissing := func(kind string, names map[string]string) {
+		for _, objName := range sortedKeys(names) {
+			if existing[kind+"/"+objName] {
+				continue
+			}
+			findings = append(findings, Finding{
+				Reason:    FindingReasonMissingImport,
+				Kind:      "Installation",
+				Namespace: inst.Namespace,
+				Name:      inst.Name,
+				Message:   fmt.Sprintf("imported %s %q (%s) does not exist", strings.ToLower(kind), names[objName], objName),
+			})
+		}
+	}
+	appendMissing("DataObject", importedDataObjects)
+	appendMissing("Target", importedTargets)
+	return findings
+}
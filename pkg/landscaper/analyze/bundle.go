@@ -0,0 +1,278 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package analyze
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	lsv1alpha1 "github.com/gardener/landscaper/apis/core/v1alpha1"
+	lsv1alpha1helper "github.com/gardener/landscaper/apis/core/v1alpha1/helper"
+	"github.com/gardener/landscaper/pkg/utils/read_write_layer"
+)
+
+// Bundle contains the full object tree of an installation together with the related events
+// and the results of its analysis. All configurations and data are redacted.
+type Bundle struct {
+	// CreationTimestamp is the time when the bundle was collected.
+	CreationTimestamp metav1.Time `json:"creationTimestamp"`
+	// Installation is the namespaced name of the analyzed installation.
+	Installation string `json:"installation"`
+	// Findings contains the problems that were detected in the object tree.
+	Findings []Finding `json:"findings,omitempty"`
+
+	// Installations contains the analyzed installation and all its subinstallations.
+	Installations []lsv1alpha1.Installation `json:"installations"`
+	// Executions contains the executions of the installations.
+	Executions []lsv1alpha1.Execution `json:"executions,omitempty"`
+	// DeployItems contains the deploy items of the executions.
+	DeployItems []lsv1alpha1.DeployItem `json:"deployItems,omitempty"`
+	// DataObjects contains the data objects that are imported or exported by the installations.
+	DataObjects []lsv1alpha1.DataObject `json:"dataObjects,omitempty"`
+	// Targets contains the targets that are imported or exported by the installations.
+	Targets []lsv1alpha1.Target `json:"targets,omitempty"`
+	// Events contains the events of all objects of the bundle, sorted by time.
+	Events []corev1.Event `json:"events,omitempty"`
+}
+
+// Collect collects the object tree of the given installation into a redacted bundle and analyzes it.
+func Collect(ctx context.Context, cl client.Reader, key client.ObjectKey) (*Bundle, error) {
+	inst := &lsv1alpha1.Installation{}
+	if err := read_write_layer.GetInstallation(ctx, cl, key, inst, read_write_layer.R000119); err != nil {
+		return nil, fmt.Errorf("unable to get installation %s: %w", key.String(), err)
+	}
+
+	bundle := &Bundle{
+		CreationTimestamp: metav1.NewTime(time.Now()),
+		Installation:      key.String(),
+	}
+
+	if err := bundle.collectInstallations(ctx, cl, inst); err != nil {
+		return nil, err
+	}
+	if err := bundle.collectExecutionsAndDeployItems(ctx, cl); err != nil {
+		return nil, err
+	}
+	if err := bundle.collectDataObjectsAndTargets(ctx, cl, inst); err != nil {
+		return nil, err
+	}
+	if err := bundle.collectEvents(ctx, cl, key.Namespace); err != nil {
+		return nil, err
+	}
+
+	bundle.Findings = Analyze(bundle)
+	bundle.Redact()
+	return bundle, nil
+}
+
+// collectInstallations collects the installation and all its subinstallations in breadth-first order.
+func (b *Bundle) collectInstallations(ctx context.Context, cl client.Reader, inst *lsv1alpha1.Installation) error {
+	queue := []lsv1alpha1.Installation{*inst}
+	for len(queue) > 0 {
+		current := queue[0]
+		queue = queue[1:]
+		b.Installations = append(b.Installations, current)
+
+		subInstList := &lsv1alpha1.InstallationList{}
+		if err := read_write_layer.ListInstallations(ctx, cl, subInstList, read_write_layer.R000120,
+			client.InNamespace(current.Namespace),
+			client.MatchingLabels{lsv1alpha1.EncompassedByLabel: current.Name}); err != nil {
+			return fmt.Errorf("unable to list subinstallations of installation %s/%s: %w", current.Namespace, current.Name, err)
+		}
+		sort.Slice(subInstList.Items, func(i, j int) bool { return subInstList.Items[i].Name < subInstList.Items[j].Name })
+		queue = append(queue, subInstList.Items...)
+	}
+	return nil
+}
+
+func (b *Bundle) collectExecutionsAndDeployItems(ctx context.Context, cl client.Reader) error {
+	for _, inst := range b.Installations {
+		if inst.Status.ExecutionReference == nil {
+			continue
+		}
+
+		if err := b.collectExecutionAndDeployItems(ctx, cl, inst.Status.ExecutionReference.NamespacedName(),
+			read_write_layer.R000121, read_write_layer.R000122); err != nil {
+			return err
+		}
+		for _, ref := range inst.Status.ChainedExecutionReferences {
+			if err := b.collectExecutionAndDeployItems(ctx, cl, ref.NamespacedName(),
+				read_write_layer.R000152, read_write_layer.R000153); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+func (b *Bundle) collectExecutionAndDeployItems(ctx context.Context, cl client.Reader, execKey types.NamespacedName,
+	execReadID, deployItemsReadID read_write_layer.ReadID) error {
+
+	exec := &lsv1alpha1.Execution{}
+	if err := read_write_layer.GetExecution(ctx, cl, execKey, exec, execReadID); err != nil {
+		if apierrors.IsNotFound(err) {
+			return nil
+		}
+		return fmt.Errorf("unable to get execution %s: %w", execKey.String(), err)
+	}
+	b.Executions = append(b.Executions, *exec)
+
+	deployItemList, err := read_write_layer.ListManagedDeployItems(ctx, cl, execKey, deployItemsReadID)
+	if err != nil {
+		return fmt.Errorf("unable to list deploy items of execution %s: %w", execKey.String(), err)
+	}
+	sort.Slice(deployItemList.Items, func(i, j int) bool { return deployItemList.Items[i].Name < deployItemList.Items[j].Name })
+	b.DeployItems = append(b.DeployItems, deployItemList.Items...)
+	return nil
+}
+
+// collectDataObjectsAndTargets collects the data objects and targets that are imported by the analyzed installation,
+// that are exported by the installations and executions of the tree, and that belong to the context of an installation
+// of the tree.
+func (b *Bundle) collectDataObjectsAndTargets(ctx context.Context, cl client.Reader, inst *lsv1alpha1.Installation) error {
+	importContext, importedDataObjects, importedTargets := importedObjectNames(inst)
+
+	contexts := map[string]bool{}
+	sources := map[string]bool{}
+	for i := range b.Installations {
+		contexts[lsv1alpha1helper.DataObjectSourceFromInstallation(&b.Installations[i])] = true
+		sources[lsv1alpha1helper.DataObjectSourceFromInstallation(&b.Installations[i])] = true
+	}
+	for i := range b.Executions {
+		sources[lsv1alpha1helper.DataObjectSourceFromExecution(&b.Executions[i])] = true
+	}
+
+	belongsToTree := func(obj metav1.Object, imported map[string]string) bool {
+		labels := obj.GetLabels()
+		if contexts[labels[lsv1alpha1.DataObjectContextLabel]] || sources[labels[lsv1alpha1.DataObjectSourceLabel]] {
+			return true
+		}
+		_, isImported := imported[obj.GetName()]
+		return labels[lsv1alpha1.DataObjectContextLabel] == importContext && isImported
+	}
+
+	dataObjectList := &lsv1alpha1.DataObjectList{}
+	if err := read_write_layer.ListDataObjects(ctx, cl, dataObjectList, read_write_layer.R000123,
+		client.InNamespace(inst.Namespace)); err != nil {
+		return fmt.Errorf("unable to list data objects: %w", err)
+	}
+	for _, do := range dataObjectList.Items {
+		if belongsToTree(&do, importedDataObjects) {
+			b.DataObjects = append(b.DataObjects, do)
+		}
+	}
+
+	targetList := &lsv1alpha1.TargetList{}
+	if err := read_write_layer.ListTargets(ctx, cl, targetList, read_write_layer.R000124,
+		client.InNamespace(inst.Namespace)); err != nil {
+		return fmt.Errorf("unable to list targets: %w", err)
+	}
+	for _, target := range targetList.Items {
+		if belongsToTree(&target, importedTargets) {
+			b.Targets = append(b.Targets, target)
+		}
+	}
+	return nil
+}
+
+// importedObjectNames returns the context from which an installation imports its data objects and targets,
+// and the object names of the imported data objects and targets mapped to the referenced names.
+func importedObjectNames(inst *lsv1alpha1.Installation) (string, map[string]string, map[string]string) {
+	importContext := ""
+	if parent, ok := inst.Labels[lsv1alpha1.EncompassedByLabel]; ok {
+		importContext = lsv1alpha1helper.DataObjectSourceFromInstallationName(parent)
+	}
+
+	dataObjects := map[string]string{}
+	for _, imp := range inst.Spec.Imports.Data {
+		if len(imp.DataRef) != 0 {
+			dataObjects[lsv1alpha1helper.GenerateDataObjectName(importContext, imp.DataRef)] = imp.DataRef
+		}
+	}
+
+	targets := map[string]string{}
+	for _, imp := range inst.Spec.Imports.Targets {
+		names := append([]string{imp.Target}, imp.Targets...)
+		for _, name := range imp.TargetMap {
+			names = append(names, name)
+		}
+		for _, name := range names {
+			if len(name) != 0 {
+				targets[lsv1alpha1helper.GenerateDataObjectName(importContext, name)] = name
+			}
+		}
+	}
+	return importContext, dataObjects, targets
+}
+
+func sortedKeys(m map[string]string) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+// collectEvents collects the events of all objects of the bundle.
+func (b *Bundle) collectEvents(ctx context.Context, cl client.Reader, namespace string) error {
+	uids := map[types.UID]bool{}
+	for _, obj := range b.objects() {
+		uids[obj.GetUID()] = true
+	}
+
+	eventList := &corev1.EventList{}
+	if err := read_write_layer.ListEvents(ctx, cl, eventList, read_write_layer.R000125, client.InNamespace(namespace)); err != nil {
+		return fmt.Errorf("unable to list events: %w", err)
+	}
+	for _, event := range eventList.Items {
+		if uids[event.InvolvedObject.UID] {
+			b.Events = append(b.Events, event)
+		}
+	}
+	sort.SliceStable(b.Events, func(i, j int) bool {
+		return eventTime(&b.Events[i]).Before(eventTime(&b.Events[j]))
+	})
+	return nil
+}
+
+// objects returns all landscaper objects of the bundle.
+func (b *Bundle) objects() []client.Object {
+	objects := make([]client.Object, 0)
+	for i := range b.Installations {
+		objects = append(objects, &b.Installations[i])
+	}
+	for i := range b.Executions {
+		objects = append(objects, &b.Executions[i])
+	}
+	for i := range b.DeployItems {
+		objects = append(objects, &b.DeployItems[i])
+	}
+	for i := range b.DataObjects {
+		objects = append(objects, &b.DataObjects[i])
+	}
+	for i := range b.Targets {
+		objects = append(objects, &b.Targets[i])
+	}
+	return objects
+}
+
+func eventTime(event *corev1.Event) time.Time {
+	if !event.LastTimestamp.IsZero() {
+		return event.LastTimestamp.Time
+	}
+	if !event.EventTime.IsZero() {
+		return event.EventTime.Time
+	}
+	return event.CreationTimestamp.Time
+}
@@ -0,0 +1,209 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package analyze_test
+
+import (
+	"context"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	lsv1alpha1 "github.com/gardener/landscaper/apis/core/v1alpha1"
+	lsv1alpha1helper "github.com/gardener/landscaper/apis/core/v1alpha1/helper"
+	"github.com/gardener/landscaper/controller-utils/pkg/logging"
+	"github.com/gardener/landscaper/pkg/api"
+	"github.com/gardener/landscaper/pkg/landscaper/analyze"
+)
+
+var _ = Describe("Bundle", func() {
+
+	const namespace = "test"
+
+	var (
+		ctx        context.Context
+		kubeClient client.Client
+	)
+
+	BeforeEach(func() {
+		ctx = logging.NewContextWithDiscard(context.Background())
+
+		root := &lsv1alpha1.Installation{
+			ObjectMeta: metav1.ObjectMeta{Name: "root", Namespace: namespace, UID: "root-uid"},
+			Spec: lsv1alpha1.InstallationSpec{
+				Imports: lsv1alpha1.InstallationImports{
+					Data: []lsv1alpha1.DataImport{
+						{Name: "config", DataRef: "config"},
+						{Name: "missing", DataRef: "missing-config"},
+					},
+					Targets: []lsv1alpha1.TargetImport{{Name: "cluster", Target: "my-cluster"}},
+				},
+				ImportDataMappings: map[string]lsv1alpha1.AnyJSON{
+					"password": lsv1alpha1.NewAnyJSON([]byte(`"secret"`)),
+				},
+			},
+			Status: lsv1alpha1.InstallationStatus{
+				InstallationPhase: lsv1alpha1.InstallationPhases.Failed,
+			},
+		}
+		sub := &lsv1alpha1.Installation{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "sub",
+				Namespace: namespace,
+				UID:       "sub-uid",
+				Labels:    map[string]string{lsv1alpha1.EncompassedByLabel: "root"},
+			},
+			Status: lsv1alpha1.InstallationStatus{
+				InstallationPhase:  lsv1alpha1.InstallationPhases.Failed,
+				ExecutionReference: &lsv1alpha1.ObjectReference{Name: "sub", Namespace: namespace},
+			},
+		}
+		unrelated := &lsv1alpha1.Installation{
+			ObjectMeta: metav1.ObjectMeta{Name: "other", Namespace: namespace},
+		}
+		exec := &lsv1alpha1.Execution{
+			ObjectMeta: metav1.ObjectMeta{Name: "sub", Namespace: namespace},
+			Status: lsv1alpha1.ExecutionStatus{
+				ExecutionPhase: lsv1alpha1.ExecutionPhases.Failed,
+			},
+		}
+		di := &lsv1alpha1.DeployItem{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "sub-di",
+				Namespace: namespace,
+				UID:       "di-uid",
+				Labels:    map[string]string{lsv1alpha1.ExecutionManagedByLabel: "sub"},
+			},
+			Spec: lsv1alpha1.DeployItemSpec{
+				Configuration: &runtime.RawExtension{Raw: []byte(`{"values": {"password": "secret"}}`)},
+			},
+			Status: lsv1alpha1.DeployItemStatus{
+				Phase: lsv1alpha1.DeployItemPhases.Failed,
+				LastError: &lsv1alpha1.Error{
+					Operation: "Reconcile",
+					Message:   "chart installation failed",
+				},
+			},
+		}
+		config := &lsv1alpha1.DataObject{
+			ObjectMeta: metav1.ObjectMeta{Name: "config", Namespace: namespace},
+			Data:       lsv1alpha1.NewAnyJSON([]byte(`{"user": "admin", "ports": [80, 443]}`)),
+		}
+		exported := &lsv1alpha1.DataObject{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      lsv1alpha1helper.GenerateDataObjectName("Inst.root", "sub-export"),
+				Namespace: namespace,
+				Labels: map[string]string{
+					lsv1alpha1.DataObjectContextLabel: "Inst.root",
+					lsv1alpha1.DataObjectSourceLabel:  "Inst.sub",
+				},
+			},
+			Data: lsv1alpha1.NewAnyJSON([]byte(`"value"`)),
+		}
+		otherConfig := &lsv1alpha1.DataObject{
+			ObjectMeta: metav1.ObjectMeta{Name: "other-config", Namespace: namespace},
+			Data:       lsv1alpha1.NewAnyJSON([]byte(`"value"`)),
+		}
+		target := &lsv1alpha1.Target{
+			ObjectMeta: metav1.ObjectMeta{Name: "my-cluster", Namespace: namespace},
+			Spec: lsv1alpha1.TargetSpec{
+				Type:          "landscaper.gardener.cloud/kubernetes-cluster",
+				Configuration: lsv1alpha1.NewAnyJSONPointer([]byte(`{"kubeconfig": "apiVersion: v1"}`)),
+			},
+		}
+		warning := &corev1.Event{
+			ObjectMeta: metav1.ObjectMeta{Name: "di-event", Namespace: namespace},
+			InvolvedObject: corev1.ObjectReference{
+				Kind:      "DeployItem",
+				Name:      "sub-di",
+				Namespace: namespace,
+				UID:       types.UID("di-uid"),
+			},
+			Type:    corev1.EventTypeWarning,
+			Reason:  "ReconcileFailed",
+			Message: "timeout",
+		}
+		otherEvent := &corev1.Event{
+			ObjectMeta:     metav1.ObjectMeta{Name: "other-event", Namespace: namespace},
+			InvolvedObject: corev1.ObjectReference{Kind: "Installation", Name: "other", UID: "other-uid"},
+			Type:           corev1.EventTypeWarning,
+		}
+
+		kubeClient = fake.NewClientBuilder().WithScheme(api.LandscaperScheme).
+			WithObjects(root, sub, unrelated, exec, di, config, exported, otherConfig, target, warning, otherEvent).
+			WithStatusSubresource(&lsv1alpha1.Installation{}, &lsv1alpha1.Execution{}, &lsv1alpha1.DeployItem{}).
+			Build()
+	})
+
+	It("should collect the object tree of an installation", func() {
+		bundle, err := analyze.Collect(ctx, kubeClient, client.ObjectKey{Namespace: namespace, Name: "root"})
+		Expect(err).ToNot(HaveOccurred())
+
+		names := func(objs ...metav1.Object) []string {
+			res := make([]string, 0, len(objs))
+			for _, obj := range objs {
+				res = append(res, obj.GetName())
+			}
+			return res
+		}
+		Expect(bundle.Installation).To(Equal("test/root"))
+		Expect(names(&bundle.Installations[0], &bundle.Installations[1])).To(Equal([]string{"root", "sub"}))
+		Expect(bundle.Installations).To(HaveLen(2))
+		Expect(bundle.Executions).To(HaveLen(1))
+		Expect(bundle.DeployItems).To(HaveLen(1))
+		Expect(bundle.DataObjects).To(HaveLen(2))
+		Expect(names(&bundle.DataObjects[0], &bundle.DataObjects[1])).To(ConsistOf("config",
+			lsv1alpha1helper.GenerateDataObjectName("Inst.root", "sub-export")))
+		Expect(bundle.Targets).To(HaveLen(1))
+		Expect(bundle.Events).To(HaveLen(1))
+		Expect(bundle.Events[0].Name).To(Equal("di-event"))
+	})
+
+	It("should redact configurations and data", func() {
+		bundle, err := analyze.Collect(ctx, kubeClient, client.ObjectKey{Namespace: namespace, Name: "root"})
+		Expect(err).ToNot(HaveOccurred())
+
+		Expect(string(bundle.Installations[0].Spec.ImportDataMappings["password"].RawMessage)).To(Equal(`"<redacted>"`))
+		Expect(string(bundle.DeployItems[0].Spec.Configuration.Raw)).To(MatchJSON(`{"values": {"password": "<redacted>"}}`))
+		for _, do := range bundle.DataObjects {
+			if do.Name == "config" {
+				Expect(string(do.Data.RawMessage)).To(MatchJSON(`{"user": "<redacted>", "ports": ["<redacted>", "<redacted>"]}`))
+			}
+		}
+		Expect(string(bundle.Targets[0].Spec.Configuration.RawMessage)).To(MatchJSON(`{"kubeconfig": "<redacted>"}`))
+	})
+
+	It("should report failed objects, missing imports and warnings", func() {
+		bundle, err := analyze.Collect(ctx, kubeClient, client.ObjectKey{Namespace: namespace, Name: "root"})
+		Expect(err).ToNot(HaveOccurred())
+
+		Expect(bundle.Findings).To(HaveLen(6))
+		Expect(bundle.Findings[0]).To(Equal(analyze.Finding{
+			Reason:    analyze.FindingReasonFailed,
+			Kind:      "DeployItem",
+			Namespace: namespace,
+			Name:      "sub-di",
+			Phase:     "Failed",
+			Message:   "Reconcile: chart installation failed",
+		}))
+		Expect(bundle.Findings[1].Kind).To(Equal("Execution"))
+		Expect(bundle.Findings[2].Name).To(Equal("sub"))
+		Expect(bundle.Findings[3].Name).To(Equal("root"))
+		Expect(bundle.Findings[4].Reason).To(Equal(analyze.FindingReasonMissingImport))
+		Expect(bundle.Findings[4].Message).To(ContainSubstring(`"missing-config"`))
+		Expect(bundle.Findings[5].Reason).To(Equal(analyze.FindingReasonWarningEvent))
+		Expect(bundle.Findings[5].Message).To(Equal("ReconcileFailed: timeout"))
+	})
+
+	It("should fail for a non-existing installation", func() {
+		_, err := analyze.Collect(ctx, kubeClient, client.ObjectKey{Namespace: namespace, Name: "unknown"})
+		Expect(err).To(HaveOccurred())
+	})
+})
@@ -0,0 +1,113 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package analyze
+
+import (
+	"bytes"
+	"encoding/json"
+
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	lsv1alpha1 "github.com/gardener/landscaper/apis/core/v1alpha1"
+)
+
+// RedactedValue replaces all values of redacted configurations and data.
+const RedactedValue = "<redacted>"
+
+// lastAppliedConfigAnnotation is the annotation of kubectl that contains the complete last applied object.
+const lastAppliedConfigAnnotation = "kubectl.kubernetes.io/last-applied-configuration"
+
+// Redact removes all potentially sensitive values from the bundle.
+// The values of target configurations, data objects, import data mappings and deploy item configurations are replaced,
+// whereas their structure is kept for the analysis.
+func (b *Bundle) Redact() {
+	for _, obj := range b.objects() {
+		obj.SetManagedFields(nil)
+		redactAnnotations(obj)
+	}
+
+	for i := range b.Installations {
+		inst := &b.Installations[i]
+		for key, mapping := range inst.Spec.ImportDataMappings {
+			inst.Spec.ImportDataMappings[key] = lsv1alpha1.NewAnyJSON(RedactJSON(mapping.RawMessage))
+		}
+	}
+	for i := range b.Executions {
+		exec := &b.Executions[i]
+		for j := range exec.Spec.DeployItems {
+			redactRawExtension(exec.Spec.DeployItems[j].Configuration)
+		}
+	}
+	for i := range b.DeployItems {
+		redactRawExtension(b.DeployItems[i].Spec.Configuration)
+	}
+	for i := range b.DataObjects {
+		do := &b.DataObjects[i]
+		do.Data = lsv1alpha1.NewAnyJSON(RedactJSON(do.Data.RawMessage))
+	}
+	for i := range b.Targets {
+		target := &b.Targets[i]
+		if target.Spec.Configuration != nil {
+			target.Spec.Configuration = lsv1alpha1.NewAnyJSONPointer(RedactJSON(target.Spec.Configuration.RawMessage))
+		}
+	}
+}
+
+// RedactJSON replaces all scalar values of a json document with RedactedValue.
+// Documents that cannot be parsed are replaced completely.
+func RedactJSON(data []byte) []byte {
+	if len(data) == 0 {
+		return data
+	}
+
+	var value interface{}
+	if err := json.Unmarshal(data, &value); err != nil {
+		return []byte(`"` + RedactedValue + `"`)
+	}
+
+	buf := &bytes.Buffer{}
+	encoder := json.NewEncoder(buf)
+	encoder.SetEscapeHTML(false)
+	if err := encoder.Encode(redactValue(value)); err != nil {
+		return []byte(`"` + RedactedValue + `"`)
+	}
+	return bytes.TrimSuffix(buf.Bytes(), []byte("\n"))
+}
+
+func redactValue(value interface{}) interface{} {
+	switch v := value.(type) {
+	case map[string]interface{}:
+		for key, elem := range v {
+			v[key] = redactValue(elem)
+		}
+		return v
+	case []interface{}:
+		for i, elem := range v {
+			v[i] = redactValue(elem)
+		}
+		return v
+	case nil:
+		return nil
+	default:
+		return RedactedValue
+	}
+}
+
+func redactRawExtension(ext *runtime.RawExtension) {
+	if ext == nil {
+		return
+	}
+	ext.Raw = RedactJSON(ext.Raw)
+	ext.Object = nil
+}
+
+func redactAnnotations(obj client.Object) {
+	annotations := obj.GetAnnotations()
+	if _, ok := annotations[lastAppliedConfigAnnotation]; ok {
+		delete(annotations, lastAppliedConfigAnnotation)
+		obj.SetAnnotations(annotations)
+	}
+}
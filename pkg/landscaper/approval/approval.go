@@ -0,0 +1,242 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package approval
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/gardener/landscaper/apis/config"
+	lsv1alpha1 "github.com/gardener/landscaper/apis/core/v1alpha1"
+	"github.com/gardener/landscaper/pkg/utils/read_write_layer"
+)
+
+const (
+	// DefaultTimeout is the time after which a pending approval is treated as rejected if the hook defines no timeout.
+	DefaultTimeout = 24 * time.Hour
+
+	// maxRecords is the maximal number of approval records that are kept in the status of an installation.
+	maxRecords = 20
+)
+
+// Request is the request for the approval of a phase transition of an installation.
+type Request struct {
+	// Installation is the installation that wants to enter the phase.
+	Installation lsv1alpha1.ObjectReference `json:"installation"`
+	// Context is the name of the context of the installation.
+	Context string `json:"context"`
+	// Phase is the phase that the installation wants to enter.
+	Phase lsv1alpha1.InstallationPhase `json:"phase"`
+	// JobID is the ID of the job of the installation.
+	// The approval of a phase transition is requested repeatedly with the same job ID until it has been decided.
+	JobID string `json:"jobID"`
+	// Reference is the identifier of the request in the external approval system that has been returned by a previous call.
+	Reference string `json:"reference,omitempty"`
+}
+
+// Response is the answer of an external approval system.
+type Response struct {
+	// State is one of "Pending", "Approved" and "Rejected".
+	State lsv1alpha1.ApprovalState `json:"state"`
+	// Reference is the identifier of the request in the external approval system.
+	Reference string `json:"reference,omitempty"`
+	// Message is an optional message for the audit trail.
+	Message string `json:"message,omitempty"`
+}
+
+// Hook requests approvals from an external approval system.
+type Hook interface {
+	// RequestApproval requests the approval of a phase transition.
+	// It is called repeatedly until the approval has been decided and therefore must be idempotent.
+	RequestApproval(ctx context.Context, req *Request) (*Response, error)
+}
+
+// ConfiguredHook is a hook together with the phase transitions it has to approve.
+type ConfiguredHook struct {
+	Name            string
+	Hook            Hook
+	Phases          []lsv1alpha1.InstallationPhase
+	ContextSelector labels.Selector
+	Timeout         time.Duration
+}
+
+// Gate checks whether installations may enter a phase.
+type Gate struct {
+	lsClient client.Client
+	hooks    []ConfiguredHook
+	now      func() time.Time
+}
+
+// NewGate creates a new gate for the given hooks.
+func NewGate(lsClient client.Client, hooks ...ConfiguredHook) *Gate {
+	return &Gate{
+		lsClient: lsClient,
+		hooks:    hooks,
+		now:      time.Now,
+	}
+}
+
+// NewGateFromConfig creates a new gate with webhooks for the configured approval hooks.
+func NewGateFromConfig(lsClient client.Client, hookConfigs []config.ApprovalHookConfiguration) (*Gate, error) {
+	hooks := make([]ConfiguredHook, 0, len(hookConfigs))
+	for _, hookConfig := range hookConfigs {
+		hook := ConfiguredHook{
+			Name:            hookConfig.Name,
+			Hook:            NewWebhook(lsClient, hookConfig.Webhook),
+			ContextSelector: labels.Everything(),
+			Timeout:         DefaultTimeout,
+		}
+		for _, phase := range hookConfig.Phases {
+			hook.Phases = append(hook.Phases, lsv1alpha1.InstallationPhase(phase))
+		}
+		if hookConfig.ContextSelector != nil {
+			selector, err := metav1.LabelSelectorAsSelector(hookConfig.ContextSelector)
+			if err != nil {
+				return nil, fmt.Errorf("invalid context selector of approval hook %q: %w", hookConfig.Name, err)
+			}
+			hook.ContextSelector = selector
+		}
+		if hookConfig.Timeout != nil {
+			hook.Timeout = hookConfig.Timeout.Duration
+		}
+		hooks = append(hooks, hook)
+	}
+	return NewGate(lsClient, hooks...), nil
+}
+
+// Check checks whether the installation may enter the given phase.
+// The hooks which select the phase and the context of the installation are asked one after the other.
+// The returned state is "Approved" if all hooks have approved the phase transition, otherwise it is the state of
+// the first hook that has not approved it. The approvals are recorded in the status of the installation.
+func (g *Gate) Check(ctx context.Context, inst *lsv1alpha1.Installation, phase lsv1alpha1.InstallationPhase) (lsv1alpha1.ApprovalState, string, error) {
+	if g == nil || len(g.hooks) == 0 {
+		return lsv1alpha1.ApprovalStateApproved, "", nil
+	}
+
+	contextLabels, err := g.getContextLabels(ctx, inst)
+	if err != nil {
+		return "", "", err
+	}
+
+	for _, hook := range g.hooks {
+		if !hook.selects(phase, contextLabels) {
+			continue
+		}
+
+		record := getOrCreateRecord(inst, hook.Name, phase, g.now())
+		if record.State == lsv1alpha1.ApprovalStatePending {
+			if err := g.request(ctx, inst, hook, record); err != nil {
+				return "", "", err
+			}
+		}
+
+		if record.State != lsv1alpha1.ApprovalStateApproved {
+			return record.State, describe(record), nil
+		}
+	}
+
+	return lsv1alpha1.ApprovalStateApproved, "", nil
+}
+
+func (g *Gate) request(ctx context.Context, inst *lsv1alpha1.Installation, hook ConfiguredHook, record *lsv1alpha1.ApprovalRecord) error {
+	now := g.now()
+	if hook.Timeout > 0 && now.Sub(record.RequestTime.Time) >= hook.Timeout {
+		record.State = lsv1alpha1.ApprovalStateTimedOut
+		record.Message = fmt.Sprintf("approval has not been decided within %s", hook.Timeout.String())
+		record.DecisionTime = &metav1.Time{Time: now}
+		return nil
+	}
+
+	resp, err := hook.Hook.RequestApproval(ctx, &Request{
+		Installation: lsv1alpha1.ObjectReference{Name: inst.Name, Namespace: inst.Namespace},
+		Context:      inst.Spec.Context,
+		Phase:        record.Phase,
+		JobID:        record.JobID,
+		Reference:    record.Reference,
+	})
+	if err != nil {
+		return fmt.Errorf("unable to request approval from hook %q: %w", hook.Name, err)
+	}
+
+	switch resp.State {
+	case lsv1alpha1.ApprovalStatePending, lsv1alpha1.ApprovalStateApproved, lsv1alpha1.ApprovalStateRejected:
+	default:
+		return fmt.Errorf("approval hook %q returned the unknown state %q", hook.Name, resp.State)
+	}
+
+	record.State = resp.State
+	if len(resp.Reference) != 0 {
+		record.Reference = resp.Reference
+	}
+	record.Message = resp.Message
+	if resp.State != lsv1alpha1.ApprovalStatePending {
+		record.DecisionTime = &metav1.Time{Time: now}
+	}
+	return nil
+}
+
+func (g *Gate) getContextLabels(ctx context.Context, inst *lsv1alpha1.Installation) (labels.Set, error) {
+	if len(inst.Spec.Context) == 0 {
+		return labels.Set{}, nil
+	}
+	lsCtx := &lsv1alpha1.Context{}
+	key := client.ObjectKey{Namespace: inst.Namespace, Name: inst.Spec.Context}
+	if err := read_write_layer.GetContext(ctx, g.lsClient, key, lsCtx, read_write_layer.R000128); err != nil {
+		if apierrors.IsNotFound(err) {
+			return labels.Set{}, nil
+		}
+		return nil, fmt.Errorf("unable to get context %s: %w", key.String(), err)
+	}
+	return lsCtx.Labels, nil
+}
+
+func (h *ConfiguredHook) selects(phase lsv1alpha1.InstallationPhase, contextLabels labels.Set) bool {
+	for _, p := range h.Phases {
+		if p == phase {
+			return h.ContextSelector == nil || h.ContextSelector.Matches(contextLabels)
+		}
+	}
+	return false
+}
+
+// getOrCreateRecord returns the approval record of the current job of the installation.
+// A new pending record is added if none exists and the oldest records are removed.
+func getOrCreateRecord(inst *lsv1alpha1.Installation, hookName string, phase lsv1alpha1.InstallationPhase, now time.Time) *lsv1alpha1.ApprovalRecord {
+	for i := range inst.Status.Approvals {
+		record := &inst.Status.Approvals[i]
+		if record.Hook == hookName && record.Phase == phase && record.JobID == inst.Status.JobID {
+			return record
+		}
+	}
+
+	inst.Status.Approvals = append(inst.Status.Approvals, lsv1alpha1.ApprovalRecord{
+		Hook:        hookName,
+		Phase:       phase,
+		JobID:       inst.Status.JobID,
+		State:       lsv1alpha1.ApprovalStatePending,
+		RequestTime: metav1.Time{Time: now},
+	})
+	if len(inst.Status.Approvals) > maxRecords {
+		inst.Status.Approvals = inst.Status.Approvals[len(inst.Status.Approvals)-maxRecords:]
+	}
+	return &inst.Status.Approvals[len(inst.Status.Approvals)-1]
+}
+
+func describe(record *lsv1alpha1.ApprovalRecord) string {
+	msg := fmt.Sprintf("approval of phase %s by hook %q is %s", record.Phase, record.Hook, record.State)
+	if len(record.Reference) != 0 {
+		msg = fmt.Sprintf("%s (reference %s)", msg, record.Reference)
+	}
+	if len(record.Message) != 0 {
+		msg = fmt.Sprintf("%s: %s", msg, record.Message)
+	}
+	return msg
+}
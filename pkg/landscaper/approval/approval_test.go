@@ -0,0 +1,175 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package approval_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	"github.com/gardener/landscaper/apis/config"
+	lscore "github.com/gardener/landscaper/apis/core"
+	lsv1alpha1 "github.com/gardener/landscaper/apis/core/v1alpha1"
+	"github.com/gardener/landscaper/pkg/api"
+	"github.com/gardener/landscaper/pkg/landscaper/approval"
+)
+
+type fakeHook struct {
+	requests []approval.Request
+	response approval.Response
+}
+
+func (h *fakeHook) RequestApproval(_ context.Context, req *approval.Request) (*approval.Response, error) {
+	h.requests = append(h.requests, *req)
+	resp := h.response
+	return &resp, nil
+}
+
+var _ = Describe("Approval", func() {
+
+	var (
+		ctx      context.Context
+		lsClient client.Client
+		inst     *lsv1alpha1.Installation
+		hook     *fakeHook
+	)
+
+	BeforeEach(func() {
+		ctx = context.Background()
+		prodCtx := &lsv1alpha1.Context{}
+		prodCtx.Name = "prod"
+		prodCtx.Namespace = "test"
+		prodCtx.Labels = map[string]string{"stage": "production"}
+		lsClient = fake.NewClientBuilder().WithScheme(api.LandscaperScheme).WithObjects(prodCtx).Build()
+
+		inst = &lsv1alpha1.Installation{}
+		inst.Name = "inst"
+		inst.Namespace = "test"
+		inst.Spec.Context = "prod"
+		inst.Status.JobID = "job1"
+
+		hook = &fakeHook{response: approval.Response{State: lsv1alpha1.ApprovalStatePending, Reference: "CHG001"}}
+	})
+
+	newGate := func(selector labels.Selector) *approval.Gate {
+		return approval.NewGate(lsClient, approval.ConfiguredHook{
+			Name:            "itsm",
+			Hook:            hook,
+			Phases:          []lsv1alpha1.InstallationPhase{lsv1alpha1.InstallationPhases.Progressing},
+			ContextSelector: selector,
+			Timeout:         time.Hour,
+		})
+	}
+
+	It("should approve phases that are not selected by a hook", func() {
+		gate := newGate(labels.SelectorFromSet(labels.Set{"stage": "production"}))
+		state, _, err := gate.Check(ctx, inst, lsv1alpha1.InstallationPhases.Deleting)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(state).To(Equal(lsv1alpha1.ApprovalStateApproved))
+
+		inst.Spec.Context = "default"
+		state, _, err = gate.Check(ctx, inst, lsv1alpha1.InstallationPhases.Progressing)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(state).To(Equal(lsv1alpha1.ApprovalStateApproved))
+		Expect(hook.requests).To(BeEmpty())
+		Expect(inst.Status.Approvals).To(BeEmpty())
+	})
+
+	It("should record the approval of the current job", func() {
+		gate := newGate(labels.SelectorFromSet(labels.Set{"stage": "production"}))
+		state, msg, err := gate.Check(ctx, inst, lsv1alpha1.InstallationPhases.Progressing)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(state).To(Equal(lsv1alpha1.ApprovalStatePending))
+		Expect(msg).To(ContainSubstring("CHG001"))
+		Expect(inst.Status.Approvals).To(HaveLen(1))
+		Expect(inst.Status.Approvals[0].State).To(Equal(lsv1alpha1.ApprovalStatePending))
+		Expect(inst.Status.Approvals[0].JobID).To(Equal("job1"))
+
+		hook.response = approval.Response{State: lsv1alpha1.ApprovalStateApproved, Message: "approved by cab"}
+		state, _, err = gate.Check(ctx, inst, lsv1alpha1.InstallationPhases.Progressing)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(state).To(Equal(lsv1alpha1.ApprovalStateApproved))
+		Expect(hook.requests).To(HaveLen(2))
+		Expect(hook.requests[1].Reference).To(Equal("CHG001"))
+		Expect(inst.Status.Approvals).To(HaveLen(1))
+		Expect(inst.Status.Approvals[0].Reference).To(Equal("CHG001"))
+		Expect(inst.Status.Approvals[0].DecisionTime).ToNot(BeNil())
+
+		// a decided approval is not requested again
+		state, _, err = gate.Check(ctx, inst, lsv1alpha1.InstallationPhases.Progressing)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(state).To(Equal(lsv1alpha1.ApprovalStateApproved))
+		Expect(hook.requests).To(HaveLen(2))
+
+		// a new job requires a new approval
+		inst.Status.JobID = "job2"
+		state, _, err = gate.Check(ctx, inst, lsv1alpha1.InstallationPhases.Progressing)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(state).To(Equal(lsv1alpha1.ApprovalStateApproved))
+		Expect(inst.Status.Approvals).To(HaveLen(2))
+	})
+
+	It("should time out a pending approval", func() {
+		inst.Status.Approvals = []lsv1alpha1.ApprovalRecord{{
+			Hook:        "itsm",
+			Phase:       lsv1alpha1.InstallationPhases.Progressing,
+			JobID:       "job1",
+			State:       lsv1alpha1.ApprovalStatePending,
+			RequestTime: metav1.NewTime(time.Now().Add(-2 * time.Hour)),
+		}}
+		state, _, err := newGate(nil).Check(ctx, inst, lsv1alpha1.InstallationPhases.Progressing)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(state).To(Equal(lsv1alpha1.ApprovalStateTimedOut))
+		Expect(hook.requests).To(BeEmpty())
+	})
+
+	It("should post the approval request to a webhook", func() {
+		secret := &corev1.Secret{}
+		secret.Name = "itsm-token"
+		secret.Namespace = "ls-system"
+		secret.Data = map[string][]byte{"token": []byte("Bearer abc")}
+		Expect(lsClient.Create(ctx, secret)).To(Succeed())
+
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			defer GinkgoRecover()
+			Expect(r.Header.Get("Authorization")).To(Equal("Bearer abc"))
+			req := &approval.Request{}
+			Expect(json.NewDecoder(r.Body).Decode(req)).To(Succeed())
+			Expect(req.Installation.Name).To(Equal("inst"))
+			Expect(req.Phase).To(Equal(lsv1alpha1.InstallationPhases.Progressing))
+			_, _ = w.Write([]byte(`{"state": "Rejected", "reference": "CHG002", "message": "freeze period"}`))
+		}))
+		defer server.Close()
+
+		gate, err := approval.NewGateFromConfig(lsClient, []config.ApprovalHookConfiguration{{
+			Name:   "itsm",
+			Phases: []lscore.InstallationPhase{lscore.InstallationPhase(lsv1alpha1.InstallationPhases.Progressing)},
+			Webhook: config.ApprovalWebhookConfiguration{
+				URL: server.URL,
+				AuthSecretRef: &lscore.SecretReference{
+					ObjectReference: lscore.ObjectReference{Name: "itsm-token", Namespace: "ls-system"},
+					Key:             "token",
+				},
+			},
+		}})
+		Expect(err).ToNot(HaveOccurred())
+
+		state, msg, err := gate.Check(ctx, inst, lsv1alpha1.InstallationPhases.Progressing)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(state).To(Equal(lsv1alpha1.ApprovalStateRejected))
+		Expect(msg).To(ContainSubstring("freeze period"))
+		Expect(inst.Status.Approvals[0].Reference).To(Equal("CHG002"))
+	})
+})
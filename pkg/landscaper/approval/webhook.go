@@ -0,0 +1,99 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package approval
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/gardener/landscaper/apis/config"
+	lsv1alpha1 "github.com/gardener/landscaper/apis/core/v1alpha1"
+	lscutils "github.com/gardener/landscaper/controller-utils/pkg/landscaper"
+)
+
+const (
+	// webhookTimeout is the timeout of a single request to an approval webhook.
+	webhookTimeout = 30 * time.Second
+
+	// webhookMaxResponseSize is the maximal size of a response of an approval webhook.
+	webhookMaxResponseSize = 1024 * 1024
+)
+
+// Webhook is a hook that posts the approval requests as JSON to the url of an external approval system
+// and expects a JSON response.
+type Webhook struct {
+	lsClient      client.Client
+	httpClient    *http.Client
+	url           string
+	authSecretRef *lsv1alpha1.SecretReference
+}
+
+var _ Hook = &Webhook{}
+
+// NewWebhook creates a new webhook for the given configuration.
+func NewWebhook(lsClient client.Client, webhookConfig config.ApprovalWebhookConfiguration) *Webhook {
+	w := &Webhook{
+		lsClient:   lsClient,
+		httpClient: &http.Client{Timeout: webhookTimeout},
+		url:        webhookConfig.URL,
+	}
+	if ref := webhookConfig.AuthSecretRef; ref != nil {
+		w.authSecretRef = &lsv1alpha1.SecretReference{
+			ObjectReference: lsv1alpha1.ObjectReference{Name: ref.Name, Namespace: ref.Namespace},
+			Key:             ref.Key,
+		}
+	}
+	return w
+}
+
+// RequestApproval implements the Hook interface.
+func (w *Webhook) RequestApproval(ctx context.Context, approvalReq *Request) (*Response, error) {
+	body, err := json.Marshal(approvalReq)
+	if err != nil {
+		return nil, fmt.Errorf("unable to marshal approval request: %w", err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, w.url, bytes.NewReader(body))
+	if err != nil {
+		return nil, fmt.Errorf("unable to create request for approval webhook %q: %w", w.url, err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("Accept", "application/json")
+	if w.authSecretRef != nil {
+		_, data, _, err := lscutils.ResolveSecretReference(ctx, w.lsClient, w.authSecretRef)
+		if err != nil {
+			return nil, fmt.Errorf("unable to resolve auth secret of approval webhook: %w", err)
+		}
+		req.Header.Set("Authorization", strings.TrimSpace(string(data)))
+	}
+
+	resp, err := w.httpClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("unable to call approval webhook %q: %w", w.url, err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return nil, fmt.Errorf("unable to call approval webhook %q: unexpected status code %d", w.url, resp.StatusCode)
+	}
+
+	respBody, err := io.ReadAll(io.LimitReader(resp.Body, webhookMaxResponseSize))
+	if err != nil {
+		return nil, fmt.Errorf("unable to read response of approval webhook %q: %w", w.url, err)
+	}
+	approvalResp := &Response{}
+	if err := json.Unmarshal(respBody, approvalResp); err != nil {
+		return nil, fmt.Errorf("response of approval webhook %q is not valid: %w", w.url, err)
+	}
+	return approvalResp, nil
+}
@@ -0,0 +1,363 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package blueprints
+
+import (
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+
+	lsv1alpha1 "github.com/gardener/landscaper/apis/core/v1alpha1"
+	"github.com/gardener/landscaper/pkg/landscaper/jsonschema"
+)
+
+// Contract maps the names of imports of a consumer blueprint to the names of the exports of a producer blueprint
+// that satisfy them.
+type Contract map[string]string
+
+// ContractParty is a blueprint that produces or consumes the values of a contract.
+type ContractParty struct {
+	Blueprint *Blueprint
+	// ReferenceContext is used to resolve references in the json schemas of the blueprint.
+	// If not set, only the local types and the filesystem of the blueprint are used.
+	ReferenceContext *jsonschema.ReferenceContext
+}
+
+// SubinstallationContractParty is a subinstallation template together with its resolved blueprint.
+type SubinstallationContractParty struct {
+	ContractParty
+	Template *lsv1alpha1.InstallationTemplate
+}
+
+// VerifyContract verifies that the exports of the producer blueprint satisfy the imports of the consumer blueprint
+// they are mapped to by the contract.
+// The kinds and target types of mapped imports and exports have to match, and the json schema of an export must not
+// contradict the json schema of the import. Only contradictions that can be proven from the schemas are reported:
+//   - the types of the export are not accepted by the import
+//   - an enum value of the export is not contained in the enum of the import
+//   - a property that is required by the import is not defined by an export that defines properties
+//   - a property that is required by the export is not allowed by an import without additional properties
+//
+// The check descends into the properties and items that are defined by both schemas.
+// All other keywords are ignored.
+func VerifyContract(fldPath *field.Path, producer, consumer ContractParty, contract Contract) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	importNames := make([]string, 0, len(contract))
+	for importName := range contract {
+		importNames = append(importNames, importName)
+	}
+	sort.Strings(importNames)
+
+	for _, importName := range importNames {
+		exportName := contract[importName]
+		impPath := fldPath.Child("imports").Key(importName)
+
+		importDef := findImportDefinition(consumer.Blueprint.Info.Imports, importName)
+		if importDef == nil {
+			allErrs = append(allErrs, field.NotFound(impPath, importName))
+			continue
+		}
+		exportDef := findExportDefinition(producer.Blueprint.Info.Exports, exportName)
+		if exportDef == nil {
+			allErrs = append(allErrs, field.Invalid(impPath, exportName,
+				fmt.Sprintf("the producer blueprint does not define the export %q", exportName)))
+			continue
+		}
+
+		allErrs = append(allErrs, verifyImportExportPair(impPath, producer, exportDef, consumer, importDef)...)
+	}
+	return allErrs
+}
+
+// VerifySubinstallationContracts verifies the contracts between all subinstallations of a blueprint.
+// The contracts are derived from the data objects and targets that are exported by one subinstallation
+// and imported by another one. Imports and exports of the templates that are not defined by the blueprints
+// of the subinstallations are ignored.
+func VerifySubinstallationContracts(fldPath *field.Path, subinsts []SubinstallationContractParty) field.ErrorList {
+	allErrs := field.ErrorList{}
+	for _, consumer := range subinsts {
+		for _, producer := range subinsts {
+			if producer.Template == consumer.Template {
+				continue
+			}
+			contract := NewSubinstallationContract(producer.Template, consumer.Template)
+			for importName, exportName := range contract {
+				if findImportDefinition(consumer.Blueprint.Info.Imports, importName) == nil ||
+					findExportDefinition(producer.Blueprint.Info.Exports, exportName) == nil {
+					delete(contract, importName)
+				}
+			}
+			if len(contract) == 0 {
+				continue
+			}
+			allErrs = append(allErrs, VerifyContract(fldPath.Key(consumer.Template.Name),
+				producer.ContractParty, consumer.ContractParty, contract)...)
+		}
+	}
+	return allErrs
+}
+
+// NewSubinstallationContract derives the contract between two subinstallations from the data objects and targets
+// that are exported by the producer and imported by the consumer.
+// Imports that are transformed or restructured by import data mappings and data objects that are overwritten
+// by export data mappings are not part of the contract.
+func NewSubinstallationContract(producer, consumer *lsv1alpha1.InstallationTemplate) Contract {
+	contract := Contract{}
+
+	exportedData := map[string]string{}
+	for _, export := range producer.Exports.Data {
+		if _, ok := producer.ExportDataMappings[export.DataRef]; !ok {
+			exportedData[export.DataRef] = export.Name
+		}
+	}
+	for _, imp := range consumer.Imports.Data {
+		if len(imp.DataRef) == 0 || len(imp.Transformations) != 0 {
+			continue
+		}
+		if _, ok := consumer.ImportDataMappings[imp.Name]; ok {
+			continue
+		}
+		if exportName, ok := exportedData[imp.DataRef]; ok {
+			contract[imp.Name] = exportName
+		}
+	}
+
+	exportedTargets := map[string]string{}
+	for _, export := range producer.Exports.Targets {
+		exportedTargets[export.Target] = export.Name
+	}
+	for _, imp := range consumer.Imports.Targets {
+		refs := imp.Targets
+		if len(imp.Target) != 0 {
+			refs = []string{imp.Target}
+		}
+		for _, ref := range refs {
+			if exportName, ok := exportedTargets[ref]; ok {
+				contract[imp.Name] = exportName
+			}
+		}
+	}
+
+	return contract
+}
+
+// verifyImportExportPair verifies that an export definition satisfies an import definition.
+func verifyImportExportPair(fldPath *field.Path, producer ContractParty, exportDef *lsv1alpha1.ExportDefinition,
+	consumer ContractParty, importDef *lsv1alpha1.ImportDefinition) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	importType := getImportType(importDef)
+	exportType := getExportType(exportDef)
+	if importType == lsv1alpha1.ImportTypeData {
+		if exportType != lsv1alpha1.ExportTypeData {
+			return append(allErrs, field.Invalid(fldPath.Child("type"), string(importType),
+				fmt.Sprintf("the export %q is of type %q", exportDef.Name, exportType)))
+		}
+	} else if exportType != lsv1alpha1.ExportTypeTarget {
+		return append(allErrs, field.Invalid(fldPath.Child("type"), string(importType),
+			fmt.Sprintf("the export %q is of type %q", exportDef.Name, exportType)))
+	}
+
+	if exportType == lsv1alpha1.ExportTypeTarget {
+		if len(importDef.TargetType) != 0 && len(exportDef.TargetType) != 0 && importDef.TargetType != exportDef.TargetType {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("targetType"), importDef.TargetType,
+				fmt.Sprintf("the export %q has the target type %q", exportDef.Name, exportDef.TargetType)))
+		}
+		return allErrs
+	}
+
+	if importDef.Schema == nil || exportDef.Schema == nil {
+		return allErrs
+	}
+	schemaPath := fldPath.Child("schema")
+	exportSchema, err := resolveSchema(producer, exportDef.Schema)
+	if err != nil {
+		return append(allErrs, field.Invalid(schemaPath, exportDef.Name,
+			fmt.Sprintf("unable to resolve the schema of the export: %s", err.Error())))
+	}
+	importSchema, err := resolveSchema(consumer, importDef.Schema)
+	if err != nil {
+		return append(allErrs, field.Invalid(schemaPath, importDef.Name,
+			fmt.Sprintf("unable to resolve the schema of the import: %s", err.Error())))
+	}
+	return append(allErrs, verifySchemaCompatibility(schemaPath, exportSchema, importSchema)...)
+}
+
+// verifySchemaCompatibility reports the provable contradictions between the schema of an export
+// and the schema of an import.
+func verifySchemaCompatibility(fldPath *field.Path, exportSchema, importSchema interface{}) field.ErrorList {
+	allErrs := field.ErrorList{}
+	exported, ok := exportSchema.(map[string]interface{})
+	if !ok {
+		return allErrs
+	}
+	imported, ok := importSchema.(map[string]interface{})
+	if !ok {
+		return allErrs
+	}
+
+	exportTypes := schemaTypes(exported)
+	importTypes := schemaTypes(imported)
+	if exportTypes.Len() != 0 && importTypes.Len() != 0 {
+		for _, t := range sets.List(exportTypes) {
+			if !importTypes.Has(t) && !(t == "integer" && importTypes.Has("number")) {
+				allErrs = append(allErrs, field.Invalid(fldPath.Child("type"), t,
+					fmt.Sprintf("exported values of type %q are not accepted, expected %s", t, strings.Join(sets.List(importTypes), ", "))))
+			}
+		}
+	}
+
+	exportEnum, exportHasEnum := exported["enum"].([]interface{})
+	importEnum, importHasEnum := imported["enum"].([]interface{})
+	if exportHasEnum && importHasEnum {
+		for _, value := range exportEnum {
+			if !containsValue(importEnum, value) {
+				allErrs = append(allErrs, field.NotSupported(fldPath.Child("enum"), value, enumStrings(importEnum)))
+			}
+		}
+	}
+
+	exportProperties, exportHasProperties := exported["properties"].(map[string]interface{})
+	importProperties, _ := imported["properties"].(map[string]interface{})
+	if exportHasProperties {
+		for _, name := range stringList(imported["required"]) {
+			if _, ok := exportProperties[name]; !ok {
+				allErrs = append(allErrs, field.Required(fldPath.Child("properties").Key(name),
+					"the property is required by the import but not defined by the export"))
+			}
+		}
+	}
+	if additional, ok := imported["additionalProperties"].(bool); ok && !additional {
+		for _, name := range stringList(exported["required"]) {
+			if _, ok := importProperties[name]; !ok {
+				allErrs = append(allErrs, field.Forbidden(fldPath.Child("properties").Key(name),
+					"the property is required by the export but not allowed by the import"))
+			}
+		}
+	}
+
+	propertyNames := make([]string, 0, len(importProperties))
+	for name := range importProperties {
+		if _, ok := exportProperties[name]; ok {
+			propertyNames = append(propertyNames, name)
+		}
+	}
+	sort.Strings(propertyNames)
+	for _, name := range propertyNames {
+		allErrs = append(allErrs, verifySchemaCompatibility(fldPath.Child("properties").Key(name),
+			exportProperties[name], importProperties[name])...)
+	}
+
+	if exportItems, ok := exported["items"]; ok {
+		if importItems, ok := imported["items"]; ok {
+			allErrs = append(allErrs, verifySchemaCompatibility(fldPath.Child("items"), exportItems, importItems)...)
+		}
+	}
+
+	return allErrs
+}
+
+// resolveSchema resolves all references of a json schema of a blueprint.
+func resolveSchema(party ContractParty, schema *lsv1alpha1.JSONSchemaDefinition) (interface{}, error) {
+	refCtx := party.ReferenceContext
+	if refCtx == nil {
+		refCtx = &jsonschema.ReferenceContext{
+			LocalTypes:  party.Blueprint.Info.LocalTypes,
+			BlueprintFs: party.Blueprint.Fs,
+		}
+	}
+	return jsonschema.NewReferenceResolver(refCtx).Resolve(schema.RawMessage)
+}
+
+// findImportDefinition returns the import definition with the given name including conditional imports.
+func findImportDefinition(importDefs lsv1alpha1.ImportDefinitionList, name string) *lsv1alpha1.ImportDefinition {
+	for i := range importDefs {
+		if importDefs[i].Name == name {
+			return &importDefs[i]
+		}
+		if def := findImportDefinition(importDefs[i].ConditionalImports, name); def != nil {
+			return def
+		}
+	}
+	return nil
+}
+
+// findExportDefinition returns the export definition with the given name.
+func findExportDefinition(exportDefs lsv1alpha1.ExportDefinitionList, name string) *lsv1alpha1.ExportDefinition {
+	for i := range exportDefs {
+		if exportDefs[i].Name == name {
+			return &exportDefs[i]
+		}
+	}
+	return nil
+}
+
+// getImportType returns the type of an import definition.
+// Import definitions without type are data imports if they define a schema and target imports otherwise.
+func getImportType(def *lsv1alpha1.ImportDefinition) lsv1alpha1.ImportType {
+	if len(def.Type) != 0 {
+		return def.Type
+	}
+	if def.Schema != nil {
+		return lsv1alpha1.ImportTypeData
+	}
+	return lsv1alpha1.ImportTypeTarget
+}
+
+// getExportType returns the type of an export definition.
+// Export definitions without type are data exports if they define a schema and target exports otherwise.
+func getExportType(def *lsv1alpha1.ExportDefinition) lsv1alpha1.ExportType {
+	if len(def.Type) != 0 {
+		return def.Type
+	}
+	if def.Schema != nil {
+		return lsv1alpha1.ExportTypeData
+	}
+	return lsv1alpha1.ExportTypeTarget
+}
+
+// schemaTypes returns the types that are allowed by a json schema.
+func schemaTypes(schema map[string]interface{}) sets.Set[string] {
+	switch t := schema["type"].(type) {
+	case string:
+		return sets.New(t)
+	default:
+		return sets.New(stringList(t)...)
+	}
+}
+
+// stringList returns the strings of a decoded json list.
+func stringList(value interface{}) []string {
+	list, _ := value.([]interface{})
+	res := make([]string, 0, len(list))
+	for _, elem := range list {
+		if s, ok := elem.(string); ok {
+			res = append(res, s)
+		}
+	}
+	return res
+}
+
+func containsValue(list []interface{}, value interface{}) bool {
+	for _, elem := range list {
+		if reflect.DeepEqual(elem, value) {
+			return true
+		}
+	}
+	return false
+}
+
+func enumStrings(list []interface{}) []string {
+	res := make([]string, len(list))
+	for i, elem := range list {
+		res[i] = fmt.Sprint(elem)
+	}
+	return res
+}
@@ -0,0 +1,185 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package blueprints_test
+
+import (
+	"github.com/mandelsoft/vfs/pkg/memoryfs"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gstruct"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+
+	lsv1alpha1 "github.com/gardener/landscaper/apis/core/v1alpha1"
+	"github.com/gardener/landscaper/pkg/landscaper/blueprints"
+)
+
+var _ = Describe("Contract", func() {
+
+	schema := func(s string) *lsv1alpha1.JSONSchemaDefinition {
+		return &lsv1alpha1.JSONSchemaDefinition{RawMessage: []byte(s)}
+	}
+
+	newParty := func(imports lsv1alpha1.ImportDefinitionList, exports lsv1alpha1.ExportDefinitionList) blueprints.ContractParty {
+		bp := &lsv1alpha1.Blueprint{
+			Imports: imports,
+			Exports: exports,
+			LocalTypes: map[string]lsv1alpha1.JSONSchemaDefinition{
+				"endpoint": *schema(`{"type": "object", "properties": {"host": {"type": "string"}, "port": {"type": "integer"}}, "required": ["host", "port"]}`),
+			},
+		}
+		return blueprints.ContractParty{Blueprint: blueprints.New(bp, memoryfs.New())}
+	}
+
+	dataImport := func(name, s string) lsv1alpha1.ImportDefinition {
+		return lsv1alpha1.ImportDefinition{
+			FieldValueDefinition: lsv1alpha1.FieldValueDefinition{Name: name, Schema: schema(s)},
+			Type:                 lsv1alpha1.ImportTypeData,
+		}
+	}
+
+	dataExport := func(name, s string) lsv1alpha1.ExportDefinition {
+		return lsv1alpha1.ExportDefinition{
+			FieldValueDefinition: lsv1alpha1.FieldValueDefinition{Name: name, Schema: schema(s)},
+			Type:                 lsv1alpha1.ExportTypeData,
+		}
+	}
+
+	It("should accept compatible schemas including resolved local references", func() {
+		producer := newParty(nil, lsv1alpha1.ExportDefinitionList{
+			dataExport("endpoint", `{"$ref": "local://endpoint"}`),
+			dataExport("replicas", `{"type": "integer"}`),
+		})
+		consumer := newParty(lsv1alpha1.ImportDefinitionList{
+			dataImport("server", `{"type": "object", "properties": {"host": {"type": "string"}}, "required": ["host"]}`),
+			dataImport("count", `{"type": "number"}`),
+		}, nil)
+
+		allErrs := blueprints.VerifyContract(nil, producer, consumer, blueprints.Contract{
+			"server": "endpoint",
+			"count":  "replicas",
+		})
+		Expect(allErrs).To(BeEmpty())
+	})
+
+	It("should report incompatible schemas", func() {
+		producer := newParty(nil, lsv1alpha1.ExportDefinitionList{
+			dataExport("endpoint", `{"$ref": "local://endpoint"}`),
+			dataExport("mode", `{"type": "string", "enum": ["a", "b"]}`),
+		})
+		consumer := newParty(lsv1alpha1.ImportDefinitionList{
+			dataImport("server", `{"type": "object", "properties": {"port": {"type": "string"}}, "required": ["url"]}`),
+			dataImport("mode", `{"type": "string", "enum": ["a"]}`),
+		}, nil)
+
+		allErrs := blueprints.VerifyContract(nil, producer, consumer, blueprints.Contract{
+			"server": "endpoint",
+			"mode":   "mode",
+		})
+		Expect(allErrs).To(ConsistOf(
+			PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeInvalid),
+				"Field": Equal("imports[server].schema.properties[port].type"),
+			})),
+			PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeRequired),
+				"Field": Equal("imports[server].schema.properties[url]"),
+			})),
+			PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeNotSupported),
+				"Field": Equal("imports[mode].schema.enum"),
+			})),
+		))
+	})
+
+	It("should report mismatching kinds, target types and missing definitions", func() {
+		producer := newParty(nil, lsv1alpha1.ExportDefinitionList{
+			dataExport("data", `{"type": "string"}`),
+			{
+				FieldValueDefinition: lsv1alpha1.FieldValueDefinition{Name: "cluster", TargetType: "landscaper.gardener.cloud/kubernetes-cluster"},
+				Type:                 lsv1alpha1.ExportTypeTarget,
+			},
+		})
+		consumer := newParty(lsv1alpha1.ImportDefinitionList{
+			{
+				FieldValueDefinition: lsv1alpha1.FieldValueDefinition{Name: "target", TargetType: "landscaper.gardener.cloud/mock"},
+				Type:                 lsv1alpha1.ImportTypeTarget,
+			},
+			{
+				FieldValueDefinition: lsv1alpha1.FieldValueDefinition{Name: "targets"},
+				Type:                 lsv1alpha1.ImportTypeTargetList,
+			},
+		}, nil)
+
+		allErrs := blueprints.VerifyContract(nil, producer, consumer, blueprints.Contract{
+			"target":  "cluster",
+			"targets": "data",
+			"missing": "data",
+			"cluster": "missing",
+		})
+		Expect(allErrs).To(ConsistOf(
+			PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeInvalid),
+				"Field": Equal("imports[target].targetType"),
+			})),
+			PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeInvalid),
+				"Field": Equal("imports[targets].type"),
+			})),
+			PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeNotFound),
+				"Field": Equal("imports[missing]"),
+			})),
+			PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeNotFound),
+				"Field": Equal("imports[cluster]"),
+			})),
+		))
+	})
+
+	It("should derive and verify the contracts between subinstallations", func() {
+		producerTmpl := &lsv1alpha1.InstallationTemplate{
+			Name: "producer",
+			Exports: lsv1alpha1.InstallationExports{
+				Data: []lsv1alpha1.DataExport{
+					{Name: "endpoint", DataRef: "endpoint"},
+					{Name: "mode", DataRef: "mapped"},
+				},
+			},
+			ExportDataMappings: map[string]lsv1alpha1.AnyJSON{"mapped": lsv1alpha1.NewAnyJSON([]byte(`"(( mode ))"`))},
+		}
+		consumerTmpl := &lsv1alpha1.InstallationTemplate{
+			Name: "consumer",
+			Imports: lsv1alpha1.InstallationImports{
+				Data: []lsv1alpha1.DataImport{
+					{Name: "server", DataRef: "endpoint"},
+					{Name: "mode", DataRef: "mapped"},
+				},
+			},
+		}
+		Expect(blueprints.NewSubinstallationContract(producerTmpl, consumerTmpl)).To(Equal(blueprints.Contract{
+			"server": "endpoint",
+		}))
+		Expect(blueprints.NewSubinstallationContract(consumerTmpl, producerTmpl)).To(BeEmpty())
+
+		allErrs := blueprints.VerifySubinstallationContracts(field.NewPath("subinstallations"), []blueprints.SubinstallationContractParty{
+			{
+				Template: producerTmpl,
+				ContractParty: newParty(nil, lsv1alpha1.ExportDefinitionList{
+					dataExport("endpoint", `{"type": "string"}`),
+				}),
+			},
+			{
+				Template: consumerTmpl,
+				ContractParty: newParty(lsv1alpha1.ImportDefinitionList{
+					dataImport("server", `{"$ref": "local://endpoint"}`),
+				}, nil),
+			},
+		})
+		Expect(allErrs).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+			"Type":  Equal(field.ErrorTypeInvalid),
+			"Field": Equal("subinstallations[consumer].imports[server].schema.type"),
+		}))))
+	})
+})
@@ -0,0 +1,176 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package blueprints
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"sort"
+
+	"k8s.io/apimachinery/pkg/util/validation/field"
+
+	lsv1alpha1 "github.com/gardener/landscaper/apis/core/v1alpha1"
+	"github.com/gardener/landscaper/pkg/landscaper/jsonschema"
+)
+
+// VerifyInstallation verifies the imports and exports of an installation against the import and export definitions
+// of its blueprint:
+//   - all required imports of the blueprint without default value have to be imported or defined by an import data mapping
+//   - imports have to be of the kind that is defined by the blueprint (data, target, target list or target map)
+//   - imports that are not defined by the blueprint are only allowed as input of import data mappings
+//   - import data mappings have to define data imports of the blueprint; mappings that contain no template expression
+//     are validated against the json schema of the import
+//   - data exports have to be data exports of the blueprint or be defined by an export data mapping
+//   - target exports have to be target exports of the blueprint
+func VerifyInstallation(fldPath *field.Path, inst *lsv1alpha1.Installation, party ContractParty) field.ErrorList {
+	allErrs := field.ErrorList{}
+	allErrs = append(allErrs, verifyInstallationImports(fldPath, inst, party)...)
+	allErrs = append(allErrs, verifyInstallationExports(fldPath, inst, party.Blueprint)...)
+	return allErrs
+}
+
+func verifyInstallationImports(fldPath *field.Path, inst *lsv1alpha1.Installation, party ContractParty) field.ErrorList {
+	allErrs := field.ErrorList{}
+	importDefs := party.Blueprint.Info.Imports
+	hasMappings := len(inst.Spec.ImportDataMappings) > 0
+	imported := map[string]bool{}
+
+	dataPath := fldPath.Child("imports", "data")
+	for i, imp := range inst.Spec.Imports.Data {
+		imported[imp.Name] = true
+		allErrs = append(allErrs, verifyImportKind(dataPath.Index(i), importDefs, imp.Name, lsv1alpha1.ImportTypeData, hasMappings)...)
+	}
+
+	targetsPath := fldPath.Child("imports", "targets")
+	for i, imp := range inst.Spec.Imports.Targets {
+		imported[imp.Name] = true
+		allErrs = append(allErrs, verifyImportKind(targetsPath.Index(i), importDefs, imp.Name, targetImportType(imp), hasMappings)...)
+	}
+
+	mappingsPath := fldPath.Child("importDataMappings")
+	mappingNames := make([]string, 0, len(inst.Spec.ImportDataMappings))
+	for name := range inst.Spec.ImportDataMappings {
+		mappingNames = append(mappingNames, name)
+	}
+	sort.Strings(mappingNames)
+	for _, name := range mappingNames {
+		imported[name] = true
+		mappingPath := mappingsPath.Key(name)
+		def := findImportDefinition(importDefs, name)
+		if def == nil {
+			allErrs = append(allErrs, field.NotFound(mappingPath, name))
+			continue
+		}
+		if impType := getImportType(def); impType != lsv1alpha1.ImportTypeData {
+			allErrs = append(allErrs, field.Invalid(mappingPath, name,
+				fmt.Sprintf("import data mappings can only define data imports, but the blueprint import is of type %q", impType)))
+			continue
+		}
+		allErrs = append(allErrs, verifyImportDataMapping(mappingPath, inst.Spec.ImportDataMappings[name], def, party)...)
+	}
+
+	for _, def := range importDefs {
+		if imported[def.Name] || len(def.Default.Value.RawMessage) != 0 {
+			continue
+		}
+		if def.Required == nil || *def.Required {
+			allErrs = append(allErrs, field.Required(fldPath.Child("imports"),
+				fmt.Sprintf("the blueprint import %q is required", def.Name)))
+		}
+	}
+	return allErrs
+}
+
+// verifyImportKind verifies that an import of an installation has the kind of the corresponding blueprint import.
+// Imports that are not defined by the blueprint are allowed if they can be used as input of import data mappings.
+func verifyImportKind(fldPath *field.Path, importDefs lsv1alpha1.ImportDefinitionList, name string, impType lsv1alpha1.ImportType, hasMappings bool) field.ErrorList {
+	def := findImportDefinition(importDefs, name)
+	if def == nil {
+		if hasMappings {
+			return nil
+		}
+		return field.ErrorList{field.NotFound(fldPath.Child("name"), name)}
+	}
+	if defType := getImportType(def); defType != impType {
+		return field.ErrorList{field.Invalid(fldPath.Child("name"), name,
+			fmt.Sprintf("the import is of type %q, but the blueprint import is of type %q", impType, defType))}
+	}
+	return nil
+}
+
+// verifyImportDataMapping validates an import data mapping that contains no template expression against the
+// json schema of its import. Schemas that cannot be resolved are skipped, as they might reference
+// component descriptors that are only available during the reconciliation.
+func verifyImportDataMapping(fldPath *field.Path, mapping lsv1alpha1.AnyJSON, def *lsv1alpha1.ImportDefinition, party ContractParty) field.ErrorList {
+	if def.Schema == nil || len(mapping.RawMessage) == 0 || bytes.Contains(mapping.RawMessage, []byte("((")) {
+		return nil
+	}
+
+	refCtx := party.ReferenceContext
+	if refCtx == nil {
+		refCtx = &jsonschema.ReferenceContext{
+			LocalTypes:  party.Blueprint.Info.LocalTypes,
+			BlueprintFs: party.Blueprint.Fs,
+		}
+	}
+	validator := jsonschema.NewValidator(refCtx)
+	if err := validator.CompileSchema(def.Schema.RawMessage); err != nil {
+		return nil
+	}
+
+	var value interface{}
+	if err := json.Unmarshal(mapping.RawMessage, &value); err != nil {
+		return field.ErrorList{field.Invalid(fldPath, string(mapping.RawMessage), err.Error())}
+	}
+	if err := validator.ValidateGoStruct(value); err != nil {
+		return field.ErrorList{field.Invalid(fldPath, string(mapping.RawMessage),
+			fmt.Sprintf("the value does not match the schema of the blueprint import: %s", err.Error()))}
+	}
+	return nil
+}
+
+func verifyInstallationExports(fldPath *field.Path, inst *lsv1alpha1.Installation, blueprint *Blueprint) field.ErrorList {
+	allErrs := field.ErrorList{}
+	exportDefs := blueprint.Info.Exports
+
+	dataPath := fldPath.Child("exports", "data")
+	for i, exp := range inst.Spec.Exports.Data {
+		if _, ok := inst.Spec.ExportDataMappings[exp.Name]; ok {
+			continue
+		}
+		allErrs = append(allErrs, verifyExportKind(dataPath.Index(i), exportDefs, exp.Name, lsv1alpha1.ExportTypeData)...)
+	}
+
+	targetsPath := fldPath.Child("exports", "targets")
+	for i, exp := range inst.Spec.Exports.Targets {
+		allErrs = append(allErrs, verifyExportKind(targetsPath.Index(i), exportDefs, exp.Name, lsv1alpha1.ExportTypeTarget)...)
+	}
+	return allErrs
+}
+
+func verifyExportKind(fldPath *field.Path, exportDefs lsv1alpha1.ExportDefinitionList, name string, expType lsv1alpha1.ExportType) field.ErrorList {
+	def := findExportDefinition(exportDefs, name)
+	if def == nil {
+		return field.ErrorList{field.NotFound(fldPath.Child("name"), name)}
+	}
+	if defType := getExportType(def); defType != expType {
+		return field.ErrorList{field.Invalid(fldPath.Child("name"), name,
+			fmt.Sprintf("the export is of type %q, but the blueprint export is of type %q", expType, defType))}
+	}
+	return nil
+}
+
+// targetImportType returns the kind of a target import of an installation.
+func targetImportType(imp lsv1alpha1.TargetImport) lsv1alpha1.ImportType {
+	switch {
+	case imp.Targets != nil || len(imp.TargetListReference) != 0 || imp.TargetSelector != nil:
+		return lsv1alpha1.ImportTypeTargetList
+	case imp.TargetMap != nil || len(imp.TargetMapReference) != 0:
+		return lsv1alpha1.ImportTypeTargetMap
+	default:
+		return lsv1alpha1.ImportTypeTarget
+	}
+}
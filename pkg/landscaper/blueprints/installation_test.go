@@ -0,0 +1,149 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package blueprints_test
+
+import (
+	"github.com/mandelsoft/vfs/pkg/memoryfs"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gstruct"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	"k8s.io/utils/ptr"
+
+	lsv1alpha1 "github.com/gardener/landscaper/apis/core/v1alpha1"
+	"github.com/gardener/landscaper/pkg/landscaper/blueprints"
+)
+
+var _ = Describe("VerifyInstallation", func() {
+
+	var (
+		party blueprints.ContractParty
+		inst  *lsv1alpha1.Installation
+	)
+
+	anyJSON := func(s string) lsv1alpha1.AnyJSON {
+		return lsv1alpha1.AnyJSON{RawMessage: []byte(s)}
+	}
+
+	BeforeEach(func() {
+		bp := &lsv1alpha1.Blueprint{
+			Imports: lsv1alpha1.ImportDefinitionList{
+				{
+					FieldValueDefinition: lsv1alpha1.FieldValueDefinition{Name: "cluster", TargetType: "landscaper.gardener.cloud/kubernetes-cluster"},
+					Type:                 lsv1alpha1.ImportTypeTarget,
+					Required:             ptr.To(true),
+				},
+				{
+					FieldValueDefinition: lsv1alpha1.FieldValueDefinition{Name: "replicas", Schema: &lsv1alpha1.JSONSchemaDefinition{RawMessage: []byte(`{"type": "integer"}`)}},
+					Type:                 lsv1alpha1.ImportTypeData,
+					Required:             ptr.To(true),
+				},
+				{
+					FieldValueDefinition: lsv1alpha1.FieldValueDefinition{Name: "namespace", Schema: &lsv1alpha1.JSONSchemaDefinition{RawMessage: []byte(`{"type": "string"}`)}},
+					Type:                 lsv1alpha1.ImportTypeData,
+					Required:             ptr.To(true),
+					Default:              lsv1alpha1.Default{Value: anyJSON(`"default"`)},
+				},
+				{
+					FieldValueDefinition: lsv1alpha1.FieldValueDefinition{Name: "config", Schema: &lsv1alpha1.JSONSchemaDefinition{RawMessage: []byte(`{"type": "object"}`)}},
+					Type:                 lsv1alpha1.ImportTypeData,
+					Required:             ptr.To(false),
+				},
+			},
+			Exports: lsv1alpha1.ExportDefinitionList{
+				{
+					FieldValueDefinition: lsv1alpha1.FieldValueDefinition{Name: "url", Schema: &lsv1alpha1.JSONSchemaDefinition{RawMessage: []byte(`{"type": "string"}`)}},
+					Type:                 lsv1alpha1.ExportTypeData,
+				},
+				{
+					FieldValueDefinition: lsv1alpha1.FieldValueDefinition{Name: "ingress", TargetType: "landscaper.gardener.cloud/kubernetes-cluster"},
+					Type:                 lsv1alpha1.ExportTypeTarget,
+				},
+			},
+		}
+		party = blueprints.ContractParty{Blueprint: blueprints.New(bp, memoryfs.New())}
+
+		inst = &lsv1alpha1.Installation{}
+		inst.Spec.Imports.Targets = []lsv1alpha1.TargetImport{{Name: "cluster", Target: "my-cluster"}}
+		inst.Spec.Imports.Data = []lsv1alpha1.DataImport{{Name: "replicas", DataRef: "my-replicas"}}
+		inst.Spec.Exports.Data = []lsv1alpha1.DataExport{{Name: "url", DataRef: "my-url"}}
+		inst.Spec.Exports.Targets = []lsv1alpha1.TargetExport{{Name: "ingress", Target: "my-ingress"}}
+	})
+
+	It("should accept an installation that matches its blueprint", func() {
+		Expect(blueprints.VerifyInstallation(field.NewPath("spec"), inst, party)).To(BeEmpty())
+	})
+
+	It("should report missing required imports", func() {
+		inst.Spec.Imports.Data = nil
+
+		Expect(blueprints.VerifyInstallation(field.NewPath("spec"), inst, party)).To(ConsistOf(
+			PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":   Equal(field.ErrorTypeRequired),
+				"Field":  Equal("spec.imports"),
+				"Detail": ContainSubstring(`"replicas"`),
+			})),
+		))
+	})
+
+	It("should report imports and exports that are not defined by the blueprint or have a different kind", func() {
+		inst.Spec.Imports.Targets = []lsv1alpha1.TargetImport{{Name: "cluster", Targets: []string{"a", "b"}}}
+		inst.Spec.Imports.Data = append(inst.Spec.Imports.Data, lsv1alpha1.DataImport{Name: "unknown", DataRef: "x"})
+		inst.Spec.Exports.Data = append(inst.Spec.Exports.Data, lsv1alpha1.DataExport{Name: "ingress", DataRef: "y"})
+		inst.Spec.Exports.Targets = append(inst.Spec.Exports.Targets, lsv1alpha1.TargetExport{Name: "other", Target: "z"})
+
+		Expect(blueprints.VerifyInstallation(field.NewPath("spec"), inst, party)).To(ConsistOf(
+			PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":   Equal(field.ErrorTypeInvalid),
+				"Field":  Equal("spec.imports.targets[0].name"),
+				"Detail": ContainSubstring(`"targetList"`),
+			})),
+			PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeNotFound),
+				"Field": Equal("spec.imports.data[1].name"),
+			})),
+			PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeInvalid),
+				"Field": Equal("spec.exports.data[1].name"),
+			})),
+			PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeNotFound),
+				"Field": Equal("spec.exports.targets[1].name"),
+			})),
+		))
+	})
+
+	It("should accept undefined imports and exports that are used by data mappings", func() {
+		inst.Spec.Imports.Data = []lsv1alpha1.DataImport{{Name: "input", DataRef: "my-input"}}
+		inst.Spec.ImportDataMappings = map[string]lsv1alpha1.AnyJSON{
+			"replicas": anyJSON(`(( input.replicas ))`),
+		}
+		inst.Spec.ExportDataMappings = map[string]lsv1alpha1.AnyJSON{
+			"endpoint": anyJSON(`(( url ))`),
+		}
+		inst.Spec.Exports.Data = append(inst.Spec.Exports.Data, lsv1alpha1.DataExport{Name: "endpoint", DataRef: "my-endpoint"})
+
+		Expect(blueprints.VerifyInstallation(field.NewPath("spec"), inst, party)).To(BeEmpty())
+	})
+
+	It("should validate import data mappings without template expressions against the schema of the import", func() {
+		inst.Spec.ImportDataMappings = map[string]lsv1alpha1.AnyJSON{
+			"config":  anyJSON(`"not-an-object"`),
+			"unknown": anyJSON(`1`),
+		}
+
+		Expect(blueprints.VerifyInstallation(field.NewPath("spec"), inst, party)).To(ConsistOf(
+			PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":   Equal(field.ErrorTypeInvalid),
+				"Field":  Equal("spec.importDataMappings[config]"),
+				"Detail": ContainSubstring("schema of the blueprint import"),
+			})),
+			PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeNotFound),
+				"Field": Equal("spec.importDataMappings[unknown]"),
+			})),
+		))
+	})
+})
@@ -1,4 +1,8 @@
-package landscaper_test
+// SPDX-FileCopyrightText: 2022 SAP SE or an SAP affiliate company and Gardener contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package renderer_test
 
 import (
 	. "github.com/onsi/ginkgo/v2"
@@ -10,7 +14,7 @@ import (
 	"sigs.k8s.io/yaml"
 
 	"github.com/gardener/landscaper/pkg/landscaper/blueprints"
-	lsutils "github.com/gardener/landscaper/pkg/utils/landscaper"
+	"github.com/gardener/landscaper/pkg/landscaper/blueprints/renderer"
 )
 
 func GetBlueprint(path string) *blueprints.Blueprint {
@@ -34,13 +38,13 @@ func GetImports(path string) map[string]interface{} {
 	return imports
 }
 
-var _ = Describe("Landscaper", func() {
+var _ = Describe("Renderer", func() {
 
 	Context("Render blueprint", func() {
 
 		It("should render a blueprint that imports a target list", func() {
-			renderer := lsutils.NewBlueprintRenderer(nil, nil, nil)
-			_, err := renderer.RenderDeployItemsAndSubInstallations(&lsutils.ResolvedInstallation{
+			r := renderer.NewBlueprintRenderer(nil, nil, nil)
+			_, err := r.RenderDeployItemsAndSubInstallations(&renderer.ResolvedInstallation{
 				ComponentVersion: nil,
 				Installation:     nil,
 				Blueprint:        GetBlueprint("./testdata/00-blueprint-with-targetlist/blueprint"),
@@ -24,6 +24,7 @@ import (
 	"github.com/gardener/landscaper/pkg/api"
 	"github.com/gardener/landscaper/pkg/components/model"
 	"github.com/gardener/landscaper/pkg/components/model/types"
+	"github.com/gardener/landscaper/pkg/components/registries/git"
 	"github.com/gardener/landscaper/pkg/utils"
 )
 
@@ -36,8 +37,12 @@ func ResolveBlueprint(ctx context.Context,
 	cdRef *lsv1alpha1.ComponentDescriptorReference,
 	bpDef lsv1alpha1.BlueprintDefinition) (*Blueprint, error) {
 
-	if bpDef.Reference == nil && bpDef.Inline == nil {
-		return nil, errors.New("no remote reference nor a inline blueprint is defined")
+	if bpDef.Reference == nil && bpDef.Inline == nil && bpDef.Git == nil {
+		return nil, errors.New("no remote reference nor a inline blueprint nor a git reference is defined")
+	}
+
+	if bpDef.Git != nil {
+		return resolveGitBlueprint(ctx, bpDef.Git)
 	}
 
 	if bpDef.Inline != nil {
@@ -99,8 +104,12 @@ func Resolve(ctx context.Context, registryAccess model.RegistryAccess, cdRef *ls
 	pm := utils.StartPerformanceMeasurement(&logger, "ResolveBlueprint")
 	defer pm.StopDebug()
 
-	if bpDef.Reference == nil && bpDef.Inline == nil {
-		return nil, errors.New("no remote reference nor a inline blueprint is defined")
+	if bpDef.Reference == nil && bpDef.Inline == nil && bpDef.Git == nil {
+		return nil, errors.New("no remote reference nor a inline blueprint nor a git reference is defined")
+	}
+
+	if bpDef.Git != nil {
+		return resolveGitBlueprint(ctx, bpDef.Git)
 	}
 
 	if bpDef.Inline != nil {
@@ -164,6 +173,19 @@ func Resolve(ctx context.Context, registryAccess model.RegistryAccess, cdRef *ls
 	return blueprint, nil
 }
 
+// resolveGitBlueprint reads a blueprint from a repository of the git registry.
+func resolveGitBlueprint(ctx context.Context, ref *lsv1alpha1.GitBlueprintReference) (*Blueprint, error) {
+	registry := git.GetRegistry()
+	if registry == nil {
+		return nil, errors.New("the blueprint is defined by a git reference, but no git registry is configured")
+	}
+	fs, err := registry.GetBlueprintFs(ctx, ref)
+	if err != nil {
+		return nil, err
+	}
+	return NewFromFs(readonlyfs.New(fs))
+}
+
 // GetBlueprintResourceFromComponentDescriptor returns the blueprint resource from a component descriptor.
 func GetBlueprintResourceFromComponentDescriptor(cd *types.ComponentDescriptor, blueprintName string) (types.Resource, error) {
 	// get blueprint resource from component descriptor
@@ -0,0 +1,177 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package blueprints
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+
+	apivalidation "k8s.io/apimachinery/pkg/api/validation"
+	"k8s.io/apimachinery/pkg/util/sets"
+
+	lsv1alpha1 "github.com/gardener/landscaper/apis/core/v1alpha1"
+	"github.com/gardener/landscaper/apis/core/validation"
+)
+
+// ExpandInstallationTemplates renders the installation templates of a blueprint with the data imports of the parent installation.
+// Templates whose includeIf import is not truthy are dropped, and templates with a forEach definition are replaced by
+// one template per element of the referenced list import. The element is passed to the subinstallation as
+// import data mapping.
+// The returned records describe all rendered subinstallations and are meant to be stored in the status of the parent.
+func ExpandInstallationTemplates(templates []*lsv1alpha1.InstallationTemplate,
+	imports map[string]interface{}) ([]*lsv1alpha1.InstallationTemplate, []lsv1alpha1.RenderedSubinstallation, error) {
+
+	var (
+		expanded = make([]*lsv1alpha1.InstallationTemplate, 0, len(templates))
+		rendered []lsv1alpha1.RenderedSubinstallation
+	)
+
+	appendTemplate := func(tmpl *lsv1alpha1.InstallationTemplate, templateName, forEachKey string) error {
+		data, err := json.Marshal(tmpl)
+		if err != nil {
+			return fmt.Errorf("unable to marshal subinstallation %q: %w", tmpl.Name, err)
+		}
+		sum := sha256.Sum256(data)
+		expanded = append(expanded, tmpl)
+		rendered = append(rendered, lsv1alpha1.RenderedSubinstallation{
+			Name:       tmpl.Name,
+			Template:   templateName,
+			ForEachKey: forEachKey,
+			Hash:       hex.EncodeToString(sum[:]),
+		})
+		return nil
+	}
+
+	for _, tmpl := range templates {
+		if len(tmpl.IncludeIf) != 0 && !isTruthy(imports[tmpl.IncludeIf]) {
+			continue
+		}
+
+		if tmpl.ForEach == nil {
+			if err := appendTemplate(tmpl, tmpl.Name, ""); err != nil {
+				return nil, nil, err
+			}
+			continue
+		}
+
+		elements, err := forEachElements(tmpl, imports)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		keys := sets.New[string]()
+		for i, element := range elements {
+			key, err := forEachKey(tmpl, i, element)
+			if err != nil {
+				return nil, nil, err
+			}
+			if keys.Has(key) {
+				return nil, nil, fmt.Errorf("subinstallation template %q: duplicate key %q in import %q",
+					tmpl.Name, key, tmpl.ForEach.Import)
+			}
+			keys.Insert(key)
+
+			sub, err := renderForEachTemplate(tmpl, key, element)
+			if err != nil {
+				return nil, nil, err
+			}
+			if err := appendTemplate(sub, tmpl.Name, key); err != nil {
+				return nil, nil, err
+			}
+		}
+	}
+
+	return expanded, rendered, nil
+}
+
+// forEachElements returns the elements of the list import referenced by the forEach definition of a template.
+// A missing import results in no elements.
+func forEachElements(tmpl *lsv1alpha1.InstallationTemplate, imports map[string]interface{}) ([]interface{}, error) {
+	value, ok := imports[tmpl.ForEach.Import]
+	if !ok || value == nil {
+		return nil, nil
+	}
+	elements, ok := value.([]interface{})
+	if !ok {
+		return nil, fmt.Errorf("subinstallation template %q: import %q is not a list but %T",
+			tmpl.Name, tmpl.ForEach.Import, value)
+	}
+	return elements, nil
+}
+
+// forEachKey returns the suffix of the name of the subinstallation that is generated for the i-th element of a list.
+func forEachKey(tmpl *lsv1alpha1.InstallationTemplate, i int, element interface{}) (string, error) {
+	if len(tmpl.ForEach.Key) == 0 {
+		return strconv.Itoa(i), nil
+	}
+
+	obj, ok := element.(map[string]interface{})
+	if !ok {
+		return "", fmt.Errorf("subinstallation template %q: element %d of import %q is not an object",
+			tmpl.Name, i, tmpl.ForEach.Import)
+	}
+	key, ok := obj[tmpl.ForEach.Key].(string)
+	if !ok || len(key) == 0 {
+		return "", fmt.Errorf("subinstallation template %q: element %d of import %q has no string field %q",
+			tmpl.Name, i, tmpl.ForEach.Import, tmpl.ForEach.Key)
+	}
+	return key, nil
+}
+
+// renderForEachTemplate returns the template of the subinstallation that is generated for an element of a list.
+func renderForEachTemplate(tmpl *lsv1alpha1.InstallationTemplate, key string, element interface{}) (*lsv1alpha1.InstallationTemplate, error) {
+	name := fmt.Sprintf("%s-%s", tmpl.Name, key)
+	if msgs := apivalidation.NameIsDNSLabel(name, false); len(msgs) != 0 {
+		return nil, fmt.Errorf("subinstallation template %q: invalid name %q: %s", tmpl.Name, name, strings.Join(msgs, ", "))
+	}
+	// need to reduce length by 1 because "-" is added during subinstallation creation
+	if len(name) > validation.InstallationGenerateNameMaxLength-1 {
+		return nil, fmt.Errorf("subinstallation template %q: name %q must not be longer than %d characters",
+			tmpl.Name, name, validation.InstallationGenerateNameMaxLength-1)
+	}
+
+	data, err := json.Marshal(element)
+	if err != nil {
+		return nil, fmt.Errorf("subinstallation template %q: unable to marshal element %q: %w", tmpl.Name, key, err)
+	}
+
+	sub := tmpl.DeepCopy()
+	sub.Name = name
+	sub.ForEach = nil
+	sub.IncludeIf = ""
+	if sub.ImportDataMappings == nil {
+		sub.ImportDataMappings = map[string]lsv1alpha1.AnyJSON{}
+	}
+	sub.ImportDataMappings[tmpl.ForEach.As] = lsv1alpha1.NewAnyJSON(data)
+	return sub, nil
+}
+
+// isTruthy returns whether an import value enables the inclusion of a subinstallation.
+// Missing values, false, 0, empty strings, empty lists and empty maps are not truthy.
+func isTruthy(value interface{}) bool {
+	if value == nil {
+		return false
+	}
+	v := reflect.ValueOf(value)
+	switch v.Kind() {
+	case reflect.Bool:
+		return v.Bool()
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return v.Int() != 0
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return v.Uint() != 0
+	case reflect.Float32, reflect.Float64:
+		return v.Float() != 0
+	case reflect.String, reflect.Slice, reflect.Map:
+		return v.Len() != 0
+	default:
+		return true
+	}
+}
@@ -0,0 +1,141 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package blueprints_test
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	lsv1alpha1 "github.com/gardener/landscaper/apis/core/v1alpha1"
+	"github.com/gardener/landscaper/pkg/landscaper/blueprints"
+)
+
+var _ = Describe("Subinstallation templates", func() {
+
+	newTemplate := func(name string) *lsv1alpha1.InstallationTemplate {
+		tmpl := &lsv1alpha1.InstallationTemplate{Name: name}
+		tmpl.Blueprint.Ref = "cd://resources/blueprint"
+		return tmpl
+	}
+
+	names := func(templates []*lsv1alpha1.InstallationTemplate) []string {
+		res := make([]string, 0, len(templates))
+		for _, tmpl := range templates {
+			res = append(res, tmpl.Name)
+		}
+		return res
+	}
+
+	It("should keep templates without forEach and includeIf definitions", func() {
+		tmpl := newTemplate("a")
+		expanded, rendered, err := blueprints.ExpandInstallationTemplates([]*lsv1alpha1.InstallationTemplate{tmpl}, nil)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(expanded).To(ConsistOf(tmpl))
+		Expect(rendered).To(HaveLen(1))
+		Expect(rendered[0].Name).To(Equal("a"))
+		Expect(rendered[0].Template).To(Equal("a"))
+		Expect(rendered[0].Hash).ToNot(BeEmpty())
+	})
+
+	DescribeTable("should include a template depending on its includeIf import",
+		func(value interface{}, included bool) {
+			tmpl := newTemplate("a")
+			tmpl.IncludeIf = "enabled"
+			imports := map[string]interface{}{"enabled": value}
+			expanded, _, err := blueprints.ExpandInstallationTemplates([]*lsv1alpha1.InstallationTemplate{tmpl}, imports)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(expanded).To(HaveLen(map[bool]int{true: 1, false: 0}[included]))
+		},
+		Entry("true", true, true),
+		Entry("false", false, false),
+		Entry("null", nil, false),
+		Entry("non-zero number", float64(3), true),
+		Entry("zero", float64(0), false),
+		Entry("non-empty string", "yes", true),
+		Entry("empty string", "", false),
+		Entry("non-empty list", []interface{}{1}, true),
+		Entry("empty list", []interface{}{}, false),
+		Entry("empty map", map[string]interface{}{}, false),
+	)
+
+	It("should generate a subinstallation for every element of a list import", func() {
+		tmpl := newTemplate("cluster")
+		tmpl.ForEach = &lsv1alpha1.InstallationTemplateForEach{Import: "clusters", As: "cluster"}
+		imports := map[string]interface{}{
+			"clusters": []interface{}{"a", "b"},
+		}
+
+		expanded, rendered, err := blueprints.ExpandInstallationTemplates([]*lsv1alpha1.InstallationTemplate{tmpl}, imports)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(names(expanded)).To(Equal([]string{"cluster-0", "cluster-1"}))
+		Expect(expanded[0].ForEach).To(BeNil())
+		Expect(expanded[0].ImportDataMappings).To(HaveKeyWithValue("cluster", lsv1alpha1.NewAnyJSON([]byte(`"a"`))))
+		Expect(expanded[1].ImportDataMappings).To(HaveKeyWithValue("cluster", lsv1alpha1.NewAnyJSON([]byte(`"b"`))))
+		Expect(tmpl.ImportDataMappings).To(BeEmpty())
+
+		Expect(rendered).To(HaveLen(2))
+		Expect(rendered[1].Name).To(Equal("cluster-1"))
+		Expect(rendered[1].Template).To(Equal("cluster"))
+		Expect(rendered[1].ForEachKey).To(Equal("1"))
+		Expect(rendered[0].Hash).ToNot(Equal(rendered[1].Hash))
+	})
+
+	It("should use the key field of the list elements as name suffix", func() {
+		tmpl := newTemplate("cluster")
+		tmpl.ForEach = &lsv1alpha1.InstallationTemplateForEach{Import: "clusters", As: "cluster", Key: "name"}
+		imports := map[string]interface{}{
+			"clusters": []interface{}{
+				map[string]interface{}{"name": "eu"},
+				map[string]interface{}{"name": "us"},
+			},
+		}
+
+		expanded, rendered, err := blueprints.ExpandInstallationTemplates([]*lsv1alpha1.InstallationTemplate{tmpl}, imports)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(names(expanded)).To(Equal([]string{"cluster-eu", "cluster-us"}))
+		Expect(rendered[0].ForEachKey).To(Equal("eu"))
+	})
+
+	It("should not generate subinstallations if the list import is not set", func() {
+		tmpl := newTemplate("cluster")
+		tmpl.ForEach = &lsv1alpha1.InstallationTemplateForEach{Import: "clusters", As: "cluster"}
+
+		expanded, rendered, err := blueprints.ExpandInstallationTemplates([]*lsv1alpha1.InstallationTemplate{tmpl}, nil)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(expanded).To(BeEmpty())
+		Expect(rendered).To(BeEmpty())
+	})
+
+	It("should fail if the forEach import is not a list", func() {
+		tmpl := newTemplate("cluster")
+		tmpl.ForEach = &lsv1alpha1.InstallationTemplateForEach{Import: "clusters", As: "cluster"}
+		imports := map[string]interface{}{"clusters": "a"}
+
+		_, _, err := blueprints.ExpandInstallationTemplates([]*lsv1alpha1.InstallationTemplate{tmpl}, imports)
+		Expect(err).To(HaveOccurred())
+	})
+
+	It("should fail if keys are duplicated or no valid name suffix", func() {
+		tmpl := newTemplate("cluster")
+		tmpl.ForEach = &lsv1alpha1.InstallationTemplateForEach{Import: "clusters", As: "cluster", Key: "name"}
+
+		imports := map[string]interface{}{
+			"clusters": []interface{}{
+				map[string]interface{}{"name": "eu"},
+				map[string]interface{}{"name": "eu"},
+			},
+		}
+		_, _, err := blueprints.ExpandInstallationTemplates([]*lsv1alpha1.InstallationTemplate{tmpl}, imports)
+		Expect(err).To(MatchError(ContainSubstring("duplicate key")))
+
+		imports = map[string]interface{}{
+			"clusters": []interface{}{
+				map[string]interface{}{"name": "EU_1"},
+			},
+		}
+		_, _, err = blueprints.ExpandInstallationTemplates([]*lsv1alpha1.InstallationTemplate{tmpl}, imports)
+		Expect(err).To(MatchError(ContainSubstring("invalid name")))
+	})
+})
@@ -57,6 +57,7 @@ func (c *defaulterController) Reconcile(ctx context.Context, req reconcile.Reque
 
 	logger := c.log.StartReconcile(req)
 	ctx = logging.NewContext(ctx, logger)
+	ctx = read_write_layer.ContextWithActor(ctx, "context-controller")
 
 	ns := &corev1.Namespace{}
 	if err := c.lsUncachedClient.Get(ctx, req.NamespacedName, ns); err != nil {
@@ -0,0 +1,32 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package deployerregistration
+
+import (
+	"github.com/go-logr/logr"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/manager"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	lsv1alpha1 "github.com/gardener/landscaper/apis/core/v1alpha1"
+	"github.com/gardener/landscaper/controller-utils/pkg/logging"
+)
+
+// AddControllerToManager adds the deployer registration controller to the manager.
+// The controller validates the registrations and periodically checks the health of the registered deployers.
+func AddControllerToManager(lsUncachedClient, lsCachedClient client.Client, logger logging.Logger, lsMgr manager.Manager) error {
+	log := logger.Reconciles("deployerRegistration", "DeployerRegistration")
+	ctrl := NewController(lsUncachedClient, lsCachedClient, log)
+
+	predicates := builder.WithPredicates(predicate.Or(predicate.GenerationChangedPredicate{},
+		predicate.AnnotationChangedPredicate{}))
+
+	return builder.ControllerManagedBy(lsMgr).
+		For(&lsv1alpha1.DeployerRegistration{}, predicates).
+		WithLogConstructor(func(r *reconcile.Request) logr.Logger { return log.Logr() }).
+		Complete(ctrl)
+}
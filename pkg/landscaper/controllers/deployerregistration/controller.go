@@ -0,0 +1,166 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package deployerregistration
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"time"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	lsv1alpha1 "github.com/gardener/landscaper/apis/core/v1alpha1"
+	"github.com/gardener/landscaper/controller-utils/pkg/logging"
+	lc "github.com/gardener/landscaper/controller-utils/pkg/logging/constants"
+	"github.com/gardener/landscaper/pkg/landscaper/deployerregistrations"
+	"github.com/gardener/landscaper/pkg/utils"
+	"github.com/gardener/landscaper/pkg/utils/read_write_layer"
+)
+
+const (
+	// DefaultHealthCheckInterval is the default time between two health checks of a registered deployer.
+	DefaultHealthCheckInterval = 1 * time.Minute
+	// DefaultHealthCheckTimeout is the default timeout of a single health check request.
+	DefaultHealthCheckTimeout = 10 * time.Second
+)
+
+// Controller is the deployer registration controller.
+type Controller struct {
+	lsUncachedClient client.Client
+	lsCachedClient   client.Client
+	log              logging.Logger
+	httpClient       *http.Client
+}
+
+// NewController returns a new deployer registration controller.
+func NewController(lsUncachedClient, lsCachedClient client.Client, logger logging.Logger) *Controller {
+	return &Controller{
+		lsUncachedClient: lsUncachedClient,
+		lsCachedClient:   lsCachedClient,
+		log:              logger,
+		httpClient: &http.Client{
+			// redirects are not followed as they could lead to endpoints outside the cluster.
+			CheckRedirect: func(*http.Request, []*http.Request) error {
+				return http.ErrUseLastResponse
+			},
+		},
+	}
+}
+
+// Reconcile reconciles requests for deployer registrations.
+func (c *Controller) Reconcile(ctx context.Context, req reconcile.Request) (result reconcile.Result, err error) {
+	_, ctx = c.log.StartReconcileAndAddToContext(ctx, req)
+
+	result = reconcile.Result{}
+	defer utils.HandlePanics(ctx, &result, nil)
+
+	return c.reconcile(ctx, req)
+}
+
+func (c *Controller) reconcile(ctx context.Context, req reconcile.Request) (reconcile.Result, error) {
+	logger, ctx := logging.FromContextOrNew(ctx, nil)
+
+	reg := &lsv1alpha1.DeployerRegistration{}
+	if err := c.lsUncachedClient.Get(ctx, req.NamespacedName, reg); err != nil {
+		if apierrors.IsNotFound(err) {
+			logger.Info(err.Error())
+			return reconcile.Result{}, nil
+		}
+		return reconcile.Result{}, err
+	}
+
+	reg.Status.ObservedGeneration = reg.GetGeneration()
+	reg.Status.Message = ""
+
+	if err := c.validate(ctx, reg); err != nil {
+		reg.Status.Phase = lsv1alpha1.DeployerRegistrationPhaseInvalid
+		reg.Status.Message = err.Error()
+	} else if reg.Spec.HealthCheck == nil {
+		reg.Status.Phase = lsv1alpha1.DeployerRegistrationPhaseRegistered
+		reg.Status.LastHealthCheckTime = nil
+	} else {
+		if err := c.checkHealth(ctx, reg.Spec.HealthCheck); err != nil {
+			logger.Info("deployer is unhealthy", lc.KeyError, err.Error())
+			reg.Status.Phase = lsv1alpha1.DeployerRegistrationPhaseUnhealthy
+			reg.Status.Message = err.Error()
+		} else {
+			reg.Status.Phase = lsv1alpha1.DeployerRegistrationPhaseHealthy
+		}
+		now := metav1.Now()
+		reg.Status.LastHealthCheckTime = &now
+	}
+
+	if err := read_write_layer.NewWriter(c.lsUncachedClient).UpdateDeployerRegistrationStatus(ctx, read_write_layer.W000181, reg); err != nil {
+		logger.Error(err, "updating status of deployer registration failed")
+		return reconcile.Result{Requeue: true}, nil
+	}
+
+	// the registrations are checked periodically as the health of the deployers and
+	// conflicts with other registrations can change without an update of the registration itself.
+	return reconcile.Result{RequeueAfter: getHealthCheckInterval(reg.Spec.HealthCheck)}, nil
+}
+
+// validate checks that the registration claims at least one deploy item type, that none of its types
+// is claimed by an older registration and that its health check refers to a service in the cluster.
+func (c *Controller) validate(ctx context.Context, reg *lsv1alpha1.DeployerRegistration) error {
+	if len(reg.Spec.DeployItemTypes) == 0 {
+		return fmt.Errorf("at least one deploy item type has to be registered")
+	}
+	if reg.Spec.HealthCheck != nil {
+		if err := deployerregistrations.ValidateHealthCheckURL(reg.Spec.HealthCheck.URL); err != nil {
+			return err
+		}
+	}
+
+	registrations := &lsv1alpha1.DeployerRegistrationList{}
+	if err := read_write_layer.ListDeployerRegistrations(ctx, c.lsCachedClient, registrations, read_write_layer.R000112); err != nil {
+		return fmt.Errorf("unable to list deployer registrations: %w", err)
+	}
+
+	if conflicts := deployerregistrations.ConflictingDeployItemTypes(reg, registrations.Items); len(conflicts) != 0 {
+		return fmt.Errorf("deploy item types %v are already registered by another deployer", conflicts)
+	}
+	return nil
+}
+
+// checkHealth probes the health check endpoint of the deployer.
+func (c *Controller) checkHealth(ctx context.Context, healthCheck *lsv1alpha1.DeployerHealthCheck) error {
+	ctx, cancel := context.WithTimeout(ctx, getHealthCheckTimeout(healthCheck))
+	defer cancel()
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, healthCheck.URL, nil)
+	if err != nil {
+		return fmt.Errorf("invalid health check url: %w", err)
+	}
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return fmt.Errorf("health check failed: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return fmt.Errorf("health check returned status code %d", resp.StatusCode)
+	}
+	return nil
+}
+
+func getHealthCheckInterval(healthCheck *lsv1alpha1.DeployerHealthCheck) time.Duration {
+	if healthCheck == nil || healthCheck.Interval == nil {
+		return DefaultHealthCheckInterval
+	}
+	return healthCheck.Interval.Duration
+}
+
+func getHealthCheckTimeout(healthCheck *lsv1alpha1.DeployerHealthCheck) time.Duration {
+	if healthCheck.Timeout == nil {
+		return DefaultHealthCheckTimeout
+	}
+	return healthCheck.Timeout.Duration
+}
@@ -15,7 +15,6 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/reconcile"
 
 	"github.com/gardener/landscaper/apis/config"
-	lscore "github.com/gardener/landscaper/apis/core"
 	lsv1alpha1 "github.com/gardener/landscaper/apis/core/v1alpha1"
 	"github.com/gardener/landscaper/controller-utils/pkg/logging"
 	"github.com/gardener/landscaper/pkg/utils"
@@ -24,20 +23,22 @@ import (
 func AddControllerToManager(lsUncachedClient, lsCachedClient client.Client,
 	logger logging.Logger,
 	lsMgr manager.Manager,
-	config config.DeployItemsController,
-	deployItemPickupTimeout *lscore.Duration) error {
+	controllerConfig config.DeployItemsController,
+	deployItemTimeouts *config.DeployItemTimeouts) error {
 
 	log := logger.Reconciles("", "DeployItem")
 
 	log.Info(fmt.Sprintf("Running on pod %s in namespace %s", utils.GetCurrentPodName(), utils.GetCurrentPodNamespace()),
-		"numberOfWorkerThreads", config.CommonControllerConfig.Workers)
+		"numberOfWorkerThreads", controllerConfig.CommonControllerConfig.Workers,
+		"maxWorkersPerNamespace", controllerConfig.MaxWorkersPerNamespace)
 
 	a, err := NewController(
 		lsUncachedClient, lsCachedClient,
 		log,
 		lsMgr.GetScheme(),
-		deployItemPickupTimeout,
-		config.CommonControllerConfig.Workers,
+		deployItemTimeouts,
+		controllerConfig.CommonControllerConfig.Workers,
+		controllerConfig.MaxWorkersPerNamespace,
 	)
 	if err != nil {
 		return err
@@ -45,7 +46,7 @@ func AddControllerToManager(lsUncachedClient, lsCachedClient client.Client,
 
 	return builder.ControllerManagedBy(lsMgr).
 		For(&lsv1alpha1.DeployItem{}, builder.OnlyMetadata).
-		WithOptions(utils.ConvertCommonControllerConfigToControllerOptions(config.CommonControllerConfig)).
+		WithOptions(utils.ConvertCommonControllerConfigToControllerOptions(controllerConfig.CommonControllerConfig)).
 		WithLogConstructor(func(r *reconcile.Request) logr.Logger { return log.Logr() }).
 		Complete(a)
 }
@@ -11,6 +11,7 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/reconcile"
 
+	"github.com/gardener/landscaper/apis/config"
 	lscore "github.com/gardener/landscaper/apis/core"
 	lsv1alpha1 "github.com/gardener/landscaper/apis/core/v1alpha1"
 	"github.com/gardener/landscaper/controller-utils/pkg/logging"
@@ -22,10 +23,13 @@ import (
 // To detect pickup timeouts (when a DeployItem resource is not reconciled by any deployer within a specified timeframe), the controller checks for a timestamp annotation.
 // It is expected that deployers remove the timestamp annotation from deploy items during reconciliation. If the timestamp annotation exists and is older than a specified duration,
 // the controller marks the deploy item as failed.
-// pickupTimeout is a string containing the pickup timeout duration, either as 'none' or as a duration that can be parsed by time.ParseDuration.
+// To detect abort timeouts, the controller checks whether a picked up deploy item has not been finished within its progressing timeout plus the abort timeout.
+// In this case, the deployer did not react on the exceeded progressing timeout and the controller marks the deploy item as failed.
+// The timeouts contain the global defaults, either as 'none' or as a duration that can be parsed by time.ParseDuration.
+// They can be overwritten per deploy item.
 func NewController(lsUncachedClient, lsCachedClient client.Client,
-	logger logging.Logger, scheme *runtime.Scheme, pickupTimeout *lscore.Duration,
-	maxNumberOfWorkers int) (reconcile.Reconciler, error) {
+	logger logging.Logger, scheme *runtime.Scheme, timeouts *config.DeployItemTimeouts,
+	maxNumberOfWorkers, maxWorkersPerNamespace int) (reconcile.Reconciler, error) {
 
 	wc := utils.NewWorkerCounter(maxNumberOfWorkers)
 
@@ -35,27 +39,48 @@ func NewController(lsUncachedClient, lsCachedClient client.Client,
 		log:              logger,
 		scheme:           scheme,
 		workerCounter:    wc,
+		namespaceLimiter: utils.NewNamespaceLimiter(maxWorkersPerNamespace),
 	}
 
-	if pickupTimeout != nil {
-		con.pickupTimeout = pickupTimeout.Duration
-	} else {
-		con.pickupTimeout = time.Duration(0)
+	if timeouts != nil {
+		con.pickupTimeout = durationOrZero(timeouts.Pickup)
+		con.progressingTimeout = durationOrZero(timeouts.ProgressingDefault)
+		con.abortTimeout = durationOrZero(timeouts.Abort)
 	}
 
-	// log pickup timeout
+	// log timeouts
 	logger.Info("deploy item pickup timeout detection", "active", con.pickupTimeout != 0, "timeout", con.pickupTimeout.String())
+	logger.Info("deploy item abort timeout detection", "active", con.abortTimeout != 0, "timeout", con.abortTimeout.String(),
+		"defaultProgressingTimeout", con.progressingTimeout.String())
 
 	return &con, nil
 }
 
 type controller struct {
-	lsUncachedClient client.Client
-	lsCachedClient   client.Client
-	log              logging.Logger
-	scheme           *runtime.Scheme
-	pickupTimeout    time.Duration
-	workerCounter    *utils.WorkerCounter
+	lsUncachedClient   client.Client
+	lsCachedClient     client.Client
+	log                logging.Logger
+	scheme             *runtime.Scheme
+	pickupTimeout      time.Duration
+	progressingTimeout time.Duration
+	abortTimeout       time.Duration
+	workerCounter      *utils.WorkerCounter
+	namespaceLimiter   *utils.NamespaceLimiter
+}
+
+func durationOrZero(d *lscore.Duration) time.Duration {
+	if d == nil {
+		return time.Duration(0)
+	}
+	return d.Duration
+}
+
+// getTimeout returns the timeout of the deploy item, or the given default if the deploy item does not specify one.
+func getTimeout(timeout *lsv1alpha1.Duration, defaultTimeout time.Duration) time.Duration {
+	if timeout == nil {
+		return defaultTimeout
+	}
+	return timeout.Duration
 }
 
 func (con *controller) Writer() *read_write_layer.Writer {
@@ -26,6 +26,12 @@ var (
 	testPickupTimeoutDuration = lscore.Duration{
 		Duration: 10 * time.Second,
 	}
+	testProgressingTimeoutDuration = lscore.Duration{
+		Duration: 10 * time.Minute,
+	}
+	testAbortTimeoutDuration = lscore.Duration{
+		Duration: 5 * time.Minute,
+	}
 )
 
 var (
@@ -18,6 +18,7 @@ import (
 	lserrors "github.com/gardener/landscaper/apis/errors"
 	"github.com/gardener/landscaper/controller-utils/pkg/logging"
 	lc "github.com/gardener/landscaper/controller-utils/pkg/logging/constants"
+	"github.com/gardener/landscaper/pkg/metrics/controllermetrics"
 	lsutil "github.com/gardener/landscaper/pkg/utils"
 	"github.com/gardener/landscaper/pkg/utils/read_write_layer"
 )
@@ -25,6 +26,12 @@ import (
 func (con *controller) Reconcile(ctx context.Context, req reconcile.Request) (result reconcile.Result, err error) {
 	logger := con.log.StartReconcile(req)
 	ctx = logging.NewContext(ctx, logger)
+	ctx = read_write_layer.ContextWithActor(ctx, "deployitem-controller")
+
+	start := time.Now()
+	defer func() {
+		controllermetrics.ObserveReconcile(controllermetrics.ControllerDeployItem, req.Namespace, time.Since(start), result, err)
+	}()
 
 	result = reconcile.Result{}
 	defer lsutil.HandlePanics(ctx, &result, nil)
@@ -40,6 +47,12 @@ func (con *controller) reconcile(ctx context.Context, req reconcile.Request) (re
 	con.workerCounter.EnterWithLog(logger, 70, "di-timeout")
 	defer con.workerCounter.Exit()
 
+	if !con.namespaceLimiter.TryEnter(req.Namespace) {
+		logger.Debug("maximal number of concurrent reconciles of the namespace reached, requeuing")
+		return reconcile.Result{RequeueAfter: con.namespaceLimiter.RequeueInterval()}, nil
+	}
+	defer con.namespaceLimiter.Exit(req.Namespace)
+
 	di := &lsv1alpha1.DeployItem{}
 	if err := read_write_layer.GetDeployItem(ctx, con.lsUncachedClient, req.NamespacedName, di, read_write_layer.R000028); err != nil {
 		if apierrors.IsNotFound(err) {
@@ -54,13 +67,19 @@ func (con *controller) reconcile(ctx context.Context, req reconcile.Request) (re
 		return reconcile.Result{}, nil
 	}
 
-	if HasBeenPickedUp(di) || con.pickupTimeout == 0 {
-		// deploy item has been picked up, or the pickup check is deactivated
+	if HasBeenPickedUp(di) {
+		// deploy item has been picked up, check whether the deployer has finished it in time
+		return con.checkAbortTimeout(ctx, di)
+	}
+
+	pickupTimeout := getTimeout(di.Spec.PickupTimeout, con.pickupTimeout)
+	if pickupTimeout == 0 {
+		// the pickup check is deactivated
 		return reconcile.Result{}, nil
 	}
 
 	logger.Debug("check for pickup timeout")
-	exceeded, requeue := con.isPickupTimeoutExceeded(di)
+	exceeded, requeue := isPickupTimeoutExceeded(di, pickupTimeout)
 	if exceeded {
 		// pickup timeout is exceeded
 
@@ -77,7 +96,7 @@ func (con *controller) reconcile(ctx context.Context, req reconcile.Request) (re
 			}
 		}
 
-		err := con.writePickupTimeoutExceeded(ctx, di, targetNotFound)
+		err := con.writePickupTimeoutExceeded(ctx, di, pickupTimeout, targetNotFound)
 		return reconcile.Result{}, err
 	}
 
@@ -89,22 +108,51 @@ func (con *controller) reconcile(ctx context.Context, req reconcile.Request) (re
 	return reconcile.Result{}, nil
 }
 
-func (con *controller) isPickupTimeoutExceeded(di *lsv1alpha1.DeployItem) (bool, *time.Duration) {
+func isPickupTimeoutExceeded(di *lsv1alpha1.DeployItem, pickupTimeout time.Duration) (bool, *time.Duration) {
 	waitingForPickupDuration := time.Duration(0)
 	if di.Status.JobIDGenerationTime != nil {
 		waitingForPickupDuration = time.Since(di.Status.JobIDGenerationTime.Time)
 	}
-	if waitingForPickupDuration >= con.pickupTimeout {
+	if waitingForPickupDuration >= pickupTimeout {
 		return true, nil
 	}
 
 	// deploy item neither picked up nor timed out
 	// => requeue shortly after expected timeout
-	requeue := con.pickupTimeout - waitingForPickupDuration + (5 * time.Second)
+	requeue := pickupTimeout - waitingForPickupDuration + (5 * time.Second)
 	return false, &requeue
 }
 
-func (con *controller) writePickupTimeoutExceeded(ctx context.Context, di *lsv1alpha1.DeployItem, reasonTargetNotFound bool) error {
+// checkAbortTimeout marks a picked up deploy item as failed if the deployer has not finished it
+// within the progressing timeout plus the abort timeout.
+func (con *controller) checkAbortTimeout(ctx context.Context, di *lsv1alpha1.DeployItem) (reconcile.Result, error) {
+	logger, ctx := logging.FromContextOrNew(ctx, nil)
+
+	progressingTimeout := getTimeout(di.Spec.Timeout, con.progressingTimeout)
+	abortTimeout := getTimeout(di.Spec.AbortTimeout, con.abortTimeout)
+	if progressingTimeout == 0 || abortTimeout == 0 {
+		// the abort check is deactivated
+		return reconcile.Result{}, nil
+	}
+
+	if di.Status.TransitionTimes == nil || di.Status.TransitionTimes.InitTime == nil ||
+		(di.Status.JobIDGenerationTime != nil && di.Status.TransitionTimes.InitTime.Before(di.Status.JobIDGenerationTime)) {
+		// the deployer has not yet started to process the current job
+		return reconcile.Result{}, nil
+	}
+
+	logger.Debug("check for abort timeout")
+	processingDuration := time.Since(di.Status.TransitionTimes.InitTime.Time)
+	if processingDuration >= progressingTimeout+abortTimeout {
+		err := con.writeAbortTimeoutExceeded(ctx, di, progressingTimeout, abortTimeout)
+		return reconcile.Result{}, err
+	}
+
+	// abort timeout not yet exceeded; check again shortly after the time when it would be exceeded
+	return reconcile.Result{RequeueAfter: progressingTimeout + abortTimeout - processingDuration + (5 * time.Second)}, nil
+}
+
+func (con *controller) writePickupTimeoutExceeded(ctx context.Context, di *lsv1alpha1.DeployItem, pickupTimeout time.Duration, reasonTargetNotFound bool) error {
 	// no deployer has picked up the deploy item within the timeframe
 	// => pickup timeout
 	logger, ctx := logging.FromContextOrNew(ctx, nil)
@@ -114,6 +162,7 @@ func (con *controller) writePickupTimeoutExceeded(ctx context.Context, di *lsv1a
 	di.Status.JobIDFinished = di.Status.GetJobID()
 	di.Status.TransitionTimes = lsutil.SetFinishedTransitionTime(di.Status.TransitionTimes)
 	di.Status.ObservedGeneration = di.Generation
+	previousPhase := di.Status.Phase
 	lsv1alpha1helper.SetDeployItemToFailed(di)
 	targetReasonMsg := ""
 	if reasonTargetNotFound {
@@ -122,7 +171,7 @@ func (con *controller) writePickupTimeoutExceeded(ctx context.Context, di *lsv1a
 	lsutil.SetLastError(&di.Status, lserrors.UpdatedError(di.Status.GetLastError(),
 		lsv1alpha1.PickupTimeoutOperation,
 		lsv1alpha1.PickupTimeoutReason,
-		fmt.Sprintf("no deployer has reconciled this deployitem within %d seconds%s", con.pickupTimeout/time.Second, targetReasonMsg),
+		fmt.Sprintf("no deployer has reconciled this deployitem within %d seconds%s", pickupTimeout/time.Second, targetReasonMsg),
 		lsv1alpha1.ErrorTimeout,
 	))
 
@@ -130,6 +179,45 @@ func (con *controller) writePickupTimeoutExceeded(ctx context.Context, di *lsv1a
 		logger.Error(err, "unable to set deployitem status")
 		return err
 	}
+	observeTimeout(di, previousPhase)
+
+	return nil
+}
+
+func (con *controller) writeAbortTimeoutExceeded(ctx context.Context, di *lsv1alpha1.DeployItem, progressingTimeout, abortTimeout time.Duration) error {
+	// the deployer has not finished the deploy item within the timeframe
+	// => abort timeout
+	logger, ctx := logging.FromContextOrNew(ctx, nil)
+	logger = logger.WithValues(lc.KeyMethod, "writeAbortTimeoutExceeded")
+	logger.Info("abort timeout occurred")
+
+	di.Status.JobIDFinished = di.Status.GetJobID()
+	di.Status.TransitionTimes = lsutil.SetFinishedTransitionTime(di.Status.TransitionTimes)
+	di.Status.ObservedGeneration = di.Generation
+	previousPhase := di.Status.Phase
+	lsv1alpha1helper.SetDeployItemToFailed(di)
+	lsutil.SetLastError(&di.Status, lserrors.UpdatedError(di.Status.GetLastError(),
+		lsv1alpha1.AbortTimeoutOperation,
+		lsv1alpha1.AbortTimeoutReason,
+		fmt.Sprintf("the deployer has not finished this deployitem within %d seconds after its progressing timeout of %d seconds",
+			abortTimeout/time.Second, progressingTimeout/time.Second),
+		lsv1alpha1.ErrorTimeout,
+	))
+
+	if err := con.Writer().UpdateDeployItemStatus(ctx, read_write_layer.W000155, di); err != nil {
+		logger.Error(err, "unable to set deployitem status")
+		return err
+	}
+	observeTimeout(di, previousPhase)
 
 	return nil
 }
+
+// observeTimeout records the metrics of a deploy item that has been set to failed due to a timeout.
+func observeTimeout(di *lsv1alpha1.DeployItem, previousPhase lsv1alpha1.DeployItemPhase) {
+	controllermetrics.ObservePhaseTransition(controllermetrics.ControllerDeployItem, di.Namespace,
+		string(previousPhase), string(di.Status.Phase))
+	if lastError := di.Status.GetLastError(); lastError != nil {
+		controllermetrics.ObserveErrorReason(controllermetrics.ControllerDeployItem, di.Namespace, lastError.Reason)
+	}
+}
@@ -13,6 +13,7 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"sigs.k8s.io/controller-runtime/pkg/reconcile"
 
+	"github.com/gardener/landscaper/apis/config"
 	lsv1alpha1 "github.com/gardener/landscaper/apis/core/v1alpha1"
 	"github.com/gardener/landscaper/controller-utils/pkg/logging"
 	"github.com/gardener/landscaper/pkg/api"
@@ -34,7 +35,11 @@ var _ = Describe("Deploy Item Controller Reconcile Test", func() {
 		var err error
 
 		deployItemController, err = dictrl.NewController(testenv.Client, testenv.Client, logging.Discard(), api.LandscaperScheme,
-			&testPickupTimeoutDuration, 1000)
+			&config.DeployItemTimeouts{
+				Pickup:             &testPickupTimeoutDuration,
+				ProgressingDefault: &testProgressingTimeoutDuration,
+				Abort:              &testAbortTimeoutDuration,
+			}, 1000, 0)
 		Expect(err).ToNot(HaveOccurred())
 	})
 
@@ -108,4 +113,67 @@ var _ = Describe("Deploy Item Controller Reconcile Test", func() {
 		Expect(di.Status.LastError.Message).To(ContainSubstring("Target"))
 	})
 
+	It("should use the pickup timeout of the deploy item", func() {
+		ctx := context.Background()
+		defer ctx.Done()
+
+		var err error
+		state, err = testenv.InitResources(ctx, testdataDir)
+		Expect(err).ToNot(HaveOccurred())
+
+		di := &lsv1alpha1.DeployItem{}
+		diReq := testutils.Request("mock-di-prog", state.Namespace)
+		utils.ExpectNoError(testenv.Client.Get(ctx, diReq.NamespacedName, di))
+		di.Spec.PickupTimeout = &lsv1alpha1.Duration{Duration: time.Hour}
+		utils.ExpectNoError(testenv.Client.Update(ctx, di))
+		timedOut := metav1.Time{Time: time.Now().Add(-(testPickupTimeoutDuration.Duration + (5 * time.Second)))}
+		Expect(testutils.UpdateJobIdForDeployItem(ctx, testenv, di, timedOut)).ToNot(HaveOccurred())
+
+		By("Verify that the deploy item is not failed before its own pickup timeout")
+		testutils.ShouldReconcile(ctx, deployItemController, diReq)
+		utils.ExpectNoError(testenv.Client.Get(ctx, diReq.NamespacedName, di))
+		Expect(di.Status.Phase).ToNot(Equal(lsv1alpha1.DeployItemPhases.Failed))
+		Expect(utils2.IsDeployItemJobIDsIdentical(di)).To(BeFalse())
+	})
+
+	It("should detect abort timeouts", func() {
+		ctx := context.Background()
+		defer ctx.Done()
+
+		var err error
+		state, err = testenv.InitResources(ctx, testdataDir)
+		Expect(err).ToNot(HaveOccurred())
+
+		di := &lsv1alpha1.DeployItem{}
+		diReq := testutils.Request("mock-di-prog-timeout", state.Namespace)
+		utils.ExpectNoError(testenv.Client.Get(ctx, diReq.NamespacedName, di))
+		jobIDGenerationTime := metav1.Time{Time: time.Now().Add(-2 * time.Hour)}
+		Expect(testutils.UpdateJobIdForDeployItem(ctx, testenv, di, jobIDGenerationTime)).ToNot(HaveOccurred())
+
+		By("Simulate a deployer that picked up the deploy item but did not finish it")
+		initTime := metav1.Time{Time: time.Now().Add(-(time.Hour + testAbortTimeoutDuration.Duration - time.Minute))}
+		di.Status.LastReconcileTime = &initTime
+		di.Status.TransitionTimes = &lsv1alpha1.TransitionTimes{InitTime: &initTime}
+		di.Status.Phase = lsv1alpha1.DeployItemPhases.Progressing
+		utils.ExpectNoError(testenv.Client.Status().Update(ctx, di))
+
+		By("Verify that the deploy item is not failed before the abort timeout is exceeded")
+		result, err := deployItemController.Reconcile(ctx, diReq)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(result.RequeueAfter).To(BeNumerically("~", time.Minute+(5*time.Second), 5*time.Second))
+		utils.ExpectNoError(testenv.Client.Get(ctx, diReq.NamespacedName, di))
+		Expect(di.Status.Phase).To(Equal(lsv1alpha1.DeployItemPhases.Progressing))
+
+		By("Verify that the deploy item is failed after the abort timeout is exceeded")
+		initTime = metav1.Time{Time: time.Now().Add(-(time.Hour + testAbortTimeoutDuration.Duration + time.Minute))}
+		di.Status.TransitionTimes.InitTime = &initTime
+		utils.ExpectNoError(testenv.Client.Status().Update(ctx, di))
+		testutils.ShouldReconcile(ctx, deployItemController, diReq)
+		utils.ExpectNoError(testenv.Client.Get(ctx, diReq.NamespacedName, di))
+		Expect(di.Status.Phase).To(Equal(lsv1alpha1.DeployItemPhases.Failed))
+		Expect(utils2.IsDeployItemJobIDsIdentical(di)).To(BeTrue())
+		Expect(di.Status.LastError).ToNot(BeNil())
+		Expect(di.Status.LastError.Reason).To(Equal(lsv1alpha1.AbortTimeoutReason))
+	})
+
 })
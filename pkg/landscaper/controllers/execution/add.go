@@ -16,6 +16,7 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/reconcile"
 
 	"github.com/gardener/landscaper/apis/config"
+	lscore "github.com/gardener/landscaper/apis/core"
 	lsv1alpha1 "github.com/gardener/landscaper/apis/core/v1alpha1"
 	"github.com/gardener/landscaper/controller-utils/pkg/logging"
 	"github.com/gardener/landscaper/pkg/utils"
@@ -32,6 +33,7 @@ func AddControllerToManager(ctx context.Context, lsUncachedClient, lsCachedClien
 
 	log.Info(fmt.Sprintf("Running on pod %s in namespace %s", utils.GetCurrentPodName(), utils.GetCurrentPodNamespace()),
 		"numberOfWorkerThreads", config.Controllers.Executions.CommonControllerConfig.Workers,
+		"maxWorkersPerNamespace", config.Controllers.Executions.MaxWorkersPerNamespace,
 		"lockingEnabled", lockingEnabled)
 
 	// check if allowed to access
@@ -46,8 +48,14 @@ func AddControllerToManager(ctx context.Context, lsUncachedClient, lsCachedClien
 		log,
 		lsMgr.GetScheme(),
 		lsMgr.GetEventRecorderFor("Landscaper"),
+		getDefaultDeployItemTimeout(config),
 		config.Controllers.Executions.CommonControllerConfig.Workers,
-		lockingEnabled,
+		config.Controllers.Executions.MaxWorkersPerNamespace,
+		Options{
+			LockingEnabled:      lockingEnabled,
+			OwnerReferenceGC:    config.FeatureGates.OwnerReferenceGarbageCollection,
+			PartialRedeployment: !config.FeatureGates.DisablePartialRedeployment,
+		},
 		"executions",
 	)
 	if err != nil {
@@ -61,3 +69,10 @@ func AddControllerToManager(ctx context.Context, lsUncachedClient, lsCachedClien
 		WithLogConstructor(func(r *reconcile.Request) logr.Logger { return log.Logr() }).
 		Complete(a)
 }
+
+func getDefaultDeployItemTimeout(config *config.LandscaperConfiguration) *lscore.Duration {
+	if config.DeployItemTimeouts == nil {
+		return nil
+	}
+	return config.DeployItemTimeouts.ProgressingDefault
+}
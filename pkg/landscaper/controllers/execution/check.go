@@ -27,3 +27,9 @@ func hasInterruptOperation(exec *lsv1alpha1.Execution) bool {
 func isDifferentJobIDs(exec *lsv1alpha1.Execution) bool {
 	return exec.Status.JobID != exec.Status.JobIDFinished
 }
+
+// isDeletedWithoutJob returns true if the execution has been deleted, but no job has been started to process
+// the deletion. This happens if the execution is deleted by the garbage collector.
+func isDeletedWithoutJob(exec *lsv1alpha1.Execution) bool {
+	return !exec.DeletionTimestamp.IsZero() && !exec.Status.ExecutionPhase.IsDeletion() && !isDifferentJobIDs(exec)
+}
@@ -6,8 +6,11 @@ package execution
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"time"
 
+	"github.com/google/uuid"
 	corev1 "k8s.io/api/core/v1"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -17,6 +20,7 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
 	"sigs.k8s.io/controller-runtime/pkg/reconcile"
 
+	lscore "github.com/gardener/landscaper/apis/core"
 	lsv1alpha1 "github.com/gardener/landscaper/apis/core/v1alpha1"
 	lsv1alpha1helper "github.com/gardener/landscaper/apis/core/v1alpha1/helper"
 	lserrors "github.com/gardener/landscaper/apis/errors"
@@ -24,20 +28,37 @@ import (
 	"github.com/gardener/landscaper/controller-utils/pkg/logging"
 	"github.com/gardener/landscaper/pkg/landscaper/execution"
 	"github.com/gardener/landscaper/pkg/landscaper/operation"
+	"github.com/gardener/landscaper/pkg/metrics/controllermetrics"
 	lsutil "github.com/gardener/landscaper/pkg/utils"
 	"github.com/gardener/landscaper/pkg/utils/lock"
 	"github.com/gardener/landscaper/pkg/utils/read_write_layer"
 )
 
+// Options defines the optional features of the execution controller.
+type Options struct {
+	// LockingEnabled defines whether executions are locked while they are reconciled.
+	LockingEnabled bool
+	// OwnerReferenceGC defines whether the owner reference garbage collection is enabled.
+	OwnerReferenceGC bool
+	// PartialRedeployment defines whether a new job only redeploys the deploy items that have changed.
+	PartialRedeployment bool
+}
+
 // NewController creates a new execution controller that reconcile Execution resources.
 func NewController(lsUncachedClient, lsCachedClient, hostUncachedClient, hostCachedClient client.Client,
-	logger logging.Logger, scheme *runtime.Scheme, eventRecorder record.EventRecorder, maxNumberOfWorker int,
-	lockingEnabled bool, callerName string) (reconcile.Reconciler, error) {
+	logger logging.Logger, scheme *runtime.Scheme, eventRecorder record.EventRecorder,
+	defaultDeployItemTimeout *lscore.Duration, maxNumberOfWorker, maxWorkersPerNamespace int,
+	opts Options, callerName string) (reconcile.Reconciler, error) {
 
 	ctx := logging.NewContext(context.Background(), logger)
 
 	wc := lsutil.NewWorkerCounter(maxNumberOfWorker)
 
+	var defaultTimeout *lsv1alpha1.Duration
+	if defaultDeployItemTimeout != nil {
+		defaultTimeout = &lsv1alpha1.Duration{Duration: defaultDeployItemTimeout.Duration}
+	}
+
 	finishedObjectCache, err := prepareFinishedObjectCache(ctx, lsUncachedClient)
 	if err != nil {
 		return nil, err
@@ -53,7 +74,11 @@ func NewController(lsUncachedClient, lsCachedClient, hostUncachedClient, hostCac
 		scheme:              scheme,
 		eventRecorder:       eventRecorder,
 		workerCounter:       wc,
-		lockingEnabled:      lockingEnabled,
+		namespaceLimiter:    lsutil.NewNamespaceLimiter(maxWorkersPerNamespace),
+		defaultTimeout:      defaultTimeout,
+		lockingEnabled:      opts.LockingEnabled,
+		ownerReferenceGC:    opts.OwnerReferenceGC,
+		partialRedeployment: opts.PartialRedeployment,
 		callerName:          callerName,
 		locker:              *lock.NewLocker(lsUncachedClient, hostUncachedClient, callerName),
 	}, nil
@@ -66,13 +91,20 @@ type controller struct {
 	hostCachedClient    client.Client
 	finishedObjectCache *lsutil.FinishedObjectCache
 
-	log            logging.Logger
-	eventRecorder  record.EventRecorder
-	scheme         *runtime.Scheme
-	workerCounter  *lsutil.WorkerCounter
-	lockingEnabled bool
-	callerName     string
-	locker         lock.Locker
+	log           logging.Logger
+	eventRecorder record.EventRecorder
+	scheme        *runtime.Scheme
+	workerCounter *lsutil.WorkerCounter
+	// namespaceLimiter limits the number of concurrent reconciles per namespace.
+	namespaceLimiter *lsutil.NamespaceLimiter
+	defaultTimeout   *lsv1alpha1.Duration
+	lockingEnabled   bool
+	// ownerReferenceGC defines whether the owner reference garbage collection is enabled.
+	ownerReferenceGC bool
+	// partialRedeployment defines whether a new job only redeploys the deploy items that have changed.
+	partialRedeployment bool
+	callerName          string
+	locker              lock.Locker
 }
 
 func prepareFinishedObjectCache(ctx context.Context, lsUncachedClient client.Client) (*lsutil.FinishedObjectCache, error) {
@@ -116,6 +148,12 @@ func prepareFinishedObjectCache(ctx context.Context, lsUncachedClient client.Cli
 func (c *controller) Reconcile(ctx context.Context, req reconcile.Request) (result reconcile.Result, err error) {
 	logger := c.log.StartReconcile(req)
 	ctx = logging.NewContext(ctx, logger)
+	ctx = read_write_layer.ContextWithActor(ctx, "execution-controller")
+
+	start := time.Now()
+	defer func() {
+		controllermetrics.ObserveReconcile(controllermetrics.ControllerExecution, req.Namespace, time.Since(start), result, err)
+	}()
 
 	result = reconcile.Result{}
 	defer lsutil.HandlePanics(ctx, &result, c.hostUncachedClient)
@@ -152,6 +190,12 @@ func (c *controller) reconcile(ctx context.Context, req reconcile.Request) (reco
 
 	logger.Info(startMessage + "3")
 
+	if !c.namespaceLimiter.TryEnter(req.Namespace) {
+		logger.Debug("maximal number of concurrent reconciles of the namespace reached, requeuing")
+		return reconcile.Result{RequeueAfter: c.namespaceLimiter.RequeueInterval()}, nil
+	}
+	defer c.namespaceLimiter.Exit(req.Namespace)
+
 	if c.lockingEnabled {
 		metadata := lsutil.EmptyExecutionMetadata()
 		if err := c.lsUncachedClient.Get(ctx, req.NamespacedName, metadata); err != nil {
@@ -199,11 +243,45 @@ func (c *controller) reconcile(ctx context.Context, req reconcile.Request) (reco
 		return reconcile.Result{}, nil
 	}
 
+	if c.ownerReferenceGC && isDeletedWithoutJob(exec) {
+		// the execution has been deleted by the garbage collector, because its installation is gone or has released it,
+		// so that no installation starts the deletion job
+		deleted, err := lsutil.IsControllingInstallationDeleted(ctx, c.lsUncachedClient, exec, read_write_layer.R000134)
+		if err != nil {
+			return lsutil.LogHelper{}.LogStandardErrorAndGetReconcileResult(ctx, err)
+		}
+
+		if deleted {
+			jobID := uuid.New().String()
+			startJob := func(exec *lsv1alpha1.Execution) error {
+				// after a conflict, the latest version might not require a new job anymore, e.g. because a job has been started
+				if !isDeletedWithoutJob(exec) {
+					return read_write_layer.ErrPreconditionChanged
+				}
+				exec.Status.JobID = jobID
+				exec.Status.TransitionTimes = lsutil.NewTransitionTimes()
+				return nil
+			}
+			if err := c.Writer().UpdateExecutionStatusWithRetry(ctx, read_write_layer.W000160, read_write_layer.R000161,
+				exec, startJob); err != nil {
+				if errors.Is(err, read_write_layer.ErrPreconditionChanged) {
+					return reconcile.Result{Requeue: true}, nil
+				}
+				return lsutil.LogHelper{}.LogStandardErrorAndGetReconcileResult(ctx, err)
+			}
+		}
+	}
+
 	if isDifferentJobIDs(exec) {
 		// Execution is unfinished
 
 		err := c.handleReconcilePhase(ctx, exec)
-		return lsutil.LogHelper{}.LogErrorAndGetReconcileResult(ctx, err)
+		result, reconcileErr := lsutil.LogHelper{}.LogErrorAndGetReconcileResult(ctx, err)
+		if requeueAfter, ok := execution.DeferralRequeueAfter(exec, time.Now()); ok && !result.Requeue {
+			// deferred deploy items are triggered when their exclusion window has ended
+			result.RequeueAfter = requeueAfter
+		}
+		return result, reconcileErr
 	} else {
 		// Execution is finished; nothing to do
 		return reconcile.Result{}, nil
@@ -226,12 +304,17 @@ func (c *controller) handleReconcilePhase(ctx context.Context, exec *lsv1alpha1.
 		}
 
 		exec.Status.DeployItemCache = nil
+		exec.Status.Progress = nil
+		exec.Status.DeferredDeployItems = nil
 
+		previousPhase := exec.Status.ExecutionPhase
 		if exec.DeletionTimestamp.IsZero() {
 			exec.Status.ExecutionPhase = lsv1alpha1.ExecutionPhases.Init
 		} else {
 			exec.Status.ExecutionPhase = lsv1alpha1.ExecutionPhases.InitDelete
 		}
+		exec.Status.Conditions = lsv1alpha1helper.UpdateExecutionConditions(exec.Status.Conditions, previousPhase,
+			exec.Status.ExecutionPhase, exec.Status.LastError)
 
 		now := metav1.Now()
 		exec.Status.PhaseTransitionTime = &now
@@ -242,6 +325,8 @@ func (c *controller) handleReconcilePhase(ctx context.Context, exec *lsv1alpha1.
 		if err := c.Writer().UpdateExecutionStatus(ctx, read_write_layer.W000105, exec); err != nil {
 			return lserrors.NewWrappedError(err, op, "UpdateExecutionStatus", err.Error())
 		}
+		controllermetrics.ObservePhaseTransition(controllermetrics.ControllerExecution, exec.Namespace,
+			string(previousPhase), string(exec.Status.ExecutionPhase))
 	}
 
 	if exec.Status.ExecutionPhase == lsv1alpha1.ExecutionPhases.Init {
@@ -268,14 +353,23 @@ func (c *controller) handleReconcilePhase(ctx context.Context, exec *lsv1alpha1.
 		if !deployItemClassification.HasRunningItems() && deployItemClassification.HasFailedItems() {
 			err = lserrors.NewError(op, "handlePhaseProgressing", "has failed or missing deploy items", lsv1alpha1.ErrorForInfoOnly)
 			return c.setExecutionPhaseAndUpdate(ctx, exec, lsv1alpha1.ExecutionPhases.Failed, err, read_write_layer.W000134)
-		} else if !deployItemClassification.HasRunningItems() && !deployItemClassification.HasRunnableItems() && deployItemClassification.HasPendingItems() {
+		} else if !deployItemClassification.HasRunningItems() && !deployItemClassification.HasRunnableItems() &&
+			deployItemClassification.HasPendingItems() && !deployItemClassification.HasDeferredItems() {
 			err = lserrors.NewError(op, "handlePhaseProgressing", "items could not be started", lsv1alpha1.ErrorForInfoOnly)
 			return c.setExecutionPhaseAndUpdate(ctx, exec, lsv1alpha1.ExecutionPhases.Failed, err, read_write_layer.W000135)
+		} else if !deployItemClassification.HasRunningItems() && deployItemClassification.HasDeferredItems() {
+			// remain in progressing until the exclusion windows of the deferred items have ended
+			err = lserrors.NewError(op, "handlePhaseProgressing", execution.DeferralMessage(exec),
+				lsv1alpha1.ErrorUnfinished, lsv1alpha1.ErrorForInfoOnly, lsv1alpha1.ErrorNoRetry)
+			return c.setExecutionPhaseAndUpdate(ctx, exec, exec.Status.ExecutionPhase, err, read_write_layer.W000159)
 		} else if !deployItemClassification.AllSucceeded() {
 			// remain in progressing in all other cases
 			err = lserrors.NewError(op, "handlePhaseProgressing", "some running items", lsv1alpha1.ErrorUnfinished,
 				lsv1alpha1.ErrorForInfoOnly, lsv1alpha1.ErrorNoRetry)
 			return c.setExecutionPhaseAndUpdate(ctx, exec, exec.Status.ExecutionPhase, err, read_write_layer.W000136)
+		} else if err = deployItemClassification.CheckRequiredReadiness(exec); err != nil {
+			// do not publish exports of resources whose readiness has not been checked
+			return c.setExecutionPhaseAndUpdate(ctx, exec, lsv1alpha1.ExecutionPhases.Failed, err, read_write_layer.W000176)
 		} else {
 			// all succeeded; go to next phase
 			if err := c.setExecutionPhaseAndUpdate(ctx, exec, lsv1alpha1.ExecutionPhases.Completing, nil, read_write_layer.W000137); err != nil {
@@ -321,7 +415,8 @@ func (c *controller) handleReconcilePhase(ctx context.Context, exec *lsv1alpha1.
 		if !deployItemClassification.HasRunningItems() && deployItemClassification.HasFailedItems() {
 			err = lserrors.NewError(op, "handlePhaseDeleting", "has failed items", lsv1alpha1.ErrorForInfoOnly)
 			return c.setExecutionPhaseAndUpdate(ctx, exec, lsv1alpha1.ExecutionPhases.DeleteFailed, err, read_write_layer.W000143)
-		} else if !deployItemClassification.HasRunningItems() && !deployItemClassification.HasRunnableItems() && deployItemClassification.HasPendingItems() {
+		} else if !deployItemClassification.HasRunningItems() && !deployItemClassification.HasRunnableItems() &&
+			deployItemClassification.HasPendingItems() && !deployItemClassification.HasDeferredItems() {
 			err = lserrors.NewError(op, "handlePhaseDeleting", "has pending items", lsv1alpha1.ErrorForInfoOnly)
 			return c.setExecutionPhaseAndUpdate(ctx, exec, lsv1alpha1.ExecutionPhases.DeleteFailed, err, read_write_layer.W000144)
 		}
@@ -339,14 +434,18 @@ func (c *controller) handleReconcilePhase(ctx context.Context, exec *lsv1alpha1.
 func (c *controller) handlePhaseInit(ctx context.Context, exec *lsv1alpha1.Execution, deployItemCache *lsv1alpha1.DeployItemCache) lserrors.LsError {
 	forceReconcile := false
 	o := execution.NewOperation(operation.NewOperation(c.scheme, c.eventRecorder, c.lsUncachedClient), exec, forceReconcile)
+	o.SetDefaultDeployItemTimeout(c.defaultTimeout)
+	o.SetOwnerReferenceGarbageCollection(c.ownerReferenceGC)
 
 	return o.UpdateDeployItems(ctx, deployItemCache)
 }
 
 func (c *controller) handlePhaseProgressing(ctx context.Context, exec *lsv1alpha1.Execution) (
 	*execution.DeployItemClassification, lserrors.LsError) {
-	forceReconcile := false
+	// an explicit reconcile of the installation redeploys all deploy items
+	forceReconcile := exec.Status.RedeployAllDeployItems
 	o := execution.NewOperation(operation.NewOperation(c.scheme, c.eventRecorder, c.lsUncachedClient), exec, forceReconcile)
+	o.SetPartialRedeployment(c.partialRedeployment)
 
 	return o.TriggerDeployItems(ctx)
 }
@@ -359,6 +458,7 @@ func (c *controller) handlePhaseCompleting(ctx context.Context, exec *lsv1alpha1
 
 	forceReconcile := false
 	o := execution.NewOperation(operation.NewOperation(c.scheme, c.eventRecorder, c.lsUncachedClient), exec, forceReconcile)
+	o.SetOwnerReferenceGarbageCollection(c.ownerReferenceGC)
 
 	return o.CollectAndUpdateExportsNew(ctx)
 }
@@ -428,6 +528,15 @@ func (c *controller) handleInterruptOperation(ctx context.Context, exec *lsv1alp
 	for i := range managedItems {
 		item := managedItems[i]
 
+		if !item.Status.Phase.IsFinal() && !lsv1alpha1helper.HasOperation(item.ObjectMeta, lsv1alpha1.AbortOperation) {
+			// let the responsible deployer stop its ongoing work for the deploy item
+			lsv1alpha1helper.SetOperation(&item.ObjectMeta, lsv1alpha1.AbortOperation)
+			if err := o.WriterToLsUncachedClient().UpdateDeployItem(ctx, read_write_layer.W000163, item); err != nil {
+				return lserrors.NewWrappedError(err, "UpdateDeployItem",
+					fmt.Sprintf("unable to abort deploy item %s / %s for interrupt", item.Namespace, item.Name), err.Error())
+			}
+		}
+
 		if item.Status.JobIDFinished != exec.Status.JobID {
 			item.Status.SetJobID(exec.Status.JobID)
 			item.Status.JobIDFinished = exec.Status.JobID
@@ -445,6 +554,18 @@ func (c *controller) handleInterruptOperation(ctx context.Context, exec *lsv1alp
 		}
 	}
 
+	if exec.Status.JobID != exec.Status.JobIDFinished {
+		// finish the current job immediately, so that the installation does not wait for the interrupted deploy items
+		phase := lsv1alpha1.ExecutionPhases.Failed
+		if !exec.DeletionTimestamp.IsZero() {
+			phase = lsv1alpha1.ExecutionPhases.DeleteFailed
+		}
+		lsError := lserrors.NewError(op, lsv1alpha1.AbortedReason, "the current job was interrupted")
+		if err := c.setExecutionPhaseAndUpdate(ctx, exec, phase, lsError, read_write_layer.W000166); err != nil {
+			return err
+		}
+	}
+
 	return nil
 }
 
@@ -453,20 +574,40 @@ func (c *controller) setExecutionPhaseAndUpdate(ctx context.Context, exec *lsv1a
 
 	logger, ctx := logging.FromContextOrNew(ctx, nil)
 
+	jobIDFinished := exec.Status.JobIDFinished
 	exec.Status.LastError = lserrors.TryUpdateLsError(exec.Status.LastError, lsErr)
+	controllermetrics.ObserveError(controllermetrics.ControllerExecution, exec.Namespace, lsErr)
 
+	previousPhase := exec.Status.ExecutionPhase
 	if phase != exec.Status.ExecutionPhase {
 		now := metav1.Now()
 		exec.Status.PhaseTransitionTime = &now
 	}
 	exec.Status.ExecutionPhase = phase
+	exec.Status.Conditions = lsv1alpha1helper.UpdateExecutionConditions(exec.Status.Conditions, previousPhase, phase, exec.Status.LastError)
 
 	if exec.Status.ExecutionPhase.IsFinal() {
 		exec.Status.JobIDFinished = exec.Status.JobID
 		exec.Status.TransitionTimes = lsutil.SetFinishedTransitionTime(exec.Status.TransitionTimes)
 	}
 
-	if err := c.Writer().UpdateExecutionStatus(ctx, writeID, exec); err != nil {
+	// After a conflict, e.g. because the execution has been touched, the computed status is written to the latest
+	// version of the execution, unless its job has been replaced or finished in the meantime.
+	status := exec.Status.DeepCopy()
+	resourceVersion := exec.ResourceVersion
+	setStatus := func(latest *lsv1alpha1.Execution) error {
+		if latest.ResourceVersion == resourceVersion {
+			// the status has been computed for this version
+			return nil
+		}
+		if latest.Status.JobID != status.JobID || latest.Status.JobIDFinished != jobIDFinished {
+			return read_write_layer.ErrPreconditionChanged
+		}
+		latest.Status = *status.DeepCopy()
+		return nil
+	}
+
+	if err := c.Writer().UpdateExecutionStatusWithRetry(ctx, writeID, read_write_layer.R000170, exec, setStatus); err != nil {
 
 		if exec.Status.ExecutionPhase == lsv1alpha1.ExecutionPhases.Deleting {
 			// recheck if already deleted
@@ -483,8 +624,16 @@ func (c *controller) setExecutionPhaseAndUpdate(ctx context.Context, exec *lsv1a
 		if lsErr == nil {
 			return lserrors.NewWrappedError(err, "setExecutionPhaseAndUpdate", "UpdateExecutionStatus", err.Error())
 		}
-	} else if isExecFinished(exec) {
-		c.finishedObjectCache.AddSynchonized(&exec.ObjectMeta)
+	} else {
+		controllermetrics.ObservePhaseTransition(controllermetrics.ControllerExecution, exec.Namespace,
+			string(previousPhase), string(exec.Status.ExecutionPhase))
+		if previousPhase != phase && (phase == lsv1alpha1.ExecutionPhases.Succeeded || phase == lsv1alpha1.ExecutionPhases.Failed) &&
+			exec.Status.TransitionTimes != nil {
+			controllermetrics.ObserveDeployItemDurations(exec.Namespace, exec.Name, exec.Status.TransitionTimes.WaitTime, exec.Status.Progress)
+		}
+		if isExecFinished(exec) {
+			c.finishedObjectCache.AddSynchonized(&exec.ObjectMeta)
+		}
 	}
 
 	return lsErr
@@ -36,7 +36,7 @@ var _ = Describe("Reconcile", func() {
 	BeforeEach(func() {
 		var err error
 		ctrl, err = execution.NewController(testenv.Client, testenv.Client, testenv.Client, testenv.Client, logging.Discard(), api.Scheme,
-			record.NewFakeRecorder(1024), 1000, false, "exec-test-"+testutils.GetNextCounter())
+			record.NewFakeRecorder(1024), nil, 1000, 0, execution.Options{}, "exec-test-"+testutils.GetNextCounter())
 		Expect(err).ToNot(HaveOccurred())
 		state, err = testenv.InitState(context.TODO())
 		Expect(err).ToNot(HaveOccurred())
@@ -504,5 +504,38 @@ var _ = Describe("Reconcile", func() {
 			Expect(di3.Status.JobID).To(Equal(currentJobID))
 			Expect(di3.Status.JobIDFinished).To(Equal(currentJobID))
 		})
+
+		It("should fail with the cycle if the deploy items have cyclic dependencies", func() {
+			ctx := context.Background()
+
+			exec := &lsv1alpha1.Execution{}
+			exec.GenerateName = "test-"
+			exec.Namespace = state.Namespace
+			exec.Spec.DeployItems = []lsv1alpha1.DeployItemTemplate{
+				{Name: "a", Type: "test-type", DependsOn: []string{"c"}},
+				{Name: "b", Type: "test-type", DependsOn: []string{"a"}},
+				{Name: "c", Type: "test-type", DependsOn: []string{"b"}},
+			}
+			for i := range exec.Spec.DeployItems {
+				exec.Spec.DeployItems[i].Configuration = &runtime.RawExtension{Raw: []byte(`{"apiVersion": "sometest", "kind": "somekind"}`)}
+			}
+
+			Expect(state.Create(ctx, exec)).To(Succeed())
+			Expect(state.Client.Get(ctx, kutil.ObjectKeyFromObject(exec), exec)).To(Succeed())
+			Expect(testutils.UpdateJobIdForExecution(ctx, testenv, exec)).To(Succeed())
+			testutils.ShouldReconcileButRetry(ctx, ctrl, testutils.RequestFromObject(exec))
+
+			Expect(state.Client.Get(ctx, kutil.ObjectKeyFromObject(exec), exec)).To(Succeed())
+			Expect(exec.Status.ExecutionPhase).To(Equal(lsv1alpha1.ExecutionPhases.Failed))
+			Expect(exec.Status.LastError).ToNot(BeNil())
+			Expect(exec.Status.LastError.Reason).To(Equal(lsv1alpha1.CyclicDependencyReason))
+			Expect(exec.Status.LastError.Message).To(ContainSubstring("a -{depends_on}-> c -{depends_on}-> b -{depends_on}-> a"))
+			Expect(exec.Status.LastError.Codes).To(ContainElement(lsv1alpha1.ErrorCyclicDependencies))
+
+			// no deploy items are created for an execution with cyclic dependencies
+			items := &lsv1alpha1.DeployItemList{}
+			testutils.ExpectNoError(testenv.Client.List(ctx, items, client.InNamespace(state.Namespace)))
+			Expect(items.Items).To(BeEmpty())
+		})
 	})
 })
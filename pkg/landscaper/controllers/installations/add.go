@@ -13,6 +13,7 @@ import (
 	"github.com/gardener/landscaper/pkg/utils/lock"
 
 	"github.com/go-logr/logr"
+	"github.com/mandelsoft/vfs/pkg/osfs"
 	"sigs.k8s.io/controller-runtime/pkg/builder"
 	"sigs.k8s.io/controller-runtime/pkg/manager"
 	"sigs.k8s.io/controller-runtime/pkg/reconcile"
@@ -20,6 +21,7 @@ import (
 	"github.com/gardener/landscaper/apis/config"
 	"github.com/gardener/landscaper/apis/core/v1alpha1"
 	"github.com/gardener/landscaper/controller-utils/pkg/logging"
+	"github.com/gardener/landscaper/pkg/components/registries"
 	"github.com/gardener/landscaper/pkg/utils"
 )
 
@@ -34,6 +36,7 @@ func AddControllerToManager(lsUncachedClient, lsCachedClient, hostUncachedClient
 
 	log.Info(fmt.Sprintf("Running on pod %s in namespace %s", utils.GetCurrentPodName(), utils.GetCurrentPodNamespace()),
 		"numberOfWorkerThreads", config.Controllers.Installations.CommonControllerConfig.Workers,
+		"maxWorkersPerNamespace", config.Controllers.Installations.MaxWorkersPerNamespace,
 		"lockingEnabled", lockingEnabled)
 
 	// check if allowed to access
@@ -50,6 +53,7 @@ func AddControllerToManager(lsUncachedClient, lsCachedClient, hostUncachedClient
 		lsMgr.GetEventRecorderFor("Landscaper"),
 		config,
 		config.Controllers.Installations.CommonControllerConfig.Workers,
+		config.Controllers.Installations.MaxWorkersPerNamespace,
 		lockingEnabled,
 		callerName,
 	)
@@ -57,11 +61,26 @@ func AddControllerToManager(lsUncachedClient, lsCachedClient, hostUncachedClient
 		return err
 	}
 
-	return builder.ControllerManagedBy(lsMgr).
+	if err := builder.ControllerManagedBy(lsMgr).
 		For(&v1alpha1.Installation{}, builder.OnlyMetadata).
 		Owns(&v1alpha1.Execution{}, builder.OnlyMetadata).
 		Owns(&v1alpha1.Installation{}, builder.OnlyMetadata).
 		WithOptions(utils.ConvertCommonControllerConfigToControllerOptions(config.Controllers.Installations.CommonControllerConfig)).
 		WithLogConstructor(func(r *reconcile.Request) logr.Logger { return log.Logr() }).
-		Complete(a)
+		Complete(a); err != nil {
+		return err
+	}
+
+	if config.Registry.Local != nil && config.Registry.Local.Watch {
+		watcher := registries.NewLocalRegistryWatcher(log.WithName("localRegistryWatcher"), osfs.New(), config.Registry.Local.RootPath)
+		if err := lsMgr.Add(manager.RunnableFunc(watcher.Start)); err != nil {
+			return fmt.Errorf("unable to add local registry watcher: %w", err)
+		}
+	}
+
+	if config.FeatureGates.ReconcileOnReferencedDataChange {
+		log.Info("automatic reconcile on changes of referenced secrets and configmaps enabled")
+		return addReferencedDataControllerToManager(ctx, lsUncachedClient, lsCachedClient, log, lsMgr)
+	}
+	return nil
 }
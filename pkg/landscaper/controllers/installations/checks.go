@@ -1,6 +1,8 @@
 package installations
 
 import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
 	lsv1alpha1 "github.com/gardener/landscaper/apis/core/v1alpha1"
 	lsv1alpha1helper "github.com/gardener/landscaper/apis/core/v1alpha1/helper"
 	kutil "github.com/gardener/landscaper/controller-utils/pkg/kubernetes"
@@ -25,7 +27,7 @@ func isInstFinished(inst *lsv1alpha1.Installation) bool {
 func isAutomaticReconcileOnSpecChange(inst *lsv1alpha1.Installation) bool {
 	return installations.IsRootInstallation(inst) &&
 		lsv1alpha1helper.HasReconcileIfChangedAnnotation(inst.ObjectMeta) &&
-		!lsv1alpha1helper.HasOperation(inst.ObjectMeta, lsv1alpha1.ReconcileOperation) &&
+		!hasReconcileOperation(inst) &&
 		inst.Status.JobID == inst.Status.JobIDFinished &&
 		inst.GetGeneration() != inst.Status.ObservedGeneration
 }
@@ -49,17 +51,41 @@ func hasInterruptOperation(inst *lsv1alpha1.Installation) bool {
 }
 
 func isNotRootWithReconcileOperation(inst *lsv1alpha1.Installation) bool {
-	return !installations.IsRootInstallation(inst) && lsv1alpha1helper.HasOperation(inst.ObjectMeta, lsv1alpha1.ReconcileOperation)
+	return !installations.IsRootInstallation(inst) && hasReconcileOperation(inst)
+}
+
+// hasReconcileOperation returns true if the installation has a reconcile or a force-reconcile operation annotation.
+func hasReconcileOperation(inst *lsv1alpha1.Installation) bool {
+	return lsv1alpha1helper.HasOperation(inst.ObjectMeta, lsv1alpha1.ReconcileOperation) ||
+		lsv1alpha1helper.HasOperation(inst.ObjectMeta, lsv1alpha1.ForceReconcileOperation)
+}
+
+// isExplicitReconcile returns true if the installation has a force-reconcile operation annotation or a reconcile
+// operation annotation without a reconcile reason. Reconcile operations that are set by the landscaper itself
+// always have a reason.
+func isExplicitReconcile(inst *lsv1alpha1.Installation) bool {
+	if lsv1alpha1helper.HasOperation(inst.ObjectMeta, lsv1alpha1.ForceReconcileOperation) {
+		return true
+	}
+	return lsv1alpha1helper.HasOperation(inst.ObjectMeta, lsv1alpha1.ReconcileOperation) &&
+		!metav1.HasAnnotation(inst.ObjectMeta, lsv1alpha1.ReconcileReasonAnnotation)
 }
 
 func isCreateNewJobID(inst *lsv1alpha1.Installation) bool {
 	isFirstDelete := !inst.DeletionTimestamp.IsZero() && !inst.Status.InstallationPhase.IsDeletion()
 
 	return installations.IsRootInstallation(inst) &&
-		(lsv1alpha1helper.HasOperation(inst.ObjectMeta, lsv1alpha1.ReconcileOperation) || isFirstDelete) &&
+		(hasReconcileOperation(inst) || isFirstDelete) &&
 		inst.Status.JobID == inst.Status.JobIDFinished
 }
 
+// isDeletedWithoutJob returns true if a subinstallation has been deleted, but no job has been started to process
+// the deletion. This happens if the subinstallation is deleted by the garbage collector.
+func isDeletedWithoutJob(inst *lsv1alpha1.Installation) bool {
+	return !installations.IsRootInstallation(inst) && !inst.DeletionTimestamp.IsZero() &&
+		!inst.Status.InstallationPhase.IsDeletion() && inst.Status.JobID == inst.Status.JobIDFinished
+}
+
 func isDifferentJobIDs(inst *lsv1alpha1.Installation) bool {
 	return inst.Status.JobID != inst.Status.JobIDFinished
 }
@@ -2,6 +2,9 @@ package installations
 
 import (
 	"context"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	"github.com/gardener/landscaper/pkg/utils"
 
@@ -62,6 +65,35 @@ func (c *DataObjectAndTargetCleaner) CleanupExports(ctx context.Context) error {
 	return nil
 }
 
+// MarkExportsOutdated marks all DataObjects exported by the given Installation as outdated, because the Installation
+// is deleted or has failed. DataObjects that are already marked keep their original timestamp.
+// The mark is removed when the DataObjects are exported again.
+func (c *DataObjectAndTargetCleaner) MarkExportsOutdated(ctx context.Context) error {
+	doList := &lsv1alpha1.DataObjectList{}
+	if err := read_write_layer.ListDataObjects(ctx, c.client, doList, read_write_layer.R000127,
+		client.InNamespace(c.installation.Namespace),
+		client.MatchingLabels{
+			lsv1alpha1.DataObjectSourceLabel:     lsv1alpha1helper.DataObjectSourceFromInstallation(c.installation),
+			lsv1alpha1.DataObjectSourceTypeLabel: string(lsv1alpha1.ExportDataObjectSourceType),
+		}); err != nil {
+		return err
+	}
+
+	now := time.Now().UTC().Format(time.RFC3339)
+	for i := range doList.Items {
+		do := &doList.Items[i]
+		if _, ok := do.Annotations[lsv1alpha1.DataObjectOutdatedSinceAnnotation]; ok || !do.DeletionTimestamp.IsZero() {
+			continue
+		}
+		metav1.SetMetaDataAnnotation(&do.ObjectMeta, lsv1alpha1.DataObjectOutdatedSinceAnnotation, now)
+		if err := read_write_layer.NewWriter(c.client).UpdateDataObject(ctx, read_write_layer.W000156, do); client.IgnoreNotFound(err) != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
 // CleanupContext deletes all DataObjects and Targets in the context of the given Installation.
 func (c *DataObjectAndTargetCleaner) CleanupContext(ctx context.Context) error {
 	doList := &lsv1alpha1.DataObjectList{}
@@ -6,8 +6,10 @@ package installations
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"reflect"
+	"time"
 
 	"github.com/gardener/component-cli/ociclient/cache"
 	"github.com/google/uuid"
@@ -30,13 +32,17 @@ import (
 	lc "github.com/gardener/landscaper/controller-utils/pkg/logging/constants"
 	"github.com/gardener/landscaper/pkg/api"
 	cnudieutils "github.com/gardener/landscaper/pkg/components/cnudie/utils"
+	"github.com/gardener/landscaper/pkg/components/ocmlib"
 	"github.com/gardener/landscaper/pkg/components/registries"
+	"github.com/gardener/landscaper/pkg/landscaper/approval"
 	"github.com/gardener/landscaper/pkg/landscaper/blueprints"
 	"github.com/gardener/landscaper/pkg/landscaper/installations"
 	"github.com/gardener/landscaper/pkg/landscaper/installations/executions"
 	"github.com/gardener/landscaper/pkg/landscaper/operation"
+	"github.com/gardener/landscaper/pkg/metrics/controllermetrics"
 	"github.com/gardener/landscaper/pkg/utils"
 	utilscache "github.com/gardener/landscaper/pkg/utils/cache"
+	"github.com/gardener/landscaper/pkg/utils/events"
 	"github.com/gardener/landscaper/pkg/utils/lock"
 	"github.com/gardener/landscaper/pkg/utils/read_write_layer"
 	"github.com/gardener/landscaper/pkg/utils/verify"
@@ -54,6 +60,7 @@ func NewController(ctx context.Context,
 	eventRecorder record.EventRecorder,
 	lsConfig *config.LandscaperConfiguration,
 	maxNumberOfWorkers int,
+	maxWorkersPerNamespace int,
 	lockingEnabled bool,
 	callerName string) (reconcile.Reconciler, error) {
 
@@ -67,6 +74,7 @@ func NewController(ctx context.Context,
 		clock:              clock.RealClock{},
 		LsConfig:           lsConfig,
 		workerCounter:      ws,
+		namespaceLimiter:   utils.NewNamespaceLimiter(maxWorkersPerNamespace),
 		lockingEnabled:     lockingEnabled,
 		callerName:         callerName,
 		locker:             *lock.NewLocker(lsUncachedClient, hostUncachedClient, callerName),
@@ -82,6 +90,13 @@ func NewController(ctx context.Context,
 	}
 
 	registries.SetOCMLibraryMode(lsConfig.UseOCMLib)
+	ocmlib.SetComponentVersionCache(lsConfig.Registry.ComponentVersionCache)
+
+	approvalGate, err := approval.NewGateFromConfig(lsUncachedClient, lsConfig.ApprovalHooks)
+	if err != nil {
+		return nil, err
+	}
+	ctrl.approvalGate = approvalGate
 
 	op := operation.NewOperation(scheme, eventRecorder, lsUncachedClient)
 	ctrl.Operation = *op
@@ -92,6 +107,14 @@ func NewController(ctx context.Context,
 	}
 	ctrl.finishedObjectCache = finishedObjectCache
 
+	if lsConfig != nil && lsConfig.Controllers.Installations.StartupPriority != nil {
+		startupPrioritizer, err := prepareStartupPrioritizer(ctx, lsUncachedClient, lsConfig.Controllers.Installations.StartupPriority)
+		if err != nil {
+			return nil, err
+		}
+		ctrl.startupPrioritizer = startupPrioritizer
+	}
+
 	return ctrl, nil
 }
 
@@ -133,6 +156,54 @@ func prepareFinishedObjectCache(ctx context.Context, lsUncachedClient client.Cli
 	return finishedObjectCache, nil
 }
 
+// prepareStartupPrioritizer creates a prioritizer for the installations with the startup priority label,
+// and for the installations in namespaces with this label.
+func prepareStartupPrioritizer(ctx context.Context, lsUncachedClient client.Client,
+	startupPriority *config.StartupPriorityConfiguration) (*utils.StartupPrioritizer, error) {
+
+	log, ctx := logging.FromContextOrNew(ctx, nil)
+
+	timeout := utils.DefaultStartupPriorityTimeout
+	if startupPriority.Timeout != nil {
+		timeout = startupPriority.Timeout.Duration
+	}
+	startupPrioritizer := utils.NewStartupPrioritizer(clock.RealClock{}, timeout)
+
+	priorityLabel := client.MatchingLabels{lsv1alpha1.StartupPriorityLabel: "true"}
+
+	namespaces := &corev1.NamespaceList{}
+	if err := read_write_layer.ListNamespaces(ctx, lsUncachedClient, namespaces, read_write_layer.R000143, priorityLabel); err != nil {
+		return nil, err
+	}
+
+	addInstallations := func(instList *lsv1alpha1.InstallationList) {
+		for instIndex := range instList.Items {
+			inst := &instList.Items[instIndex]
+			startupPrioritizer.Add(client.ObjectKeyFromObject(inst), isInstFinished(inst))
+		}
+	}
+
+	for _, namespace := range namespaces.Items {
+		instList := &lsv1alpha1.InstallationList{}
+		if err := read_write_layer.ListInstallations(ctx, lsUncachedClient, instList, read_write_layer.R000144,
+			client.InNamespace(namespace.Name)); err != nil {
+			return nil, err
+		}
+		addInstallations(instList)
+	}
+
+	instList := &lsv1alpha1.InstallationList{}
+	if err := read_write_layer.ListInstallations(ctx, lsUncachedClient, instList, read_write_layer.R000145, priorityLabel); err != nil {
+		return nil, err
+	}
+	addInstallations(instList)
+
+	log.Info("prepared startup priority of installations", "priorityNamespaces", len(namespaces.Items),
+		"active", startupPrioritizer.IsActive(), "timeout", timeout.String())
+
+	return startupPrioritizer, nil
+}
+
 // NewTestActuator creates a new Controller that is only meant for testing.
 func NewTestActuator(lsCachedClient, hostUncachedClient, hostCachedClient client.Client,
 	op operation.Operation, logger logging.Logger, passiveClock clock.PassiveClock,
@@ -166,13 +237,22 @@ type Controller struct {
 	LsConfig            *config.LandscaperConfiguration
 	SharedCache         cache.Cache
 	workerCounter       *utils.WorkerCounter
+	namespaceLimiter    *utils.NamespaceLimiter
+	startupPrioritizer  *utils.StartupPrioritizer
 	lockingEnabled      bool
 	callerName          string
 	locker              lock.Locker
+	approvalGate        *approval.Gate
 }
 
 func (c *Controller) Reconcile(ctx context.Context, req reconcile.Request) (result reconcile.Result, err error) {
 	_, ctx = c.log.StartReconcileAndAddToContext(ctx, req)
+	ctx = read_write_layer.ContextWithActor(ctx, "installation-controller")
+
+	start := time.Now()
+	defer func() {
+		controllermetrics.ObserveReconcile(controllermetrics.ControllerInstallation, req.Namespace, time.Since(start), result, err)
+	}()
 
 	result = reconcile.Result{}
 	defer utils.HandlePanics(ctx, &result, c.hostUncachedClient)
@@ -209,6 +289,29 @@ func (c *Controller) reconcile(ctx context.Context, req reconcile.Request) (reco
 
 	logger.Info(startMessage + "3")
 
+	if c.startupPrioritizer.IsActive() {
+		metadata := utils.EmptyInstallationMetadata()
+		if err := read_write_layer.GetMetaData(ctx, c.lsCachedClient, req.NamespacedName, metadata, read_write_layer.R000146); err != nil {
+			if apierrors.IsNotFound(err) {
+				logger.Debug(err.Error())
+				c.startupPrioritizer.Finished(req.NamespacedName)
+				return reconcile.Result{}, nil
+			}
+			return utils.LogHelper{}.LogStandardErrorAndGetReconcileResult(ctx, err)
+		}
+
+		if !c.startupPrioritizer.Admit(req.NamespacedName, metadata.Labels[lsv1alpha1.EncompassedByLabel]) {
+			logger.Debug("installation has no startup priority, postponing reconcile until the prioritized installations are processed")
+			return reconcile.Result{RequeueAfter: c.startupPrioritizer.RequeueInterval()}, nil
+		}
+	}
+
+	if !c.namespaceLimiter.TryEnter(req.Namespace) {
+		logger.Debug("maximal number of concurrent reconciles of the namespace reached, requeuing")
+		return reconcile.Result{RequeueAfter: c.namespaceLimiter.RequeueInterval()}, nil
+	}
+	defer c.namespaceLimiter.Exit(req.Namespace)
+
 	if c.lockingEnabled {
 		metadata := utils.EmptyInstallationMetadata()
 		if err := c.LsUncachedClient().Get(ctx, req.NamespacedName, metadata); err != nil {
@@ -237,11 +340,16 @@ func (c *Controller) reconcile(ctx context.Context, req reconcile.Request) (reco
 	if err := read_write_layer.GetInstallation(ctx, c.LsUncachedClient(), req.NamespacedName, inst, read_write_layer.R000010); err != nil {
 		if apierrors.IsNotFound(err) {
 			logger.Info(err.Error())
+			c.startupPrioritizer.Finished(req.NamespacedName)
 			return reconcile.Result{}, nil
 		}
 		return utils.LogHelper{}.LogStandardErrorAndGetReconcileResult(ctx, err)
 	}
 
+	if isInstFinished(inst) {
+		c.startupPrioritizer.Finished(req.NamespacedName)
+	}
+
 	// default the installation as it not done by the Controller runtime
 	if err := c.updateInstallationWithDefaults(ctx, inst); err != nil {
 		return utils.LogHelper{}.LogStandardErrorAndGetReconcileResult(ctx, err)
@@ -311,6 +419,20 @@ func (c *Controller) reconcileInstallation(ctx context.Context, inst *lsv1alpha1
 		}
 	}
 
+	if lsv1alpha1helper.HasRefreshBlueprintAnnotation(inst.ObjectMeta) {
+		if err := c.handleRefreshBlueprint(ctx, inst); err != nil {
+			return reconcile.Result{}, err
+		}
+		return reconcile.Result{}, nil
+	}
+
+	if lsv1alpha1helper.HasResumeImportsAnnotation(inst.ObjectMeta) {
+		if err := c.handleResumeImports(ctx, inst); err != nil {
+			return reconcile.Result{}, err
+		}
+		return reconcile.Result{}, nil
+	}
+
 	if hasInterruptOperation(inst) {
 		if err := c.handleInterruptOperation(ctx, inst); err != nil {
 			return reconcile.Result{}, err
@@ -328,12 +450,50 @@ func (c *Controller) reconcileInstallation(ctx context.Context, inst *lsv1alpha1
 		return reconcile.Result{}, nil
 	}
 
+	createNewJobID := isCreateNewJobID(inst)
+	deletedByGC := false
+	if !createNewJobID && c.isOwnerReferenceGCEnabled() && isDeletedWithoutJob(inst) {
+		// the installation has been deleted by the garbage collector, because its parent is gone or has released it,
+		// so that no parent starts the deletion job
+		deleted, err := utils.IsControllingInstallationDeleted(ctx, c.LsUncachedClient(), inst, read_write_layer.R000135)
+		if err != nil {
+			return reconcile.Result{}, err
+		}
+		deletedByGC = deleted
+		createNewJobID = deleted
+	}
+
+	// changes are only rolled out during the maintenance windows of the installation
+	if createNewJobID && hasReconcileSchedule(inst) {
+		requeueAfter, pending, err := c.waitForMaintenanceWindow(ctx, inst)
+		if err != nil {
+			return reconcile.Result{}, err
+		}
+		if pending {
+			return reconcile.Result{RequeueAfter: requeueAfter}, nil
+		}
+	}
+
 	// generate new jobID
-	if isCreateNewJobID(inst) {
-		inst.Status.JobID = uuid.New().String()
-		inst.Status.TransitionTimes = utils.NewTransitionTimes()
+	if createNewJobID {
+		jobID := uuid.New().String()
+		startJob := func(inst *lsv1alpha1.Installation) error {
+			// after a conflict, the latest version might not require a new job anymore, e.g. because a job has been started
+			if !isCreateNewJobID(inst) && !(deletedByGC && isDeletedWithoutJob(inst)) {
+				return read_write_layer.ErrPreconditionChanged
+			}
+			inst.Status.JobID = jobID
+			inst.Status.RedeployAllDeployItems = isExplicitReconcile(inst)
+			inst.Status.TransitionTimes = utils.NewTransitionTimes()
+			inst.Status.Conditions = lsv1alpha1helper.RemoveCondition(inst.Status.Conditions, lsv1alpha1.PendingWindowCondition)
+			return nil
+		}
 
-		if err := c.WriterToLsUncachedClient().UpdateInstallationStatus(ctx, read_write_layer.W000082, inst); err != nil {
+		if err := c.WriterToLsUncachedClient().UpdateInstallationStatusWithRetry(ctx, read_write_layer.W000082,
+			read_write_layer.R000160, inst, startJob); err != nil {
+			if errors.Is(err, read_write_layer.ErrPreconditionChanged) {
+				return reconcile.Result{Requeue: true}, nil
+			}
 			return reconcile.Result{}, err
 		}
 
@@ -352,7 +512,10 @@ func (c *Controller) reconcileInstallation(ctx context.Context, inst *lsv1alpha1
 		err := c.handleReconcilePhase(ctx, inst)
 		return utils.LogHelper{}.LogErrorAndGetReconcileResult(ctx, err)
 	} else {
-		// job finished; nothing to do
+		// job finished; only the marking of the exports of a failed installation is repeated if it has failed
+		if err := c.markExportsOfFailedInstallation(ctx, inst); err != nil {
+			return utils.LogHelper{}.LogStandardErrorAndGetReconcileResult(ctx, err)
+		}
 		return reconcile.Result{}, nil
 	}
 }
@@ -377,23 +540,10 @@ func (c *Controller) initPrerequisites(ctx context.Context, inst *lsv1alpha1.Ins
 		return nil, lserrors.NewWrappedError(err, currOp, "SetupRegistries", err.Error())
 	}
 
-	if runVerify && verify.IsVerifyEnabled(inst, c.LsConfig) {
-		componentVersion, err := op.ComponentsRegistry().GetComponentVersion(ctx, lsCtx.External.ComponentDescriptorRef())
-		if err != nil {
-			return nil, lserrors.NewWrappedError(err, currOp, "GetComponentVersion", err.Error())
-		}
-
-		signatureName, publicKeyData, caCertData, err := verify.ExtractVerifyInfo(ctx, inst, &lsCtx.External.Context, c.hostUncachedClient)
-		if err != nil {
-			return nil, lserrors.NewWrappedError(err, currOp, "ExtractVerifyInfo", err.Error())
-		}
-
-		pmVerify := utils.StartPerformanceMeasurement(&logger, "VerifySignature")
-		if err := op.ComponentsRegistry().VerifySignature(componentVersion, signatureName, publicKeyData, caCertData); err != nil {
-			pmVerify.StopDebug()
-			return nil, lserrors.NewWrappedError(err, currOp, "VerifySignature", err.Error())
+	if runVerify {
+		if err := c.verifySignature(ctx, op, inst, lsCtx); err != nil {
+			return nil, err
 		}
-		pmVerify.StopDebug()
 	}
 
 	intBlueprint, err := blueprints.Resolve(ctx, op.ComponentsRegistry(), lsCtx.External.ComponentDescriptorRef(), inst.Spec.Blueprint)
@@ -415,6 +565,56 @@ func (c *Controller) initPrerequisites(ctx context.Context, inst *lsv1alpha1.Ins
 	return instOp, nil
 }
 
+// verifySignature verifies the signature of the component descriptor of the installation, if the verification is enabled.
+// The result is reported in the SignatureVerified condition of the installation. An error is only returned
+// for failed verifications in mode "Fail".
+func (c *Controller) verifySignature(ctx context.Context, op *operation.Operation, inst *lsv1alpha1.Installation,
+	lsCtx *installations.Scope) lserrors.LsError {
+	currOp := "VerifySignature"
+
+	logger, ctx := logging.FromContextOrNew(ctx, nil)
+
+	settings := verify.GetVerifySettings(inst, c.LsConfig, lsCtx.External.RepositoryContext)
+	if settings == nil {
+		inst.Status.Conditions = lsv1alpha1helper.RemoveCondition(inst.Status.Conditions, lsv1alpha1.SignatureVerifiedCondition)
+		return nil
+	}
+
+	handleFailure := func(err error, reason string) lserrors.LsError {
+		inst.Status.Conditions = lsv1alpha1helper.CreateOrUpdateConditions(inst.Status.Conditions,
+			lsv1alpha1.SignatureVerifiedCondition, lsv1alpha1.ConditionFalse, reason, err.Error())
+		if settings.Mode == config.SignatureVerificationModeWarn {
+			logger.Info("signature verification failed", "signatureName", settings.SignatureName, "error", err.Error())
+			events.Warning(c.EventRecorder(), inst, events.ReasonSignatureVerificationFailed, reason, err.Error(), nil)
+			return nil
+		}
+		return lserrors.NewWrappedError(err, currOp, reason, err.Error())
+	}
+
+	componentVersion, err := op.ComponentsRegistry().GetComponentVersion(ctx, lsCtx.External.ComponentDescriptorRef())
+	if err != nil {
+		return lserrors.NewWrappedError(err, currOp, "GetComponentVersion", err.Error())
+	}
+
+	signatureName, publicKeyData, caCertData, err := verify.ExtractVerifyInfoForSignature(ctx, settings.SignatureName,
+		&lsCtx.External.Context, c.hostUncachedClient)
+	if err != nil {
+		return handleFailure(err, "ExtractVerifyInfo")
+	}
+
+	pmVerify := utils.StartPerformanceMeasurement(&logger, "VerifySignature")
+	err = op.ComponentsRegistry().VerifySignature(componentVersion, signatureName, publicKeyData, caCertData)
+	pmVerify.StopDebug()
+	if err != nil {
+		return handleFailure(err, "VerifySignature")
+	}
+
+	inst.Status.Conditions = lsv1alpha1helper.CreateOrUpdateConditions(inst.Status.Conditions,
+		lsv1alpha1.SignatureVerifiedCondition, lsv1alpha1.ConditionTrue, "SignatureVerified",
+		fmt.Sprintf("signature %q of the component descriptor has been verified", signatureName))
+	return nil
+}
+
 func (c *Controller) compareJobIDs(predecessorMap, predecessorMapNew map[string]*installations.InstallationAndImports) bool {
 	if len(predecessorMap) != len(predecessorMapNew) {
 		return false
@@ -440,12 +640,12 @@ func (c *Controller) handleInterruptOperation(ctx context.Context, inst *lsv1alp
 		return err
 	}
 
-	exec, err := executions.GetExecutionForInstallation(ctx, c.LsUncachedClient(), inst)
+	execs, err := executions.GetExecutionsForInstallation(ctx, c.LsUncachedClient(), inst)
 	if err != nil {
 		return err
 	}
 
-	if exec != nil {
+	for _, exec := range execs {
 		lsv1alpha1helper.SetOperation(&exec.ObjectMeta, lsv1alpha1.InterruptOperation)
 		lsv1alpha1helper.Touch(&exec.ObjectMeta)
 
@@ -468,6 +668,18 @@ func (c *Controller) handleInterruptOperation(ctx context.Context, inst *lsv1alp
 		}
 	}
 
+	if inst.Status.JobID != inst.Status.JobIDFinished {
+		// finish the current job immediately, so that the installation does not wait for its interrupted children
+		phase := lsv1alpha1.InstallationPhases.Failed
+		if !inst.DeletionTimestamp.IsZero() {
+			phase = lsv1alpha1.InstallationPhases.DeleteFailed
+		}
+		lsError := lserrors.NewError("handleInterruptOperation", lsv1alpha1.AbortedReason, "the current job was interrupted")
+		if err := c.setInstallationPhaseAndUpdate(ctx, inst, phase, lsError, read_write_layer.W000165, false); err != nil {
+			return err
+		}
+	}
+
 	return nil
 }
 
@@ -481,18 +693,21 @@ func (c *Controller) setInstallationPhaseAndUpdate(ctx context.Context, inst *ls
 		[]interface{}{lc.KeyReconciledResource, client.ObjectKeyFromObject(inst).String()},
 		lc.KeyMethod, op)
 
+	jobIDFinished := inst.Status.JobIDFinished
 	inst.Status.LastError = lserrors.TryUpdateLsError(inst.Status.LastError, lsError)
+	controllermetrics.ObserveError(controllermetrics.ControllerInstallation, inst.Namespace, lsError)
 
 	if inst.Status.LastError != nil {
-		lastErr := inst.Status.LastError
-		c.EventRecorder().Event(inst, corev1.EventTypeWarning, lastErr.Reason, lastErr.Message)
+		events.Error(c.EventRecorder(), inst, events.ReasonInstallationFailed, inst.Status.LastError)
 	}
 
+	previousPhase := inst.Status.InstallationPhase
 	if phase != inst.Status.InstallationPhase {
 		now := metav1.Now()
 		inst.Status.PhaseTransitionTime = &now
 	}
 	inst.Status.InstallationPhase = phase
+	inst.Status.Conditions = lsv1alpha1helper.UpdateInstallationConditions(inst.Status.Conditions, previousPhase, phase, inst.Status.LastError)
 
 	if phase.IsFinal() {
 		if installations.IsRootInstallation(inst) {
@@ -521,7 +736,23 @@ func (c *Controller) setInstallationPhaseAndUpdate(ctx context.Context, inst *ls
 		inst.Status.DependentsToTrigger = dependents
 	}
 
-	err := c.WriterToLsUncachedClient().UpdateInstallationStatus(ctx, writeID, inst)
+	// After a conflict, e.g. because the installation has been touched, the computed status is written to the latest
+	// version of the installation, unless its job has been replaced or finished in the meantime.
+	status := inst.Status.DeepCopy()
+	resourceVersion := inst.ResourceVersion
+	setStatus := func(latest *lsv1alpha1.Installation) error {
+		if latest.ResourceVersion == resourceVersion {
+			// the status has been computed for this version
+			return nil
+		}
+		if latest.Status.JobID != status.JobID || latest.Status.JobIDFinished != jobIDFinished {
+			return read_write_layer.ErrPreconditionChanged
+		}
+		latest.Status = *status.DeepCopy()
+		return nil
+	}
+
+	err := c.WriterToLsUncachedClient().UpdateInstallationStatusWithRetry(ctx, writeID, read_write_layer.R000169, inst, setStatus)
 	if err != nil {
 		if inst.Status.InstallationPhase == lsv1alpha1.InstallationPhases.Deleting {
 			// recheck if already deleted
@@ -535,7 +766,7 @@ func (c *Controller) setInstallationPhaseAndUpdate(ctx context.Context, inst *ls
 
 		// reduceLogLevelForConflicts is set on true, if conflicts might occur, e.g.
 		// - when deleting an item a touch operation might be triggered for all siblings to speed up the operation
-		if reduceLogLevelForConflicts && apierrors.IsConflict(err) {
+		if (reduceLogLevelForConflicts && apierrors.IsConflict(err)) || errors.Is(err, read_write_layer.ErrPreconditionChanged) {
 			logger.Info("unable to update installation status", lc.KeyError, err.Error())
 		} else {
 			logger.Error(err, "unable to update installation status")
@@ -545,17 +776,77 @@ func (c *Controller) setInstallationPhaseAndUpdate(ctx context.Context, inst *ls
 		}
 
 		return lsError
-	} else if isInstFinished(inst) {
+	}
+
+	controllermetrics.ObservePhaseTransition(controllermetrics.ControllerInstallation, inst.Namespace,
+		string(previousPhase), string(inst.Status.InstallationPhase))
+
+	// the exports are only marked as outdated after the failed phase has been persisted
+	if err := c.markExportsOfFailedInstallation(ctx, inst); err != nil {
+		logger.Error(err, "unable to mark exports as outdated")
+		return lserrors.NewWrappedError(err, op, "MarkExportsOutdated", err.Error())
+	}
+
+	if isInstFinished(inst) {
 		c.finishedObjectCache.AddSynchonized(&inst.ObjectMeta)
+		c.startupPrioritizer.Finished(client.ObjectKeyFromObject(inst))
 	}
 
 	return lsError
 }
 
+// markExportsOfFailedInstallation marks the exports of a permanently failed installation as outdated,
+// so that the consumers of the exports know that they run on data of a failed installation.
+// Exports that are already marked are skipped, so that it can be repeated if it has failed before.
+func (c *Controller) markExportsOfFailedInstallation(ctx context.Context, inst *lsv1alpha1.Installation) error {
+	if inst.Status.InstallationPhase != lsv1alpha1.InstallationPhases.Failed || !IsPermanentlyFailed(inst) {
+		return nil
+	}
+	return NewDataObjectAndTargetCleaner(inst, c.LsUncachedClient()).MarkExportsOutdated(ctx)
+}
+
+// isOwnerReferenceGCEnabled returns true if the subobjects of deleted installations are deleted by the garbage collector.
+func (c *Controller) isOwnerReferenceGCEnabled() bool {
+	return c.LsConfig != nil && c.LsConfig.FeatureGates.OwnerReferenceGarbageCollection
+}
+
+// handleRefreshBlueprint invalidates the cached blueprints and component versions, so that the blueprint
+// of the installation is resolved again, and removes the refresh annotation.
+// A root installation is reconciled afterwards.
+func (c *Controller) handleRefreshBlueprint(ctx context.Context, inst *lsv1alpha1.Installation) error {
+	logger, ctx := logging.FromContextOrNew(ctx, nil)
+
+	logger.Info("invalidating cached blueprints and component versions due to refresh annotation")
+	if err := registries.InvalidateCaches(); err != nil {
+		return err
+	}
+
+	if len(inst.Status.ResolvedComponentVersions) > 0 {
+		logger.Info("removing pinned component versions due to refresh annotation")
+		inst.Status.ResolvedComponentVersions = nil
+		if err := c.WriterToLsUncachedClient().UpdateInstallationStatus(ctx, read_write_layer.W000172, inst); err != nil {
+			logger.Error(err, "failed to remove pinned component versions of installation")
+			return err
+		}
+	}
+
+	delete(inst.Annotations, lsv1alpha1.RefreshBlueprintAnnotation)
+	if installations.IsRootInstallation(inst) {
+		lsv1alpha1helper.SetOperation(&inst.ObjectMeta, lsv1alpha1.ReconcileOperation)
+		metav1.SetMetaDataAnnotation(&inst.ObjectMeta, lsv1alpha1.ReconcileReasonAnnotation, reconcileReasonRefreshBlueprint)
+	}
+	if err := c.WriterToLsUncachedClient().UpdateInstallation(ctx, read_write_layer.W000167, inst); err != nil {
+		logger.Error(err, "failed to remove refresh annotation of installation")
+		return err
+	}
+	return nil
+}
+
 func (c *Controller) addReconcileAnnotation(ctx context.Context, inst *lsv1alpha1.Installation) error {
 	logger, ctx := logging.FromContextOrNew(ctx, nil)
 
 	lsv1alpha1helper.SetOperation(&inst.ObjectMeta, lsv1alpha1.ReconcileOperation)
+	metav1.SetMetaDataAnnotation(&inst.ObjectMeta, lsv1alpha1.ReconcileReasonAnnotation, reconcileReasonSpecChanged)
 
 	if err := c.WriterToLsUncachedClient().UpdateInstallation(ctx, read_write_layer.W000078, inst); err != nil {
 		logger.Error(err, "failed to trigger automatic reconcile operation of installation")
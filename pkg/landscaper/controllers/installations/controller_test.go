@@ -110,6 +110,7 @@ var _ = Describe("Installation Controller", func() {
 			// We consider an unfinished Installation with an Execution and a subinstallation.
 			// The Installation has an interrupt annotation. After a reconciliation the annotation should be
 			// added to the Execution and subinstallation, and it should be removed from the root Installation.
+			// The current job of the root Installation should be finished as failed.
 			ctx := context.Background()
 
 			var err error
@@ -139,6 +140,10 @@ var _ = Describe("Installation Controller", func() {
 
 			testutils.ExpectNoError(testenv.Client.Get(ctx, kutil.ObjectKeyFromObject(inst), inst))
 			Expect(inst.ObjectMeta.Annotations).NotTo(HaveKeyWithValue(lsv1alpha1.OperationAnnotation, string(lsv1alpha1.InterruptOperation)))
+			Expect(inst.Status.InstallationPhase).To(Equal(lsv1alpha1.InstallationPhases.Failed))
+			Expect(inst.Status.JobIDFinished).To(Equal(inst.Status.JobID))
+			Expect(inst.Status.LastError).NotTo(BeNil())
+			Expect(inst.Status.LastError.Reason).To(Equal(lsv1alpha1.AbortedReason))
 
 			testutils.ExpectNoError(testenv.Client.Get(ctx, kutil.ObjectKeyFromObject(exec), exec))
 			Expect(exec.ObjectMeta.Annotations).To(HaveKeyWithValue(lsv1alpha1.OperationAnnotation, string(lsv1alpha1.InterruptOperation)))
@@ -0,0 +1,8 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package installations
+
+// CheckOutdatedImports exposes the check of outdated imports for tests.
+var CheckOutdatedImports = (*Controller).checkOutdatedImports
@@ -0,0 +1,167 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package installations
+
+import (
+	"context"
+	"fmt"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	lsv1alpha1 "github.com/gardener/landscaper/apis/core/v1alpha1"
+	lsv1alpha1helper "github.com/gardener/landscaper/apis/core/v1alpha1/helper"
+	lserrors "github.com/gardener/landscaper/apis/errors"
+	"github.com/gardener/landscaper/controller-utils/pkg/logging"
+	"github.com/gardener/landscaper/pkg/landscaper/installations"
+	"github.com/gardener/landscaper/pkg/utils/events"
+	"github.com/gardener/landscaper/pkg/utils/read_write_layer"
+)
+
+const (
+	// importQuarantineThreshold is the number of consecutive failures caused by the same version of an import source
+	// after which an installation is quarantined.
+	importQuarantineThreshold = 3
+
+	// quarantinedBadImportReason is the reason of the QuarantinedBadImport condition and error.
+	quarantinedBadImportReason = "QuarantinedBadImport"
+)
+
+// isImportQuarantined returns true if the installation is quarantined because of malformed import data.
+func isImportQuarantined(inst *lsv1alpha1.Installation) bool {
+	return inst.Status.ImportQuarantine != nil && inst.Status.ImportQuarantine.QuarantineTime != nil
+}
+
+// getImportSourceResourceVersion returns the resourceVersion of the given import source.
+// The second return value is false if the source object does not exist.
+func getImportSourceResourceVersion(ctx context.Context, cl client.Client, source lsv1alpha1.TypedObjectReference,
+	readID read_write_layer.ReadID) (string, bool, error) {
+
+	metadata := &metav1.PartialObjectMetadata{}
+	metadata.APIVersion = source.APIVersion
+	metadata.Kind = source.Kind
+	if err := read_write_layer.GetMetaData(ctx, cl, source.NamespacedName(), metadata, readID); err != nil {
+		if apierrors.IsNotFound(err) {
+			return "", false, nil
+		}
+		return "", false, err
+	}
+	return metadata.GetResourceVersion(), true, nil
+}
+
+// isImportSourceChanged returns true if the import source of the quarantine has been changed or deleted.
+func isImportSourceChanged(ctx context.Context, cl client.Client, quarantine *lsv1alpha1.ImportQuarantine,
+	readID read_write_layer.ReadID) (bool, error) {
+
+	resourceVersion, found, err := getImportSourceResourceVersion(ctx, cl, quarantine.Source, readID)
+	if err != nil {
+		return false, err
+	}
+	return !found || resourceVersion != quarantine.SourceResourceVersion, nil
+}
+
+// checkImportQuarantine returns an error if the installation is quarantined and the source of the malformed import
+// data has not been changed since. Otherwise, the quarantine is released.
+func (c *Controller) checkImportQuarantine(ctx context.Context, inst *lsv1alpha1.Installation) lserrors.LsError {
+	op := "checkImportQuarantine"
+
+	if !isImportQuarantined(inst) {
+		return nil
+	}
+
+	logger, ctx := logging.FromContextOrNew(ctx, nil)
+
+	quarantine := inst.Status.ImportQuarantine
+	changed, err := isImportSourceChanged(ctx, c.LsUncachedClient(), quarantine, read_write_layer.R000154)
+	if err != nil {
+		return lserrors.NewWrappedError(err, op, "GetImportSource", err.Error())
+	}
+	if changed {
+		logger.Info("releasing installation from import quarantine, because the import source has changed",
+			"import", quarantine.ImportName, "kind", quarantine.Source.Kind, "source", quarantine.Source.NamespacedName().String())
+		releaseImportQuarantine(inst)
+		return nil
+	}
+
+	return lserrors.NewError(op, quarantinedBadImportReason, importQuarantineMessage(quarantine))
+}
+
+// recordBadImport counts the failures of the import construction that are caused by malformed data of the same
+// version of an import source. The installation is quarantined if the threshold is reached.
+func (c *Controller) recordBadImport(ctx context.Context, inst *lsv1alpha1.Installation, badImportErr *installations.BadImportError) {
+	logger, ctx := logging.FromContextOrNew(ctx, nil)
+
+	resourceVersion, _, err := getImportSourceResourceVersion(ctx, c.LsUncachedClient(), badImportErr.Source, read_write_layer.R000155)
+	if err != nil {
+		logger.Error(err, "unable to get import source", "kind", badImportErr.Source.Kind,
+			"source", badImportErr.Source.NamespacedName().String())
+	}
+
+	quarantine := inst.Status.ImportQuarantine
+	if quarantine != nil && quarantine.ImportName == badImportErr.ImportName && quarantine.Source == badImportErr.Source &&
+		quarantine.SourceResourceVersion == resourceVersion {
+		quarantine.Failures++
+	} else {
+		quarantine = &lsv1alpha1.ImportQuarantine{
+			ImportName:            badImportErr.ImportName,
+			Source:                badImportErr.Source,
+			SourceResourceVersion: resourceVersion,
+			Failures:              1,
+		}
+		inst.Status.ImportQuarantine = quarantine
+	}
+
+	if quarantine.Failures < importQuarantineThreshold || quarantine.QuarantineTime != nil {
+		return
+	}
+
+	now := metav1.Now()
+	quarantine.QuarantineTime = &now
+	msg := importQuarantineMessage(quarantine)
+	inst.Status.Conditions = lsv1alpha1helper.CreateOrUpdateConditions(inst.Status.Conditions,
+		lsv1alpha1.QuarantinedBadImportCondition, lsv1alpha1.ConditionTrue, "MalformedImportData", msg)
+	events.Warning(c.EventRecorder(), inst, events.ReasonImportQuarantined, quarantinedBadImportReason, msg,
+		map[string]string{events.ImportsAnnotation: quarantine.ImportName})
+}
+
+// releaseImportQuarantine removes the quarantine and the QuarantinedBadImport condition from the installation.
+func releaseImportQuarantine(inst *lsv1alpha1.Installation) {
+	inst.Status.ImportQuarantine = nil
+	inst.Status.Conditions = lsv1alpha1helper.RemoveCondition(inst.Status.Conditions, lsv1alpha1.QuarantinedBadImportCondition)
+}
+
+func importQuarantineMessage(quarantine *lsv1alpha1.ImportQuarantine) string {
+	return fmt.Sprintf("import %q failed %d times due to malformed data of %s %s: the installation is not retried "+
+		"until the %s is changed or the annotation %s=true is set", quarantine.ImportName, quarantine.Failures,
+		quarantine.Source.Kind, quarantine.Source.NamespacedName().String(), quarantine.Source.Kind,
+		lsv1alpha1.ResumeImportsAnnotation)
+}
+
+// handleResumeImports releases the installation from the import quarantine and removes the resume annotation.
+// A root installation is reconciled afterwards.
+func (c *Controller) handleResumeImports(ctx context.Context, inst *lsv1alpha1.Installation) error {
+	logger, ctx := logging.FromContextOrNew(ctx, nil)
+
+	if inst.Status.ImportQuarantine != nil {
+		logger.Info("releasing installation from import quarantine due to resume annotation")
+		releaseImportQuarantine(inst)
+		if err := c.WriterToLsUncachedClient().UpdateInstallationStatus(ctx, read_write_layer.W000173, inst); err != nil {
+			logger.Error(err, "failed to release installation from import quarantine")
+			return err
+		}
+	}
+
+	delete(inst.Annotations, lsv1alpha1.ResumeImportsAnnotation)
+	if installations.IsRootInstallation(inst) {
+		lsv1alpha1helper.SetOperation(&inst.ObjectMeta, lsv1alpha1.ReconcileOperation)
+		metav1.SetMetaDataAnnotation(&inst.ObjectMeta, lsv1alpha1.ReconcileReasonAnnotation, reconcileReasonResumeImports)
+	}
+	if err := c.WriterToLsUncachedClient().UpdateInstallation(ctx, read_write_layer.W000174, inst); err != nil {
+		logger.Error(err, "failed to remove resume annotation of installation")
+		return err
+	}
+	return nil
+}
@@ -0,0 +1,73 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package installations_test
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/record"
+	"k8s.io/utils/clock/testing"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	"github.com/gardener/landscaper/apis/config"
+	"github.com/gardener/landscaper/apis/core/v1alpha1"
+	lsv1alpha1helper "github.com/gardener/landscaper/apis/core/v1alpha1/helper"
+	"github.com/gardener/landscaper/controller-utils/pkg/logging"
+	"github.com/gardener/landscaper/pkg/api"
+	installationsctl "github.com/gardener/landscaper/pkg/landscaper/controllers/installations"
+	"github.com/gardener/landscaper/pkg/landscaper/dataobjects"
+	"github.com/gardener/landscaper/pkg/landscaper/installations/imports"
+	lsoperation "github.com/gardener/landscaper/pkg/landscaper/operation"
+)
+
+var _ = Describe("Outdated imports", func() {
+
+	var (
+		recorder *record.FakeRecorder
+		ctrl     *installationsctl.Controller
+	)
+
+	BeforeEach(func() {
+		recorder = record.NewFakeRecorder(10)
+		fakeClient := fake.NewClientBuilder().WithScheme(api.LandscaperScheme).Build()
+		op := lsoperation.NewOperation(api.LandscaperScheme, recorder, fakeClient)
+		ctrl = installationsctl.NewTestActuator(fakeClient, fakeClient, fakeClient, *op, logging.Discard(),
+			&testing.FakePassiveClock{}, &config.LandscaperConfiguration{}, "test-outdated-imports")
+	})
+
+	importsWith := func(outdated bool) *imports.Imports {
+		do := &dataobjects.DataObject{}
+		if outdated {
+			do.Metadata.OutdatedSince = &metav1.Time{}
+		}
+		return &imports.Imports{DataObjects: map[string]*dataobjects.DataObject{"a": do}}
+	}
+
+	conditionStatus := func(inst *v1alpha1.Installation) v1alpha1.ConditionStatus {
+		cond := lsv1alpha1helper.GetCondition(inst.Status.Conditions, v1alpha1.ImportsUpToDateCondition)
+		Expect(cond).ToNot(BeNil())
+		return cond.Status
+	}
+
+	It("should emit a warning only when the imports become outdated", func() {
+		inst := &v1alpha1.Installation{}
+
+		installationsctl.CheckOutdatedImports(ctrl, inst, importsWith(true))
+		Expect(conditionStatus(inst)).To(Equal(v1alpha1.ConditionFalse))
+		Expect(recorder.Events).To(HaveLen(1))
+
+		installationsctl.CheckOutdatedImports(ctrl, inst, importsWith(true))
+		Expect(conditionStatus(inst)).To(Equal(v1alpha1.ConditionFalse))
+		Expect(recorder.Events).To(HaveLen(1))
+
+		installationsctl.CheckOutdatedImports(ctrl, inst, importsWith(false))
+		Expect(conditionStatus(inst)).To(Equal(v1alpha1.ConditionTrue))
+
+		installationsctl.CheckOutdatedImports(ctrl, inst, importsWith(true))
+		Expect(conditionStatus(inst)).To(Equal(v1alpha1.ConditionFalse))
+		Expect(recorder.Events).To(HaveLen(2))
+	})
+})
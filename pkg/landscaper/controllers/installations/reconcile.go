@@ -7,9 +7,12 @@ package installations
 import (
 	"context"
 	"fmt"
+	"strings"
+	"time"
 
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/sets"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	lsv1alpha1 "github.com/gardener/landscaper/apis/core/v1alpha1"
@@ -23,7 +26,10 @@ import (
 	"github.com/gardener/landscaper/pkg/landscaper/installations/imports"
 	"github.com/gardener/landscaper/pkg/landscaper/installations/reconcilehelper"
 	"github.com/gardener/landscaper/pkg/landscaper/installations/subinstallations"
+	"github.com/gardener/landscaper/pkg/metrics/controllermetrics"
 	lsutil "github.com/gardener/landscaper/pkg/utils"
+	"github.com/gardener/landscaper/pkg/utils/dependencies"
+	"github.com/gardener/landscaper/pkg/utils/events"
 	"github.com/gardener/landscaper/pkg/utils/read_write_layer"
 )
 
@@ -48,7 +54,9 @@ func (c *Controller) handleReconcilePhase(ctx context.Context, inst *lsv1alpha1.
 			nextPhase = lsv1alpha1.InstallationPhases.InitDelete
 		}
 
+		previousPhase := inst.Status.InstallationPhase
 		inst.Status.InstallationPhase = nextPhase
+		inst.Status.Conditions = lsv1alpha1helper.UpdateInstallationConditions(inst.Status.Conditions, previousPhase, nextPhase, inst.Status.LastError)
 		now := metav1.Now()
 		inst.Status.PhaseTransitionTime = &now
 		inst.Status.TransitionTimes = lsutil.SetInitTransitionTime(inst.Status.TransitionTimes)
@@ -58,6 +66,8 @@ func (c *Controller) handleReconcilePhase(ctx context.Context, inst *lsv1alpha1.
 		if err := c.WriterToLsUncachedClient().UpdateInstallationStatus(ctx, read_write_layer.W000115, inst); err != nil {
 			return lserrors.NewWrappedError(err, op, "InitialPhaseSetting", err.Error())
 		}
+		controllermetrics.ObservePhaseTransition(controllermetrics.ControllerInstallation, inst.Namespace,
+			string(previousPhase), string(nextPhase))
 	}
 
 	if inst.Status.InstallationPhase == lsv1alpha1.InstallationPhases.Init {
@@ -106,6 +116,15 @@ func (c *Controller) handleReconcilePhase(ctx context.Context, inst *lsv1alpha1.
 	}
 
 	if inst.Status.InstallationPhase == lsv1alpha1.InstallationPhases.ObjectsCreated {
+		if failed, err := c.checkApproval(ctx, inst, lsv1alpha1.InstallationPhases.Progressing); err != nil {
+			nextPhase := inst.Status.InstallationPhase
+			if failed {
+				nextPhase = lsv1alpha1.InstallationPhases.Failed
+			}
+			return c.setInstallationPhaseAndUpdate(ctx, inst, nextPhase, err,
+				read_write_layer.W000157, false)
+		}
+
 		if err := c.handlePhaseObjectsCreated(ctx, inst); err != nil {
 			return c.setInstallationPhaseAndUpdate(ctx, inst, inst.Status.InstallationPhase, err,
 				read_write_layer.W000116, false)
@@ -187,6 +206,23 @@ func (c *Controller) handleReconcilePhase(ctx context.Context, inst *lsv1alpha1.
 
 	if inst.Status.InstallationPhase == lsv1alpha1.InstallationPhases.TriggerDelete {
 
+		if failed, err := c.checkApproval(ctx, inst, lsv1alpha1.InstallationPhases.Deleting); err != nil {
+			nextPhase := inst.Status.InstallationPhase
+			if failed {
+				nextPhase = lsv1alpha1.InstallationPhases.DeleteFailed
+			}
+			return c.setInstallationPhaseAndUpdate(ctx, inst, nextPhase, err,
+				read_write_layer.W000158, true)
+		}
+
+		if c.isDeletedByGarbageCollector(inst) {
+			if err := c.handleDeletionPhaseGarbageCollection(ctx, inst); err != nil {
+				return c.setInstallationPhaseAndUpdate(ctx, inst, inst.Status.InstallationPhase, err,
+					read_write_layer.W000161, true)
+			}
+			return nil
+		}
+
 		if err := c.handleDeletionPhaseTriggerDeleting(ctx, inst); err != nil {
 			return c.setInstallationPhaseAndUpdate(ctx, inst, inst.Status.InstallationPhase, err,
 				read_write_layer.W000126, true)
@@ -236,6 +272,11 @@ func (c *Controller) handlePhaseInit(ctx context.Context, inst *lsv1alpha1.Insta
 		return lserrors.NewWrappedError(err, currentOperation, "CleanupExports", err.Error()), nil
 	}
 
+	// an installation with malformed import data is not retried until the import source has changed
+	if fatalError := c.checkImportQuarantine(ctx, inst); fatalError != nil {
+		return fatalError, nil
+	}
+
 	instOp, imps, importsHash, predecessorMap, fatalError, normalError := c.init(ctx, inst, true)
 
 	if fatalError != nil {
@@ -245,9 +286,16 @@ func (c *Controller) handlePhaseInit(ctx context.Context, inst *lsv1alpha1.Insta
 	}
 
 	if err := c.CreateImportsAndSubobjects(ctx, instOp, imps, subInstCache); err != nil {
+		if badImportErr, ok := installations.GetBadImportError(err); ok {
+			c.recordBadImport(ctx, inst, badImportErr)
+		}
 		return lserrors.NewWrappedError(err, currentOperation, "CreateImportsAndSubobjects", err.Error()), nil
 	}
 
+	if inst.Status.ImportQuarantine != nil {
+		releaseImportQuarantine(inst)
+	}
+
 	// we need to recheck the predecessors because they might have been changed during fetching the import data and therefore
 	// the import data might not be consistent. Then we should not go to the next phase and start the current sub objects
 	// fatal errors are not so important here as there will be a retry and if these still exists, they will result in a failure
@@ -276,6 +324,33 @@ func (c *Controller) handlePhaseInit(ctx context.Context, inst *lsv1alpha1.Insta
 func (c *Controller) init(ctx context.Context, inst *lsv1alpha1.Installation, runVerify bool) (*installations.Operation,
 	*imports.Imports, string, map[string]*installations.InstallationAndImports, lserrors.LsError, lserrors.LsError) {
 
+	if !lsv1alpha1helper.HasTraceImportsAnnotation(inst.ObjectMeta) {
+		return c.initWithImportTrace(ctx, inst, runVerify, nil)
+	}
+
+	trace := installations.NewImportTrace()
+	instOp, imps, hash, predecessorMap, fatalError, normalError := c.initWithImportTrace(ctx, inst, runVerify, trace)
+
+	var result error
+	if fatalError != nil {
+		result = fatalError
+	} else if normalError != nil {
+		result = normalError
+	}
+	if err := trace.Write(ctx, c.LsUncachedClient(), inst, result); err != nil {
+		logger, _ := logging.FromContextOrNew(ctx, nil)
+		logger.Error(err, "unable to write import trace")
+	}
+
+	return instOp, imps, hash, predecessorMap, fatalError, normalError
+}
+
+// initWithImportTrace resolves the imports of the installation. The decisions of the import resolution are recorded
+// in the given trace, which may be nil.
+func (c *Controller) initWithImportTrace(ctx context.Context, inst *lsv1alpha1.Installation, runVerify bool,
+	trace *installations.ImportTrace) (*installations.Operation, *imports.Imports, string,
+	map[string]*installations.InstallationAndImports, lserrors.LsError, lserrors.LsError) {
+
 	logger, ctx := logging.FromContextOrNew(ctx, []interface{}{lc.KeyReconciledResource, client.ObjectKeyFromObject(inst).String()})
 
 	currentOperation := "init"
@@ -289,6 +364,7 @@ func (c *Controller) init(ctx context.Context, inst *lsv1alpha1.Installation, ru
 	}
 
 	instOp.CurrentOperation = currentOperation
+	instOp.ImportTrace = trace
 
 	rh, err := reconcilehelper.NewReconcileHelper(ctx, instOp)
 	if err != nil {
@@ -311,6 +387,8 @@ func (c *Controller) init(ctx context.Context, inst *lsv1alpha1.Installation, ru
 			return nil, nil, "", nil, nil, normalError
 		}
 
+		inst.Status.Predecessors = installations.GetPredecessorStatus(predecessorMap)
+
 		if err = rh.AllPredecessorsFinished(ctx, inst, predecessorMap); err != nil {
 			normalError := lserrors.NewWrappedError(err, currentOperation, "AllPredecessorsFinished", err.Error())
 			return nil, nil, "", nil, nil, normalError
@@ -320,6 +398,10 @@ func (c *Controller) init(ctx context.Context, inst *lsv1alpha1.Installation, ru
 			fatalError = lserrors.NewWrappedError(err, currentOperation, "AllPredecessorsSucceeded", err.Error())
 			return nil, nil, "", nil, fatalError, nil
 		}
+	} else {
+		trace.Record("", "FetchPredecessors", installations.ImportTraceInfo,
+			"predecessors are not checked as the installation has no sibling imports")
+		inst.Status.Predecessors = nil
 	}
 
 	imps, err := rh.ImportsSatisfied(ctx)
@@ -328,6 +410,23 @@ func (c *Controller) init(ctx context.Context, inst *lsv1alpha1.Installation, ru
 		return nil, nil, "", nil, fatalError, nil
 	}
 
+	if inst.Spec.StrictImportOwnership {
+		importSources, lsErr := imports.CheckImportOwnership(inst, imps)
+		if lsErr != nil {
+			trace.Record("", "CheckImportOwnership", installations.ImportTraceRejected, "%s", lsErr.Error())
+			return nil, nil, "", nil, lsErr, nil
+		}
+		for _, source := range importSources {
+			trace.Record(source.Name, "CheckImportOwnership", installations.ImportTraceAccepted,
+				"%q is provided by the recorded source %q", source.Key, source.Source)
+		}
+		inst.Status.ImportSources = importSources
+	} else {
+		inst.Status.ImportSources = nil
+	}
+
+	c.checkOutdatedImports(inst, imps)
+
 	hash, err := c.hash(imps)
 	if err != nil {
 		fatalError = lserrors.NewWrappedError(err, currentOperation, "HashImports", err.Error())
@@ -335,10 +434,43 @@ func (c *Controller) init(ctx context.Context, inst *lsv1alpha1.Installation, ru
 	}
 
 	logger.Debug("imports hash computation", "hash", hash)
+	trace.Record("", "HashImports", installations.ImportTraceInfo, "imports hash %q (previous hash %q)",
+		hash, inst.Status.ImportsHash)
 
 	return instOp, imps, hash, predecessorMap, nil, nil
 }
 
+// checkOutdatedImports sets the ImportsUpToDate condition of the installation and emits a warning event
+// when some imported data objects become outdated. The condition is only added once imports are outdated,
+// so that a missing condition means that all imports are up to date.
+func (c *Controller) checkOutdatedImports(inst *lsv1alpha1.Installation, imps *imports.Imports) {
+	outdated := imps.OutdatedDataObjects()
+	cond := lsv1alpha1helper.GetCondition(inst.Status.Conditions, lsv1alpha1.ImportsUpToDateCondition)
+	if len(outdated) == 0 {
+		if cond != nil {
+			inst.Status.Conditions = lsv1alpha1helper.CreateOrUpdateConditions(inst.Status.Conditions,
+				lsv1alpha1.ImportsUpToDateCondition, lsv1alpha1.ConditionTrue, "ImportsUpToDate", "All imports are up to date")
+		}
+		return
+	}
+
+	details := make([]string, 0, len(outdated))
+	for _, impName := range outdated {
+		details = append(details, fmt.Sprintf("%s (outdated since %s)", impName,
+			imps.DataObjects[impName].Metadata.OutdatedSince.UTC().Format(time.RFC3339)))
+	}
+	msg := fmt.Sprintf("the exporting installations of the following imports have been deleted or have failed: %s",
+		strings.Join(details, ", "))
+	inst.Status.Conditions = lsv1alpha1helper.CreateOrUpdateConditions(inst.Status.Conditions,
+		lsv1alpha1.ImportsUpToDateCondition, lsv1alpha1.ConditionFalse, "OutdatedImports", msg)
+	if cond != nil && cond.Status == lsv1alpha1.ConditionFalse {
+		// the warning has already been emitted when the imports became outdated
+		return
+	}
+	events.Warning(c.EventRecorder(), inst, events.ReasonImportsOutdated, "OutdatedImports", msg,
+		map[string]string{events.ImportsAnnotation: strings.Join(outdated, ",")})
+}
+
 func (c *Controller) hash(imps *imports.Imports) (string, error) {
 	hash, err := imports.ComputeImportsHash(imps)
 	if err != nil {
@@ -368,10 +500,14 @@ func (c *Controller) handlePhaseCleanupOrphaned(ctx context.Context, inst *lsv1a
 		return nil, nil
 	}
 
-	// Consider the remaining subinstallations to be deleted. If they are all finished with phase DeleteFailed,
+	// Orphaned subinstallations do not wait for their successors. Therefore, the deletion is only triggered for
+	// those orphaned subinstallations whose exports are not imported by other orphaned subinstallations.
+	subInstsToTrigger := getOrphanedSubinstallationsToTrigger(ctx, subInstsToDelete)
+
+	// Consider the subinstallations whose deletion has been triggered. If they are all finished with phase DeleteFailed,
 	// we are stuck and return an error.
 	allFailed := true
-	for _, next := range subInstsToDelete {
+	for _, next := range subInstsToTrigger {
 		if next.Status.JobIDFinished != inst.Status.JobID || next.Status.InstallationPhase != lsv1alpha1.InstallationPhases.DeleteFailed {
 			allFailed = false
 		}
@@ -380,7 +516,7 @@ func (c *Controller) handlePhaseCleanupOrphaned(ctx context.Context, inst *lsv1a
 		return lserrors.NewError(currentOperation, "AllOrphanedSubinstallationsFailed", "all orphaned subinstallations failed"), nil
 	}
 
-	for _, next := range subInstsToDelete {
+	for _, next := range subInstsToTrigger {
 		if next.Status.JobID != inst.Status.JobID {
 			next.Status.JobID = inst.Status.JobID
 			inst.Status.TransitionTimes = lsutil.NewTransitionTimes()
@@ -394,6 +530,55 @@ func (c *Controller) handlePhaseCleanupOrphaned(ctx context.Context, inst *lsv1a
 		"some orphaned subinstallations are still deleting")
 }
 
+// getOrphanedSubinstallationsToTrigger returns the orphaned subinstallations that can be deleted next, because their
+// exports are not imported by other orphaned subinstallations. If the orphaned subinstallations have cyclic dependencies,
+// all of them are returned.
+func getOrphanedSubinstallationsToTrigger(ctx context.Context, subInstsToDelete []*lsv1alpha1.Installation) []*lsv1alpha1.Installation {
+	logger, _ := logging.FromContextOrNew(ctx, nil)
+
+	order, err := dependencies.ComputeDeletionOrder(subInstsToDelete)
+	if err != nil {
+		logger.Info("deleting orphaned subinstallations in arbitrary order", lc.KeyError, err.Error())
+		return subInstsToDelete
+	}
+
+	next := sets.New[string](order[0]...)
+	result := []*lsv1alpha1.Installation{}
+	for _, subInst := range subInstsToDelete {
+		if next.Has(subInst.Name) {
+			result = append(result, subInst)
+		}
+	}
+	return result
+}
+
+// checkApproval checks whether the external approval systems have approved that a root installation enters the given phase.
+// An error is returned if the phase must not be entered yet. If the approval has been rejected or has timed out,
+// failed is true.
+func (c *Controller) checkApproval(ctx context.Context, inst *lsv1alpha1.Installation,
+	phase lsv1alpha1.InstallationPhase) (failed bool, lsErr lserrors.LsError) {
+
+	currentOperation := "checkApproval"
+
+	if !installations.IsRootInstallation(inst) {
+		return false, nil
+	}
+
+	state, msg, err := c.approvalGate.Check(ctx, inst, phase)
+	if err != nil {
+		return false, lserrors.NewWrappedError(err, currentOperation, "RequestApproval", err.Error())
+	}
+
+	switch state {
+	case lsv1alpha1.ApprovalStateApproved:
+		return false, nil
+	case lsv1alpha1.ApprovalStatePending:
+		return false, lserrors.NewError(currentOperation, lsv1alpha1.WaitingForApprovalReason, msg, lsv1alpha1.ErrorForInfoOnly)
+	default:
+		return true, lserrors.NewError(currentOperation, lsv1alpha1.ApprovalNotGrantedReason, msg)
+	}
+}
+
 func (c *Controller) handlePhaseObjectsCreated(ctx context.Context, inst *lsv1alpha1.Installation) lserrors.LsError {
 	currentOperation := "handlePhaseObjectsCreated"
 
@@ -406,6 +591,7 @@ func (c *Controller) handlePhaseObjectsCreated(ctx context.Context, inst *lsv1al
 	for _, next := range subInsts {
 		if next.Status.JobID != inst.Status.JobID {
 			next.Status.JobID = inst.Status.JobID
+			next.Status.RedeployAllDeployItems = inst.Status.RedeployAllDeployItems
 			next.Status.TransitionTimes = lsutil.NewTransitionTimes()
 			if err = c.WriterToLsUncachedClient().UpdateInstallationStatus(ctx, read_write_layer.W000083, next); err != nil {
 				return lserrors.NewWrappedError(err, currentOperation, "UpdateInstallationStatus", err.Error())
@@ -422,6 +608,7 @@ func (c *Controller) handlePhaseObjectsCreated(ctx context.Context, inst *lsv1al
 
 		if exec.Status.JobID != inst.Status.JobID {
 			exec.Status.JobID = inst.Status.JobID
+			exec.Status.RedeployAllDeployItems = inst.Status.RedeployAllDeployItems
 			exec.Status.TransitionTimes = lsutil.NewTransitionTimes()
 			if err := c.WriterToLsUncachedClient().UpdateExecutionStatus(ctx, read_write_layer.W000084, exec); err != nil {
 				return lserrors.NewWrappedError(err, currentOperation, "UpdateExecutionStatus", err.Error())
@@ -467,11 +654,50 @@ func (c *Controller) handlePhaseProgressing(ctx context.Context, inst *lsv1alpha
 		}
 
 		allSucceeded = allSucceeded && (exec.Status.ExecutionPhase == lsv1alpha1.ExecutionPhases.Succeeded)
+		if !allSucceeded {
+			return false, nil
+		}
+
+		return c.handleChainedExecutions(ctx, inst)
 	}
 
 	return allSucceeded, nil
 }
 
+// handleChainedExecutions triggers the chained executions of an installation one after another.
+// A chained execution is only triggered if its predecessor has succeeded.
+func (c *Controller) handleChainedExecutions(ctx context.Context, inst *lsv1alpha1.Installation) (allSucceeded bool, lsErr lserrors.LsError) {
+	currentOperation := "handleChainedExecutions"
+
+	for _, ref := range inst.Status.ChainedExecutionReferences {
+		exec := &lsv1alpha1.Execution{}
+		if err := read_write_layer.GetExecution(ctx, c.LsUncachedClient(), ref.NamespacedName(), exec, read_write_layer.R000151); err != nil {
+			return false, lserrors.NewWrappedError(err, currentOperation, "GetExecution", err.Error())
+		}
+
+		if exec.Status.JobID != inst.Status.JobID {
+			exec.Status.JobID = inst.Status.JobID
+			exec.Status.RedeployAllDeployItems = inst.Status.RedeployAllDeployItems
+			exec.Status.TransitionTimes = lsutil.NewTransitionTimes()
+			if err := c.WriterToLsUncachedClient().UpdateExecutionStatus(ctx, read_write_layer.W000170, exec); err != nil {
+				return false, lserrors.NewWrappedError(err, currentOperation, "UpdateExecutionStatus", err.Error())
+			}
+		}
+
+		if exec.Status.JobIDFinished != exec.Status.JobID {
+			message := fmt.Sprintf("chained execution %s / %s is not finished yet", exec.Namespace, exec.Name)
+			return false, lserrors.NewError(currentOperation, "JobIDFinished", message,
+				lsv1alpha1.ErrorUnfinished, lsv1alpha1.ErrorForInfoOnly, lsv1alpha1.ErrorNoRetry)
+		}
+
+		if exec.Status.ExecutionPhase != lsv1alpha1.ExecutionPhases.Succeeded {
+			return false, nil
+		}
+	}
+
+	return true, nil
+}
+
 func (c *Controller) handlePhaseCompleting(ctx context.Context, inst *lsv1alpha1.Installation) (lserrors.LsError, lserrors.LsError) {
 	logger, ctx := logging.FromContextOrNew(ctx, []interface{}{lc.KeyReconciledResource, client.ObjectKeyFromObject(inst).String()})
 	currentOperation := "handlePhaseCompleting"
@@ -503,7 +729,7 @@ func (c *Controller) handlePhaseCompleting(ctx context.Context, inst *lsv1alpha1
 	if err != nil {
 		return lserrors.NewWrappedError(err, currentOperation, "ConstructImportsForExports", err.Error()), nil
 	}
-	err = con.RenderImportExecutions()
+	err = con.RenderImportExecutions(ctx)
 	if err != nil {
 		return lserrors.NewWrappedError(err, currentOperation, "RenderImportExecutionsForExports", err.Error()), nil
 	}
@@ -520,6 +746,11 @@ func (c *Controller) handlePhaseCompleting(ctx context.Context, inst *lsv1alpha1
 		return lserrors.NewWrappedError(err, currentOperation, "CreateOrUpdateExports", err.Error()), nil
 	}
 
+	// the written sinks are recorded in the status, which is persisted by the caller also in case of an error
+	if err := exports.NewSinkWriter(c.LsUncachedClient()).Write(ctx, inst, dataExports); err != nil {
+		return lserrors.NewWrappedError(err, currentOperation, "WriteExportSinks", err.Error()), nil
+	}
+
 	return nil, nil
 }
 
@@ -533,7 +764,7 @@ func (c *Controller) CreateImportsAndSubobjects(ctx context.Context, op *install
 	if err := constructor.Construct(ctx, imps); err != nil {
 		return lserrors.NewWrappedError(err, currOp, "ConstructImports", err.Error())
 	}
-	if err := constructor.RenderImportExecutions(); err != nil {
+	if err := constructor.RenderImportExecutions(ctx); err != nil {
 		return lserrors.NewWrappedError(err, currOp, "RenderImportExecutions", err.Error())
 	}
 
@@ -557,7 +788,7 @@ func (c *Controller) CreateImportsAndSubobjects(ctx context.Context, op *install
 func (c *Controller) removeReconcileAnnotation(ctx context.Context, inst *lsv1alpha1.Installation) lserrors.LsError {
 	logger, ctx := logging.FromContextOrNew(ctx, []interface{}{lc.KeyReconciledResource, client.ObjectKeyFromObject(inst).String()})
 
-	if lsv1alpha1helper.HasOperation(inst.ObjectMeta, lsv1alpha1.ReconcileOperation) {
+	if hasReconcileOperation(inst) {
 		logger.Debug("remove reconcile annotation")
 		delete(inst.Annotations, lsv1alpha1.OperationAnnotation)
 		delete(inst.Annotations, lsv1alpha1.ReconcileReasonAnnotation)
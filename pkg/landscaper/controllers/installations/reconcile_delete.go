@@ -7,11 +7,14 @@ package installations
 import (
 	"context"
 	"errors"
+	"fmt"
+	"strings"
 
 	"github.com/gardener/landscaper/controller-utils/pkg/logging"
 
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
 
 	lsv1alpha1 "github.com/gardener/landscaper/apis/core/v1alpha1"
@@ -19,29 +22,39 @@ import (
 	lserrors "github.com/gardener/landscaper/apis/errors"
 	"github.com/gardener/landscaper/pkg/landscaper/installations"
 	"github.com/gardener/landscaper/pkg/landscaper/installations/executions"
+	"github.com/gardener/landscaper/pkg/landscaper/installations/exports"
+	"github.com/gardener/landscaper/pkg/landscaper/installations/imports"
 	lsutil "github.com/gardener/landscaper/pkg/utils"
+	"github.com/gardener/landscaper/pkg/utils/dependencies"
 	"github.com/gardener/landscaper/pkg/utils/read_write_layer"
 )
 
 var (
-	SiblingImportError = errors.New("a sibling still imports some of the exports")
-	SiblingDeleteError = errors.New("deletion of a sibling failed")
+	SiblingImportError                = errors.New("a sibling still imports some of the exports")
+	SiblingDeleteError                = errors.New("deletion of a sibling failed")
+	CyclicInstallationDependencyError = errors.New("the installation and its successors import each others exports")
 )
 
 func (c *Controller) handleDeletionPhaseInit(ctx context.Context, inst *lsv1alpha1.Installation) (fatalError lserrors.LsError, normalError lserrors.LsError) {
 	op := "handleDeletionPhaseInit"
 
+	// the exports are marked before the deletion is checked, so that the importing installations which block
+	// the deletion already know that their imports are outdated
+	if err := NewDataObjectAndTargetCleaner(inst, c.LsUncachedClient()).MarkExportsOutdated(ctx); err != nil {
+		return lserrors.NewWrappedError(err, op, "MarkExportsOutdated", err.Error()), nil
+	}
+
 	fatalError, normalError = c.deleteAllowed(ctx, inst)
 	if fatalError != nil || normalError != nil {
 		return fatalError, normalError
 	}
 
-	exec, err := executions.GetExecutionForInstallation(ctx, c.LsUncachedClient(), inst)
+	execs, err := executions.GetExecutionsForInstallation(ctx, c.LsUncachedClient(), inst)
 	if err != nil {
-		return lserrors.NewWrappedError(err, op, "GetExecutionForInstallation", err.Error()), nil
+		return lserrors.NewWrappedError(err, op, "GetExecutionsForInstallation", err.Error()), nil
 	}
 
-	if exec != nil {
+	for _, exec := range execs {
 		if lsv1alpha1helper.HasDeleteWithoutUninstallAnnotation(inst.ObjectMeta) &&
 			!lsv1alpha1helper.HasDeleteWithoutUninstallAnnotation(exec.ObjectMeta) {
 			metav1.SetMetaDataAnnotation(&exec.ObjectMeta, lsv1alpha1.DeleteWithoutUninstallAnnotation, "true")
@@ -53,7 +66,7 @@ func (c *Controller) handleDeletionPhaseInit(ctx context.Context, inst *lsv1alph
 			}
 		}
 
-		if exec.DeletionTimestamp.IsZero() {
+		if exec.DeletionTimestamp.IsZero() && !c.isDeletedByGarbageCollector(inst) {
 			if err = c.WriterToLsUncachedClient().DeleteExecution(ctx, read_write_layer.W000012, exec); err != nil {
 				if apierrors.IsConflict(err) {
 					return nil, lserrors.NewWrappedError(err, op, "DeleteExecutionConflict", err.Error())
@@ -80,7 +93,7 @@ func (c *Controller) handleDeletionPhaseInit(ctx context.Context, inst *lsv1alph
 			}
 		}
 
-		if subInst.DeletionTimestamp.IsZero() {
+		if subInst.DeletionTimestamp.IsZero() && !c.isDeletedByGarbageCollector(inst) {
 			if err = c.WriterToLsUncachedClient().DeleteInstallation(ctx, read_write_layer.W000091, subInst); err != nil {
 				if apierrors.IsConflict(err) {
 					return nil, lserrors.NewWrappedError(err, op, "DeleteInstallationConflict", err.Error())
@@ -95,12 +108,14 @@ func (c *Controller) handleDeletionPhaseInit(ctx context.Context, inst *lsv1alph
 
 func (c *Controller) handleDeletionPhaseTriggerDeleting(ctx context.Context, inst *lsv1alpha1.Installation) lserrors.LsError {
 	op := "handleDeletionPhaseTriggerDeleting"
-	exec, err := executions.GetExecutionForInstallation(ctx, c.LsUncachedClient(), inst)
+	execs, err := executions.GetExecutionsForInstallation(ctx, c.LsUncachedClient(), inst)
 	if err != nil {
-		return lserrors.NewWrappedError(err, op, "GetExecutionForInstallation", err.Error())
+		return lserrors.NewWrappedError(err, op, "GetExecutionsForInstallation", err.Error())
 	}
 
-	if exec != nil && exec.Status.JobID != inst.Status.JobID {
+	// chained executions are deleted in reverse order, so only the last one is triggered
+	if len(execs) > 0 && execs[len(execs)-1].Status.JobID != inst.Status.JobID {
+		exec := execs[len(execs)-1]
 		exec.Status.JobID = inst.Status.JobID
 		exec.Status.TransitionTimes = lsutil.NewTransitionTimes()
 		if err = c.WriterToLsUncachedClient().UpdateExecutionStatus(ctx, read_write_layer.W000093, exec); err != nil {
@@ -128,11 +143,10 @@ func (c *Controller) handleDeletionPhaseTriggerDeleting(ctx context.Context, ins
 
 func (c *Controller) handleDeletionPhaseDeleting(ctx context.Context, inst *lsv1alpha1.Installation) (allFinished bool, allDeleted bool, lsErr lserrors.LsError) {
 	op := "handleDeletionPhaseDeleting"
-	logger, ctx := logging.FromContextOrNew(ctx, nil)
 
-	exec, err := executions.GetExecutionForInstallation(ctx, c.LsUncachedClient(), inst)
+	execs, err := executions.GetExecutionsForInstallation(ctx, c.LsUncachedClient(), inst)
 	if err != nil {
-		return false, false, lserrors.NewWrappedError(err, op, "GetExecutionForInstallation", err.Error())
+		return false, false, lserrors.NewWrappedError(err, op, "GetExecutionsForInstallation", err.Error())
 	}
 
 	subInsts, err := installations.ListSubinstallations(ctx, c.LsUncachedClient(), inst, inst.Status.SubInstCache, read_write_layer.R000091)
@@ -140,41 +154,32 @@ func (c *Controller) handleDeletionPhaseDeleting(ctx context.Context, inst *lsv1
 		return false, false, lserrors.NewWrappedError(err, op, "ListSubinstallations", err.Error())
 	}
 
-	if exec == nil && len(subInsts) == 0 {
-		controllerutil.RemoveFinalizer(inst, lsv1alpha1.LandscaperFinalizer)
-		if err = c.WriterToLsUncachedClient().UpdateInstallation(ctx, read_write_layer.W000095, inst); err != nil {
-			return false, false, lserrors.NewWrappedError(err, op, "UpdateInstallation", err.Error())
-		}
-
-		if inst.Spec.Optimization == nil || !inst.Spec.Optimization.HasNoSiblingImports {
-			// touch siblings to speed up processing
-			// a potential improvement is to only touch siblings exporting data for the current installation but this would
-			// result in more complex coding and should only be done if the current approach results in performance problems
-			_, siblings, err := installations.GetParentAndSiblings(ctx, c.LsUncachedClient(), inst)
-			if err != nil {
-				return false, false, lserrors.NewWrappedError(err, op, "GetParentAndSiblings", err.Error())
-			}
-			for _, nextSibling := range siblings {
-				if !nextSibling.DeletionTimestamp.IsZero() {
-					lsv1alpha1helper.Touch(&nextSibling.ObjectMeta)
-					if err = c.WriterToLsUncachedClient().UpdateInstallation(ctx, read_write_layer.W000147, nextSibling); err != nil {
-						if apierrors.IsConflict(err) {
-							logger.Info(op + " - conflict touching sibling inst")
-						} else if apierrors.IsNotFound(err) {
-							logger.Info(op + " - not found touching sibling inst")
-						} else {
-							return false, false, lserrors.NewWrappedError(err, op, "TouchSibling", err.Error())
-						}
-					}
-				}
+	if len(execs) == 0 && len(subInsts) == 0 {
+		if !lsv1alpha1helper.HasDeleteWithoutUninstallAnnotation(inst.ObjectMeta) {
+			if err := exports.NewSinkWriter(c.LsUncachedClient()).Delete(ctx, inst); err != nil {
+				return false, false, lserrors.NewWrappedError(err, op, "DeleteExportSinks", err.Error())
 			}
 		}
 
+		if err := c.removeFinalizerAndTouchSiblings(ctx, inst); err != nil {
+			return false, false, err
+		}
 		return true, true, nil
 	}
 
 	// check if all finished
-	if exec != nil {
+	if len(execs) > 0 {
+		// the last remaining execution is triggered after its chained successors have been deleted
+		exec := execs[len(execs)-1]
+		if exec.Status.JobID != inst.Status.JobID {
+			exec.Status.JobID = inst.Status.JobID
+			exec.Status.TransitionTimes = lsutil.NewTransitionTimes()
+			if err = c.WriterToLsUncachedClient().UpdateExecutionStatus(ctx, read_write_layer.W000171, exec); err != nil {
+				return false, false, lserrors.NewWrappedError(err, op, "UpdateExecutionStatus", err.Error())
+			}
+			return false, false, nil
+		}
+
 		if exec.Status.JobIDFinished != inst.Status.JobID {
 			return false, false, nil
 		}
@@ -213,14 +218,42 @@ func (c *Controller) deleteAllowed(ctx context.Context, inst *lsv1alpha1.Install
 }
 
 // checkIfSiblingImports checks if a sibling imports any of the installations exports.
+// The result is reported in the condition "DeletionAllowed" of the installation.
 func checkIfSiblingImports(inst *lsv1alpha1.Installation, siblings []*installations.InstallationAndImports) (fatalError lserrors.LsError, normalError lserrors.LsError) {
+	op := "CheckIfSiblingImports"
+
+	siblingInsts := []*lsv1alpha1.Installation{}
+	siblingMap := map[string]*installations.InstallationAndImports{}
 	for _, sibling := range siblings {
-		if inst.IsSuccessor(sibling.GetInstallation()) {
-			return checkSuccessorSibling(inst, sibling)
+		siblingInsts = append(siblingInsts, sibling.GetInstallation())
+		siblingMap[sibling.GetInstallation().Name] = sibling
+	}
+
+	successors := dependencies.FetchSuccessorsFromInstallation(inst, siblingInsts).List()
+	if len(successors) == 0 {
+		setDeletionAllowedCondition(inst, lsv1alpha1.ConditionTrue, "NoSuccessors",
+			"no sibling imports an export of the installation")
+		return nil, nil
+	}
+
+	// the installations of a cycle would wait for each other forever
+	if cycle := dependencies.FindCycleOfInstallation(inst, siblingInsts); len(cycle) != 0 {
+		msg := fmt.Sprintf("%s: %s", CyclicInstallationDependencyError.Error(), strings.Join(cycle, " -{depends_on}-> "))
+		setDeletionAllowedCondition(inst, lsv1alpha1.ConditionFalse, lsv1alpha1.CyclicDependencyReason, msg)
+		return lserrors.NewWrappedError(CyclicInstallationDependencyError, op, lsv1alpha1.CyclicDependencyReason, msg,
+			lsv1alpha1.ErrorCyclicDependencies), nil
+	}
+
+	for _, name := range successors {
+		if fatalError := checkSuccessorSibling(inst, siblingMap[name]); fatalError != nil {
+			setDeletionAllowedCondition(inst, lsv1alpha1.ConditionFalse, "SiblingDeleteError", fatalError.Error())
+			return fatalError, nil
 		}
 	}
 
-	return nil, nil
+	msg := fmt.Sprintf("%s: %s", SiblingImportError.Error(), strings.Join(successors, ", "))
+	setDeletionAllowedCondition(inst, lsv1alpha1.ConditionFalse, "SiblingImport", msg)
+	return nil, lserrors.NewWrappedError(SiblingImportError, op, "SiblingImport", msg, lsv1alpha1.ErrorForInfoOnly)
 }
 
 // checkSuccessorSibling is called during the deletion of an installation (parameter "inst")
@@ -228,23 +261,71 @@ func checkIfSiblingImports(inst *lsv1alpha1.Installation, siblings []*installati
 //   - If the deletion of "sibling" has failed (with same jobID), the deletion of "inst" must also fail.
 //     This is achieved by a fatal error.
 //   - Otherwise, the existence of "sibling" means that "inst" cannot yet be deleted, but must be checked again later.
-//     This is achieved by a normal error.
-func checkSuccessorSibling(inst *lsv1alpha1.Installation,
-	sibling *installations.InstallationAndImports) (fatalError lserrors.LsError, normalError lserrors.LsError) {
-
+//     This is reported by the caller.
+func checkSuccessorSibling(inst *lsv1alpha1.Installation, sibling *installations.InstallationAndImports) (fatalError lserrors.LsError) {
 	op := "CheckSuccessorSibling"
 
 	if inst.Status.JobID == sibling.GetInstallation().Status.JobIDFinished &&
 		sibling.GetInstallation().Status.InstallationPhase == lsv1alpha1.InstallationPhases.DeleteFailed {
 
-		err := lserrors.NewWrappedError(SiblingDeleteError, op, "SiblingDeleteError",
-			SiblingDeleteError.Error(), lsv1alpha1.ErrorForInfoOnly)
-
-		return err, nil
+		msg := fmt.Sprintf("%s: %s", SiblingDeleteError.Error(), sibling.GetInstallation().Name)
+		return lserrors.NewWrappedError(SiblingDeleteError, op, "SiblingDeleteError", msg, lsv1alpha1.ErrorForInfoOnly)
 	}
 
-	err := lserrors.NewWrappedError(SiblingImportError, op, "SiblingImport", SiblingImportError.Error(),
-		lsv1alpha1.ErrorForInfoOnly)
+	return nil
+}
+
+func setDeletionAllowedCondition(inst *lsv1alpha1.Installation, status lsv1alpha1.ConditionStatus, reason, message string) {
+	inst.Status.Conditions = lsv1alpha1helper.CreateOrUpdateConditions(inst.Status.Conditions,
+		lsv1alpha1.DeletionAllowedCondition, status, reason, message)
+}
 
-	return nil, err
+// isDeletedByGarbageCollector returns true if the execution and the subinstallations of the installation are deleted
+// by the garbage collector instead of the installation. This is only the case if the owner reference garbage collection
+// is enabled and the subobjects are deleted without uninstall, so that the order of their deletion does not matter.
+func (c *Controller) isDeletedByGarbageCollector(inst *lsv1alpha1.Installation) bool {
+	return c.isOwnerReferenceGCEnabled() && lsv1alpha1helper.HasDeleteWithoutUninstallAnnotation(inst.ObjectMeta)
+}
+
+// handleDeletionPhaseGarbageCollection removes the finalizer of the installation without waiting for its execution
+// and subinstallations. They are deleted by the garbage collector and process their deletion on their own.
+func (c *Controller) handleDeletionPhaseGarbageCollection(ctx context.Context, inst *lsv1alpha1.Installation) lserrors.LsError {
+	return c.removeFinalizerAndTouchSiblings(ctx, inst)
+}
+
+func (c *Controller) removeFinalizerAndTouchSiblings(ctx context.Context, inst *lsv1alpha1.Installation) lserrors.LsError {
+	op := "removeFinalizerAndTouchSiblings"
+	logger, ctx := logging.FromContextOrNew(ctx, nil)
+
+	controllerutil.RemoveFinalizer(inst, lsv1alpha1.LandscaperFinalizer)
+	if err := c.WriterToLsUncachedClient().UpdateInstallation(ctx, read_write_layer.W000095, inst); err != nil {
+		return lserrors.NewWrappedError(err, op, "UpdateInstallation", err.Error())
+	}
+	imports.GetValidationCache().Remove(client.ObjectKeyFromObject(inst))
+
+	if inst.Spec.Optimization == nil || !inst.Spec.Optimization.HasNoSiblingImports {
+		// touch siblings to speed up processing
+		// a potential improvement is to only touch siblings exporting data for the current installation but this would
+		// result in more complex coding and should only be done if the current approach results in performance problems
+		_, siblings, err := installations.GetParentAndSiblings(ctx, c.LsUncachedClient(), inst)
+		if err != nil {
+			return lserrors.NewWrappedError(err, op, "GetParentAndSiblings", err.Error())
+		}
+		for _, nextSibling := range siblings {
+			if !nextSibling.DeletionTimestamp.IsZero() {
+				lsv1alpha1helper.Touch(&nextSibling.ObjectMeta)
+				if err = c.WriterToLsUncachedClient().UpdateInstallation(ctx, read_write_layer.W000147, nextSibling); err != nil {
+					if apierrors.IsConflict(err) {
+						logger.Info(op + " - conflict touching sibling inst")
+					} else if apierrors.IsNotFound(err) {
+						logger.Info(op + " - not found touching sibling inst")
+					} else {
+						return lserrors.NewWrappedError(err, op, "TouchSibling", err.Error())
+					}
+				}
+			}
+		}
+	}
+
+	return nil
 }
@@ -24,6 +24,7 @@ import (
 
 	"github.com/gardener/landscaper/apis/config"
 	lsv1alpha1 "github.com/gardener/landscaper/apis/core/v1alpha1"
+	lsv1alpha1helper "github.com/gardener/landscaper/apis/core/v1alpha1/helper"
 	kutil "github.com/gardener/landscaper/controller-utils/pkg/kubernetes"
 	"github.com/gardener/landscaper/controller-utils/pkg/logging"
 	"github.com/gardener/landscaper/pkg/api"
@@ -182,6 +183,13 @@ var _ = Describe("Delete", func() {
 				return nil
 			}, 20*time.Second, 1*time.Second).Should(Succeed(), "should error with a sibling import error")
 
+			Expect(testenv.Client.Get(ctx, kutil.ObjectKeyFromObject(inst), inst)).ToNot(HaveOccurred())
+			cond := lsv1alpha1helper.GetCondition(inst.Status.Conditions, lsv1alpha1.DeletionAllowedCondition)
+			Expect(cond).ToNot(BeNil())
+			Expect(cond.Status).To(Equal(lsv1alpha1.ConditionFalse))
+			Expect(cond.Reason).To(Equal("SiblingImport"))
+			Expect(cond.Message).To(HaveSuffix(": b"))
+
 			instC := &lsv1alpha1.Installation{}
 			Expect(testenv.Client.Get(ctx, client.ObjectKey{Name: "c", Namespace: state.Namespace}, instC)).ToNot(HaveOccurred())
 			Expect(instC.DeletionTimestamp.IsZero()).To(BeTrue())
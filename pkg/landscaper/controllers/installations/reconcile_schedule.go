@@ -0,0 +1,56 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package installations
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	lsv1alpha1 "github.com/gardener/landscaper/apis/core/v1alpha1"
+	lsv1alpha1helper "github.com/gardener/landscaper/apis/core/v1alpha1/helper"
+	"github.com/gardener/landscaper/controller-utils/pkg/logging"
+	"github.com/gardener/landscaper/pkg/utils/read_write_layer"
+)
+
+const outsideMaintenanceWindowReason = "OutsideMaintenanceWindow"
+
+// hasReconcileSchedule returns whether a new job of the installation is only started during its maintenance windows.
+// The deletion of an installation is not restricted to the maintenance windows.
+func hasReconcileSchedule(inst *lsv1alpha1.Installation) bool {
+	return inst.Spec.ReconcileSchedule != nil && inst.DeletionTimestamp.IsZero()
+}
+
+// waitForMaintenanceWindow checks whether a maintenance window of the installation is active.
+// If not, the PendingWindow condition is set and the duration until the begin of the next window is returned.
+func (c *Controller) waitForMaintenanceWindow(ctx context.Context, inst *lsv1alpha1.Installation) (time.Duration, bool, error) {
+	logger, ctx := logging.FromContextOrNew(ctx, nil)
+
+	now := c.clock.Now()
+	begin, active, err := lsv1alpha1helper.MaintenanceWindow(*inst.Spec.ReconcileSchedule, now)
+	if err != nil {
+		return 0, false, fmt.Errorf("invalid reconcile schedule: %w", err)
+	}
+	if active {
+		return 0, false, nil
+	}
+
+	logger.Info("postponing reconcile until the next maintenance window", "begin", begin)
+	msg := pendingWindowMessage(begin)
+	cond := lsv1alpha1helper.GetCondition(inst.Status.Conditions, lsv1alpha1.PendingWindowCondition)
+	if cond == nil || cond.Status != lsv1alpha1.ConditionTrue || cond.Message != msg {
+		inst.Status.Conditions = lsv1alpha1helper.CreateOrUpdateConditions(inst.Status.Conditions,
+			lsv1alpha1.PendingWindowCondition, lsv1alpha1.ConditionTrue, outsideMaintenanceWindowReason, msg)
+		if err := c.WriterToLsUncachedClient().UpdateInstallationStatus(ctx, read_write_layer.W000180, inst); err != nil {
+			return 0, false, err
+		}
+	}
+	return begin.Sub(now), true, nil
+}
+
+func pendingWindowMessage(begin time.Time) string {
+	return fmt.Sprintf("the reconciliation is pending until the next maintenance window begins at %s",
+		begin.UTC().Format(time.RFC3339))
+}
@@ -0,0 +1,165 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package installations
+
+import (
+	"context"
+
+	"github.com/go-logr/logr"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/manager"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	lsv1alpha1 "github.com/gardener/landscaper/apis/core/v1alpha1"
+	lsv1alpha1helper "github.com/gardener/landscaper/apis/core/v1alpha1/helper"
+	"github.com/gardener/landscaper/controller-utils/pkg/logging"
+	lc "github.com/gardener/landscaper/controller-utils/pkg/logging/constants"
+	"github.com/gardener/landscaper/pkg/landscaper/installations"
+	"github.com/gardener/landscaper/pkg/utils"
+	"github.com/gardener/landscaper/pkg/utils/read_write_layer"
+)
+
+const (
+	// referencedSecretsIndex is the name of the index of the installations by the secrets they import data from.
+	referencedSecretsIndex = "spec.imports.data.secretRef.name"
+	// referencedConfigMapsIndex is the name of the index of the installations by the configmaps they import data from.
+	referencedConfigMapsIndex = "spec.imports.data.configMapRef.name"
+)
+
+// GetReferencedSecrets returns the names of the secrets from which an installation imports data.
+// The secrets are located in the namespace of the installation.
+func GetReferencedSecrets(inst *lsv1alpha1.Installation) []string {
+	names := sets.New[string]()
+	for _, dataImport := range inst.Spec.Imports.Data {
+		if dataImport.SecretRef != nil && len(dataImport.SecretRef.Name) != 0 {
+			names.Insert(dataImport.SecretRef.Name)
+		}
+		if dataImport.HTTPRef != nil && dataImport.HTTPRef.AuthSecretRef != nil && len(dataImport.HTTPRef.AuthSecretRef.Name) != 0 {
+			names.Insert(dataImport.HTTPRef.AuthSecretRef.Name)
+		}
+	}
+	return sets.List(names)
+}
+
+// GetReferencedConfigMaps returns the names of the configmaps from which an installation imports data.
+// The configmaps are located in the namespace of the installation.
+func GetReferencedConfigMaps(inst *lsv1alpha1.Installation) []string {
+	names := sets.New[string]()
+	for _, dataImport := range inst.Spec.Imports.Data {
+		if dataImport.ConfigMapRef != nil && len(dataImport.ConfigMapRef.Name) != 0 {
+			names.Insert(dataImport.ConfigMapRef.Name)
+		}
+	}
+	return sets.List(names)
+}
+
+// addReferencedDataControllerToManager registers a controller that triggers the reconciliation of root installations
+// whenever a Secret or ConfigMap changes from which they import data.
+// The installations are found via indices on their referenced secrets and configmaps.
+func addReferencedDataControllerToManager(ctx context.Context, lsUncachedClient, lsCachedClient client.Client,
+	logger logging.Logger, lsMgr manager.Manager) error {
+
+	log := logger.WithName("referencedData")
+
+	indexer := lsMgr.GetFieldIndexer()
+	if err := indexer.IndexField(ctx, &lsv1alpha1.Installation{}, referencedSecretsIndex, func(obj client.Object) []string {
+		return GetReferencedSecrets(obj.(*lsv1alpha1.Installation))
+	}); err != nil {
+		return err
+	}
+	if err := indexer.IndexField(ctx, &lsv1alpha1.Installation{}, referencedConfigMapsIndex, func(obj client.Object) []string {
+		return GetReferencedConfigMaps(obj.(*lsv1alpha1.Installation))
+	}); err != nil {
+		return err
+	}
+
+	// only modifications are of interest; the creation events of the initial list would trigger all installations.
+	onlyUpdates := builder.WithPredicates(predicate.Funcs{
+		CreateFunc:  func(event.CreateEvent) bool { return false },
+		DeleteFunc:  func(event.DeleteEvent) bool { return false },
+		GenericFunc: func(event.GenericEvent) bool { return false },
+		UpdateFunc: func(e event.UpdateEvent) bool {
+			return e.ObjectOld.GetResourceVersion() != e.ObjectNew.GetResourceVersion()
+		},
+	})
+
+	return builder.ControllerManagedBy(lsMgr).
+		Named("installation-referenced-data").
+		WatchesMetadata(&corev1.Secret{}, handler.EnqueueRequestsFromMapFunc(
+			mapReferencingInstallations(lsCachedClient, log, referencedSecretsIndex)), onlyUpdates).
+		WatchesMetadata(&corev1.ConfigMap{}, handler.EnqueueRequestsFromMapFunc(
+			mapReferencingInstallations(lsCachedClient, log, referencedConfigMapsIndex)), onlyUpdates).
+		WithLogConstructor(func(r *reconcile.Request) logr.Logger { return log.Logr() }).
+		Complete(&referencedDataController{
+			lsUncachedClient: lsUncachedClient,
+			log:              log,
+		})
+}
+
+// mapReferencingInstallations returns a map function that returns the root installations
+// that reference the given object according to the given index.
+func mapReferencingInstallations(lsCachedClient client.Client, log logging.Logger, index string) handler.MapFunc {
+	return func(ctx context.Context, obj client.Object) []reconcile.Request {
+		instList := &lsv1alpha1.InstallationList{}
+		if err := lsCachedClient.List(ctx, instList, client.InNamespace(obj.GetNamespace()),
+			client.MatchingFields{index: obj.GetName()}); err != nil {
+			log.Error(err, "unable to list installations referencing changed object",
+				lc.KeyResource, types.NamespacedName{Namespace: obj.GetNamespace(), Name: obj.GetName()}.String())
+			return nil
+		}
+
+		requests := make([]reconcile.Request, 0, len(instList.Items))
+		for i := range instList.Items {
+			inst := &instList.Items[i]
+			if installations.IsRootInstallation(inst) {
+				requests = append(requests, reconcile.Request{NamespacedName: client.ObjectKeyFromObject(inst)})
+			}
+		}
+		return requests
+	}
+}
+
+// referencedDataController triggers the reconciliation of root installations by adding the reconcile annotation.
+type referencedDataController struct {
+	lsUncachedClient client.Client
+	log              logging.Logger
+}
+
+func (c *referencedDataController) Reconcile(ctx context.Context, req reconcile.Request) (result reconcile.Result, err error) {
+	logger, ctx := c.log.StartReconcileAndAddToContext(ctx, req)
+
+	result = reconcile.Result{}
+	defer utils.HandlePanics(ctx, &result, nil)
+
+	inst := &lsv1alpha1.Installation{}
+	if err := read_write_layer.GetInstallation(ctx, c.lsUncachedClient, req.NamespacedName, inst, read_write_layer.R000116); err != nil {
+		if apierrors.IsNotFound(err) {
+			logger.Debug(err.Error())
+			return reconcile.Result{}, nil
+		}
+		return reconcile.Result{}, err
+	}
+
+	if !inst.DeletionTimestamp.IsZero() || hasReconcileOperation(inst) {
+		return reconcile.Result{}, nil
+	}
+
+	logger.Info("triggering reconcile of installation because imported data has changed")
+	lsv1alpha1helper.SetOperation(&inst.ObjectMeta, lsv1alpha1.ReconcileOperation)
+	metav1.SetMetaDataAnnotation(&inst.ObjectMeta, lsv1alpha1.ReconcileReasonAnnotation, reconcileReasonImportedDataChange)
+	if err := read_write_layer.NewWriter(c.lsUncachedClient).UpdateInstallation(ctx, read_write_layer.W000154, inst); err != nil {
+		return reconcile.Result{}, err
+	}
+	return reconcile.Result{}, nil
+}
@@ -0,0 +1,39 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package installations_test
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/gardener/landscaper/apis/core/v1alpha1"
+	installationsctl "github.com/gardener/landscaper/pkg/landscaper/controllers/installations"
+)
+
+var _ = Describe("Referenced Data", func() {
+
+	It("should return the secrets and configmaps an installation imports data from", func() {
+		inst := &v1alpha1.Installation{}
+		inst.Spec.Imports.Data = []v1alpha1.DataImport{
+			{Name: "a", SecretRef: &v1alpha1.LocalSecretReference{Name: "my-secret", Key: "a"}},
+			{Name: "b", SecretRef: &v1alpha1.LocalSecretReference{Name: "my-secret", Key: "b"}},
+			{Name: "c", ConfigMapRef: &v1alpha1.LocalConfigMapReference{Name: "my-configmap", Key: "c"}},
+			{Name: "d", HTTPRef: &v1alpha1.HTTPDataReference{
+				URL:           "https://example.com/outputs",
+				AuthSecretRef: &v1alpha1.LocalSecretReference{Name: "my-token", Key: "token"},
+			}},
+			{Name: "e", DataRef: "my-dataobject"},
+		}
+
+		Expect(installationsctl.GetReferencedSecrets(inst)).To(Equal([]string{"my-secret", "my-token"}))
+		Expect(installationsctl.GetReferencedConfigMaps(inst)).To(Equal([]string{"my-configmap"}))
+	})
+
+	It("should return no references for an installation without secret or configmap imports", func() {
+		inst := &v1alpha1.Installation{}
+		Expect(installationsctl.GetReferencedSecrets(inst)).To(BeEmpty())
+		Expect(installationsctl.GetReferencedConfigMaps(inst)).To(BeEmpty())
+	})
+})
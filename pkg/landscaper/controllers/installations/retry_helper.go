@@ -18,12 +18,19 @@ import (
 
 	lsv1alpha1 "github.com/gardener/landscaper/apis/core/v1alpha1"
 	lsv1alpha1helper "github.com/gardener/landscaper/apis/core/v1alpha1/helper"
+	lserrors "github.com/gardener/landscaper/apis/errors"
 	"github.com/gardener/landscaper/controller-utils/pkg/logging"
 	"github.com/gardener/landscaper/pkg/utils/read_write_layer"
 )
 
+// reasons of the reconcile operations that are set by the landscaper itself. A reconcile operation with a reason
+// only redeploys the deploy items that have changed, whereas an explicit reconcile operation redeploys all of them.
 const (
-	reconcileReasonRetry = "retry"
+	reconcileReasonRetry              = "retry"
+	reconcileReasonSpecChanged        = "spec-changed"
+	reconcileReasonImportedDataChange = "imported-data-changed"
+	reconcileReasonRefreshBlueprint   = "refresh-blueprint"
+	reconcileReasonResumeImports      = "resume-imports"
 )
 
 var (
@@ -47,7 +54,7 @@ func newRetryHelper(cl client.Client, passiveClock clock.PassiveClock) *retryHel
 
 func (r *retryHelper) preProcessRetry(ctx context.Context, inst *lsv1alpha1.Installation) error {
 
-	if lsv1alpha1helper.HasOperation(inst.ObjectMeta, lsv1alpha1.ReconcileOperation) &&
+	if hasReconcileOperation(inst) &&
 		!r.hasReconcileReasonRetry(inst.ObjectMeta) {
 		// reconcile was not triggered by the retry mechanism, therefore we reset the retry status
 		if err := r.resetRetryStatus(ctx, inst, read_write_layer.W000051); err != nil {
@@ -112,6 +119,21 @@ func (r *retryHelper) isRetryActivatedForFailed(inst *lsv1alpha1.Installation) b
 func (r *retryHelper) recomputeRetryForFailed(ctx context.Context, inst *lsv1alpha1.Installation, oldResult reconcile.Result, oldError error) (reconcile.Result, error) {
 	retryStatus := inst.Status.AutomaticReconcileStatus
 
+	// no retries for installations that are quarantined because of malformed import data,
+	// but check regularly whether the import source has changed
+	if isImportQuarantined(inst) {
+		changed, err := isImportSourceChanged(ctx, r.cl, inst.Status.ImportQuarantine, read_write_layer.R000156)
+		if err != nil {
+			return reconcile.Result{}, err
+		}
+		if !changed {
+			return reconcile.Result{
+				Requeue:      true,
+				RequeueAfter: r.getRetryIntervalForFailed(inst),
+			}, nil
+		}
+	}
+
 	// first failure, or installation changed
 	if retryStatus == nil {
 		if err := r.addReconcileAnnotation(ctx, inst); err != nil {
@@ -243,6 +265,30 @@ func (r *retryHelper) maxNumberOfRetriesDoneForFailed(inst *lsv1alpha1.Installat
 		*inst.Spec.AutomaticReconcile.FailedReconcile.NumberOfReconciles <= alreadyExecutedRetries
 }
 
+// IsPermanentlyFailed returns true if a failed installation is not retried automatically anymore,
+// because its last error is unrecoverable, or because retries are not activated or already exhausted.
+func IsPermanentlyFailed(inst *lsv1alpha1.Installation) bool {
+	return newRetryHelper(nil, nil).isPermanentlyFailed(inst)
+}
+
+func (r *retryHelper) isPermanentlyFailed(inst *lsv1alpha1.Installation) bool {
+	if inst.Status.LastError != nil && lserrors.ContainsAnyErrorCode(inst.Status.LastError.Codes, lsv1alpha1.UnrecoverableErrorCodes) {
+		return true
+	}
+	if !r.isRetryActivatedForFailed(inst) {
+		return true
+	}
+
+	// the retry status is reset if it belongs to another generation or to the retries of a succeeded installation
+	retryStatus := inst.Status.AutomaticReconcileStatus
+	alreadyExecutedRetries := 0
+	if retryStatus != nil && retryStatus.OnFailed && retryStatus.Generation == inst.GetGeneration() {
+		alreadyExecutedRetries = retryStatus.NumberOfReconciles
+	}
+	return inst.Spec.AutomaticReconcile.FailedReconcile.NumberOfReconciles != nil &&
+		*inst.Spec.AutomaticReconcile.FailedReconcile.NumberOfReconciles <= alreadyExecutedRetries
+}
+
 func (r *retryHelper) isNextRetryDueForFailed(ctx context.Context, inst *lsv1alpha1.Installation) bool {
 	return r.now().After(r.getNextRetryTimeForFailed(ctx, inst))
 }
@@ -12,6 +12,7 @@ import (
 	. "github.com/onsi/gomega"
 	"k8s.io/client-go/tools/record"
 	"k8s.io/utils/clock/testing"
+	"k8s.io/utils/ptr"
 	"sigs.k8s.io/controller-runtime/pkg/reconcile"
 
 	"github.com/gardener/landscaper/apis/config"
@@ -419,4 +420,46 @@ var _ = Describe("Retry handler", func() {
 			Expect(inst.Status.AutomaticReconcileStatus.LastReconcileTime.Time.UnixMilli()).To(Equal(t6.UnixMilli()))
 		})
 	})
+
+	Context("IsPermanentlyFailed", func() {
+
+		newFailedInstallation := func(numberOfReconciles *int, executedRetries int) *v1alpha1.Installation {
+			inst := &v1alpha1.Installation{}
+			inst.Generation = 2
+			inst.Status.InstallationPhase = v1alpha1.InstallationPhases.Failed
+			inst.Spec.AutomaticReconcile = &v1alpha1.AutomaticReconcile{
+				FailedReconcile: &v1alpha1.FailedReconcile{NumberOfReconciles: numberOfReconciles},
+			}
+			inst.Status.AutomaticReconcileStatus = &v1alpha1.AutomaticReconcileStatus{
+				Generation:         2,
+				NumberOfReconciles: executedRetries,
+				OnFailed:           true,
+			}
+			return inst
+		}
+
+		It("should consider installations without retries as permanently failed", func() {
+			inst := &v1alpha1.Installation{}
+			inst.Status.InstallationPhase = v1alpha1.InstallationPhases.Failed
+			Expect(installationsctl.IsPermanentlyFailed(inst)).To(BeTrue())
+		})
+
+		It("should consider installations as permanently failed only if their retries are exhausted", func() {
+			Expect(installationsctl.IsPermanentlyFailed(newFailedInstallation(ptr.To(2), 1))).To(BeFalse())
+			Expect(installationsctl.IsPermanentlyFailed(newFailedInstallation(ptr.To(2), 2))).To(BeTrue())
+			Expect(installationsctl.IsPermanentlyFailed(newFailedInstallation(nil, 100))).To(BeFalse())
+		})
+
+		It("should ignore the retries of another generation", func() {
+			inst := newFailedInstallation(ptr.To(2), 2)
+			inst.Generation = 3
+			Expect(installationsctl.IsPermanentlyFailed(inst)).To(BeFalse())
+		})
+
+		It("should consider installations with an unrecoverable error as permanently failed", func() {
+			inst := newFailedInstallation(nil, 0)
+			inst.Status.LastError = &v1alpha1.Error{Codes: []v1alpha1.ErrorCode{v1alpha1.ErrorConfigurationProblem}}
+			Expect(installationsctl.IsPermanentlyFailed(inst)).To(BeTrue())
+		})
+	})
 })
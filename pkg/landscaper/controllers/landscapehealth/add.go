@@ -0,0 +1,52 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package landscapehealth
+
+import (
+	"maps"
+
+	"github.com/go-logr/logr"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/manager"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	lsv1alpha1 "github.com/gardener/landscaper/apis/core/v1alpha1"
+	"github.com/gardener/landscaper/controller-utils/pkg/logging"
+)
+
+// AddControllerToManager adds the landscape health controller to the manager.
+// The controller summarizes the health of the installations selected by the landscape healths.
+func AddControllerToManager(lsUncachedClient, lsCachedClient client.Client, logger logging.Logger, lsMgr manager.Manager) error {
+	log := logger.Reconciles("landscapeHealth", "LandscapeHealth")
+	ctrl := NewController(lsUncachedClient, lsCachedClient, log)
+
+	// only changes of the phase or the labels of an installation can change the summary.
+	installationPredicates := builder.WithPredicates(predicate.Funcs{
+		UpdateFunc: func(e event.UpdateEvent) bool {
+			oldInst, ok := e.ObjectOld.(*lsv1alpha1.Installation)
+			if !ok {
+				return true
+			}
+			newInst, ok := e.ObjectNew.(*lsv1alpha1.Installation)
+			if !ok {
+				return true
+			}
+			return oldInst.Status.InstallationPhase != newInst.Status.InstallationPhase ||
+				!oldInst.Status.PhaseTransitionTime.Equal(newInst.Status.PhaseTransitionTime) ||
+				!maps.Equal(oldInst.Labels, newInst.Labels)
+		},
+	})
+
+	return builder.ControllerManagedBy(lsMgr).
+		For(&lsv1alpha1.LandscapeHealth{}, builder.WithPredicates(predicate.GenerationChangedPredicate{})).
+		Watches(&lsv1alpha1.Installation{}, handler.EnqueueRequestsFromMapFunc(ctrl.mapInstallationToLandscapeHealths),
+			installationPredicates).
+		WithLogConstructor(func(r *reconcile.Request) logr.Logger { return log.Logr() }).
+		Complete(ctrl)
+}
@@ -0,0 +1,209 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package landscapehealth
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	apiequality "k8s.io/apimachinery/pkg/api/equality"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	lsv1alpha1 "github.com/gardener/landscaper/apis/core/v1alpha1"
+	"github.com/gardener/landscaper/controller-utils/pkg/logging"
+	lc "github.com/gardener/landscaper/controller-utils/pkg/logging/constants"
+	"github.com/gardener/landscaper/pkg/landscaper/installations"
+	"github.com/gardener/landscaper/pkg/utils"
+	"github.com/gardener/landscaper/pkg/utils/read_write_layer"
+)
+
+// Controller is the landscape health controller.
+type Controller struct {
+	lsUncachedClient client.Client
+	lsCachedClient   client.Client
+	log              logging.Logger
+	now              func() time.Time
+}
+
+// NewController returns a new landscape health controller.
+func NewController(lsUncachedClient, lsCachedClient client.Client, logger logging.Logger) *Controller {
+	return &Controller{
+		lsUncachedClient: lsUncachedClient,
+		lsCachedClient:   lsCachedClient,
+		log:              logger,
+		now:              time.Now,
+	}
+}
+
+// Reconcile reconciles requests for landscape healths.
+func (c *Controller) Reconcile(ctx context.Context, req reconcile.Request) (result reconcile.Result, err error) {
+	_, ctx = c.log.StartReconcileAndAddToContext(ctx, req)
+
+	result = reconcile.Result{}
+	defer utils.HandlePanics(ctx, &result, nil)
+
+	return c.reconcile(ctx, req)
+}
+
+func (c *Controller) reconcile(ctx context.Context, req reconcile.Request) (reconcile.Result, error) {
+	logger, ctx := logging.FromContextOrNew(ctx, nil)
+
+	health := &lsv1alpha1.LandscapeHealth{}
+	if err := read_write_layer.GetObject(ctx, c.lsUncachedClient, req.NamespacedName, health, read_write_layer.R000167); err != nil {
+		if apierrors.IsNotFound(err) {
+			logger.Info(err.Error())
+			return reconcile.Result{}, nil
+		}
+		return reconcile.Result{}, err
+	}
+
+	if !health.DeletionTimestamp.IsZero() {
+		return reconcile.Result{}, nil
+	}
+
+	selector := labels.Everything()
+	if health.Spec.Selector != nil {
+		var err error
+		selector, err = metav1.LabelSelectorAsSelector(health.Spec.Selector)
+		if err != nil {
+			logger.Error(err, "invalid installation selector")
+			return reconcile.Result{}, nil
+		}
+	}
+
+	instList := &lsv1alpha1.InstallationList{}
+	if err := read_write_layer.ListInstallations(ctx, c.lsCachedClient, instList, read_write_layer.R000138,
+		client.InNamespace(health.Namespace), client.MatchingLabelsSelector{Selector: selector}); err != nil {
+		return reconcile.Result{}, fmt.Errorf("unable to list installations: %w", err)
+	}
+
+	status := Summarize(instList.Items, health.Spec.RootInstallationsOnly, health.Status.LastSuccessfulRun)
+	status.ObservedGeneration = health.Generation
+	status.LastUpdateTime = health.Status.LastUpdateTime
+	if apiequality.Semantic.DeepEqual(status, health.Status) {
+		return reconcile.Result{}, nil
+	}
+
+	now := metav1.NewTime(c.now())
+	status.LastUpdateTime = &now
+	health.Status = status
+	if err := read_write_layer.NewWriter(c.lsUncachedClient).UpdateLandscapeHealthStatus(ctx, read_write_layer.W000186, health); err != nil {
+		logger.Error(err, "updating status of landscape health failed")
+		return reconcile.Result{Requeue: true}, nil
+	}
+	return reconcile.Result{}, nil
+}
+
+// mapInstallationToLandscapeHealths returns the landscape healths in the namespace of a changed installation.
+func (c *Controller) mapInstallationToLandscapeHealths(ctx context.Context, obj client.Object) []reconcile.Request {
+	healthList := &lsv1alpha1.LandscapeHealthList{}
+	if err := read_write_layer.ListLandscapeHealths(ctx, c.lsCachedClient, healthList, read_write_layer.R000139,
+		client.InNamespace(obj.GetNamespace())); err != nil {
+		c.log.Error(err, "unable to list landscape healths for changed installation",
+			lc.KeyResource, client.ObjectKeyFromObject(obj).String())
+		return nil
+	}
+
+	requests := make([]reconcile.Request, 0, len(healthList.Items))
+	for i := range healthList.Items {
+		requests = append(requests, reconcile.Request{NamespacedName: client.ObjectKeyFromObject(&healthList.Items[i])})
+	}
+	return requests
+}
+
+// Summarize computes the health of the given installations.
+// The previous last successful run is kept if none of the installations is currently succeeded.
+func Summarize(instList []lsv1alpha1.Installation, rootInstallationsOnly bool,
+	previousSuccessfulRun *lsv1alpha1.InstallationHealthRecord) lsv1alpha1.LandscapeHealthStatus {
+
+	status := lsv1alpha1.LandscapeHealthStatus{}
+	phaseCounts := map[lsv1alpha1.InstallationPhase]int32{}
+	progressing := false
+
+	for i := range instList {
+		inst := &instList[i]
+		if rootInstallationsOnly && !installations.IsRootInstallation(inst) {
+			continue
+		}
+
+		status.Installations++
+		phase := inst.Status.InstallationPhase
+		if !phase.IsEmpty() {
+			phaseCounts[phase]++
+		}
+
+		switch {
+		case phase.IsFailed():
+			status.FailedInstallations++
+			record := newRecord(inst)
+			if status.OldestFailure == nil || isBefore(record.Time, status.OldestFailure.Time) {
+				status.OldestFailure = record
+			}
+		case phase == lsv1alpha1.InstallationPhases.Succeeded:
+			record := newRecord(inst)
+			if status.LastSuccessfulRun == nil || isBefore(status.LastSuccessfulRun.Time, record.Time) {
+				status.LastSuccessfulRun = record
+			}
+		default:
+			progressing = true
+		}
+	}
+
+	for phase, count := range phaseCounts {
+		status.PhaseCounts = append(status.PhaseCounts, lsv1alpha1.InstallationPhaseCount{Phase: phase, Count: count})
+	}
+	sort.Slice(status.PhaseCounts, func(i, j int) bool {
+		return status.PhaseCounts[i].Phase < status.PhaseCounts[j].Phase
+	})
+
+	if status.LastSuccessfulRun == nil && previousSuccessfulRun != nil {
+		status.LastSuccessfulRun = previousSuccessfulRun.DeepCopy()
+	}
+
+	switch {
+	case status.FailedInstallations > 0:
+		status.Health = lsv1alpha1.LandscapeHealthStateUnhealthy
+	case progressing:
+		status.Health = lsv1alpha1.LandscapeHealthStateProgressing
+	default:
+		status.Health = lsv1alpha1.LandscapeHealthStateHealthy
+	}
+	return status
+}
+
+func newRecord(inst *lsv1alpha1.Installation) *lsv1alpha1.InstallationHealthRecord {
+	record := &lsv1alpha1.InstallationHealthRecord{
+		Name:  inst.Name,
+		Phase: inst.Status.InstallationPhase,
+		Time:  inst.Status.PhaseTransitionTime,
+	}
+	if record.Time == nil && inst.Status.TransitionTimes != nil {
+		record.Time = inst.Status.TransitionTimes.FinishedTime
+	}
+	if record.Time != nil {
+		record.Time = record.Time.DeepCopy()
+	}
+	if inst.Status.InstallationPhase.IsFailed() && inst.Status.LastError != nil {
+		record.Message = inst.Status.LastError.Message
+	}
+	return record
+}
+
+// isBefore compares two times of which each can be unknown. An unknown time is treated as the oldest time.
+func isBefore(a, b *metav1.Time) bool {
+	if a == nil {
+		return b != nil
+	}
+	if b == nil {
+		return false
+	}
+	return a.Before(b)
+}
@@ -0,0 +1,105 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package landscapehealth_test
+
+import (
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	lsv1alpha1 "github.com/gardener/landscaper/apis/core/v1alpha1"
+	"github.com/gardener/landscaper/pkg/landscaper/controllers/landscapehealth"
+)
+
+var _ = Describe("LandscapeHealth Controller", func() {
+
+	var now time.Time
+
+	BeforeEach(func() {
+		now = time.Date(2024, 5, 1, 10, 0, 0, 0, time.UTC)
+	})
+
+	newInstallation := func(name string, phase lsv1alpha1.InstallationPhase, age time.Duration) lsv1alpha1.Installation {
+		inst := lsv1alpha1.Installation{}
+		inst.Name = name
+		inst.Status.InstallationPhase = phase
+		inst.Status.PhaseTransitionTime = &metav1.Time{Time: now.Add(-age)}
+		return inst
+	}
+
+	It("should count the installations per phase and report the oldest failure and the last successful run", func() {
+		failed := newInstallation("b", lsv1alpha1.InstallationPhases.Failed, time.Hour)
+		failed.Status.LastError = &lsv1alpha1.Error{Message: "apply failed"}
+		instList := []lsv1alpha1.Installation{
+			newInstallation("a", lsv1alpha1.InstallationPhases.Succeeded, 2*time.Hour),
+			failed,
+			newInstallation("c", lsv1alpha1.InstallationPhases.DeleteFailed, time.Minute),
+			newInstallation("d", lsv1alpha1.InstallationPhases.Succeeded, time.Minute),
+			newInstallation("e", lsv1alpha1.InstallationPhases.Progressing, time.Minute),
+		}
+
+		status := landscapehealth.Summarize(instList, false, nil)
+		Expect(status.Health).To(Equal(lsv1alpha1.LandscapeHealthStateUnhealthy))
+		Expect(status.Installations).To(Equal(int32(5)))
+		Expect(status.FailedInstallations).To(Equal(int32(2)))
+		Expect(status.PhaseCounts).To(Equal([]lsv1alpha1.InstallationPhaseCount{
+			{Phase: lsv1alpha1.InstallationPhases.DeleteFailed, Count: 1},
+			{Phase: lsv1alpha1.InstallationPhases.Failed, Count: 1},
+			{Phase: lsv1alpha1.InstallationPhases.Progressing, Count: 1},
+			{Phase: lsv1alpha1.InstallationPhases.Succeeded, Count: 2},
+		}))
+		Expect(status.OldestFailure.Name).To(Equal("b"))
+		Expect(status.OldestFailure.Message).To(Equal("apply failed"))
+		Expect(status.LastSuccessfulRun.Name).To(Equal("d"))
+	})
+
+	It("should summarize the health of the installations", func() {
+		status := landscapehealth.Summarize(nil, false, nil)
+		Expect(status.Health).To(Equal(lsv1alpha1.LandscapeHealthStateHealthy))
+
+		status = landscapehealth.Summarize([]lsv1alpha1.Installation{
+			newInstallation("a", lsv1alpha1.InstallationPhases.Succeeded, time.Hour),
+		}, false, nil)
+		Expect(status.Health).To(Equal(lsv1alpha1.LandscapeHealthStateHealthy))
+
+		status = landscapehealth.Summarize([]lsv1alpha1.Installation{
+			newInstallation("a", lsv1alpha1.InstallationPhases.Succeeded, time.Hour),
+			newInstallation("b", "", time.Hour),
+		}, false, nil)
+		Expect(status.Health).To(Equal(lsv1alpha1.LandscapeHealthStateProgressing))
+		Expect(status.Installations).To(Equal(int32(2)))
+		Expect(status.PhaseCounts).To(HaveLen(1))
+	})
+
+	It("should keep the previous successful run if no installation is currently succeeded", func() {
+		previous := &lsv1alpha1.InstallationHealthRecord{Name: "a", Phase: lsv1alpha1.InstallationPhases.Succeeded}
+		status := landscapehealth.Summarize([]lsv1alpha1.Installation{
+			newInstallation("a", lsv1alpha1.InstallationPhases.Progressing, time.Minute),
+		}, false, previous)
+		Expect(status.LastSuccessfulRun).To(Equal(previous))
+	})
+
+	It("should only summarize root installations if configured", func() {
+		sub := newInstallation("sub", lsv1alpha1.InstallationPhases.Failed, time.Minute)
+		sub.OwnerReferences = []metav1.OwnerReference{{
+			APIVersion: lsv1alpha1.SchemeGroupVersion.String(),
+			Kind:       "Installation",
+			Name:       "root",
+		}}
+		instList := []lsv1alpha1.Installation{
+			newInstallation("root", lsv1alpha1.InstallationPhases.Succeeded, time.Minute),
+			sub,
+		}
+
+		status := landscapehealth.Summarize(instList, true, nil)
+		Expect(status.Health).To(Equal(lsv1alpha1.LandscapeHealthStateHealthy))
+		Expect(status.Installations).To(Equal(int32(1)))
+
+		status = landscapehealth.Summarize(instList, false, nil)
+		Expect(status.Health).To(Equal(lsv1alpha1.LandscapeHealthStateUnhealthy))
+	})
+})
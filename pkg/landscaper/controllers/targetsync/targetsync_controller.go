@@ -24,7 +24,6 @@ import (
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/client-go/kubernetes"
 	"k8s.io/client-go/rest"
-	"k8s.io/client-go/tools/clientcmd"
 	clientcmdapi "k8s.io/client-go/tools/clientcmd/api"
 	clientcmdapiv1 "k8s.io/client-go/tools/clientcmd/api/v1"
 	controllerruntime "sigs.k8s.io/controller-runtime"
@@ -665,17 +664,12 @@ func (c *TargetSyncController) fetchSecretAndKubeconfigObject(ctx context.Contex
 		return nil, nil, fmt.Errorf("no kubeconfig in secret to rotate for sync object")
 	}
 
-	clientConfig, err := clientcmd.NewClientConfigFromBytes(kubeconfigBytes)
+	kubeConfig, err := utils.LoadKubeconfig(kubeconfigBytes)
 	if err != nil {
 		return nil, nil, err
 	}
 
-	kubeConfig, err := clientConfig.RawConfig()
-	if err != nil {
-		return nil, nil, err
-	}
-
-	return secret, &kubeConfig, nil
+	return secret, kubeConfig, nil
 }
 
 func (c *TargetSyncController) rotateTokenInSecret(ctx context.Context, targetSync *lsv1alpha1.TargetSync, secret *corev1.Secret,
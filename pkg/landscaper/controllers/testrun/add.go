@@ -0,0 +1,33 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package testrun
+
+import (
+	"github.com/go-logr/logr"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/manager"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	lsv1alpha1 "github.com/gardener/landscaper/apis/core/v1alpha1"
+	"github.com/gardener/landscaper/controller-utils/pkg/logging"
+)
+
+// AddControllerToManager adds the test run controller to the manager.
+// The controller executes the verification installations of the test runs and records their results.
+func AddControllerToManager(lsUncachedClient, lsCachedClient client.Client, logger logging.Logger, lsMgr manager.Manager) error {
+	log := logger.Reconciles("testRun", "TestRun")
+	ctrl := NewController(lsUncachedClient, lsCachedClient, log)
+
+	predicates := builder.WithPredicates(predicate.Or(predicate.GenerationChangedPredicate{},
+		predicate.AnnotationChangedPredicate{}))
+
+	return builder.ControllerManagedBy(lsMgr).
+		For(&lsv1alpha1.TestRun{}, predicates).
+		Owns(&lsv1alpha1.Installation{}).
+		WithLogConstructor(func(r *reconcile.Request) logr.Logger { return log.Logr() }).
+		Complete(ctrl)
+}
@@ -0,0 +1,363 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package testrun
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/robfig/cron/v3"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	lsv1alpha1 "github.com/gardener/landscaper/apis/core/v1alpha1"
+	lsv1alpha1helper "github.com/gardener/landscaper/apis/core/v1alpha1/helper"
+	"github.com/gardener/landscaper/controller-utils/pkg/logging"
+	lc "github.com/gardener/landscaper/controller-utils/pkg/logging/constants"
+	"github.com/gardener/landscaper/pkg/api"
+	"github.com/gardener/landscaper/pkg/utils"
+	"github.com/gardener/landscaper/pkg/utils/read_write_layer"
+)
+
+// DefaultTimeout is the default maximal duration of a test run.
+const DefaultTimeout = 30 * time.Minute
+
+// Controller is the test run controller.
+type Controller struct {
+	lsUncachedClient client.Client
+	lsCachedClient   client.Client
+	log              logging.Logger
+	now              func() time.Time
+}
+
+// NewController returns a new test run controller.
+func NewController(lsUncachedClient, lsCachedClient client.Client, logger logging.Logger) *Controller {
+	return &Controller{
+		lsUncachedClient: lsUncachedClient,
+		lsCachedClient:   lsCachedClient,
+		log:              logger,
+		now:              time.Now,
+	}
+}
+
+func (c *Controller) writer() *read_write_layer.Writer {
+	return read_write_layer.NewWriter(c.lsUncachedClient)
+}
+
+// Reconcile reconciles requests for test runs.
+func (c *Controller) Reconcile(ctx context.Context, req reconcile.Request) (result reconcile.Result, err error) {
+	_, ctx = c.log.StartReconcileAndAddToContext(ctx, req)
+	ctx = read_write_layer.ContextWithActor(ctx, "testrun-controller")
+
+	result = reconcile.Result{}
+	defer utils.HandlePanics(ctx, &result, nil)
+
+	return c.reconcile(ctx, req)
+}
+
+func (c *Controller) reconcile(ctx context.Context, req reconcile.Request) (reconcile.Result, error) {
+	logger, ctx := logging.FromContextOrNew(ctx, nil)
+
+	testRun := &lsv1alpha1.TestRun{}
+	if err := c.lsUncachedClient.Get(ctx, req.NamespacedName, testRun); err != nil {
+		if apierrors.IsNotFound(err) {
+			logger.Info(err.Error())
+			return reconcile.Result{}, nil
+		}
+		return reconcile.Result{}, err
+	}
+
+	if !testRun.DeletionTimestamp.IsZero() {
+		// the verification installations are deleted by the garbage collection as they are owned by the test run.
+		return reconcile.Result{}, nil
+	}
+
+	now := metav1.NewTime(c.now())
+
+	if IsRunDue(testRun, now.Time) {
+		if lsv1alpha1helper.HasOperation(testRun.ObjectMeta, lsv1alpha1.ReconcileOperation) {
+			delete(testRun.Annotations, lsv1alpha1.OperationAnnotation)
+			if err := c.writer().UpdateTestRun(ctx, read_write_layer.W000182, testRun); err != nil {
+				return reconcile.Result{}, fmt.Errorf("unable to remove reconcile annotation from test run: %w", err)
+			}
+		}
+
+		logger.Info("starting test run")
+		if err := c.startRun(ctx, testRun, now); err != nil {
+			return reconcile.Result{}, err
+		}
+	} else if testRun.Status.Phase == lsv1alpha1.TestRunPhaseRunning {
+		if err := c.updateResults(ctx, testRun, now); err != nil {
+			return reconcile.Result{}, err
+		}
+	}
+
+	if err := c.writer().UpdateTestRunStatus(ctx, read_write_layer.W000183, testRun); err != nil {
+		logger.Error(err, "updating status of test run failed")
+		return reconcile.Result{Requeue: true}, nil
+	}
+
+	return reconcile.Result{RequeueAfter: requeueAfter(testRun, now.Time)}, nil
+}
+
+// startRun creates resp. updates the verification installations of all tests and triggers their reconciliation.
+// Installations of tests that have been removed from the test run are deleted.
+func (c *Controller) startRun(ctx context.Context, testRun *lsv1alpha1.TestRun, now metav1.Time) error {
+	results := make([]lsv1alpha1.TestResult, 0, len(testRun.Spec.Tests))
+	testInstallations := map[string]bool{}
+	for _, test := range testRun.Spec.Tests {
+		inst := &lsv1alpha1.Installation{}
+		inst.Name = TestInstallationName(testRun, test.Name)
+		inst.Namespace = testRun.Namespace
+		testInstallations[inst.Name] = true
+
+		previousJobID := ""
+		if _, err := c.writer().CreateOrUpdateInstallation(ctx, read_write_layer.W000152, inst, func() error {
+			previousJobID = inst.Status.JobID
+			metav1.SetMetaDataLabel(&inst.ObjectMeta, lsv1alpha1.TestRunLabel, testRun.Name)
+			lsv1alpha1helper.SetOperation(&inst.ObjectMeta, lsv1alpha1.ReconcileOperation)
+			inst.Spec = *test.Installation.DeepCopy()
+			return controllerutil.SetControllerReference(testRun, inst, api.LandscaperScheme)
+		}); err != nil {
+			return fmt.Errorf("unable to create or update installation of test %q: %w", test.Name, err)
+		}
+
+		results = append(results, lsv1alpha1.TestResult{
+			Name: test.Name,
+			InstallationRef: lsv1alpha1.ObjectReference{
+				Name:      inst.Name,
+				Namespace: inst.Namespace,
+			},
+			Phase: lsv1alpha1.TestRunPhasePending,
+			JobID: previousJobID,
+		})
+	}
+
+	if err := c.deleteObsoleteInstallations(ctx, testRun, testInstallations); err != nil {
+		return err
+	}
+
+	testRun.Status.ObservedGeneration = testRun.Generation
+	testRun.Status.Phase = lsv1alpha1.TestRunPhaseRunning
+	testRun.Status.Message = ""
+	testRun.Status.StartTime = &now
+	testRun.Status.FinishedTime = nil
+	testRun.Status.Results = results
+
+	nextRunTime, err := NextRunTime(testRun.Spec.Schedule, now.Time)
+	if err != nil {
+		testRun.Status.Message = err.Error()
+	}
+	testRun.Status.NextRunTime = nextRunTime
+
+	if len(results) == 0 {
+		testRun.Status.Phase = lsv1alpha1.TestRunPhaseSucceeded
+		testRun.Status.FinishedTime = &now
+	}
+	return nil
+}
+
+func (c *Controller) deleteObsoleteInstallations(ctx context.Context, testRun *lsv1alpha1.TestRun, testInstallations map[string]bool) error {
+	installations := &lsv1alpha1.InstallationList{}
+	if err := read_write_layer.ListInstallations(ctx, c.lsUncachedClient, installations, read_write_layer.R000115,
+		client.InNamespace(testRun.Namespace), client.MatchingLabels{lsv1alpha1.TestRunLabel: testRun.Name}); err != nil {
+		return fmt.Errorf("unable to list installations of test run: %w", err)
+	}
+
+	for i := range installations.Items {
+		inst := &installations.Items[i]
+		if testInstallations[inst.Name] || !metav1.IsControlledBy(inst, testRun) || !inst.DeletionTimestamp.IsZero() {
+			continue
+		}
+		if err := c.writer().DeleteInstallation(ctx, read_write_layer.W000153, inst); err != nil && !apierrors.IsNotFound(err) {
+			return fmt.Errorf("unable to delete installation %s of removed test: %w", inst.Name, err)
+		}
+	}
+	return nil
+}
+
+// updateResults updates the results of all unfinished tests from the state of their installations.
+// Tests that are not finished within the timeout of the run are failed.
+func (c *Controller) updateResults(ctx context.Context, testRun *lsv1alpha1.TestRun, now metav1.Time) error {
+	logger, ctx := logging.FromContextOrNew(ctx, nil)
+
+	timedOut := testRun.Status.StartTime != nil && !now.Time.Before(testRun.Status.StartTime.Add(getTimeout(testRun)))
+
+	for i := range testRun.Status.Results {
+		result := &testRun.Status.Results[i]
+		if result.Phase.IsFinal() {
+			continue
+		}
+
+		inst := &lsv1alpha1.Installation{}
+		if err := read_write_layer.GetInstallation(ctx, c.lsUncachedClient, result.InstallationRef.NamespacedName(), inst, read_write_layer.R000114); err != nil {
+			if !apierrors.IsNotFound(err) {
+				return fmt.Errorf("unable to get installation of test %q: %w", result.Name, err)
+			}
+			result.Phase = lsv1alpha1.TestRunPhaseFailed
+			result.Message = "the installation of the test does not exist"
+			result.FinishedTime = &now
+			continue
+		}
+
+		UpdateTestResult(result, inst, now)
+		if !result.Phase.IsFinal() && timedOut {
+			result.Phase = lsv1alpha1.TestRunPhaseFailed
+			result.Message = fmt.Sprintf("the test did not finish within %s", getTimeout(testRun))
+			result.FinishedTime = &now
+		}
+		if result.Phase.IsFinal() {
+			logger.Info("test finished", lc.KeyResource, result.InstallationRef.NamespacedName().String(), "phase", result.Phase)
+		}
+	}
+
+	if phase := RunPhase(testRun.Status.Results); phase.IsFinal() {
+		testRun.Status.Phase = phase
+		testRun.Status.FinishedTime = &now
+	}
+	return nil
+}
+
+// UpdateTestResult updates the result of a test from the state of its installation.
+// A pending test is running as soon as the installation has started a new job,
+// and it is finished as soon as this job is finished.
+func UpdateTestResult(result *lsv1alpha1.TestResult, inst *lsv1alpha1.Installation, now metav1.Time) {
+	if result.Phase == lsv1alpha1.TestRunPhasePending {
+		if lsv1alpha1helper.HasOperation(inst.ObjectMeta, lsv1alpha1.ReconcileOperation) || inst.Status.JobID == result.JobID {
+			return
+		}
+		result.Phase = lsv1alpha1.TestRunPhaseRunning
+		result.JobID = inst.Status.JobID
+	}
+
+	if result.Phase != lsv1alpha1.TestRunPhaseRunning || inst.Status.JobIDFinished != result.JobID {
+		return
+	}
+
+	result.FinishedTime = &now
+	result.Artifacts = TestArtifacts(inst)
+	if inst.Status.InstallationPhase == lsv1alpha1.InstallationPhases.Succeeded {
+		result.Phase = lsv1alpha1.TestRunPhaseSucceeded
+		result.Message = ""
+		return
+	}
+
+	result.Phase = lsv1alpha1.TestRunPhaseFailed
+	result.Message = fmt.Sprintf("the installation finished with phase %s", inst.Status.InstallationPhase)
+	if inst.Status.LastError != nil {
+		result.Message = fmt.Sprintf("%s: %s", result.Message, inst.Status.LastError.Message)
+	}
+}
+
+// TestArtifacts returns the references to the data objects and targets that are exported by a verification installation.
+func TestArtifacts(inst *lsv1alpha1.Installation) []lsv1alpha1.TestArtifact {
+	var artifacts []lsv1alpha1.TestArtifact
+	for _, export := range inst.Spec.Exports.Data {
+		artifacts = append(artifacts, lsv1alpha1.TestArtifact{
+			Name: export.Name,
+			Kind: lsv1alpha1.TestArtifactKindDataObject,
+			ObjectRef: lsv1alpha1.ObjectReference{
+				Name:      lsv1alpha1helper.GenerateDataObjectName("", export.DataRef),
+				Namespace: inst.Namespace,
+			},
+		})
+	}
+	for _, export := range inst.Spec.Exports.Targets {
+		artifacts = append(artifacts, lsv1alpha1.TestArtifact{
+			Name: export.Name,
+			Kind: lsv1alpha1.TestArtifactKindTarget,
+			ObjectRef: lsv1alpha1.ObjectReference{
+				Name:      lsv1alpha1helper.GenerateDataObjectName("", export.Target),
+				Namespace: inst.Namespace,
+			},
+		})
+	}
+	return artifacts
+}
+
+// RunPhase returns the phase of a run from the results of its tests.
+func RunPhase(results []lsv1alpha1.TestResult) lsv1alpha1.TestRunPhase {
+	phase := lsv1alpha1.TestRunPhaseSucceeded
+	for _, result := range results {
+		switch result.Phase {
+		case lsv1alpha1.TestRunPhaseSucceeded:
+		case lsv1alpha1.TestRunPhaseFailed:
+			phase = lsv1alpha1.TestRunPhaseFailed
+		default:
+			return lsv1alpha1.TestRunPhaseRunning
+		}
+	}
+	return phase
+}
+
+// IsRunDue returns true if a new run of the test run has to be started.
+// This is the case if the test run has never run or has been modified, if it is annotated with the reconcile operation,
+// or if the next scheduled run is due. A run that is still in progress is never interrupted.
+func IsRunDue(testRun *lsv1alpha1.TestRun, now time.Time) bool {
+	if testRun.Status.Phase == lsv1alpha1.TestRunPhaseRunning {
+		return false
+	}
+	if testRun.Status.StartTime == nil || testRun.Status.ObservedGeneration != testRun.Generation {
+		return true
+	}
+	if lsv1alpha1helper.HasOperation(testRun.ObjectMeta, lsv1alpha1.ReconcileOperation) {
+		return true
+	}
+	return testRun.Status.NextRunTime != nil && !now.Before(testRun.Status.NextRunTime.Time)
+}
+
+// NextRunTime returns the start time of the next scheduled run after the given time.
+// Nil is returned if no schedule is defined.
+func NextRunTime(schedule *lsv1alpha1.TestRunSchedule, from time.Time) (*metav1.Time, error) {
+	if schedule == nil {
+		return nil, nil
+	}
+	if len(schedule.CronSpec) > 0 {
+		specSchedule, err := cron.ParseStandard(schedule.CronSpec)
+		if err != nil {
+			return nil, fmt.Errorf("invalid cron spec of schedule: %w", err)
+		}
+		return &metav1.Time{Time: specSchedule.Next(from)}, nil
+	}
+	if schedule.Interval != nil && schedule.Interval.Duration > 0 {
+		return &metav1.Time{Time: from.Add(schedule.Interval.Duration)}, nil
+	}
+	return nil, nil
+}
+
+// TestInstallationName returns the name of the verification installation of a test.
+func TestInstallationName(testRun *lsv1alpha1.TestRun, testName string) string {
+	return fmt.Sprintf("%s-%s", testRun.Name, testName)
+}
+
+func getTimeout(testRun *lsv1alpha1.TestRun) time.Duration {
+	if testRun.Spec.Timeout == nil {
+		return DefaultTimeout
+	}
+	return testRun.Spec.Timeout.Duration
+}
+
+// requeueAfter returns the time until the controller has to check the test run again,
+// i.e. the timeout of a running test run or the next scheduled run.
+// Zero is returned if the test run does not need to be checked again without an event.
+func requeueAfter(testRun *lsv1alpha1.TestRun, now time.Time) time.Duration {
+	var next time.Time
+	if testRun.Status.Phase == lsv1alpha1.TestRunPhaseRunning && testRun.Status.StartTime != nil {
+		next = testRun.Status.StartTime.Add(getTimeout(testRun))
+	} else if testRun.Status.NextRunTime != nil {
+		next = testRun.Status.NextRunTime.Time
+	} else {
+		return 0
+	}
+
+	if d := next.Sub(now); d > 0 {
+		return d
+	}
+	return time.Second
+}
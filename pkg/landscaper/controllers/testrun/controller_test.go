@@ -0,0 +1,163 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package testrun_test
+
+import (
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	lsv1alpha1 "github.com/gardener/landscaper/apis/core/v1alpha1"
+	lsv1alpha1helper "github.com/gardener/landscaper/apis/core/v1alpha1/helper"
+	"github.com/gardener/landscaper/pkg/landscaper/controllers/testrun"
+)
+
+var _ = Describe("TestRun Controller", func() {
+
+	var now time.Time
+
+	BeforeEach(func() {
+		now = time.Date(2024, 5, 1, 10, 0, 0, 0, time.UTC)
+	})
+
+	Context("Schedule", func() {
+
+		It("should not schedule a next run without schedule", func() {
+			next, err := testrun.NextRunTime(nil, now)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(next).To(BeNil())
+		})
+
+		It("should schedule the next run after the interval", func() {
+			next, err := testrun.NextRunTime(&lsv1alpha1.TestRunSchedule{
+				Interval: &lsv1alpha1.Duration{Duration: time.Hour},
+			}, now)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(next.Time).To(Equal(now.Add(time.Hour)))
+		})
+
+		It("should prefer the cron spec over the interval", func() {
+			next, err := testrun.NextRunTime(&lsv1alpha1.TestRunSchedule{
+				Interval: &lsv1alpha1.Duration{Duration: time.Hour},
+				CronSpec: "30 2 * * *",
+			}, now)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(next.Time).To(Equal(time.Date(2024, 5, 2, 2, 30, 0, 0, time.UTC)))
+		})
+
+		It("should return an error for an invalid cron spec", func() {
+			_, err := testrun.NextRunTime(&lsv1alpha1.TestRunSchedule{CronSpec: "invalid"}, now)
+			Expect(err).To(HaveOccurred())
+		})
+
+		It("should start a run if the test run is new, modified, annotated or due", func() {
+			tr := &lsv1alpha1.TestRun{}
+			tr.Generation = 1
+			Expect(testrun.IsRunDue(tr, now)).To(BeTrue())
+
+			tr.Status.StartTime = &metav1.Time{Time: now.Add(-time.Hour)}
+			tr.Status.ObservedGeneration = 1
+			tr.Status.Phase = lsv1alpha1.TestRunPhaseSucceeded
+			Expect(testrun.IsRunDue(tr, now)).To(BeFalse())
+
+			tr.Generation = 2
+			Expect(testrun.IsRunDue(tr, now)).To(BeTrue())
+			tr.Generation = 1
+
+			lsv1alpha1helper.SetOperation(&tr.ObjectMeta, lsv1alpha1.ReconcileOperation)
+			Expect(testrun.IsRunDue(tr, now)).To(BeTrue())
+			delete(tr.Annotations, lsv1alpha1.OperationAnnotation)
+
+			tr.Status.NextRunTime = &metav1.Time{Time: now}
+			Expect(testrun.IsRunDue(tr, now)).To(BeTrue())
+
+			tr.Status.Phase = lsv1alpha1.TestRunPhaseRunning
+			Expect(testrun.IsRunDue(tr, now)).To(BeFalse())
+		})
+	})
+
+	Context("Results", func() {
+
+		var (
+			inst   *lsv1alpha1.Installation
+			result *lsv1alpha1.TestResult
+		)
+
+		BeforeEach(func() {
+			inst = &lsv1alpha1.Installation{}
+			inst.Name = "smoke-test-echo"
+			inst.Namespace = "tests"
+			inst.Status.JobID = "job-1"
+			inst.Status.JobIDFinished = "job-1"
+			inst.Status.InstallationPhase = lsv1alpha1.InstallationPhases.Succeeded
+			inst.Spec.Exports.Data = []lsv1alpha1.DataExport{{Name: "endpoint", DataRef: "echo-endpoint"}}
+			inst.Spec.Exports.Targets = []lsv1alpha1.TargetExport{{Name: "cluster", Target: "echo-cluster"}}
+
+			result = &lsv1alpha1.TestResult{
+				Name:  "echo",
+				Phase: lsv1alpha1.TestRunPhasePending,
+				JobID: "job-1",
+			}
+		})
+
+		It("should keep a test pending until the installation has started a new job", func() {
+			lsv1alpha1helper.SetOperation(&inst.ObjectMeta, lsv1alpha1.ReconcileOperation)
+			testrun.UpdateTestResult(result, inst, metav1.NewTime(now))
+			Expect(result.Phase).To(Equal(lsv1alpha1.TestRunPhasePending))
+
+			delete(inst.Annotations, lsv1alpha1.OperationAnnotation)
+			inst.Status.JobID = "job-2"
+			testrun.UpdateTestResult(result, inst, metav1.NewTime(now))
+			Expect(result.Phase).To(Equal(lsv1alpha1.TestRunPhaseRunning))
+			Expect(result.JobID).To(Equal("job-2"))
+		})
+
+		It("should record a succeeded test together with its exports", func() {
+			inst.Status.JobID = "job-2"
+			inst.Status.JobIDFinished = "job-2"
+			testrun.UpdateTestResult(result, inst, metav1.NewTime(now))
+			Expect(result.Phase).To(Equal(lsv1alpha1.TestRunPhaseSucceeded))
+			Expect(result.FinishedTime).ToNot(BeNil())
+			Expect(result.Artifacts).To(ConsistOf(
+				lsv1alpha1.TestArtifact{
+					Name:      "endpoint",
+					Kind:      lsv1alpha1.TestArtifactKindDataObject,
+					ObjectRef: lsv1alpha1.ObjectReference{Name: lsv1alpha1helper.GenerateDataObjectName("", "echo-endpoint"), Namespace: "tests"},
+				},
+				lsv1alpha1.TestArtifact{
+					Name:      "cluster",
+					Kind:      lsv1alpha1.TestArtifactKindTarget,
+					ObjectRef: lsv1alpha1.ObjectReference{Name: lsv1alpha1helper.GenerateDataObjectName("", "echo-cluster"), Namespace: "tests"},
+				},
+			))
+		})
+
+		It("should record a failed test with the error of the installation", func() {
+			inst.Status.JobID = "job-2"
+			inst.Status.JobIDFinished = "job-2"
+			inst.Status.InstallationPhase = lsv1alpha1.InstallationPhases.Failed
+			inst.Status.LastError = &lsv1alpha1.Error{Message: "deploy item failed"}
+			testrun.UpdateTestResult(result, inst, metav1.NewTime(now))
+			Expect(result.Phase).To(Equal(lsv1alpha1.TestRunPhaseFailed))
+			Expect(result.Message).To(ContainSubstring("deploy item failed"))
+		})
+
+		It("should compute the phase of a run from its tests", func() {
+			Expect(testrun.RunPhase([]lsv1alpha1.TestResult{
+				{Phase: lsv1alpha1.TestRunPhaseSucceeded},
+				{Phase: lsv1alpha1.TestRunPhaseRunning},
+			})).To(Equal(lsv1alpha1.TestRunPhaseRunning))
+			Expect(testrun.RunPhase([]lsv1alpha1.TestResult{
+				{Phase: lsv1alpha1.TestRunPhaseSucceeded},
+				{Phase: lsv1alpha1.TestRunPhaseFailed},
+			})).To(Equal(lsv1alpha1.TestRunPhaseFailed))
+			Expect(testrun.RunPhase([]lsv1alpha1.TestResult{
+				{Phase: lsv1alpha1.TestRunPhaseSucceeded},
+			})).To(Equal(lsv1alpha1.TestRunPhaseSucceeded))
+		})
+	})
+})
@@ -10,6 +10,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"strconv"
+	"time"
 
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/utils/ptr"
@@ -45,6 +46,9 @@ type Metadata struct {
 	Index        *int
 	TargetMapKey *string
 	JobID        string
+	Lineage      Lineage
+	// OutdatedSince is set if the installation that exported the data object has been deleted or has failed.
+	OutdatedSince *metav1.Time
 }
 
 // generateHash returns the internal data generation function for dataobjects or targets.
@@ -108,6 +112,14 @@ func GetMetadataFromObject(objAcc metav1.Object, data []byte) Metadata {
 		// calculate the hash based on the data
 		meta.Hash = generateHash(data)
 	}
+	if rawLineage, ok := objAcc.GetAnnotations()[lsv1alpha1.DataObjectLineageAnnotation]; ok {
+		meta.Lineage = ParseLineage(rawLineage)
+	}
+	if rawOutdatedSince, ok := objAcc.GetAnnotations()[lsv1alpha1.DataObjectOutdatedSinceAnnotation]; ok {
+		if outdatedSince, err := time.Parse(time.RFC3339, rawOutdatedSince); err == nil {
+			meta.OutdatedSince = &metav1.Time{Time: outdatedSince}
+		}
+	}
 	return meta
 }
 
@@ -160,6 +172,16 @@ func SetMetadataFromObject(objAcc metav1.Object, meta Metadata) {
 		ann = map[string]string{}
 	}
 	ann[lsv1alpha1.DataObjectHashAnnotation] = meta.Hash
+	if len(meta.Lineage) != 0 {
+		ann[lsv1alpha1.DataObjectLineageAnnotation] = meta.Lineage.String()
+	} else {
+		delete(ann, lsv1alpha1.DataObjectLineageAnnotation)
+	}
+	if meta.OutdatedSince != nil {
+		ann[lsv1alpha1.DataObjectOutdatedSinceAnnotation] = meta.OutdatedSince.UTC().Format(time.RFC3339)
+	} else {
+		delete(ann, lsv1alpha1.DataObjectOutdatedSinceAnnotation)
+	}
 
 	objAcc.SetAnnotations(ann)
 }
@@ -193,6 +215,12 @@ func (do *DataObject) SetJobID(jobID string) *DataObject {
 	return do
 }
 
+// SetLineage sets the chain of objects that produced the data of the given data object.
+func (do *DataObject) SetLineage(lineage Lineage) *DataObject {
+	do.Metadata.Lineage = lineage
+	return do
+}
+
 // SetSourceType sets the context for the given data object.
 func (do *DataObject) SetSourceType(ctx lsv1alpha1.DataObjectSourceType) *DataObject {
 	do.Metadata.SourceType = ctx
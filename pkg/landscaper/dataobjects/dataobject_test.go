@@ -0,0 +1,41 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package dataobjects_test
+
+import (
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	lsv1alpha1 "github.com/gardener/landscaper/apis/core/v1alpha1"
+	"github.com/gardener/landscaper/pkg/landscaper/dataobjects"
+)
+
+var _ = Describe("Metadata", func() {
+
+	It("should read and write the outdatedSince annotation", func() {
+		outdatedSince := metav1.NewTime(time.Date(2024, 3, 1, 12, 0, 0, 0, time.UTC))
+		do := &lsv1alpha1.DataObject{}
+		dataobjects.SetMetadataFromObject(do, dataobjects.Metadata{
+			SourceType:    lsv1alpha1.ExportDataObjectSourceType,
+			OutdatedSince: &outdatedSince,
+		})
+		Expect(do.Annotations).To(HaveKeyWithValue(lsv1alpha1.DataObjectOutdatedSinceAnnotation, "2024-03-01T12:00:00Z"))
+
+		meta := dataobjects.GetMetadataFromObject(do, nil)
+		Expect(meta.OutdatedSince).ToNot(BeNil())
+		Expect(meta.OutdatedSince.Equal(&outdatedSince)).To(BeTrue())
+	})
+
+	It("should remove the outdatedSince annotation if the data object is exported again", func() {
+		do := &lsv1alpha1.DataObject{}
+		do.Annotations = map[string]string{lsv1alpha1.DataObjectOutdatedSinceAnnotation: "2024-03-01T12:00:00Z"}
+		dataobjects.SetMetadataFromObject(do, dataobjects.Metadata{SourceType: lsv1alpha1.ExportDataObjectSourceType})
+		Expect(do.Annotations).ToNot(HaveKey(lsv1alpha1.DataObjectOutdatedSinceAnnotation))
+		Expect(dataobjects.GetMetadataFromObject(do, nil).OutdatedSince).To(BeNil())
+	})
+})
@@ -0,0 +1,104 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package dataobjects
+
+import (
+	"encoding/json"
+
+	lsv1alpha1 "github.com/gardener/landscaper/apis/core/v1alpha1"
+	lsv1alpha1helper "github.com/gardener/landscaper/apis/core/v1alpha1/helper"
+)
+
+// LineageSourceKind describes the kind of object in a lineage chain.
+type LineageSourceKind string
+
+const (
+	LineageSourceInstallation LineageSourceKind = "Installation"
+	LineageSourceExecution    LineageSourceKind = "Execution"
+	LineageSourceDeployItem   LineageSourceKind = "DeployItem"
+	LineageSourceDataObject   LineageSourceKind = "DataObject"
+	LineageSourceTarget       LineageSourceKind = "Target"
+)
+
+// LineageSource is an object that contributed to the data of a data object.
+// All sources are located in the namespace of the data object.
+type LineageSource struct {
+	Kind LineageSourceKind `json:"kind"`
+	Name string            `json:"name"`
+}
+
+// Lineage is the chain of objects that produced the data of a data object.
+// The first entry is the direct producer, the following entries are the objects it consumed.
+type Lineage []LineageSource
+
+// ParseLineage parses the value of a lineage annotation.
+// Malformed values are ignored as the lineage is only informational.
+func ParseLineage(raw string) Lineage {
+	lineage := Lineage{}
+	if err := json.Unmarshal([]byte(raw), &lineage); err != nil {
+		return nil
+	}
+	return lineage
+}
+
+// ImportLineage returns the lineage of the exports of an installation, which starts with the installation itself,
+// followed by the data objects and targets it imports from the given context.
+// The imported objects carry their own lineage.
+func ImportLineage(inst *lsv1alpha1.Installation, contextName string) Lineage {
+	lineage := Lineage{{Kind: LineageSourceInstallation, Name: inst.Name}}
+	for _, imp := range inst.Spec.Imports.Data {
+		if len(imp.DataRef) != 0 {
+			lineage = lineage.Add(LineageSource{
+				Kind: LineageSourceDataObject,
+				Name: lsv1alpha1helper.GenerateDataObjectName(contextName, imp.DataRef),
+			})
+		}
+	}
+	for _, imp := range inst.Spec.Imports.Targets {
+		if len(imp.Target) != 0 {
+			lineage = lineage.Add(LineageSource{
+				Kind: LineageSourceTarget,
+				Name: lsv1alpha1helper.GenerateDataObjectName(contextName, imp.Target),
+			})
+		}
+		for _, target := range imp.Targets {
+			lineage = lineage.Add(LineageSource{
+				Kind: LineageSourceTarget,
+				Name: lsv1alpha1helper.GenerateDataObjectName(contextName, target),
+			})
+		}
+	}
+	return lineage
+}
+
+// Add appends the given sources to the lineage, skipping sources that are already contained.
+func (l Lineage) Add(sources ...LineageSource) Lineage {
+	for _, src := range sources {
+		if !l.Contains(src) {
+			l = append(l, src)
+		}
+	}
+	return l
+}
+
+// Contains checks whether the given source is part of the lineage.
+func (l Lineage) Contains(src LineageSource) bool {
+	for _, s := range l {
+		if s == src {
+			return true
+		}
+	}
+	return false
+}
+
+// String returns the json representation of the lineage that is used as annotation value.
+func (l Lineage) String() string {
+	data, err := json.Marshal(l)
+	if err != nil {
+		// cannot happen as the lineage only consists of strings
+		return ""
+	}
+	return string(data)
+}
@@ -0,0 +1,59 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package dataobjects_test
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	lsv1alpha1 "github.com/gardener/landscaper/apis/core/v1alpha1"
+	lsv1alpha1helper "github.com/gardener/landscaper/apis/core/v1alpha1/helper"
+	"github.com/gardener/landscaper/pkg/landscaper/dataobjects"
+)
+
+var _ = Describe("Lineage", func() {
+
+	It("should parse a lineage from its string representation", func() {
+		lineage := dataobjects.Lineage{
+			{Kind: dataobjects.LineageSourceInstallation, Name: "inst"},
+			{Kind: dataobjects.LineageSourceDataObject, Name: "do"},
+		}
+		Expect(dataobjects.ParseLineage(lineage.String())).To(Equal(lineage))
+	})
+
+	It("should ignore malformed lineage annotations", func() {
+		Expect(dataobjects.ParseLineage("not-json")).To(BeNil())
+	})
+
+	It("should not add sources that are already contained", func() {
+		src := dataobjects.LineageSource{Kind: dataobjects.LineageSourceTarget, Name: "t"}
+		lineage := dataobjects.Lineage{src}
+		lineage = lineage.Add(src, dataobjects.LineageSource{Kind: dataobjects.LineageSourceDataObject, Name: "t"})
+		Expect(lineage).To(HaveLen(2))
+		Expect(lineage.Contains(src)).To(BeTrue())
+	})
+
+	It("should record the names of the imported data objects and targets", func() {
+		inst := &lsv1alpha1.Installation{}
+		inst.Name = "inst"
+		inst.Spec.Imports.Data = []lsv1alpha1.DataImport{
+			{Name: "a", DataRef: "my-data"},
+			{Name: "b", SecretRef: &lsv1alpha1.LocalSecretReference{Name: "my-secret"}},
+		}
+		inst.Spec.Imports.Targets = []lsv1alpha1.TargetImport{
+			{Name: "c", Target: "my-target"},
+			{Name: "d", Targets: []string{"t1"}},
+		}
+
+		lineage := dataobjects.ImportLineage(inst, "ctx")
+		Expect(lineage).To(Equal(dataobjects.Lineage{
+			{Kind: dataobjects.LineageSourceInstallation, Name: "inst"},
+			{Kind: dataobjects.LineageSourceDataObject, Name: lsv1alpha1helper.GenerateDataObjectName("ctx", "my-data")},
+			{Kind: dataobjects.LineageSourceTarget, Name: lsv1alpha1helper.GenerateDataObjectName("ctx", "my-target")},
+			{Kind: dataobjects.LineageSourceTarget, Name: lsv1alpha1helper.GenerateDataObjectName("ctx", "t1")},
+		}))
+	})
+
+})
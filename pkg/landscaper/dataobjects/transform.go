@@ -0,0 +1,106 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package dataobjects
+
+import (
+	"bytes"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	gotmpl "text/template"
+
+	"github.com/Masterminds/sprig/v3"
+	"sigs.k8s.io/yaml"
+
+	lsv1alpha1 "github.com/gardener/landscaper/apis/core/v1alpha1"
+	"github.com/gardener/landscaper/pkg/landscaper/dataobjects/jsonpath"
+)
+
+// Transform applies the given transformations in order to the imported data and returns the result.
+func Transform(data interface{}, transformations []lsv1alpha1.ImportTransformation) (interface{}, error) {
+	var err error
+	for i, t := range transformations {
+		data, err = transform(data, t)
+		if err != nil {
+			return nil, fmt.Errorf("transformation %d of type %q failed: %w", i, t.Type, err)
+		}
+	}
+	return data, nil
+}
+
+func transform(data interface{}, t lsv1alpha1.ImportTransformation) (interface{}, error) {
+	switch t.Type {
+	case lsv1alpha1.ImportTransformationTypeJSONPath:
+		var value interface{}
+		if err := jsonpath.GetValue(t.JSONPath, data, &value); err != nil {
+			return nil, fmt.Errorf("unable to get value %q: %w", t.JSONPath, err)
+		}
+		return value, nil
+	case lsv1alpha1.ImportTransformationTypeDefault:
+		if t.Value == nil {
+			return data, nil
+		}
+		var value interface{}
+		if err := json.Unmarshal(t.Value.RawMessage, &value); err != nil {
+			return nil, fmt.Errorf("unable to decode default value: %w", err)
+		}
+		return mergeDefault(data, value), nil
+	case lsv1alpha1.ImportTransformationTypeBase64Decode:
+		encoded, ok := data.(string)
+		if !ok {
+			return nil, fmt.Errorf("expected a string but got %T", data)
+		}
+		decoded, err := base64.StdEncoding.DecodeString(encoded)
+		if err != nil {
+			return nil, fmt.Errorf("unable to decode base64 string: %w", err)
+		}
+		return string(decoded), nil
+	case lsv1alpha1.ImportTransformationTypeGoTemplate:
+		return executeTemplate(data, t.Template)
+	default:
+		return nil, fmt.Errorf("unknown transformation type")
+	}
+}
+
+// mergeDefault returns the value if the data is null. If both are objects, the missing fields of the data
+// are recursively added from the value.
+func mergeDefault(data, value interface{}) interface{} {
+	if data == nil {
+		return value
+	}
+	dataMap, ok := data.(map[string]interface{})
+	if !ok {
+		return data
+	}
+	valueMap, ok := value.(map[string]interface{})
+	if !ok {
+		return data
+	}
+	for key, v := range valueMap {
+		dataMap[key] = mergeDefault(dataMap[key], v)
+	}
+	return dataMap
+}
+
+func executeTemplate(data interface{}, template string) (interface{}, error) {
+	fm := sprig.FuncMap()
+	delete(fm, "env")
+	delete(fm, "expandenv")
+
+	tmpl, err := gotmpl.New("transformation").Funcs(fm).Option("missingkey=zero").Parse(template)
+	if err != nil {
+		return nil, fmt.Errorf("unable to parse template: %w", err)
+	}
+	buf := bytes.Buffer{}
+	if err := tmpl.Execute(&buf, map[string]interface{}{"value": data}); err != nil {
+		return nil, fmt.Errorf("unable to execute template: %w", err)
+	}
+
+	var result interface{}
+	if err := yaml.Unmarshal(buf.Bytes(), &result); err != nil {
+		return nil, fmt.Errorf("unable to parse result of template as yaml: %w", err)
+	}
+	return result, nil
+}
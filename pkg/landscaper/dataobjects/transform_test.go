@@ -0,0 +1,104 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package dataobjects_test
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	lsv1alpha1 "github.com/gardener/landscaper/apis/core/v1alpha1"
+	"github.com/gardener/landscaper/pkg/landscaper/dataobjects"
+)
+
+var _ = Describe("Transform", func() {
+
+	It("should select a value by a jsonpath", func() {
+		data := map[string]interface{}{
+			"outputs": map[string]interface{}{
+				"endpoint": "https://example.com",
+			},
+		}
+		res, err := dataobjects.Transform(data, []lsv1alpha1.ImportTransformation{
+			{Type: lsv1alpha1.ImportTransformationTypeJSONPath, JSONPath: ".outputs.endpoint"},
+		})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(res).To(Equal("https://example.com"))
+	})
+
+	It("should fail if the jsonpath does not exist", func() {
+		_, err := dataobjects.Transform(map[string]interface{}{}, []lsv1alpha1.ImportTransformation{
+			{Type: lsv1alpha1.ImportTransformationTypeJSONPath, JSONPath: ".outputs.endpoint"},
+		})
+		Expect(err).To(HaveOccurred())
+	})
+
+	It("should replace null data with the default value", func() {
+		res, err := dataobjects.Transform(nil, []lsv1alpha1.ImportTransformation{
+			{Type: lsv1alpha1.ImportTransformationTypeDefault, Value: &lsv1alpha1.AnyJSON{RawMessage: []byte(`"default"`)}},
+		})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(res).To(Equal("default"))
+	})
+
+	It("should add missing fields of the default value recursively", func() {
+		data := map[string]interface{}{
+			"replicas": float64(3),
+			"image": map[string]interface{}{
+				"tag": "1.0.0",
+			},
+		}
+		res, err := dataobjects.Transform(data, []lsv1alpha1.ImportTransformation{
+			{
+				Type:  lsv1alpha1.ImportTransformationTypeDefault,
+				Value: &lsv1alpha1.AnyJSON{RawMessage: []byte(`{"replicas": 1, "image": {"repository": "nginx", "tag": "latest"}}`)},
+			},
+		})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(res).To(Equal(map[string]interface{}{
+			"replicas": float64(3),
+			"image": map[string]interface{}{
+				"repository": "nginx",
+				"tag":        "1.0.0",
+			},
+		}))
+	})
+
+	It("should decode base64 encoded strings", func() {
+		res, err := dataobjects.Transform("aGVsbG8=", []lsv1alpha1.ImportTransformation{
+			{Type: lsv1alpha1.ImportTransformationTypeBase64Decode},
+		})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(res).To(Equal("hello"))
+
+		_, err = dataobjects.Transform(map[string]interface{}{}, []lsv1alpha1.ImportTransformation{
+			{Type: lsv1alpha1.ImportTransformationTypeBase64Decode},
+		})
+		Expect(err).To(HaveOccurred())
+	})
+
+	It("should apply the transformations in order", func() {
+		data := map[string]interface{}{
+			"data": map[string]interface{}{
+				"kubeconfig": "aGVsbG8=",
+			},
+		}
+		res, err := dataobjects.Transform(data, []lsv1alpha1.ImportTransformation{
+			{Type: lsv1alpha1.ImportTransformationTypeJSONPath, JSONPath: ".data.kubeconfig"},
+			{Type: lsv1alpha1.ImportTransformationTypeBase64Decode},
+			{Type: lsv1alpha1.ImportTransformationTypeGoTemplate, Template: "greeting: {{ .value | upper }}"},
+		})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(res).To(Equal(map[string]interface{}{
+			"greeting": "HELLO",
+		}))
+	})
+
+	It("should not provide the env function in templates", func() {
+		_, err := dataobjects.Transform("value", []lsv1alpha1.ImportTransformation{
+			{Type: lsv1alpha1.ImportTransformationTypeGoTemplate, Template: `{{ env "HOME" }}`},
+		})
+		Expect(err).To(HaveOccurred())
+	})
+})
@@ -0,0 +1,125 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package deployerregistrations
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"sort"
+	"strings"
+
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	lsv1alpha1 "github.com/gardener/landscaper/apis/core/v1alpha1"
+	"github.com/gardener/landscaper/pkg/utils/read_write_layer"
+)
+
+// GetDeployerRegistrationForType returns the valid registration of the deployer that reconciles deploy items of the given type.
+// Nil is returned if no deployer is registered for the type.
+func GetDeployerRegistrationForType(ctx context.Context, lsClient client.Reader,
+	diType lsv1alpha1.DeployItemType) (*lsv1alpha1.DeployerRegistration, error) {
+
+	registrations := &lsv1alpha1.DeployerRegistrationList{}
+	if err := read_write_layer.ListDeployerRegistrations(ctx, lsClient, registrations, read_write_layer.R000113); err != nil {
+		return nil, err
+	}
+
+	for _, reg := range sortByAge(registrations.Items) {
+		if reg.Status.Phase == lsv1alpha1.DeployerRegistrationPhaseInvalid {
+			continue
+		}
+		if supportsDeployItemType(reg, diType) {
+			return reg, nil
+		}
+	}
+	return nil, nil
+}
+
+// SupportsTargetType checks whether the registered deployer supports targets of the given type.
+func SupportsTargetType(reg *lsv1alpha1.DeployerRegistration, targetType lsv1alpha1.TargetType) bool {
+	if len(reg.Spec.TargetTypes) == 0 {
+		return true
+	}
+	for _, t := range reg.Spec.TargetTypes {
+		if t == targetType {
+			return true
+		}
+	}
+	return false
+}
+
+// ValidateHealthCheckURL checks that the health check url of a registration points to a service in the landscaper
+// cluster, i.e. that its host has the form <service>.<namespace>.svc or <service>.<namespace>.svc.cluster.local.
+// Other hosts are rejected as the landscaper would otherwise send requests to arbitrary endpoints.
+func ValidateHealthCheckURL(rawURL string) error {
+	u, err := url.Parse(rawURL)
+	if err != nil {
+		return fmt.Errorf("invalid health check url: %w", err)
+	}
+	if u.Scheme != "http" && u.Scheme != "https" {
+		return fmt.Errorf("health check url must use the scheme http or https")
+	}
+	if u.User != nil {
+		return fmt.Errorf("health check url must not contain user information")
+	}
+
+	labels := strings.Split(u.Hostname(), ".")
+	if len(labels) < 3 || len(labels[0]) == 0 || len(labels[1]) == 0 {
+		return fmt.Errorf("health check url must refer to a service in the cluster (<service>.<namespace>.svc)")
+	}
+	if suffix := strings.Join(labels[2:], "."); suffix != "svc" && suffix != "svc.cluster.local" {
+		return fmt.Errorf("health check url must refer to a service in the cluster (<service>.<namespace>.svc)")
+	}
+	return nil
+}
+
+// ConflictingDeployItemTypes returns the deploy item types of the given registration
+// that are already claimed by an older registration.
+func ConflictingDeployItemTypes(reg *lsv1alpha1.DeployerRegistration,
+	registrations []lsv1alpha1.DeployerRegistration) []lsv1alpha1.DeployItemType {
+
+	conflicts := []lsv1alpha1.DeployItemType{}
+	for _, diType := range reg.Spec.DeployItemTypes {
+		for i := range registrations {
+			other := &registrations[i]
+			if other.Name != reg.Name && isOlder(other, reg) && supportsDeployItemType(other, diType) {
+				conflicts = append(conflicts, diType)
+				break
+			}
+		}
+	}
+	return conflicts
+}
+
+func supportsDeployItemType(reg *lsv1alpha1.DeployerRegistration, diType lsv1alpha1.DeployItemType) bool {
+	for _, t := range reg.Spec.DeployItemTypes {
+		if t == diType {
+			return true
+		}
+	}
+	return false
+}
+
+// sortByAge sorts the registrations by their creation timestamp and name, starting with the oldest one.
+func sortByAge(registrations []lsv1alpha1.DeployerRegistration) []*lsv1alpha1.DeployerRegistration {
+	sorted := make([]*lsv1alpha1.DeployerRegistration, len(registrations))
+	for i := range registrations {
+		sorted[i] = &registrations[i]
+	}
+	sort.SliceStable(sorted, func(i, j int) bool {
+		return isOlder(sorted[i], sorted[j])
+	})
+	return sorted
+}
+
+// isOlder checks whether registration a has been created before registration b.
+// Registrations with the same creation timestamp are ordered by their name.
+func isOlder(a, b *lsv1alpha1.DeployerRegistration) bool {
+	if !a.CreationTimestamp.Equal(&b.CreationTimestamp) {
+		return a.CreationTimestamp.Before(&b.CreationTimestamp)
+	}
+	return a.Name < b.Name
+}
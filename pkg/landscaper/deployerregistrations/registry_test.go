@@ -0,0 +1,81 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package deployerregistrations_test
+
+import (
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	lsv1alpha1 "github.com/gardener/landscaper/apis/core/v1alpha1"
+	"github.com/gardener/landscaper/pkg/landscaper/deployerregistrations"
+)
+
+var _ = Describe("Registry", func() {
+
+	newRegistration := func(name string, created time.Time, types ...lsv1alpha1.DeployItemType) lsv1alpha1.DeployerRegistration {
+		reg := lsv1alpha1.DeployerRegistration{}
+		reg.Name = name
+		reg.CreationTimestamp = metav1.NewTime(created)
+		reg.Spec.DeployItemTypes = types
+		return reg
+	}
+
+	now := time.Now()
+
+	Context("ConflictingDeployItemTypes", func() {
+		It("should not report conflicts for distinct types", func() {
+			a := newRegistration("a", now, "landscaper.gardener.cloud/a")
+			b := newRegistration("b", now.Add(time.Minute), "landscaper.gardener.cloud/b")
+			Expect(deployerregistrations.ConflictingDeployItemTypes(&b, []lsv1alpha1.DeployerRegistration{a, b})).To(BeEmpty())
+		})
+
+		It("should report the types that are claimed by an older registration", func() {
+			a := newRegistration("a", now, "landscaper.gardener.cloud/a")
+			b := newRegistration("b", now.Add(time.Minute), "landscaper.gardener.cloud/a", "landscaper.gardener.cloud/b")
+			Expect(deployerregistrations.ConflictingDeployItemTypes(&b, []lsv1alpha1.DeployerRegistration{a, b})).
+				To(ConsistOf(lsv1alpha1.DeployItemType("landscaper.gardener.cloud/a")))
+			Expect(deployerregistrations.ConflictingDeployItemTypes(&a, []lsv1alpha1.DeployerRegistration{a, b})).To(BeEmpty())
+		})
+
+		It("should order registrations with the same creation timestamp by name", func() {
+			a := newRegistration("a", now, "landscaper.gardener.cloud/a")
+			b := newRegistration("b", now, "landscaper.gardener.cloud/a")
+			Expect(deployerregistrations.ConflictingDeployItemTypes(&a, []lsv1alpha1.DeployerRegistration{a, b})).To(BeEmpty())
+			Expect(deployerregistrations.ConflictingDeployItemTypes(&b, []lsv1alpha1.DeployerRegistration{a, b})).To(HaveLen(1))
+		})
+	})
+
+	Context("SupportsTargetType", func() {
+		It("should support all target types if none are registered", func() {
+			reg := newRegistration("a", now, "landscaper.gardener.cloud/a")
+			Expect(deployerregistrations.SupportsTargetType(&reg, "landscaper.gardener.cloud/kubernetes-cluster")).To(BeTrue())
+		})
+
+		It("should only support the registered target types", func() {
+			reg := newRegistration("a", now, "landscaper.gardener.cloud/a")
+			reg.Spec.TargetTypes = []lsv1alpha1.TargetType{"landscaper.gardener.cloud/kubernetes-cluster"}
+			Expect(deployerregistrations.SupportsTargetType(&reg, "landscaper.gardener.cloud/kubernetes-cluster")).To(BeTrue())
+			Expect(deployerregistrations.SupportsTargetType(&reg, "landscaper.gardener.cloud/mock")).To(BeFalse())
+		})
+	})
+
+	Context("ValidateHealthCheckURL", func() {
+		It("should accept services in the cluster", func() {
+			Expect(deployerregistrations.ValidateHealthCheckURL("http://deployer.ls-system.svc:8080/healthz")).To(Succeed())
+			Expect(deployerregistrations.ValidateHealthCheckURL("https://deployer.ls-system.svc.cluster.local/healthz")).To(Succeed())
+		})
+
+		It("should reject other hosts", func() {
+			Expect(deployerregistrations.ValidateHealthCheckURL("http://example.com/healthz")).ToNot(Succeed())
+			Expect(deployerregistrations.ValidateHealthCheckURL("http://deployer.ls-system.svc.example.com/healthz")).ToNot(Succeed())
+			Expect(deployerregistrations.ValidateHealthCheckURL("http://169.254.169.254/latest/meta-data")).ToNot(Succeed())
+			Expect(deployerregistrations.ValidateHealthCheckURL("http://deployer.ls-system.svc.example.com@example.com")).ToNot(Succeed())
+			Expect(deployerregistrations.ValidateHealthCheckURL("file://deployer.ls-system.svc/healthz")).ToNot(Succeed())
+		})
+	})
+})
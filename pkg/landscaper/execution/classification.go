@@ -2,8 +2,11 @@ package execution
 
 import (
 	"fmt"
+	"sort"
+	"strings"
 
 	lsv1alpha1 "github.com/gardener/landscaper/apis/core/v1alpha1"
+	lsv1alpha1helper "github.com/gardener/landscaper/apis/core/v1alpha1/helper"
 	lserrors "github.com/gardener/landscaper/apis/errors"
 )
 
@@ -14,12 +17,14 @@ import (
 // - failed items:    they have the same jobID as the execution, are finished and not succeeded (=> failed)
 // - runnableItems:   they have an old jobID, which can be updated because there are no pending dependencies
 // - pending items:   they have an old jobID, which can not be updated because of pending dependencies
+// - deferred items:  they are runnable, but their update or deletion is deferred because of an active exclusion window
 type DeployItemClassification struct {
 	runningItems   []*executionItem
 	succeededItems []*executionItem
 	failedItems    []*executionItem
 	runnableItems  []*executionItem
 	pendingItems   []*executionItem
+	deferredItems  []*executionItem
 }
 
 func (c *DeployItemClassification) HasRunningItems() bool {
@@ -42,14 +47,48 @@ func (c *DeployItemClassification) HasPendingItems() bool {
 	return len(c.pendingItems) > 0
 }
 
+func (c *DeployItemClassification) HasDeferredItems() bool {
+	return len(c.deferredItems) > 0
+}
+
 func (c *DeployItemClassification) AllSucceeded() bool {
-	return !c.HasRunningItems() && !c.HasFailedItems() && !c.HasRunnableItems() && !c.HasPendingItems()
+	return !c.HasRunningItems() && !c.HasFailedItems() && !c.HasRunnableItems() && !c.HasPendingItems() && !c.HasDeferredItems()
+}
+
+// OnlyDeferredItems returns whether all unfinished items are deferred.
+func (c *DeployItemClassification) OnlyDeferredItems() bool {
+	return c.HasDeferredItems() && !c.HasRunningItems() && !c.HasFailedItems() && !c.HasRunnableItems() && !c.HasPendingItems()
 }
 
 func (c *DeployItemClassification) GetRunnableItems() []*executionItem {
 	return c.runnableItems
 }
 
+// CheckRequiredReadiness returns an error if the execution has the require-readiness annotation, and the readiness
+// checks of some succeeded items have not succeeded, because they are disabled or not supported by the deployer.
+// In this case, the exports of the execution must not be published.
+func (c *DeployItemClassification) CheckRequiredReadiness(exec *lsv1alpha1.Execution) lserrors.LsError {
+	if !lsv1alpha1helper.HasRequireReadinessAnnotation(&exec.ObjectMeta) {
+		return nil
+	}
+
+	names := []string{}
+	for _, item := range c.succeededItems {
+		if !lsv1alpha1helper.IsReadinessChecked(item.DeployItem) {
+			names = append(names, item.Info.Name)
+		}
+	}
+	if len(names) == 0 {
+		return nil
+	}
+
+	sort.Strings(names)
+	return lserrors.NewError("CheckRequiredReadiness", "ReadinessNotChecked",
+		fmt.Sprintf("the readiness checks of the deploy items %s have not succeeded, but are required by annotation %s",
+			strings.Join(names, ", "), lsv1alpha1.RequireReadinessAnnotation),
+		lsv1alpha1.ErrorConfigurationProblem)
+}
+
 func newDeployItemClassification(executionJobID string, items []*executionItem) (*DeployItemClassification, lserrors.LsError) {
 	c := &DeployItemClassification{
 		runningItems:   []*executionItem{},
@@ -57,6 +96,7 @@ func newDeployItemClassification(executionJobID string, items []*executionItem)
 		failedItems:    []*executionItem{},
 		runnableItems:  []*executionItem{},
 		pendingItems:   []*executionItem{},
+		deferredItems:  []*executionItem{},
 	}
 
 	for i := range items {
@@ -129,6 +169,7 @@ func newDeployItemClassificationForDelete(executionJobID string, items []*execut
 		failedItems:    []*executionItem{},
 		runnableItems:  []*executionItem{},
 		pendingItems:   []*executionItem{},
+		deferredItems:  []*executionItem{},
 	}
 
 	for i := range items {
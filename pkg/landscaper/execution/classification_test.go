@@ -5,11 +5,15 @@
 package execution
 
 import (
+	"time"
+
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	lsv1alpha1 "github.com/gardener/landscaper/apis/core/v1alpha1"
+	lsv1alpha1helper "github.com/gardener/landscaper/apis/core/v1alpha1/helper"
+	lserrors "github.com/gardener/landscaper/apis/errors"
 )
 
 var _ = Describe("DeployItem Classification", func() {
@@ -112,4 +116,63 @@ var _ = Describe("DeployItem Classification", func() {
 		Expect(classification.runnableItems).To(ConsistOf(items[3], items[4]))
 		Expect(classification.pendingItems).To(ConsistOf(items[5], items[6]))
 	})
+
+	It("should compute the progress of the current job", func() {
+		currJobID := "02"
+		prevJobID := "01"
+		startTime := metav1.Now()
+		finishedTime := metav1.NewTime(startTime.Add(time.Minute))
+		items := []*executionItem{
+			buildExecutionItem("c", []string{}, currJobID, prevJobID, lsv1alpha1.DeployItemPhases.Progressing),
+			buildExecutionItem("a", []string{}, currJobID, currJobID, lsv1alpha1.DeployItemPhases.Succeeded),
+			buildExecutionItem("b", []string{}, currJobID, currJobID, lsv1alpha1.DeployItemPhases.Failed),
+			buildExecutionItem("d", []string{"c"}, prevJobID, prevJobID, lsv1alpha1.DeployItemPhases.Succeeded),
+		}
+		items[1].DeployItem.Status.JobIDGenerationTime = &startTime
+		items[1].DeployItem.Status.TransitionTimes = &lsv1alpha1.TransitionTimes{FinishedTime: &finishedTime}
+
+		classification, err := newDeployItemClassification(currJobID, items)
+		Expect(err).NotTo(HaveOccurred())
+
+		progress := classification.Progress(currJobID)
+		Expect(progress.Total).To(Equal(int32(4)))
+		Expect(progress.Completed).To(Equal(int32(1)))
+		Expect(progress.Failed).To(Equal(int32(1)))
+		Expect(progress.Running).To(Equal(int32(1)))
+		Expect(progress.DeployItems).To(Equal([]lsv1alpha1.DeployItemProgress{
+			{Name: "a", Phase: lsv1alpha1.DeployItemPhases.Succeeded, StartTime: &startTime, FinishedTime: &finishedTime},
+			{Name: "b", Phase: lsv1alpha1.DeployItemPhases.Failed},
+			{Name: "c", Phase: lsv1alpha1.DeployItemPhases.Progressing},
+			{Name: "d"},
+		}))
+	})
+
+	It("should require successful readiness checks of the succeeded items if the execution requires readiness", func() {
+		currJobID := "02"
+		items := []*executionItem{
+			buildExecutionItem("b", []string{}, currJobID, currJobID, lsv1alpha1.DeployItemPhases.Succeeded),
+			buildExecutionItem("a", []string{}, currJobID, currJobID, lsv1alpha1.DeployItemPhases.Succeeded),
+			buildExecutionItem("c", []string{}, currJobID, currJobID, lsv1alpha1.DeployItemPhases.Succeeded),
+		}
+		items[0].DeployItem.Status.Conditions = lsv1alpha1helper.CreateOrUpdateConditions(nil,
+			lsv1alpha1.DeployItemReadinessCondition, lsv1alpha1.ConditionTrue, lsv1alpha1helper.ConditionReasonSucceeded, "")
+		items[2].DeployItem.Status.Conditions = lsv1alpha1helper.CreateOrUpdateConditions(nil,
+			lsv1alpha1.DeployItemReadinessCondition, lsv1alpha1.ConditionFalse, "ReadinessChecksDisabled", "")
+
+		classification, err := newDeployItemClassification(currJobID, items)
+		Expect(err).NotTo(HaveOccurred())
+
+		exec := &lsv1alpha1.Execution{}
+		Expect(classification.CheckRequiredReadiness(exec)).To(Succeed())
+
+		metav1.SetMetaDataAnnotation(&exec.ObjectMeta, lsv1alpha1.RequireReadinessAnnotation, "true")
+		err = classification.CheckRequiredReadiness(exec)
+		Expect(err).To(HaveOccurred())
+		Expect(err.Error()).To(ContainSubstring("the readiness checks of the deploy items a, c have not succeeded"))
+		Expect(lserrors.ContainsErrorCode(err, lsv1alpha1.ErrorConfigurationProblem)).To(BeTrue())
+
+		items[1].DeployItem.Status.Conditions = items[0].DeployItem.Status.Conditions
+		items[2].DeployItem.Status.Conditions = items[0].DeployItem.Status.Conditions
+		Expect(classification.CheckRequiredReadiness(exec)).To(Succeed())
+	})
 })
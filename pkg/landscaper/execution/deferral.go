@@ -0,0 +1,95 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package execution
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	lsv1alpha1 "github.com/gardener/landscaper/apis/core/v1alpha1"
+	lsv1alpha1helper "github.com/gardener/landscaper/apis/core/v1alpha1/helper"
+	lserrors "github.com/gardener/landscaper/apis/errors"
+)
+
+// deferralEnd returns the end of the active exclusion window of a deploy item.
+// Only deploy items that have already been deployed are deferred, so that new deploy items are always created.
+func deferralEnd(di *lsv1alpha1.DeployItem, now time.Time) (time.Time, bool, lserrors.LsError) {
+	if di == nil || len(di.Spec.ExclusionWindows) == 0 || len(di.Status.JobIDFinished) == 0 {
+		return time.Time{}, false, nil
+	}
+
+	end, active, err := lsv1alpha1helper.ActiveExclusionWindowEnd(di.Spec.ExclusionWindows, now)
+	if err != nil {
+		return time.Time{}, false, lserrors.NewWrappedError(err, "deferralEnd", "ExclusionWindows",
+			fmt.Sprintf("invalid exclusion window of deployitem %s: %s", di.Name, err.Error()), lsv1alpha1.ErrorConfigurationProblem)
+	}
+	return end, active, nil
+}
+
+// deferItem moves a runnable item of the classification into the class of deferred items
+// and returns its description for the status of the execution.
+func (c *DeployItemClassification) deferItem(item *executionItem, operation lsv1alpha1.DeferredOperation, until time.Time) lsv1alpha1.DeferredDeployItem {
+	// the runnable items are copied, because callers might iterate over them while items are deferred
+	runnableItems := make([]*executionItem, 0, len(c.runnableItems))
+	for _, runnableItem := range c.runnableItems {
+		if runnableItem != item {
+			runnableItems = append(runnableItems, runnableItem)
+		}
+	}
+	c.runnableItems = runnableItems
+	c.deferredItems = append(c.deferredItems, item)
+
+	return lsv1alpha1.DeferredDeployItem{
+		Name:       item.Info.Name,
+		ObjectName: item.DeployItem.Name,
+		Operation:  operation,
+		Until:      metav1.NewTime(until),
+	}
+}
+
+// DeferralMessage describes the deferred deploy items of an execution.
+// An empty string is returned if no deploy item is deferred.
+func DeferralMessage(exec *lsv1alpha1.Execution) string {
+	if len(exec.Status.DeferredDeployItems) == 0 {
+		return ""
+	}
+
+	descriptions := make([]string, len(exec.Status.DeferredDeployItems))
+	for i, item := range exec.Status.DeferredDeployItems {
+		name := item.Name
+		if len(name) == 0 {
+			name = item.ObjectName
+		}
+		descriptions[i] = fmt.Sprintf("%s of %s until %s", strings.ToLower(string(item.Operation)), name,
+			item.Until.UTC().Format(time.RFC3339))
+	}
+	sort.Strings(descriptions)
+	return "deferred by exclusion windows: " + strings.Join(descriptions, ", ")
+}
+
+// DeferralRequeueAfter returns the duration until the first exclusion window of the deferred deploy items
+// of an execution ends. The second return value is false if no deploy item is deferred.
+func DeferralRequeueAfter(exec *lsv1alpha1.Execution, now time.Time) (time.Duration, bool) {
+	if len(exec.Status.DeferredDeployItems) == 0 {
+		return 0, false
+	}
+
+	first := exec.Status.DeferredDeployItems[0].Until.Time
+	for _, item := range exec.Status.DeferredDeployItems[1:] {
+		if item.Until.Time.Before(first) {
+			first = item.Until.Time
+		}
+	}
+
+	requeueAfter := first.Sub(now)
+	if requeueAfter < time.Second {
+		requeueAfter = time.Second
+	}
+	return requeueAfter, true
+}
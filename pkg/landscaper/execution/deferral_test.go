@@ -0,0 +1,116 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package execution
+
+import (
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	lsv1alpha1 "github.com/gardener/landscaper/apis/core/v1alpha1"
+)
+
+var _ = Describe("Deferral", func() {
+
+	// the window is active from 08:00 to 10:00 UTC
+	now := time.Date(2024, 6, 15, 9, 0, 0, 0, time.UTC)
+	windowEnd := time.Date(2024, 6, 15, 10, 0, 0, 0, time.UTC)
+	windows := []lsv1alpha1.ExclusionWindow{{Begin: "08:00", End: "10:00"}}
+
+	buildExecutionItem := func(name string, dependsOn []string, jobIDFinished string, windows []lsv1alpha1.ExclusionWindow) *executionItem {
+		return &executionItem{
+			Info: lsv1alpha1.DeployItemTemplate{
+				Name:             name,
+				DependsOn:        dependsOn,
+				ExclusionWindows: windows,
+			},
+			DeployItem: &lsv1alpha1.DeployItem{
+				ObjectMeta: metav1.ObjectMeta{Name: name + "-obj"},
+				Spec:       lsv1alpha1.DeployItemSpec{ExclusionWindows: windows},
+				Status: lsv1alpha1.DeployItemStatus{
+					JobID:         jobIDFinished,
+					JobIDFinished: jobIDFinished,
+					Phase:         lsv1alpha1.DeployItemPhases.Succeeded,
+				},
+			},
+		}
+	}
+
+	It("should only defer deployed items with an active exclusion window", func() {
+		until, deferred, err := deferralEnd(buildExecutionItem("a", nil, "01", windows).DeployItem, now)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(deferred).To(BeTrue())
+		Expect(until).To(BeTemporally("==", windowEnd))
+
+		_, deferred, err = deferralEnd(buildExecutionItem("a", nil, "01", windows).DeployItem, windowEnd)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(deferred).To(BeFalse())
+
+		// new deploy items are created in any case
+		_, deferred, err = deferralEnd(buildExecutionItem("a", nil, "", windows).DeployItem, now)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(deferred).To(BeFalse())
+
+		_, _, err = deferralEnd(buildExecutionItem("a", nil, "01", []lsv1alpha1.ExclusionWindow{{Begin: "8", End: "10"}}).DeployItem, now)
+		Expect(err).To(HaveOccurred())
+	})
+
+	It("should keep the classification unfinished while items are deferred", func() {
+		items := []*executionItem{
+			buildExecutionItem("a", nil, "01", windows),
+			buildExecutionItem("b", nil, "01", nil),
+			buildExecutionItem("c", []string{"a"}, "01", nil),
+		}
+
+		classification, lsErr := newDeployItemClassification("02", items)
+		Expect(lsErr).ToNot(HaveOccurred())
+		Expect(classification.runnableItems).To(ConsistOf(items[0], items[1]))
+
+		runnableItems := classification.GetRunnableItems()
+		deferred := []lsv1alpha1.DeferredDeployItem{}
+		for _, item := range runnableItems {
+			until, isDeferred, lsErr := deferralEnd(item.DeployItem, now)
+			Expect(lsErr).ToNot(HaveOccurred())
+			if isDeferred {
+				deferred = append(deferred, classification.deferItem(item, lsv1alpha1.DeferredOperationUpdate, until))
+			}
+		}
+
+		Expect(deferred).To(ConsistOf(lsv1alpha1.DeferredDeployItem{
+			Name:       "a",
+			ObjectName: "a-obj",
+			Operation:  lsv1alpha1.DeferredOperationUpdate,
+			Until:      metav1.NewTime(windowEnd),
+		}))
+		Expect(runnableItems).To(ConsistOf(items[0], items[1]))
+		Expect(classification.runnableItems).To(ConsistOf(items[1]))
+		Expect(classification.deferredItems).To(ConsistOf(items[0]))
+		Expect(classification.pendingItems).To(ConsistOf(items[2]))
+		Expect(classification.AllSucceeded()).To(BeFalse())
+		Expect(classification.OnlyDeferredItems()).To(BeFalse())
+
+		progress := classification.Progress("02")
+		Expect(progress.Total).To(BeNumerically("==", 3))
+	})
+
+	It("should report the deferred items and requeue at the end of the first window", func() {
+		exec := &lsv1alpha1.Execution{}
+		Expect(DeferralMessage(exec)).To(BeEmpty())
+		_, ok := DeferralRequeueAfter(exec, now)
+		Expect(ok).To(BeFalse())
+
+		exec.Status.DeferredDeployItems = []lsv1alpha1.DeferredDeployItem{
+			{Name: "a", ObjectName: "a-obj", Operation: lsv1alpha1.DeferredOperationUpdate, Until: metav1.NewTime(windowEnd.Add(time.Hour))},
+			{ObjectName: "b-obj", Operation: lsv1alpha1.DeferredOperationDelete, Until: metav1.NewTime(windowEnd)},
+		}
+		Expect(DeferralMessage(exec)).To(Equal("deferred by exclusion windows: delete of b-obj until 2024-06-15T10:00:00Z, update of a until 2024-06-15T11:00:00Z"))
+
+		requeueAfter, ok := DeferralRequeueAfter(exec, now)
+		Expect(ok).To(BeTrue())
+		Expect(requeueAfter).To(Equal(time.Hour))
+	})
+})
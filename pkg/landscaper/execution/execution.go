@@ -6,7 +6,10 @@ package execution
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"strings"
+	"time"
 
 	lc "github.com/gardener/landscaper/controller-utils/pkg/logging/constants"
 
@@ -25,14 +28,26 @@ import (
 	"github.com/gardener/landscaper/pkg/landscaper/dataobjects"
 	"github.com/gardener/landscaper/pkg/landscaper/operation"
 	"github.com/gardener/landscaper/pkg/utils/clusters"
+	"github.com/gardener/landscaper/pkg/utils/dependencies"
 	"github.com/gardener/landscaper/pkg/utils/read_write_layer"
 )
 
+// CyclicDependencyError is the error of an execution whose deploy items depend on each other.
+var CyclicDependencyError = errors.New("the dependencies of the deploy items contain a cycle")
+
 // Operation contains all execution operations
 type Operation struct {
 	*operation.Operation
-	exec           *lsv1alpha1.Execution
+	exec *lsv1alpha1.Execution
+	// forceReconcile defines whether all deploy items are redeployed, even if partial redeployment is enabled.
 	forceReconcile bool
+	// defaultTimeout is the progressing timeout that is set on deploy items whose template does not specify one.
+	defaultTimeout *lsv1alpha1.Duration
+	// ownerReferenceGC defines whether the deploy items and exported data objects carry an owner reference
+	// to the installation of the execution.
+	ownerReferenceGC bool
+	// partialRedeployment defines whether a new job only redeploys the deploy items that have changed.
+	partialRedeployment bool
 }
 
 // NewOperation creates a new execution operations
@@ -44,9 +59,26 @@ func NewOperation(op *operation.Operation, exec *lsv1alpha1.Execution, forceReco
 	}
 }
 
+// SetDefaultDeployItemTimeout sets the progressing timeout for deploy items whose template does not specify a timeout.
+func (o *Operation) SetDefaultDeployItemTimeout(timeout *lsv1alpha1.Duration) {
+	o.defaultTimeout = timeout
+}
+
+// SetOwnerReferenceGarbageCollection defines whether the deploy items and exported data objects of the execution
+// carry an owner reference to the installation of the execution.
+func (o *Operation) SetOwnerReferenceGarbageCollection(enabled bool) {
+	o.ownerReferenceGC = enabled
+}
+
 func (o *Operation) UpdateDeployItems(ctx context.Context, deployItemCache *lsv1alpha1.DeployItemCache) lserrors.LsError {
 	op := "UpdateDeployItems"
 
+	// the deploy items of a cycle would wait for each other forever
+	if cycle := dependencies.FindCycleOfDeployItems(o.exec.Spec.DeployItems); len(cycle) != 0 {
+		msg := fmt.Sprintf("%s: %s", CyclicDependencyError.Error(), strings.Join(cycle, " -{depends_on}-> "))
+		return lserrors.NewWrappedError(CyclicDependencyError, op, lsv1alpha1.CyclicDependencyReason, msg, lsv1alpha1.ErrorCyclicDependencies)
+	}
+
 	executionItems, orphaned, lsErr := o.getDeployItems(ctx, deployItemCache)
 	if lsErr != nil {
 		return lsErr
@@ -56,6 +88,12 @@ func (o *Operation) UpdateDeployItems(ctx context.Context, deployItemCache *lsv1
 		return lserrors.NewWrappedError(err, op, "CleanupOrphanedDeployItems", err.Error())
 	}
 
+	for _, item := range executionItems {
+		if lsErr := o.checkDeployerRegistration(ctx, item.Info); lsErr != nil {
+			return lsErr
+		}
+	}
+
 	activePairs := []lsv1alpha1.DiNamePair{}
 	for _, item := range executionItems {
 		nextDiNamePair, lsErr := o.updateDeployItem(ctx, *item)
@@ -74,6 +112,7 @@ func (o *Operation) UpdateDeployItems(ctx context.Context, deployItemCache *lsv1
 		ActiveDIs:   activePairs,
 		OrphanedDIs: orphanedNames,
 	}
+	o.exec.Status.DeployItemHashes = pruneDeployItemHashes(o.exec.Status.DeployItemHashes, o.exec.Spec.DeployItems)
 
 	return nil
 }
@@ -86,6 +125,9 @@ func (o *Operation) TriggerDeployItems(ctx context.Context) (*DeployItemClassifi
 		return nil, lsErr
 	}
 
+	now := time.Now()
+	deferred := []lsv1alpha1.DeferredDeployItem{}
+
 	// Trigger orphaned deploy items
 	classificationOfOrphans, lsErr := newDeployItemClassificationForOrphans(o.exec.Status.JobID, orphaned)
 	if lsErr != nil {
@@ -107,15 +149,30 @@ func (o *Operation) TriggerDeployItems(ctx context.Context) (*DeployItemClassifi
 					if err := o.removeFinalizerFromDeployItem(ctx, item.DeployItem); err != nil {
 						return nil, err
 					}
-				} else {
-					if err := o.triggerDeployItem(ctx, item.DeployItem, read_write_layer.W000030); err != nil {
-						return nil, err
-					}
+					continue
+				}
+
+				until, isDeferred, lsErr := deferralEnd(item.DeployItem, now)
+				if lsErr != nil {
+					return nil, lsErr
+				}
+				if isDeferred {
+					logger.Info("Deferring deletion of deployitem because of an exclusion window", lc.KeyResource, kutil.ObjectKeyFromObject(item.DeployItem).String(), "until", until)
+					deferred = append(deferred, classificationOfOrphans.deferItem(item, lsv1alpha1.DeferredOperationDelete, until))
+					continue
+				}
+
+				if err := o.triggerDeployItem(ctx, item.DeployItem, read_write_layer.W000030); err != nil {
+					return nil, err
 				}
 			}
 		}
 
-		return classificationOfOrphans, nil
+		// The new and updated deploy items proceed if the deletion of all remaining orphaned items is deferred.
+		if !classificationOfOrphans.OnlyDeferredItems() {
+			o.exec.Status.DeferredDeployItems = deferred
+			return classificationOfOrphans, nil
+		}
 	}
 
 	// Trigger new and updated deploy items
@@ -128,12 +185,47 @@ func (o *Operation) TriggerDeployItems(ctx context.Context) (*DeployItemClassifi
 	if !classification.HasFailedItems() {
 		runnableItems := classification.GetRunnableItems()
 		for _, item := range runnableItems {
+			hash, lsErr := o.deployItemHash(ctx, item)
+			if lsErr != nil {
+				return nil, lsErr
+			}
+			if o.partialRedeployment && !o.forceReconcile && isUnchanged(o.exec.Status.JobID, item, hash, o.exec.Status.DeployItemHashes) {
+				logger.Info("Skipping unchanged deployitem", lc.KeyResource, kutil.ObjectKeyFromObject(item.DeployItem).String())
+				if err := o.skipDeployItem(ctx, item.DeployItem); err != nil {
+					return nil, err
+				}
+				continue
+			}
+
+			until, isDeferred, lsErr := deferralEnd(item.DeployItem, now)
+			if lsErr != nil {
+				return nil, lsErr
+			}
+			if isDeferred {
+				logger.Info("Deferring update of deployitem because of an exclusion window", lc.KeyResource, kutil.ObjectKeyFromObject(item.DeployItem).String(), "until", until)
+				deferred = append(deferred, classification.deferItem(item, lsv1alpha1.DeferredOperationUpdate, until))
+				continue
+			}
+
 			if err := o.triggerDeployItem(ctx, item.DeployItem, read_write_layer.W000056); err != nil {
 				return nil, err
 			}
+			o.exec.Status.DeployItemHashes = setDeployItemHash(o.exec.Status.DeployItemHashes, lsv1alpha1.DeployItemHash{
+				Name:  item.Info.Name,
+				Hash:  hash,
+				JobID: o.exec.Status.JobID,
+			})
 		}
 	}
 
+	// Deferred orphaned items keep the execution unfinished.
+	classification.deferredItems = append(classification.deferredItems, classificationOfOrphans.deferredItems...)
+	o.exec.Status.DeferredDeployItems = deferred
+
+	if lsErr := o.updateProgress(items, newDeployItemClassification); lsErr != nil {
+		return nil, lsErr
+	}
+
 	return classification, nil
 }
 
@@ -152,6 +244,9 @@ func (o *Operation) TriggerDeployItemsForDelete(ctx context.Context) (*DeployIte
 		return nil, lsErr
 	}
 
+	now := time.Now()
+	deferred := []lsv1alpha1.DeferredDeployItem{}
+
 	// If all deploy items have been successfully deleted, remove the finalizer of the execution
 	if classification.AllSucceeded() {
 		controllerutil.RemoveFinalizer(o.exec, lsv1alpha1.LandscaperFinalizer)
@@ -159,6 +254,7 @@ func (o *Operation) TriggerDeployItemsForDelete(ctx context.Context) (*DeployIte
 		if err != nil {
 			return classification, lserrors.NewWrappedError(err, op, "RemoveFinalizer", err.Error())
 		}
+		o.exec.Status.Progress = classification.Progress(o.exec.Status.JobID)
 		return classification, nil
 	}
 
@@ -176,34 +272,65 @@ func (o *Operation) TriggerDeployItemsForDelete(ctx context.Context) (*DeployIte
 				if err := o.removeFinalizerFromDeployItem(ctx, item.DeployItem); err != nil {
 					return nil, err
 				}
-			} else {
-				if err := o.triggerDeployItem(ctx, item.DeployItem, read_write_layer.W000090); err != nil {
-					return nil, err
-				}
+				continue
+			}
+
+			until, isDeferred, lsErr := deferralEnd(item.DeployItem, now)
+			if lsErr != nil {
+				return nil, lsErr
+			}
+			if isDeferred {
+				logger.Info("Deferring deletion of deployitem because of an exclusion window", lc.KeyResource, kutil.ObjectKeyFromObject(item.DeployItem).String(), "until", until)
+				deferred = append(deferred, classification.deferItem(item, lsv1alpha1.DeferredOperationDelete, until))
+				continue
+			}
+
+			if err := o.triggerDeployItem(ctx, item.DeployItem, read_write_layer.W000090); err != nil {
+				return nil, err
 			}
 		}
 	}
+	o.exec.Status.DeferredDeployItems = deferred
+
+	if lsErr := o.updateProgress(items, newDeployItemClassificationForDelete); lsErr != nil {
+		return nil, lsErr
+	}
 
 	return classification, nil
 }
 
+// updateProgress sets the progress of the current job in the status of the execution.
+// The items are classified again, so that items which have been triggered in this reconciliation are counted as running.
+func (o *Operation) updateProgress(items []*executionItem,
+	classify func(string, []*executionItem) (*DeployItemClassification, lserrors.LsError)) lserrors.LsError {
+
+	classification, lsErr := classify(o.exec.Status.JobID, items)
+	if lsErr != nil {
+		return lsErr
+	}
+	o.exec.Status.Progress = classification.Progress(o.exec.Status.JobID)
+	return nil
+}
+
 func (o *Operation) triggerDeployItem(ctx context.Context, di *lsv1alpha1.DeployItem, writeId read_write_layer.WriteID) lserrors.LsError {
 	op := "TriggerDeployItem"
 
 	key := kutil.ObjectKeyFromObject(di)
-	di = &lsv1alpha1.DeployItem{}
-	if err := read_write_layer.GetDeployItem(ctx, o.LsUncachedClient(), key, di, read_write_layer.R000034); err != nil {
+	current := &lsv1alpha1.DeployItem{}
+	if err := read_write_layer.GetDeployItem(ctx, o.LsUncachedClient(), key, current, read_write_layer.R000034); err != nil {
 		return lserrors.NewWrappedError(err, op, "GetDeployItem", err.Error())
 	}
 
-	di.Status.SetJobID(o.exec.Status.JobID)
-	di.Status.TransitionTimes = utils.NewTransitionTimes()
+	current.Status.SetJobID(o.exec.Status.JobID)
+	current.Status.TransitionTimes = utils.NewTransitionTimes()
 	now := metav1.Now()
-	di.Status.JobIDGenerationTime = &now
-	if err := o.WriterToLsUncachedClient().UpdateDeployItemStatus(ctx, writeId, di); err != nil {
+	current.Status.JobIDGenerationTime = &now
+	if err := o.WriterToLsUncachedClient().UpdateDeployItemStatus(ctx, writeId, current); err != nil {
 		return lserrors.NewWrappedError(err, op, "UpdateDeployItemStatus", err.Error())
 	}
 
+	// keep the given deploy item up to date, so that the progress of the execution contains the triggered item
+	*di = *current
 	return nil
 }
 func (o *Operation) skipUninstall(ctx context.Context, di *lsv1alpha1.DeployItem) (bool, lserrors.LsError) {
@@ -281,12 +408,14 @@ func (o *Operation) getDeployItems(ctx context.Context,
 	return executionItems, orphaned, nil
 }
 
-// CreateOrUpdateExportReference creates or updates a dataobject from a object reference
-func (o *Operation) CreateOrUpdateExportReference(ctx context.Context, values interface{}) error {
+// CreateOrUpdateExportReference creates or updates a dataobject from a object reference.
+// The lineage records the execution and the deploy items that contributed to the exported values.
+func (o *Operation) CreateOrUpdateExportReference(ctx context.Context, values interface{}, lineage dataobjects.Lineage) error {
 	do := dataobjects.New().
 		SetNamespace(o.exec.Namespace).
 		SetSource(lsv1alpha1helper.DataObjectSourceFromExecution(o.exec)).
 		SetContext(lsv1alpha1helper.DataObjectSourceFromExecution(o.exec)).
+		SetLineage(lineage).
 		SetData(values)
 
 	raw, err := do.Build()
@@ -298,6 +427,7 @@ func (o *Operation) CreateOrUpdateExportReference(ctx context.Context, values in
 		if err := controllerutil.SetOwnerReference(o.exec, raw, api.LandscaperScheme); err != nil {
 			return err
 		}
+		o.setInstallationOwnerReference(raw)
 		return do.Apply(raw)
 	}); err != nil {
 		return err
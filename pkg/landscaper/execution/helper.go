@@ -26,8 +26,14 @@ func ApplyDeployItemTemplate(di *lsv1alpha1.DeployItem, tmpl lsv1alpha1.DeployIt
 	di.Spec.Target = tmpl.Target
 	di.Spec.Configuration = tmpl.Configuration
 	di.Spec.Timeout = tmpl.Timeout
+	di.Spec.PickupTimeout = tmpl.PickupTimeout
+	di.Spec.AbortTimeout = tmpl.AbortTimeout
 	di.Spec.UpdateOnChangeOnly = tmpl.UpdateOnChangeOnly
+	di.Spec.ConsumedImports = tmpl.ConsumedImports
+	di.Spec.ConsumedImportsHash = tmpl.ConsumedImportsHash
 	di.Spec.OnDelete = tmpl.OnDelete
+	di.Spec.ExclusionWindows = tmpl.ExclusionWindows
+	di.Spec.RetryPolicy = tmpl.RetryPolicy
 	for k, v := range tmpl.Labels {
 		kutil.SetMetaDataLabel(&di.ObjectMeta, k, v)
 	}
@@ -101,9 +107,16 @@ func (o *Operation) ListManagedDeployItems(ctx context.Context, readID read_writ
 // getExecutionItems creates an internal representation for all execution items.
 // It also returns all removed deploy items that are not defined by the execution anymore.
 func (o *Operation) getExecutionItems(items []*lsv1alpha1.DeployItem) ([]*executionItem, []*lsv1alpha1.DeployItem) {
-	execItems := make([]*executionItem, len(o.exec.Spec.DeployItems))
+	return matchExecutionItems(o.exec.Spec.DeployItems, items)
+}
+
+// matchExecutionItems assigns the existing deploy items to the deploy item templates.
+// A deploy item is primarily matched by its execution item name.
+// Deploy items of renamed execution items are adopted if they have the same identity label as the template.
+func matchExecutionItems(templates []lsv1alpha1.DeployItemTemplate, items []*lsv1alpha1.DeployItem) ([]*executionItem, []*lsv1alpha1.DeployItem) {
+	execItems := make([]*executionItem, len(templates))
 	managed := sets.NewInt()
-	for i, di := range o.exec.Spec.DeployItems {
+	for i, di := range templates {
 		execItem := executionItem{
 			Info: *di.DeepCopy(),
 		}
@@ -113,6 +126,17 @@ func (o *Operation) getExecutionItems(items []*lsv1alpha1.DeployItem) ([]*execut
 		}
 		execItems[i] = &execItem
 	}
+
+	for _, execItem := range execItems {
+		if execItem.DeployItem != nil {
+			continue
+		}
+		if j, found := getAdoptableDeployItemIndex(items, managed, execItem.Info); found {
+			managed.Insert(j)
+			execItem.DeployItem = items[j].DeepCopy()
+		}
+	}
+
 	orphaned := make([]*lsv1alpha1.DeployItem, 0)
 	for i, item := range items {
 		if !managed.Has(i) {
@@ -121,3 +145,24 @@ func (o *Operation) getExecutionItems(items []*lsv1alpha1.DeployItem) ([]*execut
 	}
 	return execItems, orphaned
 }
+
+// getAdoptableDeployItemIndex returns the index of a not yet matched deploy item
+// that has the same identity label and type as the given template.
+// Deploy items that are already being deleted are not adopted.
+func getAdoptableDeployItemIndex(items []*lsv1alpha1.DeployItem, managed sets.Int, tmpl lsv1alpha1.DeployItemTemplate) (int, bool) {
+	identity := tmpl.Labels[lsv1alpha1.ExecutionIdentityLabel]
+	if len(identity) == 0 {
+		return -1, false
+	}
+
+	for i, item := range items {
+		if managed.Has(i) || !item.DeletionTimestamp.IsZero() {
+			continue
+		}
+		if item.Labels[lsv1alpha1.ExecutionIdentityLabel] == identity && item.Spec.Type == tmpl.Type {
+			return i, true
+		}
+	}
+
+	return -1, false
+}
@@ -0,0 +1,89 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package execution
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	lsv1alpha1 "github.com/gardener/landscaper/apis/core/v1alpha1"
+)
+
+var _ = Describe("Execution Item Matching", func() {
+
+	buildTemplate := func(name, identity string) lsv1alpha1.DeployItemTemplate {
+		tmpl := lsv1alpha1.DeployItemTemplate{
+			Name: name,
+			Type: "test-type",
+		}
+		if len(identity) != 0 {
+			tmpl.Labels = map[string]string{lsv1alpha1.ExecutionIdentityLabel: identity}
+		}
+		return tmpl
+	}
+
+	buildDeployItem := func(objName, name, identity string) *lsv1alpha1.DeployItem {
+		di := &lsv1alpha1.DeployItem{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:   objName,
+				Labels: map[string]string{lsv1alpha1.ExecutionManagedNameLabel: name},
+			},
+			Spec: lsv1alpha1.DeployItemSpec{Type: "test-type"},
+		}
+		if len(identity) != 0 {
+			di.Labels[lsv1alpha1.ExecutionIdentityLabel] = identity
+		}
+		return di
+	}
+
+	It("should match deploy items by their execution item name", func() {
+		execItems, orphaned := matchExecutionItems(
+			[]lsv1alpha1.DeployItemTemplate{buildTemplate("a", ""), buildTemplate("b", "")},
+			[]*lsv1alpha1.DeployItem{buildDeployItem("di-b", "b", ""), buildDeployItem("di-c", "c", "")})
+
+		Expect(execItems).To(HaveLen(2))
+		Expect(execItems[0].DeployItem).To(BeNil())
+		Expect(execItems[1].DeployItem.Name).To(Equal("di-b"))
+		Expect(orphaned).To(HaveLen(1))
+		Expect(orphaned[0].Name).To(Equal("di-c"))
+	})
+
+	It("should adopt the deploy item of a renamed execution item with the same identity", func() {
+		execItems, orphaned := matchExecutionItems(
+			[]lsv1alpha1.DeployItemTemplate{buildTemplate("new", "app")},
+			[]*lsv1alpha1.DeployItem{buildDeployItem("di-old", "old", "app")})
+
+		Expect(execItems).To(HaveLen(1))
+		Expect(execItems[0].DeployItem).ToNot(BeNil())
+		Expect(execItems[0].DeployItem.Name).To(Equal("di-old"))
+		Expect(orphaned).To(BeEmpty())
+	})
+
+	It("should prefer a deploy item with the same execution item name over an adoption", func() {
+		execItems, orphaned := matchExecutionItems(
+			[]lsv1alpha1.DeployItemTemplate{buildTemplate("a", "app"), buildTemplate("b", "app")},
+			[]*lsv1alpha1.DeployItem{buildDeployItem("di-old", "old", "app"), buildDeployItem("di-a", "a", "app")})
+
+		Expect(execItems[0].DeployItem.Name).To(Equal("di-a"))
+		Expect(execItems[1].DeployItem.Name).To(Equal("di-old"))
+		Expect(orphaned).To(BeEmpty())
+	})
+
+	It("should not adopt deploy items of a different type or that are being deleted", func() {
+		otherType := buildDeployItem("di-other", "other", "app")
+		otherType.Spec.Type = "other-type"
+		deleted := buildDeployItem("di-deleted", "deleted", "app")
+		now := metav1.Now()
+		deleted.DeletionTimestamp = &now
+
+		execItems, orphaned := matchExecutionItems(
+			[]lsv1alpha1.DeployItemTemplate{buildTemplate("new", "app")},
+			[]*lsv1alpha1.DeployItem{otherType, deleted})
+
+		Expect(execItems[0].DeployItem).To(BeNil())
+		Expect(orphaned).To(HaveLen(2))
+	})
+})
@@ -0,0 +1,52 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package execution
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/gardener/landscaper/pkg/utils"
+)
+
+// setInstallationOwnerReference adds an owner reference to the installation of the execution to the given object,
+// if the owner reference garbage collection is enabled.
+// The reference is no controller reference, so that the execution remains the controller of its deploy items.
+func (o *Operation) setInstallationOwnerReference(obj metav1.Object) {
+	if !o.ownerReferenceGC {
+		return
+	}
+	if ref, ok := installationOwnerReference(o.exec); ok {
+		obj.SetOwnerReferences(upsertOwnerReference(obj.GetOwnerReferences(), ref))
+	}
+}
+
+// installationOwnerReference returns an owner reference to the installation that controls the given object.
+func installationOwnerReference(obj metav1.Object) (metav1.OwnerReference, bool) {
+	controllerRef := metav1.GetControllerOf(obj)
+	if controllerRef == nil || controllerRef.Kind != utils.InstallationKind {
+		return metav1.OwnerReference{}, false
+	}
+
+	return metav1.OwnerReference{
+		APIVersion: controllerRef.APIVersion,
+		Kind:       controllerRef.Kind,
+		Name:       controllerRef.Name,
+		UID:        controllerRef.UID,
+	}, true
+}
+
+// upsertOwnerReference adds the given owner reference to the list of references
+// or replaces an existing reference to the same owner.
+func upsertOwnerReference(refs []metav1.OwnerReference, ref metav1.OwnerReference) []metav1.OwnerReference {
+	for i := range refs {
+		if refs[i].UID == ref.UID {
+			refs[i].APIVersion = ref.APIVersion
+			refs[i].Kind = ref.Kind
+			refs[i].Name = ref.Name
+			return refs
+		}
+	}
+	return append(refs, ref)
+}
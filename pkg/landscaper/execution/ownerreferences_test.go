@@ -0,0 +1,73 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package execution
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+
+	lsv1alpha1 "github.com/gardener/landscaper/apis/core/v1alpha1"
+)
+
+var _ = Describe("Owner References", func() {
+
+	instRef := metav1.OwnerReference{
+		APIVersion: "landscaper.gardener.cloud/v1alpha1",
+		Kind:       "Installation",
+		Name:       "inst",
+		UID:        "inst-uid",
+		Controller: ptr.To(true),
+	}
+	execRef := metav1.OwnerReference{
+		APIVersion: "landscaper.gardener.cloud/v1alpha1",
+		Kind:       "Execution",
+		Name:       "exec",
+		UID:        "exec-uid",
+		Controller: ptr.To(true),
+	}
+
+	newOperation := func(enabled bool) *Operation {
+		exec := &lsv1alpha1.Execution{}
+		exec.OwnerReferences = []metav1.OwnerReference{instRef}
+		o := NewOperation(nil, exec, false)
+		o.SetOwnerReferenceGarbageCollection(enabled)
+		return o
+	}
+
+	It("should add an owner reference to the installation of the execution", func() {
+		di := &lsv1alpha1.DeployItem{}
+		di.OwnerReferences = []metav1.OwnerReference{execRef}
+
+		newOperation(true).setInstallationOwnerReference(di)
+		Expect(di.OwnerReferences).To(ConsistOf(execRef, metav1.OwnerReference{
+			APIVersion: instRef.APIVersion,
+			Kind:       instRef.Kind,
+			Name:       instRef.Name,
+			UID:        instRef.UID,
+		}))
+		Expect(metav1.GetControllerOf(di)).To(Equal(&execRef))
+
+		// the reference is not added twice
+		newOperation(true).setInstallationOwnerReference(di)
+		Expect(di.OwnerReferences).To(HaveLen(2))
+	})
+
+	It("should not add an owner reference if the garbage collection is disabled", func() {
+		di := &lsv1alpha1.DeployItem{}
+		newOperation(false).setInstallationOwnerReference(di)
+		Expect(di.OwnerReferences).To(BeEmpty())
+	})
+
+	It("should not add an owner reference if the execution is not controlled by an installation", func() {
+		o := newOperation(true)
+		o.exec.OwnerReferences = nil
+
+		do := &lsv1alpha1.DataObject{}
+		o.setInstallationOwnerReference(do)
+		Expect(do.OwnerReferences).To(BeEmpty())
+	})
+})
@@ -0,0 +1,57 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package execution
+
+import (
+	"sort"
+
+	lsv1alpha1 "github.com/gardener/landscaper/apis/core/v1alpha1"
+)
+
+// Progress computes the progress of the current job of the execution from the classification of its deploy items.
+// Runnable, pending and deferred items are counted as not yet started.
+func (c *DeployItemClassification) Progress(executionJobID string) *lsv1alpha1.ExecutionProgress {
+	progress := &lsv1alpha1.ExecutionProgress{
+		Completed: int32(len(c.succeededItems)),
+		Failed:    int32(len(c.failedItems)),
+		Running:   int32(len(c.runningItems)),
+	}
+
+	for _, items := range [][]*executionItem{c.runningItems, c.succeededItems, c.failedItems, c.runnableItems, c.pendingItems, c.deferredItems} {
+		for _, item := range items {
+			progress.DeployItems = append(progress.DeployItems, newDeployItemProgress(executionJobID, item))
+		}
+	}
+	progress.Total = int32(len(progress.DeployItems))
+
+	sort.Slice(progress.DeployItems, func(i, j int) bool {
+		return progress.DeployItems[i].Name < progress.DeployItems[j].Name
+	})
+	return progress
+}
+
+func newDeployItemProgress(executionJobID string, item *executionItem) lsv1alpha1.DeployItemProgress {
+	itemProgress := lsv1alpha1.DeployItemProgress{
+		Name: item.Info.Name,
+	}
+
+	di := item.DeployItem
+	if di == nil {
+		return itemProgress
+	}
+	if len(itemProgress.Name) == 0 {
+		itemProgress.Name = di.Name
+	}
+	if di.Status.GetJobID() != executionJobID {
+		return itemProgress
+	}
+
+	itemProgress.Phase = di.Status.Phase
+	itemProgress.StartTime = di.Status.JobIDGenerationTime
+	if di.Status.JobIDFinished == executionJobID && di.Status.TransitionTimes != nil {
+		itemProgress.FinishedTime = di.Status.TransitionTimes.FinishedTime
+	}
+	return itemProgress
+}
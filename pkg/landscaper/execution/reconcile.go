@@ -20,6 +20,8 @@ import (
 	lserrors "github.com/gardener/landscaper/apis/errors"
 	kutil "github.com/gardener/landscaper/controller-utils/pkg/kubernetes"
 	"github.com/gardener/landscaper/controller-utils/pkg/landscaper/targetresolver/secret"
+	"github.com/gardener/landscaper/pkg/landscaper/dataobjects"
+	"github.com/gardener/landscaper/pkg/landscaper/deployerregistrations"
 	"github.com/gardener/landscaper/pkg/utils/clusters"
 	"github.com/gardener/landscaper/pkg/utils/read_write_layer"
 )
@@ -54,7 +56,14 @@ func (o *Operation) updateDeployItem(ctx context.Context, item executionItem) (*
 			controllerutil.AddFinalizer(item.DeployItem, lsv1alpha1.LandscaperFinalizer)
 		}
 		ApplyDeployItemTemplate(item.DeployItem, item.Info)
+		if item.DeployItem.Spec.Timeout == nil && o.defaultTimeout != nil {
+			item.DeployItem.Spec.Timeout = o.defaultTimeout.DeepCopy()
+		}
 		kutil.SetMetaDataLabel(&item.DeployItem.ObjectMeta, lsv1alpha1.ExecutionManagedByLabel, o.exec.Name)
+		if ref, ok := installationOwnerReference(o.exec); ok {
+			metav1.SetMetaDataAnnotation(&item.DeployItem.ObjectMeta, lsv1alpha1.ManagedByInstallationAnnotation,
+				kutil.ObjectKey(ref.Name, o.exec.Namespace).String())
+		}
 		item.DeployItem.Spec.Context = o.exec.Spec.Context
 		if len(clusterName) > 0 {
 			metav1.SetMetaDataAnnotation(&item.DeployItem.ObjectMeta, clusterNameAnnotation, clusterName)
@@ -66,6 +75,7 @@ func (o *Operation) updateDeployItem(ctx context.Context, item executionItem) (*
 		}
 
 		o.Scheme().Default(item.DeployItem)
+		o.setInstallationOwnerReference(item.DeployItem)
 		return controllerutil.SetControllerReference(o.exec, item.DeployItem, o.Scheme())
 	}); err != nil {
 		msg := fmt.Sprintf("error while creating deployitem %q", item.Info.Name)
@@ -81,6 +91,38 @@ func (o *Operation) updateDeployItem(ctx context.Context, item executionItem) (*
 	}, nil
 }
 
+// checkDeployerRegistration checks that the deployer which is registered for the type of a deployitem supports
+// the type of its target. Deployitems without target or of a type without registered deployer are not checked.
+func (o *Operation) checkDeployerRegistration(ctx context.Context, info lsv1alpha1.DeployItemTemplate) lserrors.LsError {
+	op := "checkDeployerRegistration"
+
+	if info.Target == nil {
+		return nil
+	}
+
+	reg, err := deployerregistrations.GetDeployerRegistrationForType(ctx, o.LsUncachedClient(), info.Type)
+	if err != nil {
+		return lserrors.NewWrappedError(err, op, "GetDeployerRegistration", err.Error())
+	}
+	if reg == nil {
+		return nil
+	}
+
+	target := &lsv1alpha1.Target{}
+	targetKey := client.ObjectKey{Namespace: o.exec.Namespace, Name: info.Target.Name}
+	if err := read_write_layer.GetTarget(ctx, o.LsUncachedClient(), targetKey, target, read_write_layer.R000165); err != nil {
+		msg := fmt.Sprintf("unable to fetch target %s/%s", o.exec.Namespace, info.Target.Name)
+		return lserrors.NewWrappedError(err, op, msg, err.Error())
+	}
+
+	if !deployerregistrations.SupportsTargetType(reg, target.Spec.Type) {
+		msg := fmt.Sprintf("deployer registration %s does not support the type %s of target %s of deployitem %s",
+			reg.Name, target.Spec.Type, info.Target.Name, info.Name)
+		return lserrors.NewError(op, "UnsupportedTargetType", msg, lsv1alpha1.ErrorConfigurationProblem)
+	}
+	return nil
+}
+
 // getShootClusterName determines for a deployitem whether the "skipUninstallIfClusterRemoved" feature is enabled,
 // and whether its target is managed by the "targetsync" mechanism. In this case, the name of the Gardener shoot cluster
 // is returned, otherwise an empty string. (For the "skipUninstallIfClusterRemoved" feature, a deployitem is
@@ -131,15 +173,19 @@ func (o *Operation) CollectAndUpdateExportsNew(ctx context.Context) lserrors.LsE
 	}
 
 	values := make(map[string]interface{})
+	lineage := dataobjects.Lineage{{Kind: dataobjects.LineageSourceExecution, Name: o.exec.Name}}
 	for _, item := range items {
 		data, err := o.addExports(ctx, item.DeployItem)
 		if err != nil {
 			return lserrors.NewWrappedError(err, op, "AddExports", err.Error())
 		}
 		values[item.Info.Name] = data
+		if data != nil {
+			lineage = lineage.Add(dataobjects.LineageSource{Kind: dataobjects.LineageSourceDeployItem, Name: item.DeployItem.Name})
+		}
 	}
 
-	if err := o.CreateOrUpdateExportReference(ctx, values); err != nil {
+	if err := o.CreateOrUpdateExportReference(ctx, values, lineage); err != nil {
 		return lserrors.NewWrappedError(err, op, "CreateOrUpdateExportReference", err.Error())
 	}
 
@@ -0,0 +1,155 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package execution
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	lsv1alpha1 "github.com/gardener/landscaper/apis/core/v1alpha1"
+	lserrors "github.com/gardener/landscaper/apis/errors"
+	kutil "github.com/gardener/landscaper/controller-utils/pkg/kubernetes"
+	"github.com/gardener/landscaper/pkg/utils/read_write_layer"
+)
+
+// SetPartialRedeployment defines whether a new job of the execution only redeploys the deploy items
+// whose template has changed, whose dependencies have been redeployed or whose last deployment has not succeeded.
+func (o *Operation) SetPartialRedeployment(enabled bool) {
+	o.partialRedeployment = enabled
+}
+
+// deployItemHash returns the hash of the template of an execution item and the spec of its target,
+// so that a changed target also leads to a redeployment.
+func (o *Operation) deployItemHash(ctx context.Context, item *executionItem) (string, lserrors.LsError) {
+	op := "DeployItemHash"
+
+	var targetSpec *lsv1alpha1.TargetSpec
+	if item.Info.Target != nil && len(item.Info.Target.Name) != 0 {
+		key := item.Info.Target.NamespacedName()
+		if len(key.Namespace) == 0 {
+			key.Namespace = o.exec.Namespace
+		}
+		target := &lsv1alpha1.Target{}
+		if err := read_write_layer.GetTarget(ctx, o.LsUncachedClient(), key, target, read_write_layer.R000164); err != nil {
+			if !apierrors.IsNotFound(err) {
+				return "", lserrors.NewWrappedError(err, op, "GetTarget", err.Error())
+			}
+		} else {
+			targetSpec = &target.Spec
+		}
+	}
+
+	hash, err := deployItemTemplateHash(item.Info, targetSpec)
+	if err != nil {
+		return "", lserrors.NewWrappedError(err, op, "ComputeHash", err.Error())
+	}
+	return hash, nil
+}
+
+// deployItemTemplateHash returns the hash of a deploy item template and the spec of its target.
+func deployItemTemplateHash(tmpl lsv1alpha1.DeployItemTemplate, targetSpec *lsv1alpha1.TargetSpec) (string, error) {
+	data, err := json.Marshal(struct {
+		Template   lsv1alpha1.DeployItemTemplate `json:"template"`
+		TargetSpec *lsv1alpha1.TargetSpec        `json:"targetSpec,omitempty"`
+	}{
+		Template:   tmpl,
+		TargetSpec: targetSpec,
+	})
+	if err != nil {
+		return "", err
+	}
+	sum := sha256.Sum256(data)
+	return hex.EncodeToString(sum[:]), nil
+}
+
+// getDeployItemHash returns the recorded hash of the deploy item template with the given name.
+func getDeployItemHash(hashes []lsv1alpha1.DeployItemHash, name string) (lsv1alpha1.DeployItemHash, bool) {
+	for _, h := range hashes {
+		if h.Name == name {
+			return h, true
+		}
+	}
+	return lsv1alpha1.DeployItemHash{}, false
+}
+
+// setDeployItemHash records the hash of a deploy item template, replacing a previous record of the same template.
+func setDeployItemHash(hashes []lsv1alpha1.DeployItemHash, hash lsv1alpha1.DeployItemHash) []lsv1alpha1.DeployItemHash {
+	for i := range hashes {
+		if hashes[i].Name == hash.Name {
+			hashes[i] = hash
+			return hashes
+		}
+	}
+	return append(hashes, hash)
+}
+
+// pruneDeployItemHashes removes the records of templates that are no longer part of the execution.
+func pruneDeployItemHashes(hashes []lsv1alpha1.DeployItemHash, templates lsv1alpha1.DeployItemTemplateList) []lsv1alpha1.DeployItemHash {
+	names := map[string]bool{}
+	for _, tmpl := range templates {
+		names[tmpl.Name] = true
+	}
+	var pruned []lsv1alpha1.DeployItemHash
+	for _, h := range hashes {
+		if names[h.Name] {
+			pruned = append(pruned, h)
+		}
+	}
+	return pruned
+}
+
+// isUnchanged returns whether the deploy item of an execution item does not have to be redeployed in the given job.
+// This is the case if the last deployment of the deploy item has succeeded with a template of the same hash,
+// and none of the deploy items it depends on has been redeployed in the given job.
+func isUnchanged(jobID string, item *executionItem, hash string, hashes []lsv1alpha1.DeployItemHash) bool {
+	di := item.DeployItem
+	if di == nil || di.DeletionTimestamp != nil {
+		return false
+	}
+	if di.Status.Phase != lsv1alpha1.DeployItemPhases.Succeeded || di.Status.GetJobID() != di.Status.JobIDFinished ||
+		di.Generation != di.Status.ObservedGeneration {
+		return false
+	}
+
+	recorded, ok := getDeployItemHash(hashes, item.Info.Name)
+	if !ok || recorded.Hash != hash {
+		return false
+	}
+
+	for _, dependency := range item.Info.DependsOn {
+		recordedDependency, ok := getDeployItemHash(hashes, dependency)
+		if !ok || recordedDependency.JobID == jobID {
+			return false
+		}
+	}
+	return true
+}
+
+// skipDeployItem finishes the current job of the execution for an unchanged deploy item without redeploying it.
+func (o *Operation) skipDeployItem(ctx context.Context, di *lsv1alpha1.DeployItem) lserrors.LsError {
+	op := "SkipDeployItem"
+
+	key := kutil.ObjectKeyFromObject(di)
+	current := &lsv1alpha1.DeployItem{}
+	if err := read_write_layer.GetDeployItem(ctx, o.LsUncachedClient(), key, current, read_write_layer.R000163); err != nil {
+		return lserrors.NewWrappedError(err, op, "GetDeployItem", err.Error())
+	}
+
+	current.Status.SetJobID(o.exec.Status.JobID)
+	current.Status.JobIDFinished = o.exec.Status.JobID
+	now := metav1.Now()
+	current.Status.JobIDGenerationTime = &now
+	if err := o.WriterToLsUncachedClient().UpdateDeployItemStatus(ctx, read_write_layer.W000179, current); err != nil {
+		return lserrors.NewWrappedError(err, op, "UpdateDeployItemStatus", err.Error())
+	}
+
+	*di = *current
+	return nil
+}
@@ -0,0 +1,158 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package execution
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	lsv1alpha1 "github.com/gardener/landscaper/apis/core/v1alpha1"
+)
+
+var _ = Describe("Partial redeployment", func() {
+
+	const jobID = "job-2"
+
+	buildExecutionItem := func(name string, dependsOn ...string) *executionItem {
+		di := &lsv1alpha1.DeployItem{}
+		di.Name = name
+		di.Generation = 1
+		di.Status.ObservedGeneration = 1
+		di.Status.Phase = lsv1alpha1.DeployItemPhases.Succeeded
+		di.Status.SetJobID("job-1")
+		di.Status.JobIDFinished = "job-1"
+		return &executionItem{
+			Info: lsv1alpha1.DeployItemTemplate{
+				Name:          name,
+				DependsOn:     dependsOn,
+				Configuration: &runtime.RawExtension{Raw: []byte(`{"key":"val"}`)},
+			},
+			DeployItem: di,
+		}
+	}
+
+	hashOf := func(item *executionItem) string {
+		hash, err := deployItemTemplateHash(item.Info, nil)
+		Expect(err).ToNot(HaveOccurred())
+		return hash
+	}
+
+	Context("hash", func() {
+		It("should compute the same hash for equal templates", func() {
+			Expect(hashOf(buildExecutionItem("a"))).To(Equal(hashOf(buildExecutionItem("a"))))
+		})
+
+		It("should compute a different hash if the configuration changes", func() {
+			item := buildExecutionItem("a")
+			changed := buildExecutionItem("a")
+			changed.Info.Configuration = &runtime.RawExtension{Raw: []byte(`{"key":"other"}`)}
+			Expect(hashOf(item)).ToNot(Equal(hashOf(changed)))
+		})
+
+		It("should compute a different hash if the consumed imports change", func() {
+			item := buildExecutionItem("a")
+			changed := buildExecutionItem("a")
+			changed.Info.ConsumedImports = []string{"imp"}
+			Expect(hashOf(item)).ToNot(Equal(hashOf(changed)))
+		})
+
+		It("should compute a different hash if the hash of the consumed imports changes", func() {
+			item := buildExecutionItem("a")
+			item.Info.ConsumedImportsHash = "abc"
+			changed := buildExecutionItem("a")
+			changed.Info.ConsumedImportsHash = "def"
+			Expect(hashOf(item)).ToNot(Equal(hashOf(changed)))
+		})
+
+		It("should compute a different hash if the target changes", func() {
+			item := buildExecutionItem("a")
+			hash1, err := deployItemTemplateHash(item.Info, &lsv1alpha1.TargetSpec{Type: "landscaper.gardener.cloud/kubernetes-cluster"})
+			Expect(err).ToNot(HaveOccurred())
+			hash2, err := deployItemTemplateHash(item.Info, &lsv1alpha1.TargetSpec{Type: "landscaper.gardener.cloud/mock"})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(hash1).ToNot(Equal(hash2))
+			Expect(hash1).ToNot(Equal(hashOf(item)))
+		})
+	})
+
+	Context("records", func() {
+		It("should replace the record of a template and append new records", func() {
+			hashes := setDeployItemHash(nil, lsv1alpha1.DeployItemHash{Name: "a", Hash: "1", JobID: "job-1"})
+			hashes = setDeployItemHash(hashes, lsv1alpha1.DeployItemHash{Name: "b", Hash: "2", JobID: "job-1"})
+			hashes = setDeployItemHash(hashes, lsv1alpha1.DeployItemHash{Name: "a", Hash: "3", JobID: "job-2"})
+			Expect(hashes).To(ConsistOf(
+				lsv1alpha1.DeployItemHash{Name: "a", Hash: "3", JobID: "job-2"},
+				lsv1alpha1.DeployItemHash{Name: "b", Hash: "2", JobID: "job-1"},
+			))
+		})
+
+		It("should remove the records of templates that are no longer part of the execution", func() {
+			hashes := []lsv1alpha1.DeployItemHash{{Name: "a"}, {Name: "b"}}
+			pruned := pruneDeployItemHashes(hashes, lsv1alpha1.DeployItemTemplateList{{Name: "b"}, {Name: "c"}})
+			Expect(pruned).To(ConsistOf(lsv1alpha1.DeployItemHash{Name: "b"}))
+		})
+	})
+
+	Context("unchanged", func() {
+		It("should skip a succeeded deploy item with the same template", func() {
+			item := buildExecutionItem("a")
+			hashes := []lsv1alpha1.DeployItemHash{{Name: "a", Hash: hashOf(item), JobID: "job-1"}}
+			Expect(isUnchanged(jobID, item, hashOf(item), hashes)).To(BeTrue())
+		})
+
+		It("should redeploy a deploy item without recorded hash", func() {
+			item := buildExecutionItem("a")
+			Expect(isUnchanged(jobID, item, hashOf(item), nil)).To(BeFalse())
+		})
+
+		It("should redeploy a deploy item whose template has changed", func() {
+			item := buildExecutionItem("a")
+			hashes := []lsv1alpha1.DeployItemHash{{Name: "a", Hash: "outdated", JobID: "job-1"}}
+			Expect(isUnchanged(jobID, item, hashOf(item), hashes)).To(BeFalse())
+		})
+
+		It("should redeploy a deploy item whose last deployment has not succeeded", func() {
+			item := buildExecutionItem("a")
+			item.DeployItem.Status.Phase = lsv1alpha1.DeployItemPhases.Failed
+			hashes := []lsv1alpha1.DeployItemHash{{Name: "a", Hash: hashOf(item), JobID: "job-1"}}
+			Expect(isUnchanged(jobID, item, hashOf(item), hashes)).To(BeFalse())
+		})
+
+		It("should redeploy a deploy item whose spec has not been observed", func() {
+			item := buildExecutionItem("a")
+			item.DeployItem.Generation = 2
+			hashes := []lsv1alpha1.DeployItemHash{{Name: "a", Hash: hashOf(item), JobID: "job-1"}}
+			Expect(isUnchanged(jobID, item, hashOf(item), hashes)).To(BeFalse())
+		})
+
+		It("should redeploy a deploy item that is being deleted", func() {
+			item := buildExecutionItem("a")
+			now := metav1.Now()
+			item.DeployItem.DeletionTimestamp = &now
+			hashes := []lsv1alpha1.DeployItemHash{{Name: "a", Hash: hashOf(item), JobID: "job-1"}}
+			Expect(isUnchanged(jobID, item, hashOf(item), hashes)).To(BeFalse())
+		})
+
+		It("should redeploy a deploy item whose dependency has been redeployed in the current job", func() {
+			item := buildExecutionItem("b", "a")
+			hashes := []lsv1alpha1.DeployItemHash{
+				{Name: "a", Hash: "a", JobID: jobID},
+				{Name: "b", Hash: hashOf(item), JobID: "job-1"},
+			}
+			Expect(isUnchanged(jobID, item, hashOf(item), hashes)).To(BeFalse())
+		})
+
+		It("should skip a deploy item whose dependency has been skipped in the current job", func() {
+			item := buildExecutionItem("b", "a")
+			hashes := []lsv1alpha1.DeployItemHash{
+				{Name: "a", Hash: "a", JobID: "job-1"},
+				{Name: "b", Hash: hashOf(item), JobID: "job-1"},
+			}
+			Expect(isUnchanged(jobID, item, hashOf(item), hashes)).To(BeTrue())
+		})
+	})
+})
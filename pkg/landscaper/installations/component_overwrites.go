@@ -0,0 +1,51 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package installations
+
+import (
+	"context"
+	"fmt"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/gardener/landscaper/apis/config"
+	lsv1alpha1 "github.com/gardener/landscaper/apis/core/v1alpha1"
+	lserrors "github.com/gardener/landscaper/apis/errors"
+)
+
+// globalComponentOverwrites are the references to the ComponentVersionOverwrites resources
+// that are applied to the component references of all installations.
+var globalComponentOverwrites []client.ObjectKey
+
+// SetGlobalComponentOverwrites sets the ComponentVersionOverwrites resources that are applied to the component
+// references of all installations.
+func SetGlobalComponentOverwrites(cfg *config.ComponentOverwritesConfiguration) {
+	globalComponentOverwrites = nil
+	if cfg == nil {
+		return
+	}
+	for _, ref := range cfg.References {
+		globalComponentOverwrites = append(globalComponentOverwrites, client.ObjectKey{Name: ref.Name, Namespace: ref.Namespace})
+	}
+}
+
+// getGlobalComponentOverwrites returns the overwrites of the globally configured ComponentVersionOverwrites resources
+// in the configured order.
+func getGlobalComponentOverwrites(ctx context.Context, kubeClient client.Client) (lsv1alpha1.ComponentVersionOverwriteList, error) {
+	var overwrites lsv1alpha1.ComponentVersionOverwriteList
+	for _, key := range globalComponentOverwrites {
+		cvo := &lsv1alpha1.ComponentVersionOverwrites{}
+		if err := kubeClient.Get(ctx, key, cvo); err != nil {
+			if apierrors.IsNotFound(err) {
+				return nil, lserrors.NewWrappedError(err, "ComponentVersionOverwrites", "GetGlobalComponentVersionOverwrites",
+					fmt.Sprintf("the landscaper configuration references ComponentVersionOverwrites resource '%s', which cannot be found: %s", key.String(), err.Error()))
+			}
+			return nil, lserrors.NewWrappedError(err, "ComponentVersionOverwrites", "GetGlobalComponentVersionOverwrites", err.Error())
+		}
+		overwrites = append(overwrites, cvo.Overwrites...)
+	}
+	return overwrites, nil
+}
@@ -273,9 +273,20 @@ func GetExternalContext(ctx context.Context, kubeClient client.Client, inst *lsv
 		}
 	}
 
-	if cvo != nil {
-		overwriter = componentoverwrites.NewSubstitutions(cvo.Overwrites)
-		logger.Debug("Found ComponentVersionOverwrites for context", "context", inst.Spec.Context, lc.KeyResource, lsCtx.ComponentVersionOverwritesReference, lc.KeyResourceKind, "ComponentVersionOverwrites")
+	globalOverwrites, err := getGlobalComponentOverwrites(ctx, kubeClient)
+	if err != nil {
+		return ExternalContext{}, err
+	}
+
+	if cvo != nil || len(globalOverwrites) != 0 {
+		// the overwrites of the context take precedence over the global overwrites
+		var overwrites lsv1alpha1.ComponentVersionOverwriteList
+		if cvo != nil {
+			overwrites = append(overwrites, cvo.Overwrites...)
+			logger.Debug("Found ComponentVersionOverwrites for context", "context", inst.Spec.Context, lc.KeyResource, lsCtx.ComponentVersionOverwritesReference, lc.KeyResourceKind, "ComponentVersionOverwrites")
+		}
+		overwrites = append(overwrites, globalOverwrites...)
+		overwriter = componentoverwrites.NewSubstitutions(overwrites)
 	}
 
 	cdRef := GetReferenceFromComponentDescriptorDefinition(inst.Spec.ComponentDescriptor)
@@ -21,6 +21,7 @@ import (
 	"k8s.io/client-go/tools/record"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
+	"github.com/gardener/landscaper/apis/core"
 	lsv1alpha1 "github.com/gardener/landscaper/apis/core/v1alpha1"
 	"github.com/gardener/landscaper/pkg/api"
 	"github.com/gardener/landscaper/pkg/components/cnudie/componentresolvers"
@@ -290,6 +291,68 @@ var _ = Describe("Context", func() {
 				Expect(cdv2.UnstructuredTypesEqual(inst.Spec.ComponentDescriptor.Reference.RepositoryContext, repoCtx)).To(BeTrue())
 				Expect(cdv2.UnstructuredTypesEqual(extCtx.RepositoryContext, repoCtx)).To(BeTrue())
 			})
+
+			It("should apply the global overwrites after the overwrites of the context", func() {
+				state, err := testenv.InitState(ctx)
+				Expect(err).ToNot(HaveOccurred())
+
+				lsCtx := &lsv1alpha1.Context{}
+				lsCtx.RepositoryContext = testutils.ExampleRepositoryContext()
+				lsCtx.Name = "test"
+				lsCtx.Namespace = state.Namespace
+				lsCtx.ComponentVersionOverwritesReference = lsCtx.Name
+				Expect(state.Create(ctx, lsCtx)).To(Succeed())
+
+				inst := &lsv1alpha1.Installation{}
+				inst.Namespace = state.Namespace
+				inst.Spec.Context = "test"
+				inst.Spec.ComponentDescriptor = &lsv1alpha1.ComponentDescriptorDefinition{
+					Reference: &lsv1alpha1.ComponentDescriptorReference{
+						ComponentName: "example.com/a",
+						Version:       "v1.0.0",
+					},
+				}
+
+				cvo := &lsv1alpha1.ComponentVersionOverwrites{
+					Overwrites: lsv1alpha1.ComponentVersionOverwriteList{
+						{
+							Source:       lsv1alpha1.ComponentVersionOverwriteReference{ComponentName: "example.com/a"},
+							Substitution: lsv1alpha1.ComponentVersionOverwriteReference{Version: "v1.0.1"},
+						},
+					},
+				}
+				cvo.Name = lsCtx.Name
+				cvo.Namespace = state.Namespace
+				Expect(state.Create(ctx, cvo)).To(Succeed())
+
+				mirrorRepoCtx := testutils.DefaultRepositoryContext("mirror.example.com")
+				globalCvo := &lsv1alpha1.ComponentVersionOverwrites{
+					Overwrites: lsv1alpha1.ComponentVersionOverwriteList{
+						{
+							Source:       lsv1alpha1.ComponentVersionOverwriteReference{ComponentName: "example.com/a"},
+							Substitution: lsv1alpha1.ComponentVersionOverwriteReference{Version: "v2.0.0"},
+						},
+						{
+							Source:       lsv1alpha1.ComponentVersionOverwriteReference{RepositoryContext: lsCtx.RepositoryContext},
+							Substitution: lsv1alpha1.ComponentVersionOverwriteReference{RepositoryContext: mirrorRepoCtx},
+						},
+					},
+				}
+				globalCvo.Name = "global"
+				globalCvo.Namespace = state.Namespace
+				Expect(state.Create(ctx, globalCvo)).To(Succeed())
+
+				installations.SetGlobalComponentOverwrites(&config.ComponentOverwritesConfiguration{
+					References: []core.ObjectReference{{Name: globalCvo.Name, Namespace: globalCvo.Namespace}},
+				})
+				defer installations.SetGlobalComponentOverwrites(nil)
+
+				extCtx, err := installations.GetExternalContext(ctx, testenv.Client, inst)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(extCtx.ComponentName).To(Equal("example.com/a"))
+				Expect(extCtx.ComponentVersion).To(Equal("v1.0.1"))
+				Expect(cdv2.UnstructuredTypesEqual(extCtx.RepositoryContext, mirrorRepoCtx)).To(BeTrue())
+			})
 		})
 
 	})
@@ -5,8 +5,10 @@
 package installations
 
 import (
+	"errors"
 	"fmt"
 
+	lsv1alpha1 "github.com/gardener/landscaper/apis/core/v1alpha1"
 	lserror "github.com/gardener/landscaper/apis/errors"
 )
 
@@ -14,11 +16,12 @@ import (
 type ErrorReason string
 
 const (
-	ImportNotFound         ErrorReason = "ImportNotFound"
-	ImportNotSatisfied     ErrorReason = "ImportNotSatisfied"
-	InvalidDefaultValue    ErrorReason = "InvalidDefaultValue"
-	NotCompletedDependents ErrorReason = "NotCompletedDependents"
-	SchemaValidationFailed ErrorReason = "SchemaValidationFailed"
+	ImportNotFound         ErrorReason = lsv1alpha1.ImportNotFoundReason
+	ImportNotSatisfied     ErrorReason = lsv1alpha1.ImportNotSatisfiedReason
+	InvalidDefaultValue    ErrorReason = lsv1alpha1.InvalidDefaultValueReason
+	NotCompletedDependents ErrorReason = lsv1alpha1.NotCompletedDependentsReason
+	SchemaValidationFailed ErrorReason = lsv1alpha1.SchemaValidationFailedReason
+	AmbiguousImport        ErrorReason = lsv1alpha1.AmbiguousImportReason
 )
 
 // NewErrorf creates a new import error with a formated message
@@ -41,6 +44,11 @@ func NewNotCompletedDependentsErrorf(err error, format string, a ...interface{})
 	return NewErrorf(NotCompletedDependents, err, format, a...)
 }
 
+// NewAmbiguousImportErrorf creates a new error that indicates that an import is provided by another source than the recorded one
+func NewAmbiguousImportErrorf(err error, format string, a ...interface{}) lserror.LsError {
+	return NewErrorf(AmbiguousImport, err, format, a...)
+}
+
 // IsNotCompletedDependentsError checks if the provided error is of type NotCompletedDependents
 func IsNotCompletedDependentsError(err error) bool {
 	return IsErrorForReason(err, NotCompletedDependents)
@@ -67,3 +75,36 @@ func IsErrorForReason(err error, reason ErrorReason) bool {
 
 	return false
 }
+
+// BadImportError is an import error that is caused by malformed data of the object from which an import is read.
+// Retrying such an import is useless until the source object has been changed.
+type BadImportError struct {
+	lserror.LsError
+	// ImportName is the name of the import that could not be constructed.
+	ImportName string
+	// Source is the object that contains the malformed data.
+	Source lsv1alpha1.TypedObjectReference
+}
+
+// NewBadImportError wraps the given error into an error that identifies the source object of the malformed import.
+func NewBadImportError(err lserror.LsError, importName string, source lsv1alpha1.TypedObjectReference) *BadImportError {
+	return &BadImportError{
+		LsError:    err,
+		ImportName: importName,
+		Source:     source,
+	}
+}
+
+// Unwrap implements the unwrap interface
+func (e *BadImportError) Unwrap() error {
+	return e.LsError
+}
+
+// GetBadImportError returns the BadImportError in the chain of the given error.
+func GetBadImportError(err error) (*BadImportError, bool) {
+	var badImportErr *BadImportError
+	if errors.As(err, &badImportErr) {
+		return badImportErr, true
+	}
+	return nil, false
+}
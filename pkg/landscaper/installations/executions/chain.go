@@ -0,0 +1,168 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package executions
+
+import (
+	"context"
+	"crypto/sha1"
+	"encoding/base32"
+	"encoding/json"
+	"fmt"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/gardener/landscaper/apis/core"
+	lsv1alpha1 "github.com/gardener/landscaper/apis/core/v1alpha1"
+	lsv1alpha1helper "github.com/gardener/landscaper/apis/core/v1alpha1/helper"
+	"github.com/gardener/landscaper/pkg/utils/read_write_layer"
+)
+
+// MaxExecutionDeployItemsSize is the maximal size in bytes of the serialized deploy item templates of an execution.
+// It is below the size limit of kubernetes objects, so that there is enough space for the remaining fields
+// of the execution. Deploy item templates that exceed the size are split into chained executions.
+const MaxExecutionDeployItemsSize = 1024 * 1024
+
+// ChainedExecutionName returns the name of the chained execution with the given index (starting at 1) of an installation.
+// The name ends with a hash of the installation name and the index, so that it does not collide with the name
+// of the execution of an installation that is called "<installation name>-chain-<index>".
+func ChainedExecutionName(instName string, index int) string {
+	h := sha1.Sum([]byte(fmt.Sprintf("%s/%d", instName, index)))
+	hash := base32.NewEncoding(lsv1alpha1helper.Base32EncodeStdLowerCase).WithPadding(base32.NoPadding).EncodeToString(h[:])
+	return fmt.Sprintf("%s-chain-%d-%s", instName, index, hash[:8])
+}
+
+// SplitDeployItemTemplates splits the deploy item templates of an installation into parts,
+// so that the serialized templates of each part do not exceed maxSize.
+// The templates are returned in a single part if they fit into one execution.
+// Otherwise, the templates are ordered by their dependencies, so that a deploy item only depends on deploy items
+// of the same part or of preceding parts. Dependencies on deploy items of preceding parts are removed,
+// as the parts are deployed one after another by chained executions.
+func SplitDeployItemTemplates(templates core.DeployItemTemplateList, maxSize int) ([]core.DeployItemTemplateList, error) {
+	size, err := serializedSize(templates)
+	if err != nil {
+		return nil, err
+	}
+	if size <= maxSize {
+		return []core.DeployItemTemplateList{templates}, nil
+	}
+
+	ordered, err := orderByDependencies(templates)
+	if err != nil {
+		return nil, err
+	}
+
+	var (
+		parts       []core.DeployItemTemplateList
+		current     core.DeployItemTemplateList
+		currentSize = len("[]")
+		partIndex   = map[string]int{}
+	)
+	for _, tmpl := range ordered {
+		itemSize, err := serializedSize(tmpl)
+		if err != nil {
+			return nil, err
+		}
+		// the separator between two items
+		itemSize++
+
+		if len("[]")+itemSize > maxSize {
+			return nil, fmt.Errorf("deploy item %q has a size of %d bytes, which exceeds the maximal size of %d bytes", tmpl.Name, itemSize, maxSize)
+		}
+		if currentSize+itemSize > maxSize {
+			parts = append(parts, current)
+			current = nil
+			currentSize = len("[]")
+		}
+		partIndex[tmpl.Name] = len(parts)
+		current = append(current, tmpl)
+		currentSize += itemSize
+	}
+	parts = append(parts, current)
+
+	for i := range parts {
+		for j := range parts[i] {
+			var dependsOn []string
+			for _, dep := range parts[i][j].DependsOn {
+				if partIndex[dep] == i {
+					dependsOn = append(dependsOn, dep)
+				}
+			}
+			parts[i][j].DependsOn = dependsOn
+		}
+	}
+	return parts, nil
+}
+
+// orderByDependencies returns the templates in an order in which each template follows the templates it depends on.
+// Apart from that, the original order is preserved.
+func orderByDependencies(templates core.DeployItemTemplateList) (core.DeployItemTemplateList, error) {
+	ordered := make(core.DeployItemTemplateList, 0, len(templates))
+	added := sets.New[string]()
+	for len(ordered) < len(templates) {
+		progress := false
+		for _, tmpl := range templates {
+			if added.Has(tmpl.Name) || !added.HasAll(tmpl.DependsOn...) {
+				continue
+			}
+			ordered = append(ordered, tmpl)
+			added.Insert(tmpl.Name)
+			progress = true
+		}
+		if !progress {
+			return nil, fmt.Errorf("the dependencies of the deploy items contain a cycle or undefined deploy items")
+		}
+	}
+	return ordered, nil
+}
+
+func serializedSize(obj interface{}) (int, error) {
+	data, err := json.Marshal(obj)
+	if err != nil {
+		return 0, fmt.Errorf("unable to marshal deploy item templates: %w", err)
+	}
+	return len(data), nil
+}
+
+// GetChainedExecutionsForInstallation returns the chained executions of an installation in the order
+// in which they are processed. Executions that do not exist or are not controlled by the installation are skipped.
+func GetChainedExecutionsForInstallation(ctx context.Context, kubeClient client.Client, inst *lsv1alpha1.Installation) ([]*lsv1alpha1.Execution, error) {
+	execs := []*lsv1alpha1.Execution{}
+	for _, ref := range inst.Status.ChainedExecutionReferences {
+		exec := &lsv1alpha1.Execution{}
+		if err := read_write_layer.GetExecution(ctx, kubeClient, ref.NamespacedName(), exec, read_write_layer.R000149); err != nil {
+			if apierrors.IsNotFound(err) {
+				continue
+			}
+			return nil, err
+		}
+		if !metav1.IsControlledBy(exec, inst) {
+			continue
+		}
+		execs = append(execs, exec)
+	}
+	return execs, nil
+}
+
+// GetExecutionsForInstallation returns the execution of an installation followed by its chained executions.
+// Executions that do not exist are skipped.
+func GetExecutionsForInstallation(ctx context.Context, kubeClient client.Client, inst *lsv1alpha1.Installation) ([]*lsv1alpha1.Execution, error) {
+	execs := []*lsv1alpha1.Execution{}
+	exec, err := GetExecutionForInstallation(ctx, kubeClient, inst)
+	if err != nil {
+		return nil, err
+	}
+	if exec != nil {
+		execs = append(execs, exec)
+	}
+
+	chained, err := GetChainedExecutionsForInstallation(ctx, kubeClient, inst)
+	if err != nil {
+		return nil, err
+	}
+	return append(execs, chained...), nil
+}
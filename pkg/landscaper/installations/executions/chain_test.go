@@ -0,0 +1,91 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package executions_test
+
+import (
+	"encoding/json"
+	"strings"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	"github.com/gardener/landscaper/apis/core"
+	"github.com/gardener/landscaper/pkg/landscaper/installations/executions"
+)
+
+var _ = Describe("SplitDeployItemTemplates", func() {
+
+	template := func(name string, size int, dependsOn ...string) core.DeployItemTemplate {
+		config, err := json.Marshal(map[string]string{"data": strings.Repeat("x", size)})
+		Expect(err).ToNot(HaveOccurred())
+		return core.DeployItemTemplate{
+			Name:          name,
+			Type:          "landscaper.gardener.cloud/mock",
+			Configuration: &runtime.RawExtension{Raw: config},
+			DependsOn:     dependsOn,
+		}
+	}
+
+	names := func(list core.DeployItemTemplateList) []string {
+		result := []string{}
+		for _, tmpl := range list {
+			result = append(result, tmpl.Name)
+		}
+		return result
+	}
+
+	It("should not split deploy item templates that fit into one execution", func() {
+		templates := core.DeployItemTemplateList{template("a", 100), template("b", 100, "a")}
+
+		parts, err := executions.SplitDeployItemTemplates(templates, 1000)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(parts).To(HaveLen(1))
+		Expect(parts[0]).To(Equal(templates))
+	})
+
+	It("should split deploy item templates in the order of their dependencies", func() {
+		templates := core.DeployItemTemplateList{
+			template("c", 400, "b"),
+			template("a", 400),
+			template("d", 400, "a"),
+			template("b", 400, "a"),
+		}
+
+		parts, err := executions.SplitDeployItemTemplates(templates, 1000)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(parts).To(HaveLen(2))
+		Expect(names(parts[0])).To(Equal([]string{"a", "d"}))
+		Expect(names(parts[1])).To(Equal([]string{"b", "c"}))
+
+		// dependencies on deploy items of preceding executions are satisfied by the chain
+		Expect(parts[0][1].DependsOn).To(Equal([]string{"a"}))
+		Expect(parts[1][0].DependsOn).To(BeEmpty())
+		Expect(parts[1][1].DependsOn).To(Equal([]string{"b"}))
+
+		// the original templates are not modified
+		Expect(templates[3].DependsOn).To(Equal([]string{"a"}))
+	})
+
+	It("should fail if a single deploy item template exceeds the maximal size", func() {
+		templates := core.DeployItemTemplateList{template("a", 100), template("b", 2000)}
+
+		_, err := executions.SplitDeployItemTemplates(templates, 1000)
+		Expect(err).To(MatchError(ContainSubstring(`deploy item "b"`)))
+	})
+
+})
+
+var _ = Describe("ChainedExecutionName", func() {
+
+	It("should not collide with the execution names of other installations", func() {
+		name := executions.ChainedExecutionName("inst", 1)
+		Expect(name).To(HavePrefix("inst-chain-1-"))
+		Expect(name).To(Equal(executions.ChainedExecutionName("inst", 1)))
+		Expect(name).ToNot(Equal(executions.ChainedExecutionName("inst", 2)))
+		// the execution of an installation has the name of the installation
+		Expect(name).ToNot(Equal("inst-chain-1"))
+	})
+})
@@ -6,6 +6,7 @@ package executions
 
 import (
 	"context"
+	"fmt"
 
 	"github.com/gardener/landscaper/pkg/utils/read_write_layer"
 
@@ -18,7 +19,8 @@ import (
 	"github.com/gardener/landscaper/pkg/landscaper/installations"
 )
 
-// GetExportedValues returns the exported values of the execution
+// GetExportedValues returns the exported values of the execution.
+// If the deploy items are split into chained executions, the exported values of all executions are merged.
 func (o *ExecutionOperation) GetExportedValues(ctx context.Context, inst *installations.InstallationImportsAndBlueprint) (*dataobjects.DataObject, error) {
 	exec := &lsv1alpha1.Execution{}
 	if err := read_write_layer.GetExecution(ctx, o.LsUncachedClient(), kutil.ObjectKey(inst.GetInstallation().Name, inst.GetInstallation().Namespace),
@@ -29,6 +31,41 @@ func (o *ExecutionOperation) GetExportedValues(ctx context.Context, inst *instal
 		return nil, err
 	}
 
+	do, err := o.getExportedValuesOfExecution(ctx, exec)
+	if err != nil {
+		return nil, err
+	}
+
+	chained, err := GetChainedExecutionsForInstallation(ctx, o.LsUncachedClient(), inst.GetInstallation())
+	if err != nil {
+		return nil, err
+	}
+	if len(chained) == 0 {
+		return do, nil
+	}
+
+	values, ok := do.Data.(map[string]interface{})
+	if !ok {
+		return nil, fmt.Errorf("the exported values of execution %s are not a map", exec.Name)
+	}
+	for _, chainedExec := range chained {
+		chainedDO, err := o.getExportedValuesOfExecution(ctx, chainedExec)
+		if err != nil {
+			return nil, err
+		}
+		chainedValues, ok := chainedDO.Data.(map[string]interface{})
+		if !ok {
+			return nil, fmt.Errorf("the exported values of execution %s are not a map", chainedExec.Name)
+		}
+		for key, value := range chainedValues {
+			values[key] = value
+		}
+		do.Metadata.Lineage = do.Metadata.Lineage.Add(chainedDO.Metadata.Lineage...)
+	}
+	return do, nil
+}
+
+func (o *ExecutionOperation) getExportedValuesOfExecution(ctx context.Context, exec *lsv1alpha1.Execution) (*dataobjects.DataObject, error) {
 	doName := lsv1alpha1helper.GenerateDataObjectName(lsv1alpha1helper.DataObjectSourceFromExecution(exec), "")
 	rawDO := &lsv1alpha1.DataObject{}
 	if err := o.LsUncachedClient().Get(ctx, kutil.ObjectKey(doName, o.Inst.GetInstallation().Namespace), rawDO); err != nil {
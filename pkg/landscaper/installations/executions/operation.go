@@ -6,12 +6,16 @@ package executions
 
 import (
 	"context"
+	"crypto/sha1"
+	"encoding/hex"
+	"encoding/json"
 	"fmt"
 
 	lserrors "github.com/gardener/landscaper/apis/errors"
 
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/sets"
 	"k8s.io/apimachinery/pkg/util/validation/field"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
@@ -25,14 +29,16 @@ import (
 	"github.com/gardener/landscaper/pkg/api"
 	"github.com/gardener/landscaper/pkg/landscaper/installations"
 	"github.com/gardener/landscaper/pkg/landscaper/installations/executions/template"
+	"github.com/gardener/landscaper/pkg/landscaper/installations/executions/template/cue"
 	"github.com/gardener/landscaper/pkg/landscaper/installations/executions/template/gotemplate"
 	"github.com/gardener/landscaper/pkg/landscaper/installations/executions/template/spiff"
+	lsutil "github.com/gardener/landscaper/pkg/utils"
 	"github.com/gardener/landscaper/pkg/utils/read_write_layer"
 )
 
 const (
 	// TemplatingFailedReason is the reason that is defined during templating.
-	TemplatingFailedReason = "TemplatingFailed"
+	TemplatingFailedReason = lsv1alpha1.TemplatingFailedReason
 	// CreateOrUpdateImportsReason is the reason that is defined during
 	// the creation or update of the secret containing the imported values
 	CreateOrUpdateImportsReason = "CreateOrUpdateImports"
@@ -67,15 +73,19 @@ func (o *ExecutionOperation) RenderDeployItemTemplates(ctx context.Context,
 		Inst:       inst.GetInstallation(),
 	}
 	targetResolver := genericresolver.New(o.LsUncachedClient())
-	tmpl := template.New(gotemplate.New(templateStateHandler, targetResolver), spiff.New(templateStateHandler, targetResolver))
-	executions, err := tmpl.TemplateDeployExecutions(
-		template.NewDeployExecutionOptions(
-			template.NewBlueprintExecutionOptions(
-				o.Context().External.InjectComponentDescriptorRef(inst.GetInstallation()),
-				inst.GetBlueprint(),
-				o.ComponentVersion,
-				o.ResolvedComponentDescriptorList,
-				inst.GetImports())))
+	versionResolver := installations.NewComponentVersionResolver(ctx, o.ComponentsRegistry(), o.Context().External.RepositoryContext, inst.GetInstallation())
+	tmpl := template.New(
+		gotemplate.New(templateStateHandler, targetResolver).WithComponentVersionResolver(versionResolver),
+		spiff.New(templateStateHandler, targetResolver).WithComponentVersionResolver(versionResolver),
+		cue.New())
+	deployExecutionOptions := template.NewDeployExecutionOptions(
+		template.NewBlueprintExecutionOptions(
+			o.Context().External.InjectComponentDescriptorRef(inst.GetInstallation()),
+			inst.GetBlueprint(),
+			o.ComponentVersion,
+			o.ResolvedComponentDescriptorList,
+			inst.GetImports()).WithContextFeatureFlags(o.Context().External.FeatureFlags))
+	executions, err := tmpl.TemplateDeployExecutions(deployExecutionOptions)
 
 	if err != nil {
 		inst.MergeConditions(lsv1alpha1helper.UpdatedCondition(cond, lsv1alpha1.ConditionFalse,
@@ -87,6 +97,25 @@ func (o *ExecutionOperation) RenderDeployItemTemplates(ctx context.Context,
 		return nil, nil
 	}
 
+	// with partial import updates, only those deploy items are redeployed whose specification or consumed imports have changed
+	partialImportUpdates := hasPartialImportUpdates(inst.GetInstallation())
+	var consumedImports map[string][]string
+	if partialImportUpdates {
+		// the additional templating runs must not modify the state of the executions
+		probeStateHandler := template.ReadOnlyStateHandler{GenericStateHandler: templateStateHandler}
+		probeTmpl := template.New(
+			gotemplate.New(probeStateHandler, targetResolver).WithComponentVersionResolver(versionResolver),
+			spiff.New(probeStateHandler, targetResolver).WithComponentVersionResolver(versionResolver),
+			cue.New())
+		consumedImports, err = probeTmpl.RecordImportDependencies(deployExecutionOptions, executions)
+		if err != nil {
+			inst.MergeConditions(lsv1alpha1helper.UpdatedCondition(cond, lsv1alpha1.ConditionFalse,
+				TemplatingFailedReason, "Unable to record the imports consumed by the deploy items"))
+			return nil, lserrors.NewWrappedError(err, op, "RecordImportDependencies",
+				"unable to record the imports consumed by the deploy items", lsv1alpha1.ErrorForInfoOnly)
+		}
+	}
+
 	// map deployitem specifications into templates for executions
 	// includes resolving target import references to target object references
 	execTemplates := make(core.DeployItemTemplateList, len(executions))
@@ -136,11 +165,10 @@ func (o *ExecutionOperation) RenderDeployItemTemplates(ctx context.Context,
 			}
 		}
 
-		// convert timeout
-		var timeout *core.Duration
-		if elem.Timeout != nil {
-			timeout = &core.Duration{Duration: elem.Timeout.Duration}
-		}
+		// convert timeouts
+		timeout := convertTimeout(elem.Timeout)
+		pickupTimeout := convertTimeout(elem.PickupTimeout)
+		abortTimeout := convertTimeout(elem.AbortTimeout)
 
 		execTemplates[i] = core.DeployItemTemplate{
 			Name:               elem.Name,
@@ -150,8 +178,22 @@ func (o *ExecutionOperation) RenderDeployItemTemplates(ctx context.Context,
 			Configuration:      elem.Configuration,
 			DependsOn:          elem.DependsOn,
 			Timeout:            timeout,
+			PickupTimeout:      pickupTimeout,
+			AbortTimeout:       abortTimeout,
 			UpdateOnChangeOnly: elem.UpdateOnChangeOnly,
 			OnDelete:           elem.OnDelete,
+			ExclusionWindows:   elem.ExclusionWindows,
+			RetryPolicy:        elem.RetryPolicy,
+		}
+
+		if partialImportUpdates {
+			hash, err := o.computeConsumedImportsHash(inst, consumedImports[elem.Name])
+			if err != nil {
+				return nil, o.deployItemSpecificationError(cond, elem.Name, "unable to compute hash of consumed imports: %s", err.Error())
+			}
+			execTemplates[i].UpdateOnChangeOnly = true
+			execTemplates[i].ConsumedImports = consumedImports[elem.Name]
+			execTemplates[i].ConsumedImportsHash = hash
 		}
 	}
 
@@ -165,6 +207,46 @@ func (o *ExecutionOperation) RenderDeployItemTemplates(ctx context.Context,
 	return execTemplates, nil
 }
 
+// hasPartialImportUpdates returns whether partial import updates are enabled for the installation.
+func hasPartialImportUpdates(inst *lsv1alpha1.Installation) bool {
+	return inst.Spec.Optimization != nil && inst.Spec.Optimization.PartialImportUpdates
+}
+
+// computeConsumedImportsHash computes a hash over the given imports of the installation.
+// Imported targets, target lists and target maps contribute with their generation, data imports with their value.
+func (o *ExecutionOperation) computeConsumedImportsHash(inst *installations.InstallationImportsAndBlueprint, importNames []string) (string, error) {
+	hashes := make(map[string]string, len(importNames))
+	for _, name := range importNames {
+		if t := o.GetTargetImport(name); t != nil {
+			hashes[name] = t.ComputeConfigGeneration()
+		} else if tl := o.GetTargetListImport(name); tl != nil {
+			hashes[name] = tl.ComputeConfigGeneration()
+		} else if tm := o.GetTargetMapImport(name); tm != nil {
+			hashes[name] = tm.ComputeConfigGeneration()
+		} else {
+			data, err := json.Marshal(inst.GetImports()[name])
+			if err != nil {
+				return "", fmt.Errorf("unable to marshal import %q: %w", name, err)
+			}
+			hashes[name] = string(data)
+		}
+	}
+
+	data, err := json.Marshal(hashes)
+	if err != nil {
+		return "", err
+	}
+	h := sha1.Sum(data)
+	return hex.EncodeToString(h[:]), nil
+}
+
+func convertTimeout(timeout *lsv1alpha1.Duration) *core.Duration {
+	if timeout == nil {
+		return nil
+	}
+	return &core.Duration{Duration: timeout.Duration}
+}
+
 func (o *ExecutionOperation) Ensure(ctx context.Context, inst *installations.InstallationImportsAndBlueprint) error {
 	execTemplates, err := o.RenderDeployItemTemplates(ctx, inst)
 	if execTemplates == nil || err != nil {
@@ -173,8 +255,65 @@ func (o *ExecutionOperation) Ensure(ctx context.Context, inst *installations.Ins
 
 	cond := lsv1alpha1helper.GetOrInitCondition(inst.GetInstallation().Status.Conditions, lsv1alpha1.ReconcileExecutionCondition)
 
+	// deploy items that do not fit into a single execution are split into chained executions
+	parts, err := SplitDeployItemTemplates(execTemplates, MaxExecutionDeployItemsSize)
+	if err != nil {
+		err2 := fmt.Errorf("error splitting deployitem templates into executions: %w", err)
+		inst.MergeConditions(lsv1alpha1helper.UpdatedCondition(cond, lsv1alpha1.ConditionFalse,
+			TemplatingFailedReason, err2.Error()))
+		return err2
+	}
+
+	var (
+		execRef    *lsv1alpha1.ObjectReference
+		chainedRef []lsv1alpha1.ObjectReference
+	)
+	for i := range parts {
+		name := inst.GetInstallation().Name
+		if i > 0 {
+			name = ChainedExecutionName(inst.GetInstallation().Name, i)
+		}
+
+		exec, err := o.createOrUpdateExecution(ctx, inst, cond, name, parts[i])
+		if err != nil {
+			return err
+		}
+
+		ref := lsv1alpha1.ObjectReference{
+			Name:      exec.Name,
+			Namespace: exec.Namespace,
+		}
+		if i == 0 {
+			execRef = &ref
+		} else {
+			chainedRef = append(chainedRef, ref)
+		}
+	}
+
+	if err := o.deleteObsoleteChainedExecutions(ctx, inst.GetInstallation(), chainedRef); err != nil {
+		cond = lsv1alpha1helper.UpdatedCondition(cond, lsv1alpha1.ConditionFalse,
+			CreateOrUpdateExecutionReason, "Unable to delete obsolete chained executions")
+		_ = o.UpdateInstallationStatus(ctx, inst.GetInstallation(), read_write_layer.W000058, cond)
+		return err
+	}
+
+	inst.GetInstallation().Status.ExecutionReference = execRef
+	inst.GetInstallation().Status.ChainedExecutionReferences = chainedRef
+	cond = lsv1alpha1helper.UpdatedCondition(cond, lsv1alpha1.ConditionTrue,
+		ExecutionDeployedReason, "Deployed execution item")
+	if err := o.UpdateInstallationStatus(ctx, inst.GetInstallation(), read_write_layer.W000066, cond); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// createOrUpdateExecution creates or updates an execution of the installation with the given deploy item templates.
+func (o *ExecutionOperation) createOrUpdateExecution(ctx context.Context, inst *installations.InstallationImportsAndBlueprint,
+	cond lsv1alpha1.Condition, name string, execTemplates core.DeployItemTemplateList) (*lsv1alpha1.Execution, error) {
+
 	exec := &lsv1alpha1.Execution{}
-	exec.Name = inst.GetInstallation().Name
+	exec.Name = name
 	exec.Namespace = inst.GetInstallation().Namespace
 
 	versionedDeployItemTemplateList := lsv1alpha1.DeployItemTemplateList{}
@@ -182,7 +321,7 @@ func (o *ExecutionOperation) Ensure(ctx context.Context, inst *installations.Ins
 		err2 := fmt.Errorf("error converting internal representation of deployitem templates to versioned one: %w", err)
 		inst.MergeConditions(lsv1alpha1helper.UpdatedCondition(cond, lsv1alpha1.ConditionFalse,
 			TemplatingFailedReason, err2.Error()))
-		return err2
+		return nil, err2
 	}
 
 	if _, err := o.WriterToLsUncachedClient().CreateOrUpdateExecution(ctx, read_write_layer.W000022, exec, func() error {
@@ -199,6 +338,7 @@ func (o *ExecutionOperation) Ensure(ctx context.Context, inst *installations.Ins
 		if lsv1alpha1helper.HasCacheHelmChartsAnnotation(&inst.GetInstallation().ObjectMeta) {
 			metav1.SetMetaDataAnnotation(&exec.ObjectMeta, lsv1alpha1.CacheHelmChartsAnnotation, "true")
 		}
+		lsv1alpha1helper.PropagateRequireReadinessAnnotation(&inst.GetInstallation().ObjectMeta, &exec.ObjectMeta)
 
 		if exec.CreationTimestamp.IsZero() && exec.DeletionTimestamp.IsZero() {
 			controllerutil.AddFinalizer(exec, lsv1alpha1.LandscaperFinalizer)
@@ -213,19 +353,60 @@ func (o *ExecutionOperation) Ensure(ctx context.Context, inst *installations.Ins
 		cond = lsv1alpha1helper.UpdatedCondition(cond, lsv1alpha1.ConditionFalse,
 			CreateOrUpdateExecutionReason, "Unable to create or update execution")
 		_ = o.UpdateInstallationStatus(ctx, inst.GetInstallation(), read_write_layer.W000058, cond)
-		return err
+		return nil, err
 	}
 
-	inst.GetInstallation().Status.ExecutionReference = &lsv1alpha1.ObjectReference{
-		Name:      exec.Name,
-		Namespace: exec.Namespace,
+	return exec, nil
+}
+
+// deleteObsoleteChainedExecutions deletes the chained executions of the installation that are not needed anymore,
+// because the deploy items fit into fewer executions. The deletion is started with the current job of the installation.
+func (o *ExecutionOperation) deleteObsoleteChainedExecutions(ctx context.Context, inst *lsv1alpha1.Installation,
+	chainedRefs []lsv1alpha1.ObjectReference) error {
+
+	current := sets.New[string]()
+	for _, ref := range chainedRefs {
+		current.Insert(ref.Name)
 	}
-	cond = lsv1alpha1helper.UpdatedCondition(cond, lsv1alpha1.ConditionTrue,
-		ExecutionDeployedReason, "Deployed execution item")
-	if err := o.UpdateInstallationStatus(ctx, inst.GetInstallation(), read_write_layer.W000066, cond); err != nil {
+
+	execs, err := GetChainedExecutionsForInstallation(ctx, o.LsUncachedClient(), inst)
+	if err != nil {
 		return err
 	}
 
+	for _, exec := range execs {
+		if current.Has(exec.Name) {
+			continue
+		}
+
+		if exec.DeletionTimestamp.IsZero() {
+			if err := o.WriterToLsUncachedClient().DeleteExecution(ctx, read_write_layer.W000168, exec); err != nil {
+				if apierrors.IsNotFound(err) {
+					continue
+				}
+				return err
+			}
+
+			// reload the execution, as the deletion timestamp has been set
+			if err := read_write_layer.GetExecution(ctx, o.LsUncachedClient(), client.ObjectKeyFromObject(exec), exec, read_write_layer.R000150); err != nil {
+				if apierrors.IsNotFound(err) {
+					continue
+				}
+				return err
+			}
+		}
+
+		if exec.Status.JobID != inst.Status.JobID {
+			exec.Status.JobID = inst.Status.JobID
+			exec.Status.TransitionTimes = lsutil.NewTransitionTimes()
+			if err := o.WriterToLsUncachedClient().UpdateExecutionStatus(ctx, read_write_layer.W000169, exec); err != nil {
+				if apierrors.IsNotFound(err) {
+					continue
+				}
+				return err
+			}
+		}
+	}
 	return nil
 }
 
@@ -0,0 +1,207 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package cue
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"cuelang.org/go/cue"
+	"cuelang.org/go/cue/cuecontext"
+	cueerrors "cuelang.org/go/cue/errors"
+	"github.com/mandelsoft/vfs/pkg/vfs"
+
+	lsv1alpha1 "github.com/gardener/landscaper/apis/core/v1alpha1"
+	"github.com/gardener/landscaper/pkg/components/model"
+	"github.com/gardener/landscaper/pkg/landscaper/blueprints"
+	"github.com/gardener/landscaper/pkg/landscaper/installations/executions/template"
+)
+
+// Templater describes the cue template implementation for execution templater.
+// The template is a cue document that is unified with the values of the execution, e.g. "imports" or "values".
+// Constraints that are defined in the template for these values are therefore checked against the actual values,
+// so that a template can define a schema for the imports it uses.
+// The result of an execution is read from the concrete output fields of the unified document,
+// e.g. "deployItems" for deploy executions.
+type Templater struct {
+	inputFormatter *template.TemplateInputFormatter
+}
+
+// New creates a new cue execution templater.
+func New() *Templater {
+	return &Templater{
+		inputFormatter: template.NewTemplateInputFormatter(false, "imports", "values", "state"),
+	}
+}
+
+// WithInputFormatter ads a custom input formatter to this templater used for error messages.
+func (t *Templater) WithInputFormatter(inputFormatter *template.TemplateInputFormatter) *Templater {
+	t.inputFormatter = inputFormatter
+	return t
+}
+
+func (t Templater) Type() lsv1alpha1.TemplateType {
+	return lsv1alpha1.CUETemplateType
+}
+
+func (t *Templater) TemplateSubinstallationExecutions(tmplExec lsv1alpha1.TemplateExecutor,
+	blueprint *blueprints.Blueprint,
+	_ model.ComponentVersion,
+	_ *model.ComponentVersionList,
+	values map[string]interface{}) (*template.SubinstallationExecutorOutput, error) {
+
+	output := &template.SubinstallationExecutorOutput{}
+	if err := t.execute(tmplExec, blueprint, values, output, "subinstallations"); err != nil {
+		return nil, err
+	}
+	return output, nil
+}
+
+func (t *Templater) TemplateImportExecutions(tmplExec lsv1alpha1.TemplateExecutor,
+	blueprint *blueprints.Blueprint,
+	_ model.ComponentVersion,
+	_ *model.ComponentVersionList,
+	values map[string]interface{}) (*template.ImportExecutorOutput, error) {
+
+	output := &template.ImportExecutorOutput{}
+	if err := t.execute(tmplExec, blueprint, values, output, "bindings", "errors"); err != nil {
+		return nil, err
+	}
+	return output, nil
+}
+
+// TemplateStageExecutions is the cue executor for an execution of a render stage.
+func (t *Templater) TemplateStageExecutions(tmplExec lsv1alpha1.TemplateExecutor,
+	blueprint *blueprints.Blueprint,
+	_ model.ComponentVersion,
+	_ *model.ComponentVersionList,
+	values map[string]interface{}) (*template.StageExecutorOutput, error) {
+
+	output := &template.StageExecutorOutput{}
+	if err := t.execute(tmplExec, blueprint, values, output, "values"); err != nil {
+		return nil, err
+	}
+	return output, nil
+}
+
+func (t *Templater) TemplateDeployExecutions(tmplExec lsv1alpha1.TemplateExecutor,
+	blueprint *blueprints.Blueprint,
+	_ model.ComponentVersion,
+	_ *model.ComponentVersionList,
+	values map[string]interface{}) (*template.DeployExecutorOutput, error) {
+
+	output := &template.DeployExecutorOutput{}
+	if err := t.execute(tmplExec, blueprint, values, output, "deployItems"); err != nil {
+		return nil, err
+	}
+	return output, nil
+}
+
+func (t *Templater) TemplateExportExecutions(tmplExec lsv1alpha1.TemplateExecutor,
+	blueprint *blueprints.Blueprint,
+	_ model.ComponentVersion,
+	_ *model.ComponentVersionList,
+	values map[string]interface{}) (*template.ExportExecutorOutput, error) {
+
+	output := &template.ExportExecutorOutput{}
+	if err := t.execute(tmplExec, blueprint, values, output, "exports"); err != nil {
+		return nil, err
+	}
+	return output, nil
+}
+
+// execute unifies the template with the values and decodes the given output fields into the output object.
+// Output fields that are not defined by the template are omitted.
+func (t *Templater) execute(tmplExec lsv1alpha1.TemplateExecutor,
+	blueprint *blueprints.Blueprint,
+	values map[string]interface{},
+	output interface{},
+	outputFields ...string) error {
+
+	rawTemplate, err := getTemplateFromExecution(tmplExec, blueprint)
+	if err != nil {
+		return err
+	}
+
+	cueCtx := cuecontext.New()
+	input := cueCtx.CompileString("{}")
+	for key, value := range values {
+		encoded := cueCtx.Encode(value)
+		if err := encoded.Err(); err != nil {
+			return fmt.Errorf("unable to encode %q: %s", key, cueErrorDetails(err))
+		}
+		input = input.FillPath(cue.MakePath(cue.Str(key)), encoded)
+	}
+
+	// the values are in scope of the template, so that it can reference them without declaring them
+	document := cueCtx.CompileString(rawTemplate, cue.Filename(tmplExec.Name), cue.Scope(input))
+	if err := document.Err(); err != nil {
+		return t.templateError(fmt.Errorf("unable to compile template: %s", cueErrorDetails(err)), values)
+	}
+
+	for key := range values {
+		document = document.FillPath(cue.MakePath(cue.Str(key)), input.LookupPath(cue.MakePath(cue.Str(key))))
+	}
+	if err := document.Validate(); err != nil {
+		return t.templateError(fmt.Errorf("template does not unify with the input values: %s", cueErrorDetails(err)), values)
+	}
+
+	result := map[string]json.RawMessage{}
+	for _, field := range outputFields {
+		fieldValue := document.LookupPath(cue.MakePath(cue.Str(field)))
+		if !fieldValue.Exists() {
+			continue
+		}
+		if err := fieldValue.Validate(cue.Concrete(true)); err != nil {
+			return t.templateError(fmt.Errorf("field %q is not concrete: %s", field, cueErrorDetails(err)), values)
+		}
+		data, err := fieldValue.MarshalJSON()
+		if err != nil {
+			return t.templateError(fmt.Errorf("unable to marshal field %q: %s", field, cueErrorDetails(err)), values)
+		}
+		result[field] = data
+	}
+
+	data, err := json.Marshal(result)
+	if err != nil {
+		return err
+	}
+	if err := json.Unmarshal(data, output); err != nil {
+		return fmt.Errorf("unable to decode the output of the template: %w", err)
+	}
+	return nil
+}
+
+// templateError adds the formatted input values to a templating error.
+func (t *Templater) templateError(err error, values map[string]interface{}) error {
+	if t.inputFormatter == nil {
+		return err
+	}
+	return fmt.Errorf("%w\ntemplate input:\n%s", err, t.inputFormatter.Format(values, "\t"))
+}
+
+// cueErrorDetails returns all errors of a cue error including their positions.
+func cueErrorDetails(err error) string {
+	return strings.TrimSpace(cueerrors.Details(err, nil))
+}
+
+func getTemplateFromExecution(tmplExec lsv1alpha1.TemplateExecutor, blueprint *blueprints.Blueprint) (string, error) {
+	if len(tmplExec.Template.RawMessage) != 0 {
+		var rawTemplate string
+		if err := json.Unmarshal(tmplExec.Template.RawMessage, &rawTemplate); err != nil {
+			return "", err
+		}
+		return rawTemplate, nil
+	}
+	if len(tmplExec.File) != 0 {
+		rawTemplateBytes, err := vfs.ReadFile(blueprint.Fs, tmplExec.File)
+		if err != nil {
+			return "", err
+		}
+		return string(rawTemplateBytes), nil
+	}
+	return "", fmt.Errorf("no template found")
+}
@@ -0,0 +1,131 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package cue_test
+
+import (
+	"encoding/json"
+
+	"github.com/mandelsoft/vfs/pkg/memoryfs"
+	"github.com/mandelsoft/vfs/pkg/vfs"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	lsv1alpha1 "github.com/gardener/landscaper/apis/core/v1alpha1"
+	"github.com/gardener/landscaper/pkg/landscaper/blueprints"
+	"github.com/gardener/landscaper/pkg/landscaper/installations/executions/template/cue"
+)
+
+const deployTemplate = `
+imports: {
+	replicas: int & >0
+	namespace: string | *"default"
+}
+
+deployItems: [{
+	name: "app"
+	type: "landscaper.gardener.cloud/kubernetes-manifest"
+	config: {
+		apiVersion: "manifest.deployer.landscaper.gardener.cloud/v1alpha2"
+		kind: "ProviderConfiguration"
+		manifests: [{
+			policy: "manage"
+			manifest: {
+				apiVersion: "apps/v1"
+				kind: "Deployment"
+				metadata: {name: "app", namespace: imports.namespace}
+				spec: replicas: imports.replicas
+			}
+		}]
+	}
+}]
+`
+
+var _ = Describe("TemplateDeployExecutions", func() {
+
+	executor := func(tmpl string) lsv1alpha1.TemplateExecutor {
+		raw, err := json.Marshal(tmpl)
+		Expect(err).ToNot(HaveOccurred())
+		return lsv1alpha1.TemplateExecutor{
+			Name:     "deploy",
+			Type:     lsv1alpha1.CUETemplateType,
+			Template: lsv1alpha1.NewAnyJSON(raw),
+		}
+	}
+
+	It("should render the deploy items of a cue template", func() {
+		bp := blueprints.New(&lsv1alpha1.Blueprint{}, memoryfs.New())
+		values := map[string]interface{}{
+			"imports": map[string]interface{}{"replicas": 3},
+		}
+
+		output, err := cue.New().TemplateDeployExecutions(executor(deployTemplate), bp, nil, nil, values)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(output.DeployItems).To(HaveLen(1))
+		Expect(output.DeployItems[0].Name).To(Equal("app"))
+		Expect(output.DeployItems[0].Configuration.Raw).To(ContainSubstring(`"replicas":3`))
+		Expect(output.DeployItems[0].Configuration.Raw).To(ContainSubstring(`"namespace":"default"`))
+	})
+
+	It("should read the template from the blueprint filesystem", func() {
+		fs := memoryfs.New()
+		Expect(vfs.WriteFile(fs, "deploy.cue", []byte(deployTemplate), 0600)).To(Succeed())
+		bp := blueprints.New(&lsv1alpha1.Blueprint{}, fs)
+		values := map[string]interface{}{
+			"imports": map[string]interface{}{"replicas": 1, "namespace": "test"},
+		}
+
+		output, err := cue.New().TemplateDeployExecutions(lsv1alpha1.TemplateExecutor{
+			Name: "deploy",
+			Type: lsv1alpha1.CUETemplateType,
+			File: "deploy.cue",
+		}, bp, nil, nil, values)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(output.DeployItems).To(HaveLen(1))
+		Expect(output.DeployItems[0].Configuration.Raw).To(ContainSubstring(`"namespace":"test"`))
+	})
+
+	It("should fail if the imports do not match the constraints of the template", func() {
+		bp := blueprints.New(&lsv1alpha1.Blueprint{}, memoryfs.New())
+		values := map[string]interface{}{
+			"imports": map[string]interface{}{"replicas": 0},
+		}
+
+		_, err := cue.New().TemplateDeployExecutions(executor(deployTemplate), bp, nil, nil, values)
+		Expect(err).To(HaveOccurred())
+		Expect(err.Error()).To(ContainSubstring("replicas"))
+	})
+
+	It("should fail if an output is not concrete", func() {
+		bp := blueprints.New(&lsv1alpha1.Blueprint{}, memoryfs.New())
+
+		_, err := cue.New().TemplateDeployExecutions(executor(deployTemplate), bp, nil, nil, map[string]interface{}{})
+		Expect(err).To(HaveOccurred())
+		Expect(err.Error()).To(ContainSubstring(`field "deployItems" is not concrete`))
+	})
+})
+
+var _ = Describe("TemplateExportExecutions", func() {
+
+	It("should render the exports of a cue template", func() {
+		bp := blueprints.New(&lsv1alpha1.Blueprint{}, memoryfs.New())
+		raw, err := json.Marshal(`exports: url: "https://\(values.deployitems.app.host)"`)
+		Expect(err).ToNot(HaveOccurred())
+		values := map[string]interface{}{
+			"values": map[string]interface{}{
+				"deployitems": map[string]interface{}{
+					"app": map[string]interface{}{"host": "example.com"},
+				},
+			},
+		}
+
+		output, err := cue.New().TemplateExportExecutions(lsv1alpha1.TemplateExecutor{
+			Name:     "export",
+			Type:     lsv1alpha1.CUETemplateType,
+			Template: lsv1alpha1.NewAnyJSON(raw),
+		}, bp, nil, nil, values)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(output.Exports).To(HaveKeyWithValue("url", "https://example.com"))
+	})
+})
@@ -0,0 +1,135 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package template
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+
+	"k8s.io/apimachinery/pkg/util/sets"
+)
+
+// probeSuffix is used to modify the values of imports when their consumers are recorded.
+const probeSuffix = "-landscaper-import-probe"
+
+// RecordImportDependencies determines for the given deploy items which imports they consume.
+// The deploy items must be the result of TemplateDeployExecutions with the same options.
+// The result maps the names of the deploy items to the sorted names of their consumed imports.
+//
+// The dependencies are recorded by templating the deploy executions again, once for each import, where every value
+// of the import is modified. A deploy item consumes the import if its specification changes.
+// Deploy items that reference a target import consume this import, too.
+// If the templating fails with a modified import, all deploy items are considered as consumers of the import.
+// As the deploy executions are templated several times, the templater should not store the state of the executions.
+func (o *Templater) RecordImportDependencies(opts DeployExecutionOptions, deployItems []DeployItemSpecification) (map[string][]string, error) {
+	values, err := opts.Values()
+	if err != nil {
+		return nil, err
+	}
+
+	rendered, err := marshalDeployItemSpecifications(deployItems)
+	if err != nil {
+		return nil, err
+	}
+
+	consumed := make(map[string]sets.Set[string], len(deployItems))
+	for _, item := range deployItems {
+		consumed[item.Name] = sets.New[string]()
+		if item.Target != nil && len(item.Target.Import) > 0 {
+			consumed[item.Name].Insert(item.Target.Import)
+		}
+	}
+
+	imports, _ := values["imports"].(map[string]interface{})
+	for _, importName := range sets.List(sets.KeySet(imports)) {
+		probeImports := make(map[string]interface{}, len(imports))
+		for k, v := range imports {
+			probeImports[k] = v
+		}
+		probeImports[importName] = probeValue(imports[importName])
+
+		probeValues := make(map[string]interface{}, len(values))
+		for k, v := range values {
+			probeValues[k] = v
+		}
+		probeValues["imports"] = probeImports
+
+		probeItems, err := o.templateDeployExecutions(opts, probeValues)
+		if err != nil {
+			// the templating depends on the import in a way that cannot be probed
+			for _, item := range deployItems {
+				consumed[item.Name].Insert(importName)
+			}
+			continue
+		}
+
+		probeRendered, err := marshalDeployItemSpecifications(probeItems)
+		if err != nil {
+			return nil, err
+		}
+		for _, item := range deployItems {
+			if !bytes.Equal(rendered[item.Name], probeRendered[item.Name]) {
+				consumed[item.Name].Insert(importName)
+			}
+		}
+	}
+
+	result := make(map[string][]string, len(consumed))
+	for name, importNames := range consumed {
+		result[name] = sets.List(importNames)
+	}
+	return result, nil
+}
+
+func marshalDeployItemSpecifications(deployItems []DeployItemSpecification) (map[string][]byte, error) {
+	result := make(map[string][]byte, len(deployItems))
+	for _, item := range deployItems {
+		data, err := json.Marshal(item)
+		if err != nil {
+			return nil, fmt.Errorf("unable to marshal deploy item %q: %w", item.Name, err)
+		}
+		result[item.Name] = data
+	}
+	return result, nil
+}
+
+// probeValue returns a copy of the given import value in which every contained value is modified.
+func probeValue(value interface{}) interface{} {
+	switch v := value.(type) {
+	case nil:
+		return probeSuffix
+	case string:
+		return v + probeSuffix
+	case bool:
+		return !v
+	case float64:
+		return v + 1
+	case int64:
+		return v + 1
+	case int:
+		return v + 1
+	case map[string]interface{}:
+		if len(v) == 0 {
+			return map[string]interface{}{probeSuffix: probeSuffix}
+		}
+		res := make(map[string]interface{}, len(v))
+		for key, elem := range v {
+			res[key] = probeValue(elem)
+		}
+		return res
+	case []interface{}:
+		if len(v) == 0 {
+			return []interface{}{probeSuffix}
+		}
+		res := make([]interface{}, len(v))
+		for i, elem := range v {
+			res[i] = probeValue(elem)
+		}
+		return res
+	default:
+		return probeSuffix
+	}
+}
@@ -0,0 +1,91 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package template_test
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"sigs.k8s.io/yaml"
+
+	lsv1alpha1 "github.com/gardener/landscaper/apis/core/v1alpha1"
+	"github.com/gardener/landscaper/pkg/landscaper/blueprints"
+	"github.com/gardener/landscaper/pkg/landscaper/installations/executions/template"
+	"github.com/gardener/landscaper/pkg/landscaper/installations/executions/template/gotemplate"
+	"github.com/gardener/landscaper/pkg/landscaper/installations/executions/template/spiff"
+)
+
+const importDependenciesBlueprint = `
+deployExecutions:
+- name: default
+  type: GoTemplate
+  template: |
+    deployItems:
+    - name: replicas
+      type: landscaper.gardener.cloud/mock
+      config:
+        replicas: {{ .imports.replicas }}
+    - name: target
+      type: landscaper.gardener.cloud/mock
+      target:
+        import: cluster
+      config:
+        name: {{ .imports.config.name }}
+    - name: static
+      type: landscaper.gardener.cloud/mock
+      config:
+        name: static
+    {{- if .imports.enabled }}
+    - name: optional
+      type: landscaper.gardener.cloud/mock
+      config:
+        name: optional
+    {{- end }}
+    state:
+      replicas: {{ .imports.replicas }}
+`
+
+var _ = Describe("ImportDependencies", func() {
+
+	It("should record the imports consumed by each deploy item", func() {
+		blue := &lsv1alpha1.Blueprint{}
+		Expect(yaml.Unmarshal([]byte(importDependenciesBlueprint), blue)).To(Succeed())
+
+		opts := template.NewDeployExecutionOptions(
+			template.NewBlueprintExecutionOptions(
+				nil,
+				&blueprints.Blueprint{Info: blue, Fs: nil},
+				nil,
+				nil,
+				map[string]interface{}{
+					"replicas": 3,
+					"config":   map[string]interface{}{"name": "app", "other": "value"},
+					"enabled":  true,
+					"cluster":  map[string]interface{}{"spec": map[string]interface{}{}},
+					"unused":   "value",
+				}))
+
+		stateHandler := template.NewMemoryStateHandler()
+		op := template.New(gotemplate.New(stateHandler, nil), spiff.New(stateHandler, nil))
+		items, err := op.TemplateDeployExecutions(opts)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(items).To(HaveLen(4))
+		state := string(stateHandler["deploydefault"])
+		Expect(state).ToNot(BeEmpty())
+
+		probeStateHandler := template.ReadOnlyStateHandler{GenericStateHandler: stateHandler}
+		probeOp := template.New(gotemplate.New(probeStateHandler, nil), spiff.New(probeStateHandler, nil))
+		consumed, err := probeOp.RecordImportDependencies(opts, items)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(consumed).To(Equal(map[string][]string{
+			"replicas": {"replicas"},
+			"target":   {"cluster", "config"},
+			"static":   {},
+			"optional": {"enabled"},
+		}))
+
+		// the state must not be modified by the additional templating runs
+		Expect(string(stateHandler["deploydefault"])).To(Equal(state))
+	})
+})
@@ -0,0 +1,107 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package template_test
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"sigs.k8s.io/yaml"
+
+	lsv1alpha1 "github.com/gardener/landscaper/apis/core/v1alpha1"
+	"github.com/gardener/landscaper/pkg/landscaper/blueprints"
+	"github.com/gardener/landscaper/pkg/landscaper/installations/executions/template"
+	"github.com/gardener/landscaper/pkg/landscaper/installations/executions/template/gotemplate"
+	"github.com/gardener/landscaper/pkg/landscaper/installations/executions/template/spiff"
+)
+
+const featureFlagsBlueprint = `
+featureFlags:
+- name: highAvailability
+  default: false
+- name: monitoring
+  default: true
+deployExecutions:
+- name: gotemplate
+  type: GoTemplate
+  template: |
+    deployItems:
+    - name: main
+      type: landscaper.gardener.cloud/mock
+      config:
+        {{- if .featureFlags.highAvailability }}
+        replicas: 3
+        {{- else }}
+        replicas: 1
+        {{- end }}
+- name: spiff
+  type: Spiff
+  template:
+    deployItems:
+    - name: monitoring
+      type: landscaper.gardener.cloud/mock
+      config:
+        enabled: (( featureFlags.monitoring ))
+`
+
+var _ = Describe("FeatureFlags", func() {
+
+	var (
+		stateHandler template.GenericStateHandler
+
+		executeTemplate = func(contextFlags map[string]bool) map[string]map[string]interface{} {
+			blue := &lsv1alpha1.Blueprint{}
+			Expect(yaml.Unmarshal([]byte(featureFlagsBlueprint), blue)).To(Succeed())
+
+			op := template.New(gotemplate.New(stateHandler, nil), spiff.New(stateHandler, nil))
+			res, err := op.TemplateDeployExecutions(
+				template.NewDeployExecutionOptions(
+					template.NewBlueprintExecutionOptions(
+						nil,
+						&blueprints.Blueprint{Info: blue, Fs: nil},
+						nil,
+						nil,
+						map[string]interface{}{}).WithContextFeatureFlags(contextFlags)))
+			Expect(err).ToNot(HaveOccurred())
+
+			configs := map[string]map[string]interface{}{}
+			for _, item := range res {
+				config := map[string]interface{}{}
+				Expect(yaml.Unmarshal(item.Configuration.Raw, &config)).To(Succeed())
+				configs[item.Name] = config
+			}
+			return configs
+		}
+	)
+
+	BeforeEach(func() {
+		stateHandler = template.NewMemoryStateHandler()
+	})
+
+	It("should use the defaults of the blueprint if the context does not define the feature flags", func() {
+		configs := executeTemplate(nil)
+		Expect(configs).To(HaveLen(2))
+		Expect(configs["main"]).To(HaveKeyWithValue("replicas", BeNumerically("==", 1)))
+		Expect(configs["monitoring"]).To(HaveKeyWithValue("enabled", true))
+	})
+
+	It("should overwrite the defaults of the blueprint with the values of the context", func() {
+		configs := executeTemplate(map[string]bool{
+			"highAvailability": true,
+			"monitoring":       false,
+			"unknown":          true,
+		})
+		Expect(configs).To(HaveLen(2))
+		Expect(configs["main"]).To(HaveKeyWithValue("replicas", BeNumerically("==", 3)))
+		Expect(configs["monitoring"]).To(HaveKeyWithValue("enabled", false))
+	})
+
+	It("should only expose the feature flags that are declared by the blueprint", func() {
+		opts := template.NewBlueprintExecutionOptions(nil, &blueprints.Blueprint{Info: &lsv1alpha1.Blueprint{
+			FeatureFlags: []lsv1alpha1.FeatureFlagDefinition{{Name: "a"}},
+		}}, nil, nil, nil).WithContextFeatureFlags(map[string]bool{"a": true, "b": true})
+
+		Expect(opts.FeatureFlags()).To(Equal(map[string]interface{}{"a": true}))
+	})
+})
@@ -559,6 +559,17 @@ func getOidcKubeconfigGoFunc(targetResolver targetresolver.TargetResolver) func(
 	}
 }
 
+// resolveComponentVersionGoFunc returns a function that resolves the latest version of a component
+// that matches a semver constraint.
+func resolveComponentVersionGoFunc(versionResolver lstmpl.ComponentVersionResolver) func(componentName, constraint string) (string, error) {
+	return func(componentName, constraint string) (string, error) {
+		if versionResolver == nil {
+			return "", errors.New("templating function resolveComponentVersion is not available in this context")
+		}
+		return versionResolver.ResolveComponentVersion(componentName, constraint)
+	}
+}
+
 func toInt64(value interface{}) (int64, error) {
 	switch n := value.(type) {
 	case int64:
@@ -28,9 +28,10 @@ const (
 
 // Templater is the go template implementation for landscaper templating.
 type Templater struct {
-	state          lstmpl.GenericStateHandler
-	inputFormatter *lstmpl.TemplateInputFormatter
-	targetResolver targetresolver.TargetResolver
+	state           lstmpl.GenericStateHandler
+	inputFormatter  *lstmpl.TemplateInputFormatter
+	targetResolver  targetresolver.TargetResolver
+	versionResolver lstmpl.ComponentVersionResolver
 }
 
 // New creates a new go template execution templater.
@@ -48,6 +49,13 @@ func (t *Templater) WithInputFormatter(inputFormatter *lstmpl.TemplateInputForma
 	return t
 }
 
+// WithComponentVersionResolver adds a resolver for component versions to this templater.
+// Without a resolver, component versions cannot be resolved by semver constraints.
+func (t *Templater) WithComponentVersionResolver(versionResolver lstmpl.ComponentVersionResolver) *Templater {
+	t.versionResolver = versionResolver
+	return t
+}
+
 type TemplateExecution struct {
 	funcMap       map[string]interface{}
 	blueprint     *blueprints.Blueprint
@@ -127,6 +135,7 @@ func (t *Templater) TemplateExecution(rawTemplate string,
 	if err != nil {
 		return nil, err
 	}
+	te.funcMap["resolveComponentVersion"] = resolveComponentVersionGoFunc(t.versionResolver)
 
 	return te.Execute(rawTemplate, values)
 }
@@ -293,6 +302,39 @@ func (t *Templater) TemplateExportExecutions(tmplExec lsv1alpha1.TemplateExecuto
 	return output, nil
 }
 
+// TemplateStageExecutions is the GoTemplate executor for an execution of a render stage.
+func (t *Templater) TemplateStageExecutions(tmplExec lsv1alpha1.TemplateExecutor,
+	blueprint *blueprints.Blueprint,
+	descriptor model.ComponentVersion,
+	cdList *model.ComponentVersionList,
+	values map[string]interface{}) (*lstmpl.StageExecutorOutput, error) {
+
+	const templateName = "render stage execution"
+
+	rawTemplate, err := getTemplateFromExecution(tmplExec, blueprint)
+	if err != nil {
+		return nil, err
+	}
+
+	data, err := t.TemplateExecution(rawTemplate, blueprint, descriptor, cdList, values)
+	if err != nil {
+		executeError := TemplateErrorBuilder(err).WithSource(&rawTemplate).
+			WithInput(values, t.inputFormatter).
+			Build()
+		return nil, executeError
+	}
+
+	if err := CreateErrorIfContainsNoValue(string(data), templateName, values, t.inputFormatter); err != nil {
+		return nil, err
+	}
+
+	output := &lstmpl.StageExecutorOutput{}
+	if err := yaml.Unmarshal(data, output); err != nil {
+		return nil, fmt.Errorf("error while decoding templated execution: %w", err)
+	}
+	return output, nil
+}
+
 func (t *Templater) getDeployExecutionState(ctx context.Context, tmplExec lsv1alpha1.TemplateExecutor) (interface{}, error) {
 	return t.getState(ctx, "deploy", tmplExec)
 }
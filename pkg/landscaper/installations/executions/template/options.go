@@ -22,6 +22,8 @@ type BlueprintExecutionOptions struct {
 	ComponentVersion  model.ComponentVersion
 	ComponentVersions *model.ComponentVersionList
 	Imports           map[string]interface{}
+	// ContextFeatureFlags are the values of the feature flags that are defined by the context of the installation.
+	ContextFeatureFlags map[string]bool
 }
 
 // NewBlueprintExecutionOptions create new basic blueprint execution options
@@ -36,6 +38,29 @@ func NewBlueprintExecutionOptions(installation *lsv1alpha1.Installation, bluepri
 	}
 }
 
+// WithContextFeatureFlags returns a copy of the options with the feature flag values of the context.
+func (o BlueprintExecutionOptions) WithContextFeatureFlags(flags map[string]bool) BlueprintExecutionOptions {
+	o.ContextFeatureFlags = flags
+	return o
+}
+
+// FeatureFlags resolves the values of the feature flags that are declared by the blueprint.
+// The value that is defined by the context takes precedence over the default of the blueprint.
+func (o *BlueprintExecutionOptions) FeatureFlags() map[string]interface{} {
+	flags := map[string]interface{}{}
+	if o.Blueprint == nil || o.Blueprint.Info == nil {
+		return flags
+	}
+	for _, def := range o.Blueprint.Info.FeatureFlags {
+		value, ok := o.ContextFeatureFlags[def.Name]
+		if !ok {
+			value = def.Default
+		}
+		flags[def.Name] = value
+	}
+	return flags
+}
+
 func (o *BlueprintExecutionOptions) Values() (map[string]interface{}, error) {
 	ocmSchemaVersion := common.DetermineOCMSchemaVersion(o.Blueprint, o.ComponentVersion)
 	// marshal and unmarshal resolved component descriptor
@@ -49,9 +74,10 @@ func (o *BlueprintExecutionOptions) Values() (map[string]interface{}, error) {
 	}
 
 	values := map[string]interface{}{
-		"cd":         component,
-		"components": components,
-		"imports":    o.Imports,
+		"cd":           component,
+		"components":   components,
+		"imports":      o.Imports,
+		"featureFlags": o.FeatureFlags(),
 	}
 
 	// add blueprint and component descriptor ref information to the input values
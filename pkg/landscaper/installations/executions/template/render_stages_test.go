@@ -0,0 +1,104 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package template_test
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"sigs.k8s.io/yaml"
+
+	lsv1alpha1 "github.com/gardener/landscaper/apis/core/v1alpha1"
+	"github.com/gardener/landscaper/pkg/landscaper/blueprints"
+	"github.com/gardener/landscaper/pkg/landscaper/installations/executions/template"
+	"github.com/gardener/landscaper/pkg/landscaper/installations/executions/template/gotemplate"
+	"github.com/gardener/landscaper/pkg/landscaper/installations/executions/template/spiff"
+)
+
+const renderStagesBlueprint = `
+renderStages:
+- name: sizing
+  executions:
+  - name: replicas
+    type: GoTemplate
+    template: |
+      values:
+        replicas: {{ mul .imports.nodes 2 }}
+  schema:
+    type: object
+    required:
+    - replicas
+    properties:
+      replicas:
+        type: integer
+        maximum: 10
+- name: config
+  executions:
+  - name: config
+    type: Spiff
+    template:
+      values:
+        name: (( imports.name "-" stages.sizing.replicas ))
+deployExecutions:
+- name: default
+  type: GoTemplate
+  template: |
+    deployItems:
+    - name: main
+      type: landscaper.gardener.cloud/mock
+      config:
+        name: {{ .stages.config.name }}
+        replicas: {{ .stages.sizing.replicas }}
+`
+
+var _ = Describe("RenderStages", func() {
+
+	var (
+		stateHandler template.GenericStateHandler
+
+		executeTemplate = func(imports map[string]interface{}) ([]template.DeployItemSpecification, error) {
+			blue := &lsv1alpha1.Blueprint{}
+			Expect(yaml.Unmarshal([]byte(renderStagesBlueprint), blue)).To(Succeed())
+
+			op := template.New(gotemplate.New(stateHandler, nil), spiff.New(stateHandler, nil))
+			return op.TemplateDeployExecutions(
+				template.NewDeployExecutionOptions(
+					template.NewBlueprintExecutionOptions(
+						nil,
+						&blueprints.Blueprint{Info: blue, Fs: nil},
+						nil,
+						nil,
+						imports)))
+		}
+	)
+
+	BeforeEach(func() {
+		stateHandler = template.NewMemoryStateHandler()
+	})
+
+	It("should pass the values of a stage to the next stages and the deploy executions", func() {
+		res, err := executeTemplate(map[string]interface{}{
+			"nodes": 3,
+			"name":  "app",
+		})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(res).To(HaveLen(1))
+
+		config := map[string]interface{}{}
+		Expect(yaml.Unmarshal(res[0].Configuration.Raw, &config)).To(Succeed())
+		Expect(config).To(HaveKeyWithValue("name", "app-6"))
+		Expect(config).To(HaveKeyWithValue("replicas", BeNumerically("==", 6)))
+	})
+
+	It("should fail at the stage whose values do not match the schema of the stage", func() {
+		res, err := executeTemplate(map[string]interface{}{
+			"nodes": 6,
+			"name":  "app",
+		})
+		Expect(err).To(HaveOccurred())
+		Expect(res).To(BeNil())
+		Expect(err.Error()).To(ContainSubstring(`render stage "sizing": values do not match the schema of the stage`))
+		Expect(err.Error()).To(ContainSubstring("replicas"))
+	})
+})
@@ -34,7 +34,7 @@ import (
 	"github.com/gardener/landscaper/pkg/utils/clusters"
 )
 
-func LandscaperSpiffFuncs(blueprint *blueprints.Blueprint, functions spiffing.Functions, componentVersion model.ComponentVersion, componentVersions *model.ComponentVersionList, targetResolver targetresolver.TargetResolver) error {
+func LandscaperSpiffFuncs(blueprint *blueprints.Blueprint, functions spiffing.Functions, componentVersion model.ComponentVersion, componentVersions *model.ComponentVersionList, targetResolver targetresolver.TargetResolver, versionResolver template.ComponentVersionResolver) error {
 	ocmSchemaVersion := common.DetermineOCMSchemaVersion(blueprint, componentVersion)
 
 	cd, err := model.GetComponentDescriptor(componentVersion)
@@ -59,6 +59,7 @@ func LandscaperSpiffFuncs(blueprint *blueprints.Blueprint, functions spiffing.Fu
 	functions.RegisterFunction("getServiceAccountKubeconfig", getServiceAccountKubeconfigSpiffFunc(targetResolver, false))
 	functions.RegisterFunction("getServiceAccountKubeconfigWithExpirationTimestamp", getServiceAccountKubeconfigSpiffFunc(targetResolver, true))
 	functions.RegisterFunction("getOidcKubeconfig", getOidcKubeconfigSpiffFunc(targetResolver))
+	functions.RegisterFunction("resolveComponentVersion", resolveComponentVersionSpiffFunc(versionResolver))
 
 	return nil
 }
@@ -509,6 +510,35 @@ func getOidcKubeconfigSpiffFunc(targetResolver targetresolver.TargetResolver) dy
 	}
 }
 
+func resolveComponentVersionSpiffFunc(versionResolver template.ComponentVersionResolver) dynaml.Function {
+	return func(args []interface{}, binding dynaml.Binding) (interface{}, dynaml.EvaluationInfo, bool) {
+		info := dynaml.DefaultInfo()
+		if versionResolver == nil {
+			return info.Error("templating function resolveComponentVersion is not available in this context")
+		}
+		if len(args) != 2 {
+			return info.Error("templating function resolveComponentVersion expects 2 arguments: component name and version constraint")
+		}
+
+		componentName, ok := args[0].(string)
+		if !ok {
+			return info.Error("templating function resolveComponentVersion expects a string as 1st argument, namely the component name")
+		}
+
+		constraint, ok := args[1].(string)
+		if !ok {
+			return info.Error("templating function resolveComponentVersion expects a string as 2nd argument, namely the version constraint")
+		}
+
+		version, err := versionResolver.ResolveComponentVersion(componentName, constraint)
+		if err != nil {
+			return info.Error(err)
+		}
+
+		return version, info, true
+	}
+}
+
 func toInt64(value interface{}) (int64, error) {
 	switch n := value.(type) {
 	case int64:
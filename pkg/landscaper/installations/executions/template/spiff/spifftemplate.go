@@ -22,9 +22,10 @@ import (
 
 // Templater describes the spiff template implementation for execution templater.
 type Templater struct {
-	state          template.GenericStateHandler
-	inputFormatter *template.TemplateInputFormatter
-	targetResolver targetresolver.TargetResolver
+	state           template.GenericStateHandler
+	inputFormatter  *template.TemplateInputFormatter
+	targetResolver  targetresolver.TargetResolver
+	versionResolver template.ComponentVersionResolver
 }
 
 // New creates a new spiff execution templater.
@@ -42,6 +43,13 @@ func (t *Templater) WithInputFormatter(inputFormatter *template.TemplateInputFor
 	return t
 }
 
+// WithComponentVersionResolver adds a resolver for component versions to this templater.
+// Without a resolver, component versions cannot be resolved by semver constraints.
+func (t *Templater) WithComponentVersionResolver(versionResolver template.ComponentVersionResolver) *Templater {
+	t.versionResolver = versionResolver
+	return t
+}
+
 func (t Templater) Type() lsv1alpha1.TemplateType {
 	return lsv1alpha1.SpiffTemplateType
 }
@@ -64,7 +72,7 @@ func (t *Templater) TemplateSubinstallationExecutions(tmplExec lsv1alpha1.Templa
 	}
 
 	functions := spiffing.NewFunctions()
-	if err = LandscaperSpiffFuncs(blueprint, functions, cd, cdList, t.targetResolver); err != nil {
+	if err = LandscaperSpiffFuncs(blueprint, functions, cd, cdList, t.targetResolver, t.versionResolver); err != nil {
 		return nil, err
 	}
 
@@ -109,7 +117,7 @@ func (t *Templater) TemplateImportExecutions(tmplExec lsv1alpha1.TemplateExecuto
 	defer ctx.Done()
 
 	functions := spiffing.NewFunctions()
-	if err = LandscaperSpiffFuncs(blueprint, functions, descriptor, cdList, t.targetResolver); err != nil {
+	if err = LandscaperSpiffFuncs(blueprint, functions, descriptor, cdList, t.targetResolver, t.versionResolver); err != nil {
 		return nil, err
 	}
 
@@ -137,6 +145,47 @@ func (t *Templater) TemplateImportExecutions(tmplExec lsv1alpha1.TemplateExecuto
 	return output, nil
 }
 
+// TemplateStageExecutions is the spiff executor for an execution of a render stage.
+func (t *Templater) TemplateStageExecutions(tmplExec lsv1alpha1.TemplateExecutor,
+	blueprint *blueprints.Blueprint,
+	descriptor model.ComponentVersion,
+	cdList *model.ComponentVersionList,
+	values map[string]interface{}) (*template.StageExecutorOutput, error) {
+
+	rawTemplate, err := t.templateNode(tmplExec, blueprint)
+	if err != nil {
+		return nil, err
+	}
+
+	functions := spiffing.NewFunctions()
+	if err = LandscaperSpiffFuncs(blueprint, functions, descriptor, cdList, t.targetResolver, t.versionResolver); err != nil {
+		return nil, err
+	}
+
+	spiff, err := spiffing.New().WithFunctions(functions).WithFileSystem(blueprint.Fs).WithValues(values)
+	if err != nil {
+		return nil, fmt.Errorf("unable to init spiff templater: %w", err)
+	}
+
+	res, err := spiff.Cascade(rawTemplate, nil)
+	if err != nil {
+		cascadeError := TemplateErrorBuilder(err).
+			WithInput(values, t.inputFormatter).
+			Build()
+		return nil, cascadeError
+	}
+
+	data, err := spiffyaml.Marshal(res)
+	if err != nil {
+		return nil, err
+	}
+	output := &template.StageExecutorOutput{}
+	if err := yaml.Unmarshal(data, output); err != nil {
+		return nil, err
+	}
+	return output, nil
+}
+
 func (t *Templater) TemplateDeployExecutions(tmplExec lsv1alpha1.TemplateExecutor,
 	blueprint *blueprints.Blueprint,
 	descriptor model.ComponentVersion,
@@ -155,7 +204,7 @@ func (t *Templater) TemplateDeployExecutions(tmplExec lsv1alpha1.TemplateExecuto
 	}
 
 	functions := spiffing.NewFunctions()
-	if err = LandscaperSpiffFuncs(blueprint, functions, descriptor, cdList, t.targetResolver); err != nil {
+	if err = LandscaperSpiffFuncs(blueprint, functions, descriptor, cdList, t.targetResolver, t.versionResolver); err != nil {
 		return nil, err
 	}
 
@@ -204,7 +253,7 @@ func (t *Templater) TemplateExportExecutions(tmplExec lsv1alpha1.TemplateExecuto
 	}
 
 	functions := spiffing.NewFunctions()
-	if err = LandscaperSpiffFuncs(blueprint, functions, descriptor, cdList, t.targetResolver); err != nil {
+	if err = LandscaperSpiffFuncs(blueprint, functions, descriptor, cdList, t.targetResolver, t.versionResolver); err != nil {
 		return nil, err
 	}
 
@@ -99,6 +99,18 @@ func (s KubernetesStateHandler) secretName(name string) string {
 	return base32.NewEncoding(lsv1alpha1helper.Base32EncodeStdLowerCase).WithPadding(base32.NoPadding).EncodeToString(h.Sum(nil))
 }
 
+// ReadOnlyStateHandler wraps a state handler and discards all states that should be stored.
+// It is used for additional templating runs, which must not modify the state.
+type ReadOnlyStateHandler struct {
+	GenericStateHandler
+}
+
+var _ GenericStateHandler = ReadOnlyStateHandler{}
+
+func (s ReadOnlyStateHandler) Store(_ context.Context, _ string, _ []byte) error {
+	return nil
+}
+
 type MemoryStateHandler map[string][]byte
 
 var _ GenericStateHandler = MemoryStateHandler{}
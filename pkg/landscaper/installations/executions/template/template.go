@@ -23,6 +23,7 @@ import (
 	"github.com/gardener/landscaper/pkg/api"
 	"github.com/gardener/landscaper/pkg/components/model"
 	"github.com/gardener/landscaper/pkg/landscaper/blueprints"
+	"github.com/gardener/landscaper/pkg/landscaper/jsonschema"
 	"github.com/gardener/landscaper/pkg/utils"
 )
 
@@ -71,6 +72,19 @@ type ExecutionTemplater interface {
 		descriptor model.ComponentVersion,
 		cdList *model.ComponentVersionList,
 		values map[string]interface{}) (*ExportExecutorOutput, error)
+	// TemplateStageExecutions templates an executor of a render stage and returns the values of the stage.
+	TemplateStageExecutions(tmplExec lsv1alpha1.TemplateExecutor,
+		blueprint *blueprints.Blueprint,
+		cd model.ComponentVersion,
+		cdList *model.ComponentVersionList,
+		values map[string]interface{}) (*StageExecutorOutput, error)
+}
+
+// ComponentVersionResolver describes a resolver for the templating functions that resolve
+// the latest version of a component matching a semver constraint.
+type ComponentVersionResolver interface {
+	// ResolveComponentVersion returns the latest version of the named component that matches the semver constraint.
+	ResolveComponentVersion(componentName, constraint string) (string, error)
 }
 
 // SubinstallationExecutorOutput describes the output of deploy executor.
@@ -155,11 +169,27 @@ type DeployItemSpecification struct {
 	// +optional
 	Timeout *lsv1alpha1.Duration `json:"timeout,omitempty"`
 
+	// PickupTimeout overwrites the globally configured pickup timeout for this deploy item.
+	// +optional
+	PickupTimeout *lsv1alpha1.Duration `json:"pickupTimeout,omitempty"`
+
+	// AbortTimeout overwrites the globally configured abort timeout for this deploy item.
+	// +optional
+	AbortTimeout *lsv1alpha1.Duration `json:"abortTimeout,omitempty"`
+
 	// UpdateOnChangeOnly specifies if redeployment is executed only if the specification of the deploy item has changed.
 	// +optional
 	UpdateOnChangeOnly bool `json:"updateOnChangeOnly,omitempty"`
 
 	OnDelete *core.OnDeleteConfig
+
+	// ExclusionWindows define recurring time windows during which the deploy item is neither updated nor deleted.
+	// +optional
+	ExclusionWindows []core.ExclusionWindow `json:"exclusionWindows,omitempty"`
+
+	// RetryPolicy defines how the deployer retries a failed reconcile or deletion of the deploy item.
+	// +optional
+	RetryPolicy *core.RetryPolicy `json:"retryPolicy,omitempty"`
 }
 
 // DeployExecutorOutput describes the output of deploy executor.
@@ -172,6 +202,11 @@ type ExportExecutorOutput struct {
 	Exports map[string]interface{} `json:"exports"`
 }
 
+// StageExecutorOutput describes the output of a render stage executor.
+type StageExecutorOutput struct {
+	Values map[string]interface{} `json:"values"`
+}
+
 func (o *Templater) TemplateImportExecutions(opts BlueprintExecutionOptions) ([]string, map[string]interface{}, error) {
 	values, err := opts.Values()
 	if err != nil {
@@ -221,6 +256,9 @@ func (o *Templater) TemplateSubinstallationExecutions(opts DeployExecutionOption
 	if err != nil {
 		return nil, err
 	}
+	if err := o.templateRenderStages(opts.BlueprintExecutionOptions, values); err != nil {
+		return nil, err
+	}
 	installationTemplates := make([]*lsv1alpha1.InstallationTemplate, 0)
 	for _, tmplExec := range opts.Blueprint.Info.SubinstallationExecutions {
 		impl, ok := o.impl[tmplExec.Type]
@@ -243,11 +281,18 @@ func (o *Templater) TemplateSubinstallationExecutions(opts DeployExecutionOption
 
 // TemplateDeployExecutions templates all deploy executions and returns a aggregated list of all templated deploy item templates.
 func (o *Templater) TemplateDeployExecutions(opts DeployExecutionOptions) ([]DeployItemSpecification, error) {
-
 	values, err := opts.Values()
 	if err != nil {
 		return nil, err
 	}
+	return o.templateDeployExecutions(opts, values)
+}
+
+// templateDeployExecutions templates the render stages and all deploy executions with the given values.
+func (o *Templater) templateDeployExecutions(opts DeployExecutionOptions, values map[string]interface{}) ([]DeployItemSpecification, error) {
+	if err := o.templateRenderStages(opts.BlueprintExecutionOptions, values); err != nil {
+		return nil, err
+	}
 
 	deployItemTemplateList := []DeployItemSpecification{}
 	for _, tmplExec := range opts.Blueprint.Info.DeployExecutions {
@@ -293,6 +338,48 @@ func (o *Templater) TemplateExportExecutions(opts ExportExecutionOptions) (map[s
 	return exportData, nil
 }
 
+// templateRenderStages templates the render stages of the blueprint and adds their values to the given values
+// as "stages.<stage name>".
+// The values of a stage are validated against the schema of the stage before the next stage is templated,
+// so that an invalid intermediate result fails at the stage that produced it.
+func (o *Templater) templateRenderStages(opts BlueprintExecutionOptions, values map[string]interface{}) error {
+	if len(opts.Blueprint.Info.RenderStages) == 0 {
+		return nil
+	}
+
+	refCtx := &jsonschema.ReferenceContext{
+		LocalTypes:       opts.Blueprint.Info.LocalTypes,
+		BlueprintFs:      opts.Blueprint.Fs,
+		ComponentVersion: opts.ComponentVersion,
+	}
+
+	stages := map[string]interface{}{}
+	values["stages"] = stages
+	for _, stage := range opts.Blueprint.Info.RenderStages {
+		stageValues := map[string]interface{}{}
+		for _, tmplExec := range stage.Executions {
+			impl, ok := o.impl[tmplExec.Type]
+			if !ok {
+				return fmt.Errorf("render stage %q: unknown template type %s", stage.Name, tmplExec.Type)
+			}
+
+			output, err := impl.TemplateStageExecutions(tmplExec, opts.Blueprint, opts.ComponentVersion, opts.ComponentVersions, values)
+			if err != nil {
+				return fmt.Errorf("render stage %q: execution %q failed: %w", stage.Name, tmplExec.Name, err)
+			}
+			stageValues = utils.MergeMaps(stageValues, output.Values)
+		}
+
+		if stage.Schema != nil {
+			if err := jsonschema.ValidateGoStruct(stage.Schema.RawMessage, stageValues, refCtx); err != nil {
+				return fmt.Errorf("render stage %q: values do not match the schema of the stage: %w", stage.Name, err)
+			}
+		}
+		stages[stage.Name] = stageValues
+	}
+	return nil
+}
+
 func serializeComponentDescriptor(componentVersion model.ComponentVersion, ocmSchemaVersion string) (interface{}, error) {
 	if componentVersion == nil {
 		return nil, nil
@@ -28,6 +28,7 @@ import (
 	"github.com/gardener/landscaper/pkg/landscaper/installations"
 	"github.com/gardener/landscaper/pkg/landscaper/installations/executions"
 	"github.com/gardener/landscaper/pkg/landscaper/installations/executions/template"
+	"github.com/gardener/landscaper/pkg/landscaper/installations/executions/template/cue"
 	"github.com/gardener/landscaper/pkg/landscaper/installations/executions/template/gotemplate"
 	"github.com/gardener/landscaper/pkg/landscaper/installations/executions/template/spiff"
 )
@@ -58,15 +59,18 @@ func (c *Constructor) Construct(ctx context.Context) ([]*dataobjects.DataObject,
 		}
 	)
 
+	lineage := dataobjects.ImportLineage(c.Inst.GetInstallation(), c.Context().Name)
+
 	execDo, err := executions.New(c.Operation).GetExportedValues(ctx, c.Inst)
 	if err != nil {
 		return nil, nil, err
 	}
 	if execDo != nil {
 		internalExports["deployitems"] = execDo.Data
+		lineage = lineage.Add(execDo.Metadata.Lineage...)
 	}
 
-	dataObjectMap, err := c.aggregateDataObjectsInContext(ctx)
+	dataObjectMap, err := c.aggregateDataObjectsInContext(ctx, &lineage)
 	if err != nil {
 		return nil, nil, fmt.Errorf("unable to aggregate data object: %w", err)
 	}
@@ -82,10 +86,12 @@ func (c *Constructor) Construct(ctx context.Context) ([]*dataobjects.DataObject,
 		Inst:       c.Inst.GetInstallation(),
 	}
 	targetResolver := genericresolver.New(c.LsUncachedClient())
+	versionResolver := c.ComponentVersionResolver(ctx)
 
 	tmpl := template.New(
-		gotemplate.New(stateHdlr, targetResolver),
-		spiff.New(stateHdlr, targetResolver))
+		gotemplate.New(stateHdlr, targetResolver).WithComponentVersionResolver(versionResolver),
+		spiff.New(stateHdlr, targetResolver).WithComponentVersionResolver(versionResolver),
+		cue.New())
 	exports, err := tmpl.TemplateExportExecutions(
 		template.NewExportExecutionOptions(
 			template.NewBlueprintExecutionOptions(
@@ -93,7 +99,7 @@ func (c *Constructor) Construct(ctx context.Context) ([]*dataobjects.DataObject,
 				c.Inst.GetBlueprint(),
 				c.ComponentVersion,
 				c.ResolvedComponentDescriptorList,
-				c.Inst.GetImports()), internalExports))
+				c.Inst.GetImports()).WithContextFeatureFlags(c.Context().External.FeatureFlags), internalExports))
 	if err != nil {
 		return nil, nil, err
 	}
@@ -159,6 +165,7 @@ func (c *Constructor) Construct(ctx context.Context) ([]*dataobjects.DataObject,
 		do := dataobjects.New().
 			SetSourceType(lsv1alpha1.ExportDataObjectSourceType).
 			SetKey(dataExport.DataRef).
+			SetLineage(lineage).
 			SetData(data)
 		dataObjects[i] = do
 	}
@@ -183,7 +190,9 @@ func (c *Constructor) Construct(ctx context.Context) ([]*dataobjects.DataObject,
 	return dataObjects, targets, nil
 }
 
-func (c *Constructor) aggregateDataObjectsInContext(ctx context.Context) (map[string]interface{}, error) {
+// aggregateDataObjectsInContext returns the data of all data objects in the context of the installation.
+// The lineage of the data objects that were exported by subinstallations is added to the given lineage.
+func (c *Constructor) aggregateDataObjectsInContext(ctx context.Context, lineage *dataobjects.Lineage) (map[string]interface{}, error) {
 	installationContext := lsv1alpha1helper.DataObjectSourceFromInstallation(c.Inst.GetInstallation())
 	dataObjectList := &lsv1alpha1.DataObjectList{}
 	if err := read_write_layer.ListDataObjects(ctx, c.LsUncachedClient(), dataObjectList, read_write_layer.R000070,
@@ -200,6 +209,11 @@ func (c *Constructor) aggregateDataObjectsInContext(ctx context.Context) (map[st
 			return nil, fmt.Errorf("error while decoding data object %s: %w", do.Name, err)
 		}
 		aggDataObjects[meta.Key] = data
+
+		if meta.SourceType == lsv1alpha1.ExportDataObjectSourceType {
+			*lineage = lineage.Add(dataobjects.LineageSource{Kind: dataobjects.LineageSourceDataObject, Name: do.Name})
+			*lineage = lineage.Add(meta.Lineage...)
+		}
 	}
 	return aggDataObjects, nil
 }
@@ -229,6 +243,9 @@ func (c *Constructor) aggregateTargetsInContext(ctx context.Context) (map[string
 	return aggTargets, nil
 }
 
+// ConvertTargetTemplateToTargetExtension builds a target from the exported target template of a blueprint.
+// The configuration of the target is either given inline or by a reference to a secret in the namespace of the
+// installation, e.g. a secret with the kubeconfig of a cluster that has been created by the installation.
 func ConvertTargetTemplateToTargetExtension(tmplData interface{}) (*dataobjects.TargetExtension, error) {
 	data, err := json.Marshal(tmplData)
 	if err != nil {
@@ -238,6 +255,9 @@ func ConvertTargetTemplateToTargetExtension(tmplData interface{}) (*dataobjects.
 	if err := json.Unmarshal(data, targetTemplate); err != nil {
 		return nil, err
 	}
+	if targetTemplate.Configuration != nil && targetTemplate.SecretRef != nil {
+		return nil, fmt.Errorf("either config or secretRef may be set, not both")
+	}
 	target := &lsv1alpha1.Target{
 		ObjectMeta: metav1.ObjectMeta{
 			Labels:      targetTemplate.Labels,
@@ -246,6 +266,7 @@ func ConvertTargetTemplateToTargetExtension(tmplData interface{}) (*dataobjects.
 		Spec: lsv1alpha1.TargetSpec{
 			Type:          targetTemplate.Type,
 			Configuration: targetTemplate.Configuration,
+			SecretRef:     targetTemplate.SecretRef,
 		},
 	}
 	return dataobjects.NewTargetExtension(target, nil), nil
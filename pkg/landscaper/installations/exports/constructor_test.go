@@ -257,6 +257,40 @@ var _ = Describe("Constructor", func() {
 			_, _, err = c.Construct(ctx)
 			Expect(err).To(HaveOccurred())
 		})
+
+		It("should export a target that references a secret", func() {
+			tmpl := map[string]interface{}{
+				"type": "landscaper.gardener.cloud/kubernetes-cluster",
+				"secretRef": map[string]interface{}{
+					"name": "my-cluster-kubeconfig",
+					"key":  "kubeconfig",
+				},
+				"labels": map[string]interface{}{
+					"cluster": "my-cluster",
+				},
+			}
+			res, err := exports.ConvertTargetTemplateToTargetExtension(tmpl)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(res.GetTarget().Spec.Type).To(Equal(lsv1alpha1.TargetType("landscaper.gardener.cloud/kubernetes-cluster")))
+			Expect(res.GetTarget().Spec.Configuration).To(BeNil())
+			Expect(res.GetTarget().Spec.SecretRef).To(Equal(&lsv1alpha1.LocalSecretReference{
+				Name: "my-cluster-kubeconfig",
+				Key:  "kubeconfig",
+			}))
+			Expect(res.GetTarget().Labels).To(HaveKeyWithValue("cluster", "my-cluster"))
+		})
+
+		It("should forbid the export of a target with a config and a secret reference", func() {
+			tmpl := map[string]interface{}{
+				"type":   "landscaper.gardener.cloud/kubernetes-cluster",
+				"config": map[string]interface{}{"kubeconfig": "abc"},
+				"secretRef": map[string]interface{}{
+					"name": "my-cluster-kubeconfig",
+				},
+			}
+			_, err := exports.ConvertTargetTemplateToTargetExtension(tmpl)
+			Expect(err).To(HaveOccurred())
+		})
 	})
 
 	Context("ExportDataMappings", func() {
@@ -0,0 +1,326 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package exports
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+
+	lsv1alpha1 "github.com/gardener/landscaper/apis/core/v1alpha1"
+	"github.com/gardener/landscaper/apis/core/v1alpha1/targettypes"
+	"github.com/gardener/landscaper/controller-utils/pkg/landscaper/targetresolver/secret"
+	"github.com/gardener/landscaper/controller-utils/pkg/logging"
+	"github.com/gardener/landscaper/pkg/landscaper/dataobjects"
+	"github.com/gardener/landscaper/pkg/utils"
+	"github.com/gardener/landscaper/pkg/utils/read_write_layer"
+)
+
+// DefaultExportSinkNamespace is the namespace in the target cluster that is used if an export sink defines no namespace.
+const DefaultExportSinkNamespace = "default"
+
+// targetClientTimeout is the timeout of the requests to the clusters of the targets of export sinks.
+const targetClientTimeout = 30 * time.Second
+
+// TargetClientFunc returns a client for the cluster of the target with the given namespace and name.
+type TargetClientFunc func(ctx context.Context, namespace, name string) (client.Client, error)
+
+// SinkWriter writes the data exports of an installation into the secrets and config maps of its export sinks.
+type SinkWriter struct {
+	targetClient TargetClientFunc
+}
+
+// NewSinkWriter creates a new export sink writer that reads the targets of the sinks with the given client.
+func NewSinkWriter(lsUncachedClient client.Client) *SinkWriter {
+	return &SinkWriter{
+		targetClient: NewTargetClientFunc(lsUncachedClient),
+	}
+}
+
+// WithTargetClientFunc sets the function that creates the clients for the clusters of the targets.
+func (w *SinkWriter) WithTargetClientFunc(f TargetClientFunc) *SinkWriter {
+	w.targetClient = f
+	return w
+}
+
+// NewTargetClientFunc returns a TargetClientFunc that creates a client from the kubeconfig
+// of a target of type landscaper.gardener.cloud/kubernetes-cluster.
+func NewTargetClientFunc(lsUncachedClient client.Client) TargetClientFunc {
+	return func(ctx context.Context, namespace, name string) (client.Client, error) {
+		target := &lsv1alpha1.Target{}
+		key := client.ObjectKey{Namespace: namespace, Name: name}
+		if err := read_write_layer.GetTarget(ctx, lsUncachedClient, key, target, read_write_layer.R000142); err != nil {
+			return nil, err
+		}
+		if target.Spec.Type != targettypes.KubernetesClusterTargetType {
+			return nil, fmt.Errorf("target %s has type %q but expected %q", key.String(), target.Spec.Type, targettypes.KubernetesClusterTargetType)
+		}
+
+		kubeconfig, err := secret.New(lsUncachedClient).GetKubeconfigFromTarget(ctx, target)
+		if err != nil {
+			return nil, err
+		}
+		defer utils.ZeroizeKubeconfig(kubeconfig)
+		restConfig, err := utils.RestConfigFromKubeconfig(kubeconfig)
+		if err != nil {
+			return nil, fmt.Errorf("unable to create rest config for target %s: %w", key.String(), err)
+		}
+		restConfig.Timeout = targetClientTimeout
+		return client.New(restConfig, client.Options{})
+	}
+}
+
+// Write creates or updates the secrets and config maps of all export sinks of the installation.
+// The data exports are the data objects that are constructed for the data exports of the installation.
+// The written objects are recorded in the status of the installation. Objects of sinks that have been removed
+// from the installation are deleted. The status has to be persisted by the caller, also if an error is returned.
+func (w *SinkWriter) Write(ctx context.Context, inst *lsv1alpha1.Installation, dataExports []*dataobjects.DataObject) error {
+	if len(inst.Spec.Exports.Sinks) == 0 && len(inst.Status.ExportSinks) == 0 {
+		return nil
+	}
+
+	clients := w.newClientCache(inst.Namespace)
+	values := exportValuesByName(inst, dataExports)
+	var written []lsv1alpha1.ExportSinkReference
+	for _, sink := range inst.Spec.Exports.Sinks {
+		ref := sinkReference(sink)
+		data, err := sinkData(sink, values)
+		if err != nil {
+			return fmt.Errorf("export sink %q: %w", sink.Name, err)
+		}
+
+		kubeClient, err := clients.get(ctx, ref.Target)
+		if err != nil {
+			return fmt.Errorf("export sink %q: unable to get client for target %q: %w", sink.Name, ref.Target, err)
+		}
+
+		obj := newSinkObject(ref)
+		if _, err := controllerutil.CreateOrUpdate(ctx, kubeClient, obj, func() error {
+			if err := checkSinkSource(obj, inst); err != nil {
+				return err
+			}
+			setSinkSource(obj, inst)
+			setSinkData(obj, data)
+			return nil
+		}); err != nil {
+			return fmt.Errorf("export sink %q: unable to write %s %s: %w", sink.Name, ref.Type, client.ObjectKeyFromObject(obj).String(), err)
+		}
+
+		written = append(written, ref)
+		// the object is recorded immediately, so that it is cleaned up even if a subsequent sink fails
+		if !containsSinkReference(inst.Status.ExportSinks, ref) {
+			inst.Status.ExportSinks = append(inst.Status.ExportSinks, ref)
+		}
+	}
+
+	for _, ref := range inst.Status.ExportSinks {
+		if containsSinkReference(written, ref) {
+			continue
+		}
+		if err := deleteSinkObject(ctx, clients, inst, ref); err != nil {
+			return err
+		}
+	}
+	inst.Status.ExportSinks = written
+	return nil
+}
+
+// Delete deletes the secrets and config maps of all export sinks of the installation.
+// These are the objects recorded in the status and the objects of the sinks of the spec.
+// Objects that have not been written by the installation are kept.
+// Sinks whose target does not exist anymore are skipped.
+func (w *SinkWriter) Delete(ctx context.Context, inst *lsv1alpha1.Installation) error {
+	clients := w.newClientCache(inst.Namespace)
+	refs := append([]lsv1alpha1.ExportSinkReference{}, inst.Status.ExportSinks...)
+	for _, sink := range inst.Spec.Exports.Sinks {
+		if ref := sinkReference(sink); !containsSinkReference(refs, ref) {
+			refs = append(refs, ref)
+		}
+	}
+
+	for _, ref := range refs {
+		if err := deleteSinkObject(ctx, clients, inst, ref); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// deleteSinkObject deletes the secret or config map of an export sink, if it has been written by the installation.
+func deleteSinkObject(ctx context.Context, clients *clientCache, inst *lsv1alpha1.Installation, ref lsv1alpha1.ExportSinkReference) error {
+	logger, ctx := logging.FromContextOrNew(ctx, nil)
+
+	kubeClient, err := clients.get(ctx, ref.Target)
+	if err != nil {
+		if apierrors.IsNotFound(err) {
+			logger.Info("skipping deletion of export sink, because its target does not exist", "sink", ref.Name, "target", ref.Target)
+			return nil
+		}
+		return fmt.Errorf("export sink %q: unable to get client for target %q: %w", ref.Name, ref.Target, err)
+	}
+
+	obj := newSinkObject(ref)
+	if err := kubeClient.Get(ctx, client.ObjectKeyFromObject(obj), obj); err != nil {
+		if apierrors.IsNotFound(err) {
+			return nil
+		}
+		return fmt.Errorf("export sink %q: unable to get %s %s: %w", ref.Name, ref.Type, client.ObjectKeyFromObject(obj).String(), err)
+	}
+	if obj.GetAnnotations()[lsv1alpha1.ExportSinkSourceAnnotation] != sinkSource(inst) {
+		return nil
+	}
+	if err := kubeClient.Delete(ctx, obj); client.IgnoreNotFound(err) != nil {
+		return fmt.Errorf("export sink %q: unable to delete %s %s: %w", ref.Name, ref.Type, client.ObjectKeyFromObject(obj).String(), err)
+	}
+	return nil
+}
+
+// clientCache creates the client of each target only once per write or delete of the export sinks.
+type clientCache struct {
+	targetClient TargetClientFunc
+	namespace    string
+	clients      map[string]client.Client
+}
+
+func (w *SinkWriter) newClientCache(namespace string) *clientCache {
+	return &clientCache{
+		targetClient: w.targetClient,
+		namespace:    namespace,
+		clients:      map[string]client.Client{},
+	}
+}
+
+func (c *clientCache) get(ctx context.Context, target string) (client.Client, error) {
+	if kubeClient, ok := c.clients[target]; ok {
+		return kubeClient, nil
+	}
+	kubeClient, err := c.targetClient(ctx, c.namespace, target)
+	if err != nil {
+		return nil, err
+	}
+	c.clients[target] = kubeClient
+	return kubeClient, nil
+}
+
+// exportValuesByName returns the values of the data exports by the names of the data exports of the installation.
+func exportValuesByName(inst *lsv1alpha1.Installation, dataExports []*dataobjects.DataObject) map[string]interface{} {
+	valuesByDataRef := map[string]interface{}{}
+	for _, do := range dataExports {
+		valuesByDataRef[do.Metadata.Key] = do.Data
+	}
+
+	values := map[string]interface{}{}
+	for _, exp := range inst.Spec.Exports.Data {
+		if value, ok := valuesByDataRef[exp.DataRef]; ok {
+			values[exp.Name] = value
+		}
+	}
+	return values
+}
+
+// sinkData returns the content of the secret or config map of an export sink.
+// String values are written as they are, all other values are written as json.
+func sinkData(sink lsv1alpha1.ExportSink, values map[string]interface{}) (map[string][]byte, error) {
+	data := map[string][]byte{}
+	for _, d := range sink.Data {
+		value, ok := values[d.Export]
+		if !ok {
+			return nil, fmt.Errorf("data export %q is not defined", d.Export)
+		}
+		if s, ok := value.(string); ok {
+			data[d.Key] = []byte(s)
+			continue
+		}
+		raw, err := json.Marshal(value)
+		if err != nil {
+			return nil, fmt.Errorf("unable to marshal data export %q: %w", d.Export, err)
+		}
+		data[d.Key] = raw
+	}
+	return data, nil
+}
+
+func sinkType(sink lsv1alpha1.ExportSink) lsv1alpha1.ExportSinkType {
+	if len(sink.Type) == 0 {
+		return lsv1alpha1.SecretExportSinkType
+	}
+	return sink.Type
+}
+
+func sinkSource(inst *lsv1alpha1.Installation) string {
+	return client.ObjectKeyFromObject(inst).String()
+}
+
+func sinkReference(sink lsv1alpha1.ExportSink) lsv1alpha1.ExportSinkReference {
+	ref := lsv1alpha1.ExportSinkReference{
+		Target:    sink.Target,
+		Type:      sinkType(sink),
+		Namespace: sink.Namespace,
+		Name:      sink.Name,
+	}
+	if len(ref.Namespace) == 0 {
+		ref.Namespace = DefaultExportSinkNamespace
+	}
+	return ref
+}
+
+func containsSinkReference(refs []lsv1alpha1.ExportSinkReference, ref lsv1alpha1.ExportSinkReference) bool {
+	for _, r := range refs {
+		if r == ref {
+			return true
+		}
+	}
+	return false
+}
+
+func newSinkObject(ref lsv1alpha1.ExportSinkReference) client.Object {
+	objMeta := metav1.ObjectMeta{
+		Name:      ref.Name,
+		Namespace: ref.Namespace,
+	}
+	if ref.Type == lsv1alpha1.ConfigMapExportSinkType {
+		return &corev1.ConfigMap{ObjectMeta: objMeta}
+	}
+	return &corev1.Secret{ObjectMeta: objMeta}
+}
+
+// checkSinkSource ensures that an existing object is not overwritten, unless it has been written by the installation.
+func checkSinkSource(obj client.Object, inst *lsv1alpha1.Installation) error {
+	if obj.GetResourceVersion() == "" {
+		return nil
+	}
+	if source := obj.GetAnnotations()[lsv1alpha1.ExportSinkSourceAnnotation]; source != sinkSource(inst) {
+		return fmt.Errorf("the object already exists and is not written by the installation (source: %q)", source)
+	}
+	return nil
+}
+
+func setSinkSource(obj client.Object, inst *lsv1alpha1.Installation) {
+	annotations := obj.GetAnnotations()
+	if annotations == nil {
+		annotations = map[string]string{}
+	}
+	annotations[lsv1alpha1.ExportSinkSourceAnnotation] = sinkSource(inst)
+	obj.SetAnnotations(annotations)
+}
+
+func setSinkData(obj client.Object, data map[string][]byte) {
+	switch o := obj.(type) {
+	case *corev1.Secret:
+		o.Data = data
+	case *corev1.ConfigMap:
+		o.Data = make(map[string]string, len(data))
+		for key, value := range data {
+			o.Data[key] = string(value)
+		}
+	}
+}
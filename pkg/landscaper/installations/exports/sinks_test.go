@@ -0,0 +1,172 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package exports_test
+
+import (
+	"context"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	lsv1alpha1 "github.com/gardener/landscaper/apis/core/v1alpha1"
+	"github.com/gardener/landscaper/pkg/api"
+	"github.com/gardener/landscaper/pkg/landscaper/dataobjects"
+	"github.com/gardener/landscaper/pkg/landscaper/installations/exports"
+)
+
+var _ = Describe("Export Sinks", func() {
+
+	var (
+		ctx          context.Context
+		targetClient client.Client
+		writer       *exports.SinkWriter
+		inst         *lsv1alpha1.Installation
+		dataExports  []*dataobjects.DataObject
+	)
+
+	BeforeEach(func() {
+		ctx = context.Background()
+		targetClient = fake.NewClientBuilder().WithScheme(api.LandscaperScheme).Build()
+		writer = exports.NewSinkWriter(nil).WithTargetClientFunc(func(_ context.Context, namespace, name string) (client.Client, error) {
+			if namespace != "test" || name != "my-cluster" {
+				return nil, apierrors.NewNotFound(schema.GroupResource{Resource: "targets"}, name)
+			}
+			return targetClient, nil
+		})
+
+		inst = &lsv1alpha1.Installation{}
+		inst.Name = "my-inst"
+		inst.Namespace = "test"
+		inst.Spec.Exports.Data = []lsv1alpha1.DataExport{
+			{Name: "url", DataRef: "my-url"},
+			{Name: "config", DataRef: "my-config"},
+		}
+		inst.Spec.Exports.Sinks = []lsv1alpha1.ExportSink{
+			{
+				Name:   "endpoint",
+				Target: "my-cluster",
+				Data: []lsv1alpha1.ExportSinkData{
+					{Key: "url", Export: "url"},
+					{Key: "config.json", Export: "config"},
+				},
+			},
+			{
+				Name:      "endpoint",
+				Namespace: "app",
+				Type:      lsv1alpha1.ConfigMapExportSinkType,
+				Target:    "my-cluster",
+				Data:      []lsv1alpha1.ExportSinkData{{Key: "url", Export: "url"}},
+			},
+		}
+
+		dataExports = []*dataobjects.DataObject{
+			dataobjects.New().SetKey("my-url").SetData("https://example.com"),
+			dataobjects.New().SetKey("my-config").SetData(map[string]interface{}{"replicas": 3}),
+		}
+	})
+
+	It("should write the data exports into the secrets and config maps of the sinks", func() {
+		Expect(writer.Write(ctx, inst, dataExports)).To(Succeed())
+
+		secret := &corev1.Secret{}
+		Expect(targetClient.Get(ctx, client.ObjectKey{Name: "endpoint", Namespace: exports.DefaultExportSinkNamespace}, secret)).To(Succeed())
+		Expect(secret.Annotations).To(HaveKeyWithValue(lsv1alpha1.ExportSinkSourceAnnotation, "test/my-inst"))
+		Expect(secret.Data).To(HaveKeyWithValue("url", []byte("https://example.com")))
+		Expect(secret.Data).To(HaveKeyWithValue("config.json", MatchJSON(`{"replicas": 3}`)))
+
+		cm := &corev1.ConfigMap{}
+		Expect(targetClient.Get(ctx, client.ObjectKey{Name: "endpoint", Namespace: "app"}, cm)).To(Succeed())
+		Expect(cm.Data).To(Equal(map[string]string{"url": "https://example.com"}))
+
+		// the objects are updated with the new values of the exports
+		dataExports[0].SetData("https://example.org")
+		Expect(writer.Write(ctx, inst, dataExports)).To(Succeed())
+		Expect(targetClient.Get(ctx, client.ObjectKeyFromObject(cm), cm)).To(Succeed())
+		Expect(cm.Data).To(Equal(map[string]string{"url": "https://example.org"}))
+	})
+
+	It("should not overwrite an object that has not been written by the installation", func() {
+		existing := &corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{Name: "endpoint", Namespace: exports.DefaultExportSinkNamespace},
+			Data:       map[string][]byte{"foo": []byte("bar")},
+		}
+		Expect(targetClient.Create(ctx, existing)).To(Succeed())
+
+		err := writer.Write(ctx, inst, dataExports)
+		Expect(err).To(HaveOccurred())
+		Expect(err.Error()).To(ContainSubstring("not written by the installation"))
+
+		Expect(targetClient.Get(ctx, client.ObjectKeyFromObject(existing), existing)).To(Succeed())
+		Expect(existing.Data).To(Equal(map[string][]byte{"foo": []byte("bar")}))
+	})
+
+	It("should fail if a sink references a data export without value", func() {
+		err := writer.Write(ctx, inst, dataExports[:1])
+		Expect(err).To(HaveOccurred())
+		Expect(err.Error()).To(ContainSubstring(`data export "config" is not defined`))
+	})
+
+	It("should only delete the objects that have been written by the installation", func() {
+		Expect(writer.Write(ctx, inst, dataExports)).To(Succeed())
+
+		cm := &corev1.ConfigMap{}
+		Expect(targetClient.Get(ctx, client.ObjectKey{Name: "endpoint", Namespace: "app"}, cm)).To(Succeed())
+		cm.Annotations[lsv1alpha1.ExportSinkSourceAnnotation] = "test/other-inst"
+		Expect(targetClient.Update(ctx, cm)).To(Succeed())
+
+		Expect(writer.Delete(ctx, inst)).To(Succeed())
+
+		secret := &corev1.Secret{}
+		err := targetClient.Get(ctx, client.ObjectKey{Name: "endpoint", Namespace: exports.DefaultExportSinkNamespace}, secret)
+		Expect(apierrors.IsNotFound(err)).To(BeTrue())
+		Expect(targetClient.Get(ctx, client.ObjectKeyFromObject(cm), cm)).To(Succeed())
+	})
+
+	It("should record the written objects and delete the objects of removed sinks", func() {
+		Expect(writer.Write(ctx, inst, dataExports)).To(Succeed())
+		Expect(inst.Status.ExportSinks).To(ConsistOf(
+			lsv1alpha1.ExportSinkReference{Target: "my-cluster", Type: lsv1alpha1.SecretExportSinkType, Namespace: exports.DefaultExportSinkNamespace, Name: "endpoint"},
+			lsv1alpha1.ExportSinkReference{Target: "my-cluster", Type: lsv1alpha1.ConfigMapExportSinkType, Namespace: "app", Name: "endpoint"},
+		))
+
+		inst.Spec.Exports.Sinks = inst.Spec.Exports.Sinks[:1]
+		Expect(writer.Write(ctx, inst, dataExports)).To(Succeed())
+		Expect(inst.Status.ExportSinks).To(ConsistOf(
+			lsv1alpha1.ExportSinkReference{Target: "my-cluster", Type: lsv1alpha1.SecretExportSinkType, Namespace: exports.DefaultExportSinkNamespace, Name: "endpoint"},
+		))
+
+		cm := &corev1.ConfigMap{}
+		err := targetClient.Get(ctx, client.ObjectKey{Name: "endpoint", Namespace: "app"}, cm)
+		Expect(apierrors.IsNotFound(err)).To(BeTrue())
+		secret := &corev1.Secret{}
+		Expect(targetClient.Get(ctx, client.ObjectKey{Name: "endpoint", Namespace: exports.DefaultExportSinkNamespace}, secret)).To(Succeed())
+	})
+
+	It("should delete the recorded objects of sinks that have been removed before the deletion", func() {
+		Expect(writer.Write(ctx, inst, dataExports)).To(Succeed())
+		inst.Spec.Exports.Sinks = nil
+
+		Expect(writer.Delete(ctx, inst)).To(Succeed())
+
+		secret := &corev1.Secret{}
+		err := targetClient.Get(ctx, client.ObjectKey{Name: "endpoint", Namespace: exports.DefaultExportSinkNamespace}, secret)
+		Expect(apierrors.IsNotFound(err)).To(BeTrue())
+		cm := &corev1.ConfigMap{}
+		err = targetClient.Get(ctx, client.ObjectKey{Name: "endpoint", Namespace: "app"}, cm)
+		Expect(apierrors.IsNotFound(err)).To(BeTrue())
+	})
+
+	It("should skip the deletion of sinks whose target does not exist", func() {
+		inst.Spec.Exports.Sinks[0].Target = "deleted-cluster"
+		inst.Spec.Exports.Sinks = inst.Spec.Exports.Sinks[:1]
+		Expect(writer.Delete(ctx, inst)).To(Succeed())
+	})
+})
@@ -7,6 +7,7 @@ package installations
 import (
 	"context"
 	"fmt"
+	"sort"
 
 	cdv2 "github.com/gardener/component-spec/bindings-go/apis/v2"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -119,6 +120,7 @@ func CreateInternalInstallationBase(inst *lsv1alpha1.Installation) *Installation
 }
 
 // GetDataImport fetches the data import from the cluster.
+// Data objects of subinstallation contexts are taken from the process-wide import cache if possible.
 func GetDataImport(ctx context.Context,
 	kubeClient client.Client,
 	contextName string,
@@ -128,10 +130,11 @@ func GetDataImport(ctx context.Context,
 	var rawDataObject *lsv1alpha1.DataObject
 	// get deploy item from current context
 	if len(dataImport.DataRef) != 0 {
-		rawDataObject = &lsv1alpha1.DataObject{}
-		doName := lsv1alpha1helper.GenerateDataObjectName(contextName, dataImport.DataRef)
-		if err := kubeClient.Get(ctx, kubernetes.ObjectKey(doName, inst.GetInstallation().Namespace), rawDataObject); err != nil {
-			return nil, nil, fmt.Errorf("unable to fetch data object %s (%s/%s): %w", doName, contextName, dataImport.DataRef, err)
+		var err error
+		rawDataObject, err = GetImportCache().GetDataObject(ctx, kubeClient, inst.GetInstallation().Namespace,
+			contextName, dataImport.DataRef, inst.GetInstallation().Status.JobID)
+		if err != nil {
+			return nil, nil, err
 		}
 	}
 	if dataImport.SecretRef != nil {
@@ -156,6 +159,14 @@ func GetDataImport(ctx context.Context,
 		// set the generation as it is used to detect outdated imports.
 		rawDataObject.SetGeneration(gen)
 	}
+	if dataImport.HTTPRef != nil {
+		data, err := resolveHTTPDataReference(ctx, kubeClient, inst.GetInstallation().GetNamespace(), dataImport.HTTPRef)
+		if err != nil {
+			return nil, nil, err
+		}
+		rawDataObject = &lsv1alpha1.DataObject{}
+		rawDataObject.Data.RawMessage = data
+	}
 
 	do, err := dataobjects.NewFromDataObject(rawDataObject)
 	if err != nil {
@@ -163,6 +174,13 @@ func GetDataImport(ctx context.Context,
 	}
 	do.Def = &dataImport
 
+	if len(dataImport.Transformations) != 0 {
+		do.Data, err = dataobjects.Transform(do.Data, dataImport.Transformations)
+		if err != nil {
+			return nil, nil, fmt.Errorf("unable to transform data import %q: %w", dataImport.Name, err)
+		}
+	}
+
 	owner := kubernetes.GetOwner(do.Raw.ObjectMeta)
 	return do, owner, nil
 }
@@ -261,6 +279,36 @@ func GetTargetListImportBySelector(
 	return targetExtensionList, nil
 }
 
+// GetTargetListImportByTargetSelector fetches the target imports of a root installation from the cluster,
+// based on the target selector of the import.
+// The targets are sorted by name, so that the index of a target in the list is stable.
+func GetTargetListImportByTargetSelector(
+	ctx context.Context,
+	kubeClient client.Client,
+	inst *lsv1alpha1.Installation,
+	targetImport lsv1alpha1.TargetImport) (*dataobjects.TargetExtensionList, error) {
+	selector, err := metav1.LabelSelectorAsSelector(targetImport.TargetSelector)
+	if err != nil {
+		return nil, fmt.Errorf("unable to construct label selector: %w", err)
+	}
+	// targets that have been created by installations belong to a context and are not selected
+	r, err := labels.NewRequirement(lsv1alpha1.DataObjectContextLabel, selection.DoesNotExist, nil)
+	if err != nil {
+		return nil, fmt.Errorf("unable to construct label selector: %w", err)
+	}
+	selector = selector.Add(*r)
+
+	targets := &lsv1alpha1.TargetList{}
+	if err := read_write_layer.ListTargets(ctx, kubeClient, targets, read_write_layer.R000158,
+		client.InNamespace(inst.Namespace), &client.ListOptions{LabelSelector: selector}); err != nil {
+		return nil, err
+	}
+	sort.Slice(targets.Items, func(i, j int) bool {
+		return targets.Items[i].Name < targets.Items[j].Name
+	})
+	return dataobjects.NewTargetExtensionList(targets.Items, &targetImport), nil
+}
+
 // GetTargetMapImportByNames fetches the target imports from the cluster, based on a map of target names.
 func GetTargetMapImportByNames(
 	ctx context.Context,
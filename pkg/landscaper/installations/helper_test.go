@@ -386,4 +386,42 @@ var _ = Describe("helper", func() {
 
 	})
 
+	Context("GetTargetListImportByTargetSelector", func() {
+
+		It("should get all root targets with matching labels sorted by name", func() {
+			ctx := context.Background()
+			kubeClient, _, err := envtest.NewFakeClientFromPath("")
+			Expect(err).ToNot(HaveOccurred())
+
+			newTarget := func(name string, lbls map[string]string) {
+				target := &lsv1alpha1.Target{}
+				target.Name = name
+				target.Namespace = "default"
+				target.Labels = lbls
+				Expect(kubeClient.Create(ctx, target)).To(Succeed())
+			}
+			newTarget("cluster-b", map[string]string{"fleet": "prod"})
+			newTarget("cluster-a", map[string]string{"fleet": "prod"})
+			newTarget("cluster-c", map[string]string{"fleet": "dev"})
+			newTarget("cluster-d", map[string]string{"fleet": "prod", lsv1alpha1.DataObjectContextLabel: "Inst.parent"})
+
+			targetImport := lsv1alpha1.TargetImport{
+				Name: "clusters",
+				TargetSelector: &metav1.LabelSelector{
+					MatchLabels: map[string]string{"fleet": "prod"},
+				},
+			}
+			inst := &lsv1alpha1.Installation{}
+			inst.Namespace = "default"
+
+			tl, err := installations.GetTargetListImportByTargetSelector(ctx, kubeClient, inst, targetImport)
+			Expect(err).ToNot(HaveOccurred())
+			names := []string{}
+			for _, t := range tl.GetTargetExtensions() {
+				names = append(names, t.GetTarget().Name)
+			}
+			Expect(names).To(Equal([]string{"cluster-a", "cluster-b"}))
+		})
+	})
+
 })
@@ -0,0 +1,218 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package installations
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/gardener/landscaper/apis/config"
+	lsv1alpha1 "github.com/gardener/landscaper/apis/core/v1alpha1"
+	lscutils "github.com/gardener/landscaper/controller-utils/pkg/landscaper"
+	"github.com/gardener/landscaper/pkg/landscaper/dataobjects/jsonpath"
+)
+
+const (
+	// DefaultHTTPImportCacheDuration is the default time a fetched http import is reused.
+	DefaultHTTPImportCacheDuration = 5 * time.Minute
+
+	// httpImportTimeout is the timeout of a single request of a http import.
+	httpImportTimeout = 30 * time.Second
+
+	// httpImportMaxSize is the maximal size of a document that is fetched by a http import.
+	httpImportMaxSize = 10 * 1024 * 1024
+)
+
+// HTTPImportCache caches the documents fetched by http imports so that the endpoints
+// are not requested on every reconcile of an installation.
+// Documents are only fetched from the allowed hosts, also when following redirects.
+type HTTPImportCache struct {
+	mux          sync.Mutex
+	entries      map[string]httpImportCacheEntry
+	client       *http.Client
+	allowedHosts []string
+	now          func() time.Time
+}
+
+type httpImportCacheEntry struct {
+	data       []byte
+	expiration time.Time
+}
+
+// NewHTTPImportCache creates a new cache for http imports that uses the given http client
+// and only fetches documents from the given hosts.
+func NewHTTPImportCache(httpClient *http.Client, allowedHosts []string) *HTTPImportCache {
+	c := &HTTPImportCache{
+		entries:      map[string]httpImportCacheEntry{},
+		allowedHosts: allowedHosts,
+		now:          time.Now,
+	}
+
+	client := *httpClient
+	client.CheckRedirect = func(req *http.Request, via []*http.Request) error {
+		if len(via) >= 10 {
+			return fmt.Errorf("stopped after 10 redirects")
+		}
+		return c.checkHost(req.URL)
+	}
+	c.client = &client
+	return c
+}
+
+var defaultHTTPImportCache = NewHTTPImportCache(&http.Client{Timeout: httpImportTimeout}, nil)
+
+// SetHTTPImportsConfiguration configures the process-wide cache for http imports.
+func SetHTTPImportsConfiguration(cfg *config.HTTPImportsConfiguration) {
+	var allowedHosts []string
+	if cfg != nil {
+		allowedHosts = cfg.AllowedHosts
+	}
+	defaultHTTPImportCache = NewHTTPImportCache(&http.Client{Timeout: httpImportTimeout}, allowedHosts)
+}
+
+// resolveHTTPDataReference fetches the value of a http import using the process-wide cache.
+func resolveHTTPDataReference(ctx context.Context, kubeClient client.Client, namespace string, ref *lsv1alpha1.HTTPDataReference) ([]byte, error) {
+	authHeader := ""
+	if ref.AuthSecretRef != nil {
+		secretRef := lscutils.SecretRefFromLocalRef(ref.AuthSecretRef, namespace)
+		_, data, _, err := lscutils.ResolveSecretReference(ctx, kubeClient, secretRef)
+		if err != nil {
+			return nil, fmt.Errorf("unable to resolve auth secret of http import: %w", err)
+		}
+		authHeader = strings.TrimSpace(string(data))
+	}
+	return defaultHTTPImportCache.Get(ctx, ref, authHeader)
+}
+
+// Get returns the imported value of the given http data reference.
+// The referenced document is only fetched if there is no valid cache entry for the url, the authorization and the json path.
+func (c *HTTPImportCache) Get(ctx context.Context, ref *lsv1alpha1.HTTPDataReference, authHeader string) ([]byte, error) {
+	key := httpImportCacheKey(ref, authHeader)
+
+	c.mux.Lock()
+	entry, ok := c.entries[key]
+	c.mux.Unlock()
+	if ok && c.now().Before(entry.expiration) {
+		return entry.data, nil
+	}
+
+	data, err := c.fetch(ctx, ref, authHeader)
+	if err != nil {
+		return nil, err
+	}
+
+	cacheDuration := DefaultHTTPImportCacheDuration
+	if ref.CacheDuration != nil {
+		cacheDuration = ref.CacheDuration.Duration
+	}
+
+	c.mux.Lock()
+	defer c.mux.Unlock()
+	now := c.now()
+	for k, e := range c.entries {
+		if !now.Before(e.expiration) {
+			delete(c.entries, k)
+		}
+	}
+	if cacheDuration > 0 {
+		c.entries[key] = httpImportCacheEntry{
+			data:       data,
+			expiration: now.Add(cacheDuration),
+		}
+	}
+	return data, nil
+}
+
+func (c *HTTPImportCache) fetch(ctx context.Context, ref *lsv1alpha1.HTTPDataReference, authHeader string) ([]byte, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, ref.URL, nil)
+	if err != nil {
+		return nil, fmt.Errorf("unable to create request for http import %q: %w", ref.URL, err)
+	}
+	if err := c.checkHost(req.URL); err != nil {
+		return nil, fmt.Errorf("unable to fetch http import %q: %w", ref.URL, err)
+	}
+	req.Header.Set("Accept", "application/json")
+	if len(authHeader) != 0 {
+		req.Header.Set("Authorization", authHeader)
+	}
+
+	resp, err := c.client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("unable to fetch http import %q: %w", ref.URL, err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return nil, fmt.Errorf("unable to fetch http import %q: unexpected status code %d", ref.URL, resp.StatusCode)
+	}
+
+	body, err := io.ReadAll(io.LimitReader(resp.Body, httpImportMaxSize+1))
+	if err != nil {
+		return nil, fmt.Errorf("unable to read response of http import %q: %w", ref.URL, err)
+	}
+	if len(body) > httpImportMaxSize {
+		return nil, fmt.Errorf("response of http import %q is too large: the maximal size is %d bytes", ref.URL, httpImportMaxSize)
+	}
+
+	var document interface{}
+	if err := json.Unmarshal(body, &document); err != nil {
+		return nil, fmt.Errorf("response of http import %q is not a valid JSON document: %w", ref.URL, err)
+	}
+
+	if len(ref.JSONPath) != 0 {
+		var value interface{}
+		if err := jsonpath.GetValue(ref.JSONPath, document, &value); err != nil {
+			return nil, fmt.Errorf("unable to get value %q from http import %q: %w", ref.JSONPath, ref.URL, err)
+		}
+		document = value
+	}
+
+	data, err := json.Marshal(document)
+	if err != nil {
+		return nil, fmt.Errorf("unable to marshal value of http import %q: %w", ref.URL, err)
+	}
+	return data, nil
+}
+
+// checkHost checks that the host of the url is one of the allowed hosts.
+func (c *HTTPImportCache) checkHost(u *url.URL) error {
+	if u.Scheme != "http" && u.Scheme != "https" {
+		return fmt.Errorf("unsupported scheme %q", u.Scheme)
+	}
+
+	host := strings.ToLower(u.Hostname())
+	for _, allowed := range c.allowedHosts {
+		allowed = strings.ToLower(allowed)
+		if host == allowed {
+			return nil
+		}
+		if strings.HasPrefix(allowed, "*.") && strings.HasSuffix(host, allowed[1:]) {
+			return nil
+		}
+	}
+	return fmt.Errorf("host %q is not allowed for http imports", host)
+}
+
+// httpImportCacheKey returns the key of a http import in the cache.
+// The authorization is part of the key so that documents are not shared between different credentials.
+func httpImportCacheKey(ref *lsv1alpha1.HTTPDataReference, authHeader string) string {
+	h := sha256.New()
+	for _, s := range []string{ref.URL, authHeader, ref.JSONPath} {
+		h.Write([]byte(s))
+		h.Write([]byte{0})
+	}
+	return hex.EncodeToString(h.Sum(nil))
+}
@@ -0,0 +1,137 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package installations_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	lsv1alpha1 "github.com/gardener/landscaper/apis/core/v1alpha1"
+	"github.com/gardener/landscaper/pkg/landscaper/installations"
+)
+
+var _ = Describe("HTTPImportCache", func() {
+
+	var (
+		ctx      context.Context
+		server   *httptest.Server
+		requests int
+		cache    *installations.HTTPImportCache
+	)
+
+	BeforeEach(func() {
+		ctx = context.Background()
+		requests = 0
+		server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			switch r.URL.Path {
+			case "/redirect":
+				http.Redirect(w, r, "http://example.com/", http.StatusFound)
+				return
+			case "/large":
+				_, _ = w.Write([]byte(`"` + strings.Repeat("a", 10*1024*1024) + `"`))
+				return
+			}
+			if r.Header.Get("Authorization") != "Bearer token" {
+				w.WriteHeader(http.StatusUnauthorized)
+				return
+			}
+			requests++
+			w.Header().Set("Content-Type", "application/json")
+			_, _ = w.Write([]byte(`{"outputs": {"vpc_id": {"value": "vpc-123"}}}`))
+		}))
+		cache = installations.NewHTTPImportCache(server.Client(), []string{"127.0.0.1"})
+	})
+
+	AfterEach(func() {
+		server.Close()
+	})
+
+	It("should fetch the document and select the value of the json path", func() {
+		ref := &lsv1alpha1.HTTPDataReference{
+			URL:      server.URL,
+			JSONPath: ".outputs.vpc_id.value",
+		}
+
+		data, err := cache.Get(ctx, ref, "Bearer token")
+		Expect(err).ToNot(HaveOccurred())
+		Expect(string(data)).To(Equal(`"vpc-123"`))
+	})
+
+	It("should reuse a fetched document until the cache duration has passed", func() {
+		ref := &lsv1alpha1.HTTPDataReference{
+			URL:           server.URL,
+			CacheDuration: &lsv1alpha1.Duration{Duration: time.Hour},
+		}
+
+		data, err := cache.Get(ctx, ref, "Bearer token")
+		Expect(err).ToNot(HaveOccurred())
+		Expect(data).To(MatchJSON(`{"outputs": {"vpc_id": {"value": "vpc-123"}}}`))
+
+		_, err = cache.Get(ctx, ref, "Bearer token")
+		Expect(err).ToNot(HaveOccurred())
+		Expect(requests).To(Equal(1))
+	})
+
+	It("should fetch the document on every access if caching is disabled", func() {
+		ref := &lsv1alpha1.HTTPDataReference{
+			URL:           server.URL,
+			CacheDuration: &lsv1alpha1.Duration{Duration: 0},
+		}
+
+		_, err := cache.Get(ctx, ref, "Bearer token")
+		Expect(err).ToNot(HaveOccurred())
+		_, err = cache.Get(ctx, ref, "Bearer token")
+		Expect(err).ToNot(HaveOccurred())
+		Expect(requests).To(Equal(2))
+	})
+
+	It("should fail if the endpoint does not respond with a success status code", func() {
+		ref := &lsv1alpha1.HTTPDataReference{
+			URL: server.URL,
+		}
+
+		_, err := cache.Get(ctx, ref, "")
+		Expect(err).To(HaveOccurred())
+		Expect(err.Error()).To(ContainSubstring("unexpected status code 401"))
+	})
+
+	It("should fail if the host is not allowed", func() {
+		cache = installations.NewHTTPImportCache(server.Client(), []string{"*.example.com"})
+		ref := &lsv1alpha1.HTTPDataReference{
+			URL: server.URL,
+		}
+
+		_, err := cache.Get(ctx, ref, "Bearer token")
+		Expect(err).To(HaveOccurred())
+		Expect(err.Error()).To(ContainSubstring("is not allowed"))
+		Expect(requests).To(Equal(0))
+	})
+
+	It("should not follow redirects to hosts that are not allowed", func() {
+		ref := &lsv1alpha1.HTTPDataReference{
+			URL: server.URL + "/redirect",
+		}
+
+		_, err := cache.Get(ctx, ref, "")
+		Expect(err).To(HaveOccurred())
+		Expect(err.Error()).To(ContainSubstring(`host "example.com" is not allowed`))
+	})
+
+	It("should fail if the document exceeds the maximal size", func() {
+		ref := &lsv1alpha1.HTTPDataReference{
+			URL: server.URL + "/large",
+		}
+
+		_, err := cache.Get(ctx, ref, "")
+		Expect(err).To(HaveOccurred())
+		Expect(err.Error()).To(ContainSubstring("too large"))
+	})
+})
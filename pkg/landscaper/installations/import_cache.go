@@ -0,0 +1,139 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package installations
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	lsv1alpha1 "github.com/gardener/landscaper/apis/core/v1alpha1"
+	lsv1alpha1helper "github.com/gardener/landscaper/apis/core/v1alpha1/helper"
+	"github.com/gardener/landscaper/controller-utils/pkg/kubernetes"
+	"github.com/gardener/landscaper/pkg/utils/read_write_layer"
+)
+
+// DefaultImportCacheTTL is the default time a resolved import source is reused.
+const DefaultImportCacheTTL = 10 * time.Second
+
+// ImportCache caches the data objects and source installations that are resolved for the imports of installations.
+// The cache is shared by all reconciles of the controller process, as the same data objects are resolved for every
+// sibling of a parent installation.
+//
+// Data objects are only cached if they belong to a context, i.e. if they are exported resp. imported by
+// subinstallations, and a cached data object is only returned for the job that has written it.
+// This prevents that outdated exports of a previous job are imported.
+type ImportCache struct {
+	mux           sync.Mutex
+	ttl           time.Duration
+	dataObjects   map[dataObjectCacheKey]dataObjectCacheEntry
+	installations map[types.NamespacedName]time.Time
+	now           func() time.Time
+}
+
+type dataObjectCacheKey struct {
+	namespace   string
+	contextName string
+	dataRef     string
+}
+
+type dataObjectCacheEntry struct {
+	dataObject *lsv1alpha1.DataObject
+	expiration time.Time
+}
+
+// NewImportCache creates a new import cache whose entries expire after the given ttl.
+func NewImportCache(ttl time.Duration) *ImportCache {
+	return &ImportCache{
+		ttl:           ttl,
+		dataObjects:   map[dataObjectCacheKey]dataObjectCacheEntry{},
+		installations: map[types.NamespacedName]time.Time{},
+		now:           time.Now,
+	}
+}
+
+var defaultImportCache = NewImportCache(DefaultImportCacheTTL)
+
+// GetImportCache returns the process-wide import cache.
+func GetImportCache() *ImportCache {
+	return defaultImportCache
+}
+
+// GetDataObject returns the data object with the given data ref of a context.
+// The data object is only fetched if there is no valid cache entry that was written by the given job.
+func (c *ImportCache) GetDataObject(ctx context.Context, kubeClient client.Client, namespace, contextName, dataRef,
+	jobID string) (*lsv1alpha1.DataObject, error) {
+
+	key := dataObjectCacheKey{namespace: namespace, contextName: contextName, dataRef: dataRef}
+	cacheable := len(contextName) != 0 && len(jobID) != 0
+
+	if cacheable {
+		c.mux.Lock()
+		entry, ok := c.dataObjects[key]
+		c.mux.Unlock()
+		if ok && c.now().Before(entry.expiration) &&
+			kubernetes.HasLabelWithValue(&entry.dataObject.ObjectMeta, lsv1alpha1.DataObjectJobIDLabel, jobID) {
+			return entry.dataObject.DeepCopy(), nil
+		}
+	}
+
+	doName := lsv1alpha1helper.GenerateDataObjectName(contextName, dataRef)
+	do := &lsv1alpha1.DataObject{}
+	if err := kubeClient.Get(ctx, kubernetes.ObjectKey(doName, namespace), do); err != nil {
+		return nil, fmt.Errorf("unable to fetch data object %s (%s/%s): %w", doName, contextName, dataRef, err)
+	}
+
+	if cacheable && kubernetes.HasLabelWithValue(&do.ObjectMeta, lsv1alpha1.DataObjectJobIDLabel, jobID) {
+		c.mux.Lock()
+		defer c.mux.Unlock()
+		c.removeExpired()
+		c.dataObjects[key] = dataObjectCacheEntry{
+			dataObject: do.DeepCopy(),
+			expiration: c.now().Add(c.ttl),
+		}
+	}
+	return do, nil
+}
+
+// CheckInstallationExists returns an error if the given installation does not exist.
+// The installation is only fetched if its existence is not known from a valid cache entry.
+func (c *ImportCache) CheckInstallationExists(ctx context.Context, kubeClient client.Client, key types.NamespacedName) error {
+	c.mux.Lock()
+	expiration, ok := c.installations[key]
+	c.mux.Unlock()
+	if ok && c.now().Before(expiration) {
+		return nil
+	}
+
+	inst := &lsv1alpha1.Installation{}
+	if err := read_write_layer.GetInstallation(ctx, kubeClient, key, inst, read_write_layer.R000008); err != nil {
+		return err
+	}
+
+	c.mux.Lock()
+	defer c.mux.Unlock()
+	c.removeExpired()
+	c.installations[key] = c.now().Add(c.ttl)
+	return nil
+}
+
+// removeExpired removes all expired entries. The caller must hold the lock.
+func (c *ImportCache) removeExpired() {
+	now := c.now()
+	for k, e := range c.dataObjects {
+		if !now.Before(e.expiration) {
+			delete(c.dataObjects, k)
+		}
+	}
+	for k, expiration := range c.installations {
+		if !now.Before(expiration) {
+			delete(c.installations, k)
+		}
+	}
+}
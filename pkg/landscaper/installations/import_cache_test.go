@@ -0,0 +1,112 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package installations_test
+
+import (
+	"context"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	lsv1alpha1 "github.com/gardener/landscaper/apis/core/v1alpha1"
+	lsv1alpha1helper "github.com/gardener/landscaper/apis/core/v1alpha1/helper"
+	"github.com/gardener/landscaper/pkg/landscaper/installations"
+	"github.com/gardener/landscaper/test/utils/envtest"
+)
+
+var _ = Describe("ImportCache", func() {
+
+	var (
+		ctx        context.Context
+		kubeClient client.Client
+	)
+
+	BeforeEach(func() {
+		ctx = context.Background()
+		var err error
+		kubeClient, _, err = envtest.NewFakeClientFromPath("")
+		Expect(err).ToNot(HaveOccurred())
+	})
+
+	createDataObject := func(contextName, dataRef, jobID string) *lsv1alpha1.DataObject {
+		do := &lsv1alpha1.DataObject{}
+		do.Name = lsv1alpha1helper.GenerateDataObjectName(contextName, dataRef)
+		do.Namespace = "default"
+		do.Labels = map[string]string{
+			lsv1alpha1.DataObjectContextLabel: contextName,
+			lsv1alpha1.DataObjectJobIDLabel:   jobID,
+		}
+		do.Data = lsv1alpha1.NewAnyJSON([]byte(`"val"`))
+		Expect(kubeClient.Create(ctx, do)).To(Succeed())
+		return do
+	}
+
+	It("should reuse a data object of the same job", func() {
+		cache := installations.NewImportCache(time.Minute)
+		do := createDataObject("ctx", "my-data", "job-1")
+
+		_, err := cache.GetDataObject(ctx, kubeClient, "default", "ctx", "my-data", "job-1")
+		Expect(err).ToNot(HaveOccurred())
+		Expect(kubeClient.Delete(ctx, do)).To(Succeed())
+
+		res, err := cache.GetDataObject(ctx, kubeClient, "default", "ctx", "my-data", "job-1")
+		Expect(err).ToNot(HaveOccurred())
+		Expect(res.Data.RawMessage).To(MatchJSON(`"val"`))
+	})
+
+	It("should not reuse a data object for another job", func() {
+		cache := installations.NewImportCache(time.Minute)
+		do := createDataObject("ctx", "my-data", "job-1")
+
+		_, err := cache.GetDataObject(ctx, kubeClient, "default", "ctx", "my-data", "job-1")
+		Expect(err).ToNot(HaveOccurred())
+		Expect(kubeClient.Delete(ctx, do)).To(Succeed())
+
+		_, err = cache.GetDataObject(ctx, kubeClient, "default", "ctx", "my-data", "job-2")
+		Expect(apierrors.IsNotFound(err)).To(BeTrue())
+	})
+
+	It("should not cache data objects without context", func() {
+		cache := installations.NewImportCache(time.Minute)
+		do := createDataObject("", "my-data", "job-1")
+
+		_, err := cache.GetDataObject(ctx, kubeClient, "default", "", "my-data", "job-1")
+		Expect(err).ToNot(HaveOccurred())
+		Expect(kubeClient.Delete(ctx, do)).To(Succeed())
+
+		_, err = cache.GetDataObject(ctx, kubeClient, "default", "", "my-data", "job-1")
+		Expect(apierrors.IsNotFound(err)).To(BeTrue())
+	})
+
+	It("should not reuse expired entries", func() {
+		cache := installations.NewImportCache(0)
+		do := createDataObject("ctx", "my-data", "job-1")
+
+		_, err := cache.GetDataObject(ctx, kubeClient, "default", "ctx", "my-data", "job-1")
+		Expect(err).ToNot(HaveOccurred())
+		Expect(kubeClient.Delete(ctx, do)).To(Succeed())
+
+		_, err = cache.GetDataObject(ctx, kubeClient, "default", "ctx", "my-data", "job-1")
+		Expect(apierrors.IsNotFound(err)).To(BeTrue())
+	})
+
+	It("should remember existing source installations", func() {
+		cache := installations.NewImportCache(time.Minute)
+		inst := &lsv1alpha1.Installation{}
+		inst.Name = "source"
+		inst.Namespace = "default"
+		Expect(kubeClient.Create(ctx, inst)).To(Succeed())
+
+		Expect(cache.CheckInstallationExists(ctx, kubeClient, client.ObjectKeyFromObject(inst))).To(Succeed())
+		Expect(kubeClient.Delete(ctx, inst)).To(Succeed())
+		Expect(cache.CheckInstallationExists(ctx, kubeClient, client.ObjectKeyFromObject(inst))).To(Succeed())
+
+		err := cache.CheckInstallationExists(ctx, kubeClient, client.ObjectKey{Name: "other", Namespace: "default"})
+		Expect(apierrors.IsNotFound(err)).To(BeTrue())
+	})
+})
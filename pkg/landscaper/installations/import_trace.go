@@ -0,0 +1,174 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package installations
+
+import (
+	"context"
+	"crypto/sha1"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/validation"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	lsv1alpha1 "github.com/gardener/landscaper/apis/core/v1alpha1"
+	kutil "github.com/gardener/landscaper/controller-utils/pkg/kubernetes"
+	"github.com/gardener/landscaper/pkg/landscaper/dataobjects"
+)
+
+const (
+	// ImportTraceLabel is the label of the configmaps that contain the import traces of installations.
+	ImportTraceLabel = "landscaper.gardener.cloud/import-trace"
+	// ImportTraceDataKey is the key of the configmap data that contains the decision log.
+	ImportTraceDataKey = "trace"
+	// ImportTraceResultKey is the key of the configmap data that contains the result of the import resolution.
+	ImportTraceResultKey = "result"
+	// ImportTraceJobIDKey is the key of the configmap data that contains the job id of the traced reconcile.
+	ImportTraceJobIDKey = "jobID"
+)
+
+// ImportTraceDecision describes the outcome of a step of the import resolution.
+type ImportTraceDecision string
+
+const (
+	// ImportTraceAccepted is recorded if an object has been accepted as source of an import.
+	ImportTraceAccepted ImportTraceDecision = "Accepted"
+	// ImportTraceRejected is recorded if an object has been rejected, i.e. if the import is not satisfied.
+	ImportTraceRejected ImportTraceDecision = "Rejected"
+	// ImportTraceInfo is recorded for steps that neither accept nor reject an object.
+	ImportTraceInfo ImportTraceDecision = "Info"
+)
+
+// ImportTraceEntry is a single step of the import resolution of an installation.
+type ImportTraceEntry struct {
+	// Import is the name of the import, or empty for steps that concern all imports, e.g. the predecessor checks.
+	Import   string              `json:"import,omitempty"`
+	Step     string              `json:"step"`
+	Decision ImportTraceDecision `json:"decision"`
+	Message  string              `json:"message"`
+}
+
+// ImportTrace records the decisions that are made while the imports of an installation are resolved.
+// It is only created if the installation has the trace-imports annotation. All methods can be called on a nil trace,
+// so that the recording code does not have to check whether tracing is enabled.
+type ImportTrace struct {
+	mux     sync.Mutex
+	entries []ImportTraceEntry
+}
+
+// NewImportTrace creates a new empty import trace.
+func NewImportTrace() *ImportTrace {
+	return &ImportTrace{entries: []ImportTraceEntry{}}
+}
+
+// Record adds a step to the trace.
+func (t *ImportTrace) Record(importName, step string, decision ImportTraceDecision, format string, args ...interface{}) {
+	if t == nil {
+		return
+	}
+	t.mux.Lock()
+	defer t.mux.Unlock()
+	t.entries = append(t.entries, ImportTraceEntry{
+		Import:   importName,
+		Step:     step,
+		Decision: decision,
+		Message:  fmt.Sprintf(format, args...),
+	})
+}
+
+// RecordDataObject records the data object that has been accepted for a data import,
+// including the job id and generation that are used to detect outdated imports.
+func (t *ImportTrace) RecordDataObject(importName string, do *dataobjects.DataObject, jobID string) {
+	if t == nil || do == nil || do.Raw == nil {
+		return
+	}
+	if len(do.Raw.Name) == 0 {
+		// the data is taken from a secret, configmap or http reference
+		t.Record(importName, "ResolveDataImport", ImportTraceAccepted, "resolved referenced data with generation %d",
+			do.Raw.Generation)
+		return
+	}
+	msg := fmt.Sprintf("data object %q exported by %s %q with job id %q (installation job id %q), generation %d",
+		do.Raw.Name, do.Metadata.SourceType, do.Metadata.Source, do.Metadata.JobID, jobID, do.Raw.Generation)
+	if do.Metadata.OutdatedSince != nil {
+		msg += fmt.Sprintf(", outdated since %s", do.Metadata.OutdatedSince.UTC().Format(time.RFC3339))
+	}
+	t.Record(importName, "ResolveDataImport", ImportTraceAccepted, "%s", msg)
+}
+
+// RecordTarget records the target that has been accepted for a target import.
+func (t *ImportTrace) RecordTarget(importName, step string, target *dataobjects.TargetExtension) {
+	if t == nil || target == nil || target.GetTarget() == nil {
+		return
+	}
+	meta := dataobjects.GetMetadataFromObject(target.GetTarget(), nil)
+	t.Record(importName, step, ImportTraceAccepted, "target %q exported by %s %q with job id %q, generation %d",
+		target.GetTarget().Name, meta.SourceType, meta.Source, meta.JobID, target.GetTarget().Generation)
+}
+
+// Entries returns a copy of the recorded steps.
+func (t *ImportTrace) Entries() []ImportTraceEntry {
+	if t == nil {
+		return nil
+	}
+	t.mux.Lock()
+	defer t.mux.Unlock()
+	return append([]ImportTraceEntry{}, t.entries...)
+}
+
+// Write writes the trace into the trace configmap of the installation.
+// The configmap is owned by the installation, so that it is garbage collected together with it.
+// The result describes the outcome of the import resolution, i.e. the error or nil if all imports are satisfied.
+func (t *ImportTrace) Write(ctx context.Context, kubeClient client.Client, inst *lsv1alpha1.Installation, result error) error {
+	if t == nil {
+		return nil
+	}
+	data, err := json.MarshalIndent(t.Entries(), "", "  ")
+	if err != nil {
+		return err
+	}
+	resultMessage := "all imports are satisfied"
+	if result != nil {
+		resultMessage = result.Error()
+	}
+
+	cm := &corev1.ConfigMap{}
+	cm.Name = ImportTraceConfigMapName(inst.Name)
+	cm.Namespace = inst.Namespace
+	_, err = kutil.CreateOrUpdate(ctx, kubeClient, cm, func() error {
+		kutil.SetMetaDataLabel(cm, ImportTraceLabel, "true")
+		cm.OwnerReferences = []metav1.OwnerReference{{
+			APIVersion: lsv1alpha1.SchemeGroupVersion.String(),
+			Kind:       "Installation",
+			Name:       inst.Name,
+			UID:        inst.UID,
+		}}
+		cm.Data = map[string]string{
+			ImportTraceDataKey:   string(data),
+			ImportTraceResultKey: resultMessage,
+			ImportTraceJobIDKey:  inst.Status.JobID,
+		}
+		return nil
+	})
+	return err
+}
+
+// ImportTraceConfigMapName returns the name of the configmap that contains the import trace of an installation.
+func ImportTraceConfigMapName(instName string) string {
+	cmName := instName + "-import-trace"
+	if len(cmName) <= validation.DNS1123SubdomainMaxLength {
+		return cmName
+	}
+	h := sha1.Sum([]byte(cmName))
+	suffix := "-" + hex.EncodeToString(h[:])[:10] + "-import-trace"
+	prefix := strings.TrimRight(cmName[:validation.DNS1123SubdomainMaxLength-len(suffix)], "-.")
+	return prefix + suffix
+}
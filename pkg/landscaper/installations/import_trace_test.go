@@ -0,0 +1,101 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package installations_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"strings"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	lsv1alpha1 "github.com/gardener/landscaper/apis/core/v1alpha1"
+	"github.com/gardener/landscaper/pkg/landscaper/dataobjects"
+	"github.com/gardener/landscaper/pkg/landscaper/installations"
+	"github.com/gardener/landscaper/test/utils/envtest"
+)
+
+var _ = Describe("ImportTrace", func() {
+
+	It("should ignore records if tracing is disabled", func() {
+		var trace *installations.ImportTrace
+		trace.Record("a", "ResolveDataImport", installations.ImportTraceRejected, "not found")
+		trace.RecordDataObject("a", &dataobjects.DataObject{Raw: &lsv1alpha1.DataObject{}}, "job")
+		Expect(trace.Entries()).To(BeNil())
+		Expect(trace.Write(context.Background(), nil, &lsv1alpha1.Installation{}, nil)).To(Succeed())
+	})
+
+	It("should record the compared job ids and generations of a data object", func() {
+		raw := &lsv1alpha1.DataObject{}
+		raw.Name = "do"
+		raw.Generation = 3
+		raw.Labels = map[string]string{
+			lsv1alpha1.DataObjectSourceTypeLabel: string(lsv1alpha1.ExportDataObjectSourceType),
+			lsv1alpha1.DataObjectSourceLabel:     "Inst.exporter",
+			lsv1alpha1.DataObjectJobIDLabel:      "job-1",
+		}
+		do, err := dataobjects.NewFromDataObject(raw)
+		Expect(err).ToNot(HaveOccurred())
+
+		trace := installations.NewImportTrace()
+		trace.RecordDataObject("a", do, "job-2")
+
+		entries := trace.Entries()
+		Expect(entries).To(HaveLen(1))
+		Expect(entries[0].Import).To(Equal("a"))
+		Expect(entries[0].Decision).To(Equal(installations.ImportTraceAccepted))
+		Expect(entries[0].Message).To(ContainSubstring(`job id "job-1" (installation job id "job-2"), generation 3`))
+		Expect(entries[0].Message).To(ContainSubstring(`"Inst.exporter"`))
+	})
+
+	It("should write the trace into a configmap owned by the installation", func() {
+		ctx := context.Background()
+		kubeClient, _, err := envtest.NewFakeClientFromPath("")
+		Expect(err).ToNot(HaveOccurred())
+
+		inst := &lsv1alpha1.Installation{}
+		inst.Name = "inst"
+		inst.Namespace = "default"
+		inst.UID = "abc"
+		inst.Status.JobID = "job-1"
+
+		trace := installations.NewImportTrace()
+		trace.Record("a", "ResolveDataImport", installations.ImportTraceRejected, "data object %q not found", "do")
+		Expect(trace.Write(ctx, kubeClient, inst, errors.New("import a is not satisfied"))).To(Succeed())
+
+		cm := &corev1.ConfigMap{}
+		Expect(kubeClient.Get(ctx, client.ObjectKey{Name: "inst-import-trace", Namespace: "default"}, cm)).To(Succeed())
+		Expect(cm.Labels).To(HaveKeyWithValue(installations.ImportTraceLabel, "true"))
+		Expect(cm.OwnerReferences).To(HaveLen(1))
+		Expect(cm.OwnerReferences[0].UID).To(BeEquivalentTo("abc"))
+		Expect(cm.Data).To(HaveKeyWithValue(installations.ImportTraceResultKey, "import a is not satisfied"))
+		Expect(cm.Data).To(HaveKeyWithValue(installations.ImportTraceJobIDKey, "job-1"))
+
+		entries := []installations.ImportTraceEntry{}
+		Expect(json.Unmarshal([]byte(cm.Data[installations.ImportTraceDataKey]), &entries)).To(Succeed())
+		Expect(entries).To(ConsistOf(installations.ImportTraceEntry{
+			Import:   "a",
+			Step:     "ResolveDataImport",
+			Decision: installations.ImportTraceRejected,
+			Message:  `data object "do" not found`,
+		}))
+
+		// a subsequent trace replaces the previous one
+		Expect(installations.NewImportTrace().Write(ctx, kubeClient, inst, nil)).To(Succeed())
+		Expect(kubeClient.Get(ctx, client.ObjectKeyFromObject(cm), cm)).To(Succeed())
+		Expect(cm.Data).To(HaveKeyWithValue(installations.ImportTraceResultKey, "all imports are satisfied"))
+		Expect(cm.Data).To(HaveKeyWithValue(installations.ImportTraceDataKey, "[]"))
+	})
+
+	It("should shorten long configmap names", func() {
+		name := installations.ImportTraceConfigMapName(strings.Repeat("a", 260))
+		Expect(len(name)).To(BeNumerically("<=", 253))
+		Expect(name).To(HaveSuffix("-import-trace"))
+	})
+})
@@ -7,27 +7,33 @@ package imports
 import (
 	"context"
 	"fmt"
+	"sort"
 	"strings"
 
 	"github.com/mandelsoft/spiff/spiffing"
 	spiffyaml "github.com/mandelsoft/spiff/yaml"
 	"k8s.io/apimachinery/pkg/util/validation/field"
+	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/yaml"
 
 	lsv1alpha1 "github.com/gardener/landscaper/apis/core/v1alpha1"
 	lsv1alpha1helper "github.com/gardener/landscaper/apis/core/v1alpha1/helper"
+	lserrors "github.com/gardener/landscaper/apis/errors"
 	genericresolver "github.com/gardener/landscaper/controller-utils/pkg/landscaper/targetresolver/generic"
 	"github.com/gardener/landscaper/pkg/landscaper/dataobjects"
 	"github.com/gardener/landscaper/pkg/landscaper/dataobjects/jsonpath"
 	"github.com/gardener/landscaper/pkg/landscaper/installations"
 	"github.com/gardener/landscaper/pkg/landscaper/installations/executions/template"
+	"github.com/gardener/landscaper/pkg/landscaper/installations/executions/template/cue"
 	"github.com/gardener/landscaper/pkg/landscaper/installations/executions/template/gotemplate"
 	"github.com/gardener/landscaper/pkg/landscaper/installations/executions/template/spiff"
+	"github.com/gardener/landscaper/pkg/landscaper/targettypedefinitions"
+	"github.com/gardener/landscaper/pkg/utils/read_write_layer"
 )
 
 const (
 	// TemplatingFailedReason is the reason that is defined during templating.
-	TemplatingFailedReason = "ImportValidationFailed"
+	TemplatingFailedReason = lsv1alpha1.ImportValidationFailedReason
 )
 
 // NewConstructor creates a new Import Constructor.
@@ -66,6 +72,18 @@ func (imps *Imports) Size() int {
 	return len(imps.DataObjects) + len(imps.Targets) + len(imps.TargetLists)
 }
 
+// OutdatedDataObjects returns the names of all imported data objects that are marked as outdated, sorted by name.
+func (imps *Imports) OutdatedDataObjects() []string {
+	res := []string{}
+	for impName, do := range imps.DataObjects {
+		if do.Metadata.OutdatedSince != nil {
+			res = append(res, impName)
+		}
+	}
+	sort.Strings(res)
+	return res
+}
+
 // LoadImports loads all imports from the cluster (or wherever).
 func (c *Constructor) LoadImports(ctx context.Context) (*Imports, error) {
 	imps := &Imports{}
@@ -106,6 +124,22 @@ func (c *Constructor) Construct(ctx context.Context, imps *Imports) error {
 		}
 	}
 
+	validationCache := GetValidationCache()
+	importDefinitions := inst.GetBlueprint().Info.Imports
+	schemaDigests, err := NewSchemaDigests(inst.GetBlueprint().Info, c.JSONSchemaReferenceContext())
+	if err != nil {
+		// the imports are validated without cache; an invalid schema is reported by the validation
+		schemaDigests = SchemaDigests{}
+	}
+	c.skipSchemaValidation = validationCache.IsValidated(inst.GetInstallation(), schemaDigests, imps)
+	if c.skipSchemaValidation {
+		c.ImportTrace.Record("", "ValidateImports", installations.ImportTraceInfo,
+			"schema validation skipped as neither the blueprint, its schemas, nor the imported objects have changed since the last successful validation")
+	} else if err := c.validateTargetConfigurations(ctx, imps, fldPath); err != nil {
+		validationCache.Remove(client.ObjectKeyFromObject(inst.GetInstallation()))
+		return err
+	}
+
 	// performs the importDataMappings
 	templatedDataMappings, err := c.templateDataMappings(fldPath, imps.DataObjects, imps.Targets, imps.TargetLists, imps.TargetMaps) // returns a map mapping logical names to data content
 	if err != nil {
@@ -113,11 +147,15 @@ func (c *Constructor) Construct(ctx context.Context, imps *Imports) error {
 	}
 
 	// combines imported values, results of the importDataMappings, default values, and conditional imports
-	imports, err := c.constructImports(inst.GetBlueprint().Info.Imports, imps.DataObjects, imps.Targets,
+	imports, err := c.constructImports(importDefinitions, imps.DataObjects, imps.Targets,
 		imps.TargetLists, imps.TargetMaps, templatedDataMappings, fldPath)
 	if err != nil {
+		validationCache.Remove(client.ObjectKeyFromObject(inst.GetInstallation()))
 		return err
 	}
+	if !c.skipSchemaValidation {
+		validationCache.Add(inst.GetInstallation(), schemaDigests, imps)
+	}
 
 	c.SetTargetImports(imps.Targets)
 	c.SetTargetListImports(imps.TargetLists)
@@ -127,6 +165,66 @@ func (c *Constructor) Construct(ctx context.Context, imps *Imports) error {
 	return nil
 }
 
+// validateTargetConfigurations validates the configurations of the imported targets against the schemas of the
+// TargetTypeDefinitions of their types.
+func (c *Constructor) validateTargetConfigurations(ctx context.Context, imps *Imports, fldPath *field.Path) error {
+	if len(imps.Targets) == 0 && len(imps.TargetLists) == 0 && len(imps.TargetMaps) == 0 {
+		return nil
+	}
+
+	definitionList := &lsv1alpha1.TargetTypeDefinitionList{}
+	if err := read_write_layer.ListTargetTypeDefinitions(ctx, c.LsUncachedClient(), definitionList, read_write_layer.R000118); err != nil {
+		return installations.NewErrorf(installations.SchemaValidationFailed, err, "unable to list target type definitions")
+	}
+	if len(definitionList.Items) == 0 {
+		return nil
+	}
+	definitions := map[lsv1alpha1.TargetType][]lsv1alpha1.TargetTypeDefinition{}
+	for _, def := range definitionList.Items {
+		definitions[def.Spec.Type] = append(definitions[def.Spec.Type], def)
+	}
+
+	targetResolver := genericresolver.New(c.LsUncachedClient())
+	validate := func(target *dataobjects.TargetExtension, path *field.Path) error {
+		if target == nil || target.GetTarget() == nil {
+			return nil
+		}
+		defs := definitions[target.GetTarget().Spec.Type]
+		if len(defs) == 0 {
+			return nil
+		}
+		resolvedTarget, err := targetResolver.Resolve(ctx, target.GetTarget())
+		if err != nil {
+			return installations.NewErrorf(installations.SchemaValidationFailed, err, "%s: unable to resolve imported target %q", path.String(), target.GetTarget().Name)
+		}
+		if err := targettypedefinitions.ValidateConfigurationAgainstDefinitions(defs, []byte(resolvedTarget.Content)); err != nil {
+			return installations.NewErrorf(installations.SchemaValidationFailed, err, "%s: configuration of imported target %q is invalid", path.String(), target.GetTarget().Name)
+		}
+		return nil
+	}
+
+	for name, target := range imps.Targets {
+		if err := validate(target, fldPath.Child(name)); err != nil {
+			return err
+		}
+	}
+	for name, targetList := range imps.TargetLists {
+		for i, target := range targetList.GetTargetExtensions() {
+			if err := validate(target, fldPath.Child(name).Index(i)); err != nil {
+				return err
+			}
+		}
+	}
+	for name, targetMap := range imps.TargetMaps {
+		for key, target := range targetMap.GetTargetExtensions() {
+			if err := validate(target, fldPath.Child(name).Key(key)); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
 // constructImports is an auxiliary function that can be called in a recursive manner to traverse the tree of conditional imports
 func (c *Constructor) constructImports(
 	importList lsv1alpha1.ImportDefinitionList,
@@ -143,10 +241,12 @@ func (c *Constructor) constructImports(
 		defPath := fldPath.Child(def.Name)
 		switch def.Type {
 		case lsv1alpha1.ImportTypeData:
+			var source *lsv1alpha1.TypedObjectReference
 			if val, ok := templatedDataMappings[def.Name]; ok {
 				imports[def.Name] = val
 			} else if val, ok := importedDataObjects[def.Name]; ok {
 				imports[def.Name] = val.Data
+				source = c.dataImportSource(val)
 			}
 			if _, ok := imports[def.Name]; !ok {
 				if def.Required != nil && !*def.Required {
@@ -167,12 +267,14 @@ func (c *Constructor) constructImports(
 			if def.Schema == nil {
 				return nil, installations.NewErrorf(installations.SchemaValidationFailed, fmt.Errorf("schema is nil"), "%s: no schema defined", defPath.String())
 			}
-			validator, err := c.JSONSchemaValidator(def.Schema.RawMessage)
-			if err != nil {
-				return imports, installations.NewErrorf(installations.SchemaValidationFailed, err, "%s: validator creation failed", defPath.String())
-			}
-			if err := validator.ValidateGoStruct(imports[def.Name]); err != nil {
-				return imports, installations.NewErrorf(installations.SchemaValidationFailed, err, "%s: imported datatype does not have the expected schema", defPath.String())
+			if !c.skipSchemaValidation {
+				validator, err := c.JSONSchemaValidator(def.Schema.RawMessage)
+				if err != nil {
+					return imports, installations.NewErrorf(installations.SchemaValidationFailed, err, "%s: validator creation failed", defPath.String())
+				}
+				if err := validator.ValidateGoStruct(imports[def.Name]); err != nil {
+					return imports, newBadImportError(installations.NewErrorf(installations.SchemaValidationFailed, err, "%s: imported datatype does not have the expected schema", defPath.String()), def.Name, source)
+				}
 			}
 			if len(def.ConditionalImports) > 0 {
 				// recursively check conditional imports
@@ -186,10 +288,12 @@ func (c *Constructor) constructImports(
 			}
 			continue
 		case lsv1alpha1.ImportTypeTarget:
+			var source *lsv1alpha1.TypedObjectReference
 			if val, ok := importedTargets[def.Name]; ok {
+				source = targetImportSource(val)
 				imports[def.Name], err = val.GetData()
 				if err != nil {
-					return nil, installations.NewErrorf(installations.SchemaValidationFailed, err, "%s: imported target cannot be parsed", defPath.String())
+					return nil, newBadImportError(installations.NewErrorf(installations.SchemaValidationFailed, err, "%s: imported target cannot be parsed", defPath.String()), def.Name, source)
 				}
 			}
 			data, ok := imports[def.Name]
@@ -202,10 +306,10 @@ func (c *Constructor) constructImports(
 
 			var targetType string
 			if err := jsonpath.GetValue(".spec.type", data, &targetType); err != nil {
-				return nil, installations.NewErrorf(installations.SchemaValidationFailed, err, "%s: imported target does not match the expected target template schema", defPath.String())
+				return nil, newBadImportError(installations.NewErrorf(installations.SchemaValidationFailed, err, "%s: imported target does not match the expected target template schema", defPath.String()), def.Name, source)
 			}
 			if def.TargetType != targetType {
-				return nil, installations.NewErrorf(installations.SchemaValidationFailed, nil, "%s: imported target type is %s but expected %s", defPath.String(), targetType, def.TargetType)
+				return nil, newBadImportError(installations.NewErrorf(installations.SchemaValidationFailed, nil, "%s: imported target type is %s but expected %s", defPath.String(), targetType, def.TargetType), def.Name, source)
 			}
 			continue
 		case lsv1alpha1.ImportTypeTargetList:
@@ -274,6 +378,58 @@ func (c *Constructor) constructImports(
 	return imports, nil
 }
 
+// newBadImportError marks the given import error as caused by malformed data of the given source object.
+// The error is returned unchanged if the import is not read from a kubernetes object.
+func newBadImportError(err lserrors.LsError, importName string, source *lsv1alpha1.TypedObjectReference) error {
+	if source == nil {
+		return err
+	}
+	return installations.NewBadImportError(err, importName, *source)
+}
+
+// dataImportSource returns a reference to the object from which the data of the given data object is read.
+// Nil is returned for data that is not read from a kubernetes object.
+func (c *Constructor) dataImportSource(do *dataobjects.DataObject) *lsv1alpha1.TypedObjectReference {
+	if do == nil || do.Def == nil {
+		return nil
+	}
+	namespace := c.Inst.GetInstallation().GetNamespace()
+	switch {
+	case len(do.Def.DataRef) != 0 && do.Raw != nil:
+		return &lsv1alpha1.TypedObjectReference{
+			APIVersion:      lsv1alpha1.SchemeGroupVersion.String(),
+			Kind:            "DataObject",
+			ObjectReference: lsv1alpha1.ObjectReference{Name: do.Raw.GetName(), Namespace: do.Raw.GetNamespace()},
+		}
+	case do.Def.SecretRef != nil:
+		return &lsv1alpha1.TypedObjectReference{
+			APIVersion:      "v1",
+			Kind:            "Secret",
+			ObjectReference: lsv1alpha1.ObjectReference{Name: do.Def.SecretRef.Name, Namespace: namespace},
+		}
+	case do.Def.ConfigMapRef != nil:
+		return &lsv1alpha1.TypedObjectReference{
+			APIVersion:      "v1",
+			Kind:            "ConfigMap",
+			ObjectReference: lsv1alpha1.ObjectReference{Name: do.Def.ConfigMapRef.Name, Namespace: namespace},
+		}
+	default:
+		return nil
+	}
+}
+
+// targetImportSource returns a reference to the imported target.
+func targetImportSource(target *dataobjects.TargetExtension) *lsv1alpha1.TypedObjectReference {
+	if target == nil || target.GetTarget() == nil || len(target.GetTarget().GetName()) == 0 {
+		return nil
+	}
+	return &lsv1alpha1.TypedObjectReference{
+		APIVersion:      lsv1alpha1.SchemeGroupVersion.String(),
+		Kind:            "Target",
+		ObjectReference: lsv1alpha1.ObjectReference{Name: target.GetTarget().GetName(), Namespace: target.GetTarget().GetNamespace()},
+	}
+}
+
 func (c *Constructor) templateDataMappings(
 	fldPath *field.Path,
 	importedDataObjects map[string]*dataobjects.DataObject,
@@ -341,7 +497,7 @@ func (c *Constructor) templateDataMappings(
 
 // RenderImportExecutions renders the blueprint's ImportExecutions.
 // Has to be called after import construction (c.Construct(...))
-func (c *Constructor) RenderImportExecutions() error {
+func (c *Constructor) RenderImportExecutions(ctx context.Context) error {
 	cond := lsv1alpha1helper.GetOrInitCondition(c.Operation.Inst.GetInstallation().Status.Conditions, lsv1alpha1.ValidateImportsCondition)
 
 	templateStateHandler := template.KubernetesStateHandler{
@@ -349,16 +505,18 @@ func (c *Constructor) RenderImportExecutions() error {
 		Inst:       c.Operation.Inst.GetInstallation(),
 	}
 	targetResolver := genericresolver.New(c.Operation.LsUncachedClient())
+	versionResolver := c.Operation.ComponentVersionResolver(ctx)
 	tmpl := template.New(
-		gotemplate.New(templateStateHandler, targetResolver),
-		spiff.New(templateStateHandler, targetResolver))
+		gotemplate.New(templateStateHandler, targetResolver).WithComponentVersionResolver(versionResolver),
+		spiff.New(templateStateHandler, targetResolver).WithComponentVersionResolver(versionResolver),
+		cue.New())
 	errors, bindings, err := tmpl.TemplateImportExecutions(
 		template.NewBlueprintExecutionOptions(
 			c.Operation.Context().External.InjectComponentDescriptorRef(c.Operation.Inst.GetInstallation()),
 			c.Operation.Inst.GetBlueprint(),
 			c.Operation.ComponentVersion,
 			c.Operation.ResolvedComponentDescriptorList,
-			c.Operation.Inst.GetImports()))
+			c.Operation.Inst.GetImports()).WithContextFeatureFlags(c.Operation.Context().External.FeatureFlags))
 
 	if err != nil {
 		c.Operation.Inst.MergeConditions(lsv1alpha1helper.UpdatedCondition(cond, lsv1alpha1.ConditionFalse,
@@ -211,6 +211,12 @@ var _ = Describe("Constructor", func() {
 			c := imports.NewConstructor(op)
 			err = c.Construct(ctx, nil)
 			Expect(installations.IsSchemaValidationFailedError(err)).To(BeTrue())
+
+			badImportErr, ok := installations.GetBadImportError(err)
+			Expect(ok).To(BeTrue())
+			Expect(badImportErr.ImportName).To(Equal("a.b"))
+			Expect(badImportErr.Source.Kind).To(Equal("DataObject"))
+			Expect(badImportErr.Source.NamespacedName()).To(Equal(kutil.ObjectKey(do.Name, do.Namespace)))
 		})
 
 		It("should handle missing schema definition in import gracefully", func() {
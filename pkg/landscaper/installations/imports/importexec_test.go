@@ -90,14 +90,14 @@ var _ = Describe("ImportExecutions", func() {
 
 	It("should extend imports by import executions", func() {
 		c = Load(ctx, "test11/root")
-		err := c.RenderImportExecutions()
+		err := c.RenderImportExecutions(ctx)
 		Expect(err).To(Succeed())
 		Expect(c.Inst.GetImports()["processed"]).To(Equal("mytestvalue(extended)"))
 	})
 
 	It("should extend imports incrementally by import executions", func() {
 		c = Load(ctx, "test11/multi")
-		err := c.RenderImportExecutions()
+		err := c.RenderImportExecutions(ctx)
 		Expect(err).To(Succeed())
 		Expect(c.Inst.GetImports()["processed"]).To(Equal("mytestvalue(extended)"))
 		Expect(c.Inst.GetImports()["further"]).To(Equal("mytestvalue(extended)(further)"))
@@ -105,13 +105,13 @@ var _ = Describe("ImportExecutions", func() {
 
 	It("should validate imports by import executions", func() {
 		c = Load(ctx, "test11/ok")
-		err := c.RenderImportExecutions()
+		err := c.RenderImportExecutions(ctx)
 		Expect(err).To(Succeed())
 	})
 
 	It("should reject wrong imports by import executions", func() {
 		c = Load(ctx, "test11/error")
-		err := c.RenderImportExecutions()
+		err := c.RenderImportExecutions(ctx)
 		Expect(err).NotTo(Succeed())
 		Expect(err.Error()).To(Equal("import validation failed: invalid test data:other"))
 		Expect(c.Inst.GetInstallation().Status.Conditions[0].Type).To(Equal(lsv1alpha1.ConditionType("ValidateImports")))
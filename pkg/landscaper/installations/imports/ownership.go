@@ -0,0 +1,70 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package imports
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	lsv1alpha1 "github.com/gardener/landscaper/apis/core/v1alpha1"
+	lserrors "github.com/gardener/landscaper/apis/errors"
+	"github.com/gardener/landscaper/pkg/landscaper/dataobjects"
+	"github.com/gardener/landscaper/pkg/landscaper/installations"
+)
+
+// CheckImportOwnership compares the sources of the data and target imports of an installation with its recorded sources.
+// Only imports from data objects and single targets are considered, as only they are provided by exactly one source.
+// It returns the sources that have to be recorded, or an AmbiguousImport error if an import is provided by another
+// source than the recorded one. Recorded sources of imports whose key has changed are replaced.
+func CheckImportOwnership(inst *lsv1alpha1.Installation, imps *Imports) ([]lsv1alpha1.ImportSource, lserrors.LsError) {
+	recorded := map[string]lsv1alpha1.ImportSource{}
+	for _, source := range inst.Status.ImportSources {
+		recorded[source.Name] = source
+	}
+
+	sources := []lsv1alpha1.ImportSource{}
+	for _, def := range inst.Spec.Imports.Data {
+		do, ok := imps.DataObjects[def.Name]
+		if len(def.DataRef) == 0 || !ok || do == nil {
+			continue
+		}
+		sources = append(sources, lsv1alpha1.ImportSource{
+			Name:   def.Name,
+			Key:    def.DataRef,
+			Source: do.Metadata.Source,
+		})
+	}
+	for _, def := range inst.Spec.Imports.Targets {
+		target, ok := imps.Targets[def.Name]
+		if len(def.Target) == 0 || !ok || target == nil || target.GetTarget() == nil {
+			continue
+		}
+		sources = append(sources, lsv1alpha1.ImportSource{
+			Name:   def.Name,
+			Key:    def.Target,
+			Source: dataobjects.GetMetadataFromObject(target.GetTarget(), nil).Source,
+		})
+	}
+
+	ambiguous := []string{}
+	for _, source := range sources {
+		old, ok := recorded[source.Name]
+		if !ok || old.Key != source.Key || old.Source == source.Source {
+			continue
+		}
+		ambiguous = append(ambiguous, fmt.Sprintf("import %q from %q is provided by source %q instead of the recorded source %q",
+			source.Name, source.Key, source.Source, old.Source))
+	}
+	if len(ambiguous) != 0 {
+		sort.Strings(ambiguous)
+		return nil, installations.NewAmbiguousImportErrorf(nil, "%s", strings.Join(ambiguous, "; "))
+	}
+
+	sort.Slice(sources, func(i, j int) bool {
+		return sources[i].Name < sources[j].Name
+	})
+	return sources, nil
+}
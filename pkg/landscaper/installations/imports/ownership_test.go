@@ -0,0 +1,81 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package imports_test
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	lsv1alpha1 "github.com/gardener/landscaper/apis/core/v1alpha1"
+	"github.com/gardener/landscaper/pkg/landscaper/dataobjects"
+	"github.com/gardener/landscaper/pkg/landscaper/installations"
+	"github.com/gardener/landscaper/pkg/landscaper/installations/imports"
+)
+
+var _ = Describe("Import Ownership", func() {
+
+	newInstallation := func() *lsv1alpha1.Installation {
+		inst := &lsv1alpha1.Installation{}
+		inst.Spec.StrictImportOwnership = true
+		inst.Spec.Imports.Data = []lsv1alpha1.DataImport{
+			{Name: "config", DataRef: "config"},
+			{Name: "credentials", SecretRef: &lsv1alpha1.LocalSecretReference{Name: "creds"}},
+		}
+		inst.Spec.Imports.Targets = []lsv1alpha1.TargetImport{
+			{Name: "cluster", Target: "cluster"},
+		}
+		return inst
+	}
+
+	newImports := func(dataSource, targetSource string) *imports.Imports {
+		target := &lsv1alpha1.Target{}
+		if len(targetSource) != 0 {
+			target.Labels = map[string]string{lsv1alpha1.DataObjectSourceLabel: targetSource}
+		}
+		return &imports.Imports{
+			DataObjects: map[string]*dataobjects.DataObject{
+				"config":      {Metadata: dataobjects.Metadata{Source: dataSource}},
+				"credentials": {},
+			},
+			Targets: map[string]*dataobjects.TargetExtension{
+				"cluster": dataobjects.NewTargetExtension(target, nil),
+			},
+		}
+	}
+
+	It("should record the sources of data object and target imports", func() {
+		sources, err := imports.CheckImportOwnership(newInstallation(), newImports("Inst.a", ""))
+		Expect(err).ToNot(HaveOccurred())
+		Expect(sources).To(Equal([]lsv1alpha1.ImportSource{
+			{Name: "cluster", Key: "cluster"},
+			{Name: "config", Key: "config", Source: "Inst.a"},
+		}))
+	})
+
+	It("should fail if an import is provided by another source than the recorded one", func() {
+		inst := newInstallation()
+		inst.Status.ImportSources = []lsv1alpha1.ImportSource{
+			{Name: "cluster", Key: "cluster"},
+			{Name: "config", Key: "config", Source: "Inst.a"},
+		}
+
+		_, err := imports.CheckImportOwnership(inst, newImports("Inst.b", "Inst.c"))
+		Expect(err).To(HaveOccurred())
+		Expect(installations.IsErrorForReason(err, installations.AmbiguousImport)).To(BeTrue())
+		Expect(err.Error()).To(ContainSubstring(`import "config" from "config" is provided by source "Inst.b" instead of the recorded source "Inst.a"`))
+		Expect(err.Error()).To(ContainSubstring(`import "cluster" from "cluster" is provided by source "Inst.c" instead of the recorded source ""`))
+	})
+
+	It("should record a new source if the key of an import has changed", func() {
+		inst := newInstallation()
+		inst.Status.ImportSources = []lsv1alpha1.ImportSource{
+			{Name: "config", Key: "old-config", Source: "Inst.a"},
+		}
+
+		sources, err := imports.CheckImportOwnership(inst, newImports("Inst.b", ""))
+		Expect(err).ToNot(HaveOccurred())
+		Expect(sources).To(ContainElement(lsv1alpha1.ImportSource{Name: "config", Key: "config", Source: "Inst.b"}))
+	})
+})
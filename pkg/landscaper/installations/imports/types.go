@@ -14,4 +14,6 @@ import (
 type Constructor struct {
 	*installations.Operation
 	scope *installations.Scope
+	// skipSchemaValidation is set if the imports have already been validated successfully with the same generations.
+	skipSchemaValidation bool
 }
@@ -0,0 +1,280 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package imports
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"maps"
+	"sync"
+	"time"
+
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	lsv1alpha1 "github.com/gardener/landscaper/apis/core/v1alpha1"
+	"github.com/gardener/landscaper/pkg/landscaper/dataobjects"
+	"github.com/gardener/landscaper/pkg/landscaper/jsonschema"
+)
+
+const (
+	// defaultValidationCacheMaxEntries is the maximal number of installations of the process-wide validation cache.
+	defaultValidationCacheMaxEntries = 10000
+	// defaultValidationCacheTTL is the duration after which the imports of an installation are validated again,
+	// even if nothing has changed.
+	defaultValidationCacheTTL = time.Hour
+)
+
+// ValidationCache records for every installation the generations of the imported data objects and targets
+// of the last successful validation of its imports.
+// The validation of the imports against the schemas of the blueprint and the target type definitions is skipped
+// as long as neither the installation, its blueprint, the resolved schemas, nor an imported object has changed.
+// The blueprint and the resolved schemas are compared by their content, as blueprints and schemas of local and git
+// registries can change without a new generation of the installation.
+//
+// Imports that are not read from data objects or targets, e.g. secret, configmap and http imports, and targets that
+// reference a secret have no reliable generation. The imports of installations with such imports are always validated.
+//
+// Entries expire after a fixed duration, and the oldest entry is removed if the maximal number of entries is reached.
+type ValidationCache struct {
+	mux        sync.Mutex
+	entries    map[types.NamespacedName]validationCacheEntry
+	maxEntries int
+	ttl        time.Duration
+}
+
+type validationCacheEntry struct {
+	uid         types.UID
+	generation  int64
+	digests     SchemaDigests
+	generations map[string]importedObjectGeneration
+	added       time.Time
+}
+
+// importedObjectGeneration identifies a version of an imported object.
+// The uid is part of it, as the generation of an object that has been deleted and created again starts from the beginning.
+type importedObjectGeneration struct {
+	uid        types.UID
+	generation int64
+}
+
+// SchemaDigests identifies the schemas against which the imports of an installation are validated.
+type SchemaDigests struct {
+	// Blueprint is the digest of the blueprint definition.
+	Blueprint string
+	// ResolvedSchemas is the hash of the schemas of the import definitions with all references resolved,
+	// including references to blueprint files, component resources and remote schemas.
+	ResolvedSchemas string
+}
+
+// NewSchemaDigests computes the digests of the blueprint and of the resolved schemas of its import definitions.
+func NewSchemaDigests(blueprint *lsv1alpha1.Blueprint, refCtx *jsonschema.ReferenceContext) (SchemaDigests, error) {
+	if blueprint == nil {
+		return SchemaDigests{}, fmt.Errorf("no blueprint given")
+	}
+	blueprintJSON, err := json.Marshal(blueprint)
+	if err != nil {
+		return SchemaDigests{}, fmt.Errorf("unable to marshal blueprint: %w", err)
+	}
+
+	resolvedSchemas := map[string]interface{}{}
+	if err := resolveImportSchemas(blueprint.Imports, jsonschema.NewReferenceResolver(refCtx), resolvedSchemas); err != nil {
+		return SchemaDigests{}, err
+	}
+	// maps are marshalled with sorted keys, so that the hash does not depend on the order of the import definitions
+	resolvedSchemasJSON, err := json.Marshal(resolvedSchemas)
+	if err != nil {
+		return SchemaDigests{}, fmt.Errorf("unable to marshal resolved schemas: %w", err)
+	}
+
+	return SchemaDigests{
+		Blueprint:       sha256Hex(blueprintJSON),
+		ResolvedSchemas: sha256Hex(resolvedSchemasJSON),
+	}, nil
+}
+
+// resolveImportSchemas resolves the schemas of the given import definitions and their conditional imports.
+func resolveImportSchemas(importDefinitions lsv1alpha1.ImportDefinitionList, resolver *jsonschema.ReferenceResolver,
+	resolvedSchemas map[string]interface{}) error {
+
+	for _, def := range importDefinitions {
+		if def.Schema != nil {
+			resolved, err := resolver.Resolve(def.Schema.RawMessage)
+			if err != nil {
+				return fmt.Errorf("unable to resolve schema of import %q: %w", def.Name, err)
+			}
+			resolvedSchemas[def.Name] = resolved
+		}
+		if err := resolveImportSchemas(def.ConditionalImports, resolver, resolvedSchemas); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func sha256Hex(data []byte) string {
+	h := sha256.Sum256(data)
+	return hex.EncodeToString(h[:])
+}
+
+// NewValidationCache creates a new empty validation cache with the given maximal number of entries
+// and the duration after which entries expire.
+func NewValidationCache(maxEntries int, ttl time.Duration) *ValidationCache {
+	return &ValidationCache{
+		entries:    map[types.NamespacedName]validationCacheEntry{},
+		maxEntries: maxEntries,
+		ttl:        ttl,
+	}
+}
+
+var defaultValidationCache = NewValidationCache(defaultValidationCacheMaxEntries, defaultValidationCacheTTL)
+
+// GetValidationCache returns the process-wide validation cache.
+func GetValidationCache() *ValidationCache {
+	return defaultValidationCache
+}
+
+// IsValidated returns whether the given imports of the installation have already been validated successfully
+// against the schemas with the given digests.
+func (c *ValidationCache) IsValidated(inst *lsv1alpha1.Installation, digests SchemaDigests, imps *Imports) bool {
+	newEntry, ok := newValidationCacheEntry(inst, digests, imps)
+	if !ok {
+		return false
+	}
+
+	c.mux.Lock()
+	entry, ok := c.entries[client.ObjectKeyFromObject(inst)]
+	c.mux.Unlock()
+	return ok &&
+		!c.isExpired(entry) &&
+		entry.uid == newEntry.uid &&
+		entry.generation == newEntry.generation &&
+		entry.digests == newEntry.digests &&
+		maps.Equal(entry.generations, newEntry.generations)
+}
+
+// Add records that the given imports of the installation have been validated successfully against the schemas
+// with the given digests.
+// Nothing is recorded if the digests or the generations of the imports cannot be determined.
+func (c *ValidationCache) Add(inst *lsv1alpha1.Installation, digests SchemaDigests, imps *Imports) {
+	entry, ok := newValidationCacheEntry(inst, digests, imps)
+	key := client.ObjectKeyFromObject(inst)
+
+	c.mux.Lock()
+	defer c.mux.Unlock()
+	if !ok || c.maxEntries <= 0 {
+		delete(c.entries, key)
+		return
+	}
+	if _, exists := c.entries[key]; !exists && len(c.entries) >= c.maxEntries {
+		c.evict()
+	}
+	c.entries[key] = entry
+}
+
+// Remove removes the entry of the installation, so that its imports are validated again.
+func (c *ValidationCache) Remove(key types.NamespacedName) {
+	c.mux.Lock()
+	defer c.mux.Unlock()
+	delete(c.entries, key)
+}
+
+// Len returns the number of entries of the cache.
+func (c *ValidationCache) Len() int {
+	c.mux.Lock()
+	defer c.mux.Unlock()
+	return len(c.entries)
+}
+
+// evict removes all expired entries, or the oldest entry if no entry has expired.
+// The caller must hold the lock.
+func (c *ValidationCache) evict() {
+	var (
+		oldestKey types.NamespacedName
+		oldest    time.Time
+	)
+	evicted := false
+	for key, entry := range c.entries {
+		if c.isExpired(entry) {
+			delete(c.entries, key)
+			evicted = true
+			continue
+		}
+		if oldest.IsZero() || entry.added.Before(oldest) {
+			oldestKey = key
+			oldest = entry.added
+		}
+	}
+	if !evicted {
+		delete(c.entries, oldestKey)
+	}
+}
+
+func (c *ValidationCache) isExpired(entry validationCacheEntry) bool {
+	return c.ttl > 0 && time.Since(entry.added) > c.ttl
+}
+
+// newValidationCacheEntry creates the cache entry for the given imports.
+// It returns false if the digests of the schemas or the generation of an imported object are unknown.
+func newValidationCacheEntry(inst *lsv1alpha1.Installation, digests SchemaDigests,
+	imps *Imports) (validationCacheEntry, bool) {
+
+	if imps == nil || len(inst.UID) == 0 || inst.Generation == 0 ||
+		len(digests.Blueprint) == 0 || len(digests.ResolvedSchemas) == 0 {
+		return validationCacheEntry{}, false
+	}
+
+	entry := validationCacheEntry{
+		uid:         inst.UID,
+		generation:  inst.Generation,
+		digests:     digests,
+		generations: map[string]importedObjectGeneration{},
+		added:       time.Now(),
+	}
+
+	add := func(kind string, obj client.Object) bool {
+		if obj == nil || len(obj.GetName()) == 0 || obj.GetGeneration() == 0 {
+			return false
+		}
+		entry.generations[kind+"/"+obj.GetName()] = importedObjectGeneration{
+			uid:        obj.GetUID(),
+			generation: obj.GetGeneration(),
+		}
+		return true
+	}
+	addTarget := func(target *dataobjects.TargetExtension) bool {
+		// the content of targets with a secret reference can change without a new generation of the target
+		return target != nil && target.GetTarget() != nil && target.GetTarget().Spec.SecretRef == nil &&
+			add("Target", target.GetTarget())
+	}
+
+	for _, do := range imps.DataObjects {
+		if do == nil || do.Raw == nil || !add("DataObject", do.Raw) {
+			return validationCacheEntry{}, false
+		}
+	}
+	for _, target := range imps.Targets {
+		if !addTarget(target) {
+			return validationCacheEntry{}, false
+		}
+	}
+	for _, targetList := range imps.TargetLists {
+		for _, target := range targetList.GetTargetExtensions() {
+			if !addTarget(target) {
+				return validationCacheEntry{}, false
+			}
+		}
+	}
+	for _, targetMap := range imps.TargetMaps {
+		for _, target := range targetMap.GetTargetExtensions() {
+			if !addTarget(target) {
+				return validationCacheEntry{}, false
+			}
+		}
+	}
+	return entry, true
+}
@@ -0,0 +1,185 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package imports_test
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/mandelsoft/vfs/pkg/memoryfs"
+	"github.com/mandelsoft/vfs/pkg/vfs"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	lsv1alpha1 "github.com/gardener/landscaper/apis/core/v1alpha1"
+	"github.com/gardener/landscaper/pkg/landscaper/dataobjects"
+	"github.com/gardener/landscaper/pkg/landscaper/installations/imports"
+	"github.com/gardener/landscaper/pkg/landscaper/jsonschema"
+)
+
+var _ = Describe("ValidationCache", func() {
+
+	var (
+		cache   *imports.ValidationCache
+		inst    *lsv1alpha1.Installation
+		digests imports.SchemaDigests
+		imps    *imports.Imports
+	)
+
+	BeforeEach(func() {
+		cache = imports.NewValidationCache(100, time.Hour)
+
+		inst = &lsv1alpha1.Installation{}
+		inst.Name = "inst"
+		inst.Namespace = "default"
+		inst.UID = "inst-uid"
+		inst.Generation = 1
+
+		digests = imports.SchemaDigests{Blueprint: "blueprint", ResolvedSchemas: "schemas"}
+
+		do := &lsv1alpha1.DataObject{}
+		do.Name = "config"
+		do.UID = "config-uid"
+		do.Generation = 1
+		target := &lsv1alpha1.Target{}
+		target.Name = "cluster"
+		target.UID = "cluster-uid"
+		target.Generation = 1
+
+		imps = &imports.Imports{
+			DataObjects: map[string]*dataobjects.DataObject{"config": {Raw: do}},
+			Targets:     map[string]*dataobjects.TargetExtension{"cluster": dataobjects.NewTargetExtension(target, nil)},
+		}
+	})
+
+	It("should recognize imports that have been validated", func() {
+		Expect(cache.IsValidated(inst, digests, imps)).To(BeFalse())
+		cache.Add(inst, digests, imps)
+		Expect(cache.IsValidated(inst, digests, imps)).To(BeTrue())
+
+		cache.Remove(client.ObjectKeyFromObject(inst))
+		Expect(cache.IsValidated(inst, digests, imps)).To(BeFalse())
+	})
+
+	It("should validate the imports again if an imported object has changed", func() {
+		cache.Add(inst, digests, imps)
+
+		imps.DataObjects["config"].Raw.Generation = 2
+		Expect(cache.IsValidated(inst, digests, imps)).To(BeFalse())
+		imps.DataObjects["config"].Raw.Generation = 1
+		Expect(cache.IsValidated(inst, digests, imps)).To(BeTrue())
+
+		imps.DataObjects["config"].Raw.UID = "recreated-uid"
+		Expect(cache.IsValidated(inst, digests, imps)).To(BeFalse())
+		imps.DataObjects["config"].Raw.UID = "config-uid"
+
+		imps.Targets["cluster"].GetTarget().Generation = 2
+		Expect(cache.IsValidated(inst, digests, imps)).To(BeFalse())
+	})
+
+	It("should validate the imports again if the installation or its schemas have changed", func() {
+		cache.Add(inst, digests, imps)
+
+		inst.Generation = 2
+		Expect(cache.IsValidated(inst, digests, imps)).To(BeFalse())
+		inst.Generation = 1
+
+		Expect(cache.IsValidated(inst, imports.SchemaDigests{Blueprint: "other", ResolvedSchemas: "schemas"}, imps)).To(BeFalse())
+		Expect(cache.IsValidated(inst, imports.SchemaDigests{Blueprint: "blueprint", ResolvedSchemas: "other"}, imps)).To(BeFalse())
+		Expect(cache.IsValidated(inst, digests, imps)).To(BeTrue())
+	})
+
+	It("should always validate imports if the schemas are unknown", func() {
+		cache.Add(inst, imports.SchemaDigests{}, imps)
+		Expect(cache.IsValidated(inst, imports.SchemaDigests{}, imps)).To(BeFalse())
+	})
+
+	It("should validate the imports again after the entry has expired", func() {
+		cache = imports.NewValidationCache(100, 10*time.Millisecond)
+		cache.Add(inst, digests, imps)
+		Expect(cache.IsValidated(inst, digests, imps)).To(BeTrue())
+		Eventually(func() bool {
+			return cache.IsValidated(inst, digests, imps)
+		}).WithTimeout(time.Second).WithPolling(10 * time.Millisecond).Should(BeFalse())
+	})
+
+	It("should not exceed the maximal number of entries", func() {
+		cache = imports.NewValidationCache(3, time.Hour)
+		for i := 0; i < 5; i++ {
+			inst.Name = fmt.Sprintf("inst-%d", i)
+			cache.Add(inst, digests, imps)
+			Expect(cache.Len()).To(BeNumerically("<=", 3))
+		}
+		Expect(cache.IsValidated(inst, digests, imps)).To(BeTrue())
+	})
+
+	It("should always validate imports without generation", func() {
+		imps.DataObjects["credentials"] = &dataobjects.DataObject{Raw: &lsv1alpha1.DataObject{}}
+		cache.Add(inst, digests, imps)
+		Expect(cache.IsValidated(inst, digests, imps)).To(BeFalse())
+	})
+
+	It("should always validate targets with secret references", func() {
+		imps.Targets["cluster"].GetTarget().Spec.SecretRef = &lsv1alpha1.LocalSecretReference{Name: "kubeconfig"}
+		cache.Add(inst, digests, imps)
+		Expect(cache.IsValidated(inst, digests, imps)).To(BeFalse())
+	})
+
+	Context("schema digests", func() {
+
+		var (
+			fs        vfs.FileSystem
+			blueprint *lsv1alpha1.Blueprint
+		)
+
+		BeforeEach(func() {
+			fs = memoryfs.New()
+			Expect(vfs.WriteFile(fs, "schema.json", []byte(`{"type": "string"}`), 0o644)).To(Succeed())
+			blueprint = &lsv1alpha1.Blueprint{
+				Imports: lsv1alpha1.ImportDefinitionList{
+					{
+						FieldValueDefinition: lsv1alpha1.FieldValueDefinition{
+							Name:   "config",
+							Schema: &lsv1alpha1.JSONSchemaDefinition{RawMessage: []byte(`{"$ref": "blueprint://schema.json"}`)},
+						},
+					},
+				},
+			}
+		})
+
+		computeDigests := func() imports.SchemaDigests {
+			d, err := imports.NewSchemaDigests(blueprint, &jsonschema.ReferenceContext{BlueprintFs: fs})
+			Expect(err).ToNot(HaveOccurred())
+			return d
+		}
+
+		It("should compute the same digests for the same blueprint and schemas", func() {
+			Expect(computeDigests()).To(Equal(computeDigests()))
+		})
+
+		It("should compute different digests if a referenced schema file has changed", func() {
+			before := computeDigests()
+			Expect(vfs.WriteFile(fs, "schema.json", []byte(`{"type": "number"}`), 0o644)).To(Succeed())
+			after := computeDigests()
+			Expect(after.Blueprint).To(Equal(before.Blueprint))
+			Expect(after.ResolvedSchemas).ToNot(Equal(before.ResolvedSchemas))
+		})
+
+		It("should compute a different blueprint digest if the blueprint has changed", func() {
+			before := computeDigests()
+			blueprint.Exports = lsv1alpha1.ExportDefinitionList{
+				{FieldValueDefinition: lsv1alpha1.FieldValueDefinition{Name: "out"}},
+			}
+			Expect(computeDigests().Blueprint).ToNot(Equal(before.Blueprint))
+		})
+
+		It("should fail if a schema cannot be resolved", func() {
+			Expect(fs.Remove("schema.json")).To(Succeed())
+			_, err := imports.NewSchemaDigests(blueprint, &jsonschema.ReferenceContext{BlueprintFs: fs})
+			Expect(err).To(HaveOccurred())
+		})
+	})
+})
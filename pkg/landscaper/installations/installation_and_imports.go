@@ -1,6 +1,8 @@
 package installations
 
 import (
+	"sort"
+
 	lsv1alpha1 "github.com/gardener/landscaper/apis/core/v1alpha1"
 	lsv1alpha1helper "github.com/gardener/landscaper/apis/core/v1alpha1/helper"
 )
@@ -36,3 +38,26 @@ func (i *InstallationAndImports) GetInstallation() *lsv1alpha1.Installation {
 func (i *InstallationAndImports) MergeConditions(conditions ...lsv1alpha1.Condition) {
 	i.installation.Status.Conditions = lsv1alpha1helper.MergeConditions(i.installation.Status.Conditions, conditions...)
 }
+
+// GetPredecessorStatus returns the status entries describing the given predecessor installations sorted by name.
+func GetPredecessorStatus(predecessorMap map[string]*InstallationAndImports) []lsv1alpha1.PredecessorStatus {
+	if len(predecessorMap) == 0 {
+		return nil
+	}
+
+	result := make([]lsv1alpha1.PredecessorStatus, 0, len(predecessorMap))
+	for name, predecessor := range predecessorMap {
+		inst := predecessor.GetInstallation()
+		result = append(result, lsv1alpha1.PredecessorStatus{
+			Name:               name,
+			ObservedGeneration: inst.Status.ObservedGeneration,
+			Phase:              inst.Status.InstallationPhase,
+			JobIDFinished:      inst.Status.JobIDFinished,
+		})
+	}
+
+	sort.Slice(result, func(i, j int) bool {
+		return result[i].Name < result[j].Name
+	})
+	return result
+}
@@ -0,0 +1,51 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package installations_test
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	lsv1alpha1 "github.com/gardener/landscaper/apis/core/v1alpha1"
+	"github.com/gardener/landscaper/pkg/landscaper/installations"
+)
+
+var _ = Describe("PredecessorStatus", func() {
+
+	newPredecessor := func(name string, generation, observedGeneration int64) *installations.InstallationAndImports {
+		inst := &lsv1alpha1.Installation{}
+		inst.Name = name
+		inst.Generation = generation
+		inst.Status.ObservedGeneration = observedGeneration
+		inst.Status.InstallationPhase = lsv1alpha1.InstallationPhases.Succeeded
+		inst.Status.JobIDFinished = "job"
+		return installations.NewInstallationAndImports(inst)
+	}
+
+	It("should return nil if there are no predecessors", func() {
+		Expect(installations.GetPredecessorStatus(nil)).To(BeNil())
+	})
+
+	It("should return the predecessors sorted by name", func() {
+		status := installations.GetPredecessorStatus(map[string]*installations.InstallationAndImports{
+			"b": newPredecessor("b", 1, 1),
+			"a": newPredecessor("a", 1, 1),
+		})
+		Expect(status).To(HaveLen(2))
+		Expect(status[0].Name).To(Equal("a"))
+		Expect(status[1].Name).To(Equal("b"))
+		Expect(status[0].Phase).To(Equal(lsv1alpha1.InstallationPhases.Succeeded))
+		Expect(status[0].JobIDFinished).To(Equal("job"))
+	})
+
+	It("should report the observed generation of a predecessor that has not been processed yet", func() {
+		status := installations.GetPredecessorStatus(map[string]*installations.InstallationAndImports{
+			"a": newPredecessor("a", 3, 2),
+		})
+		Expect(status).To(HaveLen(1))
+		Expect(status[0].ObservedGeneration).To(Equal(int64(2)))
+	})
+
+})
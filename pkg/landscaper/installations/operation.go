@@ -9,11 +9,11 @@ import (
 	"encoding/json"
 	"fmt"
 
-	corev1 "k8s.io/api/core/v1"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/selection"
+	"k8s.io/apimachinery/pkg/util/sets"
 	"k8s.io/utils/ptr"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
@@ -31,6 +31,7 @@ import (
 	"github.com/gardener/landscaper/pkg/landscaper/jsonschema"
 	lsoperation "github.com/gardener/landscaper/pkg/landscaper/operation"
 	lsutil "github.com/gardener/landscaper/pkg/utils"
+	"github.com/gardener/landscaper/pkg/utils/events"
 	"github.com/gardener/landscaper/pkg/utils/read_write_layer"
 )
 
@@ -49,6 +50,9 @@ type Operation struct {
 
 	// CurrentOperation is the name of the current operation that is used for the error reporting
 	CurrentOperation string
+
+	// ImportTrace records the decisions of the import resolution. It is nil if the imports are not traced.
+	ImportTrace *ImportTrace
 }
 
 // NewInstallationOperationFromOperation creates a new installation operation from an existing common operation.
@@ -109,15 +113,20 @@ func (o *Operation) InstallationContextName() string {
 	return o.context.Name
 }
 
-// JSONSchemaValidator returns a jsonschema validator.
-func (o *Operation) JSONSchemaValidator(schema []byte) (*jsonschema.Validator, error) {
-	v := jsonschema.NewValidator(&jsonschema.ReferenceContext{
+// JSONSchemaReferenceContext returns the context in which the references of the json schemas of the blueprint are resolved.
+func (o *Operation) JSONSchemaReferenceContext() *jsonschema.ReferenceContext {
+	return &jsonschema.ReferenceContext{
 		LocalTypes:        o.Inst.GetBlueprint().Info.LocalTypes,
 		BlueprintFs:       o.Inst.GetBlueprint().Fs,
 		ComponentVersion:  o.ComponentVersion,
 		RegistryAccess:    o.ComponentsRegistry(),
 		RepositoryContext: o.context.External.RepositoryContext,
-	})
+	}
+}
+
+// JSONSchemaValidator returns a jsonschema validator.
+func (o *Operation) JSONSchemaValidator(schema []byte) (*jsonschema.Validator, error) {
+	v := jsonschema.NewValidator(o.JSONSchemaReferenceContext())
 	err := v.CompileSchema(schema)
 	if err != nil {
 		return nil, fmt.Errorf("error compiling jsonschema: %w", err)
@@ -231,9 +240,11 @@ func (o *Operation) GetImportedDataObjects(ctx context.Context) (map[string]*dat
 
 		do, _, err := GetDataImport(ctx, o.LsUncachedClient(), o.Context().Name, &o.Inst.InstallationAndImports, def)
 		if err != nil {
+			o.ImportTrace.Record(def.Name, "ResolveDataImport", ImportTraceRejected, "%s", err.Error())
 			return nil, err
 		}
 		dataObjects[def.Name] = do
+		o.ImportTrace.RecordDataObject(def.Name, do, o.Inst.GetInstallation().Status.JobID)
 
 		var (
 			sourceRef *lsv1alpha1.ObjectReference
@@ -244,8 +255,9 @@ func (o *Operation) GetImportedDataObjects(ctx context.Context) (map[string]*dat
 				Name:      owner.Name,
 				Namespace: o.Inst.GetInstallation().Namespace,
 			}
-			inst := &lsv1alpha1.Installation{}
-			if err := read_write_layer.GetInstallation(ctx, o.LsUncachedClient(), sourceRef.NamespacedName(), inst, read_write_layer.R000008); err != nil {
+			if err := GetImportCache().CheckInstallationExists(ctx, o.LsUncachedClient(), sourceRef.NamespacedName()); err != nil {
+				o.ImportTrace.Record(def.Name, "CheckSourceInstallation", ImportTraceRejected,
+					"source installation %q of the data object does not exist: %s", sourceRef.NamespacedName().String(), err.Error())
 				return nil, fmt.Errorf("unable to get source installation '%s' for import '%s': %w",
 					sourceRef.NamespacedName().String(), def.Name, err)
 			}
@@ -265,9 +277,11 @@ func (o *Operation) GetImportedTargets(ctx context.Context) (map[string]*dataobj
 		}
 		target, err := GetTargetImport(ctx, o.LsUncachedClient(), o.Context().Name, o.Inst.GetInstallation(), def)
 		if err != nil {
+			o.ImportTrace.Record(def.Name, "ResolveTargetImport", ImportTraceRejected, "%s", err.Error())
 			return nil, err
 		}
 		targets[def.Name] = target
+		o.ImportTrace.RecordTarget(def.Name, "ResolveTargetImport", target)
 
 		var (
 			sourceRef *lsv1alpha1.ObjectReference
@@ -281,6 +295,8 @@ func (o *Operation) GetImportedTargets(ctx context.Context) (map[string]*dataobj
 			inst := &lsv1alpha1.Installation{}
 			if err := read_write_layer.GetInstallation(ctx, o.LsUncachedClient(), sourceRef.NamespacedName(), inst,
 				read_write_layer.R000004); err != nil {
+				o.ImportTrace.Record(def.Name, "CheckSourceInstallation", ImportTraceRejected,
+					"source installation %q of the target does not exist: %s", sourceRef.NamespacedName().String(), err.Error())
 				return nil, fmt.Errorf("unable to get source installation '%s' for import '%s': %w",
 					sourceRef.NamespacedName().String(), def.Name, err)
 			}
@@ -308,15 +324,26 @@ func (o *Operation) GetImportedTargetLists(ctx context.Context) (map[string]*dat
 		} else if len(def.TargetListReference) != 0 {
 			// TargetListReference is converted to a label selector internally
 			tl, err = GetTargetListImportBySelector(ctx, o.LsUncachedClient(), o.Context().Name, o.Inst.GetInstallation(), map[string]string{lsv1alpha1.DataObjectKeyLabel: def.TargetListReference}, def)
+		} else if def.TargetSelector != nil {
+			// Targets with matching labels, only possible for root installations
+			if !IsRootInstallation(o.Inst.GetInstallation()) {
+				err = fmt.Errorf("invalid target definition '%s': targetSelector can only be used in root installations", def.Name)
+			} else {
+				tl, err = GetTargetListImportByTargetSelector(ctx, o.LsUncachedClient(), o.Inst.GetInstallation(), def)
+			}
 		} else {
 			// Invalid target
-			err = fmt.Errorf("invalid target definition '%s': none of target, targets and targetListRef is defined", def.Name)
+			err = fmt.Errorf("invalid target definition '%s': none of target, targets, targetListRef and targetSelector is defined", def.Name)
 		}
 		if err != nil {
+			o.ImportTrace.Record(def.Name, "ResolveTargetListImport", ImportTraceRejected, "%s", err.Error())
 			return nil, err
 		}
 
 		targets[def.Name] = tl
+		for i, target := range tl.GetTargetExtensions() {
+			o.ImportTrace.RecordTarget(fmt.Sprintf("%s[%d]", def.Name, i), "ResolveTargetListImport", target)
+		}
 	}
 
 	return targets, nil
@@ -327,7 +354,7 @@ func (o *Operation) GetImportedTargetMaps(ctx context.Context) (map[string]*data
 	targetMaps := map[string]*dataobjects.TargetMapExtension{}
 
 	for _, def := range o.Inst.GetInstallation().Spec.Imports.Targets {
-		if len(def.Target) != 0 || def.Targets != nil || len(def.TargetListReference) != 0 {
+		if len(def.Target) != 0 || def.Targets != nil || len(def.TargetListReference) != 0 || def.TargetSelector != nil {
 			// It's a target or target list, skip it
 			continue
 		}
@@ -342,10 +369,15 @@ func (o *Operation) GetImportedTargetMaps(ctx context.Context) (map[string]*data
 			err = fmt.Errorf("invalid target definition %s", def.Name)
 		}
 		if err != nil {
+			o.ImportTrace.Record(def.Name, "ResolveTargetMapImport", ImportTraceRejected, "%s", err.Error())
 			return nil, err
 		}
 
 		targetMaps[def.Name] = tm
+		targetExtensions := tm.GetTargetExtensions()
+		for _, key := range sets.List(sets.KeySet(targetExtensions)) {
+			o.ImportTrace.RecordTarget(fmt.Sprintf("%s[%s]", def.Name, key), "ResolveTargetMapImport", targetExtensions[key])
+		}
 	}
 
 	return targetMaps, nil
@@ -356,9 +388,12 @@ func (o *Operation) NewError(err error, reason, message string, codes ...lsv1alp
 	return lserrors.NewWrappedError(err, o.CurrentOperation, reason, message, codes...)
 }
 
-// CreateEventFromCondition creates a new event based on the given condition
-func (o *Operation) CreateEventFromCondition(ctx context.Context, inst *lsv1alpha1.Installation, cond lsv1alpha1.Condition) error {
-	o.Operation.EventRecorder().Event(inst, corev1.EventTypeWarning, cond.Reason, cond.Message)
+// CreateEventFromCondition creates a new warning event with the given reason based on the given condition.
+// The reason of the condition is used as code of the event.
+func (o *Operation) CreateEventFromCondition(ctx context.Context, inst *lsv1alpha1.Installation, reason events.Reason,
+	cond lsv1alpha1.Condition) error {
+	events.Warning(o.Operation.EventRecorder(), inst, reason, cond.Reason, cond.Message,
+		map[string]string{events.ConditionAnnotation: string(cond.Type)})
 	return nil
 }
 
@@ -96,21 +96,32 @@ func (rh *ReconcileHelper) AllPredecessorsFinished(ctx context.Context, installa
 			if lsv1alpha1helper.HasOperation(predecessor.GetInstallation().ObjectMeta, lsv1alpha1.ReconcileOperation) {
 				msg := fmt.Sprintf("depending on installation %q which has reconcile annotation",
 					kutil.ObjectKeyFromObject(predecessor.GetInstallation()).String())
+				rh.ImportTrace.Record("", "PredecessorFinished", installations.ImportTraceRejected, "%s", msg)
 				return lserror.NewWrappedError(nil, reason, reason, msg, lsv1alpha1.ErrorForInfoOnly)
 			}
 
 			if predecessor.GetInstallation().Status.JobID != predecessor.GetInstallation().Status.JobIDFinished {
 				msg := fmt.Sprintf("depending on installation %q which not finished current job %q",
 					kutil.ObjectKeyFromObject(predecessor.GetInstallation()).String(), installation.Status.JobID)
+				rh.ImportTrace.Record("", "PredecessorFinished", installations.ImportTraceRejected,
+					"%s (job id %q, finished job id %q)", msg, predecessor.GetInstallation().Status.JobID,
+					predecessor.GetInstallation().Status.JobIDFinished)
 				return lserror.NewWrappedError(nil, reason, reason, msg, lsv1alpha1.ErrorForInfoOnly)
 			}
 		} else {
 			if installation.Status.JobID != predecessor.GetInstallation().Status.JobIDFinished {
 				msg := fmt.Sprintf("depending on installation %q which not finished current job %q",
 					kutil.ObjectKeyFromObject(predecessor.GetInstallation()).String(), installation.Status.JobID)
+				rh.ImportTrace.Record("", "PredecessorFinished", installations.ImportTraceRejected,
+					"%s (job id %q, finished job id %q)", msg, predecessor.GetInstallation().Status.JobID,
+					predecessor.GetInstallation().Status.JobIDFinished)
 				return lserror.NewWrappedError(nil, reason, reason, msg, lsv1alpha1.ErrorForInfoOnly)
 			}
 		}
+
+		rh.ImportTrace.Record("", "PredecessorFinished", installations.ImportTraceAccepted,
+			"installation %q has finished job id %q", kutil.ObjectKeyFromObject(predecessor.GetInstallation()).String(),
+			predecessor.GetInstallation().Status.JobIDFinished)
 	}
 
 	return nil
@@ -128,6 +139,8 @@ func (rh *ReconcileHelper) AllPredecessorsSucceeded(ctx context.Context, install
 			reason := string(installations.NotCompletedDependents)
 			msg := fmt.Sprintf("depending on installation %q which is not succeeded",
 				kutil.ObjectKeyFromObject(predecessor.GetInstallation()).String())
+			rh.ImportTrace.Record("", "PredecessorSucceeded", installations.ImportTraceRejected, "%s (phase %q)",
+				msg, predecessor.GetInstallation().Status.InstallationPhase)
 			return lserror.NewWrappedError(nil, reason, reason, msg, lsv1alpha1.ErrorForInfoOnly)
 		}
 	}
@@ -216,5 +229,8 @@ func (rh *ReconcileHelper) FetchPredecessors(ctx context.Context) (sets.String,
 		siblingInsts = append(siblingInsts, next.GetInstallation())
 	}
 
-	return dependencies.FetchPredecessorsFromInstallation(inst, siblingInsts), nil
+	predecessors := dependencies.FetchPredecessorsFromInstallation(inst, siblingInsts)
+	rh.ImportTrace.Record("", "FetchPredecessors", installations.ImportTraceInfo, "considered %d siblings, predecessors: %v",
+		len(siblingInsts), predecessors.List())
+	return predecessors, nil
 }
@@ -5,6 +5,7 @@
 package subinstallations
 
 import (
+	"context"
 	"fmt"
 
 	"github.com/gardener/component-spec/bindings-go/codec"
@@ -14,9 +15,13 @@ import (
 	"github.com/gardener/landscaper/apis/core"
 	lsv1alpha1 "github.com/gardener/landscaper/apis/core/v1alpha1"
 	"github.com/gardener/landscaper/apis/core/validation"
+	"github.com/gardener/landscaper/controller-utils/pkg/logging"
+	lc "github.com/gardener/landscaper/controller-utils/pkg/logging/constants"
 	"github.com/gardener/landscaper/pkg/components/model"
 	"github.com/gardener/landscaper/pkg/components/model/componentoverwrites"
 	"github.com/gardener/landscaper/pkg/components/model/types"
+	"github.com/gardener/landscaper/pkg/landscaper/blueprints"
+	"github.com/gardener/landscaper/pkg/landscaper/jsonschema"
 	"github.com/gardener/landscaper/pkg/landscaper/registry/components/cdutils"
 )
 
@@ -115,6 +120,78 @@ func (o *Operation) ValidateSubinstallations(installationTmpl []*lsv1alpha1.Inst
 	return nil
 }
 
+// ValidateSubinstallationContracts verifies that the exports of the subinstallations satisfy the imports
+// of the subinstallations that consume them.
+// Subinstallations whose blueprints cannot be resolved are skipped, as the error is reported by the subinstallations.
+func (o *Operation) ValidateSubinstallationContracts(ctx context.Context, installationTmpl []*lsv1alpha1.InstallationTemplate) error {
+	logger, ctx := logging.FromContextOrNew(ctx, nil)
+
+	parties := make([]blueprints.SubinstallationContractParty, 0, len(installationTmpl))
+	for _, tmpl := range installationTmpl {
+		party, err := o.resolveContractParty(ctx, tmpl)
+		if err != nil {
+			logger.Debug("unable to resolve blueprint of subinstallation, skipping verification of its contracts",
+				"subinstallation", tmpl.Name, lc.KeyError, err.Error())
+			continue
+		}
+		parties = append(parties, blueprints.SubinstallationContractParty{
+			ContractParty: *party,
+			Template:      tmpl,
+		})
+	}
+
+	if allErrs := blueprints.VerifySubinstallationContracts(field.NewPath("subinstallations"), parties); len(allErrs) != 0 {
+		return o.NewError(allErrs.ToAggregate(), "ValidateSubInstallationContracts", allErrs.ToAggregate().Error(),
+			lsv1alpha1.ErrorConfigurationProblem)
+	}
+	return nil
+}
+
+// resolveContractParty resolves the blueprint of a subinstallation together with the context
+// that is needed to resolve the references of its json schemas.
+func (o *Operation) resolveContractParty(ctx context.Context, tmpl *lsv1alpha1.InstallationTemplate) (*blueprints.ContractParty, error) {
+	bpDef, cdDef, err := GetBlueprintDefinitionFromInstallationTemplate(o.Inst.GetInstallation(),
+		tmpl,
+		o.ComponentVersion,
+		o.Context().External.RepositoryContext,
+		o.Context().External.Overwriter)
+	if err != nil {
+		return nil, err
+	}
+
+	var (
+		componentVersion = o.ComponentVersion
+		cdRef            *lsv1alpha1.ComponentDescriptorReference
+	)
+	if bpDef.Reference != nil {
+		if cdDef == nil || cdDef.Reference == nil {
+			return nil, errors.New("no component descriptor reference defined")
+		}
+		cdRef = cdDef.Reference.DeepCopy()
+		cdRef.RepositoryContext = o.Context().External.RepositoryContext
+		componentVersion, err = o.ComponentsRegistry().GetComponentVersion(ctx, cdRef)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	blueprint, err := blueprints.Resolve(ctx, o.ComponentsRegistry(), cdRef, *bpDef)
+	if err != nil {
+		return nil, err
+	}
+
+	return &blueprints.ContractParty{
+		Blueprint: blueprint,
+		ReferenceContext: &jsonschema.ReferenceContext{
+			LocalTypes:        blueprint.Info.LocalTypes,
+			BlueprintFs:       blueprint.Fs,
+			ComponentVersion:  componentVersion,
+			RegistryAccess:    o.ComponentsRegistry(),
+			RepositoryContext: o.Context().External.RepositoryContext,
+		},
+	}, nil
+}
+
 // convertToCoreInstallationTemplates converts a list of v1alpha1 InstallationTemplates to their core version
 func convertToCoreInstallationTemplates(installationTmpl []*lsv1alpha1.InstallationTemplate) ([]*core.InstallationTemplate, error) {
 	coreInstTmpls := make([]*core.InstallationTemplate, len(installationTmpl))
@@ -20,10 +20,13 @@ import (
 	genericresolver "github.com/gardener/landscaper/controller-utils/pkg/landscaper/targetresolver/generic"
 	"github.com/gardener/landscaper/controller-utils/pkg/logging"
 	lc "github.com/gardener/landscaper/controller-utils/pkg/logging/constants"
+	"github.com/gardener/landscaper/pkg/landscaper/blueprints"
 	"github.com/gardener/landscaper/pkg/landscaper/installations/executions/template"
+	"github.com/gardener/landscaper/pkg/landscaper/installations/executions/template/cue"
 	"github.com/gardener/landscaper/pkg/landscaper/installations/executions/template/gotemplate"
 	"github.com/gardener/landscaper/pkg/landscaper/installations/executions/template/spiff"
 	"github.com/gardener/landscaper/pkg/utils/dependencies"
+	"github.com/gardener/landscaper/pkg/utils/events"
 	"github.com/gardener/landscaper/pkg/utils/read_write_layer"
 )
 
@@ -41,12 +44,18 @@ func (o *Operation) Ensure(ctx context.Context, subInstCache *lsv1alpha1.SubInst
 		return err
 	}
 
-	installationTmpl, err := o.getInstallationTemplates()
+	installationTmpl, err := o.getInstallationTemplates(ctx)
 	if err != nil {
 		err = fmt.Errorf("unable to get installation templates of blueprint: %w", err)
 		return o.NewError(err, "GetInstallationTemplates", err.Error())
 	}
 
+	installationTmpl, renderedSubinstallations, err := blueprints.ExpandInstallationTemplates(installationTmpl, o.Inst.GetImports())
+	if err != nil {
+		err = fmt.Errorf("unable to expand installation templates of blueprint: %w", err)
+		return o.NewError(err, "ExpandInstallationTemplates", err.Error())
+	}
+
 	for _, instT := range installationTmpl {
 		// remove imports based on optional and conditional imports which are not satisfied in the parent
 		imports := []lsv1alpha1.DataImport{}
@@ -63,6 +72,9 @@ func (o *Operation) Ensure(ctx context.Context, subInstCache *lsv1alpha1.SubInst
 	if err := o.ValidateSubinstallations(installationTmpl); err != nil {
 		return err
 	}
+	if err := o.ValidateSubinstallationContracts(ctx, installationTmpl); err != nil {
+		return err
+	}
 
 	// delete removed subreferences
 	orphaned, err := o.cleanupOrphanedSubInstallations(ctx, subInstallations, installationTmpl)
@@ -79,6 +91,7 @@ func (o *Operation) Ensure(ctx context.Context, subInstCache *lsv1alpha1.SubInst
 		ActiveSubs:   subinsts,
 		OrphanedSubs: orphaned,
 	}
+	inst.Status.RenderedSubinstallations = renderedSubinstallations
 
 	cond = lsv1alpha1helper.UpdatedCondition(cond, lsv1alpha1.ConditionTrue,
 		"InstallationsInstalled", "All Installations are successfully installed")
@@ -120,7 +133,7 @@ func (o *Operation) GetSubInstallations(ctx context.Context, inst *lsv1alpha1.In
 		cond = lsv1alpha1helper.UpdatedCondition(cond, lsv1alpha1.ConditionFalse,
 			"SubInstallationsNotFound", "Unable to list subinstallations")
 		inst.Status.Conditions = lsv1alpha1helper.MergeConditions(inst.Status.Conditions, cond)
-		_ = o.CreateEventFromCondition(ctx, inst, cond)
+		_ = o.CreateEventFromCondition(ctx, inst, events.ReasonSubInstallationsFailed, cond)
 		return nil, o.NewError(err, "SubInstallationsNotFound", err.Error())
 	}
 	for _, inst := range installations {
@@ -175,7 +188,7 @@ func (o *Operation) cleanupOrphanedSubInstallations(ctx context.Context,
 			cond = lsv1alpha1helper.UpdatedCondition(cond, lsv1alpha1.ConditionFalse,
 				"InstallationNotDeleted", fmt.Sprintf("Sub Installation %s cannot be deleted", subInst.Name))
 			inst.Status.Conditions = lsv1alpha1helper.MergeConditions(inst.Status.Conditions, cond)
-			_ = o.CreateEventFromCondition(ctx, inst, cond)
+			_ = o.CreateEventFromCondition(ctx, inst, events.ReasonSubInstallationsFailed, cond)
 			return nil, o.NewError(err, "InstallationNotDeleted", err.Error())
 		}
 	}
@@ -183,7 +196,7 @@ func (o *Operation) cleanupOrphanedSubInstallations(ctx context.Context,
 }
 
 // getInstallationTemplates returns all installation templates defined by the referenced blueprint.
-func (o *Operation) getInstallationTemplates() ([]*lsv1alpha1.InstallationTemplate, error) {
+func (o *Operation) getInstallationTemplates(ctx context.Context) ([]*lsv1alpha1.InstallationTemplate, error) {
 	var instTmpls []*lsv1alpha1.InstallationTemplate
 	if len(o.Inst.GetBlueprint().Info.SubinstallationExecutions) != 0 {
 		templateStateHandler := template.KubernetesStateHandler{
@@ -191,14 +204,18 @@ func (o *Operation) getInstallationTemplates() ([]*lsv1alpha1.InstallationTempla
 			Inst:       o.Inst.GetInstallation(),
 		}
 		targetResolver := genericresolver.New(o.LsUncachedClient())
-		tmpl := template.New(gotemplate.New(templateStateHandler, targetResolver), spiff.New(templateStateHandler, targetResolver))
+		versionResolver := o.ComponentVersionResolver(ctx)
+		tmpl := template.New(
+			gotemplate.New(templateStateHandler, targetResolver).WithComponentVersionResolver(versionResolver),
+			spiff.New(templateStateHandler, targetResolver).WithComponentVersionResolver(versionResolver),
+			cue.New())
 		templatedTmpls, err := tmpl.TemplateSubinstallationExecutions(template.NewDeployExecutionOptions(
 			template.NewBlueprintExecutionOptions(
 				o.Context().External.InjectComponentDescriptorRef(o.Inst.GetInstallation().DeepCopy()),
 				o.Inst.GetBlueprint(),
 				o.ComponentVersion,
 				o.ResolvedComponentDescriptorList,
-				o.Inst.GetImports())))
+				o.Inst.GetImports()).WithContextFeatureFlags(o.Context().External.FeatureFlags)))
 
 		if err != nil {
 			return nil, fmt.Errorf("unable to template subinstllations: %w", err)
@@ -296,6 +313,7 @@ func (o *Operation) createOrUpdateNewInstallation(ctx context.Context,
 		if lsv1alpha1helper.HasCacheHelmChartsAnnotation(&inst.ObjectMeta) {
 			metav1.SetMetaDataAnnotation(&subInst.ObjectMeta, lsv1alpha1.CacheHelmChartsAnnotation, "true")
 		}
+		lsv1alpha1helper.PropagateRequireReadinessAnnotation(&inst.ObjectMeta, &subInst.ObjectMeta)
 
 		if err := controllerutil.SetControllerReference(inst, subInst, o.Scheme()); err != nil {
 			return errors.Wrapf(err, "unable to set owner reference")
@@ -319,7 +337,7 @@ func (o *Operation) createOrUpdateNewInstallation(ctx context.Context,
 			"InstallationCreatingFailed",
 			fmt.Sprintf("Sub Installation %s cannot be created", subInstTmpl.Name))
 		inst.Status.Conditions = lsv1alpha1helper.MergeConditions(inst.Status.Conditions, cond)
-		_ = o.CreateEventFromCondition(ctx, inst, cond)
+		_ = o.CreateEventFromCondition(ctx, inst, events.ReasonSubInstallationsFailed, cond)
 		return nil, errors.Wrapf(err, "unable to create installation for %s", subInstTmpl.Name)
 	}
 
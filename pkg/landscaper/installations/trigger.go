@@ -10,6 +10,7 @@ import (
 
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/sets"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	lsv1alpha1 "github.com/gardener/landscaper/apis/core/v1alpha1"
@@ -17,6 +18,10 @@ import (
 	"github.com/gardener/landscaper/pkg/utils/read_write_layer"
 )
 
+// reconcileReasonDependency is the reason of the reconcile operations that are set at dependent installations.
+// In contrast to an explicit reconcile, it only redeploys the deploy items that have changed.
+const reconcileReasonDependency = "dependency"
+
 type InstallationTrigger struct {
 	client client.Client
 	inst   *lsv1alpha1.Installation
@@ -67,7 +72,7 @@ func (t *InstallationTrigger) TriggerDependents(ctx context.Context) error {
 		}
 	}
 
-	if err := t.clearDependents(ctx); err != nil {
+	if err := t.clearDependents(ctx, t.inst.Status.DependentsToTrigger); err != nil {
 		return err
 	}
 
@@ -85,6 +90,7 @@ func (t *InstallationTrigger) triggerDependent(ctx context.Context, dependent ls
 
 	if IsRootInstallation(dependentInst) {
 		metav1.SetMetaDataAnnotation(&dependentInst.ObjectMeta, lsv1alpha1.OperationAnnotation, string(lsv1alpha1.ReconcileOperation))
+		metav1.SetMetaDataAnnotation(&dependentInst.ObjectMeta, lsv1alpha1.ReconcileReasonAnnotation, reconcileReasonDependency)
 	}
 
 	lsv1alpha1helper.Touch(&dependentInst.ObjectMeta)
@@ -108,9 +114,26 @@ func (t *InstallationTrigger) getDependent(ctx context.Context, dependent lsv1al
 	return dependentInst, nil
 }
 
-func (t *InstallationTrigger) clearDependents(ctx context.Context) error {
-	t.inst.Status.DependentsToTrigger = nil
-	if err := read_write_layer.NewWriter(t.client).UpdateInstallationStatus(ctx, read_write_layer.W000042, t.inst); err != nil {
+// clearDependents removes the triggered dependents from the status. Dependents that have been added to the latest
+// version of the installation in the meantime are kept, so that they are triggered with the next reconciliation.
+func (t *InstallationTrigger) clearDependents(ctx context.Context, triggered []lsv1alpha1.DependentToTrigger) error {
+	triggeredNames := sets.New[string]()
+	for _, dependent := range triggered {
+		triggeredNames.Insert(dependent.Name)
+	}
+
+	resetDependents := func(inst *lsv1alpha1.Installation) error {
+		var remaining []lsv1alpha1.DependentToTrigger
+		for _, dependent := range inst.Status.DependentsToTrigger {
+			if !triggeredNames.Has(dependent.Name) {
+				remaining = append(remaining, dependent)
+			}
+		}
+		inst.Status.DependentsToTrigger = remaining
+		return nil
+	}
+	if err := read_write_layer.NewWriter(t.client).UpdateInstallationStatusWithRetry(ctx, read_write_layer.W000042,
+		read_write_layer.R000159, t.inst, resetDependents); err != nil {
 		return fmt.Errorf("failed to clear depends: %w", err)
 	}
 
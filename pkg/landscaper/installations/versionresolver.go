@@ -0,0 +1,67 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package installations
+
+import (
+	"context"
+
+	cdv2 "github.com/gardener/component-spec/bindings-go/apis/v2"
+
+	lsv1alpha1 "github.com/gardener/landscaper/apis/core/v1alpha1"
+	"github.com/gardener/landscaper/pkg/components/model"
+)
+
+// ComponentVersionResolver resolves the latest version of a component that matches a semver constraint
+// during the templating of an installation.
+// The resolved versions are pinned in the status of the installation, so that subsequent reconciliations
+// of the installation use the same versions.
+type ComponentVersionResolver struct {
+	ctx               context.Context
+	registryAccess    model.RegistryAccess
+	repositoryContext *cdv2.UnstructuredTypedObject
+	inst              *lsv1alpha1.Installation
+}
+
+// NewComponentVersionResolver creates a new resolver that looks up the component versions in the given repository
+// and pins them in the status of the given installation.
+func NewComponentVersionResolver(ctx context.Context,
+	registryAccess model.RegistryAccess,
+	repositoryContext *cdv2.UnstructuredTypedObject,
+	inst *lsv1alpha1.Installation) *ComponentVersionResolver {
+
+	return &ComponentVersionResolver{
+		ctx:               ctx,
+		registryAccess:    registryAccess,
+		repositoryContext: repositoryContext,
+		inst:              inst,
+	}
+}
+
+// ComponentVersionResolver returns a resolver for the component versions of the installation of the operation.
+func (o *Operation) ComponentVersionResolver(ctx context.Context) *ComponentVersionResolver {
+	return NewComponentVersionResolver(ctx, o.ComponentsRegistry(), o.Context().External.RepositoryContext, o.Inst.GetInstallation())
+}
+
+// ResolveComponentVersion returns the version that is pinned in the installation status for the component and
+// the constraint. If there is no such version, the latest matching version is resolved and pinned.
+func (r *ComponentVersionResolver) ResolveComponentVersion(componentName, constraint string) (string, error) {
+	for _, resolved := range r.inst.Status.ResolvedComponentVersions {
+		if resolved.ComponentName == componentName && resolved.Constraint == constraint {
+			return resolved.Version, nil
+		}
+	}
+
+	version, err := model.ResolveComponentVersion(r.ctx, r.registryAccess, r.repositoryContext, componentName, constraint)
+	if err != nil {
+		return "", err
+	}
+
+	r.inst.Status.ResolvedComponentVersions = append(r.inst.Status.ResolvedComponentVersions, lsv1alpha1.ResolvedComponentVersion{
+		ComponentName: componentName,
+		Constraint:    constraint,
+		Version:       version,
+	})
+	return version, nil
+}
@@ -0,0 +1,88 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package installations_test
+
+import (
+	"context"
+
+	cdv2 "github.com/gardener/component-spec/bindings-go/apis/v2"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	lsv1alpha1 "github.com/gardener/landscaper/apis/core/v1alpha1"
+	"github.com/gardener/landscaper/pkg/components/model/types"
+	"github.com/gardener/landscaper/pkg/components/testutils"
+	"github.com/gardener/landscaper/pkg/landscaper/installations"
+)
+
+var _ = Describe("ComponentVersionResolver", func() {
+
+	const componentName = "example.com/component"
+
+	componentDescriptor := func(name, version string) types.ComponentDescriptor {
+		cd := types.ComponentDescriptor{}
+		cd.Name = name
+		cd.Version = version
+		return cd
+	}
+
+	var registryAccess *testutils.TestRegistryAccess
+
+	BeforeEach(func() {
+		registryAccess = testutils.NewTestRegistryAccess(
+			componentDescriptor(componentName, "v1.0.0"),
+			componentDescriptor(componentName, "v1.2.0"),
+			componentDescriptor(componentName, "v1.10.1"),
+			componentDescriptor(componentName, "v2.0.0"),
+			componentDescriptor(componentName, "latest"),
+			componentDescriptor("example.com/other", "v1.99.0"),
+		)
+	})
+
+	It("should resolve the latest version that matches the constraint and pin it in the status", func() {
+		inst := &lsv1alpha1.Installation{}
+		resolver := installations.NewComponentVersionResolver(context.Background(), registryAccess,
+			cdv2.NewUnstructuredType("local", nil), inst)
+
+		version, err := resolver.ResolveComponentVersion(componentName, "~1")
+		Expect(err).ToNot(HaveOccurred())
+		Expect(version).To(Equal("v1.10.1"))
+
+		version, err = resolver.ResolveComponentVersion(componentName, ">= 1.0.0, < 1.10.0")
+		Expect(err).ToNot(HaveOccurred())
+		Expect(version).To(Equal("v1.2.0"))
+
+		Expect(inst.Status.ResolvedComponentVersions).To(ConsistOf(
+			lsv1alpha1.ResolvedComponentVersion{ComponentName: componentName, Constraint: "~1", Version: "v1.10.1"},
+			lsv1alpha1.ResolvedComponentVersion{ComponentName: componentName, Constraint: ">= 1.0.0, < 1.10.0", Version: "v1.2.0"},
+		))
+	})
+
+	It("should reuse a pinned version even if a newer version matches the constraint", func() {
+		inst := &lsv1alpha1.Installation{}
+		inst.Status.ResolvedComponentVersions = []lsv1alpha1.ResolvedComponentVersion{
+			{ComponentName: componentName, Constraint: "~1", Version: "v1.0.0"},
+		}
+		resolver := installations.NewComponentVersionResolver(context.Background(), registryAccess,
+			cdv2.NewUnstructuredType("local", nil), inst)
+
+		version, err := resolver.ResolveComponentVersion(componentName, "~1")
+		Expect(err).ToNot(HaveOccurred())
+		Expect(version).To(Equal("v1.0.0"))
+		Expect(inst.Status.ResolvedComponentVersions).To(HaveLen(1))
+	})
+
+	It("should fail if no version matches the constraint", func() {
+		inst := &lsv1alpha1.Installation{}
+		resolver := installations.NewComponentVersionResolver(context.Background(), registryAccess,
+			cdv2.NewUnstructuredType("local", nil), inst)
+
+		_, err := resolver.ResolveComponentVersion(componentName, "~3")
+		Expect(err).To(HaveOccurred())
+		_, err = resolver.ResolveComponentVersion(componentName, "not a constraint")
+		Expect(err).To(HaveOccurred())
+		Expect(inst.Status.ResolvedComponentVersions).To(BeEmpty())
+	})
+})
@@ -11,9 +11,12 @@ import (
 	"encoding/base64"
 	"encoding/json"
 	"fmt"
+	"net/http"
+	"net/http/httptest"
 	"os"
 	"path/filepath"
 	"strings"
+	"sync/atomic"
 	"testing"
 	"time"
 
@@ -179,6 +182,79 @@ var _ = Describe("jsonschema", func() {
 
 			Expect(jsonschema.ValidateBytes(schemaBytes, data, config)).To(Succeed())
 		})
+
+		It("should resolve relative references across blueprint files", func() {
+			Expect(config.BlueprintFs.MkdirAll("schemas/config", os.ModePerm)).To(Succeed())
+			common := []byte(`{ "definitions": { "name": { "type": "string" }, "names": { "type": "array", "items": { "$ref": "#/definitions/name" } } } }`)
+			Expect(vfs.WriteFile(config.BlueprintFs, "schemas/common.json", common, os.ModePerm)).To(Succeed())
+			config1 := []byte(`{ "type": "object", "properties": { "names": { "$ref": "../common.json#/definitions/names" } } }`)
+			Expect(vfs.WriteFile(config.BlueprintFs, "schemas/config/config.json", config1, os.ModePerm)).To(Succeed())
+
+			schemaBytes := []byte(`{ "$ref": "blueprint://schemas/config/config.json" }`)
+
+			Expect(jsonschema.ValidateBytes(schemaBytes, []byte(`{ "names": ["a", "b"] }`), config)).To(Succeed())
+			Expect(jsonschema.ValidateBytes(schemaBytes, []byte(`{ "names": ["a", 7] }`), config)).To(HaveOccurred())
+		})
+
+		It("should fail if a relative reference points outside of the blueprint", func() {
+			localSchema := []byte(`{ "$ref": "../other.json" }`)
+			Expect(vfs.WriteFile(config.BlueprintFs, "myfile", localSchema, os.ModePerm)).To(Succeed())
+
+			schemaBytes := []byte(`{ "$ref": "blueprint://myfile" }`)
+
+			Expect(jsonschema.ValidateBytes(schemaBytes, []byte(`"abc"`), config)).To(HaveOccurred())
+		})
+	})
+
+	Context("RemoteReference", func() {
+		var (
+			server   *httptest.Server
+			requests atomic.Int32
+			config   *jsonschema.ReferenceContext
+		)
+
+		BeforeEach(func() {
+			requests.Store(0)
+			mux := http.NewServeMux()
+			mux.HandleFunc("/schemas/common.json", func(w http.ResponseWriter, _ *http.Request) {
+				requests.Add(1)
+				_, _ = w.Write([]byte(`{ "definitions": { "name": { "$ref": "types.json#/definitions/string" } } }`))
+			})
+			mux.HandleFunc("/schemas/types.json", func(w http.ResponseWriter, _ *http.Request) {
+				requests.Add(1)
+				_, _ = w.Write([]byte(`{ "definitions": { "string": { "type": "string" } } }`))
+			})
+			server = httptest.NewServer(mux)
+
+			config = &jsonschema.ReferenceContext{
+				SchemaStore: jsonschema.NewSchemaStore(apiconfig.SchemaStoreConfiguration{
+					AllowedRemoteURLs: []string{server.URL + "/schemas/"},
+				}),
+			}
+		})
+
+		AfterEach(func() {
+			server.Close()
+		})
+
+		It("should resolve and cache remote schemas from allowed urls", func() {
+			schemaBytes := []byte(fmt.Sprintf(`{ "$ref": "%s/schemas/common.json#/definitions/name" }`, server.URL))
+
+			Expect(jsonschema.ValidateBytes(schemaBytes, []byte(`"abc"`), config)).To(Succeed())
+			Expect(jsonschema.ValidateBytes(schemaBytes, []byte(`7`), config)).To(HaveOccurred())
+			Expect(requests.Load()).To(BeEquivalentTo(2))
+
+			config.SchemaStore.InvalidateAll()
+			Expect(jsonschema.ValidateBytes(schemaBytes, []byte(`"abc"`), config)).To(Succeed())
+			Expect(requests.Load()).To(BeEquivalentTo(4))
+		})
+
+		It("should fail to resolve remote schemas from urls that are not allowed", func() {
+			schemaBytes := []byte(fmt.Sprintf(`{ "$ref": "%s/other/common.json" }`, server.URL))
+
+			Expect(jsonschema.ValidateBytes(schemaBytes, []byte(`"abc"`), config)).To(HaveOccurred())
+			Expect(requests.Load()).To(BeEquivalentTo(0))
+		})
 	})
 
 	Context("LocalReference", func() {
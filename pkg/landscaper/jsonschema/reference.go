@@ -11,6 +11,7 @@ import (
 	"errors"
 	"fmt"
 	"net/url"
+	"path"
 	"path/filepath"
 	"strings"
 
@@ -39,21 +40,28 @@ type ReferenceContext struct {
 	// RepositoryContext can be used to overwrite the effective repository context of the component descriptor.
 	// If not set, the effective repository context of the ComponentDescriptor will be used.
 	RepositoryContext *types.UnstructuredTypedObject
+	// SchemaStore caches the schemas of component versions and remote schemas.
+	// If not set, the global schema store will be used.
+	SchemaStore *SchemaStore
 }
 
 type ReferenceResolver struct {
 	*ReferenceContext
+	// baseURI is the uri of the document that is currently resolved.
+	// Relative references within this document are resolved against it.
+	baseURI *url.URL
 }
 
 func NewReferenceResolver(refCtx *ReferenceContext) *ReferenceResolver {
 	if refCtx == nil {
 		refCtx = &ReferenceContext{}
 	}
-	return &ReferenceResolver{refCtx}
+	return &ReferenceResolver{ReferenceContext: refCtx}
 }
 
 // Resolve walks through the given json schema and recursively resolves all references which use one of
-// the "local", "blueprint", or "cd" schemes.
+// the "local", "blueprint", "cd", "http", or "https" schemes.
+// Relative references within blueprint files and remote schemas are resolved against the uri of the file.
 func (rr *ReferenceResolver) Resolve(schemaBytes []byte) (interface{}, error) {
 	data, err := decodeJSON(schemaBytes)
 	if err != nil {
@@ -137,6 +145,13 @@ func (rr *ReferenceResolver) resolveReference(s string, currentPath *field.Path,
 	if err != nil {
 		return nil, fmt.Errorf("invalid url: %w", err)
 	}
+	if len(uri.Scheme) == 0 && rr.baseURI != nil {
+		uri, err = rr.resolveRelativeReference(uri)
+		if err != nil {
+			return nil, err
+		}
+		s = uri.String()
+	}
 	refID := absoluteRef(rr.ComponentVersion, s)
 	if alreadyResolved.contains(refID) {
 		return nil, fmt.Errorf("cyclic references detected: reference %q from component %s:%s is part of a cycle", s, rr.ComponentVersion.GetName(), rr.ComponentVersion.GetVersion())
@@ -149,6 +164,10 @@ func (rr *ReferenceResolver) resolveReference(s string, currentPath *field.Path,
 		return rr.handleBlueprintReference(uri, currentPath, alreadyResolved)
 	case "cd":
 		return rr.handleComponentDescriptorReference(uri, currentPath, alreadyResolved)
+	case "http", "https":
+		if rr.schemaStore().RemoteSchemasEnabled() {
+			return rr.handleRemoteReference(uri, currentPath, alreadyResolved)
+		}
 	}
 
 	// unknown reference scheme
@@ -190,11 +209,36 @@ func (rr *ReferenceResolver) handleBlueprintReference(uri *url.URL, currentPath
 	if err != nil {
 		return nil, fmt.Errorf("error unmarshalling json into go struct: %w", err)
 	}
-	res, err := rr.resolve(data, currentPath, alreadyResolved)
+	// the fragment is selected before the references are resolved,
+	// so that references between the definitions of the same file are not detected as cycles.
+	data, err = resolveFragment(uri, data)
 	if err != nil {
 		return nil, err
 	}
-	return resolveFragment(uri, res)
+	return rr.withBaseURI(uri).resolve(data, currentPath, alreadyResolved)
+}
+
+func (rr *ReferenceResolver) handleRemoteReference(uri *url.URL, currentPath *field.Path, alreadyResolved stringSet) (interface{}, error) {
+	docURI := *uri
+	docURI.Fragment = ""
+	schemaBytes, err := rr.schemaStore().GetRemoteSchema(context.Background(), &docURI)
+	if err != nil {
+		return nil, err
+	}
+	data, err := decodeJSON(schemaBytes)
+	if err != nil {
+		return nil, fmt.Errorf("error unmarshalling json into go struct: %w", err)
+	}
+	data, err = resolveFragment(uri, data)
+	if err != nil {
+		return nil, err
+	}
+
+	// remote schemas can only reference other remote schemas
+	return (&ReferenceResolver{
+		ReferenceContext: &ReferenceContext{SchemaStore: rr.SchemaStore},
+		baseURI:          &docURI,
+	}).resolve(data, currentPath, alreadyResolved)
 }
 
 func (rr *ReferenceResolver) handleComponentDescriptorReference(uri *url.URL, currentPath *field.Path, alreadyResolved stringSet) (interface{}, error) {
@@ -220,13 +264,22 @@ func (rr *ReferenceResolver) handleComponentDescriptorReference(uri *url.URL, cu
 	ctx := context.Background()
 	defer ctx.Done()
 
-	resourceContent, err := resource.GetTypedContent(ctx)
+	// component versions are immutable, therefore the schema is cached for the component version and the resource
+	docURI := *uri
+	docURI.Fragment = ""
+	result, err := rr.schemaStore().GetComponentSchema(absoluteRef(rr.ComponentVersion, docURI.String()), func() ([]byte, error) {
+		resourceContent, err := resource.GetTypedContent(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("unable to fetch jsonschema for '%s': %w", uri.String(), err)
+		}
+		result, ok := resourceContent.Resource.([]byte)
+		if !ok {
+			return nil, fmt.Errorf("received resource of type %T but expected type []byte", resourceContent.Resource)
+		}
+		return result, nil
+	})
 	if err != nil {
-		return nil, fmt.Errorf("unable to fetch jsonschema for '%s': %w", uri.String(), err)
-	}
-	result, ok := resourceContent.Resource.([]byte)
-	if !ok {
-		return nil, fmt.Errorf("received resource of type %T but expected type []byte", result)
+		return nil, err
 	}
 	data, err := decodeJSON(result)
 	if err != nil {
@@ -239,6 +292,7 @@ func (rr *ReferenceResolver) handleComponentDescriptorReference(uri *url.URL, cu
 		ComponentVersion:  cd,
 		RegistryAccess:    rr.RegistryAccess,
 		RepositoryContext: rr.RepositoryContext,
+		SchemaStore:       rr.SchemaStore,
 	}).resolve(data, currentPath, alreadyResolved)
 	if err != nil {
 		return nil, err
@@ -246,6 +300,52 @@ func (rr *ReferenceResolver) handleComponentDescriptorReference(uri *url.URL, cu
 	return resolveFragment(uri, resolved)
 }
 
+// schemaStore returns the schema store of the reference context or the global schema store.
+func (rr *ReferenceResolver) schemaStore() *SchemaStore {
+	if rr.SchemaStore != nil {
+		return rr.SchemaStore
+	}
+	return GetSchemaStore()
+}
+
+// withBaseURI returns a resolver with the same context that resolves relative references against the given uri.
+func (rr *ReferenceResolver) withBaseURI(uri *url.URL) *ReferenceResolver {
+	base := *uri
+	base.Fragment = ""
+	return &ReferenceResolver{
+		ReferenceContext: rr.ReferenceContext,
+		baseURI:          &base,
+	}
+}
+
+// resolveRelativeReference resolves a reference without scheme against the base uri of the resolver.
+// Paths of blueprint references are relative to the directory of the current blueprint file
+// and must not point outside the blueprint.
+func (rr *ReferenceResolver) resolveRelativeReference(ref *url.URL) (*url.URL, error) {
+	if rr.baseURI.Scheme != "blueprint" {
+		return rr.baseURI.ResolveReference(ref), nil
+	}
+
+	filePath := path.Join(rr.baseURI.Host, rr.baseURI.Path)
+	if len(ref.Path) != 0 {
+		if path.IsAbs(ref.Path) {
+			filePath = path.Clean(ref.Path)
+		} else {
+			filePath = path.Join(path.Dir(filePath), ref.Path)
+		}
+	}
+	filePath = strings.TrimPrefix(filePath, "/")
+	if filePath == ".." || strings.HasPrefix(filePath, "../") {
+		return nil, fmt.Errorf("relative reference %q points outside of the blueprint", ref.String())
+	}
+
+	resolved := "blueprint://" + filePath
+	if len(ref.Fragment) != 0 {
+		resolved += "#" + ref.Fragment
+	}
+	return url.Parse(resolved)
+}
+
 // decodeJSON decodes a json string into go structs
 func decodeJSON(rawData []byte) (interface{}, error) {
 	decoder := json.NewDecoder(bytes.NewReader(rawData))
@@ -0,0 +1,173 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package jsonschema
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/gardener/landscaper/apis/config"
+)
+
+const (
+	// defaultRemoteCacheDuration is the default duration for which remote schemas are cached.
+	defaultRemoteCacheDuration = time.Hour
+	// maxRemoteSchemaSize is the maximal size of a remote schema in bytes.
+	maxRemoteSchemaSize = 4 * 1024 * 1024
+	// remoteRequestTimeout is the timeout for fetching a remote schema.
+	remoteRequestTimeout = 30 * time.Second
+)
+
+var schemaStore *SchemaStore
+
+func init() {
+	schemaStore = NewSchemaStore(config.SchemaStoreConfiguration{})
+}
+
+// GetSchemaStore returns the global schema store.
+func GetSchemaStore() *SchemaStore {
+	return schemaStore
+}
+
+// SetSchemaStore sets the global schema store.
+func SetSchemaStore(store *SchemaStore) {
+	schemaStore = store
+}
+
+// SchemaStore caches the json schemas that are referenced by blueprints.
+// Schemas that are resources of a component version are cached per component version without expiry,
+// as component versions are immutable.
+// Remote schemas are only fetched from allowed urls and are cached for the configured duration.
+type SchemaStore struct {
+	allowedRemoteURLs   []*url.URL
+	remoteCacheDuration time.Duration
+	httpClient          *http.Client
+
+	mux     sync.RWMutex
+	entries map[string]schemaStoreEntry
+}
+
+type schemaStoreEntry struct {
+	data []byte
+	// expiration is the time after which the entry is outdated. A zero value means that the entry does not expire.
+	expiration time.Time
+}
+
+// NewSchemaStore creates a new schema store for the given configuration.
+// Invalid urls in the list of allowed remote urls are ignored.
+func NewSchemaStore(cfg config.SchemaStoreConfiguration) *SchemaStore {
+	store := &SchemaStore{
+		remoteCacheDuration: defaultRemoteCacheDuration,
+		httpClient:          &http.Client{Timeout: remoteRequestTimeout},
+		entries:             map[string]schemaStoreEntry{},
+	}
+	for _, allowed := range cfg.AllowedRemoteURLs {
+		u, err := url.Parse(allowed)
+		if err != nil || len(u.Host) == 0 {
+			continue
+		}
+		store.allowedRemoteURLs = append(store.allowedRemoteURLs, u)
+	}
+	if cfg.RemoteCacheDuration != nil {
+		store.remoteCacheDuration = cfg.RemoteCacheDuration.Duration
+	}
+	return store
+}
+
+// RemoteSchemasEnabled returns true if remote schemas are resolved, i.e. if allowed remote urls are configured.
+func (s *SchemaStore) RemoteSchemasEnabled() bool {
+	return len(s.allowedRemoteURLs) != 0
+}
+
+// IsAllowedRemoteURL returns true if the given url has the same scheme and host as one of the allowed urls,
+// and if its path starts with the path of this allowed url.
+func (s *SchemaStore) IsAllowedRemoteURL(u *url.URL) bool {
+	for _, allowed := range s.allowedRemoteURLs {
+		if u.Scheme == allowed.Scheme && u.Host == allowed.Host && strings.HasPrefix(u.Path, allowed.Path) {
+			return true
+		}
+	}
+	return false
+}
+
+// GetRemoteSchema returns the schema with the given url. The schema is fetched if it is not cached.
+func (s *SchemaStore) GetRemoteSchema(ctx context.Context, u *url.URL) ([]byte, error) {
+	if !s.IsAllowedRemoteURL(u) {
+		return nil, fmt.Errorf("the url %q is not allowed for remote schemas", u.String())
+	}
+
+	key := "remote::" + u.String()
+	return s.getOrLoad(key, s.remoteCacheDuration, func() ([]byte, error) {
+		return s.fetchRemoteSchema(ctx, u)
+	})
+}
+
+// GetComponentSchema returns the schema with the given key of a component version.
+// The schema is loaded with the given function if it is not cached.
+func (s *SchemaStore) GetComponentSchema(key string, load func() ([]byte, error)) ([]byte, error) {
+	return s.getOrLoad("cd::"+key, 0, load)
+}
+
+// InvalidateAll removes all schemas from the store.
+func (s *SchemaStore) InvalidateAll() {
+	s.mux.Lock()
+	defer s.mux.Unlock()
+	s.entries = map[string]schemaStoreEntry{}
+}
+
+// getOrLoad returns the cached entry with the given key, or loads and caches it.
+// A duration of zero means that the entry does not expire.
+func (s *SchemaStore) getOrLoad(key string, duration time.Duration, load func() ([]byte, error)) ([]byte, error) {
+	s.mux.RLock()
+	entry, ok := s.entries[key]
+	s.mux.RUnlock()
+	if ok && (entry.expiration.IsZero() || time.Now().Before(entry.expiration)) {
+		return entry.data, nil
+	}
+
+	data, err := load()
+	if err != nil {
+		return nil, err
+	}
+
+	entry = schemaStoreEntry{data: data}
+	if duration > 0 {
+		entry.expiration = time.Now().Add(duration)
+	}
+	s.mux.Lock()
+	defer s.mux.Unlock()
+	s.entries[key] = entry
+	return data, nil
+}
+
+func (s *SchemaStore) fetchRemoteSchema(ctx context.Context, u *url.URL) ([]byte, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, u.String(), nil)
+	if err != nil {
+		return nil, fmt.Errorf("unable to create request for remote schema %q: %w", u.String(), err)
+	}
+	res, err := s.httpClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("unable to fetch remote schema %q: %w", u.String(), err)
+	}
+	defer res.Body.Close()
+
+	if res.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("unable to fetch remote schema %q: unexpected status code %d", u.String(), res.StatusCode)
+	}
+	data, err := io.ReadAll(io.LimitReader(res.Body, maxRemoteSchemaSize+1))
+	if err != nil {
+		return nil, fmt.Errorf("unable to read remote schema %q: %w", u.String(), err)
+	}
+	if len(data) > maxRemoteSchemaSize {
+		return nil, fmt.Errorf("remote schema %q exceeds the maximal size of %d bytes", u.String(), maxRemoteSchemaSize)
+	}
+	return data, nil
+}
@@ -0,0 +1,130 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package snapshot
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+)
+
+// Report describes the differences between two snapshots.
+type Report struct {
+	// Added contains the installations that only exist in the current snapshot.
+	Added []InstallationState `json:"added,omitempty"`
+	// Removed contains the installations that only exist in the previous snapshot.
+	Removed []InstallationState `json:"removed,omitempty"`
+	// Changed contains the installations that exist in both snapshots but differ.
+	Changed []InstallationDiff `json:"changed,omitempty"`
+	// VersionDrifts contains all installations whose component version has changed.
+	VersionDrifts []VersionDrift `json:"versionDrifts,omitempty"`
+}
+
+// InstallationDiff describes the changed fields of an installation.
+type InstallationDiff struct {
+	Namespace string        `json:"namespace"`
+	Name      string        `json:"name"`
+	Fields    []FieldChange `json:"fields"`
+}
+
+// FieldChange describes the previous and the current value of a field.
+type FieldChange struct {
+	Field    string `json:"field"`
+	Previous string `json:"previous"`
+	Current  string `json:"current"`
+}
+
+// VersionDrift describes a changed component version of an installation.
+type VersionDrift struct {
+	Namespace       string `json:"namespace"`
+	Name            string `json:"name"`
+	ComponentName   string `json:"componentName"`
+	PreviousVersion string `json:"previousVersion"`
+	CurrentVersion  string `json:"currentVersion"`
+}
+
+// Compare computes the differences between a previous snapshot (e.g. a backup) and the current one.
+// The generation and observed generation are not compared, as they are not preserved by backup and restore.
+func Compare(previous, current *Snapshot) *Report {
+	report := &Report{}
+
+	previousStates := make(map[string]*InstallationState, len(previous.Installations))
+	for i := range previous.Installations {
+		previousStates[previous.Installations[i].Key()] = &previous.Installations[i]
+	}
+	currentStates := make(map[string]*InstallationState, len(current.Installations))
+	for i := range current.Installations {
+		currentStates[current.Installations[i].Key()] = &current.Installations[i]
+	}
+
+	for i := range current.Installations {
+		curr := &current.Installations[i]
+		prev, ok := previousStates[curr.Key()]
+		if !ok {
+			report.Added = append(report.Added, *curr)
+			continue
+		}
+
+		fields := compareStates(prev, curr)
+		if len(fields) > 0 {
+			report.Changed = append(report.Changed, InstallationDiff{
+				Namespace: curr.Namespace,
+				Name:      curr.Name,
+				Fields:    fields,
+			})
+		}
+
+		if prev.ComponentName == curr.ComponentName && prev.ComponentVersion != curr.ComponentVersion {
+			report.VersionDrifts = append(report.VersionDrifts, VersionDrift{
+				Namespace:       curr.Namespace,
+				Name:            curr.Name,
+				ComponentName:   curr.ComponentName,
+				PreviousVersion: prev.ComponentVersion,
+				CurrentVersion:  curr.ComponentVersion,
+			})
+		}
+	}
+
+	for i := range previous.Installations {
+		prev := &previous.Installations[i]
+		if _, ok := currentStates[prev.Key()]; !ok {
+			report.Removed = append(report.Removed, *prev)
+		}
+	}
+
+	return report
+}
+
+func compareStates(prev, curr *InstallationState) []FieldChange {
+	fields := make([]FieldChange, 0)
+	add := func(field, prevValue, currValue string) {
+		if prevValue != currValue {
+			fields = append(fields, FieldChange{Field: field, Previous: prevValue, Current: currValue})
+		}
+	}
+
+	add("componentName", prev.ComponentName, curr.ComponentName)
+	add("componentVersion", prev.ComponentVersion, curr.ComponentVersion)
+	add("blueprint", prev.Blueprint, curr.Blueprint)
+	add("phase", prev.Phase.String(), curr.Phase.String())
+	add("importsHash", prev.ImportsHash, curr.ImportsHash)
+	add("imports", strings.Join(prev.Imports, ","), strings.Join(curr.Imports, ","))
+	add("exports", strings.Join(prev.Exports, ","), strings.Join(curr.Exports, ","))
+	return fields
+}
+
+// HasDifferences returns true if the compared snapshots differ.
+func (r *Report) HasDifferences() bool {
+	return len(r.Added) > 0 || len(r.Removed) > 0 || len(r.Changed) > 0
+}
+
+// ToJSON serializes the report.
+func (r *Report) ToJSON() ([]byte, error) {
+	data, err := json.MarshalIndent(r, "", "  ")
+	if err != nil {
+		return nil, fmt.Errorf("unable to marshal snapshot report: %w", err)
+	}
+	return data, nil
+}
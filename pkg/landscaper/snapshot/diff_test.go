@@ -0,0 +1,81 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package snapshot_test
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	lsv1alpha1 "github.com/gardener/landscaper/apis/core/v1alpha1"
+	"github.com/gardener/landscaper/pkg/landscaper/snapshot"
+)
+
+var _ = Describe("Compare", func() {
+
+	state := func(name, version string, phase lsv1alpha1.InstallationPhase) snapshot.InstallationState {
+		return snapshot.InstallationState{
+			Namespace:        "test",
+			Name:             name,
+			ComponentName:    "example.com/component",
+			ComponentVersion: version,
+			Blueprint:        "blueprint",
+			Phase:            phase,
+		}
+	}
+
+	It("should report no differences for equal snapshots", func() {
+		prev := &snapshot.Snapshot{Installations: []snapshot.InstallationState{state("a", "v1.0.0", lsv1alpha1.InstallationPhases.Succeeded)}}
+		curr := &snapshot.Snapshot{Installations: []snapshot.InstallationState{state("a", "v1.0.0", lsv1alpha1.InstallationPhases.Succeeded)}}
+
+		report := snapshot.Compare(prev, curr)
+		Expect(report.HasDifferences()).To(BeFalse())
+		Expect(report.VersionDrifts).To(BeEmpty())
+	})
+
+	It("should report added, removed and changed installations", func() {
+		prev := &snapshot.Snapshot{Installations: []snapshot.InstallationState{
+			state("a", "v1.0.0", lsv1alpha1.InstallationPhases.Succeeded),
+			state("b", "v1.0.0", lsv1alpha1.InstallationPhases.Succeeded),
+		}}
+		curr := &snapshot.Snapshot{Installations: []snapshot.InstallationState{
+			state("a", "v1.1.0", lsv1alpha1.InstallationPhases.Failed),
+			state("c", "v1.0.0", lsv1alpha1.InstallationPhases.Succeeded),
+		}}
+
+		report := snapshot.Compare(prev, curr)
+		Expect(report.HasDifferences()).To(BeTrue())
+		Expect(report.Added).To(HaveLen(1))
+		Expect(report.Added[0].Name).To(Equal("c"))
+		Expect(report.Removed).To(HaveLen(1))
+		Expect(report.Removed[0].Name).To(Equal("b"))
+		Expect(report.Changed).To(HaveLen(1))
+		Expect(report.Changed[0].Fields).To(ConsistOf(
+			snapshot.FieldChange{Field: "componentVersion", Previous: "v1.0.0", Current: "v1.1.0"},
+			snapshot.FieldChange{Field: "phase", Previous: "Succeeded", Current: "Failed"},
+		))
+		Expect(report.VersionDrifts).To(ConsistOf(snapshot.VersionDrift{
+			Namespace:       "test",
+			Name:            "a",
+			ComponentName:   "example.com/component",
+			PreviousVersion: "v1.0.0",
+			CurrentVersion:  "v1.1.0",
+		}))
+	})
+
+	It("should read a snapshot from json", func() {
+		orig := &snapshot.Snapshot{Installations: []snapshot.InstallationState{
+			state("b", "v1.0.0", lsv1alpha1.InstallationPhases.Succeeded),
+			state("a", "v1.0.0", lsv1alpha1.InstallationPhases.Succeeded),
+		}}
+		data, err := orig.ToJSON()
+		Expect(err).ToNot(HaveOccurred())
+
+		parsed, err := snapshot.FromJSON(data)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(parsed.Installations).To(HaveLen(2))
+		Expect(parsed.Installations[0].Name).To(Equal("a"))
+		Expect(snapshot.Compare(orig, parsed).HasDifferences()).To(BeFalse())
+	})
+})
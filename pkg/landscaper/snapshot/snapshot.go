@@ -0,0 +1,146 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package snapshot
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	lsv1alpha1 "github.com/gardener/landscaper/apis/core/v1alpha1"
+	"github.com/gardener/landscaper/pkg/utils/read_write_layer"
+)
+
+// InlineBlueprint is the blueprint reference of installations with an inline blueprint.
+const InlineBlueprint = "<inline>"
+
+// Snapshot describes the state of the installations of a landscape at a certain point in time.
+type Snapshot struct {
+	// CreationTimestamp is the time when the snapshot was taken.
+	CreationTimestamp metav1.Time `json:"creationTimestamp"`
+	// Installations contains the state of all installations, sorted by namespace and name.
+	Installations []InstallationState `json:"installations"`
+}
+
+// InstallationState describes the state of a single installation.
+type InstallationState struct {
+	Namespace string `json:"namespace"`
+	Name      string `json:"name"`
+	// ComponentName is the name of the referenced component. It is empty for installations without component.
+	ComponentName string `json:"componentName,omitempty"`
+	// ComponentVersion is the version of the referenced component.
+	ComponentVersion string `json:"componentVersion,omitempty"`
+	// Blueprint is the resource name of the blueprint, InlineBlueprint for inline blueprints,
+	// or the git reference of blueprints in git repositories.
+	Blueprint          string                       `json:"blueprint,omitempty"`
+	Generation         int64                        `json:"generation"`
+	ObservedGeneration int64                        `json:"observedGeneration"`
+	Phase              lsv1alpha1.InstallationPhase `json:"phase,omitempty"`
+	ImportsHash        string                       `json:"importsHash,omitempty"`
+	// Imports contains the names of the imported data objects and targets, sorted.
+	Imports []string `json:"imports,omitempty"`
+	// Exports contains the names of the exported data objects and targets, sorted.
+	Exports []string `json:"exports,omitempty"`
+}
+
+// Key returns the namespaced name of the installation.
+func (s *InstallationState) Key() string {
+	return s.Namespace + "/" + s.Name
+}
+
+// Take creates a snapshot of all installations that match the given list options.
+func Take(ctx context.Context, cl client.Reader, opts ...client.ListOption) (*Snapshot, error) {
+	instList := &lsv1alpha1.InstallationList{}
+	if err := read_write_layer.ListInstallations(ctx, cl, instList, read_write_layer.R000111, opts...); err != nil {
+		return nil, fmt.Errorf("unable to list installations: %w", err)
+	}
+
+	snapshot := &Snapshot{
+		CreationTimestamp: metav1.NewTime(time.Now()),
+		Installations:     make([]InstallationState, 0, len(instList.Items)),
+	}
+	for i := range instList.Items {
+		snapshot.Installations = append(snapshot.Installations, NewInstallationState(&instList.Items[i]))
+	}
+	snapshot.sort()
+	return snapshot, nil
+}
+
+// NewInstallationState extracts the snapshot relevant state of an installation.
+func NewInstallationState(inst *lsv1alpha1.Installation) InstallationState {
+	state := InstallationState{
+		Namespace:          inst.Namespace,
+		Name:               inst.Name,
+		Generation:         inst.Generation,
+		ObservedGeneration: inst.Status.ObservedGeneration,
+		Phase:              inst.Status.InstallationPhase,
+		ImportsHash:        inst.Status.ImportsHash,
+	}
+
+	if cd := inst.Spec.ComponentDescriptor; cd != nil {
+		if cd.Reference != nil {
+			state.ComponentName = cd.Reference.ComponentName
+			state.ComponentVersion = cd.Reference.Version
+		} else if cd.Inline != nil {
+			state.ComponentName = cd.Inline.GetName()
+			state.ComponentVersion = cd.Inline.GetVersion()
+		}
+	}
+
+	if inst.Spec.Blueprint.Reference != nil {
+		state.Blueprint = inst.Spec.Blueprint.Reference.ResourceName
+	} else if inst.Spec.Blueprint.Inline != nil {
+		state.Blueprint = InlineBlueprint
+	} else if git := inst.Spec.Blueprint.Git; git != nil {
+		state.Blueprint = fmt.Sprintf("git:%s/%s", git.Repository, strings.TrimPrefix(git.Path, "/"))
+		if len(git.Revision) != 0 {
+			state.Blueprint += "@" + git.Revision
+		}
+	}
+
+	for _, imp := range inst.Spec.Imports.Data {
+		state.Imports = append(state.Imports, imp.Name)
+	}
+	for _, imp := range inst.Spec.Imports.Targets {
+		state.Imports = append(state.Imports, imp.Name)
+	}
+	for _, exp := range inst.Spec.Exports.Data {
+		state.Exports = append(state.Exports, exp.Name)
+	}
+	for _, exp := range inst.Spec.Exports.Targets {
+		state.Exports = append(state.Exports, exp.Name)
+	}
+	sort.Strings(state.Imports)
+	sort.Strings(state.Exports)
+
+	return state
+}
+
+// FromJSON parses a snapshot, e.g. a previously stored backup.
+func FromJSON(data []byte) (*Snapshot, error) {
+	snapshot := &Snapshot{}
+	if err := json.Unmarshal(data, snapshot); err != nil {
+		return nil, fmt.Errorf("unable to parse snapshot: %w", err)
+	}
+	snapshot.sort()
+	return snapshot, nil
+}
+
+// ToJSON serializes the snapshot.
+func (s *Snapshot) ToJSON() ([]byte, error) {
+	return json.MarshalIndent(s, "", "  ")
+}
+
+func (s *Snapshot) sort() {
+	sort.Slice(s.Installations, func(i, j int) bool {
+		return s.Installations[i].Key() < s.Installations[j].Key()
+	})
+}
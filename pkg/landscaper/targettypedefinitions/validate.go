@@ -0,0 +1,71 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package targettypedefinitions
+
+import (
+	"context"
+	"fmt"
+
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/yaml"
+
+	lsv1alpha1 "github.com/gardener/landscaper/apis/core/v1alpha1"
+	"github.com/gardener/landscaper/pkg/landscaper/jsonschema"
+	"github.com/gardener/landscaper/pkg/utils/read_write_layer"
+)
+
+// GetTargetTypeDefinitions returns all TargetTypeDefinitions of the given target type.
+func GetTargetTypeDefinitions(ctx context.Context, c client.Reader, targetType lsv1alpha1.TargetType,
+	readID read_write_layer.ReadID) ([]lsv1alpha1.TargetTypeDefinition, error) {
+	definitionList := &lsv1alpha1.TargetTypeDefinitionList{}
+	if err := read_write_layer.ListTargetTypeDefinitions(ctx, c, definitionList, readID); err != nil {
+		return nil, fmt.Errorf("unable to list target type definitions: %w", err)
+	}
+
+	definitions := make([]lsv1alpha1.TargetTypeDefinition, 0)
+	for _, def := range definitionList.Items {
+		if def.Spec.Type == targetType {
+			definitions = append(definitions, def)
+		}
+	}
+	return definitions, nil
+}
+
+// ValidateConfiguration validates the json or yaml configuration of a target of the given type
+// against the schemas of the TargetTypeDefinitions of the type.
+// The configuration of target types without a TargetTypeDefinition is not validated.
+func ValidateConfiguration(ctx context.Context, c client.Reader, targetType lsv1alpha1.TargetType, config []byte,
+	readID read_write_layer.ReadID) error {
+	definitions, err := GetTargetTypeDefinitions(ctx, c, targetType, readID)
+	if err != nil {
+		return err
+	}
+	return ValidateConfigurationAgainstDefinitions(definitions, config)
+}
+
+// ValidateConfigurationAgainstDefinitions validates the json or yaml configuration of a target
+// against the schemas of the given TargetTypeDefinitions.
+func ValidateConfigurationAgainstDefinitions(definitions []lsv1alpha1.TargetTypeDefinition, config []byte) error {
+	if len(definitions) == 0 {
+		return nil
+	}
+
+	configJSON, err := yaml.YAMLToJSON(config)
+	if err != nil {
+		return fmt.Errorf("unable to parse target configuration: %w", err)
+	}
+	if len(configJSON) == 0 {
+		// an empty configuration is validated as null value
+		configJSON = []byte("null")
+	}
+
+	for _, def := range definitions {
+		if err := jsonschema.ValidateBytes(def.Spec.Schema.RawMessage, configJSON, nil); err != nil {
+			return fmt.Errorf("configuration of target type %q does not match the schema of target type definition %q: %w",
+				def.Spec.Type, def.Name, err)
+		}
+	}
+	return nil
+}
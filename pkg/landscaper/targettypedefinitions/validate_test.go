@@ -0,0 +1,72 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package targettypedefinitions_test
+
+import (
+	"context"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	lsv1alpha1 "github.com/gardener/landscaper/apis/core/v1alpha1"
+	"github.com/gardener/landscaper/pkg/api"
+	"github.com/gardener/landscaper/pkg/landscaper/targettypedefinitions"
+	"github.com/gardener/landscaper/pkg/utils/read_write_layer"
+)
+
+var _ = Describe("Validate", func() {
+
+	const targetType lsv1alpha1.TargetType = "example.com/my-type"
+
+	var (
+		ctx        context.Context
+		kubeClient client.Client
+	)
+
+	BeforeEach(func() {
+		ctx = context.Background()
+		kubeClient = fake.NewClientBuilder().WithScheme(api.LandscaperScheme).WithObjects(
+			&lsv1alpha1.TargetTypeDefinition{
+				ObjectMeta: metav1.ObjectMeta{Name: "my-type"},
+				Spec: lsv1alpha1.TargetTypeDefinitionSpec{
+					Type: targetType,
+					Schema: lsv1alpha1.NewAnyJSON([]byte(`{
+						"type": "object",
+						"properties": {"url": {"type": "string"}},
+						"required": ["url"]
+					}`)),
+				},
+			},
+		).Build()
+	})
+
+	It("should accept a configuration that matches the schema", func() {
+		err := targettypedefinitions.ValidateConfiguration(ctx, kubeClient, targetType,
+			[]byte(`{"url": "https://example.com"}`), read_write_layer.R000117)
+		Expect(err).ToNot(HaveOccurred())
+	})
+
+	It("should accept a yaml configuration that matches the schema", func() {
+		err := targettypedefinitions.ValidateConfiguration(ctx, kubeClient, targetType,
+			[]byte("url: https://example.com\n"), read_write_layer.R000117)
+		Expect(err).ToNot(HaveOccurred())
+	})
+
+	It("should reject a configuration that does not match the schema", func() {
+		err := targettypedefinitions.ValidateConfiguration(ctx, kubeClient, targetType,
+			[]byte(`{"url": 42}`), read_write_layer.R000117)
+		Expect(err).To(HaveOccurred())
+		Expect(err.Error()).To(ContainSubstring(`target type definition "my-type"`))
+	})
+
+	It("should not validate configurations of target types without a definition", func() {
+		err := targettypedefinitions.ValidateConfiguration(ctx, kubeClient, "example.com/other-type",
+			[]byte(`{"url": 42}`), read_write_layer.R000117)
+		Expect(err).ToNot(HaveOccurred())
+	})
+})
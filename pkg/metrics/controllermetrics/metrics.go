@@ -0,0 +1,216 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package controllermetrics
+
+import (
+	"errors"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	lsv1alpha1 "github.com/gardener/landscaper/apis/core/v1alpha1"
+	lserrors "github.com/gardener/landscaper/apis/errors"
+)
+
+/*
+  This package contains the metrics about the reconciliations of the landscaper controllers.
+*/
+
+const (
+	subsystemName = "controller"
+
+	// ControllerInstallation is the controller label of the installation controller.
+	ControllerInstallation = "installation"
+	// ControllerExecution is the controller label of the execution controller.
+	ControllerExecution = "execution"
+	// ControllerDeployItem is the controller label of the deploy item controller.
+	ControllerDeployItem = "deployitem"
+
+	// ResultSuccess is the result label of reconciliations that succeeded without requeue.
+	ResultSuccess = "success"
+	// ResultRequeue is the result label of reconciliations that succeeded and requested a requeue.
+	ResultRequeue = "requeue"
+	// ResultError is the result label of reconciliations that returned an error.
+	ResultError = "error"
+
+	labelController = "controller"
+	labelNamespace  = "namespace"
+	labelResult     = "result"
+	labelFrom       = "from"
+	labelTo         = "to"
+	labelReason     = "reason"
+	labelKind       = "kind"
+	labelExecution  = "execution"
+	labelDeployItem = "deployitem"
+
+	unknownReason = "Unknown"
+	emptyPhase    = "None"
+)
+
+var (
+	// ReconcileDuration discloses the duration and the result of the reconciliations of the controllers.
+	ReconcileDuration = prometheus.NewHistogramVec(
+		prometheus.HistogramOpts{
+			Namespace: lsv1alpha1.LandscaperMetricsNamespaceName,
+			Subsystem: subsystemName,
+			Name:      "reconcile_duration_seconds",
+			Help:      "Duration of the reconciliations of a controller by result.",
+			Buckets:   []float64{0.01, 0.05, 0.1, 0.25, 0.5, 1, 2.5, 5, 10, 30, 60, 120},
+		},
+		[]string{labelController, labelNamespace, labelResult},
+	)
+
+	// PhaseTransitions discloses the number of phase transitions of the reconciled objects.
+	PhaseTransitions = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: lsv1alpha1.LandscaperMetricsNamespaceName,
+			Subsystem: subsystemName,
+			Name:      "phase_transitions_total",
+			Help:      "Total number of phase transitions of the objects reconciled by a controller.",
+		},
+		[]string{labelController, labelNamespace, labelFrom, labelTo},
+	)
+
+	// Errors discloses the number of errors of the reconciled objects by reason.
+	Errors = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: lsv1alpha1.LandscaperMetricsNamespaceName,
+			Subsystem: subsystemName,
+			Name:      "errors_total",
+			Help:      "Total number of errors of the objects reconciled by a controller by reason.",
+		},
+		[]string{labelController, labelNamespace, labelReason},
+	)
+
+	// WriteConflicts discloses the number of conflicts of write operations of the read-write layer.
+	WriteConflicts = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: lsv1alpha1.LandscaperMetricsNamespaceName,
+			Subsystem: subsystemName,
+			Name:      "write_conflicts_total",
+			Help:      "Total number of write operations of a controller that failed with a conflict.",
+		},
+		[]string{labelController, labelNamespace, labelKind},
+	)
+
+	// DeployItemWaitDuration discloses how long the deploy items of an execution waited on their dependencies.
+	DeployItemWaitDuration = prometheus.NewHistogramVec(
+		prometheus.HistogramOpts{
+			Namespace: lsv1alpha1.LandscaperMetricsNamespaceName,
+			Subsystem: subsystemName,
+			Name:      "deployitem_wait_duration_seconds",
+			Help:      "Duration from the start of the deploy items of an execution until a deploy item was triggered.",
+			Buckets:   []float64{1, 5, 10, 30, 60, 120, 300, 600, 1200, 1800, 3600},
+		},
+		[]string{labelNamespace, labelExecution, labelDeployItem},
+	)
+
+	// DeployItemRunDuration discloses how long the deploy items of an execution were processed by their deployers.
+	DeployItemRunDuration = prometheus.NewHistogramVec(
+		prometheus.HistogramOpts{
+			Namespace: lsv1alpha1.LandscaperMetricsNamespaceName,
+			Subsystem: subsystemName,
+			Name:      "deployitem_run_duration_seconds",
+			Help:      "Duration from the trigger of a deploy item of an execution until it has finished.",
+			Buckets:   []float64{1, 5, 10, 30, 60, 120, 300, 600, 1200, 1800, 3600},
+		},
+		[]string{labelNamespace, labelExecution, labelDeployItem},
+	)
+)
+
+// RegisterMetrics allows to register the controller metrics with a given prometheus registerer
+func RegisterMetrics(reg prometheus.Registerer) {
+	reg.MustRegister(ReconcileDuration)
+	reg.MustRegister(PhaseTransitions)
+	reg.MustRegister(Errors)
+	reg.MustRegister(WriteConflicts)
+	reg.MustRegister(DeployItemWaitDuration)
+	reg.MustRegister(DeployItemRunDuration)
+}
+
+// ObserveReconcile records the duration and the result of a reconciliation.
+func ObserveReconcile(controller, namespace string, duration time.Duration, result reconcile.Result, err error) {
+	ReconcileDuration.WithLabelValues(controller, namespace, resultLabel(result, err)).Observe(duration.Seconds())
+}
+
+func resultLabel(result reconcile.Result, err error) string {
+	if err != nil {
+		return ResultError
+	}
+	if result.Requeue || result.RequeueAfter > 0 {
+		return ResultRequeue
+	}
+	return ResultSuccess
+}
+
+// ObservePhaseTransition records a phase transition of a reconciled object.
+// Nothing is recorded if the phase has not changed.
+func ObservePhaseTransition(controller, namespace, from, to string) {
+	if from == to {
+		return
+	}
+	if len(from) == 0 {
+		from = emptyPhase
+	}
+	if len(to) == 0 {
+		to = emptyPhase
+	}
+	PhaseTransitions.WithLabelValues(controller, namespace, from, to).Inc()
+}
+
+// ObserveError records the reason of an error of a reconciled object.
+// Nothing is recorded if the error is nil.
+func ObserveError(controller, namespace string, err error) {
+	if err == nil {
+		return
+	}
+	reason := unknownReason
+	var lsErr lserrors.LsError
+	if errors.As(err, &lsErr) && lsErr != nil && lsErr.LandscaperError() != nil && len(lsErr.LandscaperError().Reason) > 0 {
+		reason = lsErr.LandscaperError().Reason
+	}
+	ObserveErrorReason(controller, namespace, reason)
+}
+
+// ObserveErrorReason records an error of a reconciled object with the given reason.
+func ObserveErrorReason(controller, namespace, reason string) {
+	Errors.WithLabelValues(controller, namespace, reason).Inc()
+}
+
+// ObserveWriteConflict records a write operation that failed with a conflict.
+func ObserveWriteConflict(controller, namespace, kind string) {
+	WriteConflicts.WithLabelValues(controller, namespace, kind).Inc()
+}
+
+// ObserveDeployItemDurations records for every deploy item in the progress of a finished execution job
+// how long it waited on its dependencies and how long it ran.
+// The wait duration is measured from the given start of the deploy items of the job until the trigger of a deploy item,
+// the run duration from the trigger until the deploy item has finished. Deploy items that have not been started are
+// skipped, and the run duration is only recorded for deploy items that have finished.
+func ObserveDeployItemDurations(namespace, execution string, jobStart *metav1.Time, progress *lsv1alpha1.ExecutionProgress) {
+	if jobStart == nil || progress == nil {
+		return
+	}
+	for _, item := range progress.DeployItems {
+		if item.StartTime == nil {
+			continue
+		}
+		DeployItemWaitDuration.WithLabelValues(namespace, execution, item.Name).
+			Observe(nonNegativeSeconds(item.StartTime.Sub(jobStart.Time)))
+		if item.FinishedTime != nil {
+			DeployItemRunDuration.WithLabelValues(namespace, execution, item.Name).
+				Observe(nonNegativeSeconds(item.FinishedTime.Sub(item.StartTime.Time)))
+		}
+	}
+}
+
+func nonNegativeSeconds(d time.Duration) float64 {
+	if d < 0 {
+		return 0
+	}
+	return d.Seconds()
+}
@@ -0,0 +1,106 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package controllermetrics_test
+
+import (
+	"errors"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	dto "github.com/prometheus/client_model/go"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	lsv1alpha1 "github.com/gardener/landscaper/apis/core/v1alpha1"
+	lserrors "github.com/gardener/landscaper/apis/errors"
+	"github.com/gardener/landscaper/pkg/metrics/controllermetrics"
+)
+
+var _ = Describe("Controller metrics", func() {
+
+	BeforeEach(func() {
+		controllermetrics.ReconcileDuration.Reset()
+		controllermetrics.PhaseTransitions.Reset()
+		controllermetrics.Errors.Reset()
+		controllermetrics.WriteConflicts.Reset()
+		controllermetrics.DeployItemWaitDuration.Reset()
+		controllermetrics.DeployItemRunDuration.Reset()
+	})
+
+	It("should record the reconciliations by result", func() {
+		controllermetrics.ObserveReconcile(controllermetrics.ControllerInstallation, "test", time.Second, reconcile.Result{}, nil)
+		controllermetrics.ObserveReconcile(controllermetrics.ControllerInstallation, "test", time.Second, reconcile.Result{RequeueAfter: time.Second}, nil)
+		controllermetrics.ObserveReconcile(controllermetrics.ControllerInstallation, "test", time.Second, reconcile.Result{}, errors.New("failed"))
+		controllermetrics.ObserveReconcile(controllermetrics.ControllerExecution, "test", time.Second, reconcile.Result{}, nil)
+
+		Expect(testutil.CollectAndCount(controllermetrics.ReconcileDuration)).To(Equal(4))
+		for _, result := range []string{controllermetrics.ResultSuccess, controllermetrics.ResultRequeue, controllermetrics.ResultError} {
+			Expect(histogramCount(controllermetrics.ControllerInstallation, "test", result)).To(Equal(uint64(1)))
+		}
+	})
+
+	It("should record phase transitions", func() {
+		controllermetrics.ObservePhaseTransition(controllermetrics.ControllerExecution, "test", "", "Init")
+		controllermetrics.ObservePhaseTransition(controllermetrics.ControllerExecution, "test", "Init", "Succeeded")
+		controllermetrics.ObservePhaseTransition(controllermetrics.ControllerExecution, "test", "Succeeded", "Succeeded")
+
+		Expect(testutil.CollectAndCount(controllermetrics.PhaseTransitions)).To(Equal(2))
+		Expect(testutil.ToFloat64(controllermetrics.PhaseTransitions.WithLabelValues(controllermetrics.ControllerExecution, "test", "None", "Init"))).To(Equal(1.0))
+		Expect(testutil.ToFloat64(controllermetrics.PhaseTransitions.WithLabelValues(controllermetrics.ControllerExecution, "test", "Init", "Succeeded"))).To(Equal(1.0))
+	})
+
+	It("should record the reasons of errors", func() {
+		controllermetrics.ObserveError(controllermetrics.ControllerInstallation, "test", lserrors.NewError("op", "ImportNotFound", "msg"))
+		controllermetrics.ObserveError(controllermetrics.ControllerInstallation, "test", errors.New("failed"))
+		controllermetrics.ObserveError(controllermetrics.ControllerInstallation, "test", nil)
+
+		Expect(testutil.CollectAndCount(controllermetrics.Errors)).To(Equal(2))
+		Expect(testutil.ToFloat64(controllermetrics.Errors.WithLabelValues(controllermetrics.ControllerInstallation, "test", "ImportNotFound"))).To(Equal(1.0))
+		Expect(testutil.ToFloat64(controllermetrics.Errors.WithLabelValues(controllermetrics.ControllerInstallation, "test", "Unknown"))).To(Equal(1.0))
+	})
+
+	It("should record the wait and run durations of the deploy items of an execution", func() {
+		jobStart := metav1.NewTime(time.Now())
+		at := func(d time.Duration) *metav1.Time {
+			t := metav1.NewTime(jobStart.Add(d))
+			return &t
+		}
+		progress := &lsv1alpha1.ExecutionProgress{
+			DeployItems: []lsv1alpha1.DeployItemProgress{
+				{Name: "a", StartTime: at(0), FinishedTime: at(10 * time.Second)},
+				{Name: "b", StartTime: at(10 * time.Second), FinishedTime: at(40 * time.Second)},
+				{Name: "c", StartTime: at(40 * time.Second)},
+				{Name: "d"},
+			},
+		}
+		controllermetrics.ObserveDeployItemDurations("test", "exec", &jobStart, progress)
+		controllermetrics.ObserveDeployItemDurations("test", "exec", nil, progress)
+
+		Expect(testutil.CollectAndCount(controllermetrics.DeployItemWaitDuration)).To(Equal(3))
+		Expect(testutil.CollectAndCount(controllermetrics.DeployItemRunDuration)).To(Equal(2))
+		Expect(histogramSum(controllermetrics.DeployItemWaitDuration, "test", "exec", "b")).To(Equal(10.0))
+		Expect(histogramSum(controllermetrics.DeployItemWaitDuration, "test", "exec", "c")).To(Equal(40.0))
+		Expect(histogramSum(controllermetrics.DeployItemRunDuration, "test", "exec", "b")).To(Equal(30.0))
+	})
+})
+
+func histogramCount(controller, namespace, result string) uint64 {
+	observer, err := controllermetrics.ReconcileDuration.GetMetricWithLabelValues(controller, namespace, result)
+	Expect(err).ToNot(HaveOccurred())
+	metric := &dto.Metric{}
+	Expect(observer.(prometheus.Histogram).Write(metric)).To(Succeed())
+	return metric.GetHistogram().GetSampleCount()
+}
+
+func histogramSum(vec *prometheus.HistogramVec, labels ...string) float64 {
+	observer, err := vec.GetMetricWithLabelValues(labels...)
+	Expect(err).ToNot(HaveOccurred())
+	metric := &dto.Metric{}
+	Expect(observer.(prometheus.Histogram).Write(metric)).To(Succeed())
+	return metric.GetHistogram().GetSampleSum()
+}
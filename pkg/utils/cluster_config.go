@@ -0,0 +1,38 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package utils
+
+import (
+	"fmt"
+
+	"k8s.io/client-go/rest"
+	"k8s.io/client-go/tools/clientcmd"
+
+	"github.com/gardener/landscaper/apis/config"
+)
+
+// RestConfigFromKubeconfigFile builds a rest config from the kubeconfig file at the given path.
+// The current context of the kubeconfig is used if no context is given.
+func RestConfigFromKubeconfigFile(path, context string) (*rest.Config, error) {
+	loadingRules := &clientcmd.ClientConfigLoadingRules{ExplicitPath: path}
+	overrides := &clientcmd.ConfigOverrides{CurrentContext: context}
+	restConfig, err := clientcmd.NewNonInteractiveDeferredLoadingClientConfig(loadingRules, overrides).ClientConfig()
+	if err != nil {
+		if len(context) != 0 {
+			return nil, fmt.Errorf("unable to build rest config from context %q of kubeconfig %s: %w", context, path, err)
+		}
+		return nil, fmt.Errorf("unable to build rest config from kubeconfig %s: %w", path, err)
+	}
+	return restConfig, nil
+}
+
+// RestConfigFromClusterConfiguration builds a rest config for a cluster of the landscaper configuration.
+// It returns nil if no cluster is configured.
+func RestConfigFromClusterConfiguration(cluster *config.ClusterConfiguration) (*rest.Config, error) {
+	if cluster == nil || len(cluster.Kubeconfig) == 0 {
+		return nil, nil
+	}
+	return RestConfigFromKubeconfigFile(cluster.Kubeconfig, cluster.Context)
+}
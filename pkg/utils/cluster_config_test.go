@@ -0,0 +1,75 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package utils_test
+
+import (
+	"os"
+	"path/filepath"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/gardener/landscaper/apis/config"
+	lsutil "github.com/gardener/landscaper/pkg/utils"
+)
+
+const splitClusterKubeconfig = `apiVersion: v1
+kind: Config
+current-context: host
+clusters:
+- name: host
+  cluster:
+    server: https://host.example.com
+- name: resource
+  cluster:
+    server: https://resource.example.com
+contexts:
+- name: host
+  context:
+    cluster: host
+    user: admin
+- name: resource
+  context:
+    cluster: resource
+    user: admin
+users:
+- name: admin
+  user:
+    token: abc
+`
+
+var _ = Describe("Cluster Configuration", func() {
+
+	var kubeconfigPath string
+
+	BeforeEach(func() {
+		kubeconfigPath = filepath.Join(GinkgoT().TempDir(), "kubeconfig")
+		Expect(os.WriteFile(kubeconfigPath, []byte(splitClusterKubeconfig), 0600)).To(Succeed())
+	})
+
+	It("should use the current context of the kubeconfig if no context is given", func() {
+		restConfig, err := lsutil.RestConfigFromKubeconfigFile(kubeconfigPath, "")
+		Expect(err).ToNot(HaveOccurred())
+		Expect(restConfig.Host).To(Equal("https://host.example.com"))
+	})
+
+	It("should use the configured context of the kubeconfig", func() {
+		restConfig, err := lsutil.RestConfigFromClusterConfiguration(&config.ClusterConfiguration{
+			Kubeconfig: kubeconfigPath,
+			Context:    "resource",
+		})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(restConfig.Host).To(Equal("https://resource.example.com"))
+
+		_, err = lsutil.RestConfigFromKubeconfigFile(kubeconfigPath, "unknown")
+		Expect(err).To(HaveOccurred())
+	})
+
+	It("should return no rest config if no cluster is configured", func() {
+		restConfig, err := lsutil.RestConfigFromClusterConfiguration(nil)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(restConfig).To(BeNil())
+	})
+})
@@ -14,14 +14,17 @@ import (
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/client-go/dynamic"
-	"k8s.io/client-go/tools/clientcmd"
 
 	"github.com/gardener/landscaper/apis/core/v1alpha1"
 	"github.com/gardener/landscaper/apis/core/v1alpha1/targettypes"
 	"github.com/gardener/landscaper/controller-utils/pkg/landscaper/targetresolver"
+	"github.com/gardener/landscaper/pkg/utils"
 )
 
-const subresourceAdminKubeconfig = "adminkubeconfig"
+const (
+	subresourceAdminKubeconfig  = "adminkubeconfig"
+	subresourceViewerKubeconfig = "viewerkubeconfig"
+)
 
 var shootGVR = schema.GroupVersionResource{
 	Group:    "core.gardener.cloud",
@@ -29,12 +32,19 @@ var shootGVR = schema.GroupVersionResource{
 	Resource: "shoots",
 }
 
+var projectGVR = schema.GroupVersionResource{
+	Group:    "core.gardener.cloud",
+	Version:  "v1beta1",
+	Resource: "projects",
+}
+
 type ShootClient struct {
 	unstructuredClient dynamic.NamespaceableResourceInterface
+	projectClient      dynamic.NamespaceableResourceInterface
 }
 
 func NewShootClient(gardenKubeconfigBytes []byte) (*ShootClient, error) {
-	restConfig, err := clientcmd.RESTConfigFromKubeConfig(gardenKubeconfigBytes)
+	restConfig, err := utils.RestConfigFromKubeconfig(gardenKubeconfigBytes)
 	if err != nil {
 		return nil, fmt.Errorf("shoot client: unable to get rest config: %w", err)
 	}
@@ -46,6 +56,7 @@ func NewShootClient(gardenKubeconfigBytes []byte) (*ShootClient, error) {
 
 	return &ShootClient{
 		unstructuredClient: cl.Resource(shootGVR),
+		projectClient:      cl.Resource(projectGVR),
 	}, nil
 }
 
@@ -90,13 +101,38 @@ func (c *ShootClient) ExistsShoot(ctx context.Context, shootNamespace, shootName
 	return exists, nil
 }
 
+// GetProjectNamespace returns the namespace of the specified gardener project.
+func (c *ShootClient) GetProjectNamespace(ctx context.Context, projectName string) (string, error) {
+	project, err := c.projectClient.Get(ctx, projectName, metav1.GetOptions{})
+	if err != nil {
+		return "", fmt.Errorf("shoot client: unable to get project %s: %w", projectName, err)
+	}
+
+	namespace, found, err := unstructured.NestedString(project.Object, "spec", "namespace")
+	if err != nil {
+		return "", fmt.Errorf("shoot client: could not get namespace of project %s: %w", projectName, err)
+	} else if !found || len(namespace) == 0 {
+		return "", fmt.Errorf("shoot client: project %s has no namespace", projectName)
+	}
+	return namespace, nil
+}
+
 // GetShootAdminKubeconfig returns a short-lived admin kubeconfig for the specified shoot as base64 encoded string.
 func (c *ShootClient) GetShootAdminKubeconfig(ctx context.Context, shootName, shootNamespace string, kubeconfigExpirationSeconds int64) (string, metav1.Time, error) {
+	return c.requestShootKubeconfig(ctx, "AdminKubeconfigRequest", subresourceAdminKubeconfig, shootName, shootNamespace, kubeconfigExpirationSeconds)
+}
+
+// GetShootViewerKubeconfig returns a short-lived viewer kubeconfig for the specified shoot as base64 encoded string.
+func (c *ShootClient) GetShootViewerKubeconfig(ctx context.Context, shootName, shootNamespace string, kubeconfigExpirationSeconds int64) (string, metav1.Time, error) {
+	return c.requestShootKubeconfig(ctx, "ViewerKubeconfigRequest", subresourceViewerKubeconfig, shootName, shootNamespace, kubeconfigExpirationSeconds)
+}
+
+func (c *ShootClient) requestShootKubeconfig(ctx context.Context, kind, subresource, shootName, shootNamespace string, kubeconfigExpirationSeconds int64) (string, metav1.Time, error) {
 
-	adminKubeconfigRequest := unstructured.Unstructured{
+	kubeconfigRequest := unstructured.Unstructured{
 		Object: map[string]interface{}{
 			"apiVersion": "authentication.gardener.cloud/v1alpha1",
-			"kind":       "AdminKubeconfigRequest",
+			"kind":       kind,
 			"metadata": map[string]interface{}{
 				"namespace": shootNamespace,
 				"name":      shootName,
@@ -109,9 +145,9 @@ func (c *ShootClient) GetShootAdminKubeconfig(ctx context.Context, shootName, sh
 
 	var expirationTimestamp metav1.Time
 	namespacedShootClient := c.unstructuredClient.Namespace(shootNamespace)
-	result, err := namespacedShootClient.Create(ctx, &adminKubeconfigRequest, metav1.CreateOptions{}, subresourceAdminKubeconfig)
+	result, err := namespacedShootClient.Create(ctx, &kubeconfigRequest, metav1.CreateOptions{}, subresource)
 	if err != nil {
-		return "", expirationTimestamp, fmt.Errorf("shoot client: admin kubeconfig request failed: %w", err)
+		return "", expirationTimestamp, fmt.Errorf("shoot client: %s failed: %w", kind, err)
 	}
 
 	shootKubeconfigBase64, found, err := unstructured.NestedString(result.Object, "status", "kubeconfig")
@@ -17,11 +17,12 @@ import (
 	"github.com/gardener/landscaper/apis/core/v1alpha1"
 	"github.com/gardener/landscaper/apis/core/v1alpha1/targettypes"
 	"github.com/gardener/landscaper/controller-utils/pkg/landscaper/targetresolver"
+	"github.com/gardener/landscaper/pkg/utils"
 )
 
 // GetShootClusterNameFromKubeconfig determines the name of a Gardener shoot cluster from a given kubeconfig.
 func GetShootClusterNameFromKubeconfig(_ context.Context, kubeconfigBytes []byte) (string, error) {
-	config, err := clientcmd.Load(kubeconfigBytes)
+	config, err := utils.LoadKubeconfig(kubeconfigBytes)
 	if err != nil {
 		return "", fmt.Errorf("clusters util: failed to load kubeconfig: %w", err)
 	}
@@ -70,7 +71,7 @@ func BuildOIDCKubeconfig(ctx context.Context, issuerURL, clientID string, target
 
 	kubeconfigBytes := []byte(*targetConfig.Kubeconfig.StrVal)
 
-	config, err := clientcmd.Load(kubeconfigBytes)
+	config, err := utils.LoadKubeconfig(kubeconfigBytes)
 	if err != nil {
 		return "", fmt.Errorf("oidc kubeconfig builder: failed to load config: %w", err)
 	}
@@ -11,9 +11,9 @@ import (
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/client-go/rest"
-	"k8s.io/client-go/tools/clientcmd"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
+	"github.com/gardener/landscaper/pkg/utils"
 	"github.com/gardener/landscaper/pkg/utils/read_write_layer"
 
 	lsv1alpha1 "github.com/gardener/landscaper/apis/core/v1alpha1"
@@ -70,6 +70,7 @@ func (p *DefaultSourceClientProvider) GetSourceShootClient(
 	if err != nil {
 		return nil, err
 	}
+	defer utils.ZeroizeKubeconfig(gardenKubeconfigBytes)
 
 	return NewShootClient(gardenKubeconfigBytes)
 }
@@ -79,18 +80,9 @@ func (p *DefaultSourceClientProvider) getSourceRestConfig(ctx context.Context, t
 	if err != nil {
 		return nil, err
 	}
+	defer utils.ZeroizeKubeconfig(kubeconfigBytes)
 
-	clientConfig, err := clientcmd.NewClientConfigFromBytes(kubeconfigBytes)
-	if err != nil {
-		return nil, err
-	}
-
-	restConfig, err := clientConfig.ClientConfig()
-	if err != nil {
-		return nil, err
-	}
-
-	return restConfig, nil
+	return utils.RestConfigFromKubeconfig(kubeconfigBytes)
 }
 
 func (p *DefaultSourceClientProvider) resolveSecretRef(ctx context.Context, targetClient client.Client,
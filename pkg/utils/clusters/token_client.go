@@ -20,6 +20,7 @@ import (
 	"github.com/gardener/landscaper/apis/core/v1alpha1"
 	"github.com/gardener/landscaper/apis/core/v1alpha1/targettypes"
 	"github.com/gardener/landscaper/controller-utils/pkg/landscaper/targetresolver"
+	"github.com/gardener/landscaper/pkg/utils"
 )
 
 type TokenClient struct {
@@ -28,7 +29,7 @@ type TokenClient struct {
 }
 
 func NewTokenClient(kubeconfigBytes []byte) (*TokenClient, error) {
-	restConfig, err := clientcmd.RESTConfigFromKubeConfig(kubeconfigBytes)
+	restConfig, err := utils.RestConfigFromKubeconfig(kubeconfigBytes)
 	if err != nil {
 		return nil, fmt.Errorf("token client: unable to get rest config: %w", err)
 	}
@@ -90,7 +91,7 @@ func (c *TokenClient) GetServiceAccountKubeconfig(ctx context.Context, serviceAc
 		return "", expirationTimestamp, err
 	}
 
-	config, err := clientcmd.Load(c.kubeconfig)
+	config, err := utils.LoadKubeconfig(c.kubeconfig)
 	if err != nil {
 		return "", expirationTimestamp, fmt.Errorf("token client: failed to load config: %w", err)
 	}
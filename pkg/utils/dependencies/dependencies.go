@@ -72,6 +72,80 @@ func CheckForCyclesAndDuplicateExports(instTemplates []*lsv1alpha1.InstallationT
 	}
 }
 
+// FetchSuccessorsFromInstallation returns the names of the installations that import exports of the given installation.
+func FetchSuccessorsFromInstallation(installation *lsv1alpha1.Installation,
+	otherInstallations []*lsv1alpha1.Installation) sets.String { //nolint:staticcheck // Ignore SA1019 // TODO: change to generic set
+
+	successors := sets.NewString()
+	for _, next := range otherInstallations {
+		if next.Name == installation.Name {
+			continue
+		}
+		if FetchPredecessorsFromInstallation(next, []*lsv1alpha1.Installation{installation}).Has(installation.Name) {
+			successors.Insert(next.Name)
+		}
+	}
+	return successors
+}
+
+// FindCycleOfInstallation returns a cycle of dependencies between the given installations which starts and ends with
+// the first installation. Each installation of the cycle depends on the next one. Nil is returned if there is no such cycle.
+func FindCycleOfInstallation(installation *lsv1alpha1.Installation, otherInstallations []*lsv1alpha1.Installation) []string {
+	g := newGraph(fetchInstallationEdges(append([]*lsv1alpha1.Installation{installation}, otherInstallations...)))
+	return g.findCycleThrough(installation.Name)
+}
+
+// FindCycleOfDeployItems returns a cycle of dependencies between the given deploy item templates.
+// The cycle starts and ends with the same deploy item, and each deploy item of the cycle depends on the next one.
+// The templates are checked in the given order and the shortest cycle through the first affected template is returned,
+// so that the result is stable for the same templates. Nil is returned if there are no cyclic dependencies.
+// Dependencies on undefined deploy items are ignored.
+func FindCycleOfDeployItems(templates lsv1alpha1.DeployItemTemplateList) []string {
+	edges := map[string]sets.String{} //nolint:staticcheck // Ignore SA1019 // TODO: change to generic set
+	for _, tmpl := range templates {
+		edges[tmpl.Name] = sets.NewString(tmpl.DependsOn...)
+	}
+	g := newGraph(edges)
+	for _, tmpl := range templates {
+		if cycle := g.findCycleThrough(tmpl.Name); cycle != nil {
+			return cycle
+		}
+	}
+	return nil
+}
+
+// ComputeDeletionOrder groups the given sibling installations into the order in which they have to be deleted.
+// Installations which import exports of other installations are in an earlier group than the exporting installations.
+// The installations of one group do not depend on each other and can be deleted in parallel.
+// An error is returned if the installations have cyclic dependencies.
+func ComputeDeletionOrder(installations []*lsv1alpha1.Installation) ([][]string, error) {
+	g := newGraph(fetchInstallationEdges(installations))
+	hasCycle, cycle := g.hasCycle()
+	if hasCycle {
+		return nil, fmt.Errorf("the installations have a cycle: %s", strings.Join(cycle, " -{depends_on}-> "))
+	}
+	return g.getDeletionOrder(), nil
+}
+
+// fetchInstallationEdges maps the names of the installations to the names of the installations they depend on.
+// In contrast to the validation of installation templates, duplicate exports are tolerated.
+func fetchInstallationEdges(installations []*lsv1alpha1.Installation) map[string]sets.String { //nolint:staticcheck // Ignore SA1019 // TODO: change to generic set
+	edges := map[string]sets.String{} //nolint:staticcheck // Ignore SA1019 // TODO: change to generic set
+	for _, inst := range installations {
+		if _, ok := edges[inst.Name]; ok {
+			continue
+		}
+		predecessors := sets.NewString()
+		for _, other := range installations {
+			if other.Name != inst.Name && FetchPredecessorsFromInstallation(inst, []*lsv1alpha1.Installation{other}).Has(other.Name) {
+				predecessors.Insert(other.Name)
+			}
+		}
+		edges[inst.Name] = predecessors
+	}
+	return edges
+}
+
 type installationNode struct {
 	name    string
 	exports lsv1alpha1.InstallationExports
@@ -151,6 +151,84 @@ var _ = Describe("Cyclic Dependency Determination Tests", func() {
 		})
 
 	})
+
+	Context("ComputeDeletionOrder", func() {
+
+		It("should delete the importing installations before the exporting ones", func() {
+			insts := installationTemplatesToInstallations(generateSubinstallationTemplates(map[string][]string{
+				"a": {},
+				"b": {"a"},
+				"c": {"a", "b"},
+				"d": {},
+			}, newDependencyProvider(mixedDependency)))
+
+			order, err := ComputeDeletionOrder(insts)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(order).To(Equal([][]string{{"c", "d"}, {"b"}, {"a"}}))
+
+			Expect(FetchSuccessorsFromInstallation(insts[0], insts).List()).To(ConsistOf("b", "c"))
+			Expect(FetchSuccessorsFromInstallation(insts[2], insts).List()).To(BeEmpty())
+			Expect(FindCycleOfInstallation(insts[0], insts[1:])).To(BeNil())
+		})
+
+		It("should fail for cyclic dependencies", func() {
+			insts := installationTemplatesToInstallations(generateSubinstallationTemplates(map[string][]string{
+				"a": {"c"},
+				"b": {"a"},
+				"c": {"b"},
+				"d": {"a"},
+			}, newDependencyProvider(dataDependency)))
+
+			_, err := ComputeDeletionOrder(insts)
+			Expect(err).To(HaveOccurred())
+			Expect(FindCycleOfInstallation(insts[0], insts[1:])).To(Equal([]string{"a", "c", "b", "a"}))
+			Expect(FindCycleOfInstallation(insts[3], insts[:3])).To(BeNil())
+		})
+
+		It("should tolerate duplicate exports", func() {
+			insts := installationTemplatesToInstallations(generateSubinstallationTemplates(map[string][]string{
+				"a": {},
+				"b": {"a"},
+			}, newDependencyProvider(dataDependency)))
+			insts[1].Spec.Exports.Data[0].DataRef = "a_data"
+
+			order, err := ComputeDeletionOrder(insts)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(order).To(Equal([][]string{{"b"}, {"a"}}))
+		})
+
+	})
+
+	Context("DeployItems", func() {
+
+		It("should not find a cycle in acyclic dependencies", func() {
+			templates := lsv1alpha1.DeployItemTemplateList{
+				{Name: "a"},
+				{Name: "b", DependsOn: []string{"a"}},
+				{Name: "c", DependsOn: []string{"a", "b", "undefined"}},
+			}
+			Expect(FindCycleOfDeployItems(templates)).To(BeNil())
+		})
+
+		It("should find the exact cycle of cyclic dependencies", func() {
+			templates := lsv1alpha1.DeployItemTemplateList{
+				{Name: "a"},
+				{Name: "b", DependsOn: []string{"a", "d"}},
+				{Name: "c", DependsOn: []string{"b"}},
+				{Name: "d", DependsOn: []string{"c"}},
+				{Name: "e", DependsOn: []string{"d"}},
+			}
+			Expect(FindCycleOfDeployItems(templates)).To(Equal([]string{"b", "d", "c", "b"}))
+		})
+
+		It("should find a deploy item that depends on itself", func() {
+			templates := lsv1alpha1.DeployItemTemplateList{
+				{Name: "a", DependsOn: []string{"a"}},
+			}
+			Expect(FindCycleOfDeployItems(templates)).To(Equal([]string{"a", "a"}))
+		})
+
+	})
 })
 
 type dependencyMode string
@@ -259,6 +337,18 @@ func installationTemplatesToNames(templates []*lsv1alpha1.InstallationTemplate)
 	return res
 }
 
+// installationTemplatesToInstallations converts installation templates into installations with the same imports and exports
+func installationTemplatesToInstallations(templates []*lsv1alpha1.InstallationTemplate) []*lsv1alpha1.Installation {
+	res := make([]*lsv1alpha1.Installation, len(templates))
+	for i, tmpl := range templates {
+		res[i] = &lsv1alpha1.Installation{}
+		res[i].Name = tmpl.Name
+		res[i].Spec.Imports = tmpl.Imports
+		res[i].Spec.Exports = tmpl.Exports
+	}
+	return res
+}
+
 // stringSliceToIndexMap takes a slice of unique(!) strings and returns a mapping of these strings to their respective indices in the slice
 func stringSliceToIndexMap(data []string) map[string]int {
 	res := map[string]int{}
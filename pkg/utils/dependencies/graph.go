@@ -6,6 +6,7 @@ package dependencies
 
 import (
 	"fmt"
+	"sort"
 
 	"k8s.io/apimachinery/pkg/util/sets"
 
@@ -120,3 +121,70 @@ func (g *graph) allSuccessorAlreadyAdded(node string, alreadyAddedNodes map[stri
 
 	return true
 }
+
+// findCycleThrough searches for a cycle that starts and ends with the given node.
+// It returns nil if there is no such cycle.
+func (g *graph) findCycleThrough(node string) []string {
+	todo := queue.New[nodeWithPath]()
+	todo.Append(nodeWithPath{
+		node: node,
+		path: []string{node},
+	})
+	visited := sets.NewString(node)
+	for !todo.IsEmpty() {
+		cur, _ := todo.Pop()
+		for _, succ := range g.edges[cur.node].List() {
+			newPath := append(append([]string{}, cur.path...), succ)
+			if succ == node {
+				return newPath
+			}
+			if visited.Has(succ) {
+				continue
+			}
+			visited.Insert(succ)
+			todo.Append(nodeWithPath{
+				node: succ,
+				path: newPath,
+			})
+		}
+	}
+	return nil
+}
+
+// getDeletionOrder groups the nodes such that each node is in a later group than all nodes depending on it.
+// The nodes of a group are sorted. The graph must not contain cycles.
+func (g *graph) getDeletionOrder() [][]string {
+	dependents := make(map[string]int, len(g.edges))
+	for node, succs := range g.edges {
+		if _, ok := dependents[node]; !ok {
+			dependents[node] = 0
+		}
+		for _, succ := range succs.UnsortedList() {
+			dependents[succ]++
+		}
+	}
+
+	result := [][]string{}
+	next := []string{}
+	for node, count := range dependents {
+		if count == 0 {
+			next = append(next, node)
+		}
+	}
+	for len(next) > 0 {
+		sort.Strings(next)
+		result = append(result, next)
+
+		current := next
+		next = []string{}
+		for _, node := range current {
+			for _, succ := range g.edges[node].UnsortedList() {
+				dependents[succ]--
+				if dependents[succ] == 0 {
+					next = append(next, succ)
+				}
+			}
+		}
+	}
+	return result
+}
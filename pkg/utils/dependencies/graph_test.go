@@ -111,6 +111,32 @@ var _ = Describe("Cyclic Dependency Determination Tests", func() {
 			Expect(indices["d"]).To(BeNumerically("<", indices["c"]))
 			Expect(indices["c"]).To(BeNumerically("<", indices["a"]))
 		})
+
+		It("should group the graph into deletion layers", func() {
+			deps := map[string]sets.String{ //nolint:staticcheck // Ignore SA1019 // TODO: change to generic set
+				"a": sets.NewString().Insert("c"),
+				"b": sets.NewString().Insert("c", "d"),
+				"c": sets.NewString().Insert("d"),
+				"d": sets.NewString(),
+				"e": sets.NewString(),
+			}
+
+			Expect(newGraph(deps).getDeletionOrder()).To(Equal([][]string{{"a", "b", "e"}, {"c"}, {"d"}}))
+		})
+
+		It("should find a cycle through a given node", func() {
+			deps := map[string]sets.String{ //nolint:staticcheck // Ignore SA1019 // TODO: change to generic set
+				"a": sets.NewString().Insert("b"),
+				"b": sets.NewString().Insert("c"),
+				"c": sets.NewString().Insert("b"),
+				"d": sets.NewString().Insert("a"),
+			}
+
+			g := newGraph(deps)
+			Expect(g.findCycleThrough("b")).To(Equal([]string{"b", "c", "b"}))
+			Expect(g.findCycleThrough("a")).To(BeNil())
+			Expect(g.findCycleThrough("d")).To(BeNil())
+		})
 	})
 })
 
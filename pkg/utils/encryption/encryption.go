@@ -0,0 +1,293 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package encryption
+
+import (
+	"bytes"
+	"context"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"sync"
+
+	"sigs.k8s.io/yaml"
+
+	"github.com/gardener/landscaper/apis/config"
+	"github.com/gardener/landscaper/apis/config/v1alpha1"
+)
+
+const (
+	// EnvelopeAPIVersion is the api version of encrypted data.
+	EnvelopeAPIVersion = "encryption.landscaper.gardener.cloud/v1alpha1"
+	// EnvelopeKind is the kind of encrypted data.
+	EnvelopeKind = "EncryptedData"
+
+	// dataKeySize is the size of the AES-256 data keys.
+	dataKeySize = 32
+	// maxCacheEntries is the number of entries after which the caches of the encryptor are reset.
+	maxCacheEntries = 10000
+)
+
+// Envelope is the serialized form of encrypted data.
+// It contains the data encrypted with a data key and the data key encrypted with a key encryption key.
+type Envelope struct {
+	APIVersion string `json:"apiVersion"`
+	Kind       string `json:"kind"`
+	// Provider is the type of the key provider that has encrypted the data key.
+	Provider string `json:"provider"`
+	// KeyName is the name of the key encryption key.
+	KeyName string `json:"keyName"`
+	// EncryptedKey is the encrypted data key.
+	EncryptedKey []byte `json:"encryptedKey"`
+	// Data is the nonce followed by the data encrypted with AES-GCM.
+	Data []byte `json:"data"`
+}
+
+// KeyEncrypter encrypts and decrypts data keys with key encryption keys.
+type KeyEncrypter interface {
+	// Provider returns the type of the key provider.
+	Provider() string
+	// CurrentKey returns the name of the key encryption key that is used to encrypt new data keys.
+	CurrentKey() string
+	// EncryptKey encrypts a data key with the current key encryption key.
+	EncryptKey(ctx context.Context, dataKey []byte) (encryptedKey []byte, err error)
+	// DecryptKey decrypts a data key with the key encryption key of the given name.
+	DecryptKey(ctx context.Context, keyName string, encryptedKey []byte) ([]byte, error)
+}
+
+// Encryptor implements the envelope encryption of data.
+// Every value is encrypted with a new data key, which is in turn encrypted by a KeyEncrypter.
+type Encryptor struct {
+	keyEncrypter KeyEncrypter
+
+	mux sync.Mutex
+	// dataKeys caches the decrypted data keys by their encrypted form,
+	// so that the key encryption service is not called for every decryption.
+	dataKeys map[string][]byte
+	// envelopes caches the envelopes by the hash of their plaintext.
+	envelopes map[[sha256.Size]byte][]byte
+}
+
+// New creates a new encryptor that uses the given key encrypter.
+func New(keyEncrypter KeyEncrypter) *Encryptor {
+	return &Encryptor{
+		keyEncrypter: keyEncrypter,
+		dataKeys:     map[string][]byte{},
+		envelopes:    map[[sha256.Size]byte][]byte{},
+	}
+}
+
+// NewFromConfig creates a new encryptor from the given configuration.
+// Nil is returned if no configuration is given.
+func NewFromConfig(cfg *config.EncryptionConfiguration) (*Encryptor, error) {
+	if cfg == nil {
+		return nil, nil
+	}
+	if (cfg.Local == nil) == (cfg.KMS == nil) {
+		return nil, errors.New("exactly one of local and kms encryption has to be configured")
+	}
+	if cfg.Local != nil {
+		keyEncrypter, err := NewLocalKeyEncrypter(cfg.Local)
+		if err != nil {
+			return nil, err
+		}
+		return New(keyEncrypter), nil
+	}
+	keyEncrypter, err := NewKMSKeyEncrypter(cfg.KMS)
+	if err != nil {
+		return nil, err
+	}
+	return New(keyEncrypter), nil
+}
+
+// LoadConfiguration reads an encryption configuration in its v1alpha1 form from the given file.
+func LoadConfiguration(path string) (*config.EncryptionConfiguration, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("unable to read encryption configuration from %q: %w", path, err)
+	}
+	external := &v1alpha1.EncryptionConfiguration{}
+	if err := yaml.UnmarshalStrict(data, external); err != nil {
+		return nil, fmt.Errorf("unable to parse encryption configuration from %q: %w", path, err)
+	}
+	cfg := &config.EncryptionConfiguration{}
+	if err := v1alpha1.Convert_v1alpha1_EncryptionConfiguration_To_config_EncryptionConfiguration(external, cfg, nil); err != nil {
+		return nil, err
+	}
+	return cfg, nil
+}
+
+// NewFromFile creates a new encryptor from the encryption configuration in the given file.
+func NewFromFile(path string) (*Encryptor, error) {
+	cfg, err := LoadConfiguration(path)
+	if err != nil {
+		return nil, err
+	}
+	encryptor, err := NewFromConfig(cfg)
+	if err != nil {
+		return nil, fmt.Errorf("unable to setup the encryption: %w", err)
+	}
+	return encryptor, nil
+}
+
+// IsEncrypted returns whether the given data is an encrypted envelope.
+func IsEncrypted(data []byte) bool {
+	if !bytes.Contains(data, []byte(EnvelopeKind)) {
+		return false
+	}
+	envelope := &Envelope{}
+	if err := json.Unmarshal(data, envelope); err != nil {
+		return false
+	}
+	return envelope.APIVersion == EnvelopeAPIVersion && envelope.Kind == EnvelopeKind
+}
+
+// Encrypt encrypts the given data and returns the serialized envelope.
+// If the same data has already been encrypted or decrypted by this encryptor with the current key encryption key,
+// the previous envelope is returned, so that unchanged data does not change its encrypted form.
+// Data that is already encrypted is returned as it is.
+func (e *Encryptor) Encrypt(ctx context.Context, data []byte) ([]byte, error) {
+	if IsEncrypted(data) {
+		return data, nil
+	}
+
+	hash := sha256.Sum256(data)
+	e.mux.Lock()
+	cached, ok := e.envelopes[hash]
+	e.mux.Unlock()
+	if ok {
+		return cached, nil
+	}
+
+	dataKey := make([]byte, dataKeySize)
+	if _, err := io.ReadFull(rand.Reader, dataKey); err != nil {
+		return nil, fmt.Errorf("unable to generate data key: %w", err)
+	}
+	ciphertext, err := seal(dataKey, data)
+	if err != nil {
+		return nil, err
+	}
+	encryptedKey, err := e.keyEncrypter.EncryptKey(ctx, dataKey)
+	if err != nil {
+		return nil, fmt.Errorf("unable to encrypt data key: %w", err)
+	}
+
+	envelope, err := json.Marshal(Envelope{
+		APIVersion:   EnvelopeAPIVersion,
+		Kind:         EnvelopeKind,
+		Provider:     e.keyEncrypter.Provider(),
+		KeyName:      e.keyEncrypter.CurrentKey(),
+		EncryptedKey: encryptedKey,
+		Data:         ciphertext,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	e.mux.Lock()
+	defer e.mux.Unlock()
+	e.cacheDataKey(encryptedKey, dataKey)
+	e.cacheEnvelope(hash, envelope)
+	return envelope, nil
+}
+
+// Decrypt decrypts the given envelope.
+// Data that is not encrypted is returned as it is, so that data that has been stored before the encryption
+// has been configured can still be read.
+func (e *Encryptor) Decrypt(ctx context.Context, data []byte) ([]byte, error) {
+	if !IsEncrypted(data) {
+		return data, nil
+	}
+	envelope := &Envelope{}
+	if err := json.Unmarshal(data, envelope); err != nil {
+		return nil, fmt.Errorf("unable to parse encrypted data: %w", err)
+	}
+	if envelope.Provider != e.keyEncrypter.Provider() {
+		return nil, fmt.Errorf("data is encrypted by the key provider %q but %q is configured",
+			envelope.Provider, e.keyEncrypter.Provider())
+	}
+
+	e.mux.Lock()
+	dataKey, ok := e.dataKeys[string(envelope.EncryptedKey)]
+	e.mux.Unlock()
+	if !ok {
+		var err error
+		dataKey, err = e.keyEncrypter.DecryptKey(ctx, envelope.KeyName, envelope.EncryptedKey)
+		if err != nil {
+			return nil, fmt.Errorf("unable to decrypt data key: %w", err)
+		}
+	}
+
+	plaintext, err := open(dataKey, envelope.Data)
+	if err != nil {
+		return nil, err
+	}
+
+	e.mux.Lock()
+	defer e.mux.Unlock()
+	e.cacheDataKey(envelope.EncryptedKey, dataKey)
+	// only envelopes of the current key are reused, so that data is encrypted with a new key after a rotation
+	if envelope.KeyName == e.keyEncrypter.CurrentKey() {
+		e.cacheEnvelope(sha256.Sum256(plaintext), data)
+	}
+	return plaintext, nil
+}
+
+func (e *Encryptor) cacheDataKey(encryptedKey, dataKey []byte) {
+	if len(e.dataKeys) >= maxCacheEntries {
+		e.dataKeys = map[string][]byte{}
+	}
+	e.dataKeys[string(encryptedKey)] = dataKey
+}
+
+func (e *Encryptor) cacheEnvelope(hash [sha256.Size]byte, envelope []byte) {
+	if len(e.envelopes) >= maxCacheEntries {
+		e.envelopes = map[[sha256.Size]byte][]byte{}
+	}
+	e.envelopes[hash] = envelope
+}
+
+// seal encrypts the plaintext with AES-GCM and returns the nonce followed by the ciphertext.
+func seal(key, plaintext []byte) ([]byte, error) {
+	gcm, err := newGCM(key)
+	if err != nil {
+		return nil, err
+	}
+	nonce := make([]byte, gcm.NonceSize())
+	if _, err := io.ReadFull(rand.Reader, nonce); err != nil {
+		return nil, fmt.Errorf("unable to generate nonce: %w", err)
+	}
+	return gcm.Seal(nonce, nonce, plaintext, nil), nil
+}
+
+// open decrypts data that has been encrypted by seal.
+func open(key, data []byte) ([]byte, error) {
+	gcm, err := newGCM(key)
+	if err != nil {
+		return nil, err
+	}
+	if len(data) < gcm.NonceSize() {
+		return nil, errors.New("encrypted data is too short")
+	}
+	plaintext, err := gcm.Open(nil, data[:gcm.NonceSize()], data[gcm.NonceSize():], nil)
+	if err != nil {
+		return nil, fmt.Errorf("unable to decrypt data: %w", err)
+	}
+	return plaintext, nil
+}
+
+func newGCM(key []byte) (cipher.AEAD, error) {
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+	return cipher.NewGCM(block)
+}
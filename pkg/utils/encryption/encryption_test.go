@@ -0,0 +1,182 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package encryption_test
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/gardener/landscaper/apis/config"
+	"github.com/gardener/landscaper/pkg/utils/encryption"
+)
+
+var _ = Describe("Encryption", func() {
+
+	var (
+		ctx context.Context
+		dir string
+	)
+
+	BeforeEach(func() {
+		ctx = context.Background()
+		dir = GinkgoT().TempDir()
+	})
+
+	writeFile := func(name, content string) string {
+		path := filepath.Join(dir, name)
+		Expect(os.WriteFile(path, []byte(content), 0600)).To(Succeed())
+		return path
+	}
+
+	writeKey := func(name string, b byte) config.LocalEncryptionKey {
+		key := make([]byte, 32)
+		for i := range key {
+			key[i] = b
+		}
+		return config.LocalEncryptionKey{
+			Name:    name,
+			KeyFile: writeFile(name, base64.StdEncoding.EncodeToString(key)),
+		}
+	}
+
+	newLocalEncryptor := func(keys ...config.LocalEncryptionKey) *encryption.Encryptor {
+		enc, err := encryption.NewFromConfig(&config.EncryptionConfiguration{
+			Local: &config.LocalEncryptionConfiguration{Keys: keys},
+		})
+		Expect(err).ToNot(HaveOccurred())
+		return enc
+	}
+
+	Context("Local", func() {
+		It("should encrypt and decrypt data", func() {
+			enc := newLocalEncryptor(writeKey("key1", 1))
+			data := []byte(`{"password":"secret"}`)
+
+			encrypted, err := enc.Encrypt(ctx, data)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(encryption.IsEncrypted(encrypted)).To(BeTrue())
+			Expect(string(encrypted)).ToNot(ContainSubstring("secret"))
+
+			decrypted, err := newLocalEncryptor(writeKey("key1", 1)).Decrypt(ctx, encrypted)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(decrypted).To(Equal(data))
+		})
+
+		It("should keep the envelope of unchanged data", func() {
+			encrypted, err := newLocalEncryptor(writeKey("key1", 1)).Encrypt(ctx, []byte("data"))
+			Expect(err).ToNot(HaveOccurred())
+
+			enc := newLocalEncryptor(writeKey("key1", 1))
+			decrypted, err := enc.Decrypt(ctx, encrypted)
+			Expect(err).ToNot(HaveOccurred())
+			reencrypted, err := enc.Encrypt(ctx, decrypted)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(reencrypted).To(Equal(encrypted))
+		})
+
+		It("should pass through data that is not encrypted", func() {
+			enc := newLocalEncryptor(writeKey("key1", 1))
+			decrypted, err := enc.Decrypt(ctx, []byte(`{"a":"b"}`))
+			Expect(err).ToNot(HaveOccurred())
+			Expect(decrypted).To(Equal([]byte(`{"a":"b"}`)))
+		})
+
+		It("should decrypt data of previous keys and encrypt with the first key after a rotation", func() {
+			encrypted, err := newLocalEncryptor(writeKey("key1", 1)).Encrypt(ctx, []byte("data"))
+			Expect(err).ToNot(HaveOccurred())
+
+			enc := newLocalEncryptor(writeKey("key2", 2), writeKey("key1", 1))
+			decrypted, err := enc.Decrypt(ctx, encrypted)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(decrypted).To(Equal([]byte("data")))
+
+			reencrypted, err := enc.Encrypt(ctx, decrypted)
+			Expect(err).ToNot(HaveOccurred())
+			envelope := &encryption.Envelope{}
+			Expect(json.Unmarshal(reencrypted, envelope)).To(Succeed())
+			Expect(envelope.KeyName).To(Equal("key2"))
+		})
+
+		It("should fail to decrypt data of an unknown key", func() {
+			encrypted, err := newLocalEncryptor(writeKey("key1", 1)).Encrypt(ctx, []byte("data"))
+			Expect(err).ToNot(HaveOccurred())
+
+			_, err = newLocalEncryptor(writeKey("key2", 2)).Decrypt(ctx, encrypted)
+			Expect(err).To(HaveOccurred())
+		})
+
+		It("should reject keys of an invalid length", func() {
+			_, err := encryption.NewFromConfig(&config.EncryptionConfiguration{
+				Local: &config.LocalEncryptionConfiguration{Keys: []config.LocalEncryptionKey{
+					{Name: "key1", KeyFile: writeFile("key1", base64.StdEncoding.EncodeToString([]byte("short")))},
+				}},
+			})
+			Expect(err).To(HaveOccurred())
+		})
+	})
+
+	Context("KMS", func() {
+		It("should encrypt the data keys with the transit secrets engine", func() {
+			calls := map[string]int{}
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				Expect(r.Header.Get("X-Vault-Token")).To(Equal("my-token"))
+				body := map[string]string{}
+				Expect(json.NewDecoder(r.Body).Decode(&body)).To(Succeed())
+				calls[r.URL.Path]++
+				switch r.URL.Path {
+				case "/v1/transit/encrypt/landscaper":
+					Expect(json.NewEncoder(w).Encode(map[string]interface{}{
+						"data": map[string]string{"ciphertext": "vault:v1:" + body["plaintext"]},
+					})).To(Succeed())
+				case "/v1/transit/decrypt/landscaper":
+					Expect(json.NewEncoder(w).Encode(map[string]interface{}{
+						"data": map[string]string{"plaintext": strings.TrimPrefix(body["ciphertext"], "vault:v1:")},
+					})).To(Succeed())
+				default:
+					w.WriteHeader(http.StatusNotFound)
+				}
+			}))
+			defer server.Close()
+
+			cfg := &config.EncryptionConfiguration{
+				KMS: &config.KMSEncryptionConfiguration{
+					Address:   server.URL,
+					KeyName:   "landscaper",
+					TokenFile: writeFile("token", "my-token\n"),
+				},
+			}
+			enc, err := encryption.NewFromConfig(cfg)
+			Expect(err).ToNot(HaveOccurred())
+			encrypted, err := enc.Encrypt(ctx, []byte("data"))
+			Expect(err).ToNot(HaveOccurred())
+
+			enc, err = encryption.NewFromConfig(cfg)
+			Expect(err).ToNot(HaveOccurred())
+			for i := 0; i < 2; i++ {
+				decrypted, err := enc.Decrypt(ctx, encrypted)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(decrypted).To(Equal([]byte("data")))
+			}
+			Expect(calls).To(Equal(map[string]int{
+				"/v1/transit/encrypt/landscaper": 1,
+				"/v1/transit/decrypt/landscaper": 1,
+			}))
+		})
+	})
+
+	It("should require exactly one key provider", func() {
+		_, err := encryption.NewFromConfig(&config.EncryptionConfiguration{})
+		Expect(err).To(HaveOccurred())
+	})
+})
@@ -0,0 +1,149 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package encryption
+
+import (
+	"bytes"
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/gardener/landscaper/apis/config"
+)
+
+const (
+	// KMSProvider is the provider type of key encryption keys that are managed by a key management service.
+	KMSProvider = "kms"
+
+	// DefaultKMSMountPath is the default mount path of the transit secrets engine.
+	DefaultKMSMountPath = "transit"
+)
+
+// KMSKeyEncrypter encrypts data keys with the transit secrets engine of a HashiCorp Vault
+// or a compatible key management service.
+type KMSKeyEncrypter struct {
+	address   string
+	mountPath string
+	keyName   string
+	tokenFile string
+	client    *http.Client
+}
+
+// NewKMSKeyEncrypter creates a new key encrypter for the configured key management service.
+func NewKMSKeyEncrypter(cfg *config.KMSEncryptionConfiguration) (*KMSKeyEncrypter, error) {
+	if len(cfg.Address) == 0 || len(cfg.KeyName) == 0 || len(cfg.TokenFile) == 0 {
+		return nil, errors.New("the address, the key name and the token file of the kms encryption have to be configured")
+	}
+
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	if len(cfg.CAFile) != 0 {
+		caData, err := os.ReadFile(cfg.CAFile)
+		if err != nil {
+			return nil, fmt.Errorf("unable to read ca file of the kms encryption: %w", err)
+		}
+		pool := x509.NewCertPool()
+		if !pool.AppendCertsFromPEM(caData) {
+			return nil, fmt.Errorf("no certificates found in the ca file %q of the kms encryption", cfg.CAFile)
+		}
+		transport.TLSClientConfig = &tls.Config{RootCAs: pool, MinVersion: tls.VersionTLS12}
+	}
+
+	mountPath := cfg.MountPath
+	if len(mountPath) == 0 {
+		mountPath = DefaultKMSMountPath
+	}
+	return &KMSKeyEncrypter{
+		address:   strings.TrimSuffix(cfg.Address, "/"),
+		mountPath: strings.Trim(mountPath, "/"),
+		keyName:   cfg.KeyName,
+		tokenFile: cfg.TokenFile,
+		client:    &http.Client{Transport: transport, Timeout: 30 * time.Second},
+	}, nil
+}
+
+// Provider implements the KeyEncrypter interface.
+func (k *KMSKeyEncrypter) Provider() string {
+	return KMSProvider
+}
+
+// CurrentKey implements the KeyEncrypter interface.
+func (k *KMSKeyEncrypter) CurrentKey() string {
+	return k.keyName
+}
+
+// EncryptKey implements the KeyEncrypter interface.
+func (k *KMSKeyEncrypter) EncryptKey(ctx context.Context, dataKey []byte) ([]byte, error) {
+	res := &struct {
+		Data struct {
+			Ciphertext string `json:"ciphertext"`
+		} `json:"data"`
+	}{}
+	req := map[string]string{"plaintext": base64.StdEncoding.EncodeToString(dataKey)}
+	if err := k.call(ctx, "encrypt", k.keyName, req, res); err != nil {
+		return nil, err
+	}
+	if len(res.Data.Ciphertext) == 0 {
+		return nil, errors.New("the key management service returned no ciphertext")
+	}
+	return []byte(res.Data.Ciphertext), nil
+}
+
+// DecryptKey implements the KeyEncrypter interface.
+// The key version is part of the ciphertext, so that keys can be rotated by the key management service.
+func (k *KMSKeyEncrypter) DecryptKey(ctx context.Context, keyName string, encryptedKey []byte) ([]byte, error) {
+	res := &struct {
+		Data struct {
+			Plaintext string `json:"plaintext"`
+		} `json:"data"`
+	}{}
+	req := map[string]string{"ciphertext": string(encryptedKey)}
+	if err := k.call(ctx, "decrypt", keyName, req, res); err != nil {
+		return nil, err
+	}
+	return base64.StdEncoding.DecodeString(res.Data.Plaintext)
+}
+
+func (k *KMSKeyEncrypter) call(ctx context.Context, operation, keyName string, reqBody, resBody interface{}) error {
+	token, err := os.ReadFile(k.tokenFile)
+	if err != nil {
+		return fmt.Errorf("unable to read token of the key management service: %w", err)
+	}
+	body, err := json.Marshal(reqBody)
+	if err != nil {
+		return err
+	}
+
+	url := fmt.Sprintf("%s/v1/%s/%s/%s", k.address, k.mountPath, operation, keyName)
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, url, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("X-Vault-Token", strings.TrimSpace(string(token)))
+
+	res, err := k.client.Do(req)
+	if err != nil {
+		return fmt.Errorf("unable to %s data key with the key management service: %w", operation, err)
+	}
+	defer res.Body.Close()
+	data, err := io.ReadAll(res.Body)
+	if err != nil {
+		return err
+	}
+	if res.StatusCode != http.StatusOK {
+		return fmt.Errorf("unable to %s data key with the key management service: %s: %s",
+			operation, res.Status, strings.TrimSpace(string(data)))
+	}
+	return json.Unmarshal(data, resBody)
+}
@@ -0,0 +1,82 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package encryption
+
+import (
+	"context"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/gardener/landscaper/apis/config"
+)
+
+// LocalProvider is the provider type of local key encryption keys.
+const LocalProvider = "local"
+
+// LocalKeyEncrypter encrypts data keys with local AES keys.
+type LocalKeyEncrypter struct {
+	current string
+	keys    map[string][]byte
+}
+
+// NewLocalKeyEncrypter reads the configured keys from their files.
+// The first key is used to encrypt new data keys.
+func NewLocalKeyEncrypter(cfg *config.LocalEncryptionConfiguration) (*LocalKeyEncrypter, error) {
+	if len(cfg.Keys) == 0 {
+		return nil, errors.New("at least one local encryption key has to be configured")
+	}
+	keys := make(map[string][]byte, len(cfg.Keys))
+	for _, keyCfg := range cfg.Keys {
+		if len(keyCfg.Name) == 0 {
+			return nil, errors.New("the name of a local encryption key must not be empty")
+		}
+		if _, ok := keys[keyCfg.Name]; ok {
+			return nil, fmt.Errorf("duplicate local encryption key %q", keyCfg.Name)
+		}
+		data, err := os.ReadFile(keyCfg.KeyFile)
+		if err != nil {
+			return nil, fmt.Errorf("unable to read local encryption key %q: %w", keyCfg.Name, err)
+		}
+		key, err := base64.StdEncoding.DecodeString(strings.TrimSpace(string(data)))
+		if err != nil {
+			return nil, fmt.Errorf("local encryption key %q is not base64 encoded: %w", keyCfg.Name, err)
+		}
+		if l := len(key); l != 16 && l != 24 && l != 32 {
+			return nil, fmt.Errorf("local encryption key %q has a length of %d bytes but 16, 24 or 32 are required", keyCfg.Name, l)
+		}
+		keys[keyCfg.Name] = key
+	}
+	return &LocalKeyEncrypter{
+		current: cfg.Keys[0].Name,
+		keys:    keys,
+	}, nil
+}
+
+// Provider implements the KeyEncrypter interface.
+func (l *LocalKeyEncrypter) Provider() string {
+	return LocalProvider
+}
+
+// CurrentKey implements the KeyEncrypter interface.
+func (l *LocalKeyEncrypter) CurrentKey() string {
+	return l.current
+}
+
+// EncryptKey implements the KeyEncrypter interface.
+func (l *LocalKeyEncrypter) EncryptKey(_ context.Context, dataKey []byte) ([]byte, error) {
+	return seal(l.keys[l.current], dataKey)
+}
+
+// DecryptKey implements the KeyEncrypter interface.
+func (l *LocalKeyEncrypter) DecryptKey(_ context.Context, keyName string, encryptedKey []byte) ([]byte, error) {
+	key, ok := l.keys[keyName]
+	if !ok {
+		return nil, fmt.Errorf("local encryption key %q is not configured", keyName)
+	}
+	return open(key, encryptedKey)
+}
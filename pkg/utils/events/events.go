@@ -0,0 +1,168 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package events
+
+import (
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/tools/record"
+
+	lsv1alpha1 "github.com/gardener/landscaper/apis/core/v1alpha1"
+	lserrors "github.com/gardener/landscaper/apis/errors"
+)
+
+// Reason is the stable reason of the events that are emitted by the landscaper controllers and deployers.
+// Alerting rules should match the reason of events instead of their message, which is meant for humans.
+type Reason string
+
+const (
+	// ReasonImportsPending is the reason of events for installations whose imports are not yet available,
+	// e.g. because they are not exported or the exporting installations are not yet finished.
+	ReasonImportsPending Reason = "ImportsPending"
+	// ReasonImportsInvalid is the reason of events for installations whose imports do not satisfy the blueprint.
+	ReasonImportsInvalid Reason = "ImportsInvalid"
+	// ReasonImportsOutdated is the reason of events for installations whose imports are outdated,
+	// because the exporting installations have been deleted or have failed.
+	ReasonImportsOutdated Reason = "ImportsOutdated"
+	// ReasonImportQuarantined is the reason of events for installations that are quarantined because of malformed import data.
+	ReasonImportQuarantined Reason = "ImportQuarantined"
+	// ReasonTemplatingError is the reason of events for installations whose templates could not be rendered.
+	ReasonTemplatingError Reason = "TemplatingError"
+	// ReasonSignatureVerificationFailed is the reason of events for installations whose component descriptor
+	// could not be verified.
+	ReasonSignatureVerificationFailed Reason = "SignatureVerificationFailed"
+	// ReasonSubInstallationsFailed is the reason of events for installations whose subinstallations
+	// could not be listed, created or deleted.
+	ReasonSubInstallationsFailed Reason = "SubInstallationsFailed"
+	// ReasonApprovalPending is the reason of events for installations that wait for an approval or whose approval was denied.
+	ReasonApprovalPending Reason = "ApprovalPending"
+	// ReasonQuotaExceeded is the reason of events for objects whose resources are not admitted by the resource quotas.
+	ReasonQuotaExceeded Reason = "QuotaExceeded"
+	// ReasonTimeout is the reason of events for deploy items that timed out.
+	ReasonTimeout Reason = "Timeout"
+	// ReasonAborted is the reason of events for objects whose current job was aborted.
+	ReasonAborted Reason = "Aborted"
+	// ReasonInstallationFailed is the reason of events for other errors of installations.
+	ReasonInstallationFailed Reason = "InstallationFailed"
+	// ReasonDeployItemFailed is the reason of events for other errors of deploy items.
+	ReasonDeployItemFailed Reason = "DeployItemFailed"
+)
+
+const (
+	// AnnotationPrefix is the prefix of the annotations of the events.
+	AnnotationPrefix = "events.landscaper.gardener.cloud/"
+	// CodeAnnotation is the annotation with the detailed code of an event.
+	// For events of errors, it is the reason of the error, e.g. "TemplatingFailed".
+	CodeAnnotation = AnnotationPrefix + "code"
+	// OperationAnnotation is the annotation with the operation of the error of an event.
+	OperationAnnotation = AnnotationPrefix + "operation"
+	// ErrorCodesAnnotation is the annotation with the comma separated error codes of the error of an event.
+	ErrorCodesAnnotation = AnnotationPrefix + "error-codes"
+	// JobIDAnnotation is the annotation with the current job ID of the object of an event.
+	JobIDAnnotation = AnnotationPrefix + "job-id"
+	// ImportsAnnotation is the annotation with the comma separated names of the imports an event refers to.
+	ImportsAnnotation = AnnotationPrefix + "imports"
+	// ConditionAnnotation is the annotation with the type of the condition an event refers to.
+	ConditionAnnotation = AnnotationPrefix + "condition"
+)
+
+// reasonsOfErrors maps the stable reasons of errors to the reasons of the events of these errors.
+var reasonsOfErrors = map[string]Reason{
+	lsv1alpha1.ImportNotFoundReason:         ReasonImportsPending,
+	lsv1alpha1.ImportNotSatisfiedReason:     ReasonImportsPending,
+	lsv1alpha1.NotCompletedDependentsReason: ReasonImportsPending,
+	lsv1alpha1.InvalidDefaultValueReason:    ReasonImportsInvalid,
+	lsv1alpha1.SchemaValidationFailedReason: ReasonImportsInvalid,
+	lsv1alpha1.AmbiguousImportReason:        ReasonImportsInvalid,
+	lsv1alpha1.ImportValidationFailedReason: ReasonImportsInvalid,
+	lsv1alpha1.TemplatingFailedReason:       ReasonTemplatingError,
+	lsv1alpha1.WaitingForApprovalReason:     ReasonApprovalPending,
+	lsv1alpha1.ApprovalNotGrantedReason:     ReasonApprovalPending,
+	lsv1alpha1.QuotaExceededReason:          ReasonQuotaExceeded,
+	lsv1alpha1.PickupTimeoutReason:          ReasonTimeout,
+	lsv1alpha1.ProgressingTimeoutReason:     ReasonTimeout,
+	lsv1alpha1.AbortTimeoutReason:           ReasonTimeout,
+	lsv1alpha1.AbortedReason:                ReasonAborted,
+}
+
+// ReasonForError returns the reason of the events for an error.
+// The fallback is returned if the reason of the error is not known.
+func ReasonForError(lsErr *lsv1alpha1.Error, fallback Reason) Reason {
+	if lsErr == nil {
+		return fallback
+	}
+	if reason, ok := reasonsOfErrors[lsErr.Reason]; ok {
+		return reason
+	}
+	if lserrors.ContainsAnyErrorCode(lsErr.Codes, []lsv1alpha1.ErrorCode{lsv1alpha1.ErrorTimeout}) {
+		return ReasonTimeout
+	}
+	if lserrors.ContainsAnyErrorCode(lsErr.Codes, []lsv1alpha1.ErrorCode{lsv1alpha1.ErrorQuotaExceeded}) {
+		return ReasonQuotaExceeded
+	}
+	return fallback
+}
+
+// Warning emits a warning event for an object. The code is a more detailed, machine-readable code
+// of the event, which is added as annotation together with the given annotations.
+func Warning(recorder record.EventRecorder, object runtime.Object, reason Reason, code, message string,
+	annotations map[string]string) {
+	emit(recorder, object, corev1.EventTypeWarning, reason, code, message, annotations)
+}
+
+// Error emits a warning event for the error of an object. The reason of the event is derived from the reason
+// and the codes of the error, see ReasonForError. The reason, the operation and the codes of the error
+// are added as annotations.
+func Error(recorder record.EventRecorder, object runtime.Object, fallback Reason, lsErr *lsv1alpha1.Error) {
+	if lsErr == nil {
+		return
+	}
+	annotations := map[string]string{}
+	if len(lsErr.Operation) != 0 {
+		annotations[OperationAnnotation] = lsErr.Operation
+	}
+	if len(lsErr.Codes) != 0 {
+		codes := make([]string, len(lsErr.Codes))
+		for i, code := range lsErr.Codes {
+			codes[i] = string(code)
+		}
+		annotations[ErrorCodesAnnotation] = strings.Join(codes, ",")
+	}
+	Warning(recorder, object, ReasonForError(lsErr, fallback), lsErr.Reason, lsErr.Message, annotations)
+}
+
+func emit(recorder record.EventRecorder, object runtime.Object, eventType string, reason Reason, code, message string,
+	annotations map[string]string) {
+	if recorder == nil {
+		return
+	}
+
+	allAnnotations := make(map[string]string, len(annotations)+2)
+	for key, value := range annotations {
+		allAnnotations[key] = value
+	}
+	if len(code) != 0 {
+		allAnnotations[CodeAnnotation] = code
+	}
+	if jobID := jobIDOf(object); len(jobID) != 0 {
+		allAnnotations[JobIDAnnotation] = jobID
+	}
+	recorder.AnnotatedEventf(object, allAnnotations, eventType, string(reason), "%s", message)
+}
+
+// jobIDOf returns the current job ID of installations, executions and deploy items.
+func jobIDOf(object runtime.Object) string {
+	switch obj := object.(type) {
+	case *lsv1alpha1.Installation:
+		return obj.Status.JobID
+	case *lsv1alpha1.Execution:
+		return obj.Status.JobID
+	case *lsv1alpha1.DeployItem:
+		return obj.Status.GetJobID()
+	}
+	return ""
+}
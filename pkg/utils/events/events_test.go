@@ -0,0 +1,65 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package events_test
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"k8s.io/client-go/tools/record"
+
+	lsv1alpha1 "github.com/gardener/landscaper/apis/core/v1alpha1"
+	"github.com/gardener/landscaper/pkg/utils/events"
+)
+
+var _ = Describe("Events", func() {
+
+	var recorder *record.FakeRecorder
+
+	BeforeEach(func() {
+		recorder = record.NewFakeRecorder(10)
+	})
+
+	It("should emit an event with a stable reason and the error as annotations", func() {
+		inst := &lsv1alpha1.Installation{}
+		inst.Name = "my-inst"
+		inst.Status.JobID = "job-1"
+		events.Error(recorder, inst, events.ReasonInstallationFailed, &lsv1alpha1.Error{
+			Operation: "CreateImports",
+			Reason:    lsv1alpha1.TemplatingFailedReason,
+			Message:   "unable to template: 100% broken",
+			Codes:     []lsv1alpha1.ErrorCode{lsv1alpha1.ErrorConfigurationProblem, lsv1alpha1.ErrorNoRetry},
+		})
+
+		Expect(<-recorder.Events).To(Equal("Warning TemplatingError unable to template: 100% broken" +
+			" map[events.landscaper.gardener.cloud/code:TemplatingFailed" +
+			" events.landscaper.gardener.cloud/error-codes:ERR_CONFIGURATION_PROBLEM,ERR_NO_RETRY" +
+			" events.landscaper.gardener.cloud/job-id:job-1" +
+			" events.landscaper.gardener.cloud/operation:CreateImports]"))
+	})
+
+	It("should not emit an event without an error", func() {
+		events.Error(recorder, &lsv1alpha1.DeployItem{}, events.ReasonDeployItemFailed, nil)
+		Expect(recorder.Events).To(BeEmpty())
+	})
+
+	It("should emit a warning with the given code and annotations", func() {
+		events.Warning(recorder, &lsv1alpha1.Installation{}, events.ReasonImportsOutdated, "OutdatedImports", "outdated",
+			map[string]string{events.ImportsAnnotation: "a,b"})
+		Expect(<-recorder.Events).To(Equal("Warning ImportsOutdated outdated" +
+			" map[events.landscaper.gardener.cloud/code:OutdatedImports events.landscaper.gardener.cloud/imports:a,b]"))
+	})
+
+	DescribeTable("should derive the reason of errors",
+		func(lsErr *lsv1alpha1.Error, expected events.Reason) {
+			Expect(events.ReasonForError(lsErr, events.ReasonDeployItemFailed)).To(Equal(expected))
+		},
+		Entry("imports pending", &lsv1alpha1.Error{Reason: lsv1alpha1.ImportNotSatisfiedReason}, events.ReasonImportsPending),
+		Entry("invalid imports", &lsv1alpha1.Error{Reason: lsv1alpha1.SchemaValidationFailedReason}, events.ReasonImportsInvalid),
+		Entry("timeout reason", &lsv1alpha1.Error{Reason: lsv1alpha1.PickupTimeoutReason}, events.ReasonTimeout),
+		Entry("timeout code", &lsv1alpha1.Error{Reason: "Apply", Codes: []lsv1alpha1.ErrorCode{lsv1alpha1.ErrorTimeout}}, events.ReasonTimeout),
+		Entry("unknown reason", &lsv1alpha1.Error{Reason: "Apply"}, events.ReasonDeployItemFailed),
+		Entry("no error", nil, events.ReasonDeployItemFailed),
+	)
+})
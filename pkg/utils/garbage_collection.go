@@ -0,0 +1,44 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package utils
+
+import (
+	"context"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	lsv1alpha1 "github.com/gardener/landscaper/apis/core/v1alpha1"
+	kutil "github.com/gardener/landscaper/controller-utils/pkg/kubernetes"
+	"github.com/gardener/landscaper/pkg/utils/read_write_layer"
+)
+
+// IsControllingInstallationDeleted returns true if the installation that controls the given object is gone,
+// or if it is in deletion and has already removed its finalizer. With the owner reference garbage collection,
+// the object is then deleted by the garbage collector and has to process its deletion on its own.
+// False is returned if the object is not controlled by an installation.
+func IsControllingInstallationDeleted(ctx context.Context, c client.Reader, obj metav1.Object,
+	readID read_write_layer.ReadID) (bool, error) {
+
+	controllerRef := metav1.GetControllerOf(obj)
+	if controllerRef == nil || controllerRef.Kind != InstallationKind {
+		return false, nil
+	}
+
+	metadata := EmptyInstallationMetadata()
+	key := client.ObjectKey{Namespace: obj.GetNamespace(), Name: controllerRef.Name}
+	if err := read_write_layer.GetMetaData(ctx, c, key, metadata, readID); err != nil {
+		if apierrors.IsNotFound(err) {
+			return true, nil
+		}
+		return false, err
+	}
+
+	if metadata.UID != controllerRef.UID {
+		return true, nil
+	}
+	return !metadata.DeletionTimestamp.IsZero() && !kutil.HasFinalizer(metadata, lsv1alpha1.LandscaperFinalizer), nil
+}
@@ -0,0 +1,74 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package utils
+
+import (
+	"fmt"
+	"sync/atomic"
+
+	"k8s.io/client-go/rest"
+	"k8s.io/client-go/tools/clientcmd"
+	clientcmdapi "k8s.io/client-go/tools/clientcmd/api"
+)
+
+var rejectLocalKubeconfigCredentials atomic.Bool
+
+// SetRejectLocalKubeconfigCredentials sets whether kubeconfigs that reference local files or use credential plugins
+// are rejected by LoadKubeconfig and RestConfigFromKubeconfig.
+// Such kubeconfigs would give the owner of a target access to the files and executables of the process.
+// They are accepted by default.
+func SetRejectLocalKubeconfigCredentials(reject bool) {
+	rejectLocalKubeconfigCredentials.Store(reject)
+}
+
+// LoadKubeconfig parses a kubeconfig that is given as data instead of a file.
+// If local credentials are rejected, an error is returned for kubeconfigs that reference local files
+// or use a credential plugin.
+func LoadKubeconfig(kubeconfig []byte) (*clientcmdapi.Config, error) {
+	config, err := clientcmd.Load(kubeconfig)
+	if err != nil {
+		return nil, err
+	}
+	if rejectLocalKubeconfigCredentials.Load() {
+		if err := checkLocalKubeconfigCredentials(config); err != nil {
+			return nil, err
+		}
+	}
+	return config, nil
+}
+
+// RestConfigFromKubeconfig creates a rest config for the current context of a kubeconfig that is given as data.
+// The kubeconfig is checked like in LoadKubeconfig.
+func RestConfigFromKubeconfig(kubeconfig []byte) (*rest.Config, error) {
+	config, err := LoadKubeconfig(kubeconfig)
+	if err != nil {
+		return nil, err
+	}
+	return clientcmd.NewDefaultClientConfig(*config, &clientcmd.ConfigOverrides{}).ClientConfig()
+}
+
+// ZeroizeKubeconfig overwrites a kubeconfig with zeros, so that its credentials do not remain in memory after use.
+func ZeroizeKubeconfig(kubeconfig []byte) {
+	clear(kubeconfig)
+}
+
+func checkLocalKubeconfigCredentials(config *clientcmdapi.Config) error {
+	for name, cluster := range config.Clusters {
+		if len(cluster.CertificateAuthority) != 0 {
+			return fmt.Errorf("cluster %q of the kubeconfig must contain the certificate authority instead of referencing a file", name)
+		}
+	}
+	for name, authInfo := range config.AuthInfos {
+		switch {
+		case len(authInfo.ClientCertificate) != 0, len(authInfo.ClientKey) != 0:
+			return fmt.Errorf("user %q of the kubeconfig must contain the certificates and keys instead of referencing files", name)
+		case len(authInfo.TokenFile) != 0:
+			return fmt.Errorf("user %q of the kubeconfig must contain the token instead of referencing a file", name)
+		case authInfo.Exec != nil:
+			return fmt.Errorf("user %q of the kubeconfig must not use a credential plugin", name)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,85 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package utils_test
+
+import (
+	"fmt"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	lsutil "github.com/gardener/landscaper/pkg/utils"
+)
+
+var _ = Describe("Kubeconfig", func() {
+
+	kubeconfig := func(user string) []byte {
+		return []byte(fmt.Sprintf(`apiVersion: v1
+kind: Config
+current-context: default
+clusters:
+- name: default
+  cluster:
+    server: https://127.0.0.1:6443
+    insecure-skip-tls-verify: true
+contexts:
+- name: default
+  context:
+    cluster: default
+    user: default
+users:
+- name: default
+  user:
+%s
+`, user))
+	}
+
+	const (
+		tokenFile = "    tokenFile: /var/run/secrets/kubernetes.io/serviceaccount/token"
+		certFiles = "    client-certificate: /tmp/cert.pem\n    client-key: /tmp/key.pem"
+		exec      = "    exec:\n      apiVersion: client.authentication.k8s.io/v1\n      command: my-plugin\n      interactiveMode: Never"
+	)
+
+	AfterEach(func() {
+		lsutil.SetRejectLocalKubeconfigCredentials(false)
+	})
+
+	It("should create a rest config from a kubeconfig with inline credentials", func() {
+		lsutil.SetRejectLocalKubeconfigCredentials(true)
+
+		restConfig, err := lsutil.RestConfigFromKubeconfig(kubeconfig("    token: my-token"))
+		Expect(err).ToNot(HaveOccurred())
+		Expect(restConfig.Host).To(Equal("https://127.0.0.1:6443"))
+		Expect(restConfig.BearerToken).To(Equal("my-token"))
+	})
+
+	It("should accept kubeconfigs with local files and credential plugins by default", func() {
+		for _, user := range []string{tokenFile, certFiles, exec} {
+			_, err := lsutil.LoadKubeconfig(kubeconfig(user))
+			Expect(err).ToNot(HaveOccurred())
+		}
+
+		restConfig, err := lsutil.RestConfigFromKubeconfig(kubeconfig(exec))
+		Expect(err).ToNot(HaveOccurred())
+		Expect(restConfig.ExecProvider).ToNot(BeNil())
+	})
+
+	It("should reject kubeconfigs with local files and credential plugins if enabled", func() {
+		lsutil.SetRejectLocalKubeconfigCredentials(true)
+
+		for _, user := range []string{tokenFile, certFiles, exec} {
+			_, err := lsutil.RestConfigFromKubeconfig(kubeconfig(user))
+			Expect(err).To(HaveOccurred())
+			_, err = lsutil.LoadKubeconfig(kubeconfig(user))
+			Expect(err).To(HaveOccurred())
+		}
+	})
+
+	It("should zeroize a kubeconfig", func() {
+		data := kubeconfig("    token: my-token")
+		lsutil.ZeroizeKubeconfig(data)
+		Expect(data).To(HaveEach(byte(0)))
+	})
+})
@@ -21,6 +21,7 @@ import (
 	"github.com/gardener/landscaper/pkg/components/model"
 	"github.com/gardener/landscaper/pkg/components/model/types"
 	"github.com/gardener/landscaper/pkg/landscaper/blueprints"
+	"github.com/gardener/landscaper/pkg/landscaper/blueprints/renderer"
 	"github.com/gardener/landscaper/pkg/landscaper/installations/executions/template"
 	"github.com/gardener/landscaper/pkg/landscaper/installations/executions/template/gotemplate"
 )
@@ -161,7 +162,9 @@ type BlueprintExports Exports
 // The exports of deploy items are simulated via user defined ExportTemplates.
 type InstallationSimulator struct {
 	// blueprintRenderer is used to render blueprints of installations.
-	blueprintRenderer *BlueprintRenderer
+	blueprintRenderer *renderer.BlueprintRenderer
+	// cdList contains the component versions that are passed to the templating of subinstallations.
+	cdList *model.ComponentVersionList
 	// exportTemplates contains the user defined export templates.
 	exportTemplates ExportTemplates
 	// callbacks contains the user callbacks.
@@ -192,7 +195,8 @@ func NewInstallationSimulator(cdList *model.ComponentVersionList,
 	}
 
 	return &InstallationSimulator{
-		blueprintRenderer: NewBlueprintRenderer(cdList, registryAccess, repositoryContext),
+		blueprintRenderer: renderer.NewBlueprintRenderer(cdList, registryAccess, repositoryContext),
+		cdList:            cdList,
 		exportTemplates:   exportTemplates,
 		callbacks:         emptySimulatorCallbacks{},
 	}, nil
@@ -206,7 +210,7 @@ func (s *InstallationSimulator) SetCallbacks(callbacks InstallationSimulatorCall
 
 // Run starts the simulation for the given component descriptor, blueprint and imports and returns the calculated exports.
 func (s *InstallationSimulator) Run(componentVersion model.ComponentVersion, blueprint *blueprints.Blueprint, imports map[string]interface{}) (*BlueprintExports, error) {
-	ctx := &ResolvedInstallation{
+	ctx := &renderer.ResolvedInstallation{
 		ComponentVersion: componentVersion,
 		Installation: &lsv1alpha1.Installation{
 			ObjectMeta: metav1.ObjectMeta{
@@ -224,7 +228,7 @@ func (s *InstallationSimulator) Run(componentVersion model.ComponentVersion, blu
 }
 
 // executeInstallation calculates the exports of the current installation and calls itself recursively for its subinstallations.
-func (s *InstallationSimulator) executeInstallation(ctx *ResolvedInstallation, installationPath *InstallationPath, dataImports, targetImports map[string]interface{}) (*InstallationExports, *BlueprintExports, error) {
+func (s *InstallationSimulator) executeInstallation(ctx *renderer.ResolvedInstallation, installationPath *InstallationPath, dataImports, targetImports map[string]interface{}) (*InstallationExports, *BlueprintExports, error) {
 	if installationPath == nil {
 		installationPath = NewInstallationPath(ctx.Installation.Name)
 	} else {
@@ -441,7 +445,7 @@ func (s *InstallationSimulator) executeInstallation(ctx *ResolvedInstallation, i
 
 // handleSubInstallation tries to find an installation export template for the given subinstallation.
 // If no matching installation export template was found, the subinstallation is being executed.
-func (s *InstallationSimulator) handleSubInstallation(installationPath *InstallationPath, subInstallation *ResolvedInstallation, dataImports, targetImports map[string]interface{}) (*InstallationExports, error) {
+func (s *InstallationSimulator) handleSubInstallation(installationPath *InstallationPath, subInstallation *renderer.ResolvedInstallation, dataImports, targetImports map[string]interface{}) (*InstallationExports, error) {
 	subInstallationPath := installationPath.Child(subInstallation.Installation.Name)
 	subInstallationPathString := subInstallationPath.String()
 
@@ -475,7 +479,7 @@ func (s *InstallationSimulator) handleSubInstallation(installationPath *Installa
 			}
 			templateInput["cd"] = cdEncoded
 
-			componentsEncoded, err := encodeTemplateInput(s.blueprintRenderer.cdList)
+			componentsEncoded, err := encodeTemplateInput(s.cdList)
 			if err != nil {
 				return nil, fmt.Errorf("failed to encode component descriptor list for installation %s: %w", subInstallationPathString, err)
 			}
@@ -520,7 +524,7 @@ func (s *InstallationSimulator) handleSubInstallation(installationPath *Installa
 }
 
 // handleDeployItems handles the export calculation of the deploy items defined for an installation.
-func (s *InstallationSimulator) handleDeployItems(installationPath string, renderedDeployItems *RenderedDeployItemsSubInstallations,
+func (s *InstallationSimulator) handleDeployItems(installationPath string, renderedDeployItems *renderer.RenderedDeployItemsSubInstallations,
 	componentVersion model.ComponentVersion, imports map[string]interface{}) (map[string]interface{}, error) {
 
 	exportsByDeployItem := make(map[string]interface{})
@@ -561,7 +565,7 @@ func (s *InstallationSimulator) handleDeployItems(installationPath string, rende
 				}
 				templateInput["cd"] = cdEncoded
 
-				componentDescriptorList, err := model.ConvertComponentVersionList(s.blueprintRenderer.cdList)
+				componentDescriptorList, err := model.ConvertComponentVersionList(s.cdList)
 				if err != nil {
 					return nil, fmt.Errorf("failed to convert component descriptor list for deploy item %s: %w", deployItem.Name, err)
 				}
@@ -0,0 +1,204 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package landscaper
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	lsv1alpha1 "github.com/gardener/landscaper/apis/core/v1alpha1"
+	"github.com/gardener/landscaper/apis/core/v1alpha1/helper"
+	"github.com/gardener/landscaper/pkg/utils/read_write_layer"
+)
+
+// DefaultStatusWatchInterval is the default interval in which the status of a watched installation is read.
+const DefaultStatusWatchInterval = 2 * time.Second
+
+// InstallationEventType is the type of an event of an installation status watch.
+type InstallationEventType string
+
+const (
+	// InstallationEventPhaseChanged is emitted when the phase or the job ID of the installation has changed.
+	InstallationEventPhaseChanged InstallationEventType = "PhaseChanged"
+	// InstallationEventFinished is emitted once when the current job of the installation has finished.
+	InstallationEventFinished InstallationEventType = "Finished"
+	// InstallationEventDeleted is emitted once when the installation has been deleted.
+	InstallationEventDeleted InstallationEventType = "Deleted"
+)
+
+// InstallationEvent describes an observed change of a watched installation.
+type InstallationEvent struct {
+	// Type is the type of the event.
+	Type InstallationEventType `json:"type"`
+	// Installation is the watched installation in the format "namespace/name".
+	Installation string `json:"installation"`
+	// Phase is the phase of the installation.
+	Phase lsv1alpha1.InstallationPhase `json:"phase,omitempty"`
+	// JobID is the job ID of the installation.
+	JobID string `json:"jobID,omitempty"`
+	// Time is the time when the change has been observed.
+	Time time.Time `json:"time"`
+	// Result is the result of the installation. It is only set for the events of type Finished and Deleted.
+	Result *InstallationResult `json:"result,omitempty"`
+}
+
+// InstallationResult is the structured result of a finished job of an installation.
+type InstallationResult struct {
+	// Phase is the final phase of the installation. It is empty if the installation has been deleted.
+	Phase lsv1alpha1.InstallationPhase `json:"phase,omitempty"`
+	// JobID is the finished job ID of the installation.
+	JobID string `json:"jobID,omitempty"`
+	// Succeeded is true if the installation has been reconciled resp. deleted successfully.
+	Succeeded bool `json:"succeeded"`
+	// Deleted is true if the installation has been deleted.
+	Deleted bool `json:"deleted,omitempty"`
+	// ErrorReason is the reason of the last error of a failed installation.
+	ErrorReason string `json:"errorReason,omitempty"`
+	// ErrorMessage is the message of the last error of a failed installation.
+	ErrorMessage string `json:"errorMessage,omitempty"`
+	// ErrorCodes are the codes of the last error of a failed installation.
+	ErrorCodes []lsv1alpha1.ErrorCode `json:"errorCodes,omitempty"`
+	// Exports are the data exports of a succeeded installation by their export name.
+	Exports map[string]json.RawMessage `json:"exports,omitempty"`
+}
+
+// StatusWatchOptions configures a watch of the status of an installation.
+type StatusWatchOptions struct {
+	// Interval is the interval in which the installation is read. Defaults to DefaultStatusWatchInterval.
+	Interval time.Duration
+	// Timeout is the maximal duration of the watch. The watch has no timeout if not set.
+	Timeout time.Duration
+	// OnEvent is called for every event of the watch.
+	OnEvent func(event InstallationEvent)
+}
+
+// WatchInstallationStatus watches the given installation until its current job has finished,
+// and returns the result of the job.
+// A job counts as finished only if the installation has a final phase, its finished job ID equals its job ID,
+// and it is not annotated with the reconcile operation. Final phases of a previous job that are still reported
+// while the next job is pending are therefore never reported as result.
+// If the installation is deleted during the watch, the result is marked as deleted and succeeded.
+func WatchInstallationStatus(ctx context.Context, kubeClient client.Reader, key client.ObjectKey,
+	opts StatusWatchOptions) (*InstallationResult, error) {
+
+	interval := opts.Interval
+	if interval <= 0 {
+		interval = DefaultStatusWatchInterval
+	}
+	if opts.Timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, opts.Timeout)
+		defer cancel()
+	}
+	emit := func(event InstallationEvent) {
+		if opts.OnEvent != nil {
+			event.Installation = key.String()
+			event.Time = time.Now()
+			opts.OnEvent(event)
+		}
+	}
+
+	var (
+		result    *InstallationResult
+		lastPhase lsv1alpha1.InstallationPhase
+		lastJobID string
+		observed  bool
+	)
+	err := wait.PollUntilContextCancel(ctx, interval, true, func(ctx context.Context) (bool, error) {
+		inst := &lsv1alpha1.Installation{}
+		if err := read_write_layer.GetInstallation(ctx, kubeClient, key, inst, read_write_layer.R000140); err != nil {
+			if apierrors.IsNotFound(err) && observed {
+				result = &InstallationResult{Succeeded: true, Deleted: true}
+				emit(InstallationEvent{Type: InstallationEventDeleted, Result: result})
+				return true, nil
+			}
+			return false, err
+		}
+
+		if !observed || inst.Status.InstallationPhase != lastPhase || inst.Status.JobID != lastJobID {
+			observed = true
+			lastPhase = inst.Status.InstallationPhase
+			lastJobID = inst.Status.JobID
+			emit(InstallationEvent{Type: InstallationEventPhaseChanged, Phase: lastPhase, JobID: lastJobID})
+		}
+
+		if !IsInstallationJobFinished(inst) {
+			return false, nil
+		}
+		if inst.Status.InstallationPhase == lsv1alpha1.InstallationPhases.DeleteFailed || inst.DeletionTimestamp.IsZero() {
+			var err error
+			result, err = NewInstallationResult(ctx, kubeClient, inst)
+			if err != nil {
+				return false, err
+			}
+			emit(InstallationEvent{Type: InstallationEventFinished, Phase: result.Phase, JobID: result.JobID, Result: result})
+			return true, nil
+		}
+		// a succeeded deletion ends with the removal of the installation
+		return false, nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("error while watching installation %s: %w", key.String(), err)
+	}
+	return result, nil
+}
+
+// IsInstallationJobFinished returns true if the current job of the installation has finished.
+func IsInstallationJobFinished(inst *lsv1alpha1.Installation) bool {
+	return inst.Status.InstallationPhase.IsFinal() &&
+		inst.Status.JobID == inst.Status.JobIDFinished &&
+		!helper.HasOperation(inst.ObjectMeta, lsv1alpha1.ReconcileOperation)
+}
+
+// NewInstallationResult returns the result of the finished job of an installation.
+// The data exports are only read for succeeded installations.
+func NewInstallationResult(ctx context.Context, kubeClient client.Reader, inst *lsv1alpha1.Installation) (*InstallationResult, error) {
+	result := &InstallationResult{
+		Phase:     inst.Status.InstallationPhase,
+		JobID:     inst.Status.JobIDFinished,
+		Succeeded: inst.Status.InstallationPhase == lsv1alpha1.InstallationPhases.Succeeded,
+	}
+	if inst.Status.InstallationPhase.IsFailed() && inst.Status.LastError != nil {
+		result.ErrorReason = inst.Status.LastError.Reason
+		result.ErrorMessage = inst.Status.LastError.Message
+		result.ErrorCodes = inst.Status.LastError.Codes
+	}
+	if !result.Succeeded {
+		return result, nil
+	}
+
+	dataObjects := &lsv1alpha1.DataObjectList{}
+	if err := read_write_layer.ListDataObjects(ctx, kubeClient, dataObjects, read_write_layer.R000141,
+		client.InNamespace(inst.Namespace),
+		client.MatchingLabels{
+			lsv1alpha1.DataObjectSourceLabel:     helper.DataObjectSourceFromInstallation(inst),
+			lsv1alpha1.DataObjectSourceTypeLabel: string(lsv1alpha1.ExportDataObjectSourceType),
+			lsv1alpha1.DataObjectJobIDLabel:      inst.Status.JobIDFinished,
+		}); err != nil {
+		return nil, fmt.Errorf("unable to list exports of installation: %w", err)
+	}
+	// the data objects are labeled with the data ref of the export
+	exportNames := map[string]string{}
+	for _, export := range inst.Spec.Exports.Data {
+		exportNames[export.DataRef] = export.Name
+	}
+	for _, do := range dataObjects.Items {
+		exportName, ok := exportNames[do.Labels[lsv1alpha1.DataObjectKeyLabel]]
+		if !ok {
+			continue
+		}
+		if result.Exports == nil {
+			result.Exports = map[string]json.RawMessage{}
+		}
+		result.Exports[exportName] = json.RawMessage(do.Data.RawMessage)
+	}
+	return result, nil
+}
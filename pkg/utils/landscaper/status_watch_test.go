@@ -0,0 +1,131 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package landscaper_test
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	lsv1alpha1 "github.com/gardener/landscaper/apis/core/v1alpha1"
+	"github.com/gardener/landscaper/apis/core/v1alpha1/helper"
+	"github.com/gardener/landscaper/pkg/api"
+	"github.com/gardener/landscaper/pkg/utils/landscaper"
+)
+
+var _ = Describe("Installation Status Watch", func() {
+
+	var (
+		ctx  context.Context
+		inst *lsv1alpha1.Installation
+	)
+
+	BeforeEach(func() {
+		ctx = context.Background()
+		inst = &lsv1alpha1.Installation{}
+		inst.Name = "test"
+		inst.Namespace = "default"
+		inst.Spec.Exports.Data = []lsv1alpha1.DataExport{{Name: "url", DataRef: "my-url"}}
+		inst.Status.JobID = "job-2"
+		inst.Status.JobIDFinished = "job-2"
+		inst.Status.InstallationPhase = lsv1alpha1.InstallationPhases.Succeeded
+	})
+
+	newExport := func(dataRef, jobID, value string) *lsv1alpha1.DataObject {
+		do := &lsv1alpha1.DataObject{}
+		do.Name = dataRef + "-" + jobID
+		do.Namespace = inst.Namespace
+		do.Labels = map[string]string{
+			lsv1alpha1.DataObjectSourceLabel:     helper.DataObjectSourceFromInstallation(inst),
+			lsv1alpha1.DataObjectSourceTypeLabel: string(lsv1alpha1.ExportDataObjectSourceType),
+			lsv1alpha1.DataObjectJobIDLabel:      jobID,
+			lsv1alpha1.DataObjectKeyLabel:        dataRef,
+		}
+		do.Data = lsv1alpha1.NewAnyJSON([]byte(value))
+		return do
+	}
+
+	It("should return the result with the exports of the finished job", func() {
+		kubeClient := fake.NewClientBuilder().WithScheme(api.LandscaperScheme).WithObjects(inst,
+			newExport("my-url", "job-2", `"https://new"`), newExport("my-url", "job-1", `"https://old"`)).Build()
+
+		var events []landscaper.InstallationEvent
+		result, err := landscaper.WatchInstallationStatus(ctx, kubeClient, client.ObjectKeyFromObject(inst), landscaper.StatusWatchOptions{
+			Interval: 10 * time.Millisecond,
+			OnEvent: func(event landscaper.InstallationEvent) {
+				events = append(events, event)
+			},
+		})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(result.Succeeded).To(BeTrue())
+		Expect(result.JobID).To(Equal("job-2"))
+		Expect(result.Exports).To(HaveKeyWithValue("url", json.RawMessage(`"https://new"`)))
+
+		Expect(events).To(HaveLen(2))
+		Expect(events[0].Type).To(Equal(landscaper.InstallationEventPhaseChanged))
+		Expect(events[0].Installation).To(Equal("default/test"))
+		Expect(events[1].Type).To(Equal(landscaper.InstallationEventFinished))
+		Expect(events[1].Result).To(Equal(result))
+	})
+
+	It("should return the error of a failed installation", func() {
+		inst.Status.InstallationPhase = lsv1alpha1.InstallationPhases.Failed
+		inst.Status.LastError = &lsv1alpha1.Error{
+			Reason:  "ApplyFailed",
+			Message: "apply failed",
+			Codes:   []lsv1alpha1.ErrorCode{lsv1alpha1.ErrorWebhook},
+		}
+		kubeClient := fake.NewClientBuilder().WithScheme(api.LandscaperScheme).WithObjects(inst).Build()
+
+		result, err := landscaper.WatchInstallationStatus(ctx, kubeClient, client.ObjectKeyFromObject(inst),
+			landscaper.StatusWatchOptions{Interval: 10 * time.Millisecond})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(result.Succeeded).To(BeFalse())
+		Expect(result.Phase).To(Equal(lsv1alpha1.InstallationPhases.Failed))
+		Expect(result.ErrorReason).To(Equal("ApplyFailed"))
+		Expect(result.ErrorCodes).To(ConsistOf(lsv1alpha1.ErrorWebhook))
+		Expect(result.Exports).To(BeNil())
+	})
+
+	It("should not report the final phase of a previous job", func() {
+		inst.Status.JobID = "job-3"
+		Expect(landscaper.IsInstallationJobFinished(inst)).To(BeFalse())
+
+		inst.Status.JobIDFinished = "job-3"
+		helper.SetOperation(&inst.ObjectMeta, lsv1alpha1.ReconcileOperation)
+		Expect(landscaper.IsInstallationJobFinished(inst)).To(BeFalse())
+
+		delete(inst.Annotations, lsv1alpha1.OperationAnnotation)
+		Expect(landscaper.IsInstallationJobFinished(inst)).To(BeTrue())
+
+		inst.Status.JobID = "job-4"
+		kubeClient := fake.NewClientBuilder().WithScheme(api.LandscaperScheme).WithObjects(inst).Build()
+		_, err := landscaper.WatchInstallationStatus(ctx, kubeClient, client.ObjectKeyFromObject(inst),
+			landscaper.StatusWatchOptions{Interval: 10 * time.Millisecond, Timeout: 100 * time.Millisecond})
+		Expect(err).To(HaveOccurred())
+	})
+
+	It("should report the deletion of an installation", func() {
+		inst.Status.JobID = "job-3"
+		kubeClient := fake.NewClientBuilder().WithScheme(api.LandscaperScheme).WithObjects(inst).Build()
+
+		go func() {
+			defer GinkgoRecover()
+			time.Sleep(50 * time.Millisecond)
+			Expect(kubeClient.Delete(ctx, inst)).To(Succeed())
+		}()
+
+		result, err := landscaper.WatchInstallationStatus(ctx, kubeClient, client.ObjectKeyFromObject(inst),
+			landscaper.StatusWatchOptions{Interval: 10 * time.Millisecond, Timeout: 5 * time.Second})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(result.Deleted).To(BeTrue())
+		Expect(result.Succeeded).To(BeTrue())
+	})
+})
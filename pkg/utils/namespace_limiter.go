@@ -0,0 +1,82 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package utils
+
+import (
+	"math/rand"
+	"sync"
+	"time"
+)
+
+// NamespaceLimitRequeueInterval is the interval after which a reconcile is retried if the maximal number of
+// concurrent reconciles of its namespace has been reached. A random jitter of up to the same duration is added.
+const NamespaceLimitRequeueInterval = 5 * time.Second
+
+// NamespaceLimiter limits the number of concurrent reconciles of objects of the same namespace, so that a namespace
+// with many objects cannot occupy all workers of a controller. The limit is local to the controller process.
+type NamespaceLimiter struct {
+	maxPerNamespace int
+	inFlight        map[string]int
+	mutex           sync.Mutex
+}
+
+// NewNamespaceLimiter creates a new limiter that admits maxPerNamespace concurrent reconciles per namespace.
+// The number of reconciles is not limited if maxPerNamespace is not positive.
+func NewNamespaceLimiter(maxPerNamespace int) *NamespaceLimiter {
+	return &NamespaceLimiter{
+		maxPerNamespace: maxPerNamespace,
+		inFlight:        map[string]int{},
+	}
+}
+
+// TryEnter registers a reconcile of an object of the given namespace. It returns false if the maximal number of
+// concurrent reconciles of the namespace has been reached. Exit must be called for every successful TryEnter.
+func (l *NamespaceLimiter) TryEnter(namespace string) bool {
+	if l == nil || l.maxPerNamespace <= 0 {
+		return true
+	}
+
+	l.mutex.Lock()
+	defer l.mutex.Unlock()
+
+	if l.inFlight[namespace] >= l.maxPerNamespace {
+		return false
+	}
+	l.inFlight[namespace]++
+	return true
+}
+
+// Exit unregisters a reconcile of an object of the given namespace.
+func (l *NamespaceLimiter) Exit(namespace string) {
+	if l == nil || l.maxPerNamespace <= 0 {
+		return
+	}
+
+	l.mutex.Lock()
+	defer l.mutex.Unlock()
+
+	l.inFlight[namespace]--
+	if l.inFlight[namespace] <= 0 {
+		delete(l.inFlight, namespace)
+	}
+}
+
+// InFlight returns the number of running reconciles of objects of the given namespace.
+func (l *NamespaceLimiter) InFlight(namespace string) int {
+	if l == nil {
+		return 0
+	}
+
+	l.mutex.Lock()
+	defer l.mutex.Unlock()
+
+	return l.inFlight[namespace]
+}
+
+// RequeueInterval returns the interval after which a reconcile that was not admitted is retried.
+// The jitter prevents that all postponed reconciles of a namespace are retried at the same time.
+func (l *NamespaceLimiter) RequeueInterval() time.Duration {
+	return NamespaceLimitRequeueInterval + time.Duration(rand.Float64()*float64(NamespaceLimitRequeueInterval))
+}
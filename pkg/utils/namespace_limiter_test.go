@@ -0,0 +1,49 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package utils_test
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	lsutil "github.com/gardener/landscaper/pkg/utils"
+)
+
+var _ = Describe("NamespaceLimiter", func() {
+
+	It("should limit the concurrent reconciles per namespace", func() {
+		limiter := lsutil.NewNamespaceLimiter(2)
+
+		Expect(limiter.TryEnter("a")).To(BeTrue())
+		Expect(limiter.TryEnter("a")).To(BeTrue())
+		Expect(limiter.TryEnter("a")).To(BeFalse())
+		Expect(limiter.TryEnter("b")).To(BeTrue())
+		Expect(limiter.InFlight("a")).To(Equal(2))
+		Expect(limiter.InFlight("b")).To(Equal(1))
+
+		limiter.Exit("a")
+		Expect(limiter.InFlight("a")).To(Equal(1))
+		Expect(limiter.TryEnter("a")).To(BeTrue())
+	})
+
+	It("should not limit the reconciles if no maximum is configured", func() {
+		limiter := lsutil.NewNamespaceLimiter(0)
+		for i := 0; i < 100; i++ {
+			Expect(limiter.TryEnter("a")).To(BeTrue())
+		}
+		limiter.Exit("a")
+
+		var nilLimiter *lsutil.NamespaceLimiter
+		Expect(nilLimiter.TryEnter("a")).To(BeTrue())
+		nilLimiter.Exit("a")
+	})
+
+	It("should requeue with a jitter", func() {
+		limiter := lsutil.NewNamespaceLimiter(1)
+		interval := limiter.RequeueInterval()
+		Expect(interval).To(BeNumerically(">=", lsutil.NamespaceLimitRequeueInterval))
+		Expect(interval).To(BeNumerically("<", 2*lsutil.NamespaceLimitRequeueInterval))
+	})
+})
@@ -0,0 +1,389 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package read_write_layer
+
+import (
+	"context"
+	"crypto/sha1"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/validation"
+	"k8s.io/client-go/tools/record"
+	"k8s.io/client-go/util/retry"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+
+	"github.com/gardener/landscaper/apis/config"
+	lsv1alpha1 "github.com/gardener/landscaper/apis/core/v1alpha1"
+	"github.com/gardener/landscaper/controller-utils/pkg/logging"
+	lc "github.com/gardener/landscaper/controller-utils/pkg/logging/constants"
+	"github.com/gardener/landscaper/pkg/metrics/controllermetrics"
+)
+
+const (
+	// WriteHistoryLabel is set on all configmaps that contain the write history of a resource.
+	WriteHistoryLabel = lsv1alpha1.LandscaperDomain + "/write-history"
+	// WriteHistoryDataKey is the key of the write history in the history configmaps.
+	WriteHistoryDataKey = "history"
+	// DefaultWriteHistoryMaxEntries is the default number of writes that are kept per resource.
+	DefaultWriteHistoryMaxEntries = 50
+
+	// WriteAuditReasonWrite is the reason of the events that record a successful write.
+	WriteAuditReasonWrite = "Write"
+	// WriteAuditReasonWriteFailed is the reason of the events that record a failed write.
+	WriteAuditReasonWriteFailed = "WriteFailed"
+
+	unknownActor = "unknown"
+)
+
+// WriteRecord describes a single write operation of the read-write layer.
+type WriteRecord struct {
+	// Time is the time of the write operation.
+	Time metav1.Time `json:"time"`
+	// WriteID identifies the code location of the write operation.
+	WriteID WriteID `json:"writeID"`
+	// Operation is the kind of the write operation, e.g. "installation status update".
+	Operation string `json:"operation"`
+	// Kind is the kind of the written resource.
+	Kind string `json:"kind"`
+	// Namespace is the namespace of the written resource.
+	Namespace string `json:"namespace"`
+	// Name is the name of the written resource.
+	Name string `json:"name"`
+	// GenerationOld is the generation of the resource before the write operation.
+	GenerationOld int64 `json:"generationOld"`
+	// GenerationNew is the generation of the resource after the write operation.
+	GenerationNew int64 `json:"generationNew,omitempty"`
+	// ResourceVersionOld is the resource version of the resource before the write operation.
+	ResourceVersionOld string `json:"resourceVersionOld,omitempty"`
+	// ResourceVersionNew is the resource version of the resource after the write operation.
+	ResourceVersionNew string `json:"resourceVersionNew,omitempty"`
+	// Changes summarizes the changed fields, e.g. "spec.imports" or "metadata.annotations".
+	// The changes are only known for create-or-update operations.
+	Changes []string `json:"changes,omitempty"`
+	// Actor is the controller that has executed the write operation.
+	Actor string `json:"actor"`
+	// Error is the error message of a failed write operation.
+	Error string `json:"error,omitempty"`
+}
+
+// AuditRecorder records the write operations of the read-write layer.
+// Implementations must not fail the write operation; errors are only logged.
+type AuditRecorder interface {
+	Record(ctx context.Context, object client.Object, record *WriteRecord)
+}
+
+var auditRecorder AuditRecorder
+
+// SetAuditRecorder sets the recorder for all write operations of the read-write layer.
+// The recording is disabled if the recorder is nil.
+func SetAuditRecorder(recorder AuditRecorder) {
+	auditRecorder = recorder
+}
+
+// NewAuditRecorderFromConfig creates the audit recorder that is configured in the landscaper configuration.
+// Nil is returned if the audit trail is disabled.
+func NewAuditRecorderFromConfig(c client.Client, eventRecorder record.EventRecorder, cfg *config.WriteAuditConfiguration) AuditRecorder {
+	if cfg == nil {
+		return nil
+	}
+
+	recorders := MultiAuditRecorder{}
+	if cfg.Events {
+		recorders = append(recorders, NewEventAuditRecorder(eventRecorder))
+	}
+	if cfg.History != nil {
+		recorders = append(recorders, NewHistoryAuditRecorder(c, cfg.History.MaxEntries))
+	}
+
+	switch len(recorders) {
+	case 0:
+		return nil
+	case 1:
+		return recorders[0]
+	default:
+		return recorders
+	}
+}
+
+type actorContextKey struct{}
+
+// ContextWithActor returns a context whose write operations are recorded with the given actor.
+func ContextWithActor(ctx context.Context, actor string) context.Context {
+	return context.WithValue(ctx, actorContextKey{}, actor)
+}
+
+func actorFromContext(ctx context.Context) string {
+	if actor, ok := ctx.Value(actorContextKey{}).(string); ok && len(actor) > 0 {
+		return actor
+	}
+	return unknownActor
+}
+
+// auditMutateFn wraps the mutate function of a create-or-update operation such that the state
+// of the object before the mutation is available for the audit record.
+// The returned function returns nil if the object did not exist before.
+func auditMutateFn(object client.Object, f controllerutil.MutateFn) (controllerutil.MutateFn, func() client.Object) {
+	if auditRecorder == nil {
+		return f, func() client.Object { return nil }
+	}
+
+	var before client.Object
+	mutate := func() error {
+		if len(object.GetResourceVersion()) > 0 {
+			before = object.DeepCopyObject().(client.Object)
+		}
+		if f == nil {
+			return nil
+		}
+		return f()
+	}
+	return mutate, func() client.Object { return before }
+}
+
+// observeWrite counts write conflicts and passes the write operation to the audit recorder if one is set.
+func observeWrite(ctx context.Context, writeID WriteID, msg string, object, before client.Object,
+	generationOld int64, resourceVersionOld string, err error) {
+	if apierrors.IsConflict(err) {
+		controllermetrics.ObserveWriteConflict(strings.TrimSuffix(actorFromContext(ctx), "-controller"),
+			object.GetNamespace(), kindOf(object))
+	}
+
+	recorder := auditRecorder
+	if recorder == nil || object.GetLabels()[WriteHistoryLabel] == "true" {
+		// the writes of the history configmaps are not recorded, as this would record them endlessly
+		return
+	}
+
+	if before != nil {
+		generationOld, resourceVersionOld = getGenerationAndResourceVersion(before)
+	}
+
+	rec := &WriteRecord{
+		Time:               metav1.Now(),
+		WriteID:            writeID,
+		Operation:          strings.TrimPrefix(msg, "history: "),
+		Kind:               kindOf(object),
+		Namespace:          object.GetNamespace(),
+		Name:               object.GetName(),
+		GenerationOld:      generationOld,
+		ResourceVersionOld: resourceVersionOld,
+		Actor:              actorFromContext(ctx),
+	}
+	if err != nil {
+		rec.Error = err.Error()
+	} else {
+		rec.GenerationNew, rec.ResourceVersionNew = getGenerationAndResourceVersion(object)
+		if before != nil {
+			rec.Changes = changedFields(before, object)
+		}
+	}
+
+	recorder.Record(ctx, object, rec)
+}
+
+func kindOf(object client.Object) string {
+	if kind := object.GetObjectKind().GroupVersionKind().Kind; len(kind) > 0 {
+		return kind
+	}
+	t := reflect.TypeOf(object)
+	if t.Kind() == reflect.Pointer {
+		t = t.Elem()
+	}
+	return t.Name()
+}
+
+// changedFields returns the changed labels, annotations, finalizers and owner references
+// as well as the changed top level fields of the spec and the status of an object.
+func changedFields(before, after client.Object) []string {
+	changes := []string{}
+	if !reflect.DeepEqual(before.GetLabels(), after.GetLabels()) {
+		changes = append(changes, "metadata.labels")
+	}
+	if !reflect.DeepEqual(before.GetAnnotations(), after.GetAnnotations()) {
+		changes = append(changes, "metadata.annotations")
+	}
+	if !reflect.DeepEqual(before.GetFinalizers(), after.GetFinalizers()) {
+		changes = append(changes, "metadata.finalizers")
+	}
+	if !reflect.DeepEqual(before.GetOwnerReferences(), after.GetOwnerReferences()) {
+		changes = append(changes, "metadata.ownerReferences")
+	}
+
+	beforeMap, err := runtime.DefaultUnstructuredConverter.ToUnstructured(before)
+	if err != nil {
+		return changes
+	}
+	afterMap, err := runtime.DefaultUnstructuredConverter.ToUnstructured(after)
+	if err != nil {
+		return changes
+	}
+
+	for _, section := range []string{"spec", "status"} {
+		beforeSection, _ := beforeMap[section].(map[string]interface{})
+		afterSection, _ := afterMap[section].(map[string]interface{})
+		keys := map[string]struct{}{}
+		for k := range beforeSection {
+			keys[k] = struct{}{}
+		}
+		for k := range afterSection {
+			keys[k] = struct{}{}
+		}
+		sectionChanges := []string{}
+		for k := range keys {
+			if !reflect.DeepEqual(beforeSection[k], afterSection[k]) {
+				sectionChanges = append(sectionChanges, section+"."+k)
+			}
+		}
+		sort.Strings(sectionChanges)
+		changes = append(changes, sectionChanges...)
+	}
+
+	// the data of data objects is not part of a spec
+	if !reflect.DeepEqual(beforeMap["data"], afterMap["data"]) {
+		changes = append(changes, "data")
+	}
+	return changes
+}
+
+// EventAuditRecorder records every write operation as event of the written resource.
+type EventAuditRecorder struct {
+	recorder record.EventRecorder
+}
+
+// NewEventAuditRecorder creates a new audit recorder that emits events with the given event recorder.
+func NewEventAuditRecorder(recorder record.EventRecorder) *EventAuditRecorder {
+	return &EventAuditRecorder{recorder: recorder}
+}
+
+// Record implements the AuditRecorder interface.
+func (r *EventAuditRecorder) Record(_ context.Context, object client.Object, rec *WriteRecord) {
+	if rec.Error != "" {
+		r.recorder.Eventf(object, corev1.EventTypeWarning, WriteAuditReasonWriteFailed,
+			"%s %s by %s failed: %s", rec.Operation, rec.WriteID, rec.Actor, rec.Error)
+		return
+	}
+
+	msg := fmt.Sprintf("%s %s by %s: generation %d -> %d", rec.Operation, rec.WriteID, rec.Actor,
+		rec.GenerationOld, rec.GenerationNew)
+	if len(rec.Changes) > 0 {
+		msg = msg + ", changed: " + strings.Join(rec.Changes, ", ")
+	}
+	r.recorder.Event(object, corev1.EventTypeNormal, WriteAuditReasonWrite, msg)
+}
+
+// HistoryAuditRecorder records the write operations into a history configmap per written resource.
+// The configmaps are located in the namespace of the written resources and are owned by them,
+// so that they are garbage collected together with the resources.
+type HistoryAuditRecorder struct {
+	client     client.Client
+	maxEntries int
+}
+
+// NewHistoryAuditRecorder creates a new audit recorder that keeps the last maxEntries write operations
+// of every resource in a configmap.
+func NewHistoryAuditRecorder(c client.Client, maxEntries int) *HistoryAuditRecorder {
+	if maxEntries <= 0 {
+		maxEntries = DefaultWriteHistoryMaxEntries
+	}
+	return &HistoryAuditRecorder{client: c, maxEntries: maxEntries}
+}
+
+// Record implements the AuditRecorder interface.
+func (r *HistoryAuditRecorder) Record(ctx context.Context, object client.Object, rec *WriteRecord) {
+	if len(object.GetUID()) == 0 {
+		// the resource does not exist, e.g. because its creation failed
+		return
+	}
+
+	logger, ctx := logging.FromContextOrNew(ctx, nil)
+
+	err := retry.RetryOnConflict(retry.DefaultRetry, func() error {
+		return r.appendRecord(ctx, object, rec)
+	})
+	if err != nil {
+		logger.Error(err, "unable to record write operation in history configmap",
+			lc.KeyWriteID, rec.WriteID,
+			keyUpdatedResource, fmt.Sprintf("%s/%s", rec.Namespace, rec.Name))
+	}
+}
+
+func (r *HistoryAuditRecorder) appendRecord(ctx context.Context, object client.Object, rec *WriteRecord) error {
+	cm := &corev1.ConfigMap{}
+	key := client.ObjectKey{Namespace: object.GetNamespace(), Name: WriteHistoryConfigMapName(rec.Kind, object.GetName())}
+	exists := true
+	if err := GetConfigMap(ctx, r.client, key, cm, R000166); err != nil {
+		if !apierrors.IsNotFound(err) {
+			return err
+		}
+		exists = false
+		cm.Namespace = key.Namespace
+		cm.Name = key.Name
+		cm.Labels = map[string]string{WriteHistoryLabel: "true"}
+		cm.OwnerReferences = []metav1.OwnerReference{{
+			APIVersion: lsv1alpha1.SchemeGroupVersion.String(),
+			Kind:       rec.Kind,
+			Name:       object.GetName(),
+			UID:        object.GetUID(),
+		}}
+	}
+
+	history := []WriteRecord{}
+	if data, ok := cm.Data[WriteHistoryDataKey]; ok && len(data) > 0 {
+		if err := json.Unmarshal([]byte(data), &history); err != nil {
+			// a corrupted history is replaced
+			history = []WriteRecord{}
+		}
+	}
+	history = append(history, *rec)
+	if len(history) > r.maxEntries {
+		history = history[len(history)-r.maxEntries:]
+	}
+
+	data, err := json.Marshal(history)
+	if err != nil {
+		return err
+	}
+	if cm.Data == nil {
+		cm.Data = map[string]string{}
+	}
+	cm.Data[WriteHistoryDataKey] = string(data)
+
+	if !exists {
+		return NewWriter(r.client).CreateWriteHistoryConfigMap(ctx, W000184, cm)
+	}
+	return NewWriter(r.client).UpdateWriteHistoryConfigMap(ctx, W000185, cm)
+}
+
+// WriteHistoryConfigMapName returns the name of the configmap that contains the write history of a resource.
+func WriteHistoryConfigMapName(kind, name string) string {
+	cmName := fmt.Sprintf("%s-%s-write-history", strings.ToLower(kind), name)
+	if len(cmName) <= validation.DNS1123SubdomainMaxLength {
+		return cmName
+	}
+	h := sha1.Sum([]byte(cmName))
+	suffix := "-" + hex.EncodeToString(h[:])[:10] + "-write-history"
+	prefix := strings.TrimRight(cmName[:validation.DNS1123SubdomainMaxLength-len(suffix)], "-.")
+	return prefix + suffix
+}
+
+// MultiAuditRecorder passes the write operations to several audit recorders.
+type MultiAuditRecorder []AuditRecorder
+
+// Record implements the AuditRecorder interface.
+func (m MultiAuditRecorder) Record(ctx context.Context, object client.Object, rec *WriteRecord) {
+	for _, r := range m {
+		r.Record(ctx, object, rec)
+	}
+}
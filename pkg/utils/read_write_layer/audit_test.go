@@ -0,0 +1,209 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package read_write_layer_test
+
+import (
+	"context"
+	"encoding/json"
+	"strings"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/record"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	lsv1alpha1 "github.com/gardener/landscaper/apis/core/v1alpha1"
+	"github.com/gardener/landscaper/pkg/api"
+	"github.com/gardener/landscaper/pkg/utils/read_write_layer"
+)
+
+type recorderSpy struct {
+	records []*read_write_layer.WriteRecord
+}
+
+func (r *recorderSpy) Record(_ context.Context, _ client.Object, rec *read_write_layer.WriteRecord) {
+	r.records = append(r.records, rec)
+}
+
+var _ = Describe("Write audit", func() {
+
+	var (
+		ctx context.Context
+		spy *recorderSpy
+	)
+
+	BeforeEach(func() {
+		ctx = read_write_layer.ContextWithActor(context.Background(), "installations")
+		spy = &recorderSpy{}
+		read_write_layer.SetAuditRecorder(spy)
+	})
+
+	AfterEach(func() {
+		read_write_layer.SetAuditRecorder(nil)
+	})
+
+	It("should record the changed fields of a create-or-update operation", func() {
+		inst := &lsv1alpha1.Installation{}
+		inst.Name = "a"
+		inst.Namespace = "test"
+		inst.Spec.Context = "default"
+		lsClient := fake.NewClientBuilder().WithScheme(api.LandscaperScheme).WithObjects(inst).Build()
+
+		inst = &lsv1alpha1.Installation{}
+		inst.Name = "a"
+		inst.Namespace = "test"
+		_, err := read_write_layer.NewWriter(lsClient).CreateOrUpdateInstallation(ctx, read_write_layer.W000001, inst, func() error {
+			inst.Spec.Context = "other"
+			metav1.SetMetaDataAnnotation(&inst.ObjectMeta, "foo", "bar")
+			return nil
+		})
+		Expect(err).ToNot(HaveOccurred())
+
+		Expect(spy.records).To(HaveLen(1))
+		rec := spy.records[0]
+		Expect(rec.WriteID).To(Equal(read_write_layer.W000001))
+		Expect(rec.Operation).To(Equal("installation create or update"))
+		Expect(rec.Kind).To(Equal("Installation"))
+		Expect(rec.Namespace).To(Equal("test"))
+		Expect(rec.Name).To(Equal("a"))
+		Expect(rec.Actor).To(Equal("installations"))
+		Expect(rec.Changes).To(Equal([]string{"metadata.annotations", "spec.context"}))
+		Expect(rec.ResourceVersionNew).ToNot(Equal(rec.ResourceVersionOld))
+		Expect(rec.Error).To(BeEmpty())
+	})
+
+	It("should not record create-or-update operations without changes", func() {
+		inst := &lsv1alpha1.Installation{}
+		inst.Name = "a"
+		inst.Namespace = "test"
+		lsClient := fake.NewClientBuilder().WithScheme(api.LandscaperScheme).WithObjects(inst).Build()
+
+		_, err := read_write_layer.NewWriter(lsClient).CreateOrUpdateInstallation(ctx, read_write_layer.W000001, inst, func() error {
+			return nil
+		})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(spy.records).To(BeEmpty())
+	})
+
+	It("should record failed write operations", func() {
+		lsClient := fake.NewClientBuilder().WithScheme(api.LandscaperScheme).Build()
+
+		inst := &lsv1alpha1.Installation{}
+		inst.Name = "a"
+		inst.Namespace = "test"
+		err := read_write_layer.NewWriter(lsClient).DeleteInstallation(context.Background(), read_write_layer.W000002, inst)
+		Expect(err).To(HaveOccurred())
+
+		Expect(spy.records).To(HaveLen(1))
+		Expect(spy.records[0].Operation).To(Equal("installation delete"))
+		Expect(spy.records[0].Actor).To(Equal("unknown"))
+		Expect(spy.records[0].Error).ToNot(BeEmpty())
+	})
+
+	Context("EventAuditRecorder", func() {
+
+		It("should emit an event for every write operation", func() {
+			fakeRecorder := record.NewFakeRecorder(10)
+			recorder := read_write_layer.NewEventAuditRecorder(fakeRecorder)
+
+			inst := &lsv1alpha1.Installation{}
+			recorder.Record(ctx, inst, &read_write_layer.WriteRecord{
+				WriteID:       read_write_layer.W000001,
+				Operation:     "installation update",
+				GenerationOld: 1,
+				GenerationNew: 2,
+				Changes:       []string{"spec.imports"},
+				Actor:         "installations",
+			})
+			recorder.Record(ctx, inst, &read_write_layer.WriteRecord{
+				WriteID:   read_write_layer.W000002,
+				Operation: "installation status update",
+				Actor:     "installations",
+				Error:     "conflict",
+			})
+
+			Expect(<-fakeRecorder.Events).To(Equal("Normal Write installation update w000001 by installations: generation 1 -> 2, changed: spec.imports"))
+			Expect(<-fakeRecorder.Events).To(Equal("Warning WriteFailed installation status update w000002 by installations failed: conflict"))
+		})
+	})
+
+	Context("HistoryAuditRecorder", func() {
+
+		It("should keep the latest write operations in a configmap owned by the resource", func() {
+			lsClient := fake.NewClientBuilder().WithScheme(api.LandscaperScheme).Build()
+			recorder := read_write_layer.NewHistoryAuditRecorder(lsClient, 2)
+
+			inst := &lsv1alpha1.Installation{}
+			inst.Name = "a"
+			inst.Namespace = "test"
+			inst.UID = types.UID("abc")
+			for _, id := range []read_write_layer.WriteID{read_write_layer.W000001, read_write_layer.W000002, read_write_layer.W000003} {
+				recorder.Record(ctx, inst, &read_write_layer.WriteRecord{WriteID: id, Kind: "Installation", Namespace: "test", Name: "a"})
+			}
+
+			cm := &corev1.ConfigMap{}
+			Expect(lsClient.Get(ctx, client.ObjectKey{Namespace: "test", Name: "installation-a-write-history"}, cm)).To(Succeed())
+			Expect(cm.Labels).To(HaveKeyWithValue(read_write_layer.WriteHistoryLabel, "true"))
+			Expect(cm.OwnerReferences).To(HaveLen(1))
+			Expect(cm.OwnerReferences[0].UID).To(Equal(types.UID("abc")))
+			Expect(cm.OwnerReferences[0].Kind).To(Equal("Installation"))
+
+			history := []read_write_layer.WriteRecord{}
+			Expect(json.Unmarshal([]byte(cm.Data[read_write_layer.WriteHistoryDataKey]), &history)).To(Succeed())
+			Expect(history).To(HaveLen(2))
+			Expect(history[0].WriteID).To(Equal(read_write_layer.W000002))
+			Expect(history[1].WriteID).To(Equal(read_write_layer.W000003))
+		})
+
+		It("should not record the writes of the history configmaps themselves", func() {
+			lsClient := fake.NewClientBuilder().WithScheme(api.LandscaperScheme).Build()
+			read_write_layer.SetAuditRecorder(read_write_layer.NewHistoryAuditRecorder(lsClient, 0))
+
+			inst := &lsv1alpha1.Installation{}
+			inst.Name = "a"
+			inst.Namespace = "test"
+			inst.UID = types.UID("abc")
+			Expect(read_write_layer.NewWriter(lsClient).CreateOrUpdateInstallation(ctx, read_write_layer.W000001, inst, func() error {
+				inst.Spec.Context = "default"
+				return nil
+			})).Error().ToNot(HaveOccurred())
+			inst.Spec.Context = "other"
+			Expect(read_write_layer.NewWriter(lsClient).UpdateInstallation(ctx, read_write_layer.W000002, inst)).To(Succeed())
+
+			cmList := &corev1.ConfigMapList{}
+			Expect(lsClient.List(ctx, cmList)).To(Succeed())
+			Expect(cmList.Items).To(HaveLen(1))
+			history := []read_write_layer.WriteRecord{}
+			Expect(json.Unmarshal([]byte(cmList.Items[0].Data[read_write_layer.WriteHistoryDataKey]), &history)).To(Succeed())
+			Expect(history).To(HaveLen(2))
+		})
+
+		It("should not record write operations of resources that do not exist", func() {
+			lsClient := fake.NewClientBuilder().WithScheme(api.LandscaperScheme).Build()
+			recorder := read_write_layer.NewHistoryAuditRecorder(lsClient, 0)
+
+			inst := &lsv1alpha1.Installation{}
+			inst.Name = "a"
+			inst.Namespace = "test"
+			recorder.Record(ctx, inst, &read_write_layer.WriteRecord{WriteID: read_write_layer.W000001, Kind: "Installation"})
+
+			cmList := &corev1.ConfigMapList{}
+			Expect(lsClient.List(ctx, cmList)).To(Succeed())
+			Expect(cmList.Items).To(BeEmpty())
+		})
+	})
+
+	It("should shorten long names of history configmaps", func() {
+		name := read_write_layer.WriteHistoryConfigMapName("DeployItem", strings.Repeat("a", 253))
+		Expect(len(name)).To(BeNumerically("<=", 253))
+		Expect(name).To(HavePrefix("deployitem-aaa"))
+		Expect(name).To(HaveSuffix("-write-history"))
+		Expect(name).ToNot(Equal(read_write_layer.WriteHistoryConfigMapName("DeployItem", strings.Repeat("a", 252))))
+	})
+})
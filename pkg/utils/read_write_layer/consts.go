@@ -153,6 +153,43 @@ const (
 	W000147 WriteID = "w000147"
 	W000148 WriteID = "w000148"
 	W000149 WriteID = "w000149"
+	W000150 WriteID = "w000150"
+	W000151 WriteID = "w000151"
+	W000152 WriteID = "w000152"
+	W000153 WriteID = "w000153"
+	W000154 WriteID = "w000154"
+	W000155 WriteID = "w000155"
+	W000156 WriteID = "w000156"
+	W000157 WriteID = "w000157"
+	W000158 WriteID = "w000158"
+	W000159 WriteID = "w000159"
+	W000160 WriteID = "w000160"
+	W000161 WriteID = "w000161"
+	W000162 WriteID = "w000162"
+	W000163 WriteID = "w000163"
+	W000164 WriteID = "w000164"
+	W000165 WriteID = "w000165"
+	W000166 WriteID = "w000166"
+	W000167 WriteID = "w000167"
+	W000168 WriteID = "w000168"
+	W000169 WriteID = "w000169"
+	W000170 WriteID = "w000170"
+	W000171 WriteID = "w000171"
+	W000172 WriteID = "w000172"
+	W000173 WriteID = "w000173"
+	W000174 WriteID = "w000174"
+	W000175 WriteID = "w000175"
+	W000176 WriteID = "w000176"
+	W000177 WriteID = "w000177"
+	W000178 WriteID = "w000178"
+	W000179 WriteID = "w000179"
+	W000180 WriteID = "w000180"
+	W000181 WriteID = "w000181"
+	W000182 WriteID = "w000182"
+	W000183 WriteID = "w000183"
+	W000184 WriteID = "w000184"
+	W000185 WriteID = "w000185"
+	W000186 WriteID = "w000186"
 )
 
 type ReadID string
@@ -270,11 +307,73 @@ const (
 	R000108 ReadID = "r000108"
 	R000109 ReadID = "r000109"
 	R000110 ReadID = "r000110"
+	R000111 ReadID = "r000111"
+	R000112 ReadID = "r000112"
+	R000113 ReadID = "r000113"
+	R000114 ReadID = "r000114"
+	R000115 ReadID = "r000115"
+	R000116 ReadID = "r000116"
+	R000117 ReadID = "r000117"
+	R000118 ReadID = "r000118"
+	R000119 ReadID = "r000119"
+	R000120 ReadID = "r000120"
+	R000121 ReadID = "r000121"
+	R000122 ReadID = "r000122"
+	R000123 ReadID = "r000123"
+	R000124 ReadID = "r000124"
+	R000125 ReadID = "r000125"
+	R000126 ReadID = "r000126"
+	R000127 ReadID = "r000127"
+	R000128 ReadID = "r000128"
+	R000129 ReadID = "r000129"
+	R000130 ReadID = "r000130"
+	R000131 ReadID = "r000131"
+	R000132 ReadID = "r000132"
+	R000133 ReadID = "r000133"
+	R000134 ReadID = "r000134"
+	R000135 ReadID = "r000135"
+	R000136 ReadID = "r000136"
+	R000137 ReadID = "r000137"
+	R000138 ReadID = "r000138"
+	R000139 ReadID = "r000139"
+	R000140 ReadID = "r000140"
+	R000141 ReadID = "r000141"
+	R000142 ReadID = "r000142"
+	R000143 ReadID = "r000143"
+	R000144 ReadID = "r000144"
+	R000145 ReadID = "r000145"
+	R000146 ReadID = "r000146"
+	R000147 ReadID = "r000147"
+	R000148 ReadID = "r000148"
+	R000149 ReadID = "r000149"
+	R000150 ReadID = "r000150"
+	R000151 ReadID = "r000151"
+	R000152 ReadID = "r000152"
+	R000153 ReadID = "r000153"
+	R000154 ReadID = "r000154"
+	R000155 ReadID = "r000155"
+	R000156 ReadID = "r000156"
+	R000157 ReadID = "r000157"
+	R000158 ReadID = "r000158"
+	R000159 ReadID = "r000159"
+	R000160 ReadID = "r000160"
+	R000161 ReadID = "r000161"
+	R000162 ReadID = "r000162"
+	R000163 ReadID = "r000163"
+	R000164 ReadID = "r000164"
+	R000165 ReadID = "r000165"
+	R000166 ReadID = "r000166"
+	R000167 ReadID = "r000167"
+	R000168 ReadID = "r000168"
+	R000169 ReadID = "r000169"
+	R000170 ReadID = "r000170"
+	R000171 ReadID = "r000171"
 )
 
 const (
 	opContextCreateOrUpdate = "history: context create or update"
 	opDOCreateOrUpdate      = "history: dataobject create or update"
+	opDOSpec                = "history: dataobject update"
 	opInstCreateOrUpdate    = "history: installation create or update"
 	opInstSpec              = "history: installation update"
 	opInstStatus            = "history: installation status update"
@@ -292,4 +391,11 @@ const (
 	opSyncObjectCreate      = "history: syncobject create"
 	opSyncObjectSpec        = "history: syncobject update"
 	opSyncObjectDelete      = "history: syncobject delete"
+
+	opDeployerRegistrationStatus = "history: deployer registration status update"
+	opLandscapeHealthStatus      = "history: landscape health status update"
+	opTestRunSpec                = "history: testrun update"
+	opTestRunStatus              = "history: testrun status update"
+	opWriteHistoryCreate         = "history: write history configmap create"
+	opWriteHistoryUpdate         = "history: write history configmap update"
 )
@@ -0,0 +1,91 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package read_write_layer
+
+import (
+	"context"
+	"fmt"
+
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+
+	lsv1alpha1 "github.com/gardener/landscaper/apis/core/v1alpha1"
+)
+
+// Encryptor encrypts and decrypts sensitive data at rest.
+// Decrypt must return data that is not encrypted as it is.
+type Encryptor interface {
+	Encrypt(ctx context.Context, data []byte) ([]byte, error)
+	Decrypt(ctx context.Context, data []byte) ([]byte, error)
+}
+
+var deployItemEncryptor Encryptor
+
+// SetDeployItemEncryptor sets the encryptor for the configuration of deploy items.
+// The configuration is encrypted by all write operations of the read-write layer and decrypted by all read operations,
+// so that callers only work with the plain configuration.
+// The encryption is disabled if the encryptor is nil.
+func SetDeployItemEncryptor(encryptor Encryptor) {
+	deployItemEncryptor = encryptor
+}
+
+// encryptDeployItem encrypts the configuration of a deploy item before it is written.
+func encryptDeployItem(ctx context.Context, deployItem *lsv1alpha1.DeployItem) error {
+	if deployItemEncryptor == nil {
+		return nil
+	}
+	return transformDeployItemConfiguration(ctx, deployItem, "encrypt", deployItemEncryptor.Encrypt)
+}
+
+// decryptDeployItem decrypts the configuration of a deploy item after it has been read or written.
+func decryptDeployItem(ctx context.Context, deployItem *lsv1alpha1.DeployItem) error {
+	if deployItemEncryptor == nil {
+		return nil
+	}
+	return transformDeployItemConfiguration(ctx, deployItem, "decrypt", deployItemEncryptor.Decrypt)
+}
+
+func transformDeployItemConfiguration(ctx context.Context, deployItem *lsv1alpha1.DeployItem, operation string,
+	transform func(ctx context.Context, data []byte) ([]byte, error)) error {
+
+	if deployItem.Spec.Configuration == nil || len(deployItem.Spec.Configuration.Raw) == 0 {
+		return nil
+	}
+	data, err := transform(ctx, deployItem.Spec.Configuration.Raw)
+	if err != nil {
+		return fmt.Errorf("unable to %s the configuration of deploy item %s/%s: %w",
+			operation, deployItem.Namespace, deployItem.Name, err)
+	}
+	deployItem.Spec.Configuration = &runtime.RawExtension{Raw: data}
+	return nil
+}
+
+// decryptDeployItems decrypts the configuration of all deploy items of a list.
+func decryptDeployItems(ctx context.Context, deployItems *lsv1alpha1.DeployItemList) error {
+	for i := range deployItems.Items {
+		if err := decryptDeployItem(ctx, &deployItems.Items[i]); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// encryptingMutateFn wraps the mutate function of a create-or-update operation of a deploy item.
+// The configuration of the existing deploy item is decrypted before, and the configuration of the mutated
+// deploy item is encrypted after the mutate function, so that an unchanged configuration keeps its encrypted form.
+func encryptingMutateFn(ctx context.Context, deployItem *lsv1alpha1.DeployItem, f controllerutil.MutateFn) controllerutil.MutateFn {
+	if deployItemEncryptor == nil {
+		return f
+	}
+	return func() error {
+		if err := decryptDeployItem(ctx, deployItem); err != nil {
+			return err
+		}
+		if err := f(); err != nil {
+			return err
+		}
+		return encryptDeployItem(ctx, deployItem)
+	}
+}
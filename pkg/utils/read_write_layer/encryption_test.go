@@ -0,0 +1,122 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package read_write_layer_test
+
+import (
+	"context"
+	"encoding/json"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	lsv1alpha1 "github.com/gardener/landscaper/apis/core/v1alpha1"
+	"github.com/gardener/landscaper/pkg/api"
+	"github.com/gardener/landscaper/pkg/utils/read_write_layer"
+)
+
+// wrappingEncryptor wraps the data into a json document instead of encrypting it.
+type wrappingEncryptor struct{}
+
+type wrappedData struct {
+	Encrypted []byte `json:"encrypted"`
+}
+
+func (wrappingEncryptor) Encrypt(_ context.Context, data []byte) ([]byte, error) {
+	return json.Marshal(wrappedData{Encrypted: data})
+}
+
+func (wrappingEncryptor) Decrypt(_ context.Context, data []byte) ([]byte, error) {
+	wrapped := &wrappedData{}
+	if err := json.Unmarshal(data, wrapped); err != nil || len(wrapped.Encrypted) == 0 {
+		return data, nil
+	}
+	return wrapped.Encrypted, nil
+}
+
+var _ = Describe("Deploy item encryption", func() {
+
+	const config = `{"key":"val"}`
+
+	var (
+		ctx      context.Context
+		lsClient client.Client
+	)
+
+	BeforeEach(func() {
+		ctx = context.Background()
+		lsClient = fake.NewClientBuilder().WithScheme(api.LandscaperScheme).Build()
+	})
+
+	AfterEach(func() {
+		read_write_layer.SetDeployItemEncryptor(nil)
+	})
+
+	writeDeployItem := func() *lsv1alpha1.DeployItem {
+		di := &lsv1alpha1.DeployItem{}
+		di.Name = "a"
+		di.Namespace = "test"
+		_, err := read_write_layer.NewWriter(lsClient).CreateOrUpdateDeployItem(ctx, read_write_layer.W000001, di, func() error {
+			di.Spec.Configuration = &runtime.RawExtension{Raw: []byte(config)}
+			return nil
+		})
+		Expect(err).ToNot(HaveOccurred())
+		return di
+	}
+
+	storedConfiguration := func() string {
+		di := &lsv1alpha1.DeployItem{}
+		Expect(lsClient.Get(ctx, client.ObjectKey{Namespace: "test", Name: "a"}, di)).To(Succeed())
+		return string(di.Spec.Configuration.Raw)
+	}
+
+	It("should neither encrypt nor decrypt the configuration if no encryptor is set", func() {
+		read_write_layer.SetDeployItemEncryptor(nil)
+
+		di := writeDeployItem()
+		Expect(string(di.Spec.Configuration.Raw)).To(Equal(config))
+		Expect(storedConfiguration()).To(Equal(config))
+
+		di.Spec.Configuration = &runtime.RawExtension{Raw: []byte(`{"key":"other"}`)}
+		Expect(read_write_layer.NewWriter(lsClient).UpdateDeployItem(ctx, read_write_layer.W000002, di)).To(Succeed())
+		Expect(storedConfiguration()).To(Equal(`{"key":"other"}`))
+
+		read := &lsv1alpha1.DeployItem{}
+		Expect(read_write_layer.GetDeployItem(ctx, lsClient, client.ObjectKeyFromObject(di), read, read_write_layer.R000001)).To(Succeed())
+		Expect(string(read.Spec.Configuration.Raw)).To(Equal(`{"key":"other"}`))
+
+		list := &lsv1alpha1.DeployItemList{}
+		Expect(read_write_layer.ListDeployItems(ctx, lsClient, list, read_write_layer.R000002)).To(Succeed())
+		Expect(list.Items).To(HaveLen(1))
+		Expect(string(list.Items[0].Spec.Configuration.Raw)).To(Equal(`{"key":"other"}`))
+	})
+
+	It("should handle deploy items without configuration if no encryptor is set", func() {
+		read_write_layer.SetDeployItemEncryptor(nil)
+
+		di := &lsv1alpha1.DeployItem{}
+		di.Name = "a"
+		di.Namespace = "test"
+		_, err := read_write_layer.NewWriter(lsClient).CreateOrUpdateDeployItem(ctx, read_write_layer.W000001, di, func() error {
+			return nil
+		})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(di.Spec.Configuration).To(BeNil())
+	})
+
+	It("should store the encrypted and return the decrypted configuration if an encryptor is set", func() {
+		read_write_layer.SetDeployItemEncryptor(wrappingEncryptor{})
+
+		di := writeDeployItem()
+		Expect(string(di.Spec.Configuration.Raw)).To(Equal(config))
+		Expect(storedConfiguration()).ToNot(ContainSubstring("val"))
+
+		read := &lsv1alpha1.DeployItem{}
+		Expect(read_write_layer.GetDeployItem(ctx, lsClient, client.ObjectKeyFromObject(di), read, read_write_layer.R000001)).To(Succeed())
+		Expect(string(read.Spec.Configuration.Raw)).To(Equal(config))
+	})
+})
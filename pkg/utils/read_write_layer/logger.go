@@ -5,6 +5,7 @@ import (
 	"fmt"
 
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	lsv1alpha1 "github.com/gardener/landscaper/apis/core/v1alpha1"
 	lsv1alpha1helper "github.com/gardener/landscaper/apis/core/v1alpha1/helper"
@@ -27,6 +28,36 @@ func (w *Writer) getLogger(ctx context.Context, keysAndValues ...interface{}) lo
 	return log
 }
 
+// logObjectUpdate logs the update of an object for which no specific log method exists.
+func (w *Writer) logObjectUpdate(ctx context.Context, writeID WriteID, msg string, object client.Object,
+	generationOld int64, resourceVersionOld string, err error) {
+	logger := w.getLogger(ctx, keyUpdatedResource, fmt.Sprintf("%s/%s", object.GetNamespace(), object.GetName()))
+
+	if err == nil {
+		generationNew, resourceVersionNew := getGenerationAndResourceVersion(object)
+		logger.Log(historyLogLevel, msg,
+			lc.KeyWriteID, writeID,
+			lc.KeyGenerationOld, generationOld,
+			lc.KeyGenerationNew, generationNew,
+			lc.KeyResourceVersionOld, resourceVersionOld,
+			lc.KeyResourceVersionNew, resourceVersionNew,
+		)
+	} else if apierrors.IsConflict(err) {
+		message := msg + ": " + err.Error()
+		logger.Info(message,
+			lc.KeyWriteID, writeID,
+			lc.KeyGenerationOld, generationOld,
+			lc.KeyResourceVersionOld, resourceVersionOld,
+		)
+	} else {
+		logger.Error(err, msg,
+			lc.KeyWriteID, writeID,
+			lc.KeyGenerationOld, generationOld,
+			lc.KeyResourceVersionOld, resourceVersionOld,
+		)
+	}
+}
+
 func (w *Writer) logContextUpdate(ctx context.Context, writeID WriteID, msg string, con *lsv1alpha1.Context,
 	generationOld int64, resourceVersionOld string, err error) {
 	logger := w.getLogger(ctx, keyUpdatedResource, fmt.Sprintf("%s/%s", con.Namespace, con.Name))
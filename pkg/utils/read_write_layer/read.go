@@ -50,7 +50,10 @@ func ListExecutions(ctx context.Context, c client.Reader, executions *lsv1alpha1
 
 // read methods for deploy items
 func GetDeployItem(ctx context.Context, c client.Reader, key client.ObjectKey, deployItem *lsv1alpha1.DeployItem, readID ReadID) error {
-	return get(ctx, c, key, deployItem, readID, "deployItem")
+	if err := get(ctx, c, key, deployItem, readID, "deployItem"); err != nil {
+		return err
+	}
+	return decryptDeployItem(ctx, deployItem)
 }
 
 func ListManagedDeployItems(ctx context.Context, c client.Reader, execKey client.ObjectKey, readID ReadID) (*lsv1alpha1.DeployItemList, error) {
@@ -65,7 +68,10 @@ func ListManagedDeployItems(ctx context.Context, c client.Reader, execKey client
 }
 
 func ListDeployItems(ctx context.Context, c client.Reader, deployItems *lsv1alpha1.DeployItemList, readID ReadID, opts ...client.ListOption) error {
-	return list(ctx, c, deployItems, readID, "deployItems", opts...)
+	if err := list(ctx, c, deployItems, readID, "deployItems", opts...); err != nil {
+		return err
+	}
+	return decryptDeployItems(ctx, deployItems)
 }
 
 // read methods for target
@@ -80,6 +86,10 @@ func ListTargets(ctx context.Context, c client.Reader, targets *lsv1alpha1.Targe
 
 // read methods for data objects
 
+func GetDataObject(ctx context.Context, c client.Reader, key client.ObjectKey, dataObject *lsv1alpha1.DataObject, readID ReadID) error {
+	return get(ctx, c, key, dataObject, readID, "dataObject")
+}
+
 func ListDataObjects(ctx context.Context, c client.Reader, dataObjects *lsv1alpha1.DataObjectList, readID ReadID, opts ...client.ListOption) error {
 	return list(ctx, c, dataObjects, readID, "dataObjects", opts...)
 }
@@ -90,6 +100,24 @@ func ListTargetSyncs(ctx context.Context, c client.Reader, targetSyncs *lsv1alph
 	return list(ctx, c, targetSyncs, readID, "targetSyncs", opts...)
 }
 
+// read methods for deployer registrations
+
+func ListDeployerRegistrations(ctx context.Context, c client.Reader, registrations *lsv1alpha1.DeployerRegistrationList, readID ReadID, opts ...client.ListOption) error {
+	return list(ctx, c, registrations, readID, "deployerRegistrations", opts...)
+}
+
+// read methods for target type definitions
+
+func ListTargetTypeDefinitions(ctx context.Context, c client.Reader, definitions *lsv1alpha1.TargetTypeDefinitionList, readID ReadID, opts ...client.ListOption) error {
+	return list(ctx, c, definitions, readID, "targetTypeDefinitions", opts...)
+}
+
+// read methods for landscape healths
+
+func ListLandscapeHealths(ctx context.Context, c client.Reader, healths *lsv1alpha1.LandscapeHealthList, readID ReadID, opts ...client.ListOption) error {
+	return list(ctx, c, healths, readID, "landscapeHealths", opts...)
+}
+
 // read methods for secret
 
 func GetSecret(ctx context.Context, c client.Reader, key client.ObjectKey, secret *v1.Secret, readID ReadID) error {
@@ -100,6 +128,12 @@ func ListSecrets(ctx context.Context, c client.Reader, secrets *v1.SecretList, r
 	return list(ctx, c, secrets, readID, "secrets", opts...)
 }
 
+// read methods for configmap
+
+func GetConfigMap(ctx context.Context, c client.Reader, key client.ObjectKey, configMap *v1.ConfigMap, readID ReadID) error {
+	return get(ctx, c, key, configMap, readID, "configMap")
+}
+
 // read methods for health checks
 
 func GetHealthCheck(ctx context.Context, c client.Reader, key client.ObjectKey, health *lsv1alpha1.LsHealthCheck, readID ReadID) error {
@@ -129,6 +163,16 @@ func ListPods(ctx context.Context, c client.Reader, pods *v1.PodList, readID Rea
 	return list(ctx, c, pods, readID, "pods", opts...)
 }
 
+// read methods for events
+func ListEvents(ctx context.Context, c client.Reader, events *v1.EventList, readID ReadID, opts ...client.ListOption) error {
+	return list(ctx, c, events, readID, "events", opts...)
+}
+
+// read methods for resource quotas
+func ListResourceQuotas(ctx context.Context, c client.Reader, quotas *v1.ResourceQuotaList, readID ReadID, opts ...client.ListOption) error {
+	return list(ctx, c, quotas, readID, "resourceQuotas", opts...)
+}
+
 // read methods for namespaces
 func ListNamespaces(ctx context.Context, c client.Reader, namespaces *v1.NamespaceList, readID ReadID, opts ...client.ListOption) error {
 	return list(ctx, c, namespaces, readID, "namespaces", opts...)
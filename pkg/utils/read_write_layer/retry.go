@@ -0,0 +1,92 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package read_write_layer
+
+import (
+	"context"
+	"errors"
+
+	"k8s.io/client-go/util/retry"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	lsv1alpha1 "github.com/gardener/landscaper/apis/core/v1alpha1"
+	"github.com/gardener/landscaper/controller-utils/pkg/logging"
+	lc "github.com/gardener/landscaper/controller-utils/pkg/logging/constants"
+)
+
+// ConflictRetryBackoff defines how often and with which delays a write is retried after a conflict.
+var ConflictRetryBackoff = retry.DefaultRetry
+
+// ErrPreconditionChanged is returned by a MutateFn if the latest version of an object does not fulfill
+// the preconditions of the mutation anymore. The object is not written and the caller has to reevaluate it,
+// e.g. by requeuing it.
+var ErrPreconditionChanged = errors.New("the object has changed and does not fulfill the preconditions of the mutation anymore")
+
+// MutateFn applies a change to an object before it is written.
+// It must only depend on the given object, because it is applied again to the latest version of the object
+// if the write fails with a conflict. If the change has been decided based on the state of an older version,
+// the mutation has to check that the decision is still valid for the given object,
+// and return ErrPreconditionChanged otherwise.
+type MutateFn[T client.Object] func(object T) error
+
+// WriteFn writes an object.
+type WriteFn[T client.Object] func(ctx context.Context, object T) error
+
+// RetryOnConflict applies the mutation to the object and writes it.
+// If the write fails with a conflict, the latest version of the object is read into the given object,
+// the mutation is applied again and the write is retried, until the retries of ConflictRetryBackoff are exhausted.
+// Other errors, including errors of the mutation, are returned immediately.
+func RetryOnConflict[T client.Object](ctx context.Context, c client.Reader, readID ReadID, object T,
+	mutate MutateFn[T], write WriteFn[T]) error {
+
+	key := client.ObjectKeyFromObject(object)
+	attempt := 0
+	return retry.RetryOnConflict(ConflictRetryBackoff, func() error {
+		if attempt > 0 {
+			logger, _ := logging.FromContextOrNew(ctx, nil)
+			logger.Debug("retrying write after conflict", keyFetchedResource, key.String(), lc.KeyReadID, readID,
+				"attempt", attempt)
+			if err := GetObject(ctx, c, key, object, readID); err != nil {
+				return err
+			}
+		}
+		attempt++
+
+		if err := mutate(object); err != nil {
+			return err
+		}
+		return write(ctx, object)
+	})
+}
+
+// UpdateInstallationStatusWithRetry applies the mutation to the installation and updates its status.
+// Conflicts are retried with the latest version of the installation, see RetryOnConflict.
+func (w *Writer) UpdateInstallationStatusWithRetry(ctx context.Context, writeID WriteID, readID ReadID,
+	installation *lsv1alpha1.Installation, mutate MutateFn[*lsv1alpha1.Installation]) error {
+	return RetryOnConflict(ctx, w.client, readID, installation, mutate,
+		func(ctx context.Context, installation *lsv1alpha1.Installation) error {
+			return w.UpdateInstallationStatus(ctx, writeID, installation)
+		})
+}
+
+// UpdateExecutionStatusWithRetry applies the mutation to the execution and updates its status.
+// Conflicts are retried with the latest version of the execution, see RetryOnConflict.
+func (w *Writer) UpdateExecutionStatusWithRetry(ctx context.Context, writeID WriteID, readID ReadID,
+	execution *lsv1alpha1.Execution, mutate MutateFn[*lsv1alpha1.Execution]) error {
+	return RetryOnConflict(ctx, w.client, readID, execution, mutate,
+		func(ctx context.Context, execution *lsv1alpha1.Execution) error {
+			return w.UpdateExecutionStatus(ctx, writeID, execution)
+		})
+}
+
+// UpdateDeployItemStatusWithRetry applies the mutation to the deploy item and updates its status.
+// Conflicts are retried with the latest version of the deploy item, see RetryOnConflict.
+func (w *Writer) UpdateDeployItemStatusWithRetry(ctx context.Context, writeID WriteID, readID ReadID,
+	deployItem *lsv1alpha1.DeployItem, mutate MutateFn[*lsv1alpha1.DeployItem]) error {
+	return RetryOnConflict(ctx, w.client, readID, deployItem, mutate,
+		func(ctx context.Context, deployItem *lsv1alpha1.DeployItem) error {
+			return w.UpdateDeployItemStatus(ctx, writeID, deployItem)
+		})
+}
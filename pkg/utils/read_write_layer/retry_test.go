@@ -0,0 +1,126 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package read_write_layer_test
+
+import (
+	"context"
+	"errors"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	lsv1alpha1 "github.com/gardener/landscaper/apis/core/v1alpha1"
+	"github.com/gardener/landscaper/pkg/api"
+	"github.com/gardener/landscaper/pkg/utils/read_write_layer"
+)
+
+var _ = Describe("Retry on conflict", func() {
+
+	var (
+		ctx      context.Context
+		lsClient client.Client
+		writer   *read_write_layer.Writer
+	)
+
+	BeforeEach(func() {
+		ctx = context.Background()
+		inst := &lsv1alpha1.Installation{}
+		inst.Name = "a"
+		inst.Namespace = "test"
+		lsClient = fake.NewClientBuilder().WithScheme(api.LandscaperScheme).
+			WithObjects(inst).WithStatusSubresource(inst).Build()
+		writer = read_write_layer.NewWriter(lsClient)
+	})
+
+	getInstallation := func() *lsv1alpha1.Installation {
+		inst := &lsv1alpha1.Installation{}
+		Expect(lsClient.Get(ctx, client.ObjectKey{Name: "a", Namespace: "test"}, inst)).To(Succeed())
+		return inst
+	}
+
+	It("should apply the mutation again to the latest version after a conflict", func() {
+		outdated := getInstallation()
+
+		latest := getInstallation()
+		latest.Status.JobID = "job-1"
+		Expect(lsClient.Status().Update(ctx, latest)).To(Succeed())
+
+		calls := 0
+		err := writer.UpdateInstallationStatusWithRetry(ctx, read_write_layer.W000001, read_write_layer.R000001, outdated,
+			func(inst *lsv1alpha1.Installation) error {
+				calls++
+				inst.Status.JobIDFinished = "job-0"
+				return nil
+			})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(calls).To(Equal(2))
+		Expect(outdated.Status.JobID).To(Equal("job-1"))
+
+		inst := getInstallation()
+		Expect(inst.Status.JobID).To(Equal("job-1"))
+		Expect(inst.Status.JobIDFinished).To(Equal("job-0"))
+	})
+
+	It("should write only once without conflict", func() {
+		calls := 0
+		err := writer.UpdateInstallationStatusWithRetry(ctx, read_write_layer.W000001, read_write_layer.R000001, getInstallation(),
+			func(inst *lsv1alpha1.Installation) error {
+				calls++
+				inst.Status.JobID = "job-1"
+				return nil
+			})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(calls).To(Equal(1))
+		Expect(getInstallation().Status.JobID).To(Equal("job-1"))
+	})
+
+	It("should return the error of the mutation without writing", func() {
+		mutateErr := errors.New("mutation failed")
+		err := writer.UpdateInstallationStatusWithRetry(ctx, read_write_layer.W000001, read_write_layer.R000001, getInstallation(),
+			func(inst *lsv1alpha1.Installation) error {
+				inst.Status.JobID = "job-1"
+				return mutateErr
+			})
+		Expect(err).To(MatchError(mutateErr))
+		Expect(getInstallation().Status.JobID).To(BeEmpty())
+	})
+
+	It("should not write if the preconditions of the mutation have changed after a conflict", func() {
+		outdated := getInstallation()
+
+		latest := getInstallation()
+		latest.Status.JobID = "job-1"
+		Expect(lsClient.Status().Update(ctx, latest)).To(Succeed())
+
+		err := writer.UpdateInstallationStatusWithRetry(ctx, read_write_layer.W000001, read_write_layer.R000001, outdated,
+			func(inst *lsv1alpha1.Installation) error {
+				if inst.Status.JobID != inst.Status.JobIDFinished {
+					return read_write_layer.ErrPreconditionChanged
+				}
+				inst.Status.JobID = "job-2"
+				return nil
+			})
+		Expect(errors.Is(err, read_write_layer.ErrPreconditionChanged)).To(BeTrue())
+		Expect(getInstallation().Status.JobID).To(Equal("job-1"))
+	})
+
+	It("should not retry other errors than conflicts", func() {
+		inst := &lsv1alpha1.Installation{}
+		inst.Name = "missing"
+		inst.Namespace = "test"
+
+		calls := 0
+		err := writer.UpdateInstallationStatusWithRetry(ctx, read_write_layer.W000001, read_write_layer.R000001, inst,
+			func(inst *lsv1alpha1.Installation) error {
+				calls++
+				return nil
+			})
+		Expect(apierrors.IsNotFound(err)).To(BeTrue())
+		Expect(calls).To(Equal(1))
+	})
+})
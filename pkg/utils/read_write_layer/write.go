@@ -10,6 +10,7 @@ import (
 	"github.com/gardener/landscaper/controller-utils/pkg/logging"
 	lc "github.com/gardener/landscaper/controller-utils/pkg/logging/constants"
 
+	corev1 "k8s.io/api/core/v1"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 
 	"github.com/gardener/landscaper/apis/errors"
@@ -99,6 +100,13 @@ func (w *Writer) CreateOrUpdateDataObject(ctx context.Context, writeID WriteID,
 	return result, errorWithWriteID(err, writeID)
 }
 
+func (w *Writer) UpdateDataObject(ctx context.Context, writeID WriteID, do *lsv1alpha1.DataObject) error {
+	generationOld, resourceVersionOld := getGenerationAndResourceVersion(do)
+	err := update(ctx, w.client, do, writeID, opDOSpec)
+	w.logDataObjectUpdate(ctx, writeID, opDOSpec, do, generationOld, resourceVersionOld, err)
+	return errorWithWriteID(err, writeID)
+}
+
 func (w *Writer) DeleteDataObject(ctx context.Context, writeID WriteID, do *lsv1alpha1.DataObject) error {
 	generationOld, resourceVersionOld := getGenerationAndResourceVersion(do)
 	err := delete(ctx, w.client, do, writeID, opInstDelete)
@@ -181,22 +189,37 @@ func (w *Writer) DeleteExecution(ctx context.Context, writeID WriteID, execution
 func (w *Writer) CreateOrUpdateDeployItem(ctx context.Context, writeID WriteID, deployItem *lsv1alpha1.DeployItem,
 	f controllerutil.MutateFn) (controllerutil.OperationResult, error) {
 	generationOld, resourceVersionOld := getGenerationAndResourceVersion(deployItem)
-	result, err := createOrUpdateKubernetes(ctx, w.client, deployItem, f, writeID, opDICreateOrUpdate)
+	result, err := createOrUpdateKubernetes(ctx, w.client, deployItem, encryptingMutateFn(ctx, deployItem, f), writeID, opDICreateOrUpdate)
 	w.logDeployItemUpdate(ctx, writeID, opDICreateOrUpdate, deployItem, generationOld, resourceVersionOld, err)
+	if decryptErr := decryptDeployItem(ctx, deployItem); err == nil {
+		err = decryptErr
+	}
 	return result, errorWithWriteID(err, writeID)
 }
 
 func (w *Writer) UpdateDeployItem(ctx context.Context, writeID WriteID, deployItem *lsv1alpha1.DeployItem) error {
 	generationOld, resourceVersionOld := getGenerationAndResourceVersion(deployItem)
+	if err := encryptDeployItem(ctx, deployItem); err != nil {
+		return errorWithWriteID(err, writeID)
+	}
 	err := update(ctx, w.client, deployItem, writeID, opDISpec)
 	w.logDeployItemUpdate(ctx, writeID, opDISpec, deployItem, generationOld, resourceVersionOld, err)
+	if decryptErr := decryptDeployItem(ctx, deployItem); err == nil {
+		err = decryptErr
+	}
 	return errorWithWriteID(err, writeID)
 }
 
 func (w *Writer) UpdateDeployItemStatus(ctx context.Context, writeID WriteID, deployItem *lsv1alpha1.DeployItem) error {
 	generationOld, resourceVersionOld := getGenerationAndResourceVersion(deployItem)
+	if err := encryptDeployItem(ctx, deployItem); err != nil {
+		return errorWithWriteID(err, writeID)
+	}
 	err := updateStatus(ctx, w.client.Status(), deployItem, writeID, opDIStatus)
 	w.logDeployItemUpdate(ctx, writeID, opDIStatus, deployItem, generationOld, resourceVersionOld, err)
+	if decryptErr := decryptDeployItem(ctx, deployItem); err == nil {
+		err = decryptErr
+	}
 	return errorWithWriteID(err, writeID)
 }
 
@@ -207,12 +230,61 @@ func (w *Writer) DeleteDeployItem(ctx context.Context, writeID WriteID, deployIt
 	return errorWithWriteID(err, writeID)
 }
 
+// methods for deployer registrations
+
+func (w *Writer) UpdateDeployerRegistrationStatus(ctx context.Context, writeID WriteID,
+	registration *lsv1alpha1.DeployerRegistration) error {
+	generationOld, resourceVersionOld := getGenerationAndResourceVersion(registration)
+	err := updateStatus(ctx, w.client.Status(), registration, writeID, opDeployerRegistrationStatus)
+	w.logObjectUpdate(ctx, writeID, opDeployerRegistrationStatus, registration, generationOld, resourceVersionOld, err)
+	return errorWithWriteID(err, writeID)
+}
+
+// methods for landscape healths
+
+func (w *Writer) UpdateLandscapeHealthStatus(ctx context.Context, writeID WriteID, health *lsv1alpha1.LandscapeHealth) error {
+	generationOld, resourceVersionOld := getGenerationAndResourceVersion(health)
+	err := updateStatus(ctx, w.client.Status(), health, writeID, opLandscapeHealthStatus)
+	w.logObjectUpdate(ctx, writeID, opLandscapeHealthStatus, health, generationOld, resourceVersionOld, err)
+	return errorWithWriteID(err, writeID)
+}
+
+// methods for test runs
+
+func (w *Writer) UpdateTestRun(ctx context.Context, writeID WriteID, testRun *lsv1alpha1.TestRun) error {
+	generationOld, resourceVersionOld := getGenerationAndResourceVersion(testRun)
+	err := update(ctx, w.client, testRun, writeID, opTestRunSpec)
+	w.logObjectUpdate(ctx, writeID, opTestRunSpec, testRun, generationOld, resourceVersionOld, err)
+	return errorWithWriteID(err, writeID)
+}
+
+func (w *Writer) UpdateTestRunStatus(ctx context.Context, writeID WriteID, testRun *lsv1alpha1.TestRun) error {
+	generationOld, resourceVersionOld := getGenerationAndResourceVersion(testRun)
+	err := updateStatus(ctx, w.client.Status(), testRun, writeID, opTestRunStatus)
+	w.logObjectUpdate(ctx, writeID, opTestRunStatus, testRun, generationOld, resourceVersionOld, err)
+	return errorWithWriteID(err, writeID)
+}
+
+// methods for write history configmaps
+// The writes are not logged as history, because they are themselves part of the write history.
+
+func (w *Writer) CreateWriteHistoryConfigMap(ctx context.Context, writeID WriteID, configMap *corev1.ConfigMap) error {
+	err := create(ctx, w.client, configMap, writeID, opWriteHistoryCreate)
+	return errorWithWriteID(err, writeID)
+}
+
+func (w *Writer) UpdateWriteHistoryConfigMap(ctx context.Context, writeID WriteID, configMap *corev1.ConfigMap) error {
+	err := update(ctx, w.client, configMap, writeID, opWriteHistoryUpdate)
+	return errorWithWriteID(err, writeID)
+}
+
 // base methods
 
 func create(ctx context.Context, c client.Client, object client.Object, writeID WriteID, msg string) error {
 	log, ctx := logging.FromContextOrNew(ctx, nil,
 		keyFetchedResource, fmt.Sprintf("%s/%s", object.GetNamespace(), object.GetName()),
 		lc.KeyWriteID, writeID)
+	generationOld, resourceVersionOld := getGenerationAndResourceVersion(object)
 
 	debugEnabled := log.Enabled(logging.DEBUG)
 	var start time.Time
@@ -221,6 +293,7 @@ func create(ctx context.Context, c client.Client, object client.Object, writeID
 	}
 
 	err := c.Create(ctx, object)
+	observeWrite(ctx, writeID, msg, object, nil, generationOld, resourceVersionOld, err)
 
 	if debugEnabled {
 		if err != nil {
@@ -243,6 +316,7 @@ func createOrUpdateKubernetes(ctx context.Context, c client.Client, object clien
 	log, ctx := logging.FromContextOrNew(ctx, nil,
 		keyFetchedResource, fmt.Sprintf("%s/%s", object.GetNamespace(), object.GetName()),
 		lc.KeyWriteID, writeID)
+	generationOld, resourceVersionOld := getGenerationAndResourceVersion(object)
 
 	debugEnabled := log.Enabled(logging.DEBUG)
 	var start time.Time
@@ -250,7 +324,11 @@ func createOrUpdateKubernetes(ctx context.Context, c client.Client, object clien
 		start = time.Now()
 	}
 
-	or, err := kubernetes.CreateOrUpdate(ctx, c, object, f)
+	mutate, getBefore := auditMutateFn(object, f)
+	or, err := kubernetes.CreateOrUpdate(ctx, c, object, mutate)
+	if or != controllerutil.OperationResultNone || err != nil {
+		observeWrite(ctx, writeID, msg, object, getBefore(), generationOld, resourceVersionOld, err)
+	}
 
 	if debugEnabled {
 		if err != nil {
@@ -273,6 +351,7 @@ func createOrPatchCore(ctx context.Context, c client.Client, object client.Objec
 	log, ctx := logging.FromContextOrNew(ctx, nil,
 		keyFetchedResource, fmt.Sprintf("%s/%s", object.GetNamespace(), object.GetName()),
 		lc.KeyWriteID, writeID)
+	generationOld, resourceVersionOld := getGenerationAndResourceVersion(object)
 
 	debugEnabled := log.Enabled(logging.DEBUG)
 	var start time.Time
@@ -280,7 +359,11 @@ func createOrPatchCore(ctx context.Context, c client.Client, object client.Objec
 		start = time.Now()
 	}
 
-	or, err := controllerutil.CreateOrPatch(ctx, c, object, f)
+	mutate, getBefore := auditMutateFn(object, f)
+	or, err := controllerutil.CreateOrPatch(ctx, c, object, mutate)
+	if or != controllerutil.OperationResultNone || err != nil {
+		observeWrite(ctx, writeID, msg, object, getBefore(), generationOld, resourceVersionOld, err)
+	}
 
 	if debugEnabled {
 		if err != nil {
@@ -303,6 +386,7 @@ func createOrUpdateCore(ctx context.Context, c client.Client, object client.Obje
 	log, ctx := logging.FromContextOrNew(ctx, nil,
 		keyFetchedResource, fmt.Sprintf("%s/%s", object.GetNamespace(), object.GetName()),
 		lc.KeyWriteID, writeID)
+	generationOld, resourceVersionOld := getGenerationAndResourceVersion(object)
 
 	debugEnabled := log.Enabled(logging.DEBUG)
 	var start time.Time
@@ -310,7 +394,11 @@ func createOrUpdateCore(ctx context.Context, c client.Client, object client.Obje
 		start = time.Now()
 	}
 
-	or, err := controllerutil.CreateOrUpdate(ctx, c, object, f)
+	mutate, getBefore := auditMutateFn(object, f)
+	or, err := controllerutil.CreateOrUpdate(ctx, c, object, mutate)
+	if or != controllerutil.OperationResultNone || err != nil {
+		observeWrite(ctx, writeID, msg, object, getBefore(), generationOld, resourceVersionOld, err)
+	}
 
 	if debugEnabled {
 		if err != nil {
@@ -332,6 +420,7 @@ func update(ctx context.Context, c client.Client, object client.Object, writeID
 	log, ctx := logging.FromContextOrNew(ctx, nil,
 		keyFetchedResource, fmt.Sprintf("%s/%s", object.GetNamespace(), object.GetName()),
 		lc.KeyWriteID, writeID)
+	generationOld, resourceVersionOld := getGenerationAndResourceVersion(object)
 
 	debugEnabled := log.Enabled(logging.DEBUG)
 	var start time.Time
@@ -340,6 +429,7 @@ func update(ctx context.Context, c client.Client, object client.Object, writeID
 	}
 
 	err := c.Update(ctx, object)
+	observeWrite(ctx, writeID, msg, object, nil, generationOld, resourceVersionOld, err)
 
 	if debugEnabled {
 		if err != nil {
@@ -361,6 +451,7 @@ func updateStatus(ctx context.Context, c client.StatusWriter, object client.Obje
 	log, ctx := logging.FromContextOrNew(ctx, nil,
 		keyFetchedResource, fmt.Sprintf("%s/%s", object.GetNamespace(), object.GetName()),
 		lc.KeyWriteID, writeID)
+	generationOld, resourceVersionOld := getGenerationAndResourceVersion(object)
 
 	debugEnabled := log.Enabled(logging.DEBUG)
 	var start time.Time
@@ -369,6 +460,7 @@ func updateStatus(ctx context.Context, c client.StatusWriter, object client.Obje
 	}
 
 	err := c.Update(ctx, object)
+	observeWrite(ctx, writeID, msg, object, nil, generationOld, resourceVersionOld, err)
 
 	if debugEnabled {
 		if err != nil {
@@ -390,6 +482,7 @@ func delete(ctx context.Context, c client.Client, object client.Object, writeID
 	log, ctx := logging.FromContextOrNew(ctx, nil,
 		keyFetchedResource, fmt.Sprintf("%s/%s", object.GetNamespace(), object.GetName()),
 		lc.KeyWriteID, writeID)
+	generationOld, resourceVersionOld := getGenerationAndResourceVersion(object)
 
 	debugEnabled := log.Enabled(logging.DEBUG)
 	var start time.Time
@@ -398,6 +491,7 @@ func delete(ctx context.Context, c client.Client, object client.Object, writeID
 	}
 
 	err := c.Delete(ctx, object)
+	observeWrite(ctx, writeID, msg, object, nil, generationOld, resourceVersionOld, err)
 
 	if debugEnabled {
 		if err != nil {
@@ -0,0 +1,129 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package utils
+
+import (
+	"math/rand"
+	"sync"
+	"time"
+
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/utils/clock"
+)
+
+// DefaultStartupPriorityTimeout is the default maximal duration of the startup phase of a StartupPrioritizer.
+const DefaultStartupPriorityTimeout = 10 * time.Minute
+
+// StartupPriorityRequeueInterval is the interval after which a reconcile is retried that has been postponed
+// during the startup phase. A random jitter of up to the same duration is added.
+const StartupPriorityRequeueInterval = 10 * time.Second
+
+// StartupPrioritizer postpones the reconciles of objects without priority during a startup phase after a restart
+// of a controller, so that the prioritized objects are processed first.
+// The startup phase ends when all prioritized objects have finished, or when the timeout has expired.
+// The prioritizer is local to the controller process.
+type StartupPrioritizer struct {
+	clock       clock.PassiveClock
+	deadline    time.Time
+	prioritized sets.Set[types.NamespacedName]
+	pending     sets.Set[types.NamespacedName]
+	done        bool
+	mutex       sync.Mutex
+}
+
+// NewStartupPrioritizer creates a new prioritizer whose startup phase starts now and lasts at most timeout.
+func NewStartupPrioritizer(passiveClock clock.PassiveClock, timeout time.Duration) *StartupPrioritizer {
+	return &StartupPrioritizer{
+		clock:       passiveClock,
+		deadline:    passiveClock.Now().Add(timeout),
+		prioritized: sets.New[types.NamespacedName](),
+		pending:     sets.New[types.NamespacedName](),
+	}
+}
+
+// Add marks an object as prioritized. The startup phase does not end before all prioritized objects,
+// that are not finished, have been reported as finished.
+func (p *StartupPrioritizer) Add(key types.NamespacedName, finished bool) {
+	if p == nil {
+		return
+	}
+
+	p.mutex.Lock()
+	defer p.mutex.Unlock()
+
+	p.prioritized.Insert(key)
+	if !finished {
+		p.pending.Insert(key)
+	}
+}
+
+// IsActive returns true as long as the startup phase has not ended.
+func (p *StartupPrioritizer) IsActive() bool {
+	if p == nil {
+		return false
+	}
+
+	p.mutex.Lock()
+	defer p.mutex.Unlock()
+
+	return p.isActive()
+}
+
+func (p *StartupPrioritizer) isActive() bool {
+	if p.done {
+		return false
+	}
+	if p.pending.Len() == 0 || !p.clock.Now().Before(p.deadline) {
+		p.done = true
+		p.prioritized = nil
+		p.pending = nil
+		return false
+	}
+	return true
+}
+
+// Admit returns true if the reconcile of an object can be started. This is the case if the startup phase has ended,
+// or if the object is prioritized. An object whose parent is prioritized becomes prioritized as well,
+// so that the children of prioritized objects are not postponed. The parent must be in the same namespace as the object.
+func (p *StartupPrioritizer) Admit(key types.NamespacedName, parentName string) bool {
+	if p == nil {
+		return true
+	}
+
+	p.mutex.Lock()
+	defer p.mutex.Unlock()
+
+	if !p.isActive() || p.prioritized.Has(key) {
+		return true
+	}
+
+	if len(parentName) > 0 && p.prioritized.Has(types.NamespacedName{Namespace: key.Namespace, Name: parentName}) {
+		p.prioritized.Insert(key)
+		p.pending.Insert(key)
+		return true
+	}
+	return false
+}
+
+// Finished reports that a prioritized object has finished, or that it does not exist anymore.
+func (p *StartupPrioritizer) Finished(key types.NamespacedName) {
+	if p == nil {
+		return
+	}
+
+	p.mutex.Lock()
+	defer p.mutex.Unlock()
+
+	if p.done {
+		return
+	}
+	p.pending.Delete(key)
+}
+
+// RequeueInterval returns the interval after which a postponed reconcile is retried.
+func (p *StartupPrioritizer) RequeueInterval() time.Duration {
+	return StartupPriorityRequeueInterval + time.Duration(rand.Float64()*float64(StartupPriorityRequeueInterval))
+}
@@ -0,0 +1,82 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package utils_test
+
+import (
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"k8s.io/apimachinery/pkg/types"
+	testingclock "k8s.io/utils/clock/testing"
+
+	lsutil "github.com/gardener/landscaper/pkg/utils"
+)
+
+var _ = Describe("StartupPrioritizer", func() {
+
+	var (
+		fakeClock *testingclock.FakePassiveClock
+		critical  = types.NamespacedName{Namespace: "platform", Name: "critical"}
+		finished  = types.NamespacedName{Namespace: "platform", Name: "finished"}
+		other     = types.NamespacedName{Namespace: "apps", Name: "other"}
+		child     = types.NamespacedName{Namespace: "platform", Name: "child"}
+	)
+
+	BeforeEach(func() {
+		fakeClock = testingclock.NewFakePassiveClock(time.Now())
+	})
+
+	It("should postpone objects without priority until the prioritized objects have finished", func() {
+		p := lsutil.NewStartupPrioritizer(fakeClock, time.Hour)
+		p.Add(critical, false)
+		p.Add(finished, true)
+
+		Expect(p.IsActive()).To(BeTrue())
+		Expect(p.Admit(critical, "")).To(BeTrue())
+		Expect(p.Admit(finished, "")).To(BeTrue())
+		Expect(p.Admit(other, "")).To(BeFalse())
+
+		p.Finished(critical)
+		Expect(p.IsActive()).To(BeFalse())
+		Expect(p.Admit(other, "")).To(BeTrue())
+	})
+
+	It("should prioritize the children of prioritized objects", func() {
+		p := lsutil.NewStartupPrioritizer(fakeClock, time.Hour)
+		p.Add(critical, false)
+
+		Expect(p.Admit(child, "critical")).To(BeTrue())
+		Expect(p.Admit(types.NamespacedName{Namespace: "apps", Name: "child"}, "critical")).To(BeFalse())
+
+		// the startup phase lasts until the child has finished as well
+		p.Finished(critical)
+		Expect(p.IsActive()).To(BeTrue())
+		Expect(p.Admit(other, "")).To(BeFalse())
+		p.Finished(child)
+		Expect(p.IsActive()).To(BeFalse())
+	})
+
+	It("should end the startup phase after the timeout", func() {
+		p := lsutil.NewStartupPrioritizer(fakeClock, time.Minute)
+		p.Add(critical, false)
+		Expect(p.Admit(other, "")).To(BeFalse())
+
+		fakeClock.SetTime(fakeClock.Now().Add(2 * time.Minute))
+		Expect(p.IsActive()).To(BeFalse())
+		Expect(p.Admit(other, "")).To(BeTrue())
+	})
+
+	It("should not postpone objects if there are no pending prioritized objects", func() {
+		p := lsutil.NewStartupPrioritizer(fakeClock, time.Hour)
+		p.Add(finished, true)
+		Expect(p.Admit(other, "")).To(BeTrue())
+
+		var nilPrioritizer *lsutil.StartupPrioritizer
+		Expect(nilPrioritizer.IsActive()).To(BeFalse())
+		Expect(nilPrioritizer.Admit(other, "")).To(BeTrue())
+		nilPrioritizer.Finished(other)
+	})
+})
@@ -8,6 +8,9 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"strings"
+
+	cdv2 "github.com/gardener/component-spec/bindings-go/apis/v2"
 
 	"github.com/gardener/landscaper/controller-utils/pkg/logging"
 	"github.com/gardener/landscaper/pkg/utils"
@@ -42,17 +45,87 @@ func IsVerifyEnabled(inst *lsv1alpha1.Installation, lsConfig *config.LandscaperC
 	}
 }
 
+// Settings describe how the signature of the component descriptor of an installation is verified.
+type Settings struct {
+	// SignatureName is the name of the verified signature.
+	SignatureName string
+	// Mode defines how a failed verification is handled.
+	Mode config.SignatureVerificationMode
+}
+
+// GetVerifySettings returns how the signature of the component descriptor of an installation is verified.
+// The verification is enabled by the enforcement policy and the verification of the installation (see IsVerifyEnabled),
+// or by a signature verification rule of the landscaper configuration that matches the repository context.
+// The signature name of the installation takes precedence over the signature name of the rule.
+// Verifications that are enabled by the enforcement policy or the installation always fail on an invalid signature.
+// Nil is returned if the signature is not verified.
+func GetVerifySettings(inst *lsv1alpha1.Installation, lsConfig *config.LandscaperConfiguration,
+	repositoryContext *cdv2.UnstructuredTypedObject) *Settings {
+
+	if lsConfig.SignatureVerificationEnforcementPolicy == config.Disabled {
+		return nil
+	}
+
+	signatureName := ""
+	if inst.Spec.Verification != nil {
+		signatureName = inst.Spec.Verification.SignatureName
+	}
+
+	rule := GetVerificationRule(lsConfig.SignatureVerificationRules, repositoryContext)
+	if len(signatureName) == 0 && rule != nil {
+		signatureName = rule.SignatureName
+	}
+
+	if IsVerifyEnabled(inst, lsConfig) {
+		return &Settings{SignatureName: signatureName, Mode: config.SignatureVerificationModeFail}
+	}
+
+	if rule != nil {
+		mode := rule.Mode
+		if len(mode) == 0 {
+			mode = config.SignatureVerificationModeFail
+		}
+		return &Settings{SignatureName: signatureName, Mode: mode}
+	}
+
+	return nil
+}
+
+// GetVerificationRule returns the first signature verification rule that matches the repository context.
+// Rules only match repository contexts of type "ociRegistry". Nil is returned if no rule matches.
+func GetVerificationRule(rules []config.SignatureVerificationRule, repositoryContext *cdv2.UnstructuredTypedObject) *config.SignatureVerificationRule {
+	if len(rules) == 0 || repositoryContext == nil || repositoryContext.GetType() != cdv2.OCIRegistryType {
+		return nil
+	}
+
+	repository := cdv2.OCIRegistryRepository{}
+	if err := repositoryContext.DecodeInto(&repository); err != nil {
+		return nil
+	}
+
+	for i := range rules {
+		if len(rules[i].RepositoryBaseURL) != 0 && strings.HasPrefix(repository.BaseURL, rules[i].RepositoryBaseURL) {
+			return &rules[i]
+		}
+	}
+	return nil
+}
+
 // ExtractVerifyInfo extracts signautre name, publickey data and caCert data from the secrets referenced in the context
 func ExtractVerifyInfo(ctx context.Context, inst *lsv1alpha1.Installation, installationContext *lsv1alpha1.Context, client client.Client) (string, PublicKeyData, CaCertData, error) {
-	logger, ctx := logging.FromContextOrNew(ctx, nil)
-	pm := utils.StartPerformanceMeasurement(&logger, "ExtractVerifyInfo")
-	defer pm.StopDebug()
-
 	if inst.Spec.Verification == nil {
 		return "", nil, nil, errors.New("installation.Spec.Verification cant be nil")
 	}
 
-	signatureName := inst.Spec.Verification.SignatureName
+	return ExtractVerifyInfoForSignature(ctx, inst.Spec.Verification.SignatureName, installationContext, client)
+}
+
+// ExtractVerifyInfoForSignature extracts the publickey data and caCert data of a signature from the secrets referenced in the context
+func ExtractVerifyInfoForSignature(ctx context.Context, signatureName string, installationContext *lsv1alpha1.Context, client client.Client) (string, PublicKeyData, CaCertData, error) {
+	logger, ctx := logging.FromContextOrNew(ctx, nil)
+	pm := utils.StartPerformanceMeasurement(&logger, "ExtractVerifyInfo")
+	defer pm.StopDebug()
+
 	if signatureName == "" {
 		return "", nil, nil, errors.New("installation.Spec.Verification.SignatureName must be set")
 
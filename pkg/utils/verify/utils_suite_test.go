@@ -8,6 +8,8 @@ import (
 	"context"
 	"testing"
 
+	cdv2 "github.com/gardener/component-spec/bindings-go/apis/v2"
+
 	corev1 "k8s.io/api/core/v1"
 	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
@@ -113,6 +115,70 @@ var _ = Describe("Verify Utils", func() {
 
 	})
 
+	Describe("determine the verification settings", func() {
+
+		repositoryContext := func(baseURL string) *cdv2.UnstructuredTypedObject {
+			repoCtx, err := cdv2.NewUnstructured(cdv2.NewOCIRegistryRepository(baseURL, ""))
+			Expect(err).ToNot(HaveOccurred())
+			return &repoCtx
+		}
+
+		rules := []config.SignatureVerificationRule{
+			{RepositoryBaseURL: "example.com/signed", SignatureName: "rule-signature", Mode: config.SignatureVerificationModeWarn},
+			{RepositoryBaseURL: "example.com/strict", SignatureName: "strict-signature"},
+		}
+
+		It("should not verify if neither the installation nor a rule enables the verification", func() {
+			lsConfig := &config.LandscaperConfiguration{
+				SignatureVerificationEnforcementPolicy: config.DoNotEnforce,
+				SignatureVerificationRules:             rules,
+			}
+			inst := &lsv1alpha1.Installation{}
+			Expect(verify.GetVerifySettings(inst, lsConfig, repositoryContext("example.com/other"))).To(BeNil())
+			Expect(verify.GetVerifySettings(inst, lsConfig, nil)).To(BeNil())
+		})
+
+		It("should use the signature name and mode of a matching rule", func() {
+			lsConfig := &config.LandscaperConfiguration{
+				SignatureVerificationEnforcementPolicy: config.DoNotEnforce,
+				SignatureVerificationRules:             rules,
+			}
+			inst := &lsv1alpha1.Installation{}
+			Expect(verify.GetVerifySettings(inst, lsConfig, repositoryContext("example.com/signed/components"))).To(Equal(&verify.Settings{
+				SignatureName: "rule-signature",
+				Mode:          config.SignatureVerificationModeWarn,
+			}))
+			Expect(verify.GetVerifySettings(inst, lsConfig, repositoryContext("example.com/strict"))).To(Equal(&verify.Settings{
+				SignatureName: "strict-signature",
+				Mode:          config.SignatureVerificationModeFail,
+			}))
+		})
+
+		It("should prefer the signature name of the installation and fail if the installation enables the verification", func() {
+			lsConfig := &config.LandscaperConfiguration{
+				SignatureVerificationEnforcementPolicy: config.DoNotEnforce,
+				SignatureVerificationRules:             rules,
+			}
+			inst := &lsv1alpha1.Installation{
+				Spec: lsv1alpha1.InstallationSpec{
+					Verification: &lsv1alpha1.Verification{SignatureName: "inst-signature"},
+				},
+			}
+			Expect(verify.GetVerifySettings(inst, lsConfig, repositoryContext("example.com/signed"))).To(Equal(&verify.Settings{
+				SignatureName: "inst-signature",
+				Mode:          config.SignatureVerificationModeFail,
+			}))
+		})
+
+		It("should not verify if the verification is disabled", func() {
+			lsConfig := &config.LandscaperConfiguration{
+				SignatureVerificationEnforcementPolicy: config.Disabled,
+				SignatureVerificationRules:             rules,
+			}
+			Expect(verify.GetVerifySettings(&lsv1alpha1.Installation{}, lsConfig, repositoryContext("example.com/signed"))).To(BeNil())
+		})
+	})
+
 	Describe("extract verification information", func() {
 		var (
 			ctx    context.Context
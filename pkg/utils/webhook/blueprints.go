@@ -0,0 +1,187 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package webhook
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/open-component-model/ocm/pkg/contexts/datacontext"
+	"github.com/open-component-model/ocm/pkg/contexts/ocm"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/utils/clock"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	lsv1alpha1 "github.com/gardener/landscaper/apis/core/v1alpha1"
+	"github.com/gardener/landscaper/controller-utils/pkg/logging"
+	"github.com/gardener/landscaper/pkg/components/model/types"
+	"github.com/gardener/landscaper/pkg/components/registries"
+	"github.com/gardener/landscaper/pkg/landscaper/blueprints"
+	"github.com/gardener/landscaper/pkg/landscaper/installations"
+	"github.com/gardener/landscaper/pkg/utils/read_write_layer"
+)
+
+const (
+	// DefaultBlueprintCacheTTL is the default duration for which resolved blueprints are cached.
+	DefaultBlueprintCacheTTL = 10 * time.Minute
+	// DefaultBlueprintCacheSize is the default maximal number of cached blueprints.
+	DefaultBlueprintCacheSize = 100
+)
+
+// BlueprintResolver resolves the blueprints of installations for the validation webhook.
+// Blueprints that are referenced in component versions are cached, as the resources of a component version
+// are immutable. Inline blueprints are not cached.
+type BlueprintResolver struct {
+	kubeClient client.Client
+	clock      clock.PassiveClock
+	ttl        time.Duration
+	size       int
+
+	mutex   sync.Mutex
+	entries map[string]blueprintCacheEntry
+}
+
+type blueprintCacheEntry struct {
+	blueprint *blueprints.Blueprint
+	expiresAt time.Time
+}
+
+// NewBlueprintResolver creates a new blueprint resolver that reads contexts and registry pull secrets with the given client.
+func NewBlueprintResolver(kubeClient client.Client) *BlueprintResolver {
+	return &BlueprintResolver{
+		kubeClient: kubeClient,
+		clock:      clock.RealClock{},
+		ttl:        DefaultBlueprintCacheTTL,
+		size:       DefaultBlueprintCacheSize,
+		entries:    map[string]blueprintCacheEntry{},
+	}
+}
+
+// Resolve returns the blueprint of an installation.
+func (r *BlueprintResolver) Resolve(ctx context.Context, inst *lsv1alpha1.Installation) (*blueprints.Blueprint, error) {
+	if inst.Spec.Blueprint.Inline != nil || inst.Spec.Blueprint.Git != nil {
+		return blueprints.Resolve(ctx, nil, nil, inst.Spec.Blueprint)
+	}
+	if inst.Spec.Blueprint.Reference == nil {
+		return nil, fmt.Errorf("no remote reference nor a inline blueprint nor a git reference is defined")
+	}
+
+	externalCtx, err := installations.GetExternalContext(ctx, r.kubeClient, inst)
+	if err != nil {
+		return nil, err
+	}
+	cdRef := externalCtx.ComponentDescriptorRef()
+
+	key, err := blueprintCacheKey(cdRef, inst.Spec.Blueprint.Reference.ResourceName)
+	if err != nil {
+		return nil, err
+	}
+	if blueprint := r.get(key); blueprint != nil {
+		return blueprint, nil
+	}
+
+	octx := ocm.New(datacontext.MODE_EXTENDED)
+	defer func() {
+		if err := octx.Finalize(); err != nil {
+			logger, _ := logging.FromContextOrNew(ctx, nil)
+			logger.Error(err, "unable to finalize ocm context")
+		}
+	}()
+	ctx = octx.BindTo(ctx)
+
+	secrets, err := r.resolveSecrets(ctx, externalCtx.RegistryPullSecrets())
+	if err != nil {
+		return nil, err
+	}
+
+	var ocmConfig *corev1.ConfigMap
+	if externalCtx.Context.OCMConfig != nil {
+		ocmConfig = &corev1.ConfigMap{}
+		key := client.ObjectKey{Namespace: externalCtx.Context.Namespace, Name: externalCtx.Context.OCMConfig.Name}
+		if err := read_write_layer.GetConfigMap(ctx, r.kubeClient, key, ocmConfig, read_write_layer.R000148); err != nil {
+			return nil, err
+		}
+	}
+
+	var inlineCd *types.ComponentDescriptor
+	if inst.Spec.ComponentDescriptor != nil {
+		inlineCd = inst.Spec.ComponentDescriptor.Inline
+	}
+	registryAccess, err := registries.GetFactory(externalCtx.Context.UseOCM).NewRegistryAccess(ctx, nil, ocmConfig, secrets, nil, nil, nil, inlineCd)
+	if err != nil {
+		return nil, err
+	}
+
+	blueprint, err := blueprints.Resolve(ctx, registryAccess, cdRef, inst.Spec.Blueprint)
+	if err != nil {
+		return nil, err
+	}
+	if inlineCd == nil {
+		r.add(key, blueprint)
+	}
+	return blueprint, nil
+}
+
+func (r *BlueprintResolver) resolveSecrets(ctx context.Context, secretRefs []lsv1alpha1.ObjectReference) ([]corev1.Secret, error) {
+	secrets := make([]corev1.Secret, len(secretRefs))
+	for i, secretRef := range secretRefs {
+		if err := read_write_layer.GetSecret(ctx, r.kubeClient, secretRef.NamespacedName(), &secrets[i], read_write_layer.R000147); err != nil {
+			return nil, err
+		}
+	}
+	return secrets, nil
+}
+
+func (r *BlueprintResolver) get(key string) *blueprints.Blueprint {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	entry, ok := r.entries[key]
+	if !ok {
+		return nil
+	}
+	if !r.clock.Now().Before(entry.expiresAt) {
+		delete(r.entries, key)
+		return nil
+	}
+	return entry.blueprint
+}
+
+func (r *BlueprintResolver) add(key string, blueprint *blueprints.Blueprint) {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	now := r.clock.Now()
+	if len(r.entries) >= r.size {
+		// remove expired entries, and all entries if the cache is still full
+		for k, entry := range r.entries {
+			if !now.Before(entry.expiresAt) {
+				delete(r.entries, k)
+			}
+		}
+		if len(r.entries) >= r.size {
+			r.entries = map[string]blueprintCacheEntry{}
+		}
+	}
+	r.entries[key] = blueprintCacheEntry{
+		blueprint: blueprint,
+		expiresAt: now.Add(r.ttl),
+	}
+}
+
+// blueprintCacheKey returns the key of a blueprint resource of a component version.
+func blueprintCacheKey(cdRef *lsv1alpha1.ComponentDescriptorReference, resourceName string) (string, error) {
+	if cdRef == nil {
+		return "", fmt.Errorf("no component descriptor reference defined")
+	}
+	repoCtx, err := json.Marshal(cdRef.RepositoryContext)
+	if err != nil {
+		return "", fmt.Errorf("unable to marshal repository context: %w", err)
+	}
+	return fmt.Sprintf("%s|%s|%s|%s", repoCtx, cdRef.ComponentName, cdRef.Version, resourceName), nil
+}
@@ -6,6 +6,7 @@ package webhook
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	"net/http"
 
@@ -14,12 +15,19 @@ import (
 	admissionv1 "k8s.io/api/admission/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/util/validation/field"
+	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
 
 	lscore "github.com/gardener/landscaper/apis/core"
+	lsv1alpha1 "github.com/gardener/landscaper/apis/core/v1alpha1"
 	"github.com/gardener/landscaper/apis/core/validation"
 	"github.com/gardener/landscaper/controller-utils/pkg/logging"
 	webhooklib "github.com/gardener/landscaper/controller-utils/pkg/webhook"
+	"github.com/gardener/landscaper/pkg/api"
+	"github.com/gardener/landscaper/pkg/landscaper/blueprints"
+	"github.com/gardener/landscaper/pkg/landscaper/jsonschema"
+	"github.com/gardener/landscaper/pkg/landscaper/targettypedefinitions"
+	"github.com/gardener/landscaper/pkg/utils/read_write_layer"
 )
 
 // INSTALLATION
@@ -41,6 +49,45 @@ var InstallationWebhookLogic webhooklib.WebhookLogic = func(ctx context.Context,
 	return admission.Allowed("Installation is valid")
 }
 
+// NewInstallationWebhookLogic returns the webhook logic for installations that additionally verifies the imports and
+// exports of the installations against the import and export definitions of their blueprints.
+// Installations are admitted with a warning if their blueprint cannot be resolved, e.g. because the registry
+// is not reachable. Installations that are being deleted are not verified against their blueprints.
+func NewInstallationWebhookLogic(resolver *BlueprintResolver) webhooklib.WebhookLogic {
+	return func(ctx context.Context, req admission.Request, dec runtime.Decoder) admission.Response {
+		if res := InstallationWebhookLogic(ctx, req, dec); !res.Allowed {
+			return res
+		}
+
+		logger, ctx := logging.FromContextOrNew(ctx, []interface{}{lc.KeyMethod, "InstallationWebhookLogic"})
+
+		inst := &lsv1alpha1.Installation{}
+		if err := json.Unmarshal(req.Object.Raw, inst); err != nil {
+			logger.Debug("Decoding failed: " + err.Error())
+			return admission.Errored(http.StatusBadRequest, err)
+		}
+		if !inst.DeletionTimestamp.IsZero() {
+			return admission.Allowed("Installation is valid")
+		}
+		api.LandscaperScheme.Default(inst)
+
+		blueprint, err := resolver.Resolve(ctx, inst)
+		if err != nil {
+			logger.Debug("Resolving the blueprint failed: " + err.Error())
+			return admission.Allowed("Installation is valid").
+				WithWarnings(fmt.Sprintf("the imports and exports have not been verified against the blueprint, because it could not be resolved: %s", err.Error()))
+		}
+
+		if errs := blueprints.VerifyInstallation(field.NewPath("spec"), inst, blueprints.ContractParty{Blueprint: blueprint}); len(errs) > 0 {
+			aggErr := errs.ToAggregate().Error()
+			logger.Debug("Verification against the blueprint failed: " + aggErr)
+			return admission.Denied(aggErr)
+		}
+
+		return admission.Allowed("Installation is valid")
+	}
+}
+
 // DEPLOYITEM
 
 var DeployItemWebhookLogic webhooklib.WebhookLogic = func(ctx context.Context, req admission.Request, dec runtime.Decoder) admission.Response {
@@ -113,3 +160,78 @@ var TargetWebhookLogic webhooklib.WebhookLogic = func(ctx context.Context, req a
 
 	return admission.Allowed("Target is valid")
 }
+
+// NewTargetWebhookLogic returns the webhook logic for targets that additionally validates the inline configuration of
+// the targets against the schemas of the TargetTypeDefinitions of their types.
+// The configuration of targets that reference a secret is only validated when the targets are imported by installations.
+func NewTargetWebhookLogic(kubeClient client.Reader) webhooklib.WebhookLogic {
+	return func(ctx context.Context, req admission.Request, dec runtime.Decoder) admission.Response {
+		if res := TargetWebhookLogic(ctx, req, dec); !res.Allowed {
+			return res
+		}
+
+		logger, _ := logging.FromContextOrNew(ctx, []interface{}{lc.KeyMethod, "TargetWebhookLogic"})
+
+		t := &lscore.Target{}
+		if _, _, err := dec.Decode(req.Object.Raw, nil, t); err != nil {
+			logger.Debug("Decoding failed: " + err.Error())
+			return admission.Errored(http.StatusBadRequest, err)
+		}
+		if t.Spec.Configuration == nil {
+			return admission.Allowed("Target is valid")
+		}
+
+		if err := targettypedefinitions.ValidateConfiguration(ctx, kubeClient, lsv1alpha1.TargetType(t.Spec.Type),
+			t.Spec.Configuration.RawMessage, read_write_layer.R000168); err != nil {
+			logger.Debug("Validation of the configuration failed: " + err.Error())
+			return admission.Denied(field.Invalid(field.NewPath("spec", "config"), "", err.Error()).Error())
+		}
+
+		return admission.Allowed("Target is valid")
+	}
+}
+
+// TARGET TYPE DEFINITION
+
+var TargetTypeDefinitionWebhookLogic webhooklib.WebhookLogic = func(ctx context.Context, req admission.Request, dec runtime.Decoder) admission.Response {
+	logger, _ := logging.FromContextOrNew(ctx, []interface{}{lc.KeyMethod, "TargetTypeDefinitionWebhookLogic"})
+
+	def := &lscore.TargetTypeDefinition{}
+	if _, _, err := dec.Decode(req.Object.Raw, nil, def); err != nil {
+		logger.Debug("Decoding failed: " + err.Error())
+		return admission.Errored(http.StatusBadRequest, err)
+	}
+
+	if errs := validation.ValidateTargetTypeDefinition(def); len(errs) > 0 {
+		aggErr := errs.ToAggregate().Error()
+		logger.Debug("Validation failed: " + aggErr)
+		return admission.Denied(aggErr)
+	}
+
+	if err := jsonschema.ValidateSchema(def.Spec.Schema.RawMessage); err != nil {
+		logger.Debug("Validation of the schema failed: " + err.Error())
+		return admission.Denied(field.Invalid(field.NewPath("spec", "schema"), "", err.Error()).Error())
+	}
+
+	return admission.Allowed("TargetTypeDefinition is valid")
+}
+
+// CONTEXT
+
+var ContextWebhookLogic webhooklib.WebhookLogic = func(ctx context.Context, req admission.Request, dec runtime.Decoder) admission.Response {
+	logger, _ := logging.FromContextOrNew(ctx, []interface{}{lc.KeyMethod, "ContextWebhookLogic"})
+
+	lsCtx := &lscore.Context{}
+	if _, _, err := dec.Decode(req.Object.Raw, nil, lsCtx); err != nil {
+		logger.Debug("Decoding failed: " + err.Error())
+		return admission.Errored(http.StatusBadRequest, err)
+	}
+
+	if errs := validation.ValidateContext(lsCtx); len(errs) > 0 {
+		aggErr := errs.ToAggregate().Error()
+		logger.Debug("Validation failed: " + aggErr)
+		return admission.Denied(aggErr)
+	}
+
+	return admission.Allowed("Context is valid")
+}
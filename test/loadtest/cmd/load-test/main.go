@@ -0,0 +1,73 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+
+	"github.com/spf13/cobra"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/manager/signals"
+
+	"github.com/gardener/landscaper/controller-utils/pkg/logging"
+	"github.com/gardener/landscaper/test/loadtest"
+)
+
+func main() {
+	ctx := signals.SetupSignalHandler()
+
+	if err := NewLoadTestCommand(ctx).Execute(); err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+}
+
+// NewLoadTestCommand creates a new command that runs a load test against the landscaper controllers.
+func NewLoadTestCommand(ctx context.Context) *cobra.Command {
+	opts := loadtest.NewOptions()
+	cmd := &cobra.Command{
+		Use:          "load-test",
+		Short:        "Runs the landscaper controllers against a large number of synthetic installations",
+		SilenceUsage: true,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return run(ctx, opts)
+		},
+	}
+
+	fs := cmd.Flags()
+	opts.AddFlags(fs)
+	logging.InitFlags(fs)
+	return cmd
+}
+
+func run(ctx context.Context, opts *loadtest.Options) error {
+	log, err := logging.GetLogger()
+	if err != nil {
+		return err
+	}
+	ctrl.SetLogger(log.Logr())
+
+	report, runErr := loadtest.New(log.WithName("load-test"), *opts).Run(ctx)
+	if report == nil {
+		return runErr
+	}
+
+	if err := report.Print(os.Stdout); err != nil {
+		return err
+	}
+	if len(opts.ReportPath) != 0 {
+		data, err := json.MarshalIndent(report, "", "  ")
+		if err != nil {
+			return fmt.Errorf("unable to marshal report: %w", err)
+		}
+		if err := os.WriteFile(opts.ReportPath, data, 0644); err != nil {
+			return fmt.Errorf("unable to write report to %s: %w", opts.ReportPath, err)
+		}
+	}
+	return runErr
+}
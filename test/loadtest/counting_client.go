@@ -0,0 +1,169 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package loadtest
+
+import (
+	"context"
+	"sort"
+	"sync"
+
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// Operations of the write requests that are counted.
+const (
+	OperationCreate       = "create"
+	OperationUpdate       = "update"
+	OperationPatch        = "patch"
+	OperationDelete       = "delete"
+	OperationDeleteAllOf  = "deleteAllOf"
+	OperationStatusCreate = "status/create"
+	OperationStatusUpdate = "status/update"
+	OperationStatusPatch  = "status/patch"
+)
+
+// WriteKey identifies a kind of write requests.
+type WriteKey struct {
+	Kind      string `json:"kind"`
+	Operation string `json:"operation"`
+}
+
+// WriteCount is the number of write requests of a kind.
+type WriteCount struct {
+	WriteKey `json:",inline"`
+	Count    int64 `json:"count"`
+}
+
+// WriteCounter counts the write requests that are sent to the api server.
+type WriteCounter struct {
+	mux    sync.Mutex
+	counts map[WriteKey]int64
+}
+
+// NewWriteCounter creates a new write counter.
+func NewWriteCounter() *WriteCounter {
+	return &WriteCounter{
+		counts: map[WriteKey]int64{},
+	}
+}
+
+func (w *WriteCounter) add(kind, operation string) {
+	w.mux.Lock()
+	defer w.mux.Unlock()
+	w.counts[WriteKey{Kind: kind, Operation: operation}]++
+}
+
+// Reset sets all counts to zero.
+func (w *WriteCounter) Reset() {
+	w.mux.Lock()
+	defer w.mux.Unlock()
+	w.counts = map[WriteKey]int64{}
+}
+
+// Total returns the number of all counted write requests.
+func (w *WriteCounter) Total() int64 {
+	w.mux.Lock()
+	defer w.mux.Unlock()
+	var total int64
+	for _, count := range w.counts {
+		total += count
+	}
+	return total
+}
+
+// Counts returns the counts sorted by kind and operation.
+func (w *WriteCounter) Counts() []WriteCount {
+	w.mux.Lock()
+	defer w.mux.Unlock()
+	counts := make([]WriteCount, 0, len(w.counts))
+	for key, count := range w.counts {
+		counts = append(counts, WriteCount{WriteKey: key, Count: count})
+	}
+	sort.Slice(counts, func(i, j int) bool {
+		if counts[i].Kind != counts[j].Kind {
+			return counts[i].Kind < counts[j].Kind
+		}
+		return counts[i].Operation < counts[j].Operation
+	})
+	return counts
+}
+
+// countingClient is a client that counts all successful write requests.
+type countingClient struct {
+	client.Client
+	counter *WriteCounter
+}
+
+// NewCountingClient wraps the client so that all successful write requests are counted by the given counter.
+func NewCountingClient(c client.Client, counter *WriteCounter) client.Client {
+	return &countingClient{
+		Client:  c,
+		counter: counter,
+	}
+}
+
+func (c *countingClient) count(obj client.Object, operation string, err error) error {
+	if err == nil {
+		c.counter.add(kindOf(c.Client, obj), operation)
+	}
+	return err
+}
+
+func (c *countingClient) Create(ctx context.Context, obj client.Object, opts ...client.CreateOption) error {
+	return c.count(obj, OperationCreate, c.Client.Create(ctx, obj, opts...))
+}
+
+func (c *countingClient) Update(ctx context.Context, obj client.Object, opts ...client.UpdateOption) error {
+	return c.count(obj, OperationUpdate, c.Client.Update(ctx, obj, opts...))
+}
+
+func (c *countingClient) Patch(ctx context.Context, obj client.Object, patch client.Patch, opts ...client.PatchOption) error {
+	return c.count(obj, OperationPatch, c.Client.Patch(ctx, obj, patch, opts...))
+}
+
+func (c *countingClient) Delete(ctx context.Context, obj client.Object, opts ...client.DeleteOption) error {
+	return c.count(obj, OperationDelete, c.Client.Delete(ctx, obj, opts...))
+}
+
+func (c *countingClient) DeleteAllOf(ctx context.Context, obj client.Object, opts ...client.DeleteAllOfOption) error {
+	return c.count(obj, OperationDeleteAllOf, c.Client.DeleteAllOf(ctx, obj, opts...))
+}
+
+func (c *countingClient) Status() client.SubResourceWriter {
+	return &countingStatusWriter{
+		SubResourceWriter: c.Client.Status(),
+		client:            c,
+	}
+}
+
+// countingStatusWriter counts all successful write requests to the status subresource.
+type countingStatusWriter struct {
+	client.SubResourceWriter
+	client *countingClient
+}
+
+func (w *countingStatusWriter) Create(ctx context.Context, obj client.Object, subResource client.Object, opts ...client.SubResourceCreateOption) error {
+	return w.client.count(obj, OperationStatusCreate, w.SubResourceWriter.Create(ctx, obj, subResource, opts...))
+}
+
+func (w *countingStatusWriter) Update(ctx context.Context, obj client.Object, opts ...client.SubResourceUpdateOption) error {
+	return w.client.count(obj, OperationStatusUpdate, w.SubResourceWriter.Update(ctx, obj, opts...))
+}
+
+func (w *countingStatusWriter) Patch(ctx context.Context, obj client.Object, patch client.Patch, opts ...client.SubResourcePatchOption) error {
+	return w.client.count(obj, OperationStatusPatch, w.SubResourceWriter.Patch(ctx, obj, patch, opts...))
+}
+
+// kindOf returns the kind of the object.
+func kindOf(c client.Client, obj client.Object) string {
+	if kind := obj.GetObjectKind().GroupVersionKind().Kind; len(kind) != 0 {
+		return kind
+	}
+	gvk, err := c.GroupVersionKindFor(obj)
+	if err != nil {
+		return "unknown"
+	}
+	return gvk.Kind
+}
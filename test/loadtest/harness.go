@@ -0,0 +1,339 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package loadtest
+
+import (
+	"context"
+	"fmt"
+	"path/filepath"
+	"time"
+
+	"golang.org/x/sync/errgroup"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/util/rand"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/client-go/rest"
+	"k8s.io/client-go/tools/clientcmd"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/envtest"
+	"sigs.k8s.io/controller-runtime/pkg/manager"
+	metricsserver "sigs.k8s.io/controller-runtime/pkg/metrics/server"
+
+	"github.com/gardener/landscaper/apis/config"
+	configv1alpha1 "github.com/gardener/landscaper/apis/config/v1alpha1"
+	lsv1alpha1 "github.com/gardener/landscaper/apis/core/v1alpha1"
+	mockv1alpha1 "github.com/gardener/landscaper/apis/deployer/mock/v1alpha1"
+	"github.com/gardener/landscaper/controller-utils/pkg/logging"
+	"github.com/gardener/landscaper/pkg/api"
+	mockctrl "github.com/gardener/landscaper/pkg/deployer/mock"
+	deployitemctrl "github.com/gardener/landscaper/pkg/landscaper/controllers/deployitem"
+	executionctrl "github.com/gardener/landscaper/pkg/landscaper/controllers/execution"
+	installationsctrl "github.com/gardener/landscaper/pkg/landscaper/controllers/installations"
+	lsutils "github.com/gardener/landscaper/pkg/utils"
+)
+
+// Harness runs the landscaper controllers and the mock deployer in-process against a test cluster
+// and measures how fast they process a large number of installations.
+type Harness struct {
+	opts    Options
+	log     logging.Logger
+	counter *WriteCounter
+
+	restConfig *rest.Config
+	testEnv    *envtest.Environment
+	// client is used by the harness itself. Its requests are not counted.
+	client client.Client
+}
+
+// New creates a new load test harness.
+func New(log logging.Logger, opts Options) *Harness {
+	return &Harness{
+		opts:    opts,
+		log:     log,
+		counter: NewWriteCounter(),
+	}
+}
+
+// Run starts the test cluster and the controllers, creates the installations and waits until all of them are finished.
+func (h *Harness) Run(ctx context.Context) (*Report, error) {
+	if err := h.opts.Validate(); err != nil {
+		return nil, err
+	}
+	steps, err := ParseSteps(h.opts.Steps)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := h.startCluster(); err != nil {
+		return nil, err
+	}
+	defer h.stopCluster()
+
+	ctx, cancel := context.WithTimeout(ctx, h.opts.Timeout)
+	defer cancel()
+	ctrlCtx, stopControllers := context.WithCancel(ctx)
+	eg, ctrlCtx := errgroup.WithContext(ctrlCtx)
+	defer func() {
+		stopControllers()
+		if err := eg.Wait(); err != nil {
+			h.log.Error(err, "controllers stopped with error")
+		}
+	}()
+
+	if err := h.prepareNamespace(ctx); err != nil {
+		return nil, err
+	}
+	if err := h.startControllers(ctrlCtx, eg); err != nil {
+		return nil, err
+	}
+
+	report := &Report{
+		RunID:         rand.String(8),
+		Installations: h.opts.Installations,
+		DeployItems:   h.opts.DeployItems,
+	}
+	h.counter.Reset()
+
+	h.log.Info("creating installations", "run", report.RunID, "installations", h.opts.Installations, "deployItems", h.opts.DeployItems)
+	start := time.Now()
+	if err := h.createInstallations(ctx, report.RunID, steps); err != nil {
+		return nil, err
+	}
+	latencies, err := h.waitForInstallations(ctx, report)
+	report.Duration = time.Since(start)
+	report.complete(latencies, h.counter)
+	if err != nil {
+		return report, err
+	}
+
+	if !h.opts.KeepObjects {
+		if err := h.deleteInstallations(ctx, report.RunID); err != nil {
+			return report, err
+		}
+	}
+	return report, nil
+}
+
+// startCluster starts an envtest api server or connects to the cluster of the configured kubeconfig.
+func (h *Harness) startCluster() error {
+	var err error
+	crdPath := filepath.Join(h.opts.ProjectRoot, "apis", "crds", "manifests")
+	if len(h.opts.Kubeconfig) == 0 {
+		h.testEnv = &envtest.Environment{CRDDirectoryPaths: []string{crdPath}}
+		h.restConfig, err = h.testEnv.Start()
+		if err != nil {
+			return fmt.Errorf("unable to start envtest: %w", err)
+		}
+	} else {
+		h.restConfig, err = clientcmd.BuildConfigFromFlags("", h.opts.Kubeconfig)
+		if err != nil {
+			return fmt.Errorf("unable to read kubeconfig from %s: %w", h.opts.Kubeconfig, err)
+		}
+		if _, err := envtest.InstallCRDs(h.restConfig, envtest.CRDInstallOptions{Paths: []string{crdPath}}); err != nil {
+			return fmt.Errorf("unable to install crds: %w", err)
+		}
+	}
+
+	burst, qps := lsutils.GetHostClientRequestRestrictions(h.log, false)
+	h.restConfig = lsutils.RestConfigWithModifiedClientRequestRestrictions(h.log, h.restConfig, burst, qps)
+
+	h.client, err = client.New(h.restConfig, client.Options{Scheme: api.LandscaperScheme})
+	if err != nil {
+		return fmt.Errorf("unable to create client: %w", err)
+	}
+	return nil
+}
+
+func (h *Harness) stopCluster() {
+	if h.testEnv == nil {
+		return
+	}
+	if err := h.testEnv.Stop(); err != nil {
+		h.log.Error(err, "unable to stop envtest")
+	}
+}
+
+// prepareNamespace creates the namespace of the installations and its default context.
+func (h *Harness) prepareNamespace(ctx context.Context) error {
+	ns := &corev1.Namespace{}
+	ns.Name = h.opts.Namespace
+	if err := h.client.Create(ctx, ns); err != nil && !apierrors.IsAlreadyExists(err) {
+		return fmt.Errorf("unable to create namespace %s: %w", ns.Name, err)
+	}
+
+	lsContext := &lsv1alpha1.Context{}
+	lsContext.Name = lsv1alpha1.DefaultContextName
+	lsContext.Namespace = h.opts.Namespace
+	if err := h.client.Create(ctx, lsContext); err != nil && !apierrors.IsAlreadyExists(err) {
+		return fmt.Errorf("unable to create context: %w", err)
+	}
+	return nil
+}
+
+// startControllers starts the installation, execution and deploy item controllers and the mock deployer.
+// All their write requests are counted.
+func (h *Harness) startControllers(ctx context.Context, eg *errgroup.Group) error {
+	mgr, err := ctrl.NewManager(h.restConfig, manager.Options{
+		Scheme:  api.LandscaperScheme,
+		Metrics: metricsserver.Options{BindAddress: "0"},
+	})
+	if err != nil {
+		return fmt.Errorf("unable to setup manager: %w", err)
+	}
+
+	lsUncachedClient, lsCachedClient, hostUncachedClient, hostCachedClient, err := lsutils.ClientsFromManagers(mgr, mgr)
+	if err != nil {
+		return err
+	}
+	lsUncachedClient = NewCountingClient(lsUncachedClient, h.counter)
+	lsCachedClient = NewCountingClient(lsCachedClient, h.counter)
+	hostUncachedClient = NewCountingClient(hostUncachedClient, h.counter)
+	hostCachedClient = NewCountingClient(hostCachedClient, h.counter)
+
+	lsConfig, err := h.landscaperConfiguration()
+	if err != nil {
+		return err
+	}
+	log := h.log.WithName("controllers")
+
+	if err := installationsctrl.AddControllerToManager(lsUncachedClient, lsCachedClient, hostUncachedClient, hostCachedClient,
+		log, mgr, lsConfig, "installations"); err != nil {
+		return fmt.Errorf("unable to setup installation controller: %w", err)
+	}
+	if err := executionctrl.AddControllerToManager(ctx, lsUncachedClient, lsCachedClient, hostUncachedClient, hostCachedClient,
+		log, mgr, mgr, lsConfig); err != nil {
+		return fmt.Errorf("unable to setup execution controller: %w", err)
+	}
+	if err := deployitemctrl.AddControllerToManager(lsUncachedClient, lsCachedClient,
+		log, mgr, lsConfig.Controllers.DeployItems, lsConfig.DeployItemTimeouts); err != nil {
+		return fmt.Errorf("unable to setup deployitem controller: %w", err)
+	}
+	if err := mockctrl.AddDeployerToManager(lsUncachedClient, lsCachedClient, hostUncachedClient, hostCachedClient,
+		lsutils.NewFinishedObjectCache(), log, mgr, mgr, mockv1alpha1.Configuration{}, "mock"); err != nil {
+		return fmt.Errorf("unable to setup mock deployer: %w", err)
+	}
+
+	eg.Go(func() error {
+		if err := mgr.Start(ctx); err != nil {
+			return fmt.Errorf("error while running manager: %w", err)
+		}
+		return nil
+	})
+	if !mgr.GetCache().WaitForCacheSync(ctx) {
+		return fmt.Errorf("unable to sync cache")
+	}
+	return nil
+}
+
+// landscaperConfiguration returns the defaulted landscaper configuration with the configured number of workers.
+func (h *Harness) landscaperConfiguration() (*config.LandscaperConfiguration, error) {
+	lsConfigV1alpha1 := &configv1alpha1.LandscaperConfiguration{}
+	api.ConfigScheme.Default(lsConfigV1alpha1)
+
+	lsConfig := &config.LandscaperConfiguration{}
+	if err := api.ConfigScheme.Convert(lsConfigV1alpha1, lsConfig, nil); err != nil {
+		return nil, fmt.Errorf("unable to convert landscaper configuration: %w", err)
+	}
+	api.ConfigScheme.Default(lsConfig)
+
+	lsConfig.Controllers.Installations.Workers = h.opts.ControllerWorkers
+	lsConfig.Controllers.Executions.Workers = h.opts.ControllerWorkers
+	lsConfig.Controllers.DeployItems.Workers = h.opts.ControllerWorkers
+	return lsConfig, nil
+}
+
+// createInstallations creates the installations of the run in parallel.
+func (h *Harness) createInstallations(ctx context.Context, runID string, steps []mockv1alpha1.Step) error {
+	indices := make(chan int)
+	eg, ctx := errgroup.WithContext(ctx)
+	for w := 0; w < h.opts.CreateWorkers; w++ {
+		eg.Go(func() error {
+			for i := range indices {
+				name := fmt.Sprintf("load-%s-%05d", runID, i)
+				inst, err := BuildInstallation(name, h.opts.Namespace, runID, h.opts.DeployItems, steps)
+				if err != nil {
+					return err
+				}
+				if err := h.client.Create(ctx, inst); err != nil {
+					return fmt.Errorf("unable to create installation %s: %w", name, err)
+				}
+			}
+			return nil
+		})
+	}
+
+	eg.Go(func() error {
+		defer close(indices)
+		for i := 0; i < h.opts.Installations; i++ {
+			select {
+			case indices <- i:
+			case <-ctx.Done():
+				return ctx.Err()
+			}
+		}
+		return nil
+	})
+	return eg.Wait()
+}
+
+// waitForInstallations waits until all installations of the run are finished.
+// It returns the durations from the creation of the installations until they have been finished.
+func (h *Harness) waitForInstallations(ctx context.Context, report *Report) ([]time.Duration, error) {
+	var latencies []time.Duration
+	err := wait.PollUntilContextCancel(ctx, h.opts.PollInterval, true, func(ctx context.Context) (bool, error) {
+		instList := &lsv1alpha1.InstallationList{}
+		if err := h.client.List(ctx, instList, client.InNamespace(h.opts.Namespace),
+			client.MatchingLabels{LoadTestLabel: report.RunID}); err != nil {
+			h.log.Error(err, "unable to list installations")
+			return false, nil
+		}
+
+		report.Succeeded, report.Failed = 0, 0
+		latencies = latencies[:0]
+		now := time.Now()
+		for _, inst := range instList.Items {
+			if inst.Status.JobID == "" || inst.Status.JobID != inst.Status.JobIDFinished || !inst.Status.InstallationPhase.IsFinal() {
+				continue
+			}
+			if inst.Status.InstallationPhase == lsv1alpha1.InstallationPhases.Succeeded {
+				report.Succeeded++
+			} else {
+				report.Failed++
+			}
+			finished := now
+			if times := inst.Status.TransitionTimes; times != nil && times.FinishedTime != nil {
+				finished = times.FinishedTime.Time
+			}
+			latencies = append(latencies, finished.Sub(inst.CreationTimestamp.Time))
+		}
+
+		h.log.Info("waiting for installations", "succeeded", report.Succeeded, "failed", report.Failed,
+			"total", h.opts.Installations, "writes", h.counter.Total())
+		return report.Succeeded+report.Failed == h.opts.Installations, nil
+	})
+	if err != nil {
+		return latencies, fmt.Errorf("not all installations have finished: %w", err)
+	}
+	return latencies, nil
+}
+
+// deleteInstallations deletes the installations of the run and waits until they are gone.
+func (h *Harness) deleteInstallations(ctx context.Context, runID string) error {
+	h.log.Info("deleting installations", "run", runID)
+	if err := h.client.DeleteAllOf(ctx, &lsv1alpha1.Installation{}, client.InNamespace(h.opts.Namespace),
+		client.MatchingLabels{LoadTestLabel: runID}); err != nil {
+		return fmt.Errorf("unable to delete installations: %w", err)
+	}
+	return wait.PollUntilContextCancel(ctx, h.opts.PollInterval, true, func(ctx context.Context) (bool, error) {
+		instList := &lsv1alpha1.InstallationList{}
+		if err := h.client.List(ctx, instList, client.InNamespace(h.opts.Namespace),
+			client.MatchingLabels{LoadTestLabel: runID}); err != nil {
+			return false, nil
+		}
+		return len(instList.Items) == 0, nil
+	})
+}
@@ -0,0 +1,133 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package loadtest_test
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/yaml"
+
+	lsv1alpha1 "github.com/gardener/landscaper/apis/core/v1alpha1"
+	mockv1alpha1 "github.com/gardener/landscaper/apis/deployer/mock/v1alpha1"
+	"github.com/gardener/landscaper/pkg/api"
+	"github.com/gardener/landscaper/test/loadtest"
+)
+
+var _ = Describe("Load Test Harness", func() {
+
+	Context("CountingClient", func() {
+
+		It("should count successful write requests by kind and operation", func() {
+			ctx := context.Background()
+			counter := loadtest.NewWriteCounter()
+			inst := &lsv1alpha1.Installation{}
+			inst.Name = "inst"
+			inst.Namespace = "test"
+			c := loadtest.NewCountingClient(fake.NewClientBuilder().WithScheme(api.LandscaperScheme).
+				WithStatusSubresource(inst).Build(), counter)
+
+			cm := &corev1.ConfigMap{}
+			cm.Name = "cm"
+			cm.Namespace = "test"
+			Expect(c.Create(ctx, cm)).To(Succeed())
+			Expect(c.Create(ctx, cm.DeepCopy())).ToNot(Succeed())
+			Expect(c.Update(ctx, cm)).To(Succeed())
+			Expect(c.Delete(ctx, cm)).To(Succeed())
+
+			Expect(c.Create(ctx, inst)).To(Succeed())
+			inst.Status.InstallationPhase = lsv1alpha1.InstallationPhases.Succeeded
+			Expect(c.Status().Update(ctx, inst)).To(Succeed())
+
+			Expect(counter.Total()).To(BeEquivalentTo(5))
+			Expect(counter.Counts()).To(Equal([]loadtest.WriteCount{
+				{WriteKey: loadtest.WriteKey{Kind: "ConfigMap", Operation: loadtest.OperationCreate}, Count: 1},
+				{WriteKey: loadtest.WriteKey{Kind: "ConfigMap", Operation: loadtest.OperationDelete}, Count: 1},
+				{WriteKey: loadtest.WriteKey{Kind: "ConfigMap", Operation: loadtest.OperationUpdate}, Count: 1},
+				{WriteKey: loadtest.WriteKey{Kind: "Installation", Operation: loadtest.OperationCreate}, Count: 1},
+				{WriteKey: loadtest.WriteKey{Kind: "Installation", Operation: loadtest.OperationStatusUpdate}, Count: 1},
+			}))
+
+			counter.Reset()
+			Expect(counter.Total()).To(BeZero())
+		})
+	})
+
+	Context("ParseSteps", func() {
+
+		It("should parse phases with and without durations", func() {
+			steps, err := loadtest.ParseSteps("Progressing=5s, Succeeded")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(steps).To(Equal([]mockv1alpha1.Step{
+				{Phase: lsv1alpha1.DeployItemPhases.Progressing, Duration: &lsv1alpha1.Duration{Duration: 5 * time.Second}},
+				{Phase: lsv1alpha1.DeployItemPhases.Succeeded},
+			}))
+		})
+
+		It("should fail for invalid steps", func() {
+			_, err := loadtest.ParseSteps("Progressing=soon,Succeeded")
+			Expect(err).To(HaveOccurred())
+			_, err = loadtest.ParseSteps("")
+			Expect(err).To(HaveOccurred())
+		})
+	})
+
+	Context("BuildInstallation", func() {
+
+		It("should create an installation with an inline blueprint that deploys mock deploy items", func() {
+			steps, err := loadtest.ParseSteps("Progressing=1s,Succeeded")
+			Expect(err).ToNot(HaveOccurred())
+			inst, err := loadtest.BuildInstallation("inst", "test", "run", 3, steps)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(inst.Labels).To(HaveKeyWithValue(loadtest.LoadTestLabel, "run"))
+			Expect(inst.Spec.Blueprint.Inline).ToNot(BeNil())
+
+			filesystem := map[string]string{}
+			Expect(json.Unmarshal(inst.Spec.Blueprint.Inline.Filesystem.RawMessage, &filesystem)).To(Succeed())
+			blueprint := &lsv1alpha1.Blueprint{}
+			Expect(yaml.Unmarshal([]byte(filesystem["blueprint.yaml"]), blueprint)).To(Succeed())
+			Expect(blueprint.DeployExecutions).To(HaveLen(1))
+
+			// the template contains no template directives, so it can be decoded directly
+			executions := struct {
+				DeployItems []struct {
+					Name   string          `json:"name"`
+					Type   string          `json:"type"`
+					Config json.RawMessage `json:"config"`
+				} `json:"deployItems"`
+			}{}
+			template := ""
+			Expect(json.Unmarshal(blueprint.DeployExecutions[0].Template.RawMessage, &template)).To(Succeed())
+			Expect(yaml.Unmarshal([]byte(template), &executions)).To(Succeed())
+			Expect(executions.DeployItems).To(HaveLen(3))
+			providerConfig := &mockv1alpha1.ProviderConfiguration{}
+			Expect(json.Unmarshal(executions.DeployItems[2].Config, providerConfig)).To(Succeed())
+			Expect(executions.DeployItems[2].Name).To(Equal("item-2"))
+			Expect(providerConfig.Steps).To(Equal(steps))
+		})
+	})
+
+	Context("Report", func() {
+
+		It("should compute the latency percentiles", func() {
+			durations := make([]time.Duration, 0, 100)
+			for i := 100; i > 0; i-- {
+				durations = append(durations, time.Duration(i)*time.Second)
+			}
+			Expect(loadtest.ComputeLatencies(durations)).To(Equal(loadtest.Latencies{
+				P50: 50 * time.Second,
+				P90: 90 * time.Second,
+				P99: 99 * time.Second,
+				Max: 100 * time.Second,
+			}))
+			Expect(loadtest.ComputeLatencies(nil)).To(Equal(loadtest.Latencies{}))
+		})
+	})
+})
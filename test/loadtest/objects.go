@@ -0,0 +1,102 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package loadtest
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	lsv1alpha1 "github.com/gardener/landscaper/apis/core/v1alpha1"
+	mockv1alpha1 "github.com/gardener/landscaper/apis/deployer/mock/v1alpha1"
+	"github.com/gardener/landscaper/pkg/deployer/mock"
+)
+
+// LoadTestLabel is the label that is set on all objects created by the load test.
+const LoadTestLabel = "loadtest.landscaper.gardener.cloud/run"
+
+// ParseSteps parses a comma separated list of phases of the mock deployer.
+// Each phase can be followed by "=" and the duration of the step, e.g. "Progressing=10s,Succeeded".
+func ParseSteps(s string) ([]mockv1alpha1.Step, error) {
+	steps := []mockv1alpha1.Step{}
+	for _, part := range strings.Split(s, ",") {
+		part = strings.TrimSpace(part)
+		if len(part) == 0 {
+			continue
+		}
+		phase, duration, hasDuration := strings.Cut(part, "=")
+		step := mockv1alpha1.Step{Phase: lsv1alpha1.DeployItemPhase(phase)}
+		if hasDuration {
+			d, err := time.ParseDuration(duration)
+			if err != nil {
+				return nil, fmt.Errorf("invalid duration of step %q: %w", part, err)
+			}
+			step.Duration = &lsv1alpha1.Duration{Duration: d}
+		}
+		steps = append(steps, step)
+	}
+	if len(steps) == 0 {
+		return nil, fmt.Errorf("at least one step has to be defined")
+	}
+	return steps, nil
+}
+
+// BuildInstallation creates a root installation with an inline blueprint. The blueprint deploys the given number
+// of mock deploy items which run through the given steps.
+func BuildInstallation(name, namespace, runID string, deployItems int, steps []mockv1alpha1.Step) (*lsv1alpha1.Installation, error) {
+	providerConfig := &mockv1alpha1.ProviderConfiguration{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: mockv1alpha1.SchemeGroupVersion.String(),
+			Kind:       "ProviderConfiguration",
+		},
+		Steps: steps,
+	}
+	config, err := json.Marshal(providerConfig)
+	if err != nil {
+		return nil, fmt.Errorf("unable to marshal mock provider configuration: %w", err)
+	}
+
+	template := strings.Builder{}
+	template.WriteString("deployItems:\n")
+	for i := 0; i < deployItems; i++ {
+		// json is valid yaml, so the provider configuration can be inlined
+		template.WriteString(fmt.Sprintf("  - name: item-%d\n    type: %s\n    config: %s\n", i, mock.Type, config))
+	}
+
+	blueprint := map[string]interface{}{
+		"apiVersion": lsv1alpha1.SchemeGroupVersion.String(),
+		"kind":       "Blueprint",
+		"jsonSchema": "https://json-schema.org/draft/2019-09/schema",
+		"deployExecutions": []interface{}{
+			map[string]interface{}{
+				"name":     "default",
+				"type":     string(lsv1alpha1.GOTemplateType),
+				"template": template.String(),
+			},
+		},
+	}
+	blueprintBytes, err := json.Marshal(blueprint)
+	if err != nil {
+		return nil, fmt.Errorf("unable to marshal blueprint: %w", err)
+	}
+	filesystem, err := json.Marshal(map[string]string{"blueprint.yaml": string(blueprintBytes)})
+	if err != nil {
+		return nil, fmt.Errorf("unable to marshal blueprint filesystem: %w", err)
+	}
+
+	inst := &lsv1alpha1.Installation{}
+	inst.Name = name
+	inst.Namespace = namespace
+	inst.Labels = map[string]string{LoadTestLabel: runID}
+	inst.Annotations = map[string]string{lsv1alpha1.OperationAnnotation: string(lsv1alpha1.ReconcileOperation)}
+	inst.Spec.Context = lsv1alpha1.DefaultContextName
+	inst.Spec.Blueprint.Inline = &lsv1alpha1.InlineBlueprint{
+		Filesystem: lsv1alpha1.NewAnyJSON(filesystem),
+	}
+	return inst, nil
+}
@@ -0,0 +1,93 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package loadtest
+
+import (
+	"fmt"
+	"time"
+
+	flag "github.com/spf13/pflag"
+)
+
+// Options configure a load test run.
+type Options struct {
+	// Kubeconfig is the path to the kubeconfig of the cluster, e.g. a kind cluster, that is used for the load test.
+	// If not set, a local envtest api server is started.
+	// The Landscaper controllers must not run in the cluster, as they are started by the load test itself.
+	Kubeconfig string
+	// ProjectRoot is the path to the root of the landscaper repository. It is used to find the crds.
+	ProjectRoot string
+	// Namespace is the namespace in which the installations are created.
+	Namespace string
+	// Installations is the number of root installations that are created.
+	Installations int
+	// DeployItems is the number of mock deploy items of each installation.
+	DeployItems int
+	// Steps is the phase sequence of the mock deploy items, e.g. "Progressing=5s,Succeeded".
+	Steps string
+	// CreateWorkers is the number of parallel workers that create the installations.
+	CreateWorkers int
+	// ControllerWorkers is the number of workers of the installation, execution and deploy item controllers.
+	ControllerWorkers int
+	// PollInterval is the interval in which the state of the installations is checked.
+	PollInterval time.Duration
+	// Timeout is the maximal duration of the load test.
+	Timeout time.Duration
+	// KeepObjects disables the deletion of the created installations after the run.
+	KeepObjects bool
+	// ReportPath is the path of a file to which the report is written as json.
+	ReportPath string
+}
+
+// NewOptions returns the default options.
+func NewOptions() *Options {
+	return &Options{
+		ProjectRoot:       ".",
+		Namespace:         "ls-load-test",
+		Installations:     1000,
+		DeployItems:       1,
+		Steps:             "Progressing=1s,Succeeded",
+		CreateWorkers:     10,
+		ControllerWorkers: 30,
+		PollInterval:      2 * time.Second,
+		Timeout:           30 * time.Minute,
+	}
+}
+
+// AddFlags adds flags for the options to a flagset.
+func (o *Options) AddFlags(fs *flag.FlagSet) {
+	fs.StringVar(&o.Kubeconfig, "kubeconfig", o.Kubeconfig, "path to the kubeconfig of the test cluster; an envtest api server is started if not set")
+	fs.StringVar(&o.ProjectRoot, "project-root", o.ProjectRoot, "path to the root of the landscaper repository")
+	fs.StringVarP(&o.Namespace, "namespace", "n", o.Namespace, "namespace in which the installations are created")
+	fs.IntVar(&o.Installations, "installations", o.Installations, "number of root installations")
+	fs.IntVar(&o.DeployItems, "deploy-items", o.DeployItems, "number of mock deploy items per installation")
+	fs.StringVar(&o.Steps, "steps", o.Steps, "phase sequence of the mock deploy items, e.g. \"Progressing=5s,Succeeded\"")
+	fs.IntVar(&o.CreateWorkers, "create-workers", o.CreateWorkers, "number of parallel workers that create the installations")
+	fs.IntVar(&o.ControllerWorkers, "controller-workers", o.ControllerWorkers, "number of workers of the landscaper controllers")
+	fs.DurationVar(&o.PollInterval, "poll-interval", o.PollInterval, "interval in which the state of the installations is checked")
+	fs.DurationVar(&o.Timeout, "timeout", o.Timeout, "maximal duration of the load test")
+	fs.BoolVar(&o.KeepObjects, "keep-objects", o.KeepObjects, "do not delete the installations after the run")
+	fs.StringVar(&o.ReportPath, "report", o.ReportPath, "path of a file to which the report is written as json")
+}
+
+// Validate validates the options.
+func (o *Options) Validate() error {
+	if o.Installations < 1 {
+		return fmt.Errorf("at least one installation has to be created")
+	}
+	if o.DeployItems < 1 {
+		return fmt.Errorf("each installation needs at least one deploy item")
+	}
+	if o.CreateWorkers < 1 || o.ControllerWorkers < 1 {
+		return fmt.Errorf("the number of workers must be positive")
+	}
+	if o.PollInterval <= 0 || o.Timeout <= 0 {
+		return fmt.Errorf("poll interval and timeout must be positive")
+	}
+	if _, err := ParseSteps(o.Steps); err != nil {
+		return err
+	}
+	return nil
+}
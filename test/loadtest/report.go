@@ -0,0 +1,101 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package loadtest
+
+import (
+	"fmt"
+	"io"
+	"sort"
+	"text/tabwriter"
+	"time"
+)
+
+// Report is the result of a load test run.
+type Report struct {
+	// RunID is the unique id of the run.
+	RunID string `json:"runID"`
+	// Installations is the number of created installations.
+	Installations int `json:"installations"`
+	// DeployItems is the number of deploy items per installation.
+	DeployItems int `json:"deployItems"`
+	// Succeeded is the number of installations that have succeeded.
+	Succeeded int `json:"succeeded"`
+	// Failed is the number of installations that have failed.
+	Failed int `json:"failed"`
+	// Duration is the time from the creation of the first installation until all installations have finished.
+	Duration time.Duration `json:"duration"`
+	// Throughput is the number of finished installations per second.
+	Throughput float64 `json:"throughput"`
+	// Latencies contains percentiles of the time from the creation of an installation until it has finished.
+	Latencies Latencies `json:"latencies"`
+	// Writes is the number of write requests of the controllers by kind and operation.
+	Writes []WriteCount `json:"writes"`
+	// TotalWrites is the number of all write requests of the controllers.
+	TotalWrites int64 `json:"totalWrites"`
+	// WritesPerInstallation is the average number of write requests per installation.
+	WritesPerInstallation float64 `json:"writesPerInstallation"`
+}
+
+// Latencies contains percentiles of durations.
+type Latencies struct {
+	P50 time.Duration `json:"p50"`
+	P90 time.Duration `json:"p90"`
+	P99 time.Duration `json:"p99"`
+	Max time.Duration `json:"max"`
+}
+
+// ComputeLatencies calculates the percentiles of the given durations.
+func ComputeLatencies(durations []time.Duration) Latencies {
+	if len(durations) == 0 {
+		return Latencies{}
+	}
+	sorted := append([]time.Duration{}, durations...)
+	sort.Slice(sorted, func(i, j int) bool { return sorted[i] < sorted[j] })
+	percentile := func(p int) time.Duration {
+		// nearest rank method
+		rank := (p*len(sorted) + 99) / 100
+		if rank < 1 {
+			rank = 1
+		}
+		return sorted[rank-1]
+	}
+	return Latencies{
+		P50: percentile(50),
+		P90: percentile(90),
+		P99: percentile(99),
+		Max: sorted[len(sorted)-1],
+	}
+}
+
+// complete calculates the derived values of the report.
+func (r *Report) complete(latencies []time.Duration, counter *WriteCounter) {
+	if r.Duration > 0 {
+		r.Throughput = float64(r.Succeeded+r.Failed) / r.Duration.Seconds()
+	}
+	r.Latencies = ComputeLatencies(latencies)
+	r.Writes = counter.Counts()
+	r.TotalWrites = counter.Total()
+	if r.Installations > 0 {
+		r.WritesPerInstallation = float64(r.TotalWrites) / float64(r.Installations)
+	}
+}
+
+// Print writes a human-readable summary of the report.
+func (r *Report) Print(w io.Writer) error {
+	tw := tabwriter.NewWriter(w, 0, 8, 2, ' ', 0)
+	fmt.Fprintf(tw, "Run:\t%s\n", r.RunID)
+	fmt.Fprintf(tw, "Installations:\t%d (%d deploy items each)\n", r.Installations, r.DeployItems)
+	fmt.Fprintf(tw, "Succeeded / Failed:\t%d / %d\n", r.Succeeded, r.Failed)
+	fmt.Fprintf(tw, "Duration:\t%s\n", r.Duration.Round(time.Millisecond))
+	fmt.Fprintf(tw, "Throughput:\t%.2f installations/s\n", r.Throughput)
+	fmt.Fprintf(tw, "Latency p50 / p90 / p99 / max:\t%s / %s / %s / %s\n",
+		r.Latencies.P50.Round(time.Millisecond), r.Latencies.P90.Round(time.Millisecond),
+		r.Latencies.P99.Round(time.Millisecond), r.Latencies.Max.Round(time.Millisecond))
+	fmt.Fprintf(tw, "Writes:\t%d (%.1f per installation)\n", r.TotalWrites, r.WritesPerInstallation)
+	for _, count := range r.Writes {
+		fmt.Fprintf(tw, "  %s %s:\t%d\n", count.Kind, count.Operation, count.Count)
+	}
+	return tw.Flush()
+}